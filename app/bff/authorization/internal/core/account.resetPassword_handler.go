@@ -19,18 +19,26 @@
 package core
 
 import (
-	"time"
-
 	"github.com/teamgram/proto/mtproto"
+	userpb "github.com/teamgram/teamgram-server/app/service/biz/user/user"
 )
 
 // AccountResetPassword
 // account.resetPassword#9308ce1b = account.ResetPasswordResult;
 func (c *AuthorizationCore) AccountResetPassword(in *mtproto.TLAccountResetPassword) (*mtproto.Account_ResetPasswordResult, error) {
-	// TODO: not impl
-	c.Logger.Errorf("account.resetPassword blocked, License key from https://teamgram.net required to unlock enterprise features.")
+	userId, _, err := c.getPasswordUserId()
+	if err != nil {
+		c.Logger.Errorf("account.resetPassword - error: %v", err)
+		return nil, err
+	}
+
+	rValue, err := c.svcCtx.Dao.UserClient.UserResetPassword(c.ctx, &userpb.TLUserResetPassword{
+		UserId: userId,
+	})
+	if err != nil {
+		c.Logger.Errorf("account.resetPassword - error: %v", err)
+		return nil, err
+	}
 
-	return mtproto.MakeTLAccountResetPasswordFailedWait(&mtproto.Account_ResetPasswordResult{
-		RetryDate: int32(time.Now().Unix() + 30*24*60*60),
-	}).To_Account_ResetPasswordResult(), nil
+	return rValue, nil
 }
@@ -20,13 +20,25 @@ package core
 
 import (
 	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/proto/mtproto/rpc/metadata"
+	"github.com/teamgram/teamgram-server/app/service/authsession/authsession"
 )
 
 // AuthBindTempAuthKey
 // auth.bindTempAuthKey#cdd42a05 perm_auth_key_id:long nonce:long expires_at:int encrypted_message:bytes = Bool;
 func (c *AuthorizationCore) AuthBindTempAuthKey(in *mtproto.TLAuthBindTempAuthKey) (*mtproto.Bool, error) {
-	// TODO: not impl
-	// c.Logger.Errorf("auth.bindTempAuthKey blocked, License key from https://teamgram.net required to unlock enterprise features.")
+	// authsession checks the bind_auth_key_inner against the temp key, session and msg_id of this request
+	ctx, _ := metadata.RpcMetadataToOutgoing(c.ctx, c.MD)
+	rBool, err := c.svcCtx.Dao.AuthsessionClient.AuthsessionBindTempAuthKey(ctx, &authsession.TLAuthsessionBindTempAuthKey{
+		PermAuthKeyId:    in.PermAuthKeyId,
+		Nonce:            in.Nonce,
+		ExpiresAt:        in.ExpiresAt,
+		EncryptedMessage: in.EncryptedMessage,
+	})
+	if err != nil {
+		c.Logger.Errorf("auth.bindTempAuthKey - error: %v", err)
+		return nil, err
+	}
 
-	return mtproto.BoolTrue, nil
+	return rBool, nil
 }
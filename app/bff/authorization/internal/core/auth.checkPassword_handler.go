@@ -26,18 +26,25 @@ import (
 // AuthCheckPassword
 // auth.checkPassword#d18b4d16 password:InputCheckPasswordSRP = auth.Authorization;
 func (c *AuthorizationCore) AuthCheckPassword(in *mtproto.TLAuthCheckPassword) (*mtproto.Auth_Authorization, error) {
-	// TODO: check password
-	c.Logger.Errorf("auth.checkPassword blocked, License key from https://teamgram.net required to unlock enterprise features.")
+	userId, passwordNeeded, err := c.getPasswordUserId()
+	if err != nil {
+		c.Logger.Errorf("auth.checkPassword - error: %v", err)
+		return nil, err
+	}
+
+	if _, err = c.svcCtx.Dao.UserClient.UserCheckPassword(c.ctx, &userpb.TLUserCheckPassword{
+		UserId:   userId,
+		Password: in.Password,
+	}); err != nil {
+		c.Logger.Errorf("auth.checkPassword - error: %v", err)
+		return nil, err
+	}
 
-	user, err := c.svcCtx.UserClient.UserGetImmutableUser(c.ctx, &userpb.TLUserGetImmutableUser{
-		Id: c.MD.UserId,
-	})
+	rValue, err := c.doSignInWithPassword(userId, passwordNeeded)
 	if err != nil {
 		c.Logger.Errorf("auth.checkPassword - error: %v", err)
 		return nil, err
 	}
 
-	return mtproto.MakeTLAuthAuthorization(&mtproto.Auth_Authorization{
-		User: user.ToSelfUser(),
-	}).To_Auth_Authorization(), nil
+	return rValue, nil
 }
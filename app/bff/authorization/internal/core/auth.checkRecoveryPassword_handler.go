@@ -20,13 +20,26 @@ package core
 
 import (
 	"github.com/teamgram/proto/mtproto"
+	userpb "github.com/teamgram/teamgram-server/app/service/biz/user/user"
 )
 
 // AuthCheckRecoveryPassword
 // auth.checkRecoveryPassword#d36bf79 code:string = Bool;
 func (c *AuthorizationCore) AuthCheckRecoveryPassword(in *mtproto.TLAuthCheckRecoveryPassword) (*mtproto.Bool, error) {
-	// TODO: not impl
-	c.Logger.Errorf("auth.checkRecoveryPassword blocked, License key from https://teamgram.net required to unlock enterprise features.")
+	userId, _, err := c.getPasswordUserId()
+	if err != nil {
+		c.Logger.Errorf("auth.checkRecoveryPassword - error: %v", err)
+		return nil, err
+	}
 
-	return nil, mtproto.ErrEnterpriseIsBlocked
+	rValue, err := c.svcCtx.Dao.UserClient.UserCheckRecoveryPassword(c.ctx, &userpb.TLUserCheckRecoveryPassword{
+		UserId: userId,
+		Code:   in.Code,
+	})
+	if err != nil {
+		c.Logger.Errorf("auth.checkRecoveryPassword - error: %v", err)
+		return nil, err
+	}
+
+	return rValue, nil
 }
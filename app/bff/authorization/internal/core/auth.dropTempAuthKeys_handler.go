@@ -20,13 +20,34 @@ package core
 
 import (
 	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/authsession/authsession"
 )
 
 // AuthDropTempAuthKeys
 // auth.dropTempAuthKeys#8e48a188 except_auth_keys:Vector<long> = Bool;
 func (c *AuthorizationCore) AuthDropTempAuthKeys(in *mtproto.TLAuthDropTempAuthKeys) (*mtproto.Bool, error) {
-	// TODO: not impl
-	// c.Logger.Errorf("auth.dropTempAuthKeys blocked, License key from https://teamgram.net required to unlock enterprise features.")
+	permAuthKeyId, err := c.svcCtx.Dao.AuthsessionClient.AuthsessionGetPermAuthKeyId(c.ctx, &authsession.TLAuthsessionGetPermAuthKeyId{
+		AuthKeyId: c.MD.AuthId,
+	})
+	if err != nil {
+		c.Logger.Errorf("auth.dropTempAuthKeys - error: %v", err)
+		return nil, err
+	} else if permAuthKeyId.GetV() == 0 {
+		err = mtproto.ErrAuthKeyPermEmpty
+		c.Logger.Errorf("auth.dropTempAuthKeys - error: %v", err)
+		return nil, err
+	}
+
+	// the temp key of this request is dropped too unless it is listed
+	droppedKeys, err := c.svcCtx.Dao.AuthsessionClient.AuthsessionDropTempAuthKeys(c.ctx, &authsession.TLAuthsessionDropTempAuthKeys{
+		PermAuthKeyId:  permAuthKeyId.GetV(),
+		ExceptAuthKeys: in.ExceptAuthKeys,
+	})
+	if err != nil {
+		c.Logger.Errorf("auth.dropTempAuthKeys - error: %v", err)
+		return nil, err
+	}
+	c.Logger.Infof("auth.dropTempAuthKeys - dropped: %v", droppedKeys.GetDatas())
 
 	return mtproto.BoolTrue, nil
 }
@@ -20,13 +20,42 @@ package core
 
 import (
 	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/authsession/authsession"
+	userpb "github.com/teamgram/teamgram-server/app/service/biz/user/user"
 )
 
 // AuthImportBotAuthorization
 // auth.importBotAuthorization#67a3ff2c flags:int api_id:int api_hash:string bot_auth_token:string = auth.Authorization;
 func (c *AuthorizationCore) AuthImportBotAuthorization(in *mtproto.TLAuthImportBotAuthorization) (*mtproto.Auth_Authorization, error) {
-	// TODO: not impl
-	c.Logger.Errorf("auth.importBotAuthorization blocked, License key from https://teamgram.net required to unlock enterprise features.")
+	if err := c.svcCtx.Dao.CheckApiIdAndHash(in.ApiId, in.ApiHash); err != nil {
+		c.Logger.Errorf("auth.importBotAuthorization - invalid api: {api_id: %d, api_hash: %s}", in.ApiId, in.ApiHash)
+		return nil, err
+	}
 
-	return nil, mtproto.ErrEnterpriseIsBlocked
+	// bot_auth_token: {bot_id}:{secret}
+	if _, ok := userpb.GetBotIdByToken(in.BotAuthToken); !ok {
+		err := mtproto.ErrAccessTokenInvalid
+		c.Logger.Errorf("auth.importBotAuthorization - error: %v", err)
+		return nil, err
+	}
+
+	bot, err := c.svcCtx.Dao.UserClient.UserGetImmutableUserByToken(c.ctx, &userpb.TLUserGetImmutableUserByToken{
+		Token: in.BotAuthToken,
+	})
+	if err != nil || !bot.IsBot() || bot.Deleted() {
+		c.Logger.Errorf("auth.importBotAuthorization - error: %v", err)
+		return nil, mtproto.ErrAccessTokenInvalid
+	}
+
+	if _, err = c.svcCtx.Dao.AuthsessionClient.AuthsessionBindAuthKeyUser(c.ctx, &authsession.TLAuthsessionBindAuthKeyUser{
+		AuthKeyId: c.MD.AuthId,
+		UserId:    bot.Id(),
+	}); err != nil {
+		c.Logger.Errorf("auth.importBotAuthorization - error: %v", err)
+		return nil, err
+	}
+
+	return mtproto.MakeTLAuthAuthorization(&mtproto.Auth_Authorization{
+		User: bot.ToSelfUser(),
+	}).To_Auth_Authorization(), nil
 }
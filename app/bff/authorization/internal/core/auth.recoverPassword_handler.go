@@ -20,13 +20,32 @@ package core
 
 import (
 	"github.com/teamgram/proto/mtproto"
+	userpb "github.com/teamgram/teamgram-server/app/service/biz/user/user"
 )
 
 // AuthRecoverPassword
 // auth.recoverPassword#37096c70 flags:# code:string new_settings:flags.0?account.PasswordInputSettings = auth.Authorization;
 func (c *AuthorizationCore) AuthRecoverPassword(in *mtproto.TLAuthRecoverPassword) (*mtproto.Auth_Authorization, error) {
-	// TODO: not impl
-	c.Logger.Errorf("auth.recoverPassword blocked, License key from https://teamgram.net required to unlock enterprise features.")
+	userId, passwordNeeded, err := c.getPasswordUserId()
+	if err != nil {
+		c.Logger.Errorf("auth.recoverPassword - error: %v", err)
+		return nil, err
+	}
 
-	return nil, mtproto.ErrEnterpriseIsBlocked
+	if _, err = c.svcCtx.Dao.UserClient.UserRecoverPassword(c.ctx, &userpb.TLUserRecoverPassword{
+		UserId:      userId,
+		Code:        in.Code,
+		NewSettings: in.NewSettings,
+	}); err != nil {
+		c.Logger.Errorf("auth.recoverPassword - error: %v", err)
+		return nil, err
+	}
+
+	rValue, err := c.doSignInWithPassword(userId, passwordNeeded)
+	if err != nil {
+		c.Logger.Errorf("auth.recoverPassword - error: %v", err)
+		return nil, err
+	}
+
+	return rValue, nil
 }
@@ -20,13 +20,25 @@ package core
 
 import (
 	"github.com/teamgram/proto/mtproto"
+	userpb "github.com/teamgram/teamgram-server/app/service/biz/user/user"
 )
 
 // AuthRequestPasswordRecovery
 // auth.requestPasswordRecovery#d897bc66 = auth.PasswordRecovery;
 func (c *AuthorizationCore) AuthRequestPasswordRecovery(in *mtproto.TLAuthRequestPasswordRecovery) (*mtproto.Auth_PasswordRecovery, error) {
-	// TODO: not impl
-	c.Logger.Errorf("auth.requestPasswordRecovery blocked, License key from https://teamgram.net required to unlock enterprise features.")
+	userId, _, err := c.getPasswordUserId()
+	if err != nil {
+		c.Logger.Errorf("auth.requestPasswordRecovery - error: %v", err)
+		return nil, err
+	}
 
-	return nil, mtproto.ErrEnterpriseIsBlocked
+	rValue, err := c.svcCtx.Dao.UserClient.UserRequestPasswordRecovery(c.ctx, &userpb.TLUserRequestPasswordRecovery{
+		UserId: userId,
+	})
+	if err != nil {
+		c.Logger.Errorf("auth.requestPasswordRecovery - error: %v", err)
+		return nil, err
+	}
+
+	return rValue, nil
 }
@@ -132,16 +132,13 @@ func (c *AuthorizationCore) AuthResendCode(in *mtproto.TLAuthResendCode) (*mtpro
 			//}
 
 			// 400	SMS_CODE_CREATE_FAILED	An error occurred while creating the SMS code
-			extraData, err2 := c.svcCtx.AuthLogic.VerifyCodeInterface.SendSmsVerifyCode(c.ctx, phoneNumber, codeData2.PhoneCode, codeData2.PhoneCodeHash)
-			if err2 != nil {
+			if err2 := c.svcCtx.AuthLogic.SendVerifyCode(c.ctx, phoneNumber, codeData2); err2 != nil {
 				c.Logger.Errorf("sendSmsVerifyCode error: %v", err2)
 				return err2
 			}
 
-			codeData2.SentCodeType = model.CodeTypeSms
 			codeData2.NextCodeType = model.CodeTypeSms
 			codeData2.State = model.CodeStateSent
-			codeData2.PhoneCodeExtraData = extraData
 
 			return nil
 
@@ -307,16 +307,12 @@ func (c *AuthorizationCore) authSendCode(authKeyId, sessionId int64, request *mt
 
 			if needSendSms {
 				c.Logger.Infof("send code by sms")
-				if extraData, err2 := c.svcCtx.AuthLogic.VerifyCodeInterface.SendSmsVerifyCode(
+				if err2 := c.svcCtx.AuthLogic.SendVerifyCode(
 					context.Background(),
 					phoneNumber,
-					codeData2.PhoneCode,
-					codeData2.PhoneCodeHash); err2 != nil {
+					codeData2); err2 != nil {
 					c.Logger.Errorf("send sms code error: %v", err2)
 					return err2
-				} else {
-					codeData2.SentCodeType = model.CodeTypeSms
-					codeData2.PhoneCodeExtraData = extraData
 				}
 			}
 
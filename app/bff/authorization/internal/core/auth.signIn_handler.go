@@ -23,7 +23,6 @@ import (
 	"github.com/teamgram/proto/mtproto/crypto"
 	"github.com/teamgram/teamgram-server/app/bff/authorization/internal/logic"
 	"github.com/teamgram/teamgram-server/app/bff/authorization/internal/model"
-	"github.com/teamgram/teamgram-server/app/messenger/sync/sync"
 	"github.com/teamgram/teamgram-server/app/service/authsession/authsession"
 	userpb "github.com/teamgram/teamgram-server/app/service/biz/user/user"
 	"github.com/teamgram/teamgram-server/pkg/env2"
@@ -209,35 +208,19 @@ func (c *AuthorizationCore) AuthSignIn(in *mtproto.TLAuthSignIn) (*mtproto.Auth_
 		return nil, err
 	}
 
-	// Bind authKeyId and userId
-	c.svcCtx.Dao.AuthsessionClient.AuthsessionBindAuthKeyUser(c.ctx, &authsession.TLAuthsessionBindAuthKeyUser{
-		AuthKeyId: c.MD.AuthId,
-		UserId:    user.User.Id,
-	})
-
-	// Check SESSION_PASSWORD_NEEDED
+	// Check SESSION_PASSWORD_NEEDED, the authKeyId is bound after auth.checkPassword
 	if c.svcCtx.Plugin != nil {
-		if c.svcCtx.Plugin.CheckSessionPasswordNeeded(c.ctx, c.MD.UserId) {
+		if c.svcCtx.Plugin.CheckSessionPasswordNeeded(c.ctx, user.Id()) {
+			c.svcCtx.Dao.PutCachePasswordNeeded(c.ctx, c.MD.AuthId, &model.SessionPasswordNeeded{
+				UserId:        user.Id(),
+				PhoneNumber:   in.PhoneNumber,
+				PhoneCodeHash: in.PhoneCodeHash,
+			})
 			err = mtproto.ErrSessionPasswordNeeded
 			c.Logger.Infof("auth.signIn - registered, next step auth.checkPassword: %v", err)
 			return nil, err
 		}
 	}
 
-	selfUser := user.ToSelfUser()
-
-	c.svcCtx.AuthLogic.DeletePhoneCode(c.ctx, c.MD.AuthId, in.PhoneNumber, in.PhoneCodeHash)
-	region, _ := c.svcCtx.Dao.GetCountryAndRegionByIp(c.MD.ClientAddr)
-	signInN := mtproto.MakeSignInServiceNotification(selfUser, c.MD.AuthId, c.MD.Client, region, c.MD.ClientAddr)
-	c.svcCtx.Dao.SyncClient.SyncUpdatesNotMe(
-		c.ctx,
-		&sync.TLSyncUpdatesNotMe{
-			UserId:    user.Id(),
-			AuthKeyId: c.MD.AuthId,
-			Updates:   mtproto.MakeUpdatesByUpdates(signInN),
-		})
-
-	return mtproto.MakeTLAuthAuthorization(&mtproto.Auth_Authorization{
-		User: selfUser,
-	}).To_Auth_Authorization(), nil
+	return c.doSignIn(user, in.PhoneNumber, in.PhoneCodeHash), nil
 }
@@ -27,7 +27,11 @@ import (
 
 	"github.com/teamgram/proto/mtproto"
 	"github.com/teamgram/proto/mtproto/rpc/metadata"
+	"github.com/teamgram/teamgram-server/app/bff/authorization/internal/model"
 	"github.com/teamgram/teamgram-server/app/bff/authorization/internal/svc"
+	"github.com/teamgram/teamgram-server/app/messenger/sync/sync"
+	"github.com/teamgram/teamgram-server/app/service/authsession/authsession"
+	userpb "github.com/teamgram/teamgram-server/app/service/biz/user/user"
 	msgpb "github.com/teamgram/teamgram-server/app/messenger/msg/msg/msg"
 	"github.com/teamgram/teamgram-server/pkg/env2"
 	"github.com/teamgram/teamgram-server/pkg/phonenumber"
@@ -123,3 +127,63 @@ func (c *AuthorizationCore) pushSignInMessage(ctx context.Context, signInUserId
 			})
 	})
 }
+
+// doSignIn binds authKeyId and userId, and notifies the other sessions.
+func (c *AuthorizationCore) doSignIn(user *userpb.ImmutableUser, phoneNumber, phoneCodeHash string) *mtproto.Auth_Authorization {
+	c.svcCtx.Dao.AuthsessionClient.AuthsessionBindAuthKeyUser(c.ctx, &authsession.TLAuthsessionBindAuthKeyUser{
+		AuthKeyId: c.MD.AuthId,
+		UserId:    user.Id(),
+	})
+
+	selfUser := user.ToSelfUser()
+
+	c.svcCtx.AuthLogic.DeletePhoneCode(c.ctx, c.MD.AuthId, phoneNumber, phoneCodeHash)
+	region, _ := c.svcCtx.Dao.GetCountryAndRegionByIp(c.MD.ClientAddr)
+	signInN := mtproto.MakeSignInServiceNotification(selfUser, c.MD.AuthId, c.MD.Client, region, c.MD.ClientAddr)
+	c.svcCtx.Dao.SyncClient.SyncUpdatesNotMe(
+		c.ctx,
+		&sync.TLSyncUpdatesNotMe{
+			UserId:    user.Id(),
+			AuthKeyId: c.MD.AuthId,
+			Updates:   mtproto.MakeUpdatesByUpdates(signInN),
+		})
+
+	return mtproto.MakeTLAuthAuthorization(&mtproto.Auth_Authorization{
+		User: selfUser,
+	}).To_Auth_Authorization()
+}
+
+// getPasswordUserId returns the logged in user, or the user waiting for auth.checkPassword
+func (c *AuthorizationCore) getPasswordUserId() (int64, *model.SessionPasswordNeeded, error) {
+	if c.MD.UserId != 0 {
+		return c.MD.UserId, nil, nil
+	}
+
+	passwordNeeded, err := c.svcCtx.Dao.GetCachePasswordNeeded(c.ctx, c.MD.AuthId)
+	if err != nil {
+		return 0, nil, err
+	} else if passwordNeeded == nil {
+		return 0, nil, mtproto.ErrAuthKeyUnregistered
+	}
+
+	return passwordNeeded.UserId, passwordNeeded, nil
+}
+
+// doSignInWithPassword finishes auth.signIn after auth.checkPassword or auth.recoverPassword
+func (c *AuthorizationCore) doSignInWithPassword(userId int64, passwordNeeded *model.SessionPasswordNeeded) (*mtproto.Auth_Authorization, error) {
+	user, err := c.svcCtx.Dao.UserClient.UserGetImmutableUser(c.ctx, &userpb.TLUserGetImmutableUser{
+		Id: userId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if passwordNeeded == nil {
+		return mtproto.MakeTLAuthAuthorization(&mtproto.Auth_Authorization{
+			User: user.ToSelfUser(),
+		}).To_Auth_Authorization(), nil
+	}
+
+	c.svcCtx.Dao.DeleteCachePasswordNeeded(c.ctx, c.MD.AuthId)
+	return c.doSignIn(user, passwordNeeded.PhoneNumber, passwordNeeded.PhoneCodeHash), nil
+}
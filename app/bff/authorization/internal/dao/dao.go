@@ -68,3 +68,8 @@ func New(c config.Config) *Dao {
 		MsgClient:         msg_client.NewMsgClient(rpcx.GetCachedRpcClient(c.MsgClient)),
 	}
 }
+
+// KV the shared redis kv, pkg/code counts the codes sent to a phone number in it.
+func (d *Dao) KV() kv.Store {
+	return d.kv
+}
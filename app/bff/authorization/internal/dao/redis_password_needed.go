@@ -0,0 +1,76 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package dao
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/teamgram/marmota/pkg/hack"
+	"github.com/teamgram/teamgram-server/app/bff/authorization/internal/model"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+const (
+	passwordNeededTimeout = 30 * 60
+	// cachePasswordNeededPrefix also read by bff twofa for account.getPassword
+	cachePasswordNeededPrefix = "password_needed"
+)
+
+func genCachePasswordNeededKey(authKeyId int64) string {
+	return fmt.Sprintf("%s_%d", cachePasswordNeededPrefix, authKeyId)
+}
+
+func (d *Dao) GetCachePasswordNeeded(ctx context.Context, authKeyId int64) (*model.SessionPasswordNeeded, error) {
+	cacheKey := genCachePasswordNeededKey(authKeyId)
+
+	v, err := d.kv.Get(cacheKey)
+	if err != nil {
+		logx.WithContext(ctx).Errorf("conn.GET(%s) error(%v)", cacheKey, err)
+		return nil, err
+	} else if v == "" {
+		return nil, nil
+	}
+
+	passwordNeeded := &model.SessionPasswordNeeded{}
+	err = json.Unmarshal(hack.Bytes(v), passwordNeeded)
+	return passwordNeeded, err
+}
+
+func (d *Dao) PutCachePasswordNeeded(ctx context.Context, authKeyId int64, passwordNeeded *model.SessionPasswordNeeded) (err error) {
+	cacheKey := genCachePasswordNeededKey(authKeyId)
+	b, _ := json.Marshal(passwordNeeded)
+
+	if err = d.kv.Setex(cacheKey, string(b), passwordNeededTimeout); err != nil {
+		logx.WithContext(ctx).Errorf("conn.SETEX(%s) error(%v)", cacheKey, err)
+	}
+	return
+}
+
+func (d *Dao) DeleteCachePasswordNeeded(ctx context.Context, authKeyId int64) (err error) {
+	cacheKey := genCachePasswordNeededKey(authKeyId)
+
+	if _, err = d.kv.Del(cacheKey); err != nil {
+		logx.WithContext(ctx).Errorf("conn.DEL(%s) error(%v)", cacheKey, err)
+	}
+
+	return
+}
@@ -39,7 +39,7 @@ type AuthLogic struct {
 func NewAuthSignLogic(dao *dao.Dao, code2 *conf.SmsVerifyCodeConfig) *AuthLogic {
 	return &AuthLogic{
 		Dao:                 dao,
-		VerifyCodeInterface: code.NewVerifyCode(code2),
+		VerifyCodeInterface: code.NewVerifyCode(code2, dao.KV()),
 	}
 }
 
@@ -101,6 +101,48 @@ func (m *AuthLogic) DoAuthSendCode(
 	return
 }
 
+// SendVerifyCode delivers codeData.PhoneCode and records how it was sent,
+// providers without delivery info are treated as sms. A failed delivery is
+// saved with codeData before the error is returned.
+func (m *AuthLogic) SendVerifyCode(ctx context.Context, phoneNumber string, codeData *model.PhoneCodeTransaction) error {
+	sender, ok := m.VerifyCodeInterface.(code.SentCodeSender)
+	if !ok {
+		extraData, err := m.VerifyCodeInterface.SendSmsVerifyCode(ctx, phoneNumber, codeData.PhoneCode, codeData.PhoneCodeHash)
+		if err != nil {
+			return err
+		}
+		codeData.SentCodeType = model.CodeTypeSms
+		codeData.PhoneCodeExtraData = extraData
+		return nil
+	}
+
+	sentCode, err := sender.SendVerifyCode(ctx, phoneNumber, codeData.PhoneCode, codeData.PhoneCodeHash)
+	if sentCode == nil {
+		return err
+	}
+
+	codeData.SentCodeProvider = sentCode.Provider
+	codeData.SentCodeMessageId = sentCode.MessageId
+	codeData.SentCodeStatus = sentCode.Status
+	codeData.SentCodeAttempts = sentCode.Attempts
+	codeData.SentCodeError = sentCode.Error
+	if err != nil {
+		m.Dao.UpdatePhoneCodeData(ctx, codeData.AuthKeyId, phoneNumber, codeData.PhoneCodeHash, codeData)
+		return err
+	}
+
+	switch sentCode.Type {
+	case code.SentCodeTypeCall:
+		codeData.SentCodeType = model.CodeTypeCall
+	default:
+		codeData.SentCodeType = model.CodeTypeSms
+	}
+	codeData.PhoneCodeExtraData = sentCode.ExtraData
+	codeData.SentCodeTimeout = sentCode.Timeout
+
+	return nil
+}
+
 // auth.resendCode
 func (m *AuthLogic) DoAuthReSendCode(ctx context.Context,
 	authKeyId int64,
@@ -44,6 +44,19 @@ type PhoneCodeTransaction struct {
 	FlashCallPattern      string `json:"flash_call_pattern"`
 	NextCodeType          int    `json:"next_code_type"`
 	State                 int    `json:"state"`
+	SentCodeTimeout       int32  `json:"sent_code_timeout,omitempty"`
+	SentCodeProvider      string `json:"sent_code_provider,omitempty"`
+	SentCodeMessageId     string `json:"sent_code_message_id,omitempty"`
+	SentCodeStatus        string `json:"sent_code_status,omitempty"`
+	SentCodeAttempts      int    `json:"sent_code_attempts,omitempty"`
+	SentCodeError         string `json:"sent_code_error,omitempty"`
+}
+
+// SessionPasswordNeeded auth.signIn passed the phone code, waiting for auth.checkPassword
+type SessionPasswordNeeded struct {
+	UserId        int64  `json:"user_id"`
+	PhoneNumber   string `json:"phone_number"`
+	PhoneCodeHash string `json:"phone_code_hash"`
 }
 
 /////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -59,6 +72,9 @@ func (m *PhoneCodeTransaction) ToAuthSentCode() *mtproto.Auth_SentCode {
 		NextType:      makeAuthCodeType(m.NextCodeType),
 		Timeout:       &types.Int32Value{Value: 60}, // TODO(@benqi): 默认60s
 	}).To_Auth_SentCode()
+	if m.SentCodeTimeout > 0 {
+		authSentCode.Timeout.Value = m.SentCodeTimeout
+	}
 	if m.SentCodeType == CodeTypeApp {
 		authSentCode.Timeout = nil
 	}
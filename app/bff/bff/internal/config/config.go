@@ -21,6 +21,9 @@ package config
 import (
 	kafka "github.com/teamgram/marmota/pkg/mq"
 	"github.com/teamgram/teamgram-server/pkg/code/conf"
+	filereference_conf "github.com/teamgram/teamgram-server/pkg/filereference/conf"
+	phonecall_conf "github.com/teamgram/teamgram-server/pkg/phonecall/conf"
+	sfu_conf "github.com/teamgram/teamgram-server/pkg/sfu/conf"
 	"github.com/zeromicro/go-zero/core/stores/kv"
 	"github.com/zeromicro/go-zero/zrpc"
 )
@@ -29,6 +32,9 @@ type Config struct {
 	zrpc.RpcServerConf
 	KV                kv.KvConf
 	Code              *conf.SmsVerifyCodeConfig
+	PhoneCall         phonecall_conf.PhoneCallConfig
+	Sfu               sfu_conf.SfuConfig
+	FileReference     filereference_conf.FileReferenceConfig
 	BizServiceClient  zrpc.RpcClientConf
 	AuthSessionClient zrpc.RpcClientConf
 	MediaClient       zrpc.RpcClientConf
@@ -37,4 +43,8 @@ type Config struct {
 	SyncClient        *kafka.KafkaProducerConf
 	DfsClient         zrpc.RpcClientConf
 	StatusClient      zrpc.RpcClientConf
+	PushClient        zrpc.RpcClientConf
+	PollClient        zrpc.RpcClientConf
+	SecretChatClient  zrpc.RpcClientConf
+	StickerClient     zrpc.RpcClientConf
 }
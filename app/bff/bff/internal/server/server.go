@@ -19,12 +19,17 @@
 package server
 
 import (
+	"context"
 	"flag"
+
+	"github.com/teamgram/marmota/pkg/net/rpcx"
 	"github.com/teamgram/proto/mtproto"
 	account_helper "github.com/teamgram/teamgram-server/app/bff/account"
 	authorization_helper "github.com/teamgram/teamgram-server/app/bff/authorization"
 	autodownload_helper "github.com/teamgram/teamgram-server/app/bff/autodownload"
 	"github.com/teamgram/teamgram-server/app/bff/bff/internal/config"
+	bots_helper "github.com/teamgram/teamgram-server/app/bff/bots"
+	channels_helper "github.com/teamgram/teamgram-server/app/bff/channels"
 	chatinvites_helper "github.com/teamgram/teamgram-server/app/bff/chatinvites"
 	chats_helper "github.com/teamgram/teamgram-server/app/bff/chats"
 	configuration_helper "github.com/teamgram/teamgram-server/app/bff/configuration"
@@ -32,17 +37,28 @@ import (
 	dialogs_helper "github.com/teamgram/teamgram-server/app/bff/dialogs"
 	drafts_helper "github.com/teamgram/teamgram-server/app/bff/drafts"
 	files_helper "github.com/teamgram/teamgram-server/app/bff/files"
+	groupcalls_helper "github.com/teamgram/teamgram-server/app/bff/groupcalls"
 	messages_helper "github.com/teamgram/teamgram-server/app/bff/messages"
 	miscellaneous_helper "github.com/teamgram/teamgram-server/app/bff/miscellaneous"
 	notification_helper "github.com/teamgram/teamgram-server/app/bff/notification"
 	nsfw_helper "github.com/teamgram/teamgram-server/app/bff/nsfw"
 	photos_helper "github.com/teamgram/teamgram-server/app/bff/photos"
 	qrcode_helper "github.com/teamgram/teamgram-server/app/bff/qrcode"
+	scheduledmessages_helper "github.com/teamgram/teamgram-server/app/bff/scheduledmessages"
+	secretchats_helper "github.com/teamgram/teamgram-server/app/bff/secretchats"
 	sponsoredmessages_helper "github.com/teamgram/teamgram-server/app/bff/sponsoredmessages"
+	stickers_helper "github.com/teamgram/teamgram-server/app/bff/stickers"
 	tos_helper "github.com/teamgram/teamgram-server/app/bff/tos"
+	twofa_helper "github.com/teamgram/teamgram-server/app/bff/twofa"
 	updates_helper "github.com/teamgram/teamgram-server/app/bff/updates"
 	usernames_helper "github.com/teamgram/teamgram-server/app/bff/usernames"
 	users_helper "github.com/teamgram/teamgram-server/app/bff/users"
+	voipcalls_helper "github.com/teamgram/teamgram-server/app/bff/voipcalls"
+	channel_client "github.com/teamgram/teamgram-server/app/service/biz/channel/client"
+	user_client "github.com/teamgram/teamgram-server/app/service/biz/user/client"
+	sticker_client "github.com/teamgram/teamgram-server/app/service/sticker/client"
+	"github.com/teamgram/teamgram-server/pkg/groupcall"
+	"github.com/teamgram/teamgram-server/pkg/sfu"
 
 	"github.com/zeromicro/go-zero/core/conf"
 	"github.com/zeromicro/go-zero/core/logx"
@@ -50,6 +66,17 @@ import (
 	"google.golang.org/grpc"
 )
 
+// filesPlugin the thumbs of the sticker sets are resolved by the sticker service,
+// the streams of the group calls by the sfu.
+type filesPlugin struct {
+	*sticker_client.StickerPlugin
+	stream *groupcall.StreamPlugin
+}
+
+func (m *filesPlugin) GetGroupCallStreamFile(ctx context.Context, userId int64, file *mtproto.InputFileLocation) (*mtproto.Upload_File, error) {
+	return m.stream.GetGroupCallStreamFile(ctx, userId, file)
+}
+
 var configFile = flag.String("f", "etc/bff.yaml", "the config file")
 
 type Server struct {
@@ -68,6 +95,9 @@ func (s *Server) Initialize() error {
 	// ctx := svc.NewServiceContext(c)
 	// s.grpcSrv = grpc.New(ctx, c.RpcServerConf)
 
+	channelPlugin := channel_client.NewChannelPlugin(rpcx.GetCachedRpcClient(c.BizServiceClient))
+	userPlugin := user_client.NewUserPlugin(rpcx.GetCachedRpcClient(c.BizServiceClient))
+
 	s.grpcSrv = zrpc.MustNewServer(c.RpcServerConf, func(grpcServer *grpc.Server) {
 		// tos_helper
 		mtproto.RegisterRPCTosServer(
@@ -114,7 +144,54 @@ func (s *Server) Initialize() error {
 				StatusClient:      c.StatusClient,
 				SyncClient:        c.SyncClient,
 				MsgClient:         c.MsgClient,
-			}, nil))
+			}, userPlugin))
+
+		// twofa_helper
+		mtproto.RegisterRPCTwoFaServer(
+			grpcServer,
+			twofa_helper.New(twofa_helper.Config{
+				RpcServerConf: c.RpcServerConf,
+				KV:            c.KV,
+				UserClient:    c.BizServiceClient,
+			}))
+
+		// secretchats_helper
+		mtproto.RegisterRPCSecretChatsServer(
+			grpcServer,
+			secretchats_helper.New(secretchats_helper.Config{
+				RpcServerConf:     c.RpcServerConf,
+				UserClient:        c.BizServiceClient,
+				AuthSessionClient: c.AuthSessionClient,
+				MediaClient:       c.MediaClient,
+				SecretChatClient:  c.SecretChatClient,
+				SyncClient:        c.SyncClient,
+			}))
+
+		// voipcalls_helper
+		mtproto.RegisterRPCVoipCallsServer(
+			grpcServer,
+			voipcalls_helper.New(voipcalls_helper.Config{
+				RpcServerConf:     c.RpcServerConf,
+				KV:                c.KV,
+				PhoneCall:         c.PhoneCall,
+				UserClient:        c.BizServiceClient,
+				AuthSessionClient: c.AuthSessionClient,
+				MsgClient:         c.MsgClient,
+				SyncClient:        c.SyncClient,
+			}))
+
+		// groupcalls_helper
+		mtproto.RegisterRPCGroupCallsServer(
+			grpcServer,
+			groupcalls_helper.New(groupcalls_helper.Config{
+				RpcServerConf: c.RpcServerConf,
+				KV:            c.KV,
+				Sfu:           c.Sfu,
+				UserClient:    c.BizServiceClient,
+				ChatClient:    c.BizServiceClient,
+				MsgClient:     c.MsgClient,
+				SyncClient:    c.SyncClient,
+			}))
 
 		// chatinvites_helper
 		mtproto.RegisterRPCChatInvitesServer(
@@ -126,11 +203,38 @@ func (s *Server) Initialize() error {
 				MsgClient:     c.MsgClient,
 			}))
 
+		// channels_helper
+		mtproto.RegisterRPCChannelsServer(
+			grpcServer,
+			channels_helper.New(channels_helper.Config{
+				RpcServerConf: c.RpcServerConf,
+				FileReference: c.FileReference,
+				UserClient:    c.BizServiceClient,
+				ChannelClient: c.BizServiceClient,
+				MsgClient:     c.MsgClient,
+				DialogClient:  c.BizServiceClient,
+				SyncClient:    c.SyncClient,
+				MediaClient:   c.MediaClient,
+			}))
+
+		// scheduledmessages_helper
+		mtproto.RegisterRPCScheduledMessagesServer(
+			grpcServer,
+			scheduledmessages_helper.New(scheduledmessages_helper.Config{
+				RpcServerConf: c.RpcServerConf,
+				FileReference: c.FileReference,
+				UserClient:    c.BizServiceClient,
+				ChatClient:    c.BizServiceClient,
+				ChannelClient: c.BizServiceClient,
+				MsgClient:     c.MsgClient,
+			}))
+
 		// chats_helper
 		mtproto.RegisterRPCChatsServer(
 			grpcServer,
 			chats_helper.New(chats_helper.Config{
 				RpcServerConf:     c.RpcServerConf,
+				FileReference:     c.FileReference,
 				UserClient:        c.BizServiceClient,
 				ChatClient:        c.BizServiceClient,
 				MsgClient:         c.MsgClient,
@@ -147,20 +251,36 @@ func (s *Server) Initialize() error {
 			grpcServer,
 			files_helper.New(files_helper.Config{
 				RpcServerConf: c.RpcServerConf,
+				FileReference: c.FileReference,
 				DfsClient:     c.DfsClient,
 				UserClient:    c.BizServiceClient,
 				MediaClient:   c.MediaClient,
-			}, nil))
+			}, &filesPlugin{
+				StickerPlugin: sticker_client.NewStickerPlugin(rpcx.GetCachedRpcClient(c.StickerClient)),
+				stream:        groupcall.NewStreamPlugin(c.KV, sfu.New(&c.Sfu)),
+			}))
+
+		// stickers_helper
+		mtproto.RegisterRPCStickersServer(
+			grpcServer,
+			stickers_helper.New(stickers_helper.Config{
+				RpcServerConf: c.RpcServerConf,
+				FileReference: c.FileReference,
+				MediaClient:   c.MediaClient,
+				StickerClient: c.StickerClient,
+			}))
 
 		// updates_helper
 		mtproto.RegisterRPCUpdatesServer(
 			grpcServer,
 			updates_helper.New(updates_helper.Config{
 				RpcServerConf:     c.RpcServerConf,
+				FileReference:     c.FileReference,
 				UpdatesClient:     c.BizServiceClient,
 				UserClient:        c.BizServiceClient,
 				ChatClient:        c.BizServiceClient,
 				AuthsessionClient: c.AuthSessionClient,
+				ChannelClient:     c.BizServiceClient,
 			}))
 
 		// contacts_helper
@@ -174,20 +294,22 @@ func (s *Server) Initialize() error {
 					UsernameClient: c.BizServiceClient,
 					SyncClient:     c.SyncClient,
 				},
-				nil))
+				channelPlugin))
 
 		// dialogs_helper
-		mtproto.RegisterRPCDialogsServer(
-			grpcServer,
-			dialogs_helper.New(dialogs_helper.Config{
-				RpcServerConf: c.RpcServerConf,
-				UpdatesClient: c.BizServiceClient,
-				UserClient:    c.BizServiceClient,
-				ChatClient:    c.BizServiceClient,
-				DialogClient:  c.BizServiceClient,
-				SyncClient:    c.SyncClient,
-				MessageClient: c.BizServiceClient,
-			}, nil))
+		dialogsService := dialogs_helper.New(dialogs_helper.Config{
+			RpcServerConf: c.RpcServerConf,
+			FileReference: c.FileReference,
+			UpdatesClient: c.BizServiceClient,
+			UserClient:    c.BizServiceClient,
+			ChatClient:    c.BizServiceClient,
+			DialogClient:  c.BizServiceClient,
+			SyncClient:    c.SyncClient,
+			MessageClient: c.BizServiceClient,
+			IdgenClient:   c.IdgenClient,
+		}, channelPlugin)
+		mtproto.RegisterRPCDialogsServer(grpcServer, dialogsService)
+		mtproto.RegisterRPCFoldersServer(grpcServer, dialogsService)
 
 		// drafts_helper
 		mtproto.RegisterRPCDraftsServer(
@@ -198,7 +320,7 @@ func (s *Server) Initialize() error {
 				UserClient:    c.BizServiceClient,
 				SyncClient:    c.SyncClient,
 				ChatClient:    c.BizServiceClient,
-			}, nil))
+			}, channelPlugin))
 
 		// autodownload_helper
 		mtproto.RegisterRPCAutoDownloadServer(
@@ -208,20 +330,39 @@ func (s *Server) Initialize() error {
 			}))
 
 		// messages_helper
-		mtproto.RegisterRPCMessagesServer(
-			grpcServer,
-			messages_helper.New(messages_helper.Config{
-				RpcServerConf:  c.RpcServerConf,
-				UserClient:     c.BizServiceClient,
-				ChatClient:     c.BizServiceClient,
-				MsgClient:      c.MsgClient,
-				DialogClient:   c.BizServiceClient,
-				IdgenClient:    c.IdgenClient,
-				MessageClient:  c.BizServiceClient,
-				MediaClient:    c.MediaClient,
-				UsernameClient: c.BizServiceClient,
-				SyncClient:     c.SyncClient,
-			}, nil))
+		messagesService := messages_helper.New(messages_helper.Config{
+			RpcServerConf:  c.RpcServerConf,
+			FileReference:  c.FileReference,
+			UserClient:     c.BizServiceClient,
+			ChatClient:     c.BizServiceClient,
+			MsgClient:      c.MsgClient,
+			DialogClient:   c.BizServiceClient,
+			IdgenClient:    c.IdgenClient,
+			MessageClient:  c.BizServiceClient,
+			MediaClient:    c.MediaClient,
+			UsernameClient: c.BizServiceClient,
+			SyncClient:     c.SyncClient,
+			ChannelClient:  c.BizServiceClient,
+			PollClient:     c.PollClient,
+		}, nil)
+		mtproto.RegisterRPCMessagesServer(grpcServer, messagesService)
+		mtproto.RegisterRPCPollsServer(grpcServer, messagesService)
+		mtproto.RegisterRPCReactionsServer(grpcServer, messagesService)
+
+		// bots_helper
+		botsService := bots_helper.New(bots_helper.Config{
+			RpcServerConf: c.RpcServerConf,
+			FileReference: c.FileReference,
+			KV:            c.KV,
+			UserClient:    c.BizServiceClient,
+			MessageClient: c.BizServiceClient,
+			ChannelClient: c.BizServiceClient,
+			MsgClient:     c.MsgClient,
+			MediaClient:   c.MediaClient,
+			SyncClient:    c.SyncClient,
+		})
+		mtproto.RegisterRPCBotsServer(grpcServer, botsService)
+		mtproto.RegisterRPCInlineBotServer(grpcServer, botsService)
 
 		// notification_helper
 		mtproto.RegisterRPCNotificationServer(
@@ -231,13 +372,15 @@ func (s *Server) Initialize() error {
 				UserClient:    c.BizServiceClient,
 				ChatClient:    c.BizServiceClient,
 				SyncClient:    c.SyncClient,
-			}, nil))
+				PushClient:    c.PushClient,
+			}, channelPlugin))
 
 		// users_helper
 		mtproto.RegisterRPCUsersServer(
 			grpcServer,
 			users_helper.New(users_helper.Config{
 				RpcServerConf: c.RpcServerConf,
+				FileReference: c.FileReference,
 				UserClient:    c.BizServiceClient,
 				ChatClient:    c.BizServiceClient,
 			}))
@@ -273,6 +416,7 @@ func (s *Server) Initialize() error {
 			grpcServer,
 			photos_helper.New(photos_helper.Config{
 				RpcServerConf: c.RpcServerConf,
+				FileReference: c.FileReference,
 				MediaClient:   c.MediaClient,
 				UserClient:    c.BizServiceClient,
 				SyncClient:    c.SyncClient,
@@ -287,7 +431,7 @@ func (s *Server) Initialize() error {
 				UsernameClient: c.BizServiceClient,
 				ChatClient:     c.BizServiceClient,
 				SyncClient:     c.SyncClient,
-			}, nil))
+			}, channelPlugin))
 	})
 
 	// logx.Must(err)
@@ -0,0 +1,50 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	userpb "github.com/teamgram/teamgram-server/app/service/biz/user/user"
+)
+
+// BotsSetBotCommands
+// bots.setBotCommands#517165a scope:BotCommandScope lang_code:string commands:Vector<BotCommand> = Bool;
+func (c *BotsCore) BotsSetBotCommands(in *mtproto.TLBotsSetBotCommands) (*mtproto.Bool, error) {
+	if err := c.checkSelfIsBot(); err != nil {
+		c.Logger.Errorf("bots.setBotCommands - error: %v", err)
+		return nil, err
+	}
+
+	if err := checkBotCommandScope(in.Scope, in.LangCode); err != nil {
+		c.Logger.Errorf("bots.setBotCommands - error: %v", err)
+		return nil, err
+	}
+
+	rV, err := c.svcCtx.Dao.UserClient.UserSetBotCommands(c.ctx, &userpb.TLUserSetBotCommands{
+		UserId:   c.MD.UserId,
+		BotId:    c.MD.UserId,
+		Commands: in.Commands,
+	})
+	if err != nil {
+		c.Logger.Errorf("bots.setBotCommands - error: %v", err)
+		return nil, err
+	}
+
+	return rV, nil
+}
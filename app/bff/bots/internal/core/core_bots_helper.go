@@ -0,0 +1,174 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"bytes"
+	"fmt"
+	"hash/fnv"
+
+	"github.com/teamgram/proto/mtproto"
+	channelpb "github.com/teamgram/teamgram-server/app/service/biz/channel/channel"
+	messagepb "github.com/teamgram/teamgram-server/app/service/biz/message/message"
+	userpb "github.com/teamgram/teamgram-server/app/service/biz/user/user"
+
+	"google.golang.org/grpc/status"
+)
+
+// checkSelfIsBot bots.* methods can only be used by bots
+func (c *BotsCore) checkSelfIsBot() error {
+	isBot, err := c.svcCtx.Dao.UserClient.UserIsBot(c.ctx, &userpb.TLUserIsBot{
+		Id: c.MD.UserId,
+	})
+	if err != nil {
+		return err
+	} else if !mtproto.FromBool(isBot) {
+		return mtproto.ErrUserBotRequired
+	}
+
+	return nil
+}
+
+var (
+	// errBotCommandScopeNotSupported the commands are only kept per bot, the same as LANG_CODE_NOT_SUPPORTED
+	errBotCommandScopeNotSupported = status.Error(mtproto.ErrBadRequest, "BOT_COMMAND_SCOPE_NOT_SUPPORTED")
+)
+
+// checkBotCommandScope commands are only kept per bot, so only the default scope is supported
+func checkBotCommandScope(scope *mtproto.BotCommandScope, langCode string) error {
+	if langCode != "" {
+		return mtproto.ErrLangCodeNotSupported
+	}
+
+	switch scope.GetPredicateName() {
+	case "", mtproto.Predicate_botCommandScopeDefault:
+		return nil
+	default:
+		return errBotCommandScopeNotSupported
+	}
+}
+
+// callbackMessage is the message with the inline keyboard, as seen by the user and by the bot.
+type callbackMessage struct {
+	botId    int64
+	botPeer  *mtproto.Peer
+	botMsgId int32
+	message  *mtproto.Message
+}
+
+// getCallbackMessage finds msgId in peer and where the bot who sent it keeps the message.
+func (c *BotsCore) getCallbackMessage(peer *mtproto.PeerUtil, msgId int32) (*callbackMessage, error) {
+	var (
+		box *mtproto.MessageBox
+		cbm = new(callbackMessage)
+		err error
+	)
+
+	switch peer.PeerType {
+	case mtproto.PEER_USER, mtproto.PEER_CHAT:
+		box, err = c.svcCtx.Dao.MessageClient.MessageGetUserMessage(c.ctx, &messagepb.TLMessageGetUserMessage{
+			UserId: c.MD.UserId,
+			Id:     msgId,
+		})
+		if err != nil {
+			return nil, mtproto.ErrMessageIdInvalid
+		} else if box.GetPeerType() != peer.PeerType || box.GetPeerId() != peer.PeerId {
+			return nil, mtproto.ErrMessageIdInvalid
+		}
+		cbm.botId = box.SenderUserId
+		cbm.message = box.Message
+
+		if peer.IsUser() {
+			botBox, err := c.svcCtx.Dao.MessageClient.MessageGetPeerUserMessage(c.ctx, &messagepb.TLMessageGetPeerUserMessage{
+				UserId:     c.MD.UserId,
+				PeerUserId: cbm.botId,
+				MsgId:      msgId,
+			})
+			if err != nil {
+				return nil, mtproto.ErrMessageIdInvalid
+			}
+			cbm.botPeer = mtproto.MakePeerUser(c.MD.UserId)
+			cbm.botMsgId = botBox.MessageId
+		} else {
+			idList, err := c.svcCtx.Dao.MessageClient.MessageGetPeerChatMessageIdList(c.ctx, &messagepb.TLMessageGetPeerChatMessageIdList{
+				UserId:     c.MD.UserId,
+				PeerChatId: peer.PeerId,
+				MsgId:      msgId,
+			})
+			if err != nil {
+				return nil, mtproto.ErrMessageIdInvalid
+			}
+			for _, id := range idList.GetDatas() {
+				if id.UserId == cbm.botId {
+					cbm.botMsgId = id.MsgId
+					break
+				}
+			}
+			cbm.botPeer = mtproto.MakePeerChat(peer.PeerId)
+		}
+	case mtproto.PEER_CHANNEL:
+		boxList, err := c.svcCtx.Dao.ChannelClient.ChannelGetMessages(c.ctx, &channelpb.TLChannelGetMessages{
+			UserId:    c.MD.UserId,
+			ChannelId: peer.PeerId,
+			Id:        []int32{msgId},
+		})
+		if err != nil || len(boxList.GetDatas()) == 0 {
+			return nil, mtproto.ErrMessageIdInvalid
+		}
+		box = boxList.GetDatas()[0]
+		cbm.botId = box.SenderUserId
+		cbm.botPeer = mtproto.MakePeerChannel(peer.PeerId)
+		cbm.botMsgId = box.MessageId
+		cbm.message = box.Message
+	default:
+		return nil, mtproto.ErrPeerIdInvalid
+	}
+
+	if cbm.botMsgId == 0 || cbm.message == nil {
+		return nil, mtproto.ErrMessageIdInvalid
+	}
+
+	return cbm, nil
+}
+
+// hasCallbackButton checks the message has an inline keyboardButtonCallback with data
+func hasCallbackButton(message *mtproto.Message, data []byte) bool {
+	replyMarkup := message.GetReplyMarkup()
+	if replyMarkup.GetPredicateName() != mtproto.Predicate_replyInlineMarkup {
+		return false
+	}
+
+	for _, row := range replyMarkup.GetRows() {
+		for _, button := range row.GetButtons() {
+			if button.GetPredicateName() == mtproto.Predicate_keyboardButtonCallback &&
+				bytes.Equal(button.GetData(), data) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// makeChatInstance chat_instance is the same for all callback queries of a bot in a chat
+func makeChatInstance(botId int64, botPeer *mtproto.Peer) int64 {
+	h := fnv.New64a()
+	fmt.Fprintf(h, "%d:%s:%d:%d:%d", botId, botPeer.GetPredicateName(), botPeer.GetUserId(), botPeer.GetChatId(), botPeer.GetChannelId())
+	return int64(h.Sum64())
+}
@@ -0,0 +1,254 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"strings"
+
+	"github.com/teamgram/proto/mtproto"
+	channelpb "github.com/teamgram/teamgram-server/app/service/biz/channel/channel"
+	mediapb "github.com/teamgram/teamgram-server/app/service/media/media"
+
+	"google.golang.org/grpc/status"
+)
+
+var (
+	errResultIdInvalid = status.Error(mtproto.ErrBadRequest, "RESULT_ID_INVALID")
+)
+
+// makeInlineQueryPeerType is the type of the chat where the inline query was sent
+func (c *BotsCore) makeInlineQueryPeerType(botId int64, peer *mtproto.PeerUtil) *mtproto.InlineQueryPeerType {
+	switch peer.PeerType {
+	case mtproto.PEER_SELF:
+		return mtproto.MakeTLInlineQueryPeerTypePM(nil).To_InlineQueryPeerType()
+	case mtproto.PEER_USER:
+		if peer.PeerId == botId {
+			return mtproto.MakeTLInlineQueryPeerTypeSameBotPM(nil).To_InlineQueryPeerType()
+		}
+		return mtproto.MakeTLInlineQueryPeerTypePM(nil).To_InlineQueryPeerType()
+	case mtproto.PEER_CHAT:
+		return mtproto.MakeTLInlineQueryPeerTypeChat(nil).To_InlineQueryPeerType()
+	case mtproto.PEER_CHANNEL:
+		channel, err := c.svcCtx.Dao.ChannelClient.ChannelGetMutableChannel(c.ctx, &channelpb.TLChannelGetMutableChannel{
+			ChannelId: peer.PeerId,
+		})
+		if err != nil {
+			return nil
+		} else if channel.GetChannel().GetBroadcast() {
+			return mtproto.MakeTLInlineQueryPeerTypeBroadcast(nil).To_InlineQueryPeerType()
+		}
+		return mtproto.MakeTLInlineQueryPeerTypeMegagroup(nil).To_InlineQueryPeerType()
+	default:
+		return nil
+	}
+}
+
+// makeWebDocument the web documents are not proxied, the clients download them from the url
+func makeWebDocument(in *mtproto.InputWebDocument) (*mtproto.WebDocument, error) {
+	if in == nil {
+		return nil, nil
+	}
+	if !strings.HasPrefix(in.Url, "http://") && !strings.HasPrefix(in.Url, "https://") {
+		return nil, mtproto.ErrWebdocumentUrlInvalid
+	}
+
+	return mtproto.MakeTLWebDocumentNoProxy(&mtproto.WebDocument{
+		Url:        in.Url,
+		Size2:      in.Size2,
+		MimeType:   in.MimeType,
+		Attributes: in.Attributes,
+	}).To_WebDocument(), nil
+}
+
+// makeBotInlineResult converts a result of messages.setInlineBotResults, the photos and documents must
+// have been uploaded before, games are not supported.
+func (c *BotsCore) makeBotInlineResult(in *mtproto.InputBotInlineResult) (*mtproto.BotInlineResult, error) {
+	sendMessage, err := makeBotInlineMessage(in.SendMessage)
+	if err != nil {
+		return nil, err
+	}
+
+	switch in.PredicateName {
+	case mtproto.Predicate_inputBotInlineResult:
+		// inputBotInlineResult#88bf9319 flags:# id:string type:string title:flags.1?string description:flags.2?string url:flags.3?string thumb:flags.4?InputWebDocument content:flags.5?InputWebDocument send_message:InputBotInlineMessage = InputBotInlineResult;
+		thumb, err := makeWebDocument(in.Thumb)
+		if err != nil {
+			return nil, err
+		}
+		content, err := makeWebDocument(in.Content)
+		if err != nil {
+			return nil, err
+		}
+
+		return mtproto.MakeTLBotInlineResult(&mtproto.BotInlineResult{
+			Id:          in.Id,
+			Type:        in.Type,
+			Title:       in.Title,
+			Description: in.Description,
+			Url:         in.Url,
+			Thumb:       thumb,
+			Content:     content,
+			SendMessage: sendMessage,
+		}).To_BotInlineResult(), nil
+	case mtproto.Predicate_inputBotInlineResultPhoto:
+		// inputBotInlineResultPhoto#a8d864a7 id:string type:string photo:InputPhoto send_message:InputBotInlineMessage = InputBotInlineResult;
+		photo, err := c.svcCtx.Dao.MediaClient.MediaGetPhoto(c.ctx, &mediapb.TLMediaGetPhoto{
+			PhotoId: in.Photo.GetId(),
+		})
+		if err != nil || photo.GetPredicateName() != mtproto.Predicate_photo || photo.GetAccessHash() != in.Photo.GetAccessHash() {
+			return nil, mtproto.ErrPhotoInvalid
+		}
+
+		return mtproto.MakeTLBotInlineMediaResult(&mtproto.BotInlineResult{
+			Id:          in.Id,
+			Type:        in.Type,
+			Photo:       photo,
+			SendMessage: sendMessage,
+		}).To_BotInlineResult(), nil
+	case mtproto.Predicate_inputBotInlineResultDocument:
+		// inputBotInlineResultDocument#fff8fdc4 flags:# id:string type:string title:flags.1?string description:flags.2?string document:InputDocument send_message:InputBotInlineMessage = InputBotInlineResult;
+		document, err := c.svcCtx.Dao.MediaClient.MediaGetDocument(c.ctx, &mediapb.TLMediaGetDocument{
+			Id: in.Document.GetId(),
+		})
+		if err != nil || document.GetPredicateName() != mtproto.Predicate_document || document.GetAccessHash() != in.Document.GetAccessHash() {
+			return nil, mtproto.ErrDocumentInvalid
+		}
+
+		return mtproto.MakeTLBotInlineMediaResult(&mtproto.BotInlineResult{
+			Id:          in.Id,
+			Type:        in.Type,
+			Title:       in.Title,
+			Description: in.Description,
+			Document:    document,
+			SendMessage: sendMessage,
+		}).To_BotInlineResult(), nil
+	default:
+		// TODO: inputBotInlineResultGame
+		return nil, mtproto.ErrResultTypeInvalid
+	}
+}
+
+// makeBotInlineMessage only the inline keyboards can be attached to the inline messages
+func makeBotInlineMessage(in *mtproto.InputBotInlineMessage) (*mtproto.BotInlineMessage, error) {
+	replyMarkup := in.GetReplyMarkup()
+	if replyMarkup != nil && replyMarkup.GetPredicateName() != mtproto.Predicate_replyInlineMarkup {
+		return nil, mtproto.ErrReplyMarkupInvalid
+	}
+
+	switch in.GetPredicateName() {
+	case mtproto.Predicate_inputBotInlineMessageMediaAuto:
+		return mtproto.MakeTLBotInlineMessageMediaAuto(&mtproto.BotInlineMessage{
+			Message:     in.Message,
+			Entities:    in.Entities,
+			ReplyMarkup: replyMarkup,
+		}).To_BotInlineMessage(), nil
+	case mtproto.Predicate_inputBotInlineMessageText:
+		if in.Message == "" {
+			return nil, mtproto.ErrMessageEmpty
+		}
+		return mtproto.MakeTLBotInlineMessageText(&mtproto.BotInlineMessage{
+			NoWebpage:   in.NoWebpage,
+			Message:     in.Message,
+			Entities:    in.Entities,
+			ReplyMarkup: replyMarkup,
+		}).To_BotInlineMessage(), nil
+	case mtproto.Predicate_inputBotInlineMessageMediaGeo:
+		return mtproto.MakeTLBotInlineMessageMediaGeo(&mtproto.BotInlineMessage{
+			Geo:                         mtproto.MakeGeoPointByInput(in.GeoPoint),
+			Heading:                     in.Heading,
+			Period:                      in.Period,
+			ProximityNotificationRadius: in.ProximityNotificationRadius,
+			ReplyMarkup:                 replyMarkup,
+		}).To_BotInlineMessage(), nil
+	case mtproto.Predicate_inputBotInlineMessageMediaVenue:
+		return mtproto.MakeTLBotInlineMessageMediaVenue(&mtproto.BotInlineMessage{
+			Geo:         mtproto.MakeGeoPointByInput(in.GeoPoint),
+			Title:       in.Title,
+			Address:     in.Address,
+			Provider:    in.Provider,
+			VenueId:     in.VenueId,
+			VenueType:   in.VenueType,
+			ReplyMarkup: replyMarkup,
+		}).To_BotInlineMessage(), nil
+	case mtproto.Predicate_inputBotInlineMessageMediaContact:
+		return mtproto.MakeTLBotInlineMessageMediaContact(&mtproto.BotInlineMessage{
+			PhoneNumber: in.PhoneNumber,
+			FirstName:   in.FirstName,
+			LastName:    in.LastName,
+			Vcard:       in.Vcard,
+			ReplyMarkup: replyMarkup,
+		}).To_BotInlineMessage(), nil
+	default:
+		// TODO: inputBotInlineMessageGame, inputBotInlineMessageMediaInvoice
+		return nil, mtproto.ErrSendMessageTypeInvalid
+	}
+}
+
+// makeMessageMediaByInlineResult the media of the message sent by messages.sendInlineBotResult,
+// the web documents are not downloaded by the server, so they are sent as messageMediaUnsupported.
+func makeMessageMediaByInlineResult(result *mtproto.BotInlineResult) *mtproto.MessageMedia {
+	sendMessage := result.GetSendMessage()
+
+	switch sendMessage.GetPredicateName() {
+	case mtproto.Predicate_botInlineMessageMediaAuto:
+		switch {
+		case result.GetPhoto() != nil:
+			return mtproto.MakeTLMessageMediaPhoto(&mtproto.MessageMedia{
+				Photo_FLAGPHOTO: result.GetPhoto(),
+			}).To_MessageMedia()
+		case result.GetDocument() != nil:
+			return mtproto.MakeTLMessageMediaDocument(&mtproto.MessageMedia{
+				Document: result.GetDocument(),
+			}).To_MessageMedia()
+		default:
+			return mtproto.MakeTLMessageMediaUnsupported(nil).To_MessageMedia()
+		}
+	case mtproto.Predicate_botInlineMessageMediaGeo:
+		if sendMessage.GetPeriod() != nil {
+			return mtproto.MakeTLMessageMediaGeoLive(&mtproto.MessageMedia{
+				Geo:                         sendMessage.GetGeo(),
+				Heading:                     sendMessage.GetHeading(),
+				Period:                      sendMessage.GetPeriod().GetValue(),
+				ProximityNotificationRadius: sendMessage.GetProximityNotificationRadius(),
+			}).To_MessageMedia()
+		}
+		return mtproto.MakeTLMessageMediaGeo(&mtproto.MessageMedia{
+			Geo: sendMessage.GetGeo(),
+		}).To_MessageMedia()
+	case mtproto.Predicate_botInlineMessageMediaVenue:
+		return mtproto.MakeTLMessageMediaVenue(&mtproto.MessageMedia{
+			Geo:       sendMessage.GetGeo(),
+			Title:     sendMessage.GetTitle(),
+			Address:   sendMessage.GetAddress(),
+			Provider:  sendMessage.GetProvider(),
+			VenueId:   sendMessage.GetVenueId(),
+			VenueType: sendMessage.GetVenueType(),
+		}).To_MessageMedia()
+	case mtproto.Predicate_botInlineMessageMediaContact:
+		return mtproto.MakeTLMessageMediaContact(&mtproto.MessageMedia{
+			PhoneNumber: sendMessage.GetPhoneNumber(),
+			FirstName:   sendMessage.GetFirstName(),
+			LastName:    sendMessage.GetLastName(),
+			Vcard:       sendMessage.GetVcard(),
+		}).To_MessageMedia()
+	default:
+		// botInlineMessageText
+		return nil
+	}
+}
@@ -0,0 +1,136 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"math/rand"
+	"time"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/bff/bots/internal/model"
+	"github.com/teamgram/teamgram-server/app/messenger/sync/sync"
+	userpb "github.com/teamgram/teamgram-server/app/service/biz/user/user"
+)
+
+// MessagesGetBotCallbackAnswer
+// messages.getBotCallbackAnswer#9342ca07 flags:# game:flags.1?true peer:InputPeer msg_id:int data:flags.0?bytes password:flags.2?InputCheckPasswordSRP = messages.BotCallbackAnswer;
+func (c *BotsCore) MessagesGetBotCallbackAnswer(in *mtproto.TLMessagesGetBotCallbackAnswer) (*mtproto.Messages_BotCallbackAnswer, error) {
+	if in.Game {
+		// the games can't be sent, messages.sendMedia makes inputMediaGame unsupported,
+		// so no message has a game to launch
+		err := mtproto.ErrDataInvalid
+		c.Logger.Errorf("messages.getBotCallbackAnswer - error: %v", err)
+		return nil, err
+	}
+
+	if len(in.Data) == 0 || len(in.Data) > model.MaxCallbackDataLength {
+		err := mtproto.ErrDataInvalid
+		c.Logger.Errorf("messages.getBotCallbackAnswer - error: %v", err)
+		return nil, err
+	}
+
+	peer := mtproto.FromInputPeer2(c.MD.UserId, in.Peer)
+	cbm, err := c.getCallbackMessage(peer, in.MsgId)
+	if err != nil {
+		c.Logger.Errorf("messages.getBotCallbackAnswer - error: %v", err)
+		return nil, err
+	}
+
+	if !hasCallbackButton(cbm.message, in.Data) {
+		err = mtproto.ErrDataInvalid
+		c.Logger.Errorf("messages.getBotCallbackAnswer - error: %v", err)
+		return nil, err
+	}
+
+	users, err := c.svcCtx.Dao.UserClient.UserGetMutableUsers(c.ctx, &userpb.TLUserGetMutableUsers{
+		Id: []int64{c.MD.UserId, cbm.botId},
+	})
+	if err != nil {
+		c.Logger.Errorf("messages.getBotCallbackAnswer - error: %v", err)
+		return nil, err
+	}
+	bot, _ := users.GetImmutableUser(cbm.botId)
+	if bot == nil || !bot.IsBot() {
+		err = mtproto.ErrBotInvalid
+		c.Logger.Errorf("messages.getBotCallbackAnswer - error: %v", err)
+		return nil, err
+	}
+
+	query := &model.BotCallbackQuery{
+		QueryId: rand.Int63(),
+		BotId:   cbm.botId,
+		UserId:  c.MD.UserId,
+	}
+	if err = c.svcCtx.Dao.PutCacheBotCallbackQuery(c.ctx, query); err != nil {
+		c.Logger.Errorf("messages.getBotCallbackAnswer - error: %v", err)
+		return nil, err
+	}
+
+	c.svcCtx.Dao.SyncClient.SyncPushBotUpdates(c.ctx, &sync.TLSyncPushBotUpdates{
+		UserId: cbm.botId,
+		Updates: mtproto.MakeUpdatesByUpdatesUsers(
+			users.GetUserListByIdList(cbm.botId, c.MD.UserId),
+			mtproto.MakeTLUpdateBotCallbackQuery(&mtproto.Update{
+				QueryId:        query.QueryId,
+				UserId:         c.MD.UserId,
+				Peer_PEER:      cbm.botPeer,
+				MsgId_INT32:    cbm.botMsgId,
+				ChatInstance:   makeChatInstance(cbm.botId, cbm.botPeer),
+				Data_FLAGBYTES: in.Data,
+			}).To_Update()),
+	})
+
+	// wait for messages.setBotCallbackAnswer
+	ticker := time.NewTicker(200 * time.Millisecond)
+	defer ticker.Stop()
+	timeout := time.After(model.BotCallbackQueryTimeout * time.Second)
+
+	for {
+		select {
+		case <-c.ctx.Done():
+			return nil, mtproto.ErrBotResponseTimeout
+		case <-timeout:
+			err = mtproto.ErrBotResponseTimeout
+			c.Logger.Errorf("messages.getBotCallbackAnswer - error: %v", err)
+			return nil, err
+		case <-ticker.C:
+			answer, _ := c.svcCtx.Dao.GetCacheBotCallbackAnswer(c.ctx, query.QueryId)
+			if answer == nil {
+				continue
+			}
+
+			rV := mtproto.MakeTLMessagesBotCallbackAnswer(&mtproto.Messages_BotCallbackAnswer{
+				Alert:     answer.Alert,
+				HasUrl:    answer.Url != "",
+				NativeUi:  false,
+				Message:   nil,
+				Url:       nil,
+				CacheTime: answer.CacheTime,
+			}).To_Messages_BotCallbackAnswer()
+			if answer.Message != "" {
+				rV.Message = mtproto.MakeFlagsString(answer.Message)
+			}
+			if answer.Url != "" {
+				rV.Url = mtproto.MakeFlagsString(answer.Url)
+			}
+
+			return rV, nil
+		}
+	}
+}
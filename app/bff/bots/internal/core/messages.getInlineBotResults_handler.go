@@ -0,0 +1,123 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"math/rand"
+	"time"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/bff/bots/internal/model"
+	"github.com/teamgram/teamgram-server/app/messenger/sync/sync"
+	userpb "github.com/teamgram/teamgram-server/app/service/biz/user/user"
+)
+
+// MessagesGetInlineBotResults
+// messages.getInlineBotResults#514e999d flags:# bot:InputUser peer:InputPeer geo_point:flags.0?InputGeoPoint query:string offset:string = messages.BotResults;
+func (c *BotsCore) MessagesGetInlineBotResults(in *mtproto.TLMessagesGetInlineBotResults) (*mtproto.Messages_BotResults, error) {
+	botId := mtproto.FromInputUser(c.MD.UserId, in.Bot).PeerId
+
+	users, err := c.svcCtx.Dao.UserClient.UserGetMutableUsers(c.ctx, &userpb.TLUserGetMutableUsers{
+		Id: []int64{c.MD.UserId, botId},
+	})
+	if err != nil {
+		c.Logger.Errorf("messages.getInlineBotResults - error: %v", err)
+		return nil, err
+	}
+	bot, _ := users.GetImmutableUser(botId)
+	if bot == nil || !bot.IsBot() {
+		err = mtproto.ErrBotInvalid
+		c.Logger.Errorf("messages.getInlineBotResults - error: %v", err)
+		return nil, err
+	} else if bot.BotInlinePlaceholder() == nil {
+		err = mtproto.ErrBotInlineDisabled
+		c.Logger.Errorf("messages.getInlineBotResults - error: %v", err)
+		return nil, err
+	}
+
+	// the results of the same query are reused for cache_time
+	results, err := c.svcCtx.Dao.GetCacheBotInlineResultsByQuery(c.ctx, botId, c.MD.UserId, in.Query, in.Offset)
+	if err != nil {
+		c.Logger.Errorf("messages.getInlineBotResults - error: %v", err)
+		return nil, err
+	} else if results != nil {
+		return c.makeBotResults(botId, results, users.GetUserListByIdList(c.MD.UserId, botId)), nil
+	}
+
+	query := &model.BotInlineQuery{
+		QueryId: rand.Int63(),
+		BotId:   botId,
+		UserId:  c.MD.UserId,
+		Query:   in.Query,
+		Offset:  in.Offset,
+	}
+	// the location is only sent to the bots which request it
+	if in.GeoPoint != nil && bot.BotInlineGeo() {
+		query.Geo = mtproto.MakeGeoPointByInput(in.GeoPoint)
+	}
+	if err = c.svcCtx.Dao.PutCacheBotInlineQuery(c.ctx, query); err != nil {
+		c.Logger.Errorf("messages.getInlineBotResults - error: %v", err)
+		return nil, err
+	}
+
+	c.svcCtx.Dao.SyncClient.SyncPushBotUpdates(c.ctx, &sync.TLSyncPushBotUpdates{
+		UserId: botId,
+		Updates: mtproto.MakeUpdatesByUpdatesUsers(
+			users.GetUserListByIdList(botId, c.MD.UserId),
+			mtproto.MakeTLUpdateBotInlineQuery(&mtproto.Update{
+				QueryId:  query.QueryId,
+				UserId:   c.MD.UserId,
+				Query:    query.Query,
+				Geo:      query.Geo,
+				PeerType: c.makeInlineQueryPeerType(botId, mtproto.FromInputPeer2(c.MD.UserId, in.Peer)),
+				Offset:   query.Offset,
+			}).To_Update()),
+	})
+
+	// wait for messages.setInlineBotResults
+	ticker := time.NewTicker(200 * time.Millisecond)
+	defer ticker.Stop()
+	timeout := time.After(model.BotInlineQueryTimeout * time.Second)
+
+	for {
+		select {
+		case <-c.ctx.Done():
+			return nil, mtproto.ErrBotResponseTimeout
+		case <-timeout:
+			err = mtproto.ErrBotResponseTimeout
+			c.Logger.Errorf("messages.getInlineBotResults - error: %v", err)
+			return nil, err
+		case <-ticker.C:
+			results, err = c.svcCtx.Dao.GetCacheBotInlineResults(c.ctx, query.QueryId)
+			if err != nil {
+				c.Logger.Errorf("messages.getInlineBotResults - error: %v", err)
+				return nil, err
+			} else if results != nil {
+				return c.makeBotResults(botId, results, users.GetUserListByIdList(c.MD.UserId, botId)), nil
+			}
+		}
+	}
+}
+
+func (c *BotsCore) makeBotResults(botId int64, results *model.BotInlineResults, users []*mtproto.User) *mtproto.Messages_BotResults {
+	botResults := results.Results
+	botResults.Users = users
+	c.svcCtx.Dao.FileReference.SetBotResults(c.MD.UserId, botId, botResults)
+	return botResults
+}
@@ -0,0 +1,133 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"time"
+
+	"github.com/teamgram/proto/mtproto"
+	msgpb "github.com/teamgram/teamgram-server/app/messenger/msg/msg/msg"
+	"github.com/teamgram/teamgram-server/app/messenger/sync/sync"
+	userpb "github.com/teamgram/teamgram-server/app/service/biz/user/user"
+)
+
+// MessagesSendInlineBotResult
+// messages.sendInlineBotResult#7aa11297 flags:# silent:flags.5?true background:flags.6?true clear_draft:flags.7?true hide_via:flags.11?true peer:InputPeer reply_to_msg_id:flags.0?int random_id:long query_id:long id:string schedule_date:flags.10?int send_as:flags.13?InputPeer = Updates;
+func (c *BotsCore) MessagesSendInlineBotResult(in *mtproto.TLMessagesSendInlineBotResult) (*mtproto.Updates, error) {
+	peer := mtproto.FromInputPeer2(c.MD.UserId, in.Peer)
+	switch peer.PeerType {
+	case mtproto.PEER_SELF:
+		peer.PeerType = mtproto.PEER_USER
+	case mtproto.PEER_USER, mtproto.PEER_CHAT, mtproto.PEER_CHANNEL:
+	default:
+		err := mtproto.ErrPeerIdInvalid
+		c.Logger.Errorf("messages.sendInlineBotResult - error: %v", err)
+		return nil, err
+	}
+
+	results, err := c.svcCtx.Dao.GetCacheBotInlineResults(c.ctx, in.QueryId)
+	if err != nil {
+		c.Logger.Errorf("messages.sendInlineBotResult - error: %v", err)
+		return nil, err
+	} else if results == nil || !results.CanBeSentBy(c.MD.UserId) {
+		err = mtproto.ErrQueryIdInvalid
+		c.Logger.Errorf("messages.sendInlineBotResult - error: %v", err)
+		return nil, err
+	}
+
+	var result *mtproto.BotInlineResult
+	for _, r := range results.Results.GetResults() {
+		if r.GetId() == in.Id {
+			result = r
+			break
+		}
+	}
+	if result == nil {
+		err = errResultIdInvalid
+		c.Logger.Errorf("messages.sendInlineBotResult - error: %v", err)
+		return nil, err
+	}
+
+	var (
+		botId       = results.Query.BotId
+		sendMessage = result.GetSendMessage()
+	)
+
+	outMessage := mtproto.MakeTLMessage(&mtproto.Message{
+		Out:         true,
+		Silent:      in.Silent,
+		Id:          0,
+		FromId:      mtproto.MakePeerUser(c.MD.UserId),
+		PeerId:      peer.ToPeer(),
+		Date:        int32(time.Now().Unix()),
+		Media:       makeMessageMediaByInlineResult(result),
+		Message:     sendMessage.GetMessage(),
+		ReplyMarkup: sendMessage.GetReplyMarkup(),
+		Entities:    sendMessage.GetEntities(),
+	}).To_Message()
+	if !in.HideVia {
+		outMessage.ViaBotId = mtproto.MakeFlagsInt64(botId)
+	}
+	if in.GetReplyToMsgId() != nil {
+		outMessage.ReplyTo = mtproto.MakeTLMessageReplyHeader(&mtproto.MessageReplyHeader{
+			ReplyToMsgId: in.GetReplyToMsgId().GetValue(),
+		}).To_MessageReplyHeader()
+	}
+
+	rUpdates, err := c.svcCtx.Dao.MsgClient.MsgSendMessage(c.ctx, &msgpb.TLMsgSendMessage{
+		UserId:    c.MD.UserId,
+		AuthKeyId: c.MD.AuthId,
+		PeerType:  peer.PeerType,
+		PeerId:    peer.PeerId,
+		Message: msgpb.MakeTLOutboxMessage(&msgpb.OutboxMessage{
+			NoWebpage:    sendMessage.GetNoWebpage(),
+			Background:   in.Background,
+			RandomId:     in.RandomId,
+			Message:      outMessage,
+			ScheduleDate: in.ScheduleDate,
+		}).To_OutboxMessage(),
+	})
+	if err != nil {
+		c.Logger.Errorf("messages.sendInlineBotResult - error: %v", err)
+		return nil, err
+	}
+	c.svcCtx.Dao.FileReference.SetUpdates(c.MD.UserId, rUpdates)
+
+	// the chosen result feedback
+	users, err := c.svcCtx.Dao.UserClient.UserGetMutableUsers(c.ctx, &userpb.TLUserGetMutableUsers{
+		Id: []int64{c.MD.UserId, botId},
+	})
+	if err != nil {
+		c.Logger.Errorf("messages.sendInlineBotResult - error: %v", err)
+	} else {
+		c.svcCtx.Dao.SyncClient.SyncPushBotUpdates(c.ctx, &sync.TLSyncPushBotUpdates{
+			UserId: botId,
+			Updates: mtproto.MakeUpdatesByUpdatesUsers(
+				users.GetUserListByIdList(botId, c.MD.UserId),
+				mtproto.MakeTLUpdateBotInlineSend(&mtproto.Update{
+					UserId:    c.MD.UserId,
+					Query:     results.Query.Query,
+					Geo:       results.Query.Geo,
+					Id_STRING: result.GetId(),
+				}).To_Update()),
+		})
+	}
+
+	return rUpdates, nil
+}
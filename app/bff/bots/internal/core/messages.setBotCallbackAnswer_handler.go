@@ -0,0 +1,51 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/bff/bots/internal/model"
+)
+
+// MessagesSetBotCallbackAnswer
+// messages.setBotCallbackAnswer#d58f130a flags:# alert:flags.1?true query_id:long message:flags.0?string url:flags.3?string cache_time:int = Bool;
+func (c *BotsCore) MessagesSetBotCallbackAnswer(in *mtproto.TLMessagesSetBotCallbackAnswer) (*mtproto.Bool, error) {
+	query, err := c.svcCtx.Dao.GetCacheBotCallbackQuery(c.ctx, in.QueryId)
+	if err != nil {
+		c.Logger.Errorf("messages.setBotCallbackAnswer - error: %v", err)
+		return nil, err
+	} else if query == nil || query.BotId != c.MD.UserId {
+		err = mtproto.ErrQueryIdInvalid
+		c.Logger.Errorf("messages.setBotCallbackAnswer - error: %v", err)
+		return nil, err
+	}
+
+	err = c.svcCtx.Dao.PutCacheBotCallbackAnswer(c.ctx, in.QueryId, &model.BotCallbackAnswer{
+		Alert:     in.Alert,
+		Message:   in.GetMessage().GetValue(),
+		Url:       in.GetUrl().GetValue(),
+		CacheTime: in.CacheTime,
+	})
+	if err != nil {
+		c.Logger.Errorf("messages.setBotCallbackAnswer - error: %v", err)
+		return nil, err
+	}
+
+	return mtproto.BoolTrue, nil
+}
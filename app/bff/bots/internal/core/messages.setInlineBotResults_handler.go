@@ -0,0 +1,92 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/bff/bots/internal/model"
+)
+
+// MessagesSetInlineBotResults
+// messages.setInlineBotResults#eb5ea206 flags:# gallery:flags.0?true private:flags.1?true query_id:long results:Vector<InputBotInlineResult> cache_time:int next_offset:flags.2?string switch_pm:flags.3?InlineBotSwitchPM = Bool;
+func (c *BotsCore) MessagesSetInlineBotResults(in *mtproto.TLMessagesSetInlineBotResults) (*mtproto.Bool, error) {
+	query, err := c.svcCtx.Dao.GetCacheBotInlineQuery(c.ctx, in.QueryId)
+	if err != nil {
+		c.Logger.Errorf("messages.setInlineBotResults - error: %v", err)
+		return nil, err
+	} else if query == nil || query.BotId != c.MD.UserId {
+		err = mtproto.ErrQueryIdInvalid
+		c.Logger.Errorf("messages.setInlineBotResults - error: %v", err)
+		return nil, err
+	}
+
+	if len(in.Results) > model.MaxInlineResults {
+		err = mtproto.ErrResultsTooMuch
+		c.Logger.Errorf("messages.setInlineBotResults - error: %v", err)
+		return nil, err
+	}
+
+	var (
+		idList  = make(map[string]struct{}, len(in.Results))
+		results = make([]*mtproto.BotInlineResult, 0, len(in.Results))
+	)
+	for _, r := range in.Results {
+		if r.GetId() == "" {
+			err = mtproto.ErrResultIdEmpty
+			c.Logger.Errorf("messages.setInlineBotResults - error: %v", err)
+			return nil, err
+		} else if _, ok := idList[r.GetId()]; ok {
+			err = mtproto.ErrResultIdDuplicate
+			c.Logger.Errorf("messages.setInlineBotResults - error: %v", err)
+			return nil, err
+		}
+		idList[r.GetId()] = struct{}{}
+
+		result, err := c.makeBotInlineResult(r)
+		if err != nil {
+			c.Logger.Errorf("messages.setInlineBotResults - error: %v", err)
+			return nil, err
+		}
+		results = append(results, result)
+	}
+
+	cacheTime := in.CacheTime
+	if cacheTime < 0 {
+		cacheTime = 0
+	}
+
+	err = c.svcCtx.Dao.PutCacheBotInlineResults(c.ctx, &model.BotInlineResults{
+		Query:   query,
+		Private: in.Private,
+		Results: mtproto.MakeTLMessagesBotResults(&mtproto.Messages_BotResults{
+			Gallery:    in.Gallery,
+			QueryId:    in.QueryId,
+			NextOffset: in.NextOffset,
+			SwitchPm:   in.SwitchPm,
+			Results:    results,
+			CacheTime:  cacheTime,
+		}).To_Messages_BotResults(),
+	})
+	if err != nil {
+		c.Logger.Errorf("messages.setInlineBotResults - error: %v", err)
+		return nil, err
+	}
+
+	return mtproto.BoolTrue, nil
+}
@@ -0,0 +1,111 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package dao
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/teamgram/teamgram-server/app/bff/bots/internal/model"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+const (
+	botCallbackQueryKeyPrefix  = "bot_callback_query"
+	botCallbackAnswerKeyPrefix = "bot_callback_answer"
+	botCallbackExpireTimeout   = 2 * model.BotCallbackQueryTimeout
+)
+
+func genBotCallbackQueryKey(queryId int64) string {
+	return fmt.Sprintf("%s_%d", botCallbackQueryKeyPrefix, queryId)
+}
+
+func genBotCallbackAnswerKey(queryId int64) string {
+	return fmt.Sprintf("%s_%d", botCallbackAnswerKeyPrefix, queryId)
+}
+
+func (d *Dao) PutCacheBotCallbackQuery(ctx context.Context, query *model.BotCallbackQuery) error {
+	var (
+		key      = genBotCallbackQueryKey(query.QueryId)
+		value, _ = json.Marshal(query)
+	)
+
+	if err := d.kv.Setex(key, string(value), botCallbackExpireTimeout); err != nil {
+		logx.WithContext(ctx).Errorf("conn.Do(SETEX %s) error(%v)", key, err)
+		return err
+	}
+
+	return nil
+}
+
+func (d *Dao) GetCacheBotCallbackQuery(ctx context.Context, queryId int64) (*model.BotCallbackQuery, error) {
+	key := genBotCallbackQueryKey(queryId)
+
+	value, err := d.kv.Get(key)
+	if err != nil {
+		logx.WithContext(ctx).Errorf("conn.Do(GET %s) error(%v)", key, err)
+		return nil, err
+	} else if value == "" {
+		return nil, nil
+	}
+
+	query := new(model.BotCallbackQuery)
+	if err = json.Unmarshal([]byte(value), query); err != nil {
+		logx.WithContext(ctx).Errorf("json.Unmarshal(%s) error(%v)", value, err)
+		return nil, err
+	}
+
+	return query, nil
+}
+
+func (d *Dao) PutCacheBotCallbackAnswer(ctx context.Context, queryId int64, answer *model.BotCallbackAnswer) error {
+	var (
+		key      = genBotCallbackAnswerKey(queryId)
+		value, _ = json.Marshal(answer)
+	)
+
+	if err := d.kv.Setex(key, string(value), botCallbackExpireTimeout); err != nil {
+		logx.WithContext(ctx).Errorf("conn.Do(SETEX %s) error(%v)", key, err)
+		return err
+	}
+
+	return nil
+}
+
+func (d *Dao) GetCacheBotCallbackAnswer(ctx context.Context, queryId int64) (*model.BotCallbackAnswer, error) {
+	key := genBotCallbackAnswerKey(queryId)
+
+	value, err := d.kv.Get(key)
+	if err != nil {
+		logx.WithContext(ctx).Errorf("conn.Do(GET %s) error(%v)", key, err)
+		return nil, err
+	} else if value == "" {
+		return nil, nil
+	}
+
+	answer := new(model.BotCallbackAnswer)
+	if err = json.Unmarshal([]byte(value), answer); err != nil {
+		logx.WithContext(ctx).Errorf("json.Unmarshal(%s) error(%v)", value, err)
+		return nil, err
+	}
+
+	return answer, nil
+}
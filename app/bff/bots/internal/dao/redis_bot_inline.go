@@ -0,0 +1,169 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package dao
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"strconv"
+
+	"github.com/teamgram/teamgram-server/app/bff/bots/internal/model"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+const (
+	botInlineQueryKeyPrefix   = "bot_inline_query"
+	botInlineResultsKeyPrefix = "bot_inline_results"
+	botInlineCacheKeyPrefix   = "bot_inline_cache"
+	botInlineExpireTimeout    = 2 * model.BotInlineQueryTimeout
+)
+
+func genBotInlineQueryKey(queryId int64) string {
+	return fmt.Sprintf("%s_%d", botInlineQueryKeyPrefix, queryId)
+}
+
+func genBotInlineResultsKey(queryId int64) string {
+	return fmt.Sprintf("%s_%d", botInlineResultsKeyPrefix, queryId)
+}
+
+// genBotInlineCacheKey userId is 0 for the results shared by all the users
+func genBotInlineCacheKey(botId, userId int64, query, offset string) string {
+	h := fnv.New64a()
+	h.Write([]byte(query))
+	h.Write([]byte{0})
+	h.Write([]byte(offset))
+	return fmt.Sprintf("%s_%d_%d_%d", botInlineCacheKeyPrefix, botId, userId, h.Sum64())
+}
+
+func (d *Dao) PutCacheBotInlineQuery(ctx context.Context, query *model.BotInlineQuery) error {
+	var (
+		key      = genBotInlineQueryKey(query.QueryId)
+		value, _ = json.Marshal(query)
+	)
+
+	if err := d.kv.Setex(key, string(value), botInlineExpireTimeout); err != nil {
+		logx.WithContext(ctx).Errorf("conn.Do(SETEX %s) error(%v)", key, err)
+		return err
+	}
+
+	return nil
+}
+
+func (d *Dao) GetCacheBotInlineQuery(ctx context.Context, queryId int64) (*model.BotInlineQuery, error) {
+	key := genBotInlineQueryKey(queryId)
+
+	value, err := d.kv.Get(key)
+	if err != nil {
+		logx.WithContext(ctx).Errorf("conn.Do(GET %s) error(%v)", key, err)
+		return nil, err
+	} else if value == "" {
+		return nil, nil
+	}
+
+	query := new(model.BotInlineQuery)
+	if err = json.Unmarshal([]byte(value), query); err != nil {
+		logx.WithContext(ctx).Errorf("json.Unmarshal(%s) error(%v)", value, err)
+		return nil, err
+	}
+
+	return query, nil
+}
+
+// PutCacheBotInlineResults the results are kept for cache_time, but at least model.BotInlineResultsExpire,
+// the results of the same query are reused for cache_time.
+func (d *Dao) PutCacheBotInlineResults(ctx context.Context, results *model.BotInlineResults) error {
+	var (
+		query     = results.Query
+		cacheTime = int(results.Results.GetCacheTime())
+		key       = genBotInlineResultsKey(query.QueryId)
+		value, _  = json.Marshal(results)
+		expire    = cacheTime
+	)
+
+	if expire < model.BotInlineResultsExpire {
+		expire = model.BotInlineResultsExpire
+	}
+	if err := d.kv.Setex(key, string(value), expire); err != nil {
+		logx.WithContext(ctx).Errorf("conn.Do(SETEX %s) error(%v)", key, err)
+		return err
+	}
+
+	if cacheTime > 0 {
+		var userId int64
+		if results.Private {
+			userId = query.UserId
+		}
+		cacheKey := genBotInlineCacheKey(query.BotId, userId, query.Query, query.Offset)
+		if err := d.kv.Setex(cacheKey, strconv.FormatInt(query.QueryId, 10), cacheTime); err != nil {
+			logx.WithContext(ctx).Errorf("conn.Do(SETEX %s) error(%v)", cacheKey, err)
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (d *Dao) GetCacheBotInlineResults(ctx context.Context, queryId int64) (*model.BotInlineResults, error) {
+	key := genBotInlineResultsKey(queryId)
+
+	value, err := d.kv.Get(key)
+	if err != nil {
+		logx.WithContext(ctx).Errorf("conn.Do(GET %s) error(%v)", key, err)
+		return nil, err
+	} else if value == "" {
+		return nil, nil
+	}
+
+	results := new(model.BotInlineResults)
+	if err = json.Unmarshal([]byte(value), results); err != nil {
+		logx.WithContext(ctx).Errorf("json.Unmarshal(%s) error(%v)", value, err)
+		return nil, err
+	}
+
+	return results, nil
+}
+
+// GetCacheBotInlineResultsByQuery returns the cached results of the same query,
+// the results for userId only are preferred to the shared ones.
+func (d *Dao) GetCacheBotInlineResultsByQuery(ctx context.Context, botId, userId int64, query, offset string) (*model.BotInlineResults, error) {
+	for _, id := range []int64{userId, 0} {
+		key := genBotInlineCacheKey(botId, id, query, offset)
+
+		value, err := d.kv.Get(key)
+		if err != nil {
+			logx.WithContext(ctx).Errorf("conn.Do(GET %s) error(%v)", key, err)
+			return nil, err
+		} else if value == "" {
+			continue
+		}
+
+		queryId, _ := strconv.ParseInt(value, 10, 64)
+		results, err := d.GetCacheBotInlineResults(ctx, queryId)
+		if err != nil {
+			return nil, err
+		} else if results != nil {
+			return results, nil
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,105 @@
+// Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+//  All rights reserved.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package dao
+
+import (
+	"context"
+	"testing"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/bff/bots/internal/model"
+
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/kv"
+	"github.com/zeromicro/go-zero/core/stores/redis"
+	"github.com/zeromicro/go-zero/core/stores/redis/redistest"
+)
+
+func TestGenBotInlineCacheKey(t *testing.T) {
+	var (
+		shared  = genBotInlineCacheKey(100, 0, "cats", "")
+		private = genBotInlineCacheKey(100, 1, "cats", "")
+	)
+
+	if shared == private {
+		t.Fatalf("the private key must differ from the shared one: %s", shared)
+	}
+	if private == genBotInlineCacheKey(100, 2, "cats", "") {
+		t.Fatalf("the private keys of two users must differ: %s", private)
+	}
+	if shared != genBotInlineCacheKey(100, 0, "cats", "") {
+		t.Fatalf("the shared key must be stable: %s", shared)
+	}
+	if shared == genBotInlineCacheKey(101, 0, "cats", "") {
+		t.Fatalf("the keys of two bots must differ: %s", shared)
+	}
+	// query and offset are separated, "ab"+"c" isn't "a"+"bc"
+	if genBotInlineCacheKey(100, 0, "ab", "c") == genBotInlineCacheKey(100, 0, "a", "bc") {
+		t.Fatal("query and offset must be separated in the key")
+	}
+}
+
+func TestGetCacheBotInlineResultsByQuery(t *testing.T) {
+	r, clean, err := redistest.CreateRedis()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer clean()
+
+	var (
+		ctx = context.Background()
+		d   = &Dao{
+			kv: kv.NewStore(cache.ClusterConf{
+				{
+					RedisConf: redis.RedisConf{Host: r.Addr, Type: redis.NodeType},
+					Weight:    100,
+				},
+			}),
+		}
+		putResults = func(queryId, userId int64, private bool) {
+			err := d.PutCacheBotInlineResults(ctx, &model.BotInlineResults{
+				Query:   &model.BotInlineQuery{QueryId: queryId, BotId: 100, UserId: userId, Query: "cats"},
+				Private: private,
+				Results: mtproto.MakeTLMessagesBotResults(&mtproto.Messages_BotResults{
+					QueryId:   queryId,
+					CacheTime: 60,
+				}).To_Messages_BotResults(),
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+		}
+		getQueryId = func(userId int64) int64 {
+			results, err := d.GetCacheBotInlineResultsByQuery(ctx, 100, userId, "cats", "")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if results == nil {
+				return 0
+			}
+			return results.Query.QueryId
+		}
+	)
+
+	// the private results of user 1 aren't reused for user 2
+	putResults(1, 1, true)
+	if id := getQueryId(1); id != 1 {
+		t.Fatalf("user 1: query_id = %d, want 1", id)
+	}
+	if id := getQueryId(2); id != 0 {
+		t.Fatalf("user 2: query_id = %d, want none", id)
+	}
+
+	// the shared results are reused for everyone, the private ones are preferred
+	putResults(2, 2, false)
+	if id := getQueryId(1); id != 1 {
+		t.Fatalf("user 1: query_id = %d, want 1", id)
+	}
+	if id := getQueryId(3); id != 2 {
+		t.Fatalf("user 3: query_id = %d, want 2", id)
+	}
+}
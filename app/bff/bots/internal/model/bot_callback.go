@@ -0,0 +1,42 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package model
+
+const (
+	// BotCallbackQueryTimeout is how long the client waits for messages.setBotCallbackAnswer
+	BotCallbackQueryTimeout = 10 // 10s
+
+	// MaxCallbackDataLength is the max size of keyboardButtonCallback.data
+	MaxCallbackDataLength = 64
+)
+
+// BotCallbackQuery is a pending messages.getBotCallbackAnswer waiting for the bot.
+type BotCallbackQuery struct {
+	QueryId int64 `json:"query_id"`
+	BotId   int64 `json:"bot_id"`
+	UserId  int64 `json:"user_id"`
+}
+
+// BotCallbackAnswer is the answer set by messages.setBotCallbackAnswer.
+type BotCallbackAnswer struct {
+	Alert     bool   `json:"alert"`
+	Message   string `json:"message,omitempty"`
+	Url       string `json:"url,omitempty"`
+	CacheTime int32  `json:"cache_time"`
+}
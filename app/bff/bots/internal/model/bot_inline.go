@@ -0,0 +1,63 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package model
+
+import (
+	"github.com/teamgram/proto/mtproto"
+)
+
+const (
+	// BotInlineQueryTimeout is how long the client waits for messages.setInlineBotResults
+	BotInlineQueryTimeout = 10 // 10s
+
+	// BotInlineResultsExpire the results can be sent at least 5 minutes after the query, even if cache_time is less
+	BotInlineResultsExpire = 300
+
+	MaxInlineResults = 50
+)
+
+// BotInlineQuery is a pending messages.getInlineBotResults waiting for the bot.
+type BotInlineQuery struct {
+	QueryId int64             `json:"query_id"`
+	BotId   int64             `json:"bot_id"`
+	UserId  int64             `json:"user_id"`
+	Query   string            `json:"query"`
+	Offset  string            `json:"offset"`
+	Geo     *mtproto.GeoPoint `json:"geo,omitempty"`
+}
+
+// BotInlineResults is the answer set by messages.setInlineBotResults,
+// messages.sendInlineBotResult sends one of them.
+type BotInlineResults struct {
+	Query   *BotInlineQuery              `json:"query"`
+	Private bool                         `json:"private"`
+	Results *mtproto.Messages_BotResults `json:"results"`
+}
+
+// CanBeSentBy the private results can only be sent by the user who made the query.
+func (m *BotInlineResults) CanBeSentBy(userId int64) bool {
+	return !m.Private || m.Query.GetUserId() == userId
+}
+
+func (m *BotInlineQuery) GetUserId() int64 {
+	if m == nil {
+		return 0
+	}
+	return m.UserId
+}
@@ -0,0 +1,33 @@
+// Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+//  All rights reserved.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package model
+
+import (
+	"testing"
+)
+
+func TestBotInlineResultsCanBeSentBy(t *testing.T) {
+	results := &BotInlineResults{
+		Query: &BotInlineQuery{QueryId: 1, BotId: 100, UserId: 1},
+	}
+	if !results.CanBeSentBy(1) || !results.CanBeSentBy(2) {
+		t.Fatal("shared results: want sent by anyone")
+	}
+
+	results.Private = true
+	if !results.CanBeSentBy(1) {
+		t.Fatal("private results: want sent by the owner")
+	}
+	if results.CanBeSentBy(2) {
+		t.Fatal("private results: want not sent by another user")
+	}
+
+	results.Query = nil
+	if results.CanBeSentBy(2) {
+		t.Fatal("private results without query: want not sent")
+	}
+}
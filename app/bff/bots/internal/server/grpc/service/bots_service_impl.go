@@ -0,0 +1,138 @@
+/*
+ * WARNING! All changes made in this file will be lost!
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright 2022 Teamgram Authors.
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package service
+
+import (
+	"context"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/bff/bots/internal/core"
+)
+
+// BotsSetBotCommands
+// bots.setBotCommands#517165a scope:BotCommandScope lang_code:string commands:Vector<BotCommand> = Bool;
+func (s *Service) BotsSetBotCommands(ctx context.Context, request *mtproto.TLBotsSetBotCommands) (*mtproto.Bool, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Infof("bots.setBotCommands - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.BotsSetBotCommands(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Infof("bots.setBotCommands - reply: %s", r.DebugString())
+	return r, err
+}
+
+// BotsResetBotCommands
+// bots.resetBotCommands#3d8de0f9 scope:BotCommandScope lang_code:string = Bool;
+func (s *Service) BotsResetBotCommands(ctx context.Context, request *mtproto.TLBotsResetBotCommands) (*mtproto.Bool, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Infof("bots.resetBotCommands - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.BotsResetBotCommands(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Infof("bots.resetBotCommands - reply: %s", r.DebugString())
+	return r, err
+}
+
+// BotsGetBotCommands
+// bots.getBotCommands#e34c0dd6 scope:BotCommandScope lang_code:string = Vector<BotCommand>;
+func (s *Service) BotsGetBotCommands(ctx context.Context, request *mtproto.TLBotsGetBotCommands) (*mtproto.Vector_BotCommand, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Infof("bots.getBotCommands - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.BotsGetBotCommands(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Infof("bots.getBotCommands - reply: %s", r.DebugString())
+	return r, err
+}
+
+// MessagesGetInlineBotResults
+// messages.getInlineBotResults#514e999d flags:# bot:InputUser peer:InputPeer geo_point:flags.0?InputGeoPoint query:string offset:string = messages.BotResults;
+func (s *Service) MessagesGetInlineBotResults(ctx context.Context, request *mtproto.TLMessagesGetInlineBotResults) (*mtproto.Messages_BotResults, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Infof("messages.getInlineBotResults - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.MessagesGetInlineBotResults(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Infof("messages.getInlineBotResults - reply: %s", r.DebugString())
+	return r, err
+}
+
+// MessagesSetInlineBotResults
+// messages.setInlineBotResults#eb5ea206 flags:# gallery:flags.0?true private:flags.1?true query_id:long results:Vector<InputBotInlineResult> cache_time:int next_offset:flags.2?string switch_pm:flags.3?InlineBotSwitchPM = Bool;
+func (s *Service) MessagesSetInlineBotResults(ctx context.Context, request *mtproto.TLMessagesSetInlineBotResults) (*mtproto.Bool, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Infof("messages.setInlineBotResults - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.MessagesSetInlineBotResults(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Infof("messages.setInlineBotResults - reply: %s", r.DebugString())
+	return r, err
+}
+
+// MessagesSendInlineBotResult
+// messages.sendInlineBotResult#7aa11297 flags:# silent:flags.5?true background:flags.6?true clear_draft:flags.7?true hide_via:flags.11?true peer:InputPeer reply_to_msg_id:flags.0?int random_id:long query_id:long id:string schedule_date:flags.10?int send_as:flags.13?InputPeer = Updates;
+func (s *Service) MessagesSendInlineBotResult(ctx context.Context, request *mtproto.TLMessagesSendInlineBotResult) (*mtproto.Updates, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Infof("messages.sendInlineBotResult - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.MessagesSendInlineBotResult(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Infof("messages.sendInlineBotResult - reply: %s", r.DebugString())
+	return r, err
+}
+
+// MessagesGetBotCallbackAnswer
+// messages.getBotCallbackAnswer#9342ca07 flags:# game:flags.1?true peer:InputPeer msg_id:int data:flags.0?bytes password:flags.2?InputCheckPasswordSRP = messages.BotCallbackAnswer;
+func (s *Service) MessagesGetBotCallbackAnswer(ctx context.Context, request *mtproto.TLMessagesGetBotCallbackAnswer) (*mtproto.Messages_BotCallbackAnswer, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Infof("messages.getBotCallbackAnswer - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.MessagesGetBotCallbackAnswer(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Infof("messages.getBotCallbackAnswer - reply: %s", r.DebugString())
+	return r, err
+}
+
+// MessagesSetBotCallbackAnswer
+// messages.setBotCallbackAnswer#d58f130a flags:# alert:flags.1?true query_id:long message:flags.0?string url:flags.3?string cache_time:int = Bool;
+func (s *Service) MessagesSetBotCallbackAnswer(ctx context.Context, request *mtproto.TLMessagesSetBotCallbackAnswer) (*mtproto.Bool, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Infof("messages.setBotCallbackAnswer - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.MessagesSetBotCallbackAnswer(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Infof("messages.setBotCallbackAnswer - reply: %s", r.DebugString())
+	return r, err
+}
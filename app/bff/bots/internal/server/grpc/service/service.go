@@ -0,0 +1,27 @@
+/*
+ * WARNING! All changes made in this file will be lost!
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright 2022 Teamgram Authors.
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package service
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/bff/bots/internal/svc"
+)
+
+type Service struct {
+	mtproto.UnimplementedRPCInlineBotServer
+	svcCtx *svc.ServiceContext
+}
+
+func New(ctx *svc.ServiceContext) *Service {
+	return &Service{
+		svcCtx: ctx,
+	}
+}
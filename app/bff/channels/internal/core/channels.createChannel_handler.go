@@ -0,0 +1,85 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"math/rand"
+
+	"github.com/teamgram/proto/mtproto"
+	msgpb "github.com/teamgram/teamgram-server/app/messenger/msg/msg/msg"
+	channelpb "github.com/teamgram/teamgram-server/app/service/biz/channel/channel"
+	"github.com/teamgram/teamgram-server/app/service/biz/dialog/dialog"
+)
+
+// ChannelsCreateChannel
+// channels.createChannel#3d5fb10f flags:# broadcast:flags.0?true megagroup:flags.1?true for_import:flags.3?true title:string about:string geo_point:flags.2?InputGeoPoint address:flags.2?string ttl_period:flags.4?int = Updates;
+func (c *ChannelsCore) ChannelsCreateChannel(in *mtproto.TLChannelsCreateChannel) (*mtproto.Updates, error) {
+	if in.GetTitle() == "" {
+		err := mtproto.ErrChatTitleEmpty
+		c.Logger.Errorf("channels.createChannel - error: %v", err)
+		return nil, err
+	}
+
+	// TODO: geo_point, address and ttl_period not impl
+	channel, err := c.svcCtx.Dao.ChannelClient.ChannelCreateChannel(c.ctx, &channelpb.TLChannelCreateChannel{
+		CreatorId: c.MD.UserId,
+		Broadcast: in.GetBroadcast(),
+		Megagroup: in.GetMegagroup(),
+		Title:     in.GetTitle(),
+		About:     in.GetAbout(),
+	})
+	if err != nil {
+		c.Logger.Errorf("channels.createChannel - error: %v", err)
+		return nil, err
+	}
+
+	_, err = c.svcCtx.Dao.DialogClient.DialogInsertOrUpdateDialog(c.ctx, &dialog.TLDialogInsertOrUpdateDialog{
+		UserId:   c.MD.UserId,
+		PeerType: mtproto.PEER_CHANNEL,
+		PeerId:   channel.Id(),
+	})
+	if err != nil {
+		c.Logger.Errorf("channels.createChannel - error: %v", err)
+		return nil, err
+	}
+
+	rUpdates, err := c.svcCtx.Dao.MsgClient.MsgSendMessage(c.ctx, &msgpb.TLMsgSendMessage{
+		UserId:    c.MD.UserId,
+		AuthKeyId: c.MD.AuthId,
+		PeerType:  mtproto.PEER_CHANNEL,
+		PeerId:    channel.Id(),
+		Message: msgpb.MakeTLOutboxMessage(&msgpb.OutboxMessage{
+			NoWebpage:  true,
+			Background: false,
+			RandomId:   rand.Int63(),
+			Message: channel.MakeMessageService(
+				c.MD.UserId,
+				mtproto.MakeTLMessageActionChannelCreate(&mtproto.MessageAction{
+					Title: in.GetTitle(),
+				}).To_MessageAction()),
+			ScheduleDate: nil,
+		}).To_OutboxMessage(),
+	})
+	if err != nil {
+		c.Logger.Errorf("channels.createChannel - error: %v", err)
+		return nil, err
+	}
+
+	return rUpdates, nil
+}
@@ -0,0 +1,65 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	channelpb "github.com/teamgram/teamgram-server/app/service/biz/channel/channel"
+)
+
+// ChannelsDeleteMessages
+// channels.deleteMessages#84c1fd4e channel:InputChannel id:Vector<int> = messages.AffectedMessages;
+func (c *ChannelsCore) ChannelsDeleteMessages(in *mtproto.TLChannelsDeleteMessages) (*mtproto.Messages_AffectedMessages, error) {
+	if in.GetChannel().GetPredicateName() != mtproto.Predicate_inputChannel {
+		err := mtproto.ErrChannelInvalid
+		c.Logger.Errorf("channels.deleteMessages - error: %v", err)
+		return nil, err
+	}
+	if len(in.GetId()) == 0 {
+		err := mtproto.ErrMessageIdInvalid
+		c.Logger.Errorf("channels.deleteMessages - error: %v", err)
+		return nil, err
+	}
+
+	channelId := in.GetChannel().GetChannelId()
+	deleted, err := c.svcCtx.Dao.ChannelClient.ChannelDeleteMessages(c.ctx, &channelpb.TLChannelDeleteMessages{
+		UserId:    c.MD.UserId,
+		ChannelId: channelId,
+		Id:        in.GetId(),
+	})
+	if err != nil {
+		c.Logger.Errorf("channels.deleteMessages - error: %v", err)
+		return nil, err
+	}
+
+	mChannel, err := c.svcCtx.Dao.ChannelClient.ChannelGetMutableChannel(c.ctx, &channelpb.TLChannelGetMutableChannel{
+		ChannelId: channelId,
+		Id:        []int64{c.MD.UserId},
+	})
+	if err != nil {
+		c.Logger.Errorf("channels.deleteMessages - error: %v", err)
+	} else {
+		c.broadcastDeleteChannelMessages(mChannel, deleted)
+	}
+
+	return mtproto.MakeTLMessagesAffectedMessages(&mtproto.Messages_AffectedMessages{
+		Pts:      deleted.GetPts(),
+		PtsCount: deleted.GetPtsCount(),
+	}).To_Messages_AffectedMessages(), nil
+}
@@ -0,0 +1,84 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	channelpb "github.com/teamgram/teamgram-server/app/service/biz/channel/channel"
+)
+
+// deleteParticipantHistoryLimit the messages deleted by one call, the client repeats the call
+// while the offset of messages.affectedHistory is not 0.
+const deleteParticipantHistoryLimit = 100
+
+// ChannelsDeleteParticipantHistory
+// channels.deleteParticipantHistory#367544db channel:InputChannel participant:InputPeer = messages.AffectedHistory;
+func (c *ChannelsCore) ChannelsDeleteParticipantHistory(in *mtproto.TLChannelsDeleteParticipantHistory) (*mtproto.Messages_AffectedHistory, error) {
+	if in.GetChannel().GetPredicateName() != mtproto.Predicate_inputChannel {
+		err := mtproto.ErrChannelInvalid
+		c.Logger.Errorf("channels.deleteParticipantHistory - error: %v", err)
+		return nil, err
+	}
+
+	peer := mtproto.FromInputPeer2(c.MD.UserId, in.GetParticipant())
+	if peer.PeerType != mtproto.PEER_USER {
+		err := mtproto.ErrParticipantIdInvalid
+		c.Logger.Errorf("channels.deleteParticipantHistory - error: %v", err)
+		return nil, err
+	}
+
+	channelId := in.GetChannel().GetChannelId()
+	deleted, err := c.svcCtx.Dao.ChannelClient.ChannelDeleteParticipantHistory(c.ctx, &channelpb.TLChannelDeleteParticipantHistory{
+		OperatorId:    c.MD.UserId,
+		ChannelId:     channelId,
+		ParticipantId: peer.PeerId,
+		Limit:         deleteParticipantHistoryLimit,
+	})
+	if err != nil {
+		c.Logger.Errorf("channels.deleteParticipantHistory - error: %v", err)
+		return nil, err
+	}
+
+	mChannel, err := c.svcCtx.Dao.ChannelClient.ChannelGetMutableChannel(c.ctx, &channelpb.TLChannelGetMutableChannel{
+		ChannelId: channelId,
+		Id:        []int64{c.MD.UserId},
+	})
+	if err != nil {
+		c.Logger.Errorf("channels.deleteParticipantHistory - error: %v", err)
+	} else {
+		c.broadcastDeleteChannelMessages(mChannel, deleted)
+	}
+
+	var offset int32
+	if idList := deleted.GetId(); len(idList) == deleteParticipantHistoryLimit {
+		// more messages may be left
+		offset = idList[0]
+		for _, id := range idList[1:] {
+			if id < offset {
+				offset = id
+			}
+		}
+	}
+
+	return mtproto.MakeTLMessagesAffectedHistory(&mtproto.Messages_AffectedHistory{
+		Pts:      deleted.GetPts(),
+		PtsCount: deleted.GetPtsCount(),
+		Offset:   offset,
+	}).To_Messages_AffectedHistory(), nil
+}
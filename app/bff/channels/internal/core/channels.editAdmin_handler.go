@@ -0,0 +1,57 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	channelpb "github.com/teamgram/teamgram-server/app/service/biz/channel/channel"
+)
+
+// ChannelsEditAdmin
+// channels.editAdmin#d33c8902 channel:InputChannel user_id:InputUser admin_rights:ChatAdminRights rank:string = Updates;
+func (c *ChannelsCore) ChannelsEditAdmin(in *mtproto.TLChannelsEditAdmin) (*mtproto.Updates, error) {
+	if in.GetChannel().GetPredicateName() != mtproto.Predicate_inputChannel {
+		err := mtproto.ErrChannelInvalid
+		c.Logger.Errorf("channels.editAdmin - error: %v", err)
+		return nil, err
+	}
+
+	peer := mtproto.FromInputUser(c.MD.UserId, in.GetUserId())
+	if peer.PeerType != mtproto.PEER_USER || peer.PeerId == c.MD.UserId {
+		err := mtproto.ErrUserIdInvalid
+		c.Logger.Errorf("channels.editAdmin - error: %v", err)
+		return nil, err
+	}
+
+	mChannel, err := c.svcCtx.Dao.ChannelClient.ChannelEditAdmin(c.ctx, &channelpb.TLChannelEditAdmin{
+		ChannelId:   in.GetChannel().GetChannelId(),
+		OperatorId:  c.MD.UserId,
+		UserId:      peer.PeerId,
+		AdminRights: in.GetAdminRights(),
+		Rank:        in.GetRank(),
+	})
+	if err != nil {
+		c.Logger.Errorf("channels.editAdmin - error: %v", err)
+		return nil, err
+	}
+
+	c.pushUpdateChannel(mChannel, peer.PeerId)
+
+	return c.makeUpdateChannelUpdates(mChannel, c.MD.UserId), nil
+}
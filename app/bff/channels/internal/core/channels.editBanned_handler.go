@@ -0,0 +1,76 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	channelpb "github.com/teamgram/teamgram-server/app/service/biz/channel/channel"
+	"github.com/teamgram/teamgram-server/app/service/biz/dialog/dialog"
+)
+
+// ChannelsEditBanned
+// channels.editBanned#96e6cd81 channel:InputChannel participant:InputPeer banned_rights:ChatBannedRights = Updates;
+func (c *ChannelsCore) ChannelsEditBanned(in *mtproto.TLChannelsEditBanned) (*mtproto.Updates, error) {
+	if in.GetChannel().GetPredicateName() != mtproto.Predicate_inputChannel {
+		err := mtproto.ErrChannelInvalid
+		c.Logger.Errorf("channels.editBanned - error: %v", err)
+		return nil, err
+	}
+
+	// TODO: ban the channels which send as a channel
+	peer := mtproto.FromInputPeer2(c.MD.UserId, in.GetParticipant())
+	if peer.PeerType != mtproto.PEER_USER || peer.PeerId == c.MD.UserId {
+		err := mtproto.ErrParticipantIdInvalid
+		c.Logger.Errorf("channels.editBanned - error: %v", err)
+		return nil, err
+	}
+
+	mChannel, err := c.svcCtx.Dao.ChannelClient.ChannelEditBanned(c.ctx, &channelpb.TLChannelEditBanned{
+		ChannelId:    in.GetChannel().GetChannelId(),
+		OperatorId:   c.MD.UserId,
+		UserId:       peer.PeerId,
+		BannedRights: in.GetBannedRights(),
+	})
+	if err != nil {
+		c.Logger.Errorf("channels.editBanned - error: %v", err)
+		return nil, err
+	}
+
+	kicked := in.GetBannedRights().GetViewMessages()
+	if kicked {
+		c.svcCtx.Dao.DialogClient.DialogDeleteDialog(c.ctx, &dialog.TLDialogDeleteDialog{
+			UserId:   peer.PeerId,
+			PeerType: mtproto.PEER_CHANNEL,
+			PeerId:   mChannel.Id(),
+		})
+	}
+	c.pushUpdateChannel(mChannel, peer.PeerId)
+
+	if !kicked || !mChannel.Megagroup() {
+		return c.makeUpdateChannelUpdates(mChannel, c.MD.UserId), nil
+	}
+
+	rUpdates, err := c.sendMessageService(mChannel, mtproto.MakeMessageActionChatDeleteUser(peer.PeerId))
+	if err != nil {
+		c.Logger.Errorf("channels.editBanned - error: %v", err)
+		return nil, err
+	}
+
+	return rUpdates, nil
+}
@@ -0,0 +1,84 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	channelpb "github.com/teamgram/teamgram-server/app/service/biz/channel/channel"
+	mediapb "github.com/teamgram/teamgram-server/app/service/media/media"
+)
+
+// ChannelsEditPhoto
+// channels.editPhoto#f12e57c9 channel:InputChannel photo:InputChatPhoto = Updates;
+func (c *ChannelsCore) ChannelsEditPhoto(in *mtproto.TLChannelsEditPhoto) (*mtproto.Updates, error) {
+	if in.GetChannel().GetPredicateName() != mtproto.Predicate_inputChannel {
+		err := mtproto.ErrChannelInvalid
+		c.Logger.Errorf("channels.editPhoto - error: %v", err)
+		return nil, err
+	}
+
+	var (
+		chatPhoto = in.GetPhoto()
+		photo     = mtproto.MakeTLPhotoEmpty(nil).To_Photo()
+		action    *mtproto.MessageAction
+		err       error
+	)
+
+	switch chatPhoto.GetPredicateName() {
+	case mtproto.Predicate_inputChatPhotoEmpty:
+		// inputChatPhotoEmpty#1ca48f57 = InputChatPhoto;
+		action = mtproto.MakeMessageActionChatDeletePhoto()
+	case mtproto.Predicate_inputChatUploadedPhoto:
+		// inputChatUploadedPhoto#c642724e flags:# file:flags.0?InputFile video:flags.1?InputFile video_start_ts:flags.2?double = InputChatPhoto;
+		photo, err = c.svcCtx.Dao.MediaClient.MediaUploadProfilePhotoFile(c.ctx, &mediapb.TLMediaUploadProfilePhotoFile{
+			OwnerId:      c.MD.AuthId,
+			File:         chatPhoto.GetFile(),
+			Video:        chatPhoto.GetVideo(),
+			VideoStartTs: chatPhoto.GetVideoStartTs(),
+		})
+		if err != nil {
+			c.Logger.Errorf("channels.editPhoto - error: %v", err)
+			return nil, err
+		}
+		action = mtproto.MakeMessageActionChatEditPhoto(photo)
+	default:
+		err = mtproto.ErrInputRequestInvalid
+		c.Logger.Errorf("channels.editPhoto - error: %v", err)
+		return nil, err
+	}
+
+	mChannel, err := c.svcCtx.Dao.ChannelClient.ChannelEditPhoto(c.ctx, &channelpb.TLChannelEditPhoto{
+		ChannelId:  in.GetChannel().GetChannelId(),
+		OperatorId: c.MD.UserId,
+		Photo:      photo,
+	})
+	if err != nil {
+		c.Logger.Errorf("channels.editPhoto - error: %v", err)
+		return nil, err
+	}
+
+	rUpdates, err := c.sendMessageService(mChannel, action)
+	if err != nil {
+		c.Logger.Errorf("channels.editPhoto - error: %v", err)
+		return c.broadcastUpdateChannel(mChannel), nil
+	}
+	c.svcCtx.Dao.FileReference.SetUpdates(c.MD.UserId, rUpdates)
+
+	return rUpdates, nil
+}
@@ -0,0 +1,53 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	channelpb "github.com/teamgram/teamgram-server/app/service/biz/channel/channel"
+)
+
+// ChannelsEditTitle
+// channels.editTitle#566decd0 channel:InputChannel title:string = Updates;
+func (c *ChannelsCore) ChannelsEditTitle(in *mtproto.TLChannelsEditTitle) (*mtproto.Updates, error) {
+	if in.GetChannel().GetPredicateName() != mtproto.Predicate_inputChannel {
+		err := mtproto.ErrChannelInvalid
+		c.Logger.Errorf("channels.editTitle - error: %v", err)
+		return nil, err
+	}
+
+	mChannel, err := c.svcCtx.Dao.ChannelClient.ChannelEditTitle(c.ctx, &channelpb.TLChannelEditTitle{
+		ChannelId:  in.GetChannel().GetChannelId(),
+		OperatorId: c.MD.UserId,
+		Title:      in.GetTitle(),
+	})
+	if err != nil {
+		c.Logger.Errorf("channels.editTitle - error: %v", err)
+		return nil, err
+	}
+
+	rUpdates, err := c.sendMessageService(mChannel, mtproto.MakeMessageActionChatEditTitle(mChannel.Title()))
+	if err != nil {
+		// e.g. the admin can change the info but not post to the broadcast channel
+		c.Logger.Errorf("channels.editTitle - error: %v", err)
+		return c.broadcastUpdateChannel(mChannel), nil
+	}
+
+	return rUpdates, nil
+}
@@ -0,0 +1,74 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"fmt"
+
+	"github.com/teamgram/proto/mtproto"
+	channelpb "github.com/teamgram/teamgram-server/app/service/biz/channel/channel"
+	"github.com/teamgram/teamgram-server/pkg/env2"
+)
+
+// ChannelsExportMessageLink
+// channels.exportMessageLink#e63fadeb flags:# grouped:flags.0?true thread:flags.1?true channel:InputChannel id:int = ExportedMessageLink;
+func (c *ChannelsCore) ChannelsExportMessageLink(in *mtproto.TLChannelsExportMessageLink) (*mtproto.ExportedMessageLink, error) {
+	if in.GetChannel().GetPredicateName() != mtproto.Predicate_inputChannel {
+		err := mtproto.ErrChannelInvalid
+		c.Logger.Errorf("channels.exportMessageLink - error: %v", err)
+		return nil, err
+	}
+
+	channelId := in.GetChannel().GetChannelId()
+	mChannel, err := c.svcCtx.Dao.ChannelClient.ChannelGetMutableChannel(c.ctx, &channelpb.TLChannelGetMutableChannel{
+		ChannelId: channelId,
+		Id:        []int64{c.MD.UserId},
+	})
+	if err != nil {
+		c.Logger.Errorf("channels.exportMessageLink - error: %v", err)
+		return nil, err
+	}
+
+	boxList, err := c.svcCtx.Dao.ChannelClient.ChannelGetMessages(c.ctx, &channelpb.TLChannelGetMessages{
+		UserId:    c.MD.UserId,
+		ChannelId: channelId,
+		Id:        []int32{in.GetId()},
+	})
+	if err != nil {
+		c.Logger.Errorf("channels.exportMessageLink - error: %v", err)
+		return nil, err
+	} else if len(boxList.GetDatas()) == 0 {
+		err = mtproto.ErrMessageIdInvalid
+		c.Logger.Errorf("channels.exportMessageLink - error: %v", err)
+		return nil, err
+	}
+
+	// the private links only open for the participants
+	var link string
+	if mChannel.Username() != "" {
+		link = fmt.Sprintf("https://%s/%s/%d", env2.TDotMe, mChannel.Username(), in.GetId())
+	} else {
+		link = fmt.Sprintf("https://%s/c/%d/%d", env2.TDotMe, channelId, in.GetId())
+	}
+
+	return mtproto.MakeTLExportedMessageLink(&mtproto.ExportedMessageLink{
+		Link: link,
+		Html: "",
+	}).To_ExportedMessageLink(), nil
+}
@@ -0,0 +1,121 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	channelpb "github.com/teamgram/teamgram-server/app/service/biz/channel/channel"
+	"github.com/teamgram/teamgram-server/app/service/biz/dialog/dialog"
+	userpb "github.com/teamgram/teamgram-server/app/service/biz/user/user"
+	"github.com/teamgram/teamgram-server/pkg/filereference"
+
+	"github.com/gogo/protobuf/types"
+)
+
+// ChannelsGetFullChannel
+// channels.getFullChannel#8736a09 channel:InputChannel = messages.ChatFull;
+func (c *ChannelsCore) ChannelsGetFullChannel(in *mtproto.TLChannelsGetFullChannel) (*mtproto.Messages_ChatFull, error) {
+	if in.GetChannel().GetPredicateName() != mtproto.Predicate_inputChannel {
+		err := mtproto.ErrChannelInvalid
+		c.Logger.Errorf("channels.getFullChannel - error: %v", err)
+		return nil, err
+	}
+
+	channelId := in.GetChannel().GetChannelId()
+	mChannel, err := c.svcCtx.Dao.ChannelClient.ChannelGetMutableChannel(c.ctx, &channelpb.TLChannelGetMutableChannel{
+		ChannelId: channelId,
+		Id:        []int64{c.MD.UserId},
+	})
+	if err != nil {
+		c.Logger.Errorf("channels.getFullChannel - error: %v", err)
+		return nil, err
+	}
+
+	me, ok := mChannel.GetImmutableChannelParticipant(c.MD.UserId)
+	if ok && me.IsChatMemberStateKicked() {
+		err = mtproto.ErrChannelPrivate
+		c.Logger.Errorf("channels.getFullChannel - error: %v", err)
+		return nil, err
+	} else if !ok || !me.IsChatMemberStateNormal() {
+		// who is not a participant only sees a public channel
+		if mChannel.Username() == "" {
+			err = mtproto.ErrChannelPrivate
+			c.Logger.Errorf("channels.getFullChannel - error: %v", err)
+			return nil, err
+		}
+		me = nil
+	}
+
+	photo := mChannel.Photo()
+	if photo == nil {
+		photo = mtproto.MakeTLPhotoEmpty(nil).To_Photo()
+	}
+	c.svcCtx.Dao.FileReference.SetPhoto(c.MD.UserId, photo, filereference.PeerPhotoContext(mtproto.PEER_CHANNEL, channelId))
+
+	channelFull := mtproto.MakeTLChannelFull(&mtproto.ChatFull{
+		CanViewParticipants: mChannel.Megagroup(),
+		Id:                  channelId,
+		About:               mChannel.About(),
+		ParticipantsCount:   &types.Int32Value{Value: mChannel.ParticipantsCount()},
+		ChatPhoto:           photo,
+		NotifySettings:      mtproto.MakeTLPeerNotifySettings(nil).To_PeerNotifySettings(),
+		ExportedInvite:      nil, // TODO
+		BotInfo:             []*mtproto.BotInfo{},
+		PinnedMsgId:         nil, // TODO
+		Pts:                 mChannel.Pts(),
+		HiddenPrehistory:    mChannel.HiddenPrehistory(),
+	}).To_ChatFull()
+	if mChannel.SlowmodeSeconds() > 0 {
+		channelFull.SlowmodeSeconds = &types.Int32Value{Value: mChannel.SlowmodeSeconds()}
+	}
+
+	if me != nil {
+		channelFull.CanViewParticipants = mChannel.Megagroup() || me.IsChatMemberCreator() || me.IsChatMemberAdmin()
+		channelFull.ReadInboxMaxId = me.ReadInboxMaxId
+		if unreadCount := mChannel.TopMessage() - me.ReadInboxMaxId; unreadCount > 0 {
+			channelFull.UnreadCount = unreadCount
+		}
+		if me.AvailableMinId > 0 {
+			channelFull.AvailableMinId = &types.Int32Value{Value: me.AvailableMinId}
+		}
+
+		if dialogs, _ := c.svcCtx.Dao.DialogClient.DialogGetDialogsByIdList(c.ctx, &dialog.TLDialogGetDialogsByIdList{
+			UserId: c.MD.UserId,
+			IdList: []int64{mtproto.MakePeerDialogId(mtproto.PEER_CHANNEL, channelId)},
+		}); len(dialogs.GetDatas()) > 0 {
+			dlg := dialogs.GetDatas()[0].GetDialog()
+			channelFull.ReadOutboxMaxId = dlg.GetReadOutboxMaxId()
+			channelFull.FolderId = dlg.GetFolderId()
+		}
+
+		if settings, _ := c.svcCtx.Dao.UserClient.UserGetNotifySettings(c.ctx, &userpb.TLUserGetNotifySettings{
+			UserId:   c.MD.UserId,
+			PeerType: mtproto.PEER_CHANNEL,
+			PeerId:   channelId,
+		}); settings != nil {
+			channelFull.NotifySettings = settings
+		}
+	}
+
+	return mtproto.MakeTLMessagesChatFull(&mtproto.Messages_ChatFull{
+		FullChat: channelFull,
+		Chats:    []*mtproto.Chat{mChannel.ToUnsafeChat(c.MD.UserId)},
+		Users:    []*mtproto.User{},
+	}).To_Messages_ChatFull(), nil
+}
@@ -0,0 +1,108 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	channelpb "github.com/teamgram/teamgram-server/app/service/biz/channel/channel"
+	userpb "github.com/teamgram/teamgram-server/app/service/biz/user/user"
+)
+
+// ChannelsGetMessages
+// channels.getMessages#ad8c9a23 channel:InputChannel id:Vector<InputMessage> = messages.Messages;
+func (c *ChannelsCore) ChannelsGetMessages(in *mtproto.TLChannelsGetMessages) (*mtproto.Messages_Messages, error) {
+	var (
+		idList    []int32
+		channelId = in.GetChannel().GetChannelId()
+	)
+
+	if in.GetChannel().GetPredicateName() != mtproto.Predicate_inputChannel {
+		err := mtproto.ErrChannelInvalid
+		c.Logger.Errorf("channels.getMessages - error: %v", err)
+		return nil, err
+	}
+
+	for _, id := range in.GetId() {
+		switch id.PredicateName {
+		case mtproto.Predicate_inputMessageID:
+			idList = append(idList, id.Id)
+		default:
+			// client not use: inputMessageReplyTo, inputMessagePinned, inputMessageCallbackQuery
+			err := mtproto.ErrInputConstructorInvalid
+			c.Logger.Errorf("channels.getMessages - error: %v", err)
+			return nil, err
+		}
+	}
+
+	mChannel, err := c.svcCtx.Dao.ChannelClient.ChannelGetMutableChannel(c.ctx, &channelpb.TLChannelGetMutableChannel{
+		ChannelId: channelId,
+		Id:        []int64{c.MD.UserId},
+	})
+	if err != nil {
+		c.Logger.Errorf("channels.getMessages - error: %v", err)
+		return nil, err
+	}
+
+	rValues := mtproto.MakeTLMessagesChannelMessages(&mtproto.Messages_Messages{
+		Inexact:        false,
+		Pts:            mChannel.Pts(),
+		Count:          0,
+		OffsetIdOffset: nil,
+		Messages:       []*mtproto.Message{},
+		Chats:          []*mtproto.Chat{},
+		Users:          []*mtproto.User{},
+	}).To_Messages_Messages()
+
+	if len(idList) == 0 {
+		return rValues, nil
+	}
+
+	boxList, err := c.svcCtx.Dao.ChannelClient.ChannelGetMessages(c.ctx, &channelpb.TLChannelGetMessages{
+		UserId:    c.MD.UserId,
+		ChannelId: channelId,
+		Id:        idList,
+	})
+	if err != nil {
+		c.Logger.Errorf("channels.getMessages - error: %v", err)
+		return nil, err
+	}
+
+	boxList.Visit(c.MD.UserId,
+		func(messageList []*mtproto.Message) {
+			c.svcCtx.Dao.FileReference.SetMessages(c.MD.UserId, messageList...)
+			rValues.Messages = messageList
+			rValues.Count = int32(len(messageList))
+		},
+		func(userIdList []int64) {
+			mUsers, _ := c.svcCtx.Dao.UserClient.UserGetMutableUsers(
+				c.ctx,
+				&userpb.TLUserGetMutableUsers{
+					Id: userIdList,
+				})
+			rValues.Users = append(rValues.Users, mUsers.GetUserListByIdList(c.MD.UserId, userIdList...)...)
+		},
+		func(chatIdList []int64) {
+			// a channel message only references its own channel
+		},
+		func(channelIdList []int64) {
+			rValues.Chats = append(rValues.Chats, mChannel.ToUnsafeChat(c.MD.UserId))
+		})
+
+	return rValues, nil
+}
@@ -0,0 +1,81 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	channelpb "github.com/teamgram/teamgram-server/app/service/biz/channel/channel"
+	userpb "github.com/teamgram/teamgram-server/app/service/biz/user/user"
+)
+
+// ChannelsGetParticipant
+// channels.getParticipant#a0ab6cc6 channel:InputChannel participant:InputPeer = channels.ChannelParticipant;
+func (c *ChannelsCore) ChannelsGetParticipant(in *mtproto.TLChannelsGetParticipant) (*mtproto.Channels_ChannelParticipant, error) {
+	if in.GetChannel().GetPredicateName() != mtproto.Predicate_inputChannel {
+		err := mtproto.ErrChannelInvalid
+		c.Logger.Errorf("channels.getParticipant - error: %v", err)
+		return nil, err
+	}
+
+	peer := mtproto.FromInputPeer2(c.MD.UserId, in.GetParticipant())
+	if peer.PeerType != mtproto.PEER_USER {
+		err := mtproto.ErrParticipantIdInvalid
+		c.Logger.Errorf("channels.getParticipant - error: %v", err)
+		return nil, err
+	}
+
+	mChannel, err := c.svcCtx.Dao.ChannelClient.ChannelGetMutableChannel(c.ctx, &channelpb.TLChannelGetMutableChannel{
+		ChannelId: in.GetChannel().GetChannelId(),
+		Id:        []int64{c.MD.UserId, peer.PeerId},
+	})
+	if err != nil {
+		c.Logger.Errorf("channels.getParticipant - error: %v", err)
+		return nil, err
+	}
+
+	me, ok := mChannel.GetImmutableChannelParticipant(c.MD.UserId)
+	if !ok || !me.IsChatMemberStateNormal() {
+		err = mtproto.ErrChannelPrivate
+		c.Logger.Errorf("channels.getParticipant - error: %v", err)
+		return nil, err
+	}
+
+	participant, ok := mChannel.GetImmutableChannelParticipant(peer.PeerId)
+	if !ok {
+		err = mtproto.ErrUserNotParticipant
+		c.Logger.Errorf("channels.getParticipant - error: %v", err)
+		return nil, err
+	}
+
+	idList := []int64{participant.UserId}
+	for _, id := range []int64{participant.InviterUserId, participant.PromotedBy, participant.KickedBy} {
+		if id != 0 {
+			idList = append(idList, id)
+		}
+	}
+	mUsers, _ := c.svcCtx.Dao.UserClient.UserGetMutableUsers(c.ctx, &userpb.TLUserGetMutableUsers{
+		Id: idList,
+	})
+
+	return mtproto.MakeTLChannelsChannelParticipant(&mtproto.Channels_ChannelParticipant{
+		Participant: participant.ToChannelParticipant(c.MD.UserId),
+		Chats:       []*mtproto.Chat{mChannel.ToUnsafeChat(c.MD.UserId)},
+		Users:       mUsers.GetUserListByIdList(c.MD.UserId, idList...),
+	}).To_Channels_ChannelParticipant(), nil
+}
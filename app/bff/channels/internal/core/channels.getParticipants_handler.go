@@ -0,0 +1,99 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	channelpb "github.com/teamgram/teamgram-server/app/service/biz/channel/channel"
+	userpb "github.com/teamgram/teamgram-server/app/service/biz/user/user"
+)
+
+// ChannelsGetParticipants
+// channels.getParticipants#77ced9d0 channel:InputChannel filter:ChannelParticipantsFilter offset:int limit:int hash:long = channels.ChannelParticipants;
+func (c *ChannelsCore) ChannelsGetParticipants(in *mtproto.TLChannelsGetParticipants) (*mtproto.Channels_ChannelParticipants, error) {
+	if in.GetChannel().GetPredicateName() != mtproto.Predicate_inputChannel {
+		err := mtproto.ErrChannelInvalid
+		c.Logger.Errorf("channels.getParticipants - error: %v", err)
+		return nil, err
+	}
+
+	channelId := in.GetChannel().GetChannelId()
+	mChannel, err := c.svcCtx.Dao.ChannelClient.ChannelGetMutableChannel(c.ctx, &channelpb.TLChannelGetMutableChannel{
+		ChannelId: channelId,
+		Id:        []int64{c.MD.UserId},
+	})
+	if err != nil {
+		c.Logger.Errorf("channels.getParticipants - error: %v", err)
+		return nil, err
+	}
+
+	me, ok := mChannel.GetImmutableChannelParticipant(c.MD.UserId)
+	if !ok || !me.IsChatMemberStateNormal() {
+		err = mtproto.ErrChannelPrivate
+		c.Logger.Errorf("channels.getParticipants - error: %v", err)
+		return nil, err
+	}
+
+	// only admins can list the subscribers of a broadcast channel
+	if mChannel.Broadcast() && !me.IsChatMemberCreator() && !me.IsChatMemberAdmin() {
+		err = mtproto.ErrChatAdminRequired
+		c.Logger.Errorf("channels.getParticipants - error: %v", err)
+		return nil, err
+	}
+
+	participants, err := c.svcCtx.Dao.ChannelClient.ChannelGetChannelParticipants(c.ctx, &channelpb.TLChannelGetChannelParticipants{
+		ChannelId: channelId,
+		Filter:    in.GetFilter(),
+		Offset:    in.GetOffset(),
+		Limit:     in.GetLimit(),
+	})
+	if err != nil {
+		c.Logger.Errorf("channels.getParticipants - error: %v", err)
+		return nil, err
+	}
+
+	rValues := mtproto.MakeTLChannelsChannelParticipants(&mtproto.Channels_ChannelParticipants{
+		Count:        participants.GetCount(),
+		Participants: make([]*mtproto.ChannelParticipant, 0, len(participants.GetParticipants())),
+		Chats:        []*mtproto.Chat{mChannel.ToUnsafeChat(c.MD.UserId)},
+		Users:        []*mtproto.User{},
+	}).To_Channels_ChannelParticipants()
+
+	idHelper := mtproto.NewIDListHelper(c.MD.UserId)
+	for _, participant := range participants.GetParticipants() {
+		rValues.Participants = append(rValues.Participants, participant.ToChannelParticipant(c.MD.UserId))
+		idHelper.AppendUsers(participant.UserId, participant.InviterUserId, participant.PromotedBy, participant.KickedBy)
+	}
+
+	idHelper.Visit(
+		func(userIdList []int64) {
+			mUsers, _ := c.svcCtx.Dao.UserClient.UserGetMutableUsers(
+				c.ctx,
+				&userpb.TLUserGetMutableUsers{
+					Id: userIdList,
+				})
+			rValues.Users = append(rValues.Users, mUsers.GetUserListByIdList(c.MD.UserId, userIdList...)...)
+		},
+		func(chatIdList []int64) {
+		},
+		func(channelIdList []int64) {
+		})
+
+	return rValues, nil
+}
@@ -0,0 +1,149 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	channelpb "github.com/teamgram/teamgram-server/app/service/biz/channel/channel"
+	"github.com/teamgram/teamgram-server/app/service/biz/dialog/dialog"
+	userpb "github.com/teamgram/teamgram-server/app/service/biz/user/user"
+)
+
+// ChannelsInviteToChannel
+// channels.inviteToChannel#199f3a6c channel:InputChannel users:Vector<InputUser> = Updates;
+func (c *ChannelsCore) ChannelsInviteToChannel(in *mtproto.TLChannelsInviteToChannel) (*mtproto.Updates, error) {
+	if in.GetChannel().GetPredicateName() != mtproto.Predicate_inputChannel {
+		err := mtproto.ErrChannelInvalid
+		c.Logger.Errorf("channels.inviteToChannel - error: %v", err)
+		return nil, err
+	}
+
+	var (
+		channelId = in.GetChannel().GetChannelId()
+		idList    = make([]int64, 0, len(in.GetUsers()))
+	)
+
+	for _, u := range in.GetUsers() {
+		peer := mtproto.FromInputUser(c.MD.UserId, u)
+		if peer.PeerType != mtproto.PEER_USER || peer.PeerId == c.MD.UserId {
+			err := mtproto.ErrUserIdInvalid
+			c.Logger.Errorf("channels.inviteToChannel - error: %v", err)
+			return nil, err
+		}
+		idList = append(idList, peer.PeerId)
+	}
+	if len(idList) == 0 {
+		err := mtproto.ErrUsersTooFew
+		c.Logger.Errorf("channels.inviteToChannel - error: %v", err)
+		return nil, err
+	}
+
+	mUsers, _ := c.svcCtx.Dao.UserClient.UserGetMutableUsers(c.ctx, &userpb.TLUserGetMutableUsers{
+		Id: idList,
+	})
+	for _, id := range idList {
+		if _, ok := mUsers.GetImmutableUser(id); !ok {
+			err := mtproto.ErrUserIdInvalid
+			c.Logger.Errorf("channels.inviteToChannel - error: %v", err)
+			return nil, err
+		}
+
+		rules, _ := c.svcCtx.Dao.UserClient.UserGetPrivacy(c.ctx, &userpb.TLUserGetPrivacy{
+			UserId:  id,
+			KeyType: userpb.CHAT_INVITE,
+		})
+		if len(rules.GetDatas()) == 0 {
+			continue
+		}
+		allowInvite := userpb.CheckPrivacyIsAllow(
+			id,
+			rules.Datas,
+			c.MD.UserId,
+			func(id, checkId int64) bool {
+				contact, _ := c.svcCtx.Dao.UserClient.UserCheckContact(c.ctx, &userpb.TLUserCheckContact{
+					UserId: id,
+					Id:     checkId,
+				})
+				return mtproto.FromBool(contact)
+			},
+			func(checkId int64, idList []int64) bool {
+				// TODO: allow the participants of the chats in the rule
+				return false
+			})
+		if !allowInvite {
+			err := mtproto.ErrUserPrivacyRestricted
+			c.Logger.Errorf("channels.inviteToChannel - error: %v", err)
+			return nil, err
+		}
+	}
+
+	mChannel, err := c.svcCtx.Dao.ChannelClient.ChannelGetMutableChannel(c.ctx, &channelpb.TLChannelGetMutableChannel{
+		ChannelId: channelId,
+		Id:        idList,
+	})
+	if err != nil {
+		c.Logger.Errorf("channels.inviteToChannel - error: %v", err)
+		return nil, err
+	}
+	joined := make(map[int64]bool, len(idList))
+	for _, id := range idList {
+		if participant, ok := mChannel.GetImmutableChannelParticipant(id); ok && participant.IsChatMemberStateNormal() {
+			joined[id] = true
+		}
+	}
+
+	mChannel, err = c.svcCtx.Dao.ChannelClient.ChannelInviteToChannel(c.ctx, &channelpb.TLChannelInviteToChannel{
+		ChannelId: channelId,
+		InviterId: c.MD.UserId,
+		Id:        idList,
+	})
+	if err != nil {
+		c.Logger.Errorf("channels.inviteToChannel - error: %v", err)
+		return nil, err
+	}
+
+	// skip who was a participant already or is still kicked, the inviter can't unban
+	addedList := make([]int64, 0, len(idList))
+	for _, id := range idList {
+		if joined[id] {
+			continue
+		}
+		if participant, ok := mChannel.GetImmutableChannelParticipant(id); ok && participant.IsChatMemberStateNormal() {
+			addedList = append(addedList, id)
+			c.svcCtx.Dao.DialogClient.DialogInsertOrUpdateDialog(c.ctx, &dialog.TLDialogInsertOrUpdateDialog{
+				UserId:   id,
+				PeerType: mtproto.PEER_CHANNEL,
+				PeerId:   channelId,
+			})
+		}
+	}
+	c.pushUpdateChannel(mChannel, addedList...)
+
+	if !mChannel.Megagroup() || len(addedList) == 0 {
+		return c.makeUpdateChannelUpdates(mChannel, c.MD.UserId), nil
+	}
+
+	rUpdates, err := c.sendMessageService(mChannel, mtproto.MakeMessageActionChatAddUser(addedList...))
+	if err != nil {
+		c.Logger.Errorf("channels.inviteToChannel - error: %v", err)
+		return nil, err
+	}
+
+	return rUpdates, nil
+}
@@ -0,0 +1,86 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	channelpb "github.com/teamgram/teamgram-server/app/service/biz/channel/channel"
+	"github.com/teamgram/teamgram-server/app/service/biz/dialog/dialog"
+)
+
+// ChannelsJoinChannel
+// channels.joinChannel#24b524c5 channel:InputChannel = Updates;
+func (c *ChannelsCore) ChannelsJoinChannel(in *mtproto.TLChannelsJoinChannel) (*mtproto.Updates, error) {
+	if in.GetChannel().GetPredicateName() != mtproto.Predicate_inputChannel {
+		err := mtproto.ErrChannelInvalid
+		c.Logger.Errorf("channels.joinChannel - error: %v", err)
+		return nil, err
+	}
+
+	channelId := in.GetChannel().GetChannelId()
+	mChannel, err := c.svcCtx.Dao.ChannelClient.ChannelGetMutableChannel(c.ctx, &channelpb.TLChannelGetMutableChannel{
+		ChannelId: channelId,
+		Id:        []int64{c.MD.UserId},
+	})
+	if err != nil {
+		c.Logger.Errorf("channels.joinChannel - error: %v", err)
+		return nil, err
+	}
+
+	// the access_hash is only known to who has seen the channel
+	if in.GetChannel().GetAccessHash() != mChannel.AccessHash() {
+		err = mtproto.ErrChannelPrivate
+		c.Logger.Errorf("channels.joinChannel - error: %v", err)
+		return nil, err
+	}
+
+	mChannel, err = c.svcCtx.Dao.ChannelClient.ChannelJoinChannel(c.ctx, &channelpb.TLChannelJoinChannel{
+		ChannelId: channelId,
+		UserId:    c.MD.UserId,
+	})
+	if err != nil {
+		c.Logger.Errorf("channels.joinChannel - error: %v", err)
+		return nil, err
+	}
+
+	_, err = c.svcCtx.Dao.DialogClient.DialogInsertOrUpdateDialog(c.ctx, &dialog.TLDialogInsertOrUpdateDialog{
+		UserId:   c.MD.UserId,
+		PeerType: mtproto.PEER_CHANNEL,
+		PeerId:   channelId,
+	})
+	if err != nil {
+		c.Logger.Errorf("channels.joinChannel - error: %v", err)
+		return nil, err
+	}
+
+	if !mChannel.Megagroup() {
+		return c.makeUpdateChannelUpdates(mChannel, c.MD.UserId), nil
+	}
+
+	rUpdates, err := c.sendMessageService(mChannel, mtproto.MakeMessageActionChatAddUser(c.MD.UserId))
+	if err != nil {
+		c.Logger.Errorf("channels.joinChannel - error: %v", err)
+		return nil, err
+	}
+	rUpdates.Updates = append(rUpdates.Updates, mtproto.MakeTLUpdateChannel(&mtproto.Update{
+		ChannelId: channelId,
+	}).To_Update())
+
+	return rUpdates, nil
+}
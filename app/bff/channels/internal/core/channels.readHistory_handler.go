@@ -0,0 +1,92 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/messenger/sync/sync"
+	channelpb "github.com/teamgram/teamgram-server/app/service/biz/channel/channel"
+	"github.com/teamgram/teamgram-server/app/service/biz/dialog/dialog"
+)
+
+// ChannelsReadHistory
+// channels.readHistory#cc104937 channel:InputChannel max_id:int = Bool;
+func (c *ChannelsCore) ChannelsReadHistory(in *mtproto.TLChannelsReadHistory) (*mtproto.Bool, error) {
+	if in.GetChannel().GetPredicateName() != mtproto.Predicate_inputChannel {
+		err := mtproto.ErrChannelInvalid
+		c.Logger.Errorf("channels.readHistory - error: %v", err)
+		return nil, err
+	}
+
+	channelId := in.GetChannel().GetChannelId()
+	mChannel, err := c.svcCtx.Dao.ChannelClient.ChannelGetMutableChannel(c.ctx, &channelpb.TLChannelGetMutableChannel{
+		ChannelId: channelId,
+		Id:        []int64{c.MD.UserId},
+	})
+	if err != nil {
+		c.Logger.Errorf("channels.readHistory - error: %v", err)
+		return nil, err
+	}
+
+	me, ok := mChannel.GetImmutableChannelParticipant(c.MD.UserId)
+	if !ok || !me.IsChatMemberStateNormal() {
+		err = mtproto.ErrChannelPrivate
+		c.Logger.Errorf("channels.readHistory - error: %v", err)
+		return nil, err
+	}
+
+	maxId := in.GetMaxId()
+	if maxId == 0 || maxId > mChannel.TopMessage() {
+		maxId = mChannel.TopMessage()
+	}
+	if maxId <= me.ReadInboxMaxId {
+		return mtproto.BoolTrue, nil
+	}
+
+	_, err = c.svcCtx.Dao.ChannelClient.ChannelReadHistory(c.ctx, &channelpb.TLChannelReadHistory{
+		UserId:    c.MD.UserId,
+		ChannelId: channelId,
+		MaxId:     maxId,
+	})
+	if err != nil {
+		c.Logger.Errorf("channels.readHistory - error: %v", err)
+		return nil, err
+	}
+
+	c.svcCtx.Dao.DialogClient.DialogUpdateReadInbox(c.ctx, &dialog.TLDialogUpdateReadInbox{
+		UserId:      c.MD.UserId,
+		PeerType:    mtproto.PEER_CHANNEL,
+		PeerId:      channelId,
+		ReadInboxId: maxId,
+	})
+
+	c.svcCtx.Dao.SyncClient.SyncUpdatesNotMe(c.ctx, &sync.TLSyncUpdatesNotMe{
+		UserId:    c.MD.UserId,
+		AuthKeyId: c.MD.AuthId,
+		Updates: mtproto.MakeUpdatesByUpdates(mtproto.MakeTLUpdateReadChannelInbox(&mtproto.Update{
+			FolderId:         nil,
+			ChannelId:        channelId,
+			MaxId:            maxId,
+			StillUnreadCount: mChannel.TopMessage() - maxId,
+			Pts_INT32:        mChannel.Pts(),
+		}).To_Update()),
+	})
+
+	return mtproto.BoolTrue, nil
+}
@@ -0,0 +1,67 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/messenger/sync/sync"
+	channelpb "github.com/teamgram/teamgram-server/app/service/biz/channel/channel"
+)
+
+// ChannelsReadMessageContents
+// channels.readMessageContents#eab5dc38 channel:InputChannel id:Vector<int> = Bool;
+func (c *ChannelsCore) ChannelsReadMessageContents(in *mtproto.TLChannelsReadMessageContents) (*mtproto.Bool, error) {
+	if in.GetChannel().GetPredicateName() != mtproto.Predicate_inputChannel {
+		err := mtproto.ErrChannelInvalid
+		c.Logger.Errorf("channels.readMessageContents - error: %v", err)
+		return nil, err
+	}
+
+	channelId := in.GetChannel().GetChannelId()
+	mChannel, err := c.svcCtx.Dao.ChannelClient.ChannelGetMutableChannel(c.ctx, &channelpb.TLChannelGetMutableChannel{
+		ChannelId: channelId,
+		Id:        []int64{c.MD.UserId},
+	})
+	if err != nil {
+		c.Logger.Errorf("channels.readMessageContents - error: %v", err)
+		return nil, err
+	}
+
+	me, ok := mChannel.GetImmutableChannelParticipant(c.MD.UserId)
+	if !ok || !me.IsChatMemberStateNormal() {
+		err = mtproto.ErrChannelPrivate
+		c.Logger.Errorf("channels.readMessageContents - error: %v", err)
+		return nil, err
+	}
+	if len(in.GetId()) == 0 {
+		return mtproto.BoolTrue, nil
+	}
+
+	// the contents are read per user, only the other sessions of the user are notified
+	c.svcCtx.Dao.SyncClient.SyncUpdatesNotMe(c.ctx, &sync.TLSyncUpdatesNotMe{
+		UserId:    c.MD.UserId,
+		AuthKeyId: c.MD.AuthId,
+		Updates: mtproto.MakeUpdatesByUpdates(mtproto.MakeTLUpdateChannelReadMessagesContents(&mtproto.Update{
+			ChannelId: channelId,
+			Messages:  in.GetId(),
+		}).To_Update()),
+	})
+
+	return mtproto.BoolTrue, nil
+}
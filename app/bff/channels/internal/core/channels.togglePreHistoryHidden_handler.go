@@ -0,0 +1,46 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	channelpb "github.com/teamgram/teamgram-server/app/service/biz/channel/channel"
+)
+
+// ChannelsTogglePreHistoryHidden
+// channels.togglePreHistoryHidden#eabbb94c channel:InputChannel enabled:Bool = Updates;
+func (c *ChannelsCore) ChannelsTogglePreHistoryHidden(in *mtproto.TLChannelsTogglePreHistoryHidden) (*mtproto.Updates, error) {
+	if in.GetChannel().GetPredicateName() != mtproto.Predicate_inputChannel {
+		err := mtproto.ErrChannelInvalid
+		c.Logger.Errorf("channels.togglePreHistoryHidden - error: %v", err)
+		return nil, err
+	}
+
+	mChannel, err := c.svcCtx.Dao.ChannelClient.ChannelTogglePreHistoryHidden(c.ctx, &channelpb.TLChannelTogglePreHistoryHidden{
+		ChannelId:  in.GetChannel().GetChannelId(),
+		OperatorId: c.MD.UserId,
+		Enabled:    in.GetEnabled(),
+	})
+	if err != nil {
+		c.Logger.Errorf("channels.togglePreHistoryHidden - error: %v", err)
+		return nil, err
+	}
+
+	return c.broadcastUpdateChannel(mChannel), nil
+}
@@ -0,0 +1,150 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"math/rand"
+
+	"github.com/teamgram/proto/mtproto"
+	msgpb "github.com/teamgram/teamgram-server/app/messenger/msg/msg/msg"
+	"github.com/teamgram/teamgram-server/app/messenger/sync/sync"
+	channelpb "github.com/teamgram/teamgram-server/app/service/biz/channel/channel"
+)
+
+// makeUpdateChannelUpdates the reply of the participant changes which have no service message,
+// the client refetches the channel on updateChannel.
+func (c *ChannelsCore) makeUpdateChannelUpdates(mChannel *channelpb.MutableChannel, userId int64) *mtproto.Updates {
+	return mtproto.MakeUpdatesByUpdatesChats(
+		[]*mtproto.Chat{mChannel.ToUnsafeChat(userId)},
+		mtproto.MakeTLUpdateChannel(&mtproto.Update{
+			ChannelId: mChannel.Id(),
+		}).To_Update())
+}
+
+// pushUpdateChannel notifies the other users whose participant was changed by c.MD.UserId.
+func (c *ChannelsCore) pushUpdateChannel(mChannel *channelpb.MutableChannel, idList ...int64) {
+	for _, id := range idList {
+		if id == c.MD.UserId {
+			continue
+		}
+		c.svcCtx.Dao.SyncClient.SyncPushUpdates(c.ctx, &sync.TLSyncPushUpdates{
+			UserId:  id,
+			Updates: c.makeUpdateChannelUpdates(mChannel, id),
+		})
+	}
+}
+
+// sendMessageService posts a service message to the megagroup mChannel on behalf of c.MD.UserId.
+func (c *ChannelsCore) sendMessageService(mChannel *channelpb.MutableChannel, action *mtproto.MessageAction) (*mtproto.Updates, error) {
+	return c.svcCtx.Dao.MsgClient.MsgSendMessage(c.ctx, &msgpb.TLMsgSendMessage{
+		UserId:    c.MD.UserId,
+		AuthKeyId: c.MD.AuthId,
+		PeerType:  mtproto.PEER_CHANNEL,
+		PeerId:    mChannel.Id(),
+		Message: msgpb.MakeTLOutboxMessage(&msgpb.OutboxMessage{
+			NoWebpage:    true,
+			Background:   false,
+			RandomId:     rand.Int63(),
+			Message:      mChannel.MakeMessageService(c.MD.UserId, action),
+			ScheduleDate: nil,
+		}).To_OutboxMessage(),
+	})
+}
+
+// broadcastUpdates pushes updates to the other sessions of c.MD.UserId and to the other participants
+// of mChannel, sync replaces the channel in updates by the one each participant sees.
+func (c *ChannelsCore) broadcastUpdates(mChannel *channelpb.MutableChannel, updates *mtproto.Updates) {
+	_, err := c.svcCtx.Dao.SyncClient.SyncUpdatesNotMe(c.ctx, &sync.TLSyncUpdatesNotMe{
+		UserId:    c.MD.UserId,
+		AuthKeyId: c.MD.AuthId,
+		Updates:   updates,
+	})
+	if err != nil {
+		c.Logger.Errorf("broadcastUpdates - error: %v", err)
+	}
+
+	_, err = c.svcCtx.Dao.SyncClient.SyncBroadcastUpdates(c.ctx, &sync.TLSyncBroadcastUpdates{
+		BroadcastType: sync.BroadcastTypeChannel,
+		ChatId:        mChannel.Id(),
+		ExcludeIdList: []int64{c.MD.UserId},
+		Updates:       updates,
+	})
+	if err != nil {
+		c.Logger.Errorf("broadcastUpdates - error: %v", err)
+	}
+}
+
+// broadcastUpdateChannel the reply of the channel changes which have no service message.
+func (c *ChannelsCore) broadcastUpdateChannel(mChannel *channelpb.MutableChannel) *mtproto.Updates {
+	rUpdates := c.makeUpdateChannelUpdates(mChannel, c.MD.UserId)
+	c.broadcastUpdates(mChannel, rUpdates)
+
+	return rUpdates
+}
+
+// broadcastDeleteChannelMessages notifies all the participants of mChannel of the deleted messages.
+func (c *ChannelsCore) broadcastDeleteChannelMessages(mChannel *channelpb.MutableChannel, deleted *channelpb.ChannelDeletedMessages) {
+	if deleted.GetPtsCount() == 0 {
+		return
+	}
+
+	c.broadcastUpdates(
+		mChannel,
+		mtproto.MakeUpdatesByUpdatesChats(
+			[]*mtproto.Chat{mChannel.ToUnsafeChat(c.MD.UserId)},
+			mtproto.MakeTLUpdateDeleteChannelMessages(&mtproto.Update{
+				ChannelId: mChannel.Id(),
+				Messages:  deleted.GetId(),
+				Pts_INT32: deleted.GetPts(),
+				PtsCount:  deleted.GetPtsCount(),
+			}).To_Update()))
+}
+
+// deleteHistory hides the messages up to maxId from c.MD.UserId, forEveryone deletes them for all the participants.
+func (c *ChannelsCore) deleteHistory(channelId int64, forEveryone bool, maxId int32) (*mtproto.Updates, error) {
+	mChannel, err := c.svcCtx.Dao.ChannelClient.ChannelDeleteHistory(c.ctx, &channelpb.TLChannelDeleteHistory{
+		UserId:      c.MD.UserId,
+		ChannelId:   channelId,
+		ForEveryone: forEveryone,
+		MaxId:       maxId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	me, _ := mChannel.GetImmutableChannelParticipant(c.MD.UserId)
+	rUpdates := mtproto.MakeUpdatesByUpdatesChats(
+		[]*mtproto.Chat{mChannel.ToUnsafeChat(c.MD.UserId)},
+		mtproto.MakeTLUpdateChannelAvailableMessages(&mtproto.Update{
+			ChannelId:      channelId,
+			AvailableMinId: me.GetAvailableMinId(),
+		}).To_Update())
+
+	if forEveryone {
+		c.broadcastUpdates(mChannel, rUpdates)
+	} else {
+		c.svcCtx.Dao.SyncClient.SyncUpdatesNotMe(c.ctx, &sync.TLSyncUpdatesNotMe{
+			UserId:    c.MD.UserId,
+			AuthKeyId: c.MD.AuthId,
+			Updates:   rUpdates,
+		})
+	}
+
+	return rUpdates, nil
+}
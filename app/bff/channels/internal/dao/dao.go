@@ -0,0 +1,54 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package dao
+
+import (
+	kafka "github.com/teamgram/marmota/pkg/mq"
+	"github.com/teamgram/marmota/pkg/net/rpcx"
+	"github.com/teamgram/teamgram-server/app/bff/channels/internal/config"
+	msg_client "github.com/teamgram/teamgram-server/app/messenger/msg/msg/client"
+	sync_client "github.com/teamgram/teamgram-server/app/messenger/sync/client"
+	channel_client "github.com/teamgram/teamgram-server/app/service/biz/channel/client"
+	dialog_client "github.com/teamgram/teamgram-server/app/service/biz/dialog/client"
+	user_client "github.com/teamgram/teamgram-server/app/service/biz/user/client"
+	media_client "github.com/teamgram/teamgram-server/app/service/media/client"
+	"github.com/teamgram/teamgram-server/pkg/filereference"
+)
+
+type Dao struct {
+	user_client.UserClient
+	channel_client.ChannelClient
+	msg_client.MsgClient
+	dialog_client.DialogClient
+	sync_client.SyncClient
+	media_client.MediaClient
+	FileReference *filereference.FileReference
+}
+
+func New(c config.Config) *Dao {
+	return &Dao{
+		UserClient:    user_client.NewUserClient(rpcx.GetCachedRpcClient(c.UserClient)),
+		ChannelClient: channel_client.NewChannelClient(rpcx.GetCachedRpcClient(c.ChannelClient)),
+		MsgClient:     msg_client.NewMsgClient(rpcx.GetCachedRpcClient(c.MsgClient)),
+		DialogClient:  dialog_client.NewDialogClient(rpcx.GetCachedRpcClient(c.DialogClient)),
+		SyncClient:    sync_client.NewSyncMqClient(kafka.MustKafkaProducer(c.SyncClient)),
+		MediaClient:   media_client.NewMediaClient(rpcx.GetCachedRpcClient(c.MediaClient)),
+		FileReference: filereference.New(&c.FileReference),
+	}
+}
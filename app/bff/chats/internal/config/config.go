@@ -20,11 +20,13 @@ package config
 
 import (
 	kafka "github.com/teamgram/marmota/pkg/mq"
+	"github.com/teamgram/teamgram-server/pkg/filereference/conf"
 	"github.com/zeromicro/go-zero/zrpc"
 )
 
 type Config struct {
 	zrpc.RpcServerConf
+	FileReference     conf.FileReferenceConfig
 	UserClient        zrpc.RpcClientConf
 	ChatClient        zrpc.RpcClientConf
 	MsgClient         zrpc.RpcClientConf
@@ -153,6 +153,7 @@ func (c *ChatsCore) MessagesEditChatPhoto(in *mtproto.TLMessagesEditChatPhoto) (
 		c.Logger.Errorf("messages.editChatPhoto - error: %v", err)
 		return nil, err
 	}
+	c.svcCtx.Dao.FileReference.SetUpdates(c.MD.UserId, replyUpdates)
 
 	return replyUpdates, nil
 }
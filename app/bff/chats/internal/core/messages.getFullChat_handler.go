@@ -23,6 +23,7 @@ import (
 	chatpb "github.com/teamgram/teamgram-server/app/service/biz/chat/chat"
 	"github.com/teamgram/teamgram-server/app/service/biz/dialog/dialog"
 	userpb "github.com/teamgram/teamgram-server/app/service/biz/user/user"
+	"github.com/teamgram/teamgram-server/pkg/filereference"
 )
 
 // MessagesGetFullChat
@@ -78,6 +79,7 @@ func (c *ChatsCore) MessagesGetFullChat(in *mtproto.TLMessagesGetFullChat) (*mtp
 		RecentRequesters:       nil, // TODO
 		AvailableReactions:     chat.AvailableReactions(),
 	}).To_ChatFull()
+	c.svcCtx.Dao.FileReference.SetPhoto(c.MD.UserId, chatFull.ChatPhoto, filereference.PeerPhotoContext(mtproto.PEER_CHAT, in.ChatId))
 
 	var (
 		idList []int64
@@ -31,6 +31,7 @@ import (
 	user_client "github.com/teamgram/teamgram-server/app/service/biz/user/client"
 	idgen_client "github.com/teamgram/teamgram-server/app/service/idgen/client"
 	media_client "github.com/teamgram/teamgram-server/app/service/media/client"
+	"github.com/teamgram/teamgram-server/pkg/filereference"
 )
 
 type Dao struct {
@@ -43,6 +44,7 @@ type Dao struct {
 	authsession_client.AuthsessionClient
 	idgen_client.IDGenClient2
 	message_client.MessageClient
+	FileReference *filereference.FileReference
 }
 
 func New(c config.Config) *Dao {
@@ -56,5 +58,6 @@ func New(c config.Config) *Dao {
 		AuthsessionClient: authsession_client.NewAuthsessionClient(rpcx.GetCachedRpcClient(c.AuthsessionClient)),
 		IDGenClient2:      idgen_client.NewIDGenClient2(rpcx.GetCachedRpcClient(c.IdgenClient)),
 		MessageClient:     message_client.NewMessageClient(rpcx.GetCachedRpcClient(c.MessageClient)),
+		FileReference:     filereference.New(&c.FileReference),
 	}
 }
@@ -20,16 +20,20 @@ package config
 
 import (
 	kafka "github.com/teamgram/marmota/pkg/mq"
+	"github.com/teamgram/teamgram-server/pkg/filereference/conf"
 	"github.com/zeromicro/go-zero/zrpc"
 )
 
 type Config struct {
 	zrpc.RpcServerConf
+	FileReference conf.FileReferenceConfig
+
 	UserClient    zrpc.RpcClientConf
 	ChatClient    zrpc.RpcClientConf
 	DialogClient  zrpc.RpcClientConf
 	UpdatesClient zrpc.RpcClientConf
 	SyncClient    *kafka.KafkaProducerConf
 	MessageClient zrpc.RpcClientConf
+	IdgenClient   zrpc.RpcClientConf
 	// ChannelClient zrpc.RpcClientConf
 }
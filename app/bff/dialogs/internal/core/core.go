@@ -146,6 +146,7 @@ func (c *DialogsCore) makeMessagesDialogs(dialogExtList dialog.DialogExtList) *d
 		})
 		dialogsData.Dialogs = append(dialogsData.Dialogs, dialogExt.Dialog)
 	}
+	c.svcCtx.Dao.FileReference.SetMessages(c.MD.UserId, dialogsData.Messages...)
 
 	idHelper.Visit(
 		func(userIdList []int64) {
@@ -0,0 +1,175 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"math"
+	"time"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/bff/dialogs/internal/model"
+	"github.com/teamgram/teamgram-server/app/service/biz/dialog/dialog"
+	userpb "github.com/teamgram/teamgram-server/app/service/biz/user/user"
+)
+
+// getDialogFilter returns the dialog filter filterId of me.
+func (c *DialogsCore) getDialogFilter(filterId int32) (*model.DialogFilter, error) {
+	filters, err := c.svcCtx.Dao.DialogClient.DialogGetDialogFilters(c.ctx, &dialog.TLDialogGetDialogFilters{
+		UserId: c.MD.UserId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, v := range filters.GetDatas() {
+		if v.Id == filterId {
+			return model.NewDialogFilter(c.MD.UserId, v.DialogFilter), nil
+		}
+	}
+
+	return nil, mtproto.ErrFolderIdInvalid
+}
+
+// getDialogFilterDialogList returns the dialogs of the main list and the archive, the dialog
+// filter is applied later by filterDialogList when the channel dialogs are up to date.
+func (c *DialogsCore) getDialogFilterDialogList() (dialog.DialogExtList, error) {
+	var (
+		dialogExtList dialog.DialogExtList
+	)
+
+	for _, folderId := range []int32{0, 1} {
+		dialogs, err := c.svcCtx.Dao.DialogClient.DialogGetDialogs(c.ctx, &dialog.TLDialogGetDialogs{
+			UserId:        c.MD.UserId,
+			ExcludePinned: mtproto.BoolFalse,
+			FolderId:      folderId,
+		})
+		if err != nil {
+			return nil, err
+		}
+		dialogExtList = append(dialogExtList, dialogs.GetDatas()...)
+	}
+
+	return dialogExtList, nil
+}
+
+// filterDialogList keeps the dialogs in the dialog filter, the pinned peers of the filter
+// are pinned in their order and are dropped if excludePinned.
+func (c *DialogsCore) filterDialogList(filter *model.DialogFilter, dialogExtList dialog.DialogExtList, excludePinned bool) dialog.DialogExtList {
+	var (
+		now           = int32(time.Now().Unix())
+		contacts      = make(map[int64]struct{})
+		muted         = make(map[int64]struct{})
+		bots          = make(map[int64]struct{})
+		broadcasts    = make(map[int64]struct{})
+		userIdList    []int64
+		channelIdList []int64
+	)
+
+	for _, dialogExt := range dialogExtList {
+		peer := mtproto.FromPeer(dialogExt.GetDialog().GetPeer())
+		switch peer.PeerType {
+		case mtproto.PEER_USER:
+			userIdList = append(userIdList, peer.PeerId)
+		case mtproto.PEER_CHANNEL:
+			channelIdList = append(channelIdList, peer.PeerId)
+		}
+	}
+
+	if filter.Contacts || filter.NonContacts {
+		idList, _ := c.svcCtx.Dao.UserClient.UserGetContactIdList(c.ctx, &userpb.TLUserGetContactIdList{
+			UserId: c.MD.UserId,
+		})
+		for _, id := range idList.GetDatas() {
+			contacts[id] = struct{}{}
+		}
+	}
+
+	if filter.ExcludeMuted {
+		settingsList, _ := c.svcCtx.Dao.UserClient.UserGetAllNotifySettings(c.ctx, &userpb.TLUserGetAllNotifySettings{
+			UserId: c.MD.UserId,
+		})
+		for _, v := range settingsList.GetDatas() {
+			if v.GetSettings().GetMuteUntil().GetValue() > now {
+				muted[mtproto.MakePeerDialogId(v.PeerType, v.PeerId)] = struct{}{}
+			}
+		}
+	}
+
+	if len(userIdList) > 0 {
+		users, _ := c.svcCtx.Dao.UserClient.UserGetMutableUsers(c.ctx, &userpb.TLUserGetMutableUsers{
+			Id: userIdList,
+		})
+		users.Visit(func(it *userpb.ImmutableUser) {
+			if it.IsBot() {
+				bots[it.Id()] = struct{}{}
+			}
+		})
+	}
+
+	if len(channelIdList) > 0 && c.svcCtx.Plugin != nil {
+		for _, channel := range c.svcCtx.Plugin.GetChannelListByIdList(c.ctx, c.MD.UserId, channelIdList...) {
+			if channel.GetBroadcast() {
+				broadcasts[channel.GetId()] = struct{}{}
+			}
+		}
+	}
+
+	filtered := make(dialog.DialogExtList, 0, len(dialogExtList))
+	for _, dialogExt := range dialogExtList {
+		var (
+			dialog2 = dialogExt.GetDialog()
+			peer    = mtproto.FromPeer(dialog2.GetPeer())
+			id      = mtproto.MakePeerDialogId(peer.PeerType, peer.PeerId)
+		)
+
+		filterPeer := &model.DialogFilterPeer{
+			PeerType: peer.PeerType,
+			PeerId:   peer.PeerId,
+			Unread:   dialog2.UnreadCount > 0 || dialog2.UnreadMark,
+			Archived: dialog2.GetFolderId().GetValue() == 1,
+		}
+		switch peer.PeerType {
+		case mtproto.PEER_USER:
+			_, filterPeer.Contact = contacts[peer.PeerId]
+			filterPeer.Contact = filterPeer.Contact || peer.PeerId == c.MD.UserId
+			_, filterPeer.Bot = bots[peer.PeerId]
+		case mtproto.PEER_CHANNEL:
+			_, filterPeer.Broadcast = broadcasts[peer.PeerId]
+			filterPeer.Unread = filterPeer.Unread || dialog2.TopMessage > dialog2.ReadInboxMaxId
+		}
+		_, filterPeer.Muted = muted[id]
+
+		if !filter.Match(filterPeer) {
+			continue
+		}
+
+		if idx := filter.PinnedIndex(peer.PeerType, peer.PeerId); idx >= 0 {
+			if excludePinned {
+				continue
+			}
+			dialog2.Pinned = true
+			dialogExt.Order = math.MaxInt64 - int64(idx)
+		} else {
+			dialog2.Pinned = false
+		}
+		filtered = append(filtered, dialogExt)
+	}
+
+	return filtered
+}
@@ -0,0 +1,63 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/biz/dialog/dialog"
+)
+
+// FoldersDeleteFolder
+// folders.deleteFolder#1c295881 folder_id:int = Updates;
+func (c *DialogsCore) FoldersDeleteFolder(in *mtproto.TLFoldersDeleteFolder) (*mtproto.Updates, error) {
+	// the archive is the only folder, deleting it moves its dialogs back to the main list
+	if in.GetFolderId() != 1 {
+		err := mtproto.ErrFolderIdInvalid
+		c.Logger.Errorf("folders.deleteFolder - error: %v", err)
+		return nil, err
+	}
+
+	dialogs, err := c.svcCtx.Dao.DialogClient.DialogGetDialogs(c.ctx, &dialog.TLDialogGetDialogs{
+		UserId:        c.MD.UserId,
+		ExcludePinned: mtproto.BoolFalse,
+		FolderId:      in.GetFolderId(),
+	})
+	if err != nil {
+		c.Logger.Errorf("folders.deleteFolder - error: %v", err)
+		return nil, err
+	} else if len(dialogs.GetDatas()) == 0 {
+		return mtproto.MakeEmptyUpdates(), nil
+	}
+
+	folderPeers := make([]*mtproto.FolderPeer, 0, len(dialogs.GetDatas()))
+	for _, v := range dialogs.GetDatas() {
+		folderPeers = append(folderPeers, mtproto.MakeTLFolderPeer(&mtproto.FolderPeer{
+			Peer:     v.GetDialog().GetPeer(),
+			FolderId: 0,
+		}).To_FolderPeer())
+	}
+
+	rUpdates, err := c.editPeerFolders(folderPeers)
+	if err != nil {
+		c.Logger.Errorf("folders.deleteFolder - error: %v", err)
+		return nil, err
+	}
+
+	return rUpdates, nil
+}
@@ -0,0 +1,133 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/messenger/sync/sync"
+	chatpb "github.com/teamgram/teamgram-server/app/service/biz/chat/chat"
+	"github.com/teamgram/teamgram-server/app/service/biz/dialog/dialog"
+	userpb "github.com/teamgram/teamgram-server/app/service/biz/user/user"
+)
+
+// FoldersEditPeerFolders
+// folders.editPeerFolders#6847d0ab folder_peers:Vector<InputFolderPeer> = Updates;
+func (c *DialogsCore) FoldersEditPeerFolders(in *mtproto.TLFoldersEditPeerFolders) (*mtproto.Updates, error) {
+	folderPeers := make([]*mtproto.FolderPeer, 0, len(in.GetFolderPeers()))
+
+	for _, v := range in.GetFolderPeers() {
+		if v.GetFolderId() != 0 && v.GetFolderId() != 1 {
+			err := mtproto.ErrFolderIdInvalid
+			c.Logger.Errorf("folders.editPeerFolders - error: %v", err)
+			return nil, err
+		}
+
+		peer := mtproto.FromInputPeer2(c.MD.UserId, v.GetPeer())
+		switch peer.PeerType {
+		case mtproto.PEER_SELF, mtproto.PEER_USER, mtproto.PEER_CHAT, mtproto.PEER_CHANNEL:
+		default:
+			err := mtproto.ErrPeerIdInvalid
+			c.Logger.Errorf("folders.editPeerFolders - error: %v", err)
+			return nil, err
+		}
+
+		folderPeers = append(folderPeers, mtproto.MakeTLFolderPeer(&mtproto.FolderPeer{
+			Peer:     peer.ToPeer(),
+			FolderId: v.GetFolderId(),
+		}).To_FolderPeer())
+	}
+
+	rUpdates, err := c.editPeerFolders(folderPeers)
+	if err != nil {
+		c.Logger.Errorf("folders.editPeerFolders - error: %v", err)
+		return nil, err
+	}
+
+	return rUpdates, nil
+}
+
+// editPeerFolders moves the peers to their folders, other sessions receive updateFolderPeers.
+func (c *DialogsCore) editPeerFolders(folderPeers []*mtproto.FolderPeer) (*mtproto.Updates, error) {
+	var (
+		peerDialogLists = make(map[int32][]int64)
+		idHelper        = mtproto.NewIDListHelper(c.MD.UserId)
+	)
+
+	for _, v := range folderPeers {
+		peer := mtproto.FromPeer(v.GetPeer())
+		if peer.IsChannel() && c.svcCtx.Plugin == nil {
+			c.Logger.Errorf("editPeerFolders blocked, License key from https://teamgram.net required to unlock enterprise features.")
+			return nil, mtproto.ErrEnterpriseIsBlocked
+		}
+		peerDialogLists[v.GetFolderId()] = append(peerDialogLists[v.GetFolderId()], mtproto.MakePeerDialogId(peer.PeerType, peer.PeerId))
+		idHelper.PickByPeerUtil(peer.PeerType, peer.PeerId)
+	}
+
+	for _, folderId := range []int32{0, 1} {
+		if len(peerDialogLists[folderId]) == 0 {
+			continue
+		}
+		_, err := c.svcCtx.Dao.DialogClient.DialogEditPeerFolders(c.ctx, &dialog.TLDialogEditPeerFolders{
+			UserId:         c.MD.UserId,
+			PeerDialogList: peerDialogLists[folderId],
+			FolderId:       folderId,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	rUpdates := mtproto.MakeUpdatesByUpdates(mtproto.MakeTLUpdateFolderPeers(&mtproto.Update{
+		FolderPeers: folderPeers,
+		Pts_INT32:   c.svcCtx.Dao.IDGenClient2.NextPtsId(c.ctx, c.MD.UserId),
+		PtsCount:    1,
+	}).To_Update())
+
+	idHelper.Visit(
+		func(userIdList []int64) {
+			users, _ := c.svcCtx.Dao.UserClient.UserGetMutableUsers(c.ctx,
+				&userpb.TLUserGetMutableUsers{
+					Id: userIdList,
+				})
+			rUpdates.PushUser(users.GetUserListByIdList(c.MD.UserId, userIdList...)...)
+		},
+		func(chatIdList []int64) {
+			chats, _ := c.svcCtx.Dao.ChatClient.ChatGetChatListByIdList(c.ctx,
+				&chatpb.TLChatGetChatListByIdList{
+					IdList: chatIdList,
+				})
+			rUpdates.PushChat(chats.GetChatListByIdList(c.MD.UserId, chatIdList...)...)
+		},
+		func(channelIdList []int64) {
+			// the channels were rejected above without the plugin
+			rUpdates.PushChat(c.svcCtx.Plugin.GetChannelListByIdList(c.ctx, c.MD.UserId, channelIdList...)...)
+		})
+
+	// sync stores updateFolderPeers in the pts queue of the user, getDifference replays it
+	_, err := c.svcCtx.Dao.SyncClient.SyncUpdatesNotMe(c.ctx, &sync.TLSyncUpdatesNotMe{
+		UserId:    c.MD.UserId,
+		AuthKeyId: c.MD.AuthId,
+		Updates:   rUpdates,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return rUpdates, nil
+}
@@ -0,0 +1,46 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/biz/dialog/dialog"
+)
+
+// MessagesGetDialogFilters
+// messages.getDialogFilters#f19ed96d = Vector<DialogFilter>;
+func (c *DialogsCore) MessagesGetDialogFilters(in *mtproto.TLMessagesGetDialogFilters) (*mtproto.Vector_DialogFilter, error) {
+	filters, err := c.svcCtx.Dao.DialogClient.DialogGetDialogFilters(c.ctx, &dialog.TLDialogGetDialogFilters{
+		UserId: c.MD.UserId,
+	})
+	if err != nil {
+		c.Logger.Errorf("messages.getDialogFilters - error: %v", err)
+		return nil, err
+	}
+
+	rValues := &mtproto.Vector_DialogFilter{
+		Datas: make([]*mtproto.DialogFilter, 0, len(filters.GetDatas())),
+	}
+	for _, v := range filters.GetDatas() {
+		v.DialogFilter.Id = v.Id
+		rValues.Datas = append(rValues.Datas, v.DialogFilter)
+	}
+
+	return rValues, nil
+}
@@ -20,6 +20,7 @@ package core
 
 import (
 	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/bff/dialogs/internal/model"
 	"github.com/teamgram/teamgram-server/app/service/biz/dialog/dialog"
 	"math"
 	"sort"
@@ -38,15 +39,33 @@ func (c *DialogsCore) MessagesGetDialogs(in *mtproto.TLMessagesGetDialogs) (*mtp
 		limit = 500
 	}
 
-	dialogs, err := c.svcCtx.Dao.DialogClient.DialogGetDialogs(c.ctx, &dialog.TLDialogGetDialogs{
-		UserId:        c.MD.UserId,
-		ExcludePinned: mtproto.ToBool(in.ExcludePinned),
-		FolderId:      folderId,
-	})
+	var (
+		dialogFilter  *model.DialogFilter
+		dialogExtList dialog.DialogExtList
+		err           error
+	)
+
+	if folderId >= model.DialogFilterMinId {
+		// folder_id of a dialog filter
+		dialogFilter, err = c.getDialogFilter(folderId)
+		if err != nil {
+			c.Logger.Errorf("messages.getDialogs - error: %v", err)
+			return nil, err
+		}
+		dialogExtList, err = c.getDialogFilterDialogList()
+	} else {
+		var dialogs *dialog.Vector_DialogExt
+		dialogs, err = c.svcCtx.Dao.DialogClient.DialogGetDialogs(c.ctx, &dialog.TLDialogGetDialogs{
+			UserId:        c.MD.UserId,
+			ExcludePinned: mtproto.ToBool(in.ExcludePinned),
+			FolderId:      folderId,
+		})
+		dialogExtList = dialogs.GetDatas()
+	}
 	if err != nil {
 		c.Logger.Errorf("messages.getDialogs - error: %v", err)
 		return nil, err
-	} else if len(dialogs.Datas) == 0 {
+	} else if len(dialogExtList) == 0 {
 		return mtproto.MakeTLMessagesDialogsSlice(&mtproto.Messages_Dialogs{
 			Dialogs:  []*mtproto.Dialog{},
 			Messages: []*mtproto.Message{},
@@ -56,11 +75,6 @@ func (c *DialogsCore) MessagesGetDialogs(in *mtproto.TLMessagesGetDialogs) (*mtp
 		}).To_Messages_Dialogs(), nil
 	}
 
-	var (
-		dialogCount                        = int32(len(dialogs.Datas))
-		dialogExtList dialog.DialogExtList = dialogs.Datas
-	)
-
 	for _, dialogEx := range dialogExtList {
 		peer2 := dialogEx.GetDialog().GetPeer()
 
@@ -82,6 +96,11 @@ func (c *DialogsCore) MessagesGetDialogs(in *mtproto.TLMessagesGetDialogs) (*mtp
 		}
 	}
 
+	if dialogFilter != nil {
+		dialogExtList = c.filterDialogList(dialogFilter, dialogExtList, in.ExcludePinned)
+	}
+	dialogCount := int32(len(dialogExtList))
+
 	r2 := sort.Reverse(dialogExtList)
 	sort.Sort(r2)
 
@@ -0,0 +1,63 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+)
+
+// MessagesGetSuggestedDialogFilters
+// messages.getSuggestedDialogFilters#a29cd42c = Vector<DialogFilterSuggested>;
+func (c *DialogsCore) MessagesGetSuggestedDialogFilters(in *mtproto.TLMessagesGetSuggestedDialogFilters) (*mtproto.Vector_DialogFilterSuggested, error) {
+	// the suggested filters are not created yet, their id is set by the client
+	return &mtproto.Vector_DialogFilterSuggested{
+		Datas: []*mtproto.DialogFilterSuggested{
+			makeDialogFilterSuggested("Unread", "Chats with unread messages", &mtproto.DialogFilter{
+				Contacts:    true,
+				NonContacts: true,
+				Groups:      true,
+				Broadcasts:  true,
+				Bots:        true,
+				ExcludeRead: true,
+			}),
+			makeDialogFilterSuggested("Personal", "Only messages from personal chats", &mtproto.DialogFilter{
+				Contacts:    true,
+				NonContacts: true,
+			}),
+			makeDialogFilterSuggested("Groups", "Only messages from groups", &mtproto.DialogFilter{
+				Groups: true,
+			}),
+			makeDialogFilterSuggested("Channels", "Only messages from channels", &mtproto.DialogFilter{
+				Broadcasts: true,
+			}),
+		},
+	}, nil
+}
+
+func makeDialogFilterSuggested(title, description string, filter *mtproto.DialogFilter) *mtproto.DialogFilterSuggested {
+	filter.Title = title
+	filter.PinnedPeers = []*mtproto.InputPeer{}
+	filter.IncludePeers = []*mtproto.InputPeer{}
+	filter.ExcludePeers = []*mtproto.InputPeer{}
+
+	return mtproto.MakeTLDialogFilterSuggested(&mtproto.DialogFilterSuggested{
+		Filter:      mtproto.MakeTLDialogFilter(filter).To_DialogFilter(),
+		Description: description,
+	}).To_DialogFilterSuggested()
+}
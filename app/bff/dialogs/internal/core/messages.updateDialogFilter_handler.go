@@ -0,0 +1,113 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/bff/dialogs/internal/model"
+	"github.com/teamgram/teamgram-server/app/messenger/sync/sync"
+	"github.com/teamgram/teamgram-server/app/service/biz/dialog/dialog"
+)
+
+// MessagesUpdateDialogFilter
+// messages.updateDialogFilter#1ad4a04a flags:# id:int filter:flags.0?DialogFilter = Bool;
+func (c *DialogsCore) MessagesUpdateDialogFilter(in *mtproto.TLMessagesUpdateDialogFilter) (*mtproto.Bool, error) {
+	if in.GetId() < model.DialogFilterMinId {
+		err := mtproto.ErrFilterIdInvalid
+		c.Logger.Errorf("messages.updateDialogFilter - error: %v", err)
+		return nil, err
+	}
+
+	if in.GetFilter() == nil {
+		_, err := c.svcCtx.Dao.DialogClient.DialogDeleteDialogFilter(c.ctx, &dialog.TLDialogDeleteDialogFilter{
+			UserId: c.MD.UserId,
+			Id:     in.GetId(),
+		})
+		if err != nil {
+			c.Logger.Errorf("messages.updateDialogFilter - error: %v", err)
+			return nil, err
+		}
+	} else {
+		if err := model.CheckDialogFilter(in.GetId(), in.GetFilter()); err != nil {
+			c.Logger.Errorf("messages.updateDialogFilter - error: %v", err)
+			return nil, err
+		}
+
+		filters, err := c.svcCtx.Dao.DialogClient.DialogGetDialogFilters(c.ctx, &dialog.TLDialogGetDialogFilters{
+			UserId: c.MD.UserId,
+		})
+		if err != nil {
+			c.Logger.Errorf("messages.updateDialogFilter - error: %v", err)
+			return nil, err
+		}
+
+		var found bool
+		for _, v := range filters.GetDatas() {
+			if v.Id == in.GetId() {
+				found = true
+				break
+			}
+		}
+		if !found && len(filters.GetDatas()) >= model.MaxDialogFilters {
+			err = model.ErrDialogFiltersTooMuch
+			c.Logger.Errorf("messages.updateDialogFilter - error: %v", err)
+			return nil, err
+		}
+
+		_, err = c.svcCtx.Dao.DialogClient.DialogInsertOrUpdateDialogFilter(c.ctx, &dialog.TLDialogInsertOrUpdateDialogFilter{
+			UserId:       c.MD.UserId,
+			Id:           in.GetId(),
+			DialogFilter: in.GetFilter(),
+		})
+		if err != nil {
+			c.Logger.Errorf("messages.updateDialogFilter - error: %v", err)
+			return nil, err
+		}
+
+		// dialog.insertOrUpdateDialogFilter puts the filter first, an edited filter keeps
+		// its position and a new one is appended
+		order := make([]int32, 0, len(filters.GetDatas())+1)
+		for _, v := range filters.GetDatas() {
+			order = append(order, v.Id)
+		}
+		if !found {
+			order = append(order, in.GetId())
+		}
+		if len(order) > 1 {
+			_, err = c.svcCtx.Dao.DialogClient.DialogUpdateDialogFiltersOrder(c.ctx, &dialog.TLDialogUpdateDialogFiltersOrder{
+				UserId: c.MD.UserId,
+				Order:  order,
+			})
+			if err != nil {
+				c.Logger.Errorf("messages.updateDialogFilter - error: %v", err)
+			}
+		}
+	}
+
+	c.svcCtx.Dao.SyncClient.SyncUpdatesNotMe(c.ctx, &sync.TLSyncUpdatesNotMe{
+		UserId:    c.MD.UserId,
+		AuthKeyId: c.MD.AuthId,
+		Updates: mtproto.MakeUpdatesByUpdates(mtproto.MakeTLUpdateDialogFilter(&mtproto.Update{
+			Id_INT32: in.GetId(),
+			Filter:   in.GetFilter(),
+		}).To_Update()),
+	})
+
+	return mtproto.BoolTrue, nil
+}
@@ -0,0 +1,48 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/messenger/sync/sync"
+	"github.com/teamgram/teamgram-server/app/service/biz/dialog/dialog"
+)
+
+// MessagesUpdateDialogFiltersOrder
+// messages.updateDialogFiltersOrder#c563c1e4 order:Vector<int> = Bool;
+func (c *DialogsCore) MessagesUpdateDialogFiltersOrder(in *mtproto.TLMessagesUpdateDialogFiltersOrder) (*mtproto.Bool, error) {
+	_, err := c.svcCtx.Dao.DialogClient.DialogUpdateDialogFiltersOrder(c.ctx, &dialog.TLDialogUpdateDialogFiltersOrder{
+		UserId: c.MD.UserId,
+		Order:  in.GetOrder(),
+	})
+	if err != nil {
+		c.Logger.Errorf("messages.updateDialogFiltersOrder - error: %v", err)
+		return nil, err
+	}
+
+	c.svcCtx.Dao.SyncClient.SyncUpdatesNotMe(c.ctx, &sync.TLSyncUpdatesNotMe{
+		UserId:    c.MD.UserId,
+		AuthKeyId: c.MD.AuthId,
+		Updates: mtproto.MakeUpdatesByUpdates(mtproto.MakeTLUpdateDialogFilterOrder(&mtproto.Update{
+			Order_VECTORINT32: in.GetOrder(),
+		}).To_Update()),
+	})
+
+	return mtproto.BoolTrue, nil
+}
@@ -28,6 +28,8 @@ import (
 	message_client "github.com/teamgram/teamgram-server/app/service/biz/message/client"
 	updates_client "github.com/teamgram/teamgram-server/app/service/biz/updates/client"
 	user_client "github.com/teamgram/teamgram-server/app/service/biz/user/client"
+	idgen_client "github.com/teamgram/teamgram-server/app/service/idgen/client"
+	"github.com/teamgram/teamgram-server/pkg/filereference"
 )
 
 type Dao struct {
@@ -37,6 +39,8 @@ type Dao struct {
 	sync_client.SyncClient
 	updates_client.UpdatesClient
 	message_client.MessageClient
+	idgen_client.IDGenClient2
+	FileReference *filereference.FileReference
 }
 
 func New(c config.Config) *Dao {
@@ -47,5 +51,7 @@ func New(c config.Config) *Dao {
 		SyncClient:    sync_client.NewSyncMqClient(kafka.MustKafkaProducer(c.SyncClient)),
 		MessageClient: message_client.NewMessageClient(rpcx.GetCachedRpcClient(c.MessageClient)),
 		ChatClient:    chat_client.NewChatClient(rpcx.GetCachedRpcClient(c.ChatClient)),
+		IDGenClient2:  idgen_client.NewIDGenClient2(rpcx.GetCachedRpcClient(c.IdgenClient)),
+		FileReference: filereference.New(&c.FileReference),
 	}
 }
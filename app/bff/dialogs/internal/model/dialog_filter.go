@@ -0,0 +1,177 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package model
+
+import (
+	"github.com/teamgram/proto/mtproto"
+
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// DialogFilterMinId the folder_id 0 is the main list and 1 the archive,
+	// the ids of the dialog filters start from 2
+	DialogFilterMinId = 2
+
+	// MaxDialogFilters the number of dialog filters a user can have
+	MaxDialogFilters = 10
+	// MaxDialogFilterPeers the number of pinned and included peers of a filter,
+	// and separately of the excluded ones
+	MaxDialogFilterPeers = 100
+)
+
+var (
+	ErrDialogFiltersTooMuch = status.Error(mtproto.ErrBadRequest, "DIALOG_FILTERS_TOO_MUCH")
+	ErrFilterIncludeTooMuch = status.Error(mtproto.ErrBadRequest, "FILTER_INCLUDE_TOO_MUCH")
+	ErrFilterExcludeTooMuch = status.Error(mtproto.ErrBadRequest, "FILTER_EXCLUDE_TOO_MUCH")
+)
+
+// DialogFilterPeer what a dialog is matched on by a dialog filter.
+type DialogFilterPeer struct {
+	PeerType  int32
+	PeerId    int64
+	Contact   bool
+	Bot       bool
+	Broadcast bool
+	Muted     bool
+	Unread    bool
+	Archived  bool
+}
+
+// DialogFilter evaluates a dialogFilter on the dialogs of selfId.
+type DialogFilter struct {
+	*mtproto.DialogFilter
+	pinned   map[int64]int
+	included map[int64]struct{}
+	excluded map[int64]struct{}
+}
+
+func NewDialogFilter(selfId int64, filter *mtproto.DialogFilter) *DialogFilter {
+	f := &DialogFilter{
+		DialogFilter: filter,
+		pinned:       make(map[int64]int, len(filter.GetPinnedPeers())),
+		included:     make(map[int64]struct{}, len(filter.GetIncludePeers())),
+		excluded:     make(map[int64]struct{}, len(filter.GetExcludePeers())),
+	}
+
+	for i, peer := range filter.GetPinnedPeers() {
+		if id, ok := makeInputPeerDialogId(selfId, peer); ok {
+			if _, ok2 := f.pinned[id]; !ok2 {
+				f.pinned[id] = i
+			}
+		}
+	}
+	for _, peer := range filter.GetIncludePeers() {
+		if id, ok := makeInputPeerDialogId(selfId, peer); ok {
+			f.included[id] = struct{}{}
+		}
+	}
+	for _, peer := range filter.GetExcludePeers() {
+		if id, ok := makeInputPeerDialogId(selfId, peer); ok {
+			f.excluded[id] = struct{}{}
+		}
+	}
+
+	return f
+}
+
+func makeInputPeerDialogId(selfId int64, peer *mtproto.InputPeer) (int64, bool) {
+	p := mtproto.FromInputPeer2(selfId, peer)
+	switch p.PeerType {
+	case mtproto.PEER_SELF, mtproto.PEER_USER, mtproto.PEER_CHAT, mtproto.PEER_CHANNEL:
+		return mtproto.MakePeerDialogId(p.PeerType, p.PeerId), true
+	default:
+		return 0, false
+	}
+}
+
+// Match returns true if the dialog is in the filter, the pinned and the included peers are
+// always in, the excluded peers never.
+func (f *DialogFilter) Match(p *DialogFilterPeer) bool {
+	id := mtproto.MakePeerDialogId(p.PeerType, p.PeerId)
+	if _, ok := f.pinned[id]; ok {
+		return true
+	}
+	if _, ok := f.included[id]; ok {
+		return true
+	}
+	if _, ok := f.excluded[id]; ok {
+		return false
+	}
+
+	if (f.ExcludeMuted && p.Muted) ||
+		(f.ExcludeRead && !p.Unread) ||
+		(f.ExcludeArchived && p.Archived) {
+		return false
+	}
+
+	switch p.PeerType {
+	case mtproto.PEER_SELF, mtproto.PEER_USER:
+		if p.Bot {
+			return f.Bots
+		} else if p.Contact {
+			return f.Contacts
+		}
+		return f.NonContacts
+	case mtproto.PEER_CHAT:
+		return f.Groups
+	case mtproto.PEER_CHANNEL:
+		if p.Broadcast {
+			return f.Broadcasts
+		}
+		return f.Groups
+	default:
+		return false
+	}
+}
+
+// PinnedIndex returns the position of the dialog in pinned_peers or -1.
+func (f *DialogFilter) PinnedIndex(peerType int32, peerId int64) int {
+	if i, ok := f.pinned[mtproto.MakePeerDialogId(peerType, peerId)]; ok {
+		return i
+	}
+	return -1
+}
+
+// CheckDialogFilter checks the filter of messages.updateDialogFilter.
+func CheckDialogFilter(id int32, filter *mtproto.DialogFilter) error {
+	if id < DialogFilterMinId || filter.GetId() != id {
+		return mtproto.ErrFilterIdInvalid
+	}
+	if filter.GetTitle() == "" {
+		return mtproto.ErrFilterTitleEmpty
+	}
+	if !filter.GetContacts() &&
+		!filter.GetNonContacts() &&
+		!filter.GetGroups() &&
+		!filter.GetBroadcasts() &&
+		!filter.GetBots() &&
+		len(filter.GetIncludePeers()) == 0 &&
+		len(filter.GetPinnedPeers()) == 0 {
+		return mtproto.ErrFilterIncludeEmpty
+	}
+	if len(filter.GetPinnedPeers())+len(filter.GetIncludePeers()) > MaxDialogFilterPeers {
+		return ErrFilterIncludeTooMuch
+	}
+	if len(filter.GetExcludePeers()) > MaxDialogFilterPeers {
+		return ErrFilterExcludeTooMuch
+	}
+
+	return nil
+}
@@ -0,0 +1,146 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package model
+
+import (
+	"testing"
+
+	"github.com/teamgram/proto/mtproto"
+)
+
+func TestDialogFilterMatch(t *testing.T) {
+	const selfId = 1
+
+	filter := NewDialogFilter(selfId, mtproto.MakeTLDialogFilter(&mtproto.DialogFilter{
+		Contacts:        true,
+		Groups:          true,
+		ExcludeMuted:    true,
+		ExcludeArchived: true,
+		Id:              2,
+		Title:           "Work",
+		PinnedPeers:     []*mtproto.InputPeer{mtproto.MakeInputPeerChannel(30), mtproto.MakeTLInputPeerSelf(nil).To_InputPeer()},
+		IncludePeers:    []*mtproto.InputPeer{mtproto.MakeInputPeerChannel(31)},
+		ExcludePeers:    []*mtproto.InputPeer{mtproto.MakeInputPeerChat(20)},
+	}).To_DialogFilter())
+
+	for i, c := range []struct {
+		peer DialogFilterPeer
+		want bool
+	}{
+		{DialogFilterPeer{PeerType: mtproto.PEER_USER, PeerId: 10, Contact: true}, true},
+		{DialogFilterPeer{PeerType: mtproto.PEER_USER, PeerId: 11}, false},
+		{DialogFilterPeer{PeerType: mtproto.PEER_USER, PeerId: 12, Contact: true, Bot: true}, false},
+		{DialogFilterPeer{PeerType: mtproto.PEER_USER, PeerId: 13, Contact: true, Muted: true}, false},
+		{DialogFilterPeer{PeerType: mtproto.PEER_USER, PeerId: 14, Contact: true, Archived: true}, false},
+		{DialogFilterPeer{PeerType: mtproto.PEER_USER, PeerId: selfId, Muted: true}, true},
+		{DialogFilterPeer{PeerType: mtproto.PEER_CHAT, PeerId: 21}, true},
+		{DialogFilterPeer{PeerType: mtproto.PEER_CHAT, PeerId: 20}, false},
+		{DialogFilterPeer{PeerType: mtproto.PEER_CHANNEL, PeerId: 32}, true},
+		{DialogFilterPeer{PeerType: mtproto.PEER_CHANNEL, PeerId: 33, Broadcast: true}, false},
+		{DialogFilterPeer{PeerType: mtproto.PEER_CHANNEL, PeerId: 30, Broadcast: true, Archived: true}, true},
+		{DialogFilterPeer{PeerType: mtproto.PEER_CHANNEL, PeerId: 31, Broadcast: true, Muted: true}, true},
+	} {
+		if v := filter.Match(&c.peer); v != c.want {
+			t.Errorf("#%d: Match(%+v) = %v, want %v", i, c.peer, v, c.want)
+		}
+	}
+
+	if i := filter.PinnedIndex(mtproto.PEER_USER, selfId); i != 1 {
+		t.Errorf("PinnedIndex(self) = %d, want 1", i)
+	}
+	if i := filter.PinnedIndex(mtproto.PEER_CHANNEL, 31); i != -1 {
+		t.Errorf("PinnedIndex(31) = %d, want -1", i)
+	}
+}
+
+func TestDialogFilterExcludeRead(t *testing.T) {
+	filter := NewDialogFilter(1, mtproto.MakeTLDialogFilter(&mtproto.DialogFilter{
+		NonContacts: true,
+		Broadcasts:  true,
+		ExcludeRead: true,
+		Id:          3,
+		Title:       "Unread",
+	}).To_DialogFilter())
+
+	if filter.Match(&DialogFilterPeer{PeerType: mtproto.PEER_USER, PeerId: 10}) {
+		t.Errorf("Match: a read dialog is in")
+	}
+	if !filter.Match(&DialogFilterPeer{PeerType: mtproto.PEER_USER, PeerId: 10, Unread: true}) {
+		t.Errorf("Match: an unread dialog is not in")
+	}
+}
+
+func TestCheckDialogFilter(t *testing.T) {
+	makeFilter := func(id int32, title string, contacts bool, include ...*mtproto.InputPeer) *mtproto.DialogFilter {
+		return mtproto.MakeTLDialogFilter(&mtproto.DialogFilter{
+			Contacts:     contacts,
+			Id:           id,
+			Title:        title,
+			IncludePeers: include,
+		}).To_DialogFilter()
+	}
+
+	for i, c := range []struct {
+		id     int32
+		filter *mtproto.DialogFilter
+		want   error
+	}{
+		{2, makeFilter(2, "Work", true), nil},
+		{2, makeFilter(2, "Work", false, mtproto.MakeInputPeerChat(20)), nil},
+		{1, makeFilter(1, "Work", true), mtproto.ErrFilterIdInvalid},
+		{2, makeFilter(3, "Work", true), mtproto.ErrFilterIdInvalid},
+		{2, makeFilter(2, "", true), mtproto.ErrFilterTitleEmpty},
+		{2, makeFilter(2, "Work", false), mtproto.ErrFilterIncludeEmpty},
+		{2, makeFilter(2, "Work", false, makeTestPeers(MaxDialogFilterPeers)...), nil},
+		{2, makeFilter(2, "Work", false, makeTestPeers(MaxDialogFilterPeers+1)...), ErrFilterIncludeTooMuch},
+	} {
+		if err := CheckDialogFilter(c.id, c.filter); err != c.want {
+			t.Errorf("#%d: CheckDialogFilter() = %v, want %v", i, err, c.want)
+		}
+	}
+}
+
+func TestCheckDialogFilterPeersTooMuch(t *testing.T) {
+	filter := mtproto.MakeTLDialogFilter(&mtproto.DialogFilter{
+		Contacts:     true,
+		Id:           2,
+		Title:        "Work",
+		PinnedPeers:  makeTestPeers(MaxDialogFilterPeers / 2),
+		IncludePeers: makeTestPeers(MaxDialogFilterPeers/2 + 1),
+	}).To_DialogFilter()
+
+	// the pinned peers count against the include limit
+	if err := CheckDialogFilter(2, filter); err != ErrFilterIncludeTooMuch {
+		t.Errorf("CheckDialogFilter(pinned + include) = %v, want %v", err, ErrFilterIncludeTooMuch)
+	}
+
+	filter.PinnedPeers = nil
+	filter.ExcludePeers = makeTestPeers(MaxDialogFilterPeers + 1)
+	if err := CheckDialogFilter(2, filter); err != ErrFilterExcludeTooMuch {
+		t.Errorf("CheckDialogFilter(exclude) = %v, want %v", err, ErrFilterExcludeTooMuch)
+	}
+}
+
+func makeTestPeers(n int) []*mtproto.InputPeer {
+	peers := make([]*mtproto.InputPeer, 0, n)
+	for i := 0; i < n; i++ {
+		peers = append(peers, mtproto.MakeInputPeerChat(int64(100+i)))
+	}
+	return peers
+}
@@ -23,7 +23,9 @@ import (
 // New new a grpc server.
 func New(ctx *svc.ServiceContext, c zrpc.RpcServerConf) *zrpc.RpcServer {
 	s, err := zrpc.NewServer(c, func(grpcServer *grpc.Server) {
-		mtproto.RegisterRPCDialogsServer(grpcServer, service.New(ctx))
+		s := service.New(ctx)
+		mtproto.RegisterRPCDialogsServer(grpcServer, s)
+		mtproto.RegisterRPCFoldersServer(grpcServer, s)
 	})
 	logx.Must(err)
 	return s
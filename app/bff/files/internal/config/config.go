@@ -19,12 +19,14 @@
 package config
 
 import (
+	"github.com/teamgram/teamgram-server/pkg/filereference/conf"
 	"github.com/zeromicro/go-zero/zrpc"
 )
 
 type Config struct {
 	zrpc.RpcServerConf
-	DfsClient   zrpc.RpcClientConf
-	UserClient  zrpc.RpcClientConf
-	MediaClient zrpc.RpcClientConf
+	FileReference conf.FileReferenceConfig
+	DfsClient     zrpc.RpcClientConf
+	UserClient    zrpc.RpcClientConf
+	MediaClient   zrpc.RpcClientConf
 }
@@ -20,17 +20,17 @@ package core
 
 import (
 	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/dfs/dfs"
 )
 
 // HelpGetCdnConfig
 // help.getCdnConfig#52029342 = CdnConfig;
 func (c *FilesCore) HelpGetCdnConfig(in *mtproto.TLHelpGetCdnConfig) (*mtproto.CdnConfig, error) {
-	// TODO: not impl
-	c.Logger.Errorf("help.getCdnConfig blocked, License key from https://teamgram.net required to unlock enterprise features.")
-
-	rValue := mtproto.MakeTLCdnConfig(&mtproto.CdnConfig{
-		PublicKeys: []*mtproto.CdnPublicKey{},
-	}).To_CdnConfig()
+	rValue, err := c.svcCtx.Dao.DfsClient.DfsGetCdnConfig(c.ctx, &dfs.TLDfsGetCdnConfig{})
+	if err != nil {
+		c.Logger.Errorf("help.getCdnConfig - error: %v", err)
+		return nil, err
+	}
 
 	return rValue, nil
 }
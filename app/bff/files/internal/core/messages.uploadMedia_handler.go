@@ -22,6 +22,7 @@ import (
 	"github.com/teamgram/proto/mtproto"
 	userpb "github.com/teamgram/teamgram-server/app/service/biz/user/user"
 	mediapb "github.com/teamgram/teamgram-server/app/service/media/media"
+	"github.com/teamgram/teamgram-server/pkg/filereference"
 	"github.com/teamgram/teamgram-server/pkg/phonenumber"
 	"math/rand"
 	"time"
@@ -35,6 +36,7 @@ func (c *FilesCore) MessagesUploadMedia(in *mtproto.TLMessagesUploadMedia) (*mtp
 		c.Logger.Errorf("messages.uploadMedia - error: %v", err)
 		return nil, err
 	}
+	c.svcCtx.Dao.FileReference.SetMessageMedia(c.MD.UserId, rValue, filereference.UploadContext())
 
 	return rValue, nil
 }
@@ -138,6 +140,39 @@ func (c *FilesCore) makeMediaByInputMedia(media *mtproto.InputMedia) (messageMed
 		//	attributes:Vector<DocumentAttribute>
 		//	stickers:flags.0?Vector<InputDocument>
 		//	ttl_seconds:flags.1?int = InputMedia;
+		// the sticker files uploaded for the sticker sets
+		if stickerAttr := getDocumentAttributeSticker(media.GetAttributes()); stickerAttr != nil {
+			var (
+				fileName string
+			)
+			for _, attr := range media.GetAttributes() {
+				if attr.GetPredicateName() == mtproto.Predicate_documentAttributeFilename {
+					fileName = attr.GetFileName()
+				}
+			}
+			if fileName == "" {
+				fileName = media.GetFile().GetName()
+			}
+
+			document, err2 := c.svcCtx.Dao.MediaClient.MediaUploadStickerFile(c.ctx, &mediapb.TLMediaUploadStickerFile{
+				OwnerId:                  c.MD.AuthId,
+				File:                     media.GetFile(),
+				Thumb:                    media.GetThumb(),
+				MimeType:                 media.GetMimeType(),
+				FileName:                 fileName,
+				DocumentAttributeSticker: stickerAttr,
+			})
+			if err2 != nil {
+				c.Logger.Errorf("messages.uploadMedia - error: %v", err2)
+				err = err2
+				return
+			}
+			messageMedia = mtproto.MakeTLMessageMediaDocument(&mtproto.MessageMedia{
+				Document: document,
+			}).To_MessageMedia()
+			break
+		}
+
 		documentMedia, err2 := c.svcCtx.Dao.MediaClient.MediaUploadedDocumentMedia(c.ctx, &mediapb.TLMediaUploadedDocumentMedia{
 			OwnerId: c.MD.AuthId,
 			Media:   media,
@@ -246,3 +281,12 @@ func (c *FilesCore) makeMediaByInputMedia(media *mtproto.InputMedia) (messageMed
 
 	return
 }
+
+func getDocumentAttributeSticker(attributes []*mtproto.DocumentAttribute) *mtproto.DocumentAttribute {
+	for _, attr := range attributes {
+		if attr.GetPredicateName() == mtproto.Predicate_documentAttributeSticker {
+			return attr
+		}
+	}
+	return nil
+}
@@ -20,15 +20,22 @@ package core
 
 import (
 	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/dfs/dfs"
 )
 
 // UploadGetCdnFileHashes
 // upload.getCdnFileHashes#4da54231 file_token:bytes offset:int = Vector<FileHash>;
 func (c *FilesCore) UploadGetCdnFileHashes(in *mtproto.TLUploadGetCdnFileHashes) (*mtproto.Vector_FileHash, error) {
-	// TODO: not impl
-	c.Logger.Errorf("upload.getCdnFileHashes blocked, License key from https://teamgram.net required to unlock enterprise features.")
+	hashes, err := c.svcCtx.Dao.DfsClient.DfsGetCdnFileHashes(c.ctx, &dfs.TLDfsGetCdnFileHashes{
+		FileToken: in.GetFileToken(),
+		Offset:    in.GetOffset(),
+	})
+	if err != nil {
+		c.Logger.Errorf("upload.getCdnFileHashes - error: %v", err)
+		return nil, err
+	}
 
 	return &mtproto.Vector_FileHash{
-		Datas: []*mtproto.FileHash{},
+		Datas: hashes.GetDatas(),
 	}, nil
 }
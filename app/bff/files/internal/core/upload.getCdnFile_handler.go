@@ -20,15 +20,16 @@ package core
 
 import (
 	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/interface/cdn/cdn"
 )
 
 // UploadGetCdnFile
 // upload.getCdnFile#2000bcc3 file_token:bytes offset:int limit:int = upload.CdnFile;
 func (c *FilesCore) UploadGetCdnFile(in *mtproto.TLUploadGetCdnFile) (*mtproto.Upload_CdnFile, error) {
-	// TODO: not impl
-	c.Logger.Errorf("upload.getCdnFile blocked, License key from https://teamgram.net required to unlock enterprise features.")
+	// upload.getCdnFile is served by the cdn dc (app/interface/cdn), the clients
+	// download the file from the master dc after FILE_TOKEN_INVALID.
+	err := cdn.ErrFileTokenInvalid
+	c.Logger.Errorf("upload.getCdnFile - error: %v", err)
 
-	return mtproto.MakeTLUploadCdnFile(&mtproto.Upload_CdnFile{
-		Bytes: []byte{},
-	}).To_Upload_CdnFile(), nil
+	return nil, err
 }
@@ -20,13 +20,67 @@ package core
 
 import (
 	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/dfs/dfs"
 )
 
 // UploadGetFileHashes
 // upload.getFileHashes#c7025931 location:InputFileLocation offset:int = Vector<FileHash>;
 func (c *FilesCore) UploadGetFileHashes(in *mtproto.TLUploadGetFileHashes) (*mtproto.Vector_FileHash, error) {
-	// TODO: not impl
-	c.Logger.Errorf("upload.getFileHashes blocked, License key from https://teamgram.net required to unlock enterprise features.")
+	var (
+		location = in.GetLocation()
+		offset   = in.GetOffset()
+	)
 
-	return nil, mtproto.ErrEnterpriseIsBlocked
+	switch location.GetPredicateName() {
+	case mtproto.Predicate_inputFileLocation:
+		err := mtproto.ErrInputRequestInvalid
+		c.Logger.Errorf("upload.getFileHashes - error: %v inputFileLocation", err)
+		return nil, err
+	case mtproto.Predicate_inputDocumentFileLocation,
+		mtproto.Predicate_inputPhotoFileLocation:
+		if err := c.svcCtx.Dao.FileReference.CheckFileLocation(c.MD.UserId, location); err != nil {
+			c.Logger.Errorf("upload.getFileHashes - error: %v", err)
+			return nil, err
+		}
+	case mtproto.Predicate_inputEncryptedFileLocation,
+		mtproto.Predicate_inputSecureFileLocation,
+		mtproto.Predicate_inputTakeoutFileLocation,
+		mtproto.Predicate_inputPeerPhotoFileLocation:
+	case mtproto.Predicate_inputStickerSetThumb:
+		if c.svcCtx.Plugin != nil {
+			location2, err := c.svcCtx.Plugin.GetStickerSetThumbFileLocation(c.ctx,
+				c.MD.UserId,
+				location.GetStickerset(),
+				location.GetThumbVersion())
+			if err != nil {
+				c.Logger.Errorf("upload.getFileHashes - error: %v inputFileLocation", err)
+				return nil, mtproto.ErrStickerIdInvalid
+			}
+			location = location2
+		} else {
+			c.Logger.Errorf("upload.getFileHashes blocked, License key from https://teamgram.net required to unlock enterprise features.")
+			return nil, mtproto.ErrEnterpriseIsBlocked
+		}
+	case mtproto.Predicate_inputGroupCallStream:
+		// live streams have no hashes
+		err := mtproto.ErrInputRequestInvalid
+		c.Logger.Errorf("upload.getFileHashes - error: %v inputGroupCallStream", err)
+		return nil, err
+	default:
+		c.Logger.Errorf("upload.getFileHashes - error: invalid location")
+		return nil, mtproto.ErrLocationInvalid
+	}
+
+	hashes, err := c.svcCtx.Dao.DfsClient.DfsGetFileHashes(c.ctx, &dfs.TLDfsGetFileHashes{
+		Location: location,
+		Offset:   offset,
+	})
+	if err != nil {
+		c.Logger.Errorf("upload.getFileHashes - error: %v", err)
+		return nil, err
+	}
+
+	return &mtproto.Vector_FileHash{
+		Datas: hashes.GetDatas(),
+	}, nil
 }
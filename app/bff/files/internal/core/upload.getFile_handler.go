@@ -55,6 +55,10 @@ func (c *FilesCore) UploadGetFile(in *mtproto.TLUploadGetFile) (*mtproto.Upload_
 		//	file_reference:bytes
 		//	thumb_size:string = InputFileLocation;
 		//
+		if err := c.svcCtx.Dao.FileReference.CheckFileLocation(c.MD.UserId, location); err != nil {
+			c.Logger.Errorf("upload.getFile - error: %v inputDocumentFileLocation", err)
+			return nil, err
+		}
 	case mtproto.Predicate_inputSecureFileLocation:
 		// inputSecureFileLocation#cbc7ee28
 		//	id:long
@@ -70,6 +74,10 @@ func (c *FilesCore) UploadGetFile(in *mtproto.TLUploadGetFile) (*mtproto.Upload_
 		//	file_reference:bytes
 		//	thumb_size:string = InputFileLocation;
 		//
+		if err := c.svcCtx.Dao.FileReference.CheckFileLocation(c.MD.UserId, location); err != nil {
+			c.Logger.Errorf("upload.getFile - error: %v inputPhotoFileLocation", err)
+			return nil, err
+		}
 	case mtproto.Predicate_inputPeerPhotoFileLocation:
 		// inputPeerPhotoFileLocation#37257e99 flags:#
 		//	big:flags.0?true
@@ -116,16 +124,20 @@ func (c *FilesCore) UploadGetFile(in *mtproto.TLUploadGetFile) (*mtproto.Upload_
 		return nil, mtproto.ErrLocationInvalid
 	}
 
-	uploadFile, err := c.svcCtx.Dao.DfsClient.DfsDownloadFile(c.ctx, &dfs.TLDfsDownloadFile{
-		Location: location,
-		Offset:   offset,
-		Limit:    limit,
+	uploadFile, err := c.svcCtx.Dao.DfsClient.DfsDownloadFileV2(c.ctx, &dfs.TLDfsDownloadFileV2{
+		CdnSupported: in.GetCdnSupported(),
+		Location:     location,
+		Offset:       offset,
+		Limit:        limit,
 	})
 	if err != nil {
 		c.Logger.Errorf("upload.getFile - error: %v", err.Error())
 		// err = mtproto.ErrOffsetInvalid
 		return nil, err
 	}
+	if uploadFile.GetPredicateName() == mtproto.Predicate_upload_fileCdnRedirect {
+		return uploadFile, nil
+	}
 	if uploadFile.GetType().GetPredicateName() == mtproto.Predicate_storage_fileUnknown {
 		uploadFile.Type.PredicateName = mtproto.Predicate_storage_filePartial
 	}
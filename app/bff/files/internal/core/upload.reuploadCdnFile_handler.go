@@ -20,15 +20,22 @@ package core
 
 import (
 	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/dfs/dfs"
 )
 
 // UploadReuploadCdnFile
 // upload.reuploadCdnFile#9b2754a8 file_token:bytes request_token:bytes = Vector<FileHash>;
 func (c *FilesCore) UploadReuploadCdnFile(in *mtproto.TLUploadReuploadCdnFile) (*mtproto.Vector_FileHash, error) {
-	// TODO: not impl
-	c.Logger.Errorf("upload.reuploadCdnFile blocked, License key from https://teamgram.net required to unlock enterprise features.")
+	hashes, err := c.svcCtx.Dao.DfsClient.DfsReuploadCdnFile(c.ctx, &dfs.TLDfsReuploadCdnFile{
+		FileToken:    in.GetFileToken(),
+		RequestToken: in.GetRequestToken(),
+	})
+	if err != nil {
+		c.Logger.Errorf("upload.reuploadCdnFile - error: %v", err)
+		return nil, err
+	}
 
 	return &mtproto.Vector_FileHash{
-		Datas: []*mtproto.FileHash{},
+		Datas: hashes.GetDatas(),
 	}, nil
 }
@@ -24,18 +24,21 @@ import (
 	user_client "github.com/teamgram/teamgram-server/app/service/biz/user/client"
 	dfs_client "github.com/teamgram/teamgram-server/app/service/dfs/client"
 	media_client "github.com/teamgram/teamgram-server/app/service/media/client"
+	"github.com/teamgram/teamgram-server/pkg/filereference"
 )
 
 type Dao struct {
 	dfs_client.DfsClient
 	media_client.MediaClient
 	user_client.UserClient
+	FileReference *filereference.FileReference
 }
 
 func New(c config.Config) *Dao {
 	return &Dao{
-		DfsClient:   dfs_client.NewDfsClient(rpcx.GetCachedRpcClient(c.DfsClient)),
-		MediaClient: media_client.NewMediaClient(rpcx.GetCachedRpcClient(c.MediaClient)),
-		UserClient:  user_client.NewUserClient(rpcx.GetCachedRpcClient(c.UserClient)),
+		DfsClient:     dfs_client.NewDfsClient(rpcx.GetCachedRpcClient(c.DfsClient)),
+		MediaClient:   media_client.NewMediaClient(rpcx.GetCachedRpcClient(c.MediaClient)),
+		UserClient:    user_client.NewUserClient(rpcx.GetCachedRpcClient(c.UserClient)),
+		FileReference: filereference.New(&c.FileReference),
 	}
 }
@@ -0,0 +1,292 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"math/rand"
+	"strconv"
+	"time"
+
+	"github.com/teamgram/proto/mtproto"
+	msgpb "github.com/teamgram/teamgram-server/app/messenger/msg/msg/msg"
+	"github.com/teamgram/teamgram-server/app/messenger/sync/sync"
+	chatpb "github.com/teamgram/teamgram-server/app/service/biz/chat/chat"
+	userpb "github.com/teamgram/teamgram-server/app/service/biz/user/user"
+	"github.com/teamgram/teamgram-server/pkg/groupcall"
+)
+
+const (
+	maxParticipantsLimit = 100
+)
+
+// getChat only the members of the basic group take part in its call.
+func (c *GroupCallsCore) getChat(chatId int64) (*chatpb.MutableChat, *chatpb.ImmutableChatParticipant, error) {
+	chat, err := c.svcCtx.Dao.ChatClient.Client().ChatGetMutableChat(c.ctx, &chatpb.TLChatGetMutableChat{
+		ChatId: chatId,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	me, ok := chat.GetImmutableChatParticipant(c.MD.UserId)
+	if !ok || !me.IsChatMemberStateNormal() {
+		return nil, nil, mtproto.ErrGroupcallForbidden
+	}
+
+	return chat, me, nil
+}
+
+// getGroupCall resolves the InputGroupCall and the chat of the call.
+func (c *GroupCallsCore) getGroupCall(in *mtproto.InputGroupCall) (*groupcall.GroupCall, *chatpb.MutableChat, *chatpb.ImmutableChatParticipant, error) {
+	call, err := c.svcCtx.Dao.GetGroupCall(c.ctx, in.GetId())
+	if err != nil {
+		return nil, nil, nil, err
+	} else if call == nil || !call.Check(in) {
+		return nil, nil, nil, mtproto.ErrGroupCallInvalid
+	}
+
+	chat, me, err := c.getChat(call.ChatId)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	return call, chat, me, nil
+}
+
+func (c *GroupCallsCore) makeGroupCall(call *groupcall.GroupCall) *mtproto.GroupCall {
+	if call.Discarded {
+		return call.ToGroupCall(0, 0)
+	}
+
+	count, _ := c.svcCtx.Dao.CountParticipants(c.ctx, call.Id)
+	version, _ := c.svcCtx.Dao.GetVersion(c.ctx, call.Id)
+
+	return call.ToGroupCall(count, version)
+}
+
+func makeUpdateGroupCall(call *groupcall.GroupCall, groupCall *mtproto.GroupCall) *mtproto.Update {
+	return mtproto.MakeTLUpdateGroupCall(&mtproto.Update{
+		ChatId_INT64:   call.ChatId,
+		Call_GROUPCALL: groupCall,
+	}).To_Update()
+}
+
+// makeUpdateGroupCallParticipants the participants seen by userId, left and justJoined are the flags of the change.
+func makeUpdateGroupCallParticipants(
+	call *groupcall.GroupCall,
+	version int32,
+	userId int64,
+	left, justJoined bool,
+	participants ...*groupcall.Participant) *mtproto.Update {
+
+	vList := make([]*mtproto.GroupCallParticipant, 0, len(participants))
+	for _, p := range participants {
+		participant := p.ToGroupCallParticipant(userId)
+		participant.Left = left
+		participant.JustJoined = justJoined
+		participant.Versioned = !justJoined
+		vList = append(vList, participant)
+	}
+
+	return mtproto.MakeTLUpdateGroupCallParticipants(&mtproto.Update{
+		Call_INPUTGROUPCALL:                     call.ToInputGroupCall(),
+		Participants_VECTORGROUPCALLPARTICIPANT: vList,
+		Version:                                 version,
+	}).To_Update()
+}
+
+// pushGroupCallUpdates pushes the updates made by makeUpdates to the members of the chat.
+//
+// the device of the request gets its updates in the reply, the other devices of the user are pushed.
+func (c *GroupCallsCore) pushGroupCallUpdates(
+	chat *chatpb.MutableChat,
+	idList []int64,
+	makeUpdates func(userId int64) []*mtproto.Update) *mtproto.Updates {
+
+	var (
+		rUpdates   *mtproto.Updates
+		memberList []int64
+	)
+
+	chat.Walk(func(userId int64, participant *chatpb.ImmutableChatParticipant) error {
+		if participant.IsChatMemberStateNormal() {
+			memberList = append(memberList, userId)
+		}
+		return nil
+	})
+
+	// the users are seen by each member
+	mUsers, _ := c.svcCtx.Dao.UserClient.UserGetMutableUsers(c.ctx, &userpb.TLUserGetMutableUsers{
+		Id: append(memberList, idList...),
+	})
+
+	for _, userId := range memberList {
+		updates := mtproto.MakeUpdatesByUpdatesUsersChats(
+			mUsers.GetUserListByIdList(userId, idList...),
+			[]*mtproto.Chat{chat.ToUnsafeChat(userId)},
+			makeUpdates(userId)...)
+		if userId == c.MD.UserId {
+			c.svcCtx.Dao.SyncClient.SyncUpdatesNotMe(c.ctx, &sync.TLSyncUpdatesNotMe{
+				UserId:    userId,
+				AuthKeyId: c.MD.AuthId,
+				Updates:   updates,
+			})
+			rUpdates = updates
+		} else {
+			c.svcCtx.Dao.SyncClient.SyncPushUpdates(c.ctx, &sync.TLSyncPushUpdates{
+				UserId:  userId,
+				Updates: updates,
+			})
+		}
+	}
+
+	if rUpdates == nil {
+		rUpdates = mtproto.MakeUpdatesByUpdates(makeUpdates(c.MD.UserId)...)
+	}
+
+	return rUpdates
+}
+
+// pushGroupCall pushes updateGroupCall to the members of the chat.
+func (c *GroupCallsCore) pushGroupCall(chat *chatpb.MutableChat, call *groupcall.GroupCall) *mtproto.Updates {
+	update := makeUpdateGroupCall(call, c.makeGroupCall(call))
+
+	return c.pushGroupCallUpdates(chat, nil, func(userId int64) []*mtproto.Update {
+		return []*mtproto.Update{update}
+	})
+}
+
+// pushGroupCallParticipants bumps the version of the participants list and pushes the changes to the members of the chat.
+func (c *GroupCallsCore) pushGroupCallParticipants(
+	chat *chatpb.MutableChat,
+	call *groupcall.GroupCall,
+	left, justJoined bool,
+	participants ...*groupcall.Participant) (*mtproto.Updates, error) {
+
+	version, err := c.svcCtx.Dao.NextVersion(c.ctx, call.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	idList := make([]int64, 0, len(participants))
+	for _, p := range participants {
+		idList = append(idList, p.UserId)
+	}
+
+	return c.pushGroupCallUpdates(chat, idList, func(userId int64) []*mtproto.Update {
+		return []*mtproto.Update{
+			makeUpdateGroupCallParticipants(call, version, userId, left, justJoined, participants...),
+		}
+	}), nil
+}
+
+// sendGroupCallMessage writes the messageActionGroupCall to the chat, the reply holds the new message.
+func (c *GroupCallsCore) sendGroupCallMessage(chat *chatpb.MutableChat, call *groupcall.GroupCall) (*mtproto.Updates, error) {
+	return c.sendMessageService(chat, call.ToMessageAction())
+}
+
+// sendMessageService writes the service message of action to the chat.
+func (c *GroupCallsCore) sendMessageService(chat *chatpb.MutableChat, action *mtproto.MessageAction) (*mtproto.Updates, error) {
+	return c.svcCtx.Dao.MsgClient.MsgSendMessage(c.ctx, &msgpb.TLMsgSendMessage{
+		UserId:    c.MD.UserId,
+		AuthKeyId: c.MD.AuthId,
+		PeerType:  mtproto.PEER_CHAT,
+		PeerId:    chat.Id(),
+		Message: msgpb.MakeTLOutboxMessage(&msgpb.OutboxMessage{
+			NoWebpage:    true,
+			Background:   false,
+			RandomId:     rand.Int63(),
+			Message:      chat.MakeMessageService(c.MD.UserId, action),
+			ScheduleDate: nil,
+		}).To_OutboxMessage(),
+	})
+}
+
+// leaveGroupCall removes userId from the call, false if it wasn't in the call.
+func (c *GroupCallsCore) leaveGroupCall(call *groupcall.GroupCall, p *groupcall.Participant) (bool, error) {
+	ok, err := c.svcCtx.Dao.DeleteParticipant(c.ctx, call.Id, p.UserId)
+	if err != nil || !ok {
+		return false, err
+	}
+
+	if err = c.svcCtx.Dao.Sfu.LeaveGroupCall(c.ctx, call.Id, p.UserId, p.Source); err != nil {
+		c.Logger.Errorf("sfu.LeaveGroupCall(%d, %d) - error: %v", call.Id, p.UserId, err)
+	}
+
+	return true, nil
+}
+
+// discardGroupCall ends the call, the participants are dropped without updateGroupCallParticipants.
+func (c *GroupCallsCore) discardGroupCall(call *groupcall.GroupCall) error {
+	call.Discarded = true
+	call.Duration = int32(time.Now().Unix()) - call.Date
+	if err := c.svcCtx.Dao.PutGroupCall(c.ctx, call); err != nil {
+		return err
+	}
+	if err := c.svcCtx.Dao.DeleteChatGroupCall(c.ctx, call.ChatId); err != nil {
+		return err
+	}
+	c.svcCtx.Dao.DeleteParticipants(c.ctx, call.Id)
+
+	if err := c.svcCtx.Dao.Sfu.DiscardGroupCall(c.ctx, call.Id); err != nil {
+		c.Logger.Errorf("sfu.DiscardGroupCall(%d) - error: %v", call.Id, err)
+	}
+
+	return nil
+}
+
+// toGroupCallParticipants the participants seen by the user of the request and their users.
+func (c *GroupCallsCore) toGroupCallParticipants(participants []*groupcall.Participant) ([]*mtproto.GroupCallParticipant, []*mtproto.User) {
+	var (
+		vList  = make([]*mtproto.GroupCallParticipant, 0, len(participants))
+		idList = make([]int64, 0, len(participants))
+	)
+
+	for _, p := range participants {
+		vList = append(vList, p.ToGroupCallParticipant(c.MD.UserId))
+		idList = append(idList, p.UserId)
+	}
+	if len(idList) == 0 {
+		return vList, []*mtproto.User{}
+	}
+
+	mUsers, _ := c.svcCtx.Dao.UserClient.UserGetMutableUsers(c.ctx, &userpb.TLUserGetMutableUsers{
+		Id: append(idList, c.MD.UserId),
+	})
+
+	return vList, mUsers.GetUserListByIdList(c.MD.UserId, idList...)
+}
+
+// sliceParticipants pages the participants, offset is the index of the first participant of the page.
+func sliceParticipants(participants []*groupcall.Participant, offset string, limit int32) ([]*groupcall.Participant, string) {
+	idx, _ := strconv.Atoi(offset)
+	if idx < 0 || idx > len(participants) {
+		idx = len(participants)
+	}
+	if limit <= 0 || limit > maxParticipantsLimit {
+		limit = maxParticipantsLimit
+	}
+
+	end := idx + int(limit)
+	if end >= len(participants) {
+		return participants[idx:], ""
+	}
+
+	return participants[idx:end], strconv.Itoa(end)
+}
@@ -0,0 +1,56 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+)
+
+// PhoneCheckGroupCall
+// phone.checkGroupCall#b59cf977 call:InputGroupCall sources:Vector<int> = Vector<int>;
+func (c *GroupCallsCore) PhoneCheckGroupCall(in *mtproto.TLPhoneCheckGroupCall) (*mtproto.Vector_Int, error) {
+	call, _, _, err := c.getGroupCall(in.Call)
+	if err != nil {
+		c.Logger.Errorf("phone.checkGroupCall - error: %v", err)
+		return nil, err
+	}
+
+	rValues := &mtproto.Vector_Int{
+		Datas: []int32{},
+	}
+	if call.Discarded {
+		return rValues, nil
+	}
+
+	// the sources of the user still in the call, the client joins again if its source is missing
+	p, err := c.svcCtx.Dao.GetParticipant(c.ctx, call.Id, c.MD.UserId)
+	if err != nil {
+		c.Logger.Errorf("phone.checkGroupCall - error: %v", err)
+		return nil, mtproto.ErrInternelServerError
+	} else if p == nil {
+		return rValues, nil
+	}
+	for _, source := range in.Sources {
+		if source == p.Source {
+			rValues.Datas = append(rValues.Datas, source)
+		}
+	}
+
+	return rValues, nil
+}
@@ -0,0 +1,96 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"math/rand"
+	"time"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/pkg/groupcall"
+
+	"google.golang.org/grpc/status"
+)
+
+var (
+	errGroupCallAlreadyStarted = status.Error(mtproto.ErrBadRequest, "GROUPCALL_ALREADY_STARTED")
+)
+
+// PhoneCreateGroupCall
+// phone.createGroupCall#48cdc6d8 flags:# rtmp_stream:flags.2?true peer:InputPeer random_id:int title:flags.0?string schedule_date:flags.1?int = Updates;
+func (c *GroupCallsCore) PhoneCreateGroupCall(in *mtproto.TLPhoneCreateGroupCall) (*mtproto.Updates, error) {
+	// only the voice chats of the basic groups, the rtmp streams are for the channels
+	peer := mtproto.FromInputPeer2(c.MD.UserId, in.Peer)
+	if !peer.IsChat() || in.RtmpStream {
+		err := mtproto.ErrPeerIdInvalid
+		c.Logger.Errorf("phone.createGroupCall - error: %v", err)
+		return nil, err
+	}
+	if in.ScheduleDate != nil {
+		err := mtproto.ErrScheduleDateInvalid
+		c.Logger.Errorf("phone.createGroupCall - error: scheduled group calls not supported")
+		return nil, err
+	}
+
+	chat, me, err := c.getChat(peer.PeerId)
+	if err != nil {
+		c.Logger.Errorf("phone.createGroupCall - error: %v", err)
+		return nil, err
+	} else if !me.CanAdminManageCall() {
+		err = mtproto.ErrChatAdminRequired
+		c.Logger.Errorf("phone.createGroupCall - error: %v", err)
+		return nil, err
+	}
+
+	call := &groupcall.GroupCall{
+		Id:         rand.Int63(),
+		AccessHash: rand.Int63(),
+		ChatId:     peer.PeerId,
+		CreatorId:  c.MD.UserId,
+		Date:       int32(time.Now().Unix()),
+		Title:      in.GetTitle().GetValue(),
+	}
+	if err = c.svcCtx.Dao.PutGroupCall(c.ctx, call); err != nil {
+		c.Logger.Errorf("phone.createGroupCall - error: %v", err)
+		return nil, mtproto.ErrInternelServerError
+	}
+	if ok, err := c.svcCtx.Dao.PutChatGroupCall(c.ctx, call.ChatId, call.Id); err != nil {
+		c.Logger.Errorf("phone.createGroupCall - error: %v", err)
+		return nil, mtproto.ErrInternelServerError
+	} else if !ok {
+		err = errGroupCallAlreadyStarted
+		c.Logger.Errorf("phone.createGroupCall - error: %v", err)
+		return nil, err
+	}
+
+	if err = c.svcCtx.Dao.Sfu.CreateGroupCall(c.ctx, call.Id); err != nil {
+		c.Logger.Errorf("phone.createGroupCall - error: sfu.CreateGroupCall(%d) - %v", call.Id, err)
+		c.svcCtx.Dao.DeleteChatGroupCall(c.ctx, call.ChatId)
+		return nil, mtproto.ErrInternelServerError
+	}
+
+	rUpdates, err := c.sendGroupCallMessage(chat, call)
+	if err != nil {
+		c.Logger.Errorf("phone.createGroupCall - error: %v", err)
+		return nil, err
+	}
+	rUpdates.Updates = append(rUpdates.Updates, c.pushGroupCall(chat, call).Updates...)
+
+	return rUpdates, nil
+}
@@ -0,0 +1,57 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+)
+
+// PhoneDiscardGroupCall
+// phone.discardGroupCall#7a777135 call:InputGroupCall = Updates;
+func (c *GroupCallsCore) PhoneDiscardGroupCall(in *mtproto.TLPhoneDiscardGroupCall) (*mtproto.Updates, error) {
+	call, chat, me, err := c.getGroupCall(in.Call)
+	if err != nil {
+		c.Logger.Errorf("phone.discardGroupCall - error: %v", err)
+		return nil, err
+	} else if call.Discarded {
+		err = mtproto.ErrGroupCallInvalid
+		c.Logger.Errorf("phone.discardGroupCall - error: %v", err)
+		return nil, err
+	}
+
+	if call.CreatorId != c.MD.UserId && !me.CanAdminManageCall() {
+		err = mtproto.ErrChatAdminRequired
+		c.Logger.Errorf("phone.discardGroupCall - error: %v", err)
+		return nil, err
+	}
+
+	if err = c.discardGroupCall(call); err != nil {
+		c.Logger.Errorf("phone.discardGroupCall - error: %v", err)
+		return nil, mtproto.ErrInternelServerError
+	}
+
+	rUpdates, err := c.sendGroupCallMessage(chat, call)
+	if err != nil {
+		c.Logger.Errorf("phone.discardGroupCall - error: %v", err)
+		return nil, err
+	}
+	rUpdates.Updates = append(rUpdates.Updates, c.pushGroupCall(chat, call).Updates...)
+
+	return rUpdates, nil
+}
@@ -0,0 +1,138 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"time"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/pkg/groupcall"
+)
+
+const (
+	maxVolume = 2 * groupcall.DefaultVolume
+)
+
+// PhoneEditGroupCallParticipant
+// phone.editGroupCallParticipant#a5273abf flags:# call:InputGroupCall participant:InputPeer muted:flags.0?Bool volume:flags.1?int raise_hand:flags.2?Bool video_stopped:flags.3?Bool video_paused:flags.4?Bool presentation_paused:flags.5?Bool = Updates;
+func (c *GroupCallsCore) PhoneEditGroupCallParticipant(in *mtproto.TLPhoneEditGroupCallParticipant) (*mtproto.Updates, error) {
+	call, chat, me, err := c.getGroupCall(in.Call)
+	if err != nil {
+		c.Logger.Errorf("phone.editGroupCallParticipant - error: %v", err)
+		return nil, err
+	} else if call.Discarded {
+		err = mtproto.ErrGroupCallInvalid
+		c.Logger.Errorf("phone.editGroupCallParticipant - error: %v", err)
+		return nil, err
+	}
+
+	peer := mtproto.FromInputPeer2(c.MD.UserId, in.Participant)
+	if !peer.IsUser() {
+		err = mtproto.ErrGroupCallParticipantInvalid
+		c.Logger.Errorf("phone.editGroupCallParticipant - error: %v", err)
+		return nil, err
+	}
+
+	p, err := c.svcCtx.Dao.GetParticipant(c.ctx, call.Id, peer.PeerId)
+	if err != nil {
+		c.Logger.Errorf("phone.editGroupCallParticipant - error: %v", err)
+		return nil, mtproto.ErrInternelServerError
+	} else if p == nil {
+		err = mtproto.ErrParticipantJoinMissing
+		c.Logger.Errorf("phone.editGroupCallParticipant - error: %v", err)
+		return nil, err
+	}
+
+	var (
+		isSelf    = p.UserId == c.MD.UserId
+		canManage = me.CanAdminManageCall()
+	)
+
+	// the admins mute and set the volume of the others, the raised hand and the video are the participant's own
+	if !isSelf && (!canManage || in.RaiseHand != nil || in.VideoStopped != nil) {
+		err = mtproto.ErrChatAdminRequired
+		c.Logger.Errorf("phone.editGroupCallParticipant - error: %v", err)
+		return nil, err
+	}
+
+	if in.Muted != nil {
+		muted := mtproto.FromBool(in.Muted)
+		switch {
+		case isSelf && !muted && p.MutedByAdmin && !canManage:
+			err = mtproto.ErrGroupcallForbidden
+			c.Logger.Errorf("phone.editGroupCallParticipant - error: muted by an admin")
+			return nil, err
+		case isSelf:
+			p.Muted = muted
+			if canManage {
+				p.MutedByAdmin = false
+			}
+		default:
+			// unmuted by an admin, the participant may unmute itself
+			p.MutedByAdmin = muted
+			if muted {
+				p.Muted = true
+			}
+		}
+
+		if err = c.svcCtx.Dao.Sfu.MuteGroupCallParticipant(c.ctx, call.Id, p.UserId, p.Muted); err != nil {
+			c.Logger.Errorf("phone.editGroupCallParticipant - error: sfu.MuteGroupCallParticipant(%d, %d) - %v", call.Id, p.UserId, err)
+		}
+	}
+
+	if in.Volume != nil {
+		if in.Volume.Value < 1 || in.Volume.Value > maxVolume {
+			err = mtproto.ErrGroupCallParticipantInvalid
+			c.Logger.Errorf("phone.editGroupCallParticipant - error: invalid volume %d", in.Volume.Value)
+			return nil, err
+		}
+		p.Volume = in.Volume.Value
+		p.VolumeByAdmin = !isSelf
+	}
+
+	if in.RaiseHand != nil {
+		if mtproto.FromBool(in.RaiseHand) {
+			// the hands raised first are listed first
+			p.RaiseHandRating = time.Now().UnixNano() / int64(time.Millisecond)
+		} else {
+			p.RaiseHandRating = 0
+		}
+	}
+
+	// TODO: video_paused and presentation_paused, the video is not relayed
+	if in.VideoStopped != nil {
+		p.VideoStopped = mtproto.FromBool(in.VideoStopped)
+	}
+
+	if isSelf {
+		p.ActiveDate = int32(time.Now().Unix())
+	}
+	if err = c.svcCtx.Dao.PutParticipant(c.ctx, call.Id, p); err != nil {
+		c.Logger.Errorf("phone.editGroupCallParticipant - error: %v", err)
+		return nil, mtproto.ErrInternelServerError
+	}
+
+	rUpdates, err := c.pushGroupCallParticipants(chat, call, false, false, p)
+	if err != nil {
+		c.Logger.Errorf("phone.editGroupCallParticipant - error: %v", err)
+		return nil, mtproto.ErrInternelServerError
+	}
+
+	return rUpdates, nil
+}
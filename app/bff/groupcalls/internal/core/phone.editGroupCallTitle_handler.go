@@ -0,0 +1,58 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+)
+
+// PhoneEditGroupCallTitle
+// phone.editGroupCallTitle#1ca6ac0a call:InputGroupCall title:string = Updates;
+func (c *GroupCallsCore) PhoneEditGroupCallTitle(in *mtproto.TLPhoneEditGroupCallTitle) (*mtproto.Updates, error) {
+	call, chat, me, err := c.getGroupCall(in.Call)
+	if err != nil {
+		c.Logger.Errorf("phone.editGroupCallTitle - error: %v", err)
+		return nil, err
+	} else if call.Discarded {
+		err = mtproto.ErrGroupCallInvalid
+		c.Logger.Errorf("phone.editGroupCallTitle - error: %v", err)
+		return nil, err
+	}
+
+	if !me.CanAdminManageCall() {
+		err = mtproto.ErrChatAdminRequired
+		c.Logger.Errorf("phone.editGroupCallTitle - error: %v", err)
+		return nil, err
+	}
+
+	// an empty title resets it to the title of the chat
+	if in.Title == call.Title {
+		err = mtproto.ErrGroupcallNotModified
+		c.Logger.Errorf("phone.editGroupCallTitle - error: %v", err)
+		return nil, err
+	}
+
+	call.Title = in.Title
+	if err = c.svcCtx.Dao.PutGroupCall(c.ctx, call); err != nil {
+		c.Logger.Errorf("phone.editGroupCallTitle - error: %v", err)
+		return nil, mtproto.ErrInternelServerError
+	}
+
+	return c.pushGroupCall(chat, call), nil
+}
@@ -0,0 +1,55 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	userpb "github.com/teamgram/teamgram-server/app/service/biz/user/user"
+)
+
+// PhoneGetGroupCallJoinAs
+// phone.getGroupCallJoinAs#ef7c213a peer:InputPeer = phone.JoinAsPeers;
+func (c *GroupCallsCore) PhoneGetGroupCallJoinAs(in *mtproto.TLPhoneGetGroupCallJoinAs) (*mtproto.Phone_JoinAsPeers, error) {
+	peer := mtproto.FromInputPeer2(c.MD.UserId, in.Peer)
+	if !peer.IsChat() {
+		err := mtproto.ErrPeerIdInvalid
+		c.Logger.Errorf("phone.getGroupCallJoinAs - error: %v", err)
+		return nil, err
+	}
+
+	if _, _, err := c.getChat(peer.PeerId); err != nil {
+		c.Logger.Errorf("phone.getGroupCallJoinAs - error: %v", err)
+		return nil, err
+	}
+
+	// the users only join as themselves
+	mUsers, err := c.svcCtx.Dao.UserClient.UserGetMutableUsers(c.ctx, &userpb.TLUserGetMutableUsers{
+		Id: []int64{c.MD.UserId},
+	})
+	if err != nil {
+		c.Logger.Errorf("phone.getGroupCallJoinAs - error: %v", err)
+		return nil, err
+	}
+
+	return mtproto.MakeTLPhoneJoinAsPeers(&mtproto.Phone_JoinAsPeers{
+		Peers: []*mtproto.Peer{mtproto.MakePeerUser(c.MD.UserId)},
+		Chats: []*mtproto.Chat{},
+		Users: mUsers.GetUserListByIdList(c.MD.UserId, c.MD.UserId),
+	}).To_Phone_JoinAsPeers(), nil
+}
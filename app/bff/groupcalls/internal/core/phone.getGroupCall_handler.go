@@ -0,0 +1,57 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/pkg/groupcall"
+)
+
+// PhoneGetGroupCall
+// phone.getGroupCall#41845db call:InputGroupCall limit:int = phone.GroupCall;
+func (c *GroupCallsCore) PhoneGetGroupCall(in *mtproto.TLPhoneGetGroupCall) (*mtproto.Phone_GroupCall, error) {
+	call, _, _, err := c.getGroupCall(in.Call)
+	if err != nil {
+		c.Logger.Errorf("phone.getGroupCall - error: %v", err)
+		return nil, err
+	}
+
+	var (
+		participants []*groupcall.Participant
+		nextOffset   string
+	)
+	if !call.Discarded {
+		participants, err = c.svcCtx.Dao.GetParticipants(c.ctx, call.Id)
+		if err != nil {
+			c.Logger.Errorf("phone.getGroupCall - error: %v", err)
+			return nil, mtproto.ErrInternelServerError
+		}
+		participants, nextOffset = sliceParticipants(participants, "", in.Limit)
+	}
+
+	vList, users := c.toGroupCallParticipants(participants)
+
+	return mtproto.MakeTLPhoneGroupCall(&mtproto.Phone_GroupCall{
+		Call:                   c.makeGroupCall(call),
+		Participants:           vList,
+		ParticipantsNextOffset: nextOffset,
+		Chats:                  []*mtproto.Chat{},
+		Users:                  users,
+	}).To_Phone_GroupCall(), nil
+}
@@ -0,0 +1,96 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/pkg/groupcall"
+)
+
+// PhoneGetGroupParticipants
+// phone.getGroupParticipants#c558d8ab call:InputGroupCall ids:Vector<InputPeer> sources:Vector<int> offset:string limit:int = phone.GroupParticipants;
+func (c *GroupCallsCore) PhoneGetGroupParticipants(in *mtproto.TLPhoneGetGroupParticipants) (*mtproto.Phone_GroupParticipants, error) {
+	call, _, _, err := c.getGroupCall(in.Call)
+	if err != nil {
+		c.Logger.Errorf("phone.getGroupParticipants - error: %v", err)
+		return nil, err
+	} else if call.Discarded {
+		err = mtproto.ErrGroupCallInvalid
+		c.Logger.Errorf("phone.getGroupParticipants - error: %v", err)
+		return nil, err
+	}
+
+	// the version is read first, the client reloads if an update is missed meanwhile
+	version, _ := c.svcCtx.Dao.GetVersion(c.ctx, call.Id)
+	participants, err := c.svcCtx.Dao.GetParticipants(c.ctx, call.Id)
+	if err != nil {
+		c.Logger.Errorf("phone.getGroupParticipants - error: %v", err)
+		return nil, mtproto.ErrInternelServerError
+	}
+
+	var (
+		count      = int32(len(participants))
+		nextOffset string
+	)
+	if len(in.Ids) > 0 || len(in.Sources) > 0 {
+		// resolves the unknown peers or sources of the client, not paged
+		participants = filterParticipants(c.MD.UserId, participants, in.Ids, in.Sources)
+	} else {
+		participants, nextOffset = sliceParticipants(participants, in.Offset, in.Limit)
+	}
+
+	vList, users := c.toGroupCallParticipants(participants)
+
+	return mtproto.MakeTLPhoneGroupParticipants(&mtproto.Phone_GroupParticipants{
+		Count:        count,
+		Participants: vList,
+		NextOffset:   nextOffset,
+		Chats:        []*mtproto.Chat{},
+		Users:        users,
+		Version:      version,
+	}).To_Phone_GroupParticipants(), nil
+}
+
+func filterParticipants(selfId int64, participants []*groupcall.Participant, ids []*mtproto.InputPeer, sources []int32) []*groupcall.Participant {
+	var (
+		rList = make([]*groupcall.Participant, 0, len(ids)+len(sources))
+	)
+
+	for _, p := range participants {
+		found := false
+		for _, id := range ids {
+			peer := mtproto.FromInputPeer2(selfId, id)
+			if peer.IsUser() && peer.PeerId == p.UserId {
+				found = true
+				break
+			}
+		}
+		for _, source := range sources {
+			if found {
+				break
+			}
+			found = source == p.Source
+		}
+		if found {
+			rList = append(rList, p)
+		}
+	}
+
+	return rList
+}
@@ -0,0 +1,92 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+
+	"google.golang.org/grpc/status"
+)
+
+var (
+	errUserAlreadyInvited = status.Error(mtproto.ErrBadRequest, "USER_ALREADY_INVITED")
+)
+
+// PhoneInviteToGroupCall
+// phone.inviteToGroupCall#7b393160 call:InputGroupCall users:Vector<InputUser> = Updates;
+func (c *GroupCallsCore) PhoneInviteToGroupCall(in *mtproto.TLPhoneInviteToGroupCall) (*mtproto.Updates, error) {
+	call, chat, _, err := c.getGroupCall(in.Call)
+	if err != nil {
+		c.Logger.Errorf("phone.inviteToGroupCall - error: %v", err)
+		return nil, err
+	} else if call.Discarded {
+		err = mtproto.ErrGroupCallInvalid
+		c.Logger.Errorf("phone.inviteToGroupCall - error: %v", err)
+		return nil, err
+	}
+
+	// only the participants of the call invite the other members of the chat
+	if p, err := c.svcCtx.Dao.GetParticipant(c.ctx, call.Id, c.MD.UserId); err != nil {
+		c.Logger.Errorf("phone.inviteToGroupCall - error: %v", err)
+		return nil, mtproto.ErrInternelServerError
+	} else if p == nil {
+		err = mtproto.ErrGroupcallForbidden
+		c.Logger.Errorf("phone.inviteToGroupCall - error: %v", err)
+		return nil, err
+	}
+
+	idList := make([]int64, 0, len(in.Users))
+	for _, u := range in.Users {
+		peer := mtproto.FromInputUser(c.MD.UserId, u)
+		if !peer.IsUser() || peer.PeerId == c.MD.UserId {
+			err = mtproto.ErrUserIdInvalid
+			c.Logger.Errorf("phone.inviteToGroupCall - error: %v", err)
+			return nil, err
+		}
+		if participant, ok := chat.GetImmutableChatParticipant(peer.PeerId); !ok || !participant.IsChatMemberStateNormal() {
+			err = mtproto.ErrUserNotParticipant
+			c.Logger.Errorf("phone.inviteToGroupCall - error: %v", err)
+			return nil, err
+		}
+
+		// the users already in the call are not invited again
+		if p, err := c.svcCtx.Dao.GetParticipant(c.ctx, call.Id, peer.PeerId); err != nil {
+			c.Logger.Errorf("phone.inviteToGroupCall - error: %v", err)
+			return nil, mtproto.ErrInternelServerError
+		} else if p == nil {
+			idList = append(idList, peer.PeerId)
+		}
+	}
+	if len(idList) == 0 {
+		err = errUserAlreadyInvited
+		c.Logger.Errorf("phone.inviteToGroupCall - error: %v", err)
+		return nil, err
+	}
+
+	rUpdates, err := c.sendMessageService(chat, mtproto.MakeTLMessageActionInviteToGroupCall(&mtproto.MessageAction{
+		Call:  call.ToInputGroupCall(),
+		Users: idList,
+	}).To_MessageAction())
+	if err != nil {
+		c.Logger.Errorf("phone.inviteToGroupCall - error: %v", err)
+		return nil, err
+	}
+
+	return rUpdates, nil
+}
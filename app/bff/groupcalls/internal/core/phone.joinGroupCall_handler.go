@@ -0,0 +1,124 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"time"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/pkg/groupcall"
+)
+
+// PhoneJoinGroupCall
+// phone.joinGroupCall#b132ff7b flags:# muted:flags.0?true video_stopped:flags.2?true call:InputGroupCall join_as:InputPeer invite_hash:flags.1?string params:DataJSON = Updates;
+func (c *GroupCallsCore) PhoneJoinGroupCall(in *mtproto.TLPhoneJoinGroupCall) (*mtproto.Updates, error) {
+	call, chat, me, err := c.getGroupCall(in.Call)
+	if err != nil {
+		c.Logger.Errorf("phone.joinGroupCall - error: %v", err)
+		return nil, err
+	} else if call.Discarded {
+		err = mtproto.ErrGroupCallInvalid
+		c.Logger.Errorf("phone.joinGroupCall - error: %v", err)
+		return nil, err
+	}
+
+	// the users only join as themselves
+	joinAs := mtproto.FromInputPeer2(c.MD.UserId, in.JoinAs)
+	if !joinAs.IsUser() || joinAs.PeerId != c.MD.UserId {
+		err = mtproto.ErrJoinAsPeerInvalid
+		c.Logger.Errorf("phone.joinGroupCall - error: %v", err)
+		return nil, err
+	}
+
+	source, err := groupcall.ParseSource(in.GetParams().GetData())
+	if err != nil {
+		c.Logger.Errorf("phone.joinGroupCall - error: %v", err)
+		return nil, err
+	}
+
+	participants, err := c.svcCtx.Dao.GetParticipants(c.ctx, call.Id)
+	if err != nil {
+		c.Logger.Errorf("phone.joinGroupCall - error: %v", err)
+		return nil, mtproto.ErrInternelServerError
+	}
+
+	var (
+		old *groupcall.Participant
+		now = int32(time.Now().Unix())
+	)
+	for _, p := range participants {
+		if p.UserId == c.MD.UserId {
+			old = p
+		} else if p.Source == source {
+			err = mtproto.ErrGroupcallSsrcDuplicateMuch
+			c.Logger.Errorf("phone.joinGroupCall - error: %v", err)
+			return nil, err
+		}
+	}
+
+	mutedByAdmin := call.JoinMuted && !me.CanAdminManageCall()
+	p := &groupcall.Participant{
+		UserId:       c.MD.UserId,
+		Date:         now,
+		ActiveDate:   now,
+		Source:       source,
+		Muted:        in.Muted || mutedByAdmin,
+		MutedByAdmin: mutedByAdmin,
+		Volume:       groupcall.DefaultVolume,
+		VideoStopped: in.VideoStopped,
+	}
+	if old != nil {
+		// joined again, e.g. from another device, the settings of the admins are kept
+		p.Date = old.Date
+		p.MutedByAdmin = old.MutedByAdmin
+		p.Muted = in.Muted || old.MutedByAdmin
+		p.Volume = old.Volume
+		p.VolumeByAdmin = old.VolumeByAdmin
+		if old.Source != source {
+			c.svcCtx.Dao.Sfu.LeaveGroupCall(c.ctx, call.Id, old.UserId, old.Source)
+		}
+	}
+
+	params, err := c.svcCtx.Dao.Sfu.JoinGroupCall(c.ctx, call.Id, c.MD.UserId, source, in.GetParams().GetData())
+	if err != nil {
+		c.Logger.Errorf("phone.joinGroupCall - error: sfu.JoinGroupCall(%d, %d) - %v", call.Id, c.MD.UserId, err)
+		return nil, mtproto.ErrInternelServerError
+	}
+
+	if err = c.svcCtx.Dao.PutParticipant(c.ctx, call.Id, p); err != nil {
+		c.Logger.Errorf("phone.joinGroupCall - error: %v", err)
+		return nil, mtproto.ErrInternelServerError
+	}
+
+	rUpdates, err := c.pushGroupCallParticipants(chat, call, false, old == nil, p)
+	if err != nil {
+		c.Logger.Errorf("phone.joinGroupCall - error: %v", err)
+		return nil, mtproto.ErrInternelServerError
+	}
+
+	// the transport of the sfu is only sent to the device joining
+	rUpdates.Updates = append([]*mtproto.Update{
+		mtproto.MakeTLUpdateGroupCallConnection(&mtproto.Update{
+			Presentation: false,
+			Params:       mtproto.MakeTLDataJSON(&mtproto.DataJSON{Data: params}).To_DataJSON(),
+		}).To_Update(),
+	}, rUpdates.Updates...)
+
+	return rUpdates, nil
+}
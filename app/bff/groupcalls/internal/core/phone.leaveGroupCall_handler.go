@@ -0,0 +1,57 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+)
+
+// PhoneLeaveGroupCall
+// phone.leaveGroupCall#500377f9 call:InputGroupCall source:int = Updates;
+func (c *GroupCallsCore) PhoneLeaveGroupCall(in *mtproto.TLPhoneLeaveGroupCall) (*mtproto.Updates, error) {
+	call, chat, _, err := c.getGroupCall(in.Call)
+	if err != nil {
+		c.Logger.Errorf("phone.leaveGroupCall - error: %v", err)
+		return nil, err
+	}
+
+	p, err := c.svcCtx.Dao.GetParticipant(c.ctx, call.Id, c.MD.UserId)
+	if err != nil {
+		c.Logger.Errorf("phone.leaveGroupCall - error: %v", err)
+		return nil, mtproto.ErrInternelServerError
+	} else if p == nil || p.Source != in.Source {
+		// already left, or the user joined again with another source
+		return mtproto.MakeUpdatesByUpdates(), nil
+	}
+
+	if ok, err := c.leaveGroupCall(call, p); err != nil {
+		c.Logger.Errorf("phone.leaveGroupCall - error: %v", err)
+		return nil, mtproto.ErrInternelServerError
+	} else if !ok {
+		return mtproto.MakeUpdatesByUpdates(), nil
+	}
+
+	rUpdates, err := c.pushGroupCallParticipants(chat, call, true, false, p)
+	if err != nil {
+		c.Logger.Errorf("phone.leaveGroupCall - error: %v", err)
+		return nil, mtproto.ErrInternelServerError
+	}
+
+	return rUpdates, nil
+}
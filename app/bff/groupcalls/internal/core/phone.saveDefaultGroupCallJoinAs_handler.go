@@ -0,0 +1,49 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+)
+
+// PhoneSaveDefaultGroupCallJoinAs
+// phone.saveDefaultGroupCallJoinAs#575e1f8c peer:InputPeer join_as:InputPeer = Bool;
+func (c *GroupCallsCore) PhoneSaveDefaultGroupCallJoinAs(in *mtproto.TLPhoneSaveDefaultGroupCallJoinAs) (*mtproto.Bool, error) {
+	peer := mtproto.FromInputPeer2(c.MD.UserId, in.Peer)
+	if !peer.IsChat() {
+		err := mtproto.ErrPeerIdInvalid
+		c.Logger.Errorf("phone.saveDefaultGroupCallJoinAs - error: %v", err)
+		return nil, err
+	}
+
+	if _, _, err := c.getChat(peer.PeerId); err != nil {
+		c.Logger.Errorf("phone.saveDefaultGroupCallJoinAs - error: %v", err)
+		return nil, err
+	}
+
+	// the users only join as themselves, see phone.getGroupCallJoinAs
+	joinAs := mtproto.FromInputPeer2(c.MD.UserId, in.JoinAs)
+	if !joinAs.IsSelfUser(c.MD.UserId) {
+		err := mtproto.ErrJoinAsPeerInvalid
+		c.Logger.Errorf("phone.saveDefaultGroupCallJoinAs - error: %v", err)
+		return nil, err
+	}
+
+	return mtproto.BoolTrue, nil
+}
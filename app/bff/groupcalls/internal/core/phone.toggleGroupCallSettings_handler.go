@@ -0,0 +1,58 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+)
+
+// PhoneToggleGroupCallSettings
+// phone.toggleGroupCallSettings#74bbb43d flags:# reset_invite_hash:flags.1?true call:InputGroupCall join_muted:flags.0?Bool = Updates;
+func (c *GroupCallsCore) PhoneToggleGroupCallSettings(in *mtproto.TLPhoneToggleGroupCallSettings) (*mtproto.Updates, error) {
+	call, chat, me, err := c.getGroupCall(in.Call)
+	if err != nil {
+		c.Logger.Errorf("phone.toggleGroupCallSettings - error: %v", err)
+		return nil, err
+	} else if call.Discarded {
+		err = mtproto.ErrGroupCallInvalid
+		c.Logger.Errorf("phone.toggleGroupCallSettings - error: %v", err)
+		return nil, err
+	}
+
+	if !me.CanAdminManageCall() {
+		err = mtproto.ErrChatAdminRequired
+		c.Logger.Errorf("phone.toggleGroupCallSettings - error: %v", err)
+		return nil, err
+	}
+
+	// TODO: reset_invite_hash, the invite links of the calls are not supported
+	if in.JoinMuted == nil || mtproto.FromBool(in.JoinMuted) == call.JoinMuted {
+		err = mtproto.ErrGroupcallNotModified
+		c.Logger.Errorf("phone.toggleGroupCallSettings - error: %v", err)
+		return nil, err
+	}
+
+	call.JoinMuted = mtproto.FromBool(in.JoinMuted)
+	if err = c.svcCtx.Dao.PutGroupCall(c.ctx, call); err != nil {
+		c.Logger.Errorf("phone.toggleGroupCallSettings - error: %v", err)
+		return nil, mtproto.ErrInternelServerError
+	}
+
+	return c.pushGroupCall(chat, call), nil
+}
@@ -0,0 +1,51 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package dao
+
+import (
+	kafka "github.com/teamgram/marmota/pkg/mq"
+	"github.com/teamgram/marmota/pkg/net/rpcx"
+	"github.com/teamgram/teamgram-server/app/bff/groupcalls/internal/config"
+	msg_client "github.com/teamgram/teamgram-server/app/messenger/msg/msg/client"
+	sync_client "github.com/teamgram/teamgram-server/app/messenger/sync/client"
+	chat_client "github.com/teamgram/teamgram-server/app/service/biz/chat/client"
+	user_client "github.com/teamgram/teamgram-server/app/service/biz/user/client"
+	"github.com/teamgram/teamgram-server/pkg/groupcall"
+	"github.com/teamgram/teamgram-server/pkg/sfu"
+)
+
+type Dao struct {
+	*groupcall.Store
+	Sfu sfu.Adapter
+	user_client.UserClient
+	ChatClient *chat_client.ChatClientHelper
+	msg_client.MsgClient
+	sync_client.SyncClient
+}
+
+func New(c config.Config) *Dao {
+	return &Dao{
+		Store:      groupcall.NewStore(c.KV),
+		Sfu:        sfu.New(&c.Sfu),
+		UserClient: user_client.NewUserClient(rpcx.GetCachedRpcClient(c.UserClient)),
+		ChatClient: chat_client.NewChatClientHelper(rpcx.GetCachedRpcClient(c.ChatClient)),
+		MsgClient:  msg_client.NewMsgClient(rpcx.GetCachedRpcClient(c.MsgClient)),
+		SyncClient: sync_client.NewSyncMqClient(kafka.MustKafkaProducer(c.SyncClient)),
+	}
+}
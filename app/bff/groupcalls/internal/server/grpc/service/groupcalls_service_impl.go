@@ -0,0 +1,213 @@
+/*
+ * WARNING! All changes made in this file will be lost!
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright 2022 Teamgram Authors.
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package service
+
+import (
+	"context"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/bff/groupcalls/internal/core"
+)
+
+// PhoneCreateGroupCall
+// phone.createGroupCall#48cdc6d8 flags:# rtmp_stream:flags.2?true peer:InputPeer random_id:int title:flags.0?string schedule_date:flags.1?int = Updates;
+func (s *Service) PhoneCreateGroupCall(ctx context.Context, request *mtproto.TLPhoneCreateGroupCall) (*mtproto.Updates, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Infof("phone.createGroupCall - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.PhoneCreateGroupCall(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Infof("phone.createGroupCall - reply: %s", r.DebugString())
+	return r, err
+}
+
+// PhoneJoinGroupCall
+// phone.joinGroupCall#b132ff7b flags:# muted:flags.0?true video_stopped:flags.2?true call:InputGroupCall join_as:InputPeer invite_hash:flags.1?string params:DataJSON = Updates;
+func (s *Service) PhoneJoinGroupCall(ctx context.Context, request *mtproto.TLPhoneJoinGroupCall) (*mtproto.Updates, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Infof("phone.joinGroupCall - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.PhoneJoinGroupCall(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Infof("phone.joinGroupCall - reply: %s", r.DebugString())
+	return r, err
+}
+
+// PhoneLeaveGroupCall
+// phone.leaveGroupCall#500377f9 call:InputGroupCall source:int = Updates;
+func (s *Service) PhoneLeaveGroupCall(ctx context.Context, request *mtproto.TLPhoneLeaveGroupCall) (*mtproto.Updates, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Infof("phone.leaveGroupCall - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.PhoneLeaveGroupCall(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Infof("phone.leaveGroupCall - reply: %s", r.DebugString())
+	return r, err
+}
+
+// PhoneInviteToGroupCall
+// phone.inviteToGroupCall#7b393160 call:InputGroupCall users:Vector<InputUser> = Updates;
+func (s *Service) PhoneInviteToGroupCall(ctx context.Context, request *mtproto.TLPhoneInviteToGroupCall) (*mtproto.Updates, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Infof("phone.inviteToGroupCall - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.PhoneInviteToGroupCall(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Infof("phone.inviteToGroupCall - reply: %s", r.DebugString())
+	return r, err
+}
+
+// PhoneDiscardGroupCall
+// phone.discardGroupCall#7a777135 call:InputGroupCall = Updates;
+func (s *Service) PhoneDiscardGroupCall(ctx context.Context, request *mtproto.TLPhoneDiscardGroupCall) (*mtproto.Updates, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Infof("phone.discardGroupCall - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.PhoneDiscardGroupCall(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Infof("phone.discardGroupCall - reply: %s", r.DebugString())
+	return r, err
+}
+
+// PhoneToggleGroupCallSettings
+// phone.toggleGroupCallSettings#74bbb43d flags:# reset_invite_hash:flags.1?true call:InputGroupCall join_muted:flags.0?Bool = Updates;
+func (s *Service) PhoneToggleGroupCallSettings(ctx context.Context, request *mtproto.TLPhoneToggleGroupCallSettings) (*mtproto.Updates, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Infof("phone.toggleGroupCallSettings - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.PhoneToggleGroupCallSettings(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Infof("phone.toggleGroupCallSettings - reply: %s", r.DebugString())
+	return r, err
+}
+
+// PhoneGetGroupCall
+// phone.getGroupCall#41845db call:InputGroupCall limit:int = phone.GroupCall;
+func (s *Service) PhoneGetGroupCall(ctx context.Context, request *mtproto.TLPhoneGetGroupCall) (*mtproto.Phone_GroupCall, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Infof("phone.getGroupCall - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.PhoneGetGroupCall(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Infof("phone.getGroupCall - reply: %s", r.DebugString())
+	return r, err
+}
+
+// PhoneGetGroupParticipants
+// phone.getGroupParticipants#c558d8ab call:InputGroupCall ids:Vector<InputPeer> sources:Vector<int> offset:string limit:int = phone.GroupParticipants;
+func (s *Service) PhoneGetGroupParticipants(ctx context.Context, request *mtproto.TLPhoneGetGroupParticipants) (*mtproto.Phone_GroupParticipants, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Infof("phone.getGroupParticipants - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.PhoneGetGroupParticipants(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Infof("phone.getGroupParticipants - reply: %s", r.DebugString())
+	return r, err
+}
+
+// PhoneCheckGroupCall
+// phone.checkGroupCall#b59cf977 call:InputGroupCall sources:Vector<int> = Vector<int>;
+func (s *Service) PhoneCheckGroupCall(ctx context.Context, request *mtproto.TLPhoneCheckGroupCall) (*mtproto.Vector_Int, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Infof("phone.checkGroupCall - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.PhoneCheckGroupCall(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Infof("phone.checkGroupCall - reply: %s", r.DebugString())
+	return r, err
+}
+
+// PhoneEditGroupCallParticipant
+// phone.editGroupCallParticipant#a5273abf flags:# call:InputGroupCall participant:InputPeer muted:flags.0?Bool volume:flags.1?int raise_hand:flags.2?Bool video_stopped:flags.3?Bool video_paused:flags.4?Bool presentation_paused:flags.5?Bool = Updates;
+func (s *Service) PhoneEditGroupCallParticipant(ctx context.Context, request *mtproto.TLPhoneEditGroupCallParticipant) (*mtproto.Updates, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Infof("phone.editGroupCallParticipant - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.PhoneEditGroupCallParticipant(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Infof("phone.editGroupCallParticipant - reply: %s", r.DebugString())
+	return r, err
+}
+
+// PhoneEditGroupCallTitle
+// phone.editGroupCallTitle#1ca6ac0a call:InputGroupCall title:string = Updates;
+func (s *Service) PhoneEditGroupCallTitle(ctx context.Context, request *mtproto.TLPhoneEditGroupCallTitle) (*mtproto.Updates, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Infof("phone.editGroupCallTitle - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.PhoneEditGroupCallTitle(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Infof("phone.editGroupCallTitle - reply: %s", r.DebugString())
+	return r, err
+}
+
+// PhoneGetGroupCallJoinAs
+// phone.getGroupCallJoinAs#ef7c213a peer:InputPeer = phone.JoinAsPeers;
+func (s *Service) PhoneGetGroupCallJoinAs(ctx context.Context, request *mtproto.TLPhoneGetGroupCallJoinAs) (*mtproto.Phone_JoinAsPeers, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Infof("phone.getGroupCallJoinAs - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.PhoneGetGroupCallJoinAs(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Infof("phone.getGroupCallJoinAs - reply: %s", r.DebugString())
+	return r, err
+}
+
+// PhoneSaveDefaultGroupCallJoinAs
+// phone.saveDefaultGroupCallJoinAs#575e1f8c peer:InputPeer join_as:InputPeer = Bool;
+func (s *Service) PhoneSaveDefaultGroupCallJoinAs(ctx context.Context, request *mtproto.TLPhoneSaveDefaultGroupCallJoinAs) (*mtproto.Bool, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Infof("phone.saveDefaultGroupCallJoinAs - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.PhoneSaveDefaultGroupCallJoinAs(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Infof("phone.saveDefaultGroupCallJoinAs - reply: %s", r.DebugString())
+	return r, err
+}
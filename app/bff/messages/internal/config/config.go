@@ -20,11 +20,13 @@ package config
 
 import (
 	kafka "github.com/teamgram/marmota/pkg/mq"
+	"github.com/teamgram/teamgram-server/pkg/filereference/conf"
 	"github.com/zeromicro/go-zero/zrpc"
 )
 
 type Config struct {
 	zrpc.RpcServerConf
+	FileReference conf.FileReferenceConfig
 
 	UserClient     zrpc.RpcClientConf
 	ChatClient     zrpc.RpcClientConf
@@ -35,4 +37,6 @@ type Config struct {
 	MediaClient    zrpc.RpcClientConf
 	UsernameClient zrpc.RpcClientConf
 	SyncClient     *kafka.KafkaProducerConf
+	ChannelClient  zrpc.RpcClientConf
+	PollClient     zrpc.RpcClientConf
 }
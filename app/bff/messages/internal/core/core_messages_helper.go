@@ -227,7 +227,7 @@ func (c *MessagesCore) makeMediaByInputMedia(media *mtproto.InputMedia) (message
 		// inputMediaPoll#f94e5f1 flags:# poll:Poll correct_answers:flags.0?Vector<bytes> solution:flags.1?string solution_entities:flags.1?Vector<MessageEntity> = InputMedia;
 		messageMedia = mtproto.MakeTLMessageMediaPoll(&mtproto.MessageMedia{
 			Poll:    media.Poll,
-			Results: nil,
+			Results: mtproto.MakeTLPollResults(&mtproto.PollResults{}).To_PollResults(),
 		}).To_MessageMedia()
 
 	case mtproto.Predicate_inputMediaDice:
@@ -418,3 +418,33 @@ func (c *MessagesCore) fixMessageEntities(fromId int64, peer *mtproto.PeerUtil,
 	message.Entities = entities
 	return message, nil
 }
+
+// checkReplyMarkup keyboards can only be sent by bots, reply_markup from users is dropped
+func (c *MessagesCore) checkReplyMarkup(replyMarkup *mtproto.ReplyMarkup) (*mtproto.ReplyMarkup, error) {
+	if replyMarkup == nil {
+		return nil, nil
+	}
+
+	isBot, err := c.svcCtx.Dao.UserClient.UserIsBot(c.ctx, &userpb.TLUserIsBot{
+		Id: c.MD.UserId,
+	})
+	if err != nil {
+		return nil, err
+	} else if !mtproto.FromBool(isBot) {
+		return nil, nil
+	}
+
+	for _, row := range replyMarkup.GetRows() {
+		for _, button := range row.GetButtons() {
+			if button.GetPredicateName() != mtproto.Predicate_keyboardButtonCallback {
+				continue
+			}
+			// callback data: 1-64 bytes
+			if len(button.GetData()) == 0 || len(button.GetData()) > 64 {
+				return nil, mtproto.ErrButtonDataInvalid
+			}
+		}
+	}
+
+	return replyMarkup, nil
+}
@@ -0,0 +1,133 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/messenger/sync/sync"
+	channelpb "github.com/teamgram/teamgram-server/app/service/biz/channel/channel"
+	"github.com/teamgram/teamgram-server/app/service/biz/message/message"
+	pollpb "github.com/teamgram/teamgram-server/app/service/poll/poll"
+)
+
+// getMessagePollId returns the poll of a message, the channel messages are only
+// visible to the participants of the channel.
+func (c *MessagesCore) getMessagePollId(peer *mtproto.PeerUtil, msgId int32) (int64, error) {
+	var (
+		boxList *message.Vector_MessageBox
+		err     error
+	)
+
+	switch peer.PeerType {
+	case mtproto.PEER_SELF, mtproto.PEER_USER, mtproto.PEER_CHAT:
+		boxList, err = c.svcCtx.Dao.MessageClient.MessageGetUserMessageList(c.ctx, &message.TLMessageGetUserMessageList{
+			UserId: c.MD.UserId,
+			IdList: []int32{msgId},
+		})
+	case mtproto.PEER_CHANNEL:
+		var channelBoxList *channelpb.Vector_MessageBox
+		channelBoxList, err = c.svcCtx.Dao.ChannelClient.ChannelGetMessages(c.ctx, &channelpb.TLChannelGetMessages{
+			UserId:    c.MD.UserId,
+			ChannelId: peer.PeerId,
+			Id:        []int32{msgId},
+		})
+		if err == nil {
+			boxList = &message.Vector_MessageBox{Datas: channelBoxList.GetDatas()}
+		}
+	default:
+		return 0, mtproto.ErrPeerIdInvalid
+	}
+	if err != nil {
+		return 0, err
+	} else if len(boxList.GetDatas()) != 1 {
+		return 0, mtproto.ErrMsgIdInvalid
+	}
+
+	pollId, err := mtproto.GetPollIdByMessage(boxList.Datas[0].GetMessage().GetMedia())
+	if err != nil {
+		return 0, mtproto.ErrMsgIdInvalid
+	}
+
+	return pollId, nil
+}
+
+// pushUpdateMessagePoll sends the results seen by the voter to the other sessions of the voter,
+// the other participants get the min results and keep their own chosen answers.
+func (c *MessagesCore) pushUpdateMessagePoll(peer *mtproto.PeerUtil, mediaPoll *mtproto.MessageMedia) *mtproto.Updates {
+	rUpdates := mtproto.MakeUpdatesByUpdates(mtproto.MakeTLUpdateMessagePoll(&mtproto.Update{
+		PollId:  mediaPoll.GetPoll().GetId(),
+		Poll:    mediaPoll.GetPoll(),
+		Results: mediaPoll.GetResults(),
+	}).To_Update())
+
+	c.svcCtx.Dao.SyncClient.SyncUpdatesNotMe(
+		c.ctx,
+		&sync.TLSyncUpdatesNotMe{
+			UserId:    c.MD.UserId,
+			AuthKeyId: c.MD.AuthId,
+			Updates:   rUpdates,
+		})
+
+	minUpdates := mtproto.MakeUpdatesByUpdates(mtproto.MakeTLUpdateMessagePoll(&mtproto.Update{
+		PollId:  mediaPoll.GetPoll().GetId(),
+		Poll:    mediaPoll.GetPoll(),
+		Results: pollpb.MakeMinPollResults(mediaPoll.GetResults()),
+	}).To_Update())
+
+	switch peer.PeerType {
+	case mtproto.PEER_USER:
+		if peer.PeerId != c.MD.UserId {
+			c.svcCtx.Dao.SyncClient.SyncPushUpdates(
+				c.ctx,
+				&sync.TLSyncPushUpdates{
+					UserId:  peer.PeerId,
+					Updates: minUpdates,
+				})
+		}
+	case mtproto.PEER_CHAT:
+		c.svcCtx.Dao.SyncClient.SyncBroadcastUpdates(
+			c.ctx,
+			&sync.TLSyncBroadcastUpdates{
+				BroadcastType: sync.BroadcastTypeChat,
+				ChatId:        peer.PeerId,
+				ExcludeIdList: []int64{c.MD.UserId},
+				Updates:       minUpdates,
+			})
+	case mtproto.PEER_CHANNEL:
+		// sync.broadcastUpdates only serves the basic groups
+		idList, _ := c.svcCtx.Dao.ChannelClient.ChannelGetChannelParticipantIdList(
+			c.ctx,
+			&channelpb.TLChannelGetChannelParticipantIdList{
+				ChannelId: peer.PeerId,
+			})
+		for _, id := range idList.GetDatas() {
+			if id == c.MD.UserId {
+				continue
+			}
+			c.svcCtx.Dao.SyncClient.SyncPushUpdates(
+				c.ctx,
+				&sync.TLSyncPushUpdates{
+					UserId:  id,
+					Updates: minUpdates,
+				})
+		}
+	}
+
+	return rUpdates
+}
@@ -0,0 +1,81 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"hash/crc32"
+	"strings"
+
+	"github.com/teamgram/proto/mtproto"
+	channelpb "github.com/teamgram/teamgram-server/app/service/biz/channel/channel"
+	chatpb "github.com/teamgram/teamgram-server/app/service/biz/chat/chat"
+	"github.com/teamgram/teamgram-server/app/service/biz/message/message"
+)
+
+// getAvailableReactions returns the reactions allowed in a private chat or a basic group,
+// only the participants of the group get them.
+func (c *MessagesCore) getAvailableReactions(peer *mtproto.PeerUtil) ([]string, error) {
+	switch peer.PeerType {
+	case mtproto.PEER_SELF, mtproto.PEER_USER:
+		return message.DefaultAvailableReactions, nil
+	case mtproto.PEER_CHAT:
+		chat, err := c.svcCtx.Dao.ChatClient.Client().ChatGetMutableChat(c.ctx, &chatpb.TLChatGetMutableChat{
+			ChatId: peer.PeerId,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := chat.GetImmutableChatParticipant(c.MD.UserId); !ok {
+			return nil, mtproto.ErrChatIdInvalid
+		}
+		return chat.AvailableReactions(), nil
+	case mtproto.PEER_CHANNEL:
+		// the channel messages are kept by the channel service and carry no reactions,
+		// the reactions stay disabled in the channels.
+		if _, err := c.getChannelForReactions(peer.PeerId); err != nil {
+			return nil, err
+		}
+		return []string{}, nil
+	default:
+		return nil, mtproto.ErrPeerIdInvalid
+	}
+}
+
+// getChannelForReactions returns the channel seen by c.MD.UserId, who isn't a participant only sees a public channel.
+func (c *MessagesCore) getChannelForReactions(channelId int64) (*channelpb.MutableChannel, error) {
+	mChannel, err := c.svcCtx.Dao.ChannelClient.ChannelGetMutableChannel(c.ctx, &channelpb.TLChannelGetMutableChannel{
+		ChannelId: channelId,
+		Id:        []int64{c.MD.UserId},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if me, ok := mChannel.GetImmutableChannelParticipant(c.MD.UserId); ok && me.IsChatMemberStateKicked() {
+		return nil, mtproto.ErrChannelPrivate
+	} else if (!ok || !me.IsChatMemberStateNormal()) && mChannel.Username() == "" {
+		return nil, mtproto.ErrChannelPrivate
+	}
+
+	return mChannel, nil
+}
+
+// makeAvailableReactionsHash the hash of messages.getAvailableReactions
+func makeAvailableReactionsHash(reactions []string) int32 {
+	return int32(crc32.ChecksumIEEE([]byte(strings.Join(reactions, ","))))
+}
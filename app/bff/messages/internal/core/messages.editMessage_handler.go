@@ -23,6 +23,7 @@ import (
 	"github.com/teamgram/proto/mtproto"
 	msgpb "github.com/teamgram/teamgram-server/app/messenger/msg/msg/msg"
 	"github.com/teamgram/teamgram-server/app/service/biz/message/message"
+	pollpb "github.com/teamgram/teamgram-server/app/service/poll/poll"
 	"time"
 )
 
@@ -88,17 +89,25 @@ func (c *MessagesCore) MessagesEditMessage(in *mtproto.TLMessagesEditMessage) (*
 
 	// reply_markup
 	if in.ReplyMarkup != nil {
-		outMessage.ReplyMarkup = in.ReplyMarkup
-	}
-
-	if in.Media != nil {
-		outMessage.Media, err = c.makeMediaByInputMedia(in.Media)
+		replyMarkup, err := c.checkReplyMarkup(in.ReplyMarkup)
 		if err != nil {
-			c.Logger.Errorf("messages.editMessage - media error: %v", err)
+			c.Logger.Errorf("messages.editMessage - error: %v", err)
 			return nil, err
+		} else if replyMarkup != nil {
+			outMessage.ReplyMarkup = replyMarkup
 		}
-		if outMessage.Media.PredicateName == mtproto.Predicate_messageMediaPoll {
+	}
+
+	if in.Media != nil {
+		// a poll is edited to close it, the poll of the message is kept
+		if in.Media.GetPredicateName() == mtproto.Predicate_inputMediaPoll {
 			isPoll = true
+		} else {
+			outMessage.Media, err = c.makeMediaByInputMedia(in.Media)
+			if err != nil {
+				c.Logger.Errorf("messages.editMessage - media error: %v", err)
+				return nil, err
+			}
 		}
 	}
 	// message
@@ -114,72 +123,27 @@ func (c *MessagesCore) MessagesEditMessage(in *mtproto.TLMessagesEditMessage) (*
 	}
 
 	if isPoll {
-		//var (
-		//	pollId    int64
-		//	mediaPoll *mtproto.MessageMedia
-		//	// MediaPoll
-		//)
-		//pollId, err = mtproto.GetPollIdByMessage(outMessage.GetMedia())
-		//if err != nil {
-		//	c.Logger.Errorf("messages.editMessage - media error: %v", err)
-		//	return nil, err
-		//}
-		//mediaPoll, err = c.svcCtx.PollClient.PollCloseMediaPoll(c.ctx, &pollpb.TLPollCloseMediaPoll{
-		//	UserId: c.MD.UserId,
-		//	PollId: pollId,
-		//})
-		//if err != nil {
-		//	c.Logger.Errorf("messages.editMessage - media error: %v", err)
-		//	return nil, err
-		//}
-		//_ = mediaPoll
-		//
-		//// TODO(@benqi): sendTo editMessage???
-		//// rUpdate := mtproto.MakeUpdatesByUpdates(mediaPoll.ToUpdateMessagePoll())
-		//rUpdates := mtproto.MakeUpdatesByUpdates(mtproto.MakeTLUpdateMessagePoll(&mtproto.Update{
-		//	PollId:  pollId,
-		//	Poll:    mediaPoll.GetPoll(),
-		//	Results: mediaPoll.GetResults(),
-		//}).To_Update())
-		//
-		//c.svcCtx.Dao.SyncClient.SyncUpdatesNotMe(
-		//	c.ctx, &sync.TLSyncUpdatesNotMe{
-		//		UserId:    c.MD.UserId,
-		//		AuthKeyId: c.MD.AuthId,
-		//		Updates:   rUpdates,
-		//	})
-		//switch peer.PeerType {
-		//case mtproto.PEER_USER:
-		//	c.svcCtx.Dao.SyncClient.SyncPushUpdates(
-		//		c.ctx,
-		//		&sync.TLSyncPushUpdates{
-		//			UserId:  peer.PeerId,
-		//			Updates: rUpdates,
-		//		})
-		//case mtproto.PEER_CHAT:
-		//	var (
-		//		broadcastType int32 = sync.BroadcastTypeChat
-		//	)
-		//	if peer.PeerType == mtproto.PEER_CHANNEL {
-		//		broadcastType = sync.BroadcastTypeChannel
-		//	}
-		//	c.svcCtx.Dao.SyncClient.SyncBroadcastUpdates(
-		//		c.ctx,
-		//		&sync.TLSyncBroadcastUpdates{
-		//			BroadcastType: broadcastType,
-		//			ChatId:        peer.PeerId,
-		//			ExcludeIdList: []int64{c.MD.UserId},
-		//			Updates:       rUpdates,
-		//		})
-		//case mtproto.PEER_CHANNEL:
-		//	c.Logger.Errorf("messages.forwardMessages blocked, License key from https://teamgram.net required to unlock enterprise features.")
-		//
-		//	return nil, mtproto.ErrEnterpriseIsBlocked
-		//}
-		//return rUpdates, nil
-		c.Logger.Errorf("messages.editMessage blocked, License key from https://teamgram.net required to unlock enterprise features.")
+		if !in.Media.GetPoll().GetClosed() {
+			err = mtproto.ErrMediaInvalid
+			c.Logger.Errorf("messages.editMessage - media error: %v", err)
+			return nil, err
+		}
 
-		return nil, mtproto.ErrEnterpriseIsBlocked
+		pollId, err := mtproto.GetPollIdByMessage(outMessage.GetMedia())
+		if err != nil {
+			c.Logger.Errorf("messages.editMessage - media error: %v", err)
+			return nil, err
+		}
+		mediaPoll, err := c.svcCtx.Dao.PollClient.PollCloseMediaPoll(c.ctx, &pollpb.TLPollCloseMediaPoll{
+			UserId: c.MD.UserId,
+			PollId: pollId,
+		})
+		if err != nil {
+			c.Logger.Errorf("messages.editMessage - media error: %v", err)
+			return nil, err
+		}
+
+		return c.pushUpdateMessagePoll(peer, mediaPoll), nil
 	} else {
 		rUpdates, err := c.svcCtx.Dao.MsgClient.MsgEditMessage(c.ctx, &msgpb.TLMsgEditMessage{
 			UserId:    c.MD.UserId,
@@ -198,6 +162,7 @@ func (c *MessagesCore) MessagesEditMessage(in *mtproto.TLMessagesEditMessage) (*
 			c.Logger.Errorf("messages.editMessage - error: %v", err)
 			return nil, err
 		}
+		c.svcCtx.Dao.FileReference.SetUpdates(c.MD.UserId, rUpdates)
 
 		return rUpdates, nil
 	}
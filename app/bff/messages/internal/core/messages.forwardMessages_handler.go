@@ -101,9 +101,11 @@ func (c *MessagesCore) MessagesForwardMessages(in *mtproto.TLMessagesForwardMess
 	})
 	if err != nil {
 		c.Logger.Errorf("messages.forwardMessages - error: %v", err)
+		return nil, err
 	}
+	c.svcCtx.Dao.FileReference.SetUpdates(c.MD.UserId, rUpdates)
 
-	return rUpdates, err
+	return rUpdates, nil
 }
 
 func (c *MessagesCore) checkForwardPrivacy(ctx context.Context, selfUserId, checkId int64) bool {
@@ -0,0 +1,55 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/biz/message/message"
+)
+
+// MessagesGetAvailableReactions
+// messages.getAvailableReactions#18dea0ac hash:int = messages.AvailableReactions;
+func (c *MessagesCore) MessagesGetAvailableReactions(in *mtproto.TLMessagesGetAvailableReactions) (*mtproto.Messages_AvailableReactions, error) {
+	hash := makeAvailableReactionsHash(message.DefaultAvailableReactions)
+	if in.Hash != 0 && in.Hash == hash {
+		return mtproto.MakeTLMessagesAvailableReactionsNotModified(nil).To_Messages_AvailableReactions(), nil
+	}
+
+	reactions := make([]*mtproto.AvailableReaction, 0, len(message.DefaultAvailableReactions))
+	for _, reaction := range message.DefaultAvailableReactions {
+		// TODO: animations
+		reactions = append(reactions, mtproto.MakeTLAvailableReaction(&mtproto.AvailableReaction{
+			Inactive:          false,
+			Reaction:          reaction,
+			Title:             reaction,
+			StaticIcon:        mtproto.MakeTLDocumentEmpty(nil).To_Document(),
+			AppearAnimation:   mtproto.MakeTLDocumentEmpty(nil).To_Document(),
+			SelectAnimation:   mtproto.MakeTLDocumentEmpty(nil).To_Document(),
+			ActivateAnimation: mtproto.MakeTLDocumentEmpty(nil).To_Document(),
+			EffectAnimation:   mtproto.MakeTLDocumentEmpty(nil).To_Document(),
+			AroundAnimation:   nil,
+			CenterIcon:        nil,
+		}).To_AvailableReaction())
+	}
+
+	return mtproto.MakeTLMessagesAvailableReactions(&mtproto.Messages_AvailableReactions{
+		Hash:      hash,
+		Reactions: reactions,
+	}).To_Messages_AvailableReactions(), nil
+}
@@ -20,6 +20,7 @@ package core
 
 import (
 	"github.com/teamgram/proto/mtproto"
+	channelpb "github.com/teamgram/teamgram-server/app/service/biz/channel/channel"
 	chatpb "github.com/teamgram/teamgram-server/app/service/biz/chat/chat"
 	"github.com/teamgram/teamgram-server/app/service/biz/message/message"
 	userpb "github.com/teamgram/teamgram-server/app/service/biz/user/user"
@@ -30,12 +31,14 @@ import (
 func (c *MessagesCore) MessagesGetHistory(in *mtproto.TLMessagesGetHistory) (*mtproto.Messages_Messages, error) {
 	// TODO(@benqi): 重复FromInputPeer2
 	var (
-		err  error
-		peer = mtproto.FromInputPeer2(c.MD.UserId, in.GetPeer())
-		chat *chatpb.MutableChat
-		//channel   *channelpb.MutableChannel
-		//isChannel bool
-		limit = in.Limit
+		err       error
+		peer      = mtproto.FromInputPeer2(c.MD.UserId, in.GetPeer())
+		chat      *chatpb.MutableChat
+		channel   *channelpb.MutableChannel
+		isChannel bool
+		boxList   *message.Vector_MessageBox
+		count     *mtproto.Int32
+		limit     = in.Limit
 	)
 
 	if limit > 50 {
@@ -57,56 +60,79 @@ func (c *MessagesCore) MessagesGetHistory(in *mtproto.TLMessagesGetHistory) (*mt
 			_ = chat
 		}
 	case mtproto.PEER_CHANNEL:
-		//// 400	CHANNEL_INVALID	The provided channel is invalid
-		//// 400	CHANNEL_PRIVATE	You haven't joined this channel/supergroup
-		//channel, err = c.svcCtx.Dao.ChannelClient.ChannelGetMutableChannel(
-		//	c.ctx,
-		//	&channelpb.TLChannelGetMutableChannel{
-		//		ChannelId: peer.PeerId,
-		//		Id:        []int64{c.MD.UserId},
-		//	})
-		//if err != nil {
-		//	err = mtproto.ErrChannelInvalid
-		//	c.Logger.Errorf("messages.getHistory - error: %v", err)
-		//	return nil, err
-		//}
-		//
-		//me := channel.GetImmutableChannelParticipant(c.MD.UserId)
-		//if me != nil {
-		//	// TODO
-		//	// minId = mathx.MaxInt(int(me.AvailableMinId), int(in.MinId))
-		//}
-		//
-		//isChannel = true
+		// 400	CHANNEL_INVALID	The provided channel is invalid
+		// 400	CHANNEL_PRIVATE	You haven't joined this channel/supergroup
+		channel, err = c.svcCtx.Dao.ChannelClient.ChannelGetMutableChannel(
+			c.ctx,
+			&channelpb.TLChannelGetMutableChannel{
+				ChannelId: peer.PeerId,
+				Id:        []int64{c.MD.UserId},
+			})
+		if err != nil {
+			err = mtproto.ErrChannelInvalid
+			c.Logger.Errorf("messages.getHistory - error: %v", err)
+			return nil, err
+		}
+
+		if me, _ := channel.GetImmutableChannelParticipant(c.MD.UserId); me == nil || me.IsChatMemberStateKicked() {
+			err = mtproto.ErrChannelPrivate
+			c.Logger.Errorf("messages.getHistory - error: %v", err)
+			return nil, err
+		}
+
+		isChannel = true
 	default:
 		err = mtproto.ErrPeerIdInvalid
 		c.Logger.Errorf("messages.getHistory - error: %v", err)
 		return nil, err
 	}
 
-	boxList, err := c.svcCtx.Dao.MessageClient.MessageGetHistoryMessages(c.ctx, &message.TLMessageGetHistoryMessages{
-		UserId:     c.MD.UserId,
-		PeerType:   peer.PeerType,
-		PeerId:     peer.PeerId,
-		OffsetId:   in.OffsetId,
-		OffsetDate: in.OffsetDate,
-		AddOffset:  in.AddOffset,
-		Limit:      limit,
-		MaxId:      in.MaxId,
-		MinId:      in.MinId,
-		Hash:       in.Hash,
-	})
-	if err != nil {
-		c.Logger.Errorf("messages.getHistory - error: %v", err)
-	}
+	if !isChannel {
+		boxList, err = c.svcCtx.Dao.MessageClient.MessageGetHistoryMessages(c.ctx, &message.TLMessageGetHistoryMessages{
+			UserId:     c.MD.UserId,
+			PeerType:   peer.PeerType,
+			PeerId:     peer.PeerId,
+			OffsetId:   in.OffsetId,
+			OffsetDate: in.OffsetDate,
+			AddOffset:  in.AddOffset,
+			Limit:      limit,
+			MaxId:      in.MaxId,
+			MinId:      in.MinId,
+			Hash:       in.Hash,
+		})
+		if err != nil {
+			c.Logger.Errorf("messages.getHistory - error: %v", err)
+		}
 
-	count, _ := c.svcCtx.Dao.MessageClient.MessageGetHistoryMessagesCount(
-		c.ctx,
-		&message.TLMessageGetHistoryMessagesCount{
-			UserId:   c.MD.UserId,
-			PeerType: peer.PeerType,
-			PeerId:   peer.PeerId,
+		count, _ = c.svcCtx.Dao.MessageClient.MessageGetHistoryMessagesCount(
+			c.ctx,
+			&message.TLMessageGetHistoryMessagesCount{
+				UserId:   c.MD.UserId,
+				PeerType: peer.PeerType,
+				PeerId:   peer.PeerId,
+			})
+	} else {
+		channelBoxList, err := c.svcCtx.Dao.ChannelClient.ChannelGetHistory(c.ctx, &channelpb.TLChannelGetHistory{
+			UserId:    c.MD.UserId,
+			ChannelId: peer.PeerId,
+			OffsetId:  in.OffsetId,
+			AddOffset: in.AddOffset,
+			Limit:     limit,
+			MaxId:     in.MaxId,
+			MinId:     in.MinId,
 		})
+		if err != nil {
+			c.Logger.Errorf("messages.getHistory - error: %v", err)
+		}
+
+		// channel message ids are sequential
+		boxList = &message.Vector_MessageBox{
+			Datas: channelBoxList.GetDatas(),
+		}
+		count = &mtproto.Int32{
+			V: channel.TopMessage(),
+		}
+	}
 
 	var (
 		messages []*mtproto.Message
@@ -115,6 +141,7 @@ func (c *MessagesCore) MessagesGetHistory(in *mtproto.TLMessagesGetHistory) (*mt
 	)
 	boxList.Visit(c.MD.UserId,
 		func(messageList []*mtproto.Message) {
+			c.svcCtx.Dao.FileReference.SetMessages(c.MD.UserId, messageList...)
 			messages = messageList
 		},
 		func(userIdList []int64) {
@@ -132,45 +159,45 @@ func (c *MessagesCore) MessagesGetHistory(in *mtproto.TLMessagesGetHistory) (*mt
 			chats = append(chats, mChats.GetChatListByIdList(c.MD.UserId, chatIdList...)...)
 		},
 		func(channelIdList []int64) {
-			//// TODO: handler other...
-			//if channel != nil {
-			//	chats = append(chats, channel.ToUnsafeChat(c.MD.UserId))
-			//}
+			// TODO: handler other...
+			if channel != nil {
+				chats = append(chats, channel.ToUnsafeChat(c.MD.UserId))
+			}
 		})
 
 	var (
 		rValues *mtproto.Messages_Messages
 	)
 
-	//if !isChannel {
-	if boxList.Length() == limit {
-		rValues = mtproto.MakeTLMessagesMessagesSlice(&mtproto.Messages_Messages{
+	if !isChannel {
+		if boxList.Length() == limit {
+			rValues = mtproto.MakeTLMessagesMessagesSlice(&mtproto.Messages_Messages{
+				Inexact:        false, // TODO: ???
+				Count:          count.V,
+				NextRate:       nil, // TODO: ???
+				OffsetIdOffset: nil, // TODO: ???
+				Messages:       messages,
+				Users:          mtproto.ToSafeUsers(users),
+				Chats:          mtproto.ToSafeChats(chats),
+			}).To_Messages_Messages()
+		} else {
+			rValues = mtproto.MakeTLMessagesMessages(&mtproto.Messages_Messages{
+				Messages: messages,
+				Users:    mtproto.ToSafeUsers(users),
+				Chats:    mtproto.ToSafeChats(chats),
+			}).To_Messages_Messages()
+		}
+	} else {
+		rValues = mtproto.MakeTLMessagesChannelMessages(&mtproto.Messages_Messages{
 			Inexact:        false, // TODO: ???
+			Pts:            channel.Pts(),
 			Count:          count.V,
-			NextRate:       nil, // TODO: ???
 			OffsetIdOffset: nil, // TODO: ???
 			Messages:       messages,
-			Users:          mtproto.ToSafeUsers(users),
 			Chats:          mtproto.ToSafeChats(chats),
-		}).To_Messages_Messages()
-	} else {
-		rValues = mtproto.MakeTLMessagesMessages(&mtproto.Messages_Messages{
-			Messages: messages,
-			Users:    mtproto.ToSafeUsers(users),
-			Chats:    mtproto.ToSafeChats(chats),
+			Users:          mtproto.ToSafeUsers(users),
 		}).To_Messages_Messages()
 	}
-	//} else {
-	//	rValues = mtproto.MakeTLMessagesChannelMessages(&mtproto.Messages_Messages{
-	//		Inexact:        false, // TODO: ???
-	//		Pts:            channel.Pts(),
-	//		Count:          count.V,
-	//		OffsetIdOffset: &types.Int32Value{Value: channel.TopMessage() - in.OffsetId}, // TODO: ???
-	//		Messages:       messages,
-	//		Chats:          mtproto.ToSafeChats(chats),
-	//		Users:          mtproto.ToSafeUsers(users),
-	//	}).To_Messages_Messages()
-	//}
 
 	return rValues, nil
 }
@@ -0,0 +1,68 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	msgpb "github.com/teamgram/teamgram-server/app/messenger/msg/msg/msg"
+	userpb "github.com/teamgram/teamgram-server/app/service/biz/user/user"
+)
+
+// MessagesGetMessageReactionsList
+// messages.getMessageReactionsList#e0ee6b77 flags:# peer:InputPeer id:int reaction:flags.0?string offset:flags.1?string limit:int = messages.MessageReactionsList;
+func (c *MessagesCore) MessagesGetMessageReactionsList(in *mtproto.TLMessagesGetMessageReactionsList) (*mtproto.Messages_MessageReactionsList, error) {
+	peer := mtproto.FromInputPeer2(c.MD.UserId, in.Peer)
+
+	_, err := c.getAvailableReactions(peer)
+	if err != nil {
+		c.Logger.Errorf("messages.getMessageReactionsList - error: %v", err)
+		return nil, err
+	}
+	if peer.PeerType == mtproto.PEER_SELF {
+		peer.PeerType = mtproto.PEER_USER
+	}
+
+	reactionsList, err := c.svcCtx.Dao.MsgClient.MsgGetMessageReactionsList(c.ctx, &msgpb.TLMsgGetMessageReactionsList{
+		UserId:   c.MD.UserId,
+		PeerType: peer.PeerType,
+		PeerId:   peer.PeerId,
+		MsgId:    in.Id,
+		Reaction: in.Reaction,
+		Offset:   in.Offset,
+		Limit:    in.Limit,
+	})
+	if err != nil {
+		c.Logger.Errorf("messages.getMessageReactionsList - error: %v", err)
+		return nil, err
+	}
+
+	if len(reactionsList.Reactions) > 0 {
+		userIdList := make([]int64, 0, len(reactionsList.Reactions)+1)
+		for _, v := range reactionsList.Reactions {
+			userIdList = append(userIdList, v.GetPeerId().GetUserId())
+		}
+		mUsers, _ := c.svcCtx.Dao.UserClient.UserGetMutableUsers(c.ctx,
+			&userpb.TLUserGetMutableUsers{
+				Id: append(userIdList, c.MD.UserId),
+			})
+		reactionsList.Users = mUsers.GetUserListByIdList(c.MD.UserId, userIdList...)
+	}
+
+	return reactionsList, nil
+}
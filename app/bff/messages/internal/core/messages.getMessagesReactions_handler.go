@@ -0,0 +1,85 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/biz/message/message"
+	userpb "github.com/teamgram/teamgram-server/app/service/biz/user/user"
+)
+
+// MessagesGetMessagesReactions
+// messages.getMessagesReactions#8bba90e6 peer:InputPeer id:Vector<int> = Updates;
+func (c *MessagesCore) MessagesGetMessagesReactions(in *mtproto.TLMessagesGetMessagesReactions) (*mtproto.Updates, error) {
+	peer := mtproto.FromInputPeer2(c.MD.UserId, in.Peer)
+
+	_, err := c.getAvailableReactions(peer)
+	if err != nil {
+		c.Logger.Errorf("messages.getMessagesReactions - error: %v", err)
+		return nil, err
+	}
+	if peer.PeerType == mtproto.PEER_SELF {
+		peer.PeerType = mtproto.PEER_USER
+	}
+
+	boxList, err := c.svcCtx.Dao.MessageClient.MessageGetUserMessageList(c.ctx, &message.TLMessageGetUserMessageList{
+		UserId: c.MD.UserId,
+		IdList: in.Id,
+	})
+	if err != nil {
+		c.Logger.Errorf("messages.getMessagesReactions - error: %v", err)
+		return nil, err
+	}
+
+	var (
+		updateList = make([]*mtproto.Update, 0, len(boxList.GetDatas()))
+		idList     []int64
+	)
+	boxList.Walk(func(idx int, v *mtproto.MessageBox) {
+		if v.PeerType != peer.PeerType || v.PeerId != peer.PeerId {
+			return
+		}
+
+		reactions := v.GetMessage().GetReactions()
+		if reactions == nil {
+			reactions = message.MakeMessageReactions(c.MD.UserId, peer.IsChat(), nil)
+		}
+		for _, r := range reactions.GetRecentReactions() {
+			idList = append(idList, r.GetPeerId().GetUserId())
+		}
+		updateList = append(updateList, mtproto.MakeTLUpdateMessageReactions(&mtproto.Update{
+			Peer_PEER:   peer.ToPeer(),
+			MsgId_INT32: v.MessageId,
+			Reactions:   reactions,
+		}).To_Update())
+	})
+
+	var (
+		users []*mtproto.User
+	)
+	if len(idList) > 0 {
+		mUsers, _ := c.svcCtx.Dao.UserClient.UserGetMutableUsers(c.ctx,
+			&userpb.TLUserGetMutableUsers{
+				Id: append(idList, c.MD.UserId),
+			})
+		users = mUsers.GetUserListByIdList(c.MD.UserId, idList...)
+	}
+
+	return mtproto.MakeUpdatesByUpdatesUsers(users, updateList...), nil
+}
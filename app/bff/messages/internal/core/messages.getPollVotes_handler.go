@@ -0,0 +1,63 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	userpb "github.com/teamgram/teamgram-server/app/service/biz/user/user"
+	pollpb "github.com/teamgram/teamgram-server/app/service/poll/poll"
+)
+
+// MessagesGetPollVotes
+// messages.getPollVotes#b86e380e flags:# peer:InputPeer id:int option:flags.0?bytes offset:flags.1?string limit:int = messages.VotesList;
+func (c *MessagesCore) MessagesGetPollVotes(in *mtproto.TLMessagesGetPollVotes) (*mtproto.Messages_VotesList, error) {
+	peer := mtproto.FromInputPeer2(c.MD.UserId, in.Peer)
+
+	pollId, err := c.getMessagePollId(peer, in.Id)
+	if err != nil {
+		c.Logger.Errorf("messages.getPollVotes - error: %v", err)
+		return nil, err
+	}
+
+	votesList, err := c.svcCtx.Dao.PollClient.PollGetPollVotes(c.ctx, &pollpb.TLPollGetPollVotes{
+		UserId: c.MD.UserId,
+		PollId: pollId,
+		Option: in.Option,
+		Offset: in.Offset,
+		Limit:  in.Limit,
+	})
+	if err != nil {
+		c.Logger.Errorf("messages.getPollVotes - error: %v", err)
+		return nil, err
+	}
+
+	if len(votesList.Votes) > 0 {
+		userIdList := make([]int64, 0, len(votesList.Votes)+1)
+		for _, v := range votesList.Votes {
+			userIdList = append(userIdList, v.UserId)
+		}
+		mUsers, _ := c.svcCtx.Dao.UserClient.UserGetMutableUsers(c.ctx,
+			&userpb.TLUserGetMutableUsers{
+				Id: append(userIdList, c.MD.UserId),
+			})
+		votesList.Users = mUsers.GetUserListByIdList(c.MD.UserId, userIdList...)
+	}
+
+	return votesList, nil
+}
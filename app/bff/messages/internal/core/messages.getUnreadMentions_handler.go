@@ -20,6 +20,7 @@ package core
 
 import (
 	"github.com/teamgram/proto/mtproto"
+	channelpb "github.com/teamgram/teamgram-server/app/service/biz/channel/channel"
 	chatpb "github.com/teamgram/teamgram-server/app/service/biz/chat/chat"
 	messagepb "github.com/teamgram/teamgram-server/app/service/biz/message/message"
 	userpb "github.com/teamgram/teamgram-server/app/service/biz/user/user"
@@ -134,6 +135,7 @@ func (c *MessagesCore) MessagesGetUnreadMentions(in *mtproto.TLMessagesGetUnread
 
 	boxList.Visit(c.MD.UserId,
 		func(messageList []*mtproto.Message) {
+			c.svcCtx.Dao.FileReference.SetMessages(c.MD.UserId, messageList...)
 			rValues.Messages = messageList
 		},
 		func(userIdList []int64) {
@@ -151,14 +153,14 @@ func (c *MessagesCore) MessagesGetUnreadMentions(in *mtproto.TLMessagesGetUnread
 			rValues.Chats = append(rValues.Chats, mChats.GetChatListByIdList(c.MD.UserId, chatIdList...)...)
 		},
 		func(channelIdList []int64) {
-			//mChannels, _ := c.svcCtx.Dao.ChannelClient.ChannelGetChannelListByIdList(c.ctx,
-			//	&channelpb.TLChannelGetChannelListByIdList{
-			//		SelfUserId: c.MD.UserId,
-			//		Id:         channelIdList,
-			//	})
-			//if len(mChannels.GetDatas()) > 0 {
-			//	rValues.Chats = append(rValues.Chats, mChannels.GetDatas()...)
-			//}
+			mChannels, _ := c.svcCtx.Dao.ChannelClient.ChannelGetChannelListByIdList(c.ctx,
+				&channelpb.TLChannelGetChannelListByIdList{
+					SelfUserId: c.MD.UserId,
+					Id:         channelIdList,
+				})
+			if len(mChannels.GetDatas()) > 0 {
+				rValues.Chats = append(rValues.Chats, mChannels.GetDatas()...)
+			}
 		})
 
 	return rValues, nil
@@ -0,0 +1,66 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	msgpb "github.com/teamgram/teamgram-server/app/messenger/msg/msg/msg"
+)
+
+// MessagesReadReactions
+// messages.readReactions#82e251d7 peer:InputPeer = messages.AffectedHistory;
+func (c *MessagesCore) MessagesReadReactions(in *mtproto.TLMessagesReadReactions) (*mtproto.Messages_AffectedHistory, error) {
+	peer := mtproto.FromInputPeer2(c.MD.UserId, in.Peer)
+
+	switch peer.PeerType {
+	case mtproto.PEER_SELF, mtproto.PEER_USER:
+		peer.PeerType = mtproto.PEER_USER
+	case mtproto.PEER_CHAT:
+	case mtproto.PEER_CHANNEL:
+		// the channel messages carry no reactions, see getAvailableReactions
+		mChannel, err := c.getChannelForReactions(peer.PeerId)
+		if err != nil {
+			c.Logger.Errorf("messages.readReactions - error: %v", err)
+			return nil, err
+		}
+
+		return mtproto.MakeTLMessagesAffectedHistory(&mtproto.Messages_AffectedHistory{
+			Pts:      mChannel.Pts(),
+			PtsCount: 0,
+			Offset:   0,
+		}).To_Messages_AffectedHistory(), nil
+	default:
+		err := mtproto.ErrPeerIdInvalid
+		c.Logger.Errorf("messages.readReactions - error: %v", err)
+		return nil, err
+	}
+
+	affectedHistory, err := c.svcCtx.Dao.MsgClient.MsgReadReactions(c.ctx, &msgpb.TLMsgReadReactions{
+		UserId:    c.MD.UserId,
+		AuthKeyId: c.MD.AuthId,
+		PeerType:  peer.PeerType,
+		PeerId:    peer.PeerId,
+	})
+	if err != nil {
+		c.Logger.Errorf("messages.readReactions - error: %v", err)
+		return nil, err
+	}
+
+	return affectedHistory, nil
+}
@@ -22,6 +22,7 @@ import (
 	"math"
 
 	"github.com/teamgram/proto/mtproto"
+	channelpb "github.com/teamgram/teamgram-server/app/service/biz/channel/channel"
 	chatpb "github.com/teamgram/teamgram-server/app/service/biz/chat/chat"
 	"github.com/teamgram/teamgram-server/app/service/biz/message/message"
 	userpb "github.com/teamgram/teamgram-server/app/service/biz/user/user"
@@ -220,7 +221,7 @@ func (c *MessagesCore) MessagesSearch(in *mtproto.TLMessagesSearch) (*mtproto.Me
 				PeerType:  peer.PeerType,
 				PeerId:    peer.PeerId,
 				Q:         in.Q,
-				FromId:    fromId.PeerId,
+				FromId:    fromId.GetPeerId(),
 				MinDate:   in.MinDate,
 				MaxDate:   in.MaxDate,
 				OffsetId:  offsetId,
@@ -253,6 +254,7 @@ func (c *MessagesCore) MessagesSearch(in *mtproto.TLMessagesSearch) (*mtproto.Me
 
 	boxList.Visit(c.MD.UserId,
 		func(messageList []*mtproto.Message) {
+			c.svcCtx.Dao.FileReference.SetMessages(c.MD.UserId, messageList...)
 			rValues.Messages = messageList
 		},
 		func(userIdList []int64) {
@@ -270,14 +272,14 @@ func (c *MessagesCore) MessagesSearch(in *mtproto.TLMessagesSearch) (*mtproto.Me
 			rValues.Chats = append(rValues.Chats, mChats.GetChatListByIdList(c.MD.UserId, chatIdList...)...)
 		},
 		func(channelIdList []int64) {
-			//mChannels, _ := c.svcCtx.Dao.ChannelClient.ChannelGetChannelListByIdList(c.ctx,
-			//	&channelpb.TLChannelGetChannelListByIdList{
-			//		SelfUserId: c.MD.UserId,
-			//		Id:         channelIdList,
-			//	})
-			//if len(mChannels.GetDatas()) > 0 {
-			//	rValues.Chats = append(rValues.Chats, mChannels.GetDatas()...)
-			//}
+			mChannels, _ := c.svcCtx.Dao.ChannelClient.ChannelGetChannelListByIdList(c.ctx,
+				&channelpb.TLChannelGetChannelListByIdList{
+					SelfUserId: c.MD.UserId,
+					Id:         channelIdList,
+				})
+			if len(mChannels.GetDatas()) > 0 {
+				rValues.Chats = append(rValues.Chats, mChannels.GetDatas()...)
+			}
 		})
 
 	return rValues, nil
@@ -21,6 +21,7 @@ package core
 import (
 	"github.com/teamgram/proto/mtproto"
 	msgpb "github.com/teamgram/teamgram-server/app/messenger/msg/msg/msg"
+	pollpb "github.com/teamgram/teamgram-server/app/service/poll/poll"
 	"github.com/zeromicro/go-zero/core/contextx"
 	"github.com/zeromicro/go-zero/core/threading"
 	"time"
@@ -63,6 +64,12 @@ func (c *MessagesCore) MessagesSendMedia(in *mtproto.TLMessagesSendMedia) (*mtpr
 		return nil, err
 	}
 
+	replyMarkup, err := c.checkReplyMarkup(in.ReplyMarkup)
+	if err != nil {
+		c.Logger.Errorf("messages.sendMedia - error: %v", err)
+		return nil, err
+	}
+
 	/////////////////////////////////////////////////////////////////////////////////////
 	// 发件箱
 
@@ -100,7 +107,7 @@ func (c *MessagesCore) MessagesSendMedia(in *mtproto.TLMessagesSendMedia) (*mtpr
 		Date:              int32(time.Now().Unix()),
 		Media:             nil,
 		Message:           in.Message,
-		ReplyMarkup:       in.ReplyMarkup,
+		ReplyMarkup:       replyMarkup,
 		Entities:          in.Entities,
 		Views:             nil,
 		Forwards:          nil,
@@ -139,34 +146,20 @@ func (c *MessagesCore) MessagesSendMedia(in *mtproto.TLMessagesSendMedia) (*mtpr
 		return nil, err
 	}
 
-	//// poll
-	//poll, _ := mtproto.GetPollByMessage(outMessage.Media)
-	//if poll != nil {
-	//	var correctAnswers []int32
-	//	for _, v := range in.Media.CorrectAnswers {
-	//		if iV, err := strconv.ParseInt(hack.String(v), 10, 64); err == nil {
-	//			correctAnswers = append(correctAnswers, int32(iV))
-	//		}
-	//	}
-	//
-	//	// TODO(@benqi): 112
-	//	// solution:flags.1?string solution_entities:flags.1?Vector<MessageEntity>
-	//
-	//	// outMessage.Media,
-	//	pollMedia, err := c.svcCtx.Dao.PollClient.PollCreateMediaPoll(c.ctx, &pollpb.TLPollCreateMediaPoll{
-	//		UserId:         c.MD.UserId,
-	//		CorrectAnswers: correctAnswers,
-	//		Poll:           poll,
-	//	})
-	//	if err != nil {
-	//		c.Logger.Errorf("createMediaPoll error - %v", err)
-	//		return nil, err
-	//	}
-	//	outMessage.Media = pollMedia
-	//
-	//	// TODO(@benqi): poll2.0 disable, debug it.
-	//	// outMessage.Media.Results.Results = nil
-	//}
+	// poll
+	if in.Media.GetPredicateName() == mtproto.Predicate_inputMediaPoll {
+		outMessage.Media, err = c.svcCtx.Dao.PollClient.PollCreateMediaPoll(c.ctx, &pollpb.TLPollCreateMediaPoll{
+			UserId:           c.MD.UserId,
+			CorrectAnswers:   in.Media.CorrectAnswers,
+			Poll:             in.Media.Poll,
+			Solution:         in.Media.Solution,
+			SolutionEntities: in.Media.SolutionEntities,
+		})
+		if err != nil {
+			c.Logger.Errorf("messages.sendMedia - error: %v", err)
+			return nil, err
+		}
+	}
 
 	outMessage, _ = c.fixMessageEntities(c.MD.UserId, peer, true, outMessage, hasBot)
 	rUpdate, err := c.svcCtx.Dao.MsgClient.MsgSendMessage(c.ctx, &msgpb.TLMsgSendMessage{
@@ -187,6 +180,7 @@ func (c *MessagesCore) MessagesSendMedia(in *mtproto.TLMessagesSendMedia) (*mtpr
 		c.Logger.Errorf("messages.sendMedia#c8f16791 - error: %v", err)
 		return nil, err
 	}
+	c.svcCtx.Dao.FileReference.SetUpdates(c.MD.UserId, rUpdate)
 
 	if in.ClearDraft {
 		ctx := contextx.ValueOnlyFrom(c.ctx)
@@ -73,6 +73,12 @@ func (c *MessagesCore) MessagesSendMessage(in *mtproto.TLMessagesSendMessage) (*
 	//	return
 	//}
 
+	replyMarkup, err := c.checkReplyMarkup(in.ReplyMarkup)
+	if err != nil {
+		c.Logger.Errorf("messages.sendMessage - error: %v", err)
+		return nil, err
+	}
+
 	outMessage := mtproto.MakeTLMessage(&mtproto.Message{
 		Out:               true,
 		Mentioned:         false,
@@ -93,7 +99,7 @@ func (c *MessagesCore) MessagesSendMessage(in *mtproto.TLMessagesSendMessage) (*
 		Date:              int32(time.Now().Unix()),
 		Message:           in.Message,
 		Media:             nil,
-		ReplyMarkup:       in.ReplyMarkup,
+		ReplyMarkup:       replyMarkup,
 		Entities:          in.Entities,
 		Views:             nil,
 		Forwards:          nil,
@@ -134,6 +140,7 @@ func (c *MessagesCore) MessagesSendMessage(in *mtproto.TLMessagesSendMessage) (*
 		c.Logger.Errorf("messages.sendMessage#fa88427a - error: %v", err)
 		return nil, err
 	}
+	c.svcCtx.Dao.FileReference.SetUpdates(c.MD.UserId, rUpdate)
 
 	if in.ClearDraft {
 		ctx := contextx.ValueOnlyFrom(c.ctx)
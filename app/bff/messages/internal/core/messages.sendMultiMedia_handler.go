@@ -178,6 +178,7 @@ func (c *MessagesCore) MessagesSendMultiMedia(in *mtproto.TLMessagesSendMultiMed
 		c.Logger.Errorf("messages.sendMedia#c8f16791 - error: %v", err)
 		return nil, err
 	}
+	c.svcCtx.Dao.FileReference.SetUpdates(c.MD.UserId, rUpdate)
 
 	if in.ClearDraft {
 		ctx := contextx.ValueOnlyFrom(c.ctx)
@@ -0,0 +1,73 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"github.com/teamgram/marmota/pkg/container2"
+	"github.com/teamgram/proto/mtproto"
+	msgpb "github.com/teamgram/teamgram-server/app/messenger/msg/msg/msg"
+	"github.com/teamgram/teamgram-server/app/service/biz/message/message"
+)
+
+// MessagesSendReaction
+// messages.sendReaction#25690ce4 flags:# big:flags.1?true peer:InputPeer msg_id:int reaction:flags.0?string = Updates;
+func (c *MessagesCore) MessagesSendReaction(in *mtproto.TLMessagesSendReaction) (*mtproto.Updates, error) {
+	peer := mtproto.FromInputPeer2(c.MD.UserId, in.Peer)
+
+	availableReactions, err := c.getAvailableReactions(peer)
+	if err != nil {
+		c.Logger.Errorf("messages.sendReaction - error: %v", err)
+		return nil, err
+	}
+
+	// an empty reaction removes the reaction
+	if reaction := in.GetReaction().GetValue(); reaction != "" {
+		if ok, _ := container2.Contains(reaction, availableReactions); !ok {
+			err = message.ErrReactionInvalid
+			c.Logger.Errorf("messages.sendReaction - error: %v", err)
+			return nil, err
+		}
+	}
+
+	// the channel messages carry no reactions, see getAvailableReactions
+	if peer.PeerType == mtproto.PEER_CHANNEL {
+		err = message.ErrReactionInvalid
+		c.Logger.Errorf("messages.sendReaction - error: %v", err)
+		return nil, err
+	}
+	if peer.PeerType == mtproto.PEER_SELF {
+		peer.PeerType = mtproto.PEER_USER
+	}
+
+	rUpdates, err := c.svcCtx.Dao.MsgClient.MsgSendReaction(c.ctx, &msgpb.TLMsgSendReaction{
+		UserId:    c.MD.UserId,
+		AuthKeyId: c.MD.AuthId,
+		Big:       in.Big,
+		PeerType:  peer.PeerType,
+		PeerId:    peer.PeerId,
+		MsgId:     in.MsgId,
+		Reaction:  in.Reaction,
+	})
+	if err != nil {
+		c.Logger.Errorf("messages.sendReaction - error: %v", err)
+		return nil, err
+	}
+
+	return rUpdates, nil
+}
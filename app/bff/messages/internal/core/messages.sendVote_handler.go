@@ -0,0 +1,48 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	pollpb "github.com/teamgram/teamgram-server/app/service/poll/poll"
+)
+
+// MessagesSendVote
+// messages.sendVote#10ea6184 peer:InputPeer msg_id:int options:Vector<bytes> = Updates;
+func (c *MessagesCore) MessagesSendVote(in *mtproto.TLMessagesSendVote) (*mtproto.Updates, error) {
+	peer := mtproto.FromInputPeer2(c.MD.UserId, in.Peer)
+
+	pollId, err := c.getMessagePollId(peer, in.MsgId)
+	if err != nil {
+		c.Logger.Errorf("messages.sendVote - error: %v", err)
+		return nil, err
+	}
+
+	mediaPoll, err := c.svcCtx.Dao.PollClient.PollSendVote(c.ctx, &pollpb.TLPollSendVote{
+		UserId:  c.MD.UserId,
+		PollId:  pollId,
+		Options: in.Options,
+	})
+	if err != nil {
+		c.Logger.Errorf("messages.sendVote - error: %v", err)
+		return nil, err
+	}
+
+	return c.pushUpdateMessagePoll(peer, mediaPoll), nil
+}
@@ -0,0 +1,82 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"github.com/teamgram/marmota/pkg/container2"
+	"github.com/teamgram/proto/mtproto"
+	chatpb "github.com/teamgram/teamgram-server/app/service/biz/chat/chat"
+	"github.com/teamgram/teamgram-server/app/service/biz/message/message"
+)
+
+// MessagesSetChatAvailableReactions
+// messages.setChatAvailableReactions#14050ea6 peer:InputPeer available_reactions:Vector<string> = Updates;
+func (c *MessagesCore) MessagesSetChatAvailableReactions(in *mtproto.TLMessagesSetChatAvailableReactions) (*mtproto.Updates, error) {
+	var (
+		peer     = mtproto.FromInputPeer2(c.MD.UserId, in.Peer)
+		rUpdates *mtproto.Updates
+	)
+
+	switch peer.PeerType {
+	case mtproto.PEER_CHAT:
+	case mtproto.PEER_CHANNEL:
+		// the reactions stay disabled in the channels, see getAvailableReactions
+		if _, err := c.getChannelForReactions(peer.PeerId); err != nil {
+			c.Logger.Errorf("messages.setChatAvailableReactions - error: %v", err)
+			return nil, err
+		}
+
+		err := mtproto.ErrChatNotModified
+		if len(in.AvailableReactions) > 0 {
+			err = message.ErrReactionInvalid
+		}
+		c.Logger.Errorf("messages.setChatAvailableReactions - error: %v", err)
+		return nil, err
+	default:
+		err := mtproto.ErrPeerIdInvalid
+		c.Logger.Errorf("messages.setChatAvailableReactions - error: %v", err)
+		return nil, err
+	}
+
+	for _, reaction := range in.AvailableReactions {
+		if ok, _ := container2.Contains(reaction, message.DefaultAvailableReactions); !ok {
+			err := message.ErrReactionInvalid
+			c.Logger.Errorf("messages.setChatAvailableReactions - error: %v", err)
+			return nil, err
+		}
+	}
+
+	chat, err := c.svcCtx.Dao.ChatClient.Client().ChatSetChatAvailableReactions(c.ctx, &chatpb.TLChatSetChatAvailableReactions{
+		SelfId:             c.MD.UserId,
+		ChatId:             peer.PeerId,
+		AvailableReactions: in.AvailableReactions,
+	})
+	if err != nil {
+		c.Logger.Errorf("messages.setChatAvailableReactions - error: %v", err)
+		return nil, err
+	}
+
+	rUpdates = mtproto.MakeUpdatesByUpdatesChats(
+		[]*mtproto.Chat{chat.ToUnsafeChat(c.MD.UserId)},
+		mtproto.MakeTLUpdateChat(&mtproto.Update{
+			ChatId_INT64: peer.PeerId,
+		}).To_Update())
+
+	return rUpdates, nil
+}
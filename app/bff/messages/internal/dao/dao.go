@@ -24,6 +24,7 @@ import (
 	"github.com/teamgram/teamgram-server/app/bff/messages/internal/config"
 	msg_client "github.com/teamgram/teamgram-server/app/messenger/msg/msg/client"
 	sync_client "github.com/teamgram/teamgram-server/app/messenger/sync/client"
+	channel_client "github.com/teamgram/teamgram-server/app/service/biz/channel/client"
 	chat_client "github.com/teamgram/teamgram-server/app/service/biz/chat/client"
 	dialog_client "github.com/teamgram/teamgram-server/app/service/biz/dialog/client"
 	message_client "github.com/teamgram/teamgram-server/app/service/biz/message/client"
@@ -31,6 +32,8 @@ import (
 	username_client "github.com/teamgram/teamgram-server/app/service/biz/username/client"
 	idgen_client "github.com/teamgram/teamgram-server/app/service/idgen/client"
 	media_client "github.com/teamgram/teamgram-server/app/service/media/client"
+	poll_client "github.com/teamgram/teamgram-server/app/service/poll/client"
+	"github.com/teamgram/teamgram-server/pkg/filereference"
 )
 
 type Dao struct {
@@ -43,6 +46,9 @@ type Dao struct {
 	idgen_client.IDGenClient2
 	dialog_client.DialogClient
 	sync_client.SyncClient
+	channel_client.ChannelClient
+	poll_client.PollClient
+	FileReference *filereference.FileReference
 }
 
 func New(c config.Config) *Dao {
@@ -56,5 +62,8 @@ func New(c config.Config) *Dao {
 		MessageClient:  message_client.NewMessageClient(rpcx.GetCachedRpcClient(c.MessageClient)),
 		UsernameClient: username_client.NewUsernameClient(rpcx.GetCachedRpcClient(c.UsernameClient)),
 		SyncClient:     sync_client.NewSyncMqClient(kafka.MustKafkaProducer(c.SyncClient)),
+		ChannelClient:  channel_client.NewChannelClient(rpcx.GetCachedRpcClient(c.ChannelClient)),
+		PollClient:     poll_client.NewPollClient(rpcx.GetCachedRpcClient(c.PollClient)),
+		FileReference:  filereference.New(&c.FileReference),
 	}
 }
@@ -23,7 +23,10 @@ import (
 // New new a grpc server.
 func New(ctx *svc.ServiceContext, c zrpc.RpcServerConf) *zrpc.RpcServer {
 	s, err := zrpc.NewServer(c, func(grpcServer *grpc.Server) {
-		mtproto.RegisterRPCMessagesServer(grpcServer, service.New(ctx))
+		s := service.New(ctx)
+		mtproto.RegisterRPCMessagesServer(grpcServer, s)
+		mtproto.RegisterRPCPollsServer(grpcServer, s)
+		mtproto.RegisterRPCReactionsServer(grpcServer, s)
 	})
 	logx.Must(err)
 	return s
@@ -28,4 +28,5 @@ type Config struct {
 	UserClient zrpc.RpcClientConf
 	ChatClient zrpc.RpcClientConf
 	SyncClient *kafka.KafkaProducerConf
+	PushClient zrpc.RpcClientConf
 }
@@ -20,13 +20,32 @@ package core
 
 import (
 	"github.com/teamgram/proto/mtproto"
+	pushpb "github.com/teamgram/teamgram-server/app/messenger/push/push"
 )
 
 // AccountRegisterDevice
 // account.registerDevice#ec86017a flags:# no_muted:flags.0?true token_type:int token:string app_sandbox:Bool secret:bytes other_uids:Vector<long> = Bool;
 func (c *NotificationCore) AccountRegisterDevice(in *mtproto.TLAccountRegisterDevice) (*mtproto.Bool, error) {
-	// TODO: not impl
-	c.Logger.Errorf("account.registerDevice blocked, License key from https://teamgram.net required to unlock enterprise features.")
+	if in.Token == "" {
+		err := mtproto.ErrTokenInvalid
+		c.Logger.Errorf("account.registerDevice - error: %v", err)
+		return nil, err
+	}
 
-	return mtproto.BoolTrue, nil
+	rValue, err := c.svcCtx.Dao.PushClient.PushRegisterDevice(c.ctx, &pushpb.TLPushRegisterDevice{
+		UserId:     c.MD.UserId,
+		AuthKeyId:  c.MD.AuthId,
+		NoMuted:    in.NoMuted,
+		TokenType:  in.TokenType,
+		Token:      in.Token,
+		AppSandbox: mtproto.ToBool(mtproto.FromBool(in.AppSandbox)),
+		Secret:     in.Secret,
+		OtherUids:  in.OtherUids,
+	})
+	if err != nil {
+		c.Logger.Errorf("account.registerDevice - error: %v", err)
+		return nil, err
+	}
+
+	return rValue, nil
 }
@@ -20,13 +20,23 @@ package core
 
 import (
 	"github.com/teamgram/proto/mtproto"
+	pushpb "github.com/teamgram/teamgram-server/app/messenger/push/push"
 )
 
 // AccountUnregisterDevice
 // account.unregisterDevice#6a0d3206 token_type:int token:string other_uids:Vector<long> = Bool;
 func (c *NotificationCore) AccountUnregisterDevice(in *mtproto.TLAccountUnregisterDevice) (*mtproto.Bool, error) {
-	// TODO: not impl
-	c.Logger.Errorf("account.unregisterDevice blocked, License key from https://teamgram.net required to unlock enterprise features.")
+	rValue, err := c.svcCtx.Dao.PushClient.PushUnregisterDevice(c.ctx, &pushpb.TLPushUnregisterDevice{
+		UserId:    c.MD.UserId,
+		AuthKeyId: c.MD.AuthId,
+		TokenType: in.TokenType,
+		Token:     in.Token,
+		OtherUids: in.OtherUids,
+	})
+	if err != nil {
+		c.Logger.Errorf("account.unregisterDevice - error: %v", err)
+		return nil, err
+	}
 
-	return mtproto.BoolTrue, nil
+	return rValue, nil
 }
@@ -20,13 +20,21 @@ package core
 
 import (
 	"github.com/teamgram/proto/mtproto"
+	pushpb "github.com/teamgram/teamgram-server/app/messenger/push/push"
 )
 
 // AccountUpdateDeviceLocked
 // account.updateDeviceLocked#38df3532 period:int = Bool;
 func (c *NotificationCore) AccountUpdateDeviceLocked(in *mtproto.TLAccountUpdateDeviceLocked) (*mtproto.Bool, error) {
-	// TODO: not impl
-	c.Logger.Errorf("account.updateDeviceLocked blocked, License key from https://teamgram.net required to unlock enterprise features.")
+	rValue, err := c.svcCtx.Dao.PushClient.PushUpdateDeviceLocked(c.ctx, &pushpb.TLPushUpdateDeviceLocked{
+		UserId:    c.MD.UserId,
+		AuthKeyId: c.MD.AuthId,
+		Period:    in.Period,
+	})
+	if err != nil {
+		c.Logger.Errorf("account.updateDeviceLocked - error: %v", err)
+		return nil, err
+	}
 
-	return mtproto.BoolTrue, nil
+	return rValue, nil
 }
@@ -22,6 +22,7 @@ import (
 	kafka "github.com/teamgram/marmota/pkg/mq"
 	"github.com/teamgram/marmota/pkg/net/rpcx"
 	"github.com/teamgram/teamgram-server/app/bff/notification/internal/config"
+	push_client "github.com/teamgram/teamgram-server/app/messenger/push/client"
 	sync_client "github.com/teamgram/teamgram-server/app/messenger/sync/client"
 	chat_client "github.com/teamgram/teamgram-server/app/service/biz/chat/client"
 	user_client "github.com/teamgram/teamgram-server/app/service/biz/user/client"
@@ -31,6 +32,7 @@ type Dao struct {
 	user_client.UserClient
 	chat_client.ChatClient
 	sync_client.SyncClient
+	push_client.PushClient
 }
 
 func New(c config.Config) *Dao {
@@ -38,5 +40,6 @@ func New(c config.Config) *Dao {
 		UserClient: user_client.NewUserClient(rpcx.GetCachedRpcClient(c.UserClient)),
 		ChatClient: chat_client.NewChatClient(rpcx.GetCachedRpcClient(c.ChatClient)),
 		SyncClient: sync_client.NewSyncMqClient(kafka.MustKafkaProducer(c.SyncClient)),
+		PushClient: push_client.NewPushClient(rpcx.GetCachedRpcClient(c.PushClient)),
 	}
 }
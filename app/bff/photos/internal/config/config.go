@@ -20,12 +20,14 @@ package config
 
 import (
 	kafka "github.com/teamgram/marmota/pkg/mq"
+	"github.com/teamgram/teamgram-server/pkg/filereference/conf"
 	"github.com/zeromicro/go-zero/zrpc"
 )
 
 type Config struct {
 	zrpc.RpcServerConf
-	MediaClient zrpc.RpcClientConf
-	UserClient  zrpc.RpcClientConf
-	SyncClient  *kafka.KafkaProducerConf
+	FileReference conf.FileReferenceConfig
+	MediaClient   zrpc.RpcClientConf
+	UserClient    zrpc.RpcClientConf
+	SyncClient    *kafka.KafkaProducerConf
 }
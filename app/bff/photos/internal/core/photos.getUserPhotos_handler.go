@@ -22,6 +22,7 @@ import (
 	"github.com/teamgram/proto/mtproto"
 	userpb "github.com/teamgram/teamgram-server/app/service/biz/user/user"
 	mediapb "github.com/teamgram/teamgram-server/app/service/media/media"
+	"github.com/teamgram/teamgram-server/pkg/filereference"
 )
 
 // PhotosGetUserPhotos
@@ -56,6 +57,8 @@ func (c *PhotosCore) PhotosGetUserPhotos(in *mtproto.TLPhotosGetUserPhotos) (*mt
 			}); err != nil {
 			c.Logger.Errorf("photos.getUserPhotos - error: %v", err)
 		} else if photo != nil {
+			// the client refreshes the reference by photos.getUserPhotos
+			c.svcCtx.Dao.FileReference.SetPhoto(c.MD.UserId, photo, filereference.ProfilePhotoContext(userId.PeerId))
 			photos.Photos = append(photos.Photos, photo)
 		}
 	}
@@ -23,6 +23,7 @@ import (
 	"github.com/teamgram/teamgram-server/app/messenger/sync/sync"
 	userpb "github.com/teamgram/teamgram-server/app/service/biz/user/user"
 	mediapb "github.com/teamgram/teamgram-server/app/service/media/media"
+	"github.com/teamgram/teamgram-server/pkg/filereference"
 	"time"
 )
 
@@ -67,6 +68,7 @@ func (c *PhotosCore) PhotosUpdateProfilePhoto(in *mtproto.TLPhotosUpdateProfileP
 		}).To_Update()),
 	})
 
+	c.svcCtx.Dao.FileReference.SetPhoto(c.MD.UserId, photo, filereference.ProfilePhotoContext(c.MD.UserId))
 	return mtproto.MakeTLPhotosPhoto(&mtproto.Photos_Photo{
 		Photo: photo,
 		Users: []*mtproto.User{},
@@ -25,18 +25,21 @@ import (
 	sync_client "github.com/teamgram/teamgram-server/app/messenger/sync/client"
 	user_client "github.com/teamgram/teamgram-server/app/service/biz/user/client"
 	media_client "github.com/teamgram/teamgram-server/app/service/media/client"
+	"github.com/teamgram/teamgram-server/pkg/filereference"
 )
 
 type Dao struct {
 	media_client.MediaClient
 	user_client.UserClient
 	sync_client.SyncClient
+	FileReference *filereference.FileReference
 }
 
 func New(c config.Config) *Dao {
 	return &Dao{
-		MediaClient: media_client.NewMediaClient(rpcx.GetCachedRpcClient(c.MediaClient)),
-		UserClient:  user_client.NewUserClient(rpcx.GetCachedRpcClient(c.UserClient)),
-		SyncClient:  sync_client.NewSyncMqClient(kafka.MustKafkaProducer(c.SyncClient)),
+		MediaClient:   media_client.NewMediaClient(rpcx.GetCachedRpcClient(c.MediaClient)),
+		UserClient:    user_client.NewUserClient(rpcx.GetCachedRpcClient(c.UserClient)),
+		SyncClient:    sync_client.NewSyncMqClient(kafka.MustKafkaProducer(c.SyncClient)),
+		FileReference: filereference.New(&c.FileReference),
 	}
 }
@@ -0,0 +1,81 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	channelpb "github.com/teamgram/teamgram-server/app/service/biz/channel/channel"
+	chatpb "github.com/teamgram/teamgram-server/app/service/biz/chat/chat"
+	userpb "github.com/teamgram/teamgram-server/app/service/biz/user/user"
+)
+
+func (c *ScheduledMessagesCore) getScheduledPeer(inputPeer *mtproto.InputPeer) (*mtproto.PeerUtil, error) {
+	peer := mtproto.FromInputPeer2(c.MD.UserId, inputPeer)
+	if !peer.IsUserOrChatOrChannel() {
+		return nil, mtproto.ErrPeerIdInvalid
+	}
+
+	if peer.IsSelfUser(c.MD.UserId) {
+		peer.PeerType = mtproto.PEER_USER
+	}
+
+	return peer, nil
+}
+
+func (c *ScheduledMessagesCore) makeMessagesMessages(messageList []*mtproto.Message) *mtproto.Messages_Messages {
+	rValues := mtproto.MakeTLMessagesMessages(&mtproto.Messages_Messages{
+		Messages: messageList,
+		Users:    []*mtproto.User{},
+		Chats:    []*mtproto.Chat{},
+	}).To_Messages_Messages()
+	c.svcCtx.Dao.FileReference.SetMessages(c.MD.UserId, messageList...)
+
+	idHelper := mtproto.NewIDListHelper(c.MD.UserId)
+	idHelper.PickByMessages(messageList...)
+	idHelper.Visit(
+		func(userIdList []int64) {
+			mUsers, _ := c.svcCtx.Dao.UserClient.UserGetMutableUsers(
+				c.ctx,
+				&userpb.TLUserGetMutableUsers{
+					Id: userIdList,
+				})
+			rValues.Users = append(rValues.Users, mUsers.GetUserListByIdList(c.MD.UserId, userIdList...)...)
+		},
+		func(chatIdList []int64) {
+			mChats, _ := c.svcCtx.Dao.ChatClient.ChatGetChatListByIdList(
+				c.ctx,
+				&chatpb.TLChatGetChatListByIdList{
+					IdList: chatIdList,
+				})
+			rValues.Chats = append(rValues.Chats, mChats.GetChatListByIdList(c.MD.UserId, chatIdList...)...)
+		},
+		func(channelIdList []int64) {
+			mChannels, _ := c.svcCtx.Dao.ChannelClient.ChannelGetChannelListByIdList(
+				c.ctx,
+				&channelpb.TLChannelGetChannelListByIdList{
+					SelfUserId: c.MD.UserId,
+					Id:         channelIdList,
+				})
+			if len(mChannels.GetDatas()) > 0 {
+				rValues.Chats = append(rValues.Chats, mChannels.GetDatas()...)
+			}
+		})
+
+	return rValues
+}
@@ -0,0 +1,154 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/messenger/sync/sync"
+	"github.com/teamgram/teamgram-server/app/service/authsession/authsession"
+	"github.com/teamgram/teamgram-server/app/service/secretchat/secretchat"
+	"github.com/teamgram/teamgram-server/pkg/srp"
+)
+
+const (
+	// maxEncryptedDataLength the max size of the data of an encrypted message
+	maxEncryptedDataLength = 1024 * 1024
+)
+
+var (
+	dhP = new(big.Int).SetBytes(srp.P)
+)
+
+// checkGAOrB the g_a and g_b of the clients must be in the range (1, p-1)
+func checkGAOrB(gAOrB []byte) bool {
+	v := new(big.Int).SetBytes(gAOrB)
+	return len(gAOrB) <= srp.SizeBytes &&
+		v.Cmp(big.NewInt(1)) > 0 &&
+		v.Cmp(new(big.Int).Sub(dhP, big.NewInt(1))) < 0
+}
+
+// getPermAuthKeyId a secret chat is bound to the perm auth key of the device,
+// the temp keys change while the device keeps its secret chats.
+func (c *SecretChatsCore) getPermAuthKeyId() (int64, error) {
+	keyId, err := c.svcCtx.Dao.AuthsessionClient.AuthsessionGetPermAuthKeyId(c.ctx, &authsession.TLAuthsessionGetPermAuthKeyId{
+		AuthKeyId: c.MD.AuthId,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return keyId.GetV(), nil
+}
+
+func (c *SecretChatsCore) getSecretChat(peer *mtproto.InputEncryptedChat) (*secretchat.SecretChat, error) {
+	chat, err := c.svcCtx.Dao.SecretchatClient.SecretchatGetSecretChat(c.ctx, &secretchat.TLSecretchatGetSecretChat{
+		UserId: c.MD.UserId,
+		ChatId: peer.GetChatId(),
+	})
+	if err != nil {
+		return nil, err
+	} else if chat.AccessHash != peer.GetAccessHash() {
+		return nil, mtproto.ErrEncryptionIdInvalid
+	}
+
+	return chat, nil
+}
+
+// getActiveSecretChat returns the accepted secret chat of the calling device.
+func (c *SecretChatsCore) getActiveSecretChat(peer *mtproto.InputEncryptedChat) (*secretchat.SecretChat, error) {
+	chat, err := c.getSecretChat(peer)
+	if err != nil {
+		return nil, err
+	}
+
+	switch chat.State {
+	case secretchat.EncryptedChatStateAccepted:
+	case secretchat.EncryptedChatStateDiscarded:
+		return nil, mtproto.ErrEncryptionDeclined
+	default:
+		return nil, mtproto.ErrEncryptionIdInvalid
+	}
+
+	keyId, err := c.getPermAuthKeyId()
+	if err != nil {
+		return nil, err
+	} else if chat.AuthKeyId(c.MD.UserId) != keyId {
+		return nil, mtproto.ErrEncryptionIdInvalid
+	}
+
+	return chat, nil
+}
+
+// pushToPeer pushes the updates to the device of the peer, or to all the devices
+// of the peer if the secret chat isn't accepted yet.
+func (c *SecretChatsCore) pushToPeer(chat *secretchat.SecretChat, updates *mtproto.Updates) {
+	var (
+		peerId    = chat.PeerId(c.MD.UserId)
+		peerKeyId = chat.PeerAuthKeyId(c.MD.UserId)
+	)
+
+	if peerKeyId == 0 {
+		c.svcCtx.Dao.SyncClient.SyncPushUpdates(c.ctx, &sync.TLSyncPushUpdates{
+			UserId:  peerId,
+			Updates: updates,
+		})
+	} else {
+		c.svcCtx.Dao.SyncClient.SyncUpdatesMe(c.ctx, &sync.TLSyncUpdatesMe{
+			UserId:    peerId,
+			AuthKeyId: peerKeyId,
+			ServerId:  "",
+			SessionId: nil,
+			Updates:   updates,
+		})
+	}
+}
+
+// sendEncryptedMessage queues the message for the device of the peer.
+func (c *SecretChatsCore) sendEncryptedMessage(chat *secretchat.SecretChat, message *mtproto.EncryptedMessage) error {
+	update, err := c.svcCtx.Dao.SecretchatClient.SecretchatPushEncryptedMessage(c.ctx, &secretchat.TLSecretchatPushEncryptedMessage{
+		UserId:    chat.PeerId(c.MD.UserId),
+		AuthKeyId: chat.PeerAuthKeyId(c.MD.UserId),
+		Message:   message,
+	})
+	if err != nil {
+		return err
+	}
+
+	c.pushToPeer(chat, mtproto.MakeTLUpdateShort(&mtproto.Updates{
+		Update: update,
+		Date:   int32(time.Now().Unix()),
+	}).To_Updates())
+
+	return nil
+}
+
+func checkEncryptedMessage(randomId int64, data []byte) error {
+	if randomId == 0 {
+		return mtproto.ErrRandomIdEmpty
+	} else if len(data) == 0 {
+		return mtproto.ErrDataInvalid
+	} else if len(data) > maxEncryptedDataLength {
+		return mtproto.ErrDataTooLong
+	}
+
+	return nil
+}
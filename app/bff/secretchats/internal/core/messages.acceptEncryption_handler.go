@@ -0,0 +1,68 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"time"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/secretchat/secretchat"
+)
+
+// MessagesAcceptEncryption
+// messages.acceptEncryption#3dbc0415 peer:InputEncryptedChat g_b:bytes key_fingerprint:long = EncryptedChat;
+func (c *SecretChatsCore) MessagesAcceptEncryption(in *mtproto.TLMessagesAcceptEncryption) (*mtproto.EncryptedChat, error) {
+	if !checkGAOrB(in.GB) {
+		err := mtproto.ErrDhGAInvalid
+		c.Logger.Errorf("messages.acceptEncryption - error: %v", err)
+		return nil, err
+	}
+
+	chat, err := c.getSecretChat(in.Peer)
+	if err != nil {
+		c.Logger.Errorf("messages.acceptEncryption - error: %v", err)
+		return nil, err
+	}
+
+	keyId, err := c.getPermAuthKeyId()
+	if err != nil {
+		c.Logger.Errorf("messages.acceptEncryption - error: %v", err)
+		return nil, err
+	}
+
+	chat, err = c.svcCtx.Dao.SecretchatClient.SecretchatAcceptEncryption(c.ctx, &secretchat.TLSecretchatAcceptEncryption{
+		UserId:         c.MD.UserId,
+		AuthKeyId:      keyId,
+		ChatId:         chat.Id,
+		GB:             in.GB,
+		KeyFingerprint: in.KeyFingerprint,
+	})
+	if err != nil {
+		c.Logger.Errorf("messages.acceptEncryption - error: %v", err)
+		return nil, err
+	}
+
+	c.pushToPeer(chat, mtproto.MakeUpdatesByUpdates(
+		mtproto.MakeTLUpdateEncryption(&mtproto.Update{
+			Chat: chat.ToEncryptedChat(chat.AdminId),
+			Date: int32(time.Now().Unix()),
+		}).To_Update()))
+
+	return chat.ToEncryptedChat(c.MD.UserId), nil
+}
@@ -0,0 +1,48 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"time"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/secretchat/secretchat"
+)
+
+// MessagesDiscardEncryption
+// messages.discardEncryption#f393aea0 flags:# delete_history:flags.0?true chat_id:int = Bool;
+func (c *SecretChatsCore) MessagesDiscardEncryption(in *mtproto.TLMessagesDiscardEncryption) (*mtproto.Bool, error) {
+	chat, err := c.svcCtx.Dao.SecretchatClient.SecretchatDiscardEncryption(c.ctx, &secretchat.TLSecretchatDiscardEncryption{
+		UserId:        c.MD.UserId,
+		ChatId:        in.ChatId,
+		DeleteHistory: in.DeleteHistory,
+	})
+	if err != nil {
+		c.Logger.Errorf("messages.discardEncryption - error: %v", err)
+		return nil, err
+	}
+
+	c.pushToPeer(chat, mtproto.MakeUpdatesByUpdates(
+		mtproto.MakeTLUpdateEncryption(&mtproto.Update{
+			Chat: chat.ToEncryptedChat(chat.PeerId(c.MD.UserId)),
+			Date: int32(time.Now().Unix()),
+		}).To_Update()))
+
+	return mtproto.BoolTrue, nil
+}
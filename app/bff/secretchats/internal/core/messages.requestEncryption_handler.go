@@ -0,0 +1,92 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/messenger/sync/sync"
+	userpb "github.com/teamgram/teamgram-server/app/service/biz/user/user"
+	"github.com/teamgram/teamgram-server/app/service/secretchat/secretchat"
+)
+
+// MessagesRequestEncryption
+// messages.requestEncryption#f64daf43 user_id:InputUser random_id:int g_a:bytes = EncryptedChat;
+func (c *SecretChatsCore) MessagesRequestEncryption(in *mtproto.TLMessagesRequestEncryption) (*mtproto.EncryptedChat, error) {
+	peer := mtproto.FromInputUser(c.MD.UserId, in.UserId)
+	if peer.PeerType != mtproto.PEER_USER || peer.PeerId == c.MD.UserId {
+		err := mtproto.ErrUserIdInvalid
+		c.Logger.Errorf("messages.requestEncryption - error: %v", err)
+		return nil, err
+	}
+
+	if !checkGAOrB(in.GA) {
+		err := mtproto.ErrDhGAInvalid
+		c.Logger.Errorf("messages.requestEncryption - error: %v", err)
+		return nil, err
+	}
+
+	mUsers, err := c.svcCtx.Dao.UserClient.UserGetMutableUsers(c.ctx, &userpb.TLUserGetMutableUsers{
+		Id: []int64{c.MD.UserId, peer.PeerId},
+	})
+	if err != nil {
+		c.Logger.Errorf("messages.requestEncryption - error: %v", err)
+		return nil, err
+	}
+	participant, _ := mUsers.GetImmutableUser(peer.PeerId)
+	if participant == nil {
+		err = mtproto.ErrUserIdInvalid
+		c.Logger.Errorf("messages.requestEncryption - error: %v", err)
+		return nil, err
+	} else if participant.Deleted() {
+		err = mtproto.ErrInputUserDeactivated
+		c.Logger.Errorf("messages.requestEncryption - error: %v", err)
+		return nil, err
+	}
+
+	keyId, err := c.getPermAuthKeyId()
+	if err != nil {
+		c.Logger.Errorf("messages.requestEncryption - error: %v", err)
+		return nil, err
+	}
+
+	chat, err := c.svcCtx.Dao.SecretchatClient.SecretchatRequestEncryption(c.ctx, &secretchat.TLSecretchatRequestEncryption{
+		UserId:        c.MD.UserId,
+		AuthKeyId:     keyId,
+		ParticipantId: peer.PeerId,
+		RandomId:      in.RandomId,
+		GA:            in.GA,
+	})
+	if err != nil {
+		c.Logger.Errorf("messages.requestEncryption - error: %v", err)
+		return nil, err
+	}
+
+	// all the devices of the participant are asked, the first one accepting gets the chat
+	c.svcCtx.Dao.SyncClient.SyncPushUpdates(c.ctx, &sync.TLSyncPushUpdates{
+		UserId: peer.PeerId,
+		Updates: mtproto.MakeUpdatesByUpdatesUsers(
+			mUsers.GetUserListByIdList(peer.PeerId, c.MD.UserId),
+			mtproto.MakeTLUpdateEncryption(&mtproto.Update{
+				Chat: chat.ToEncryptedChat(peer.PeerId),
+				Date: chat.Date,
+			}).To_Update()),
+	})
+
+	return chat.ToEncryptedChat(c.MD.UserId), nil
+}
@@ -0,0 +1,83 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"time"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/media/media"
+)
+
+// MessagesSendEncryptedFile
+// messages.sendEncryptedFile#5559481d flags:# silent:flags.0?true peer:InputEncryptedChat random_id:long data:bytes file:InputEncryptedFile = messages.SentEncryptedMessage;
+func (c *SecretChatsCore) MessagesSendEncryptedFile(in *mtproto.TLMessagesSendEncryptedFile) (*mtproto.Messages_SentEncryptedMessage, error) {
+	if err := checkEncryptedMessage(in.RandomId, in.Data); err != nil {
+		c.Logger.Errorf("messages.sendEncryptedFile - error: %v", err)
+		return nil, err
+	}
+
+	chat, err := c.getActiveSecretChat(in.Peer)
+	if err != nil {
+		c.Logger.Errorf("messages.sendEncryptedFile - error: %v", err)
+		return nil, err
+	}
+
+	var (
+		file *mtproto.EncryptedFile
+	)
+	switch in.GetFile().GetPredicateName() {
+	case mtproto.Predicate_inputEncryptedFileUploaded,
+		mtproto.Predicate_inputEncryptedFileBigUploaded:
+		file, err = c.svcCtx.Dao.MediaClient.MediaUploadEncryptedFile(c.ctx, &media.TLMediaUploadEncryptedFile{
+			OwnerId: c.MD.AuthId,
+			File:    in.File,
+		})
+	case mtproto.Predicate_inputEncryptedFile:
+		// forwarded, the file was uploaded before
+		file, err = c.svcCtx.Dao.MediaClient.MediaGetEncryptedFile(c.ctx, &media.TLMediaGetEncryptedFile{
+			Id:         in.File.Id,
+			AccessHash: in.File.AccessHash,
+		})
+	default:
+		err = mtproto.ErrFileIdInvalid
+	}
+	if err != nil {
+		c.Logger.Errorf("messages.sendEncryptedFile - error: %v", err)
+		return nil, err
+	}
+
+	date := int32(time.Now().Unix())
+	err = c.sendEncryptedMessage(chat, mtproto.MakeTLEncryptedMessage(&mtproto.EncryptedMessage{
+		RandomId: in.RandomId,
+		ChatId:   chat.Id,
+		Date:     date,
+		Bytes:    in.Data,
+		File:     file,
+	}).To_EncryptedMessage())
+	if err != nil {
+		c.Logger.Errorf("messages.sendEncryptedFile - error: %v", err)
+		return nil, err
+	}
+
+	return mtproto.MakeTLMessagesSentEncryptedFile(&mtproto.Messages_SentEncryptedMessage{
+		Date: date,
+		File: file,
+	}).To_Messages_SentEncryptedMessage(), nil
+}
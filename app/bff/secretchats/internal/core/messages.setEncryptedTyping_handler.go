@@ -0,0 +1,43 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+)
+
+// MessagesSetEncryptedTyping
+// messages.setEncryptedTyping#791451ed peer:InputEncryptedChat typing:Bool = Bool;
+func (c *SecretChatsCore) MessagesSetEncryptedTyping(in *mtproto.TLMessagesSetEncryptedTyping) (*mtproto.Bool, error) {
+	chat, err := c.getActiveSecretChat(in.Peer)
+	if err != nil {
+		c.Logger.Errorf("messages.setEncryptedTyping - error: %v", err)
+		return nil, err
+	}
+
+	// the clients cancel the typing by themselves
+	if mtproto.FromBool(in.Typing) {
+		c.pushToPeer(chat, mtproto.MakeUpdatesByUpdates(
+			mtproto.MakeTLUpdateEncryptedChatTyping(&mtproto.Update{
+				ChatId_INT32: chat.Id,
+			}).To_Update()))
+	}
+
+	return mtproto.BoolTrue, nil
+}
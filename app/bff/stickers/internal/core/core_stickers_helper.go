@@ -0,0 +1,156 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	mediapb "github.com/teamgram/teamgram-server/app/service/media/media"
+	"github.com/teamgram/teamgram-server/app/service/sticker/sticker"
+	"github.com/teamgram/teamgram-server/pkg/filereference"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	errStickerVideoNowebm = status.Error(mtproto.ErrBadRequest, "STICKER_VIDEO_NOWEBM")
+)
+
+// getStickerSetDocuments the documents of the stickers in the same order, their sticker
+// attribute refers to the set they belong to, and their file_reference is signed in the
+// context of the set.
+func (c *StickersCore) getStickerSetDocuments(stickers []*sticker.StickerSetDocument) ([]*mtproto.Document, error) {
+	if len(stickers) == 0 {
+		return []*mtproto.Document{}, nil
+	}
+
+	documentList, err := c.svcCtx.Dao.MediaClient.MediaGetDocumentList(c.ctx, &mediapb.TLMediaGetDocumentList{
+		IdList: sticker.GetDocumentIdList(stickers),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	documentMap := make(map[int64]*mtproto.Document, len(documentList.GetDatas()))
+	for _, doc := range documentList.GetDatas() {
+		documentMap[doc.GetId()] = doc
+	}
+
+	documents := make([]*mtproto.Document, 0, len(stickers))
+	for _, v := range stickers {
+		doc, ok := documentMap[v.GetDocumentId()]
+		if !ok {
+			continue
+		}
+		for _, attr := range doc.GetAttributes() {
+			if attr.GetPredicateName() == mtproto.Predicate_documentAttributeSticker {
+				attr.Alt = v.GetEmoji()
+				attr.Stickerset = mtproto.MakeTLInputStickerSetID(&mtproto.InputStickerSet{
+					Id:         v.GetSetId(),
+					AccessHash: v.GetSetAccessHash(),
+				}).To_InputStickerSet()
+			}
+		}
+		c.svcCtx.Dao.FileReference.SetDocument(c.MD.UserId, doc, filereference.StickerSetContext(v.GetSetId()))
+		documents = append(documents, doc)
+	}
+
+	return documents, nil
+}
+
+func (c *StickersCore) makeMessagesStickerSet(data *sticker.StickerSetData) (*mtproto.Messages_StickerSet, error) {
+	documents, err := c.getStickerSetDocuments(data.GetDocuments())
+	if err != nil {
+		return nil, err
+	}
+
+	return mtproto.MakeTLMessagesStickerSet(&mtproto.Messages_StickerSet{
+		Set:       data.GetSet(),
+		Packs:     sticker.MakeStickerPacks(data.GetDocuments()),
+		Documents: documents,
+	}).To_Messages_StickerSet(), nil
+}
+
+// getStickerDocument the uploaded sticker file, it must be of the kind of the set
+func (c *StickersCore) getStickerDocument(id *mtproto.InputDocument, animated, videos bool) (*mtproto.Document, error) {
+	if id.GetPredicateName() != mtproto.Predicate_inputDocument {
+		return nil, mtproto.ErrStickerDocumentInvalid
+	}
+
+	document, err := c.svcCtx.Dao.MediaClient.MediaGetDocument(c.ctx, &mediapb.TLMediaGetDocument{
+		Id: id.GetId(),
+	})
+	if err != nil {
+		c.Logger.Errorf("media.getDocument - error: %v", err)
+		return nil, mtproto.ErrStickerDocumentInvalid
+	} else if document.GetAccessHash() != id.GetAccessHash() {
+		return nil, mtproto.ErrStickerDocumentInvalid
+	}
+
+	switch {
+	case animated:
+		if document.GetMimeType() != "application/x-tgsticker" {
+			return nil, mtproto.ErrStickerTgsNotgs
+		}
+	case videos:
+		if document.GetMimeType() != "video/webm" {
+			return nil, errStickerVideoNowebm
+		}
+	default:
+		if document.GetMimeType() != "image/webp" && document.GetMimeType() != "image/png" {
+			return nil, mtproto.ErrStickerPngNopng
+		}
+	}
+
+	return document, nil
+}
+
+func (c *StickersCore) makeStickerSetDocument(item *mtproto.InputStickerSetItem, animated, videos bool) (*sticker.StickerSetDocument, error) {
+	if item.GetEmoji() == "" || len(sticker.SplitEmoji(item.GetEmoji())) > sticker.StickerEmojiMax {
+		return nil, mtproto.ErrStickerEmojiInvalid
+	}
+
+	document, err := c.getStickerDocument(item.GetDocument(), animated, videos)
+	if err != nil {
+		return nil, err
+	}
+
+	return sticker.MakeTLStickerSetDocument(&sticker.StickerSetDocument{
+		DocumentId: document.GetId(),
+		Emoji:      item.GetEmoji(),
+	}).To_StickerSetDocument(), nil
+}
+
+func (c *StickersCore) getAllStickers(masks bool, hash int64) (*mtproto.Messages_AllStickers, error) {
+	sets, err := c.svcCtx.Dao.StickerClient.StickerGetInstalledStickerSets(c.ctx, &sticker.TLStickerGetInstalledStickerSets{
+		UserId: c.MD.UserId,
+		Masks:  mtproto.ToBool(masks),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	allHash := sticker.MakeAllStickersHash(sets.GetDatas())
+	if hash != 0 && hash == allHash {
+		return mtproto.MakeTLMessagesAllStickersNotModified(nil).To_Messages_AllStickers(), nil
+	}
+
+	return mtproto.MakeTLMessagesAllStickers(&mtproto.Messages_AllStickers{
+		Hash: allHash,
+		Sets: sets.GetDatas(),
+	}).To_Messages_AllStickers(), nil
+}
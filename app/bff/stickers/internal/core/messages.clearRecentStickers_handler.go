@@ -0,0 +1,39 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/sticker/sticker"
+)
+
+// MessagesClearRecentStickers
+// messages.clearRecentStickers#8999602d flags:# attached:flags.0?true = Bool;
+func (c *StickersCore) MessagesClearRecentStickers(in *mtproto.TLMessagesClearRecentStickers) (*mtproto.Bool, error) {
+	rValue, err := c.svcCtx.Dao.StickerClient.StickerClearRecentStickers(c.ctx, &sticker.TLStickerClearRecentStickers{
+		UserId:   c.MD.UserId,
+		Attached: mtproto.ToBool(in.Attached),
+	})
+	if err != nil {
+		c.Logger.Errorf("messages.clearRecentStickers - error: %v", err)
+		return nil, err
+	}
+
+	return rValue, nil
+}
@@ -0,0 +1,60 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/sticker/sticker"
+)
+
+// MessagesGetRecentStickers
+// messages.getRecentStickers#9da9403b flags:# attached:flags.0?true hash:long = messages.RecentStickers;
+func (c *StickersCore) MessagesGetRecentStickers(in *mtproto.TLMessagesGetRecentStickers) (*mtproto.Messages_RecentStickers, error) {
+	stickers, err := c.svcCtx.Dao.StickerClient.StickerGetRecentStickers(c.ctx, &sticker.TLStickerGetRecentStickers{
+		UserId:   c.MD.UserId,
+		Attached: mtproto.ToBool(in.Attached),
+	})
+	if err != nil {
+		c.Logger.Errorf("messages.getRecentStickers - error: %v", err)
+		return nil, err
+	}
+
+	hash := sticker.MakeStickersHash(sticker.GetDocumentIdList(stickers.GetDatas()))
+	if in.Hash != 0 && in.Hash == hash {
+		return mtproto.MakeTLMessagesRecentStickersNotModified(nil).To_Messages_RecentStickers(), nil
+	}
+
+	documents, err := c.getStickerSetDocuments(stickers.GetDatas())
+	if err != nil {
+		c.Logger.Errorf("messages.getRecentStickers - error: %v", err)
+		return nil, err
+	}
+
+	dates := make([]int32, 0, len(stickers.GetDatas()))
+	for _, v := range stickers.GetDatas() {
+		dates = append(dates, v.GetDate())
+	}
+
+	return mtproto.MakeTLMessagesRecentStickers(&mtproto.Messages_RecentStickers{
+		Hash:     hash,
+		Packs:    sticker.MakeStickerPacks(stickers.GetDatas()),
+		Stickers: documents,
+		Dates:    dates,
+	}).To_Messages_RecentStickers(), nil
+}
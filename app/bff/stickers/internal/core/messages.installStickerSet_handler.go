@@ -0,0 +1,41 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/sticker/sticker"
+)
+
+// MessagesInstallStickerSet
+// messages.installStickerSet#c78fe460 stickerset:InputStickerSet archived:Bool = messages.StickerSetInstallResult;
+func (c *StickersCore) MessagesInstallStickerSet(in *mtproto.TLMessagesInstallStickerSet) (*mtproto.Messages_StickerSetInstallResult, error) {
+	_, err := c.svcCtx.Dao.StickerClient.StickerInstallStickerSet(c.ctx, &sticker.TLStickerInstallStickerSet{
+		UserId:     c.MD.UserId,
+		Stickerset: in.Stickerset,
+		Archived:   in.Archived,
+	})
+	if err != nil {
+		c.Logger.Errorf("messages.installStickerSet - error: %v", err)
+		return nil, err
+	}
+
+	// no other set is archived to make room for this one
+	return mtproto.MakeTLMessagesStickerSetInstallResultSuccess(nil).To_Messages_StickerSetInstallResult(), nil
+}
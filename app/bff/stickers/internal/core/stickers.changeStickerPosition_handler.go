@@ -0,0 +1,46 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/sticker/sticker"
+)
+
+// StickersChangeStickerPosition
+// stickers.changeStickerPosition#ffb6d4ca sticker:InputDocument position:int = messages.StickerSet;
+func (c *StickersCore) StickersChangeStickerPosition(in *mtproto.TLStickersChangeStickerPosition) (*mtproto.Messages_StickerSet, error) {
+	if in.Sticker.GetPredicateName() != mtproto.Predicate_inputDocument {
+		err := mtproto.ErrStickerInvalid
+		c.Logger.Errorf("stickers.changeStickerPosition - error: %v", err)
+		return nil, err
+	}
+
+	data, err := c.svcCtx.Dao.StickerClient.StickerChangeStickerPosition(c.ctx, &sticker.TLStickerChangeStickerPosition{
+		UserId:     c.MD.UserId,
+		DocumentId: in.Sticker.GetId(),
+		Position:   in.Position,
+	})
+	if err != nil {
+		c.Logger.Errorf("stickers.changeStickerPosition - error: %v", err)
+		return nil, err
+	}
+
+	return c.makeMessagesStickerSet(data)
+}
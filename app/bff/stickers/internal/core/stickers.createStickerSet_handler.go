@@ -0,0 +1,82 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/sticker/sticker"
+)
+
+// StickersCreateStickerSet
+// stickers.createStickerSet#9021ab67 flags:# masks:flags.0?true animated:flags.1?true videos:flags.4?true user_id:InputUser title:string short_name:string thumb:flags.2?InputDocument stickers:Vector<InputStickerSetItem> software:flags.3?string = messages.StickerSet;
+func (c *StickersCore) StickersCreateStickerSet(in *mtproto.TLStickersCreateStickerSet) (*mtproto.Messages_StickerSet, error) {
+	peer := mtproto.FromInputUser(c.MD.UserId, in.UserId)
+	if peer.PeerId != c.MD.UserId {
+		// the sets are only created for oneself
+		err := mtproto.ErrUserIdInvalid
+		c.Logger.Errorf("stickers.createStickerSet - error: %v", err)
+		return nil, err
+	}
+	if in.Animated && in.Videos {
+		err := mtproto.ErrStickersetInvalid
+		c.Logger.Errorf("stickers.createStickerSet - error: %v", err)
+		return nil, err
+	}
+
+	stickers := make([]*sticker.StickerSetDocument, 0, len(in.Stickers))
+	for _, item := range in.Stickers {
+		v, err := c.makeStickerSetDocument(item, in.Animated, in.Videos)
+		if err != nil {
+			c.Logger.Errorf("stickers.createStickerSet - error: %v", err)
+			return nil, err
+		}
+		stickers = append(stickers, v)
+	}
+
+	var (
+		thumb *mtproto.Document
+	)
+	if in.Thumb != nil && in.Thumb.GetPredicateName() != mtproto.Predicate_inputDocumentEmpty {
+		var (
+			err error
+		)
+		thumb, err = c.getStickerDocument(in.Thumb, in.Animated, in.Videos)
+		if err != nil {
+			c.Logger.Errorf("stickers.createStickerSet - error: %v", err)
+			return nil, err
+		}
+	}
+
+	data, err := c.svcCtx.Dao.StickerClient.StickerCreateStickerSet(c.ctx, &sticker.TLStickerCreateStickerSet{
+		UserId:    c.MD.UserId,
+		Masks:     in.Masks,
+		Animated:  in.Animated,
+		Videos:    in.Videos,
+		Title:     in.Title,
+		ShortName: in.ShortName,
+		Thumb:     thumb,
+		Stickers:  stickers,
+	})
+	if err != nil {
+		c.Logger.Errorf("stickers.createStickerSet - error: %v", err)
+		return nil, err
+	}
+
+	return c.makeMessagesStickerSet(data)
+}
@@ -0,0 +1,56 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/sticker/sticker"
+)
+
+// StickersSetStickerSetThumb
+// stickers.setStickerSetThumb#9a364e30 stickerset:InputStickerSet thumb:InputDocument = messages.StickerSet;
+func (c *StickersCore) StickersSetStickerSetThumb(in *mtproto.TLStickersSetStickerSetThumb) (*mtproto.Messages_StickerSet, error) {
+	data, err := c.svcCtx.Dao.StickerClient.StickerGetStickerSet(c.ctx, &sticker.TLStickerGetStickerSet{
+		UserId:     c.MD.UserId,
+		Stickerset: in.Stickerset,
+	})
+	if err != nil {
+		c.Logger.Errorf("stickers.setStickerSetThumb - error: %v", err)
+		return nil, err
+	}
+
+	// the thumb is of the kind of the set
+	thumb, err := c.getStickerDocument(in.Thumb, data.GetSet().GetAnimated(), data.GetSet().GetVideos())
+	if err != nil {
+		c.Logger.Errorf("stickers.setStickerSetThumb - error: %v", err)
+		return nil, err
+	}
+
+	data, err = c.svcCtx.Dao.StickerClient.StickerSetStickerSetThumb(c.ctx, &sticker.TLStickerSetStickerSetThumb{
+		UserId:     c.MD.UserId,
+		Stickerset: in.Stickerset,
+		Thumb:      thumb,
+	})
+	if err != nil {
+		c.Logger.Errorf("stickers.setStickerSetThumb - error: %v", err)
+		return nil, err
+	}
+
+	return c.makeMessagesStickerSet(data)
+}
@@ -0,0 +1,68 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"strconv"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/sticker/sticker"
+
+	"google.golang.org/grpc/status"
+)
+
+// suggestShortNameTries the suffixes tried when the short name made from the title is occupied
+const suggestShortNameTries = 10
+
+// StickersSuggestShortName
+// stickers.suggestShortName#4dafc503 title:string = stickers.SuggestedShortName;
+func (c *StickersCore) StickersSuggestShortName(in *mtproto.TLStickersSuggestShortName) (*mtproto.Stickers_SuggestedShortName, error) {
+	if l := len(in.Title); l == 0 || l > sticker.StickerSetTitleMaxLen {
+		err := mtproto.ErrTitleInvalid
+		c.Logger.Errorf("stickers.suggestShortName - error: %v", err)
+		return nil, err
+	}
+
+	shortName := sticker.MakeShortName(in.Title)
+	if shortName == "" {
+		shortName = "stickers"
+	}
+
+	for i := 0; i < suggestShortNameTries; i++ {
+		name := shortName
+		if i > 0 {
+			name = shortName + "_" + strconv.Itoa(i+1)
+		}
+		_, err := c.svcCtx.Dao.StickerClient.StickerCheckShortName(c.ctx, &sticker.TLStickerCheckShortName{
+			ShortName: name,
+		})
+		if err == nil {
+			return mtproto.MakeTLStickersSuggestedShortName(&mtproto.Stickers_SuggestedShortName{
+				ShortName: name,
+			}).To_Stickers_SuggestedShortName(), nil
+		} else if status.Convert(err).Message() != status.Convert(mtproto.ErrShortNameOccupied).Message() {
+			c.Logger.Errorf("stickers.suggestShortName - error: %v", err)
+			return nil, err
+		}
+	}
+
+	err := mtproto.ErrTitleInvalid
+	c.Logger.Errorf("stickers.suggestShortName - error: %v", err)
+	return nil, err
+}
@@ -0,0 +1,56 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	userpb "github.com/teamgram/teamgram-server/app/service/biz/user/user"
+)
+
+// AccountGetPassword
+// account.getPassword#548a30f5 = account.Password;
+func (c *TwoFaCore) AccountGetPassword(in *mtproto.TLAccountGetPassword) (*mtproto.Account_Password, error) {
+	var (
+		userId = c.MD.UserId
+		err    error
+	)
+
+	if userId == 0 {
+		// auth.signIn returned SESSION_PASSWORD_NEEDED
+		userId, err = c.svcCtx.Dao.GetPasswordNeededUserId(c.ctx, c.MD.AuthId)
+		if err != nil {
+			c.Logger.Errorf("account.getPassword - error: %v", err)
+			return nil, err
+		} else if userId == 0 {
+			err = mtproto.ErrAuthKeyUnregistered
+			c.Logger.Errorf("account.getPassword - error: %v", err)
+			return nil, err
+		}
+	}
+
+	rValue, err := c.svcCtx.Dao.UserClient.UserGetPassword(c.ctx, &userpb.TLUserGetPassword{
+		UserId: userId,
+	})
+	if err != nil {
+		c.Logger.Errorf("account.getPassword - error: %v", err)
+		return nil, err
+	}
+
+	return rValue, nil
+}
@@ -0,0 +1,60 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package dao
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/teamgram/marmota/pkg/hack"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// cachePasswordNeededPrefix written by bff authorization when auth.signIn returns SESSION_PASSWORD_NEEDED
+const (
+	cachePasswordNeededPrefix = "password_needed"
+)
+
+func genCachePasswordNeededKey(authKeyId int64) string {
+	return fmt.Sprintf("%s_%d", cachePasswordNeededPrefix, authKeyId)
+}
+
+// GetPasswordNeededUserId returns the user waiting for auth.checkPassword on authKeyId, or 0.
+func (d *Dao) GetPasswordNeededUserId(ctx context.Context, authKeyId int64) (int64, error) {
+	cacheKey := genCachePasswordNeededKey(authKeyId)
+
+	v, err := d.kv.Get(cacheKey)
+	if err != nil {
+		logx.WithContext(ctx).Errorf("conn.GET(%s) error(%v)", cacheKey, err)
+		return 0, err
+	} else if v == "" {
+		return 0, nil
+	}
+
+	passwordNeeded := &struct {
+		UserId int64 `json:"user_id"`
+	}{}
+	if err = json.Unmarshal(hack.Bytes(v), passwordNeeded); err != nil {
+		return 0, err
+	}
+
+	return passwordNeeded.UserId, nil
+}
@@ -19,13 +19,17 @@
 package config
 
 import (
+	"github.com/teamgram/teamgram-server/pkg/filereference/conf"
 	"github.com/zeromicro/go-zero/zrpc"
 )
 
 type Config struct {
 	zrpc.RpcServerConf
+	FileReference conf.FileReferenceConfig
+
 	UpdatesClient     zrpc.RpcClientConf
 	UserClient        zrpc.RpcClientConf
 	ChatClient        zrpc.RpcClientConf
 	AuthsessionClient zrpc.RpcClientConf
+	ChannelClient     zrpc.RpcClientConf
 }
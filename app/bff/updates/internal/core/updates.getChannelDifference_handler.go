@@ -20,13 +20,79 @@ package core
 
 import (
 	"github.com/teamgram/proto/mtproto"
+	channelpb "github.com/teamgram/teamgram-server/app/service/biz/channel/channel"
+	userpb "github.com/teamgram/teamgram-server/app/service/biz/user/user"
 )
 
 // UpdatesGetChannelDifference
 // updates.getChannelDifference#3173d78 flags:# force:flags.0?true channel:InputChannel filter:ChannelMessagesFilter pts:int limit:int = updates.ChannelDifference;
 func (c *UpdatesCore) UpdatesGetChannelDifference(in *mtproto.TLUpdatesGetChannelDifference) (*mtproto.Updates_ChannelDifference, error) {
-	// TODO: not impl
-	c.Logger.Errorf("updates.getChannelDifference blocked, License key from https://teamgram.net required to unlock enterprise features.")
+	if in.GetChannel().GetPredicateName() != mtproto.Predicate_inputChannel {
+		err := mtproto.ErrChannelInvalid
+		c.Logger.Errorf("updates.getChannelDifference - error: %v", err)
+		return nil, err
+	}
 
-	return nil, mtproto.ErrEnterpriseIsBlocked
+	channelId := in.GetChannel().GetChannelId()
+	mChannel, err := c.svcCtx.Dao.ChannelClient.ChannelGetMutableChannel(c.ctx, &channelpb.TLChannelGetMutableChannel{
+		ChannelId: channelId,
+		Id:        []int64{c.MD.UserId},
+	})
+	if err != nil {
+		c.Logger.Errorf("updates.getChannelDifference - error: %v", err)
+		return nil, err
+	}
+
+	if in.GetPts() >= mChannel.Pts() {
+		return mtproto.MakeTLUpdatesChannelDifferenceEmpty(&mtproto.Updates_ChannelDifference{
+			Final:   true,
+			Pts:     mChannel.Pts(),
+			Timeout: nil,
+		}).To_Updates_ChannelDifference(), nil
+	}
+
+	difference, err := c.svcCtx.Dao.ChannelClient.ChannelGetChannelDifference(c.ctx, &channelpb.TLChannelGetChannelDifference{
+		UserId:    c.MD.UserId,
+		ChannelId: channelId,
+		Pts:       in.GetPts(),
+		Limit:     in.GetLimit(),
+	})
+	if err != nil {
+		c.Logger.Errorf("updates.getChannelDifference - error: %v", err)
+		return nil, err
+	}
+
+	rValue := mtproto.MakeTLUpdatesChannelDifference(&mtproto.Updates_ChannelDifference{
+		Final:        difference.GetFinal(),
+		Pts:          difference.GetPts(),
+		Timeout:      nil,
+		NewMessages:  make([]*mtproto.Message, 0, len(difference.GetNewMessages())),
+		OtherUpdates: []*mtproto.Update{},
+		Chats:        []*mtproto.Chat{mChannel.ToUnsafeChat(c.MD.UserId)},
+		Users:        []*mtproto.User{},
+	}).To_Updates_ChannelDifference()
+
+	idHelper := mtproto.NewIDListHelper(c.MD.UserId)
+	for _, box := range difference.GetNewMessages() {
+		m := box.ToMessage(c.MD.UserId)
+		idHelper.PickByMessage(m)
+		rValue.NewMessages = append(rValue.NewMessages, m)
+	}
+	c.svcCtx.Dao.FileReference.SetChannelDifference(c.MD.UserId, rValue)
+
+	idHelper.Visit(
+		func(userIdList []int64) {
+			mUsers, _ := c.svcCtx.Dao.UserClient.UserGetMutableUsers(
+				c.ctx,
+				&userpb.TLUserGetMutableUsers{
+					Id: userIdList,
+				})
+			rValue.Users = append(rValue.Users, mUsers.GetUserListByIdList(c.MD.UserId, userIdList...)...)
+		},
+		func(chatIdList []int64) {
+		},
+		func(channelIdList []int64) {
+		})
+
+	return rValue, nil
 }
@@ -46,6 +46,7 @@ func (c *UpdatesCore) UpdatesGetDifference(in *mtproto.TLUpdatesGetDifference) (
 		Pts:           in.Pts,
 		PtsTotalLimit: in.PtsTotalLimit,
 		Date:          int64(in.Date) - 1,
+		Qts:           mtproto.MakeFlagsInt32(in.Qts),
 	})
 	if err != nil {
 		c.Logger.Errorf("updates.getDifference - error: %v", err)
@@ -69,7 +70,7 @@ func (c *UpdatesCore) UpdatesGetDifference(in *mtproto.TLUpdatesGetDifference) (
 
 		rDifference = mtproto.MakeTLUpdatesDifference(&mtproto.Updates_Difference{
 			NewMessages:          updatesDiff.NewMessages,
-			NewEncryptedMessages: []*mtproto.EncryptedMessage{},
+			NewEncryptedMessages: updatesDiff.NewEncryptedMessages,
 			OtherUpdates:         updatesDiff.OtherUpdates,
 			Chats:                nil,
 			Users:                nil,
@@ -78,7 +79,7 @@ func (c *UpdatesCore) UpdatesGetDifference(in *mtproto.TLUpdatesGetDifference) (
 	case updates.Predicate_differenceSlice:
 		rDifference = mtproto.MakeTLUpdatesDifferenceSlice(&mtproto.Updates_Difference{
 			NewMessages:          updatesDiff.NewMessages,
-			NewEncryptedMessages: []*mtproto.EncryptedMessage{},
+			NewEncryptedMessages: updatesDiff.NewEncryptedMessages,
 			OtherUpdates:         updatesDiff.OtherUpdates,
 			Chats:                nil,
 			Users:                nil,
@@ -94,6 +95,8 @@ func (c *UpdatesCore) UpdatesGetDifference(in *mtproto.TLUpdatesGetDifference) (
 	default:
 	}
 
+	c.svcCtx.Dao.FileReference.SetDifference(c.MD.UserId, rDifference)
+
 	idHelper.PickByMessages(rDifference.NewMessages...)
 	idHelper.PickByUpdates(rDifference.OtherUpdates...)
 
@@ -20,14 +20,24 @@ package core
 
 import (
 	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/authsession/authsession"
 	"github.com/teamgram/teamgram-server/app/service/biz/updates/updates"
 )
 
 // UpdatesGetState
 // updates.getState#edd4882a = updates.State;
 func (c *UpdatesCore) UpdatesGetState(in *mtproto.TLUpdatesGetState) (*mtproto.Updates_State, error) {
-	rValue, err := c.svcCtx.Dao.UpdatesClient.UpdatesGetState(c.ctx, &updates.TLUpdatesGetState{
+	// the qts queue is kept by the perm auth key
+	keyId, err := c.svcCtx.Dao.AuthsessionClient.AuthsessionGetPermAuthKeyId(c.ctx, &authsession.TLAuthsessionGetPermAuthKeyId{
 		AuthKeyId: c.MD.AuthId,
+	})
+	if err != nil {
+		c.Logger.Errorf("updates.getState - error: %v", err)
+		return nil, err
+	}
+
+	rValue, err := c.svcCtx.Dao.UpdatesClient.UpdatesGetState(c.ctx, &updates.TLUpdatesGetState{
+		AuthKeyId: keyId.GetV(),
 		UserId:    c.MD.UserId,
 	})
 	if err != nil {
@@ -22,9 +22,11 @@ import (
 	"github.com/teamgram/marmota/pkg/net/rpcx"
 	"github.com/teamgram/teamgram-server/app/bff/updates/internal/config"
 	authsession_client "github.com/teamgram/teamgram-server/app/service/authsession/client"
+	channel_client "github.com/teamgram/teamgram-server/app/service/biz/channel/client"
 	chat_client "github.com/teamgram/teamgram-server/app/service/biz/chat/client"
 	updates_client "github.com/teamgram/teamgram-server/app/service/biz/updates/client"
 	user_client "github.com/teamgram/teamgram-server/app/service/biz/user/client"
+	"github.com/teamgram/teamgram-server/pkg/filereference"
 )
 
 type Dao struct {
@@ -32,6 +34,8 @@ type Dao struct {
 	user_client.UserClient
 	chat_client.ChatClient
 	authsession_client.AuthsessionClient
+	channel_client.ChannelClient
+	FileReference *filereference.FileReference
 }
 
 func New(c config.Config) *Dao {
@@ -40,5 +44,7 @@ func New(c config.Config) *Dao {
 		UserClient:        user_client.NewUserClient(rpcx.GetCachedRpcClient(c.UserClient)),
 		ChatClient:        chat_client.NewChatClient(rpcx.GetCachedRpcClient(c.ChatClient)),
 		AuthsessionClient: authsession_client.NewAuthsessionClient(rpcx.GetCachedRpcClient(c.AuthsessionClient)),
+		ChannelClient:     channel_client.NewChannelClient(rpcx.GetCachedRpcClient(c.ChannelClient)),
+		FileReference:     filereference.New(&c.FileReference),
 	}
 }
@@ -19,11 +19,13 @@
 package config
 
 import (
+	"github.com/teamgram/teamgram-server/pkg/filereference/conf"
 	"github.com/zeromicro/go-zero/zrpc"
 )
 
 type Config struct {
 	zrpc.RpcServerConf
-	UserClient zrpc.RpcClientConf
-	ChatClient zrpc.RpcClientConf
+	FileReference conf.FileReferenceConfig
+	UserClient    zrpc.RpcClientConf
+	ChatClient    zrpc.RpcClientConf
 }
@@ -23,6 +23,7 @@ import (
 	"github.com/teamgram/proto/mtproto"
 	chatpb "github.com/teamgram/teamgram-server/app/service/biz/chat/chat"
 	userpb "github.com/teamgram/teamgram-server/app/service/biz/user/user"
+	"github.com/teamgram/teamgram-server/pkg/filereference"
 	"github.com/zeromicro/go-zero/core/mr"
 )
 
@@ -106,6 +107,7 @@ func (c *UsersCore) UsersGetFullUser(in *mtproto.TLUsersGetFullUser) (*mtproto.U
 		ThemeEmoticon:       nil,
 		PrivateForwardName:  nil,
 	}).To_UserFull()
+	c.svcCtx.Dao.FileReference.SetPhoto(c.MD.UserId, userFull.ProfilePhoto, filereference.ProfilePhotoContext(peerId))
 
 	mr.FinishVoid(
 		func() {
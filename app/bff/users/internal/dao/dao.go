@@ -23,16 +23,19 @@ import (
 	"github.com/teamgram/teamgram-server/app/bff/users/internal/config"
 	chat_client "github.com/teamgram/teamgram-server/app/service/biz/chat/client"
 	user_client "github.com/teamgram/teamgram-server/app/service/biz/user/client"
+	"github.com/teamgram/teamgram-server/pkg/filereference"
 )
 
 type Dao struct {
 	user_client.UserClient
 	chat_client.ChatClient
+	FileReference *filereference.FileReference
 }
 
 func New(c config.Config) *Dao {
 	return &Dao{
-		UserClient: user_client.NewUserClient(rpcx.GetCachedRpcClient(c.UserClient)),
-		ChatClient: chat_client.NewChatClient(rpcx.GetCachedRpcClient(c.ChatClient)),
+		UserClient:    user_client.NewUserClient(rpcx.GetCachedRpcClient(c.UserClient)),
+		ChatClient:    chat_client.NewChatClient(rpcx.GetCachedRpcClient(c.ChatClient)),
+		FileReference: filereference.New(&c.FileReference),
 	}
 }
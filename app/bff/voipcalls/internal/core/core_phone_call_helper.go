@@ -0,0 +1,328 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"context"
+	"crypto/rand"
+	"math/big"
+	mrand "math/rand"
+	"time"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/bff/voipcalls/internal/model"
+	msgpb "github.com/teamgram/teamgram-server/app/messenger/msg/msg/msg"
+	"github.com/teamgram/teamgram-server/app/messenger/sync/sync"
+	"github.com/teamgram/teamgram-server/app/service/authsession/authsession"
+	userpb "github.com/teamgram/teamgram-server/app/service/biz/user/user"
+	"github.com/teamgram/teamgram-server/pkg/srp"
+
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// gAHashLength g_a_hash is the sha256 of g_a
+	gAHashLength = 32
+
+	// defaultCallConfig the libtgvoip defaults returned by phone.getCallConfig
+	defaultCallConfig = `{"audio_frame_size":60,"jitter_min_delay_60":2,"jitter_max_delay_60":10,` +
+		`"jitter_max_slots_60":20,"jitter_losses_to_reset":20,"jitter_resync_threshold":0.5,` +
+		`"audio_congestion_window":1024,"audio_max_bitrate":20000,"audio_max_bitrate_edge":16000,` +
+		`"audio_max_bitrate_gprs":8000,"audio_max_bitrate_saving":8000,"audio_init_bitrate":16000,` +
+		`"audio_init_bitrate_edge":8000,"audio_init_bitrate_gprs":8000,"audio_init_bitrate_saving":8000,` +
+		`"audio_bitrate_step_incr":1000,"audio_bitrate_step_decr":1000,"use_system_ns":true,"use_system_aec":true}`
+
+	// defaultRingTimeout is used if PhoneCall.RingTimeout isn't set
+	defaultRingTimeout = 90
+)
+
+var (
+	dhP = new(big.Int).SetBytes(srp.P)
+
+	errCallRatingInvalid = status.Error(mtproto.ErrBadRequest, "RATING_INVALID")
+)
+
+// checkGAOrB the g_a and g_b of the clients must be in the range (1, p-1)
+func checkGAOrB(gAOrB []byte) bool {
+	v := new(big.Int).SetBytes(gAOrB)
+	return len(gAOrB) <= srp.SizeBytes &&
+		v.Cmp(big.NewInt(1)) > 0 &&
+		v.Cmp(new(big.Int).Sub(dhP, big.NewInt(1))) < 0
+}
+
+// checkProtocol at least one of the transports must be supported
+func checkProtocol(protocol *mtproto.PhoneCallProtocol) bool {
+	return protocol != nil && (protocol.UdpP2P || protocol.UdpReflector)
+}
+
+func makeDiscardReason(reason *mtproto.PhoneCallDiscardReason) *mtproto.PhoneCallDiscardReason {
+	if reason == nil {
+		return mtproto.MakeTLPhoneCallDiscardReasonHangup(nil).To_PhoneCallDiscardReason()
+	}
+
+	return reason
+}
+
+// getPermAuthKeyId a call is bound to the perm auth key of the device which requested or accepted it.
+func (c *VoipCallsCore) getPermAuthKeyId() (int64, error) {
+	keyId, err := c.svcCtx.Dao.AuthsessionClient.AuthsessionGetPermAuthKeyId(c.ctx, &authsession.TLAuthsessionGetPermAuthKeyId{
+		AuthKeyId: c.MD.AuthId,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return keyId.GetV(), nil
+}
+
+// getPhoneCall returns the call if the user takes part in it.
+func (c *VoipCallsCore) getPhoneCall(peer *mtproto.InputPhoneCall) (*model.PhoneCallSession, error) {
+	call, err := c.svcCtx.Dao.GetCachePhoneCall(c.ctx, peer.GetId())
+	if err != nil {
+		return nil, err
+	} else if call == nil || call.AccessHash != peer.GetAccessHash() || !call.IsParticipant(c.MD.UserId) {
+		return nil, mtproto.ErrCallPeerInvalid
+	}
+
+	return call, nil
+}
+
+func (c *VoipCallsCore) getPhoneCallUsers(call *model.PhoneCallSession) (*userpb.Vector_ImmutableUser, error) {
+	return c.svcCtx.Dao.UserClient.UserGetMutableUsers(c.ctx, &userpb.TLUserGetMutableUsers{
+		Id: []int64{call.AdminId, call.ParticipantId},
+	})
+}
+
+// makePhoneConnections the relays of the config, the reflectors share the peer_tag of the call.
+func (c *VoipCallsCore) makePhoneConnections(call *model.PhoneCallSession) []*mtproto.PhoneConnection {
+	connections := make([]*mtproto.PhoneConnection, 0, len(c.svcCtx.Config.PhoneCall.Connections))
+	for _, v := range c.svcCtx.Config.PhoneCall.Connections {
+		if v.Type == "webrtc" {
+			connections = append(connections, mtproto.MakeTLPhoneConnectionWebrtc(&mtproto.PhoneConnection{
+				Turn:     v.Turn,
+				Stun:     v.Stun,
+				Id:       v.Id,
+				Ip:       v.Ip,
+				Ipv6:     v.Ipv6,
+				Port:     v.Port,
+				Username: v.Username,
+				Password: v.Password,
+			}).To_PhoneConnection())
+		} else {
+			connections = append(connections, mtproto.MakeTLPhoneConnection(&mtproto.PhoneConnection{
+				Id:      v.Id,
+				Ip:      v.Ip,
+				Ipv6:    v.Ipv6,
+				Port:    v.Port,
+				PeerTag: call.PeerTag,
+			}).To_PhoneConnection())
+		}
+	}
+
+	return connections
+}
+
+func (c *VoipCallsCore) toPhoneCall(call *model.PhoneCallSession, userId int64) *mtproto.PhoneCall {
+	var connections []*mtproto.PhoneConnection
+	if call.State == model.PhoneCallStateConfirmed {
+		connections = c.makePhoneConnections(call)
+	}
+
+	return call.ToPhoneCall(userId, c.svcCtx.Config.PhoneCall.P2PAllowed, connections)
+}
+
+func (c *VoipCallsCore) makePhonePhoneCall(call *model.PhoneCallSession, mUsers *userpb.Vector_ImmutableUser) *mtproto.Phone_PhoneCall {
+	return mtproto.MakeTLPhonePhoneCall(&mtproto.Phone_PhoneCall{
+		PhoneCall: c.toPhoneCall(call, c.MD.UserId),
+		Users:     mUsers.GetUserListByIdList(c.MD.UserId, call.AdminId, call.ParticipantId),
+	}).To_Phone_PhoneCall()
+}
+
+func (c *VoipCallsCore) makeUpdatePhoneCall(call *model.PhoneCallSession, userId int64, mUsers *userpb.Vector_ImmutableUser) *mtproto.Updates {
+	return mtproto.MakeUpdatesByUpdatesUsers(
+		mUsers.GetUserListByIdList(userId, call.AdminId, call.ParticipantId),
+		mtproto.MakeTLUpdatePhoneCall(&mtproto.Update{
+			PhoneCall: c.toPhoneCall(call, userId),
+		}).To_Update())
+}
+
+// pushPhoneCall pushes the call to the device of userId in the call,
+// or to all the devices if the user hasn't picked up a device yet.
+func (c *VoipCallsCore) pushPhoneCall(call *model.PhoneCallSession, userId int64, mUsers *userpb.Vector_ImmutableUser) {
+	var (
+		keyId   = call.AuthKeyId(userId)
+		updates = c.makeUpdatePhoneCall(call, userId, mUsers)
+	)
+
+	if keyId == 0 {
+		c.svcCtx.Dao.SyncClient.SyncPushUpdates(c.ctx, &sync.TLSyncPushUpdates{
+			UserId:  userId,
+			Updates: updates,
+		})
+	} else {
+		c.svcCtx.Dao.SyncClient.SyncUpdatesMe(c.ctx, &sync.TLSyncUpdatesMe{
+			UserId:    userId,
+			AuthKeyId: keyId,
+			ServerId:  "",
+			SessionId: nil,
+			Updates:   updates,
+		})
+	}
+}
+
+// pushPhoneCallNotMe pushes the updates to the other devices of userId.
+func (c *VoipCallsCore) pushPhoneCallNotMe(userId, keyId int64, updates *mtproto.Updates) {
+	c.svcCtx.Dao.SyncClient.SyncUpdatesNotMe(c.ctx, &sync.TLSyncUpdatesNotMe{
+		UserId:    userId,
+		AuthKeyId: keyId,
+		Updates:   updates,
+	})
+}
+
+// discardPhoneCall ends the call and writes the messageActionPhoneCall.
+//
+// userId and keyId are the device hanging up, 0 if the server discards the call,
+// the device gets the call in the reply, all the other devices are pushed.
+func (c *VoipCallsCore) discardPhoneCall(
+	call *model.PhoneCallSession,
+	userId, keyId int64,
+	reason *mtproto.PhoneCallDiscardReason,
+	duration int32,
+	mUsers *userpb.Vector_ImmutableUser) error {
+
+	// the duration of the client is preferred, it excludes the time to connect
+	if call.State == model.PhoneCallStateConfirmed {
+		maxDuration := int32(time.Now().Unix()) - call.StartDate
+		if duration <= 0 || duration > maxDuration {
+			duration = maxDuration
+		}
+		call.Duration = duration
+	}
+	call.State = model.PhoneCallStateDiscarded
+	call.Reason = makeDiscardReason(reason)
+
+	if err := c.svcCtx.Dao.PutCachePhoneCall(c.ctx, call); err != nil {
+		return err
+	}
+	c.svcCtx.Dao.DeleteCacheUserPhoneCall(c.ctx, call.AdminId, call.Id)
+	c.svcCtx.Dao.DeleteCacheUserPhoneCall(c.ctx, call.ParticipantId, call.Id)
+
+	// the call is always written by the admin
+	c.svcCtx.Dao.MsgClient.MsgPushUserMessage(
+		c.ctx,
+		&msgpb.TLMsgPushUserMessage{
+			UserId:    call.AdminId,
+			AuthKeyId: 0,
+			PeerType:  mtproto.PEER_USER,
+			PeerId:    call.ParticipantId,
+			PushType:  0,
+			Message: msgpb.MakeTLOutboxMessage(&msgpb.OutboxMessage{
+				NoWebpage:  true,
+				Background: false,
+				RandomId:   mrand.Int63(),
+				Message: mtproto.MakeTLMessageService(&mtproto.Message{
+					Out:    true,
+					FromId: mtproto.MakePeerUser(call.AdminId),
+					PeerId: mtproto.MakePeerUser(call.ParticipantId),
+					Date:   int32(time.Now().Unix()),
+					Action: call.ToMessageAction(),
+				}).To_Message(),
+				ScheduleDate: nil,
+			}).To_OutboxMessage(),
+		})
+
+	for _, id := range []int64{call.AdminId, call.ParticipantId} {
+		if id == userId {
+			c.pushPhoneCallNotMe(id, keyId, c.makeUpdatePhoneCall(call, id, mUsers))
+		} else {
+			c.svcCtx.Dao.SyncClient.SyncPushUpdates(c.ctx, &sync.TLSyncPushUpdates{
+				UserId:  id,
+				Updates: c.makeUpdatePhoneCall(call, id, mUsers),
+			})
+		}
+	}
+
+	return nil
+}
+
+// getRingTimeout seconds
+func (c *VoipCallsCore) getRingTimeout() int32 {
+	if c.svcCtx.Config.PhoneCall.RingTimeout > 0 {
+		return c.svcCtx.Config.PhoneCall.RingTimeout
+	}
+
+	return defaultRingTimeout
+}
+
+// startRingTimer discards the call if it isn't answered in time.
+//
+// the timer lives in the bff which requested the call, the call isn't discarded if it is restarted,
+// the clients hang up after call_ring_timeout_ms themselves.
+func (c *VoipCallsCore) startRingTimer(id int64) {
+	time.AfterFunc(time.Duration(c.getRingTimeout())*time.Second, func() {
+		c2 := New(context.Background(), c.svcCtx)
+
+		call, err := c2.svcCtx.Dao.GetCachePhoneCall(c2.ctx, id)
+		if err != nil || call == nil {
+			return
+		}
+
+		var (
+			reason    *mtproto.PhoneCallDiscardReason
+			nextState int
+		)
+		switch call.State {
+		case model.PhoneCallStateRequested, model.PhoneCallStateReceived:
+			reason = mtproto.MakeTLPhoneCallDiscardReasonMissed(nil).To_PhoneCallDiscardReason()
+			nextState = model.PhoneCallStateAccepted
+		case model.PhoneCallStateAccepted:
+			// the admin never confirmed
+			reason = mtproto.MakeTLPhoneCallDiscardReasonDisconnect(nil).To_PhoneCallDiscardReason()
+			nextState = model.PhoneCallStateConfirmed
+		default:
+			return
+		}
+
+		// the call may be accepted or confirmed right now, the timer takes the lock of the next state
+		// so the late phone.acceptCall or phone.confirmCall fails instead of reviving the discarded call.
+		if ok, err := c2.svcCtx.Dao.LockPhoneCallState(c2.ctx, id, nextState); err != nil || !ok {
+			return
+		}
+		// the state may be changed before the lock was taken
+		if call, err = c2.svcCtx.Dao.GetCachePhoneCall(c2.ctx, id); err != nil || call == nil || call.State >= nextState {
+			return
+		}
+
+		mUsers, err := c2.getPhoneCallUsers(call)
+		if err != nil {
+			c2.Logger.Errorf("phone.requestCall - ring timeout error: %v", err)
+			return
+		}
+		if err = c2.discardPhoneCall(call, 0, 0, reason, 0, mUsers); err != nil {
+			c2.Logger.Errorf("phone.requestCall - ring timeout error: %v", err)
+		}
+	})
+}
+
+// genPeerTag the peer_tag identifies the call on the reflectors
+func genPeerTag() []byte {
+	peerTag := make([]byte, model.PeerTagLength)
+	_, _ = rand.Read(peerTag)
+	return peerTag
+}
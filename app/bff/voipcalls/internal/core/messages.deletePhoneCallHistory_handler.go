@@ -0,0 +1,40 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	msgpb "github.com/teamgram/teamgram-server/app/messenger/msg/msg/msg"
+)
+
+// MessagesDeletePhoneCallHistory
+// messages.deletePhoneCallHistory#f9cbe409 flags:# revoke:flags.0?true = messages.AffectedFoundMessages;
+func (c *VoipCallsCore) MessagesDeletePhoneCallHistory(in *mtproto.TLMessagesDeletePhoneCallHistory) (*mtproto.Messages_AffectedFoundMessages, error) {
+	rValue, err := c.svcCtx.Dao.MsgClient.MsgDeletePhoneCallHistory(c.ctx, &msgpb.TLMsgDeletePhoneCallHistory{
+		UserId:    c.MD.UserId,
+		AuthKeyId: c.MD.AuthId,
+		Revoke:    in.Revoke,
+	})
+	if err != nil {
+		c.Logger.Errorf("messages.deletePhoneCallHistory - error: %v", err)
+		return nil, err
+	}
+
+	return rValue, nil
+}
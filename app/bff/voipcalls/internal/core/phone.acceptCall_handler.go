@@ -0,0 +1,114 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/bff/voipcalls/internal/model"
+)
+
+// PhoneAcceptCall
+// phone.acceptCall#3bd2b4a0 peer:InputPhoneCall g_b:bytes protocol:PhoneCallProtocol = phone.PhoneCall;
+func (c *VoipCallsCore) PhoneAcceptCall(in *mtproto.TLPhoneAcceptCall) (*mtproto.Phone_PhoneCall, error) {
+	call, err := c.getPhoneCall(in.Peer)
+	if err != nil {
+		c.Logger.Errorf("phone.acceptCall - error: %v", err)
+		return nil, err
+	} else if call.IsAdmin(c.MD.UserId) {
+		err = mtproto.ErrCallPeerInvalid
+		c.Logger.Errorf("phone.acceptCall - error: %v", err)
+		return nil, err
+	}
+
+	switch call.State {
+	case model.PhoneCallStateRequested, model.PhoneCallStateReceived:
+	case model.PhoneCallStateDiscarded:
+		err = mtproto.ErrCallAlreadyDeclined
+		c.Logger.Errorf("phone.acceptCall - error: %v", err)
+		return nil, err
+	default:
+		err = mtproto.ErrCallAlreadyAccepted
+		c.Logger.Errorf("phone.acceptCall - error: %v", err)
+		return nil, err
+	}
+
+	if !checkGAOrB(in.GB) {
+		err = mtproto.ErrDhGAInvalid
+		c.Logger.Errorf("phone.acceptCall - error: %v", err)
+		return nil, err
+	}
+
+	if !checkProtocol(in.Protocol) {
+		err = mtproto.ErrCallProtocolFlagsInvalid
+		c.Logger.Errorf("phone.acceptCall - error: %v", err)
+		return nil, err
+	}
+	protocol := model.NegotiateProtocol(call.Protocol, in.Protocol)
+	if !checkProtocol(protocol) {
+		err = mtproto.ErrParticipantVersionOutdated
+		c.Logger.Errorf("phone.acceptCall - error: %v", err)
+		return nil, err
+	}
+
+	keyId, err := c.getPermAuthKeyId()
+	if err != nil {
+		c.Logger.Errorf("phone.acceptCall - error: %v", err)
+		return nil, err
+	}
+
+	// the devices of the participant may accept at the same time
+	if ok, err := c.svcCtx.Dao.LockPhoneCallState(c.ctx, call.Id, model.PhoneCallStateAccepted); err != nil {
+		c.Logger.Errorf("phone.acceptCall - error: %v", err)
+		return nil, err
+	} else if !ok {
+		err = mtproto.ErrCallAlreadyAccepted
+		c.Logger.Errorf("phone.acceptCall - error: %v", err)
+		return nil, err
+	}
+
+	call.State = model.PhoneCallStateAccepted
+	call.ParticipantAuthKeyId = keyId
+	call.GB = in.GB
+	call.Protocol = protocol
+	if err = c.svcCtx.Dao.PutCachePhoneCall(c.ctx, call); err != nil {
+		c.Logger.Errorf("phone.acceptCall - error: %v", err)
+		return nil, err
+	}
+
+	mUsers, err := c.getPhoneCallUsers(call)
+	if err != nil {
+		c.Logger.Errorf("phone.acceptCall - error: %v", err)
+		return nil, err
+	}
+
+	c.pushPhoneCall(call, call.AdminId, mUsers)
+
+	// the other devices of the participant stop ringing
+	c.pushPhoneCallNotMe(
+		call.ParticipantId,
+		keyId,
+		mtproto.MakeUpdatesByUpdates(mtproto.MakeTLUpdatePhoneCall(&mtproto.Update{
+			PhoneCall: mtproto.MakeTLPhoneCallDiscarded(&mtproto.PhoneCall{
+				Video: call.Video,
+				Id:    call.Id,
+			}).To_PhoneCall(),
+		}).To_Update()))
+
+	return c.makePhonePhoneCall(call, mUsers), nil
+}
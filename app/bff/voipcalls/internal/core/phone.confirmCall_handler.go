@@ -0,0 +1,112 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"time"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/bff/voipcalls/internal/model"
+)
+
+// PhoneConfirmCall
+// phone.confirmCall#2efe1722 peer:InputPhoneCall g_a:bytes key_fingerprint:long protocol:PhoneCallProtocol = phone.PhoneCall;
+func (c *VoipCallsCore) PhoneConfirmCall(in *mtproto.TLPhoneConfirmCall) (*mtproto.Phone_PhoneCall, error) {
+	call, err := c.getPhoneCall(in.Peer)
+	if err != nil {
+		c.Logger.Errorf("phone.confirmCall - error: %v", err)
+		return nil, err
+	} else if !call.IsAdmin(c.MD.UserId) {
+		err = mtproto.ErrCallPeerInvalid
+		c.Logger.Errorf("phone.confirmCall - error: %v", err)
+		return nil, err
+	}
+
+	switch call.State {
+	case model.PhoneCallStateAccepted:
+	case model.PhoneCallStateDiscarded:
+		err = mtproto.ErrCallAlreadyDeclined
+		c.Logger.Errorf("phone.confirmCall - error: %v", err)
+		return nil, err
+	case model.PhoneCallStateConfirmed:
+		err = mtproto.ErrCallAlreadyAccepted
+		c.Logger.Errorf("phone.confirmCall - error: %v", err)
+		return nil, err
+	default:
+		err = mtproto.ErrCallPeerInvalid
+		c.Logger.Errorf("phone.confirmCall - error: %v", err)
+		return nil, err
+	}
+
+	keyId, err := c.getPermAuthKeyId()
+	if err != nil {
+		c.Logger.Errorf("phone.confirmCall - error: %v", err)
+		return nil, err
+	} else if keyId != call.AdminAuthKeyId {
+		err = mtproto.ErrCallPeerInvalid
+		c.Logger.Errorf("phone.confirmCall - error: %v", err)
+		return nil, err
+	}
+
+	// g_a must be the one committed to by g_a_hash
+	gAHash := sha256.Sum256(in.GA)
+	if !checkGAOrB(in.GA) || !bytes.Equal(gAHash[:], call.GAHash) {
+		err = mtproto.ErrDhGAInvalid
+		c.Logger.Errorf("phone.confirmCall - error: %v", err)
+		return nil, err
+	}
+
+	protocol := model.NegotiateProtocol(call.Protocol, in.Protocol)
+	if !checkProtocol(protocol) {
+		err = mtproto.ErrCallProtocolFlagsInvalid
+		c.Logger.Errorf("phone.confirmCall - error: %v", err)
+		return nil, err
+	}
+
+	if ok, err := c.svcCtx.Dao.LockPhoneCallState(c.ctx, call.Id, model.PhoneCallStateConfirmed); err != nil {
+		c.Logger.Errorf("phone.confirmCall - error: %v", err)
+		return nil, err
+	} else if !ok {
+		err = mtproto.ErrCallAlreadyAccepted
+		c.Logger.Errorf("phone.confirmCall - error: %v", err)
+		return nil, err
+	}
+
+	call.State = model.PhoneCallStateConfirmed
+	call.GA = in.GA
+	call.KeyFingerprint = in.KeyFingerprint
+	call.Protocol = protocol
+	call.PeerTag = genPeerTag()
+	call.StartDate = int32(time.Now().Unix())
+	if err = c.svcCtx.Dao.PutCachePhoneCall(c.ctx, call); err != nil {
+		c.Logger.Errorf("phone.confirmCall - error: %v", err)
+		return nil, err
+	}
+
+	mUsers, err := c.getPhoneCallUsers(call)
+	if err != nil {
+		c.Logger.Errorf("phone.confirmCall - error: %v", err)
+		return nil, err
+	}
+	c.pushPhoneCall(call, call.ParticipantId, mUsers)
+
+	return c.makePhonePhoneCall(call, mUsers), nil
+}
@@ -0,0 +1,62 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+)
+
+// PhoneDiscardCall
+// phone.discardCall#b2cbc1c0 flags:# video:flags.0?true peer:InputPhoneCall duration:int reason:PhoneCallDiscardReason connection_id:long = Updates;
+func (c *VoipCallsCore) PhoneDiscardCall(in *mtproto.TLPhoneDiscardCall) (*mtproto.Updates, error) {
+	call, err := c.getPhoneCall(in.Peer)
+	if err != nil {
+		c.Logger.Errorf("phone.discardCall - error: %v", err)
+		return nil, err
+	}
+
+	mUsers, err := c.getPhoneCallUsers(call)
+	if err != nil {
+		c.Logger.Errorf("phone.discardCall - error: %v", err)
+		return nil, err
+	}
+
+	// the call may be discarded by the peer or the ring timeout at the same time
+	if call.IsDiscarded() {
+		return c.makeUpdatePhoneCall(call, c.MD.UserId, mUsers), nil
+	}
+
+	keyId, err := c.getPermAuthKeyId()
+	if err != nil {
+		c.Logger.Errorf("phone.discardCall - error: %v", err)
+		return nil, err
+	} else if callKeyId := call.AuthKeyId(c.MD.UserId); callKeyId != 0 && callKeyId != keyId {
+		// only the device in the call hangs up, any device of the participant declines a ringing call
+		err = mtproto.ErrCallPeerInvalid
+		c.Logger.Errorf("phone.discardCall - error: %v", err)
+		return nil, err
+	}
+
+	if err = c.discardPhoneCall(call, c.MD.UserId, keyId, in.Reason, in.Duration, mUsers); err != nil {
+		c.Logger.Errorf("phone.discardCall - error: %v", err)
+		return nil, err
+	}
+
+	return c.makeUpdatePhoneCall(call, c.MD.UserId, mUsers), nil
+}
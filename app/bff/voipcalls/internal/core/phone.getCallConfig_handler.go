@@ -0,0 +1,38 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"encoding/json"
+
+	"github.com/teamgram/proto/mtproto"
+)
+
+// PhoneGetCallConfig
+// phone.getCallConfig#55451fa9 = DataJSON;
+func (c *VoipCallsCore) PhoneGetCallConfig(in *mtproto.TLPhoneGetCallConfig) (*mtproto.DataJSON, error) {
+	data := c.svcCtx.Config.PhoneCall.Json
+	if data == "" || !json.Valid([]byte(data)) {
+		data = defaultCallConfig
+	}
+
+	return mtproto.MakeTLDataJSON(&mtproto.DataJSON{
+		Data: data,
+	}).To_DataJSON(), nil
+}
@@ -0,0 +1,57 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"time"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/bff/voipcalls/internal/model"
+)
+
+// PhoneReceivedCall
+// phone.receivedCall#17d54f61 peer:InputPhoneCall = Bool;
+func (c *VoipCallsCore) PhoneReceivedCall(in *mtproto.TLPhoneReceivedCall) (*mtproto.Bool, error) {
+	call, err := c.getPhoneCall(in.Peer)
+	if err != nil {
+		c.Logger.Errorf("phone.receivedCall - error: %v", err)
+		return nil, err
+	}
+
+	// only the first device ringing is reported to the admin
+	if call.IsAdmin(c.MD.UserId) || call.State != model.PhoneCallStateRequested {
+		return mtproto.BoolTrue, nil
+	}
+
+	call.State = model.PhoneCallStateReceived
+	call.ReceiveDate = int32(time.Now().Unix())
+	if err = c.svcCtx.Dao.PutCachePhoneCall(c.ctx, call); err != nil {
+		c.Logger.Errorf("phone.receivedCall - error: %v", err)
+		return nil, err
+	}
+
+	mUsers, err := c.getPhoneCallUsers(call)
+	if err != nil {
+		c.Logger.Errorf("phone.receivedCall - error: %v", err)
+		return nil, err
+	}
+	c.pushPhoneCall(call, call.AdminId, mUsers)
+
+	return mtproto.BoolTrue, nil
+}
@@ -0,0 +1,132 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"math/rand"
+	"time"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/bff/voipcalls/internal/model"
+	userpb "github.com/teamgram/teamgram-server/app/service/biz/user/user"
+)
+
+// PhoneRequestCall
+// phone.requestCall#42ff96ed flags:# video:flags.0?true user_id:InputUser random_id:int g_a_hash:bytes protocol:PhoneCallProtocol = phone.PhoneCall;
+func (c *VoipCallsCore) PhoneRequestCall(in *mtproto.TLPhoneRequestCall) (*mtproto.Phone_PhoneCall, error) {
+	peer := mtproto.FromInputUser(c.MD.UserId, in.UserId)
+	if peer.PeerType != mtproto.PEER_USER || peer.PeerId == c.MD.UserId {
+		err := mtproto.ErrUserIdInvalid
+		c.Logger.Errorf("phone.requestCall - error: %v", err)
+		return nil, err
+	}
+
+	if len(in.GAHash) != gAHashLength {
+		err := mtproto.ErrDhGAInvalid
+		c.Logger.Errorf("phone.requestCall - error: %v", err)
+		return nil, err
+	}
+
+	if !checkProtocol(in.Protocol) {
+		err := mtproto.ErrCallProtocolFlagsInvalid
+		c.Logger.Errorf("phone.requestCall - error: %v", err)
+		return nil, err
+	}
+
+	mUsers, err := c.svcCtx.Dao.UserClient.UserGetMutableUsers(c.ctx, &userpb.TLUserGetMutableUsers{
+		Id: []int64{c.MD.UserId, peer.PeerId},
+	})
+	if err != nil {
+		c.Logger.Errorf("phone.requestCall - error: %v", err)
+		return nil, err
+	}
+	participant, _ := mUsers.GetImmutableUser(peer.PeerId)
+	if participant == nil || participant.IsBot() {
+		err = mtproto.ErrUserIdInvalid
+		c.Logger.Errorf("phone.requestCall - error: %v", err)
+		return nil, err
+	} else if participant.Deleted() {
+		err = mtproto.ErrInputUserDeactivated
+		c.Logger.Errorf("phone.requestCall - error: %v", err)
+		return nil, err
+	}
+
+	blocked, _ := c.svcCtx.Dao.UserClient.UserBlockedByUser(c.ctx, &userpb.TLUserBlockedByUser{
+		UserId:     peer.PeerId,
+		PeerUserId: c.MD.UserId,
+	})
+	if mtproto.FromBool(blocked) || !participant.CheckPrivacy(userpb.PHONE_CALL, c.MD.UserId) {
+		err = mtproto.ErrUserPrivacyRestricted
+		c.Logger.Errorf("phone.requestCall - error: %v", err)
+		return nil, err
+	}
+
+	keyId, err := c.getPermAuthKeyId()
+	if err != nil {
+		c.Logger.Errorf("phone.requestCall - error: %v", err)
+		return nil, err
+	}
+
+	call := &model.PhoneCallSession{
+		Id:             rand.Int63(),
+		AccessHash:     rand.Int63(),
+		Video:          in.Video,
+		Date:           int32(time.Now().Unix()),
+		State:          model.PhoneCallStateRequested,
+		AdminId:        c.MD.UserId,
+		AdminAuthKeyId: keyId,
+		ParticipantId:  peer.PeerId,
+		Protocol:       in.Protocol,
+		GAHash:         in.GAHash,
+	}
+
+	// the participant is in another call, the call is discarded at once
+	busyCall, err := c.svcCtx.Dao.GetCacheUserPhoneCall(c.ctx, peer.PeerId)
+	if err != nil {
+		c.Logger.Errorf("phone.requestCall - error: %v", err)
+		return nil, err
+	} else if busyCall != nil {
+		err = c.discardPhoneCall(
+			call,
+			c.MD.UserId,
+			keyId,
+			mtproto.MakeTLPhoneCallDiscardReasonBusy(nil).To_PhoneCallDiscardReason(),
+			0,
+			mUsers)
+		if err != nil {
+			c.Logger.Errorf("phone.requestCall - error: %v", err)
+			return nil, err
+		}
+
+		return c.makePhonePhoneCall(call, mUsers), nil
+	}
+
+	if err = c.svcCtx.Dao.PutCachePhoneCall(c.ctx, call); err != nil {
+		c.Logger.Errorf("phone.requestCall - error: %v", err)
+		return nil, err
+	}
+	c.svcCtx.Dao.PutCacheUserPhoneCall(c.ctx, call.AdminId, call.Id)
+	c.svcCtx.Dao.PutCacheUserPhoneCall(c.ctx, call.ParticipantId, call.Id)
+
+	// all the devices of the participant ring, the first one accepting gets the call
+	c.pushPhoneCall(call, call.ParticipantId, mUsers)
+	c.startRingTimer(call.Id)
+
+	return c.makePhonePhoneCall(call, mUsers), nil
+}
@@ -0,0 +1,45 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"encoding/json"
+
+	"github.com/teamgram/proto/mtproto"
+)
+
+// PhoneSaveCallDebug
+// phone.saveCallDebug#277add7e peer:InputPhoneCall debug:DataJSON = Bool;
+func (c *VoipCallsCore) PhoneSaveCallDebug(in *mtproto.TLPhoneSaveCallDebug) (*mtproto.Bool, error) {
+	call, err := c.getPhoneCall(in.Peer)
+	if err != nil {
+		c.Logger.Errorf("phone.saveCallDebug - error: %v", err)
+		return nil, err
+	}
+
+	if !json.Valid([]byte(in.GetDebug().GetData())) {
+		err = mtproto.ErrDataJsonInvalid
+		c.Logger.Errorf("phone.saveCallDebug - error: %v", err)
+		return nil, err
+	}
+
+	c.Logger.Infof("phone.saveCallDebug - call: %d, user: %d, debug: %s", call.Id, c.MD.UserId, in.GetDebug().GetData())
+
+	return mtproto.BoolTrue, nil
+}
@@ -0,0 +1,56 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/bff/voipcalls/internal/model"
+	"github.com/teamgram/teamgram-server/app/messenger/sync/sync"
+)
+
+// PhoneSendSignalingData
+// phone.sendSignalingData#ff7a9383 peer:InputPhoneCall data:bytes = Bool;
+func (c *VoipCallsCore) PhoneSendSignalingData(in *mtproto.TLPhoneSendSignalingData) (*mtproto.Bool, error) {
+	call, err := c.getPhoneCall(in.Peer)
+	if err != nil {
+		c.Logger.Errorf("phone.sendSignalingData - error: %v", err)
+		return nil, err
+	}
+
+	// the data is relayed once both devices are known
+	if call.State != model.PhoneCallStateAccepted && call.State != model.PhoneCallStateConfirmed {
+		err = mtproto.ErrCallPeerInvalid
+		c.Logger.Errorf("phone.sendSignalingData - error: %v", err)
+		return nil, err
+	}
+
+	peerId := call.PeerId(c.MD.UserId)
+	c.svcCtx.Dao.SyncClient.SyncUpdatesMe(c.ctx, &sync.TLSyncUpdatesMe{
+		UserId:    peerId,
+		AuthKeyId: call.AuthKeyId(peerId),
+		ServerId:  "",
+		SessionId: nil,
+		Updates: mtproto.MakeUpdatesByUpdates(mtproto.MakeTLUpdatePhoneCallSignalingData(&mtproto.Update{
+			PhoneCallId:    call.Id,
+			Data_FLAGBYTES: in.Data,
+		}).To_Update()),
+	})
+
+	return mtproto.BoolTrue, nil
+}
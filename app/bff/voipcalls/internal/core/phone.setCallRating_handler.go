@@ -0,0 +1,49 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+)
+
+// PhoneSetCallRating
+// phone.setCallRating#59ead627 flags:# user_initiative:flags.0?true peer:InputPhoneCall rating:int comment:string = Updates;
+func (c *VoipCallsCore) PhoneSetCallRating(in *mtproto.TLPhoneSetCallRating) (*mtproto.Updates, error) {
+	call, err := c.getPhoneCall(in.Peer)
+	if err != nil {
+		c.Logger.Errorf("phone.setCallRating - error: %v", err)
+		return nil, err
+	}
+
+	if in.Rating < 1 || in.Rating > 5 {
+		err = errCallRatingInvalid
+		c.Logger.Errorf("phone.setCallRating - error: %v", err)
+		return nil, err
+	}
+
+	// TODO: keep the ratings for the statistics
+	c.Logger.Infof("phone.setCallRating - call: %d, user: %d, rating: %d, user_initiative: %v, comment: %s",
+		call.Id,
+		c.MD.UserId,
+		in.Rating,
+		in.UserInitiative,
+		in.Comment)
+
+	return mtproto.MakeEmptyUpdates(), nil
+}
@@ -0,0 +1,155 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package dao
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/teamgram/teamgram-server/app/bff/voipcalls/internal/model"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+const (
+	phoneCallKeyPrefix       = "phone_call"
+	phoneCallLockKeyPrefix   = "phone_call_lock"
+	userPhoneCallKeyPrefix   = "user_phone_call"
+	phoneCallExpireTimeout   = 24 * 60 * 60 // the rating and the debug log are accepted for a day
+	phoneCallLockTimeout     = 10
+	userPhoneCallExpireLimit = phoneCallExpireTimeout
+)
+
+func genPhoneCallKey(id int64) string {
+	return fmt.Sprintf("%s_%d", phoneCallKeyPrefix, id)
+}
+
+func genPhoneCallLockKey(id int64, state int) string {
+	return fmt.Sprintf("%s_%d_%d", phoneCallLockKeyPrefix, id, state)
+}
+
+func genUserPhoneCallKey(userId int64) string {
+	return fmt.Sprintf("%s_%d", userPhoneCallKeyPrefix, userId)
+}
+
+func (d *Dao) PutCachePhoneCall(ctx context.Context, call *model.PhoneCallSession) error {
+	var (
+		key      = genPhoneCallKey(call.Id)
+		value, _ = json.Marshal(call)
+	)
+
+	if err := d.kv.Setex(key, string(value), phoneCallExpireTimeout); err != nil {
+		logx.WithContext(ctx).Errorf("conn.Do(SETEX %s) error(%v)", key, err)
+		return err
+	}
+
+	return nil
+}
+
+func (d *Dao) GetCachePhoneCall(ctx context.Context, id int64) (*model.PhoneCallSession, error) {
+	key := genPhoneCallKey(id)
+
+	value, err := d.kv.Get(key)
+	if err != nil {
+		logx.WithContext(ctx).Errorf("conn.Do(GET %s) error(%v)", key, err)
+		return nil, err
+	} else if value == "" {
+		return nil, nil
+	}
+
+	call := new(model.PhoneCallSession)
+	if err = json.Unmarshal([]byte(value), call); err != nil {
+		logx.WithContext(ctx).Errorf("json.Unmarshal(%s) error(%v)", value, err)
+		return nil, err
+	}
+
+	return call, nil
+}
+
+// LockPhoneCallState only the first of the concurrent requests moving a call to state gets the lock,
+// e.g. the devices of the participant accepting the call at the same time.
+func (d *Dao) LockPhoneCallState(ctx context.Context, id int64, state int) (bool, error) {
+	key := genPhoneCallLockKey(id, state)
+
+	ok, err := d.kv.SetnxEx(key, "1", phoneCallLockTimeout)
+	if err != nil {
+		logx.WithContext(ctx).Errorf("conn.Do(SETEX %s NX) error(%v)", key, err)
+		return false, err
+	}
+
+	return ok, nil
+}
+
+// PutCacheUserPhoneCall marks the user busy with the call.
+func (d *Dao) PutCacheUserPhoneCall(ctx context.Context, userId, id int64) error {
+	key := genUserPhoneCallKey(userId)
+
+	if err := d.kv.Setex(key, strconv.FormatInt(id, 10), userPhoneCallExpireLimit); err != nil {
+		logx.WithContext(ctx).Errorf("conn.Do(SETEX %s) error(%v)", key, err)
+		return err
+	}
+
+	return nil
+}
+
+// GetCacheUserPhoneCall returns the running call of the user, nil if the user isn't busy.
+func (d *Dao) GetCacheUserPhoneCall(ctx context.Context, userId int64) (*model.PhoneCallSession, error) {
+	key := genUserPhoneCallKey(userId)
+
+	value, err := d.kv.Get(key)
+	if err != nil {
+		logx.WithContext(ctx).Errorf("conn.Do(GET %s) error(%v)", key, err)
+		return nil, err
+	} else if value == "" {
+		return nil, nil
+	}
+
+	id, _ := strconv.ParseInt(value, 10, 64)
+	call, err := d.GetCachePhoneCall(ctx, id)
+	if err != nil {
+		return nil, err
+	} else if call == nil || call.IsDiscarded() {
+		return nil, nil
+	}
+
+	return call, nil
+}
+
+// DeleteCacheUserPhoneCall the user is no longer busy with the call id.
+func (d *Dao) DeleteCacheUserPhoneCall(ctx context.Context, userId, id int64) error {
+	key := genUserPhoneCallKey(userId)
+
+	value, err := d.kv.Get(key)
+	if err != nil {
+		logx.WithContext(ctx).Errorf("conn.Do(GET %s) error(%v)", key, err)
+		return err
+	} else if value != strconv.FormatInt(id, 10) {
+		// already busy with another call
+		return nil
+	}
+
+	if _, err = d.kv.Del(key); err != nil {
+		logx.WithContext(ctx).Errorf("conn.Do(DEL %s) error(%v)", key, err)
+		return err
+	}
+
+	return nil
+}
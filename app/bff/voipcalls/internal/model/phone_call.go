@@ -0,0 +1,234 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package model
+
+import (
+	"github.com/teamgram/proto/mtproto"
+
+	"github.com/gogo/protobuf/types"
+)
+
+const (
+	PhoneCallStateRequested = 0 // phone.requestCall, waiting for phone.receivedCall
+	PhoneCallStateReceived  = 1 // ringing on the devices of the participant
+	PhoneCallStateAccepted  = 2 // phone.acceptCall, waiting for phone.confirmCall
+	PhoneCallStateConfirmed = 3 // the key is exchanged, the call is running
+	PhoneCallStateDiscarded = 4
+)
+
+const (
+	// PeerTagLength is the length of the peer_tag of a reflector
+	PeerTagLength = 16
+)
+
+// PhoneCallSession is the signaling state of a call, the admin is the caller.
+//
+// the server only relays the DH exchange: g_a_hash and g_b in phone.acceptCall,
+// g_a and key_fingerprint in phone.confirmCall.
+type PhoneCallSession struct {
+	Id                   int64                           `json:"id"`
+	AccessHash           int64                           `json:"access_hash"`
+	Video                bool                            `json:"video"`
+	Date                 int32                           `json:"date"`
+	State                int                             `json:"state"`
+	AdminId              int64                           `json:"admin_id"`
+	AdminAuthKeyId       int64                           `json:"admin_auth_key_id"`
+	ParticipantId        int64                           `json:"participant_id"`
+	ParticipantAuthKeyId int64                           `json:"participant_auth_key_id"`
+	Protocol             *mtproto.PhoneCallProtocol      `json:"protocol"`
+	GAHash               []byte                          `json:"g_a_hash"`
+	GA                   []byte                          `json:"g_a,omitempty"`
+	GB                   []byte                          `json:"g_b,omitempty"`
+	KeyFingerprint       int64                           `json:"key_fingerprint"`
+	PeerTag              []byte                          `json:"peer_tag,omitempty"`
+	ReceiveDate          int32                           `json:"receive_date"`
+	StartDate            int32                           `json:"start_date"`
+	Duration             int32                           `json:"duration"`
+	Reason               *mtproto.PhoneCallDiscardReason `json:"reason,omitempty"`
+}
+
+func (m *PhoneCallSession) IsAdmin(userId int64) bool {
+	return m.AdminId == userId
+}
+
+func (m *PhoneCallSession) IsParticipant(userId int64) bool {
+	return m.AdminId == userId || m.ParticipantId == userId
+}
+
+func (m *PhoneCallSession) PeerId(userId int64) int64 {
+	if m.AdminId == userId {
+		return m.ParticipantId
+	}
+	return m.AdminId
+}
+
+// AuthKeyId the device of userId in the call, 0 if the participant hasn't accepted yet.
+func (m *PhoneCallSession) AuthKeyId(userId int64) int64 {
+	if m.AdminId == userId {
+		return m.AdminAuthKeyId
+	}
+	return m.ParticipantAuthKeyId
+}
+
+func (m *PhoneCallSession) IsDiscarded() bool {
+	return m.State == PhoneCallStateDiscarded
+}
+
+// ToPhoneCall the PhoneCall seen by userId, connections are only sent once the key is exchanged.
+func (m *PhoneCallSession) ToPhoneCall(userId int64, p2pAllowed bool, connections []*mtproto.PhoneConnection) *mtproto.PhoneCall {
+	switch m.State {
+	case PhoneCallStateRequested, PhoneCallStateReceived:
+		if m.IsAdmin(userId) {
+			return m.makePhoneCallWaiting()
+		}
+		return mtproto.MakeTLPhoneCallRequested(&mtproto.PhoneCall{
+			Video:         m.Video,
+			Id:            m.Id,
+			AccessHash:    m.AccessHash,
+			Date:          m.Date,
+			AdminId:       m.AdminId,
+			ParticipantId: m.ParticipantId,
+			GAHash:        m.GAHash,
+			Protocol:      m.Protocol,
+		}).To_PhoneCall()
+	case PhoneCallStateAccepted:
+		if !m.IsAdmin(userId) {
+			return m.makePhoneCallWaiting()
+		}
+		return mtproto.MakeTLPhoneCallAccepted(&mtproto.PhoneCall{
+			Video:         m.Video,
+			Id:            m.Id,
+			AccessHash:    m.AccessHash,
+			Date:          m.Date,
+			AdminId:       m.AdminId,
+			ParticipantId: m.ParticipantId,
+			GB:            m.GB,
+			Protocol:      m.Protocol,
+		}).To_PhoneCall()
+	case PhoneCallStateConfirmed:
+		// the admin gets g_b, the participant gets g_a
+		gAOrB := m.GA
+		if m.IsAdmin(userId) {
+			gAOrB = m.GB
+		}
+		return mtproto.MakeTLPhoneCall(&mtproto.PhoneCall{
+			P2PAllowed:     p2pAllowed,
+			Video:          m.Video,
+			Id:             m.Id,
+			AccessHash:     m.AccessHash,
+			Date:           m.Date,
+			AdminId:        m.AdminId,
+			ParticipantId:  m.ParticipantId,
+			GAOrB:          gAOrB,
+			KeyFingerprint: m.KeyFingerprint,
+			Protocol:       m.Protocol,
+			Connections:    connections,
+			StartDate:      m.StartDate,
+		}).To_PhoneCall()
+	default:
+		return m.ToPhoneCallDiscarded()
+	}
+}
+
+func (m *PhoneCallSession) makePhoneCallWaiting() *mtproto.PhoneCall {
+	call := mtproto.MakeTLPhoneCallWaiting(&mtproto.PhoneCall{
+		Video:         m.Video,
+		Id:            m.Id,
+		AccessHash:    m.AccessHash,
+		Date:          m.Date,
+		AdminId:       m.AdminId,
+		ParticipantId: m.ParticipantId,
+		Protocol:      m.Protocol,
+	}).To_PhoneCall()
+	if m.ReceiveDate != 0 {
+		call.ReceiveDate = &types.Int32Value{Value: m.ReceiveDate}
+	}
+
+	return call
+}
+
+// ToPhoneCallDiscarded a rating is asked for the calls which were connected.
+func (m *PhoneCallSession) ToPhoneCallDiscarded() *mtproto.PhoneCall {
+	call := mtproto.MakeTLPhoneCallDiscarded(&mtproto.PhoneCall{
+		NeedRating: m.StartDate != 0,
+		NeedDebug:  m.Reason.GetPredicateName() == mtproto.Predicate_phoneCallDiscardReasonDisconnect,
+		Video:      m.Video,
+		Id:         m.Id,
+		Reason:     m.Reason,
+	}).To_PhoneCall()
+	if m.StartDate != 0 {
+		call.Duration = &types.Int32Value{Value: m.Duration}
+	}
+
+	return call
+}
+
+// ToMessageAction the messageActionPhoneCall of the service message written when the call ends.
+func (m *PhoneCallSession) ToMessageAction() *mtproto.MessageAction {
+	action := mtproto.MakeTLMessageActionPhoneCall(&mtproto.MessageAction{
+		Video:  m.Video,
+		CallId: m.Id,
+		Reason: m.Reason,
+	}).To_MessageAction()
+	if m.StartDate != 0 {
+		action.Duration = &types.Int32Value{Value: m.Duration}
+	}
+
+	return action
+}
+
+// NegotiateProtocol returns the protocol supported by both sides, nil if there is none.
+func NegotiateProtocol(a, b *mtproto.PhoneCallProtocol) *mtproto.PhoneCallProtocol {
+	if a == nil || b == nil {
+		return nil
+	}
+
+	p := mtproto.MakeTLPhoneCallProtocol(&mtproto.PhoneCallProtocol{
+		UdpP2P:          a.UdpP2P && b.UdpP2P,
+		UdpReflector:    a.UdpReflector && b.UdpReflector,
+		MinLayer:        a.MinLayer,
+		MaxLayer:        a.MaxLayer,
+		LibraryVersions: nil,
+	}).To_PhoneCallProtocol()
+	if b.MinLayer > p.MinLayer {
+		p.MinLayer = b.MinLayer
+	}
+	if b.MaxLayer < p.MaxLayer {
+		p.MaxLayer = b.MaxLayer
+	}
+	if p.MinLayer > p.MaxLayer {
+		return nil
+	}
+
+	// keep the order of a
+	for _, v := range a.LibraryVersions {
+		for _, v2 := range b.LibraryVersions {
+			if v == v2 {
+				p.LibraryVersions = append(p.LibraryVersions, v)
+				break
+			}
+		}
+	}
+	// no common version, the clients choose among the versions of b
+	if len(p.LibraryVersions) == 0 {
+		p.LibraryVersions = b.LibraryVersions
+	}
+
+	return p
+}
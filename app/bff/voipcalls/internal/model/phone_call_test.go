@@ -0,0 +1,114 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package model
+
+import (
+	"testing"
+
+	"github.com/teamgram/proto/mtproto"
+)
+
+func makeProtocol(p2p, reflector bool, minLayer, maxLayer int32, versions ...string) *mtproto.PhoneCallProtocol {
+	return mtproto.MakeTLPhoneCallProtocol(&mtproto.PhoneCallProtocol{
+		UdpP2P:          p2p,
+		UdpReflector:    reflector,
+		MinLayer:        minLayer,
+		MaxLayer:        maxLayer,
+		LibraryVersions: versions,
+	}).To_PhoneCallProtocol()
+}
+
+func TestNegotiateProtocol(t *testing.T) {
+	for i, c := range []struct {
+		a, b *mtproto.PhoneCallProtocol
+		want *mtproto.PhoneCallProtocol
+	}{
+		{makeProtocol(true, true, 65, 92, "4.0.0", "3.0.0"), makeProtocol(true, true, 65, 92, "3.0.0"), makeProtocol(true, true, 65, 92, "3.0.0")},
+		{makeProtocol(true, true, 65, 92), makeProtocol(false, true, 70, 100), makeProtocol(false, true, 70, 92)},
+		{makeProtocol(true, true, 65, 92, "4.0.0"), makeProtocol(true, true, 65, 92, "2.4.4"), makeProtocol(true, true, 65, 92, "2.4.4")},
+		{makeProtocol(true, true, 65, 70), makeProtocol(true, true, 71, 92), nil},
+		{makeProtocol(true, true, 65, 92), nil, nil},
+	} {
+		p := NegotiateProtocol(c.a, c.b)
+		if (p == nil) != (c.want == nil) {
+			t.Errorf("#%d: NegotiateProtocol() = %v, want %v", i, p, c.want)
+			continue
+		} else if p == nil {
+			continue
+		}
+		if p.UdpP2P != c.want.UdpP2P ||
+			p.UdpReflector != c.want.UdpReflector ||
+			p.MinLayer != c.want.MinLayer ||
+			p.MaxLayer != c.want.MaxLayer ||
+			len(p.LibraryVersions) != len(c.want.LibraryVersions) {
+			t.Errorf("#%d: NegotiateProtocol() = %v, want %v", i, p, c.want)
+			continue
+		}
+		for j := range p.LibraryVersions {
+			if p.LibraryVersions[j] != c.want.LibraryVersions[j] {
+				t.Errorf("#%d: NegotiateProtocol() = %v, want %v", i, p, c.want)
+			}
+		}
+	}
+}
+
+func TestToPhoneCall(t *testing.T) {
+	const (
+		adminId       = 1
+		participantId = 2
+	)
+
+	call := &PhoneCallSession{
+		Id:            100,
+		AccessHash:    200,
+		AdminId:       adminId,
+		ParticipantId: participantId,
+		GA:            []byte{1},
+		GB:            []byte{2},
+	}
+
+	for _, c := range []struct {
+		state       int
+		admin       string
+		participant string
+	}{
+		{PhoneCallStateRequested, mtproto.Predicate_phoneCallWaiting, mtproto.Predicate_phoneCallRequested},
+		{PhoneCallStateReceived, mtproto.Predicate_phoneCallWaiting, mtproto.Predicate_phoneCallRequested},
+		{PhoneCallStateAccepted, mtproto.Predicate_phoneCallAccepted, mtproto.Predicate_phoneCallWaiting},
+		{PhoneCallStateConfirmed, mtproto.Predicate_phoneCall, mtproto.Predicate_phoneCall},
+		{PhoneCallStateDiscarded, mtproto.Predicate_phoneCallDiscarded, mtproto.Predicate_phoneCallDiscarded},
+	} {
+		call.State = c.state
+		if v := call.ToPhoneCall(adminId, true, nil).PredicateName; v != c.admin {
+			t.Errorf("state %d: admin gets %s, want %s", c.state, v, c.admin)
+		}
+		if v := call.ToPhoneCall(participantId, true, nil).PredicateName; v != c.participant {
+			t.Errorf("state %d: participant gets %s, want %s", c.state, v, c.participant)
+		}
+	}
+
+	// each side gets the key part of the other one
+	call.State = PhoneCallStateConfirmed
+	if v := call.ToPhoneCall(adminId, true, nil).GAOrB; len(v) != 1 || v[0] != 2 {
+		t.Errorf("admin gets g_a_or_b %v, want g_b", v)
+	}
+	if v := call.ToPhoneCall(participantId, true, nil).GAOrB; len(v) != 1 || v[0] != 1 {
+		t.Errorf("participant gets g_a_or_b %v, want g_a", v)
+	}
+}
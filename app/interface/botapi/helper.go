@@ -0,0 +1,19 @@
+/*
+ * WARNING! All changes made in this file will be lost!
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright 2022 Teamgram Authors.
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package botapi_helper
+
+import (
+	"github.com/teamgram/teamgram-server/app/interface/botapi/internal/server"
+)
+
+var (
+	New = server.New
+)
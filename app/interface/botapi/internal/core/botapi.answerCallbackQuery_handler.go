@@ -0,0 +1,65 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"strconv"
+
+	"github.com/teamgram/teamgram-server/app/interface/botapi/internal/model"
+)
+
+// AnswerCallbackQuery
+// https://core.telegram.org/bots/api#answercallbackquery
+func (c *BotApiCore) AnswerCallbackQuery(in *model.Params) (bool, error) {
+	queryId, err := strconv.ParseInt(in.String("callback_query_id"), 10, 64)
+	if err != nil {
+		return false, model.ErrQueryIdInvalid
+	}
+
+	text := in.String("text")
+	if model.TextLength(text) > model.MaxCallbackAnswerLen {
+		return false, model.NewBadRequest("MESSAGE_TOO_LONG")
+	}
+	cacheTime, err := in.Int("cache_time", 0)
+	if err != nil {
+		return false, err
+	}
+
+	// the same as messages.setBotCallbackAnswer, the answer is polled by messages.getBotCallbackAnswer
+	query, err := c.svcCtx.Dao.GetCacheBotCallbackQuery(c.ctx, queryId)
+	if err != nil {
+		c.Logger.Errorf("botapi.answerCallbackQuery - error: %v", err)
+		return false, err
+	} else if query == nil || query.BotId != c.BotId() {
+		return false, model.ErrQueryIdInvalid
+	}
+
+	err = c.svcCtx.Dao.PutCacheBotCallbackAnswer(c.ctx, queryId, &model.BotCallbackAnswer{
+		Alert:     in.Bool("show_alert"),
+		Message:   text,
+		Url:       in.String("url"),
+		CacheTime: int32(cacheTime),
+	})
+	if err != nil {
+		c.Logger.Errorf("botapi.answerCallbackQuery - error: %v", err)
+		return false, err
+	}
+
+	return true, nil
+}
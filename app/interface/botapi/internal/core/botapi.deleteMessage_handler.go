@@ -0,0 +1,52 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"github.com/teamgram/teamgram-server/app/interface/botapi/internal/model"
+	msgpb "github.com/teamgram/teamgram-server/app/messenger/msg/msg/msg"
+)
+
+// DeleteMessage
+// https://core.telegram.org/bots/api#deletemessage
+func (c *BotApiCore) DeleteMessage(in *model.Params) (bool, error) {
+	peerType, peerId, box, err := c.getMessageBox(in)
+	if err != nil {
+		c.Logger.Errorf("botapi.deleteMessage - error: %v", err)
+		if err == model.ErrMessageToEdit {
+			err = model.NewBadRequest("message to delete not found")
+		}
+		return false, err
+	}
+
+	_, err = c.svcCtx.Dao.MsgClient.MsgDeleteMessages(c.ctx, &msgpb.TLMsgDeleteMessages{
+		UserId:    c.BotId(),
+		AuthKeyId: 0,
+		PeerType:  peerType,
+		PeerId:    peerId,
+		Revoke:    true,
+		Id:        []int32{box.GetMessageId()},
+	})
+	if err != nil {
+		c.Logger.Errorf("botapi.deleteMessage - error: %v", err)
+		return false, err
+	}
+
+	return true, nil
+}
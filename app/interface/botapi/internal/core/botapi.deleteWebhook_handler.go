@@ -0,0 +1,41 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"github.com/teamgram/teamgram-server/app/interface/botapi/internal/model"
+)
+
+// DeleteWebhook
+// https://core.telegram.org/bots/api#deletewebhook
+func (c *BotApiCore) DeleteWebhook(in *model.Params) (bool, error) {
+	if err := c.svcCtx.Dao.DeleteBotWebhook(c.ctx, c.BotId()); err != nil {
+		c.Logger.Errorf("botapi.deleteWebhook - error: %v", err)
+		return false, err
+	}
+
+	if in.Bool("drop_pending_updates") {
+		if err := c.svcCtx.Dao.DropBotUpdates(c.ctx, c.BotId()); err != nil {
+			c.Logger.Errorf("botapi.deleteWebhook - error: %v", err)
+			return false, err
+		}
+	}
+
+	return true, nil
+}
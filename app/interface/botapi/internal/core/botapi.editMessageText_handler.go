@@ -0,0 +1,63 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/interface/botapi/internal/model"
+)
+
+// EditMessageText
+// https://core.telegram.org/bots/api#editmessagetext
+func (c *BotApiCore) EditMessageText(in *model.Params) (*model.Message, error) {
+	text, entities, err := c.makeText(in, "text", "entities")
+	if err != nil {
+		return nil, err
+	}
+	if model.TextLength(text) == 0 {
+		return nil, model.ErrMessageEmpty
+	} else if model.TextLength(text) > model.MaxMessageLength {
+		return nil, model.ErrMessageTooLong
+	}
+
+	replyMarkup, err := c.makeReplyMarkup(in)
+	if err != nil {
+		return nil, err
+	}
+
+	peerType, peerId, message, err := c.getSelfMessage(in)
+	if err != nil {
+		c.Logger.Errorf("botapi.editMessageText - error: %v", err)
+		return nil, err
+	} else if message.GetMedia() != nil && message.GetMedia().GetPredicateName() != mtproto.Predicate_messageMediaEmpty {
+		return nil, model.NewBadRequest("there is no text in the message to edit")
+	}
+
+	message.Message = text
+	message.Entities = entities
+	message.ReplyMarkup = replyMarkup
+
+	rMessage, err := c.editMessage(peerType, peerId, message)
+	if err != nil {
+		c.Logger.Errorf("botapi.editMessageText - error: %v", err)
+		return nil, err
+	}
+
+	return rMessage, nil
+}
@@ -0,0 +1,47 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"github.com/teamgram/teamgram-server/app/interface/botapi/internal/model"
+)
+
+// GetFile
+// https://core.telegram.org/bots/api#getfile
+func (c *BotApiCore) GetFile(in *model.Params) (*model.File, error) {
+	fileId, err := model.DecodeFileId(in.String("file_id"))
+	if err != nil {
+		return nil, model.NewBadRequest("invalid file_id")
+	}
+
+	_, size, err := c.getFileLocation(fileId)
+	if err != nil {
+		c.Logger.Errorf("botapi.getFile - error: %v", err)
+		return nil, err
+	} else if size > model.MaxDownloadFileSize {
+		return nil, model.ErrFileTooBig
+	}
+
+	return &model.File{
+		FileId:       fileId.Encode(),
+		FileUniqueId: fileId.UniqueId(),
+		FileSize:     size,
+		FilePath:     fileId.FilePath(),
+	}, nil
+}
@@ -0,0 +1,44 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"github.com/teamgram/teamgram-server/app/interface/botapi/internal/model"
+)
+
+// GetMe
+// https://core.telegram.org/bots/api#getme
+func (c *BotApiCore) GetMe(in *model.Params) (*model.User, error) {
+	var (
+		canJoinGroups           = !c.Bot.BotNochats()
+		canReadAllGroupMessages = c.Bot.BotChatHistory()
+		supportsInlineQueries   = c.Bot.BotInlinePlaceholder() != nil
+	)
+
+	return &model.User{
+		Id:                      c.BotId(),
+		IsBot:                   true,
+		FirstName:               c.Bot.FirstName(),
+		LastName:                c.Bot.LastName(),
+		Username:                c.Bot.Username(),
+		CanJoinGroups:           &canJoinGroups,
+		CanReadAllGroupMessages: &canReadAllGroupMessages,
+		SupportsInlineQueries:   &supportsInlineQueries,
+	}, nil
+}
@@ -0,0 +1,42 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"github.com/teamgram/teamgram-server/app/interface/botapi/internal/model"
+	userpb "github.com/teamgram/teamgram-server/app/service/biz/user/user"
+)
+
+// GetMyCommands
+// https://core.telegram.org/bots/api#getmycommands
+func (c *BotApiCore) GetMyCommands(in *model.Params) ([]*model.BotCommand, error) {
+	if err := checkBotCommandScope(in); err != nil {
+		return nil, err
+	}
+
+	botInfo, err := c.svcCtx.Dao.UserClient.UserGetBotInfo(c.ctx, &userpb.TLUserGetBotInfo{
+		BotId: c.BotId(),
+	})
+	if err != nil {
+		c.Logger.Errorf("botapi.getMyCommands - error: %v", err)
+		return nil, err
+	}
+
+	return model.MakeBotCommands(botInfo.GetCommands()), nil
+}
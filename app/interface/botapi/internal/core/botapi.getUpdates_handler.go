@@ -0,0 +1,114 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"time"
+
+	"github.com/teamgram/teamgram-server/app/interface/botapi/internal/model"
+)
+
+const (
+	getUpdatesPollInterval = 200 * time.Millisecond
+)
+
+// GetUpdates
+// https://core.telegram.org/bots/api#getupdates
+func (c *BotApiCore) GetUpdates(in *model.Params) ([]*model.Update, error) {
+	webhook, err := c.svcCtx.Dao.GetBotWebhook(c.ctx, c.BotId())
+	if err != nil {
+		c.Logger.Errorf("botapi.getUpdates - error: %v", err)
+		return nil, err
+	} else if webhook != nil {
+		return nil, model.ErrWebhookActive
+	}
+
+	offset, err := in.Int64("offset")
+	if err != nil {
+		return nil, err
+	}
+	limit, err := in.Int("limit", model.DefaultGetUpdatesLimit)
+	if err != nil {
+		return nil, err
+	} else if limit < 1 || limit > model.DefaultGetUpdatesLimit {
+		limit = model.DefaultGetUpdatesLimit
+	}
+	timeout, err := in.Int("timeout", 0)
+	if err != nil {
+		return nil, err
+	} else if timeout < 0 {
+		timeout = 0
+	} else if timeout > model.MaxGetUpdatesTimeout {
+		timeout = model.MaxGetUpdatesTimeout
+	}
+
+	if in.Has("allowed_updates") {
+		var allowedUpdates []string
+		if err = in.JSON("allowed_updates", &allowedUpdates); err != nil {
+			return nil, err
+		}
+		if err = c.putAllowedUpdates(allowedUpdates); err != nil {
+			return nil, err
+		}
+	}
+
+	// an offset confirms the updates before it
+	if offset != 0 {
+		if err = c.svcCtx.Dao.ConfirmBotUpdates(c.ctx, c.BotId(), offset); err != nil {
+			c.Logger.Errorf("botapi.getUpdates - error: %v", err)
+			return nil, err
+		}
+	}
+
+	deadline := time.Now().Add(time.Duration(timeout) * time.Second)
+	for {
+		updates, err := c.svcCtx.Dao.GetBotUpdates(c.ctx, c.BotId(), offset, limit)
+		if err != nil {
+			c.Logger.Errorf("botapi.getUpdates - error: %v", err)
+			return nil, err
+		}
+		if len(updates) > 0 || !time.Now().Before(deadline) {
+			if updates == nil {
+				updates = []*model.Update{}
+			}
+			return updates, nil
+		}
+
+		select {
+		case <-c.ctx.Done():
+			return []*model.Update{}, nil
+		case <-time.After(getUpdatesPollInterval):
+		}
+	}
+}
+
+// putAllowedUpdates an empty list restores the default, all the updates.
+func (c *BotApiCore) putAllowedUpdates(allowedUpdates []string) error {
+	for _, v := range allowedUpdates {
+		if !model.IsUpdateType(v) {
+			return model.NewBadRequest("unsupported allowed_updates value \"%s\"", v)
+		}
+	}
+
+	if err := c.svcCtx.Dao.PutBotAllowedUpdates(c.ctx, c.BotId(), allowedUpdates); err != nil {
+		c.Logger.Errorf("botapi.putAllowedUpdates - error: %v", err)
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,53 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"github.com/teamgram/teamgram-server/app/interface/botapi/internal/model"
+)
+
+// GetWebhookInfo
+// https://core.telegram.org/bots/api#getwebhookinfo
+func (c *BotApiCore) GetWebhookInfo(in *model.Params) (*model.WebhookInfo, error) {
+	webhook, err := c.svcCtx.Dao.GetBotWebhook(c.ctx, c.BotId())
+	if err != nil {
+		c.Logger.Errorf("botapi.getWebhookInfo - error: %v", err)
+		return nil, err
+	}
+
+	count, err := c.svcCtx.Dao.GetBotPendingUpdateCount(c.ctx, c.BotId())
+	if err != nil {
+		c.Logger.Errorf("botapi.getWebhookInfo - error: %v", err)
+		return nil, err
+	}
+
+	webhookInfo := &model.WebhookInfo{
+		PendingUpdateCount: count,
+	}
+	if webhook != nil {
+		webhookInfo.Url = webhook.Url
+		webhookInfo.IpAddress = webhook.IpAddress
+		webhookInfo.LastErrorDate = webhook.LastErrorDate
+		webhookInfo.LastErrorMessage = webhook.LastErrorMessage
+		webhookInfo.MaxConnections = webhook.MaxConnections
+		webhookInfo.AllowedUpdates = webhook.AllowedUpdates
+	}
+
+	return webhookInfo, nil
+}
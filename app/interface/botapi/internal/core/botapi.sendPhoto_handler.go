@@ -0,0 +1,48 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"github.com/teamgram/teamgram-server/app/interface/botapi/internal/model"
+)
+
+// SendPhoto
+// https://core.telegram.org/bots/api#sendphoto
+func (c *BotApiCore) SendPhoto(in *model.Params) (*model.Message, error) {
+	caption, entities, err := c.makeText(in, "caption", "caption_entities")
+	if err != nil {
+		return nil, err
+	} else if model.TextLength(caption) > model.MaxCaptionLength {
+		return nil, model.ErrCaptionTooLong
+	}
+
+	media, err := c.makePhotoMedia(in)
+	if err != nil {
+		c.Logger.Errorf("botapi.sendPhoto - error: %v", err)
+		return nil, err
+	}
+
+	message, err := c.sendMessage(in, caption, entities, media)
+	if err != nil {
+		c.Logger.Errorf("botapi.sendPhoto - error: %v", err)
+		return nil, err
+	}
+
+	return message, nil
+}
@@ -0,0 +1,75 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/interface/botapi/internal/model"
+	userpb "github.com/teamgram/teamgram-server/app/service/biz/user/user"
+)
+
+// SetMyCommands
+// https://core.telegram.org/bots/api#setmycommands
+func (c *BotApiCore) SetMyCommands(in *model.Params) (bool, error) {
+	if err := checkBotCommandScope(in); err != nil {
+		return false, err
+	}
+
+	var commands []*model.BotCommand
+	if err := in.JSON("commands", &commands); err != nil {
+		return false, err
+	}
+
+	return c.setBotCommands(model.ToMTProtoBotCommands(commands))
+}
+
+func (c *BotApiCore) setBotCommands(commands []*mtproto.BotCommand) (bool, error) {
+	// the commands are checked by user.setBotCommands
+	_, err := c.svcCtx.Dao.UserClient.UserSetBotCommands(c.ctx, &userpb.TLUserSetBotCommands{
+		UserId:   c.BotId(),
+		BotId:    c.BotId(),
+		Commands: commands,
+	})
+	if err != nil {
+		c.Logger.Errorf("botapi.setBotCommands - error: %v", err)
+		return false, err
+	}
+
+	return true, nil
+}
+
+// checkBotCommandScope commands are only kept per bot, the same as bots.setBotCommands
+func checkBotCommandScope(in *model.Params) error {
+	if in.String("language_code") != "" {
+		return model.NewBadRequest("LANG_CODE_NOT_SUPPORTED")
+	}
+
+	var scope struct {
+		Type string `json:"type"`
+	}
+	if err := in.JSON("scope", &scope); err != nil {
+		return err
+	}
+	switch scope.Type {
+	case "", "default":
+		return nil
+	default:
+		return model.NewBadRequest("unsupported bot command scope \"%s\"", scope.Type)
+	}
+}
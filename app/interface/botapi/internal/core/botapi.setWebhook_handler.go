@@ -0,0 +1,116 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"net"
+	"net/url"
+	"regexp"
+
+	"github.com/teamgram/teamgram-server/app/interface/botapi/internal/model"
+	"github.com/teamgram/teamgram-server/app/interface/botapi/internal/webhook"
+
+	"github.com/zeromicro/go-zero/core/threading"
+)
+
+var (
+	secretTokenRe = regexp.MustCompile("^[A-Za-z0-9_-]+$")
+)
+
+// SetWebhook
+// https://core.telegram.org/bots/api#setwebhook
+func (c *BotApiCore) SetWebhook(in *model.Params) (bool, error) {
+	webhookUrl := in.String("url")
+	if webhookUrl == "" {
+		return c.DeleteWebhook(in)
+	}
+
+	u, err := url.Parse(webhookUrl)
+	if err != nil || u.Host == "" {
+		return false, model.NewBadRequest("invalid webhook URL specified")
+	} else if u.Scheme != "https" && !(u.Scheme == "http" && c.svcCtx.Config.Webhook.AllowHttp) {
+		return false, model.NewBadRequest("bad webhook: HTTPS url must be provided for webhook")
+	}
+	if in.File("certificate") != nil {
+		return false, model.NewBadRequest("custom certificates are not supported")
+	}
+
+	ipAddress := in.String("ip_address")
+	if ipAddress != "" && net.ParseIP(ipAddress) == nil {
+		return false, model.NewBadRequest("invalid IP address specified")
+	}
+	if err = webhook.CheckUrl(c.ctx, c.svcCtx.Config.Webhook, u, ipAddress); err != nil {
+		return false, model.NewBadRequest("bad webhook: " + err.Error())
+	}
+
+	maxConnections, err := in.Int("max_connections", model.DefaultWebhookMaxConnections)
+	if err != nil {
+		return false, err
+	} else if maxConnections < 1 {
+		maxConnections = 1
+	} else if maxConnections > model.MaxWebhookMaxConnections {
+		maxConnections = model.MaxWebhookMaxConnections
+	}
+
+	secretToken := in.String("secret_token")
+	if secretToken != "" &&
+		(len(secretToken) > model.MaxWebhookSecretTokenLen || !secretTokenRe.MatchString(secretToken)) {
+		return false, model.NewBadRequest("secret token contains unallowed characters")
+	}
+
+	var allowedUpdates []string
+	if in.Has("allowed_updates") {
+		if err = in.JSON("allowed_updates", &allowedUpdates); err != nil {
+			return false, err
+		}
+		if err = c.putAllowedUpdates(allowedUpdates); err != nil {
+			return false, err
+		}
+	} else if allowedUpdates, err = c.svcCtx.Dao.GetBotAllowedUpdates(c.ctx, c.BotId()); err != nil {
+		c.Logger.Errorf("botapi.setWebhook - error: %v", err)
+		return false, err
+	}
+
+	if in.Bool("drop_pending_updates") {
+		if err = c.svcCtx.Dao.DropBotUpdates(c.ctx, c.BotId()); err != nil {
+			c.Logger.Errorf("botapi.setWebhook - error: %v", err)
+			return false, err
+		}
+	}
+
+	err = c.svcCtx.Dao.PutBotWebhook(c.ctx, c.BotId(), &model.Webhook{
+		Url:            webhookUrl,
+		IpAddress:      ipAddress,
+		MaxConnections: maxConnections,
+		AllowedUpdates: allowedUpdates,
+		SecretToken:    secretToken,
+	})
+	if err != nil {
+		c.Logger.Errorf("botapi.setWebhook - error: %v", err)
+		return false, err
+	}
+
+	// the pending updates are delivered to the new webhook
+	botId := c.BotId()
+	threading.GoSafe(func() {
+		DeliverWebhookUpdates(c.svcCtx, botId)
+	})
+
+	return true, nil
+}
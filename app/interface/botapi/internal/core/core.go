@@ -0,0 +1,66 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"context"
+
+	"github.com/teamgram/teamgram-server/app/interface/botapi/internal/model"
+	"github.com/teamgram/teamgram-server/app/interface/botapi/internal/svc"
+	userpb "github.com/teamgram/teamgram-server/app/service/biz/user/user"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type BotApiCore struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+	Bot *userpb.ImmutableUser
+}
+
+func New(ctx context.Context, svcCtx *svc.ServiceContext) *BotApiCore {
+	return &BotApiCore{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// Authorize checks the '<bot_id>:<secret>' token of the request.
+func (c *BotApiCore) Authorize(token string) error {
+	if _, ok := userpb.GetBotIdByToken(token); !ok {
+		return model.ErrUnauthorized
+	}
+
+	bot, err := c.svcCtx.Dao.UserClient.UserGetImmutableUserByToken(c.ctx, &userpb.TLUserGetImmutableUserByToken{
+		Token: token,
+	})
+	if err != nil || bot == nil || !bot.IsBot() || bot.Deleted() {
+		c.Logger.Errorf("botapi.authorize - error: invalid token, %v", err)
+		return model.ErrUnauthorized
+	}
+	c.Bot = bot
+
+	return nil
+}
+
+func (c *BotApiCore) BotId() int64 {
+	return c.Bot.Id()
+}
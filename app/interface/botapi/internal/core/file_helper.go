@@ -0,0 +1,293 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"io"
+	"math/rand"
+	"mime/multipart"
+	"strings"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/interface/botapi/internal/model"
+	dfspb "github.com/teamgram/teamgram-server/app/service/dfs/dfs"
+	mediapb "github.com/teamgram/teamgram-server/app/service/media/media"
+
+	"github.com/gogo/protobuf/types"
+)
+
+const (
+	uploadPartSize   = 512 * 1024
+	downloadPartSize = 512 * 1024
+	// bigFileSize the files larger than 10MB are saved as upload.saveBigFilePart does
+	bigFileSize = 10 << 20
+)
+
+// getInputFile returns the uploaded file of a multipart/form-data parameter,
+// the parameter is the file itself or attach://<file_attach_name>.
+func (c *BotApiCore) getInputFile(in *model.Params, key string) *multipart.FileHeader {
+	if fh := in.File(key); fh != nil {
+		return fh
+	}
+	if v := in.String(key); strings.HasPrefix(v, "attach://") {
+		return in.File(strings.TrimPrefix(v, "attach://"))
+	}
+	return nil
+}
+
+// uploadFile writes the file parts to dfs as the bot, the same as upload.saveFilePart.
+func (c *BotApiCore) uploadFile(fh *multipart.FileHeader, maxSize int64) (*mtproto.InputFile, error) {
+	if fh.Size == 0 {
+		return nil, model.NewBadRequest("file must be non-empty")
+	} else if fh.Size > maxSize {
+		return nil, model.ErrFileTooBig
+	}
+
+	f, err := fh.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var (
+		fileId     = rand.Int63()
+		big        = fh.Size > bigFileSize
+		totalParts = int32((fh.Size + uploadPartSize - 1) / uploadPartSize)
+		buf        = make([]byte, uploadPartSize)
+	)
+
+	for part := int32(0); part < totalParts; part++ {
+		n, err := io.ReadFull(f, buf)
+		if err != nil && err != io.ErrUnexpectedEOF {
+			return nil, err
+		}
+
+		filePart := &dfspb.TLDfsWriteFilePartData{
+			Creator:  c.BotId(),
+			FileId:   fileId,
+			FilePart: part,
+			Bytes:    buf[:n],
+			Big:      big,
+		}
+		if big {
+			filePart.FileTotalParts = &types.Int32Value{Value: totalParts}
+		}
+		if _, err = c.svcCtx.Dao.DfsClient.DfsWriteFilePartData(c.ctx, filePart); err != nil {
+			return nil, err
+		}
+	}
+
+	if big {
+		return mtproto.MakeTLInputFileBig(&mtproto.InputFile{
+			Id:    fileId,
+			Parts: totalParts,
+			Name:  fh.Filename,
+		}).To_InputFile(), nil
+	}
+	return mtproto.MakeTLInputFile(&mtproto.InputFile{
+		Id:    fileId,
+		Parts: totalParts,
+		Name:  fh.Filename,
+	}).To_InputFile(), nil
+}
+
+// getPhoto returns the photo of a file_id, the access_hash must match.
+func (c *BotApiCore) getPhoto(fileId *model.FileId) (*mtproto.Photo, error) {
+	if fileId.Type != model.FileTypePhoto {
+		return nil, model.ErrWrongFileId
+	}
+
+	photo, err := c.svcCtx.Dao.MediaClient.MediaGetPhoto(c.ctx, &mediapb.TLMediaGetPhoto{
+		PhotoId: fileId.Id,
+	})
+	if err != nil || photo.GetPredicateName() != mtproto.Predicate_photo || photo.GetAccessHash() != fileId.AccessHash {
+		return nil, model.ErrWrongFileId
+	}
+
+	return photo, nil
+}
+
+// getDocument returns the document of a file_id, the access_hash must match.
+func (c *BotApiCore) getDocument(fileId *model.FileId) (*mtproto.Document, error) {
+	if fileId.Type != model.FileTypeDocument {
+		return nil, model.ErrWrongFileId
+	}
+
+	document, err := c.svcCtx.Dao.MediaClient.MediaGetDocument(c.ctx, &mediapb.TLMediaGetDocument{
+		Id: fileId.Id,
+	})
+	if err != nil || document.GetPredicateName() != mtproto.Predicate_document || document.GetAccessHash() != fileId.AccessHash {
+		return nil, model.ErrWrongFileId
+	}
+
+	return document, nil
+}
+
+// makePhotoMedia the photo is a file_id or an uploaded file, the HTTP URLs are not supported.
+func (c *BotApiCore) makePhotoMedia(in *model.Params) (*mtproto.MessageMedia, error) {
+	var (
+		photo *mtproto.Photo
+		err   error
+	)
+
+	if fh := c.getInputFile(in, "photo"); fh != nil {
+		inputFile, err := c.uploadFile(fh, model.MaxUploadPhotoSize)
+		if err != nil {
+			return nil, err
+		}
+		photo, err = c.svcCtx.Dao.MediaClient.MediaUploadPhotoFile(c.ctx, &mediapb.TLMediaUploadPhotoFile{
+			OwnerId: c.BotId(),
+			File:    inputFile,
+		})
+		if err != nil {
+			return nil, model.NewBadRequest("IMAGE_PROCESS_FAILED")
+		}
+	} else {
+		fileId, err := model.DecodeFileId(in.String("photo"))
+		if err != nil {
+			return nil, model.ErrWrongFileId
+		}
+		if photo, err = c.getPhoto(fileId); err != nil {
+			return nil, err
+		}
+	}
+
+	return mtproto.MakeTLMessageMediaPhoto(&mtproto.MessageMedia{
+		Photo_FLAGPHOTO: photo,
+	}).To_MessageMedia(), err
+}
+
+// makeDocumentMedia the document is a file_id or an uploaded file, the HTTP URLs are not supported.
+func (c *BotApiCore) makeDocumentMedia(in *model.Params) (*mtproto.MessageMedia, error) {
+	if fh := c.getInputFile(in, "document"); fh != nil {
+		inputFile, err := c.uploadFile(fh, model.MaxUploadFileSize)
+		if err != nil {
+			return nil, err
+		}
+
+		mimeType := fh.Header.Get("Content-Type")
+		if mimeType == "" {
+			mimeType = "application/octet-stream"
+		}
+		media, err := c.svcCtx.Dao.MediaClient.MediaUploadedDocumentMedia(c.ctx, &mediapb.TLMediaUploadedDocumentMedia{
+			OwnerId: c.BotId(),
+			Media: mtproto.MakeTLInputMediaUploadedDocument(&mtproto.InputMedia{
+				ForceFile: true,
+				File:      inputFile,
+				MimeType:  mimeType,
+				Attributes: []*mtproto.DocumentAttribute{
+					mtproto.MakeTLDocumentAttributeFilename(&mtproto.DocumentAttribute{
+						FileName: fh.Filename,
+					}).To_DocumentAttribute(),
+				},
+			}).To_InputMedia(),
+		})
+		if err != nil {
+			return nil, model.NewBadRequest("MEDIA_INVALID")
+		}
+		return media, nil
+	}
+
+	fileId, err := model.DecodeFileId(in.String("document"))
+	if err != nil {
+		return nil, model.ErrWrongFileId
+	}
+	document, err := c.getDocument(fileId)
+	if err != nil {
+		return nil, err
+	}
+
+	return mtproto.MakeTLMessageMediaDocument(&mtproto.MessageMedia{
+		Document: document,
+	}).To_MessageMedia(), nil
+}
+
+// getFileLocation returns the dfs location and the size of a file_id.
+func (c *BotApiCore) getFileLocation(fileId *model.FileId) (*mtproto.InputFileLocation, int32, error) {
+	switch fileId.Type {
+	case model.FileTypePhoto:
+		photo, err := c.getPhoto(fileId)
+		if err != nil {
+			return nil, 0, err
+		}
+		for _, sz := range model.MakePhotoSizes(photo) {
+			if sz.FileId == fileId.Encode() {
+				return mtproto.MakeTLInputPhotoFileLocation(&mtproto.InputFileLocation{
+					Id:            photo.GetId(),
+					AccessHash:    photo.GetAccessHash(),
+					FileReference: photo.GetFileReference(),
+					ThumbSize:     fileId.ThumbSize,
+				}).To_InputFileLocation(), sz.FileSize, nil
+			}
+		}
+		return nil, 0, model.ErrWrongFileId
+	default:
+		document, err := c.getDocument(fileId)
+		if err != nil {
+			return nil, 0, err
+		}
+		size := document.GetSize2()
+		if fileId.ThumbSize != "" {
+			thumb := model.MakeDocument(document).Thumb
+			if thumb == nil || thumb.FileId != fileId.Encode() {
+				return nil, 0, model.ErrWrongFileId
+			}
+			size = thumb.FileSize
+		}
+		return mtproto.MakeTLInputDocumentFileLocation(&mtproto.InputFileLocation{
+			Id:            document.GetId(),
+			AccessHash:    document.GetAccessHash(),
+			FileReference: document.GetFileReference(),
+			ThumbSize:     fileId.ThumbSize,
+		}).To_InputFileLocation(), size, nil
+	}
+}
+
+// DownloadFile writes the file of a file_path returned by getFile to w.
+func (c *BotApiCore) DownloadFile(filePath string, w io.Writer) error {
+	fileId, err := model.DecodeFilePath(filePath)
+	if err != nil || fileId.FilePath() != filePath {
+		return model.ErrNotFound
+	}
+
+	location, size, err := c.getFileLocation(fileId)
+	if err != nil {
+		return model.ErrNotFound
+	} else if size > model.MaxDownloadFileSize {
+		return model.ErrFileTooBig
+	}
+
+	for offset := int32(0); ; offset += downloadPartSize {
+		file, err := c.svcCtx.Dao.DfsClient.DfsDownloadFile(c.ctx, &dfspb.TLDfsDownloadFile{
+			Location: location,
+			Offset:   offset,
+			Limit:    downloadPartSize,
+		})
+		if err != nil {
+			c.Logger.Errorf("botapi.downloadFile - error: %v", err)
+			return err
+		}
+		if _, err = w.Write(file.GetBytes()); err != nil {
+			return err
+		}
+		if len(file.GetBytes()) < downloadPartSize {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,226 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"math/rand"
+	"time"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/interface/botapi/internal/model"
+	msgpb "github.com/teamgram/teamgram-server/app/messenger/msg/msg/msg"
+	messagepb "github.com/teamgram/teamgram-server/app/service/biz/message/message"
+)
+
+// makeText parses the text with parse_mode or the entities of entitiesKey.
+func (c *BotApiCore) makeText(in *model.Params, textKey, entitiesKey string) (string, []*mtproto.MessageEntity, error) {
+	var (
+		text     = in.String(textKey)
+		entities []*model.MessageEntity
+		err      error
+	)
+
+	switch parseMode := in.String("parse_mode"); parseMode {
+	case "":
+		if err = in.JSON(entitiesKey, &entities); err != nil {
+			return "", nil, err
+		}
+	case model.ParseModeHTML:
+		text, entities, err = model.ParseHTML(text)
+		if err != nil {
+			return "", nil, err
+		}
+	default:
+		return "", nil, model.NewBadRequest("unsupported parse_mode \"%s\"", parseMode)
+	}
+
+	mEntities, err := model.ToMTProtoEntities(entities)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return text, mEntities, nil
+}
+
+func (c *BotApiCore) makeReplyMarkup(in *model.Params) (*mtproto.ReplyMarkup, error) {
+	if !in.Has("reply_markup") {
+		return nil, nil
+	}
+
+	markup := new(model.ReplyMarkup)
+	if err := in.JSON("reply_markup", markup); err != nil {
+		return nil, err
+	}
+
+	return model.ToMTProtoReplyMarkup(markup)
+}
+
+// sendMessage sends the message as the bot through msg.sendMessage.
+func (c *BotApiCore) sendMessage(in *model.Params, text string, entities []*mtproto.MessageEntity, media *mtproto.MessageMedia) (*model.Message, error) {
+	peerType, peerId, err := in.ChatId()
+	if err != nil {
+		return nil, err
+	}
+
+	replyMarkup, err := c.makeReplyMarkup(in)
+	if err != nil {
+		return nil, err
+	}
+
+	outMessage := mtproto.MakeTLMessage(&mtproto.Message{
+		Out:         true,
+		Silent:      in.Bool("disable_notification"),
+		Noforwards:  in.Bool("protect_content"),
+		Id:          0,
+		FromId:      mtproto.MakePeerUser(c.BotId()),
+		PeerId:      mtproto.MakePeerUtil(peerType, peerId).ToPeer(),
+		Date:        int32(time.Now().Unix()),
+		Media:       media,
+		Message:     text,
+		ReplyMarkup: replyMarkup,
+		Entities:    entities,
+	}).To_Message()
+
+	replyToMsgId, err := in.Int64("reply_to_message_id")
+	if err != nil {
+		return nil, err
+	} else if replyToMsgId > 0 {
+		outMessage.ReplyTo = mtproto.MakeTLMessageReplyHeader(&mtproto.MessageReplyHeader{
+			ReplyToMsgId: int32(replyToMsgId),
+		}).To_MessageReplyHeader()
+	}
+
+	rUpdates, err := c.svcCtx.Dao.MsgClient.MsgSendMessage(c.ctx, &msgpb.TLMsgSendMessage{
+		UserId:    c.BotId(),
+		AuthKeyId: 0,
+		PeerType:  peerType,
+		PeerId:    peerId,
+		Message: msgpb.MakeTLOutboxMessage(&msgpb.OutboxMessage{
+			NoWebpage:    in.Bool("disable_web_page_preview"),
+			Background:   false,
+			RandomId:     rand.Int63(),
+			Message:      outMessage,
+			ScheduleDate: nil,
+		}).To_OutboxMessage(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return c.getMessageByUpdates(rUpdates, outMessage), nil
+}
+
+// getMessageByUpdates returns the sent or edited message of the Updates of msg,
+// outMessage is used if the Updates has no message.
+func (c *BotApiCore) getMessageByUpdates(rUpdates *mtproto.Updates, outMessage *mtproto.Message) *model.Message {
+	peers := model.NewPeers(rUpdates.GetUsers(), rUpdates.GetChats())
+
+	for _, update := range rUpdates.GetUpdates() {
+		switch update.GetPredicateName() {
+		case mtproto.Predicate_updateNewMessage,
+			mtproto.Predicate_updateNewChannelMessage,
+			mtproto.Predicate_updateEditMessage,
+			mtproto.Predicate_updateEditChannelMessage:
+			if m := model.MakeMessage(c.BotId(), update.GetMessage_MESSAGE(), peers); m != nil {
+				return m
+			}
+		}
+	}
+
+	for _, update := range rUpdates.GetUpdates() {
+		if update.GetPredicateName() == mtproto.Predicate_updateMessageID {
+			outMessage.Id = update.GetId_INT32()
+		}
+	}
+	return model.MakeMessage(c.BotId(), outMessage, peers)
+}
+
+// getSelfMessage returns the message_id of chat_id sent by the bot.
+func (c *BotApiCore) getSelfMessage(in *model.Params) (int32, int64, *mtproto.Message, error) {
+	peerType, peerId, box, err := c.getMessageBox(in)
+	if err != nil {
+		return 0, 0, nil, err
+	} else if box.GetSenderUserId() != c.BotId() {
+		return 0, 0, nil, model.NewBadRequest("message can't be edited")
+	}
+
+	return peerType, peerId, box.GetMessage(), nil
+}
+
+// getMessageBox returns the message_id of chat_id in the bot's box.
+func (c *BotApiCore) getMessageBox(in *model.Params) (int32, int64, *mtproto.MessageBox, error) {
+	peerType, peerId, err := in.ChatId()
+	if err != nil {
+		return 0, 0, nil, err
+	}
+	if in.Has("inline_message_id") {
+		return 0, 0, nil, model.NewBadRequest("inline messages are not supported")
+	}
+
+	messageId, err := in.Int64("message_id")
+	if err != nil {
+		return 0, 0, nil, err
+	} else if messageId <= 0 {
+		return 0, 0, nil, model.ErrMessageToEdit
+	}
+
+	if peerType == mtproto.PEER_CHANNEL {
+		return 0, 0, nil, model.NewBadRequest("editing the messages of channels is not supported")
+	}
+
+	boxList, err := c.svcCtx.Dao.MessageClient.MessageGetUserMessageList(c.ctx, &messagepb.TLMessageGetUserMessageList{
+		UserId: c.BotId(),
+		IdList: []int32{int32(messageId)},
+	})
+	if err != nil {
+		return 0, 0, nil, err
+	} else if len(boxList.GetDatas()) != 1 {
+		return 0, 0, nil, model.ErrMessageToEdit
+	}
+
+	box := boxList.GetDatas()[0]
+	if box.GetPeerType() != peerType || box.GetPeerId() != peerId {
+		return 0, 0, nil, model.ErrMessageToEdit
+	}
+
+	return peerType, peerId, box, nil
+}
+
+// editMessage edits the message through msg.editMessage.
+func (c *BotApiCore) editMessage(peerType int32, peerId int64, message *mtproto.Message) (*model.Message, error) {
+	message.EditDate = mtproto.MakeFlagsInt32(int32(time.Now().Unix()))
+	message.EditHide = false
+
+	rUpdates, err := c.svcCtx.Dao.MsgClient.MsgEditMessage(c.ctx, &msgpb.TLMsgEditMessage{
+		UserId:    c.BotId(),
+		AuthKeyId: 0,
+		PeerType:  peerType,
+		PeerId:    peerId,
+		Message: msgpb.MakeTLOutboxMessage(&msgpb.OutboxMessage{
+			NoWebpage: true,
+			RandomId:  0,
+			Message:   message,
+		}).To_OutboxMessage(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return c.getMessageByUpdates(rUpdates, message), nil
+}
@@ -0,0 +1,120 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"strconv"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/interface/botapi/internal/model"
+	messagepb "github.com/teamgram/teamgram-server/app/service/biz/message/message"
+
+	"github.com/zeromicro/go-zero/core/threading"
+)
+
+// OnBotUpdates converts the updates pushed to the bot by sync.pushBotUpdates,
+// they are kept for getUpdates and delivered to the webhook if it's set.
+func (c *BotApiCore) OnBotUpdates(botId int64, updates *mtproto.Updates) error {
+	allowedUpdates, err := c.svcCtx.Dao.GetBotAllowedUpdates(c.ctx, botId)
+	if err != nil {
+		c.Logger.Errorf("botapi.onBotUpdates - error: %v", err)
+		return err
+	}
+
+	var (
+		peers = model.NewPeers(updates.GetUsers(), updates.GetChats())
+		added = false
+	)
+	for _, update := range updates.GetUpdates() {
+		botUpdate := c.makeBotUpdate(botId, update, peers)
+		if botUpdate == nil || !model.IsAllowedUpdate(allowedUpdates, botUpdate.Type()) {
+			continue
+		}
+
+		if err = c.svcCtx.Dao.AddBotUpdate(c.ctx, botId, botUpdate); err != nil {
+			c.Logger.Errorf("botapi.onBotUpdates - error: %v", err)
+			return err
+		}
+		added = true
+	}
+
+	if !added {
+		return nil
+	}
+
+	webhook, err := c.svcCtx.Dao.GetBotWebhook(c.ctx, botId)
+	if err != nil {
+		c.Logger.Errorf("botapi.onBotUpdates - error: %v", err)
+		return err
+	} else if webhook != nil {
+		threading.GoSafe(func() {
+			DeliverWebhookUpdates(c.svcCtx, botId)
+		})
+	}
+
+	return nil
+}
+
+// makeBotUpdate returns nil for the updates the gateway doesn't support.
+func (c *BotApiCore) makeBotUpdate(botId int64, update *mtproto.Update, peers *model.Peers) *model.Update {
+	switch update.GetPredicateName() {
+	case mtproto.Predicate_updateNewMessage,
+		mtproto.Predicate_updateNewChannelMessage:
+		m := update.GetMessage_MESSAGE()
+		if m.GetOut() {
+			return nil
+		}
+		message := model.MakeMessage(botId, m, peers)
+		if message == nil {
+			return nil
+		} else if message.Chat.Type == "channel" {
+			return &model.Update{ChannelPost: message}
+		}
+		return &model.Update{Message: message}
+	case mtproto.Predicate_updateEditMessage,
+		mtproto.Predicate_updateEditChannelMessage:
+		message := model.MakeMessage(botId, update.GetMessage_MESSAGE(), peers)
+		if message == nil {
+			return nil
+		} else if message.Chat.Type == "channel" {
+			return &model.Update{EditedChannelPost: message}
+		}
+		return &model.Update{EditedMessage: message}
+	case mtproto.Predicate_updateBotCallbackQuery:
+		callbackQuery := &model.CallbackQuery{
+			Id:           strconv.FormatInt(update.GetQueryId(), 10),
+			From:         peers.GetUser(update.GetUserId()),
+			ChatInstance: strconv.FormatInt(update.GetChatInstance(), 10),
+			Data:         string(update.GetData_FLAGBYTES()),
+		}
+
+		boxList, err := c.svcCtx.Dao.MessageClient.MessageGetUserMessageList(c.ctx, &messagepb.TLMessageGetUserMessageList{
+			UserId: botId,
+			IdList: []int32{update.GetMsgId_INT32()},
+		})
+		if err != nil {
+			c.Logger.Errorf("botapi.makeBotUpdate - error: %v", err)
+		} else if len(boxList.GetDatas()) == 1 {
+			callbackQuery.Message = model.MakeMessage(botId, boxList.GetDatas()[0].GetMessage(), peers)
+		}
+		return &model.Update{CallbackQuery: callbackQuery}
+	default:
+		return nil
+	}
+}
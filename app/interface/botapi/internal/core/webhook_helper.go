@@ -0,0 +1,76 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"context"
+	"time"
+
+	"github.com/teamgram/teamgram-server/app/interface/botapi/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+const (
+	webhookLockSeconds = 60
+	webhookBatchSize   = 100
+)
+
+// DeliverWebhookUpdates posts the pending updates of the bot to its webhook in order,
+// an update is confirmed only if the webhook accepts it, the rest wait for the next retry.
+func DeliverWebhookUpdates(svcCtx *svc.ServiceContext, botId int64) {
+	var (
+		ctx    = context.Background()
+		logger = logx.WithContext(ctx)
+	)
+
+	// only one delivery per bot, the others leave the updates to it
+	if ok, err := svcCtx.Dao.LockBotWebhook(ctx, botId, webhookLockSeconds); err != nil || !ok {
+		return
+	}
+	defer svcCtx.Dao.UnlockBotWebhook(ctx, botId)
+
+	deadline := time.Now().Add(webhookLockSeconds * time.Second)
+	for time.Now().Before(deadline) {
+		webhook, err := svcCtx.Dao.GetBotWebhook(ctx, botId)
+		if err != nil || webhook == nil {
+			return
+		}
+
+		updates, err := svcCtx.Dao.GetBotUpdates(ctx, botId, 0, webhookBatchSize)
+		if err != nil || len(updates) == 0 {
+			return
+		}
+
+		for _, update := range updates {
+			if err = svcCtx.Webhook.Send(ctx, webhook.Url, webhook.IpAddress, webhook.SecretToken, update); err != nil {
+				logger.Errorf("botapi.deliverWebhookUpdates - bot: %d, error: %v", botId, err)
+
+				// the webhook may be changed during the delivery
+				if webhook2, _ := svcCtx.Dao.GetBotWebhook(ctx, botId); webhook2 != nil && webhook2.Url == webhook.Url {
+					webhook2.LastErrorDate = time.Now().Unix()
+					webhook2.LastErrorMessage = err.Error()
+					svcCtx.Dao.PutBotWebhook(ctx, botId, webhook2)
+				}
+				return
+			}
+			svcCtx.Dao.DeleteBotUpdate(ctx, botId, update.UpdateId)
+		}
+	}
+}
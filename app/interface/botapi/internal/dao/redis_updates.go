@@ -0,0 +1,198 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package dao
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+
+	"github.com/teamgram/teamgram-server/app/interface/botapi/internal/model"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// the pending updates of a bot are a sorted set scored by update_id
+const (
+	botUpdateIdKeyPrefix       = "bot_api_update_id"
+	botUpdatesKeyPrefix        = "bot_api_updates"
+	botAllowedUpdatesKeyPrefix = "bot_api_allowed_updates"
+)
+
+func genBotUpdateIdKey(botId int64) string {
+	return fmt.Sprintf("%s_%d", botUpdateIdKeyPrefix, botId)
+}
+
+func genBotUpdatesKey(botId int64) string {
+	return fmt.Sprintf("%s_%d", botUpdatesKeyPrefix, botId)
+}
+
+func genBotAllowedUpdatesKey(botId int64) string {
+	return fmt.Sprintf("%s_%d", botAllowedUpdatesKeyPrefix, botId)
+}
+
+// AddBotUpdate assigns the update_id and appends the update to the pending updates.
+func (d *Dao) AddBotUpdate(ctx context.Context, botId int64, update *model.Update) error {
+	var (
+		idKey = genBotUpdateIdKey(botId)
+		key   = genBotUpdatesKey(botId)
+	)
+
+	updateId, err := d.kv.Incr(idKey)
+	if err != nil {
+		logx.WithContext(ctx).Errorf("conn.Do(INCR %s) error(%v)", idKey, err)
+		return err
+	}
+	update.UpdateId = updateId
+
+	value, _ := json.Marshal(update)
+	if _, err = d.kv.Zadd(key, updateId, string(value)); err != nil {
+		logx.WithContext(ctx).Errorf("conn.Do(ZADD %s) error(%v)", key, err)
+		return err
+	}
+	d.kv.Expire(key, model.PendingUpdatesExpire)
+
+	// drop the oldest updates
+	if _, err = d.kv.Zremrangebyrank(key, 0, -model.MaxPendingUpdates-1); err != nil {
+		logx.WithContext(ctx).Errorf("conn.Do(ZREMRANGEBYRANK %s) error(%v)", key, err)
+	}
+
+	return nil
+}
+
+// GetBotUpdates returns the pending updates from update_id offset.
+func (d *Dao) GetBotUpdates(ctx context.Context, botId, offset int64, limit int) ([]*model.Update, error) {
+	key := genBotUpdatesKey(botId)
+
+	pairs, err := d.kv.ZrangebyscoreWithScoresAndLimit(key, offset, math.MaxInt64, 0, limit)
+	if err != nil {
+		logx.WithContext(ctx).Errorf("conn.Do(ZRANGEBYSCORE %s) error(%v)", key, err)
+		return nil, err
+	}
+
+	updates := make([]*model.Update, 0, len(pairs))
+	for _, pair := range pairs {
+		update := new(model.Update)
+		if err = json.Unmarshal([]byte(pair.Key), update); err != nil {
+			logx.WithContext(ctx).Errorf("json.Unmarshal(%s) error(%v)", pair.Key, err)
+			continue
+		}
+		updates = append(updates, update)
+	}
+
+	return updates, nil
+}
+
+// ConfirmBotUpdates the updates before offset are forgotten,
+// a negative offset keeps the last -offset updates.
+func (d *Dao) ConfirmBotUpdates(ctx context.Context, botId, offset int64) error {
+	var (
+		key = genBotUpdatesKey(botId)
+		err error
+	)
+
+	switch {
+	case offset > 0:
+		_, err = d.kv.Zremrangebyscore(key, 0, offset-1)
+	case offset < 0:
+		_, err = d.kv.Zremrangebyrank(key, 0, offset-1)
+	}
+	if err != nil {
+		logx.WithContext(ctx).Errorf("conn.Do(ZREMRANGE %s) error(%v)", key, err)
+		return err
+	}
+
+	return nil
+}
+
+func (d *Dao) DeleteBotUpdate(ctx context.Context, botId, updateId int64) error {
+	key := genBotUpdatesKey(botId)
+
+	if _, err := d.kv.Zremrangebyscore(key, updateId, updateId); err != nil {
+		logx.WithContext(ctx).Errorf("conn.Do(ZREMRANGEBYSCORE %s) error(%v)", key, err)
+		return err
+	}
+
+	return nil
+}
+
+func (d *Dao) DropBotUpdates(ctx context.Context, botId int64) error {
+	key := genBotUpdatesKey(botId)
+
+	if _, err := d.kv.Del(key); err != nil {
+		logx.WithContext(ctx).Errorf("conn.Do(DEL %s) error(%v)", key, err)
+		return err
+	}
+
+	return nil
+}
+
+func (d *Dao) GetBotPendingUpdateCount(ctx context.Context, botId int64) (int, error) {
+	key := genBotUpdatesKey(botId)
+
+	n, err := d.kv.Zcard(key)
+	if err != nil {
+		logx.WithContext(ctx).Errorf("conn.Do(ZCARD %s) error(%v)", key, err)
+		return 0, err
+	}
+
+	return n, nil
+}
+
+// GetBotAllowedUpdates an empty list allows all the updates.
+func (d *Dao) GetBotAllowedUpdates(ctx context.Context, botId int64) ([]string, error) {
+	key := genBotAllowedUpdatesKey(botId)
+
+	value, err := d.kv.Get(key)
+	if err != nil {
+		logx.WithContext(ctx).Errorf("conn.Do(GET %s) error(%v)", key, err)
+		return nil, err
+	} else if value == "" {
+		return nil, nil
+	}
+
+	var allowedUpdates []string
+	if err = json.Unmarshal([]byte(value), &allowedUpdates); err != nil {
+		logx.WithContext(ctx).Errorf("json.Unmarshal(%s) error(%v)", value, err)
+		return nil, err
+	}
+
+	return allowedUpdates, nil
+}
+
+func (d *Dao) PutBotAllowedUpdates(ctx context.Context, botId int64, allowedUpdates []string) error {
+	var (
+		key = genBotAllowedUpdatesKey(botId)
+		err error
+	)
+
+	if len(allowedUpdates) == 0 {
+		_, err = d.kv.Del(key)
+	} else {
+		value, _ := json.Marshal(allowedUpdates)
+		err = d.kv.Set(key, string(value))
+	}
+	if err != nil {
+		logx.WithContext(ctx).Errorf("conn.Do(SET %s) error(%v)", key, err)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,136 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package dao
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/teamgram/teamgram-server/app/interface/botapi/internal/model"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+const (
+	botWebhookKeyPrefix     = "bot_api_webhook"
+	botWebhookLockKeyPrefix = "bot_api_webhook_lock"
+	// botWebhooksKey is the set of the bots with a webhook, their pending updates are redelivered
+	botWebhooksKey = "bot_api_webhooks"
+)
+
+func genBotWebhookKey(botId int64) string {
+	return fmt.Sprintf("%s_%d", botWebhookKeyPrefix, botId)
+}
+
+func genBotWebhookLockKey(botId int64) string {
+	return fmt.Sprintf("%s_%d", botWebhookLockKeyPrefix, botId)
+}
+
+func (d *Dao) GetBotWebhook(ctx context.Context, botId int64) (*model.Webhook, error) {
+	key := genBotWebhookKey(botId)
+
+	value, err := d.kv.Get(key)
+	if err != nil {
+		logx.WithContext(ctx).Errorf("conn.Do(GET %s) error(%v)", key, err)
+		return nil, err
+	} else if value == "" {
+		return nil, nil
+	}
+
+	webhook := new(model.Webhook)
+	if err = json.Unmarshal([]byte(value), webhook); err != nil {
+		logx.WithContext(ctx).Errorf("json.Unmarshal(%s) error(%v)", value, err)
+		return nil, err
+	}
+
+	return webhook, nil
+}
+
+func (d *Dao) PutBotWebhook(ctx context.Context, botId int64, webhook *model.Webhook) error {
+	var (
+		key      = genBotWebhookKey(botId)
+		value, _ = json.Marshal(webhook)
+	)
+
+	if err := d.kv.Set(key, string(value)); err != nil {
+		logx.WithContext(ctx).Errorf("conn.Do(SET %s) error(%v)", key, err)
+		return err
+	}
+	if _, err := d.kv.Sadd(botWebhooksKey, botId); err != nil {
+		logx.WithContext(ctx).Errorf("conn.Do(SADD %s) error(%v)", botWebhooksKey, err)
+		return err
+	}
+
+	return nil
+}
+
+func (d *Dao) DeleteBotWebhook(ctx context.Context, botId int64) error {
+	key := genBotWebhookKey(botId)
+
+	if _, err := d.kv.Del(key); err != nil {
+		logx.WithContext(ctx).Errorf("conn.Do(DEL %s) error(%v)", key, err)
+		return err
+	}
+	if _, err := d.kv.Srem(botWebhooksKey, botId); err != nil {
+		logx.WithContext(ctx).Errorf("conn.Do(SREM %s) error(%v)", botWebhooksKey, err)
+		return err
+	}
+
+	return nil
+}
+
+func (d *Dao) GetWebhookBotIdList(ctx context.Context) ([]int64, error) {
+	members, err := d.kv.Smembers(botWebhooksKey)
+	if err != nil {
+		logx.WithContext(ctx).Errorf("conn.Do(SMEMBERS %s) error(%v)", botWebhooksKey, err)
+		return nil, err
+	}
+
+	idList := make([]int64, 0, len(members))
+	for _, v := range members {
+		if id, err := strconv.ParseInt(v, 10, 64); err == nil {
+			idList = append(idList, id)
+		}
+	}
+
+	return idList, nil
+}
+
+// LockBotWebhook only one delivery of a bot runs at a time, so the updates are delivered in order.
+func (d *Dao) LockBotWebhook(ctx context.Context, botId int64, seconds int) (bool, error) {
+	key := genBotWebhookLockKey(botId)
+
+	ok, err := d.kv.SetnxEx(key, "1", seconds)
+	if err != nil {
+		logx.WithContext(ctx).Errorf("conn.Do(SETNX %s) error(%v)", key, err)
+		return false, err
+	}
+
+	return ok, nil
+}
+
+func (d *Dao) UnlockBotWebhook(ctx context.Context, botId int64) {
+	key := genBotWebhookLockKey(botId)
+
+	if _, err := d.kv.Del(key); err != nil {
+		logx.WithContext(ctx).Errorf("conn.Do(DEL %s) error(%v)", key, err)
+	}
+}
@@ -0,0 +1,67 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package model
+
+import (
+	"github.com/teamgram/proto/mtproto"
+)
+
+// channelChatIdOffset the chat_id of a channel is -100xxxxxxxxxx, the same as the official Bot API.
+const channelChatIdOffset = 1000000000000
+
+// MakeChatId users are positive, chats are negative and channels are -(1e12 + channel_id).
+func MakeChatId(peerType int32, peerId int64) int64 {
+	switch peerType {
+	case mtproto.PEER_USER, mtproto.PEER_SELF:
+		return peerId
+	case mtproto.PEER_CHAT:
+		return -peerId
+	case mtproto.PEER_CHANNEL:
+		return -(channelChatIdOffset + peerId)
+	default:
+		return 0
+	}
+}
+
+func MakeChatIdByPeer(peer *mtproto.Peer) int64 {
+	switch peer.GetPredicateName() {
+	case mtproto.Predicate_peerUser:
+		return MakeChatId(mtproto.PEER_USER, peer.GetUserId())
+	case mtproto.Predicate_peerChat:
+		return MakeChatId(mtproto.PEER_CHAT, peer.GetChatId())
+	case mtproto.Predicate_peerChannel:
+		return MakeChatId(mtproto.PEER_CHANNEL, peer.GetChannelId())
+	default:
+		return 0
+	}
+}
+
+// ParseChatId returns PEER_EMPTY for 0.
+func ParseChatId(chatId int64) (int32, int64) {
+	switch {
+	case chatId > 0:
+		return mtproto.PEER_USER, chatId
+	case chatId < -channelChatIdOffset:
+		return mtproto.PEER_CHANNEL, -chatId - channelChatIdOffset
+	case chatId < 0:
+		return mtproto.PEER_CHAT, -chatId
+	default:
+		return mtproto.PEER_EMPTY, 0
+	}
+}
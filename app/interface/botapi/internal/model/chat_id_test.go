@@ -0,0 +1,44 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package model
+
+import (
+	"testing"
+
+	"github.com/teamgram/proto/mtproto"
+)
+
+func TestChatId(t *testing.T) {
+	for _, c := range []struct {
+		peerType int32
+		peerId   int64
+		chatId   int64
+	}{
+		{mtproto.PEER_USER, 136907713, 136907713},
+		{mtproto.PEER_CHAT, 1234, -1234},
+		{mtproto.PEER_CHANNEL, 1234, -1000000001234},
+	} {
+		if chatId := MakeChatId(c.peerType, c.peerId); chatId != c.chatId {
+			t.Errorf("MakeChatId(%d, %d) = %d, want %d", c.peerType, c.peerId, chatId, c.chatId)
+		}
+		if peerType, peerId := ParseChatId(c.chatId); peerType != c.peerType || peerId != c.peerId {
+			t.Errorf("ParseChatId(%d) = (%d, %d), want (%d, %d)", c.chatId, peerType, peerId, c.peerType, c.peerId)
+		}
+	}
+}
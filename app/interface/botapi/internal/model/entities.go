@@ -0,0 +1,325 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package model
+
+import (
+	"fmt"
+	"html"
+	"strings"
+	"unicode/utf16"
+
+	"github.com/teamgram/proto/mtproto"
+)
+
+const (
+	ParseModeHTML = "HTML"
+)
+
+// The offsets and lengths of the entities are in UTF-16 code units in both of the Bot API and mtproto.
+
+// MakeMessageEntities converts the mtproto entities, the unknown ones are dropped.
+func MakeMessageEntities(entities []*mtproto.MessageEntity, getUser func(id int64) *User) []*MessageEntity {
+	if len(entities) == 0 {
+		return nil
+	}
+
+	rList := make([]*MessageEntity, 0, len(entities))
+	for _, e := range entities {
+		entity := &MessageEntity{
+			Offset: e.GetOffset(),
+			Length: e.GetLength(),
+		}
+		switch e.GetPredicateName() {
+		case mtproto.Predicate_messageEntityMention:
+			entity.Type = "mention"
+		case mtproto.Predicate_messageEntityHashtag:
+			entity.Type = "hashtag"
+		case mtproto.Predicate_messageEntityCashtag:
+			entity.Type = "cashtag"
+		case mtproto.Predicate_messageEntityBotCommand:
+			entity.Type = "bot_command"
+		case mtproto.Predicate_messageEntityUrl:
+			entity.Type = "url"
+		case mtproto.Predicate_messageEntityEmail:
+			entity.Type = "email"
+		case mtproto.Predicate_messageEntityPhone:
+			entity.Type = "phone_number"
+		case mtproto.Predicate_messageEntityBold:
+			entity.Type = "bold"
+		case mtproto.Predicate_messageEntityItalic:
+			entity.Type = "italic"
+		case mtproto.Predicate_messageEntityUnderline:
+			entity.Type = "underline"
+		case mtproto.Predicate_messageEntityStrike:
+			entity.Type = "strikethrough"
+		case mtproto.Predicate_messageEntitySpoiler:
+			entity.Type = "spoiler"
+		case mtproto.Predicate_messageEntityCode:
+			entity.Type = "code"
+		case mtproto.Predicate_messageEntityPre:
+			entity.Type = "pre"
+			entity.Language = e.GetLanguage()
+		case mtproto.Predicate_messageEntityTextUrl:
+			entity.Type = "text_link"
+			entity.Url = e.GetUrl()
+		case mtproto.Predicate_messageEntityMentionName:
+			entity.Type = "text_mention"
+			if getUser != nil {
+				entity.User = getUser(e.GetUserId_INT64())
+			}
+			if entity.User == nil {
+				entity.User = &User{Id: e.GetUserId_INT64()}
+			}
+		default:
+			continue
+		}
+		rList = append(rList, entity)
+	}
+
+	return rList
+}
+
+// ToMTProtoEntities converts the entities of the requests.
+func ToMTProtoEntities(entities []*MessageEntity) ([]*mtproto.MessageEntity, error) {
+	if len(entities) == 0 {
+		return nil, nil
+	}
+
+	rList := make([]*mtproto.MessageEntity, 0, len(entities))
+	for _, e := range entities {
+		if e.Offset < 0 || e.Length <= 0 {
+			return nil, NewBadRequest("can't parse entities: invalid entity offset or length")
+		}
+
+		data := &mtproto.MessageEntity{
+			Offset: e.Offset,
+			Length: e.Length,
+		}
+		var entity *mtproto.MessageEntity
+		switch e.Type {
+		case "mention":
+			entity = mtproto.MakeTLMessageEntityMention(data).To_MessageEntity()
+		case "hashtag":
+			entity = mtproto.MakeTLMessageEntityHashtag(data).To_MessageEntity()
+		case "cashtag":
+			entity = mtproto.MakeTLMessageEntityCashtag(data).To_MessageEntity()
+		case "bot_command":
+			entity = mtproto.MakeTLMessageEntityBotCommand(data).To_MessageEntity()
+		case "url":
+			entity = mtproto.MakeTLMessageEntityUrl(data).To_MessageEntity()
+		case "email":
+			entity = mtproto.MakeTLMessageEntityEmail(data).To_MessageEntity()
+		case "phone_number":
+			entity = mtproto.MakeTLMessageEntityPhone(data).To_MessageEntity()
+		case "bold":
+			entity = mtproto.MakeTLMessageEntityBold(data).To_MessageEntity()
+		case "italic":
+			entity = mtproto.MakeTLMessageEntityItalic(data).To_MessageEntity()
+		case "underline":
+			entity = mtproto.MakeTLMessageEntityUnderline(data).To_MessageEntity()
+		case "strikethrough":
+			entity = mtproto.MakeTLMessageEntityStrike(data).To_MessageEntity()
+		case "spoiler":
+			entity = mtproto.MakeTLMessageEntitySpoiler(data).To_MessageEntity()
+		case "code":
+			entity = mtproto.MakeTLMessageEntityCode(data).To_MessageEntity()
+		case "pre":
+			data.Language = e.Language
+			entity = mtproto.MakeTLMessageEntityPre(data).To_MessageEntity()
+		case "text_link":
+			if e.Url == "" {
+				return nil, NewBadRequest("can't parse entities: text_link without url")
+			}
+			data.Url = e.Url
+			entity = mtproto.MakeTLMessageEntityTextUrl(data).To_MessageEntity()
+		case "text_mention":
+			if e.User == nil || e.User.Id <= 0 {
+				return nil, NewBadRequest("can't parse entities: text_mention without user")
+			}
+			data.UserId_INT64 = e.User.Id
+			entity = mtproto.MakeTLMessageEntityMentionName(data).To_MessageEntity()
+		default:
+			return nil, NewBadRequest("can't parse entities: unsupported entity type \"%s\"", e.Type)
+		}
+		rList = append(rList, entity)
+	}
+
+	return rList, nil
+}
+
+type htmlTag struct {
+	name   string
+	offset int32
+	entity *MessageEntity
+}
+
+// ParseHTML supports the tags of the HTML parse_mode:
+// b, strong, i, em, u, ins, s, strike, del, tg-spoiler, span class="tg-spoiler", a href, code, pre
+// and <pre><code class="language-xxx">.
+func ParseHTML(text string) (string, []*MessageEntity, error) {
+	var (
+		out      strings.Builder
+		outLen   int32
+		stack    []*htmlTag
+		entities []*MessageEntity
+	)
+
+	writeText := func(s string) {
+		s = html.UnescapeString(s)
+		out.WriteString(s)
+		outLen += int32(len(utf16.Encode([]rune(s))))
+	}
+
+	for i := 0; i < len(text); {
+		lt := strings.IndexByte(text[i:], '<')
+		if lt < 0 {
+			writeText(text[i:])
+			break
+		}
+		writeText(text[i : i+lt])
+		i += lt
+
+		gt := strings.IndexByte(text[i:], '>')
+		if gt < 0 {
+			return "", nil, NewBadRequest("can't parse entities: unclosed start tag at byte offset %d", i)
+		}
+		tag := text[i+1 : i+gt]
+		offset := i
+		i += gt + 1
+
+		if strings.HasPrefix(tag, "/") {
+			name := strings.ToLower(strings.TrimSpace(tag[1:]))
+			if len(stack) == 0 || stack[len(stack)-1].name != name {
+				return "", nil, NewBadRequest("can't parse entities: unmatched end tag at byte offset %d, expected \"</%s>\", found \"</%s>\"",
+					offset, topTagName(stack), name)
+			}
+			top := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			if top.entity != nil && outLen > top.offset {
+				top.entity.Offset = top.offset
+				top.entity.Length = outLen - top.offset
+				entities = append(entities, top.entity)
+			}
+			continue
+		}
+
+		name, attrs := parseHTMLTag(tag)
+		t := &htmlTag{name: name, offset: outLen}
+		switch name {
+		case "b", "strong":
+			t.entity = &MessageEntity{Type: "bold"}
+		case "i", "em":
+			t.entity = &MessageEntity{Type: "italic"}
+		case "u", "ins":
+			t.entity = &MessageEntity{Type: "underline"}
+		case "s", "strike", "del":
+			t.entity = &MessageEntity{Type: "strikethrough"}
+		case "tg-spoiler":
+			t.entity = &MessageEntity{Type: "spoiler"}
+		case "span":
+			if attrs["class"] != "tg-spoiler" {
+				return "", nil, NewBadRequest("can't parse entities: tag \"span\" must have class \"tg-spoiler\" at byte offset %d", offset)
+			}
+			t.entity = &MessageEntity{Type: "spoiler"}
+		case "a":
+			href := attrs["href"]
+			if href == "" {
+				return "", nil, NewBadRequest("can't parse entities: tag \"a\" must have attribute \"href\" at byte offset %d", offset)
+			}
+			if strings.HasPrefix(href, "tg://user?id=") {
+				var userId int64
+				fmt.Sscanf(strings.TrimPrefix(href, "tg://user?id="), "%d", &userId)
+				t.entity = &MessageEntity{Type: "text_mention", User: &User{Id: userId}}
+			} else {
+				t.entity = &MessageEntity{Type: "text_link", Url: href}
+			}
+		case "code":
+			if len(stack) > 0 && stack[len(stack)-1].name == "pre" && stack[len(stack)-1].offset == outLen {
+				// <pre><code class="language-xxx"> is a pre entity with the language
+				stack[len(stack)-1].entity.Language = strings.TrimPrefix(attrs["class"], "language-")
+			} else {
+				t.entity = &MessageEntity{Type: "code"}
+			}
+		case "pre":
+			t.entity = &MessageEntity{Type: "pre"}
+		default:
+			return "", nil, NewBadRequest("can't parse entities: unsupported start tag \"%s\" at byte offset %d", name, offset)
+		}
+		stack = append(stack, t)
+	}
+
+	if len(stack) > 0 {
+		return "", nil, NewBadRequest("can't parse entities: can't find end tag corresponding to start tag \"%s\"", topTagName(stack))
+	}
+
+	return out.String(), entities, nil
+}
+
+func topTagName(stack []*htmlTag) string {
+	if len(stack) == 0 {
+		return ""
+	}
+	return stack[len(stack)-1].name
+}
+
+// parseHTMLTag parses `name attr="value" attr='value'`
+func parseHTMLTag(tag string) (string, map[string]string) {
+	var (
+		attrs = make(map[string]string)
+		name  string
+	)
+
+	tag = strings.TrimSpace(tag)
+	if idx := strings.IndexAny(tag, " \t\n"); idx < 0 {
+		return strings.ToLower(tag), attrs
+	} else {
+		name, tag = strings.ToLower(tag[:idx]), tag[idx+1:]
+	}
+
+	for {
+		tag = strings.TrimSpace(tag)
+		eq := strings.IndexByte(tag, '=')
+		if eq <= 0 || eq+1 >= len(tag) {
+			break
+		}
+		key := strings.ToLower(strings.TrimSpace(tag[:eq]))
+		tag = strings.TrimSpace(tag[eq+1:])
+
+		var value string
+		if q := tag[0]; q == '"' || q == '\'' {
+			end := strings.IndexByte(tag[1:], q)
+			if end < 0 {
+				break
+			}
+			value, tag = tag[1:end+1], tag[end+2:]
+		} else if end := strings.IndexAny(tag, " \t\n"); end < 0 {
+			value, tag = tag, ""
+		} else {
+			value, tag = tag[:end], tag[end:]
+		}
+		attrs[key] = html.UnescapeString(value)
+	}
+
+	return name, attrs
+}
+
+// TextLength is the length of the text in UTF-16 code units.
+func TextLength(text string) int {
+	return len(utf16.Encode([]rune(text)))
+}
@@ -0,0 +1,58 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package model
+
+import (
+	"testing"
+)
+
+func TestParseHTML(t *testing.T) {
+	text, entities, err := ParseHTML(`<b>bold</b> 😀 <a href="https://teamgram.io">link</a> &lt;<code>x</code>`)
+	if err != nil {
+		t.Fatalf("ParseHTML - error: %v", err)
+	}
+	if text != "bold 😀 link <x" {
+		t.Errorf("text = %q, want %q", text, "bold 😀 link <x")
+	}
+
+	// the offsets are in UTF-16 code units, the emoji takes two of them
+	want := []MessageEntity{
+		{Type: "bold", Offset: 0, Length: 4},
+		{Type: "text_link", Offset: 8, Length: 4, Url: "https://teamgram.io"},
+		{Type: "code", Offset: 14, Length: 1},
+	}
+	if len(entities) != len(want) {
+		t.Fatalf("entities = %d, want %d", len(entities), len(want))
+	}
+	for i, e := range entities {
+		if e.Type != want[i].Type || e.Offset != want[i].Offset || e.Length != want[i].Length || e.Url != want[i].Url {
+			t.Errorf("entities[%d] = %+v, want %+v", i, *e, want[i])
+		}
+	}
+
+	for _, v := range []string{
+		"<b>unclosed",
+		"<b>wrong</i>",
+		"<unknown>tag</unknown>",
+	} {
+		if _, _, err := ParseHTML(v); err == nil {
+			t.Errorf("ParseHTML(%q) - want error", v)
+		}
+	}
+}
@@ -0,0 +1,133 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package model
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"google.golang.org/grpc/status"
+)
+
+// Error is returned as {"ok":false,"error_code":...,"description":...}
+type Error struct {
+	Code        int
+	Description string
+	RetryAfter  int
+}
+
+func NewError(code int, description string) *Error {
+	return &Error{
+		Code:        code,
+		Description: description,
+	}
+}
+
+func NewBadRequest(format string, a ...interface{}) *Error {
+	return NewError(http.StatusBadRequest, "Bad Request: "+fmt.Sprintf(format, a...))
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("%d: %s", e.Code, e.Description)
+}
+
+var (
+	ErrUnauthorized   = NewError(http.StatusUnauthorized, "Unauthorized")
+	ErrNotFound       = NewError(http.StatusNotFound, "Not Found")
+	ErrWebhookActive  = NewError(http.StatusConflict, "Conflict: can't use getUpdates method while webhook is active; use deleteWebhook to delete the webhook first")
+	ErrInternal       = NewError(http.StatusInternalServerError, "Internal Server Error")
+	ErrChatNotFound   = NewBadRequest("chat not found")
+	ErrMessageEmpty   = NewBadRequest("message text is empty")
+	ErrMessageTooLong = NewBadRequest("message is too long")
+	ErrCaptionTooLong = NewBadRequest("message caption is too long")
+	ErrMessageToEdit  = NewBadRequest("message to edit not found")
+	ErrQueryIdInvalid = NewBadRequest("query is too old and response timeout expired or query ID is invalid")
+	ErrWrongFileId    = NewBadRequest("wrong file identifier/HTTP URL specified")
+	ErrFileTooBig     = NewBadRequest("file is too big")
+)
+
+// FromError converts the rpc errors of the services, FLOOD_WAIT_X becomes 429 with retry_after.
+func FromError(err error) *Error {
+	if e, ok := err.(*Error); ok {
+		return e
+	}
+
+	s, ok := status.FromError(err)
+	if !ok {
+		return ErrInternal
+	}
+
+	switch code := int(s.Code()); code {
+	case http.StatusBadRequest:
+		return NewBadRequest(s.Message())
+	case http.StatusUnauthorized:
+		return ErrUnauthorized
+	case http.StatusForbidden:
+		return NewError(code, "Forbidden: "+s.Message())
+	case http.StatusNotFound:
+		return ErrNotFound
+	case 420:
+		if strings.HasPrefix(s.Message(), "FLOOD_WAIT_") {
+			retryAfter, _ := strconv.Atoi(strings.TrimPrefix(s.Message(), "FLOOD_WAIT_"))
+			return &Error{
+				Code:        http.StatusTooManyRequests,
+				Description: fmt.Sprintf("Too Many Requests: retry after %d", retryAfter),
+				RetryAfter:  retryAfter,
+			}
+		}
+		return NewError(http.StatusTooManyRequests, "Too Many Requests: "+s.Message())
+	default:
+		return ErrInternal
+	}
+}
+
+type ResponseParameters struct {
+	RetryAfter int `json:"retry_after,omitempty"`
+}
+
+type Response struct {
+	Ok          bool                `json:"ok"`
+	Result      interface{}         `json:"result,omitempty"`
+	ErrorCode   int                 `json:"error_code,omitempty"`
+	Description string              `json:"description,omitempty"`
+	Parameters  *ResponseParameters `json:"parameters,omitempty"`
+}
+
+func MakeOkResponse(result interface{}) *Response {
+	return &Response{
+		Ok:     true,
+		Result: result,
+	}
+}
+
+func MakeErrorResponse(e *Error) *Response {
+	r := &Response{
+		Ok:          false,
+		ErrorCode:   e.Code,
+		Description: e.Description,
+	}
+	if e.RetryAfter > 0 {
+		r.Parameters = &ResponseParameters{
+			RetryAfter: e.RetryAfter,
+		}
+	}
+	return r
+}
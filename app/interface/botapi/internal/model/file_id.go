@@ -0,0 +1,109 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package model
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"strings"
+)
+
+const (
+	FileTypePhoto    = 1
+	FileTypeDocument = 2
+)
+
+// fileIdHeaderLen type(1) + dc_id(4) + id(8) + access_hash(8), the thumb_size follows
+const fileIdHeaderLen = 21
+
+// FileId is encoded as the file_id of the Bot API objects,
+// it is everything needed to build the InputFileLocation or the InputMedia.
+type FileId struct {
+	Type       int8
+	DcId       int32
+	Id         int64
+	AccessHash int64
+	ThumbSize  string
+}
+
+func (m *FileId) Encode() string {
+	buf := make([]byte, fileIdHeaderLen, fileIdHeaderLen+len(m.ThumbSize))
+	buf[0] = byte(m.Type)
+	binary.LittleEndian.PutUint32(buf[1:], uint32(m.DcId))
+	binary.LittleEndian.PutUint64(buf[5:], uint64(m.Id))
+	binary.LittleEndian.PutUint64(buf[13:], uint64(m.AccessHash))
+	buf = append(buf, m.ThumbSize...)
+
+	return base64.RawURLEncoding.EncodeToString(buf)
+}
+
+// UniqueId is the same for the same file of any bot, so the access_hash is not in it.
+func (m *FileId) UniqueId() string {
+	buf := make([]byte, 9, 9+len(m.ThumbSize))
+	buf[0] = byte(m.Type)
+	binary.LittleEndian.PutUint64(buf[1:], uint64(m.Id))
+	buf = append(buf, m.ThumbSize...)
+
+	return base64.RawURLEncoding.EncodeToString(buf)
+}
+
+// FilePath is returned by getFile, the file is downloaded from /file/bot<token>/<file_path>
+func (m *FileId) FilePath() string {
+	switch m.Type {
+	case FileTypePhoto:
+		return "photos/" + m.Encode()
+	default:
+		return "documents/" + m.Encode()
+	}
+}
+
+func DecodeFileId(v string) (*FileId, error) {
+	buf, err := base64.RawURLEncoding.DecodeString(v)
+	if err != nil {
+		return nil, err
+	} else if len(buf) < fileIdHeaderLen {
+		return nil, fmt.Errorf("invalid file_id: %s", v)
+	}
+
+	fileId := &FileId{
+		Type:       int8(buf[0]),
+		DcId:       int32(binary.LittleEndian.Uint32(buf[1:])),
+		Id:         int64(binary.LittleEndian.Uint64(buf[5:])),
+		AccessHash: int64(binary.LittleEndian.Uint64(buf[13:])),
+		ThumbSize:  string(buf[fileIdHeaderLen:]),
+	}
+	switch fileId.Type {
+	case FileTypePhoto:
+		if fileId.ThumbSize == "" {
+			return nil, fmt.Errorf("invalid file_id: %s", v)
+		}
+	case FileTypeDocument:
+	default:
+		return nil, fmt.Errorf("invalid file_id: %s", v)
+	}
+
+	return fileId, nil
+}
+
+// DecodeFilePath is the inverse of FilePath.
+func DecodeFilePath(v string) (*FileId, error) {
+	idx := strings.LastIndexByte(v, '/')
+	return DecodeFileId(v[idx+1:])
+}
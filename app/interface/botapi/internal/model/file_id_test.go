@@ -0,0 +1,60 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package model
+
+import (
+	"testing"
+)
+
+func TestFileId(t *testing.T) {
+	for _, fileId := range []*FileId{
+		{Type: FileTypePhoto, DcId: 1, Id: 1234567890123, AccessHash: -987654321, ThumbSize: "y"},
+		{Type: FileTypeDocument, DcId: 2, Id: 1234567890123, AccessHash: 987654321},
+		{Type: FileTypeDocument, DcId: 2, Id: 1234567890123, AccessHash: 987654321, ThumbSize: "m"},
+	} {
+		v, err := DecodeFileId(fileId.Encode())
+		if err != nil {
+			t.Fatalf("DecodeFileId(%s) - error: %v", fileId.Encode(), err)
+		} else if *v != *fileId {
+			t.Errorf("DecodeFileId(%s) = %v, want %v", fileId.Encode(), v, fileId)
+		}
+
+		v, err = DecodeFilePath(fileId.FilePath())
+		if err != nil || *v != *fileId {
+			t.Errorf("DecodeFilePath(%s) = %v, %v, want %v", fileId.FilePath(), v, err, fileId)
+		}
+
+		other := *fileId
+		other.AccessHash++
+		if other.UniqueId() != fileId.UniqueId() {
+			t.Errorf("UniqueId() depends on the access_hash")
+		}
+	}
+
+	for _, v := range []string{
+		"",
+		"not-a-file-id",
+		(&FileId{Type: FileTypePhoto, Id: 1}).Encode(),
+		(&FileId{Type: 9, Id: 1}).Encode(),
+	} {
+		if _, err := DecodeFileId(v); err == nil {
+			t.Errorf("DecodeFileId(%q) - want error", v)
+		}
+	}
+}
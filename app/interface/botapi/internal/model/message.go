@@ -0,0 +1,262 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package model
+
+import (
+	"github.com/teamgram/proto/mtproto"
+)
+
+// Peers are the users and chats of an Updates.
+type Peers struct {
+	users map[int64]*mtproto.User
+	chats map[int64]*mtproto.Chat
+}
+
+func NewPeers(users []*mtproto.User, chats []*mtproto.Chat) *Peers {
+	p := &Peers{
+		users: make(map[int64]*mtproto.User, len(users)),
+		chats: make(map[int64]*mtproto.Chat, len(chats)),
+	}
+	for _, u := range users {
+		p.users[u.GetId()] = u
+	}
+	for _, c := range chats {
+		p.chats[c.GetId()] = c
+	}
+	return p
+}
+
+func (p *Peers) GetUser(id int64) *User {
+	if u, ok := p.users[id]; ok {
+		return MakeUser(u)
+	}
+	return &User{Id: id}
+}
+
+func (p *Peers) GetChat(peer *mtproto.Peer) *Chat {
+	chat := &Chat{
+		Id: MakeChatIdByPeer(peer),
+	}
+
+	switch peer.GetPredicateName() {
+	case mtproto.Predicate_peerUser:
+		chat.Type = "private"
+		if u, ok := p.users[peer.GetUserId()]; ok {
+			chat.FirstName = u.GetFirstName().GetValue()
+			chat.LastName = u.GetLastName().GetValue()
+			chat.Username = u.GetUsername().GetValue()
+		}
+	case mtproto.Predicate_peerChat:
+		chat.Type = "group"
+		if c, ok := p.chats[peer.GetChatId()]; ok {
+			chat.Title = c.GetTitle()
+		}
+	case mtproto.Predicate_peerChannel:
+		chat.Type = "supergroup"
+		if c, ok := p.chats[peer.GetChannelId()]; ok {
+			chat.Title = c.GetTitle()
+			chat.Username = c.GetUsername().GetValue()
+			if c.GetBroadcast() {
+				chat.Type = "channel"
+			}
+		}
+	}
+
+	return chat
+}
+
+func MakeUser(u *mtproto.User) *User {
+	return &User{
+		Id:           u.GetId(),
+		IsBot:        u.GetBot(),
+		FirstName:    u.GetFirstName().GetValue(),
+		LastName:     u.GetLastName().GetValue(),
+		Username:     u.GetUsername().GetValue(),
+		LanguageCode: u.GetLangCode().GetValue(),
+	}
+}
+
+// MakeMessage converts the message of the bot's box, the service messages are not supported and return nil.
+func MakeMessage(botId int64, m *mtproto.Message, peers *Peers) *Message {
+	if m.GetPredicateName() != mtproto.Predicate_message {
+		return nil
+	}
+
+	message := &Message{
+		MessageId: m.GetId(),
+		Date:      m.GetDate(),
+		Chat:      peers.GetChat(m.GetPeerId()),
+		EditDate:  m.GetEditDate().GetValue(),
+	}
+
+	switch {
+	case m.GetFromId().GetPredicateName() == mtproto.Predicate_peerUser:
+		message.From = peers.GetUser(m.GetFromId().GetUserId())
+	case message.Chat.Type == "private":
+		if m.GetOut() {
+			message.From = peers.GetUser(botId)
+		} else {
+			message.From = peers.GetUser(m.GetPeerId().GetUserId())
+		}
+	case message.Chat.Type == "channel":
+		message.SenderChat = message.Chat
+	}
+
+	entities := MakeMessageEntities(m.GetEntities(), func(id int64) *User {
+		return peers.GetUser(id)
+	})
+
+	switch media := m.GetMedia(); media.GetPredicateName() {
+	case mtproto.Predicate_messageMediaPhoto:
+		message.Photo = MakePhotoSizes(media.GetPhoto_FLAGPHOTO())
+	case mtproto.Predicate_messageMediaDocument:
+		message.Document = MakeDocument(media.GetDocument())
+	case mtproto.Predicate_messageMediaContact:
+		message.Contact = &Contact{
+			PhoneNumber: media.GetPhoneNumber(),
+			FirstName:   media.GetFirstName(),
+			LastName:    media.GetLastName(),
+			UserId:      media.GetUserId(),
+			Vcard:       media.GetVcard(),
+		}
+	case mtproto.Predicate_messageMediaGeo:
+		message.Location = &Location{
+			Longitude: media.GetGeo().GetLong(),
+			Latitude:  media.GetGeo().GetLat(),
+		}
+	}
+
+	if message.Photo != nil || message.Document != nil {
+		message.Caption = m.GetMessage()
+		message.CaptionEntities = entities
+	} else {
+		message.Text = m.GetMessage()
+		message.Entities = entities
+	}
+	message.ReplyMarkup = MakeInlineKeyboardMarkup(m.GetReplyMarkup())
+
+	return message
+}
+
+// MakePhotoSizes the stripped and cached sizes are skipped, they have no file.
+func MakePhotoSizes(photo *mtproto.Photo) []*PhotoSize {
+	if photo.GetPredicateName() != mtproto.Predicate_photo {
+		return nil
+	}
+
+	sizes := make([]*PhotoSize, 0, len(photo.GetSizes()))
+	for _, sz := range photo.GetSizes() {
+		var size int32
+		switch sz.GetPredicateName() {
+		case mtproto.Predicate_photoSize:
+			size = sz.GetSize2()
+		case mtproto.Predicate_photoSizeProgressive:
+			if len(sz.GetSizes()) > 0 {
+				size = sz.GetSizes()[len(sz.GetSizes())-1]
+			}
+		default:
+			continue
+		}
+
+		fileId := &FileId{
+			Type:       FileTypePhoto,
+			DcId:       photo.GetDcId(),
+			Id:         photo.GetId(),
+			AccessHash: photo.GetAccessHash(),
+			ThumbSize:  sz.GetType(),
+		}
+		sizes = append(sizes, &PhotoSize{
+			FileId:       fileId.Encode(),
+			FileUniqueId: fileId.UniqueId(),
+			Width:        sz.GetW(),
+			Height:       sz.GetH(),
+			FileSize:     size,
+		})
+	}
+
+	return sizes
+}
+
+func MakeDocument(document *mtproto.Document) *Document {
+	if document.GetPredicateName() != mtproto.Predicate_document {
+		return nil
+	}
+
+	fileId := &FileId{
+		Type:       FileTypeDocument,
+		DcId:       document.GetDcId(),
+		Id:         document.GetId(),
+		AccessHash: document.GetAccessHash(),
+	}
+	doc := &Document{
+		FileId:       fileId.Encode(),
+		FileUniqueId: fileId.UniqueId(),
+		MimeType:     document.GetMimeType(),
+		FileSize:     document.GetSize2(),
+	}
+	for _, attr := range document.GetAttributes() {
+		if attr.GetPredicateName() == mtproto.Predicate_documentAttributeFilename {
+			doc.FileName = attr.GetFileName()
+		}
+	}
+	for _, thumb := range document.GetThumbs() {
+		if thumb.GetPredicateName() != mtproto.Predicate_photoSize {
+			continue
+		}
+		thumbId := &FileId{
+			Type:       FileTypeDocument,
+			DcId:       document.GetDcId(),
+			Id:         document.GetId(),
+			AccessHash: document.GetAccessHash(),
+			ThumbSize:  thumb.GetType(),
+		}
+		doc.Thumb = &PhotoSize{
+			FileId:       thumbId.Encode(),
+			FileUniqueId: thumbId.UniqueId(),
+			Width:        thumb.GetW(),
+			Height:       thumb.GetH(),
+			FileSize:     thumb.GetSize2(),
+		}
+		break
+	}
+
+	return doc
+}
+
+func MakeBotCommands(commands []*mtproto.BotCommand) []*BotCommand {
+	botCommands := make([]*BotCommand, 0, len(commands))
+	for _, command := range commands {
+		botCommands = append(botCommands, &BotCommand{
+			Command:     command.GetCommand(),
+			Description: command.GetDescription(),
+		})
+	}
+	return botCommands
+}
+
+func ToMTProtoBotCommands(commands []*BotCommand) []*mtproto.BotCommand {
+	botCommands := make([]*mtproto.BotCommand, 0, len(commands))
+	for _, command := range commands {
+		botCommands = append(botCommands, mtproto.MakeTLBotCommand(&mtproto.BotCommand{
+			Command:     command.Command,
+			Description: command.Description,
+		}).To_BotCommand())
+	}
+	return botCommands
+}
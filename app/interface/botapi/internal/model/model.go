@@ -0,0 +1,114 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package model
+
+const (
+	UpdateTypeMessage           = "message"
+	UpdateTypeEditedMessage     = "edited_message"
+	UpdateTypeChannelPost       = "channel_post"
+	UpdateTypeEditedChannelPost = "edited_channel_post"
+	UpdateTypeCallbackQuery     = "callback_query"
+)
+
+const (
+	// MaxPendingUpdates the oldest updates are dropped if the bot doesn't fetch them
+	MaxPendingUpdates = 1000
+	// PendingUpdatesExpire the pending updates are kept for 24 hours
+	PendingUpdatesExpire = 86400
+
+	DefaultGetUpdatesLimit = 100
+	MaxGetUpdatesTimeout   = 50 // 50s
+
+	MaxMessageLength = 4096
+	MaxCaptionLength = 1024
+
+	MaxUploadPhotoSize  = 10 << 20 // 10MB
+	MaxUploadFileSize   = 50 << 20 // 50MB
+	MaxDownloadFileSize = 20 << 20 // 20MB
+
+	// MaxCallbackDataLength is the max size of callback_data, the same as keyboardButtonCallback.data
+	MaxCallbackDataLength = 64
+	MaxCallbackAnswerLen  = 200
+
+	DefaultWebhookMaxConnections = 40
+	MaxWebhookMaxConnections     = 100
+	MaxWebhookSecretTokenLen     = 256
+)
+
+// IsUpdateType reports whether v is a valid allowed_updates entry,
+// the types the gateway doesn't produce are accepted and never sent.
+func IsUpdateType(v string) bool {
+	switch v {
+	case UpdateTypeMessage,
+		UpdateTypeEditedMessage,
+		UpdateTypeChannelPost,
+		UpdateTypeEditedChannelPost,
+		UpdateTypeCallbackQuery,
+		"inline_query",
+		"chosen_inline_result",
+		"shipping_query",
+		"pre_checkout_query",
+		"poll",
+		"poll_answer",
+		"my_chat_member",
+		"chat_member",
+		"chat_join_request":
+		return true
+	}
+	return false
+}
+
+// IsAllowedUpdate an empty allowed_updates list allows all the updates.
+func IsAllowedUpdate(allowedUpdates []string, updateType string) bool {
+	if len(allowedUpdates) == 0 {
+		return true
+	}
+	for _, v := range allowedUpdates {
+		if v == updateType {
+			return true
+		}
+	}
+	return false
+}
+
+// Webhook is the setWebhook state of a bot.
+type Webhook struct {
+	Url              string   `json:"url"`
+	IpAddress        string   `json:"ip_address,omitempty"`
+	MaxConnections   int      `json:"max_connections"`
+	AllowedUpdates   []string `json:"allowed_updates,omitempty"`
+	SecretToken      string   `json:"secret_token,omitempty"`
+	LastErrorDate    int64    `json:"last_error_date,omitempty"`
+	LastErrorMessage string   `json:"last_error_message,omitempty"`
+}
+
+// BotCallbackQuery is the pending messages.getBotCallbackAnswer, written by app/bff/bots.
+type BotCallbackQuery struct {
+	QueryId int64 `json:"query_id"`
+	BotId   int64 `json:"bot_id"`
+	UserId  int64 `json:"user_id"`
+}
+
+// BotCallbackAnswer is polled by messages.getBotCallbackAnswer of app/bff/bots.
+type BotCallbackAnswer struct {
+	Alert     bool   `json:"alert"`
+	Message   string `json:"message,omitempty"`
+	Url       string `json:"url,omitempty"`
+	CacheTime int32  `json:"cache_time"`
+}
@@ -0,0 +1,112 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package model
+
+import (
+	"encoding/json"
+	"mime/multipart"
+	"strconv"
+)
+
+// Params are the parameters of a method from the query string, the form or the json body,
+// the objects and arrays are kept as their JSON text.
+type Params struct {
+	values map[string]string
+	files  map[string]*multipart.FileHeader
+}
+
+func NewParams() *Params {
+	return &Params{
+		values: make(map[string]string),
+		files:  make(map[string]*multipart.FileHeader),
+	}
+}
+
+func (p *Params) Set(key, value string) {
+	p.values[key] = value
+}
+
+func (p *Params) SetFile(key string, file *multipart.FileHeader) {
+	p.files[key] = file
+}
+
+func (p *Params) Has(key string) bool {
+	_, ok := p.values[key]
+	return ok
+}
+
+func (p *Params) String(key string) string {
+	return p.values[key]
+}
+
+func (p *Params) Int64(key string) (int64, error) {
+	v, ok := p.values[key]
+	if !ok || v == "" {
+		return 0, nil
+	}
+
+	i, err := strconv.ParseInt(v, 10, 64)
+	if err != nil {
+		return 0, NewBadRequest("invalid %s specified", key)
+	}
+	return i, nil
+}
+
+func (p *Params) Int(key string, defaultValue int) (int, error) {
+	if !p.Has(key) {
+		return defaultValue, nil
+	}
+	i, err := p.Int64(key)
+	return int(i), err
+}
+
+func (p *Params) Bool(key string) bool {
+	b, _ := strconv.ParseBool(p.values[key])
+	return b
+}
+
+// JSON unmarshals a JSON-serialized parameter, v is untouched if the parameter is absent.
+func (p *Params) JSON(key string, v interface{}) error {
+	s, ok := p.values[key]
+	if !ok || s == "" {
+		return nil
+	}
+	if err := json.Unmarshal([]byte(s), v); err != nil {
+		return NewBadRequest("can't parse %s JSON object", key)
+	}
+	return nil
+}
+
+// ChatId only the numeric chat_id is supported, @channelusername is not found.
+func (p *Params) ChatId() (int32, int64, error) {
+	chatId, err := p.Int64("chat_id")
+	if err != nil {
+		return 0, 0, ErrChatNotFound
+	}
+	peerType, peerId := ParseChatId(chatId)
+	if peerId == 0 {
+		return 0, 0, ErrChatNotFound
+	}
+	return peerType, peerId, nil
+}
+
+// File returns the uploaded file of a multipart/form-data request.
+func (p *Params) File(key string) *multipart.FileHeader {
+	return p.files[key]
+}
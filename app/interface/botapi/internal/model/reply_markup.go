@@ -0,0 +1,138 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package model
+
+import (
+	"github.com/teamgram/proto/mtproto"
+
+	"github.com/gogo/protobuf/types"
+)
+
+// ToMTProtoReplyMarkup converts the reply_markup of the requests, nil means no reply_markup.
+func ToMTProtoReplyMarkup(markup *ReplyMarkup) (*mtproto.ReplyMarkup, error) {
+	if markup == nil {
+		return nil, nil
+	}
+
+	var placeholder *types.StringValue
+	if markup.InputFieldPlaceholder != "" {
+		placeholder = &types.StringValue{Value: markup.InputFieldPlaceholder}
+	}
+
+	switch {
+	case markup.InlineKeyboard != nil:
+		rows := make([]*mtproto.KeyboardButtonRow, 0, len(markup.InlineKeyboard))
+		for _, row := range markup.InlineKeyboard {
+			buttons := make([]*mtproto.KeyboardButton, 0, len(row))
+			for _, button := range row {
+				if button == nil || button.Text == "" {
+					return nil, NewBadRequest("text buttons are unallowed in the inline keyboard")
+				}
+				switch {
+				case button.Url != "":
+					buttons = append(buttons, mtproto.MakeTLKeyboardButtonUrl(&mtproto.KeyboardButton{
+						Text: button.Text,
+						Url:  button.Url,
+					}).To_KeyboardButton())
+				case button.CallbackData != "":
+					if len(button.CallbackData) > MaxCallbackDataLength {
+						return nil, NewBadRequest("BUTTON_DATA_INVALID")
+					}
+					buttons = append(buttons, mtproto.MakeTLKeyboardButtonCallback(&mtproto.KeyboardButton{
+						Text: button.Text,
+						Data: []byte(button.CallbackData),
+					}).To_KeyboardButton())
+				default:
+					return nil, NewBadRequest("BUTTON_TYPE_INVALID")
+				}
+			}
+			rows = append(rows, mtproto.MakeTLKeyboardButtonRow(&mtproto.KeyboardButtonRow{
+				Buttons: buttons,
+			}).To_KeyboardButtonRow())
+		}
+		return mtproto.MakeTLReplyInlineMarkup(&mtproto.ReplyMarkup{
+			Rows: rows,
+		}).To_ReplyMarkup(), nil
+	case markup.Keyboard != nil:
+		rows := make([]*mtproto.KeyboardButtonRow, 0, len(markup.Keyboard))
+		for _, row := range markup.Keyboard {
+			buttons := make([]*mtproto.KeyboardButton, 0, len(row))
+			for _, button := range row {
+				if button == nil || button.Text == "" {
+					return nil, NewBadRequest("BUTTON_TEXT_INVALID")
+				}
+				buttons = append(buttons, mtproto.MakeTLKeyboardButton(&mtproto.KeyboardButton{
+					Text: button.Text,
+				}).To_KeyboardButton())
+			}
+			rows = append(rows, mtproto.MakeTLKeyboardButtonRow(&mtproto.KeyboardButtonRow{
+				Buttons: buttons,
+			}).To_KeyboardButtonRow())
+		}
+		return mtproto.MakeTLReplyKeyboardMarkup(&mtproto.ReplyMarkup{
+			Resize:      markup.ResizeKeyboard,
+			SingleUse:   markup.OneTimeKeyboard,
+			Selective:   markup.Selective,
+			Placeholder: placeholder,
+			Rows:        rows,
+		}).To_ReplyMarkup(), nil
+	case markup.RemoveKeyboard:
+		return mtproto.MakeTLReplyKeyboardHide(&mtproto.ReplyMarkup{
+			Selective: markup.Selective,
+		}).To_ReplyMarkup(), nil
+	case markup.ForceReply:
+		return mtproto.MakeTLReplyKeyboardForceReply(&mtproto.ReplyMarkup{
+			Selective:   markup.Selective,
+			Placeholder: placeholder,
+		}).To_ReplyMarkup(), nil
+	default:
+		return nil, NewBadRequest("can't parse reply keyboard markup JSON object")
+	}
+}
+
+// MakeInlineKeyboardMarkup only the inline keyboards are returned in the messages.
+func MakeInlineKeyboardMarkup(markup *mtproto.ReplyMarkup) *InlineKeyboardMarkup {
+	if markup.GetPredicateName() != mtproto.Predicate_replyInlineMarkup {
+		return nil
+	}
+
+	keyboard := make([][]*InlineKeyboardButton, 0, len(markup.GetRows()))
+	for _, row := range markup.GetRows() {
+		buttons := make([]*InlineKeyboardButton, 0, len(row.GetButtons()))
+		for _, button := range row.GetButtons() {
+			switch button.GetPredicateName() {
+			case mtproto.Predicate_keyboardButtonUrl:
+				buttons = append(buttons, &InlineKeyboardButton{
+					Text: button.GetText(),
+					Url:  button.GetUrl(),
+				})
+			case mtproto.Predicate_keyboardButtonCallback:
+				buttons = append(buttons, &InlineKeyboardButton{
+					Text:         button.GetText(),
+					CallbackData: string(button.GetData()),
+				})
+			}
+		}
+		keyboard = append(keyboard, buttons)
+	}
+
+	return &InlineKeyboardMarkup{
+		InlineKeyboard: keyboard,
+	}
+}
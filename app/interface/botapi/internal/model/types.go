@@ -0,0 +1,185 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package model
+
+// The objects of https://core.telegram.org/bots/api, only the fields the gateway fills are declared.
+
+// Update the values of UpdateType* are the names of the optional fields.
+type Update struct {
+	UpdateId          int64          `json:"update_id"`
+	Message           *Message       `json:"message,omitempty"`
+	EditedMessage     *Message       `json:"edited_message,omitempty"`
+	ChannelPost       *Message       `json:"channel_post,omitempty"`
+	EditedChannelPost *Message       `json:"edited_channel_post,omitempty"`
+	CallbackQuery     *CallbackQuery `json:"callback_query,omitempty"`
+}
+
+func (m *Update) Type() string {
+	switch {
+	case m.Message != nil:
+		return UpdateTypeMessage
+	case m.EditedMessage != nil:
+		return UpdateTypeEditedMessage
+	case m.ChannelPost != nil:
+		return UpdateTypeChannelPost
+	case m.EditedChannelPost != nil:
+		return UpdateTypeEditedChannelPost
+	case m.CallbackQuery != nil:
+		return UpdateTypeCallbackQuery
+	default:
+		return ""
+	}
+}
+
+type User struct {
+	Id           int64  `json:"id"`
+	IsBot        bool   `json:"is_bot"`
+	FirstName    string `json:"first_name"`
+	LastName     string `json:"last_name,omitempty"`
+	Username     string `json:"username,omitempty"`
+	LanguageCode string `json:"language_code,omitempty"`
+
+	// getMe only
+	CanJoinGroups           *bool `json:"can_join_groups,omitempty"`
+	CanReadAllGroupMessages *bool `json:"can_read_all_group_messages,omitempty"`
+	SupportsInlineQueries   *bool `json:"supports_inline_queries,omitempty"`
+}
+
+type Chat struct {
+	Id        int64  `json:"id"`
+	Type      string `json:"type"`
+	Title     string `json:"title,omitempty"`
+	Username  string `json:"username,omitempty"`
+	FirstName string `json:"first_name,omitempty"`
+	LastName  string `json:"last_name,omitempty"`
+}
+
+type Message struct {
+	MessageId       int32                 `json:"message_id"`
+	From            *User                 `json:"from,omitempty"`
+	SenderChat      *Chat                 `json:"sender_chat,omitempty"`
+	Date            int32                 `json:"date"`
+	Chat            *Chat                 `json:"chat"`
+	EditDate        int32                 `json:"edit_date,omitempty"`
+	Text            string                `json:"text,omitempty"`
+	Entities        []*MessageEntity      `json:"entities,omitempty"`
+	Document        *Document             `json:"document,omitempty"`
+	Photo           []*PhotoSize          `json:"photo,omitempty"`
+	Caption         string                `json:"caption,omitempty"`
+	CaptionEntities []*MessageEntity      `json:"caption_entities,omitempty"`
+	Contact         *Contact              `json:"contact,omitempty"`
+	Location        *Location             `json:"location,omitempty"`
+	ReplyMarkup     *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+}
+
+type MessageEntity struct {
+	Type     string `json:"type"`
+	Offset   int32  `json:"offset"`
+	Length   int32  `json:"length"`
+	Url      string `json:"url,omitempty"`
+	User     *User  `json:"user,omitempty"`
+	Language string `json:"language,omitempty"`
+}
+
+type PhotoSize struct {
+	FileId       string `json:"file_id"`
+	FileUniqueId string `json:"file_unique_id"`
+	Width        int32  `json:"width"`
+	Height       int32  `json:"height"`
+	FileSize     int32  `json:"file_size,omitempty"`
+}
+
+type Document struct {
+	FileId       string     `json:"file_id"`
+	FileUniqueId string     `json:"file_unique_id"`
+	Thumb        *PhotoSize `json:"thumb,omitempty"`
+	FileName     string     `json:"file_name,omitempty"`
+	MimeType     string     `json:"mime_type,omitempty"`
+	FileSize     int32      `json:"file_size,omitempty"`
+}
+
+type Contact struct {
+	PhoneNumber string `json:"phone_number"`
+	FirstName   string `json:"first_name"`
+	LastName    string `json:"last_name,omitempty"`
+	UserId      int64  `json:"user_id,omitempty"`
+	Vcard       string `json:"vcard,omitempty"`
+}
+
+type Location struct {
+	Longitude float64 `json:"longitude"`
+	Latitude  float64 `json:"latitude"`
+}
+
+type File struct {
+	FileId       string `json:"file_id"`
+	FileUniqueId string `json:"file_unique_id"`
+	FileSize     int32  `json:"file_size,omitempty"`
+	FilePath     string `json:"file_path,omitempty"`
+}
+
+type CallbackQuery struct {
+	Id           string   `json:"id"`
+	From         *User    `json:"from"`
+	Message      *Message `json:"message,omitempty"`
+	ChatInstance string   `json:"chat_instance"`
+	Data         string   `json:"data,omitempty"`
+}
+
+type InlineKeyboardMarkup struct {
+	InlineKeyboard [][]*InlineKeyboardButton `json:"inline_keyboard"`
+}
+
+type InlineKeyboardButton struct {
+	Text         string `json:"text"`
+	Url          string `json:"url,omitempty"`
+	CallbackData string `json:"callback_data,omitempty"`
+}
+
+type KeyboardButton struct {
+	Text string `json:"text"`
+}
+
+// ReplyMarkup is one of InlineKeyboardMarkup, ReplyKeyboardMarkup, ReplyKeyboardRemove or ForceReply.
+type ReplyMarkup struct {
+	InlineKeyboard        [][]*InlineKeyboardButton `json:"inline_keyboard,omitempty"`
+	Keyboard              [][]*KeyboardButton       `json:"keyboard,omitempty"`
+	ResizeKeyboard        bool                      `json:"resize_keyboard,omitempty"`
+	OneTimeKeyboard       bool                      `json:"one_time_keyboard,omitempty"`
+	InputFieldPlaceholder string                    `json:"input_field_placeholder,omitempty"`
+	RemoveKeyboard        bool                      `json:"remove_keyboard,omitempty"`
+	ForceReply            bool                      `json:"force_reply,omitempty"`
+	Selective             bool                      `json:"selective,omitempty"`
+}
+
+type BotCommand struct {
+	Command     string `json:"command"`
+	Description string `json:"description"`
+}
+
+type WebhookInfo struct {
+	Url                  string   `json:"url"`
+	HasCustomCertificate bool     `json:"has_custom_certificate"`
+	PendingUpdateCount   int      `json:"pending_update_count"`
+	IpAddress            string   `json:"ip_address,omitempty"`
+	LastErrorDate        int64    `json:"last_error_date,omitempty"`
+	LastErrorMessage     string   `json:"last_error_message,omitempty"`
+	MaxConnections       int      `json:"max_connections,omitempty"`
+	AllowedUpdates       []string `json:"allowed_updates,omitempty"`
+}
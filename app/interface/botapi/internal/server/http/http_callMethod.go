@@ -0,0 +1,188 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package http
+
+import (
+	"encoding/json"
+	"mime"
+	"net/http"
+	"strings"
+
+	"github.com/teamgram/teamgram-server/app/interface/botapi/internal/core"
+	"github.com/teamgram/teamgram-server/app/interface/botapi/internal/model"
+	"github.com/teamgram/teamgram-server/app/interface/botapi/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/rest/httpx"
+)
+
+const (
+	maxMemory = 32 << 20 // 32MB
+)
+
+type methodHandler func(c *core.BotApiCore, in *model.Params) (interface{}, error)
+
+// methods the names are case-insensitive
+var methods = map[string]methodHandler{
+	"getme": func(c *core.BotApiCore, in *model.Params) (interface{}, error) {
+		return c.GetMe(in)
+	},
+	"getupdates": func(c *core.BotApiCore, in *model.Params) (interface{}, error) {
+		return c.GetUpdates(in)
+	},
+	"setwebhook": func(c *core.BotApiCore, in *model.Params) (interface{}, error) {
+		return c.SetWebhook(in)
+	},
+	"deletewebhook": func(c *core.BotApiCore, in *model.Params) (interface{}, error) {
+		return c.DeleteWebhook(in)
+	},
+	"getwebhookinfo": func(c *core.BotApiCore, in *model.Params) (interface{}, error) {
+		return c.GetWebhookInfo(in)
+	},
+	"sendmessage": func(c *core.BotApiCore, in *model.Params) (interface{}, error) {
+		return c.SendMessage(in)
+	},
+	"sendphoto": func(c *core.BotApiCore, in *model.Params) (interface{}, error) {
+		return c.SendPhoto(in)
+	},
+	"senddocument": func(c *core.BotApiCore, in *model.Params) (interface{}, error) {
+		return c.SendDocument(in)
+	},
+	"editmessagetext": func(c *core.BotApiCore, in *model.Params) (interface{}, error) {
+		return c.EditMessageText(in)
+	},
+	"editmessagereplymarkup": func(c *core.BotApiCore, in *model.Params) (interface{}, error) {
+		return c.EditMessageReplyMarkup(in)
+	},
+	"deletemessage": func(c *core.BotApiCore, in *model.Params) (interface{}, error) {
+		return c.DeleteMessage(in)
+	},
+	"answercallbackquery": func(c *core.BotApiCore, in *model.Params) (interface{}, error) {
+		return c.AnswerCallbackQuery(in)
+	},
+	"setmycommands": func(c *core.BotApiCore, in *model.Params) (interface{}, error) {
+		return c.SetMyCommands(in)
+	},
+	"getmycommands": func(c *core.BotApiCore, in *model.Params) (interface{}, error) {
+		return c.GetMyCommands(in)
+	},
+	"deletemycommands": func(c *core.BotApiCore, in *model.Params) (interface{}, error) {
+		return c.DeleteMyCommands(in)
+	},
+	"getfile": func(c *core.BotApiCore, in *model.Params) (interface{}, error) {
+		return c.GetFile(in)
+	},
+}
+
+func callMethod(ctx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var (
+			req struct {
+				Token  string `path:"token"`
+				Method string `path:"method"`
+			}
+		)
+
+		if err := httpx.ParsePath(r, &req); err != nil || !strings.HasPrefix(req.Token, "bot") {
+			writeError(w, model.ErrNotFound)
+			return
+		}
+
+		handler, ok := methods[strings.ToLower(req.Method)]
+		if !ok {
+			writeError(w, model.ErrNotFound)
+			return
+		}
+
+		c := core.New(r.Context(), ctx)
+		if err := c.Authorize(strings.TrimPrefix(req.Token, "bot")); err != nil {
+			writeError(w, err)
+			return
+		}
+
+		in, err := parseParams(r)
+		if err != nil {
+			logx.WithContext(r.Context()).Errorf("botapi.%s - error: %v", req.Method, err)
+			writeError(w, err)
+			return
+		}
+
+		result, err := handler(c, in)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+
+		httpx.WriteJson(w, http.StatusOK, model.MakeOkResponse(result))
+	}
+}
+
+// parseParams the parameters are passed in the query string, application/x-www-form-urlencoded,
+// application/json or multipart/form-data (the only way to upload files).
+func parseParams(r *http.Request) (*model.Params, error) {
+	in := model.NewParams()
+
+	for k, v := range r.URL.Query() {
+		in.Set(k, v[0])
+	}
+	if r.Method != http.MethodPost {
+		return in, nil
+	}
+
+	contentType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	switch contentType {
+	case "application/json":
+		var values map[string]json.RawMessage
+		if err := json.NewDecoder(r.Body).Decode(&values); err != nil {
+			return nil, model.NewBadRequest("can't parse JSON request body")
+		}
+		for k, v := range values {
+			var s string
+			if err := json.Unmarshal(v, &s); err == nil {
+				in.Set(k, s)
+			} else {
+				in.Set(k, string(v))
+			}
+		}
+	case "multipart/form-data":
+		if err := r.ParseMultipartForm(maxMemory); err != nil {
+			return nil, model.NewBadRequest("can't parse multipart/form-data request body")
+		}
+		for k, v := range r.MultipartForm.Value {
+			in.Set(k, v[0])
+		}
+		for k, v := range r.MultipartForm.File {
+			in.SetFile(k, v[0])
+		}
+	case "application/x-www-form-urlencoded":
+		if err := r.ParseForm(); err != nil {
+			return nil, model.NewBadRequest("can't parse form request body")
+		}
+		for k, v := range r.PostForm {
+			in.Set(k, v[0])
+		}
+	}
+
+	return in, nil
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	e := model.FromError(err)
+	httpx.WriteJson(w, e.Code, model.MakeErrorResponse(e))
+}
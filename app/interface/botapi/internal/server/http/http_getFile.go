@@ -0,0 +1,67 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package http
+
+import (
+	"bytes"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/teamgram/teamgram-server/app/interface/botapi/internal/core"
+	"github.com/teamgram/teamgram-server/app/interface/botapi/internal/model"
+	"github.com/teamgram/teamgram-server/app/interface/botapi/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/rest/httpx"
+)
+
+// getFile downloads the file_path of getFile from dfs.
+func getFile(ctx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var (
+			req struct {
+				Token string `path:"token"`
+				Dir   string `path:"dir"`
+				File  string `path:"file"`
+			}
+		)
+
+		if err := httpx.ParsePath(r, &req); err != nil || !strings.HasPrefix(req.Token, "bot") {
+			writeError(w, model.ErrNotFound)
+			return
+		}
+
+		c := core.New(r.Context(), ctx)
+		if err := c.Authorize(strings.TrimPrefix(req.Token, "bot")); err != nil {
+			writeError(w, err)
+			return
+		}
+
+		// the file is at most 20MB, so it's buffered to reply an error if dfs fails
+		buf := new(bytes.Buffer)
+		if err := c.DownloadFile(req.Dir+"/"+req.File, buf); err != nil {
+			logx.WithContext(r.Context()).Errorf("getFile - error: %v", err)
+			writeError(w, err)
+			return
+		}
+
+		http.ServeContent(w, r, req.File, time.Time{}, bytes.NewReader(buf.Bytes()))
+	}
+}
@@ -0,0 +1,61 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package mq
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	kafka "github.com/teamgram/marmota/pkg/mq"
+	"github.com/teamgram/teamgram-server/app/interface/botapi/internal/core"
+	"github.com/teamgram/teamgram-server/app/interface/botapi/internal/svc"
+	"github.com/teamgram/teamgram-server/app/messenger/sync/sync"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// New new a mq consumer, sync forwards sync.pushBotUpdates to the BotsClient topic.
+func New(svcCtx *svc.ServiceContext, conf kafka.KafkaConsumerConf) *kafka.ConsumerGroup {
+	s := kafka.MustKafkaConsumer(&conf)
+	s.RegisterHandlers(
+		conf.Topics[0],
+		func(ctx context.Context, key string, value []byte) {
+			logx.WithContext(ctx).Infof("key: %s, value: %s", key, value)
+
+			switch key {
+			case proto.MessageName((*sync.TLSyncPushBotUpdates)(nil)):
+				c := core.New(ctx, svcCtx)
+
+				r := new(sync.TLSyncPushBotUpdates)
+				if err := json.Unmarshal(value, r); err != nil {
+					c.Logger.Error(err.Error())
+					return
+				}
+				c.Logger.Infof("sync.pushBotUpdates - request: %s", r.DebugString())
+
+				c.OnBotUpdates(r.UserId, r.Updates)
+			default:
+				err := fmt.Errorf("invalid key: %s", key)
+				logx.Error(err.Error())
+			}
+		})
+	return s
+}
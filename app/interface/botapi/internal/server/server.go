@@ -0,0 +1,102 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package server
+
+import (
+	"context"
+	"flag"
+	"time"
+
+	kafka "github.com/teamgram/marmota/pkg/mq"
+	"github.com/teamgram/teamgram-server/app/interface/botapi/internal/config"
+	"github.com/teamgram/teamgram-server/app/interface/botapi/internal/core"
+	"github.com/teamgram/teamgram-server/app/interface/botapi/internal/server/http"
+	"github.com/teamgram/teamgram-server/app/interface/botapi/internal/server/mq"
+	"github.com/teamgram/teamgram-server/app/interface/botapi/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/conf"
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/threading"
+	"github.com/zeromicro/go-zero/rest"
+)
+
+var configFile = flag.String("f", "etc/botapi.yaml", "the config file")
+
+type Server struct {
+	httpSrv *rest.Server
+	mq      *kafka.ConsumerGroup
+	done    chan struct{}
+}
+
+func New() *Server {
+	return &Server{
+		done: make(chan struct{}),
+	}
+}
+
+func (s *Server) Initialize() error {
+	var c config.Config
+	conf.MustLoad(*configFile, &c)
+
+	logx.Infov(c)
+	ctx := svc.NewServiceContext(c)
+
+	s.httpSrv = http.New(ctx, c.RestConf)
+
+	s.mq = mq.New(ctx, c.BotApiConsumer)
+	go s.mq.Start()
+
+	go s.retryWebhooks(ctx, c.Webhook.RetryInterval)
+
+	return nil
+}
+
+func (s *Server) RunLoop() {
+}
+
+func (s *Server) Destroy() {
+	close(s.done)
+	s.mq.Stop()
+	s.httpSrv.Stop()
+}
+
+// retryWebhooks redelivers the pending updates of the failed webhooks.
+func (s *Server) retryWebhooks(ctx *svc.ServiceContext, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.done:
+			return
+		case <-ticker.C:
+			idList, err := ctx.Dao.GetWebhookBotIdList(context.Background())
+			if err != nil {
+				logx.Errorf("retryWebhooks - error: %v", err)
+				continue
+			}
+			for _, id := range idList {
+				botId := id
+				threading.GoSafe(func() {
+					core.DeliverWebhookUpdates(ctx, botId)
+				})
+			}
+		}
+	}
+}
@@ -0,0 +1,229 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"syscall"
+	"time"
+)
+
+const (
+	// SecretTokenHeader carries the secret_token of setWebhook in every webhook request
+	SecretTokenHeader = "X-Telegram-Bot-Api-Secret-Token"
+)
+
+var (
+	// AllowedPorts the webhooks are only sent to the ports allowed by the telegram bot api
+	AllowedPorts = []int{443, 80, 88, 8443}
+
+	ErrPortNotAllowed    = errors.New("webhook can be set up only on ports 80, 88, 443 or 8443")
+	ErrAddressNotAllowed = errors.New("webhook address is not allowed")
+	ErrRedirect          = errors.New("webhook redirects are not allowed")
+
+	// carrier-grade nat, not covered by net.IP.IsPrivate
+	sharedAddressSpace = &net.IPNet{IP: net.IPv4(100, 64, 0, 0), Mask: net.CIDRMask(10, 32)}
+)
+
+type WebhookConf struct {
+	Timeout time.Duration `json:",default=10s"`
+	// RetryInterval the pending updates of the failed webhooks are redelivered every RetryInterval
+	RetryInterval time.Duration `json:",default=10s"`
+	// AllowHttp accepts http:// webhook urls, only for the local tests
+	AllowHttp bool `json:",optional"`
+	// AllowPrivate accepts the loopback and the private addresses on any port, only for the local tests
+	AllowPrivate bool `json:",optional"`
+}
+
+// isPublicIP reports whether ip may be the address of a webhook,
+// the webhooks must not reach the services on the internal network.
+func isPublicIP(ip net.IP) bool {
+	if ip == nil ||
+		ip.IsUnspecified() ||
+		ip.IsLoopback() ||
+		ip.IsPrivate() ||
+		ip.IsLinkLocalUnicast() ||
+		ip.IsLinkLocalMulticast() ||
+		ip.IsInterfaceLocalMulticast() ||
+		ip.IsMulticast() ||
+		sharedAddressSpace.Contains(ip) {
+		return false
+	}
+	if ip4 := ip.To4(); ip4 != nil && ip4.Equal(net.IPv4bcast) {
+		return false
+	}
+	return true
+}
+
+func isAllowedPort(port int) bool {
+	for _, p := range AllowedPorts {
+		if p == port {
+			return true
+		}
+	}
+	return false
+}
+
+func urlPort(u *url.URL) (int, error) {
+	if p := u.Port(); p != "" {
+		return strconv.Atoi(p)
+	}
+	if u.Scheme == "http" {
+		return 80, nil
+	}
+	return 443, nil
+}
+
+// CheckUrl checks the port of the webhook url and the addresses of its host,
+// ipAddress is used instead of resolving the host if it isn't empty.
+func CheckUrl(ctx context.Context, c WebhookConf, u *url.URL, ipAddress string) error {
+	if c.AllowPrivate {
+		return nil
+	}
+
+	if port, err := urlPort(u); err != nil || !isAllowedPort(port) {
+		return ErrPortNotAllowed
+	}
+
+	if ipAddress != "" {
+		if !isPublicIP(net.ParseIP(ipAddress)) {
+			return ErrAddressNotAllowed
+		}
+		return nil
+	}
+
+	host := u.Hostname()
+	if ip := net.ParseIP(host); ip != nil {
+		if !isPublicIP(ip) {
+			return ErrAddressNotAllowed
+		}
+		return nil
+	}
+
+	addrs, err := net.DefaultResolver.LookupIPAddr(ctx, host)
+	if err != nil || len(addrs) == 0 {
+		return fmt.Errorf("failed to resolve host: %s", host)
+	}
+	for _, addr := range addrs {
+		if !isPublicIP(addr.IP) {
+			return ErrAddressNotAllowed
+		}
+	}
+
+	return nil
+}
+
+type ipAddressKey struct{}
+
+// Sender posts the updates as json to the webhook url of a bot,
+// the update is delivered only if the webhook replies 2xx.
+//
+// The address is checked again when the connection is made, the host may resolve to
+// another address after setWebhook, and the redirects are refused.
+type Sender struct {
+	cli *http.Client
+}
+
+func NewSender(c WebhookConf) *Sender {
+	dialer := &net.Dialer{
+		Timeout: c.Timeout,
+	}
+	if !c.AllowPrivate {
+		dialer.Control = func(network, address string, _ syscall.RawConn) error {
+			host, port, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			if p, _ := strconv.Atoi(port); !isAllowedPort(p) {
+				return ErrPortNotAllowed
+			}
+			if !isPublicIP(net.ParseIP(host)) {
+				return ErrAddressNotAllowed
+			}
+			return nil
+		}
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = func(ctx context.Context, network, address string) (net.Conn, error) {
+		// the ip_address of setWebhook is used instead of resolving the host
+		if ipAddress, _ := ctx.Value(ipAddressKey{}).(string); ipAddress != "" {
+			_, port, err := net.SplitHostPort(address)
+			if err != nil {
+				return nil, err
+			}
+			address = net.JoinHostPort(ipAddress, port)
+		}
+		return dialer.DialContext(ctx, network, address)
+	}
+
+	return &Sender{
+		cli: &http.Client{
+			Transport: transport,
+			Timeout:   c.Timeout,
+			CheckRedirect: func(req *http.Request, via []*http.Request) error {
+				return ErrRedirect
+			},
+		},
+	}
+}
+
+// Send posts update to url, the connection is made to ipAddress if it isn't empty.
+func (m *Sender) Send(ctx context.Context, url, ipAddress, secretToken string, update interface{}) error {
+	body, err := json.Marshal(update)
+	if err != nil {
+		return err
+	}
+
+	if ipAddress != "" {
+		ctx = context.WithValue(ctx, ipAddressKey{}, ipAddress)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if secretToken != "" {
+		req.Header.Set(SecretTokenHeader, secretToken)
+	}
+
+	resp, err := m.cli.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	io.Copy(ioutil.Discard, resp.Body)
+
+	if resp.StatusCode/100 != 2 {
+		return fmt.Errorf("wrong response from the webhook: %s", resp.Status)
+	}
+
+	return nil
+}
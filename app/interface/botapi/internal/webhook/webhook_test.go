@@ -0,0 +1,165 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestSend(t *testing.T) {
+	type update struct {
+		UpdateId int64 `json:"update_id"`
+	}
+
+	var (
+		received    []update
+		secretToken string
+	)
+
+	// the local webhook receiver
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var u update
+		if err := json.NewDecoder(r.Body).Decode(&u); err != nil {
+			t.Errorf("decode update - error: %v", err)
+		}
+		secretToken = r.Header.Get(SecretTokenHeader)
+		if u.UpdateId == 3 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		received = append(received, u)
+	}))
+	defer srv.Close()
+
+	sender := NewSender(WebhookConf{Timeout: time.Second, AllowPrivate: true})
+
+	if err := sender.Send(context.Background(), srv.URL, "", "secret_1-A", &update{UpdateId: 1}); err != nil {
+		t.Fatalf("Send(1) - error: %v", err)
+	} else if secretToken != "secret_1-A" {
+		t.Errorf("secret token = %q, want %q", secretToken, "secret_1-A")
+	}
+
+	if err := sender.Send(context.Background(), srv.URL, "", "", &update{UpdateId: 2}); err != nil {
+		t.Fatalf("Send(2) - error: %v", err)
+	} else if secretToken != "" {
+		t.Errorf("secret token = %q, want empty", secretToken)
+	}
+
+	if err := sender.Send(context.Background(), srv.URL, "", "", &update{UpdateId: 3}); err == nil {
+		t.Errorf("Send(3) to a failed webhook - want error")
+	}
+
+	if len(received) != 2 || received[0].UpdateId != 1 || received[1].UpdateId != 2 {
+		t.Errorf("received = %v, want [{1} {2}]", received)
+	}
+}
+
+func TestSendRefused(t *testing.T) {
+	var hits int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits++
+		if r.URL.Path == "/redirect" {
+			http.Redirect(w, r, "/", http.StatusFound)
+		}
+	}))
+	defer srv.Close()
+
+	// the loopback address and the random port of the test server are refused at dial time
+	sender := NewSender(WebhookConf{Timeout: time.Second})
+	if err := sender.Send(context.Background(), srv.URL, "", "", 1); err == nil {
+		t.Errorf("Send to loopback - want error")
+	}
+	if err := sender.Send(context.Background(), "http://127.0.0.1:443/", "", "", 1); !errors.Is(err, ErrAddressNotAllowed) {
+		t.Errorf("Send to 127.0.0.1:443 - error = %v, want %v", err, ErrAddressNotAllowed)
+	}
+	if hits != 0 {
+		t.Fatalf("hits = %d, want 0", hits)
+	}
+
+	// the redirects are not followed
+	sender = NewSender(WebhookConf{Timeout: time.Second, AllowPrivate: true})
+	if err := sender.Send(context.Background(), srv.URL+"/redirect", "", "", 1); err == nil || !strings.Contains(err.Error(), ErrRedirect.Error()) {
+		t.Errorf("Send to redirect - error = %v, want %v", err, ErrRedirect)
+	}
+	if hits != 1 {
+		t.Errorf("hits = %d, want 1", hits)
+	}
+}
+
+func TestSendIpAddress(t *testing.T) {
+	var host string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		host = r.Host
+	}))
+	defer srv.Close()
+
+	_, port, _ := net.SplitHostPort(strings.TrimPrefix(srv.URL, "http://"))
+	sender := NewSender(WebhookConf{Timeout: time.Second, AllowPrivate: true})
+
+	// the host isn't resolved, the connection is made to ip_address
+	if err := sender.Send(context.Background(), "http://webhook.invalid:"+port+"/", "127.0.0.1", "", 1); err != nil {
+		t.Fatalf("Send - error: %v", err)
+	}
+	if host != "webhook.invalid:"+port {
+		t.Errorf("host = %q, want %q", host, "webhook.invalid:"+port)
+	}
+}
+
+func TestCheckUrl(t *testing.T) {
+	c := WebhookConf{}
+	for _, tt := range []struct {
+		url       string
+		ipAddress string
+		want      error
+	}{
+		{"https://1.1.1.1/hook", "", nil},
+		{"https://1.1.1.1:8443/hook", "", nil},
+		{"https://1.1.1.1:8080/hook", "", ErrPortNotAllowed},
+		{"https://127.0.0.1/hook", "", ErrAddressNotAllowed},
+		{"https://10.0.0.1/hook", "", ErrAddressNotAllowed},
+		{"https://192.168.1.1/hook", "", ErrAddressNotAllowed},
+		{"https://169.254.169.254/latest", "", ErrAddressNotAllowed},
+		{"https://100.64.0.1/hook", "", ErrAddressNotAllowed},
+		{"https://[::1]/hook", "", ErrAddressNotAllowed},
+		{"https://[fe80::1]/hook", "", ErrAddressNotAllowed},
+		{"https://0.0.0.0/hook", "", ErrAddressNotAllowed},
+		{"https://localhost/hook", "", ErrAddressNotAllowed},
+		{"https://example.invalid/hook", "1.1.1.1", nil},
+		{"https://example.invalid/hook", "10.1.1.1", ErrAddressNotAllowed},
+	} {
+		u, _ := url.Parse(tt.url)
+		if err := CheckUrl(context.Background(), c, u, tt.ipAddress); err != tt.want {
+			t.Errorf("CheckUrl(%s, %q) = %v, want %v", tt.url, tt.ipAddress, err, tt.want)
+		}
+	}
+
+	u, _ := url.Parse("http://127.0.0.1:8080/hook")
+	if err := CheckUrl(context.Background(), WebhookConf{AllowPrivate: true}, u, ""); err != nil {
+		t.Errorf("CheckUrl with AllowPrivate = %v, want nil", err)
+	}
+}
@@ -0,0 +1,609 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cdn.tl.proto
+
+package cdn
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/types"
+	mtproto "github.com/teamgram/proto/mtproto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	reflect "reflect"
+	strings "strings"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type TLConstructor int32
+
+const (
+	CRC32_UNKNOWN               TLConstructor = 0
+	CRC32_cdn_uploadCdnFilePart TLConstructor = -1208229953
+)
+
+var TLConstructor_name = map[int32]string{
+	0:           "CRC32_UNKNOWN",
+	-1208229953: "CRC32_cdn_uploadCdnFilePart",
+}
+
+var TLConstructor_value = map[string]int32{
+	"CRC32_UNKNOWN":               0,
+	"CRC32_cdn_uploadCdnFilePart": -1208229953,
+}
+
+func (x TLConstructor) String() string {
+	return proto.EnumName(TLConstructor_name, int32(x))
+}
+
+func (TLConstructor) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_b09a8edbf202f2a6, []int{0}
+}
+
+//--------------------------------------------------------------------------------------------
+// cdn.uploadCdnFilePart file_token:bytes offset:int bytes:bytes = Bool;
+type TLCdnUploadCdnFilePart struct {
+	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=cdn.TLConstructor" json:"constructor,omitempty"`
+	FileToken            []byte        `protobuf:"bytes,3,opt,name=file_token,json=fileToken,proto3" json:"file_token,omitempty"`
+	Offset               int32         `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	Bytes                []byte        `protobuf:"bytes,5,opt,name=bytes,proto3" json:"bytes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *TLCdnUploadCdnFilePart) Reset()         { *m = TLCdnUploadCdnFilePart{} }
+func (m *TLCdnUploadCdnFilePart) String() string { return proto.CompactTextString(m) }
+func (*TLCdnUploadCdnFilePart) ProtoMessage()    {}
+func (*TLCdnUploadCdnFilePart) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b09a8edbf202f2a6, []int{0}
+}
+func (m *TLCdnUploadCdnFilePart) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLCdnUploadCdnFilePart) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLCdnUploadCdnFilePart.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TLCdnUploadCdnFilePart) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLCdnUploadCdnFilePart.Merge(m, src)
+}
+func (m *TLCdnUploadCdnFilePart) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLCdnUploadCdnFilePart) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLCdnUploadCdnFilePart.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLCdnUploadCdnFilePart proto.InternalMessageInfo
+
+func (m *TLCdnUploadCdnFilePart) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
+	}
+	return CRC32_UNKNOWN
+}
+
+func (m *TLCdnUploadCdnFilePart) GetFileToken() []byte {
+	if m != nil {
+		return m.FileToken
+	}
+	return nil
+}
+
+func (m *TLCdnUploadCdnFilePart) GetOffset() int32 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *TLCdnUploadCdnFilePart) GetBytes() []byte {
+	if m != nil {
+		return m.Bytes
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("cdn.TLConstructor", TLConstructor_name, TLConstructor_value)
+	proto.RegisterType((*TLCdnUploadCdnFilePart)(nil), "cdn.TL_cdn_uploadCdnFilePart")
+}
+
+func init() { proto.RegisterFile("cdn.tl.proto", fileDescriptor_b09a8edbf202f2a6) }
+
+var fileDescriptor_b09a8edbf202f2a6 = []byte{
+	// 395 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0x4f, 0x8b, 0xd3, 0x40,
+	0x18, 0xc6, 0x3b, 0xec, 0xb6, 0xe0, 0xb8, 0x95, 0x75, 0x58, 0x25, 0x46, 0x36, 0x94, 0x3d, 0x05,
+	0x61, 0x13, 0xe8, 0x7a, 0xf1, 0xba, 0x01, 0x3d, 0x6c, 0xa9, 0x35, 0x54, 0x04, 0x2f, 0x61, 0x32,
+	0x99, 0xa4, 0xc1, 0x64, 0xde, 0x61, 0x66, 0xa2, 0xf4, 0x1b, 0xf8, 0x25, 0x3c, 0xf9, 0x41, 0x7a,
+	0xf5, 0xe8, 0x47, 0xd0, 0x7e, 0x01, 0xbd, 0x7b, 0x88, 0xe4, 0x8f, 0xa4, 0x42, 0x0f, 0x81, 0xf7,
+	0x79, 0x7e, 0xef, 0x1f, 0x9e, 0x0c, 0x3e, 0x63, 0x89, 0xf0, 0x4c, 0xe1, 0x49, 0x05, 0x06, 0xc8,
+	0x09, 0x4b, 0x84, 0x7d, 0x9d, 0xe5, 0x66, 0x53, 0xc5, 0x1e, 0x83, 0xd2, 0xcf, 0x20, 0x03, 0xbf,
+	0x65, 0x71, 0x95, 0xb6, 0xaa, 0x15, 0x6d, 0xd5, 0xcd, 0xd8, 0x4e, 0x06, 0x90, 0x15, 0x7c, 0xe8,
+	0xfa, 0xa4, 0xa8, 0x94, 0x5c, 0xe9, 0x9e, 0xdb, 0x9a, 0x6d, 0x78, 0x49, 0x9b, 0x23, 0x0c, 0x14,
+	0x8f, 0xcc, 0x56, 0xf2, 0x7f, 0xec, 0xc9, 0xc0, 0x8c, 0xa2, 0x42, 0x4b, 0x50, 0xa6, 0x47, 0x17,
+	0x03, 0xd2, 0x5b, 0xc1, 0x3a, 0xf7, 0xea, 0x0b, 0xc2, 0xd6, 0x7a, 0x11, 0xb1, 0x44, 0x44, 0x95,
+	0x2c, 0x80, 0x26, 0x41, 0x22, 0x5e, 0xe6, 0x05, 0x5f, 0x51, 0x65, 0xc8, 0x73, 0x7c, 0x9f, 0x81,
+	0xd0, 0x46, 0x55, 0xcc, 0x80, 0xb2, 0xd0, 0x0c, 0xb9, 0x0f, 0xe6, 0xc4, 0x6b, 0x12, 0xae, 0x17,
+	0xc1, 0x40, 0xc2, 0xc3, 0x36, 0x72, 0x89, 0x71, 0x9a, 0x17, 0x3c, 0x32, 0xf0, 0x81, 0x0b, 0xeb,
+	0x64, 0x86, 0xdc, 0xb3, 0xf0, 0x5e, 0xe3, 0xac, 0x1b, 0x83, 0x3c, 0xc6, 0x13, 0x48, 0x53, 0xcd,
+	0x8d, 0x75, 0x3a, 0x43, 0xee, 0x38, 0xec, 0x15, 0xb9, 0xc0, 0xe3, 0x78, 0x6b, 0xb8, 0xb6, 0xc6,
+	0xed, 0x44, 0x27, 0x9e, 0x85, 0x78, 0xfa, 0xdf, 0x29, 0xf2, 0x10, 0x4f, 0x83, 0x30, 0xb8, 0x99,
+	0x47, 0x6f, 0x97, 0x77, 0xcb, 0xd7, 0xef, 0x96, 0xe7, 0x23, 0xe2, 0xe2, 0xa7, 0x9d, 0x75, 0x34,
+	0xc5, 0xf9, 0x6e, 0xf7, 0x6b, 0xf7, 0xa7, 0xae, 0xeb, 0x1a, 0xd9, 0xa7, 0x9f, 0xbf, 0x3a, 0xa3,
+	0xf9, 0x1b, 0x3c, 0x09, 0x57, 0x41, 0x90, 0x08, 0xf2, 0x0a, 0x3f, 0x3a, 0x9e, 0xfc, 0xb2, 0x0f,
+	0x79, 0x7c, 0xa5, 0x3d, 0xf5, 0x4a, 0xd3, 0xfe, 0x3f, 0xef, 0x16, 0xa0, 0xb8, 0x1a, 0xdd, 0xde,
+	0xfd, 0xfe, 0xe9, 0xa0, 0x6f, 0x7b, 0x07, 0x7d, 0xdf, 0x3b, 0xe8, 0xc7, 0xde, 0x41, 0xef, 0x5f,
+	0x1c, 0x3c, 0xba, 0xe1, 0xb4, 0xcc, 0x14, 0x1d, 0x8a, 0x6b, 0xcd, 0xd5, 0x47, 0xae, 0x7c, 0x2a,
+	0xa5, 0x9f, 0x0b, 0xc3, 0x55, 0x4a, 0x19, 0xf7, 0x59, 0x22, 0x9a, 0x2f, 0x9e, 0xb4, 0xab, 0x6f,
+	0xfe, 0x0e, 0x00, 0xc7, 0x5b, 0xad, 0xb1, 0x4b, 0x02, 0x00, 0x00,
+}
+
+func (this *TLCdnUploadCdnFilePart) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&cdn.TLCdnUploadCdnFilePart{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "FileToken: "+fmt.Sprintf("%#v", this.FileToken)+",\n")
+	s = append(s, "Offset: "+fmt.Sprintf("%#v", this.Offset)+",\n")
+	s = append(s, "Bytes: "+fmt.Sprintf("%#v", this.Bytes)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringCdnTl(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// RPCCdnClient is the client API for RPCCdn service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type RPCCdnClient interface {
+	// cdn.uploadCdnFilePart file_token:bytes offset:int bytes:bytes = Bool;
+	CdnUploadCdnFilePart(ctx context.Context, in *TLCdnUploadCdnFilePart, opts ...grpc.CallOption) (*mtproto.Bool, error)
+}
+
+type rPCCdnClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewRPCCdnClient(cc *grpc.ClientConn) RPCCdnClient {
+	return &rPCCdnClient{cc}
+}
+
+func (c *rPCCdnClient) CdnUploadCdnFilePart(ctx context.Context, in *TLCdnUploadCdnFilePart, opts ...grpc.CallOption) (*mtproto.Bool, error) {
+	out := new(mtproto.Bool)
+	err := c.cc.Invoke(ctx, "/cdn.RPCCdn/cdn_uploadCdnFilePart", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RPCCdnServer is the server API for RPCCdn service.
+type RPCCdnServer interface {
+	// cdn.uploadCdnFilePart file_token:bytes offset:int bytes:bytes = Bool;
+	CdnUploadCdnFilePart(context.Context, *TLCdnUploadCdnFilePart) (*mtproto.Bool, error)
+}
+
+// UnimplementedRPCCdnServer can be embedded to have forward compatible implementations.
+type UnimplementedRPCCdnServer struct {
+}
+
+func (*UnimplementedRPCCdnServer) CdnUploadCdnFilePart(ctx context.Context, req *TLCdnUploadCdnFilePart) (*mtproto.Bool, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CdnUploadCdnFilePart not implemented")
+}
+
+func RegisterRPCCdnServer(s *grpc.Server, srv RPCCdnServer) {
+	s.RegisterService(&_RPCCdn_serviceDesc, srv)
+}
+
+func _RPCCdn_CdnUploadCdnFilePart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLCdnUploadCdnFilePart)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCCdnServer).CdnUploadCdnFilePart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cdn.RPCCdn/CdnUploadCdnFilePart",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCCdnServer).CdnUploadCdnFilePart(ctx, req.(*TLCdnUploadCdnFilePart))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _RPCCdn_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cdn.RPCCdn",
+	HandlerType: (*RPCCdnServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "cdn_uploadCdnFilePart",
+			Handler:    _RPCCdn_CdnUploadCdnFilePart_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cdn.tl.proto",
+}
+
+func (m *TLCdnUploadCdnFilePart) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLCdnUploadCdnFilePart) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLCdnUploadCdnFilePart) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Bytes) > 0 {
+		i -= len(m.Bytes)
+		copy(dAtA[i:], m.Bytes)
+		i = encodeVarintCdnTl(dAtA, i, uint64(len(m.Bytes)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Offset != 0 {
+		i = encodeVarintCdnTl(dAtA, i, uint64(m.Offset))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.FileToken) > 0 {
+		i -= len(m.FileToken)
+		copy(dAtA[i:], m.FileToken)
+		i = encodeVarintCdnTl(dAtA, i, uint64(len(m.FileToken)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintCdnTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintCdnTl(dAtA []byte, offset int, v uint64) int {
+	offset -= sovCdnTl(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *TLCdnUploadCdnFilePart) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovCdnTl(uint64(m.Constructor))
+	}
+	l = len(m.FileToken)
+	if l > 0 {
+		n += 1 + l + sovCdnTl(uint64(l))
+	}
+	if m.Offset != 0 {
+		n += 1 + sovCdnTl(uint64(m.Offset))
+	}
+	l = len(m.Bytes)
+	if l > 0 {
+		n += 1 + l + sovCdnTl(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovCdnTl(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozCdnTl(x uint64) (n int) {
+	return sovCdnTl(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *TLCdnUploadCdnFilePart) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCdnTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_cdn_uploadCdnFilePart: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_cdn_uploadCdnFilePart: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCdnTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FileToken", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCdnTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCdnTl
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCdnTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FileToken = append(m.FileToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.FileToken == nil {
+				m.FileToken = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			}
+			m.Offset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCdnTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Offset |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bytes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCdnTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCdnTl
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCdnTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bytes = append(m.Bytes[:0], dAtA[iNdEx:postIndex]...)
+			if m.Bytes == nil {
+				m.Bytes = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCdnTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCdnTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipCdnTl(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowCdnTl
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCdnTl
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCdnTl
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthCdnTl
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupCdnTl
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthCdnTl
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthCdnTl        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowCdnTl          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupCdnTl = fmt.Errorf("proto: unexpected end of group")
+)
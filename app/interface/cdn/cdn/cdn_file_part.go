@@ -0,0 +1,82 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package cdn
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"encoding/binary"
+
+	"github.com/teamgram/proto/mtproto"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// CdnFilePartSize the cdn caches and the master dc reuploads 1 MB parts,
+	// upload.getCdnFile never crosses a 1 MB boundary.
+	CdnFilePartSize = 1024 * 1024
+
+	requestTokenLength = 4
+)
+
+var (
+	// ErrFileTokenInvalid the file_token is expired, download from the master dc by upload.getFile
+	ErrFileTokenInvalid = status.Error(mtproto.ErrBadRequest, "FILE_TOKEN_INVALID")
+	// ErrRequestTokenInvalid the request_token is not issued by the cdn
+	ErrRequestTokenInvalid = status.Error(mtproto.ErrBadRequest, "REQUEST_TOKEN_INVALID")
+)
+
+// MakeRequestToken the request_token of upload.cdnFileReuploadNeeded is the offset of the missing part.
+func MakeRequestToken(offset int32) []byte {
+	b := make([]byte, requestTokenLength)
+	binary.BigEndian.PutUint32(b, uint32(offset))
+	return b
+}
+
+// ParseRequestToken returns the offset of the part to reupload.
+func ParseRequestToken(requestToken []byte) (int32, error) {
+	if len(requestToken) != requestTokenLength {
+		return 0, ErrRequestTokenInvalid
+	}
+
+	offset := int32(binary.BigEndian.Uint32(requestToken))
+	if offset < 0 || offset%CdnFilePartSize != 0 {
+		return 0, ErrRequestTokenInvalid
+	}
+
+	return offset, nil
+}
+
+// EncryptCdnFilePart encrypts the bytes at offset by AES-256-CTR, the iv is encryption_iv
+// with the last 4 bytes replaced by offset/16 in big-endian, as done by the clients.
+func EncryptCdnFilePart(key, iv []byte, offset int32, b []byte) ([]byte, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	ctrIv := make([]byte, aes.BlockSize)
+	copy(ctrIv, iv)
+	binary.BigEndian.PutUint32(ctrIv[aes.BlockSize-4:], uint32(offset/16))
+
+	dst := make([]byte, len(b))
+	cipher.NewCTR(block, ctrIv).XORKeyStream(dst, b)
+
+	return dst, nil
+}
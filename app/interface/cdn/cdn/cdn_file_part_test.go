@@ -0,0 +1,67 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package cdn
+
+import (
+	"bytes"
+	"testing"
+)
+
+// go test -v -run=TestEncryptCdnFilePart
+func TestEncryptCdnFilePart(t *testing.T) {
+	var (
+		key  = bytes.Repeat([]byte{1}, 32)
+		iv   = bytes.Repeat([]byte{2}, 16)
+		data = make([]byte, 2*CdnFilePartSize)
+	)
+	for i := range data {
+		data[i] = byte(i)
+	}
+
+	encrypted, err := EncryptCdnFilePart(key, iv, 0, data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the client decrypts every downloaded part by its own offset
+	for _, offset := range []int32{0, 4096, CdnFilePartSize, CdnFilePartSize + 128*1024} {
+		b, err := EncryptCdnFilePart(key, iv, offset, encrypted[offset:offset+4096])
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(b, data[offset:offset+4096]) {
+			t.Fatalf("offset %d: decrypted bytes mismatch", offset)
+		}
+	}
+}
+
+// go test -v -run=TestRequestToken
+func TestRequestToken(t *testing.T) {
+	offset, err := ParseRequestToken(MakeRequestToken(3 * CdnFilePartSize))
+	if err != nil || offset != 3*CdnFilePartSize {
+		t.Fatalf("offset = %d, err = %v", offset, err)
+	}
+
+	if _, err = ParseRequestToken(MakeRequestToken(4096)); err != ErrRequestTokenInvalid {
+		t.Fatalf("unaligned offset: err = %v", err)
+	}
+	if _, err = ParseRequestToken([]byte{1}); err != ErrRequestTokenInvalid {
+		t.Fatalf("short token: err = %v", err)
+	}
+}
@@ -0,0 +1,41 @@
+/*
+ * WARNING! All changes made in this file will be lost!
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2022-present,  Teamgram Authors.
+ *  All rights reserved.
+ *
+ * Author: teagramio (teagram.io@gmail.com)
+ */
+
+package cdn
+
+const (
+	Predicate_cdn_uploadCdnFilePart = "cdn_uploadCdnFilePart"
+)
+
+var clazzNameRegisters2 = map[string]map[int]int32{
+	Predicate_cdn_uploadCdnFilePart: {
+		0: -1208229953, // 0xb7fbdfbf
+
+	},
+}
+
+var clazzIdNameRegisters2 = map[int32]string{
+	-1208229953: Predicate_cdn_uploadCdnFilePart, // 0xb7fbdfbf
+
+}
+
+func GetClazzID(clazzName string, layer int) int32 {
+	if m, ok := clazzNameRegisters2[clazzName]; ok {
+		m2, ok2 := m[layer]
+		if ok2 {
+			return m2
+		}
+		m2, ok2 = m[0]
+		if ok2 {
+			return m2
+		}
+	}
+	return 0
+}
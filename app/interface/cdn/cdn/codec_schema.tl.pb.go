@@ -0,0 +1,111 @@
+/*
+ * WARNING! All changes made in this file will be lost!
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2022-present,  Teamgram Authors.
+ *  All rights reserved.
+ *
+ * Author: teagramio (teagram.io@gmail.com)
+ */
+
+// ConstructorList
+// RequestList
+
+package cdn
+
+import (
+	"fmt"
+
+	"github.com/teamgram/proto/mtproto"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/types"
+)
+
+//////////////////////////////////////////////////////////////////////////////////////////
+
+var _ *types.Int32Value
+var _ *mtproto.Bool
+var _ fmt.GoStringer
+
+var clazzIdRegisters2 = map[int32]func() mtproto.TLObject{
+	// Constructor
+
+	// Method
+	-1208229953: func() mtproto.TLObject { // 0xb7fbdfbf
+		return &TLCdnUploadCdnFilePart{
+			Constructor: -1208229953,
+		}
+	},
+}
+
+func NewTLObjectByClassID(classId int32) mtproto.TLObject {
+	f, ok := clazzIdRegisters2[classId]
+	if !ok {
+		return nil
+	}
+	return f()
+}
+
+func CheckClassID(classId int32) (ok bool) {
+	_, ok = clazzIdRegisters2[classId]
+	return
+}
+
+//----------------------------------------------------------------------------------------------------------------
+
+//----------------------------------------------------------------------------------------------------------------
+// TLCdnUploadCdnFilePart
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLCdnUploadCdnFilePart) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_cdn_uploadCdnFilePart))
+
+	switch uint32(m.Constructor) {
+	case 0xb7fbdfbf:
+		// cdn.uploadCdnFilePart file_token:bytes offset:int bytes:bytes = Bool;
+		x.UInt(0xb7fbdfbf)
+
+		// no flags
+
+		x.StringBytes(m.GetFileToken())
+		x.Int(m.GetOffset())
+		x.StringBytes(m.GetBytes())
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLCdnUploadCdnFilePart) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLCdnUploadCdnFilePart) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0xb7fbdfbf:
+		// cdn.uploadCdnFilePart file_token:bytes offset:int bytes:bytes = Bool;
+
+		// not has flags
+
+		m.FileToken = dBuf.StringBytes()
+		m.Offset = dBuf.Int()
+		m.Bytes = dBuf.StringBytes()
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLCdnUploadCdnFilePart) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+//----------------------------------------------------------------------------------------------------------------
@@ -0,0 +1,43 @@
+/*
+ * WARNING! All changes made in this file will be lost!
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package cdn_client
+
+import (
+	"context"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/interface/cdn/cdn"
+
+	"github.com/zeromicro/go-zero/zrpc"
+)
+
+var _ *mtproto.Bool
+
+type CdnClient interface {
+	CdnUploadCdnFilePart(ctx context.Context, in *cdn.TLCdnUploadCdnFilePart) (*mtproto.Bool, error)
+}
+
+type defaultCdnClient struct {
+	cli zrpc.Client
+}
+
+func NewCdnClient(cli zrpc.Client) CdnClient {
+	return &defaultCdnClient{
+		cli: cli,
+	}
+}
+
+// CdnUploadCdnFilePart
+// cdn.uploadCdnFilePart file_token:bytes offset:int bytes:bytes = Bool;
+func (m *defaultCdnClient) CdnUploadCdnFilePart(ctx context.Context, in *cdn.TLCdnUploadCdnFilePart) (*mtproto.Bool, error) {
+	client := cdn.NewRPCCdnClient(m.cli.Conn())
+	return client.CdnUploadCdnFilePart(ctx, in)
+}
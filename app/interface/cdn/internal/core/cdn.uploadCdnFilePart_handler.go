@@ -0,0 +1,34 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/interface/cdn/cdn"
+)
+
+// CdnUploadCdnFilePart
+// cdn.uploadCdnFilePart file_token:bytes offset:int bytes:bytes = Bool;
+func (c *CdnCore) CdnUploadCdnFilePart(in *cdn.TLCdnUploadCdnFilePart) (*mtproto.Bool, error) {
+	if len(in.GetFileToken()) == 0 {
+		err := cdn.ErrFileTokenInvalid
+		c.Logger.Errorf("cdn.uploadCdnFilePart - error: %v", err)
+		return nil, err
+	}
+	if in.GetOffset() < 0 || in.GetOffset()%cdn.CdnFilePartSize != 0 || len(in.GetBytes()) > cdn.CdnFilePartSize {
+		err := mtproto.ErrOffsetInvalid
+		c.Logger.Errorf("cdn.uploadCdnFilePart - error: %v", err)
+		return nil, err
+	}
+
+	c.svcCtx.Dao.PutCdnFilePart(in.GetFileToken(), in.GetOffset(), in.GetBytes())
+
+	return mtproto.BoolTrue, nil
+}
@@ -0,0 +1,71 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/interface/cdn/cdn"
+)
+
+// UploadGetCdnFile
+// upload.getCdnFile#2000bcc3 file_token:bytes offset:int limit:int = upload.CdnFile;
+func (c *CdnCore) UploadGetCdnFile(in *mtproto.TLUploadGetCdnFile) (*mtproto.Upload_CdnFile, error) {
+	var (
+		fileToken = in.GetFileToken()
+		offset    = in.GetOffset()
+		limit     = in.GetLimit()
+	)
+
+	if len(fileToken) == 0 {
+		err := cdn.ErrFileTokenInvalid
+		c.Logger.Errorf("upload.getCdnFile - error: %v", err)
+		return nil, err
+	}
+
+	// offset and limit must be divisible by 4096, 1048576 must be divisible by limit,
+	// and the part must be in a single 1 MB chunk
+	if offset < 0 || offset%4096 != 0 {
+		err := mtproto.ErrOffsetInvalid
+		c.Logger.Errorf("upload.getCdnFile - error: %v", err)
+		return nil, err
+	}
+	if limit <= 0 || limit%4096 != 0 || cdn.CdnFilePartSize%limit != 0 ||
+		offset/cdn.CdnFilePartSize != (offset+limit-1)/cdn.CdnFilePartSize {
+		err := mtproto.ErrLimitInvalid
+		c.Logger.Errorf("upload.getCdnFile - error: %v", err)
+		return nil, err
+	}
+
+	partOffset := offset - offset%cdn.CdnFilePartSize
+	part, ok := c.svcCtx.Dao.GetCdnFilePart(fileToken, partOffset)
+	if !ok {
+		return mtproto.MakeTLUploadCdnFileReuploadNeeded(&mtproto.Upload_CdnFile{
+			RequestToken: cdn.MakeRequestToken(partOffset),
+		}).To_Upload_CdnFile(), nil
+	}
+
+	var (
+		bytes []byte
+		start = int(offset - partOffset)
+		end   = start + int(limit)
+	)
+	if end > len(part) {
+		end = len(part)
+	}
+	if start < end {
+		bytes = part[start:end]
+	} else {
+		bytes = []byte{}
+	}
+
+	return mtproto.MakeTLUploadCdnFile(&mtproto.Upload_CdnFile{
+		Bytes: bytes,
+	}).To_Upload_CdnFile(), nil
+}
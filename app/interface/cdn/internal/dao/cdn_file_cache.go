@@ -0,0 +1,95 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package dao
+
+import (
+	"container/list"
+	"encoding/hex"
+	"strconv"
+	"sync"
+)
+
+type cdnFilePart struct {
+	key   string
+	bytes []byte
+}
+
+// CdnFileCache keeps the encrypted file parts uploaded by the master dc in memory,
+// the least recently used parts are dropped when the size exceeds maxSize.
+type CdnFileCache struct {
+	mu      sync.Mutex
+	size    int64
+	maxSize int64
+	ll      *list.List
+	parts   map[string]*list.Element
+}
+
+func NewCdnFileCache(maxSize int64) *CdnFileCache {
+	return &CdnFileCache{
+		maxSize: maxSize,
+		ll:      list.New(),
+		parts:   make(map[string]*list.Element),
+	}
+}
+
+func getCdnFilePartKey(fileToken []byte, offset int32) string {
+	return hex.EncodeToString(fileToken) + "_" + strconv.Itoa(int(offset))
+}
+
+// GetCdnFilePart returns the encrypted part of fileToken at offset, ok is false if the part is not cached.
+func (c *CdnFileCache) GetCdnFilePart(fileToken []byte, offset int32) (bytes []byte, ok bool) {
+	key := getCdnFilePartKey(fileToken, offset)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e, ok := c.parts[key]
+	if !ok {
+		return nil, false
+	}
+	c.ll.MoveToFront(e)
+
+	return e.Value.(*cdnFilePart).bytes, true
+}
+
+// PutCdnFilePart caches the encrypted part of fileToken at offset, an empty part is the end of the file.
+func (c *CdnFileCache) PutCdnFilePart(fileToken []byte, offset int32, bytes []byte) {
+	key := getCdnFilePartKey(fileToken, offset)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if e, ok := c.parts[key]; ok {
+		part := e.Value.(*cdnFilePart)
+		c.size += int64(len(bytes) - len(part.bytes))
+		part.bytes = bytes
+		c.ll.MoveToFront(e)
+	} else {
+		c.parts[key] = c.ll.PushFront(&cdnFilePart{key: key, bytes: bytes})
+		c.size += int64(len(bytes))
+	}
+
+	for c.size > c.maxSize && c.ll.Len() > 1 {
+		e := c.ll.Back()
+		part := e.Value.(*cdnFilePart)
+		c.ll.Remove(e)
+		delete(c.parts, part.key)
+		c.size -= int64(len(part.bytes))
+	}
+}
@@ -0,0 +1,67 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package dao
+
+import (
+	"bytes"
+	"testing"
+)
+
+// go test -v -run=TestCdnFileCache
+func TestCdnFileCache(t *testing.T) {
+	var (
+		c     = NewCdnFileCache(3)
+		token = []byte{1, 2, 3}
+	)
+
+	c.PutCdnFilePart(token, 0, []byte{1})
+	c.PutCdnFilePart(token, 1, []byte{2})
+	c.PutCdnFilePart(token, 2, []byte{})
+
+	if b, ok := c.GetCdnFilePart(token, 0); !ok || !bytes.Equal(b, []byte{1}) {
+		t.Fatalf("part 0: %v, %v", b, ok)
+	}
+	if b, ok := c.GetCdnFilePart(token, 2); !ok || len(b) != 0 {
+		t.Fatalf("empty part 2: %v, %v", b, ok)
+	}
+
+	// part 1 is the least recently used
+	c.PutCdnFilePart(token, 3, []byte{3, 3})
+	if _, ok := c.GetCdnFilePart(token, 1); ok {
+		t.Fatal("part 1 not dropped")
+	}
+	if _, ok := c.GetCdnFilePart(token, 0); !ok {
+		t.Fatal("part 0 dropped")
+	}
+
+	// part 2 is the least recently used now
+	c.PutCdnFilePart(token, 4, []byte{4})
+	if _, ok := c.GetCdnFilePart(token, 2); ok {
+		t.Fatal("part 2 not dropped")
+	}
+	if _, ok := c.GetCdnFilePart(token, 3); ok {
+		t.Fatal("part 3 not dropped")
+	}
+	if _, ok := c.GetCdnFilePart(token, 4); !ok {
+		t.Fatal("part 4 dropped")
+	}
+	if _, ok := c.GetCdnFilePart([]byte{4}, 3); ok {
+		t.Fatal("unknown file_token")
+	}
+}
@@ -0,0 +1,32 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package grpc
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/interface/cdn/cdn"
+	"github.com/teamgram/teamgram-server/app/interface/cdn/internal/server/grpc/service"
+	"github.com/teamgram/teamgram-server/app/interface/cdn/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/zrpc"
+	"google.golang.org/grpc"
+)
+
+// New new a grpc server.
+func New(ctx *svc.ServiceContext, c zrpc.RpcServerConf) *zrpc.RpcServer {
+	s, err := zrpc.NewServer(c, func(grpcServer *grpc.Server) {
+		srv := service.New(ctx)
+		cdn.RegisterRPCCdnServer(grpcServer, srv)
+		mtproto.RegisterRPCFilesServer(grpcServer, srv)
+	})
+	logx.Must(err)
+	return s
+}
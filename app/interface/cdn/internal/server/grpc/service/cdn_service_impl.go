@@ -0,0 +1,37 @@
+/*
+ * WARNING! All changes made in this file will be lost!
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package service
+
+import (
+	"context"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/interface/cdn/cdn"
+	"github.com/teamgram/teamgram-server/app/interface/cdn/internal/core"
+)
+
+// CdnUploadCdnFilePart
+// cdn.uploadCdnFilePart file_token:bytes offset:int bytes:bytes = Bool;
+func (s *Service) CdnUploadCdnFilePart(ctx context.Context, request *cdn.TLCdnUploadCdnFilePart) (*mtproto.Bool, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Infof("cdn.uploadCdnFilePart - request: {file_token: %x, offset: %d, bytes: %d}",
+		request.FileToken,
+		request.Offset,
+		len(request.Bytes))
+
+	r, err := c.CdnUploadCdnFilePart(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Infof("cdn.uploadCdnFilePart - reply: %s", r.DebugString())
+	return r, err
+}
@@ -0,0 +1,37 @@
+/*
+ * WARNING! All changes made in this file will be lost!
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package service
+
+import (
+	"context"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/interface/cdn/internal/core"
+)
+
+// UploadGetCdnFile
+// upload.getCdnFile#2000bcc3 file_token:bytes offset:int limit:int = upload.CdnFile;
+func (s *Service) UploadGetCdnFile(ctx context.Context, request *mtproto.TLUploadGetCdnFile) (*mtproto.Upload_CdnFile, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Infof("upload.getCdnFile - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.UploadGetCdnFile(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Infof("upload.getCdnFile - reply: {predicate: %s, request_token: %x, bytes: %d}",
+		r.GetPredicateName(),
+		r.GetRequestToken(),
+		len(r.GetBytes()))
+
+	return r, err
+}
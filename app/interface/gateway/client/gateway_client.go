@@ -23,6 +23,7 @@ var _ *mtproto.Bool
 
 type GatewayClient interface {
 	GatewaySendDataToGateway(ctx context.Context, in *gateway.TLGatewaySendDataToGateway) (*mtproto.Bool, error)
+	GatewayCloseAuthKey(ctx context.Context, in *gateway.TLGatewayCloseAuthKey) (*mtproto.Bool, error)
 }
 
 type defaultGatewayClient struct {
@@ -41,3 +42,10 @@ func (m *defaultGatewayClient) GatewaySendDataToGateway(ctx context.Context, in
 	client := gateway.NewRPCGatewayClient(m.cli.Conn())
 	return client.GatewaySendDataToGateway(ctx, in)
 }
+
+// GatewayCloseAuthKey
+// gateway.closeAuthKey auth_key_id:long = Bool;
+func (m *defaultGatewayClient) GatewayCloseAuthKey(ctx context.Context, in *gateway.TLGatewayCloseAuthKey) (*mtproto.Bool, error) {
+	client := gateway.NewRPCGatewayClient(m.cli.Conn())
+	return client.GatewayCloseAuthKey(ctx, in)
+}
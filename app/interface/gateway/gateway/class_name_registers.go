@@ -12,6 +12,7 @@ package gateway
 
 const (
 	Predicate_gateway_sendDataToGateway = "gateway_sendDataToGateway"
+	Predicate_gateway_closeAuthKey      = "gateway_closeAuthKey"
 )
 
 var clazzNameRegisters2 = map[string]map[int]int32{
@@ -19,10 +20,15 @@ var clazzNameRegisters2 = map[string]map[int]int32{
 		0: 645953552, // 0x26807810
 
 	},
+	Predicate_gateway_closeAuthKey: {
+		0: -1888963265, // 0x8f68b53f
+
+	},
 }
 
 var clazzIdNameRegisters2 = map[int32]string{
-	645953552: Predicate_gateway_sendDataToGateway, // 0x26807810
+	645953552:   Predicate_gateway_sendDataToGateway, // 0x26807810
+	-1888963265: Predicate_gateway_closeAuthKey,      // 0x8f68b53f
 
 }
 
@@ -36,6 +36,11 @@ var clazzIdRegisters2 = map[int32]func() mtproto.TLObject{
 			Constructor: 645953552,
 		}
 	},
+	-1888963265: func() mtproto.TLObject { // 0x8f68b53f
+		return &TLGatewayCloseAuthKey{
+			Constructor: -1888963265,
+		}
+	},
 }
 
 func NewTLObjectByClassID(classId int32) mtproto.TLObject {
@@ -106,4 +111,52 @@ func (m *TLGatewaySendDataToGateway) DebugString() string {
 	return dbgString
 }
 
+// TLGatewayCloseAuthKey
+///////////////////////////////////////////////////////////////////////////////
+func (m *TLGatewayCloseAuthKey) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_gateway_closeAuthKey))
+
+	switch uint32(m.Constructor) {
+	case 0x8f68b53f:
+		// gateway.closeAuthKey auth_key_id:long = Bool;
+		x.UInt(0x8f68b53f)
+
+		// no flags
+
+		x.Long(m.GetAuthKeyId())
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLGatewayCloseAuthKey) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLGatewayCloseAuthKey) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0x8f68b53f:
+		// gateway.closeAuthKey auth_key_id:long = Bool;
+
+		// not has flags
+
+		m.AuthKeyId = dBuf.Long()
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLGatewayCloseAuthKey) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
 //----------------------------------------------------------------------------------------------------------------
@@ -36,16 +36,19 @@ type TLConstructor int32
 const (
 	CRC32_UNKNOWN                   TLConstructor = 0
 	CRC32_gateway_sendDataToGateway TLConstructor = 645953552
+	CRC32_gateway_closeAuthKey      TLConstructor = -1888963265
 )
 
 var TLConstructor_name = map[int32]string{
-	0:         "CRC32_UNKNOWN",
-	645953552: "CRC32_gateway_sendDataToGateway",
+	0:           "CRC32_UNKNOWN",
+	645953552:   "CRC32_gateway_sendDataToGateway",
+	-1888963265: "CRC32_gateway_closeAuthKey",
 }
 
 var TLConstructor_value = map[string]int32{
 	"CRC32_UNKNOWN":                   0,
 	"CRC32_gateway_sendDataToGateway": 645953552,
+	"CRC32_gateway_closeAuthKey":      -1888963265,
 }
 
 func (x TLConstructor) String() string {
@@ -129,41 +132,102 @@ func (m *TLGatewaySendDataToGateway) GetPayload() []byte {
 	return nil
 }
 
+//--------------------------------------------------------------------------------------------
+// gateway.closeAuthKey auth_key_id:long = Bool;
+type TLGatewayCloseAuthKey struct {
+	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=gateway.TLConstructor" json:"constructor,omitempty"`
+	AuthKeyId            int64         `protobuf:"varint,3,opt,name=auth_key_id,json=authKeyId,proto3" json:"auth_key_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *TLGatewayCloseAuthKey) Reset()         { *m = TLGatewayCloseAuthKey{} }
+func (m *TLGatewayCloseAuthKey) String() string { return proto.CompactTextString(m) }
+func (*TLGatewayCloseAuthKey) ProtoMessage()    {}
+func (*TLGatewayCloseAuthKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c10c1e4729b66838, []int{1}
+}
+func (m *TLGatewayCloseAuthKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLGatewayCloseAuthKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLGatewayCloseAuthKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TLGatewayCloseAuthKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLGatewayCloseAuthKey.Merge(m, src)
+}
+func (m *TLGatewayCloseAuthKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLGatewayCloseAuthKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLGatewayCloseAuthKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLGatewayCloseAuthKey proto.InternalMessageInfo
+
+func (m *TLGatewayCloseAuthKey) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
+	}
+	return CRC32_UNKNOWN
+}
+
+func (m *TLGatewayCloseAuthKey) GetAuthKeyId() int64 {
+	if m != nil {
+		return m.AuthKeyId
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterEnum("gateway.TLConstructor", TLConstructor_name, TLConstructor_value)
 	proto.RegisterType((*TLGatewaySendDataToGateway)(nil), "gateway.TL_gateway_sendDataToGateway")
+	proto.RegisterType((*TLGatewayCloseAuthKey)(nil), "gateway.TL_gateway_closeAuthKey")
 }
 
 func init() { proto.RegisterFile("gateway.tl.proto", fileDescriptor_c10c1e4729b66838) }
 
 var fileDescriptor_c10c1e4729b66838 = []byte{
-	// 404 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x90, 0xcf, 0x8a, 0xda, 0x50,
-	0x14, 0xc6, 0xbd, 0xd5, 0x56, 0x7a, 0xad, 0xc5, 0x86, 0x52, 0x62, 0x68, 0x53, 0x11, 0x4a, 0xa5,
-	0x60, 0x02, 0xba, 0x69, 0xb7, 0xa6, 0x50, 0x44, 0xb1, 0x6d, 0x6a, 0x29, 0x74, 0x13, 0xae, 0xc9,
-	0x35, 0x86, 0x26, 0x39, 0x97, 0x7b, 0x6f, 0x2a, 0x59, 0xce, 0x6e, 0x96, 0xf3, 0x0e, 0xf3, 0x04,
-	0x03, 0xf3, 0x10, 0xb3, 0x9c, 0x47, 0x98, 0xf1, 0x09, 0x7c, 0x84, 0xc1, 0xfc, 0x21, 0xce, 0xc2,
-	0x55, 0xce, 0xf9, 0x7e, 0x27, 0xe7, 0xbb, 0xdf, 0xc1, 0x1d, 0x9f, 0x48, 0xba, 0x25, 0xa9, 0x21,
-	0x43, 0x83, 0x71, 0x90, 0xa0, 0x34, 0x0b, 0x45, 0x1b, 0xfa, 0x81, 0xdc, 0x24, 0x2b, 0xc3, 0x85,
-	0xc8, 0xf4, 0xc1, 0x07, 0x33, 0xe3, 0xab, 0x64, 0x9d, 0x75, 0x59, 0x93, 0x55, 0xf9, 0x7f, 0x9a,
-	0xee, 0x03, 0xf8, 0x21, 0xad, 0xa6, 0xb6, 0x9c, 0x30, 0x46, 0xb9, 0x28, 0xb8, 0x26, 0xdc, 0x0d,
-	0x8d, 0xc8, 0xc1, 0xc8, 0x05, 0x4e, 0x1d, 0x99, 0x32, 0x5a, 0xb2, 0x6e, 0xc5, 0x24, 0x27, 0xb1,
-	0x60, 0xc0, 0x65, 0x81, 0x5e, 0x57, 0x48, 0xa4, 0xb1, 0x9b, 0xab, 0xfd, 0x2b, 0x84, 0xdf, 0x2e,
-	0xe7, 0x4e, 0xf1, 0x54, 0x47, 0xd0, 0xd8, 0xfb, 0x4a, 0x24, 0x59, 0xc2, 0xb7, 0x5c, 0x51, 0x3e,
-	0xe3, 0x96, 0x0b, 0xb1, 0x90, 0x3c, 0x71, 0x25, 0x70, 0x15, 0xf5, 0xd0, 0xe0, 0xe5, 0xe8, 0x8d,
-	0x51, 0xa6, 0x5d, 0xce, 0xad, 0x8a, 0xda, 0xc7, 0xa3, 0x8a, 0x8e, 0x5b, 0x24, 0x91, 0x1b, 0xe7,
-	0x1f, 0x4d, 0x9d, 0xc0, 0x53, 0xeb, 0x3d, 0x34, 0xa8, 0xdb, 0xcf, 0x0f, 0xd2, 0x8c, 0xa6, 0x53,
-	0x4f, 0x79, 0x87, 0xb1, 0xa0, 0x42, 0x04, 0x10, 0x1f, 0x70, 0x23, 0xc7, 0x85, 0x32, 0xf5, 0x14,
-	0x15, 0x37, 0x19, 0x49, 0x43, 0x20, 0x9e, 0xfa, 0xb4, 0x87, 0x06, 0x2f, 0xec, 0xb2, 0xfd, 0xf4,
-	0x13, 0xb7, 0x1f, 0xd9, 0x2a, 0xaf, 0x70, 0xdb, 0xb2, 0xad, 0xf1, 0xc8, 0xf9, 0xbd, 0x98, 0x2d,
-	0xbe, 0xff, 0x59, 0x74, 0x6a, 0xca, 0x47, 0xfc, 0x3e, 0x97, 0x4e, 0x26, 0xeb, 0x5c, 0xec, 0xcf,
-	0xae, 0x9f, 0x68, 0x8d, 0xf3, 0x4b, 0xbd, 0x36, 0x22, 0x18, 0xdb, 0x3f, 0xac, 0x32, 0xf3, 0x2f,
-	0xdc, 0x3d, 0x7d, 0x90, 0x0f, 0x47, 0xd9, 0x4f, 0x6f, 0xd7, 0xda, 0x46, 0x24, 0xb3, 0x13, 0x1b,
-	0x13, 0x80, 0xb0, 0x5f, 0x9b, 0xcc, 0xf6, 0xf7, 0x3a, 0xba, 0xd9, 0xe9, 0xe8, 0x76, 0xa7, 0xa3,
-	0xbb, 0x9d, 0x8e, 0xfe, 0x7e, 0x91, 0x94, 0x44, 0x3e, 0x27, 0x91, 0x11, 0x80, 0x59, 0xd6, 0x43,
-	0x41, 0xf9, 0x7f, 0xca, 0x4d, 0xc2, 0x98, 0x19, 0xc4, 0x92, 0xf2, 0x35, 0x71, 0xa9, 0x59, 0xf8,
-	0x94, 0xdf, 0xd5, 0xb3, 0x6c, 0xf5, 0xf8, 0x21, 0x00, 0x00, 0xff, 0xff, 0xe4, 0x9f, 0xd9, 0x62,
-	0x76, 0x02, 0x00, 0x00,
+	// 457 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x92, 0xcf, 0x8b, 0xd3, 0x40,
+	0x14, 0xc7, 0x3b, 0xee, 0xea, 0xe2, 0xac, 0x95, 0x3a, 0x2c, 0xda, 0x0d, 0x1a, 0x4b, 0x41, 0xb6,
+	0x08, 0x9b, 0x40, 0xf7, 0xa2, 0x47, 0xb7, 0x82, 0x94, 0x2e, 0x55, 0x62, 0x45, 0xf0, 0x12, 0xa6,
+	0xc9, 0xdb, 0x34, 0x98, 0xe4, 0x0d, 0x33, 0x53, 0x97, 0x78, 0xf3, 0xe6, 0xd1, 0xbf, 0x41, 0xaf,
+	0x5e, 0x04, 0xef, 0x5e, 0x3d, 0xfa, 0x27, 0x68, 0x8f, 0x9e, 0xf6, 0x28, 0x78, 0xa8, 0x34, 0x3f,
+	0x48, 0x16, 0xb7, 0xc7, 0x3d, 0x65, 0xde, 0xf7, 0xf3, 0xe6, 0x3b, 0xdf, 0x37, 0x13, 0xda, 0x0a,
+	0xb8, 0x86, 0x13, 0x9e, 0x5a, 0x3a, 0xb2, 0x84, 0x44, 0x8d, 0x6c, 0xab, 0x50, 0x8c, 0xfd, 0x20,
+	0xd4, 0xb3, 0xf9, 0xd4, 0xf2, 0x30, 0xb6, 0x03, 0x0c, 0xd0, 0xce, 0xf8, 0x74, 0x7e, 0x9c, 0x55,
+	0x59, 0x91, 0xad, 0xf2, 0x7d, 0x86, 0x19, 0x20, 0x06, 0x11, 0x54, 0x5d, 0x27, 0x92, 0x0b, 0x01,
+	0x52, 0x15, 0xdc, 0x50, 0xde, 0x0c, 0x62, 0xbe, 0x3a, 0xc8, 0x43, 0x09, 0xae, 0x4e, 0x05, 0x94,
+	0x6c, 0xb7, 0x62, 0x5a, 0xf2, 0x44, 0x09, 0x94, 0xba, 0x40, 0x3b, 0x15, 0x52, 0x69, 0xe2, 0xe5,
+	0x6a, 0xf7, 0x0b, 0xa1, 0xb7, 0x27, 0x47, 0x6e, 0x11, 0xd5, 0x55, 0x90, 0xf8, 0x8f, 0xb9, 0xe6,
+	0x13, 0x7c, 0x92, 0x2b, 0xec, 0x01, 0xdd, 0xf6, 0x30, 0x51, 0x5a, 0xce, 0x3d, 0x8d, 0xb2, 0x4d,
+	0x3a, 0xa4, 0x77, 0xbd, 0x7f, 0xd3, 0x2a, 0xa7, 0x9d, 0x1c, 0x0d, 0x2a, 0xea, 0xd4, 0x5b, 0x99,
+	0x49, 0xb7, 0xf9, 0x5c, 0xcf, 0xdc, 0xd7, 0x90, 0xba, 0xa1, 0xdf, 0xde, 0xe8, 0x90, 0xde, 0x86,
+	0x73, 0x75, 0x25, 0x8d, 0x20, 0x1d, 0xfa, 0xec, 0x0e, 0xa5, 0x0a, 0x94, 0x0a, 0x31, 0x59, 0xe1,
+	0xcd, 0x1c, 0x17, 0xca, 0xd0, 0x67, 0x6d, 0xba, 0x25, 0x78, 0x1a, 0x21, 0xf7, 0xdb, 0x97, 0x3b,
+	0xa4, 0x77, 0xcd, 0x29, 0xcb, 0xae, 0xa2, 0xb7, 0x6a, 0x91, 0xbd, 0x08, 0x15, 0x3c, 0xca, 0x5d,
+	0x2f, 0x2e, 0xed, 0xfd, 0xb7, 0xb4, 0x79, 0x66, 0x37, 0xbb, 0x41, 0x9b, 0x03, 0x67, 0x70, 0xd0,
+	0x77, 0x5f, 0x8c, 0x47, 0xe3, 0xa7, 0x2f, 0xc7, 0xad, 0x06, 0xdb, 0xa3, 0x77, 0x73, 0x69, 0xed,
+	0x75, 0xb6, 0x3e, 0x9c, 0xbe, 0xfb, 0x7a, 0x89, 0xed, 0x51, 0xe3, 0x6c, 0x63, 0x7d, 0x88, 0xd6,
+	0xb7, 0xdf, 0x1f, 0xff, 0xfe, 0x59, 0x2e, 0x97, 0x4b, 0x62, 0x6c, 0xbe, 0xff, 0x64, 0x36, 0xfa,
+	0x9f, 0x09, 0xa5, 0xce, 0xb3, 0x41, 0xf9, 0x24, 0xcf, 0xe9, 0xee, 0xfa, 0xf7, 0xba, 0x57, 0x1b,
+	0x76, 0x7d, 0x0e, 0xa3, 0x69, 0xc5, 0x3a, 0xfb, 0x03, 0xac, 0x43, 0xc4, 0xa8, 0xdb, 0x60, 0x43,
+	0xba, 0x73, 0xee, 0x8d, 0x76, 0xce, 0xf3, 0xab, 0x77, 0xfc, 0x67, 0x75, 0x38, 0x3a, 0xfd, 0x65,
+	0x92, 0xef, 0x0b, 0x93, 0xfc, 0x58, 0x98, 0xe4, 0xe7, 0xc2, 0x24, 0xaf, 0x1e, 0x6a, 0xe0, 0x71,
+	0x20, 0x79, 0x6c, 0x85, 0x68, 0x97, 0xeb, 0x7d, 0x05, 0xf2, 0x0d, 0x48, 0x9b, 0x0b, 0x61, 0x87,
+	0x89, 0x06, 0x79, 0xcc, 0x3d, 0xb0, 0x8b, 0x23, 0xca, 0xef, 0xf4, 0x4a, 0x66, 0x7d, 0xf0, 0x6f,
+	0x00, 0x9d, 0x92, 0x77, 0xd1, 0x60, 0x03, 0x00, 0x00,
 }
 
 func (this *TLGatewaySendDataToGateway) GoString() string {
@@ -182,6 +246,20 @@ func (this *TLGatewaySendDataToGateway) GoString() string {
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
+func (this *TLGatewayCloseAuthKey) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&gateway.TLGatewayCloseAuthKey{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "AuthKeyId: "+fmt.Sprintf("%#v", this.AuthKeyId)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
 func valueToGoStringGatewayTl(v interface{}, typ string) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -205,6 +283,8 @@ const _ = grpc.SupportPackageIsVersion4
 type RPCGatewayClient interface {
 	// gateway.sendDataToGateway auth_key_id:long session_id:long payload:bytes = Bool;
 	GatewaySendDataToGateway(ctx context.Context, in *TLGatewaySendDataToGateway, opts ...grpc.CallOption) (*mtproto.Bool, error)
+	// gateway.closeAuthKey auth_key_id:long = Bool;
+	GatewayCloseAuthKey(ctx context.Context, in *TLGatewayCloseAuthKey, opts ...grpc.CallOption) (*mtproto.Bool, error)
 }
 
 type rPCGatewayClient struct {
@@ -224,10 +304,21 @@ func (c *rPCGatewayClient) GatewaySendDataToGateway(ctx context.Context, in *TLG
 	return out, nil
 }
 
+func (c *rPCGatewayClient) GatewayCloseAuthKey(ctx context.Context, in *TLGatewayCloseAuthKey, opts ...grpc.CallOption) (*mtproto.Bool, error) {
+	out := new(mtproto.Bool)
+	err := c.cc.Invoke(ctx, "/gateway.RPCGateway/gateway_closeAuthKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // RPCGatewayServer is the server API for RPCGateway service.
 type RPCGatewayServer interface {
 	// gateway.sendDataToGateway auth_key_id:long session_id:long payload:bytes = Bool;
 	GatewaySendDataToGateway(context.Context, *TLGatewaySendDataToGateway) (*mtproto.Bool, error)
+	// gateway.closeAuthKey auth_key_id:long = Bool;
+	GatewayCloseAuthKey(context.Context, *TLGatewayCloseAuthKey) (*mtproto.Bool, error)
 }
 
 // UnimplementedRPCGatewayServer can be embedded to have forward compatible implementations.
@@ -237,6 +328,9 @@ type UnimplementedRPCGatewayServer struct {
 func (*UnimplementedRPCGatewayServer) GatewaySendDataToGateway(ctx context.Context, req *TLGatewaySendDataToGateway) (*mtproto.Bool, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GatewaySendDataToGateway not implemented")
 }
+func (*UnimplementedRPCGatewayServer) GatewayCloseAuthKey(ctx context.Context, req *TLGatewayCloseAuthKey) (*mtproto.Bool, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GatewayCloseAuthKey not implemented")
+}
 
 func RegisterRPCGatewayServer(s *grpc.Server, srv RPCGatewayServer) {
 	s.RegisterService(&_RPCGateway_serviceDesc, srv)
@@ -260,6 +354,24 @@ func _RPCGateway_GatewaySendDataToGateway_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RPCGateway_GatewayCloseAuthKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLGatewayCloseAuthKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCGatewayServer).GatewayCloseAuthKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gateway.RPCGateway/GatewayCloseAuthKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCGatewayServer).GatewayCloseAuthKey(ctx, req.(*TLGatewayCloseAuthKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _RPCGateway_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "gateway.RPCGateway",
 	HandlerType: (*RPCGatewayServer)(nil),
@@ -268,6 +380,10 @@ var _RPCGateway_serviceDesc = grpc.ServiceDesc{
 			MethodName: "gateway_sendDataToGateway",
 			Handler:    _RPCGateway_GatewaySendDataToGateway_Handler,
 		},
+		{
+			MethodName: "gateway_closeAuthKey",
+			Handler:    _RPCGateway_GatewayCloseAuthKey_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "gateway.tl.proto",
@@ -322,6 +438,43 @@ func (m *TLGatewaySendDataToGateway) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
+func (m *TLGatewayCloseAuthKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLGatewayCloseAuthKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLGatewayCloseAuthKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.AuthKeyId != 0 {
+		i = encodeVarintGatewayTl(dAtA, i, uint64(m.AuthKeyId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintGatewayTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGatewayTl(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGatewayTl(v)
 	base := offset
@@ -358,6 +511,24 @@ func (m *TLGatewaySendDataToGateway) Size() (n int) {
 	return n
 }
 
+func (m *TLGatewayCloseAuthKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovGatewayTl(uint64(m.Constructor))
+	}
+	if m.AuthKeyId != 0 {
+		n += 1 + sovGatewayTl(uint64(m.AuthKeyId))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func sovGatewayTl(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -506,6 +677,95 @@ func (m *TLGatewaySendDataToGateway) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *TLGatewayCloseAuthKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGatewayTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_gateway_closeAuthKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_gateway_closeAuthKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthKeyId", wireType)
+			}
+			m.AuthKeyId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuthKeyId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGatewayTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGatewayTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGatewayTl(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -27,6 +27,7 @@ type RPCContextTuple struct {
 
 var rpcContextRegisters = map[string]RPCContextTuple{
 	"TLGatewaySendDataToGateway": RPCContextTuple{"/mtproto.RPCGateway/gateway_sendDataToGateway", func() interface{} { return new(mtproto.Bool) }},
+	"TLGatewayCloseAuthKey":      RPCContextTuple{"/mtproto.RPCGateway/gateway_closeAuthKey", func() interface{} { return new(mtproto.Bool) }},
 }
 
 func FindRPCContextTuple(t interface{}) *RPCContextTuple {
@@ -20,9 +20,26 @@ type Config struct {
 	KeyFile        string
 	KeyFingerprint string
 	Server         *net2.TcpServerConfig
+	Websocket      *WebsocketConfig `json:",optional"`
 	Session        zrpc.RpcClientConf
 }
 
+// WebsocketConfig the listeners of the web clients, Addrs accept ws:// and TlsAddrs accept wss://
+// with CertFile and KeyFile. Behind a reverse proxy terminating the tls only Addrs is needed,
+// the X-Real-IP and X-Forwarded-For headers are only honoured from TrustedProxies (ips or cidrs).
+type WebsocketConfig struct {
+	Addrs        []string `json:",optional"`
+	TlsAddrs     []string `json:",optional"`
+	CertFile     string   `json:",optional"`
+	KeyFile      string   `json:",optional"`
+	Path         string   `json:",default=/apiws"`
+	SendBuf      int      `json:",default=65536"`
+	ReceiveBuf   int      `json:",default=65536"`
+	SendChanSize int      `json:",default=1024"`
+
+	TrustedProxies []string `json:",optional"`
+}
+
 //type TcpServerConfig struct {
 //	Addrs      []string
 //	Multicore  bool
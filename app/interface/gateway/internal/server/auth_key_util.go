@@ -19,22 +19,31 @@
 package server
 
 import (
+	"time"
+
 	"github.com/teamgram/proto/mtproto"
 	"github.com/teamgram/proto/mtproto/crypto"
 )
 
 type authKeyUtil struct {
-	keyData *mtproto.AuthKeyInfo
-	key     *crypto.AuthKey
+	keyData   *mtproto.AuthKeyInfo
+	key       *crypto.AuthKey
+	expiresAt int64
 }
 
 func newAuthKeyUtil(k *mtproto.AuthKeyInfo) *authKeyUtil {
 	return &authKeyUtil{
-		keyData: k,
-		key:     crypto.NewAuthKey(k.AuthKeyId, k.AuthKey),
+		keyData:   k,
+		key:       crypto.NewAuthKey(k.AuthKeyId, k.AuthKey),
+		expiresAt: authKeyExpiresAt(k),
 	}
 }
 
+// Expired the temp key must be queried again before use
+func (k *authKeyUtil) Expired() bool {
+	return k.expiresAt > 0 && k.expiresAt <= time.Now().Unix()
+}
+
 func (k *authKeyUtil) Equal(o *authKeyUtil) bool {
 	return k.keyData.AuthKeyId == o.keyData.AuthKeyId
 }
@@ -172,3 +172,20 @@ func (m *authSessionManager) FoundSessionConnIdList(authKeyId, sessionId int64)
 
 	return nil, nil
 }
+
+func (m *authSessionManager) FoundAuthSessionConnIdList(authKeyId int64) []uint64 {
+	m.rw.RLock()
+	defer m.rw.RUnlock()
+
+	if v, ok := m.sessions[authKeyId]; ok {
+		connIdList := make([]uint64, 0, len(v.sessionList))
+		for _, v2 := range v.sessionList {
+			for e := v2.connIdList.Front(); e != nil; e = e.Next() {
+				connIdList = append(connIdList, e.Value.(uint64))
+			}
+		}
+		return connIdList
+	}
+
+	return nil
+}
@@ -20,10 +20,33 @@ package server
 
 import (
 	"strconv"
+	"time"
 
 	"github.com/teamgram/proto/mtproto"
 )
 
+// tempAuthKeyRecheckInterval a temp key is queried again from authsession after
+// this interval, so a temp key expired or dropped there stops working here too.
+const tempAuthKeyRecheckInterval = 60
+
+type cacheAuthKeyValue struct {
+	keyInfo   *mtproto.AuthKeyInfo
+	expiresAt int64
+}
+
+// Impl cache.Value interface
+func (cv *cacheAuthKeyValue) Size() int {
+	return cv.keyInfo.Size()
+}
+
+// authKeyExpiresAt when the cached key must be queried again, 0 for a perm key
+func authKeyExpiresAt(keyInfo *mtproto.AuthKeyInfo) int64 {
+	if keyInfo.AuthKeyType == mtproto.AuthKeyTypePerm {
+		return 0
+	}
+	return time.Now().Unix() + tempAuthKeyRecheckInterval
+}
+
 func (s *Server) GetAuthKey(authKeyId int64) *mtproto.AuthKeyInfo {
 	var (
 		cacheK = strconv.Itoa(int(authKeyId))
@@ -31,7 +54,12 @@ func (s *Server) GetAuthKey(authKeyId int64) *mtproto.AuthKeyInfo {
 	)
 
 	if v, ok := s.cache.Get(cacheK); ok {
-		value = v.(*mtproto.AuthKeyInfo)
+		cv := v.(*cacheAuthKeyValue)
+		if cv.expiresAt > 0 && cv.expiresAt <= time.Now().Unix() {
+			s.cache.Delete(cacheK)
+		} else {
+			value = cv.keyInfo
+		}
 	}
 
 	return value
@@ -42,6 +70,12 @@ func (s *Server) PutAuthKey(keyInfo *mtproto.AuthKeyInfo) {
 		cacheK = strconv.Itoa(int(keyInfo.AuthKeyId))
 	)
 
-	// TODO: expires_in
-	s.cache.Set(cacheK, keyInfo)
+	s.cache.Set(cacheK, &cacheAuthKeyValue{
+		keyInfo:   keyInfo,
+		expiresAt: authKeyExpiresAt(keyInfo),
+	})
+}
+
+func (s *Server) DeleteAuthKey(authKeyId int64) {
+	s.cache.Delete(strconv.Itoa(int(authKeyId)))
 }
@@ -100,7 +100,7 @@ func (c *AbridgedCodec) Receive() (interface{}, error) {
 	// TODO(@benqi): process report ack and quickack
 	// 截断QuickAck消息，客户端有问题
 	if size == 4 {
-		log.Errorf("server response error: ", int32(binary.LittleEndian.Uint32(buf)))
+		log.Errorf("server response error: %d", int32(binary.LittleEndian.Uint32(buf)))
 		// return nil, fmt.Errorf("Recv QuickAckMessage, ignore!!!!") //  connId: ", c.stream, ", by client ", m.RemoteAddr())
 		return nil, nil
 	}
@@ -89,7 +89,7 @@ func (c *IntermediateCodec) Receive() (interface{}, error) {
 	// TODO(@benqi): process report ack and quickack
 	// 截断QuickAck消息，客户端有问题
 	if size == 4 {
-		log.Errorf("Server response error: ", int32(binary.LittleEndian.Uint32(buf)))
+		log.Errorf("Server response error: %d", int32(binary.LittleEndian.Uint32(buf)))
 		return nil, nil
 	}
 
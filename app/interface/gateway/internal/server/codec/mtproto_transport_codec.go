@@ -110,16 +110,29 @@ func (m *MTProtoTransport) NewCodec(rw io.ReadWriter) (net2.Codec, error) {
 	return codec, nil
 }
 
+// NewWebsocketTransportCodec the codec of the connections upgraded to websocket.
+func NewWebsocketTransportCodec(conn *WebsocketConn) *TransportCodec {
+	return &TransportCodec{
+		codecType: TRANSPORT_TCP,
+		conn:      conn,
+		remoteIp:  conn.RealIp,
+		websocket: true,
+	}
+}
+
 type TransportCodec struct {
 	codecType int // codec type
 	conn      net.Conn
 	codec     net2.Codec
 	proto     *MTProtoTransport
 	remoteIp  string
+	websocket bool
 }
 
 func (c *TransportCodec) peekCodec() error {
-	if isMTProto {
+	if c.websocket {
+		return c.peekObfuscatedCodec()
+	} else if isMTProto {
 		return c.peekMTProtoCodec()
 	} else {
 		return c.peekNTProtoCodec()
@@ -233,6 +246,22 @@ func (c *TransportCodec) peekMTProtoCodec() error {
 	}
 
 	// check obfuscated version
+	if err = c.peekObfuscatedCodec(); err != nil {
+		return err
+	}
+
+	if secondInt == PROXY_FLAG {
+		c.remoteIp = ip.IntToIP(firstInt)
+	}
+
+	return nil
+}
+
+// peekObfuscatedCodec
+// the websocket transport only supports the obfuscated version.
+func (c *TransportCodec) peekObfuscatedCodec() error {
+	peek, _ := c.conn.(net2.PeekAble)
+
 	obfuscatedBuf, err := peek.Peek(64)
 	if err != nil {
 		log.Errorf("peek error: %v", err)
@@ -273,10 +302,6 @@ func (c *TransportCodec) peekMTProtoCodec() error {
 	dcId := int16(binary.BigEndian.Uint16(obfuscatedBuf[60:]))
 	// TODO: check dcId
 
-	if secondInt == PROXY_FLAG {
-		c.remoteIp = ip.IntToIP(firstInt)
-	}
-
 	log.Infof("mtproto obfuscated version, protocol_type: %s", hex.EncodeToString(obfuscatedBuf[56:60]))
 	c.codec = NewMTProtoObfuscatedCodec(c.conn, d, e, protocolType, dcId)
 
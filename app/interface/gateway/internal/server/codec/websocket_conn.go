@@ -0,0 +1,366 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package codec
+
+import (
+	"bufio"
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+// WebsocketConn
+// https://core.telegram.org/mtproto/transports#websocket
+//
+// Web clients send the obfuscated stream (the 64 bytes init payload and then the
+// encrypted abridged, intermediate or padded intermediate packets) in binary messages.
+// The payloads of the received messages are read as one byte stream, and every
+// Write is sent as one binary message, so every packet is sent in its own message.
+type WebsocketConn struct {
+	net.Conn
+	r    *bufio.Reader
+	rBuf []byte
+	wMu  sync.Mutex
+
+	// RealIp is the client ip forwarded by a trusted reverse proxy (X-Real-IP or X-Forwarded-For), or empty.
+	RealIp string
+}
+
+const (
+	// Frame header bits from Section 5.2 of RFC 6455
+	wsFinBit  = 1 << 7
+	wsRsvBits = 0x70
+	wsOpBits  = 0x0f
+	wsMaskBit = 1 << 7
+	wsLenBits = 0x7f
+
+	wsContinuationFrame = 0
+	wsTextFrame         = 1
+	wsBinaryFrame       = 2
+	wsCloseFrame        = 8
+	wsPingFrame         = 9
+	wsPongFrame         = 10
+
+	// WebsocketMaxMessageSize the upload.saveBigFilePart is the largest request (512 KB part)
+	WebsocketMaxMessageSize = 4 * 1024 * 1024
+
+	wsKeyGUID = "258EAFA5-E914-47DA-95CA-C5AB0DC85B11"
+)
+
+var (
+	ErrWebsocketBadRequest     = errors.New("websocket: bad handshake request")
+	ErrWebsocketMessageTooBig  = errors.New("websocket: message too big")
+	ErrWebsocketFrameNotMasked = errors.New("websocket: client frame not masked")
+)
+
+// ParseTrustedProxies parses the ips and the cidrs of the reverse proxies.
+func ParseTrustedProxies(proxies []string) ([]*net.IPNet, error) {
+	nets := make([]*net.IPNet, 0, len(proxies))
+	for _, v := range proxies {
+		if !strings.Contains(v, "/") {
+			ip := net.ParseIP(v)
+			if ip == nil {
+				return nil, fmt.Errorf("invalid trusted proxy: %s", v)
+			}
+			bits := 8 * net.IPv6len
+			if ip4 := ip.To4(); ip4 != nil {
+				ip, bits = ip4, 8*net.IPv4len
+			}
+			nets = append(nets, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+		_, ipNet, err := net.ParseCIDR(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trusted proxy: %s", v)
+		}
+		nets = append(nets, ipNet)
+	}
+
+	return nets, nil
+}
+
+func isTrustedProxy(ip net.IP, trustedProxies []*net.IPNet) bool {
+	if ip == nil {
+		return false
+	}
+	for _, ipNet := range trustedProxies {
+		if ipNet.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// forwardedIp returns the client ip set by the reverse proxies, the headers are only honoured
+// if the peer is a trusted proxy, otherwise any client could spoof its ip.
+func forwardedIp(req *http.Request, remoteAddr net.Addr, trustedProxies []*net.IPNet) string {
+	host, _, err := net.SplitHostPort(remoteAddr.String())
+	if err != nil || !isTrustedProxy(net.ParseIP(host), trustedProxies) {
+		return ""
+	}
+
+	if ip := net.ParseIP(strings.TrimSpace(req.Header.Get("X-Real-IP"))); ip != nil {
+		return ip.String()
+	}
+
+	// every proxy appends the address it got the request from, the client is the
+	// right-most address which isn't one of our proxies.
+	ips := strings.Split(req.Header.Get("X-Forwarded-For"), ",")
+	for i := len(ips) - 1; i >= 0; i-- {
+		ip := net.ParseIP(strings.TrimSpace(ips[i]))
+		if ip == nil {
+			return ""
+		}
+		if !isTrustedProxy(ip, trustedProxies) || i == 0 {
+			return ip.String()
+		}
+	}
+
+	return ""
+}
+
+// UpgradeWebsocket reads the http upgrade request from conn and switches to the websocket protocol,
+// only the requests to path are accepted. The forwarded client ip is only read from the requests
+// of trustedProxies.
+func UpgradeWebsocket(conn net.Conn, path string, trustedProxies []*net.IPNet) (*WebsocketConn, error) {
+	r := bufio.NewReader(conn)
+	req, err := http.ReadRequest(r)
+	if err != nil {
+		return nil, err
+	}
+
+	challengeKey := req.Header.Get("Sec-Websocket-Key")
+	if req.Method != http.MethodGet ||
+		req.URL.Path != path ||
+		!strings.EqualFold(req.Header.Get("Upgrade"), "websocket") ||
+		!strings.Contains(strings.ToLower(req.Header.Get("Connection")), "upgrade") ||
+		req.Header.Get("Sec-Websocket-Version") != "13" ||
+		challengeKey == "" {
+		conn.Write([]byte("HTTP/1.1 400 Bad Request\r\nConnection: close\r\n\r\n"))
+		return nil, ErrWebsocketBadRequest
+	}
+
+	var resp strings.Builder
+	resp.WriteString("HTTP/1.1 101 Switching Protocols\r\nUpgrade: websocket\r\nConnection: Upgrade\r\n")
+	fmt.Fprintf(&resp, "Sec-WebSocket-Accept: %s\r\n", computeWebsocketAcceptKey(challengeKey))
+	// web clients ask for the "binary" sub protocol
+	if protocol := req.Header.Get("Sec-Websocket-Protocol"); protocol != "" {
+		fmt.Fprintf(&resp, "Sec-WebSocket-Protocol: %s\r\n", strings.TrimSpace(strings.Split(protocol, ",")[0]))
+	}
+	resp.WriteString("\r\n")
+	if _, err = conn.Write([]byte(resp.String())); err != nil {
+		return nil, err
+	}
+
+	c := &WebsocketConn{
+		Conn:   conn,
+		r:      r,
+		RealIp: forwardedIp(req, conn.RemoteAddr(), trustedProxies),
+	}
+
+	return c, nil
+}
+
+func computeWebsocketAcceptKey(challengeKey string) string {
+	h := sha1.New()
+	h.Write([]byte(challengeKey))
+	h.Write([]byte(wsKeyGUID))
+	return base64.StdEncoding.EncodeToString(h.Sum(nil))
+}
+
+func (c *WebsocketConn) String() string {
+	return c.Conn.RemoteAddr().String()
+}
+
+func (c *WebsocketConn) Read(p []byte) (int, error) {
+	for len(c.rBuf) == 0 {
+		if err := c.readMessage(); err != nil {
+			return 0, err
+		}
+	}
+
+	n := copy(p, c.rBuf)
+	c.rBuf = c.rBuf[n:]
+	return n, nil
+}
+
+func (c *WebsocketConn) Peek(n int) ([]byte, error) {
+	for len(c.rBuf) < n {
+		if err := c.readMessage(); err != nil {
+			return nil, err
+		}
+	}
+
+	return c.rBuf[:n], nil
+}
+
+func (c *WebsocketConn) PeekByte() (uint8, error) {
+	if b, err := c.Peek(1); err != nil {
+		return 0, err
+	} else {
+		return b[0], nil
+	}
+}
+
+func (c *WebsocketConn) PeekUint32() (uint32, error) {
+	if b, err := c.Peek(4); err != nil {
+		return 0, err
+	} else {
+		return binary.LittleEndian.Uint32(b), nil
+	}
+}
+
+func (c *WebsocketConn) Discard(n int) (int, error) {
+	if n > len(c.rBuf) {
+		n = len(c.rBuf)
+	}
+	c.rBuf = c.rBuf[n:]
+	return n, nil
+}
+
+// Write sends p in one binary message.
+func (c *WebsocketConn) Write(p []byte) (int, error) {
+	if err := c.writeFrame(wsBinaryFrame, p); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+func (c *WebsocketConn) Close() error {
+	c.writeFrame(wsCloseFrame, nil)
+	return c.Conn.Close()
+}
+
+// readMessage appends the payload of the next data message to rBuf, the control frames
+// between the fragments are handled here.
+func (c *WebsocketConn) readMessage() error {
+	size := 0
+	for {
+		fin, op, payload, err := c.readFrame()
+		if err != nil {
+			return err
+		}
+
+		switch op {
+		case wsBinaryFrame, wsTextFrame, wsContinuationFrame:
+			size += len(payload)
+			if size > WebsocketMaxMessageSize {
+				return ErrWebsocketMessageTooBig
+			}
+			c.rBuf = append(c.rBuf, payload...)
+			if fin {
+				return nil
+			}
+		case wsPingFrame:
+			if err = c.writeFrame(wsPongFrame, payload); err != nil {
+				return err
+			}
+		case wsPongFrame:
+		case wsCloseFrame:
+			return io.EOF
+		default:
+			return fmt.Errorf("websocket: unknown opcode %d", op)
+		}
+	}
+}
+
+func (c *WebsocketConn) readFrame() (fin bool, op int, payload []byte, err error) {
+	var h [8]byte
+	if _, err = io.ReadFull(c.r, h[:2]); err != nil {
+		return
+	}
+	if h[0]&wsRsvBits != 0 {
+		err = fmt.Errorf("websocket: unexpected reserved bits 0x%x", h[0]&wsRsvBits)
+		return
+	}
+	fin = h[0]&wsFinBit != 0
+	op = int(h[0] & wsOpBits)
+	if h[1]&wsMaskBit == 0 {
+		err = ErrWebsocketFrameNotMasked
+		return
+	}
+
+	var payloadLen uint64
+	switch l := h[1] & wsLenBits; l {
+	case 126:
+		if _, err = io.ReadFull(c.r, h[:2]); err != nil {
+			return
+		}
+		payloadLen = uint64(binary.BigEndian.Uint16(h[:2]))
+	case 127:
+		if _, err = io.ReadFull(c.r, h[:8]); err != nil {
+			return
+		}
+		payloadLen = binary.BigEndian.Uint64(h[:8])
+	default:
+		payloadLen = uint64(l)
+	}
+	if payloadLen > WebsocketMaxMessageSize {
+		err = ErrWebsocketMessageTooBig
+		return
+	}
+
+	var maskKey [4]byte
+	if _, err = io.ReadFull(c.r, maskKey[:]); err != nil {
+		return
+	}
+	payload = make([]byte, payloadLen)
+	if _, err = io.ReadFull(c.r, payload); err != nil {
+		return
+	}
+	for i := range payload {
+		payload[i] ^= maskKey[i&3]
+	}
+
+	return
+}
+
+// writeFrame the server frames are not masked.
+func (c *WebsocketConn) writeFrame(op int, payload []byte) error {
+	var (
+		l = len(payload)
+		b = make([]byte, 0, 10+l)
+	)
+
+	b = append(b, wsFinBit|byte(op))
+	switch {
+	case l <= 125:
+		b = append(b, byte(l))
+	case l < 65536:
+		b = append(b, 126, byte(l>>8), byte(l))
+	default:
+		var ext [8]byte
+		binary.BigEndian.PutUint64(ext[:], uint64(l))
+		b = append(b, 127)
+		b = append(b, ext[:]...)
+	}
+	b = append(b, payload...)
+
+	c.wMu.Lock()
+	defer c.wMu.Unlock()
+	_, err := c.Conn.Write(b)
+	return err
+}
@@ -0,0 +1,170 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package codec
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"net"
+	"net/http"
+	"testing"
+)
+
+func writeMaskedFrame(w io.Writer, fin bool, op byte, payload []byte) {
+	var (
+		b       = []byte{op, 0x80 | byte(len(payload))}
+		maskKey = []byte{1, 2, 3, 4}
+	)
+	if fin {
+		b[0] |= wsFinBit
+	}
+	b = append(b, maskKey...)
+	for i, v := range payload {
+		b = append(b, v^maskKey[i&3])
+	}
+	w.Write(b)
+}
+
+// proxyConn a net.Pipe end with the address of the reverse proxy
+type proxyConn struct {
+	net.Conn
+}
+
+func (c proxyConn) RemoteAddr() net.Addr {
+	return &net.TCPAddr{IP: net.ParseIP("127.0.0.1"), Port: 50000}
+}
+
+// go test -v -run=TestWebsocketConn
+func TestWebsocketConn(t *testing.T) {
+	client, server := net.Pipe()
+	defer client.Close()
+
+	proxies, _ := ParseTrustedProxies([]string{"127.0.0.1"})
+
+	go func() {
+		req := "GET /apiws HTTP/1.1\r\nHost: localhost\r\nUpgrade: websocket\r\nConnection: Upgrade\r\n" +
+			"Sec-WebSocket-Key: dGhlIHNhbXBsZSBub25jZQ==\r\nSec-WebSocket-Version: 13\r\n" +
+			"Sec-WebSocket-Protocol: binary\r\nX-Forwarded-For: 10.0.0.1, 10.0.0.2\r\n\r\n"
+		client.Write([]byte(req))
+	}()
+
+	var (
+		conn *WebsocketConn
+		err  error
+		done = make(chan struct{})
+	)
+	go func() {
+		conn, err = UpgradeWebsocket(proxyConn{server}, "/apiws", proxies)
+		close(done)
+	}()
+
+	cr := bufio.NewReader(client)
+	resp, err2 := http.ReadResponse(cr, nil)
+	if err2 != nil {
+		t.Fatal(err2)
+	}
+	<-done
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode != http.StatusSwitchingProtocols ||
+		resp.Header.Get("Sec-WebSocket-Accept") != "s3pPLMBiTxaQ9kYGzzhZRbK+xOo=" ||
+		resp.Header.Get("Sec-WebSocket-Protocol") != "binary" {
+		t.Fatalf("bad response: %d %v", resp.StatusCode, resp.Header)
+	}
+	if conn.RealIp != "10.0.0.2" {
+		t.Fatalf("RealIp = %s", conn.RealIp)
+	}
+
+	// a fragmented message with a ping in between, read as one stream
+	go func() {
+		writeMaskedFrame(client, false, wsBinaryFrame, []byte("hello "))
+		writeMaskedFrame(client, true, wsPingFrame, []byte("p"))
+		writeMaskedFrame(client, true, wsContinuationFrame, []byte("world"))
+	}()
+
+	var peeked []byte
+	b := make([]byte, 11)
+	done = make(chan struct{})
+	go func() {
+		if peeked, err = conn.Peek(4); err == nil {
+			peeked = append([]byte(nil), peeked...)
+			_, err = io.ReadFull(conn, b)
+		}
+		close(done)
+	}()
+	pong := make([]byte, 3)
+	if _, err2 = io.ReadFull(cr, pong); err2 != nil || !bytes.Equal(pong, []byte{wsFinBit | wsPongFrame, 1, 'p'}) {
+		t.Fatalf("pong = %v, %v", pong, err2)
+	}
+	<-done
+	if err != nil || string(peeked) != "hell" || string(b) != "hello world" {
+		t.Fatalf("Peek = %q, Read = %q, %v", peeked, b, err)
+	}
+
+	// every Write is one unmasked binary message
+	go conn.Write([]byte("packet"))
+	b = make([]byte, 8)
+	if _, err = io.ReadFull(cr, b); err != nil || !bytes.Equal(b, append([]byte{wsFinBit | wsBinaryFrame, 6}, "packet"...)) {
+		t.Fatalf("frame = %v, %v", b, err)
+	}
+}
+
+// go test -v -run=TestForwardedIp
+func TestForwardedIp(t *testing.T) {
+	proxies, err := ParseTrustedProxies([]string{"127.0.0.1", "10.0.0.0/8"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = ParseTrustedProxies([]string{"10.0.0.0/33"}); err == nil {
+		t.Fatal("want error for an invalid cidr")
+	}
+
+	var (
+		proxy  = &net.TCPAddr{IP: net.ParseIP("10.1.2.3"), Port: 443}
+		client = &net.TCPAddr{IP: net.ParseIP("203.0.113.9"), Port: 443}
+	)
+	for _, tt := range []struct {
+		name       string
+		remoteAddr net.Addr
+		realIp     string
+		forwarded  string
+		want       string
+	}{
+		{"untrusted peer", client, "1.1.1.1", "2.2.2.2", ""},
+		{"real ip", proxy, "1.1.1.1", "2.2.2.2", "1.1.1.1"},
+		{"forwarded", proxy, "", "2.2.2.2", "2.2.2.2"},
+		{"spoofed hop", proxy, "", "6.6.6.6, 2.2.2.2, 10.0.0.2", "2.2.2.2"},
+		{"only proxies", proxy, "", "10.0.0.3, 10.0.0.2", "10.0.0.3"},
+		{"garbage", proxy, "", "2.2.2.2, bad", ""},
+		{"no headers", proxy, "", "", ""},
+	} {
+		req := &http.Request{Header: http.Header{}}
+		if tt.realIp != "" {
+			req.Header.Set("X-Real-IP", tt.realIp)
+		}
+		if tt.forwarded != "" {
+			req.Header.Set("X-Forwarded-For", tt.forwarded)
+		}
+		if got := forwardedIp(req, tt.remoteAddr, proxies); got != tt.want {
+			t.Errorf("%s: forwardedIp = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
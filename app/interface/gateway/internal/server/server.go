@@ -40,6 +40,8 @@ var (
 type Server struct {
 	c      *config.Config
 	server *net2.TcpServer2
+	// wsServer serves the web clients, nil if Websocket is not configured
+	wsServer *websocketServer
 	// pool           *goroutine.Pool
 	cache          *cache.LRUCache
 	handshake      *handshake
@@ -114,6 +116,9 @@ func New(c config.Config) *Server {
 
 func (s *Server) Close() {
 	s.server.Stop()
+	if s.wsServer != nil {
+		s.wsServer.Stop()
+	}
 }
 
 // Ping ping the resource.
@@ -129,5 +134,26 @@ func (s *Server) Serve() error {
 	s.server = serv
 	s.server.Serve()
 
+	if s.c.Websocket != nil {
+		wsServ, err := newWebsocketServer(s.c.Websocket, s.c.MaxProc, s)
+		if err != nil {
+			panic(err)
+		}
+		s.wsServer = wsServ
+		s.wsServer.Serve()
+	}
+
+	return nil
+}
+
+// getConnection looks up the tcp connections and then the websocket connections,
+// the connection ids are unique between both servers.
+func (s *Server) getConnection(connId uint64) *net2.TcpConnection {
+	if conn := s.server.GetConnection(connId); conn != nil {
+		return conn
+	}
+	if s.wsServer != nil {
+		return s.wsServer.GetConnection(connId)
+	}
 	return nil
 }
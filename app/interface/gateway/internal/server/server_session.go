@@ -91,7 +91,7 @@ func (s *Server) GatewaySendDataToGateway(ctx context.Context, in *gateway.TLGat
 
 	for _, connId := range connIdList {
 		logx.Infof("[keyId: %d, sessionId: %d]: %v", in.AuthKeyId, in.SessionId, connId)
-		conn2 := s.server.GetConnection(connId)
+		conn2 := s.getConnection(connId)
 		if conn2 != nil {
 			ctx, _ := conn2.Context.(*connContext)
 			authKey = ctx.getAuthKey(in.AuthKeyId)
@@ -129,3 +129,20 @@ func (s *Server) GatewaySendDataToGateway(ctx context.Context, in *gateway.TLGat
 
 	return mtproto.BoolTrue, nil
 }
+
+// GatewayCloseAuthKey
+// gateway.closeAuthKey auth_key_id:long = Bool;
+func (s *Server) GatewayCloseAuthKey(ctx context.Context, in *gateway.TLGatewayCloseAuthKey) (reply *mtproto.Bool, err error) {
+	logx.Infof("CloseAuthKey - request: {kId: %d}", in.AuthKeyId)
+
+	// the key is queried again on reconnect, a dropped temp key is answered by -404
+	s.DeleteAuthKey(in.AuthKeyId)
+	for _, connId := range s.authSessionMgr.FoundAuthSessionConnIdList(in.AuthKeyId) {
+		if conn2 := s.getConnection(connId); conn2 != nil {
+			logx.Infof("CloseAuthKey - close conn: {kId: %d, conn: %s}", in.AuthKeyId, conn2)
+			conn2.Close()
+		}
+	}
+
+	return mtproto.BoolTrue, nil
+}
@@ -129,8 +129,9 @@ func (ctx *connContext) getAuthKey(id int64) *authKeyUtil {
 func (ctx *connContext) putAuthKey(k *authKeyUtil) {
 	ctx.Lock()
 	defer ctx.Unlock()
-	for _, key := range ctx.authKeys {
+	for i, key := range ctx.authKeys {
 		if key.Equal(k) {
+			ctx.authKeys[i] = k
 			return
 		}
 	}
@@ -236,7 +237,7 @@ func (s *Server) OnConnectionDataArrived(conn *net2.TcpConnection, msg interface
 		//} else {
 		//	if ctx.state != STATE_AUTH_KEY {
 		authKey := ctx.getAuthKey(msg2.AuthKeyId())
-		if authKey == nil {
+		if authKey == nil || authKey.Expired() {
 			key := s.GetAuthKey(msg2.AuthKeyId())
 			if key == nil {
 				sessClient, err2 := s.session.getSessionClient(strconv.FormatInt(msg2.AuthKeyId(), 10))
@@ -433,7 +434,7 @@ func (s *Server) onEncryptedMessage(ctx *connContext, conn *net2.TcpConnection,
 }
 
 func (s *Server) GetConnByConnID(id uint64) *net2.TcpConnection {
-	return s.server.GetConnection(id)
+	return s.getConnection(id)
 }
 
 func (s *Server) SendToClient(conn *net2.TcpConnection, authKey *authKeyUtil, b []byte) error {
@@ -0,0 +1,208 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package server
+
+import (
+	"crypto/tls"
+	"io"
+	"net"
+	"runtime/debug"
+	"sync"
+	"time"
+
+	"github.com/teamgram/marmota/pkg/net2"
+	"github.com/teamgram/teamgram-server/app/interface/gateway/internal/config"
+	"github.com/teamgram/teamgram-server/app/interface/gateway/internal/server/codec"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+const (
+	websocketHandshakeTimeout = 10 * time.Second
+	websocketReadTimeout      = 6 * time.Minute
+)
+
+type websocketListener struct {
+	*net.TCPListener
+	tlsConfig *tls.Config
+}
+
+// websocketServer accepts the web clients, the upgraded connections carry the obfuscated
+// mtproto stream and are served by the same callbacks as the tcp connections.
+type websocketServer struct {
+	c        *config.WebsocketConfig
+	proxies  []*net.IPNet
+	accepts  int
+	lsnList  []*websocketListener
+	callback net2.TcpConnectionCallback
+	conns    sync.Map // connId -> *net2.TcpConnection
+}
+
+func newWebsocketServer(c *config.WebsocketConfig, accepts int, cb net2.TcpConnectionCallback) (*websocketServer, error) {
+	s := &websocketServer{
+		c:        c,
+		accepts:  accepts,
+		callback: cb,
+	}
+
+	proxies, err := codec.ParseTrustedProxies(c.TrustedProxies)
+	if err != nil {
+		logx.Errorf("parseTrustedProxies(%v) error(%v)", c.TrustedProxies, err)
+		return nil, err
+	}
+	s.proxies = proxies
+
+	var tlsConfig *tls.Config
+	if len(c.TlsAddrs) > 0 {
+		cert, err := tls.LoadX509KeyPair(c.CertFile, c.KeyFile)
+		if err != nil {
+			logx.Errorf("tls.LoadX509KeyPair(%s, %s) error(%v)", c.CertFile, c.KeyFile, err)
+			return nil, err
+		}
+		tlsConfig = &tls.Config{Certificates: []tls.Certificate{cert}}
+	}
+
+	listen := func(bind string, tlsConfig *tls.Config) error {
+		addr, err := net.ResolveTCPAddr("tcp", bind)
+		if err != nil {
+			logx.Errorf(`net.ResolveTCPAddr("tcp", "%s") error(%v)`, bind, err)
+			return err
+		}
+		lsn, err := net.ListenTCP("tcp", addr)
+		if err != nil {
+			logx.Errorf(`net.ListenTCP("tcp", "%s") error(%v)`, bind, err)
+			return err
+		}
+		s.lsnList = append(s.lsnList, &websocketListener{TCPListener: lsn, tlsConfig: tlsConfig})
+		return nil
+	}
+
+	for _, bind := range c.Addrs {
+		if err := listen(bind, nil); err != nil {
+			s.Stop()
+			return nil, err
+		}
+	}
+	for _, bind := range c.TlsAddrs {
+		if err := listen(bind, tlsConfig); err != nil {
+			s.Stop()
+			return nil, err
+		}
+	}
+
+	return s, nil
+}
+
+func (s *websocketServer) Serve() {
+	for _, lsn := range s.lsnList {
+		for i := 0; i < s.accepts; i++ {
+			go s.acceptLoop(lsn)
+		}
+	}
+}
+
+func (s *websocketServer) Stop() {
+	for _, lsn := range s.lsnList {
+		lsn.Close()
+	}
+	s.conns.Range(func(key, value interface{}) bool {
+		value.(*net2.TcpConnection).Close()
+		return true
+	})
+}
+
+func (s *websocketServer) GetConnection(connId uint64) *net2.TcpConnection {
+	if conn, ok := s.conns.Load(connId); ok {
+		return conn.(*net2.TcpConnection)
+	}
+	return nil
+}
+
+// OnConnectionClosed called by net2.TcpConnection.Close
+func (s *websocketServer) OnConnectionClosed(conn net2.Connection) {
+	s.conns.Delete(conn.GetConnID())
+	if s.callback != nil {
+		s.callback.OnConnectionClosed(conn.(*net2.TcpConnection))
+	}
+}
+
+func (s *websocketServer) acceptLoop(lsn *websocketListener) {
+	for {
+		conn, err := net2.AcceptTCP(lsn.TCPListener)
+		if err != nil {
+			// if listener close then return
+			logx.Errorf("listener.Accept(\"%s\") error(%v)", lsn.Addr().String(), err)
+			return
+		}
+		conn.SetKeepAlive(true)
+		conn.SetReadBuffer(s.c.ReceiveBuf)
+		conn.SetWriteBuffer(s.c.SendBuf)
+
+		if lsn.tlsConfig != nil {
+			go s.establishWebsocketConnection(tls.Server(conn, lsn.tlsConfig))
+		} else {
+			go s.establishWebsocketConnection(conn)
+		}
+	}
+}
+
+func (s *websocketServer) establishWebsocketConnection(conn net.Conn) {
+	defer conn.Close()
+
+	conn.SetDeadline(time.Now().Add(websocketHandshakeTimeout))
+	wsConn, err := codec.UpgradeWebsocket(conn, s.c.Path, s.proxies)
+	if err != nil {
+		if err != io.EOF {
+			logx.Errorf("upgradeWebsocket(%s) error(%v)", conn.RemoteAddr(), err)
+		}
+		return
+	}
+	conn.SetDeadline(time.Time{})
+
+	tcpConn := net2.NewTcpConnection2("websocket", wsConn, s.c.SendChanSize, codec.NewWebsocketTransportCodec(wsConn), true, s)
+	defer func() {
+		if err := recover(); err != nil {
+			logx.Errorf("websocket_server handle panic: %v\n%s", err, debug.Stack())
+			tcpConn.Close()
+		}
+	}()
+
+	s.conns.Store(tcpConn.GetConnID(), tcpConn)
+	if s.callback != nil {
+		s.callback.OnNewConnection(tcpConn)
+	}
+
+	for {
+		conn.SetReadDeadline(time.Now().Add(websocketReadTimeout))
+		msg, err := tcpConn.Receive()
+		if err != nil {
+			logx.Errorf("conn: %s recv error: %v", tcpConn, err)
+			return
+		}
+
+		if msg == nil {
+			logx.Errorf("recv a nil msg by conn: %s", tcpConn)
+			continue
+		}
+
+		if s.callback != nil {
+			s.callback.OnConnectionDataArrived(tcpConn, msg)
+		}
+	}
+}
@@ -1,7 +1,7 @@
 package main
 
 import (
-	"github.com/devops-ntpro/teamgram-server/app/interface/ntproxy/internal/server/server"
+	"github.com/teamgram/teamgram-server/app/interface/ntproxy/internal/server/server"
 
 	"github.com/teamgram/marmota/pkg/commands"
 )
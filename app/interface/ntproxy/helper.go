@@ -11,7 +11,7 @@
 package ntproxy_helper
 
 import (
-	"github.com/devops-ntpro/teamgram-server/app/interface/ntproxy/internal/server/server"
+	"github.com/teamgram/teamgram-server/app/interface/ntproxy/internal/server/server"
 )
 
 type (
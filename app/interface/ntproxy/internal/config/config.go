@@ -2,11 +2,35 @@ package config
 
 import (
 	"github.com/teamgram/marmota/pkg/net2"
+	"github.com/zeromicro/go-zero/core/discov"
 )
 
 type Config struct {
-	MaxProc        int
-	ServiceId      int
-	Server         *net2.TcpServerConfig
-	Client         *net2.ClientConfig
+	MaxProc   int
+	ServiceId int
+	Server    *net2.TcpServerConfig
+	Upstreams []UpstreamConf
+	Reconnect BackoffConf
+}
+
+// UpstreamConf is a set of gateways chat subscriptions are proxied to.
+// Sets are tried in order, the next set is used only when no endpoint of the previous one can be dialed.
+type UpstreamConf struct {
+	Name      string          `json:",optional"`
+	Endpoints []string        `json:",optional"`
+	Etcd      discov.EtcdConf `json:",optional"`
+	// Port replaces the port of the addresses discovered through etcd,
+	// gateways register their rpc address but chats are served on the tcp port.
+	Port        int    `json:",optional"`
+	Balancer    string `json:",default=round_robin,options=round_robin|random|consistent_hash"`
+	DialTimeout int64  `json:",default=3000"`  // ms
+	FailTimeout int64  `json:",default=10000"` // ms, an endpoint is skipped after a dial error
+}
+
+// BackoffConf controls reconnecting a subscription after its chat connection is lost.
+type BackoffConf struct {
+	MinDelay   int64   `json:",default=500"`   // ms
+	MaxDelay   int64   `json:",default=30000"` // ms
+	Factor     float64 `json:",default=2"`
+	MaxRetries int     `json:",optional"` // 0 - retry until unsubscribed
 }
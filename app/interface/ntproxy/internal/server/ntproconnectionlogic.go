@@ -21,11 +21,12 @@ package server
 import (
 	"net"
 	"fmt"
+	"sync"
 	"encoding/binary"
 	"github.com/zeromicro/go-zero/core/logx"
 	
-	"github.com/devops-ntpro/teamgram-server/app/interface/ntproxy/internal/config"
-	"github.com/devops-ntpro/teamgram-server/app/interface/ntproxy/internal/server/ntproto/types"
+	"github.com/teamgram/teamgram-server/app/interface/ntproxy/internal/config"
+	"github.com/teamgram/teamgram-server/app/interface/ntproxy/internal/server/ntproto/types"
 )
 
 const (
@@ -55,8 +56,12 @@ type ntproConnection struct {
 	index int
 	info ntproConnectionInfo
 
+	// wMu guards wBuf, chat events are written from the subscription goroutines
+	wMu sync.Mutex
 	wBuf [128000]byte
 
+	upstream *upstream
+
 	sessions map[types.NtproNetsessionId]*ntproSession
 
 	state int
@@ -74,6 +79,8 @@ const (
 type ntproSession struct {
 	conEvent types.NtproNetgateConnected
 	con *ntproConnection
+
+	mu sync.Mutex
 	subscriptions map[string]*ntproSubscription
 }
 
@@ -81,7 +88,11 @@ type ntproSession struct {
 type ntproSubscription struct {
 	subEvent types.NtproSubscribeChat
 	session *ntproSession
-	chatCon tcpConnection
+
+	mu      sync.Mutex
+	chatCon *tcpConnection
+	closed  bool
+	done    chan struct{}
 }
 
 // Сетевое подключение между сервером NTPro и сервером чата
@@ -99,14 +110,16 @@ func handleNtproConn(
 	c *net.TCPConn,
 	conIndex int,
 	cfg *config.Config,
+	u *upstream,
 	onEvent onNtproEvent) {
 
 	logx.Infof("New incoming ntproConnection %d", conIndex)
 
-	con := ntproConnection {
+	con := &ntproConnection {
 		index: conIndex,
 		sessions: make(map[types.NtproNetsessionId]*ntproSession),
 		onEvent: onEvent,
+		upstream: u,
 		state: initial}
 
 	onPack := func(buf []byte) error {
@@ -117,16 +130,14 @@ func handleNtproConn(
 		return nil
 	}
 
-	onDsc := func(e error) { processNtproDisconnect(&con, e) }
+	onDsc := func(e error) { processNtproDisconnect(con, e) }
 	
 	con.Process(c, onDsc, onPack, true, "ntpro con")
 }
 
 func processNtproDisconnect(c *ntproConnection,	err error) {
 	for _, session := range c.sessions {
-		for _, sub := range session.subscriptions {
-			sub.close()
-		}
+		session.closeSubscriptions()
 	}
 	c.Close()
 }
@@ -257,6 +268,9 @@ func (c *ntproConnection) readNtproEvent(buf []byte) (error) {
 }
 
 func (c *ntproConnection) writeHeartbeat() (error) {
+	c.wMu.Lock()
+	defer c.wMu.Unlock()
+
 	wBuf := c.wBuf[:1]
 	wBuf[0] = heartbeatData
 	logx.Infof("con %d: write heartbeat", c.index)
@@ -290,9 +304,99 @@ func (c *ntproConnection) processConEvent(eRaw types.NtproNetevent) (error) {
 	return nil
 }
 
+func newNtproSubscription(e types.NtproSubscribeChat, s *ntproSession) *ntproSubscription {
+	return &ntproSubscription{
+		subEvent: e,
+		session:  s,
+		done:     make(chan struct{}),
+	}
+}
+
+// close stops the subscription and its chat connection, it doesn't remove it from the session.
 func (sub *ntproSubscription) close() {
-	sub.chatCon.Close()
-	delete(sub.session.subscriptions, string(sub.subEvent.Id))
+	sub.mu.Lock()
+	defer sub.mu.Unlock()
+
+	if sub.closed {
+		return
+	}
+	sub.closed = true
+	close(sub.done)
+	if sub.chatCon != nil {
+		sub.chatCon.Close()
+	}
+}
+
+func (sub *ntproSubscription) isClosed() bool {
+	sub.mu.Lock()
+	defer sub.mu.Unlock()
+
+	return sub.closed
+}
+
+// setChatCon replaces the chat connection after a reconnect, false if the subscription is closed.
+func (sub *ntproSubscription) setChatCon(chatCon *tcpConnection) bool {
+	sub.mu.Lock()
+	defer sub.mu.Unlock()
+
+	if sub.closed {
+		return false
+	}
+	sub.chatCon = chatCon
+	return true
+}
+
+func (sub *ntproSubscription) write(buf []byte) error {
+	sub.mu.Lock()
+	chatCon := sub.chatCon
+	sub.mu.Unlock()
+
+	if chatCon == nil {
+		return fmt.Errorf("chat not connected")
+	}
+	return chatCon.Write(buf)
+}
+
+func (s *ntproSession) addSubscription(id string, sub *ntproSubscription) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, alreadyExists := s.subscriptions[id]; alreadyExists {
+		return false
+	}
+	s.subscriptions[id] = sub
+	return true
+}
+
+func (s *ntproSession) getSubscription(id string) *ntproSubscription {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.subscriptions[id]
+}
+
+// removeSubscription deletes id, if sub isn't nil only when it's still the registered one.
+func (s *ntproSession) removeSubscription(id string, sub *ntproSubscription) *ntproSubscription {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	old, ok := s.subscriptions[id]
+	if !ok || (sub != nil && old != sub) {
+		return nil
+	}
+	delete(s.subscriptions, id)
+	return old
+}
+
+func (s *ntproSession) closeSubscriptions() {
+	s.mu.Lock()
+	subscriptions := s.subscriptions
+	s.subscriptions = make(map[string]*ntproSubscription)
+	s.mu.Unlock()
+
+	for _, sub := range subscriptions {
+		sub.close()
+	}
 }
 
 func (c *ntproConnection) processDisconEvent(eRaw types.NtproNetevent) (error) {
@@ -302,9 +406,7 @@ func (c *ntproConnection) processDisconEvent(eRaw types.NtproNetevent) (error) {
 		return nil
 	}
 
-	for _, sub := range session.subscriptions {
-		sub.close()
-	}
+	session.closeSubscriptions()
 	delete(c.sessions, e.SessionId)
 	
 	logx.Infof("con %d: in session disconnected: %v", c.index, e)
@@ -4,7 +4,7 @@ import (
 	"time"
 	"fmt"
 	
-	"github.com/devops-ntpro/teamgram-server/app/interface/ntproxy/internal/server/ntproto/convert"
+	"github.com/teamgram/teamgram-server/app/interface/ntproxy/internal/server/ntproto/convert"
 )
 
 type NtproDatetime struct {
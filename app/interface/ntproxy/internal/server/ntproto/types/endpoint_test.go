@@ -3,7 +3,7 @@ package types
 import (
 	"testing"
 
-	"github.com/devops-ntpro/teamgram-server/app/interface/ntproxy/internal/server/ntproto/convert"
+	"github.com/teamgram/teamgram-server/app/interface/ntproxy/internal/server/ntproto/convert"
 )
 
 func TestDecodeEndpoint(t *testing.T) {
@@ -3,7 +3,7 @@ package types
 import (
 	"fmt"
 	
-	"github.com/devops-ntpro/teamgram-server/app/interface/ntproxy/internal/server/ntproto/convert"
+	"github.com/teamgram/teamgram-server/app/interface/ntproxy/internal/server/ntproto/convert"
 )
 
 type NtproEventtypeId struct {
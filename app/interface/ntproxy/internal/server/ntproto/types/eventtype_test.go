@@ -3,7 +3,7 @@ package types
 import (
 	"testing"
 
-	"github.com/devops-ntpro/teamgram-server/app/interface/ntproxy/internal/server/ntproto/convert"
+	"github.com/teamgram/teamgram-server/app/interface/ntproxy/internal/server/ntproto/convert"
 )
 
 func TestDecodeEventtype(t *testing.T) {
@@ -28,7 +28,7 @@ func TestDecodeEventtype(t *testing.T) {
 		}
 		cmpEventtypes(t, e, i.e)
 		s := e.String()
-		cmpStr(t, s, i.s, e)
+		cmpEventtypeStr(t, s, i.s, e)
 	}
 }
 
@@ -61,7 +61,7 @@ func TestEncodeEventtype(t *testing.T) {
 		cmpEventtypes(t, e, i.e)
 
 		s := e.String()
-		cmpStr(t, s, i.s, e)
+		cmpEventtypeStr(t, s, i.s, e)
 
 		var bts [8]byte
 		if err := e.Encode(bts[0:8]); err  != nil {
@@ -82,10 +82,10 @@ func cmpEventtypes(t *testing.T, e, ie NtproEventtypeId) {
 	}
 }
 
-func cmpStr(t *testing.T, s, is string, e NtproEventtypeId) {
+func cmpEventtypeStr(t *testing.T, s, is string, e NtproEventtypeId) {
 	if s != is {
 		t.Errorf(
-			"ToString(%bdmitry.chizhov) = %q, expected: %q",
+			"ToString(%b) = %q, expected: %q",
 			e.Value, s, is)
 	}
 }
@@ -80,7 +80,7 @@ func (e *NtproNetgateInfo) Encode(b *[]byte) (error) {
 	contentLen := 9 + cnt * 16
 	if cap(content) < contentLen {
 		return fmt.Errorf(
-			"cannot write netgateinfo: contents len %d, cnt %s",
+			"cannot write netgateinfo: contents len %d, cnt %d",
 			cap(content), cnt)
     }
 	
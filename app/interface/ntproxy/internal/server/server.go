@@ -21,11 +21,12 @@ package server
 import (
 	"net"
 	"fmt"
+	"time"
 	"github.com/teamgram/marmota/pkg/timer2"
 	"github.com/zeromicro/go-zero/core/logx"
 	
-	"github.com/devops-ntpro/teamgram-server/app/interface/ntproxy/internal/config"
-	"github.com/devops-ntpro/teamgram-server/app/interface/ntproxy/internal/server/ntproto/types"
+	"github.com/teamgram/teamgram-server/app/interface/ntproxy/internal/config"
+	"github.com/teamgram/teamgram-server/app/interface/ntproxy/internal/server/ntproto/types"
 )
 
 type Server struct {
@@ -33,6 +34,7 @@ type Server struct {
 	timer    *timer2.Timer // 32 * 2048
 	l        net.Listener
 	isClosed bool
+	upstream *upstream
 }
 
 func New(c config.Config) *Server {
@@ -42,6 +44,7 @@ func New(c config.Config) *Server {
 
 	s.c = &c
 	s.timer = timer2.NewTimer(1024)
+	s.upstream = newUpstream(s.c)
 	
 	logx.Infof("New: %v, %s", c, netEndpoint.String())
 
@@ -72,7 +75,7 @@ func (s *Server) Serve() (e error) {
 				continue
 			}
 			
-			handleNtproConn(conn.(*net.TCPConn), i, s.c, processNtproBusEvent)
+			handleNtproConn(conn.(*net.TCPConn), i, s.c, s.upstream, processNtproBusEvent)
 		}
 	} ()
 	
@@ -130,14 +133,12 @@ func (c *ntproConnection) processConnectChatEvent(
 	}
 
 	id := string(subEvent.Id)
-	if _, alreadyExists := s.subscriptions[id]; alreadyExists {
+	sub := newNtproSubscription(subEvent, s)
+	if !s.addSubscription(id, sub) {
 		return fmt.Errorf("subscription already exists %s", id)
 	}
 
-	sub := &ntproSubscription {	subEvent: subEvent, session: s }
-	s.subscriptions[id] = sub
-
-	go connectToChat(c, sub)
+	go runChatSubscription(c, sub)
 	return nil
 }
 
@@ -154,9 +155,8 @@ func (c *ntproConnection) processDisconnectChatEvent(
 	}
 
 	id := string(subEvent.Id)
-	if sub, alreadyExists := s.subscriptions[id]; alreadyExists {
-		sub.close();
-		delete(s.subscriptions, id)
+	if sub := s.removeSubscription(id, nil); sub != nil {
+		sub.close()
 	}
 
 	return nil
@@ -175,39 +175,102 @@ func (c *ntproConnection) processSendChatMsgEvent(
 	}
 
 	id := string(subEvent.Id)
-	sub, found := s.subscriptions[id]
-	if !found {
+	sub := s.getSubscription(id)
+	if sub == nil {
 		return fmt.Errorf("subscription not found for event %v", subEvent)
 	}
 
-	if err := sub.chatCon.Write(subEvent.Buf); err != nil {
+	if err := sub.write(subEvent.Buf); err != nil {
 		return fmt.Errorf("cannot send msg to chat: %v", err)
 	}
 
 	return nil
 }
 
-func connectToChat(
+// runChatSubscription keeps the subscription connected to a gateway until it's closed.
+// NtproChatConnected/NtproChatDisconnected are sent only when the state seen by ntpro changes,
+// so a subscription which can't reach a gateway reports disconnected once and keeps retrying.
+func runChatSubscription(
 	c *ntproConnection,
 	sub *ntproSubscription) {
 
-	// Открываем соединение.
-	conn, err := net.Dial("tcp", "localhost:10443")
+	var (
+		id        = string(sub.subEvent.Id)
+		b         = backoff{c: c.upstream.reconnect}
+		connected = false
+	)
+
+	for {
+		dsc, err := connectToChat(c, sub)
+		if err == nil {
+			if !connected {
+				if err = sendChatConnected(c, sub); err != nil {
+					logx.Infof("%s: cannot send connected event: %v", c.prefix, err)
+				}
+				connected = true
+			}
+			b.reset()
+
+			select {
+			case err = <-dsc:
+			case <-sub.done:
+				return
+			}
+		}
+
+		if sub.isClosed() {
+			return
+		}
+
+		logx.Infof("%s: chat %s disconnected: %v", c.prefix, id, err)
+		if connected || b.attempts == 0 {
+			sendChatDisconnected(c, sub)
+			connected = false
+		}
+
+		delay, ok := b.next()
+		if !ok {
+			logx.Infof("%s: chat %s reconnect stopped: %s", c.prefix, id, b.String())
+			sub.session.removeSubscription(id, sub)
+			sub.close()
+			return
+		}
+
+		select {
+		case <-time.After(delay):
+		case <-sub.done:
+			return
+		}
+	}
+}
+
+// connectToChat dials a gateway, the returned channel receives the error which closed the connection.
+func connectToChat(
+	c *ntproConnection,
+	sub *ntproSubscription) (<-chan error, error) {
+
+	conn, err := c.upstream.dial(string(sub.subEvent.Id))
 	if err != nil {
-		logx.Infof("Cannot establish chat connection for %v", *sub)
-		sendChatDisconnected(c, sub)
-		return 
+		logx.Infof("Cannot establish chat connection for %v: %v", sub.subEvent, err)
+		return nil, err
 	}
 
-	onDsc := func(err error) { processChatDisconnected(c, sub, err) }
+	var (
+		chatCon = &tcpConnection{}
+		dsc     = make(chan error, 1)
+	)
+
+	onDsc := func(err error) { dsc <- err }
 	onPack := func(b []byte) error { return readChatMsg(c, sub, b) }
-	
-	sub.chatCon.Process(conn.(*net.TCPConn), onDsc, onPack, false, "chat con")
 
-	if err = sendChatConnected(c, sub); err != nil {
-		logx.Infof("Cannot send connected event: %v", err)
-		sendChatDisconnected(c, sub)
+	chatCon.Process(conn, onDsc, onPack, false, "chat con")
+
+	if !sub.setChatCon(chatCon) {
+		chatCon.Close()
+		return nil, fmt.Errorf("subscription closed")
 	}
+
+	return dsc, nil
 }
 
 func sendChatConnected(c *ntproConnection, sub *ntproSubscription) error {
@@ -219,17 +282,18 @@ func sendChatConnected(c *ntproConnection, sub *ntproSubscription) error {
 	return nil
 }
 
-
 func sendChatDisconnected(c *ntproConnection, sub *ntproSubscription) {
 	e := sub.subEvent.NewChatDisconnectedEvent()
-	sub.close()
-	
+
 	if err := sendChatEventToNtpro(c, &e); err != nil {
 		logx.Infof("%s: cannot send disconnect event: %v", c.prefix, err)
 	}
 }
 
 func sendChatEventToNtpro(c *ntproConnection, e Encoder) error {
+	c.wMu.Lock()
+	defer c.wMu.Unlock()
+
 	buf := c.wBuf[:0]
 	if err := e.Encode(&buf); err != nil {
 		return err
@@ -237,15 +301,6 @@ func sendChatEventToNtpro(c *ntproConnection, e Encoder) error {
 	return c.Write(buf)
 }
 
-func processChatDisconnected(
-	c *ntproConnection,
-	sub *ntproSubscription,
-	err error) {
-
-	logx.Infof("%s: chat disconnected: %v", sub.chatCon.prefix, err)
-	sendChatDisconnected(c, sub)
-}
-
 func readChatMsg(c *ntproConnection, sub *ntproSubscription, buf []byte) error {
 	e := sub.subEvent.NewChatMsgReceivedEvent(buf)
 	
@@ -5,8 +5,8 @@ import (
 	"syscall"
 
 	"github.com/teamgram/marmota/pkg/commands"
-	"github.com/devops-ntpro/teamgram-server/app/interface/ntproxy/internal/config"
-	"github.com/devops-ntpro/teamgram-server/app/interface/ntproxy/internal/server"
+	"github.com/teamgram/teamgram-server/app/interface/ntproxy/internal/config"
+	"github.com/teamgram/teamgram-server/app/interface/ntproxy/internal/server"
 
 	"github.com/zeromicro/go-zero/core/conf"
 	"github.com/zeromicro/go-zero/core/logx"
@@ -0,0 +1,271 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package server
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+	"net"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/teamgram/teamgram-server/app/interface/ntproxy/internal/config"
+
+	"github.com/zeromicro/go-zero/core/discov"
+	"github.com/zeromicro/go-zero/core/hash"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+const (
+	balancerRoundRobin     = "round_robin"
+	balancerRandom         = "random"
+	balancerConsistentHash = "consistent_hash"
+)
+
+var (
+	errNoUpstream = errors.New("no upstream gateway available")
+)
+
+// upstream dials the gateways chat subscriptions are proxied to.
+type upstream struct {
+	sets      []*upstreamSet
+	reconnect config.BackoffConf
+}
+
+func newUpstream(c *config.Config) *upstream {
+	if len(c.Upstreams) == 0 {
+		logx.Must(errors.New("ntproxy: Upstreams is empty"))
+	}
+
+	u := &upstream{
+		reconnect: c.Reconnect,
+	}
+	for _, uc := range c.Upstreams {
+		u.sets = append(u.sets, newUpstreamSet(uc))
+	}
+
+	return u
+}
+
+// dial connects to the first reachable gateway, key is used by the consistent_hash balancer.
+func (u *upstream) dial(key string) (*net.TCPConn, error) {
+	var lastErr = errNoUpstream
+
+	for _, s := range u.sets {
+		for _, addr := range s.pick(key) {
+			conn, err := net.DialTimeout("tcp", addr, time.Duration(s.c.DialTimeout)*time.Millisecond)
+			if err != nil {
+				logx.Errorf("upstream %s: dial %s error: %v", s.c.Name, addr, err)
+				s.markFailed(addr)
+				lastErr = err
+				continue
+			}
+			logx.Infof("upstream %s: connected to %s for %s", s.c.Name, addr, key)
+			return conn.(*net.TCPConn), nil
+		}
+	}
+
+	return nil, lastErr
+}
+
+type upstreamSet struct {
+	c config.UpstreamConf
+
+	mu         sync.Mutex
+	addrs      []string
+	dispatcher *hash.ConsistentHash
+	next       int
+	failed     map[string]time.Time
+}
+
+func newUpstreamSet(c config.UpstreamConf) *upstreamSet {
+	s := &upstreamSet{
+		c:      c,
+		failed: make(map[string]time.Time),
+	}
+
+	s.update(nil)
+	if len(c.Etcd.Hosts) > 0 {
+		s.watch()
+	}
+
+	return s
+}
+
+func (s *upstreamSet) watch() {
+	sub, err := discov.NewSubscriber(s.c.Etcd.Hosts, s.c.Etcd.Key)
+	if err != nil {
+		logx.Errorf("upstream %s: watch etcd(%v) error: %v", s.c.Name, s.c.Etcd, err)
+		return
+	}
+
+	update := func() {
+		s.update(sub.Values())
+	}
+
+	sub.AddListener(update)
+	update()
+}
+
+// update merges the static endpoints with the discovered ones.
+func (s *upstreamSet) update(discovered []string) {
+	var (
+		addrs = make([]string, 0, len(s.c.Endpoints)+len(discovered))
+		seen  = make(map[string]bool)
+	)
+
+	add := func(addr string) {
+		if !seen[addr] {
+			seen[addr] = true
+			addrs = append(addrs, addr)
+		}
+	}
+	for _, addr := range s.c.Endpoints {
+		add(addr)
+	}
+	for _, v := range discovered {
+		addr, err := s.resolve(v)
+		if err != nil {
+			logx.Errorf("upstream %s: invalid address %s: %v", s.c.Name, v, err)
+			continue
+		}
+		add(addr)
+	}
+
+	dispatcher := hash.NewConsistentHash()
+	for _, addr := range addrs {
+		dispatcher.Add(addr)
+	}
+
+	s.mu.Lock()
+	s.addrs = addrs
+	s.dispatcher = dispatcher
+	for addr := range s.failed {
+		if !seen[addr] {
+			delete(s.failed, addr)
+		}
+	}
+	s.mu.Unlock()
+
+	logx.Infof("upstream %s: endpoints %v", s.c.Name, addrs)
+}
+
+func (s *upstreamSet) resolve(v string) (string, error) {
+	if s.c.Port == 0 {
+		return v, nil
+	}
+
+	host, _, err := net.SplitHostPort(v)
+	if err != nil {
+		return "", err
+	}
+
+	return net.JoinHostPort(host, strconv.Itoa(s.c.Port)), nil
+}
+
+// pick returns the endpoints in the order they should be dialed,
+// endpoints which failed recently go last so a set never runs out of candidates.
+func (s *upstreamSet) pick(key string) []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	n := len(s.addrs)
+	if n == 0 {
+		return nil
+	}
+
+	ordered := make([]string, 0, n)
+	switch s.c.Balancer {
+	case balancerRandom:
+		for _, i := range rand.Perm(n) {
+			ordered = append(ordered, s.addrs[i])
+		}
+	case balancerConsistentHash:
+		first, _ := s.dispatcher.Get(key)
+		ordered = append(ordered, first.(string))
+		for _, addr := range s.addrs {
+			if addr != first {
+				ordered = append(ordered, addr)
+			}
+		}
+	default:
+		s.next = (s.next + 1) % n
+		ordered = append(ordered, s.addrs[s.next:]...)
+		ordered = append(ordered, s.addrs[:s.next]...)
+	}
+
+	var (
+		now     = time.Now()
+		healthy = make([]string, 0, n)
+		failed  []string
+	)
+	for _, addr := range ordered {
+		if until, ok := s.failed[addr]; ok {
+			if now.Before(until) {
+				failed = append(failed, addr)
+				continue
+			}
+			delete(s.failed, addr)
+		}
+		healthy = append(healthy, addr)
+	}
+
+	return append(healthy, failed...)
+}
+
+func (s *upstreamSet) markFailed(addr string) {
+	s.mu.Lock()
+	s.failed[addr] = time.Now().Add(time.Duration(s.c.FailTimeout) * time.Millisecond)
+	s.mu.Unlock()
+}
+
+// backoff is the delay between reconnects of one subscription.
+type backoff struct {
+	c        config.BackoffConf
+	attempts int
+}
+
+func (b *backoff) reset() {
+	b.attempts = 0
+}
+
+// next returns the delay before the next reconnect, false when MaxRetries is exhausted.
+func (b *backoff) next() (time.Duration, bool) {
+	if b.c.MaxRetries > 0 && b.attempts >= b.c.MaxRetries {
+		return 0, false
+	}
+
+	d := float64(b.c.MinDelay) * math.Pow(b.c.Factor, float64(b.attempts))
+	if d > float64(b.c.MaxDelay) {
+		d = float64(b.c.MaxDelay)
+	}
+	b.attempts++
+
+	// jitter, so subscriptions lost together don't reconnect together
+	d = d/2 + rand.Float64()*d/2
+
+	return time.Duration(d) * time.Millisecond, true
+}
+
+func (b *backoff) String() string {
+	return fmt.Sprintf("{attempts: %d, max_retries: %d}", b.attempts, b.c.MaxRetries)
+}
@@ -0,0 +1,83 @@
+package server
+
+import (
+	"testing"
+	"time"
+
+	"github.com/teamgram/teamgram-server/app/interface/ntproxy/internal/config"
+)
+
+func TestUpstreamSetPick(t *testing.T) {
+	s := newUpstreamSet(config.UpstreamConf{
+		Endpoints:   []string{"a:1", "b:1", "c:1"},
+		Balancer:    balancerRoundRobin,
+		FailTimeout: 10000,
+	})
+
+	first := s.pick("")
+	second := s.pick("")
+	if len(first) != 3 || first[0] == second[0] {
+		t.Fatalf("round robin: %v, %v", first, second)
+	}
+
+	s.markFailed("b:1")
+	for i := 0; i < 3; i++ {
+		if addrs := s.pick(""); addrs[2] != "b:1" {
+			t.Fatalf("failed endpoint isn't last: %v", addrs)
+		}
+	}
+
+	s.failed["b:1"] = time.Now().Add(-time.Second)
+	found := false
+	for i := 0; i < 3; i++ {
+		found = found || s.pick("")[0] == "b:1"
+	}
+	if !found {
+		t.Fatal("expired endpoint isn't back in rotation")
+	}
+}
+
+func TestUpstreamSetConsistentHash(t *testing.T) {
+	s := newUpstreamSet(config.UpstreamConf{
+		Endpoints: []string{"a:1", "b:1", "c:1"},
+		Balancer:  balancerConsistentHash,
+	})
+
+	first := s.pick("chat-1")[0]
+	for i := 0; i < 10; i++ {
+		if addr := s.pick("chat-1")[0]; addr != first {
+			t.Fatalf("consistent hash: %s != %s", addr, first)
+		}
+	}
+}
+
+func TestUpstreamSetResolve(t *testing.T) {
+	s := newUpstreamSet(config.UpstreamConf{Endpoints: []string{"a:1"}, Port: 10443})
+	s.update([]string{"10.0.0.1:20110", "a:1"})
+
+	if addrs := s.pick(""); len(addrs) != 3 {
+		t.Fatalf("update: %v", addrs)
+	}
+	if _, err := s.resolve("10.0.0.1"); err == nil {
+		t.Fatal("resolve without port")
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	b := backoff{c: config.BackoffConf{MinDelay: 100, MaxDelay: 1000, Factor: 2, MaxRetries: 5}}
+
+	for i, max := range []int64{100, 200, 400, 800, 1000} {
+		d, ok := b.next()
+		if !ok || d < time.Duration(max/2)*time.Millisecond || d > time.Duration(max)*time.Millisecond {
+			t.Fatalf("attempt %d: %v, %v", i, d, ok)
+		}
+	}
+	if _, ok := b.next(); ok {
+		t.Fatal("MaxRetries exceeded")
+	}
+
+	b.reset()
+	if _, ok := b.next(); !ok {
+		t.Fatal("reset")
+	}
+}
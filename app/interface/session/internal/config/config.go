@@ -11,6 +11,7 @@ package config
 
 import (
 	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/redis"
 	"github.com/zeromicro/go-zero/zrpc"
 )
 
@@ -21,6 +22,7 @@ type Config struct {
 	StatusClient    zrpc.RpcClientConf
 	GatewayClient   zrpc.RpcClientConf
 	BFFProxyClients BFFProxyClients
+	FloodLimit      FloodLimitConf `json:",optional"`
 }
 
 // Routine routine.
@@ -33,3 +35,22 @@ type BFFProxyClients struct {
 	Clients []zrpc.RpcClientConf
 	IDMap   map[string]string
 }
+
+// FloodLimitConf the requests over the quota of a rule are answered with FLOOD_WAIT_X,
+// the counters are kept in Redis and shared by the session instances.
+type FloodLimitConf struct {
+	Redis redis.RedisConf  `json:",optional"`
+	Rules []FloodLimitRule `json:",optional"`
+}
+
+// FloodLimitRule allows Quota requests of Method in every Period seconds for a user, an
+// auth key or an ip. Method is the TL type name, e.g. TLMessagesSendMessage, or * for
+// every method. The requests over a Premium rule get FLOOD_PREMIUM_WAIT_X unless the
+// user is premium.
+type FloodLimitRule struct {
+	Method  string
+	Key     string `json:",options=user|auth_key|ip"`
+	Period  int
+	Quota   int
+	Premium bool `json:",optional"`
+}
@@ -26,6 +26,7 @@ type Dao struct {
 	authsession_client.AuthsessionClient
 	status_client.StatusClient
 	*bff_proxy_client.BFFProxyClient
+	floodLimiter *floodLimiter
 }
 
 func New(c config.Config) *Dao {
@@ -34,6 +35,7 @@ func New(c config.Config) *Dao {
 		AuthsessionClient: authsession_client.NewAuthsessionClient(zrpc.MustNewClient(c.AuthSession)),
 		BFFProxyClient:    bff_proxy_client.NewBFFProxyClients(c.BFFProxyClients.Clients, c.BFFProxyClients.IDMap),
 		StatusClient:      status_client.NewStatusClient(zrpc.MustNewClient(c.StatusClient)),
+		floodLimiter:      newFloodLimiter(c.FloodLimit),
 	}
 }
 
@@ -0,0 +1,175 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package dao
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/interface/session/internal/config"
+
+	"github.com/zeromicro/go-zero/core/limit"
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/metric"
+	"github.com/zeromicro/go-zero/core/stores/redis"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	floodLimitKeyUser    = "user"
+	floodLimitKeyAuthKey = "auth_key"
+	floodLimitKeyIp      = "ip"
+
+	floodLimitAllMethods = "*"
+)
+
+var floodLimitRequests = metric.NewCounterVec(&metric.CounterVecOpts{
+	Namespace: "session",
+	Subsystem: "flood_limit",
+	Name:      "requests_total",
+	Help:      "session flood limit requests, result is allowed or limited.",
+	Labels:    []string{"method", "key", "result"},
+})
+
+type floodLimitRule struct {
+	config.FloodLimitRule
+	limiter *limit.PeriodLimit
+}
+
+// floodLimiter the rules by method, the windows of a rule are aligned so that the wait
+// of FLOOD_WAIT_X is the end of the current window.
+type floodLimiter struct {
+	rules     map[string][]*floodLimitRule
+	isPremium func(userId int64) bool
+	now       func() int64
+}
+
+func newFloodLimiter(c config.FloodLimitConf) *floodLimiter {
+	if len(c.Rules) == 0 {
+		return nil
+	}
+
+	return newFloodLimiterWithStore(c.Redis.NewRedis(), c.Rules)
+}
+
+func newFloodLimiterWithStore(store *redis.Redis, rules []config.FloodLimitRule) *floodLimiter {
+	l := &floodLimiter{
+		rules: make(map[string][]*floodLimitRule),
+		// TODO: there are no premium subscriptions yet, no user is premium
+		isPremium: func(userId int64) bool { return false },
+		now: func() int64 {
+			return time.Now().Unix()
+		},
+	}
+	for _, r := range rules {
+		if r.Period <= 0 || r.Quota <= 0 {
+			logx.Errorf("newFloodLimiter - invalid rule: %+v", r)
+			continue
+		}
+		l.rules[r.Method] = append(l.rules[r.Method], &floodLimitRule{
+			FloodLimitRule: r,
+			limiter:        newPeriodLimit(store, r),
+		})
+	}
+
+	return l
+}
+
+func newPeriodLimit(store *redis.Redis, r config.FloodLimitRule) *limit.PeriodLimit {
+	return limit.NewPeriodLimit(r.Period, r.Quota, store, fmt.Sprintf("flood_limit#%s#%s#%d#", r.Method, r.Key, r.Period), limit.Align())
+}
+
+// floodLimitKey returns the bucket of the request in the rule, the requests without
+// a user are not limited by the user rules.
+func floodLimitKey(key string, userId, authKeyId int64, clientIp string) (string, bool) {
+	switch key {
+	case floodLimitKeyUser:
+		return strconv.FormatInt(userId, 10), userId != 0
+	case floodLimitKeyAuthKey:
+		return strconv.FormatInt(authKeyId, 10), authKeyId != 0
+	case floodLimitKeyIp:
+		return clientIp, clientIp != ""
+	default:
+		return "", false
+	}
+}
+
+// floodWaitSeconds returns the seconds until the end of the aligned window at now.
+func floodWaitSeconds(period int, now int64) int32 {
+	return int32(int64(period) - now%int64(period))
+}
+
+func makeFloodWaitError(premium bool, second int32) error {
+	if premium {
+		return status.Errorf(mtproto.ErrFlood, "FLOOD_PREMIUM_WAIT_%d", second)
+	}
+	return mtproto.NewErrFloodWaitX(second)
+}
+
+// check returns the error of the rule with the longest wait, a request over the quota
+// of a rule still counts in the others. The requests pass if Redis fails. A premium
+// user gets FLOOD_WAIT_X on a Premium rule, premium can't lift the limit any further.
+func (l *floodLimiter) check(method string, userId, authKeyId int64, clientIp string) error {
+	var (
+		wait    int32
+		premium bool
+		now     = l.now()
+	)
+
+	for _, rules := range [][]*floodLimitRule{l.rules[method], l.rules[floodLimitAllMethods]} {
+		for _, r := range rules {
+			key, ok := floodLimitKey(r.Key, userId, authKeyId, clientIp)
+			if !ok {
+				continue
+			}
+
+			code, err := r.limiter.Take(key)
+			if err != nil {
+				logx.Errorf("floodLimiter.check(%s, %s, %s) - error: %v", method, r.Key, key, err)
+				continue
+			}
+
+			if code == limit.OverQuota {
+				floodLimitRequests.Inc(method, r.Key, "limited")
+				if w := floodWaitSeconds(r.Period, now); w > wait {
+					wait = w
+					premium = r.Premium
+				}
+			} else {
+				floodLimitRequests.Inc(method, r.Key, "allowed")
+			}
+		}
+	}
+
+	if wait > 0 {
+		return makeFloodWaitError(premium && !l.isPremium(userId), wait)
+	}
+	return nil
+}
+
+// CheckFloodLimit returns FLOOD_WAIT_X or FLOOD_PREMIUM_WAIT_X if the request of method,
+// the TL type name, is over a flood limit.
+func (d *Dao) CheckFloodLimit(method string, userId, authKeyId int64, clientIp string) error {
+	if d.floodLimiter == nil {
+		return nil
+	}
+	return d.floodLimiter.check(method, userId, authKeyId, clientIp)
+}
@@ -0,0 +1,138 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package dao
+
+import (
+	"testing"
+
+	"github.com/teamgram/teamgram-server/app/interface/session/internal/config"
+
+	"github.com/zeromicro/go-zero/core/stores/redis/redistest"
+	"google.golang.org/grpc/status"
+)
+
+func TestFloodLimitKey(t *testing.T) {
+	for _, c := range []struct {
+		key  string
+		want string
+		ok   bool
+	}{
+		{floodLimitKeyUser, "1", true},
+		{floodLimitKeyAuthKey, "2", true},
+		{floodLimitKeyIp, "127.0.0.1", true},
+		{"phone", "", false},
+	} {
+		if v, ok := floodLimitKey(c.key, 1, 2, "127.0.0.1"); v != c.want || ok != c.ok {
+			t.Errorf("floodLimitKey(%s) = %s, %v, want %s, %v", c.key, v, ok, c.want, c.ok)
+		}
+	}
+
+	if _, ok := floodLimitKey(floodLimitKeyUser, 0, 2, "127.0.0.1"); ok {
+		t.Errorf("floodLimitKey: the requests without a user are limited by the user rules")
+	}
+}
+
+func TestFloodWaitSeconds(t *testing.T) {
+	for _, c := range []struct {
+		period int
+		now    int64
+		want   int32
+	}{
+		{1, 1000, 1},
+		{60, 1200, 60},
+		{60, 1259, 1},
+		{3600, 3600*10 + 600, 3000},
+	} {
+		if v := floodWaitSeconds(c.period, c.now); v != c.want {
+			t.Errorf("floodWaitSeconds(%d, %d) = %d, want %d", c.period, c.now, v, c.want)
+		}
+	}
+}
+
+func TestMakeFloodWaitError(t *testing.T) {
+	if s := status.Convert(makeFloodWaitError(false, 30)); s.Code() != 420 || s.Message() != "FLOOD_WAIT_30" {
+		t.Errorf("makeFloodWaitError(false, 30) = %v", s)
+	}
+	if s := status.Convert(makeFloodWaitError(true, 5)); s.Code() != 420 || s.Message() != "FLOOD_PREMIUM_WAIT_5" {
+		t.Errorf("makeFloodWaitError(true, 5) = %v", s)
+	}
+}
+
+func TestNewFloodLimiter(t *testing.T) {
+	if newFloodLimiter(config.FloodLimitConf{}) != nil {
+		t.Errorf("newFloodLimiter without rules")
+	}
+
+	l := newFloodLimiter(config.FloodLimitConf{
+		Rules: []config.FloodLimitRule{
+			{Method: "TLMessagesSendMessage", Key: floodLimitKeyUser, Period: 1, Quota: 30},
+			{Method: "TLMessagesSendMessage", Key: floodLimitKeyIp, Period: 60, Quota: 600},
+			{Method: floodLimitAllMethods, Key: floodLimitKeyAuthKey, Period: 1, Quota: 100},
+			{Method: "TLContactsResolveUsername", Key: floodLimitKeyUser, Period: 0, Quota: 10},
+		},
+	})
+	if len(l.rules["TLMessagesSendMessage"]) != 2 || len(l.rules[floodLimitAllMethods]) != 1 {
+		t.Errorf("newFloodLimiter: %v", l.rules)
+	}
+	if len(l.rules["TLContactsResolveUsername"]) != 0 {
+		t.Errorf("newFloodLimiter: the invalid rules are kept")
+	}
+}
+
+func TestFloodLimiterCheck(t *testing.T) {
+	r, clean, err := redistest.CreateRedis()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer clean()
+
+	l := newFloodLimiterWithStore(r, []config.FloodLimitRule{
+		{Method: "TLMessagesSendMessage", Key: floodLimitKeyUser, Period: 60, Quota: 2},
+		{Method: floodLimitAllMethods, Key: floodLimitKeyIp, Period: 3600, Quota: 2, Premium: true},
+	})
+	l.now = func() int64 { return 3600*10 + 600 }
+
+	for i := 0; i < 2; i++ {
+		if err = l.check("TLMessagesSendMessage", 1, 2, "127.0.0.1"); err != nil {
+			t.Fatalf("check #%d: %v", i, err)
+		}
+	}
+
+	// over the quota of both rules, the ip rule has the longest wait
+	if s := status.Convert(l.check("TLMessagesSendMessage", 1, 2, "127.0.0.1")); s.Code() != 420 || s.Message() != "FLOOD_PREMIUM_WAIT_3000" {
+		t.Errorf("check: over quota = %v, want FLOOD_PREMIUM_WAIT_3000", s)
+	}
+
+	// only the user rule is over the quota
+	if s := status.Convert(l.check("TLMessagesSendMessage", 1, 2, "127.0.0.2")); s.Code() != 420 || s.Message() != "FLOOD_WAIT_60" {
+		t.Errorf("check: over the user quota = %v, want FLOOD_WAIT_60", s)
+	}
+
+	// a premium user isn't offered premium
+	l.isPremium = func(userId int64) bool { return userId == 3 }
+	if s := status.Convert(l.check("TLMessagesGetHistory", 3, 2, "127.0.0.1")); s.Code() != 420 || s.Message() != "FLOOD_WAIT_3000" {
+		t.Errorf("check: premium user = %v, want FLOOD_WAIT_3000", s)
+	}
+
+	// the requests pass if Redis fails
+	clean()
+	if err = l.check("TLMessagesSendMessage", 1, 2, "127.0.0.1"); err != nil {
+		t.Errorf("check: Redis error = %v, want nil", err)
+	}
+}
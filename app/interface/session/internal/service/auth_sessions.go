@@ -31,7 +31,6 @@ import (
 	"github.com/teamgram/marmota/pkg/sync2"
 	"github.com/teamgram/proto/mtproto"
 	"github.com/teamgram/proto/mtproto/rpc/metadata"
-	"github.com/teamgram/teamgram-server/app/service/authsession/authsession"
 	"github.com/teamgram/teamgram-server/app/service/status/status"
 
 	"github.com/zeromicro/go-zero/core/logx"
@@ -159,6 +158,8 @@ type authSessions struct {
 	clientType      int
 	nextNotifyId    int64
 	nextPushId      int64
+	// the sessions of a bound temp key are closed when it expires
+	tempKeyExpiresAt sync2.AtomicInt64
 	*Service
 }
 
@@ -458,6 +459,12 @@ func (s *authSessions) rpcRunLoop() {
 }
 
 func (s *authSessions) onTimer() {
+	if expiresAt := s.tempKeyExpiresAt.Get(); expiresAt > 0 && expiresAt <= time.Now().Unix() {
+		logx.Infof("onTimer - temp auth_key(%d) expired", s.authKeyId)
+		s.closeAuthKey()
+		return
+	}
+
 	for _, sess := range s.sessions {
 		if (sess.isGeneric && sess.sessionOnline()) ||
 			sess.isAndroidPush && sess.sessionOnline() {
@@ -478,6 +485,16 @@ func (s *authSessions) onTimer() {
 	}()
 }
 
+// closeAuthKey closes the sessions of a key that authsession reset, dropped or expired,
+// the gateways drop their connections and cached copy of the key too.
+func (s *authSessions) closeAuthKey() {
+	s.Dao.PutCacheUserId(context.Background(), s.authKeyId, 0)
+	go func() {
+		s.DeleteByAuthKeyId(s.authKeyId)
+		s.CloseAuthKeyOnGateways(context.Background(), s.authKeyId)
+	}()
+}
+
 /////////////////////////////////////////////////////////////////////////////////////////////////////
 // client
 func (s *authSessions) sessionClientNew(gatewayId string, sessionId int64) error {
@@ -677,8 +694,7 @@ func (s *authSessions) onSyncData(syncMsg *syncData) {
 			if s.AuthUserId != upds.GetUserId() {
 				logx.Errorf("upds -- ", upds)
 			}
-			s.Dao.PutCacheUserId(context.Background(), s.authKeyId, 0)
-			s.DeleteByAuthKeyId(s.authKeyId)
+			s.closeAuthKey()
 			s.AuthUserId = 0
 			return
 		} else {
@@ -743,19 +759,7 @@ func (s *authSessions) onRpcRequest(request *rpcApiMessage) bool {
 	}
 
 	// TODO(@benqi): change state.
-	switch request.reqMsg.(type) {
-	case *mtproto.TLAuthBindTempAuthKey:
-		r := request.reqMsg.(*mtproto.TLAuthBindTempAuthKey)
-		rpcResult, err = s.Service.Dao.AuthsessionClient.AuthsessionBindTempAuthKey(context.Background(), &authsession.TLAuthsessionBindTempAuthKey{
-			PermAuthKeyId:    r.PermAuthKeyId,
-			Nonce:            r.Nonce,
-			ExpiresAt:        r.ExpiresAt,
-			EncryptedMessage: r.EncryptedMessage,
-		})
-		// request.reqMsg.(*mtproto.TLAuthBindTempAuthKey))
-	default:
-		rpcResult, err = s.Service.Dao.Invoke(rpcMetadata, request.reqMsg)
-	}
+	rpcResult, err = s.Service.Dao.Invoke(rpcMetadata, request.reqMsg)
 
 	reply := &mtproto.TLRpcResult{
 		ReqMsgId: request.reqMsgId,
@@ -779,5 +783,9 @@ func (s *authSessions) onRpcRequest(request *rpcApiMessage) bool {
 		logx.Infof("authLogOut - %#v", rpcMetadata)
 		s.Dao.PutCacheUserId(context.Background(), s.authKeyId, 0)
 	}
+	if r, ok := request.reqMsg.(*mtproto.TLAuthBindTempAuthKey); ok && err == nil {
+		logx.Infof("authBindTempAuthKey - %#v", rpcMetadata)
+		s.tempKeyExpiresAt.Set(int64(r.ExpiresAt))
+	}
 	return true
 }
@@ -62,8 +62,9 @@ import (
 func checkRpcWithoutLogin(tl mtproto.TLObject) bool {
 	switch tl.(type) {
 	// account
-	// case *mtproto.TLAccountGetPassword:
-	//	return true
+	case *mtproto.TLAccountGetPassword,
+		*mtproto.TLAccountResetPassword:
+		return true
 
 	// auth
 	case *mtproto.TLAuthSendCode,
@@ -74,9 +75,12 @@ func checkRpcWithoutLogin(tl mtproto.TLObject) bool {
 		*mtproto.TLAuthExportedAuthorization,
 		*mtproto.TLAuthExportAuthorization,
 		*mtproto.TLAuthImportAuthorization,
+		*mtproto.TLAuthImportBotAuthorization,
 		*mtproto.TLAuthCancelCode,
-		// *mtproto.TLAuthRequestPasswordRecovery,	// TODO: before process, try fetch usrId
-		// *mtproto.TLAuthRecoverPassword,			// TODO: before process, try fetch usrId
+		*mtproto.TLAuthCheckPassword,
+		*mtproto.TLAuthRequestPasswordRecovery,
+		*mtproto.TLAuthCheckRecoveryPassword,
+		*mtproto.TLAuthRecoverPassword,
 		*mtproto.TLAuthExportLoginToken,
 		*mtproto.TLAuthAcceptLoginToken,
 		*mtproto.TLAuthLogOut, // TODO: before process, try fetch usrId
@@ -99,6 +103,10 @@ func checkRpcWithoutLogin(tl mtproto.TLObject) bool {
 		*mtproto.TLLangpackGetLanguage:
 		return true
 
+	// cdn dc, the auth_key is never bound to a user
+	case *mtproto.TLUploadGetCdnFile:
+		return true
+
 	// TODO(@benqi): debug.
 	case *mtproto.TLUploadGetWebFile,
 		*mtproto.TLUploadGetFile:
@@ -115,3 +123,19 @@ func checkRpcWithoutLogin(tl mtproto.TLObject) bool {
 		return false
 	}
 }
+
+// checkRpcTryFetchUserId the two-step verification rpc can be called both
+// before auth.checkPassword and by a logged in user, so try fetch userId.
+func checkRpcTryFetchUserId(tl mtproto.TLObject) bool {
+	switch tl.(type) {
+	case *mtproto.TLAccountGetPassword,
+		*mtproto.TLAccountResetPassword,
+		*mtproto.TLAuthCheckPassword,
+		*mtproto.TLAuthRequestPasswordRecovery,
+		*mtproto.TLAuthCheckRecoveryPassword,
+		*mtproto.TLAuthRecoverPassword:
+		return true
+	}
+
+	return false
+}
@@ -89,6 +89,27 @@ func (c *Gateway) SendDataToGate(ctx context.Context, authKeyId, sessionId int64
 	return
 }
 
+// CloseAuthKey
+// gateway.closeAuthKey auth_key_id:long = Bool;
+func (c *Gateway) CloseAuthKey(ctx context.Context, authKeyId int64) (b bool, err error) {
+	var (
+		res *mtproto.Bool
+	)
+
+	res, err = c.client.GatewayCloseAuthKey(ctx, &gateway.TLGatewayCloseAuthKey{
+		AuthKeyId: authKeyId,
+	})
+
+	if err != nil {
+		logx.Errorf("closeAuthKey error: %v", err)
+		b = false
+		return
+	}
+
+	b = mtproto.FromBool(res)
+	return
+}
+
 //// NewGateway
 //// NewComet new a comet.
 //func NewGateway(data *naming.Instance, conf *Config, options gatewayOptions) (*Gateway, error) {
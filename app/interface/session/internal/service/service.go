@@ -191,6 +191,15 @@ func (s *Service) SendHttpDataToGateway(ctx context.Context, ch chan interface{}
 	}
 }
 
+// CloseAuthKeyOnGateways the key may be cached by every gateway, not only by the ones its sessions are on.
+func (s *Service) CloseAuthKeyOnGateways(ctx context.Context, authKeyId int64) {
+	for gatewayId, c := range s.eGateServers {
+		if _, err := c.CloseAuthKey(ctx, authKeyId); err != nil {
+			logx.WithContext(ctx).Errorf("closeAuthKey - error: %v, {gatewayId: %s, authKeyId: %d}", err, gatewayId, authKeyId)
+		}
+	}
+}
+
 //func (s *Service) PushUpdatesToNpns(ctx context.Context, authKeyId int64, updates *mtproto.Updates) {
 //	s.npnsClient.PushUpdates(ctx, &npnspb.PushUpdates{
 //		AuthKeyId: authKeyId,
@@ -26,6 +26,7 @@ import (
 	"github.com/teamgram/proto/mtproto"
 
 	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/status"
 )
 
 func (c *session) onInvokeWithLayer(gatewayId, clientIp string, msgId *inboxMsg, request *mtproto.TLInvokeWithLayer) {
@@ -417,9 +418,24 @@ func (c *session) onRpcRequest(gatewayId, clientIp string, msgId *inboxMsg, quer
 			} else {
 				c.cb.setUserId(authUserId)
 			}
+		} else if checkRpcTryFetchUserId(query) {
+			if authUserId, _ := c.GetCacheUserID(context.Background(), c.cb.getAuthKeyId()); authUserId != 0 {
+				c.cb.setUserId(authUserId)
+			}
 		}
 	}
 
+	if err := c.CheckFloodLimit(reflect.TypeOf(query).Elem().Name(), c.cb.getUserId(), c.cb.getAuthKeyId(), clientIp); err != nil {
+		logx.Infof("onRpcRequest - flood limit: {sess: %s, msg_id: %d, request: {%s}, error: %v}",
+			c,
+			msgId.msgId,
+			reflect.TypeOf(query),
+			err)
+		c.sendRpcResultToQueue(gatewayId, msgId.msgId, mtproto.NewRpcError(status.Convert(err)))
+		msgId.state = RECEIVED | RESPONSE_GENERATED
+		return false
+	}
+
 	msgId.state = RECEIVED | RPC_PROCESSING
 	c.cb.sendToRpcQueue(&rpcApiMessage{
 		sessionId: c.sessionId,
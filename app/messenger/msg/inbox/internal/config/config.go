@@ -27,4 +27,5 @@ type Config struct {
 	DialogClient  zrpc.RpcClientConf
 	SyncClient    *kafka.KafkaProducerConf
 	BotSyncClient *kafka.KafkaProducerConf `json:",optional"`
+	SearchClient  *kafka.KafkaProducerConf `json:",optional"`
 }
@@ -65,14 +65,15 @@ func (c *InboxCore) InboxSendUserMessageToInbox(in *inbox.TLInboxSendUserMessage
 		}
 	}
 	if isBot {
-		if c.svcCtx.Dao.BotSyncClient != nil {
-			_, err = c.svcCtx.Dao.BotSyncClient.SyncPushBotUpdates(c.ctx, &sync.TLSyncPushBotUpdates{
-				UserId:  inBox.UserId,
-				Updates: pushUpdates,
-			})
-		} else {
-			// TODO: log
+		// BotSyncClient is optional, bot updates share the sync topic by default
+		botSyncClient := c.svcCtx.Dao.BotSyncClient
+		if botSyncClient == nil {
+			botSyncClient = c.svcCtx.Dao.SyncClient
 		}
+		_, err = botSyncClient.SyncPushBotUpdates(c.ctx, &sync.TLSyncPushBotUpdates{
+			UserId:  inBox.UserId,
+			Updates: pushUpdates,
+		})
 	} else {
 		_, err = c.svcCtx.Dao.SyncClient.SyncPushUpdates(c.ctx, &sync.TLSyncPushUpdates{
 			UserId:  inBox.UserId,
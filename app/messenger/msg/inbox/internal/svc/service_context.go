@@ -19,6 +19,7 @@ import (
 	// channel_client "github.com/teamgram/teamgram-server/app/service/biz/channel/client"
 	chat_client "github.com/teamgram/teamgram-server/app/service/biz/chat/client"
 	dialog_client "github.com/teamgram/teamgram-server/app/service/biz/dialog/client"
+	message_client "github.com/teamgram/teamgram-server/app/service/biz/message/client"
 	user_client "github.com/teamgram/teamgram-server/app/service/biz/user/client"
 	idgen_client "github.com/teamgram/teamgram-server/app/service/idgen/client"
 
@@ -45,6 +46,9 @@ func NewServiceContext(c config.Config) *ServiceContext {
 	if c.BotSyncClient != nil {
 		dao.BotSyncClient = sync_client.NewSyncMqClient(kafka.GetCachedMQClient(c.BotSyncClient))
 	}
+	if c.SearchClient != nil {
+		dao.SearchClient = message_client.NewSearchIndexMqClient(kafka.GetCachedMQClient(c.SearchClient))
+	}
 
 	return &ServiceContext{
 		Config: c,
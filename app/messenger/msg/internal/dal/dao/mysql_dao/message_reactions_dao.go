@@ -0,0 +1,23 @@
+/*
+ * WARNING! All changes made in this file will be lost!
+ *   Created from by 'dalgen'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package mysql_dao
+
+import (
+	message_helper "github.com/teamgram/teamgram-server/app/service/biz/message"
+)
+
+type (
+	MessageReactionsDAO = message_helper.MessageReactionsDAO
+)
+
+var (
+	NewMessageReactionsDAO = message_helper.NewMessageReactionsDAO
+)
@@ -0,0 +1,483 @@
+/*
+ * WARNING! All changes made in this file will be lost!
+ *   Created from by 'dalgen'
+ *
+ * Copyright (c) 2022-present,  Teamgram Authors.
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package mysql_dao
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/teamgram/marmota/pkg/stores/sqlx"
+	"github.com/teamgram/teamgram-server/app/messenger/msg/internal/dal/dataobject"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+var _ *sql.Result
+
+type ScheduledMessagesDAO struct {
+	db *sqlx.DB
+}
+
+func NewScheduledMessagesDAO(db *sqlx.DB) *ScheduledMessagesDAO {
+	return &ScheduledMessagesDAO{db}
+}
+
+// Insert
+// insert into scheduled_messages(user_id, peer_type, peer_id, scheduled_message_id, random_id, no_webpage, background, message_data, schedule_date, date2) values (:user_id, :peer_type, :peer_id, :scheduled_message_id, :random_id, :no_webpage, :background, :message_data, :schedule_date, :date2)
+// TODO(@benqi): sqlmap
+func (dao *ScheduledMessagesDAO) Insert(ctx context.Context, do *dataobject.ScheduledMessagesDO) (lastInsertId, rowsAffected int64, err error) {
+	var (
+		query = "insert into scheduled_messages(user_id, peer_type, peer_id, scheduled_message_id, random_id, no_webpage, background, message_data, schedule_date, date2) values (:user_id, :peer_type, :peer_id, :scheduled_message_id, :random_id, :no_webpage, :background, :message_data, :schedule_date, :date2)"
+		r     sql.Result
+	)
+
+	r, err = dao.db.NamedExec(ctx, query, do)
+	if err != nil {
+		logx.WithContext(ctx).Errorf("namedExec in Insert(%v), error: %v", do, err)
+		return
+	}
+
+	lastInsertId, err = r.LastInsertId()
+	if err != nil {
+		logx.WithContext(ctx).Errorf("lastInsertId in Insert(%v)_error: %v", do, err)
+		return
+	}
+	rowsAffected, err = r.RowsAffected()
+	if err != nil {
+		logx.WithContext(ctx).Errorf("rowsAffected in Insert(%v)_error: %v", do, err)
+	}
+
+	return
+}
+
+// InsertTx
+// insert into scheduled_messages(user_id, peer_type, peer_id, scheduled_message_id, random_id, no_webpage, background, message_data, schedule_date, date2) values (:user_id, :peer_type, :peer_id, :scheduled_message_id, :random_id, :no_webpage, :background, :message_data, :schedule_date, :date2)
+// TODO(@benqi): sqlmap
+func (dao *ScheduledMessagesDAO) InsertTx(tx *sqlx.Tx, do *dataobject.ScheduledMessagesDO) (lastInsertId, rowsAffected int64, err error) {
+	var (
+		query = "insert into scheduled_messages(user_id, peer_type, peer_id, scheduled_message_id, random_id, no_webpage, background, message_data, schedule_date, date2) values (:user_id, :peer_type, :peer_id, :scheduled_message_id, :random_id, :no_webpage, :background, :message_data, :schedule_date, :date2)"
+		r     sql.Result
+	)
+
+	r, err = tx.NamedExec(query, do)
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("namedExec in Insert(%v), error: %v", do, err)
+		return
+	}
+
+	lastInsertId, err = r.LastInsertId()
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("lastInsertId in Insert(%v)_error: %v", do, err)
+		return
+	}
+	rowsAffected, err = r.RowsAffected()
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("rowsAffected in Insert(%v)_error: %v", do, err)
+	}
+
+	return
+}
+
+// SelectByRandomId
+// select id, user_id, peer_type, peer_id, scheduled_message_id, random_id, no_webpage, background, message_data, schedule_date, state, date2 from scheduled_messages where user_id = :user_id and random_id = :random_id and deleted = 0 limit 1
+// TODO(@benqi): sqlmap
+func (dao *ScheduledMessagesDAO) SelectByRandomId(ctx context.Context, user_id int64, random_id int64) (rValue *dataobject.ScheduledMessagesDO, err error) {
+	var (
+		query = "select id, user_id, peer_type, peer_id, scheduled_message_id, random_id, no_webpage, background, message_data, schedule_date, state, date2 from scheduled_messages where user_id = ? and random_id = ? and deleted = 0 limit 1"
+		do    = &dataobject.ScheduledMessagesDO{}
+	)
+	err = dao.db.QueryRowPartial(ctx, do, query, user_id, random_id)
+
+	if err != nil {
+		if err != sqlx.ErrNotFound {
+			logx.WithContext(ctx).Errorf("queryx in SelectByRandomId(_), error: %v", err)
+			return
+		} else {
+			err = nil
+		}
+	} else {
+		rValue = do
+	}
+
+	return
+}
+
+// SelectByPeer
+// select id, user_id, peer_type, peer_id, scheduled_message_id, random_id, no_webpage, background, message_data, schedule_date, state, date2 from scheduled_messages where user_id = :user_id and peer_type = :peer_type and peer_id = :peer_id and state = 0 and deleted = 0 order by schedule_date desc, scheduled_message_id desc
+// TODO(@benqi): sqlmap
+func (dao *ScheduledMessagesDAO) SelectByPeer(ctx context.Context, user_id int64, peer_type int32, peer_id int64) (rList []dataobject.ScheduledMessagesDO, err error) {
+	var (
+		query  = "select id, user_id, peer_type, peer_id, scheduled_message_id, random_id, no_webpage, background, message_data, schedule_date, state, date2 from scheduled_messages where user_id = ? and peer_type = ? and peer_id = ? and state = 0 and deleted = 0 order by schedule_date desc, scheduled_message_id desc"
+		values []dataobject.ScheduledMessagesDO
+	)
+	err = dao.db.QueryRowsPartial(ctx, &values, query, user_id, peer_type, peer_id)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("queryx in SelectByPeer(_), error: %v", err)
+		return
+	}
+
+	rList = values
+
+	return
+}
+
+// SelectByPeerWithCB
+// select id, user_id, peer_type, peer_id, scheduled_message_id, random_id, no_webpage, background, message_data, schedule_date, state, date2 from scheduled_messages where user_id = :user_id and peer_type = :peer_type and peer_id = :peer_id and state = 0 and deleted = 0 order by schedule_date desc, scheduled_message_id desc
+// TODO(@benqi): sqlmap
+func (dao *ScheduledMessagesDAO) SelectByPeerWithCB(ctx context.Context, user_id int64, peer_type int32, peer_id int64, cb func(i int, v *dataobject.ScheduledMessagesDO)) (rList []dataobject.ScheduledMessagesDO, err error) {
+	var (
+		query  = "select id, user_id, peer_type, peer_id, scheduled_message_id, random_id, no_webpage, background, message_data, schedule_date, state, date2 from scheduled_messages where user_id = ? and peer_type = ? and peer_id = ? and state = 0 and deleted = 0 order by schedule_date desc, scheduled_message_id desc"
+		values []dataobject.ScheduledMessagesDO
+	)
+	err = dao.db.QueryRowsPartial(ctx, &values, query, user_id, peer_type, peer_id)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("queryx in SelectByPeer(_), error: %v", err)
+		return
+	}
+
+	rList = values
+
+	if cb != nil {
+		for i := 0; i < len(rList); i++ {
+			cb(i, &rList[i])
+		}
+	}
+
+	return
+}
+
+// SelectByIdList
+// select id, user_id, peer_type, peer_id, scheduled_message_id, random_id, no_webpage, background, message_data, schedule_date, state, date2 from scheduled_messages where user_id = :user_id and peer_type = :peer_type and peer_id = :peer_id and scheduled_message_id in (:idList) and state = 0 and deleted = 0 order by schedule_date desc, scheduled_message_id desc
+// TODO(@benqi): sqlmap
+func (dao *ScheduledMessagesDAO) SelectByIdList(ctx context.Context, user_id int64, peer_type int32, peer_id int64, idList []int32) (rList []dataobject.ScheduledMessagesDO, err error) {
+	var (
+		query  = "select id, user_id, peer_type, peer_id, scheduled_message_id, random_id, no_webpage, background, message_data, schedule_date, state, date2 from scheduled_messages where user_id = ? and peer_type = ? and peer_id = ? and scheduled_message_id in (?) and state = 0 and deleted = 0 order by schedule_date desc, scheduled_message_id desc"
+		a      []interface{}
+		values []dataobject.ScheduledMessagesDO
+	)
+
+	if len(idList) == 0 {
+		rList = []dataobject.ScheduledMessagesDO{}
+		return
+	}
+
+	query, a, err = sqlx.In(query, user_id, peer_type, peer_id, idList)
+	if err != nil {
+		// r sql.Result
+		logx.WithContext(ctx).Errorf("sqlx.In in SelectByIdList(_), error: %v", err)
+		return
+	}
+	err = dao.db.QueryRowsPartial(ctx, &values, query, a...)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("queryx in SelectByIdList(_), error: %v", err)
+		return
+	}
+
+	rList = values
+
+	return
+}
+
+// SelectByIdListWithCB
+// select id, user_id, peer_type, peer_id, scheduled_message_id, random_id, no_webpage, background, message_data, schedule_date, state, date2 from scheduled_messages where user_id = :user_id and peer_type = :peer_type and peer_id = :peer_id and scheduled_message_id in (:idList) and state = 0 and deleted = 0 order by schedule_date desc, scheduled_message_id desc
+// TODO(@benqi): sqlmap
+func (dao *ScheduledMessagesDAO) SelectByIdListWithCB(ctx context.Context, user_id int64, peer_type int32, peer_id int64, idList []int32, cb func(i int, v *dataobject.ScheduledMessagesDO)) (rList []dataobject.ScheduledMessagesDO, err error) {
+	var (
+		query  = "select id, user_id, peer_type, peer_id, scheduled_message_id, random_id, no_webpage, background, message_data, schedule_date, state, date2 from scheduled_messages where user_id = ? and peer_type = ? and peer_id = ? and scheduled_message_id in (?) and state = 0 and deleted = 0 order by schedule_date desc, scheduled_message_id desc"
+		a      []interface{}
+		values []dataobject.ScheduledMessagesDO
+	)
+
+	if len(idList) == 0 {
+		rList = []dataobject.ScheduledMessagesDO{}
+		return
+	}
+
+	query, a, err = sqlx.In(query, user_id, peer_type, peer_id, idList)
+	if err != nil {
+		// r sql.Result
+		logx.WithContext(ctx).Errorf("sqlx.In in SelectByIdList(_), error: %v", err)
+		return
+	}
+	err = dao.db.QueryRowsPartial(ctx, &values, query, a...)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("queryx in SelectByIdList(_), error: %v", err)
+		return
+	}
+
+	rList = values
+
+	if cb != nil {
+		for i := 0; i < len(rList); i++ {
+			cb(i, &rList[i])
+		}
+	}
+
+	return
+}
+
+// SelectPendingList
+// select id, user_id, peer_type, peer_id, scheduled_message_id, random_id, no_webpage, background, message_data, schedule_date, state, date2 from scheduled_messages where schedule_date <= :schedule_date and state = 0 and deleted = 0 order by schedule_date limit :limit
+// TODO(@benqi): sqlmap
+func (dao *ScheduledMessagesDAO) SelectPendingList(ctx context.Context, schedule_date int64, limit int32) (rList []dataobject.ScheduledMessagesDO, err error) {
+	var (
+		query  = "select id, user_id, peer_type, peer_id, scheduled_message_id, random_id, no_webpage, background, message_data, schedule_date, state, date2 from scheduled_messages where schedule_date <= ? and state = 0 and deleted = 0 order by schedule_date limit ?"
+		values []dataobject.ScheduledMessagesDO
+	)
+	err = dao.db.QueryRowsPartial(ctx, &values, query, schedule_date, limit)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("queryx in SelectPendingList(_), error: %v", err)
+		return
+	}
+
+	rList = values
+
+	return
+}
+
+// SelectPendingListWithCB
+// select id, user_id, peer_type, peer_id, scheduled_message_id, random_id, no_webpage, background, message_data, schedule_date, state, date2 from scheduled_messages where schedule_date <= :schedule_date and state = 0 and deleted = 0 order by schedule_date limit :limit
+// TODO(@benqi): sqlmap
+func (dao *ScheduledMessagesDAO) SelectPendingListWithCB(ctx context.Context, schedule_date int64, limit int32, cb func(i int, v *dataobject.ScheduledMessagesDO)) (rList []dataobject.ScheduledMessagesDO, err error) {
+	var (
+		query  = "select id, user_id, peer_type, peer_id, scheduled_message_id, random_id, no_webpage, background, message_data, schedule_date, state, date2 from scheduled_messages where schedule_date <= ? and state = 0 and deleted = 0 order by schedule_date limit ?"
+		values []dataobject.ScheduledMessagesDO
+	)
+	err = dao.db.QueryRowsPartial(ctx, &values, query, schedule_date, limit)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("queryx in SelectPendingList(_), error: %v", err)
+		return
+	}
+
+	rList = values
+
+	if cb != nil {
+		for i := 0; i < len(rList); i++ {
+			cb(i, &rList[i])
+		}
+	}
+
+	return
+}
+
+// UpdateSending
+// update scheduled_messages set state = 1 where id = :id and state = 0 and deleted = 0
+// TODO(@benqi): sqlmap
+func (dao *ScheduledMessagesDAO) UpdateSending(ctx context.Context, id int64) (rowsAffected int64, err error) {
+	var (
+		query   = "update scheduled_messages set state = 1 where id = ? and state = 0 and deleted = 0"
+		rResult sql.Result
+	)
+	rResult, err = dao.db.Exec(ctx, query, id)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("exec in UpdateSending(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(ctx).Errorf("rowsAffected in UpdateSending(_), error: %v", err)
+	}
+
+	return
+}
+
+// update scheduled_messages set state = 1 where id = :id and state = 0 and deleted = 0
+// UpdateSendingTx
+// TODO(@benqi): sqlmap
+func (dao *ScheduledMessagesDAO) UpdateSendingTx(tx *sqlx.Tx, id int64) (rowsAffected int64, err error) {
+	var (
+		query   = "update scheduled_messages set state = 1 where id = ? and state = 0 and deleted = 0"
+		rResult sql.Result
+	)
+	rResult, err = tx.Exec(query, id)
+
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("exec in UpdateSending(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("rowsAffected in UpdateSending(_), error: %v", err)
+	}
+
+	return
+}
+
+// UpdateState
+// update scheduled_messages set state = :state where id = :id
+// TODO(@benqi): sqlmap
+func (dao *ScheduledMessagesDAO) UpdateState(ctx context.Context, state int32, id int64) (rowsAffected int64, err error) {
+	var (
+		query   = "update scheduled_messages set state = ? where id = ?"
+		rResult sql.Result
+	)
+	rResult, err = dao.db.Exec(ctx, query, state, id)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("exec in UpdateState(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(ctx).Errorf("rowsAffected in UpdateState(_), error: %v", err)
+	}
+
+	return
+}
+
+// update scheduled_messages set state = :state where id = :id
+// UpdateStateTx
+// TODO(@benqi): sqlmap
+func (dao *ScheduledMessagesDAO) UpdateStateTx(tx *sqlx.Tx, state int32, id int64) (rowsAffected int64, err error) {
+	var (
+		query   = "update scheduled_messages set state = ? where id = ?"
+		rResult sql.Result
+	)
+	rResult, err = tx.Exec(query, state, id)
+
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("exec in UpdateState(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("rowsAffected in UpdateState(_), error: %v", err)
+	}
+
+	return
+}
+
+// ResetSending
+// update scheduled_messages set state = 0 where state = 1
+// TODO(@benqi): sqlmap
+func (dao *ScheduledMessagesDAO) ResetSending(ctx context.Context) (rowsAffected int64, err error) {
+	var (
+		query   = "update scheduled_messages set state = 0 where state = 1"
+		rResult sql.Result
+	)
+	rResult, err = dao.db.Exec(ctx, query)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("exec in ResetSending(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(ctx).Errorf("rowsAffected in ResetSending(_), error: %v", err)
+	}
+
+	return
+}
+
+// update scheduled_messages set state = 0 where state = 1
+// ResetSendingTx
+// TODO(@benqi): sqlmap
+func (dao *ScheduledMessagesDAO) ResetSendingTx(tx *sqlx.Tx) (rowsAffected int64, err error) {
+	var (
+		query   = "update scheduled_messages set state = 0 where state = 1"
+		rResult sql.Result
+	)
+	rResult, err = tx.Exec(query)
+
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("exec in ResetSending(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("rowsAffected in ResetSending(_), error: %v", err)
+	}
+
+	return
+}
+
+// DeleteByIdList
+// update scheduled_messages set deleted = 1 where user_id = :user_id and peer_type = :peer_type and peer_id = :peer_id and scheduled_message_id in (:idList) and state = 0 and deleted = 0
+// TODO(@benqi): sqlmap
+func (dao *ScheduledMessagesDAO) DeleteByIdList(ctx context.Context, user_id int64, peer_type int32, peer_id int64, idList []int32) (rowsAffected int64, err error) {
+	var (
+		query   = "update scheduled_messages set deleted = 1 where user_id = ? and peer_type = ? and peer_id = ? and scheduled_message_id in (?) and state = 0 and deleted = 0"
+		a       []interface{}
+		rResult sql.Result
+	)
+
+	if len(idList) == 0 {
+		return
+	}
+
+	query, a, err = sqlx.In(query, user_id, peer_type, peer_id, idList)
+	if err != nil {
+		// r sql.Result
+		logx.WithContext(ctx).Errorf("sqlx.In in DeleteByIdList(_), error: %v", err)
+		return
+	}
+	rResult, err = dao.db.Exec(ctx, query, a...)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("exec in DeleteByIdList(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(ctx).Errorf("rowsAffected in DeleteByIdList(_), error: %v", err)
+	}
+
+	return
+}
+
+// update scheduled_messages set deleted = 1 where user_id = :user_id and peer_type = :peer_type and peer_id = :peer_id and scheduled_message_id in (:idList) and state = 0 and deleted = 0
+// DeleteByIdListTx
+// TODO(@benqi): sqlmap
+func (dao *ScheduledMessagesDAO) DeleteByIdListTx(tx *sqlx.Tx, user_id int64, peer_type int32, peer_id int64, idList []int32) (rowsAffected int64, err error) {
+	var (
+		query   = "update scheduled_messages set deleted = 1 where user_id = ? and peer_type = ? and peer_id = ? and scheduled_message_id in (?) and state = 0 and deleted = 0"
+		a       []interface{}
+		rResult sql.Result
+	)
+
+	if len(idList) == 0 {
+		return
+	}
+
+	query, a, err = sqlx.In(query, user_id, peer_type, peer_id, idList)
+	if err != nil {
+		// r sql.Result
+		logx.WithContext(tx.Context()).Errorf("sqlx.In in DeleteByIdList(_), error: %v", err)
+		return
+	}
+	rResult, err = tx.Exec(query, a...)
+
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("exec in DeleteByIdList(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("rowsAffected in DeleteByIdList(_), error: %v", err)
+	}
+
+	return
+}
@@ -0,0 +1,19 @@
+/*
+ * WARNING! All changes made in this file will be lost!
+ *   Created from by 'dalgen'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package dataobject
+
+import (
+	message_helper "github.com/teamgram/teamgram-server/app/service/biz/message"
+)
+
+type (
+	MessageReactionsDO = message_helper.MessageReactionsDO
+)
@@ -0,0 +1,27 @@
+/*
+ * WARNING! All changes made in this file will be lost!
+ *   Created from by 'dalgen'
+ *
+ * Copyright (c) 2022-present,  Teamgram Authors.
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package dataobject
+
+type ScheduledMessagesDO struct {
+	Id                 int64  `db:"id"`
+	UserId             int64  `db:"user_id"`
+	PeerType           int32  `db:"peer_type"`
+	PeerId             int64  `db:"peer_id"`
+	ScheduledMessageId int32  `db:"scheduled_message_id"`
+	RandomId           int64  `db:"random_id"`
+	NoWebpage          bool   `db:"no_webpage"`
+	Background         bool   `db:"background"`
+	MessageData        string `db:"message_data"`
+	ScheduleDate       int64  `db:"schedule_date"`
+	State              int32  `db:"state"`
+	Date2              int64  `db:"date2"`
+	Deleted            bool   `db:"deleted"`
+}
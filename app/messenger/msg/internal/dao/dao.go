@@ -14,9 +14,12 @@ import (
 	"github.com/teamgram/teamgram-server/app/messenger/msg/msg/plugin"
 	sync_client "github.com/teamgram/teamgram-server/app/messenger/sync/client"
 	// channel_client "github.com/teamgram/teamgram-server/app/service/biz/channel/client"
+	channel_client "github.com/teamgram/teamgram-server/app/service/biz/channel/client"
 	chat_client "github.com/teamgram/teamgram-server/app/service/biz/chat/client"
 	dialog_client "github.com/teamgram/teamgram-server/app/service/biz/dialog/client"
+	message_client "github.com/teamgram/teamgram-server/app/service/biz/message/client"
 	user_client "github.com/teamgram/teamgram-server/app/service/biz/user/client"
+	username_client "github.com/teamgram/teamgram-server/app/service/biz/username/client"
 	idgen_client "github.com/teamgram/teamgram-server/app/service/idgen/client"
 
 	"github.com/zeromicro/go-zero/core/stores/kv"
@@ -28,9 +31,12 @@ type Dao struct {
 	idgen_client.IDGenClient2
 	user_client.UserClient
 	chat_client.ChatClient
+	channel_client.ChannelClient
 	inbox_client.InboxClient
 	SyncClient    sync_client.SyncClient
 	BotSyncClient sync_client.SyncClient
 	dialog_client.DialogClient
+	SearchClient   message_client.SearchIndexClient
+	UsernameClient username_client.UsernameClient
 	plugin.MsgPlugin
 }
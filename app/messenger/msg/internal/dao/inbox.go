@@ -27,6 +27,7 @@ import (
 	"github.com/teamgram/proto/mtproto"
 	"github.com/teamgram/teamgram-server/app/messenger/msg/inbox/inbox"
 	"github.com/teamgram/teamgram-server/app/messenger/msg/internal/dal/dataobject"
+	"github.com/teamgram/teamgram-server/pkg/search"
 
 	"github.com/gogo/protobuf/proto"
 	"github.com/zeromicro/go-zero/core/jsonx"
@@ -222,6 +223,8 @@ func (d *Dao) sendMessageToInbox(ctx context.Context, fromId int64, peer *mtprot
 	inBox.Pts = d.IDGenClient2.NextPtsId(ctx, toUserId)
 	inBox.PtsCount = 1
 
+	d.indexMessageBox(ctx, inBox)
+
 	return inBox, nil
 }
 
@@ -378,6 +381,8 @@ func (d *Dao) DeleteInboxMessages(ctx context.Context, deleteUserId int64, delet
 			return tR.Err
 		}
 
+		d.unindexMessages(ctx, userId, msgIds)
+
 		if cb != nil {
 			cb(ctx, userId, msgIds)
 		}
@@ -411,6 +416,12 @@ func (d *Dao) EditUserInboxMessage(ctx context.Context, fromId, peerId int64, me
 		return
 	}
 
+	d.updateSearchIndex(ctx, &search.IndexUpdates{
+		Documents: []*search.Document{
+			makeSearchDocument(peerId, peerMsgDO.DialogId1, peerMsgDO.DialogId2, peerMsgDO.SenderUserId, message),
+		},
+	})
+
 	box = &mtproto.MessageBox{
 		UserId:            peerId,
 		SenderUserId:      0,
@@ -456,6 +467,12 @@ func (d *Dao) EditChatInboxMessage(ctx context.Context, fromId int64, peerChatId
 		return
 	}
 
+	d.updateSearchIndex(ctx, &search.IndexUpdates{
+		Documents: []*search.Document{
+			makeSearchDocument(toId, peerMsgDO.DialogId1, peerMsgDO.DialogId2, peerMsgDO.SenderUserId, message),
+		},
+	})
+
 	box = &mtproto.MessageBox{
 		UserId:            toId,
 		SenderUserId:      0,
@@ -29,16 +29,20 @@ type Mysql struct {
 	*mysql_dao.ChatParticipantsDAO
 	*mysql_dao.HashTagsDAO
 	*mysql_dao.DialogsDAO
+	*mysql_dao.ScheduledMessagesDAO
+	*mysql_dao.MessageReactionsDAO
 	*sqlx.CommonDAO
 }
 
 func NewMysqlDao(db *sqlx.DB) *Mysql {
 	return &Mysql{
-		DB:                  db,
-		MessagesDAO:         mysql_dao.NewMessagesDAO(db),
-		ChatParticipantsDAO: mysql_dao.NewChatParticipantsDAO(db),
-		HashTagsDAO:         mysql_dao.NewHashTagsDAO(db),
-		DialogsDAO:          mysql_dao.NewDialogsDAO(db),
-		CommonDAO:           sqlx.NewCommonDAO(db),
+		DB:                   db,
+		MessagesDAO:          mysql_dao.NewMessagesDAO(db),
+		ChatParticipantsDAO:  mysql_dao.NewChatParticipantsDAO(db),
+		HashTagsDAO:          mysql_dao.NewHashTagsDAO(db),
+		DialogsDAO:           mysql_dao.NewDialogsDAO(db),
+		ScheduledMessagesDAO: mysql_dao.NewScheduledMessagesDAO(db),
+		MessageReactionsDAO:  mysql_dao.NewMessageReactionsDAO(db),
+		CommonDAO:            sqlx.NewCommonDAO(db),
 	}
 }
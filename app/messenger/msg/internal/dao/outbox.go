@@ -33,6 +33,7 @@ import (
 	"github.com/teamgram/proto/mtproto"
 	"github.com/teamgram/teamgram-server/app/messenger/msg/internal/dal/dataobject"
 	"github.com/teamgram/teamgram-server/app/messenger/msg/msg/msg"
+	"github.com/teamgram/teamgram-server/pkg/search"
 )
 
 func makeMessageBoxByDO(boxDO *dataobject.MessagesDO) *mtproto.MessageBox {
@@ -305,6 +306,8 @@ func (d *Dao) sendMessageToOutbox(ctx context.Context, fromId int64, peer *mtpro
 		return nil, errors.New("fatal unknown error")
 	}
 
+	d.indexMessageBox(ctx, outBox)
+
 	return outBox, nil
 }
 
@@ -429,6 +432,8 @@ func (d *Dao) DeleteMessages(ctx context.Context, userId int64, msgIds []int32)
 		return nil, tR.Err
 	}
 
+	d.unindexMessages(ctx, userId, msgIds)
+
 	for i := 0; i < len(msgDOList); i++ {
 		deletedMsgDataIdList = append(deletedMsgDataIdList, msgDOList[i].DialogMessageId)
 	}
@@ -478,6 +483,10 @@ func (d *Dao) editOutboxMessage(ctx context.Context, fromId int64, peerType int3
 		return nil, err
 	}
 
+	d.updateSearchIndex(ctx, &search.IndexUpdates{
+		Documents: []*search.Document{makeSearchDocument(fromId, did.A, did.B, fromId, message)},
+	})
+
 	return &mtproto.MessageBox{
 		UserId:            fromId,
 		MessageId:         message.Id,
@@ -536,6 +545,7 @@ func (d *Dao) DeletePhoneCallHistory(ctx context.Context, userId int64) ([]int32
 		if err != nil {
 			continue
 		}
+		d.unindexMessages(ctx, userId, msgIds)
 		lastMessageId, _ := d.MessagesDAO.SelectDialogLastMessageId(ctx, userId, dialogId.A, dialogId.B)
 		d.DialogsDAO.UpdateCustomMap(ctx, map[string]interface{}{
 			"top_message": lastMessageId,
@@ -0,0 +1,107 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package dao
+
+import (
+	"context"
+	"time"
+
+	"github.com/teamgram/marmota/pkg/stores/sqlx"
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/messenger/msg/internal/dal/dataobject"
+	"github.com/teamgram/teamgram-server/app/messenger/msg/msg/plugin"
+	"github.com/teamgram/teamgram-server/app/service/biz/message/message"
+)
+
+// SetMessageReaction replaces the reaction of userId to the message, an empty reaction removes it.
+// The new reaction is unread for the sender of the message.
+func (d *Dao) SetMessageReaction(ctx context.Context, userId int64, do *dataobject.MessagesDO, reaction string, big bool) error {
+	date := time.Now().Unix()
+	tR := sqlx.TxWrapper(ctx, d.DB, func(tx *sqlx.Tx, result *sqlx.StoreResult) {
+		_, result.Err = d.MessageReactionsDAO.DeleteTx(tx, do.DialogMessageId, userId)
+		if result.Err != nil || reaction == "" {
+			return
+		}
+		_, _, result.Err = d.MessageReactionsDAO.InsertTx(tx, &dataobject.MessageReactionsDO{
+			DialogMessageId: do.DialogMessageId,
+			UserId:          userId,
+			Reaction:        reaction,
+			Big:             big,
+			Date2:           date,
+		})
+	})
+	if tR.Err != nil {
+		return tR.Err
+	}
+
+	doList, err := d.MessageReactionsDAO.SelectList(ctx, do.DialogMessageId)
+	if err != nil {
+		return err
+	}
+	if hasReaction := len(doList) > 0; hasReaction != do.HasReaction {
+		if _, err = d.MessagesDAO.UpdateHasReaction(ctx, hasReaction, do.DialogMessageId); err != nil {
+			return err
+		}
+	}
+
+	if reaction != "" && userId != do.SenderUserId {
+		senderDO, _ := d.MessagesDAO.SelectByMessageDataId(ctx, do.SenderUserId, do.DialogMessageId)
+		if senderDO != nil {
+			d.MessagesDAO.UpdateReaction(ctx, reaction, date, true, senderDO.UserId, senderDO.UserMessageBoxId)
+		}
+	}
+
+	return nil
+}
+
+// MakeMessagePeerReactions the sender of the message sees the last reaction as unread until it's read.
+func (d *Dao) MakeMessagePeerReactions(do *dataobject.MessagesDO, doList []dataobject.MessageReactionsDO) []*mtproto.MessagePeerReaction {
+	var (
+		unread    = do.ReactionUnread && do.UserId == do.SenderUserId
+		reactions = make([]*mtproto.MessagePeerReaction, 0, len(doList))
+	)
+	for i := 0; i < len(doList); i++ {
+		reactions = append(reactions, message.MakeMessagePeerReaction(
+			doList[i].UserId,
+			doList[i].Reaction,
+			doList[i].Big,
+			unread && doList[i].UserId != do.SenderUserId && doList[i].Date2 == do.ReactionDate))
+	}
+
+	return reactions
+}
+
+// MakeMessageReactions returns the reactions seen by the owner of the message box do.
+func (d *Dao) MakeMessageReactions(do *dataobject.MessagesDO, doList []dataobject.MessageReactionsDO) *mtproto.MessageReactions {
+	return message.MakeMessageReactions(do.UserId, do.PeerType == mtproto.PEER_CHAT, d.MakeMessagePeerReactions(do, doList))
+}
+
+type msgPlugin struct {
+	*Mysql
+}
+
+// NewMsgPlugin the default plugin, it marks the reactions to the messages of the user as read.
+func NewMsgPlugin(m *Mysql) plugin.MsgPlugin {
+	return &msgPlugin{m}
+}
+
+func (p *msgPlugin) ReadReactionUnreadMessage(ctx context.Context, userId int64, msgId int32) error {
+	_, err := p.MessagesDAO.UpdateReactionUnread(ctx, userId, msgId)
+	return err
+}
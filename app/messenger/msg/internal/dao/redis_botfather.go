@@ -0,0 +1,91 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package dao
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+const (
+	botFatherStateKeyPrefix = "botfather_state"
+	botFatherStateTimeout   = 30 * 60 // 30m
+)
+
+// BotFatherState keeps the pending step of a multi-message BotFather command.
+type BotFatherState struct {
+	Command string `json:"command"`
+	Step    int    `json:"step"`
+	Name    string `json:"name,omitempty"`
+	BotId   int64  `json:"bot_id,omitempty"`
+}
+
+func makeBotFatherStateKey(userId int64) string {
+	return fmt.Sprintf("%s_%d", botFatherStateKeyPrefix, userId)
+}
+
+func (d *Dao) GetBotFatherState(ctx context.Context, userId int64) (*BotFatherState, error) {
+	k := makeBotFatherStateKey(userId)
+
+	cacheData, err := d.KV.Get(k)
+	if err != nil {
+		if err.Error() == "redigo: nil returned" {
+			return nil, nil
+		}
+
+		logx.WithContext(ctx).Errorf("getBotFatherState - GET {%s}, error: %v", k, err)
+		return nil, err
+	} else if cacheData == "" {
+		return nil, nil
+	}
+
+	state := new(BotFatherState)
+	if err = json.Unmarshal([]byte(cacheData), state); err != nil {
+		logx.WithContext(ctx).Errorf("getBotFatherState - Unmarshal {%s}, error: %v", cacheData, err)
+		return nil, nil
+	}
+
+	return state, nil
+}
+
+func (d *Dao) PutBotFatherState(ctx context.Context, userId int64, state *BotFatherState) error {
+	k := makeBotFatherStateKey(userId)
+	cacheData, _ := json.Marshal(state)
+
+	if err := d.KV.Setex(k, string(cacheData), botFatherStateTimeout); err != nil {
+		logx.WithContext(ctx).Errorf("putBotFatherState - SETEX {%s, %s, %d}, error: %v", k, cacheData, botFatherStateTimeout, err)
+		return err
+	}
+
+	return nil
+}
+
+func (d *Dao) ClearBotFatherState(ctx context.Context, userId int64) error {
+	k := makeBotFatherStateKey(userId)
+
+	if _, err := d.KV.Del(k); err != nil {
+		logx.WithContext(ctx).Errorf("clearBotFatherState - DEL {%s}, error: %v", k, err)
+		return err
+	}
+
+	return nil
+}
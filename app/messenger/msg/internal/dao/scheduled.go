@@ -0,0 +1,186 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package dao
+
+import (
+	"context"
+	"time"
+
+	"github.com/teamgram/marmota/pkg/hack"
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/messenger/msg/internal/dal/dataobject"
+	"github.com/teamgram/teamgram-server/app/messenger/msg/msg/msg"
+
+	"github.com/zeromicro/go-zero/core/jsonx"
+)
+
+const (
+	ScheduledStateWaiting = 0
+	ScheduledStateSending = 1
+	ScheduledStateSent    = 2
+	ScheduledStateFailed  = 3
+)
+
+const (
+	// MaxScheduledMessagesPerPeer same as the telegram server
+	MaxScheduledMessagesPerPeer = 100
+	// MaxScheduleDelay a message can be scheduled at most 365 days ahead
+	MaxScheduleDelay = 365 * 24 * 60 * 60
+)
+
+// MakeScheduledMessageByDO the message shown in the scheduled list, date is the schedule date
+func MakeScheduledMessageByDO(do *dataobject.ScheduledMessagesDO) *mtproto.Message {
+	var (
+		message *mtproto.Message
+	)
+
+	jsonx.UnmarshalFromString(do.MessageData, &message)
+	if message == nil {
+		return nil
+	}
+
+	message.Out = true
+	message.FromScheduled = true
+	message.Id = do.ScheduledMessageId
+	message.Date = int32(do.ScheduleDate)
+
+	return message
+}
+
+// MakeOutboxMessageByDO the outbox used to deliver the scheduled message
+func MakeOutboxMessageByDO(do *dataobject.ScheduledMessagesDO) *msg.OutboxMessage {
+	message := MakeScheduledMessageByDO(do)
+	if message == nil {
+		return nil
+	}
+
+	message.Id = 0
+	message.Date = int32(time.Now().Unix())
+
+	return msg.MakeTLOutboxMessage(&msg.OutboxMessage{
+		NoWebpage:    do.NoWebpage,
+		Background:   do.Background,
+		RandomId:     do.RandomId,
+		Message:      message,
+		ScheduleDate: nil,
+	}).To_OutboxMessage()
+}
+
+// scheduledMessagesStore is the part of ScheduledMessagesDAO used by SaveScheduledMessage.
+type scheduledMessagesStore interface {
+	SelectByRandomId(ctx context.Context, userId int64, randomId int64) (*dataobject.ScheduledMessagesDO, error)
+	SelectByPeer(ctx context.Context, userId int64, peerType int32, peerId int64) ([]dataobject.ScheduledMessagesDO, error)
+	Insert(ctx context.Context, do *dataobject.ScheduledMessagesDO) (int64, int64, error)
+}
+
+// SaveScheduledMessage persists a scheduled outbox, it's delivered by the scheduler at schedule_date.
+func (d *Dao) SaveScheduledMessage(ctx context.Context, fromId int64, peer *mtproto.PeerUtil, outBox *msg.OutboxMessage) (*mtproto.Message, error) {
+	return saveScheduledMessage(ctx, d.ScheduledMessagesDAO, d.IDGenClient2.NextScheduledMessageBoxId, fromId, peer, outBox)
+}
+
+func saveScheduledMessage(
+	ctx context.Context,
+	store scheduledMessagesStore,
+	nextId func(ctx context.Context, key int64) int32,
+	fromId int64,
+	peer *mtproto.PeerUtil,
+	outBox *msg.OutboxMessage) (*mtproto.Message, error) {
+	// handle duplicate scheduled message
+	if do, _ := store.SelectByRandomId(ctx, fromId, outBox.RandomId); do != nil {
+		return MakeScheduledMessageByDO(do), nil
+	}
+
+	doList, err := store.SelectByPeer(ctx, fromId, peer.PeerType, peer.PeerId)
+	if err != nil {
+		return nil, err
+	} else if len(doList) >= MaxScheduledMessagesPerPeer {
+		return nil, mtproto.ErrScheduleTooMuch
+	}
+
+	id := nextId(ctx, fromId)
+	if id == 0 {
+		return nil, mtproto.ErrInternelServerError
+	}
+
+	mData, _ := jsonx.Marshal(outBox.Message)
+	do := &dataobject.ScheduledMessagesDO{
+		UserId:             fromId,
+		PeerType:           peer.PeerType,
+		PeerId:             peer.PeerId,
+		ScheduledMessageId: id,
+		RandomId:           outBox.RandomId,
+		NoWebpage:          outBox.NoWebpage,
+		Background:         outBox.Background,
+		MessageData:        hack.String(mData),
+		ScheduleDate:       int64(outBox.GetScheduleDate().GetValue()),
+		State:              ScheduledStateWaiting,
+		Date2:              time.Now().Unix(),
+	}
+	if _, _, err = store.Insert(ctx, do); err != nil {
+		return nil, err
+	}
+
+	return MakeScheduledMessageByDO(do), nil
+}
+
+// GetScheduledMessageList returns the waiting messages scheduled to peer
+func (d *Dao) GetScheduledMessageList(ctx context.Context, userId int64, peer *mtproto.PeerUtil) ([]*mtproto.Message, error) {
+	messageList := make([]*mtproto.Message, 0)
+
+	_, err := d.ScheduledMessagesDAO.SelectByPeerWithCB(
+		ctx,
+		userId,
+		peer.PeerType,
+		peer.PeerId,
+		func(i int, v *dataobject.ScheduledMessagesDO) {
+			if m := MakeScheduledMessageByDO(v); m != nil {
+				messageList = append(messageList, m)
+			}
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return messageList, nil
+}
+
+// GetScheduledMessageListByIdList returns the waiting messages with the given scheduled ids
+func (d *Dao) GetScheduledMessageListByIdList(ctx context.Context, userId int64, peer *mtproto.PeerUtil, idList []int32) ([]*mtproto.Message, error) {
+	messageList := make([]*mtproto.Message, 0, len(idList))
+	if len(idList) == 0 {
+		return messageList, nil
+	}
+
+	_, err := d.ScheduledMessagesDAO.SelectByIdListWithCB(
+		ctx,
+		userId,
+		peer.PeerType,
+		peer.PeerId,
+		idList,
+		func(i int, v *dataobject.ScheduledMessagesDO) {
+			if m := MakeScheduledMessageByDO(v); m != nil {
+				messageList = append(messageList, m)
+			}
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return messageList, nil
+}
@@ -0,0 +1,139 @@
+// Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+//  All rights reserved.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package dao
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/messenger/msg/internal/dal/dataobject"
+	"github.com/teamgram/teamgram-server/app/messenger/msg/msg/msg"
+)
+
+type testScheduledStore struct {
+	doList []dataobject.ScheduledMessagesDO
+}
+
+func (s *testScheduledStore) SelectByRandomId(ctx context.Context, userId int64, randomId int64) (*dataobject.ScheduledMessagesDO, error) {
+	for i := range s.doList {
+		if s.doList[i].UserId == userId && s.doList[i].RandomId == randomId {
+			return &s.doList[i], nil
+		}
+	}
+	return nil, nil
+}
+
+func (s *testScheduledStore) SelectByPeer(ctx context.Context, userId int64, peerType int32, peerId int64) ([]dataobject.ScheduledMessagesDO, error) {
+	var rList []dataobject.ScheduledMessagesDO
+	for _, do := range s.doList {
+		if do.UserId == userId && do.PeerType == peerType && do.PeerId == peerId {
+			rList = append(rList, do)
+		}
+	}
+	return rList, nil
+}
+
+func (s *testScheduledStore) Insert(ctx context.Context, do *dataobject.ScheduledMessagesDO) (int64, int64, error) {
+	s.doList = append(s.doList, *do)
+	return int64(len(s.doList)), 1, nil
+}
+
+func makeTestScheduledOutbox(randomId int64, scheduleDate int32) *msg.OutboxMessage {
+	return msg.MakeTLOutboxMessage(&msg.OutboxMessage{
+		RandomId: randomId,
+		Message: mtproto.MakeTLMessage(&mtproto.Message{
+			Out:     true,
+			PeerId:  mtproto.MakePeerUser(2),
+			FromId:  mtproto.MakePeerUser(1),
+			Message: "hi",
+		}).To_Message(),
+		ScheduleDate: &types.Int32Value{Value: scheduleDate},
+	}).To_OutboxMessage()
+}
+
+func TestSaveScheduledMessage(t *testing.T) {
+	var (
+		ctx    = context.Background()
+		store  = &testScheduledStore{}
+		peer   = mtproto.MakeUserPeerUtil(2)
+		lastId = int32(0)
+		nextId = func(ctx context.Context, key int64) int32 {
+			lastId++
+			return lastId
+		}
+		scheduleDate = int32(time.Now().Unix()) + 3600
+	)
+
+	m, err := saveScheduledMessage(ctx, store, nextId, 1, peer, makeTestScheduledOutbox(100, scheduleDate))
+	if err != nil {
+		t.Fatalf("save: %v", err)
+	}
+	if m.Id != 1 || m.Date != scheduleDate || !m.FromScheduled {
+		t.Fatalf("save: unexpected message %#v", m)
+	}
+
+	// same random_id returns the saved message without a second insert
+	m, err = saveScheduledMessage(ctx, store, nextId, 1, peer, makeTestScheduledOutbox(100, scheduleDate))
+	if err != nil {
+		t.Fatalf("duplicate: %v", err)
+	}
+	if m.Id != 1 || len(store.doList) != 1 || lastId != 1 {
+		t.Fatalf("duplicate: want id 1 and one row, got id %d and %d rows", m.Id, len(store.doList))
+	}
+
+	for i := 1; i < MaxScheduledMessagesPerPeer; i++ {
+		if _, err = saveScheduledMessage(ctx, store, nextId, 1, peer, makeTestScheduledOutbox(int64(100+i), scheduleDate)); err != nil {
+			t.Fatalf("save %d: %v", i, err)
+		}
+	}
+
+	_, err = saveScheduledMessage(ctx, store, nextId, 1, peer, makeTestScheduledOutbox(1000, scheduleDate))
+	if err != mtproto.ErrScheduleTooMuch {
+		t.Fatalf("limit: want %v, got %v", mtproto.ErrScheduleTooMuch, err)
+	}
+
+	// the limit is per peer
+	if _, err = saveScheduledMessage(ctx, store, nextId, 1, mtproto.MakeUserPeerUtil(3), makeTestScheduledOutbox(1001, scheduleDate)); err != nil {
+		t.Fatalf("other peer: %v", err)
+	}
+}
+
+func TestMakeOutboxMessageByDO(t *testing.T) {
+	var (
+		store        = &testScheduledStore{}
+		scheduleDate = int32(time.Now().Unix()) + 3600
+	)
+
+	_, err := saveScheduledMessage(
+		context.Background(),
+		store,
+		func(ctx context.Context, key int64) int32 { return 7 },
+		1,
+		mtproto.MakeUserPeerUtil(2),
+		makeTestScheduledOutbox(100, scheduleDate))
+	if err != nil {
+		t.Fatalf("save: %v", err)
+	}
+
+	now := int32(time.Now().Unix())
+	outBox := MakeOutboxMessageByDO(&store.doList[0])
+	if outBox == nil {
+		t.Fatal("want outbox, got nil")
+	}
+	if outBox.RandomId != 100 || outBox.ScheduleDate != nil {
+		t.Fatalf("unexpected outbox %#v", outBox)
+	}
+	if outBox.Message.Id != 0 || outBox.Message.Date < now || outBox.Message.Date > now+1 {
+		t.Fatalf("want id 0 and date now, got id %d date %d", outBox.Message.Id, outBox.Message.Date)
+	}
+	if !outBox.Message.FromScheduled || outBox.Message.Message != "hi" {
+		t.Fatalf("unexpected message %#v", outBox.Message)
+	}
+}
@@ -0,0 +1,80 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package dao
+
+import (
+	"context"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/pkg/search"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+func makeSearchDocument(userId int64, dialogId1, dialogId2, fromId int64, message *mtproto.Message) *search.Document {
+	return &search.Document{
+		UserId:    userId,
+		MessageId: message.GetId(),
+		DialogId1: dialogId1,
+		DialogId2: dialogId2,
+		FromId:    fromId,
+		MediaType: int32(mtproto.GetMediaType(message)),
+		Date:      int64(message.GetDate()),
+		Text:      message.GetMessage(),
+	}
+}
+
+func (d *Dao) updateSearchIndex(ctx context.Context, in *search.IndexUpdates) {
+	if d.SearchClient == nil {
+		return
+	}
+
+	if err := d.SearchClient.SearchUpdateIndex(ctx, in); err != nil {
+		logx.WithContext(ctx).Errorf("updateSearchIndex - error: %v", err)
+	}
+}
+
+// indexMessageBox publishes a new or edited message to the search index.
+func (d *Dao) indexMessageBox(ctx context.Context, box *mtproto.MessageBox) {
+	if d.SearchClient == nil || box == nil || box.Message == nil {
+		return
+	}
+
+	d.updateSearchIndex(ctx, &search.IndexUpdates{
+		Documents: []*search.Document{
+			makeSearchDocument(box.UserId, box.DialogId1, box.DialogId2, box.SenderUserId, box.Message),
+		},
+	})
+}
+
+// unindexMessages drops deleted messages from the search index.
+func (d *Dao) unindexMessages(ctx context.Context, userId int64, idList []int32) {
+	if d.SearchClient == nil || len(idList) == 0 {
+		return
+	}
+
+	d.updateSearchIndex(ctx, &search.IndexUpdates{
+		Deleted: []*search.DeletedMessages{
+			{
+				UserId: userId,
+				IdList: idList,
+			},
+		},
+	})
+}
@@ -36,8 +36,9 @@ import (
 var configFile = flag.String("f", "etc/msg.yaml", "the config file")
 
 type Server struct {
-	grpcSrv *zrpc.RpcServer
-	mq      *kafka.ConsumerGroup
+	grpcSrv   *zrpc.RpcServer
+	mq        *kafka.ConsumerGroup
+	scheduler *msg_helper.Scheduler
 }
 
 func New() *Server {
@@ -52,22 +53,25 @@ func (s *Server) Initialize() error {
 	// ctx := svc.NewServiceContext(c)
 	// s.grpcSrv = grpc.New(ctx, c.RpcServerConf)
 
+	msgSrv, msgScheduler := msg_helper.NewWithScheduler(
+		msg_helper.Config{
+			RpcServerConf:  c.RpcServerConf,
+			Mysql:          c.Mysql,
+			KV:             c.KV,
+			IdgenClient:    c.IdgenClient,
+			UserClient:     c.BizServiceClient,
+			ChatClient:     c.BizServiceClient,
+			SyncClient:     c.SyncClient,
+			InboxClient:    c.InboxClient,
+			ChannelClient:  c.BizServiceClient,
+			DialogClient:   c.BizServiceClient,
+			UsernameClient: c.BizServiceClient,
+			SearchClient:   c.SearchClient,
+		}, nil)
+
 	s.grpcSrv = zrpc.MustNewServer(c.RpcServerConf, func(grpcServer *grpc.Server) {
 		// msg_helper
-		msg.RegisterRPCMsgServer(
-			grpcServer,
-			msg_helper.New(
-				msg_helper.Config{
-					RpcServerConf: c.RpcServerConf,
-					Mysql:         c.Mysql,
-					KV:            c.KV,
-					IdgenClient:   c.IdgenClient,
-					UserClient:    c.BizServiceClient,
-					ChatClient:    c.BizServiceClient,
-					SyncClient:    c.SyncClient,
-					InboxClient:   c.InboxClient,
-					DialogClient:  c.BizServiceClient,
-				}, nil))
+		msg.RegisterRPCMsgServer(grpcServer, msgSrv)
 	})
 
 	go func() {
@@ -85,12 +89,18 @@ func (s *Server) Initialize() error {
 		SyncClient:    c.SyncClient,
 		BotSyncClient: c.BotSyncClient,
 		DialogClient:  c.BizServiceClient,
+		SearchClient:  c.SearchClient,
 	})
 
 	go func() {
 		s.mq.Start()
 	}()
 
+	s.scheduler = msgScheduler
+	go func() {
+		s.scheduler.Start()
+	}()
+
 	return nil
 }
 
@@ -98,5 +108,6 @@ func (s *Server) RunLoop() {
 }
 
 func (s *Server) Destroy() {
+	s.scheduler.Stop()
 	s.grpcSrv.Stop()
 }
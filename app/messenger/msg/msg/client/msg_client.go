@@ -35,6 +35,13 @@ type MsgClient interface {
 	MsgReadHistory(ctx context.Context, in *msg.TLMsgReadHistory) (*mtproto.Messages_AffectedMessages, error)
 	MsgUpdatePinnedMessage(ctx context.Context, in *msg.TLMsgUpdatePinnedMessage) (*mtproto.Updates, error)
 	MsgUnpinAllMessages(ctx context.Context, in *msg.TLMsgUnpinAllMessages) (*mtproto.Messages_AffectedHistory, error)
+	MsgGetScheduledHistory(ctx context.Context, in *msg.TLMsgGetScheduledHistory) (*msg.Vector_Message, error)
+	MsgGetScheduledMessages(ctx context.Context, in *msg.TLMsgGetScheduledMessages) (*msg.Vector_Message, error)
+	MsgSendScheduledMessages(ctx context.Context, in *msg.TLMsgSendScheduledMessages) (*mtproto.Updates, error)
+	MsgDeleteScheduledMessages(ctx context.Context, in *msg.TLMsgDeleteScheduledMessages) (*mtproto.Updates, error)
+	MsgSendReaction(ctx context.Context, in *msg.TLMsgSendReaction) (*mtproto.Updates, error)
+	MsgReadReactions(ctx context.Context, in *msg.TLMsgReadReactions) (*mtproto.Messages_AffectedHistory, error)
+	MsgGetMessageReactionsList(ctx context.Context, in *msg.TLMsgGetMessageReactionsList) (*mtproto.Messages_MessageReactionsList, error)
 }
 
 type defaultMsgClient struct {
@@ -137,3 +144,52 @@ func (m *defaultMsgClient) MsgUnpinAllMessages(ctx context.Context, in *msg.TLMs
 	client := msg.NewRPCMsgClient(m.cli.Conn())
 	return client.MsgUnpinAllMessages(ctx, in)
 }
+
+// MsgGetScheduledHistory
+// msg.getScheduledHistory user_id:long peer_type:int peer_id:long = Vector<Message>;
+func (m *defaultMsgClient) MsgGetScheduledHistory(ctx context.Context, in *msg.TLMsgGetScheduledHistory) (*msg.Vector_Message, error) {
+	client := msg.NewRPCMsgClient(m.cli.Conn())
+	return client.MsgGetScheduledHistory(ctx, in)
+}
+
+// MsgGetScheduledMessages
+// msg.getScheduledMessages user_id:long peer_type:int peer_id:long id:Vector<int> = Vector<Message>;
+func (m *defaultMsgClient) MsgGetScheduledMessages(ctx context.Context, in *msg.TLMsgGetScheduledMessages) (*msg.Vector_Message, error) {
+	client := msg.NewRPCMsgClient(m.cli.Conn())
+	return client.MsgGetScheduledMessages(ctx, in)
+}
+
+// MsgSendScheduledMessages
+// msg.sendScheduledMessages user_id:long auth_key_id:long peer_type:int peer_id:long id:Vector<int> = Updates;
+func (m *defaultMsgClient) MsgSendScheduledMessages(ctx context.Context, in *msg.TLMsgSendScheduledMessages) (*mtproto.Updates, error) {
+	client := msg.NewRPCMsgClient(m.cli.Conn())
+	return client.MsgSendScheduledMessages(ctx, in)
+}
+
+// MsgDeleteScheduledMessages
+// msg.deleteScheduledMessages user_id:long auth_key_id:long peer_type:int peer_id:long id:Vector<int> = Updates;
+func (m *defaultMsgClient) MsgDeleteScheduledMessages(ctx context.Context, in *msg.TLMsgDeleteScheduledMessages) (*mtproto.Updates, error) {
+	client := msg.NewRPCMsgClient(m.cli.Conn())
+	return client.MsgDeleteScheduledMessages(ctx, in)
+}
+
+// MsgSendReaction
+// msg.sendReaction flags:# user_id:long auth_key_id:long big:flags.1?true peer_type:int peer_id:long msg_id:int reaction:flags.0?string = Updates;
+func (m *defaultMsgClient) MsgSendReaction(ctx context.Context, in *msg.TLMsgSendReaction) (*mtproto.Updates, error) {
+	client := msg.NewRPCMsgClient(m.cli.Conn())
+	return client.MsgSendReaction(ctx, in)
+}
+
+// MsgReadReactions
+// msg.readReactions user_id:long auth_key_id:long peer_type:int peer_id:long = messages.AffectedHistory;
+func (m *defaultMsgClient) MsgReadReactions(ctx context.Context, in *msg.TLMsgReadReactions) (*mtproto.Messages_AffectedHistory, error) {
+	client := msg.NewRPCMsgClient(m.cli.Conn())
+	return client.MsgReadReactions(ctx, in)
+}
+
+// MsgGetMessageReactionsList
+// msg.getMessageReactionsList flags:# user_id:long peer_type:int peer_id:long msg_id:int reaction:flags.0?string offset:flags.1?string limit:int = messages.MessageReactionsList;
+func (m *defaultMsgClient) MsgGetMessageReactionsList(ctx context.Context, in *msg.TLMsgGetMessageReactionsList) (*mtproto.Messages_MessageReactionsList, error) {
+	client := msg.NewRPCMsgClient(m.cli.Conn())
+	return client.MsgGetMessageReactionsList(ctx, in)
+}
@@ -13,14 +13,23 @@ package msg_helper
 import (
 	"github.com/teamgram/teamgram-server/app/messenger/msg/msg/internal/config"
 	"github.com/teamgram/teamgram-server/app/messenger/msg/msg/internal/server/grpc/service"
+	"github.com/teamgram/teamgram-server/app/messenger/msg/msg/internal/server/scheduler"
 	"github.com/teamgram/teamgram-server/app/messenger/msg/msg/internal/svc"
 	"github.com/teamgram/teamgram-server/app/messenger/msg/msg/plugin"
 )
 
 type (
-	Config = config.Config
+	Config    = config.Config
+	Scheduler = scheduler.Scheduler
 )
 
 func New(c Config, plugin plugin.MsgPlugin) *service.Service {
 	return service.New(svc.NewServiceContext(c, plugin))
 }
+
+// NewWithScheduler also returns the worker delivering scheduled messages.
+func NewWithScheduler(c Config, plugin plugin.MsgPlugin) (*service.Service, *Scheduler) {
+	svcCtx := svc.NewServiceContext(c, plugin)
+
+	return service.New(svcCtx), scheduler.New(svcCtx)
+}
@@ -0,0 +1,407 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"strings"
+	"time"
+	"unicode/utf16"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/messenger/msg/inbox/inbox"
+	"github.com/teamgram/teamgram-server/app/messenger/msg/internal/dao"
+	"github.com/teamgram/teamgram-server/app/messenger/msg/msg/msg"
+	userpb "github.com/teamgram/teamgram-server/app/service/biz/user/user"
+	usernamepb "github.com/teamgram/teamgram-server/app/service/biz/username/username"
+)
+
+const (
+	botFatherStepNewBotName = iota + 1
+	botFatherStepNewBotUsername
+	botFatherStepChooseBot
+	botFatherStepSetCommands
+)
+
+const botFatherHelpText = `I can help you create and manage bots.
+
+You can control me by sending these commands:
+
+/newbot - create a new bot
+/mybots - list your bots
+/token - get the authorization token of a bot
+/revoke - revoke the access token of a bot
+/setcommands - change the list of commands of a bot
+/cancel - cancel the current operation`
+
+// onBotFatherMessage handles a private message sent to BotFather, BotFather is
+// a built-in bot, so it runs here instead of going through the bot updates.
+func (c *MsgCore) onBotFatherMessage(userId int64, message *mtproto.Message) {
+	// replies must not depend on the lifetime of the sendMessage request
+	c2 := New(context.Background(), c.svcCtx)
+
+	text := strings.TrimSpace(message.GetMessage())
+	if text == "" {
+		c2.replyBotFather(userId, "Sorry, I only understand text messages.")
+		return
+	}
+
+	if strings.HasPrefix(text, "/") {
+		c2.onBotFatherCommand(userId, text)
+		return
+	}
+
+	state, _ := c2.svcCtx.Dao.GetBotFatherState(c2.ctx, userId)
+	if state == nil {
+		c2.replyBotFather(userId, "Unrecognized command. Say what?\n\n"+botFatherHelpText)
+		return
+	}
+
+	switch state.Step {
+	case botFatherStepNewBotName:
+		c2.onBotFatherNewBotName(userId, state, text)
+	case botFatherStepNewBotUsername:
+		c2.onBotFatherNewBotUsername(userId, state, text)
+	case botFatherStepChooseBot:
+		c2.onBotFatherChooseBot(userId, state, text)
+	case botFatherStepSetCommands:
+		c2.onBotFatherSetCommands(userId, state, text)
+	default:
+		c2.svcCtx.Dao.ClearBotFatherState(c2.ctx, userId)
+		c2.replyBotFather(userId, botFatherHelpText)
+	}
+}
+
+func (c *MsgCore) onBotFatherCommand(userId int64, text string) {
+	var (
+		command = strings.Fields(text)[0]
+		args    = strings.TrimSpace(strings.TrimPrefix(text, command))
+	)
+
+	// /command@BotFather
+	if idx := strings.Index(command, "@"); idx > 0 {
+		command = command[:idx]
+	}
+	command = strings.ToLower(command)
+
+	switch command {
+	case "/start", "/help":
+		c.svcCtx.Dao.ClearBotFatherState(c.ctx, userId)
+		c.replyBotFather(userId, botFatherHelpText)
+	case "/cancel":
+		state, _ := c.svcCtx.Dao.GetBotFatherState(c.ctx, userId)
+		if state == nil {
+			c.replyBotFather(userId, "No active command to cancel. I wasn't doing anything anyway. Zzzzz...")
+			return
+		}
+		c.svcCtx.Dao.ClearBotFatherState(c.ctx, userId)
+		c.replyBotFather(userId, fmt.Sprintf("The command /%s has been cancelled. Anything else I can do for you?", state.Command))
+	case "/newbot":
+		c.svcCtx.Dao.PutBotFatherState(c.ctx, userId, &dao.BotFatherState{
+			Command: "newbot",
+			Step:    botFatherStepNewBotName,
+		})
+		c.replyBotFather(userId, "Alright, a new bot. How are we going to call it? Please choose a name for your bot.")
+	case "/mybots":
+		c.svcCtx.Dao.ClearBotFatherState(c.ctx, userId)
+		bots := c.getBotFatherBotList(userId)
+		if len(bots) == 0 {
+			c.replyBotFather(userId, "You have currently no bots. Use the /newbot command to create a new bot first.")
+			return
+		}
+		c.replyBotFather(userId, "Here are your bots:\n\n"+makeBotFatherBotListText(bots))
+	case "/token", "/revoke", "/setcommands":
+		state := &dao.BotFatherState{
+			Command: command[1:],
+			Step:    botFatherStepChooseBot,
+		}
+		if args != "" {
+			c.onBotFatherChooseBot(userId, state, args)
+			return
+		}
+
+		bots := c.getBotFatherBotList(userId)
+		if len(bots) == 0 {
+			c.svcCtx.Dao.ClearBotFatherState(c.ctx, userId)
+			c.replyBotFather(userId, "You have currently no bots. Use the /newbot command to create a new bot first.")
+			return
+		}
+		c.svcCtx.Dao.PutBotFatherState(c.ctx, userId, state)
+		c.replyBotFather(userId, "Choose a bot, send me its username:\n\n"+makeBotFatherBotListText(bots))
+	default:
+		c.replyBotFather(userId, "Unrecognized command. Say what?\n\n"+botFatherHelpText)
+	}
+}
+
+func (c *MsgCore) onBotFatherNewBotName(userId int64, state *dao.BotFatherState, text string) {
+	if name := []rune(text); len(name) > userpb.MaxBotFirstNameLen {
+		c.replyBotFather(userId, fmt.Sprintf("Sorry, the name is too long, it must be at most %d characters. Please try again.", userpb.MaxBotFirstNameLen))
+		return
+	}
+
+	state.Name = text
+	state.Step = botFatherStepNewBotUsername
+	c.svcCtx.Dao.PutBotFatherState(c.ctx, userId, state)
+	c.replyBotFather(userId, "Good. Now let's choose a username for your bot. It must end in `bot`. Like this, for example: TetrisBot or tetris_bot.")
+}
+
+func (c *MsgCore) onBotFatherNewBotUsername(userId int64, state *dao.BotFatherState, text string) {
+	name := strings.TrimPrefix(text, "@")
+	if !userpb.CheckBotUsername(name) {
+		c.replyBotFather(userId, "Sorry, this username is invalid. A username must end in `bot` and may only contain a-z, 0-9 and underscores. Please try again.")
+		return
+	}
+
+	existed, err := c.svcCtx.Dao.UsernameClient.UsernameCheckUsername(c.ctx, &usernamepb.TLUsernameCheckUsername{
+		Username: name,
+	})
+	if err != nil {
+		c.Logger.Errorf("botFather.newbot - error: %v", err)
+		c.replyBotFather(userId, "Sorry, something went wrong. Please try again later.")
+		return
+	} else if existed.GetPredicateName() != usernamepb.Predicate_usernameNotExisted {
+		c.replyBotFather(userId, "Sorry, this username is already taken. Please try something different.")
+		return
+	}
+
+	bot, err := c.svcCtx.Dao.UserClient.UserCreateBot(c.ctx, &userpb.TLUserCreateBot{
+		CreatorId: userId,
+		FirstName: state.Name,
+		Username:  name,
+	})
+	if err != nil {
+		c.Logger.Errorf("botFather.newbot - error: %v", err)
+		if mtproto.StatusErrEqual(err, mtproto.ErrBotsTooMuch) {
+			c.svcCtx.Dao.ClearBotFatherState(c.ctx, userId)
+			c.replyBotFather(userId, fmt.Sprintf("That I cannot do. You come to me asking for more than %d bots.", userpb.MaxBotsPerCreator))
+		} else {
+			c.replyBotFather(userId, "Sorry, this username is already taken. Please try something different.")
+		}
+		return
+	}
+
+	// bot has been created, reserve its username
+	ok, err := c.svcCtx.Dao.UsernameClient.UsernameUpdateUsername(c.ctx, &usernamepb.TLUsernameUpdateUsername{
+		PeerType: mtproto.PEER_USER,
+		PeerId:   bot.Id(),
+		Username: name,
+	})
+	if err != nil || !mtproto.FromBool(ok) {
+		c.Logger.Errorf("botFather.newbot - reserve username(%s) for bot(%d) error: %v", name, bot.Id(), err)
+	}
+
+	c.svcCtx.Dao.ClearBotFatherState(c.ctx, userId)
+	c.replyBotFatherToken(
+		userId,
+		fmt.Sprintf("Done! Congratulations on your new bot. You will find it at @%s. You can now add a description and commands for your bot.\n\nUse this token to access the HTTP API:\n", bot.Username()),
+		bot.User.GetBot().GetToken(),
+		"\n\nKeep your token secure and store it safely, it can be used by anyone to control your bot.")
+}
+
+func (c *MsgCore) onBotFatherChooseBot(userId int64, state *dao.BotFatherState, text string) {
+	bot := c.findBotFatherBot(userId, text)
+	if bot == nil {
+		c.svcCtx.Dao.PutBotFatherState(c.ctx, userId, state)
+		c.replyBotFather(userId, "Invalid bot selected. Send me the username of one of your bots, or /cancel.")
+		return
+	}
+
+	switch state.Command {
+	case "token":
+		c.svcCtx.Dao.ClearBotFatherState(c.ctx, userId)
+		c.replyBotFatherToken(
+			userId,
+			fmt.Sprintf("You can use this token to access the HTTP API of @%s:\n", bot.Username()),
+			bot.User.GetBot().GetToken(),
+			"")
+	case "revoke":
+		c.svcCtx.Dao.ClearBotFatherState(c.ctx, userId)
+		token, err := c.svcCtx.Dao.UserClient.UserResetBotToken(c.ctx, &userpb.TLUserResetBotToken{
+			CreatorId: userId,
+			BotId:     bot.Id(),
+		})
+		if err != nil {
+			c.Logger.Errorf("botFather.revoke - error: %v", err)
+			c.replyBotFather(userId, "Sorry, something went wrong. Please try again later.")
+			return
+		}
+		c.replyBotFatherToken(
+			userId,
+			fmt.Sprintf("Your token was replaced with a new one. You can use this token to access the HTTP API of @%s:\n", bot.Username()),
+			token.GetV(),
+			"")
+	case "setcommands":
+		state.BotId = bot.Id()
+		state.Step = botFatherStepSetCommands
+		c.svcCtx.Dao.PutBotFatherState(c.ctx, userId, state)
+		c.replyBotFather(userId, "OK. Send me a list of commands for your bot, one command per line, in this format:\n\ncommand1 - Description\ncommand2 - Another description")
+	default:
+		c.svcCtx.Dao.ClearBotFatherState(c.ctx, userId)
+		c.replyBotFather(userId, botFatherHelpText)
+	}
+}
+
+func (c *MsgCore) onBotFatherSetCommands(userId int64, state *dao.BotFatherState, text string) {
+	commands, ok := parseBotFatherCommandList(text)
+	if !ok {
+		c.replyBotFather(userId, "Sorry, the list of commands is invalid. Please use this format:\n\ncommand1 - Description\ncommand2 - Another description")
+		return
+	}
+
+	_, err := c.svcCtx.Dao.UserClient.UserSetBotCommands(c.ctx, &userpb.TLUserSetBotCommands{
+		UserId:   userId,
+		BotId:    state.BotId,
+		Commands: commands,
+	})
+	if err != nil {
+		c.Logger.Errorf("botFather.setcommands - error: %v", err)
+		c.replyBotFather(userId, fmt.Sprintf("Sorry, the list of commands is invalid. A command must be at most %d characters, may only contain a-z, 0-9 and underscores, and needs a description.", userpb.MaxBotCommandLen))
+		return
+	}
+
+	c.svcCtx.Dao.ClearBotFatherState(c.ctx, userId)
+	c.replyBotFather(userId, "Success! Command list updated. /help")
+}
+
+func (c *MsgCore) getBotFatherBotList(userId int64) []*userpb.ImmutableUser {
+	idList, err := c.svcCtx.Dao.UserClient.UserGetBotsByCreator(c.ctx, &userpb.TLUserGetBotsByCreator{
+		CreatorId: userId,
+	})
+	if err != nil {
+		c.Logger.Errorf("botFather.getBotList - error: %v", err)
+		return nil
+	} else if len(idList.GetDatas()) == 0 {
+		return nil
+	}
+
+	users, err := c.svcCtx.Dao.UserClient.UserGetMutableUsers(c.ctx, &userpb.TLUserGetMutableUsers{
+		Id: idList.GetDatas(),
+	})
+	if err != nil {
+		c.Logger.Errorf("botFather.getBotList - error: %v", err)
+		return nil
+	}
+
+	bots := make([]*userpb.ImmutableUser, 0, len(idList.GetDatas()))
+	for _, id := range idList.GetDatas() {
+		if bot, ok := users.GetImmutableUser(id); ok && !bot.Deleted() {
+			bots = append(bots, bot)
+		}
+	}
+
+	return bots
+}
+
+func (c *MsgCore) findBotFatherBot(userId int64, name string) *userpb.ImmutableUser {
+	name = strings.TrimPrefix(strings.TrimSpace(name), "@")
+	for _, bot := range c.getBotFatherBotList(userId) {
+		if strings.EqualFold(bot.Username(), name) {
+			return bot
+		}
+	}
+
+	return nil
+}
+
+func (c *MsgCore) replyBotFatherToken(userId int64, prefix, token, suffix string) {
+	c.replyBotFather(
+		userId,
+		prefix+token+suffix,
+		mtproto.MakeTLMessageEntityCode(&mtproto.MessageEntity{
+			Offset: int32(len(utf16.Encode([]rune(prefix)))),
+			Length: int32(len(utf16.Encode([]rune(token)))),
+		}).To_MessageEntity())
+}
+
+func (c *MsgCore) replyBotFather(userId int64, text string, entities ...*mtproto.MessageEntity) {
+	outBox := msg.MakeTLOutboxMessage(&msg.OutboxMessage{
+		NoWebpage: true,
+		RandomId:  rand.Int63(),
+		Message: mtproto.MakeTLMessage(&mtproto.Message{
+			Out:      true,
+			Date:     int32(time.Now().Unix()),
+			FromId:   mtproto.MakePeerUser(userpb.BotFatherId),
+			PeerId:   mtproto.MakePeerUser(userId),
+			Message:  text,
+			Entities: entities,
+		}).To_Message(),
+	}).To_OutboxMessage()
+
+	err := c.pushUserMessage(
+		userpb.BotFatherId,
+		userId,
+		outBox,
+		func(did int64, inboxMsg *mtproto.Message) error {
+			_, err := c.svcCtx.Dao.InboxClient.InboxSendUserMessageToInbox(c.ctx, &inbox.TLInboxSendUserMessageToInbox{
+				FromId:     userpb.BotFatherId,
+				PeerUserId: userId,
+				Message: inbox.MakeTLInboxMessageData(&inbox.InboxMessageData{
+					RandomId:        outBox.RandomId,
+					DialogMessageId: did,
+					Message:         inboxMsg,
+				}).To_InboxMessageData(),
+			})
+			return err
+		})
+	if err != nil {
+		c.Logger.Errorf("botFather.reply - error: %v", err)
+	}
+}
+
+func makeBotFatherBotListText(bots []*userpb.ImmutableUser) string {
+	var b strings.Builder
+	for i, bot := range bots {
+		if i > 0 {
+			b.WriteString("\n")
+		}
+		b.WriteString("@" + bot.Username())
+	}
+
+	return b.String()
+}
+
+// parseBotFatherCommandList parses `command - description` lines.
+func parseBotFatherCommandList(text string) ([]*mtproto.BotCommand, bool) {
+	var commands []*mtproto.BotCommand
+
+	for _, line := range strings.Split(text, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		idx := strings.Index(line, "-")
+		if idx <= 0 {
+			return nil, false
+		}
+
+		command := &mtproto.BotCommand{
+			Command:     strings.ToLower(strings.TrimPrefix(strings.TrimSpace(line[:idx]), "/")),
+			Description: strings.TrimSpace(line[idx+1:]),
+		}
+		if userpb.CheckBotCommand(command) != nil {
+			return nil, false
+		}
+		commands = append(commands, command)
+	}
+
+	return commands, len(commands) > 0 && len(commands) <= userpb.MaxBotCommands
+}
@@ -0,0 +1,59 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/messenger/msg/internal/dal/dataobject"
+	"github.com/teamgram/teamgram-server/app/messenger/msg/msg/msg"
+	"github.com/teamgram/teamgram-server/app/messenger/sync/sync"
+)
+
+// MsgDeleteScheduledMessages
+// msg.deleteScheduledMessages user_id:long auth_key_id:long peer_type:int peer_id:long id:Vector<int> = Updates;
+func (c *MsgCore) MsgDeleteScheduledMessages(in *msg.TLMsgDeleteScheduledMessages) (*mtproto.Updates, error) {
+	var (
+		idList = make([]int32, 0, len(in.Id))
+	)
+
+	_, err := c.svcCtx.Dao.ScheduledMessagesDAO.SelectByIdListWithCB(
+		c.ctx,
+		in.UserId,
+		in.PeerType,
+		in.PeerId,
+		in.Id,
+		func(i int, v *dataobject.ScheduledMessagesDO) {
+			idList = append(idList, v.ScheduledMessageId)
+		})
+	if err != nil {
+		c.Logger.Errorf("msg.deleteScheduledMessages - error: %v", err)
+		return nil, err
+	} else if len(idList) == 0 {
+		return mtproto.MakeEmptyUpdates(), nil
+	}
+
+	if _, err = c.svcCtx.Dao.ScheduledMessagesDAO.DeleteByIdList(c.ctx, in.UserId, in.PeerType, in.PeerId, idList); err != nil {
+		c.Logger.Errorf("msg.deleteScheduledMessages - error: %v", err)
+		return nil, err
+	}
+
+	updateDeleteScheduledMessages := mtproto.MakeTLUpdateDeleteScheduledMessages(&mtproto.Update{
+		Peer_PEER: mtproto.MakePeerUtil(in.PeerType, in.PeerId).ToPeer(),
+		Messages:  idList,
+	}).To_Update()
+
+	c.svcCtx.Dao.SyncClient.SyncUpdatesNotMe(c.ctx, &sync.TLSyncUpdatesNotMe{
+		UserId:    in.UserId,
+		AuthKeyId: in.AuthKeyId,
+		Updates:   mtproto.MakeUpdatesByUpdates(updateDeleteScheduledMessages),
+	})
+
+	return mtproto.MakeUpdatesByUpdates(updateDeleteScheduledMessages), nil
+}
@@ -0,0 +1,91 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"math"
+	"strconv"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/messenger/msg/internal/dal/dataobject"
+	"github.com/teamgram/teamgram-server/app/messenger/msg/msg/msg"
+
+	"github.com/gogo/protobuf/types"
+)
+
+const (
+	reactionsListLimitMax = 100
+)
+
+// MsgGetMessageReactionsList
+// msg.getMessageReactionsList flags:# user_id:long peer_type:int peer_id:long msg_id:int reaction:flags.0?string offset:flags.1?string limit:int = messages.MessageReactionsList;
+func (c *MsgCore) MsgGetMessageReactionsList(in *msg.TLMsgGetMessageReactionsList) (*mtproto.Messages_MessageReactionsList, error) {
+	do, err := c.svcCtx.Dao.MessagesDAO.SelectByMessageId(c.ctx, in.UserId, in.MsgId)
+	if err != nil {
+		c.Logger.Errorf("msg.getMessageReactionsList - error: %v", err)
+		return nil, err
+	} else if do == nil || do.PeerType != in.PeerType || do.PeerId != in.PeerId {
+		err = mtproto.ErrMsgIdInvalid
+		c.Logger.Errorf("msg.getMessageReactionsList - error: %v", err)
+		return nil, err
+	}
+
+	var (
+		offset   int64 = math.MaxInt64
+		limit          = in.Limit
+		reaction       = in.GetReaction().GetValue()
+		count    int32
+		rList    []dataobject.MessageReactionsDO
+	)
+	if in.GetOffset().GetValue() != "" {
+		offset, err = strconv.ParseInt(in.GetOffset().GetValue(), 10, 64)
+		if err != nil {
+			err = mtproto.ErrOffsetInvalid
+			c.Logger.Errorf("msg.getMessageReactionsList - error: %v", err)
+			return nil, err
+		}
+	}
+	if limit <= 0 || limit > reactionsListLimitMax {
+		limit = reactionsListLimitMax
+	}
+
+	// count
+	c.svcCtx.Dao.MessageReactionsDAO.SelectListWithCB(
+		c.ctx,
+		do.DialogMessageId,
+		func(i int, v *dataobject.MessageReactionsDO) {
+			if reaction == "" || v.Reaction == reaction {
+				count++
+			}
+		})
+
+	if reaction != "" {
+		rList, err = c.svcCtx.Dao.MessageReactionsDAO.SelectListByReaction(c.ctx, do.DialogMessageId, reaction, offset, limit)
+	} else {
+		rList, err = c.svcCtx.Dao.MessageReactionsDAO.SelectListByOffset(c.ctx, do.DialogMessageId, offset, limit)
+	}
+	if err != nil {
+		c.Logger.Errorf("msg.getMessageReactionsList - error: %v", err)
+		return nil, err
+	}
+
+	reactionsList := mtproto.MakeTLMessagesMessageReactionsList(&mtproto.Messages_MessageReactionsList{
+		Count:      count,
+		Reactions:  c.svcCtx.Dao.MakeMessagePeerReactions(do, rList),
+		Chats:      []*mtproto.Chat{},
+		Users:      []*mtproto.User{},
+		NextOffset: nil,
+	}).To_Messages_MessageReactionsList()
+	if len(rList) == int(limit) {
+		reactionsList.NextOffset = &types.StringValue{Value: strconv.FormatInt(rList[len(rList)-1].Id, 10)}
+	}
+
+	return reactionsList, nil
+}
@@ -0,0 +1,29 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/messenger/msg/msg/msg"
+)
+
+// MsgGetScheduledHistory
+// msg.getScheduledHistory user_id:long peer_type:int peer_id:long = Vector<Message>;
+func (c *MsgCore) MsgGetScheduledHistory(in *msg.TLMsgGetScheduledHistory) (*msg.Vector_Message, error) {
+	messageList, err := c.svcCtx.Dao.GetScheduledMessageList(c.ctx, in.UserId, mtproto.MakePeerUtil(in.PeerType, in.PeerId))
+	if err != nil {
+		c.Logger.Errorf("msg.getScheduledHistory - error: %v", err)
+		return nil, err
+	}
+
+	return &msg.Vector_Message{
+		Datas: messageList,
+	}, nil
+}
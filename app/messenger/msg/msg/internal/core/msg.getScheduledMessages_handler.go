@@ -0,0 +1,29 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/messenger/msg/msg/msg"
+)
+
+// MsgGetScheduledMessages
+// msg.getScheduledMessages user_id:long peer_type:int peer_id:long id:Vector<int> = Vector<Message>;
+func (c *MsgCore) MsgGetScheduledMessages(in *msg.TLMsgGetScheduledMessages) (*msg.Vector_Message, error) {
+	messageList, err := c.svcCtx.Dao.GetScheduledMessageListByIdList(c.ctx, in.UserId, mtproto.MakePeerUtil(in.PeerType, in.PeerId), in.Id)
+	if err != nil {
+		c.Logger.Errorf("msg.getScheduledMessages - error: %v", err)
+		return nil, err
+	}
+
+	return &msg.Vector_Message{
+		Datas: messageList,
+	}, nil
+}
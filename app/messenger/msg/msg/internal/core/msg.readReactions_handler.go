@@ -0,0 +1,31 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/messenger/msg/msg/msg"
+)
+
+// MsgReadReactions
+// msg.readReactions user_id:long auth_key_id:long peer_type:int peer_id:long = messages.AffectedHistory;
+func (c *MsgCore) MsgReadReactions(in *msg.TLMsgReadReactions) (*mtproto.Messages_AffectedHistory, error) {
+	_, err := c.svcCtx.Dao.MessagesDAO.UpdateReactionUnreadByPeer(c.ctx, in.UserId, in.PeerType, in.PeerId)
+	if err != nil {
+		c.Logger.Errorf("msg.readReactions - error: %v", err)
+		return nil, err
+	}
+
+	return mtproto.MakeTLMessagesAffectedHistory(&mtproto.Messages_AffectedHistory{
+		Pts:      c.svcCtx.Dao.IDGenClient2.CurrentPtsId(c.ctx, in.UserId),
+		PtsCount: 0,
+		Offset:   0,
+	}).To_Messages_AffectedHistory(), nil
+}
@@ -11,11 +11,14 @@ package core
 
 import (
 	"context"
+	"time"
 
 	"github.com/teamgram/proto/mtproto"
 	"github.com/teamgram/teamgram-server/app/messenger/msg/inbox/inbox"
+	"github.com/teamgram/teamgram-server/app/messenger/msg/internal/dao"
 	"github.com/teamgram/teamgram-server/app/messenger/msg/msg/msg"
 	"github.com/teamgram/teamgram-server/app/messenger/sync/sync"
+	channelpb "github.com/teamgram/teamgram-server/app/service/biz/channel/channel"
 	chatpb "github.com/teamgram/teamgram-server/app/service/biz/chat/chat"
 	userpb "github.com/teamgram/teamgram-server/app/service/biz/user/user"
 )
@@ -30,14 +33,29 @@ func (c *MsgCore) MsgSendMessage(in *msg.TLMsgSendMessage) (*mtproto.Updates, er
 		peer     = mtproto.MakePeerUtil(in.PeerType, in.PeerId)
 	)
 
-	if peer.IsChannel() {
-		// c.Logger.Errorf("msg.sendMultiMessage blocked, License key from https://teamgram.net required to unlock enterprise features.")
-		return nil, mtproto.ErrEnterpriseIsBlocked
+	if scheduleDate := outBox.GetScheduleDate().GetValue(); scheduleDate != 0 {
+		if int64(scheduleDate) > time.Now().Unix() {
+			rUpdates, err = c.sendScheduledOutgoingMessage(in.UserId, in.AuthKeyId, peer, outBox)
+			if err != nil {
+				c.Logger.Errorf("msg.sendMessage - error: %v", err)
+				return nil, err
+			}
+
+			return rUpdates, nil
+		}
+
+		// schedule_date has passed, send it right now
+		outBox.ScheduleDate = nil
 	}
 
-	if outBox.GetScheduleDate().GetValue() != 0 {
-		// c.Logger.Errorf("msg.sendMessage blocked, License key from https://teamgram.net required to unlock enterprise features.")
-		return nil, mtproto.ErrEnterpriseIsBlocked
+	if peer.IsChannel() {
+		rUpdates, err = c.sendChannelOutgoingMessage(in.UserId, in.AuthKeyId, in.PeerId, outBox)
+		if err != nil {
+			c.Logger.Errorf("msg.sendMessage - error: %v", err)
+			return nil, err
+		}
+
+		return rUpdates, nil
 	}
 
 	if !peer.IsChatOrUser() {
@@ -108,19 +126,12 @@ func (c *MsgCore) sendUserOutgoingMessage(userId, authKeyId, peerUserId int64, o
 		peerUserId,
 		outBox,
 		func(did int64, inboxMsg *mtproto.Message) error {
-			//inBox := &msgpb.InboxUserMessage{
-			//	From:            makeSender(r.From),
-			//	PeerUserId:      r.PeerId,
-			//	RandomId:        r.Message.RandomId,
-			//	DialogMessageId: did,
-			//	MessageDataId:   mid,
-			//	Message:         inboxMsg,
-			//}
-			//if model.IsBotFather(r.PeerUserId) {
-			//	return s.botsClient.SendUserMessageToInbox(ctx, inBox)
-			//} else {
-			// toUser, _ := s.UserFacade.GetUserById(ctx, r.From.Id, r.PeerId)
-			// log.Debug(toUser.DebugString())
+			if userpb.IsBotFather(peerUserId) {
+				// BotFather is built in, answer it here instead of delivering to the inbox
+				go c.onBotFatherMessage(userId, inboxMsg)
+				return nil
+			}
+
 			blocked, _ := c.svcCtx.Dao.UserClient.UserBlockedByUser(c.ctx, &userpb.TLUserBlockedByUser{
 				UserId:     peerUserId,
 				PeerUserId: userId,
@@ -138,7 +149,6 @@ func (c *MsgCore) sendUserOutgoingMessage(userId, authKeyId, peerUserId int64, o
 					}).To_InboxMessageData(),
 				})
 			}
-			//}
 			return nil
 		})
 	if err != nil {
@@ -359,3 +369,145 @@ func (c *MsgCore) sendChatMessage(
 
 	return rUpdates, nil
 }
+
+func (c *MsgCore) sendChannelOutgoingMessage(userId, authKeyId, channelId int64, outBox *msg.OutboxMessage) (*mtproto.Updates, error) {
+	box, err := c.svcCtx.Dao.ChannelClient.ChannelSendMessage(c.ctx, &channelpb.TLChannelSendMessage{
+		UserId:    userId,
+		ChannelId: channelId,
+		RandomId:  outBox.RandomId,
+		Message:   outBox.Message,
+	})
+	if err != nil {
+		c.Logger.Errorf("msg.sendChannelOutgoingMessage - error: %v", err)
+		return nil, err
+	}
+
+	makeUpdateNewChannelMessage := func(toUserId int64) *mtproto.Update {
+		return mtproto.MakeTLUpdateNewChannelMessage(&mtproto.Update{
+			Pts_INT32:       box.Pts,
+			PtsCount:        box.PtsCount,
+			RandomId:        box.RandomId,
+			Message_MESSAGE: box.ToMessage(toUserId),
+		}).To_Update()
+	}
+
+	getUserListByIdList := func(toUserId int64, idList []int64) []*mtproto.User {
+		users, err := c.svcCtx.Dao.UserClient.UserGetMutableUsers(c.ctx,
+			&userpb.TLUserGetMutableUsers{
+				Id: append(idList, toUserId),
+			})
+		if err != nil {
+			c.Logger.Errorf("msg.sendChannelOutgoingMessage - error: %v", err)
+		}
+		return users.GetUserListByIdList(toUserId, idList...)
+	}
+
+	getChannelListByIdList := func(toUserId int64, idList []int64) []*mtproto.Chat {
+		channels, err := c.svcCtx.Dao.ChannelClient.ChannelGetChannelListByIdList(c.ctx,
+			&channelpb.TLChannelGetChannelListByIdList{
+				SelfUserId: toUserId,
+				Id:         idList,
+			})
+		if err != nil {
+			c.Logger.Errorf("msg.sendChannelOutgoingMessage - error: %v", err)
+		}
+		return channels.GetDatas()
+	}
+
+	updateNewChannelMessage := makeUpdateNewChannelMessage(userId)
+	rUpdates := mtproto.MakeReplyUpdates(
+		func(idList []int64) []*mtproto.User {
+			return getUserListByIdList(userId, idList)
+		},
+		nil,
+		func(idList []int64) []*mtproto.Chat {
+			return getChannelListByIdList(userId, idList)
+		},
+		updateNewChannelMessage)
+
+	_, err = c.svcCtx.Dao.SyncClient.SyncUpdatesNotMe(c.ctx, &sync.TLSyncUpdatesNotMe{
+		UserId:    userId,
+		AuthKeyId: authKeyId,
+		Updates: mtproto.MakeSyncNotMeUpdates(
+			func(idList []int64) []*mtproto.User {
+				return rUpdates.Users
+			},
+			nil,
+			func(idList []int64) []*mtproto.Chat {
+				return rUpdates.Chats
+			},
+			updateNewChannelMessage),
+	})
+	if err != nil {
+		c.Logger.Errorf("msg.sendChannelOutgoingMessage - error: %v", err)
+	}
+
+	// the message is the same for all the other participants, sync fans it out to them
+	// and replaces the users and the channel by the ones each of them sees.
+	// TODO(@benqi): large channels should be fetched by getChannelDifference instead of pushed
+	_, err = c.svcCtx.Dao.SyncClient.SyncBroadcastUpdates(c.ctx, &sync.TLSyncBroadcastUpdates{
+		BroadcastType: sync.BroadcastTypeChannel,
+		ChatId:        channelId,
+		ExcludeIdList: []int64{userId},
+		Updates: mtproto.MakePushUpdates(
+			func(idList []int64) []*mtproto.User {
+				return rUpdates.Users
+			},
+			nil,
+			func(idList []int64) []*mtproto.Chat {
+				return rUpdates.Chats
+			},
+			makeUpdateNewChannelMessage(0)),
+	})
+	if err != nil {
+		c.Logger.Errorf("msg.sendChannelOutgoingMessage - error: %v", err)
+	}
+
+	return rUpdates, nil
+}
+
+func (c *MsgCore) sendScheduledOutgoingMessage(userId, authKeyId int64, peer *mtproto.PeerUtil, outBox *msg.OutboxMessage) (*mtproto.Updates, error) {
+	if !peer.IsChatOrUser() && !peer.IsChannel() {
+		err := mtproto.ErrPeerIdInvalid
+		c.Logger.Errorf("msg.sendScheduledOutgoingMessage - error: %v", err)
+		return nil, err
+	}
+
+	if int64(outBox.GetScheduleDate().GetValue()) > time.Now().Unix()+dao.MaxScheduleDelay {
+		err := mtproto.ErrScheduleDateTooLate
+		c.Logger.Errorf("msg.sendScheduledOutgoingMessage - error: %v", err)
+		return nil, err
+	}
+
+	message, err := c.svcCtx.Dao.SaveScheduledMessage(c.ctx, userId, peer, outBox)
+	if err != nil {
+		c.Logger.Errorf("msg.sendScheduledOutgoingMessage - error: %v", err)
+		return nil, err
+	}
+
+	updateNewScheduledMessage := mtproto.MakeTLUpdateNewScheduledMessage(&mtproto.Update{
+		RandomId:        outBox.RandomId,
+		Message_MESSAGE: message,
+	}).To_Update()
+
+	rUpdates := c.makeScheduledReplyUpdates(userId, updateNewScheduledMessage)
+
+	c.svcCtx.Dao.SyncClient.SyncUpdatesNotMe(c.ctx, &sync.TLSyncUpdatesNotMe{
+		UserId:    userId,
+		AuthKeyId: authKeyId,
+		Updates: mtproto.MakeSyncNotMeUpdates(
+			func(idList []int64) []*mtproto.User {
+				return rUpdates.Users
+			},
+			func(idList []int64) []*mtproto.Chat {
+				return rUpdates.Chats
+			},
+			func(idList []int64) []*mtproto.Chat {
+				// rUpdates.Chats include channels
+				return nil
+			},
+			updateNewScheduledMessage),
+	})
+
+	return rUpdates, nil
+}
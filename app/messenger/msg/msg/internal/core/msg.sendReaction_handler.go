@@ -0,0 +1,116 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/messenger/msg/msg/msg"
+	"github.com/teamgram/teamgram-server/app/messenger/sync/sync"
+	userpb "github.com/teamgram/teamgram-server/app/service/biz/user/user"
+)
+
+// MsgSendReaction
+// msg.sendReaction flags:# user_id:long auth_key_id:long big:flags.1?true peer_type:int peer_id:long msg_id:int reaction:flags.0?string = Updates;
+func (c *MsgCore) MsgSendReaction(in *msg.TLMsgSendReaction) (*mtproto.Updates, error) {
+	switch in.PeerType {
+	case mtproto.PEER_USER, mtproto.PEER_CHAT:
+	default:
+		err := mtproto.ErrPeerIdInvalid
+		c.Logger.Errorf("msg.sendReaction - error: %v", err)
+		return nil, err
+	}
+
+	do, err := c.svcCtx.Dao.MessagesDAO.SelectByMessageId(c.ctx, in.UserId, in.MsgId)
+	if err != nil {
+		c.Logger.Errorf("msg.sendReaction - error: %v", err)
+		return nil, err
+	} else if do == nil || do.PeerType != in.PeerType || do.PeerId != in.PeerId {
+		err = mtproto.ErrMsgIdInvalid
+		c.Logger.Errorf("msg.sendReaction - error: %v", err)
+		return nil, err
+	}
+
+	err = c.svcCtx.Dao.SetMessageReaction(c.ctx, in.UserId, do, in.GetReaction().GetValue(), in.GetBig())
+	if err != nil {
+		c.Logger.Errorf("msg.sendReaction - error: %v", err)
+		return nil, err
+	}
+
+	return c.pushUpdateMessageReactions(in.UserId, in.AuthKeyId, do.DialogMessageId)
+}
+
+// pushUpdateMessageReactions sends updateMessageReactions to the owners of all the copies
+// of the message, every owner gets the reactions with their own chosen reaction.
+func (c *MsgCore) pushUpdateMessageReactions(userId, authKeyId, dialogMessageId int64) (*mtproto.Updates, error) {
+	doList, err := c.svcCtx.Dao.MessageReactionsDAO.SelectList(c.ctx, dialogMessageId)
+	if err != nil {
+		return nil, err
+	}
+	boxList, err := c.svcCtx.Dao.MessagesDAO.SelectByMessageDataIdList(c.ctx, []int64{dialogMessageId})
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		rUpdates     *mtproto.Updates
+		reactionList = make([]*mtproto.MessageReactions, 0, len(boxList))
+		idList       []int64
+		ownerIdList  = make([]int64, 0, len(boxList))
+	)
+	for i := 0; i < len(boxList); i++ {
+		ownerIdList = append(ownerIdList, boxList[i].UserId)
+		reactions := c.svcCtx.Dao.MakeMessageReactions(&boxList[i], doList)
+		reactionList = append(reactionList, reactions)
+		if i == 0 {
+			for _, r := range reactions.GetRecentReactions() {
+				idList = append(idList, r.GetPeerId().GetUserId())
+			}
+		}
+	}
+
+	users, _ := c.svcCtx.Dao.UserClient.UserGetMutableUsers(c.ctx, &userpb.TLUserGetMutableUsers{
+		Id: append(ownerIdList, idList...),
+	})
+
+	for i := 0; i < len(boxList); i++ {
+		box := &boxList[i]
+		updates := mtproto.MakeUpdatesByUpdatesUsers(
+			users.GetUserListByIdList(box.UserId, idList...),
+			mtproto.MakeTLUpdateMessageReactions(&mtproto.Update{
+				Peer_PEER:   mtproto.MakePeer(box.PeerType, box.PeerId),
+				MsgId_INT32: box.UserMessageBoxId,
+				Reactions:   reactionList[i],
+			}).To_Update())
+
+		if box.UserId == userId {
+			rUpdates = updates
+			c.svcCtx.Dao.SyncClient.SyncUpdatesNotMe(
+				c.ctx,
+				&sync.TLSyncUpdatesNotMe{
+					UserId:    userId,
+					AuthKeyId: authKeyId,
+					Updates:   updates,
+				})
+		} else {
+			c.svcCtx.Dao.SyncClient.SyncPushUpdates(
+				c.ctx,
+				&sync.TLSyncPushUpdates{
+					UserId:  box.UserId,
+					Updates: updates,
+				})
+		}
+	}
+
+	if rUpdates == nil {
+		rUpdates = mtproto.MakeUpdatesByUpdates()
+	}
+
+	return rUpdates, nil
+}
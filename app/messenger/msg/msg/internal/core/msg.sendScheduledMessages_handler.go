@@ -0,0 +1,184 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/messenger/msg/internal/dal/dataobject"
+	"github.com/teamgram/teamgram-server/app/messenger/msg/internal/dao"
+	"github.com/teamgram/teamgram-server/app/messenger/msg/msg/msg"
+	"github.com/teamgram/teamgram-server/app/messenger/sync/sync"
+	channelpb "github.com/teamgram/teamgram-server/app/service/biz/channel/channel"
+	chatpb "github.com/teamgram/teamgram-server/app/service/biz/chat/chat"
+	userpb "github.com/teamgram/teamgram-server/app/service/biz/user/user"
+)
+
+// MsgSendScheduledMessages
+// msg.sendScheduledMessages user_id:long auth_key_id:long peer_type:int peer_id:long id:Vector<int> = Updates;
+func (c *MsgCore) MsgSendScheduledMessages(in *msg.TLMsgSendScheduledMessages) (*mtproto.Updates, error) {
+	doList, err := c.svcCtx.Dao.ScheduledMessagesDAO.SelectByIdList(c.ctx, in.UserId, in.PeerType, in.PeerId, in.Id)
+	if err != nil {
+		c.Logger.Errorf("msg.sendScheduledMessages - error: %v", err)
+		return nil, err
+	} else if len(doList) == 0 {
+		err = mtproto.ErrMessageIdInvalid
+		c.Logger.Errorf("msg.sendScheduledMessages - error: %v", err)
+		return nil, err
+	}
+
+	var (
+		updateList = make([]*mtproto.Update, 0, len(doList)+1)
+		idList     = make([]int32, 0, len(doList))
+	)
+
+	// send in the scheduled order
+	for i := len(doList) - 1; i >= 0; i-- {
+		rUpdates, err2 := c.deliverScheduledMessage(in.AuthKeyId, &doList[i])
+		if err2 != nil {
+			c.Logger.Errorf("msg.sendScheduledMessages - error: %v", err2)
+			c.svcCtx.Dao.ScheduledMessagesDAO.UpdateState(c.ctx, dao.ScheduledStateWaiting, doList[i].Id)
+			err = err2
+			continue
+		} else if rUpdates == nil {
+			continue
+		}
+
+		idList = append(idList, doList[i].ScheduledMessageId)
+		for _, upd := range rUpdates.GetUpdates() {
+			switch upd.PredicateName {
+			case mtproto.Predicate_updateNewMessage,
+				mtproto.Predicate_updateNewChannelMessage:
+				updateList = append(updateList, upd)
+			}
+		}
+	}
+
+	if len(idList) == 0 {
+		if err == nil {
+			err = mtproto.ErrMessageIdInvalid
+		}
+		return nil, err
+	}
+
+	updateDeleteScheduledMessages := mtproto.MakeTLUpdateDeleteScheduledMessages(&mtproto.Update{
+		Peer_PEER: mtproto.MakePeerUtil(in.PeerType, in.PeerId).ToPeer(),
+		Messages:  idList,
+	}).To_Update()
+	updateList = append(updateList, updateDeleteScheduledMessages)
+
+	c.svcCtx.Dao.SyncClient.SyncUpdatesNotMe(c.ctx, &sync.TLSyncUpdatesNotMe{
+		UserId:    in.UserId,
+		AuthKeyId: in.AuthKeyId,
+		Updates:   mtproto.MakeUpdatesByUpdates(updateDeleteScheduledMessages),
+	})
+
+	return c.makeScheduledReplyUpdates(in.UserId, updateList...), nil
+}
+
+// DeliverScheduledMessages sends the scheduled messages whose schedule_date has come,
+// returns the number of pending messages it fetched.
+func (c *MsgCore) DeliverScheduledMessages(now int64, limit int32) int {
+	doList, err := c.svcCtx.Dao.ScheduledMessagesDAO.SelectPendingList(c.ctx, now, limit)
+	if err != nil {
+		c.Logger.Errorf("deliverScheduledMessages - error: %v", err)
+		return 0
+	}
+
+	for i := 0; i < len(doList); i++ {
+		do := &doList[i]
+		rUpdates, err := c.deliverScheduledMessage(0, do)
+		if err != nil {
+			// drop it, the sender is notified by updateDeleteScheduledMessages
+			c.Logger.Errorf("deliverScheduledMessages - error: %v, scheduled: {user_id: %d, id: %d}",
+				err,
+				do.UserId,
+				do.ScheduledMessageId)
+			c.svcCtx.Dao.ScheduledMessagesDAO.UpdateState(c.ctx, dao.ScheduledStateFailed, do.Id)
+		} else if rUpdates == nil {
+			continue
+		}
+
+		c.svcCtx.Dao.SyncClient.SyncPushUpdates(c.ctx, &sync.TLSyncPushUpdates{
+			UserId: do.UserId,
+			Updates: mtproto.MakeUpdatesByUpdates(mtproto.MakeTLUpdateDeleteScheduledMessages(&mtproto.Update{
+				Peer_PEER: mtproto.MakePeerUtil(do.PeerType, do.PeerId).ToPeer(),
+				Messages:  []int32{do.ScheduledMessageId},
+			}).To_Update()),
+		})
+	}
+
+	return len(doList)
+}
+
+// deliverScheduledMessage sends a scheduled message through the normal outgoing path,
+// it returns nil updates if the message is delivered or deleted by someone else.
+func (c *MsgCore) deliverScheduledMessage(authKeyId int64, do *dataobject.ScheduledMessagesDO) (*mtproto.Updates, error) {
+	rowsAffected, err := c.svcCtx.Dao.ScheduledMessagesDAO.UpdateSending(c.ctx, do.Id)
+	if err != nil {
+		return nil, err
+	} else if rowsAffected == 0 {
+		return nil, nil
+	}
+
+	outBox := dao.MakeOutboxMessageByDO(do)
+	if outBox == nil {
+		return nil, mtproto.ErrMessageEmpty
+	}
+
+	var (
+		rUpdates *mtproto.Updates
+		peer     = mtproto.MakePeerUtil(do.PeerType, do.PeerId)
+	)
+
+	switch {
+	case peer.IsUser():
+		rUpdates, err = c.sendUserOutgoingMessage(do.UserId, authKeyId, peer.PeerId, outBox)
+	case peer.IsChat():
+		rUpdates, err = c.sendChatOutgoingMessage(do.UserId, authKeyId, peer.PeerId, outBox)
+	case peer.IsChannel():
+		rUpdates, err = c.sendChannelOutgoingMessage(do.UserId, authKeyId, peer.PeerId, outBox)
+	default:
+		err = mtproto.ErrPeerIdInvalid
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	c.svcCtx.Dao.ScheduledMessagesDAO.UpdateState(c.ctx, dao.ScheduledStateSent, do.Id)
+
+	return rUpdates, nil
+}
+
+func (c *MsgCore) makeScheduledReplyUpdates(userId int64, updates ...*mtproto.Update) *mtproto.Updates {
+	return mtproto.MakeReplyUpdates(
+		func(idList []int64) []*mtproto.User {
+			users, _ := c.svcCtx.Dao.UserClient.UserGetMutableUsers(c.ctx,
+				&userpb.TLUserGetMutableUsers{
+					Id: idList,
+				})
+			return users.GetUserListByIdList(userId, idList...)
+		},
+		func(idList []int64) []*mtproto.Chat {
+			chats, _ := c.svcCtx.Dao.ChatClient.ChatGetChatListByIdList(c.ctx,
+				&chatpb.TLChatGetChatListByIdList{
+					IdList: idList,
+				})
+			return chats.GetChatListByIdList(userId, idList...)
+		},
+		func(idList []int64) []*mtproto.Chat {
+			channels, _ := c.svcCtx.Dao.ChannelClient.ChannelGetChannelListByIdList(c.ctx,
+				&channelpb.TLChannelGetChannelListByIdList{
+					SelfUserId: userId,
+					Id:         idList,
+				})
+			return channels.GetDatas()
+		},
+		updates...)
+}
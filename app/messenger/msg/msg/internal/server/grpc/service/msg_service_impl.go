@@ -212,3 +212,108 @@ func (s *Service) MsgUnpinAllMessages(ctx context.Context, request *msg.TLMsgUnp
 	c.Infof("msg.unpinAllMessages - reply: %s", r.DebugString())
 	return r, err
 }
+
+// MsgGetScheduledHistory
+// msg.getScheduledHistory user_id:long peer_type:int peer_id:long = Vector<Message>;
+func (s *Service) MsgGetScheduledHistory(ctx context.Context, request *msg.TLMsgGetScheduledHistory) (*msg.Vector_Message, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Infof("msg.getScheduledHistory - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.MsgGetScheduledHistory(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Infof("msg.getScheduledHistory - reply: %s", r.DebugString())
+	return r, err
+}
+
+// MsgGetScheduledMessages
+// msg.getScheduledMessages user_id:long peer_type:int peer_id:long id:Vector<int> = Vector<Message>;
+func (s *Service) MsgGetScheduledMessages(ctx context.Context, request *msg.TLMsgGetScheduledMessages) (*msg.Vector_Message, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Infof("msg.getScheduledMessages - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.MsgGetScheduledMessages(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Infof("msg.getScheduledMessages - reply: %s", r.DebugString())
+	return r, err
+}
+
+// MsgSendScheduledMessages
+// msg.sendScheduledMessages user_id:long auth_key_id:long peer_type:int peer_id:long id:Vector<int> = Updates;
+func (s *Service) MsgSendScheduledMessages(ctx context.Context, request *msg.TLMsgSendScheduledMessages) (*mtproto.Updates, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Infof("msg.sendScheduledMessages - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.MsgSendScheduledMessages(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Infof("msg.sendScheduledMessages - reply: %s", r.DebugString())
+	return r, err
+}
+
+// MsgDeleteScheduledMessages
+// msg.deleteScheduledMessages user_id:long auth_key_id:long peer_type:int peer_id:long id:Vector<int> = Updates;
+func (s *Service) MsgDeleteScheduledMessages(ctx context.Context, request *msg.TLMsgDeleteScheduledMessages) (*mtproto.Updates, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Infof("msg.deleteScheduledMessages - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.MsgDeleteScheduledMessages(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Infof("msg.deleteScheduledMessages - reply: %s", r.DebugString())
+	return r, err
+}
+
+// MsgSendReaction
+// msg.sendReaction flags:# user_id:long auth_key_id:long big:flags.1?true peer_type:int peer_id:long msg_id:int reaction:flags.0?string = Updates;
+func (s *Service) MsgSendReaction(ctx context.Context, request *msg.TLMsgSendReaction) (*mtproto.Updates, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Infof("msg.sendReaction - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.MsgSendReaction(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Infof("msg.sendReaction - reply: %s", r.DebugString())
+	return r, err
+}
+
+// MsgReadReactions
+// msg.readReactions user_id:long auth_key_id:long peer_type:int peer_id:long = messages.AffectedHistory;
+func (s *Service) MsgReadReactions(ctx context.Context, request *msg.TLMsgReadReactions) (*mtproto.Messages_AffectedHistory, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Infof("msg.readReactions - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.MsgReadReactions(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Infof("msg.readReactions - reply: %s", r.DebugString())
+	return r, err
+}
+
+// MsgGetMessageReactionsList
+// msg.getMessageReactionsList flags:# user_id:long peer_type:int peer_id:long msg_id:int reaction:flags.0?string offset:flags.1?string limit:int = messages.MessageReactionsList;
+func (s *Service) MsgGetMessageReactionsList(ctx context.Context, request *msg.TLMsgGetMessageReactionsList) (*mtproto.Messages_MessageReactionsList, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Infof("msg.getMessageReactionsList - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.MsgGetMessageReactionsList(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Infof("msg.getMessageReactionsList - reply: %s", r.DebugString())
+	return r, err
+}
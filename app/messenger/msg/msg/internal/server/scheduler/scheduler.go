@@ -0,0 +1,89 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package scheduler
+
+import (
+	"context"
+	"time"
+
+	"github.com/teamgram/teamgram-server/app/messenger/msg/msg/internal/core"
+	"github.com/teamgram/teamgram-server/app/messenger/msg/msg/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+const (
+	tickInterval = time.Second
+	batchSize    = 100
+)
+
+// Scheduler delivers the scheduled messages whose schedule_date has come.
+type Scheduler struct {
+	interval time.Duration
+	// reset puts the messages left in sending state back to waiting
+	reset func(ctx context.Context) (int64, error)
+	// deliver sends at most limit due messages and returns how many were picked up
+	deliver func(ctx context.Context, now int64, limit int32) int
+	done    chan struct{}
+}
+
+func New(svcCtx *svc.ServiceContext) *Scheduler {
+	return &Scheduler{
+		interval: tickInterval,
+		reset:    svcCtx.Dao.ScheduledMessagesDAO.ResetSending,
+		deliver: func(ctx context.Context, now int64, limit int32) int {
+			return core.New(ctx, svcCtx).DeliverScheduledMessages(now, limit)
+		},
+		done: make(chan struct{}),
+	}
+}
+
+// Start runs until Stop is called.
+func (s *Scheduler) Start() {
+	ctx := context.Background()
+
+	// messages left in sending state by a previous process are picked up again,
+	// the outbox drops the duplicates by random_id.
+	if _, err := s.reset(ctx); err != nil {
+		logx.WithContext(ctx).Errorf("scheduler - reset sending error: %v", err)
+	}
+
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.done:
+			return
+		case <-ticker.C:
+			// drain the backlog before waiting for the next tick
+			for s.deliver(ctx, time.Now().Unix(), batchSize) == batchSize {
+				select {
+				case <-s.done:
+					return
+				default:
+				}
+			}
+		}
+	}
+}
+
+func (s *Scheduler) Stop() {
+	close(s.done)
+}
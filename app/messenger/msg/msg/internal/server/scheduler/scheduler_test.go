@@ -0,0 +1,97 @@
+// Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+//  All rights reserved.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestSchedulerResetBeforeDeliver(t *testing.T) {
+	var (
+		mu        sync.Mutex
+		calls     []string
+		delivered = make(chan struct{})
+		once      sync.Once
+	)
+
+	s := &Scheduler{
+		interval: 10 * time.Millisecond,
+		reset: func(ctx context.Context) (int64, error) {
+			mu.Lock()
+			calls = append(calls, "reset")
+			mu.Unlock()
+			return 0, errors.New("reset failed")
+		},
+		deliver: func(ctx context.Context, now int64, limit int32) int {
+			mu.Lock()
+			calls = append(calls, "deliver")
+			n := len(calls)
+			mu.Unlock()
+			// the first two batches are full, the drain loop must not wait for the next tick
+			if n <= 3 {
+				return batchSize
+			}
+			once.Do(func() { close(delivered) })
+			return 0
+		},
+		done: make(chan struct{}),
+	}
+
+	go s.Start()
+	defer s.Stop()
+
+	select {
+	case <-delivered:
+	case <-time.After(time.Second):
+		t.Fatal("timeout waiting for the delivery")
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(calls) < 4 || calls[0] != "reset" {
+		t.Fatalf("want reset then deliver, got %v", calls)
+	}
+	for _, c := range calls[1:] {
+		if c != "deliver" {
+			t.Fatalf("reset must run once, got %v", calls)
+		}
+	}
+}
+
+func TestSchedulerStop(t *testing.T) {
+	stopped := make(chan struct{})
+
+	s := &Scheduler{
+		interval: 10 * time.Millisecond,
+		reset: func(ctx context.Context) (int64, error) {
+			return 0, nil
+		},
+		deliver: func(ctx context.Context, now int64, limit int32) int {
+			// a full backlog never drains, Stop must still end the loop
+			return batchSize
+		},
+		done: make(chan struct{}),
+	}
+
+	go func() {
+		s.Start()
+		close(stopped)
+	}()
+
+	time.Sleep(30 * time.Millisecond)
+	s.Stop()
+
+	select {
+	case <-stopped:
+	case <-time.After(time.Second):
+		t.Fatal("Start didn't return after Stop")
+	}
+}
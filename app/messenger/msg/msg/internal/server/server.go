@@ -14,6 +14,7 @@ import (
 
 	"github.com/teamgram/teamgram-server/app/messenger/msg/msg/internal/config"
 	"github.com/teamgram/teamgram-server/app/messenger/msg/msg/internal/server/grpc"
+	"github.com/teamgram/teamgram-server/app/messenger/msg/msg/internal/server/scheduler"
 	"github.com/teamgram/teamgram-server/app/messenger/msg/msg/internal/svc"
 
 	"github.com/zeromicro/go-zero/core/conf"
@@ -24,7 +25,8 @@ import (
 var configFile = flag.String("f", "etc/msg.yaml", "the config file")
 
 type Server struct {
-	grpcSrv *zrpc.RpcServer
+	grpcSrv   *zrpc.RpcServer
+	scheduler *scheduler.Scheduler
 }
 
 func New() *Server {
@@ -38,10 +40,14 @@ func (s *Server) Initialize() error {
 	logx.Infov(c)
 	ctx := svc.NewServiceContext(c, nil)
 	s.grpcSrv = grpc.New(ctx, c.RpcServerConf)
+	s.scheduler = scheduler.New(ctx)
 
 	go func() {
 		go s.grpcSrv.Start()
 	}()
+	go func() {
+		s.scheduler.Start()
+	}()
 	return nil
 }
 
@@ -49,5 +55,6 @@ func (s *Server) RunLoop() {
 }
 
 func (s *Server) Destroy() {
+	s.scheduler.Stop()
 	s.grpcSrv.Stop()
 }
@@ -27,9 +27,12 @@ import (
 	"github.com/teamgram/teamgram-server/app/messenger/msg/msg/internal/config"
 	"github.com/teamgram/teamgram-server/app/messenger/msg/msg/plugin"
 	sync_client "github.com/teamgram/teamgram-server/app/messenger/sync/client"
+	channel_client "github.com/teamgram/teamgram-server/app/service/biz/channel/client"
 	chat_client "github.com/teamgram/teamgram-server/app/service/biz/chat/client"
 	dialog_client "github.com/teamgram/teamgram-server/app/service/biz/dialog/client"
+	message_client "github.com/teamgram/teamgram-server/app/service/biz/message/client"
 	user_client "github.com/teamgram/teamgram-server/app/service/biz/user/client"
+	username_client "github.com/teamgram/teamgram-server/app/service/biz/username/client"
 	idgen_client "github.com/teamgram/teamgram-server/app/service/idgen/client"
 	"github.com/zeromicro/go-zero/core/stores/kv"
 )
@@ -41,18 +44,28 @@ type ServiceContext struct {
 
 func NewServiceContext(c config.Config, plugin plugin.MsgPlugin) *ServiceContext {
 	db := sqlx.NewMySQL(&c.Mysql)
-	return &ServiceContext{
+	svcCtx := &ServiceContext{
 		Config: c,
 		Dao: &dao.Dao{
-			Mysql:        dao.NewMysqlDao(db),
-			KV:           kv.NewStore(c.KV),
-			IDGenClient2: idgen_client.NewIDGenClient2(rpcx.GetCachedRpcClient(c.IdgenClient)),
-			UserClient:   user_client.NewUserClient(rpcx.GetCachedRpcClient(c.UserClient)),
-			InboxClient:  inbox_client.NewInboxMqClient(kafka.MustKafkaProducer(c.InboxClient)),
-			ChatClient:   chat_client.NewChatClient(rpcx.GetCachedRpcClient(c.ChatClient)),
-			SyncClient:   sync_client.NewSyncMqClient(kafka.GetCachedMQClient(c.SyncClient)),
-			DialogClient: dialog_client.NewDialogClient(rpcx.GetCachedRpcClient(c.DialogClient)),
-			MsgPlugin:    plugin,
+			Mysql:          dao.NewMysqlDao(db),
+			KV:             kv.NewStore(c.KV),
+			IDGenClient2:   idgen_client.NewIDGenClient2(rpcx.GetCachedRpcClient(c.IdgenClient)),
+			UserClient:     user_client.NewUserClient(rpcx.GetCachedRpcClient(c.UserClient)),
+			InboxClient:    inbox_client.NewInboxMqClient(kafka.MustKafkaProducer(c.InboxClient)),
+			ChatClient:     chat_client.NewChatClient(rpcx.GetCachedRpcClient(c.ChatClient)),
+			ChannelClient:  channel_client.NewChannelClient(rpcx.GetCachedRpcClient(c.ChannelClient)),
+			SyncClient:     sync_client.NewSyncMqClient(kafka.GetCachedMQClient(c.SyncClient)),
+			DialogClient:   dialog_client.NewDialogClient(rpcx.GetCachedRpcClient(c.DialogClient)),
+			MsgPlugin:      plugin,
+			UsernameClient: username_client.NewUsernameClient(rpcx.GetCachedRpcClient(c.UsernameClient)),
 		},
 	}
+	if plugin == nil {
+		svcCtx.Dao.MsgPlugin = dao.NewMsgPlugin(svcCtx.Dao.Mysql)
+	}
+	if c.SearchClient != nil {
+		svcCtx.Dao.SearchClient = message_client.NewSearchIndexMqClient(kafka.GetCachedMQClient(c.SearchClient))
+	}
+
+	return svcCtx
 }
@@ -7,22 +7,29 @@
 package msg
 
 const (
-	Predicate_sender                     = "sender"
-	Predicate_outboxMessage              = "outboxMessage"
-	Predicate_contentMessage             = "contentMessage"
-	Predicate_msg_sendMessage            = "msg_sendMessage"
-	Predicate_msg_sendMultiMessage       = "msg_sendMultiMessage"
-	Predicate_msg_pushUserMessage        = "msg_pushUserMessage"
-	Predicate_msg_readMessageContents    = "msg_readMessageContents"
-	Predicate_msg_sendMessageV2          = "msg_sendMessageV2"
-	Predicate_msg_editMessage            = "msg_editMessage"
-	Predicate_msg_deleteMessages         = "msg_deleteMessages"
-	Predicate_msg_deleteHistory          = "msg_deleteHistory"
-	Predicate_msg_deletePhoneCallHistory = "msg_deletePhoneCallHistory"
-	Predicate_msg_deleteChatHistory      = "msg_deleteChatHistory"
-	Predicate_msg_readHistory            = "msg_readHistory"
-	Predicate_msg_updatePinnedMessage    = "msg_updatePinnedMessage"
-	Predicate_msg_unpinAllMessages       = "msg_unpinAllMessages"
+	Predicate_sender                      = "sender"
+	Predicate_outboxMessage               = "outboxMessage"
+	Predicate_contentMessage              = "contentMessage"
+	Predicate_msg_sendMessage             = "msg_sendMessage"
+	Predicate_msg_sendMultiMessage        = "msg_sendMultiMessage"
+	Predicate_msg_pushUserMessage         = "msg_pushUserMessage"
+	Predicate_msg_readMessageContents     = "msg_readMessageContents"
+	Predicate_msg_sendMessageV2           = "msg_sendMessageV2"
+	Predicate_msg_editMessage             = "msg_editMessage"
+	Predicate_msg_deleteMessages          = "msg_deleteMessages"
+	Predicate_msg_deleteHistory           = "msg_deleteHistory"
+	Predicate_msg_deletePhoneCallHistory  = "msg_deletePhoneCallHistory"
+	Predicate_msg_deleteChatHistory       = "msg_deleteChatHistory"
+	Predicate_msg_readHistory             = "msg_readHistory"
+	Predicate_msg_updatePinnedMessage     = "msg_updatePinnedMessage"
+	Predicate_msg_unpinAllMessages        = "msg_unpinAllMessages"
+	Predicate_msg_getScheduledHistory     = "msg_getScheduledHistory"
+	Predicate_msg_getScheduledMessages    = "msg_getScheduledMessages"
+	Predicate_msg_sendScheduledMessages   = "msg_sendScheduledMessages"
+	Predicate_msg_deleteScheduledMessages = "msg_deleteScheduledMessages"
+	Predicate_msg_sendReaction            = "msg_sendReaction"
+	Predicate_msg_readReactions           = "msg_readReactions"
+	Predicate_msg_getMessageReactionsList = "msg_getMessageReactionsList"
 )
 
 var clazzNameRegisters2 = map[string]map[int]int32{
@@ -90,25 +97,60 @@ var clazzNameRegisters2 = map[string]map[int]int32{
 		0: -1199153371, // 0xb8865f25
 
 	},
+	Predicate_msg_getScheduledHistory: {
+		0: 303888711, // 0x121cf947
+
+	},
+	Predicate_msg_getScheduledMessages: {
+		0: -512590866, // 0xe1727bee
+
+	},
+	Predicate_msg_sendScheduledMessages: {
+		0: -754764820, // 0xd30333ec
+
+	},
+	Predicate_msg_deleteScheduledMessages: {
+		0: -698200857, // 0xd6624ce7
+
+	},
+	Predicate_msg_sendReaction: {
+		0: 1932789699, // 0x733407c3
+
+	},
+	Predicate_msg_readReactions: {
+		0: -91564461, // 0xfa8ad653
+
+	},
+	Predicate_msg_getMessageReactionsList: {
+		0: -2018254215, // 0x87b3e279
+
+	},
 }
 
 var clazzIdNameRegisters2 = map[int32]string{
-	1513645242:  Predicate_sender,                     // 0x5a3864ba
-	1402283185:  Predicate_outboxMessage,              // 0x539524b1
-	295822890:   Predicate_contentMessage,             // 0x11a1e62a
-	1218652155:  Predicate_msg_sendMessage,            // 0x48a327fb
-	-1727589428: Predicate_msg_sendMultiMessage,       // 0x990713cc
-	902887962:   Predicate_msg_pushUserMessage,        // 0x35d0fa1a
-	673481940:   Predicate_msg_readMessageContents,    // 0x282484d4
-	770211174:   Predicate_msg_sendMessageV2,          // 0x2de87d66
-	-1770495214: Predicate_msg_editMessage,            // 0x96786312
-	568855069:   Predicate_msg_deleteMessages,         // 0x21e80a1d
-	1975576778:  Predicate_msg_deleteHistory,          // 0x75c0e8ca
-	649568574:   Predicate_msg_deletePhoneCallHistory, // 0x26b7a13e
-	-283155749:  Predicate_msg_deleteChatHistory,      // 0xef1f62db
-	1510960658:  Predicate_msg_readHistory,            // 0x5a0f6e12
-	-441560663:  Predicate_msg_updatePinnedMessage,    // 0xe5ae51a9
-	-1199153371: Predicate_msg_unpinAllMessages,       // 0xb8865f25
+	1513645242:  Predicate_sender,                      // 0x5a3864ba
+	1402283185:  Predicate_outboxMessage,               // 0x539524b1
+	295822890:   Predicate_contentMessage,              // 0x11a1e62a
+	1218652155:  Predicate_msg_sendMessage,             // 0x48a327fb
+	-1727589428: Predicate_msg_sendMultiMessage,        // 0x990713cc
+	902887962:   Predicate_msg_pushUserMessage,         // 0x35d0fa1a
+	673481940:   Predicate_msg_readMessageContents,     // 0x282484d4
+	770211174:   Predicate_msg_sendMessageV2,           // 0x2de87d66
+	-1770495214: Predicate_msg_editMessage,             // 0x96786312
+	568855069:   Predicate_msg_deleteMessages,          // 0x21e80a1d
+	1975576778:  Predicate_msg_deleteHistory,           // 0x75c0e8ca
+	649568574:   Predicate_msg_deletePhoneCallHistory,  // 0x26b7a13e
+	-283155749:  Predicate_msg_deleteChatHistory,       // 0xef1f62db
+	1510960658:  Predicate_msg_readHistory,             // 0x5a0f6e12
+	-441560663:  Predicate_msg_updatePinnedMessage,     // 0xe5ae51a9
+	-1199153371: Predicate_msg_unpinAllMessages,        // 0xb8865f25
+	303888711:   Predicate_msg_getScheduledHistory,     // 0x121cf947
+	-512590866:  Predicate_msg_getScheduledMessages,    // 0xe1727bee
+	-754764820:  Predicate_msg_sendScheduledMessages,   // 0xd30333ec
+	-698200857:  Predicate_msg_deleteScheduledMessages, // 0xd6624ce7
+	1932789699:  Predicate_msg_sendReaction,            // 0x733407c3
+	-91564461:   Predicate_msg_readReactions,           // 0xfa8ad653
+	-2018254215: Predicate_msg_getMessageReactionsList, // 0x87b3e279
 
 }
 
@@ -30,6 +30,11 @@ var _ fmt.GoStringer
 
 var clazzIdRegisters2 = map[int32]func() mtproto.TLObject{
 	// Constructor
+	1513645242: func() mtproto.TLObject { // 0x5a3864ba
+		o := MakeTLSender(nil)
+		o.Data2.Constructor = 1513645242
+		return o
+	},
 	1402283185: func() mtproto.TLObject { // 0x539524b1
 		o := MakeTLOutboxMessage(nil)
 		o.Data2.Constructor = 1402283185
@@ -40,11 +45,6 @@ var clazzIdRegisters2 = map[int32]func() mtproto.TLObject{
 		o.Data2.Constructor = 295822890
 		return o
 	},
-	1513645242: func() mtproto.TLObject { // 0x5a3864ba
-		o := MakeTLSender(nil)
-		o.Data2.Constructor = 1513645242
-		return o
-	},
 
 	// Method
 	1218652155: func() mtproto.TLObject { // 0x48a327fb
@@ -112,6 +112,41 @@ var clazzIdRegisters2 = map[int32]func() mtproto.TLObject{
 			Constructor: -1199153371,
 		}
 	},
+	303888711: func() mtproto.TLObject { // 0x121cf947
+		return &TLMsgGetScheduledHistory{
+			Constructor: 303888711,
+		}
+	},
+	-512590866: func() mtproto.TLObject { // 0xe1727bee
+		return &TLMsgGetScheduledMessages{
+			Constructor: -512590866,
+		}
+	},
+	-754764820: func() mtproto.TLObject { // 0xd30333ec
+		return &TLMsgSendScheduledMessages{
+			Constructor: -754764820,
+		}
+	},
+	-698200857: func() mtproto.TLObject { // 0xd6624ce7
+		return &TLMsgDeleteScheduledMessages{
+			Constructor: -698200857,
+		}
+	},
+	1932789699: func() mtproto.TLObject { // 0x733407c3
+		return &TLMsgSendReaction{
+			Constructor: 1932789699,
+		}
+	},
+	-91564461: func() mtproto.TLObject { // 0xfa8ad653
+		return &TLMsgReadReactions{
+			Constructor: -91564461,
+		}
+	},
+	-2018254215: func() mtproto.TLObject { // 0x87b3e279
+		return &TLMsgGetMessageReactionsList{
+			Constructor: -2018254215,
+		}
+	},
 }
 
 func NewTLObjectByClassID(classId int32) mtproto.TLObject {
@@ -129,6 +164,159 @@ func CheckClassID(classId int32) (ok bool) {
 
 //----------------------------------------------------------------------------------------------------------------
 
+///////////////////////////////////////////////////////////////////////////////
+// Sender <--
+//  + TL_Sender
+//
+
+func (m *Sender) Encode(layer int32) []byte {
+	predicateName := m.PredicateName
+	if predicateName == "" {
+		if n, ok := clazzIdNameRegisters2[int32(m.Constructor)]; ok {
+			predicateName = n
+		}
+	}
+
+	var (
+		xBuf []byte
+	)
+
+	switch predicateName {
+	case Predicate_sender:
+		t := m.To_Sender()
+		xBuf = t.Encode(layer)
+
+	default:
+		// logx.Errorf("invalid predicate error: %s",  m.PredicateName)
+		return []byte{}
+	}
+
+	return xBuf
+}
+
+func (m *Sender) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *Sender) Decode(dBuf *mtproto.DecodeBuf) error {
+	m.Constructor = TLConstructor(dBuf.Int())
+	switch uint32(m.Constructor) {
+	case 0x5a3864ba:
+		m2 := MakeTLSender(m)
+		m2.Decode(dBuf)
+
+	default:
+		return fmt.Errorf("invalid constructorId: 0x%x", uint32(m.Constructor))
+	}
+	return dBuf.GetError()
+}
+
+func (m *Sender) DebugString() string {
+	switch m.PredicateName {
+	case Predicate_sender:
+		t := m.To_Sender()
+		return t.DebugString()
+
+	default:
+		return "{}"
+	}
+}
+
+// To_Sender
+// sender user_id:long type:int auth_key_id:long = Sender;
+func (m *Sender) To_Sender() *TLSender {
+	m.PredicateName = Predicate_sender
+	return &TLSender{
+		Data2: m,
+	}
+}
+
+// MakeTLSender
+// sender user_id:long type:int auth_key_id:long = Sender;
+func MakeTLSender(data2 *Sender) *TLSender {
+	if data2 == nil {
+		return &TLSender{Data2: &Sender{
+			PredicateName: Predicate_sender,
+		}}
+	} else {
+		data2.PredicateName = Predicate_sender
+		return &TLSender{Data2: data2}
+	}
+}
+
+func (m *TLSender) To_Sender() *Sender {
+	m.Data2.PredicateName = Predicate_sender
+	return m.Data2
+}
+
+func (m *TLSender) SetUserId(v int64) { m.Data2.UserId = v }
+func (m *TLSender) GetUserId() int64  { return m.Data2.UserId }
+
+func (m *TLSender) SetType(v int32) { m.Data2.Type = v }
+func (m *TLSender) GetType() int32  { return m.Data2.Type }
+
+func (m *TLSender) SetAuthKeyId(v int64) { m.Data2.AuthKeyId = v }
+func (m *TLSender) GetAuthKeyId() int64  { return m.Data2.AuthKeyId }
+
+func (m *TLSender) GetPredicateName() string {
+	return Predicate_sender
+}
+
+func (m *TLSender) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+
+	var encodeF = map[uint32]func() []byte{
+		0x5a3864ba: func() []byte {
+			// sender user_id:long type:int auth_key_id:long = Sender;
+			x.UInt(0x5a3864ba)
+
+			x.Long(m.GetUserId())
+			x.Int(m.GetType())
+			x.Long(m.GetAuthKeyId())
+			return x.GetBuf()
+		},
+	}
+
+	clazzId := GetClazzID(Predicate_sender, int(layer))
+	if f, ok := encodeF[uint32(clazzId)]; ok {
+		return f()
+	} else {
+		// TODO(@benqi): handle error
+		// log.Errorf("not found clazzId by (%s, %d)", Predicate_sender, layer)
+		return x.GetBuf()
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLSender) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLSender) Decode(dBuf *mtproto.DecodeBuf) error {
+	var decodeF = map[uint32]func() error{
+		0x5a3864ba: func() error {
+			// sender user_id:long type:int auth_key_id:long = Sender;
+			m.SetUserId(dBuf.Long())
+			m.SetType(dBuf.Int())
+			m.SetAuthKeyId(dBuf.Long())
+			return dBuf.GetError()
+		},
+	}
+
+	if f, ok := decodeF[uint32(m.Data2.Constructor)]; ok {
+		return f()
+	} else {
+		return fmt.Errorf("invalid constructor: %x", uint32(m.Data2.Constructor))
+	}
+}
+
+func (m *TLSender) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
 ///////////////////////////////////////////////////////////////////////////////
 // OutboxMessage <--
 //  + TL_OutboxMessage
@@ -188,6 +376,7 @@ func (m *OutboxMessage) DebugString() string {
 }
 
 // To_OutboxMessage
+// outboxMessage flags:# no_webpage:flags.0?true background:flags.1?true random_id:long message:Message schedule_date:flags.2?int = OutboxMessage;
 func (m *OutboxMessage) To_OutboxMessage() *TLOutboxMessage {
 	m.PredicateName = Predicate_outboxMessage
 	return &TLOutboxMessage{
@@ -196,6 +385,7 @@ func (m *OutboxMessage) To_OutboxMessage() *TLOutboxMessage {
 }
 
 // MakeTLOutboxMessage
+// outboxMessage flags:# no_webpage:flags.0?true background:flags.1?true random_id:long message:Message schedule_date:flags.2?int = OutboxMessage;
 func MakeTLOutboxMessage(data2 *OutboxMessage) *TLOutboxMessage {
 	if data2 == nil {
 		return &TLOutboxMessage{Data2: &OutboxMessage{
@@ -237,6 +427,7 @@ func (m *TLOutboxMessage) Encode(layer int32) []byte {
 
 	var encodeF = map[uint32]func() []byte{
 		0x539524b1: func() []byte {
+			// outboxMessage flags:# no_webpage:flags.0?true background:flags.1?true random_id:long message:Message schedule_date:flags.2?int = OutboxMessage;
 			x.UInt(0x539524b1)
 
 			// set flags
@@ -249,7 +440,6 @@ func (m *TLOutboxMessage) Encode(layer int32) []byte {
 				if m.GetBackground() == true {
 					flags |= 1 << 1
 				}
-
 				if m.GetScheduleDate() != nil {
 					flags |= 1 << 2
 				}
@@ -289,6 +479,7 @@ func (m *TLOutboxMessage) CalcByteSize(layer int32) int {
 func (m *TLOutboxMessage) Decode(dBuf *mtproto.DecodeBuf) error {
 	var decodeF = map[uint32]func() error{
 		0x539524b1: func() error {
+			// outboxMessage flags:# no_webpage:flags.0?true background:flags.1?true random_id:long message:Message schedule_date:flags.2?int = OutboxMessage;
 			var flags = dBuf.UInt()
 			_ = flags
 			if (flags & (1 << 0)) != 0 {
@@ -383,6 +574,7 @@ func (m *ContentMessage) DebugString() string {
 }
 
 // To_ContentMessage
+// contentMessage flags:# id:int mentioned:flags.0?true media_unread:flags.1?true reaction:flags.2?true = ContentMessage;
 func (m *ContentMessage) To_ContentMessage() *TLContentMessage {
 	m.PredicateName = Predicate_contentMessage
 	return &TLContentMessage{
@@ -391,6 +583,7 @@ func (m *ContentMessage) To_ContentMessage() *TLContentMessage {
 }
 
 // MakeTLContentMessage
+// contentMessage flags:# id:int mentioned:flags.0?true media_unread:flags.1?true reaction:flags.2?true = ContentMessage;
 func MakeTLContentMessage(data2 *ContentMessage) *TLContentMessage {
 	if data2 == nil {
 		return &TLContentMessage{Data2: &ContentMessage{
@@ -429,6 +622,7 @@ func (m *TLContentMessage) Encode(layer int32) []byte {
 
 	var encodeF = map[uint32]func() []byte{
 		0x11a1e62a: func() []byte {
+			// contentMessage flags:# id:int mentioned:flags.0?true media_unread:flags.1?true reaction:flags.2?true = ContentMessage;
 			x.UInt(0x11a1e62a)
 
 			// set flags
@@ -475,6 +669,7 @@ func (m *TLContentMessage) CalcByteSize(layer int32) int {
 func (m *TLContentMessage) Decode(dBuf *mtproto.DecodeBuf) error {
 	var decodeF = map[uint32]func() error{
 		0x11a1e62a: func() error {
+			// contentMessage flags:# id:int mentioned:flags.0?true media_unread:flags.1?true reaction:flags.2?true = ContentMessage;
 			var flags = dBuf.UInt()
 			_ = flags
 			m.SetId(dBuf.Int())
@@ -504,213 +699,66 @@ func (m *TLContentMessage) DebugString() string {
 	return dbgString
 }
 
+//----------------------------------------------------------------------------------------------------------------
+// TLMsgSendMessage
 ///////////////////////////////////////////////////////////////////////////////
-// Sender <--
-//  + TL_Sender
-//
 
-func (m *Sender) Encode(layer int32) []byte {
-	predicateName := m.PredicateName
-	if predicateName == "" {
-		if n, ok := clazzIdNameRegisters2[int32(m.Constructor)]; ok {
-			predicateName = n
-		}
-	}
+func (m *TLMsgSendMessage) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_msg_sendMessage))
 
-	var (
-		xBuf []byte
-	)
+	switch uint32(m.Constructor) {
+	case 0x48a327fb:
+		// msg.sendMessage user_id:long auth_key_id:long peer_type:int peer_id:long message:OutboxMessage = Updates;
+		x.UInt(0x48a327fb)
 
-	switch predicateName {
-	case Predicate_sender:
-		t := m.To_Sender()
-		xBuf = t.Encode(layer)
+		// no flags
+
+		x.Long(m.GetUserId())
+		x.Long(m.GetAuthKeyId())
+		x.Int(m.GetPeerType())
+		x.Long(m.GetPeerId())
+		x.Bytes(m.GetMessage().Encode(layer))
 
 	default:
-		// logx.Errorf("invalid predicate error: %s",  m.PredicateName)
-		return []byte{}
+		// log.Errorf("")
 	}
 
-	return xBuf
+	return x.GetBuf()
 }
 
-func (m *Sender) CalcByteSize(layer int32) int {
+func (m *TLMsgSendMessage) CalcByteSize(layer int32) int {
 	return 0
 }
 
-func (m *Sender) Decode(dBuf *mtproto.DecodeBuf) error {
-	m.Constructor = TLConstructor(dBuf.Int())
+func (m *TLMsgSendMessage) Decode(dBuf *mtproto.DecodeBuf) error {
 	switch uint32(m.Constructor) {
-	case 0x5a3864ba:
-		m2 := MakeTLSender(m)
-		m2.Decode(dBuf)
+	case 0x48a327fb:
+		// msg.sendMessage user_id:long auth_key_id:long peer_type:int peer_id:long message:OutboxMessage = Updates;
 
-	default:
-		return fmt.Errorf("invalid constructorId: 0x%x", uint32(m.Constructor))
-	}
-	return dBuf.GetError()
-}
+		// not has flags
 
-func (m *Sender) DebugString() string {
-	switch m.PredicateName {
-	case Predicate_sender:
-		t := m.To_Sender()
-		return t.DebugString()
+		m.UserId = dBuf.Long()
+		m.AuthKeyId = dBuf.Long()
+		m.PeerType = dBuf.Int()
+		m.PeerId = dBuf.Long()
+
+		m5 := &OutboxMessage{}
+		m5.Decode(dBuf)
+		m.Message = m5
+
+		return dBuf.GetError()
 
 	default:
-		return "{}"
+		// log.Errorf("")
 	}
+	return dBuf.GetError()
 }
 
-// To_Sender
-func (m *Sender) To_Sender() *TLSender {
-	m.PredicateName = Predicate_sender
-	return &TLSender{
-		Data2: m,
-	}
-}
-
-// MakeTLSender
-func MakeTLSender(data2 *Sender) *TLSender {
-	if data2 == nil {
-		return &TLSender{Data2: &Sender{
-			PredicateName: Predicate_sender,
-		}}
-	} else {
-		data2.PredicateName = Predicate_sender
-		return &TLSender{Data2: data2}
-	}
-}
-
-func (m *TLSender) To_Sender() *Sender {
-	m.Data2.PredicateName = Predicate_sender
-	return m.Data2
-}
-
-func (m *TLSender) SetUserId(v int64) { m.Data2.UserId = v }
-func (m *TLSender) GetUserId() int64  { return m.Data2.UserId }
-
-func (m *TLSender) SetType(v int32) { m.Data2.Type = v }
-func (m *TLSender) GetType() int32  { return m.Data2.Type }
-
-func (m *TLSender) SetAuthKeyId(v int64) { m.Data2.AuthKeyId = v }
-func (m *TLSender) GetAuthKeyId() int64  { return m.Data2.AuthKeyId }
-
-func (m *TLSender) GetPredicateName() string {
-	return Predicate_sender
-}
-
-func (m *TLSender) Encode(layer int32) []byte {
-	x := mtproto.NewEncodeBuf(512)
-
-	var encodeF = map[uint32]func() []byte{
-		0x5a3864ba: func() []byte {
-			x.UInt(0x5a3864ba)
-
-			x.Long(m.GetUserId())
-			x.Int(m.GetType())
-			x.Long(m.GetAuthKeyId())
-			return x.GetBuf()
-		},
-	}
-
-	clazzId := GetClazzID(Predicate_sender, int(layer))
-	if f, ok := encodeF[uint32(clazzId)]; ok {
-		return f()
-	} else {
-		// TODO(@benqi): handle error
-		// log.Errorf("not found clazzId by (%s, %d)", Predicate_sender, layer)
-		return x.GetBuf()
-	}
-
-	return x.GetBuf()
-}
-
-func (m *TLSender) CalcByteSize(layer int32) int {
-	return 0
-}
-
-func (m *TLSender) Decode(dBuf *mtproto.DecodeBuf) error {
-	var decodeF = map[uint32]func() error{
-		0x5a3864ba: func() error {
-			m.SetUserId(dBuf.Long())
-			m.SetType(dBuf.Int())
-			m.SetAuthKeyId(dBuf.Long())
-			return dBuf.GetError()
-		},
-	}
-
-	if f, ok := decodeF[uint32(m.Data2.Constructor)]; ok {
-		return f()
-	} else {
-		return fmt.Errorf("invalid constructor: %x", uint32(m.Data2.Constructor))
-	}
-}
-
-func (m *TLSender) DebugString() string {
-	jsonm := &jsonpb.Marshaler{OrigName: true}
-	dbgString, _ := jsonm.MarshalToString(m)
-	return dbgString
-}
-
-//----------------------------------------------------------------------------------------------------------------
-// TLMsgSendMessage
-///////////////////////////////////////////////////////////////////////////////
-
-func (m *TLMsgSendMessage) Encode(layer int32) []byte {
-	x := mtproto.NewEncodeBuf(512)
-	// x.Int(int32(CRC32_msg_sendMessage))
-
-	switch uint32(m.Constructor) {
-	case 0x48a327fb:
-		x.UInt(0x48a327fb)
-
-		// no flags
-
-		x.Long(m.GetUserId())
-		x.Long(m.GetAuthKeyId())
-		x.Int(m.GetPeerType())
-		x.Long(m.GetPeerId())
-		x.Bytes(m.GetMessage().Encode(layer))
-
-	default:
-		// log.Errorf("")
-	}
-
-	return x.GetBuf()
-}
-
-func (m *TLMsgSendMessage) CalcByteSize(layer int32) int {
-	return 0
-}
-
-func (m *TLMsgSendMessage) Decode(dBuf *mtproto.DecodeBuf) error {
-	switch uint32(m.Constructor) {
-	case 0x48a327fb:
-
-		// not has flags
-
-		m.UserId = dBuf.Long()
-		m.AuthKeyId = dBuf.Long()
-		m.PeerType = dBuf.Int()
-		m.PeerId = dBuf.Long()
-
-		m5 := &OutboxMessage{}
-		m5.Decode(dBuf)
-		m.Message = m5
-
-		return dBuf.GetError()
-
-	default:
-		// log.Errorf("")
-	}
-	return dBuf.GetError()
-}
-
-func (m *TLMsgSendMessage) DebugString() string {
-	jsonm := &jsonpb.Marshaler{OrigName: true}
-	dbgString, _ := jsonm.MarshalToString(m)
-	return dbgString
+func (m *TLMsgSendMessage) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
 }
 
 // TLMsgSendMultiMessage
@@ -722,6 +770,7 @@ func (m *TLMsgSendMultiMessage) Encode(layer int32) []byte {
 
 	switch uint32(m.Constructor) {
 	case 0x990713cc:
+		// msg.sendMultiMessage user_id:long auth_key_id:long peer_type:int peer_id:long message:Vector<OutboxMessage> = Updates;
 		x.UInt(0x990713cc)
 
 		// no flags
@@ -751,6 +800,7 @@ func (m *TLMsgSendMultiMessage) CalcByteSize(layer int32) int {
 func (m *TLMsgSendMultiMessage) Decode(dBuf *mtproto.DecodeBuf) error {
 	switch uint32(m.Constructor) {
 	case 0x990713cc:
+		// msg.sendMultiMessage user_id:long auth_key_id:long peer_type:int peer_id:long message:Vector<OutboxMessage> = Updates;
 
 		// not has flags
 
@@ -758,6 +808,7 @@ func (m *TLMsgSendMultiMessage) Decode(dBuf *mtproto.DecodeBuf) error {
 		m.AuthKeyId = dBuf.Long()
 		m.PeerType = dBuf.Int()
 		m.PeerId = dBuf.Long()
+
 		c5 := dBuf.Int()
 		if c5 != int32(mtproto.CRC32_vector) {
 			// dBuf.err = fmt.Errorf("invalid mtproto.CRC32_vector, c%d: %d", 5, c5)
@@ -794,6 +845,7 @@ func (m *TLMsgPushUserMessage) Encode(layer int32) []byte {
 
 	switch uint32(m.Constructor) {
 	case 0x35d0fa1a:
+		// msg.pushUserMessage user_id:long auth_key_id:long peer_type:int peer_id:long push_type:int message:OutboxMessage = Bool;
 		x.UInt(0x35d0fa1a)
 
 		// no flags
@@ -819,6 +871,7 @@ func (m *TLMsgPushUserMessage) CalcByteSize(layer int32) int {
 func (m *TLMsgPushUserMessage) Decode(dBuf *mtproto.DecodeBuf) error {
 	switch uint32(m.Constructor) {
 	case 0x35d0fa1a:
+		// msg.pushUserMessage user_id:long auth_key_id:long peer_type:int peer_id:long push_type:int message:OutboxMessage = Bool;
 
 		// not has flags
 
@@ -855,6 +908,7 @@ func (m *TLMsgReadMessageContents) Encode(layer int32) []byte {
 
 	switch uint32(m.Constructor) {
 	case 0x282484d4:
+		// msg.readMessageContents user_id:long auth_key_id:long peer_type:int peer_id:long id:Vector<ContentMessage> = messages.AffectedMessages;
 		x.UInt(0x282484d4)
 
 		// no flags
@@ -884,6 +938,7 @@ func (m *TLMsgReadMessageContents) CalcByteSize(layer int32) int {
 func (m *TLMsgReadMessageContents) Decode(dBuf *mtproto.DecodeBuf) error {
 	switch uint32(m.Constructor) {
 	case 0x282484d4:
+		// msg.readMessageContents user_id:long auth_key_id:long peer_type:int peer_id:long id:Vector<ContentMessage> = messages.AffectedMessages;
 
 		// not has flags
 
@@ -891,6 +946,7 @@ func (m *TLMsgReadMessageContents) Decode(dBuf *mtproto.DecodeBuf) error {
 		m.AuthKeyId = dBuf.Long()
 		m.PeerType = dBuf.Int()
 		m.PeerId = dBuf.Long()
+
 		c5 := dBuf.Int()
 		if c5 != int32(mtproto.CRC32_vector) {
 			// dBuf.err = fmt.Errorf("invalid mtproto.CRC32_vector, c%d: %d", 5, c5)
@@ -927,6 +983,7 @@ func (m *TLMsgSendMessageV2) Encode(layer int32) []byte {
 
 	switch uint32(m.Constructor) {
 	case 0x2de87d66:
+		// msg.sendMessageV2 user_id:long auth_key_id:long peer_type:int peer_id:long message:Vector<OutboxMessage> = UpdateList;
 		x.UInt(0x2de87d66)
 
 		// no flags
@@ -956,6 +1013,7 @@ func (m *TLMsgSendMessageV2) CalcByteSize(layer int32) int {
 func (m *TLMsgSendMessageV2) Decode(dBuf *mtproto.DecodeBuf) error {
 	switch uint32(m.Constructor) {
 	case 0x2de87d66:
+		// msg.sendMessageV2 user_id:long auth_key_id:long peer_type:int peer_id:long message:Vector<OutboxMessage> = UpdateList;
 
 		// not has flags
 
@@ -963,6 +1021,7 @@ func (m *TLMsgSendMessageV2) Decode(dBuf *mtproto.DecodeBuf) error {
 		m.AuthKeyId = dBuf.Long()
 		m.PeerType = dBuf.Int()
 		m.PeerId = dBuf.Long()
+
 		c5 := dBuf.Int()
 		if c5 != int32(mtproto.CRC32_vector) {
 			// dBuf.err = fmt.Errorf("invalid mtproto.CRC32_vector, c%d: %d", 5, c5)
@@ -999,6 +1058,7 @@ func (m *TLMsgEditMessage) Encode(layer int32) []byte {
 
 	switch uint32(m.Constructor) {
 	case 0x96786312:
+		// msg.editMessage user_id:long auth_key_id:long peer_type:int peer_id:long message:OutboxMessage = Updates;
 		x.UInt(0x96786312)
 
 		// no flags
@@ -1023,6 +1083,7 @@ func (m *TLMsgEditMessage) CalcByteSize(layer int32) int {
 func (m *TLMsgEditMessage) Decode(dBuf *mtproto.DecodeBuf) error {
 	switch uint32(m.Constructor) {
 	case 0x96786312:
+		// msg.editMessage user_id:long auth_key_id:long peer_type:int peer_id:long message:OutboxMessage = Updates;
 
 		// not has flags
 
@@ -1058,6 +1119,7 @@ func (m *TLMsgDeleteMessages) Encode(layer int32) []byte {
 
 	switch uint32(m.Constructor) {
 	case 0x21e80a1d:
+		// msg.deleteMessages flags:# user_id:long auth_key_id:long peer_type:int peer_id:long revoke:flags.1?true id:Vector<int> = messages.AffectedMessages;
 		x.UInt(0x21e80a1d)
 
 		// set flags
@@ -1091,6 +1153,7 @@ func (m *TLMsgDeleteMessages) CalcByteSize(layer int32) int {
 func (m *TLMsgDeleteMessages) Decode(dBuf *mtproto.DecodeBuf) error {
 	switch uint32(m.Constructor) {
 	case 0x21e80a1d:
+		// msg.deleteMessages flags:# user_id:long auth_key_id:long peer_type:int peer_id:long revoke:flags.1?true id:Vector<int> = messages.AffectedMessages;
 
 		flags := dBuf.UInt()
 		_ = flags
@@ -1129,6 +1192,7 @@ func (m *TLMsgDeleteHistory) Encode(layer int32) []byte {
 
 	switch uint32(m.Constructor) {
 	case 0x75c0e8ca:
+		// msg.deleteHistory flags:# user_id:long auth_key_id:long peer_type:int peer_id:long just_clear:flags.0?true revoke:flags.1?true max_id:int = messages.AffectedHistory;
 		x.UInt(0x75c0e8ca)
 
 		// set flags
@@ -1164,6 +1228,7 @@ func (m *TLMsgDeleteHistory) CalcByteSize(layer int32) int {
 func (m *TLMsgDeleteHistory) Decode(dBuf *mtproto.DecodeBuf) error {
 	switch uint32(m.Constructor) {
 	case 0x75c0e8ca:
+		// msg.deleteHistory flags:# user_id:long auth_key_id:long peer_type:int peer_id:long just_clear:flags.0?true revoke:flags.1?true max_id:int = messages.AffectedHistory;
 
 		flags := dBuf.UInt()
 		_ = flags
@@ -1203,6 +1268,7 @@ func (m *TLMsgDeletePhoneCallHistory) Encode(layer int32) []byte {
 
 	switch uint32(m.Constructor) {
 	case 0x26b7a13e:
+		// msg.deletePhoneCallHistory flags:# user_id:long auth_key_id:long revoke:flags.1?true = messages.AffectedFoundMessages;
 		x.UInt(0x26b7a13e)
 
 		// set flags
@@ -1232,6 +1298,7 @@ func (m *TLMsgDeletePhoneCallHistory) CalcByteSize(layer int32) int {
 func (m *TLMsgDeletePhoneCallHistory) Decode(dBuf *mtproto.DecodeBuf) error {
 	switch uint32(m.Constructor) {
 	case 0x26b7a13e:
+		// msg.deletePhoneCallHistory flags:# user_id:long auth_key_id:long revoke:flags.1?true = messages.AffectedFoundMessages;
 
 		flags := dBuf.UInt()
 		_ = flags
@@ -1265,6 +1332,7 @@ func (m *TLMsgDeleteChatHistory) Encode(layer int32) []byte {
 
 	switch uint32(m.Constructor) {
 	case 0xef1f62db:
+		// msg.deleteChatHistory chat_id:long delete_user_id:long = Bool;
 		x.UInt(0xef1f62db)
 
 		// no flags
@@ -1286,6 +1354,7 @@ func (m *TLMsgDeleteChatHistory) CalcByteSize(layer int32) int {
 func (m *TLMsgDeleteChatHistory) Decode(dBuf *mtproto.DecodeBuf) error {
 	switch uint32(m.Constructor) {
 	case 0xef1f62db:
+		// msg.deleteChatHistory chat_id:long delete_user_id:long = Bool;
 
 		// not has flags
 
@@ -1314,6 +1383,7 @@ func (m *TLMsgReadHistory) Encode(layer int32) []byte {
 
 	switch uint32(m.Constructor) {
 	case 0x5a0f6e12:
+		// msg.readHistory user_id:long auth_key_id:long peer_type:int peer_id:long max_id:int = messages.AffectedMessages;
 		x.UInt(0x5a0f6e12)
 
 		// no flags
@@ -1338,6 +1408,7 @@ func (m *TLMsgReadHistory) CalcByteSize(layer int32) int {
 func (m *TLMsgReadHistory) Decode(dBuf *mtproto.DecodeBuf) error {
 	switch uint32(m.Constructor) {
 	case 0x5a0f6e12:
+		// msg.readHistory user_id:long auth_key_id:long peer_type:int peer_id:long max_id:int = messages.AffectedMessages;
 
 		// not has flags
 
@@ -1369,6 +1440,7 @@ func (m *TLMsgUpdatePinnedMessage) Encode(layer int32) []byte {
 
 	switch uint32(m.Constructor) {
 	case 0xe5ae51a9:
+		// msg.updatePinnedMessage flags:# user_id:long auth_key_id:long silent:flags.0?true unpin:flags.1?true pm_oneside:flags.2?true peer_type:int peer_id:long id:int = Updates;
 		x.UInt(0xe5ae51a9)
 
 		// set flags
@@ -1407,6 +1479,7 @@ func (m *TLMsgUpdatePinnedMessage) CalcByteSize(layer int32) int {
 func (m *TLMsgUpdatePinnedMessage) Decode(dBuf *mtproto.DecodeBuf) error {
 	switch uint32(m.Constructor) {
 	case 0xe5ae51a9:
+		// msg.updatePinnedMessage flags:# user_id:long auth_key_id:long silent:flags.0?true unpin:flags.1?true pm_oneside:flags.2?true peer_type:int peer_id:long id:int = Updates;
 
 		flags := dBuf.UInt()
 		_ = flags
@@ -1449,6 +1522,7 @@ func (m *TLMsgUnpinAllMessages) Encode(layer int32) []byte {
 
 	switch uint32(m.Constructor) {
 	case 0xb8865f25:
+		// msg.unpinAllMessages user_id:long auth_key_id:long peer_type:int peer_id:long = messages.AffectedHistory;
 		x.UInt(0xb8865f25)
 
 		// no flags
@@ -1472,6 +1546,7 @@ func (m *TLMsgUnpinAllMessages) CalcByteSize(layer int32) int {
 func (m *TLMsgUnpinAllMessages) Decode(dBuf *mtproto.DecodeBuf) error {
 	switch uint32(m.Constructor) {
 	case 0xb8865f25:
+		// msg.unpinAllMessages user_id:long auth_key_id:long peer_type:int peer_id:long = messages.AffectedHistory;
 
 		// not has flags
 
@@ -1493,4 +1568,490 @@ func (m *TLMsgUnpinAllMessages) DebugString() string {
 	return dbgString
 }
 
-//----------------------------------------------------------------------------------------------------------------
+// TLMsgGetScheduledHistory
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLMsgGetScheduledHistory) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_msg_getScheduledHistory))
+
+	switch uint32(m.Constructor) {
+	case 0x121cf947:
+		// msg.getScheduledHistory user_id:long peer_type:int peer_id:long = Vector<Message>;
+		x.UInt(0x121cf947)
+
+		// no flags
+
+		x.Long(m.GetUserId())
+		x.Int(m.GetPeerType())
+		x.Long(m.GetPeerId())
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLMsgGetScheduledHistory) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLMsgGetScheduledHistory) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0x121cf947:
+		// msg.getScheduledHistory user_id:long peer_type:int peer_id:long = Vector<Message>;
+
+		// not has flags
+
+		m.UserId = dBuf.Long()
+		m.PeerType = dBuf.Int()
+		m.PeerId = dBuf.Long()
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLMsgGetScheduledHistory) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLMsgGetScheduledMessages
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLMsgGetScheduledMessages) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_msg_getScheduledMessages))
+
+	switch uint32(m.Constructor) {
+	case 0xe1727bee:
+		// msg.getScheduledMessages user_id:long peer_type:int peer_id:long id:Vector<int> = Vector<Message>;
+		x.UInt(0xe1727bee)
+
+		// no flags
+
+		x.Long(m.GetUserId())
+		x.Int(m.GetPeerType())
+		x.Long(m.GetPeerId())
+
+		x.VectorInt(m.GetId())
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLMsgGetScheduledMessages) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLMsgGetScheduledMessages) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0xe1727bee:
+		// msg.getScheduledMessages user_id:long peer_type:int peer_id:long id:Vector<int> = Vector<Message>;
+
+		// not has flags
+
+		m.UserId = dBuf.Long()
+		m.PeerType = dBuf.Int()
+		m.PeerId = dBuf.Long()
+
+		m.Id = dBuf.VectorInt()
+
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLMsgGetScheduledMessages) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLMsgSendScheduledMessages
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLMsgSendScheduledMessages) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_msg_sendScheduledMessages))
+
+	switch uint32(m.Constructor) {
+	case 0xd30333ec:
+		// msg.sendScheduledMessages user_id:long auth_key_id:long peer_type:int peer_id:long id:Vector<int> = Updates;
+		x.UInt(0xd30333ec)
+
+		// no flags
+
+		x.Long(m.GetUserId())
+		x.Long(m.GetAuthKeyId())
+		x.Int(m.GetPeerType())
+		x.Long(m.GetPeerId())
+
+		x.VectorInt(m.GetId())
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLMsgSendScheduledMessages) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLMsgSendScheduledMessages) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0xd30333ec:
+		// msg.sendScheduledMessages user_id:long auth_key_id:long peer_type:int peer_id:long id:Vector<int> = Updates;
+
+		// not has flags
+
+		m.UserId = dBuf.Long()
+		m.AuthKeyId = dBuf.Long()
+		m.PeerType = dBuf.Int()
+		m.PeerId = dBuf.Long()
+
+		m.Id = dBuf.VectorInt()
+
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLMsgSendScheduledMessages) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLMsgDeleteScheduledMessages
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLMsgDeleteScheduledMessages) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_msg_deleteScheduledMessages))
+
+	switch uint32(m.Constructor) {
+	case 0xd6624ce7:
+		// msg.deleteScheduledMessages user_id:long auth_key_id:long peer_type:int peer_id:long id:Vector<int> = Updates;
+		x.UInt(0xd6624ce7)
+
+		// no flags
+
+		x.Long(m.GetUserId())
+		x.Long(m.GetAuthKeyId())
+		x.Int(m.GetPeerType())
+		x.Long(m.GetPeerId())
+
+		x.VectorInt(m.GetId())
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLMsgDeleteScheduledMessages) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLMsgDeleteScheduledMessages) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0xd6624ce7:
+		// msg.deleteScheduledMessages user_id:long auth_key_id:long peer_type:int peer_id:long id:Vector<int> = Updates;
+
+		// not has flags
+
+		m.UserId = dBuf.Long()
+		m.AuthKeyId = dBuf.Long()
+		m.PeerType = dBuf.Int()
+		m.PeerId = dBuf.Long()
+
+		m.Id = dBuf.VectorInt()
+
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLMsgDeleteScheduledMessages) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLMsgSendReaction
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLMsgSendReaction) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_msg_sendReaction))
+
+	switch uint32(m.Constructor) {
+	case 0x733407c3:
+		// msg.sendReaction flags:# user_id:long auth_key_id:long big:flags.1?true peer_type:int peer_id:long msg_id:int reaction:flags.0?string = Updates;
+		x.UInt(0x733407c3)
+
+		// set flags
+		var flags uint32 = 0
+
+		if m.GetBig() == true {
+			flags |= 1 << 1
+		}
+		if m.GetReaction() != nil {
+			flags |= 1 << 0
+		}
+
+		x.UInt(flags)
+
+		// flags Debug by @benqi
+		x.Long(m.GetUserId())
+		x.Long(m.GetAuthKeyId())
+		x.Int(m.GetPeerType())
+		x.Long(m.GetPeerId())
+		x.Int(m.GetMsgId())
+		if m.GetReaction() != nil {
+			x.String(m.GetReaction().Value)
+		}
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLMsgSendReaction) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLMsgSendReaction) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0x733407c3:
+		// msg.sendReaction flags:# user_id:long auth_key_id:long big:flags.1?true peer_type:int peer_id:long msg_id:int reaction:flags.0?string = Updates;
+
+		flags := dBuf.UInt()
+		_ = flags
+
+		// flags Debug by @benqi
+		m.UserId = dBuf.Long()
+		m.AuthKeyId = dBuf.Long()
+		if (flags & (1 << 1)) != 0 {
+			m.Big = true
+		}
+		m.PeerType = dBuf.Int()
+		m.PeerId = dBuf.Long()
+		m.MsgId = dBuf.Int()
+		if (flags & (1 << 0)) != 0 {
+			m.Reaction = &types.StringValue{Value: dBuf.String()}
+		}
+
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLMsgSendReaction) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLMsgReadReactions
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLMsgReadReactions) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_msg_readReactions))
+
+	switch uint32(m.Constructor) {
+	case 0xfa8ad653:
+		// msg.readReactions user_id:long auth_key_id:long peer_type:int peer_id:long = messages.AffectedHistory;
+		x.UInt(0xfa8ad653)
+
+		// no flags
+
+		x.Long(m.GetUserId())
+		x.Long(m.GetAuthKeyId())
+		x.Int(m.GetPeerType())
+		x.Long(m.GetPeerId())
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLMsgReadReactions) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLMsgReadReactions) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0xfa8ad653:
+		// msg.readReactions user_id:long auth_key_id:long peer_type:int peer_id:long = messages.AffectedHistory;
+
+		// not has flags
+
+		m.UserId = dBuf.Long()
+		m.AuthKeyId = dBuf.Long()
+		m.PeerType = dBuf.Int()
+		m.PeerId = dBuf.Long()
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLMsgReadReactions) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLMsgGetMessageReactionsList
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLMsgGetMessageReactionsList) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_msg_getMessageReactionsList))
+
+	switch uint32(m.Constructor) {
+	case 0x87b3e279:
+		// msg.getMessageReactionsList flags:# user_id:long peer_type:int peer_id:long msg_id:int reaction:flags.0?string offset:flags.1?string limit:int = messages.MessageReactionsList;
+		x.UInt(0x87b3e279)
+
+		// set flags
+		var flags uint32 = 0
+
+		if m.GetReaction() != nil {
+			flags |= 1 << 0
+		}
+		if m.GetOffset() != nil {
+			flags |= 1 << 1
+		}
+
+		x.UInt(flags)
+
+		// flags Debug by @benqi
+		x.Long(m.GetUserId())
+		x.Int(m.GetPeerType())
+		x.Long(m.GetPeerId())
+		x.Int(m.GetMsgId())
+		if m.GetReaction() != nil {
+			x.String(m.GetReaction().Value)
+		}
+
+		if m.GetOffset() != nil {
+			x.String(m.GetOffset().Value)
+		}
+
+		x.Int(m.GetLimit())
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLMsgGetMessageReactionsList) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLMsgGetMessageReactionsList) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0x87b3e279:
+		// msg.getMessageReactionsList flags:# user_id:long peer_type:int peer_id:long msg_id:int reaction:flags.0?string offset:flags.1?string limit:int = messages.MessageReactionsList;
+
+		flags := dBuf.UInt()
+		_ = flags
+
+		// flags Debug by @benqi
+		m.UserId = dBuf.Long()
+		m.PeerType = dBuf.Int()
+		m.PeerId = dBuf.Long()
+		m.MsgId = dBuf.Int()
+		if (flags & (1 << 0)) != 0 {
+			m.Reaction = &types.StringValue{Value: dBuf.String()}
+		}
+
+		if (flags & (1 << 1)) != 0 {
+			m.Offset = &types.StringValue{Value: dBuf.String()}
+		}
+
+		m.Limit = dBuf.Int()
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLMsgGetMessageReactionsList) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+//----------------------------------------------------------------------------------------------------------------
+// Vector_Message
+///////////////////////////////////////////////////////////////////////////////
+func (m *Vector_Message) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	x.Int(int32(mtproto.CRC32_vector))
+	x.Int(int32(len(m.Datas)))
+	for _, v := range m.Datas {
+		x.Bytes((*v).Encode(layer))
+	}
+
+	return x.GetBuf()
+}
+
+func (m *Vector_Message) Decode(dBuf *mtproto.DecodeBuf) error {
+	dBuf.Int() // TODO(@benqi): Check crc32 invalid
+	l1 := dBuf.Int()
+	m.Datas = make([]*mtproto.Message, l1)
+	for i := int32(0); i < l1; i++ {
+		m.Datas[i] = new(mtproto.Message)
+		(*m.Datas[i]).Decode(dBuf)
+	}
+
+	return dBuf.GetError()
+}
+
+func (m *Vector_Message) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *Vector_Message) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
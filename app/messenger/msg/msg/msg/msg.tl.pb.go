@@ -34,23 +34,30 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type TLConstructor int32
 
 const (
-	CRC32_UNKNOWN                    TLConstructor = 0
-	CRC32_sender                     TLConstructor = 1513645242
-	CRC32_outboxMessage              TLConstructor = 1402283185
-	CRC32_contentMessage             TLConstructor = 295822890
-	CRC32_msg_sendMessage            TLConstructor = 1218652155
-	CRC32_msg_sendMultiMessage       TLConstructor = -1727589428
-	CRC32_msg_pushUserMessage        TLConstructor = 902887962
-	CRC32_msg_readMessageContents    TLConstructor = 673481940
-	CRC32_msg_sendMessageV2          TLConstructor = 770211174
-	CRC32_msg_editMessage            TLConstructor = -1770495214
-	CRC32_msg_deleteMessages         TLConstructor = 568855069
-	CRC32_msg_deleteHistory          TLConstructor = 1975576778
-	CRC32_msg_deletePhoneCallHistory TLConstructor = 649568574
-	CRC32_msg_deleteChatHistory      TLConstructor = -283155749
-	CRC32_msg_readHistory            TLConstructor = 1510960658
-	CRC32_msg_updatePinnedMessage    TLConstructor = -441560663
-	CRC32_msg_unpinAllMessages       TLConstructor = -1199153371
+	CRC32_UNKNOWN                     TLConstructor = 0
+	CRC32_sender                      TLConstructor = 1513645242
+	CRC32_outboxMessage               TLConstructor = 1402283185
+	CRC32_contentMessage              TLConstructor = 295822890
+	CRC32_msg_sendMessage             TLConstructor = 1218652155
+	CRC32_msg_sendMultiMessage        TLConstructor = -1727589428
+	CRC32_msg_pushUserMessage         TLConstructor = 902887962
+	CRC32_msg_readMessageContents     TLConstructor = 673481940
+	CRC32_msg_sendMessageV2           TLConstructor = 770211174
+	CRC32_msg_editMessage             TLConstructor = -1770495214
+	CRC32_msg_deleteMessages          TLConstructor = 568855069
+	CRC32_msg_deleteHistory           TLConstructor = 1975576778
+	CRC32_msg_deletePhoneCallHistory  TLConstructor = 649568574
+	CRC32_msg_deleteChatHistory       TLConstructor = -283155749
+	CRC32_msg_readHistory             TLConstructor = 1510960658
+	CRC32_msg_updatePinnedMessage     TLConstructor = -441560663
+	CRC32_msg_unpinAllMessages        TLConstructor = -1199153371
+	CRC32_msg_getScheduledHistory     TLConstructor = 303888711
+	CRC32_msg_getScheduledMessages    TLConstructor = -512590866
+	CRC32_msg_sendScheduledMessages   TLConstructor = -754764820
+	CRC32_msg_deleteScheduledMessages TLConstructor = -698200857
+	CRC32_msg_sendReaction            TLConstructor = 1932789699
+	CRC32_msg_readReactions           TLConstructor = -91564461
+	CRC32_msg_getMessageReactionsList TLConstructor = -2018254215
 )
 
 var TLConstructor_name = map[int32]string{
@@ -71,26 +78,40 @@ var TLConstructor_name = map[int32]string{
 	1510960658:  "CRC32_msg_readHistory",
 	-441560663:  "CRC32_msg_updatePinnedMessage",
 	-1199153371: "CRC32_msg_unpinAllMessages",
+	303888711:   "CRC32_msg_getScheduledHistory",
+	-512590866:  "CRC32_msg_getScheduledMessages",
+	-754764820:  "CRC32_msg_sendScheduledMessages",
+	-698200857:  "CRC32_msg_deleteScheduledMessages",
+	1932789699:  "CRC32_msg_sendReaction",
+	-91564461:   "CRC32_msg_readReactions",
+	-2018254215: "CRC32_msg_getMessageReactionsList",
 }
 
 var TLConstructor_value = map[string]int32{
-	"CRC32_UNKNOWN":                    0,
-	"CRC32_sender":                     1513645242,
-	"CRC32_outboxMessage":              1402283185,
-	"CRC32_contentMessage":             295822890,
-	"CRC32_msg_sendMessage":            1218652155,
-	"CRC32_msg_sendMultiMessage":       -1727589428,
-	"CRC32_msg_pushUserMessage":        902887962,
-	"CRC32_msg_readMessageContents":    673481940,
-	"CRC32_msg_sendMessageV2":          770211174,
-	"CRC32_msg_editMessage":            -1770495214,
-	"CRC32_msg_deleteMessages":         568855069,
-	"CRC32_msg_deleteHistory":          1975576778,
-	"CRC32_msg_deletePhoneCallHistory": 649568574,
-	"CRC32_msg_deleteChatHistory":      -283155749,
-	"CRC32_msg_readHistory":            1510960658,
-	"CRC32_msg_updatePinnedMessage":    -441560663,
-	"CRC32_msg_unpinAllMessages":       -1199153371,
+	"CRC32_UNKNOWN":                     0,
+	"CRC32_sender":                      1513645242,
+	"CRC32_outboxMessage":               1402283185,
+	"CRC32_contentMessage":              295822890,
+	"CRC32_msg_sendMessage":             1218652155,
+	"CRC32_msg_sendMultiMessage":        -1727589428,
+	"CRC32_msg_pushUserMessage":         902887962,
+	"CRC32_msg_readMessageContents":     673481940,
+	"CRC32_msg_sendMessageV2":           770211174,
+	"CRC32_msg_editMessage":             -1770495214,
+	"CRC32_msg_deleteMessages":          568855069,
+	"CRC32_msg_deleteHistory":           1975576778,
+	"CRC32_msg_deletePhoneCallHistory":  649568574,
+	"CRC32_msg_deleteChatHistory":       -283155749,
+	"CRC32_msg_readHistory":             1510960658,
+	"CRC32_msg_updatePinnedMessage":     -441560663,
+	"CRC32_msg_unpinAllMessages":        -1199153371,
+	"CRC32_msg_getScheduledHistory":     303888711,
+	"CRC32_msg_getScheduledMessages":    -512590866,
+	"CRC32_msg_sendScheduledMessages":   -754764820,
+	"CRC32_msg_deleteScheduledMessages": -698200857,
+	"CRC32_msg_sendReaction":            1932789699,
+	"CRC32_msg_readReactions":           -91564461,
+	"CRC32_msg_getMessageReactionsList": -2018254215,
 }
 
 func (x TLConstructor) String() string {
@@ -101,9 +122,11 @@ func (TLConstructor) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_cb6f24e718b1b713, []int{0}
 }
 
-// Sender <--
-//  + TL_sender
+//--------------------------------------------------------------------------------------------
+// sender user_id:long type:int auth_key_id:long = Sender;
 //
+// Sender <--
+//   - TL_sender
 type Sender struct {
 	PredicateName        string        `protobuf:"bytes,1,opt,name=predicate_name,json=predicateName,proto3" json:"predicate_name,omitempty"`
 	Constructor          TLConstructor `protobuf:"varint,2,opt,name=constructor,proto3,enum=msg.TLConstructor" json:"constructor,omitempty"`
@@ -183,6 +206,7 @@ func (m *Sender) GetAuthKeyId() int64 {
 	return 0
 }
 
+// sender user_id:long type:int auth_key_id:long = Sender;
 type TLSender struct {
 	Data2                *Sender  `protobuf:"bytes,1,opt,name=data2,proto3" json:"data2,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -230,9 +254,11 @@ func (m *TLSender) GetData2() *Sender {
 	return nil
 }
 
-// OutboxMessage <--
-//  + TL_outboxMessage
+//--------------------------------------------------------------------------------------------
+// outboxMessage flags:# no_webpage:flags.0?true background:flags.1?true random_id:long message:Message schedule_date:flags.2?int = OutboxMessage;
 //
+// OutboxMessage <--
+//   - TL_outboxMessage
 type OutboxMessage struct {
 	PredicateName        string            `protobuf:"bytes,1,opt,name=predicate_name,json=predicateName,proto3" json:"predicate_name,omitempty"`
 	Constructor          TLConstructor     `protobuf:"varint,2,opt,name=constructor,proto3,enum=msg.TLConstructor" json:"constructor,omitempty"`
@@ -328,6 +354,7 @@ func (m *OutboxMessage) GetScheduleDate() *types.Int32Value {
 	return nil
 }
 
+// outboxMessage flags:# no_webpage:flags.0?true background:flags.1?true random_id:long message:Message schedule_date:flags.2?int = OutboxMessage;
 type TLOutboxMessage struct {
 	Data2                *OutboxMessage `protobuf:"bytes,1,opt,name=data2,proto3" json:"data2,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
@@ -375,9 +402,11 @@ func (m *TLOutboxMessage) GetData2() *OutboxMessage {
 	return nil
 }
 
-// ContentMessage <--
-//  + TL_contentMessage
+//--------------------------------------------------------------------------------------------
+// contentMessage flags:# id:int mentioned:flags.0?true media_unread:flags.1?true reaction:flags.2?true = ContentMessage;
 //
+// ContentMessage <--
+//   - TL_contentMessage
 type ContentMessage struct {
 	PredicateName        string        `protobuf:"bytes,1,opt,name=predicate_name,json=predicateName,proto3" json:"predicate_name,omitempty"`
 	Constructor          TLConstructor `protobuf:"varint,2,opt,name=constructor,proto3,enum=msg.TLConstructor" json:"constructor,omitempty"`
@@ -465,6 +494,7 @@ func (m *ContentMessage) GetReaction() bool {
 	return false
 }
 
+// contentMessage flags:# id:int mentioned:flags.0?true media_unread:flags.1?true reaction:flags.2?true = ContentMessage;
 type TLContentMessage struct {
 	Data2                *ContentMessage `protobuf:"bytes,1,opt,name=data2,proto3" json:"data2,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
@@ -513,6 +543,7 @@ func (m *TLContentMessage) GetData2() *ContentMessage {
 }
 
 //--------------------------------------------------------------------------------------------
+// msg.sendMessage user_id:long auth_key_id:long peer_type:int peer_id:long message:OutboxMessage = Updates;
 type TLMsgSendMessage struct {
 	Constructor          TLConstructor  `protobuf:"varint,1,opt,name=constructor,proto3,enum=msg.TLConstructor" json:"constructor,omitempty"`
 	UserId               int64          `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -601,6 +632,7 @@ func (m *TLMsgSendMessage) GetMessage() *OutboxMessage {
 }
 
 //--------------------------------------------------------------------------------------------
+// msg.sendMultiMessage user_id:long auth_key_id:long peer_type:int peer_id:long message:Vector<OutboxMessage> = Updates;
 type TLMsgSendMultiMessage struct {
 	Constructor          TLConstructor    `protobuf:"varint,1,opt,name=constructor,proto3,enum=msg.TLConstructor" json:"constructor,omitempty"`
 	UserId               int64            `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -689,6 +721,7 @@ func (m *TLMsgSendMultiMessage) GetMessage() []*OutboxMessage {
 }
 
 //--------------------------------------------------------------------------------------------
+// msg.pushUserMessage user_id:long auth_key_id:long peer_type:int peer_id:long push_type:int message:OutboxMessage = Bool;
 type TLMsgPushUserMessage struct {
 	Constructor          TLConstructor  `protobuf:"varint,1,opt,name=constructor,proto3,enum=msg.TLConstructor" json:"constructor,omitempty"`
 	UserId               int64          `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -785,6 +818,7 @@ func (m *TLMsgPushUserMessage) GetMessage() *OutboxMessage {
 }
 
 //--------------------------------------------------------------------------------------------
+// msg.readMessageContents user_id:long auth_key_id:long peer_type:int peer_id:long id:Vector<ContentMessage> = messages.AffectedMessages;
 type TLMsgReadMessageContents struct {
 	Constructor          TLConstructor     `protobuf:"varint,1,opt,name=constructor,proto3,enum=msg.TLConstructor" json:"constructor,omitempty"`
 	UserId               int64             `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -873,6 +907,7 @@ func (m *TLMsgReadMessageContents) GetId() []*ContentMessage {
 }
 
 //--------------------------------------------------------------------------------------------
+// msg.sendMessageV2 user_id:long auth_key_id:long peer_type:int peer_id:long message:Vector<OutboxMessage> = UpdateList;
 type TLMsgSendMessageV2 struct {
 	Constructor          TLConstructor    `protobuf:"varint,1,opt,name=constructor,proto3,enum=msg.TLConstructor" json:"constructor,omitempty"`
 	UserId               int64            `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -961,6 +996,7 @@ func (m *TLMsgSendMessageV2) GetMessage() []*OutboxMessage {
 }
 
 //--------------------------------------------------------------------------------------------
+// msg.editMessage user_id:long auth_key_id:long peer_type:int peer_id:long message:OutboxMessage = Updates;
 type TLMsgEditMessage struct {
 	Constructor          TLConstructor  `protobuf:"varint,1,opt,name=constructor,proto3,enum=msg.TLConstructor" json:"constructor,omitempty"`
 	UserId               int64          `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -1049,6 +1085,7 @@ func (m *TLMsgEditMessage) GetMessage() *OutboxMessage {
 }
 
 //--------------------------------------------------------------------------------------------
+// msg.deleteMessages flags:# user_id:long auth_key_id:long peer_type:int peer_id:long revoke:flags.1?true id:Vector<int> = messages.AffectedMessages;
 type TLMsgDeleteMessages struct {
 	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=msg.TLConstructor" json:"constructor,omitempty"`
 	UserId               int64         `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -1145,6 +1182,7 @@ func (m *TLMsgDeleteMessages) GetId() []int32 {
 }
 
 //--------------------------------------------------------------------------------------------
+// msg.deleteHistory flags:# user_id:long auth_key_id:long peer_type:int peer_id:long just_clear:flags.0?true revoke:flags.1?true max_id:int = messages.AffectedHistory;
 type TLMsgDeleteHistory struct {
 	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=msg.TLConstructor" json:"constructor,omitempty"`
 	UserId               int64         `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -1249,6 +1287,7 @@ func (m *TLMsgDeleteHistory) GetMaxId() int32 {
 }
 
 //--------------------------------------------------------------------------------------------
+// msg.deletePhoneCallHistory flags:# user_id:long auth_key_id:long revoke:flags.1?true = messages.AffectedFoundMessages;
 type TLMsgDeletePhoneCallHistory struct {
 	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=msg.TLConstructor" json:"constructor,omitempty"`
 	UserId               int64         `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -1321,6 +1360,7 @@ func (m *TLMsgDeletePhoneCallHistory) GetRevoke() bool {
 }
 
 //--------------------------------------------------------------------------------------------
+// msg.deleteChatHistory chat_id:long delete_user_id:long = Bool;
 type TLMsgDeleteChatHistory struct {
 	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=msg.TLConstructor" json:"constructor,omitempty"`
 	ChatId               int64         `protobuf:"varint,3,opt,name=chat_id,json=chatId,proto3" json:"chat_id,omitempty"`
@@ -1385,6 +1425,7 @@ func (m *TLMsgDeleteChatHistory) GetDeleteUserId() int64 {
 }
 
 //--------------------------------------------------------------------------------------------
+// msg.readHistory user_id:long auth_key_id:long peer_type:int peer_id:long max_id:int = messages.AffectedMessages;
 type TLMsgReadHistory struct {
 	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=msg.TLConstructor" json:"constructor,omitempty"`
 	UserId               int64         `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -1473,6 +1514,7 @@ func (m *TLMsgReadHistory) GetMaxId() int32 {
 }
 
 //--------------------------------------------------------------------------------------------
+// msg.updatePinnedMessage flags:# user_id:long auth_key_id:long silent:flags.0?true unpin:flags.1?true pm_oneside:flags.2?true peer_type:int peer_id:long id:int = Updates;
 type TLMsgUpdatePinnedMessage struct {
 	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=msg.TLConstructor" json:"constructor,omitempty"`
 	UserId               int64         `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -1585,6 +1627,7 @@ func (m *TLMsgUpdatePinnedMessage) GetId() int32 {
 }
 
 //--------------------------------------------------------------------------------------------
+// msg.unpinAllMessages user_id:long auth_key_id:long peer_type:int peer_id:long = messages.AffectedHistory;
 type TLMsgUnpinAllMessages struct {
 	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=msg.TLConstructor" json:"constructor,omitempty"`
 	UserId               int64         `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -1664,1889 +1707,2148 @@ func (m *TLMsgUnpinAllMessages) GetPeerId() int64 {
 	return 0
 }
 
-func init() {
-	proto.RegisterEnum("msg.TLConstructor", TLConstructor_name, TLConstructor_value)
-	proto.RegisterType((*Sender)(nil), "msg.Sender")
-	proto.RegisterType((*TLSender)(nil), "msg.TL_sender")
-	proto.RegisterType((*OutboxMessage)(nil), "msg.OutboxMessage")
-	proto.RegisterType((*TLOutboxMessage)(nil), "msg.TL_outboxMessage")
-	proto.RegisterType((*ContentMessage)(nil), "msg.ContentMessage")
-	proto.RegisterType((*TLContentMessage)(nil), "msg.TL_contentMessage")
-	proto.RegisterType((*TLMsgSendMessage)(nil), "msg.TL_msg_sendMessage")
-	proto.RegisterType((*TLMsgSendMultiMessage)(nil), "msg.TL_msg_sendMultiMessage")
-	proto.RegisterType((*TLMsgPushUserMessage)(nil), "msg.TL_msg_pushUserMessage")
-	proto.RegisterType((*TLMsgReadMessageContents)(nil), "msg.TL_msg_readMessageContents")
-	proto.RegisterType((*TLMsgSendMessageV2)(nil), "msg.TL_msg_sendMessageV2")
-	proto.RegisterType((*TLMsgEditMessage)(nil), "msg.TL_msg_editMessage")
-	proto.RegisterType((*TLMsgDeleteMessages)(nil), "msg.TL_msg_deleteMessages")
-	proto.RegisterType((*TLMsgDeleteHistory)(nil), "msg.TL_msg_deleteHistory")
-	proto.RegisterType((*TLMsgDeletePhoneCallHistory)(nil), "msg.TL_msg_deletePhoneCallHistory")
-	proto.RegisterType((*TLMsgDeleteChatHistory)(nil), "msg.TL_msg_deleteChatHistory")
-	proto.RegisterType((*TLMsgReadHistory)(nil), "msg.TL_msg_readHistory")
-	proto.RegisterType((*TLMsgUpdatePinnedMessage)(nil), "msg.TL_msg_updatePinnedMessage")
-	proto.RegisterType((*TLMsgUnpinAllMessages)(nil), "msg.TL_msg_unpinAllMessages")
+//--------------------------------------------------------------------------------------------
+// msg.getScheduledHistory user_id:long peer_type:int peer_id:long = Vector<Message>;
+type TLMsgGetScheduledHistory struct {
+	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=msg.TLConstructor" json:"constructor,omitempty"`
+	UserId               int64         `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PeerType             int32         `protobuf:"varint,4,opt,name=peer_type,json=peerType,proto3" json:"peer_type,omitempty"`
+	PeerId               int64         `protobuf:"varint,5,opt,name=peer_id,json=peerId,proto3" json:"peer_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
-func init() { proto.RegisterFile("msg.tl.proto", fileDescriptor_cb6f24e718b1b713) }
-
-var fileDescriptor_cb6f24e718b1b713 = []byte{
-	// 1528 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x58, 0x5d, 0x68, 0x1d, 0xd5,
-	0x16, 0xce, 0x9c, 0xe4, 0xfc, 0xad, 0xfc, 0xdc, 0xe9, 0x4e, 0xd2, 0x9c, 0x4c, 0x9a, 0xd3, 0x64,
-	0xee, 0x2d, 0xcd, 0x2d, 0xb7, 0x27, 0x70, 0x7a, 0x1f, 0xef, 0x2d, 0xb7, 0x3d, 0xf7, 0x5e, 0x1b,
-	0x9a, 0x26, 0x61, 0x9a, 0xa4, 0xe8, 0xcb, 0x38, 0x39, 0xb3, 0x3b, 0x67, 0xcc, 0x99, 0x1f, 0x66,
-	0xf6, 0xb4, 0xcd, 0xb3, 0x0f, 0xb6, 0xa0, 0x14, 0x8a, 0x2f, 0x4a, 0x95, 0x4a, 0x51, 0x51, 0xb0,
-	0xa0, 0xa2, 0xa0, 0x60, 0xc1, 0x9f, 0x4a, 0x2b, 0x05, 0x5b, 0xe8, 0x93, 0x15, 0xb1, 0x45, 0x2a,
-	0x82, 0xa0, 0x0f, 0x3e, 0xa8, 0x45, 0x23, 0xb3, 0xf7, 0x4c, 0xe6, 0xe7, 0x9c, 0xd8, 0x42, 0xd1,
-	0x92, 0x3e, 0x04, 0xce, 0x5e, 0x6b, 0xed, 0xb5, 0xd7, 0xf7, 0xcd, 0x5a, 0x6b, 0xaf, 0x1d, 0xe8,
-	0x31, 0x5c, 0xad, 0x42, 0x9a, 0x15, 0xdb, 0xb1, 0x88, 0x85, 0x3a, 0x0d, 0x57, 0x13, 0x76, 0x6a,
-	0x3a, 0x69, 0x78, 0x4b, 0x95, 0xba, 0x65, 0x4c, 0x6a, 0x96, 0x66, 0x4d, 0x52, 0xdd, 0x92, 0x77,
-	0x98, 0xae, 0xe8, 0x82, 0xfe, 0x62, 0x7b, 0x84, 0xb2, 0x66, 0x59, 0x5a, 0x13, 0x47, 0x56, 0x47,
-	0x1d, 0xc5, 0xb6, 0xb1, 0xe3, 0x06, 0x7a, 0xc1, 0xad, 0x37, 0xb0, 0xa1, 0xf8, 0x87, 0xd4, 0x2d,
-	0x07, 0xcb, 0x64, 0xc5, 0xc6, 0xa1, 0x6e, 0x38, 0xd2, 0x11, 0x47, 0x31, 0x5d, 0xdb, 0x72, 0x48,
-	0xa0, 0x1a, 0x88, 0x54, 0xee, 0x8a, 0x59, 0x67, 0x52, 0xf1, 0x0d, 0x0e, 0x72, 0x07, 0xb1, 0xa9,
-	0x62, 0x07, 0x6d, 0x83, 0x3e, 0xdb, 0xc1, 0xaa, 0x5e, 0x57, 0x08, 0x96, 0x4d, 0xc5, 0xc0, 0x25,
-	0x6e, 0x8c, 0x9b, 0x28, 0x4a, 0xbd, 0x6b, 0xd2, 0x19, 0xc5, 0xc0, 0xe8, 0x9f, 0xd0, 0x5d, 0xb7,
-	0x4c, 0x97, 0x38, 0x5e, 0x9d, 0x58, 0x4e, 0x29, 0x33, 0xc6, 0x4d, 0xf4, 0x55, 0x51, 0xc5, 0x87,
-	0x3d, 0x3f, 0x5d, 0x8b, 0x34, 0x52, 0xdc, 0x0c, 0x0d, 0x41, 0xde, 0x73, 0xb1, 0x23, 0xeb, 0x6a,
-	0xa9, 0x73, 0x8c, 0x9b, 0xe8, 0x94, 0x72, 0xfe, 0x72, 0x4a, 0x45, 0x08, 0xba, 0x7c, 0x00, 0xa5,
-	0xae, 0x31, 0x6e, 0x22, 0x2b, 0xd1, 0xdf, 0xa8, 0x0c, 0xdd, 0x8a, 0x47, 0x1a, 0xf2, 0x32, 0x5e,
-	0xf1, 0x37, 0x64, 0xe9, 0x86, 0xa2, 0x2f, 0xda, 0x8f, 0x57, 0xa6, 0x54, 0xb1, 0x02, 0xc5, 0xf9,
-	0x69, 0xd9, 0x65, 0x61, 0x8f, 0x43, 0x56, 0x55, 0x88, 0x52, 0xa5, 0xd1, 0x76, 0x57, 0xbb, 0x69,
-	0x24, 0x0c, 0x92, 0xc4, 0x34, 0xe2, 0xdb, 0x19, 0xe8, 0x9d, 0xf5, 0xc8, 0x92, 0x75, 0xec, 0x00,
-	0x76, 0x5d, 0x45, 0xc3, 0x7f, 0x2c, 0xd6, 0x51, 0x00, 0xd3, 0x92, 0x8f, 0xe2, 0x25, 0x5b, 0xd1,
-	0x30, 0x85, 0x5b, 0x90, 0x8a, 0xa6, 0x75, 0x88, 0x09, 0x50, 0x19, 0x60, 0x49, 0xa9, 0x2f, 0x6b,
-	0x8e, 0xe5, 0x99, 0x2a, 0xc5, 0x5d, 0x90, 0x62, 0x12, 0x34, 0x02, 0x45, 0x47, 0x31, 0x55, 0xcb,
-	0x88, 0xb0, 0x17, 0x98, 0x60, 0x4a, 0x45, 0x3b, 0x20, 0x6f, 0x30, 0x0c, 0xa5, 0x1c, 0xc5, 0xcb,
-	0x57, 0x0c, 0x42, 0x3f, 0x65, 0x25, 0xc0, 0x26, 0x85, 0x06, 0xe8, 0x3f, 0xd0, 0xeb, 0x7f, 0x73,
-	0xd5, 0x6b, 0x62, 0x59, 0x55, 0x08, 0x2e, 0xe5, 0xe9, 0x8e, 0x91, 0x0a, 0x4b, 0xb0, 0x4a, 0x98,
-	0x60, 0x95, 0x29, 0x93, 0xec, 0xaa, 0x2e, 0x2a, 0x4d, 0x0f, 0x4b, 0x3d, 0xe1, 0x8e, 0xff, 0x2a,
-	0x04, 0x8b, 0xff, 0x02, 0x7e, 0x7e, 0x5a, 0xb6, 0x12, 0xd4, 0x4d, 0x24, 0xf9, 0x66, 0x6c, 0x24,
-	0xd8, 0x0d, 0x69, 0xff, 0x8c, 0x83, 0xbe, 0x9a, 0x65, 0x12, 0x6c, 0x92, 0x3f, 0x85, 0xf7, 0x3e,
-	0xc8, 0x04, 0xe9, 0x95, 0x95, 0x32, 0xba, 0x8a, 0xb6, 0x40, 0xd1, 0xc0, 0x26, 0xd1, 0x2d, 0x13,
-	0x87, 0x3c, 0x47, 0x02, 0x34, 0x0e, 0x3d, 0x06, 0x56, 0x75, 0x45, 0xf6, 0x4c, 0x07, 0x2b, 0x8c,
-	0xe9, 0x82, 0xd4, 0x4d, 0x65, 0x0b, 0x54, 0x84, 0x04, 0x28, 0x38, 0x58, 0xa9, 0xfb, 0x1b, 0x28,
-	0xdb, 0x05, 0x69, 0x6d, 0x2d, 0xee, 0x86, 0x4d, 0xf3, 0xd3, 0x72, 0x3d, 0x09, 0xef, 0xef, 0x49,
-	0x6e, 0xfa, 0x69, 0xc4, 0x49, 0x0a, 0x42, 0x72, 0xbe, 0xe6, 0x00, 0xcd, 0x4f, 0xcb, 0x86, 0xab,
-	0xd1, 0x44, 0x0e, 0x3d, 0xa4, 0x90, 0x73, 0xf7, 0x58, 0x5d, 0xa9, 0x4a, 0xea, 0x4a, 0x55, 0x92,
-	0x9f, 0x6b, 0x36, 0xc6, 0x0e, 0xed, 0x21, 0x94, 0x81, 0xac, 0x54, 0xf0, 0x05, 0xf3, 0x7e, 0x19,
-	0x0e, 0x41, 0x9e, 0x2a, 0x75, 0x95, 0xa2, 0xef, 0x94, 0x72, 0xfe, 0x72, 0x4a, 0x45, 0xff, 0x88,
-	0x92, 0x30, 0xbf, 0x6e, 0x12, 0x84, 0x26, 0xe2, 0xb7, 0x1c, 0x0c, 0xc5, 0x91, 0x7a, 0x4d, 0xa2,
-	0x6f, 0x5c, 0xb8, 0x9d, 0x77, 0x82, 0x7b, 0x3c, 0x03, 0x9b, 0x03, 0xb8, 0xb6, 0xe7, 0x36, 0x16,
-	0x5c, 0xec, 0x6c, 0x28, 0xb4, 0xfe, 0x2e, 0xcf, 0x6d, 0xb0, 0x5d, 0xf9, 0x60, 0x97, 0xe7, 0x36,
-	0xe8, 0xae, 0x18, 0x15, 0x85, 0x3b, 0x7f, 0xf9, 0x5b, 0x1c, 0x08, 0x01, 0x15, 0x7e, 0x3d, 0x05,
-	0xfa, 0xa0, 0x1e, 0xdc, 0x8d, 0x41, 0xc7, 0x5f, 0x69, 0x53, 0x61, 0xdf, 0xbd, 0x6d, 0x3d, 0x67,
-	0x74, 0x55, 0xfc, 0x86, 0x83, 0x81, 0xd6, 0x62, 0x5e, 0xac, 0x3e, 0x88, 0xf9, 0x1d, 0x6b, 0x5c,
-	0x58, 0xd5, 0xc9, 0x03, 0xdc, 0xb8, 0xbe, 0xe2, 0x60, 0x30, 0x40, 0xaa, 0xe2, 0x26, 0x26, 0x38,
-	0xb0, 0xd8, 0x20, 0x99, 0xbb, 0x19, 0x72, 0x0e, 0x3e, 0x62, 0x2d, 0x33, 0xac, 0x05, 0x29, 0x58,
-	0x05, 0xd7, 0x64, 0x61, 0xac, 0x93, 0x5d, 0x93, 0xe2, 0x89, 0xcc, 0x5a, 0xf2, 0x32, 0x98, 0xfb,
-	0x74, 0x97, 0x58, 0xce, 0xca, 0xc6, 0x40, 0x39, 0x0a, 0xf0, 0x98, 0xe7, 0x12, 0xb9, 0xde, 0xc4,
-	0x8a, 0x13, 0x20, 0x2d, 0xfa, 0x92, 0x9a, 0x2f, 0x88, 0x91, 0x50, 0x48, 0x90, 0x30, 0x08, 0x39,
-	0x43, 0x39, 0xe6, 0xbb, 0x2b, 0xd2, 0x93, 0xb2, 0x86, 0x72, 0x6c, 0x4a, 0x15, 0x5f, 0xe2, 0x60,
-	0x34, 0xc1, 0xc5, 0x5c, 0xc3, 0x32, 0x71, 0x4d, 0x69, 0x36, 0xef, 0x13, 0x29, 0x51, 0xfc, 0xd9,
-	0x78, 0xfc, 0xe2, 0x49, 0x0e, 0x4a, 0x89, 0x40, 0x6b, 0x0d, 0x85, 0xdc, 0x73, 0x8c, 0xf5, 0x86,
-	0x42, 0x62, 0x31, 0xfa, 0xcb, 0x29, 0x15, 0xfd, 0x0d, 0xfa, 0xd8, 0x19, 0x72, 0x88, 0x81, 0x85,
-	0xd9, 0xc3, 0xa4, 0x0b, 0x14, 0x89, 0x78, 0x25, 0xea, 0x0b, 0x7e, 0xb3, 0xdf, 0x50, 0x49, 0x14,
-	0x65, 0x43, 0x3e, 0x9e, 0x0d, 0xcf, 0x67, 0xd6, 0xee, 0x2f, 0xcf, 0xf6, 0x27, 0xe8, 0x39, 0xdd,
-	0x34, 0xf1, 0xfd, 0x9a, 0xd5, 0x36, 0x43, 0xce, 0xd5, 0x9b, 0xd8, 0x24, 0x61, 0x2a, 0xb0, 0x15,
-	0x1a, 0x80, 0xac, 0x67, 0xda, 0x7a, 0x38, 0xa2, 0xb2, 0x85, 0x5f, 0x17, 0xb6, 0x21, 0x5b, 0x26,
-	0x76, 0x75, 0x35, 0xec, 0x00, 0x45, 0xdb, 0x98, 0x65, 0x82, 0x24, 0x4f, 0x85, 0xf5, 0x79, 0x2a,
-	0x26, 0x78, 0x62, 0xad, 0x03, 0xc2, 0x09, 0x5b, 0x3c, 0x1f, 0x8d, 0x76, 0xf4, 0xd4, 0x3d, 0xcd,
-	0xe6, 0x86, 0xea, 0x91, 0x3b, 0xae, 0x77, 0x41, 0x6f, 0x22, 0x1a, 0xb4, 0x09, 0x7a, 0x6b, 0x52,
-	0x6d, 0x57, 0x55, 0x5e, 0x98, 0xd9, 0x3f, 0x33, 0x7b, 0x68, 0x86, 0xef, 0x40, 0x03, 0xd0, 0xc3,
-	0x44, 0xec, 0xc5, 0xc9, 0xbf, 0x7b, 0xe9, 0xc6, 0xd5, 0x2c, 0x1a, 0x81, 0x7e, 0x26, 0x4d, 0x3c,
-	0x8f, 0xf8, 0xd7, 0x2f, 0x5d, 0x3b, 0x9d, 0x45, 0x5b, 0x60, 0x80, 0x29, 0x93, 0x0f, 0x04, 0xfe,
-	0xd5, 0xcb, 0x4f, 0x3c, 0xc5, 0xa1, 0x51, 0x18, 0x64, 0xda, 0xd4, 0xc0, 0xc0, 0xdf, 0xfe, 0xf4,
-	0xc9, 0x0b, 0x5d, 0x68, 0x3b, 0x08, 0x29, 0x75, 0x6c, 0x64, 0xe6, 0x2f, 0xbf, 0x7c, 0xfa, 0xe2,
-	0x4f, 0xab, 0xab, 0xab, 0xab, 0x1c, 0x1a, 0x87, 0xe1, 0xc8, 0x30, 0x35, 0x6c, 0xf2, 0xcf, 0xfe,
-	0xf0, 0xe1, 0xb9, 0x4e, 0xb4, 0x0d, 0x46, 0x23, 0x93, 0x36, 0x43, 0x18, 0x7f, 0xed, 0xc4, 0xa9,
-	0xf7, 0x33, 0x68, 0x2b, 0x0c, 0xb5, 0x8d, 0x68, 0xb1, 0xca, 0xdf, 0xfa, 0xf9, 0x85, 0xef, 0x32,
-	0x48, 0x8c, 0x87, 0x1c, 0xbb, 0xf7, 0xf9, 0x53, 0x1f, 0xdf, 0x78, 0x33, 0x08, 0x67, 0x0c, 0x4a,
-	0x91, 0x4d, 0xf2, 0xc6, 0xe4, 0x9f, 0x7b, 0xfa, 0xcc, 0xc9, 0xd4, 0x31, 0x89, 0xcb, 0x86, 0xff,
-	0xe4, 0xea, 0xe3, 0xe7, 0xf2, 0x68, 0x02, 0xc6, 0xd2, 0x06, 0xe9, 0x0e, 0xcc, 0x9f, 0xff, 0xe0,
-	0x8b, 0xb7, 0x32, 0x68, 0x02, 0x46, 0xd2, 0x96, 0xb1, 0x16, 0xc8, 0x5f, 0xbf, 0xf0, 0xcb, 0x8f,
-	0xbf, 0xb2, 0xb0, 0x12, 0x6c, 0xc7, 0x5a, 0x13, 0x7f, 0xea, 0xf3, 0xf7, 0xae, 0x64, 0xd1, 0x8e,
-	0x38, 0x43, 0x6d, 0xca, 0x9c, 0x7f, 0xe5, 0xec, 0x3b, 0xaf, 0x05, 0xae, 0x12, 0x5f, 0x26, 0x9d,
-	0xf1, 0xfc, 0x8b, 0xe7, 0x9f, 0xf9, 0xe8, 0x36, 0x35, 0x14, 0xba, 0x8e, 0x9f, 0x2d, 0x77, 0x54,
-	0xcf, 0x14, 0x20, 0x27, 0xcd, 0xd5, 0x0e, 0xb8, 0x1a, 0xda, 0x0d, 0x7f, 0x49, 0x3f, 0xf5, 0x86,
-	0x82, 0x5a, 0x48, 0x73, 0x2e, 0x44, 0x4f, 0xfa, 0x05, 0x1a, 0x8e, 0x2b, 0x76, 0xa0, 0x7d, 0x30,
-	0xd0, 0xf6, 0x01, 0xb5, 0xa5, 0xc5, 0x49, 0x4c, 0xdb, 0xd6, 0x53, 0x0d, 0xfa, 0xdb, 0xbd, 0x4d,
-	0x46, 0xe2, 0x8e, 0x52, 0x4a, 0xa1, 0x77, 0xcd, 0xcf, 0x5e, 0xcb, 0x6a, 0x8a, 0x1d, 0xe8, 0x51,
-	0x18, 0x5a, 0x6f, 0xaa, 0xdf, 0x1a, 0x77, 0xd4, 0xc6, 0x40, 0x10, 0xd7, 0x9c, 0x05, 0x83, 0x96,
-	0x2b, 0xef, 0x39, 0x7c, 0x18, 0xd7, 0xc9, 0x1a, 0xe5, 0x7e, 0x98, 0xff, 0x83, 0x4d, 0xad, 0xe3,
-	0xf4, 0xf0, 0x3a, 0x94, 0x2d, 0x56, 0x85, 0xfe, 0x14, 0xd4, 0x69, 0xdd, 0x25, 0x62, 0x47, 0xc8,
-	0x7b, 0x7c, 0x52, 0x4d, 0xf0, 0x1e, 0x53, 0xb4, 0x65, 0x6b, 0x11, 0x50, 0x9b, 0xf9, 0x4f, 0x88,
-	0xbb, 0x48, 0xea, 0xee, 0x12, 0xde, 0x41, 0x06, 0x2f, 0x39, 0x70, 0x0d, 0xb7, 0xba, 0x0d, 0x54,
-	0xc2, 0xf8, 0xfa, 0x5e, 0x03, 0x13, 0xb1, 0x03, 0x2d, 0x83, 0xf0, 0x3b, 0x93, 0x8b, 0xd8, 0xea,
-	0x3d, 0x6d, 0x23, 0x6c, 0x5f, 0xff, 0x98, 0xff, 0x5b, 0x9e, 0x19, 0x47, 0xf0, 0x10, 0x0c, 0xb6,
-	0x9f, 0x3e, 0x46, 0x5b, 0xcf, 0x89, 0xa9, 0x5b, 0x73, 0x69, 0x8e, 0x7d, 0xa2, 0xf8, 0xd0, 0x30,
-	0x94, 0xce, 0xa1, 0x70, 0xf3, 0xdd, 0x91, 0x3b, 0xc3, 0xb2, 0xb3, 0xdd, 0x9d, 0x9d, 0xc8, 0xce,
-	0x36, 0x06, 0x6d, 0x93, 0xe0, 0x61, 0x56, 0x7c, 0x2d, 0x57, 0x5c, 0xa2, 0xf8, 0xd2, 0xda, 0xbb,
-	0xfa, 0x64, 0x7b, 0x67, 0xbf, 0xbf, 0x51, 0xe6, 0x2e, 0xde, 0x2c, 0x73, 0x57, 0x6e, 0x96, 0xb9,
-	0x2f, 0x6f, 0x96, 0xb9, 0x47, 0xfe, 0x1d, 0xfb, 0x6f, 0x31, 0xc1, 0x8a, 0xa1, 0x39, 0x4a, 0xf4,
-	0x63, 0xa7, 0x8b, 0x9d, 0x23, 0xd8, 0x99, 0x54, 0x6c, 0x7b, 0xd2, 0xf7, 0x8a, 0x4d, 0x0d, 0x3b,
-	0x93, 0x86, 0xab, 0x85, 0x7f, 0x4b, 0x39, 0x7a, 0xe4, 0xae, 0xdf, 0x02, 0x00, 0x00, 0xff, 0xff,
-	0x69, 0x2e, 0x6c, 0x04, 0x88, 0x16, 0x00, 0x00,
+func (m *TLMsgGetScheduledHistory) Reset()         { *m = TLMsgGetScheduledHistory{} }
+func (m *TLMsgGetScheduledHistory) String() string { return proto.CompactTextString(m) }
+func (*TLMsgGetScheduledHistory) ProtoMessage()    {}
+func (*TLMsgGetScheduledHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb6f24e718b1b713, []int{19}
 }
-
-func (this *Sender) GoString() string {
-	if this == nil {
-		return "nil"
+func (m *TLMsgGetScheduledHistory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLMsgGetScheduledHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLMsgGetScheduledHistory.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	s := make([]string, 0, 9)
-	s = append(s, "&msg.Sender{")
-	s = append(s, "PredicateName: "+fmt.Sprintf("%#v", this.PredicateName)+",\n")
-	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
-	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
-	s = append(s, "Type: "+fmt.Sprintf("%#v", this.Type)+",\n")
-	s = append(s, "AuthKeyId: "+fmt.Sprintf("%#v", this.AuthKeyId)+",\n")
-	if this.XXX_unrecognized != nil {
-		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+}
+func (m *TLMsgGetScheduledHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLMsgGetScheduledHistory.Merge(m, src)
+}
+func (m *TLMsgGetScheduledHistory) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLMsgGetScheduledHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLMsgGetScheduledHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLMsgGetScheduledHistory proto.InternalMessageInfo
+
+func (m *TLMsgGetScheduledHistory) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return CRC32_UNKNOWN
 }
-func (this *TLSender) GoString() string {
-	if this == nil {
-		return "nil"
+
+func (m *TLMsgGetScheduledHistory) GetUserId() int64 {
+	if m != nil {
+		return m.UserId
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&msg.TLSender{")
-	if this.Data2 != nil {
-		s = append(s, "Data2: "+fmt.Sprintf("%#v", this.Data2)+",\n")
+	return 0
+}
+
+func (m *TLMsgGetScheduledHistory) GetPeerType() int32 {
+	if m != nil {
+		return m.PeerType
 	}
-	if this.XXX_unrecognized != nil {
-		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	return 0
+}
+
+func (m *TLMsgGetScheduledHistory) GetPeerId() int64 {
+	if m != nil {
+		return m.PeerId
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return 0
 }
-func (this *OutboxMessage) GoString() string {
-	if this == nil {
-		return "nil"
+
+//--------------------------------------------------------------------------------------------
+// msg.getScheduledMessages user_id:long peer_type:int peer_id:long id:Vector<int> = Vector<Message>;
+type TLMsgGetScheduledMessages struct {
+	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=msg.TLConstructor" json:"constructor,omitempty"`
+	UserId               int64         `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PeerType             int32         `protobuf:"varint,4,opt,name=peer_type,json=peerType,proto3" json:"peer_type,omitempty"`
+	PeerId               int64         `protobuf:"varint,5,opt,name=peer_id,json=peerId,proto3" json:"peer_id,omitempty"`
+	Id                   []int32       `protobuf:"varint,6,rep,packed,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *TLMsgGetScheduledMessages) Reset()         { *m = TLMsgGetScheduledMessages{} }
+func (m *TLMsgGetScheduledMessages) String() string { return proto.CompactTextString(m) }
+func (*TLMsgGetScheduledMessages) ProtoMessage()    {}
+func (*TLMsgGetScheduledMessages) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb6f24e718b1b713, []int{20}
+}
+func (m *TLMsgGetScheduledMessages) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLMsgGetScheduledMessages) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLMsgGetScheduledMessages.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	s := make([]string, 0, 11)
-	s = append(s, "&msg.OutboxMessage{")
-	s = append(s, "PredicateName: "+fmt.Sprintf("%#v", this.PredicateName)+",\n")
-	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
-	s = append(s, "NoWebpage: "+fmt.Sprintf("%#v", this.NoWebpage)+",\n")
-	s = append(s, "Background: "+fmt.Sprintf("%#v", this.Background)+",\n")
-	s = append(s, "RandomId: "+fmt.Sprintf("%#v", this.RandomId)+",\n")
-	if this.Message != nil {
-		s = append(s, "Message: "+fmt.Sprintf("%#v", this.Message)+",\n")
+}
+func (m *TLMsgGetScheduledMessages) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLMsgGetScheduledMessages.Merge(m, src)
+}
+func (m *TLMsgGetScheduledMessages) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLMsgGetScheduledMessages) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLMsgGetScheduledMessages.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLMsgGetScheduledMessages proto.InternalMessageInfo
+
+func (m *TLMsgGetScheduledMessages) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
 	}
-	if this.ScheduleDate != nil {
-		s = append(s, "ScheduleDate: "+fmt.Sprintf("%#v", this.ScheduleDate)+",\n")
+	return CRC32_UNKNOWN
+}
+
+func (m *TLMsgGetScheduledMessages) GetUserId() int64 {
+	if m != nil {
+		return m.UserId
 	}
-	if this.XXX_unrecognized != nil {
-		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	return 0
+}
+
+func (m *TLMsgGetScheduledMessages) GetPeerType() int32 {
+	if m != nil {
+		return m.PeerType
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return 0
 }
-func (this *TLOutboxMessage) GoString() string {
-	if this == nil {
-		return "nil"
+
+func (m *TLMsgGetScheduledMessages) GetPeerId() int64 {
+	if m != nil {
+		return m.PeerId
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&msg.TLOutboxMessage{")
-	if this.Data2 != nil {
-		s = append(s, "Data2: "+fmt.Sprintf("%#v", this.Data2)+",\n")
+	return 0
+}
+
+func (m *TLMsgGetScheduledMessages) GetId() []int32 {
+	if m != nil {
+		return m.Id
 	}
-	if this.XXX_unrecognized != nil {
-		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	return nil
+}
+
+//--------------------------------------------------------------------------------------------
+// msg.sendScheduledMessages user_id:long auth_key_id:long peer_type:int peer_id:long id:Vector<int> = Updates;
+type TLMsgSendScheduledMessages struct {
+	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=msg.TLConstructor" json:"constructor,omitempty"`
+	UserId               int64         `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	AuthKeyId            int64         `protobuf:"varint,4,opt,name=auth_key_id,json=authKeyId,proto3" json:"auth_key_id,omitempty"`
+	PeerType             int32         `protobuf:"varint,5,opt,name=peer_type,json=peerType,proto3" json:"peer_type,omitempty"`
+	PeerId               int64         `protobuf:"varint,6,opt,name=peer_id,json=peerId,proto3" json:"peer_id,omitempty"`
+	Id                   []int32       `protobuf:"varint,7,rep,packed,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *TLMsgSendScheduledMessages) Reset()         { *m = TLMsgSendScheduledMessages{} }
+func (m *TLMsgSendScheduledMessages) String() string { return proto.CompactTextString(m) }
+func (*TLMsgSendScheduledMessages) ProtoMessage()    {}
+func (*TLMsgSendScheduledMessages) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb6f24e718b1b713, []int{21}
+}
+func (m *TLMsgSendScheduledMessages) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLMsgSendScheduledMessages) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLMsgSendScheduledMessages.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
 }
-func (this *ContentMessage) GoString() string {
-	if this == nil {
-		return "nil"
+func (m *TLMsgSendScheduledMessages) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLMsgSendScheduledMessages.Merge(m, src)
+}
+func (m *TLMsgSendScheduledMessages) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLMsgSendScheduledMessages) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLMsgSendScheduledMessages.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLMsgSendScheduledMessages proto.InternalMessageInfo
+
+func (m *TLMsgSendScheduledMessages) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
 	}
-	s := make([]string, 0, 10)
-	s = append(s, "&msg.ContentMessage{")
-	s = append(s, "PredicateName: "+fmt.Sprintf("%#v", this.PredicateName)+",\n")
-	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
-	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
-	s = append(s, "Mentioned: "+fmt.Sprintf("%#v", this.Mentioned)+",\n")
-	s = append(s, "MediaUnread: "+fmt.Sprintf("%#v", this.MediaUnread)+",\n")
-	s = append(s, "Reaction: "+fmt.Sprintf("%#v", this.Reaction)+",\n")
-	if this.XXX_unrecognized != nil {
-		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	return CRC32_UNKNOWN
+}
+
+func (m *TLMsgSendScheduledMessages) GetUserId() int64 {
+	if m != nil {
+		return m.UserId
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return 0
 }
-func (this *TLContentMessage) GoString() string {
-	if this == nil {
-		return "nil"
+
+func (m *TLMsgSendScheduledMessages) GetAuthKeyId() int64 {
+	if m != nil {
+		return m.AuthKeyId
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&msg.TLContentMessage{")
-	if this.Data2 != nil {
-		s = append(s, "Data2: "+fmt.Sprintf("%#v", this.Data2)+",\n")
+	return 0
+}
+
+func (m *TLMsgSendScheduledMessages) GetPeerType() int32 {
+	if m != nil {
+		return m.PeerType
 	}
-	if this.XXX_unrecognized != nil {
-		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	return 0
+}
+
+func (m *TLMsgSendScheduledMessages) GetPeerId() int64 {
+	if m != nil {
+		return m.PeerId
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return 0
 }
-func (this *TLMsgSendMessage) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := make([]string, 0, 10)
-	s = append(s, "&msg.TLMsgSendMessage{")
-	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
-	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
-	s = append(s, "AuthKeyId: "+fmt.Sprintf("%#v", this.AuthKeyId)+",\n")
-	s = append(s, "PeerType: "+fmt.Sprintf("%#v", this.PeerType)+",\n")
-	s = append(s, "PeerId: "+fmt.Sprintf("%#v", this.PeerId)+",\n")
-	if this.Message != nil {
-		s = append(s, "Message: "+fmt.Sprintf("%#v", this.Message)+",\n")
-	}
-	if this.XXX_unrecognized != nil {
-		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+
+func (m *TLMsgSendScheduledMessages) GetId() []int32 {
+	if m != nil {
+		return m.Id
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return nil
 }
-func (this *TLMsgSendMultiMessage) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := make([]string, 0, 10)
-	s = append(s, "&msg.TLMsgSendMultiMessage{")
-	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
-	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
-	s = append(s, "AuthKeyId: "+fmt.Sprintf("%#v", this.AuthKeyId)+",\n")
-	s = append(s, "PeerType: "+fmt.Sprintf("%#v", this.PeerType)+",\n")
-	s = append(s, "PeerId: "+fmt.Sprintf("%#v", this.PeerId)+",\n")
-	if this.Message != nil {
-		s = append(s, "Message: "+fmt.Sprintf("%#v", this.Message)+",\n")
-	}
-	if this.XXX_unrecognized != nil {
-		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
-	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+
+//--------------------------------------------------------------------------------------------
+// msg.deleteScheduledMessages user_id:long auth_key_id:long peer_type:int peer_id:long id:Vector<int> = Updates;
+type TLMsgDeleteScheduledMessages struct {
+	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=msg.TLConstructor" json:"constructor,omitempty"`
+	UserId               int64         `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	AuthKeyId            int64         `protobuf:"varint,4,opt,name=auth_key_id,json=authKeyId,proto3" json:"auth_key_id,omitempty"`
+	PeerType             int32         `protobuf:"varint,5,opt,name=peer_type,json=peerType,proto3" json:"peer_type,omitempty"`
+	PeerId               int64         `protobuf:"varint,6,opt,name=peer_id,json=peerId,proto3" json:"peer_id,omitempty"`
+	Id                   []int32       `protobuf:"varint,7,rep,packed,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
-func (this *TLMsgPushUserMessage) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := make([]string, 0, 11)
-	s = append(s, "&msg.TLMsgPushUserMessage{")
-	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
-	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
-	s = append(s, "AuthKeyId: "+fmt.Sprintf("%#v", this.AuthKeyId)+",\n")
-	s = append(s, "PeerType: "+fmt.Sprintf("%#v", this.PeerType)+",\n")
-	s = append(s, "PeerId: "+fmt.Sprintf("%#v", this.PeerId)+",\n")
-	s = append(s, "PushType: "+fmt.Sprintf("%#v", this.PushType)+",\n")
-	if this.Message != nil {
-		s = append(s, "Message: "+fmt.Sprintf("%#v", this.Message)+",\n")
-	}
-	if this.XXX_unrecognized != nil {
-		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
-	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+
+func (m *TLMsgDeleteScheduledMessages) Reset()         { *m = TLMsgDeleteScheduledMessages{} }
+func (m *TLMsgDeleteScheduledMessages) String() string { return proto.CompactTextString(m) }
+func (*TLMsgDeleteScheduledMessages) ProtoMessage()    {}
+func (*TLMsgDeleteScheduledMessages) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb6f24e718b1b713, []int{22}
 }
-func (this *TLMsgReadMessageContents) GoString() string {
-	if this == nil {
-		return "nil"
+func (m *TLMsgDeleteScheduledMessages) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLMsgDeleteScheduledMessages) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLMsgDeleteScheduledMessages.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	s := make([]string, 0, 10)
-	s = append(s, "&msg.TLMsgReadMessageContents{")
-	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
-	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
-	s = append(s, "AuthKeyId: "+fmt.Sprintf("%#v", this.AuthKeyId)+",\n")
-	s = append(s, "PeerType: "+fmt.Sprintf("%#v", this.PeerType)+",\n")
-	s = append(s, "PeerId: "+fmt.Sprintf("%#v", this.PeerId)+",\n")
-	if this.Id != nil {
-		s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
+}
+func (m *TLMsgDeleteScheduledMessages) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLMsgDeleteScheduledMessages.Merge(m, src)
+}
+func (m *TLMsgDeleteScheduledMessages) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLMsgDeleteScheduledMessages) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLMsgDeleteScheduledMessages.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLMsgDeleteScheduledMessages proto.InternalMessageInfo
+
+func (m *TLMsgDeleteScheduledMessages) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
 	}
-	if this.XXX_unrecognized != nil {
-		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	return CRC32_UNKNOWN
+}
+
+func (m *TLMsgDeleteScheduledMessages) GetUserId() int64 {
+	if m != nil {
+		return m.UserId
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return 0
 }
-func (this *TLMsgSendMessageV2) GoString() string {
-	if this == nil {
-		return "nil"
+
+func (m *TLMsgDeleteScheduledMessages) GetAuthKeyId() int64 {
+	if m != nil {
+		return m.AuthKeyId
 	}
-	s := make([]string, 0, 10)
-	s = append(s, "&msg.TLMsgSendMessageV2{")
-	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
-	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
-	s = append(s, "AuthKeyId: "+fmt.Sprintf("%#v", this.AuthKeyId)+",\n")
-	s = append(s, "PeerType: "+fmt.Sprintf("%#v", this.PeerType)+",\n")
-	s = append(s, "PeerId: "+fmt.Sprintf("%#v", this.PeerId)+",\n")
-	if this.Message != nil {
-		s = append(s, "Message: "+fmt.Sprintf("%#v", this.Message)+",\n")
+	return 0
+}
+
+func (m *TLMsgDeleteScheduledMessages) GetPeerType() int32 {
+	if m != nil {
+		return m.PeerType
 	}
-	if this.XXX_unrecognized != nil {
-		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	return 0
+}
+
+func (m *TLMsgDeleteScheduledMessages) GetPeerId() int64 {
+	if m != nil {
+		return m.PeerId
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return 0
 }
-func (this *TLMsgEditMessage) GoString() string {
-	if this == nil {
-		return "nil"
+
+func (m *TLMsgDeleteScheduledMessages) GetId() []int32 {
+	if m != nil {
+		return m.Id
 	}
-	s := make([]string, 0, 10)
-	s = append(s, "&msg.TLMsgEditMessage{")
-	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
-	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
-	s = append(s, "AuthKeyId: "+fmt.Sprintf("%#v", this.AuthKeyId)+",\n")
-	s = append(s, "PeerType: "+fmt.Sprintf("%#v", this.PeerType)+",\n")
-	s = append(s, "PeerId: "+fmt.Sprintf("%#v", this.PeerId)+",\n")
-	if this.Message != nil {
-		s = append(s, "Message: "+fmt.Sprintf("%#v", this.Message)+",\n")
+	return nil
+}
+
+//--------------------------------------------------------------------------------------------
+// msg.sendReaction flags:# user_id:long auth_key_id:long big:flags.1?true peer_type:int peer_id:long msg_id:int reaction:flags.0?string = Updates;
+type TLMsgSendReaction struct {
+	Constructor          TLConstructor      `protobuf:"varint,1,opt,name=constructor,proto3,enum=msg.TLConstructor" json:"constructor,omitempty"`
+	UserId               int64              `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	AuthKeyId            int64              `protobuf:"varint,4,opt,name=auth_key_id,json=authKeyId,proto3" json:"auth_key_id,omitempty"`
+	Big                  bool               `protobuf:"varint,5,opt,name=big,proto3" json:"big,omitempty"`
+	PeerType             int32              `protobuf:"varint,6,opt,name=peer_type,json=peerType,proto3" json:"peer_type,omitempty"`
+	PeerId               int64              `protobuf:"varint,7,opt,name=peer_id,json=peerId,proto3" json:"peer_id,omitempty"`
+	MsgId                int32              `protobuf:"varint,8,opt,name=msg_id,json=msgId,proto3" json:"msg_id,omitempty"`
+	Reaction             *types.StringValue `protobuf:"bytes,9,opt,name=reaction,proto3" json:"reaction,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *TLMsgSendReaction) Reset()         { *m = TLMsgSendReaction{} }
+func (m *TLMsgSendReaction) String() string { return proto.CompactTextString(m) }
+func (*TLMsgSendReaction) ProtoMessage()    {}
+func (*TLMsgSendReaction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb6f24e718b1b713, []int{23}
+}
+func (m *TLMsgSendReaction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLMsgSendReaction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLMsgSendReaction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if this.XXX_unrecognized != nil {
-		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+}
+func (m *TLMsgSendReaction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLMsgSendReaction.Merge(m, src)
+}
+func (m *TLMsgSendReaction) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLMsgSendReaction) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLMsgSendReaction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLMsgSendReaction proto.InternalMessageInfo
+
+func (m *TLMsgSendReaction) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return CRC32_UNKNOWN
 }
-func (this *TLMsgDeleteMessages) GoString() string {
-	if this == nil {
-		return "nil"
+
+func (m *TLMsgSendReaction) GetUserId() int64 {
+	if m != nil {
+		return m.UserId
 	}
-	s := make([]string, 0, 11)
-	s = append(s, "&msg.TLMsgDeleteMessages{")
-	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
-	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
-	s = append(s, "AuthKeyId: "+fmt.Sprintf("%#v", this.AuthKeyId)+",\n")
-	s = append(s, "PeerType: "+fmt.Sprintf("%#v", this.PeerType)+",\n")
-	s = append(s, "PeerId: "+fmt.Sprintf("%#v", this.PeerId)+",\n")
-	s = append(s, "Revoke: "+fmt.Sprintf("%#v", this.Revoke)+",\n")
-	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
-	if this.XXX_unrecognized != nil {
-		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	return 0
+}
+
+func (m *TLMsgSendReaction) GetAuthKeyId() int64 {
+	if m != nil {
+		return m.AuthKeyId
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return 0
 }
-func (this *TLMsgDeleteHistory) GoString() string {
-	if this == nil {
-		return "nil"
+
+func (m *TLMsgSendReaction) GetBig() bool {
+	if m != nil {
+		return m.Big
 	}
-	s := make([]string, 0, 12)
-	s = append(s, "&msg.TLMsgDeleteHistory{")
-	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
-	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
-	s = append(s, "AuthKeyId: "+fmt.Sprintf("%#v", this.AuthKeyId)+",\n")
-	s = append(s, "PeerType: "+fmt.Sprintf("%#v", this.PeerType)+",\n")
-	s = append(s, "PeerId: "+fmt.Sprintf("%#v", this.PeerId)+",\n")
-	s = append(s, "JustClear: "+fmt.Sprintf("%#v", this.JustClear)+",\n")
-	s = append(s, "Revoke: "+fmt.Sprintf("%#v", this.Revoke)+",\n")
-	s = append(s, "MaxId: "+fmt.Sprintf("%#v", this.MaxId)+",\n")
-	if this.XXX_unrecognized != nil {
-		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	return false
+}
+
+func (m *TLMsgSendReaction) GetPeerType() int32 {
+	if m != nil {
+		return m.PeerType
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return 0
 }
-func (this *TLMsgDeletePhoneCallHistory) GoString() string {
-	if this == nil {
-		return "nil"
+
+func (m *TLMsgSendReaction) GetPeerId() int64 {
+	if m != nil {
+		return m.PeerId
 	}
-	s := make([]string, 0, 8)
-	s = append(s, "&msg.TLMsgDeletePhoneCallHistory{")
-	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
-	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
-	s = append(s, "AuthKeyId: "+fmt.Sprintf("%#v", this.AuthKeyId)+",\n")
-	s = append(s, "Revoke: "+fmt.Sprintf("%#v", this.Revoke)+",\n")
-	if this.XXX_unrecognized != nil {
-		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	return 0
+}
+
+func (m *TLMsgSendReaction) GetMsgId() int32 {
+	if m != nil {
+		return m.MsgId
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return 0
 }
-func (this *TLMsgDeleteChatHistory) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := make([]string, 0, 7)
-	s = append(s, "&msg.TLMsgDeleteChatHistory{")
-	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
-	s = append(s, "ChatId: "+fmt.Sprintf("%#v", this.ChatId)+",\n")
-	s = append(s, "DeleteUserId: "+fmt.Sprintf("%#v", this.DeleteUserId)+",\n")
-	if this.XXX_unrecognized != nil {
-		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+
+func (m *TLMsgSendReaction) GetReaction() *types.StringValue {
+	if m != nil {
+		return m.Reaction
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return nil
 }
-func (this *TLMsgReadHistory) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := make([]string, 0, 10)
-	s = append(s, "&msg.TLMsgReadHistory{")
-	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
-	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
-	s = append(s, "AuthKeyId: "+fmt.Sprintf("%#v", this.AuthKeyId)+",\n")
-	s = append(s, "PeerType: "+fmt.Sprintf("%#v", this.PeerType)+",\n")
-	s = append(s, "PeerId: "+fmt.Sprintf("%#v", this.PeerId)+",\n")
-	s = append(s, "MaxId: "+fmt.Sprintf("%#v", this.MaxId)+",\n")
-	if this.XXX_unrecognized != nil {
-		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
-	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+
+//--------------------------------------------------------------------------------------------
+// msg.readReactions user_id:long auth_key_id:long peer_type:int peer_id:long = messages.AffectedHistory;
+type TLMsgReadReactions struct {
+	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=msg.TLConstructor" json:"constructor,omitempty"`
+	UserId               int64         `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	AuthKeyId            int64         `protobuf:"varint,4,opt,name=auth_key_id,json=authKeyId,proto3" json:"auth_key_id,omitempty"`
+	PeerType             int32         `protobuf:"varint,5,opt,name=peer_type,json=peerType,proto3" json:"peer_type,omitempty"`
+	PeerId               int64         `protobuf:"varint,6,opt,name=peer_id,json=peerId,proto3" json:"peer_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
-func (this *TLMsgUpdatePinnedMessage) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := make([]string, 0, 13)
-	s = append(s, "&msg.TLMsgUpdatePinnedMessage{")
-	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
-	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
-	s = append(s, "AuthKeyId: "+fmt.Sprintf("%#v", this.AuthKeyId)+",\n")
-	s = append(s, "Silent: "+fmt.Sprintf("%#v", this.Silent)+",\n")
-	s = append(s, "Unpin: "+fmt.Sprintf("%#v", this.Unpin)+",\n")
-	s = append(s, "PmOneside: "+fmt.Sprintf("%#v", this.PmOneside)+",\n")
-	s = append(s, "PeerType: "+fmt.Sprintf("%#v", this.PeerType)+",\n")
-	s = append(s, "PeerId: "+fmt.Sprintf("%#v", this.PeerId)+",\n")
-	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
-	if this.XXX_unrecognized != nil {
-		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
-	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+
+func (m *TLMsgReadReactions) Reset()         { *m = TLMsgReadReactions{} }
+func (m *TLMsgReadReactions) String() string { return proto.CompactTextString(m) }
+func (*TLMsgReadReactions) ProtoMessage()    {}
+func (*TLMsgReadReactions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb6f24e718b1b713, []int{24}
 }
-func (this *TLMsgUnpinAllMessages) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := make([]string, 0, 9)
-	s = append(s, "&msg.TLMsgUnpinAllMessages{")
-	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
-	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
-	s = append(s, "AuthKeyId: "+fmt.Sprintf("%#v", this.AuthKeyId)+",\n")
-	s = append(s, "PeerType: "+fmt.Sprintf("%#v", this.PeerType)+",\n")
-	s = append(s, "PeerId: "+fmt.Sprintf("%#v", this.PeerId)+",\n")
-	if this.XXX_unrecognized != nil {
-		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
-	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+func (m *TLMsgReadReactions) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func valueToGoStringMsgTl(v interface{}, typ string) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
+func (m *TLMsgReadReactions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLMsgReadReactions.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// RPCMsgClient is the client API for RPCMsg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type RPCMsgClient interface {
-	MsgSendMessage(ctx context.Context, in *TLMsgSendMessage, opts ...grpc.CallOption) (*mtproto.Updates, error)
-	MsgSendMultiMessage(ctx context.Context, in *TLMsgSendMultiMessage, opts ...grpc.CallOption) (*mtproto.Updates, error)
-	MsgPushUserMessage(ctx context.Context, in *TLMsgPushUserMessage, opts ...grpc.CallOption) (*mtproto.Bool, error)
-	MsgReadMessageContents(ctx context.Context, in *TLMsgReadMessageContents, opts ...grpc.CallOption) (*mtproto.Messages_AffectedMessages, error)
-	MsgSendMessageV2(ctx context.Context, in *TLMsgSendMessageV2, opts ...grpc.CallOption) (*mtproto.UpdateList, error)
-	MsgEditMessage(ctx context.Context, in *TLMsgEditMessage, opts ...grpc.CallOption) (*mtproto.Updates, error)
-	MsgDeleteMessages(ctx context.Context, in *TLMsgDeleteMessages, opts ...grpc.CallOption) (*mtproto.Messages_AffectedMessages, error)
-	MsgDeleteHistory(ctx context.Context, in *TLMsgDeleteHistory, opts ...grpc.CallOption) (*mtproto.Messages_AffectedHistory, error)
-	MsgDeletePhoneCallHistory(ctx context.Context, in *TLMsgDeletePhoneCallHistory, opts ...grpc.CallOption) (*mtproto.Messages_AffectedFoundMessages, error)
-	MsgDeleteChatHistory(ctx context.Context, in *TLMsgDeleteChatHistory, opts ...grpc.CallOption) (*mtproto.Bool, error)
-	MsgReadHistory(ctx context.Context, in *TLMsgReadHistory, opts ...grpc.CallOption) (*mtproto.Messages_AffectedMessages, error)
-	MsgUpdatePinnedMessage(ctx context.Context, in *TLMsgUpdatePinnedMessage, opts ...grpc.CallOption) (*mtproto.Updates, error)
-	MsgUnpinAllMessages(ctx context.Context, in *TLMsgUnpinAllMessages, opts ...grpc.CallOption) (*mtproto.Messages_AffectedHistory, error)
+func (m *TLMsgReadReactions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLMsgReadReactions.Merge(m, src)
+}
+func (m *TLMsgReadReactions) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLMsgReadReactions) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLMsgReadReactions.DiscardUnknown(m)
 }
 
-type rPCMsgClient struct {
-	cc *grpc.ClientConn
+var xxx_messageInfo_TLMsgReadReactions proto.InternalMessageInfo
+
+func (m *TLMsgReadReactions) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
+	}
+	return CRC32_UNKNOWN
 }
 
-func NewRPCMsgClient(cc *grpc.ClientConn) RPCMsgClient {
-	return &rPCMsgClient{cc}
+func (m *TLMsgReadReactions) GetUserId() int64 {
+	if m != nil {
+		return m.UserId
+	}
+	return 0
 }
 
-func (c *rPCMsgClient) MsgSendMessage(ctx context.Context, in *TLMsgSendMessage, opts ...grpc.CallOption) (*mtproto.Updates, error) {
-	out := new(mtproto.Updates)
-	err := c.cc.Invoke(ctx, "/msg.RPCMsg/msg_sendMessage", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *TLMsgReadReactions) GetAuthKeyId() int64 {
+	if m != nil {
+		return m.AuthKeyId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *rPCMsgClient) MsgSendMultiMessage(ctx context.Context, in *TLMsgSendMultiMessage, opts ...grpc.CallOption) (*mtproto.Updates, error) {
-	out := new(mtproto.Updates)
-	err := c.cc.Invoke(ctx, "/msg.RPCMsg/msg_sendMultiMessage", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *TLMsgReadReactions) GetPeerType() int32 {
+	if m != nil {
+		return m.PeerType
 	}
-	return out, nil
+	return 0
 }
 
-func (c *rPCMsgClient) MsgPushUserMessage(ctx context.Context, in *TLMsgPushUserMessage, opts ...grpc.CallOption) (*mtproto.Bool, error) {
-	out := new(mtproto.Bool)
-	err := c.cc.Invoke(ctx, "/msg.RPCMsg/msg_pushUserMessage", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *TLMsgReadReactions) GetPeerId() int64 {
+	if m != nil {
+		return m.PeerId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *rPCMsgClient) MsgReadMessageContents(ctx context.Context, in *TLMsgReadMessageContents, opts ...grpc.CallOption) (*mtproto.Messages_AffectedMessages, error) {
-	out := new(mtproto.Messages_AffectedMessages)
-	err := c.cc.Invoke(ctx, "/msg.RPCMsg/msg_readMessageContents", in, out, opts...)
-	if err != nil {
-		return nil, err
+//--------------------------------------------------------------------------------------------
+// msg.getMessageReactionsList flags:# user_id:long peer_type:int peer_id:long msg_id:int reaction:flags.0?string offset:flags.1?string limit:int = messages.MessageReactionsList;
+type TLMsgGetMessageReactionsList struct {
+	Constructor          TLConstructor      `protobuf:"varint,1,opt,name=constructor,proto3,enum=msg.TLConstructor" json:"constructor,omitempty"`
+	UserId               int64              `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PeerType             int32              `protobuf:"varint,4,opt,name=peer_type,json=peerType,proto3" json:"peer_type,omitempty"`
+	PeerId               int64              `protobuf:"varint,5,opt,name=peer_id,json=peerId,proto3" json:"peer_id,omitempty"`
+	MsgId                int32              `protobuf:"varint,6,opt,name=msg_id,json=msgId,proto3" json:"msg_id,omitempty"`
+	Reaction             *types.StringValue `protobuf:"bytes,7,opt,name=reaction,proto3" json:"reaction,omitempty"`
+	Offset               *types.StringValue `protobuf:"bytes,8,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit                int32              `protobuf:"varint,9,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *TLMsgGetMessageReactionsList) Reset()         { *m = TLMsgGetMessageReactionsList{} }
+func (m *TLMsgGetMessageReactionsList) String() string { return proto.CompactTextString(m) }
+func (*TLMsgGetMessageReactionsList) ProtoMessage()    {}
+func (*TLMsgGetMessageReactionsList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb6f24e718b1b713, []int{25}
+}
+func (m *TLMsgGetMessageReactionsList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLMsgGetMessageReactionsList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLMsgGetMessageReactionsList.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *TLMsgGetMessageReactionsList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLMsgGetMessageReactionsList.Merge(m, src)
+}
+func (m *TLMsgGetMessageReactionsList) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLMsgGetMessageReactionsList) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLMsgGetMessageReactionsList.DiscardUnknown(m)
 }
 
-func (c *rPCMsgClient) MsgSendMessageV2(ctx context.Context, in *TLMsgSendMessageV2, opts ...grpc.CallOption) (*mtproto.UpdateList, error) {
-	out := new(mtproto.UpdateList)
-	err := c.cc.Invoke(ctx, "/msg.RPCMsg/msg_sendMessageV2", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_TLMsgGetMessageReactionsList proto.InternalMessageInfo
+
+func (m *TLMsgGetMessageReactionsList) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
 	}
-	return out, nil
+	return CRC32_UNKNOWN
 }
 
-func (c *rPCMsgClient) MsgEditMessage(ctx context.Context, in *TLMsgEditMessage, opts ...grpc.CallOption) (*mtproto.Updates, error) {
-	out := new(mtproto.Updates)
-	err := c.cc.Invoke(ctx, "/msg.RPCMsg/msg_editMessage", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *TLMsgGetMessageReactionsList) GetUserId() int64 {
+	if m != nil {
+		return m.UserId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *rPCMsgClient) MsgDeleteMessages(ctx context.Context, in *TLMsgDeleteMessages, opts ...grpc.CallOption) (*mtproto.Messages_AffectedMessages, error) {
-	out := new(mtproto.Messages_AffectedMessages)
-	err := c.cc.Invoke(ctx, "/msg.RPCMsg/msg_deleteMessages", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *TLMsgGetMessageReactionsList) GetPeerType() int32 {
+	if m != nil {
+		return m.PeerType
 	}
-	return out, nil
+	return 0
 }
 
-func (c *rPCMsgClient) MsgDeleteHistory(ctx context.Context, in *TLMsgDeleteHistory, opts ...grpc.CallOption) (*mtproto.Messages_AffectedHistory, error) {
-	out := new(mtproto.Messages_AffectedHistory)
-	err := c.cc.Invoke(ctx, "/msg.RPCMsg/msg_deleteHistory", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *TLMsgGetMessageReactionsList) GetPeerId() int64 {
+	if m != nil {
+		return m.PeerId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *rPCMsgClient) MsgDeletePhoneCallHistory(ctx context.Context, in *TLMsgDeletePhoneCallHistory, opts ...grpc.CallOption) (*mtproto.Messages_AffectedFoundMessages, error) {
-	out := new(mtproto.Messages_AffectedFoundMessages)
-	err := c.cc.Invoke(ctx, "/msg.RPCMsg/msg_deletePhoneCallHistory", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *TLMsgGetMessageReactionsList) GetMsgId() int32 {
+	if m != nil {
+		return m.MsgId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *rPCMsgClient) MsgDeleteChatHistory(ctx context.Context, in *TLMsgDeleteChatHistory, opts ...grpc.CallOption) (*mtproto.Bool, error) {
-	out := new(mtproto.Bool)
-	err := c.cc.Invoke(ctx, "/msg.RPCMsg/msg_deleteChatHistory", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *TLMsgGetMessageReactionsList) GetReaction() *types.StringValue {
+	if m != nil {
+		return m.Reaction
 	}
-	return out, nil
+	return nil
 }
 
-func (c *rPCMsgClient) MsgReadHistory(ctx context.Context, in *TLMsgReadHistory, opts ...grpc.CallOption) (*mtproto.Messages_AffectedMessages, error) {
-	out := new(mtproto.Messages_AffectedMessages)
-	err := c.cc.Invoke(ctx, "/msg.RPCMsg/msg_readHistory", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *TLMsgGetMessageReactionsList) GetOffset() *types.StringValue {
+	if m != nil {
+		return m.Offset
 	}
-	return out, nil
+	return nil
 }
 
-func (c *rPCMsgClient) MsgUpdatePinnedMessage(ctx context.Context, in *TLMsgUpdatePinnedMessage, opts ...grpc.CallOption) (*mtproto.Updates, error) {
-	out := new(mtproto.Updates)
-	err := c.cc.Invoke(ctx, "/msg.RPCMsg/msg_updatePinnedMessage", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *TLMsgGetMessageReactionsList) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
 	}
-	return out, nil
+	return 0
 }
 
-func (c *rPCMsgClient) MsgUnpinAllMessages(ctx context.Context, in *TLMsgUnpinAllMessages, opts ...grpc.CallOption) (*mtproto.Messages_AffectedHistory, error) {
-	out := new(mtproto.Messages_AffectedHistory)
-	err := c.cc.Invoke(ctx, "/msg.RPCMsg/msg_unpinAllMessages", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-// RPCMsgServer is the server API for RPCMsg service.
-type RPCMsgServer interface {
-	MsgSendMessage(context.Context, *TLMsgSendMessage) (*mtproto.Updates, error)
-	MsgSendMultiMessage(context.Context, *TLMsgSendMultiMessage) (*mtproto.Updates, error)
-	MsgPushUserMessage(context.Context, *TLMsgPushUserMessage) (*mtproto.Bool, error)
-	MsgReadMessageContents(context.Context, *TLMsgReadMessageContents) (*mtproto.Messages_AffectedMessages, error)
-	MsgSendMessageV2(context.Context, *TLMsgSendMessageV2) (*mtproto.UpdateList, error)
-	MsgEditMessage(context.Context, *TLMsgEditMessage) (*mtproto.Updates, error)
-	MsgDeleteMessages(context.Context, *TLMsgDeleteMessages) (*mtproto.Messages_AffectedMessages, error)
-	MsgDeleteHistory(context.Context, *TLMsgDeleteHistory) (*mtproto.Messages_AffectedHistory, error)
-	MsgDeletePhoneCallHistory(context.Context, *TLMsgDeletePhoneCallHistory) (*mtproto.Messages_AffectedFoundMessages, error)
-	MsgDeleteChatHistory(context.Context, *TLMsgDeleteChatHistory) (*mtproto.Bool, error)
-	MsgReadHistory(context.Context, *TLMsgReadHistory) (*mtproto.Messages_AffectedMessages, error)
-	MsgUpdatePinnedMessage(context.Context, *TLMsgUpdatePinnedMessage) (*mtproto.Updates, error)
-	MsgUnpinAllMessages(context.Context, *TLMsgUnpinAllMessages) (*mtproto.Messages_AffectedHistory, error)
-}
-
-// UnimplementedRPCMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedRPCMsgServer struct {
+//--------------------------------------------------------------------------------------------
+// Vector api result type
+type Vector_Message struct {
+	Datas                []*mtproto.Message `protobuf:"bytes,1,rep,name=datas,proto3" json:"datas,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
 }
 
-func (*UnimplementedRPCMsgServer) MsgSendMessage(ctx context.Context, req *TLMsgSendMessage) (*mtproto.Updates, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MsgSendMessage not implemented")
-}
-func (*UnimplementedRPCMsgServer) MsgSendMultiMessage(ctx context.Context, req *TLMsgSendMultiMessage) (*mtproto.Updates, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MsgSendMultiMessage not implemented")
-}
-func (*UnimplementedRPCMsgServer) MsgPushUserMessage(ctx context.Context, req *TLMsgPushUserMessage) (*mtproto.Bool, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MsgPushUserMessage not implemented")
-}
-func (*UnimplementedRPCMsgServer) MsgReadMessageContents(ctx context.Context, req *TLMsgReadMessageContents) (*mtproto.Messages_AffectedMessages, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MsgReadMessageContents not implemented")
+func (m *Vector_Message) Reset()         { *m = Vector_Message{} }
+func (m *Vector_Message) String() string { return proto.CompactTextString(m) }
+func (*Vector_Message) ProtoMessage()    {}
+func (*Vector_Message) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb6f24e718b1b713, []int{26}
 }
-func (*UnimplementedRPCMsgServer) MsgSendMessageV2(ctx context.Context, req *TLMsgSendMessageV2) (*mtproto.UpdateList, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MsgSendMessageV2 not implemented")
-}
-func (*UnimplementedRPCMsgServer) MsgEditMessage(ctx context.Context, req *TLMsgEditMessage) (*mtproto.Updates, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MsgEditMessage not implemented")
-}
-func (*UnimplementedRPCMsgServer) MsgDeleteMessages(ctx context.Context, req *TLMsgDeleteMessages) (*mtproto.Messages_AffectedMessages, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MsgDeleteMessages not implemented")
+func (m *Vector_Message) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedRPCMsgServer) MsgDeleteHistory(ctx context.Context, req *TLMsgDeleteHistory) (*mtproto.Messages_AffectedHistory, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MsgDeleteHistory not implemented")
+func (m *Vector_Message) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Vector_Message.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedRPCMsgServer) MsgDeletePhoneCallHistory(ctx context.Context, req *TLMsgDeletePhoneCallHistory) (*mtproto.Messages_AffectedFoundMessages, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MsgDeletePhoneCallHistory not implemented")
+func (m *Vector_Message) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Vector_Message.Merge(m, src)
 }
-func (*UnimplementedRPCMsgServer) MsgDeleteChatHistory(ctx context.Context, req *TLMsgDeleteChatHistory) (*mtproto.Bool, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MsgDeleteChatHistory not implemented")
+func (m *Vector_Message) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedRPCMsgServer) MsgReadHistory(ctx context.Context, req *TLMsgReadHistory) (*mtproto.Messages_AffectedMessages, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MsgReadHistory not implemented")
+func (m *Vector_Message) XXX_DiscardUnknown() {
+	xxx_messageInfo_Vector_Message.DiscardUnknown(m)
 }
-func (*UnimplementedRPCMsgServer) MsgUpdatePinnedMessage(ctx context.Context, req *TLMsgUpdatePinnedMessage) (*mtproto.Updates, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MsgUpdatePinnedMessage not implemented")
+
+var xxx_messageInfo_Vector_Message proto.InternalMessageInfo
+
+func (m *Vector_Message) GetDatas() []*mtproto.Message {
+	if m != nil {
+		return m.Datas
+	}
+	return nil
 }
-func (*UnimplementedRPCMsgServer) MsgUnpinAllMessages(ctx context.Context, req *TLMsgUnpinAllMessages) (*mtproto.Messages_AffectedHistory, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MsgUnpinAllMessages not implemented")
+
+func init() {
+	proto.RegisterEnum("msg.TLConstructor", TLConstructor_name, TLConstructor_value)
+	proto.RegisterType((*Sender)(nil), "msg.Sender")
+	proto.RegisterType((*TLSender)(nil), "msg.TL_sender")
+	proto.RegisterType((*OutboxMessage)(nil), "msg.OutboxMessage")
+	proto.RegisterType((*TLOutboxMessage)(nil), "msg.TL_outboxMessage")
+	proto.RegisterType((*ContentMessage)(nil), "msg.ContentMessage")
+	proto.RegisterType((*TLContentMessage)(nil), "msg.TL_contentMessage")
+	proto.RegisterType((*TLMsgSendMessage)(nil), "msg.TL_msg_sendMessage")
+	proto.RegisterType((*TLMsgSendMultiMessage)(nil), "msg.TL_msg_sendMultiMessage")
+	proto.RegisterType((*TLMsgPushUserMessage)(nil), "msg.TL_msg_pushUserMessage")
+	proto.RegisterType((*TLMsgReadMessageContents)(nil), "msg.TL_msg_readMessageContents")
+	proto.RegisterType((*TLMsgSendMessageV2)(nil), "msg.TL_msg_sendMessageV2")
+	proto.RegisterType((*TLMsgEditMessage)(nil), "msg.TL_msg_editMessage")
+	proto.RegisterType((*TLMsgDeleteMessages)(nil), "msg.TL_msg_deleteMessages")
+	proto.RegisterType((*TLMsgDeleteHistory)(nil), "msg.TL_msg_deleteHistory")
+	proto.RegisterType((*TLMsgDeletePhoneCallHistory)(nil), "msg.TL_msg_deletePhoneCallHistory")
+	proto.RegisterType((*TLMsgDeleteChatHistory)(nil), "msg.TL_msg_deleteChatHistory")
+	proto.RegisterType((*TLMsgReadHistory)(nil), "msg.TL_msg_readHistory")
+	proto.RegisterType((*TLMsgUpdatePinnedMessage)(nil), "msg.TL_msg_updatePinnedMessage")
+	proto.RegisterType((*TLMsgUnpinAllMessages)(nil), "msg.TL_msg_unpinAllMessages")
+	proto.RegisterType((*TLMsgGetScheduledHistory)(nil), "msg.TL_msg_getScheduledHistory")
+	proto.RegisterType((*TLMsgGetScheduledMessages)(nil), "msg.TL_msg_getScheduledMessages")
+	proto.RegisterType((*TLMsgSendScheduledMessages)(nil), "msg.TL_msg_sendScheduledMessages")
+	proto.RegisterType((*TLMsgDeleteScheduledMessages)(nil), "msg.TL_msg_deleteScheduledMessages")
+	proto.RegisterType((*TLMsgSendReaction)(nil), "msg.TL_msg_sendReaction")
+	proto.RegisterType((*TLMsgReadReactions)(nil), "msg.TL_msg_readReactions")
+	proto.RegisterType((*TLMsgGetMessageReactionsList)(nil), "msg.TL_msg_getMessageReactionsList")
+	proto.RegisterType((*Vector_Message)(nil), "msg.Vector_Message")
 }
 
-func RegisterRPCMsgServer(s *grpc.Server, srv RPCMsgServer) {
-	s.RegisterService(&_RPCMsg_serviceDesc, srv)
+func init() { proto.RegisterFile("msg.tl.proto", fileDescriptor_cb6f24e718b1b713) }
+
+var fileDescriptor_cb6f24e718b1b713 = []byte{
+	// 1950 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x5a, 0x5d, 0x6c, 0x1c, 0x57,
+	0x15, 0xf6, 0xec, 0x7a, 0xff, 0x4e, 0x6c, 0x77, 0x72, 0xed, 0x64, 0xd7, 0x6b, 0x7b, 0xb3, 0x9e,
+	0xfe, 0x99, 0xd0, 0xae, 0xa5, 0x4d, 0x1f, 0xfa, 0x00, 0x55, 0xdb, 0xe5, 0xa7, 0x56, 0x5d, 0x27,
+	0x4c, 0x6c, 0x57, 0xf0, 0x32, 0x8c, 0x77, 0xae, 0x67, 0x87, 0xec, 0xcc, 0xac, 0x66, 0xee, 0xb4,
+	0xf1, 0x73, 0x1f, 0x68, 0x44, 0x51, 0x50, 0xc4, 0x03, 0x41, 0x2d, 0xa2, 0xaa, 0x00, 0xf1, 0x57,
+	0x89, 0x56, 0x20, 0x01, 0x6a, 0x24, 0xfa, 0x03, 0x0d, 0xaa, 0xd4, 0x54, 0x0d, 0x2f, 0x80, 0x10,
+	0x89, 0x50, 0x23, 0xa4, 0x0a, 0x10, 0x20, 0xd1, 0x50, 0x15, 0xa3, 0xb9, 0x77, 0x66, 0x67, 0xee,
+	0xec, 0x6c, 0x6a, 0xa8, 0xdc, 0xd6, 0x79, 0x88, 0xb4, 0xf7, 0x9e, 0x73, 0xcf, 0x3d, 0xdf, 0x77,
+	0xcf, 0x3d, 0x73, 0xee, 0x89, 0x61, 0xcc, 0x74, 0xf5, 0x06, 0xe9, 0x36, 0x7a, 0x8e, 0x4d, 0x6c,
+	0x94, 0x35, 0x5d, 0xbd, 0x7a, 0xbb, 0x6e, 0x90, 0x8e, 0xb7, 0xd1, 0x68, 0xdb, 0xe6, 0xa2, 0x6e,
+	0xeb, 0xf6, 0x22, 0x95, 0x6d, 0x78, 0x9b, 0x74, 0x44, 0x07, 0xf4, 0x17, 0x5b, 0x53, 0xad, 0xe9,
+	0xb6, 0xad, 0x77, 0x71, 0xa4, 0xf5, 0xb0, 0xa3, 0xf6, 0x7a, 0xd8, 0x71, 0x03, 0x79, 0xd5, 0x6d,
+	0x77, 0xb0, 0xa9, 0xfa, 0x9b, 0xb4, 0x6d, 0x07, 0x2b, 0x64, 0xab, 0x87, 0x43, 0xd9, 0x74, 0x24,
+	0x23, 0x8e, 0x6a, 0xb9, 0x3d, 0xdb, 0x21, 0x81, 0x68, 0x2a, 0x12, 0xb9, 0x5b, 0x56, 0x9b, 0xcd,
+	0x4a, 0xcf, 0x08, 0x90, 0x3f, 0x8e, 0x2d, 0x0d, 0x3b, 0xe8, 0x66, 0x98, 0xe8, 0x39, 0x58, 0x33,
+	0xda, 0x2a, 0xc1, 0x8a, 0xa5, 0x9a, 0xb8, 0x22, 0xd4, 0x85, 0x85, 0x92, 0x3c, 0xde, 0x9f, 0x5d,
+	0x51, 0x4d, 0x8c, 0xee, 0x80, 0x7d, 0x6d, 0xdb, 0x72, 0x89, 0xe3, 0xb5, 0x89, 0xed, 0x54, 0x32,
+	0x75, 0x61, 0x61, 0xa2, 0x89, 0x1a, 0x3e, 0xec, 0xd5, 0xe5, 0x56, 0x24, 0x91, 0xe3, 0x6a, 0xa8,
+	0x0c, 0x05, 0xcf, 0xc5, 0x8e, 0x62, 0x68, 0x95, 0x6c, 0x5d, 0x58, 0xc8, 0xca, 0x79, 0x7f, 0xb8,
+	0xa4, 0x21, 0x04, 0xa3, 0x3e, 0x80, 0xca, 0x68, 0x5d, 0x58, 0xc8, 0xc9, 0xf4, 0x37, 0xaa, 0xc1,
+	0x3e, 0xd5, 0x23, 0x1d, 0xe5, 0x04, 0xde, 0xf2, 0x17, 0xe4, 0xe8, 0x82, 0x92, 0x3f, 0x75, 0x3f,
+	0xde, 0x5a, 0xd2, 0xa4, 0x06, 0x94, 0x56, 0x97, 0x15, 0x97, 0xb9, 0x3d, 0x0f, 0x39, 0x4d, 0x25,
+	0x6a, 0x93, 0x7a, 0xbb, 0xaf, 0xb9, 0x8f, 0x7a, 0xc2, 0x20, 0xc9, 0x4c, 0x22, 0xfd, 0x24, 0x03,
+	0xe3, 0x47, 0x3d, 0xb2, 0x61, 0x9f, 0x7c, 0x00, 0xbb, 0xae, 0xaa, 0xe3, 0xdd, 0xc5, 0x3a, 0x07,
+	0x60, 0xd9, 0xca, 0xc3, 0x78, 0xa3, 0xa7, 0xea, 0x98, 0xc2, 0x2d, 0xca, 0x25, 0xcb, 0x7e, 0x90,
+	0x4d, 0xa0, 0x1a, 0xc0, 0x86, 0xda, 0x3e, 0xa1, 0x3b, 0xb6, 0x67, 0x69, 0x14, 0x77, 0x51, 0x8e,
+	0xcd, 0xa0, 0x19, 0x28, 0x39, 0xaa, 0xa5, 0xd9, 0x66, 0x84, 0xbd, 0xc8, 0x26, 0x96, 0x34, 0x74,
+	0x18, 0x0a, 0x26, 0xc3, 0x50, 0xc9, 0x53, 0xbc, 0x62, 0xc3, 0x24, 0xf4, 0x28, 0x1b, 0x01, 0x36,
+	0x39, 0x54, 0x40, 0x77, 0xc3, 0xb8, 0x7f, 0xe6, 0x9a, 0xd7, 0xc5, 0x8a, 0xa6, 0x12, 0x5c, 0x29,
+	0xd0, 0x15, 0x33, 0x0d, 0x16, 0x60, 0x8d, 0x30, 0xc0, 0x1a, 0x4b, 0x16, 0x39, 0xd2, 0x5c, 0x57,
+	0xbb, 0x1e, 0x96, 0xc7, 0xc2, 0x15, 0x9f, 0x50, 0x09, 0x96, 0x3e, 0x06, 0xe2, 0xea, 0xb2, 0x62,
+	0x73, 0xd4, 0x2d, 0xf0, 0x7c, 0x33, 0x36, 0x38, 0x76, 0x43, 0xda, 0x7f, 0x2b, 0xc0, 0x44, 0xcb,
+	0xb6, 0x08, 0xb6, 0xc8, 0xfb, 0xc2, 0xfb, 0x04, 0x64, 0x82, 0xf0, 0xca, 0xc9, 0x19, 0x43, 0x43,
+	0xb3, 0x50, 0x32, 0xb1, 0x45, 0x0c, 0xdb, 0xc2, 0x21, 0xcf, 0xd1, 0x04, 0x9a, 0x87, 0x31, 0x13,
+	0x6b, 0x86, 0xaa, 0x78, 0x96, 0x83, 0x55, 0xc6, 0x74, 0x51, 0xde, 0x47, 0xe7, 0xd6, 0xe8, 0x14,
+	0xaa, 0x42, 0xd1, 0xc1, 0x6a, 0xdb, 0x5f, 0x40, 0xd9, 0x2e, 0xca, 0xfd, 0xb1, 0x74, 0x17, 0xec,
+	0x5f, 0x5d, 0x56, 0xda, 0x3c, 0xbc, 0x8f, 0xf0, 0xdc, 0x4c, 0x52, 0x8f, 0x79, 0x0a, 0x42, 0x72,
+	0xae, 0x08, 0x80, 0x56, 0x97, 0x15, 0xd3, 0xd5, 0x69, 0x20, 0x87, 0x16, 0x12, 0xc8, 0x85, 0xf7,
+	0x78, 0xbb, 0x12, 0x37, 0x69, 0x34, 0x71, 0x93, 0xfc, 0x58, 0xeb, 0x61, 0xec, 0xd0, 0x1c, 0x42,
+	0x19, 0xc8, 0xc9, 0x45, 0x7f, 0x62, 0xd5, 0xbf, 0x86, 0x65, 0x28, 0x50, 0xa1, 0xa1, 0x51, 0xf4,
+	0x59, 0x39, 0xef, 0x0f, 0x97, 0x34, 0x74, 0x5b, 0x14, 0x84, 0x85, 0xa1, 0x41, 0x10, 0xaa, 0x48,
+	0x6f, 0x0a, 0x50, 0x8e, 0x23, 0xf5, 0xba, 0xc4, 0xd8, 0xbb, 0x70, 0xb3, 0xef, 0x06, 0xf7, 0xd1,
+	0x0c, 0x1c, 0x0c, 0xe0, 0xf6, 0x3c, 0xb7, 0xb3, 0xe6, 0x62, 0x67, 0x4f, 0xa1, 0xf5, 0x57, 0x79,
+	0x6e, 0x87, 0xad, 0x2a, 0x04, 0xab, 0x3c, 0xb7, 0x43, 0x57, 0xc5, 0xa8, 0x28, 0xbe, 0xfb, 0xc9,
+	0xbf, 0x21, 0x40, 0x35, 0xa0, 0xc2, 0xbf, 0x4f, 0x81, 0x3c, 0xb8, 0x0f, 0xee, 0xde, 0xa0, 0xe3,
+	0x46, 0x9a, 0x54, 0xd8, 0xb9, 0xa7, 0xde, 0xe7, 0x8c, 0xa1, 0x49, 0x7f, 0x16, 0x60, 0x6a, 0xf0,
+	0x32, 0xaf, 0x37, 0xaf, 0xc7, 0xf8, 0x8e, 0x25, 0x2e, 0xac, 0x19, 0xe4, 0x3a, 0x4e, 0x5c, 0x7f,
+	0x12, 0xe0, 0x40, 0x80, 0x54, 0xc3, 0x5d, 0x4c, 0x70, 0xa0, 0xb1, 0x47, 0x22, 0xf7, 0x20, 0xe4,
+	0x1d, 0xfc, 0x90, 0x7d, 0x82, 0x61, 0x2d, 0xca, 0xc1, 0x28, 0xf8, 0x4c, 0x16, 0xeb, 0x59, 0xf6,
+	0x99, 0x94, 0x4e, 0x65, 0x60, 0x8a, 0x83, 0x79, 0x9f, 0xe1, 0x12, 0xdb, 0xd9, 0xda, 0x1b, 0x28,
+	0xe7, 0x00, 0xbe, 0xe0, 0xb9, 0x44, 0x69, 0x77, 0xb1, 0xea, 0x04, 0x48, 0x4b, 0xfe, 0x4c, 0xcb,
+	0x9f, 0x88, 0x91, 0x50, 0xe4, 0x48, 0x38, 0x00, 0x79, 0x53, 0x3d, 0xe9, 0x9b, 0x2b, 0xd1, 0x9d,
+	0x72, 0xa6, 0x7a, 0x72, 0x49, 0x93, 0xbe, 0x2d, 0xc0, 0x1c, 0xc7, 0xc5, 0xb1, 0x8e, 0x6d, 0xe1,
+	0x96, 0xda, 0xed, 0x7e, 0x40, 0xa4, 0x44, 0xfe, 0xe7, 0xe2, 0xfe, 0x4b, 0xa7, 0x05, 0xa8, 0x70,
+	0x8e, 0xb6, 0x3a, 0x2a, 0x79, 0xcf, 0x3e, 0xb6, 0x3b, 0x2a, 0x89, 0xf9, 0xe8, 0x0f, 0x97, 0x34,
+	0x74, 0x13, 0x4c, 0xb0, 0x3d, 0x94, 0x10, 0x03, 0x73, 0x73, 0x8c, 0xcd, 0xae, 0x51, 0x24, 0xd2,
+	0x85, 0x28, 0x2f, 0xf8, 0xc9, 0x7e, 0x4f, 0x05, 0x51, 0x14, 0x0d, 0x85, 0x78, 0x34, 0x7c, 0x23,
+	0xd3, 0xff, 0x7e, 0x79, 0x3d, 0x4d, 0x25, 0xf8, 0x98, 0x61, 0x59, 0xf8, 0x83, 0xaa, 0xd5, 0x0e,
+	0x42, 0xde, 0x35, 0xba, 0xd8, 0x22, 0x61, 0x28, 0xb0, 0x11, 0x9a, 0x82, 0x9c, 0x67, 0xf5, 0x8c,
+	0xb0, 0x44, 0x65, 0x03, 0xff, 0x5e, 0xf4, 0x4c, 0xc5, 0xb6, 0xb0, 0x6b, 0x68, 0x61, 0x06, 0x28,
+	0xf5, 0xcc, 0xa3, 0x6c, 0x82, 0xe7, 0xa9, 0x38, 0x9c, 0xa7, 0x12, 0xc7, 0x13, 0x4b, 0x1d, 0x10,
+	0x56, 0xd8, 0xd2, 0xb9, 0xa8, 0xb4, 0xa3, 0xbb, 0xde, 0xd3, 0xed, 0xee, 0xa9, 0x1c, 0x29, 0x3d,
+	0x19, 0x55, 0x28, 0x3a, 0x26, 0xc7, 0x83, 0xb7, 0xcf, 0x6e, 0x05, 0x2f, 0xe7, 0xe3, 0xe8, 0x70,
+	0x1f, 0x73, 0x9c, 0x8f, 0xcf, 0x08, 0x30, 0x93, 0xe2, 0xe3, 0x6e, 0x11, 0xfd, 0x7f, 0x39, 0x19,
+	0x44, 0x46, 0xbe, 0xff, 0x51, 0x79, 0x5d, 0x80, 0xd9, 0x58, 0x45, 0xb4, 0xeb, 0x5e, 0xef, 0x4e,
+	0x5e, 0x98, 0xe8, 0x17, 0x7f, 0x0c, 0xd5, 0x6f, 0x04, 0xa8, 0x71, 0x59, 0xf7, 0x3a, 0xc1, 0xf5,
+	0x44, 0x06, 0x26, 0x63, 0xa7, 0x25, 0x07, 0x8f, 0xdc, 0xf7, 0x1b, 0x8c, 0x08, 0xd9, 0x0d, 0x43,
+	0x0f, 0xd2, 0x9b, 0xff, 0x93, 0x87, 0x97, 0x1f, 0x0e, 0xaf, 0x30, 0x90, 0xce, 0x5d, 0x5d, 0xa1,
+	0x55, 0x0e, 0x4b, 0xe7, 0xae, 0xbe, 0xa4, 0xa1, 0x3b, 0x63, 0xcf, 0xf9, 0x12, 0x2d, 0xff, 0x66,
+	0x07, 0x5a, 0x21, 0xc7, 0x89, 0x63, 0x58, 0x3a, 0xeb, 0x85, 0x44, 0x8f, 0xfd, 0x9f, 0x47, 0xf5,
+	0xbd, 0xff, 0x6d, 0x0b, 0xf9, 0xd9, 0x23, 0x49, 0xee, 0xf9, 0x4c, 0x3f, 0x6a, 0x75, 0xdc, 0x7f,
+	0xb7, 0x84, 0x18, 0x96, 0x0d, 0x97, 0x7c, 0x38, 0x72, 0x48, 0x74, 0x6c, 0xf9, 0x61, 0xc7, 0x56,
+	0xf8, 0x5f, 0x8e, 0x0d, 0xdd, 0x01, 0x79, 0x7b, 0x73, 0xd3, 0xc5, 0xa4, 0x52, 0xdc, 0xc1, 0xba,
+	0x40, 0xd7, 0xff, 0x9e, 0x76, 0x0d, 0xd3, 0x20, 0x61, 0x65, 0x48, 0x07, 0xd2, 0x9d, 0x30, 0xb1,
+	0x8e, 0x7d, 0xd4, 0x4a, 0xf8, 0xf9, 0xbf, 0x85, 0x35, 0x7b, 0xdc, 0x8a, 0x50, 0xcf, 0xa6, 0x36,
+	0xe2, 0x98, 0xf8, 0xf0, 0x23, 0x05, 0x18, 0xe7, 0x48, 0x44, 0xfb, 0x61, 0xbc, 0x25, 0xb7, 0x8e,
+	0x34, 0x95, 0xb5, 0x95, 0xfb, 0x57, 0x8e, 0x3e, 0xb8, 0x22, 0x8e, 0xa0, 0x29, 0x18, 0x63, 0x53,
+	0xac, 0xab, 0x29, 0xfe, 0xec, 0xfc, 0xa5, 0xd7, 0x72, 0x68, 0x06, 0x26, 0xd9, 0x2c, 0xd7, 0x82,
+	0x13, 0x7f, 0x74, 0xfe, 0xe2, 0xe3, 0x39, 0x34, 0x0b, 0x53, 0x4c, 0xc8, 0x37, 0xa1, 0xc4, 0xef,
+	0xbd, 0xf2, 0xc5, 0x2f, 0x0b, 0x68, 0x0e, 0x0e, 0x30, 0x69, 0xe2, 0x51, 0x2a, 0xbe, 0xfd, 0xea,
+	0x63, 0x2f, 0x8d, 0xa2, 0x5b, 0xa1, 0x9a, 0x10, 0xc7, 0xda, 0x32, 0xe2, 0x2b, 0xdf, 0x79, 0xfc,
+	0xe5, 0xab, 0xdb, 0xdb, 0xdb, 0xdb, 0x02, 0x9a, 0x87, 0xe9, 0x48, 0x31, 0xd1, 0xd0, 0x10, 0xbf,
+	0xfe, 0xcf, 0x17, 0x9e, 0xce, 0xa2, 0x9b, 0x61, 0x2e, 0x52, 0x49, 0x79, 0xe8, 0x8b, 0x17, 0x4f,
+	0x9d, 0xf9, 0x45, 0x06, 0x1d, 0x82, 0x72, 0xaa, 0x47, 0xeb, 0x4d, 0xf1, 0x8d, 0x7f, 0x3f, 0xf9,
+	0xd7, 0x0c, 0x92, 0xe2, 0x2e, 0xc7, 0xde, 0x96, 0xe2, 0x99, 0x5f, 0x5e, 0x7a, 0x36, 0x70, 0xa7,
+	0x0e, 0x95, 0x48, 0x87, 0x7f, 0x95, 0x89, 0x4f, 0x7c, 0xf5, 0x9b, 0xa7, 0x13, 0xdb, 0x70, 0x0f,
+	0x1a, 0xf1, 0xd7, 0xaf, 0x3d, 0xf2, 0x74, 0x01, 0x2d, 0x40, 0x3d, 0xa9, 0x90, 0xac, 0xf2, 0xc5,
+	0x73, 0xcf, 0xff, 0xe1, 0xc7, 0x19, 0xb4, 0x00, 0x33, 0x49, 0xcd, 0x58, 0x99, 0x2d, 0xfe, 0xee,
+	0xa5, 0x77, 0xde, 0xfa, 0x0f, 0x73, 0x8b, 0x63, 0x3b, 0x56, 0xfe, 0x8a, 0x67, 0x7e, 0xff, 0xdc,
+	0x85, 0x1c, 0x3a, 0x1c, 0x67, 0x28, 0xa5, 0x94, 0x14, 0xbf, 0xfb, 0xd4, 0x4f, 0x7f, 0x18, 0x98,
+	0xe2, 0x4e, 0x26, 0x59, 0x55, 0x89, 0xdf, 0x3a, 0x77, 0xf6, 0xc5, 0xb7, 0x99, 0x22, 0x47, 0x7b,
+	0x4a, 0xf5, 0x22, 0xfe, 0xea, 0xef, 0xff, 0xf8, 0x8a, 0x80, 0x3e, 0x0a, 0xb5, 0x74, 0xb5, 0xbe,
+	0xcd, 0xbf, 0xfc, 0xeb, 0xfc, 0x97, 0x82, 0xcd, 0x6f, 0x83, 0x43, 0xfc, 0x19, 0x0d, 0x6a, 0xbf,
+	0x79, 0xe5, 0xb1, 0xaf, 0xbd, 0xc3, 0xb4, 0x1b, 0x30, 0x9f, 0xe4, 0x67, 0x50, 0xff, 0xca, 0xd9,
+	0x53, 0xcf, 0x06, 0xfa, 0x35, 0x38, 0xc8, 0x5b, 0x0f, 0x93, 0x90, 0xf8, 0xc2, 0xe9, 0x0b, 0x67,
+	0x0b, 0xe8, 0x26, 0x28, 0xf3, 0x2c, 0x86, 0x72, 0x57, 0x7c, 0xfd, 0x07, 0xdf, 0xbf, 0xb8, 0x9d,
+	0xb2, 0xeb, 0x90, 0x84, 0x26, 0x5e, 0x7d, 0xf1, 0xd5, 0xe7, 0xde, 0xa2, 0xfa, 0xd5, 0xd1, 0x47,
+	0x9f, 0xaa, 0x8d, 0x34, 0xaf, 0x8e, 0x41, 0x5e, 0x3e, 0xd6, 0x7a, 0xc0, 0xd5, 0xd1, 0x5d, 0x70,
+	0x43, 0xb2, 0xed, 0x5a, 0x0e, 0x52, 0x5d, 0x32, 0x36, 0xab, 0xd1, 0xad, 0x5e, 0xa3, 0xc7, 0xe6,
+	0x4a, 0x23, 0xe8, 0x3e, 0x98, 0x4a, 0x6d, 0x66, 0xce, 0x0e, 0x18, 0x89, 0x49, 0x53, 0x2d, 0xb5,
+	0x60, 0x32, 0xad, 0x4f, 0x38, 0x13, 0x37, 0x94, 0x10, 0x56, 0xc7, 0xfb, 0x76, 0xee, 0xb5, 0xed,
+	0xae, 0x34, 0x82, 0x3e, 0x0f, 0xe5, 0x61, 0x1d, 0xb6, 0x43, 0x71, 0x43, 0x29, 0x0a, 0x55, 0xa9,
+	0x6f, 0x2c, 0x68, 0x7a, 0xb8, 0xca, 0x3d, 0x9b, 0x9b, 0xb8, 0x4d, 0xa2, 0x03, 0x94, 0x46, 0xd0,
+	0x27, 0x61, 0xff, 0x60, 0x6b, 0x6b, 0x7a, 0x08, 0x65, 0xeb, 0xcd, 0xea, 0x64, 0x02, 0xaa, 0x7f,
+	0x22, 0xd2, 0x48, 0xc8, 0x7b, 0xbc, 0x6b, 0xc4, 0xf1, 0x1e, 0x13, 0xa4, 0xb2, 0xb5, 0x0e, 0x28,
+	0xa5, 0x17, 0x53, 0x8d, 0x9b, 0xe0, 0x65, 0x3b, 0x84, 0x77, 0x1c, 0xf6, 0x47, 0x6b, 0xc3, 0xd2,
+	0x7f, 0x7a, 0xd0, 0x6c, 0x20, 0xaa, 0xce, 0x0f, 0xb7, 0x1a, 0xa8, 0x48, 0x23, 0xe8, 0x04, 0x54,
+	0xaf, 0xd1, 0x45, 0x90, 0x06, 0xad, 0x27, 0x75, 0xaa, 0xb7, 0x0e, 0xdf, 0xe6, 0x53, 0xfe, 0x7f,
+	0x14, 0xc5, 0x10, 0x7c, 0x1a, 0x0e, 0xa4, 0x77, 0x02, 0xe6, 0x06, 0xf7, 0x89, 0x89, 0x07, 0x63,
+	0xe9, 0x18, 0xdc, 0x10, 0x86, 0x4a, 0x68, 0xa2, 0x9c, 0x8c, 0xa1, 0x70, 0xf1, 0xce, 0xc8, 0x5d,
+	0x81, 0xf2, 0x90, 0xa4, 0xc7, 0x47, 0x67, 0x8a, 0x42, 0x6a, 0x10, 0x7c, 0x16, 0xa6, 0xd2, 0x12,
+	0x23, 0x7f, 0xf9, 0x92, 0xd2, 0x9d, 0x1d, 0xd9, 0x67, 0xa0, 0x9c, 0xcc, 0x91, 0x21, 0x09, 0x9c,
+	0xab, 0x29, 0x0a, 0x55, 0xd6, 0x1a, 0xe6, 0x2b, 0x04, 0x1a, 0x5a, 0x95, 0xa1, 0xef, 0xb6, 0xfa,
+	0x30, 0x9b, 0x7d, 0xaf, 0x87, 0x18, 0x95, 0x61, 0x7a, 0xf8, 0xbb, 0x6a, 0x3e, 0x79, 0x2d, 0x07,
+	0xcd, 0xa6, 0xdf, 0xad, 0x99, 0x6b, 0xbd, 0x6a, 0x6e, 0x1c, 0x8c, 0xa3, 0x9d, 0xd9, 0xbd, 0x1b,
+	0xc4, 0x81, 0x57, 0x45, 0x25, 0xe9, 0x62, 0x28, 0x49, 0xb5, 0x10, 0xdc, 0x4e, 0xbe, 0xee, 0x9e,
+	0x4e, 0x06, 0x65, 0x5f, 0xb4, 0xb3, 0xa3, 0xee, 0xc2, 0xcc, 0x35, 0xbe, 0x1e, 0x3c, 0xdc, 0x21,
+	0x4a, 0xd5, 0x5b, 0x06, 0x37, 0x4a, 0xd3, 0x93, 0x46, 0xee, 0x3d, 0xfa, 0xb7, 0x4b, 0x35, 0xe1,
+	0xe5, 0xcb, 0x35, 0xe1, 0xc2, 0xe5, 0x9a, 0xf0, 0xc7, 0xcb, 0x35, 0xe1, 0x73, 0x1f, 0x8f, 0xfd,
+	0x49, 0x00, 0xc1, 0xaa, 0xa9, 0x3b, 0x6a, 0xf4, 0xe3, 0x76, 0x17, 0x3b, 0x0f, 0x61, 0x67, 0x51,
+	0xed, 0xf5, 0x16, 0x7d, 0xd3, 0xd8, 0xd2, 0xb1, 0xb3, 0x68, 0xba, 0x7a, 0xf8, 0x6f, 0x23, 0x4f,
+	0xf7, 0x3d, 0xf2, 0xdf, 0x01, 0x00, 0xe8, 0x83, 0x7d, 0x45, 0x6d, 0x20, 0x00, 0x00,
 }
 
-func _RPCMsg_MsgSendMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TLMsgSendMessage)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(RPCMsgServer).MsgSendMessage(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/msg.RPCMsg/MsgSendMessage",
+func (this *Sender) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RPCMsgServer).MsgSendMessage(ctx, req.(*TLMsgSendMessage))
+	s := make([]string, 0, 9)
+	s = append(s, "&msg.Sender{")
+	s = append(s, "PredicateName: "+fmt.Sprintf("%#v", this.PredicateName)+",\n")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
+	s = append(s, "Type: "+fmt.Sprintf("%#v", this.Type)+",\n")
+	s = append(s, "AuthKeyId: "+fmt.Sprintf("%#v", this.AuthKeyId)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
-	return interceptor(ctx, in, info, handler)
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func _RPCMsg_MsgSendMultiMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TLMsgSendMultiMessage)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(RPCMsgServer).MsgSendMultiMessage(ctx, in)
+func (this *TLSender) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/msg.RPCMsg/MsgSendMultiMessage",
+	s := make([]string, 0, 5)
+	s = append(s, "&msg.TLSender{")
+	if this.Data2 != nil {
+		s = append(s, "Data2: "+fmt.Sprintf("%#v", this.Data2)+",\n")
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RPCMsgServer).MsgSendMultiMessage(ctx, req.(*TLMsgSendMultiMessage))
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
-	return interceptor(ctx, in, info, handler)
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func _RPCMsg_MsgPushUserMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TLMsgPushUserMessage)
-	if err := dec(in); err != nil {
-		return nil, err
+func (this *OutboxMessage) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if interceptor == nil {
-		return srv.(RPCMsgServer).MsgPushUserMessage(ctx, in)
+	s := make([]string, 0, 11)
+	s = append(s, "&msg.OutboxMessage{")
+	s = append(s, "PredicateName: "+fmt.Sprintf("%#v", this.PredicateName)+",\n")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "NoWebpage: "+fmt.Sprintf("%#v", this.NoWebpage)+",\n")
+	s = append(s, "Background: "+fmt.Sprintf("%#v", this.Background)+",\n")
+	s = append(s, "RandomId: "+fmt.Sprintf("%#v", this.RandomId)+",\n")
+	if this.Message != nil {
+		s = append(s, "Message: "+fmt.Sprintf("%#v", this.Message)+",\n")
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/msg.RPCMsg/MsgPushUserMessage",
+	if this.ScheduleDate != nil {
+		s = append(s, "ScheduleDate: "+fmt.Sprintf("%#v", this.ScheduleDate)+",\n")
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RPCMsgServer).MsgPushUserMessage(ctx, req.(*TLMsgPushUserMessage))
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
-	return interceptor(ctx, in, info, handler)
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func _RPCMsg_MsgReadMessageContents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TLMsgReadMessageContents)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(RPCMsgServer).MsgReadMessageContents(ctx, in)
+func (this *TLOutboxMessage) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/msg.RPCMsg/MsgReadMessageContents",
+	s := make([]string, 0, 5)
+	s = append(s, "&msg.TLOutboxMessage{")
+	if this.Data2 != nil {
+		s = append(s, "Data2: "+fmt.Sprintf("%#v", this.Data2)+",\n")
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RPCMsgServer).MsgReadMessageContents(ctx, req.(*TLMsgReadMessageContents))
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
-	return interceptor(ctx, in, info, handler)
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func _RPCMsg_MsgSendMessageV2_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TLMsgSendMessageV2)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(RPCMsgServer).MsgSendMessageV2(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/msg.RPCMsg/MsgSendMessageV2",
+func (this *ContentMessage) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RPCMsgServer).MsgSendMessageV2(ctx, req.(*TLMsgSendMessageV2))
+	s := make([]string, 0, 10)
+	s = append(s, "&msg.ContentMessage{")
+	s = append(s, "PredicateName: "+fmt.Sprintf("%#v", this.PredicateName)+",\n")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
+	s = append(s, "Mentioned: "+fmt.Sprintf("%#v", this.Mentioned)+",\n")
+	s = append(s, "MediaUnread: "+fmt.Sprintf("%#v", this.MediaUnread)+",\n")
+	s = append(s, "Reaction: "+fmt.Sprintf("%#v", this.Reaction)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
-	return interceptor(ctx, in, info, handler)
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func _RPCMsg_MsgEditMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TLMsgEditMessage)
-	if err := dec(in); err != nil {
-		return nil, err
+func (this *TLContentMessage) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if interceptor == nil {
-		return srv.(RPCMsgServer).MsgEditMessage(ctx, in)
+	s := make([]string, 0, 5)
+	s = append(s, "&msg.TLContentMessage{")
+	if this.Data2 != nil {
+		s = append(s, "Data2: "+fmt.Sprintf("%#v", this.Data2)+",\n")
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/msg.RPCMsg/MsgEditMessage",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RPCMsgServer).MsgEditMessage(ctx, req.(*TLMsgEditMessage))
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
-	return interceptor(ctx, in, info, handler)
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func _RPCMsg_MsgDeleteMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TLMsgDeleteMessages)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(RPCMsgServer).MsgDeleteMessages(ctx, in)
+func (this *TLMsgSendMessage) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/msg.RPCMsg/MsgDeleteMessages",
+	s := make([]string, 0, 10)
+	s = append(s, "&msg.TLMsgSendMessage{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
+	s = append(s, "AuthKeyId: "+fmt.Sprintf("%#v", this.AuthKeyId)+",\n")
+	s = append(s, "PeerType: "+fmt.Sprintf("%#v", this.PeerType)+",\n")
+	s = append(s, "PeerId: "+fmt.Sprintf("%#v", this.PeerId)+",\n")
+	if this.Message != nil {
+		s = append(s, "Message: "+fmt.Sprintf("%#v", this.Message)+",\n")
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RPCMsgServer).MsgDeleteMessages(ctx, req.(*TLMsgDeleteMessages))
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
-	return interceptor(ctx, in, info, handler)
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func _RPCMsg_MsgDeleteHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TLMsgDeleteHistory)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(RPCMsgServer).MsgDeleteHistory(ctx, in)
+func (this *TLMsgSendMultiMessage) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/msg.RPCMsg/MsgDeleteHistory",
+	s := make([]string, 0, 10)
+	s = append(s, "&msg.TLMsgSendMultiMessage{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
+	s = append(s, "AuthKeyId: "+fmt.Sprintf("%#v", this.AuthKeyId)+",\n")
+	s = append(s, "PeerType: "+fmt.Sprintf("%#v", this.PeerType)+",\n")
+	s = append(s, "PeerId: "+fmt.Sprintf("%#v", this.PeerId)+",\n")
+	if this.Message != nil {
+		s = append(s, "Message: "+fmt.Sprintf("%#v", this.Message)+",\n")
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RPCMsgServer).MsgDeleteHistory(ctx, req.(*TLMsgDeleteHistory))
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
-	return interceptor(ctx, in, info, handler)
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func _RPCMsg_MsgDeletePhoneCallHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TLMsgDeletePhoneCallHistory)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(RPCMsgServer).MsgDeletePhoneCallHistory(ctx, in)
+func (this *TLMsgPushUserMessage) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/msg.RPCMsg/MsgDeletePhoneCallHistory",
+	s := make([]string, 0, 11)
+	s = append(s, "&msg.TLMsgPushUserMessage{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
+	s = append(s, "AuthKeyId: "+fmt.Sprintf("%#v", this.AuthKeyId)+",\n")
+	s = append(s, "PeerType: "+fmt.Sprintf("%#v", this.PeerType)+",\n")
+	s = append(s, "PeerId: "+fmt.Sprintf("%#v", this.PeerId)+",\n")
+	s = append(s, "PushType: "+fmt.Sprintf("%#v", this.PushType)+",\n")
+	if this.Message != nil {
+		s = append(s, "Message: "+fmt.Sprintf("%#v", this.Message)+",\n")
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RPCMsgServer).MsgDeletePhoneCallHistory(ctx, req.(*TLMsgDeletePhoneCallHistory))
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
-	return interceptor(ctx, in, info, handler)
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func _RPCMsg_MsgDeleteChatHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TLMsgDeleteChatHistory)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(RPCMsgServer).MsgDeleteChatHistory(ctx, in)
+func (this *TLMsgReadMessageContents) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/msg.RPCMsg/MsgDeleteChatHistory",
+	s := make([]string, 0, 10)
+	s = append(s, "&msg.TLMsgReadMessageContents{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
+	s = append(s, "AuthKeyId: "+fmt.Sprintf("%#v", this.AuthKeyId)+",\n")
+	s = append(s, "PeerType: "+fmt.Sprintf("%#v", this.PeerType)+",\n")
+	s = append(s, "PeerId: "+fmt.Sprintf("%#v", this.PeerId)+",\n")
+	if this.Id != nil {
+		s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RPCMsgServer).MsgDeleteChatHistory(ctx, req.(*TLMsgDeleteChatHistory))
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
-	return interceptor(ctx, in, info, handler)
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func _RPCMsg_MsgReadHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TLMsgReadHistory)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(RPCMsgServer).MsgReadHistory(ctx, in)
+func (this *TLMsgSendMessageV2) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/msg.RPCMsg/MsgReadHistory",
+	s := make([]string, 0, 10)
+	s = append(s, "&msg.TLMsgSendMessageV2{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
+	s = append(s, "AuthKeyId: "+fmt.Sprintf("%#v", this.AuthKeyId)+",\n")
+	s = append(s, "PeerType: "+fmt.Sprintf("%#v", this.PeerType)+",\n")
+	s = append(s, "PeerId: "+fmt.Sprintf("%#v", this.PeerId)+",\n")
+	if this.Message != nil {
+		s = append(s, "Message: "+fmt.Sprintf("%#v", this.Message)+",\n")
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RPCMsgServer).MsgReadHistory(ctx, req.(*TLMsgReadHistory))
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
-	return interceptor(ctx, in, info, handler)
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func _RPCMsg_MsgUpdatePinnedMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TLMsgUpdatePinnedMessage)
-	if err := dec(in); err != nil {
-		return nil, err
+func (this *TLMsgEditMessage) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if interceptor == nil {
-		return srv.(RPCMsgServer).MsgUpdatePinnedMessage(ctx, in)
+	s := make([]string, 0, 10)
+	s = append(s, "&msg.TLMsgEditMessage{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
+	s = append(s, "AuthKeyId: "+fmt.Sprintf("%#v", this.AuthKeyId)+",\n")
+	s = append(s, "PeerType: "+fmt.Sprintf("%#v", this.PeerType)+",\n")
+	s = append(s, "PeerId: "+fmt.Sprintf("%#v", this.PeerId)+",\n")
+	if this.Message != nil {
+		s = append(s, "Message: "+fmt.Sprintf("%#v", this.Message)+",\n")
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/msg.RPCMsg/MsgUpdatePinnedMessage",
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RPCMsgServer).MsgUpdatePinnedMessage(ctx, req.(*TLMsgUpdatePinnedMessage))
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLMsgDeleteMessages) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return interceptor(ctx, in, info, handler)
+	s := make([]string, 0, 11)
+	s = append(s, "&msg.TLMsgDeleteMessages{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
+	s = append(s, "AuthKeyId: "+fmt.Sprintf("%#v", this.AuthKeyId)+",\n")
+	s = append(s, "PeerType: "+fmt.Sprintf("%#v", this.PeerType)+",\n")
+	s = append(s, "PeerId: "+fmt.Sprintf("%#v", this.PeerId)+",\n")
+	s = append(s, "Revoke: "+fmt.Sprintf("%#v", this.Revoke)+",\n")
+	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func _RPCMsg_MsgUnpinAllMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TLMsgUnpinAllMessages)
-	if err := dec(in); err != nil {
-		return nil, err
+func (this *TLMsgDeleteHistory) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if interceptor == nil {
-		return srv.(RPCMsgServer).MsgUnpinAllMessages(ctx, in)
+	s := make([]string, 0, 12)
+	s = append(s, "&msg.TLMsgDeleteHistory{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
+	s = append(s, "AuthKeyId: "+fmt.Sprintf("%#v", this.AuthKeyId)+",\n")
+	s = append(s, "PeerType: "+fmt.Sprintf("%#v", this.PeerType)+",\n")
+	s = append(s, "PeerId: "+fmt.Sprintf("%#v", this.PeerId)+",\n")
+	s = append(s, "JustClear: "+fmt.Sprintf("%#v", this.JustClear)+",\n")
+	s = append(s, "Revoke: "+fmt.Sprintf("%#v", this.Revoke)+",\n")
+	s = append(s, "MaxId: "+fmt.Sprintf("%#v", this.MaxId)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/msg.RPCMsg/MsgUnpinAllMessages",
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLMsgDeletePhoneCallHistory) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RPCMsgServer).MsgUnpinAllMessages(ctx, req.(*TLMsgUnpinAllMessages))
+	s := make([]string, 0, 8)
+	s = append(s, "&msg.TLMsgDeletePhoneCallHistory{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
+	s = append(s, "AuthKeyId: "+fmt.Sprintf("%#v", this.AuthKeyId)+",\n")
+	s = append(s, "Revoke: "+fmt.Sprintf("%#v", this.Revoke)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
-	return interceptor(ctx, in, info, handler)
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-var _RPCMsg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "msg.RPCMsg",
-	HandlerType: (*RPCMsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "msg_sendMessage",
-			Handler:    _RPCMsg_MsgSendMessage_Handler,
-		},
-		{
-			MethodName: "msg_sendMultiMessage",
-			Handler:    _RPCMsg_MsgSendMultiMessage_Handler,
-		},
-		{
-			MethodName: "msg_pushUserMessage",
-			Handler:    _RPCMsg_MsgPushUserMessage_Handler,
-		},
-		{
-			MethodName: "msg_readMessageContents",
-			Handler:    _RPCMsg_MsgReadMessageContents_Handler,
-		},
-		{
-			MethodName: "msg_sendMessageV2",
-			Handler:    _RPCMsg_MsgSendMessageV2_Handler,
-		},
-		{
-			MethodName: "msg_editMessage",
-			Handler:    _RPCMsg_MsgEditMessage_Handler,
-		},
-		{
-			MethodName: "msg_deleteMessages",
-			Handler:    _RPCMsg_MsgDeleteMessages_Handler,
-		},
-		{
-			MethodName: "msg_deleteHistory",
-			Handler:    _RPCMsg_MsgDeleteHistory_Handler,
-		},
-		{
-			MethodName: "msg_deletePhoneCallHistory",
-			Handler:    _RPCMsg_MsgDeletePhoneCallHistory_Handler,
-		},
-		{
-			MethodName: "msg_deleteChatHistory",
-			Handler:    _RPCMsg_MsgDeleteChatHistory_Handler,
-		},
-		{
-			MethodName: "msg_readHistory",
-			Handler:    _RPCMsg_MsgReadHistory_Handler,
-		},
-		{
-			MethodName: "msg_updatePinnedMessage",
-			Handler:    _RPCMsg_MsgUpdatePinnedMessage_Handler,
-		},
-		{
-			MethodName: "msg_unpinAllMessages",
-			Handler:    _RPCMsg_MsgUnpinAllMessages_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "msg.tl.proto",
-}
-
-func (m *Sender) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *TLMsgDeleteChatHistory) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Sender) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	s := make([]string, 0, 7)
+	s = append(s, "&msg.TLMsgDeleteChatHistory{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "ChatId: "+fmt.Sprintf("%#v", this.ChatId)+",\n")
+	s = append(s, "DeleteUserId: "+fmt.Sprintf("%#v", this.DeleteUserId)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *Sender) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (this *TLMsgReadHistory) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.AuthKeyId != 0 {
-		i = encodeVarintMsgTl(dAtA, i, uint64(m.AuthKeyId))
-		i--
-		dAtA[i] = 0x28
+	s := make([]string, 0, 10)
+	s = append(s, "&msg.TLMsgReadHistory{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
+	s = append(s, "AuthKeyId: "+fmt.Sprintf("%#v", this.AuthKeyId)+",\n")
+	s = append(s, "PeerType: "+fmt.Sprintf("%#v", this.PeerType)+",\n")
+	s = append(s, "PeerId: "+fmt.Sprintf("%#v", this.PeerId)+",\n")
+	s = append(s, "MaxId: "+fmt.Sprintf("%#v", this.MaxId)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
-	if m.Type != 0 {
-		i = encodeVarintMsgTl(dAtA, i, uint64(m.Type))
-		i--
-		dAtA[i] = 0x20
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLMsgUpdatePinnedMessage) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.UserId != 0 {
-		i = encodeVarintMsgTl(dAtA, i, uint64(m.UserId))
-		i--
-		dAtA[i] = 0x18
+	s := make([]string, 0, 13)
+	s = append(s, "&msg.TLMsgUpdatePinnedMessage{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
+	s = append(s, "AuthKeyId: "+fmt.Sprintf("%#v", this.AuthKeyId)+",\n")
+	s = append(s, "Silent: "+fmt.Sprintf("%#v", this.Silent)+",\n")
+	s = append(s, "Unpin: "+fmt.Sprintf("%#v", this.Unpin)+",\n")
+	s = append(s, "PmOneside: "+fmt.Sprintf("%#v", this.PmOneside)+",\n")
+	s = append(s, "PeerType: "+fmt.Sprintf("%#v", this.PeerType)+",\n")
+	s = append(s, "PeerId: "+fmt.Sprintf("%#v", this.PeerId)+",\n")
+	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
-	if m.Constructor != 0 {
-		i = encodeVarintMsgTl(dAtA, i, uint64(m.Constructor))
-		i--
-		dAtA[i] = 0x10
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLMsgUnpinAllMessages) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if len(m.PredicateName) > 0 {
-		i -= len(m.PredicateName)
-		copy(dAtA[i:], m.PredicateName)
-		i = encodeVarintMsgTl(dAtA, i, uint64(len(m.PredicateName)))
-		i--
-		dAtA[i] = 0xa
+	s := make([]string, 0, 9)
+	s = append(s, "&msg.TLMsgUnpinAllMessages{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
+	s = append(s, "AuthKeyId: "+fmt.Sprintf("%#v", this.AuthKeyId)+",\n")
+	s = append(s, "PeerType: "+fmt.Sprintf("%#v", this.PeerType)+",\n")
+	s = append(s, "PeerId: "+fmt.Sprintf("%#v", this.PeerId)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
-	return len(dAtA) - i, nil
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *TLSender) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *TLMsgGetScheduledHistory) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return dAtA[:n], nil
-}
-
-func (m *TLSender) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	s := make([]string, 0, 8)
+	s = append(s, "&msg.TLMsgGetScheduledHistory{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
+	s = append(s, "PeerType: "+fmt.Sprintf("%#v", this.PeerType)+",\n")
+	s = append(s, "PeerId: "+fmt.Sprintf("%#v", this.PeerId)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *TLSender) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (this *TLMsgGetScheduledMessages) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.Data2 != nil {
-		{
-			size, err := m.Data2.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMsgTl(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	s := make([]string, 0, 9)
+	s = append(s, "&msg.TLMsgGetScheduledMessages{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
+	s = append(s, "PeerType: "+fmt.Sprintf("%#v", this.PeerType)+",\n")
+	s = append(s, "PeerId: "+fmt.Sprintf("%#v", this.PeerId)+",\n")
+	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
-	return len(dAtA) - i, nil
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *OutboxMessage) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *TLMsgSendScheduledMessages) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return dAtA[:n], nil
-}
-
-func (m *OutboxMessage) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	s := make([]string, 0, 10)
+	s = append(s, "&msg.TLMsgSendScheduledMessages{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
+	s = append(s, "AuthKeyId: "+fmt.Sprintf("%#v", this.AuthKeyId)+",\n")
+	s = append(s, "PeerType: "+fmt.Sprintf("%#v", this.PeerType)+",\n")
+	s = append(s, "PeerId: "+fmt.Sprintf("%#v", this.PeerId)+",\n")
+	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *OutboxMessage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (this *TLMsgDeleteScheduledMessages) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.ScheduleDate != nil {
-		{
-			size, err := m.ScheduleDate.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMsgTl(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x3a
+	s := make([]string, 0, 10)
+	s = append(s, "&msg.TLMsgDeleteScheduledMessages{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
+	s = append(s, "AuthKeyId: "+fmt.Sprintf("%#v", this.AuthKeyId)+",\n")
+	s = append(s, "PeerType: "+fmt.Sprintf("%#v", this.PeerType)+",\n")
+	s = append(s, "PeerId: "+fmt.Sprintf("%#v", this.PeerId)+",\n")
+	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
-	if m.Message != nil {
-		{
-			size, err := m.Message.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMsgTl(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x32
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLMsgSendReaction) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.RandomId != 0 {
-		i = encodeVarintMsgTl(dAtA, i, uint64(m.RandomId))
-		i--
-		dAtA[i] = 0x28
+	s := make([]string, 0, 12)
+	s = append(s, "&msg.TLMsgSendReaction{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
+	s = append(s, "AuthKeyId: "+fmt.Sprintf("%#v", this.AuthKeyId)+",\n")
+	s = append(s, "Big: "+fmt.Sprintf("%#v", this.Big)+",\n")
+	s = append(s, "PeerType: "+fmt.Sprintf("%#v", this.PeerType)+",\n")
+	s = append(s, "PeerId: "+fmt.Sprintf("%#v", this.PeerId)+",\n")
+	s = append(s, "MsgId: "+fmt.Sprintf("%#v", this.MsgId)+",\n")
+	if this.Reaction != nil {
+		s = append(s, "Reaction: "+fmt.Sprintf("%#v", this.Reaction)+",\n")
 	}
-	if m.Background {
-		i--
-		if m.Background {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x20
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
-	if m.NoWebpage {
-		i--
-		if m.NoWebpage {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x18
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLMsgReadReactions) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.Constructor != 0 {
-		i = encodeVarintMsgTl(dAtA, i, uint64(m.Constructor))
-		i--
-		dAtA[i] = 0x10
+	s := make([]string, 0, 9)
+	s = append(s, "&msg.TLMsgReadReactions{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
+	s = append(s, "AuthKeyId: "+fmt.Sprintf("%#v", this.AuthKeyId)+",\n")
+	s = append(s, "PeerType: "+fmt.Sprintf("%#v", this.PeerType)+",\n")
+	s = append(s, "PeerId: "+fmt.Sprintf("%#v", this.PeerId)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
-	if len(m.PredicateName) > 0 {
-		i -= len(m.PredicateName)
-		copy(dAtA[i:], m.PredicateName)
-		i = encodeVarintMsgTl(dAtA, i, uint64(len(m.PredicateName)))
-		i--
-		dAtA[i] = 0xa
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLMsgGetMessageReactionsList) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return len(dAtA) - i, nil
+	s := make([]string, 0, 12)
+	s = append(s, "&msg.TLMsgGetMessageReactionsList{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
+	s = append(s, "PeerType: "+fmt.Sprintf("%#v", this.PeerType)+",\n")
+	s = append(s, "PeerId: "+fmt.Sprintf("%#v", this.PeerId)+",\n")
+	s = append(s, "MsgId: "+fmt.Sprintf("%#v", this.MsgId)+",\n")
+	if this.Reaction != nil {
+		s = append(s, "Reaction: "+fmt.Sprintf("%#v", this.Reaction)+",\n")
+	}
+	if this.Offset != nil {
+		s = append(s, "Offset: "+fmt.Sprintf("%#v", this.Offset)+",\n")
+	}
+	s = append(s, "Limit: "+fmt.Sprintf("%#v", this.Limit)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *Vector_Message) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&msg.Vector_Message{")
+	if this.Datas != nil {
+		s = append(s, "Datas: "+fmt.Sprintf("%#v", this.Datas)+",\n")
+	}
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringMsgTl(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
 }
 
-func (m *TLOutboxMessage) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// RPCMsgClient is the client API for RPCMsg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type RPCMsgClient interface {
+	// msg.sendMessage user_id:long auth_key_id:long peer_type:int peer_id:long message:OutboxMessage = Updates;
+	MsgSendMessage(ctx context.Context, in *TLMsgSendMessage, opts ...grpc.CallOption) (*mtproto.Updates, error)
+	// msg.sendMultiMessage user_id:long auth_key_id:long peer_type:int peer_id:long message:Vector<OutboxMessage> = Updates;
+	MsgSendMultiMessage(ctx context.Context, in *TLMsgSendMultiMessage, opts ...grpc.CallOption) (*mtproto.Updates, error)
+	// msg.pushUserMessage user_id:long auth_key_id:long peer_type:int peer_id:long push_type:int message:OutboxMessage = Bool;
+	MsgPushUserMessage(ctx context.Context, in *TLMsgPushUserMessage, opts ...grpc.CallOption) (*mtproto.Bool, error)
+	// msg.readMessageContents user_id:long auth_key_id:long peer_type:int peer_id:long id:Vector<ContentMessage> = messages.AffectedMessages;
+	MsgReadMessageContents(ctx context.Context, in *TLMsgReadMessageContents, opts ...grpc.CallOption) (*mtproto.Messages_AffectedMessages, error)
+	// msg.sendMessageV2 user_id:long auth_key_id:long peer_type:int peer_id:long message:Vector<OutboxMessage> = UpdateList;
+	MsgSendMessageV2(ctx context.Context, in *TLMsgSendMessageV2, opts ...grpc.CallOption) (*mtproto.UpdateList, error)
+	// msg.editMessage user_id:long auth_key_id:long peer_type:int peer_id:long message:OutboxMessage = Updates;
+	MsgEditMessage(ctx context.Context, in *TLMsgEditMessage, opts ...grpc.CallOption) (*mtproto.Updates, error)
+	// msg.deleteMessages flags:# user_id:long auth_key_id:long peer_type:int peer_id:long revoke:flags.1?true id:Vector<int> = messages.AffectedMessages;
+	MsgDeleteMessages(ctx context.Context, in *TLMsgDeleteMessages, opts ...grpc.CallOption) (*mtproto.Messages_AffectedMessages, error)
+	// msg.deleteHistory flags:# user_id:long auth_key_id:long peer_type:int peer_id:long just_clear:flags.0?true revoke:flags.1?true max_id:int = messages.AffectedHistory;
+	MsgDeleteHistory(ctx context.Context, in *TLMsgDeleteHistory, opts ...grpc.CallOption) (*mtproto.Messages_AffectedHistory, error)
+	// msg.deletePhoneCallHistory flags:# user_id:long auth_key_id:long revoke:flags.1?true = messages.AffectedFoundMessages;
+	MsgDeletePhoneCallHistory(ctx context.Context, in *TLMsgDeletePhoneCallHistory, opts ...grpc.CallOption) (*mtproto.Messages_AffectedFoundMessages, error)
+	// msg.deleteChatHistory chat_id:long delete_user_id:long = Bool;
+	MsgDeleteChatHistory(ctx context.Context, in *TLMsgDeleteChatHistory, opts ...grpc.CallOption) (*mtproto.Bool, error)
+	// msg.readHistory user_id:long auth_key_id:long peer_type:int peer_id:long max_id:int = messages.AffectedMessages;
+	MsgReadHistory(ctx context.Context, in *TLMsgReadHistory, opts ...grpc.CallOption) (*mtproto.Messages_AffectedMessages, error)
+	// msg.updatePinnedMessage flags:# user_id:long auth_key_id:long silent:flags.0?true unpin:flags.1?true pm_oneside:flags.2?true peer_type:int peer_id:long id:int = Updates;
+	MsgUpdatePinnedMessage(ctx context.Context, in *TLMsgUpdatePinnedMessage, opts ...grpc.CallOption) (*mtproto.Updates, error)
+	// msg.unpinAllMessages user_id:long auth_key_id:long peer_type:int peer_id:long = messages.AffectedHistory;
+	MsgUnpinAllMessages(ctx context.Context, in *TLMsgUnpinAllMessages, opts ...grpc.CallOption) (*mtproto.Messages_AffectedHistory, error)
+	// msg.getScheduledHistory user_id:long peer_type:int peer_id:long = Vector<Message>;
+	MsgGetScheduledHistory(ctx context.Context, in *TLMsgGetScheduledHistory, opts ...grpc.CallOption) (*Vector_Message, error)
+	// msg.getScheduledMessages user_id:long peer_type:int peer_id:long id:Vector<int> = Vector<Message>;
+	MsgGetScheduledMessages(ctx context.Context, in *TLMsgGetScheduledMessages, opts ...grpc.CallOption) (*Vector_Message, error)
+	// msg.sendScheduledMessages user_id:long auth_key_id:long peer_type:int peer_id:long id:Vector<int> = Updates;
+	MsgSendScheduledMessages(ctx context.Context, in *TLMsgSendScheduledMessages, opts ...grpc.CallOption) (*mtproto.Updates, error)
+	// msg.deleteScheduledMessages user_id:long auth_key_id:long peer_type:int peer_id:long id:Vector<int> = Updates;
+	MsgDeleteScheduledMessages(ctx context.Context, in *TLMsgDeleteScheduledMessages, opts ...grpc.CallOption) (*mtproto.Updates, error)
+	// msg.sendReaction flags:# user_id:long auth_key_id:long big:flags.1?true peer_type:int peer_id:long msg_id:int reaction:flags.0?string = Updates;
+	MsgSendReaction(ctx context.Context, in *TLMsgSendReaction, opts ...grpc.CallOption) (*mtproto.Updates, error)
+	// msg.readReactions user_id:long auth_key_id:long peer_type:int peer_id:long = messages.AffectedHistory;
+	MsgReadReactions(ctx context.Context, in *TLMsgReadReactions, opts ...grpc.CallOption) (*mtproto.Messages_AffectedHistory, error)
+	// msg.getMessageReactionsList flags:# user_id:long peer_type:int peer_id:long msg_id:int reaction:flags.0?string offset:flags.1?string limit:int = messages.MessageReactionsList;
+	MsgGetMessageReactionsList(ctx context.Context, in *TLMsgGetMessageReactionsList, opts ...grpc.CallOption) (*mtproto.Messages_MessageReactionsList, error)
+}
+
+type rPCMsgClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewRPCMsgClient(cc *grpc.ClientConn) RPCMsgClient {
+	return &rPCMsgClient{cc}
+}
+
+func (c *rPCMsgClient) MsgSendMessage(ctx context.Context, in *TLMsgSendMessage, opts ...grpc.CallOption) (*mtproto.Updates, error) {
+	out := new(mtproto.Updates)
+	err := c.cc.Invoke(ctx, "/msg.RPCMsg/msg_sendMessage", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *TLOutboxMessage) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *rPCMsgClient) MsgSendMultiMessage(ctx context.Context, in *TLMsgSendMultiMessage, opts ...grpc.CallOption) (*mtproto.Updates, error) {
+	out := new(mtproto.Updates)
+	err := c.cc.Invoke(ctx, "/msg.RPCMsg/msg_sendMultiMessage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *TLOutboxMessage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (c *rPCMsgClient) MsgPushUserMessage(ctx context.Context, in *TLMsgPushUserMessage, opts ...grpc.CallOption) (*mtproto.Bool, error) {
+	out := new(mtproto.Bool)
+	err := c.cc.Invoke(ctx, "/msg.RPCMsg/msg_pushUserMessage", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.Data2 != nil {
-		{
-			size, err := m.Data2.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMsgTl(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	return out, nil
+}
+
+func (c *rPCMsgClient) MsgReadMessageContents(ctx context.Context, in *TLMsgReadMessageContents, opts ...grpc.CallOption) (*mtproto.Messages_AffectedMessages, error) {
+	out := new(mtproto.Messages_AffectedMessages)
+	err := c.cc.Invoke(ctx, "/msg.RPCMsg/msg_readMessageContents", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *ContentMessage) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *rPCMsgClient) MsgSendMessageV2(ctx context.Context, in *TLMsgSendMessageV2, opts ...grpc.CallOption) (*mtproto.UpdateList, error) {
+	out := new(mtproto.UpdateList)
+	err := c.cc.Invoke(ctx, "/msg.RPCMsg/msg_sendMessageV2", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *ContentMessage) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *rPCMsgClient) MsgEditMessage(ctx context.Context, in *TLMsgEditMessage, opts ...grpc.CallOption) (*mtproto.Updates, error) {
+	out := new(mtproto.Updates)
+	err := c.cc.Invoke(ctx, "/msg.RPCMsg/msg_editMessage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *ContentMessage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (c *rPCMsgClient) MsgDeleteMessages(ctx context.Context, in *TLMsgDeleteMessages, opts ...grpc.CallOption) (*mtproto.Messages_AffectedMessages, error) {
+	out := new(mtproto.Messages_AffectedMessages)
+	err := c.cc.Invoke(ctx, "/msg.RPCMsg/msg_deleteMessages", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.Reaction {
-		i--
-		if m.Reaction {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x30
-	}
-	if m.MediaUnread {
-		i--
-		if m.MediaUnread {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.Mentioned {
-		i--
-		if m.Mentioned {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.Id != 0 {
-		i = encodeVarintMsgTl(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.Constructor != 0 {
-		i = encodeVarintMsgTl(dAtA, i, uint64(m.Constructor))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.PredicateName) > 0 {
-		i -= len(m.PredicateName)
-		copy(dAtA[i:], m.PredicateName)
-		i = encodeVarintMsgTl(dAtA, i, uint64(len(m.PredicateName)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *TLContentMessage) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *rPCMsgClient) MsgDeleteHistory(ctx context.Context, in *TLMsgDeleteHistory, opts ...grpc.CallOption) (*mtproto.Messages_AffectedHistory, error) {
+	out := new(mtproto.Messages_AffectedHistory)
+	err := c.cc.Invoke(ctx, "/msg.RPCMsg/msg_deleteHistory", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *TLContentMessage) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *rPCMsgClient) MsgDeletePhoneCallHistory(ctx context.Context, in *TLMsgDeletePhoneCallHistory, opts ...grpc.CallOption) (*mtproto.Messages_AffectedFoundMessages, error) {
+	out := new(mtproto.Messages_AffectedFoundMessages)
+	err := c.cc.Invoke(ctx, "/msg.RPCMsg/msg_deletePhoneCallHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *TLContentMessage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Data2 != nil {
-		{
-			size, err := m.Data2.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMsgTl(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (c *rPCMsgClient) MsgDeleteChatHistory(ctx context.Context, in *TLMsgDeleteChatHistory, opts ...grpc.CallOption) (*mtproto.Bool, error) {
+	out := new(mtproto.Bool)
+	err := c.cc.Invoke(ctx, "/msg.RPCMsg/msg_deleteChatHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *TLMsgSendMessage) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *rPCMsgClient) MsgReadHistory(ctx context.Context, in *TLMsgReadHistory, opts ...grpc.CallOption) (*mtproto.Messages_AffectedMessages, error) {
+	out := new(mtproto.Messages_AffectedMessages)
+	err := c.cc.Invoke(ctx, "/msg.RPCMsg/msg_readHistory", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *TLMsgSendMessage) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *rPCMsgClient) MsgUpdatePinnedMessage(ctx context.Context, in *TLMsgUpdatePinnedMessage, opts ...grpc.CallOption) (*mtproto.Updates, error) {
+	out := new(mtproto.Updates)
+	err := c.cc.Invoke(ctx, "/msg.RPCMsg/msg_updatePinnedMessage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *TLMsgSendMessage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Message != nil {
-		{
-			size, err := m.Message.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMsgTl(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x3a
-	}
-	if m.PeerId != 0 {
-		i = encodeVarintMsgTl(dAtA, i, uint64(m.PeerId))
-		i--
-		dAtA[i] = 0x30
-	}
-	if m.PeerType != 0 {
-		i = encodeVarintMsgTl(dAtA, i, uint64(m.PeerType))
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.AuthKeyId != 0 {
-		i = encodeVarintMsgTl(dAtA, i, uint64(m.AuthKeyId))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.UserId != 0 {
-		i = encodeVarintMsgTl(dAtA, i, uint64(m.UserId))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.Constructor != 0 {
-		i = encodeVarintMsgTl(dAtA, i, uint64(m.Constructor))
-		i--
-		dAtA[i] = 0x8
+func (c *rPCMsgClient) MsgUnpinAllMessages(ctx context.Context, in *TLMsgUnpinAllMessages, opts ...grpc.CallOption) (*mtproto.Messages_AffectedHistory, error) {
+	out := new(mtproto.Messages_AffectedHistory)
+	err := c.cc.Invoke(ctx, "/msg.RPCMsg/msg_unpinAllMessages", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *TLMsgSendMultiMessage) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *rPCMsgClient) MsgGetScheduledHistory(ctx context.Context, in *TLMsgGetScheduledHistory, opts ...grpc.CallOption) (*Vector_Message, error) {
+	out := new(Vector_Message)
+	err := c.cc.Invoke(ctx, "/msg.RPCMsg/msg_getScheduledHistory", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *TLMsgSendMultiMessage) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *rPCMsgClient) MsgGetScheduledMessages(ctx context.Context, in *TLMsgGetScheduledMessages, opts ...grpc.CallOption) (*Vector_Message, error) {
+	out := new(Vector_Message)
+	err := c.cc.Invoke(ctx, "/msg.RPCMsg/msg_getScheduledMessages", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *TLMsgSendMultiMessage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Message) > 0 {
-		for iNdEx := len(m.Message) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Message[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintMsgTl(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x3a
-		}
-	}
-	if m.PeerId != 0 {
-		i = encodeVarintMsgTl(dAtA, i, uint64(m.PeerId))
-		i--
-		dAtA[i] = 0x30
-	}
-	if m.PeerType != 0 {
-		i = encodeVarintMsgTl(dAtA, i, uint64(m.PeerType))
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.AuthKeyId != 0 {
-		i = encodeVarintMsgTl(dAtA, i, uint64(m.AuthKeyId))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.UserId != 0 {
-		i = encodeVarintMsgTl(dAtA, i, uint64(m.UserId))
-		i--
-		dAtA[i] = 0x18
+func (c *rPCMsgClient) MsgSendScheduledMessages(ctx context.Context, in *TLMsgSendScheduledMessages, opts ...grpc.CallOption) (*mtproto.Updates, error) {
+	out := new(mtproto.Updates)
+	err := c.cc.Invoke(ctx, "/msg.RPCMsg/msg_sendScheduledMessages", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.Constructor != 0 {
-		i = encodeVarintMsgTl(dAtA, i, uint64(m.Constructor))
-		i--
-		dAtA[i] = 0x8
+	return out, nil
+}
+
+func (c *rPCMsgClient) MsgDeleteScheduledMessages(ctx context.Context, in *TLMsgDeleteScheduledMessages, opts ...grpc.CallOption) (*mtproto.Updates, error) {
+	out := new(mtproto.Updates)
+	err := c.cc.Invoke(ctx, "/msg.RPCMsg/msg_deleteScheduledMessages", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *TLMsgPushUserMessage) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *rPCMsgClient) MsgSendReaction(ctx context.Context, in *TLMsgSendReaction, opts ...grpc.CallOption) (*mtproto.Updates, error) {
+	out := new(mtproto.Updates)
+	err := c.cc.Invoke(ctx, "/msg.RPCMsg/msg_sendReaction", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *TLMsgPushUserMessage) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *rPCMsgClient) MsgReadReactions(ctx context.Context, in *TLMsgReadReactions, opts ...grpc.CallOption) (*mtproto.Messages_AffectedHistory, error) {
+	out := new(mtproto.Messages_AffectedHistory)
+	err := c.cc.Invoke(ctx, "/msg.RPCMsg/msg_readReactions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *TLMsgPushUserMessage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (c *rPCMsgClient) MsgGetMessageReactionsList(ctx context.Context, in *TLMsgGetMessageReactionsList, opts ...grpc.CallOption) (*mtproto.Messages_MessageReactionsList, error) {
+	out := new(mtproto.Messages_MessageReactionsList)
+	err := c.cc.Invoke(ctx, "/msg.RPCMsg/msg_getMessageReactionsList", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.Message != nil {
-		{
-			size, err := m.Message.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMsgTl(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x42
+	return out, nil
+}
+
+// RPCMsgServer is the server API for RPCMsg service.
+type RPCMsgServer interface {
+	// msg.sendMessage user_id:long auth_key_id:long peer_type:int peer_id:long message:OutboxMessage = Updates;
+	MsgSendMessage(context.Context, *TLMsgSendMessage) (*mtproto.Updates, error)
+	// msg.sendMultiMessage user_id:long auth_key_id:long peer_type:int peer_id:long message:Vector<OutboxMessage> = Updates;
+	MsgSendMultiMessage(context.Context, *TLMsgSendMultiMessage) (*mtproto.Updates, error)
+	// msg.pushUserMessage user_id:long auth_key_id:long peer_type:int peer_id:long push_type:int message:OutboxMessage = Bool;
+	MsgPushUserMessage(context.Context, *TLMsgPushUserMessage) (*mtproto.Bool, error)
+	// msg.readMessageContents user_id:long auth_key_id:long peer_type:int peer_id:long id:Vector<ContentMessage> = messages.AffectedMessages;
+	MsgReadMessageContents(context.Context, *TLMsgReadMessageContents) (*mtproto.Messages_AffectedMessages, error)
+	// msg.sendMessageV2 user_id:long auth_key_id:long peer_type:int peer_id:long message:Vector<OutboxMessage> = UpdateList;
+	MsgSendMessageV2(context.Context, *TLMsgSendMessageV2) (*mtproto.UpdateList, error)
+	// msg.editMessage user_id:long auth_key_id:long peer_type:int peer_id:long message:OutboxMessage = Updates;
+	MsgEditMessage(context.Context, *TLMsgEditMessage) (*mtproto.Updates, error)
+	// msg.deleteMessages flags:# user_id:long auth_key_id:long peer_type:int peer_id:long revoke:flags.1?true id:Vector<int> = messages.AffectedMessages;
+	MsgDeleteMessages(context.Context, *TLMsgDeleteMessages) (*mtproto.Messages_AffectedMessages, error)
+	// msg.deleteHistory flags:# user_id:long auth_key_id:long peer_type:int peer_id:long just_clear:flags.0?true revoke:flags.1?true max_id:int = messages.AffectedHistory;
+	MsgDeleteHistory(context.Context, *TLMsgDeleteHistory) (*mtproto.Messages_AffectedHistory, error)
+	// msg.deletePhoneCallHistory flags:# user_id:long auth_key_id:long revoke:flags.1?true = messages.AffectedFoundMessages;
+	MsgDeletePhoneCallHistory(context.Context, *TLMsgDeletePhoneCallHistory) (*mtproto.Messages_AffectedFoundMessages, error)
+	// msg.deleteChatHistory chat_id:long delete_user_id:long = Bool;
+	MsgDeleteChatHistory(context.Context, *TLMsgDeleteChatHistory) (*mtproto.Bool, error)
+	// msg.readHistory user_id:long auth_key_id:long peer_type:int peer_id:long max_id:int = messages.AffectedMessages;
+	MsgReadHistory(context.Context, *TLMsgReadHistory) (*mtproto.Messages_AffectedMessages, error)
+	// msg.updatePinnedMessage flags:# user_id:long auth_key_id:long silent:flags.0?true unpin:flags.1?true pm_oneside:flags.2?true peer_type:int peer_id:long id:int = Updates;
+	MsgUpdatePinnedMessage(context.Context, *TLMsgUpdatePinnedMessage) (*mtproto.Updates, error)
+	// msg.unpinAllMessages user_id:long auth_key_id:long peer_type:int peer_id:long = messages.AffectedHistory;
+	MsgUnpinAllMessages(context.Context, *TLMsgUnpinAllMessages) (*mtproto.Messages_AffectedHistory, error)
+	// msg.getScheduledHistory user_id:long peer_type:int peer_id:long = Vector<Message>;
+	MsgGetScheduledHistory(context.Context, *TLMsgGetScheduledHistory) (*Vector_Message, error)
+	// msg.getScheduledMessages user_id:long peer_type:int peer_id:long id:Vector<int> = Vector<Message>;
+	MsgGetScheduledMessages(context.Context, *TLMsgGetScheduledMessages) (*Vector_Message, error)
+	// msg.sendScheduledMessages user_id:long auth_key_id:long peer_type:int peer_id:long id:Vector<int> = Updates;
+	MsgSendScheduledMessages(context.Context, *TLMsgSendScheduledMessages) (*mtproto.Updates, error)
+	// msg.deleteScheduledMessages user_id:long auth_key_id:long peer_type:int peer_id:long id:Vector<int> = Updates;
+	MsgDeleteScheduledMessages(context.Context, *TLMsgDeleteScheduledMessages) (*mtproto.Updates, error)
+	// msg.sendReaction flags:# user_id:long auth_key_id:long big:flags.1?true peer_type:int peer_id:long msg_id:int reaction:flags.0?string = Updates;
+	MsgSendReaction(context.Context, *TLMsgSendReaction) (*mtproto.Updates, error)
+	// msg.readReactions user_id:long auth_key_id:long peer_type:int peer_id:long = messages.AffectedHistory;
+	MsgReadReactions(context.Context, *TLMsgReadReactions) (*mtproto.Messages_AffectedHistory, error)
+	// msg.getMessageReactionsList flags:# user_id:long peer_type:int peer_id:long msg_id:int reaction:flags.0?string offset:flags.1?string limit:int = messages.MessageReactionsList;
+	MsgGetMessageReactionsList(context.Context, *TLMsgGetMessageReactionsList) (*mtproto.Messages_MessageReactionsList, error)
+}
+
+// UnimplementedRPCMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedRPCMsgServer struct {
+}
+
+func (*UnimplementedRPCMsgServer) MsgSendMessage(ctx context.Context, req *TLMsgSendMessage) (*mtproto.Updates, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MsgSendMessage not implemented")
+}
+func (*UnimplementedRPCMsgServer) MsgSendMultiMessage(ctx context.Context, req *TLMsgSendMultiMessage) (*mtproto.Updates, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MsgSendMultiMessage not implemented")
+}
+func (*UnimplementedRPCMsgServer) MsgPushUserMessage(ctx context.Context, req *TLMsgPushUserMessage) (*mtproto.Bool, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MsgPushUserMessage not implemented")
+}
+func (*UnimplementedRPCMsgServer) MsgReadMessageContents(ctx context.Context, req *TLMsgReadMessageContents) (*mtproto.Messages_AffectedMessages, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MsgReadMessageContents not implemented")
+}
+func (*UnimplementedRPCMsgServer) MsgSendMessageV2(ctx context.Context, req *TLMsgSendMessageV2) (*mtproto.UpdateList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MsgSendMessageV2 not implemented")
+}
+func (*UnimplementedRPCMsgServer) MsgEditMessage(ctx context.Context, req *TLMsgEditMessage) (*mtproto.Updates, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MsgEditMessage not implemented")
+}
+func (*UnimplementedRPCMsgServer) MsgDeleteMessages(ctx context.Context, req *TLMsgDeleteMessages) (*mtproto.Messages_AffectedMessages, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MsgDeleteMessages not implemented")
+}
+func (*UnimplementedRPCMsgServer) MsgDeleteHistory(ctx context.Context, req *TLMsgDeleteHistory) (*mtproto.Messages_AffectedHistory, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MsgDeleteHistory not implemented")
+}
+func (*UnimplementedRPCMsgServer) MsgDeletePhoneCallHistory(ctx context.Context, req *TLMsgDeletePhoneCallHistory) (*mtproto.Messages_AffectedFoundMessages, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MsgDeletePhoneCallHistory not implemented")
+}
+func (*UnimplementedRPCMsgServer) MsgDeleteChatHistory(ctx context.Context, req *TLMsgDeleteChatHistory) (*mtproto.Bool, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MsgDeleteChatHistory not implemented")
+}
+func (*UnimplementedRPCMsgServer) MsgReadHistory(ctx context.Context, req *TLMsgReadHistory) (*mtproto.Messages_AffectedMessages, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MsgReadHistory not implemented")
+}
+func (*UnimplementedRPCMsgServer) MsgUpdatePinnedMessage(ctx context.Context, req *TLMsgUpdatePinnedMessage) (*mtproto.Updates, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MsgUpdatePinnedMessage not implemented")
+}
+func (*UnimplementedRPCMsgServer) MsgUnpinAllMessages(ctx context.Context, req *TLMsgUnpinAllMessages) (*mtproto.Messages_AffectedHistory, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MsgUnpinAllMessages not implemented")
+}
+func (*UnimplementedRPCMsgServer) MsgGetScheduledHistory(ctx context.Context, req *TLMsgGetScheduledHistory) (*Vector_Message, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MsgGetScheduledHistory not implemented")
+}
+func (*UnimplementedRPCMsgServer) MsgGetScheduledMessages(ctx context.Context, req *TLMsgGetScheduledMessages) (*Vector_Message, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MsgGetScheduledMessages not implemented")
+}
+func (*UnimplementedRPCMsgServer) MsgSendScheduledMessages(ctx context.Context, req *TLMsgSendScheduledMessages) (*mtproto.Updates, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MsgSendScheduledMessages not implemented")
+}
+func (*UnimplementedRPCMsgServer) MsgDeleteScheduledMessages(ctx context.Context, req *TLMsgDeleteScheduledMessages) (*mtproto.Updates, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MsgDeleteScheduledMessages not implemented")
+}
+func (*UnimplementedRPCMsgServer) MsgSendReaction(ctx context.Context, req *TLMsgSendReaction) (*mtproto.Updates, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MsgSendReaction not implemented")
+}
+func (*UnimplementedRPCMsgServer) MsgReadReactions(ctx context.Context, req *TLMsgReadReactions) (*mtproto.Messages_AffectedHistory, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MsgReadReactions not implemented")
+}
+func (*UnimplementedRPCMsgServer) MsgGetMessageReactionsList(ctx context.Context, req *TLMsgGetMessageReactionsList) (*mtproto.Messages_MessageReactionsList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MsgGetMessageReactionsList not implemented")
+}
+
+func RegisterRPCMsgServer(s *grpc.Server, srv RPCMsgServer) {
+	s.RegisterService(&_RPCMsg_serviceDesc, srv)
+}
+
+func _RPCMsg_MsgSendMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLMsgSendMessage)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.PushType != 0 {
-		i = encodeVarintMsgTl(dAtA, i, uint64(m.PushType))
-		i--
-		dAtA[i] = 0x38
+	if interceptor == nil {
+		return srv.(RPCMsgServer).MsgSendMessage(ctx, in)
 	}
-	if m.PeerId != 0 {
-		i = encodeVarintMsgTl(dAtA, i, uint64(m.PeerId))
-		i--
-		dAtA[i] = 0x30
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/msg.RPCMsg/MsgSendMessage",
 	}
-	if m.PeerType != 0 {
-		i = encodeVarintMsgTl(dAtA, i, uint64(m.PeerType))
-		i--
-		dAtA[i] = 0x28
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCMsgServer).MsgSendMessage(ctx, req.(*TLMsgSendMessage))
 	}
-	if m.AuthKeyId != 0 {
-		i = encodeVarintMsgTl(dAtA, i, uint64(m.AuthKeyId))
-		i--
-		dAtA[i] = 0x20
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCMsg_MsgSendMultiMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLMsgSendMultiMessage)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.UserId != 0 {
-		i = encodeVarintMsgTl(dAtA, i, uint64(m.UserId))
-		i--
-		dAtA[i] = 0x18
+	if interceptor == nil {
+		return srv.(RPCMsgServer).MsgSendMultiMessage(ctx, in)
 	}
-	if m.Constructor != 0 {
-		i = encodeVarintMsgTl(dAtA, i, uint64(m.Constructor))
-		i--
-		dAtA[i] = 0x8
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/msg.RPCMsg/MsgSendMultiMessage",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCMsgServer).MsgSendMultiMessage(ctx, req.(*TLMsgSendMultiMessage))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *TLMsgReadMessageContents) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _RPCMsg_MsgPushUserMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLMsgPushUserMessage)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *TLMsgReadMessageContents) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(RPCMsgServer).MsgPushUserMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/msg.RPCMsg/MsgPushUserMessage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCMsgServer).MsgPushUserMessage(ctx, req.(*TLMsgPushUserMessage))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *TLMsgReadMessageContents) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func _RPCMsg_MsgReadMessageContents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLMsgReadMessageContents)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Id) > 0 {
-		for iNdEx := len(m.Id) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Id[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintMsgTl(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x3a
-		}
+	if interceptor == nil {
+		return srv.(RPCMsgServer).MsgReadMessageContents(ctx, in)
 	}
-	if m.PeerId != 0 {
-		i = encodeVarintMsgTl(dAtA, i, uint64(m.PeerId))
-		i--
-		dAtA[i] = 0x30
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/msg.RPCMsg/MsgReadMessageContents",
 	}
-	if m.PeerType != 0 {
-		i = encodeVarintMsgTl(dAtA, i, uint64(m.PeerType))
-		i--
-		dAtA[i] = 0x28
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCMsgServer).MsgReadMessageContents(ctx, req.(*TLMsgReadMessageContents))
 	}
-	if m.AuthKeyId != 0 {
-		i = encodeVarintMsgTl(dAtA, i, uint64(m.AuthKeyId))
-		i--
-		dAtA[i] = 0x20
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCMsg_MsgSendMessageV2_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLMsgSendMessageV2)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.UserId != 0 {
-		i = encodeVarintMsgTl(dAtA, i, uint64(m.UserId))
-		i--
-		dAtA[i] = 0x18
+	if interceptor == nil {
+		return srv.(RPCMsgServer).MsgSendMessageV2(ctx, in)
 	}
-	if m.Constructor != 0 {
-		i = encodeVarintMsgTl(dAtA, i, uint64(m.Constructor))
-		i--
-		dAtA[i] = 0x8
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/msg.RPCMsg/MsgSendMessageV2",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCMsgServer).MsgSendMessageV2(ctx, req.(*TLMsgSendMessageV2))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *TLMsgSendMessageV2) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _RPCMsg_MsgEditMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLMsgEditMessage)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(RPCMsgServer).MsgEditMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/msg.RPCMsg/MsgEditMessage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCMsgServer).MsgEditMessage(ctx, req.(*TLMsgEditMessage))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *TLMsgSendMessageV2) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *TLMsgSendMessageV2) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func _RPCMsg_MsgDeleteMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLMsgDeleteMessages)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Message) > 0 {
-		for iNdEx := len(m.Message) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Message[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintMsgTl(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x3a
-		}
+	if interceptor == nil {
+		return srv.(RPCMsgServer).MsgDeleteMessages(ctx, in)
 	}
-	if m.PeerId != 0 {
-		i = encodeVarintMsgTl(dAtA, i, uint64(m.PeerId))
-		i--
-		dAtA[i] = 0x30
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/msg.RPCMsg/MsgDeleteMessages",
 	}
-	if m.PeerType != 0 {
-		i = encodeVarintMsgTl(dAtA, i, uint64(m.PeerType))
-		i--
-		dAtA[i] = 0x28
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCMsgServer).MsgDeleteMessages(ctx, req.(*TLMsgDeleteMessages))
 	}
-	if m.AuthKeyId != 0 {
-		i = encodeVarintMsgTl(dAtA, i, uint64(m.AuthKeyId))
-		i--
-		dAtA[i] = 0x20
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCMsg_MsgDeleteHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLMsgDeleteHistory)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.UserId != 0 {
-		i = encodeVarintMsgTl(dAtA, i, uint64(m.UserId))
-		i--
-		dAtA[i] = 0x18
+	if interceptor == nil {
+		return srv.(RPCMsgServer).MsgDeleteHistory(ctx, in)
 	}
-	if m.Constructor != 0 {
-		i = encodeVarintMsgTl(dAtA, i, uint64(m.Constructor))
-		i--
-		dAtA[i] = 0x8
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/msg.RPCMsg/MsgDeleteHistory",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCMsgServer).MsgDeleteHistory(ctx, req.(*TLMsgDeleteHistory))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *TLMsgEditMessage) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _RPCMsg_MsgDeletePhoneCallHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLMsgDeletePhoneCallHistory)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *TLMsgEditMessage) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *TLMsgEditMessage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	if interceptor == nil {
+		return srv.(RPCMsgServer).MsgDeletePhoneCallHistory(ctx, in)
 	}
-	if m.Message != nil {
-		{
-			size, err := m.Message.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMsgTl(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x3a
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/msg.RPCMsg/MsgDeletePhoneCallHistory",
 	}
-	if m.PeerId != 0 {
-		i = encodeVarintMsgTl(dAtA, i, uint64(m.PeerId))
-		i--
-		dAtA[i] = 0x30
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCMsgServer).MsgDeletePhoneCallHistory(ctx, req.(*TLMsgDeletePhoneCallHistory))
 	}
-	if m.PeerType != 0 {
-		i = encodeVarintMsgTl(dAtA, i, uint64(m.PeerType))
-		i--
-		dAtA[i] = 0x28
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCMsg_MsgDeleteChatHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLMsgDeleteChatHistory)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.AuthKeyId != 0 {
-		i = encodeVarintMsgTl(dAtA, i, uint64(m.AuthKeyId))
-		i--
-		dAtA[i] = 0x20
+	if interceptor == nil {
+		return srv.(RPCMsgServer).MsgDeleteChatHistory(ctx, in)
 	}
-	if m.UserId != 0 {
-		i = encodeVarintMsgTl(dAtA, i, uint64(m.UserId))
-		i--
-		dAtA[i] = 0x18
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/msg.RPCMsg/MsgDeleteChatHistory",
 	}
-	if m.Constructor != 0 {
-		i = encodeVarintMsgTl(dAtA, i, uint64(m.Constructor))
-		i--
-		dAtA[i] = 0x8
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCMsgServer).MsgDeleteChatHistory(ctx, req.(*TLMsgDeleteChatHistory))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *TLMsgDeleteMessages) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _RPCMsg_MsgReadHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLMsgReadHistory)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *TLMsgDeleteMessages) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(RPCMsgServer).MsgReadHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/msg.RPCMsg/MsgReadHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCMsgServer).MsgReadHistory(ctx, req.(*TLMsgReadHistory))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *TLMsgDeleteMessages) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func _RPCMsg_MsgUpdatePinnedMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLMsgUpdatePinnedMessage)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Id) > 0 {
-		dAtA10 := make([]byte, len(m.Id)*10)
-		var j9 int
-		for _, num1 := range m.Id {
-			num := uint64(num1)
-			for num >= 1<<7 {
-				dAtA10[j9] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j9++
-			}
-			dAtA10[j9] = uint8(num)
-			j9++
-		}
-		i -= j9
-		copy(dAtA[i:], dAtA10[:j9])
-		i = encodeVarintMsgTl(dAtA, i, uint64(j9))
-		i--
-		dAtA[i] = 0x42
+	if interceptor == nil {
+		return srv.(RPCMsgServer).MsgUpdatePinnedMessage(ctx, in)
 	}
-	if m.Revoke {
-		i--
-		if m.Revoke {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x38
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/msg.RPCMsg/MsgUpdatePinnedMessage",
 	}
-	if m.PeerId != 0 {
-		i = encodeVarintMsgTl(dAtA, i, uint64(m.PeerId))
-		i--
-		dAtA[i] = 0x30
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCMsgServer).MsgUpdatePinnedMessage(ctx, req.(*TLMsgUpdatePinnedMessage))
 	}
-	if m.PeerType != 0 {
-		i = encodeVarintMsgTl(dAtA, i, uint64(m.PeerType))
-		i--
-		dAtA[i] = 0x28
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCMsg_MsgUnpinAllMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLMsgUnpinAllMessages)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.AuthKeyId != 0 {
-		i = encodeVarintMsgTl(dAtA, i, uint64(m.AuthKeyId))
-		i--
-		dAtA[i] = 0x20
+	if interceptor == nil {
+		return srv.(RPCMsgServer).MsgUnpinAllMessages(ctx, in)
 	}
-	if m.UserId != 0 {
-		i = encodeVarintMsgTl(dAtA, i, uint64(m.UserId))
-		i--
-		dAtA[i] = 0x18
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/msg.RPCMsg/MsgUnpinAllMessages",
 	}
-	if m.Constructor != 0 {
-		i = encodeVarintMsgTl(dAtA, i, uint64(m.Constructor))
-		i--
-		dAtA[i] = 0x8
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCMsgServer).MsgUnpinAllMessages(ctx, req.(*TLMsgUnpinAllMessages))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *TLMsgDeleteHistory) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _RPCMsg_MsgGetScheduledHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLMsgGetScheduledHistory)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(RPCMsgServer).MsgGetScheduledHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/msg.RPCMsg/MsgGetScheduledHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCMsgServer).MsgGetScheduledHistory(ctx, req.(*TLMsgGetScheduledHistory))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *TLMsgDeleteHistory) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _RPCMsg_MsgGetScheduledMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLMsgGetScheduledMessages)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCMsgServer).MsgGetScheduledMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/msg.RPCMsg/MsgGetScheduledMessages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCMsgServer).MsgGetScheduledMessages(ctx, req.(*TLMsgGetScheduledMessages))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *TLMsgDeleteHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func _RPCMsg_MsgSendScheduledMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLMsgSendScheduledMessages)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.MaxId != 0 {
-		i = encodeVarintMsgTl(dAtA, i, uint64(m.MaxId))
-		i--
-		dAtA[i] = 0x48
+	if interceptor == nil {
+		return srv.(RPCMsgServer).MsgSendScheduledMessages(ctx, in)
 	}
-	if m.Revoke {
-		i--
-		if m.Revoke {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x40
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/msg.RPCMsg/MsgSendScheduledMessages",
 	}
-	if m.JustClear {
-		i--
-		if m.JustClear {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x38
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCMsgServer).MsgSendScheduledMessages(ctx, req.(*TLMsgSendScheduledMessages))
 	}
-	if m.PeerId != 0 {
-		i = encodeVarintMsgTl(dAtA, i, uint64(m.PeerId))
-		i--
-		dAtA[i] = 0x30
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCMsg_MsgDeleteScheduledMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLMsgDeleteScheduledMessages)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.PeerType != 0 {
-		i = encodeVarintMsgTl(dAtA, i, uint64(m.PeerType))
-		i--
-		dAtA[i] = 0x28
+	if interceptor == nil {
+		return srv.(RPCMsgServer).MsgDeleteScheduledMessages(ctx, in)
 	}
-	if m.AuthKeyId != 0 {
-		i = encodeVarintMsgTl(dAtA, i, uint64(m.AuthKeyId))
-		i--
-		dAtA[i] = 0x20
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/msg.RPCMsg/MsgDeleteScheduledMessages",
 	}
-	if m.UserId != 0 {
-		i = encodeVarintMsgTl(dAtA, i, uint64(m.UserId))
-		i--
-		dAtA[i] = 0x18
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCMsgServer).MsgDeleteScheduledMessages(ctx, req.(*TLMsgDeleteScheduledMessages))
 	}
-	if m.Constructor != 0 {
-		i = encodeVarintMsgTl(dAtA, i, uint64(m.Constructor))
-		i--
-		dAtA[i] = 0x8
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCMsg_MsgSendReaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLMsgSendReaction)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(RPCMsgServer).MsgSendReaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/msg.RPCMsg/MsgSendReaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCMsgServer).MsgSendReaction(ctx, req.(*TLMsgSendReaction))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *TLMsgDeletePhoneCallHistory) Marshal() (dAtA []byte, err error) {
+func _RPCMsg_MsgReadReactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLMsgReadReactions)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCMsgServer).MsgReadReactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/msg.RPCMsg/MsgReadReactions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCMsgServer).MsgReadReactions(ctx, req.(*TLMsgReadReactions))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCMsg_MsgGetMessageReactionsList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLMsgGetMessageReactionsList)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCMsgServer).MsgGetMessageReactionsList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/msg.RPCMsg/MsgGetMessageReactionsList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCMsgServer).MsgGetMessageReactionsList(ctx, req.(*TLMsgGetMessageReactionsList))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _RPCMsg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "msg.RPCMsg",
+	HandlerType: (*RPCMsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "msg_sendMessage",
+			Handler:    _RPCMsg_MsgSendMessage_Handler,
+		},
+		{
+			MethodName: "msg_sendMultiMessage",
+			Handler:    _RPCMsg_MsgSendMultiMessage_Handler,
+		},
+		{
+			MethodName: "msg_pushUserMessage",
+			Handler:    _RPCMsg_MsgPushUserMessage_Handler,
+		},
+		{
+			MethodName: "msg_readMessageContents",
+			Handler:    _RPCMsg_MsgReadMessageContents_Handler,
+		},
+		{
+			MethodName: "msg_sendMessageV2",
+			Handler:    _RPCMsg_MsgSendMessageV2_Handler,
+		},
+		{
+			MethodName: "msg_editMessage",
+			Handler:    _RPCMsg_MsgEditMessage_Handler,
+		},
+		{
+			MethodName: "msg_deleteMessages",
+			Handler:    _RPCMsg_MsgDeleteMessages_Handler,
+		},
+		{
+			MethodName: "msg_deleteHistory",
+			Handler:    _RPCMsg_MsgDeleteHistory_Handler,
+		},
+		{
+			MethodName: "msg_deletePhoneCallHistory",
+			Handler:    _RPCMsg_MsgDeletePhoneCallHistory_Handler,
+		},
+		{
+			MethodName: "msg_deleteChatHistory",
+			Handler:    _RPCMsg_MsgDeleteChatHistory_Handler,
+		},
+		{
+			MethodName: "msg_readHistory",
+			Handler:    _RPCMsg_MsgReadHistory_Handler,
+		},
+		{
+			MethodName: "msg_updatePinnedMessage",
+			Handler:    _RPCMsg_MsgUpdatePinnedMessage_Handler,
+		},
+		{
+			MethodName: "msg_unpinAllMessages",
+			Handler:    _RPCMsg_MsgUnpinAllMessages_Handler,
+		},
+		{
+			MethodName: "msg_getScheduledHistory",
+			Handler:    _RPCMsg_MsgGetScheduledHistory_Handler,
+		},
+		{
+			MethodName: "msg_getScheduledMessages",
+			Handler:    _RPCMsg_MsgGetScheduledMessages_Handler,
+		},
+		{
+			MethodName: "msg_sendScheduledMessages",
+			Handler:    _RPCMsg_MsgSendScheduledMessages_Handler,
+		},
+		{
+			MethodName: "msg_deleteScheduledMessages",
+			Handler:    _RPCMsg_MsgDeleteScheduledMessages_Handler,
+		},
+		{
+			MethodName: "msg_sendReaction",
+			Handler:    _RPCMsg_MsgSendReaction_Handler,
+		},
+		{
+			MethodName: "msg_readReactions",
+			Handler:    _RPCMsg_MsgReadReactions_Handler,
+		},
+		{
+			MethodName: "msg_getMessageReactionsList",
+			Handler:    _RPCMsg_MsgGetMessageReactionsList_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "msg.tl.proto",
+}
+
+func (m *Sender) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3556,12 +3858,12 @@ func (m *TLMsgDeletePhoneCallHistory) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TLMsgDeletePhoneCallHistory) MarshalTo(dAtA []byte) (int, error) {
+func (m *Sender) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TLMsgDeletePhoneCallHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Sender) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3570,18 +3872,13 @@ func (m *TLMsgDeletePhoneCallHistory) MarshalToSizedBuffer(dAtA []byte) (int, er
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Revoke {
-		i--
-		if m.Revoke {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+	if m.AuthKeyId != 0 {
+		i = encodeVarintMsgTl(dAtA, i, uint64(m.AuthKeyId))
 		i--
 		dAtA[i] = 0x28
 	}
-	if m.AuthKeyId != 0 {
-		i = encodeVarintMsgTl(dAtA, i, uint64(m.AuthKeyId))
+	if m.Type != 0 {
+		i = encodeVarintMsgTl(dAtA, i, uint64(m.Type))
 		i--
 		dAtA[i] = 0x20
 	}
@@ -3593,12 +3890,19 @@ func (m *TLMsgDeletePhoneCallHistory) MarshalToSizedBuffer(dAtA []byte) (int, er
 	if m.Constructor != 0 {
 		i = encodeVarintMsgTl(dAtA, i, uint64(m.Constructor))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x10
+	}
+	if len(m.PredicateName) > 0 {
+		i -= len(m.PredicateName)
+		copy(dAtA[i:], m.PredicateName)
+		i = encodeVarintMsgTl(dAtA, i, uint64(len(m.PredicateName)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *TLMsgDeleteChatHistory) Marshal() (dAtA []byte, err error) {
+func (m *TLSender) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3608,12 +3912,12 @@ func (m *TLMsgDeleteChatHistory) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TLMsgDeleteChatHistory) MarshalTo(dAtA []byte) (int, error) {
+func (m *TLSender) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TLMsgDeleteChatHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TLSender) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3622,25 +3926,22 @@ func (m *TLMsgDeleteChatHistory) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.DeleteUserId != 0 {
-		i = encodeVarintMsgTl(dAtA, i, uint64(m.DeleteUserId))
+	if m.Data2 != nil {
+		{
+			size, err := m.Data2.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMsgTl(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x20
-	}
-	if m.ChatId != 0 {
-		i = encodeVarintMsgTl(dAtA, i, uint64(m.ChatId))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.Constructor != 0 {
-		i = encodeVarintMsgTl(dAtA, i, uint64(m.Constructor))
-		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *TLMsgReadHistory) Marshal() (dAtA []byte, err error) {
+func (m *OutboxMessage) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3650,12 +3951,12 @@ func (m *TLMsgReadHistory) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TLMsgReadHistory) MarshalTo(dAtA []byte) (int, error) {
+func (m *OutboxMessage) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TLMsgReadHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *OutboxMessage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3664,40 +3965,71 @@ func (m *TLMsgReadHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.MaxId != 0 {
-		i = encodeVarintMsgTl(dAtA, i, uint64(m.MaxId))
+	if m.ScheduleDate != nil {
+		{
+			size, err := m.ScheduleDate.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMsgTl(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x38
+		dAtA[i] = 0x3a
 	}
-	if m.PeerId != 0 {
-		i = encodeVarintMsgTl(dAtA, i, uint64(m.PeerId))
+	if m.Message != nil {
+		{
+			size, err := m.Message.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMsgTl(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x30
+		dAtA[i] = 0x32
 	}
-	if m.PeerType != 0 {
-		i = encodeVarintMsgTl(dAtA, i, uint64(m.PeerType))
+	if m.RandomId != 0 {
+		i = encodeVarintMsgTl(dAtA, i, uint64(m.RandomId))
 		i--
 		dAtA[i] = 0x28
 	}
-	if m.AuthKeyId != 0 {
-		i = encodeVarintMsgTl(dAtA, i, uint64(m.AuthKeyId))
+	if m.Background {
+		i--
+		if m.Background {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
 		i--
 		dAtA[i] = 0x20
 	}
-	if m.UserId != 0 {
-		i = encodeVarintMsgTl(dAtA, i, uint64(m.UserId))
+	if m.NoWebpage {
+		i--
+		if m.NoWebpage {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
 		i--
 		dAtA[i] = 0x18
 	}
 	if m.Constructor != 0 {
 		i = encodeVarintMsgTl(dAtA, i, uint64(m.Constructor))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x10
+	}
+	if len(m.PredicateName) > 0 {
+		i -= len(m.PredicateName)
+		copy(dAtA[i:], m.PredicateName)
+		i = encodeVarintMsgTl(dAtA, i, uint64(len(m.PredicateName)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *TLMsgUpdatePinnedMessage) Marshal() (dAtA []byte, err error) {
+func (m *TLOutboxMessage) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3707,12 +4039,12 @@ func (m *TLMsgUpdatePinnedMessage) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TLMsgUpdatePinnedMessage) MarshalTo(dAtA []byte) (int, error) {
+func (m *TLOutboxMessage) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TLMsgUpdatePinnedMessage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TLOutboxMessage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3721,70 +4053,96 @@ func (m *TLMsgUpdatePinnedMessage) MarshalToSizedBuffer(dAtA []byte) (int, error
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Id != 0 {
-		i = encodeVarintMsgTl(dAtA, i, uint64(m.Id))
+	if m.Data2 != nil {
+		{
+			size, err := m.Data2.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMsgTl(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x50
+		dAtA[i] = 0xa
 	}
-	if m.PeerId != 0 {
-		i = encodeVarintMsgTl(dAtA, i, uint64(m.PeerId))
-		i--
-		dAtA[i] = 0x48
+	return len(dAtA) - i, nil
+}
+
+func (m *ContentMessage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.PeerType != 0 {
-		i = encodeVarintMsgTl(dAtA, i, uint64(m.PeerType))
-		i--
-		dAtA[i] = 0x40
+	return dAtA[:n], nil
+}
+
+func (m *ContentMessage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContentMessage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.PmOneside {
+	if m.Reaction {
 		i--
-		if m.PmOneside {
+		if m.Reaction {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x38
+		dAtA[i] = 0x30
 	}
-	if m.Unpin {
+	if m.MediaUnread {
 		i--
-		if m.Unpin {
+		if m.MediaUnread {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x30
+		dAtA[i] = 0x28
 	}
-	if m.Silent {
+	if m.Mentioned {
 		i--
-		if m.Silent {
+		if m.Mentioned {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x28
-	}
-	if m.AuthKeyId != 0 {
-		i = encodeVarintMsgTl(dAtA, i, uint64(m.AuthKeyId))
-		i--
 		dAtA[i] = 0x20
 	}
-	if m.UserId != 0 {
-		i = encodeVarintMsgTl(dAtA, i, uint64(m.UserId))
+	if m.Id != 0 {
+		i = encodeVarintMsgTl(dAtA, i, uint64(m.Id))
 		i--
 		dAtA[i] = 0x18
 	}
 	if m.Constructor != 0 {
 		i = encodeVarintMsgTl(dAtA, i, uint64(m.Constructor))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x10
+	}
+	if len(m.PredicateName) > 0 {
+		i -= len(m.PredicateName)
+		copy(dAtA[i:], m.PredicateName)
+		i = encodeVarintMsgTl(dAtA, i, uint64(len(m.PredicateName)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *TLMsgUnpinAllMessages) Marshal() (dAtA []byte, err error) {
+func (m *TLContentMessage) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3794,12 +4152,51 @@ func (m *TLMsgUnpinAllMessages) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TLMsgUnpinAllMessages) MarshalTo(dAtA []byte) (int, error) {
+func (m *TLContentMessage) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TLMsgUnpinAllMessages) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TLContentMessage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Data2 != nil {
+		{
+			size, err := m.Data2.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMsgTl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLMsgSendMessage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLMsgSendMessage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLMsgSendMessage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3808,6 +4205,18 @@ func (m *TLMsgUnpinAllMessages) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Message != nil {
+		{
+			size, err := m.Message.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMsgTl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
 	if m.PeerId != 0 {
 		i = encodeVarintMsgTl(dAtA, i, uint64(m.PeerId))
 		i--
@@ -3836,576 +4245,3683 @@ func (m *TLMsgUnpinAllMessages) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintMsgTl(dAtA []byte, offset int, v uint64) int {
-	offset -= sovMsgTl(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *TLMsgSendMultiMessage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Sender) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *TLMsgSendMultiMessage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLMsgSendMultiMessage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.PredicateName)
-	if l > 0 {
-		n += 1 + l + sovMsgTl(uint64(l))
-	}
-	if m.Constructor != 0 {
-		n += 1 + sovMsgTl(uint64(m.Constructor))
-	}
-	if m.UserId != 0 {
-		n += 1 + sovMsgTl(uint64(m.UserId))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Type != 0 {
-		n += 1 + sovMsgTl(uint64(m.Type))
+	if len(m.Message) > 0 {
+		for iNdEx := len(m.Message) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Message[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMsgTl(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
 	}
-	if m.AuthKeyId != 0 {
-		n += 1 + sovMsgTl(uint64(m.AuthKeyId))
+	if m.PeerId != 0 {
+		i = encodeVarintMsgTl(dAtA, i, uint64(m.PeerId))
+		i--
+		dAtA[i] = 0x30
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.PeerType != 0 {
+		i = encodeVarintMsgTl(dAtA, i, uint64(m.PeerType))
+		i--
+		dAtA[i] = 0x28
 	}
-	return n
-}
-
-func (m *TLSender) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.AuthKeyId != 0 {
+		i = encodeVarintMsgTl(dAtA, i, uint64(m.AuthKeyId))
+		i--
+		dAtA[i] = 0x20
 	}
-	var l int
-	_ = l
-	if m.Data2 != nil {
-		l = m.Data2.Size()
-		n += 1 + l + sovMsgTl(uint64(l))
+	if m.UserId != 0 {
+		i = encodeVarintMsgTl(dAtA, i, uint64(m.UserId))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Constructor != 0 {
+		i = encodeVarintMsgTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *OutboxMessage) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TLMsgPushUserMessage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *TLMsgPushUserMessage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLMsgPushUserMessage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.PredicateName)
-	if l > 0 {
-		n += 1 + l + sovMsgTl(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Constructor != 0 {
-		n += 1 + sovMsgTl(uint64(m.Constructor))
+	if m.Message != nil {
+		{
+			size, err := m.Message.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMsgTl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
 	}
-	if m.NoWebpage {
-		n += 2
+	if m.PushType != 0 {
+		i = encodeVarintMsgTl(dAtA, i, uint64(m.PushType))
+		i--
+		dAtA[i] = 0x38
 	}
-	if m.Background {
-		n += 2
+	if m.PeerId != 0 {
+		i = encodeVarintMsgTl(dAtA, i, uint64(m.PeerId))
+		i--
+		dAtA[i] = 0x30
 	}
-	if m.RandomId != 0 {
-		n += 1 + sovMsgTl(uint64(m.RandomId))
+	if m.PeerType != 0 {
+		i = encodeVarintMsgTl(dAtA, i, uint64(m.PeerType))
+		i--
+		dAtA[i] = 0x28
 	}
-	if m.Message != nil {
-		l = m.Message.Size()
-		n += 1 + l + sovMsgTl(uint64(l))
+	if m.AuthKeyId != 0 {
+		i = encodeVarintMsgTl(dAtA, i, uint64(m.AuthKeyId))
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.ScheduleDate != nil {
-		l = m.ScheduleDate.Size()
-		n += 1 + l + sovMsgTl(uint64(l))
+	if m.UserId != 0 {
+		i = encodeVarintMsgTl(dAtA, i, uint64(m.UserId))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Constructor != 0 {
+		i = encodeVarintMsgTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *TLOutboxMessage) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Data2 != nil {
-		l = m.Data2.Size()
-		n += 1 + l + sovMsgTl(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *TLMsgReadMessageContents) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ContentMessage) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *TLMsgReadMessageContents) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLMsgReadMessageContents) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.PredicateName)
-	if l > 0 {
-		n += 1 + l + sovMsgTl(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Constructor != 0 {
-		n += 1 + sovMsgTl(uint64(m.Constructor))
+	if len(m.Id) > 0 {
+		for iNdEx := len(m.Id) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Id[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMsgTl(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
 	}
-	if m.Id != 0 {
-		n += 1 + sovMsgTl(uint64(m.Id))
+	if m.PeerId != 0 {
+		i = encodeVarintMsgTl(dAtA, i, uint64(m.PeerId))
+		i--
+		dAtA[i] = 0x30
 	}
-	if m.Mentioned {
-		n += 2
+	if m.PeerType != 0 {
+		i = encodeVarintMsgTl(dAtA, i, uint64(m.PeerType))
+		i--
+		dAtA[i] = 0x28
 	}
-	if m.MediaUnread {
-		n += 2
+	if m.AuthKeyId != 0 {
+		i = encodeVarintMsgTl(dAtA, i, uint64(m.AuthKeyId))
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.Reaction {
-		n += 2
+	if m.UserId != 0 {
+		i = encodeVarintMsgTl(dAtA, i, uint64(m.UserId))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Constructor != 0 {
+		i = encodeVarintMsgTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *TLContentMessage) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Data2 != nil {
-		l = m.Data2.Size()
-		n += 1 + l + sovMsgTl(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *TLMsgSendMessageV2) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *TLMsgSendMessage) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *TLMsgSendMessageV2) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLMsgSendMessageV2) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Constructor != 0 {
-		n += 1 + sovMsgTl(uint64(m.Constructor))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.UserId != 0 {
-		n += 1 + sovMsgTl(uint64(m.UserId))
+	if len(m.Message) > 0 {
+		for iNdEx := len(m.Message) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Message[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMsgTl(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
 	}
-	if m.AuthKeyId != 0 {
-		n += 1 + sovMsgTl(uint64(m.AuthKeyId))
+	if m.PeerId != 0 {
+		i = encodeVarintMsgTl(dAtA, i, uint64(m.PeerId))
+		i--
+		dAtA[i] = 0x30
 	}
 	if m.PeerType != 0 {
-		n += 1 + sovMsgTl(uint64(m.PeerType))
+		i = encodeVarintMsgTl(dAtA, i, uint64(m.PeerType))
+		i--
+		dAtA[i] = 0x28
 	}
-	if m.PeerId != 0 {
-		n += 1 + sovMsgTl(uint64(m.PeerId))
+	if m.AuthKeyId != 0 {
+		i = encodeVarintMsgTl(dAtA, i, uint64(m.AuthKeyId))
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.Message != nil {
-		l = m.Message.Size()
-		n += 1 + l + sovMsgTl(uint64(l))
+	if m.UserId != 0 {
+		i = encodeVarintMsgTl(dAtA, i, uint64(m.UserId))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Constructor != 0 {
+		i = encodeVarintMsgTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *TLMsgSendMultiMessage) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TLMsgEditMessage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *TLMsgEditMessage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLMsgEditMessage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Constructor != 0 {
-		n += 1 + sovMsgTl(uint64(m.Constructor))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.UserId != 0 {
-		n += 1 + sovMsgTl(uint64(m.UserId))
+	if m.Message != nil {
+		{
+			size, err := m.Message.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMsgTl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
 	}
-	if m.AuthKeyId != 0 {
-		n += 1 + sovMsgTl(uint64(m.AuthKeyId))
+	if m.PeerId != 0 {
+		i = encodeVarintMsgTl(dAtA, i, uint64(m.PeerId))
+		i--
+		dAtA[i] = 0x30
 	}
 	if m.PeerType != 0 {
-		n += 1 + sovMsgTl(uint64(m.PeerType))
+		i = encodeVarintMsgTl(dAtA, i, uint64(m.PeerType))
+		i--
+		dAtA[i] = 0x28
 	}
-	if m.PeerId != 0 {
-		n += 1 + sovMsgTl(uint64(m.PeerId))
+	if m.AuthKeyId != 0 {
+		i = encodeVarintMsgTl(dAtA, i, uint64(m.AuthKeyId))
+		i--
+		dAtA[i] = 0x20
 	}
-	if len(m.Message) > 0 {
-		for _, e := range m.Message {
-			l = e.Size()
-			n += 1 + l + sovMsgTl(uint64(l))
-		}
+	if m.UserId != 0 {
+		i = encodeVarintMsgTl(dAtA, i, uint64(m.UserId))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Constructor != 0 {
+		i = encodeVarintMsgTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *TLMsgPushUserMessage) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TLMsgDeleteMessages) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *TLMsgDeleteMessages) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLMsgDeleteMessages) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Constructor != 0 {
-		n += 1 + sovMsgTl(uint64(m.Constructor))
-	}
-	if m.UserId != 0 {
-		n += 1 + sovMsgTl(uint64(m.UserId))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.AuthKeyId != 0 {
-		n += 1 + sovMsgTl(uint64(m.AuthKeyId))
+	if len(m.Id) > 0 {
+		dAtA10 := make([]byte, len(m.Id)*10)
+		var j9 int
+		for _, num1 := range m.Id {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA10[j9] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j9++
+			}
+			dAtA10[j9] = uint8(num)
+			j9++
+		}
+		i -= j9
+		copy(dAtA[i:], dAtA10[:j9])
+		i = encodeVarintMsgTl(dAtA, i, uint64(j9))
+		i--
+		dAtA[i] = 0x42
 	}
-	if m.PeerType != 0 {
-		n += 1 + sovMsgTl(uint64(m.PeerType))
+	if m.Revoke {
+		i--
+		if m.Revoke {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
 	}
 	if m.PeerId != 0 {
-		n += 1 + sovMsgTl(uint64(m.PeerId))
+		i = encodeVarintMsgTl(dAtA, i, uint64(m.PeerId))
+		i--
+		dAtA[i] = 0x30
 	}
-	if m.PushType != 0 {
-		n += 1 + sovMsgTl(uint64(m.PushType))
+	if m.PeerType != 0 {
+		i = encodeVarintMsgTl(dAtA, i, uint64(m.PeerType))
+		i--
+		dAtA[i] = 0x28
 	}
-	if m.Message != nil {
-		l = m.Message.Size()
-		n += 1 + l + sovMsgTl(uint64(l))
+	if m.AuthKeyId != 0 {
+		i = encodeVarintMsgTl(dAtA, i, uint64(m.AuthKeyId))
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.UserId != 0 {
+		i = encodeVarintMsgTl(dAtA, i, uint64(m.UserId))
+		i--
+		dAtA[i] = 0x18
 	}
-	return n
+	if m.Constructor != 0 {
+		i = encodeVarintMsgTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *TLMsgReadMessageContents) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TLMsgDeleteHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *TLMsgDeleteHistory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLMsgDeleteHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Constructor != 0 {
-		n += 1 + sovMsgTl(uint64(m.Constructor))
-	}
-	if m.UserId != 0 {
-		n += 1 + sovMsgTl(uint64(m.UserId))
-	}
-	if m.AuthKeyId != 0 {
-		n += 1 + sovMsgTl(uint64(m.AuthKeyId))
-	}
-	if m.PeerType != 0 {
-		n += 1 + sovMsgTl(uint64(m.PeerType))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.PeerId != 0 {
-		n += 1 + sovMsgTl(uint64(m.PeerId))
+	if m.MaxId != 0 {
+		i = encodeVarintMsgTl(dAtA, i, uint64(m.MaxId))
+		i--
+		dAtA[i] = 0x48
 	}
-	if len(m.Id) > 0 {
-		for _, e := range m.Id {
-			l = e.Size()
-			n += 1 + l + sovMsgTl(uint64(l))
+	if m.Revoke {
+		i--
+		if m.Revoke {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x40
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
-}
-
-func (m *TLMsgSendMessageV2) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Constructor != 0 {
-		n += 1 + sovMsgTl(uint64(m.Constructor))
-	}
-	if m.UserId != 0 {
-		n += 1 + sovMsgTl(uint64(m.UserId))
+	if m.JustClear {
+		i--
+		if m.JustClear {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
 	}
-	if m.AuthKeyId != 0 {
-		n += 1 + sovMsgTl(uint64(m.AuthKeyId))
+	if m.PeerId != 0 {
+		i = encodeVarintMsgTl(dAtA, i, uint64(m.PeerId))
+		i--
+		dAtA[i] = 0x30
 	}
 	if m.PeerType != 0 {
-		n += 1 + sovMsgTl(uint64(m.PeerType))
+		i = encodeVarintMsgTl(dAtA, i, uint64(m.PeerType))
+		i--
+		dAtA[i] = 0x28
 	}
-	if m.PeerId != 0 {
-		n += 1 + sovMsgTl(uint64(m.PeerId))
+	if m.AuthKeyId != 0 {
+		i = encodeVarintMsgTl(dAtA, i, uint64(m.AuthKeyId))
+		i--
+		dAtA[i] = 0x20
 	}
-	if len(m.Message) > 0 {
-		for _, e := range m.Message {
-			l = e.Size()
-			n += 1 + l + sovMsgTl(uint64(l))
-		}
+	if m.UserId != 0 {
+		i = encodeVarintMsgTl(dAtA, i, uint64(m.UserId))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Constructor != 0 {
+		i = encodeVarintMsgTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *TLMsgEditMessage) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TLMsgDeletePhoneCallHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *TLMsgDeletePhoneCallHistory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLMsgDeletePhoneCallHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Constructor != 0 {
-		n += 1 + sovMsgTl(uint64(m.Constructor))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.UserId != 0 {
-		n += 1 + sovMsgTl(uint64(m.UserId))
+	if m.Revoke {
+		i--
+		if m.Revoke {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
 	}
 	if m.AuthKeyId != 0 {
-		n += 1 + sovMsgTl(uint64(m.AuthKeyId))
-	}
-	if m.PeerType != 0 {
-		n += 1 + sovMsgTl(uint64(m.PeerType))
-	}
-	if m.PeerId != 0 {
-		n += 1 + sovMsgTl(uint64(m.PeerId))
+		i = encodeVarintMsgTl(dAtA, i, uint64(m.AuthKeyId))
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.Message != nil {
-		l = m.Message.Size()
-		n += 1 + l + sovMsgTl(uint64(l))
+	if m.UserId != 0 {
+		i = encodeVarintMsgTl(dAtA, i, uint64(m.UserId))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Constructor != 0 {
+		i = encodeVarintMsgTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *TLMsgDeleteMessages) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TLMsgDeleteChatHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *TLMsgDeleteChatHistory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLMsgDeleteChatHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Constructor != 0 {
-		n += 1 + sovMsgTl(uint64(m.Constructor))
-	}
-	if m.UserId != 0 {
-		n += 1 + sovMsgTl(uint64(m.UserId))
-	}
-	if m.AuthKeyId != 0 {
-		n += 1 + sovMsgTl(uint64(m.AuthKeyId))
-	}
-	if m.PeerType != 0 {
-		n += 1 + sovMsgTl(uint64(m.PeerType))
-	}
-	if m.PeerId != 0 {
-		n += 1 + sovMsgTl(uint64(m.PeerId))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Revoke {
-		n += 2
+	if m.DeleteUserId != 0 {
+		i = encodeVarintMsgTl(dAtA, i, uint64(m.DeleteUserId))
+		i--
+		dAtA[i] = 0x20
 	}
-	if len(m.Id) > 0 {
-		l = 0
-		for _, e := range m.Id {
-			l += sovMsgTl(uint64(e))
-		}
-		n += 1 + sovMsgTl(uint64(l)) + l
+	if m.ChatId != 0 {
+		i = encodeVarintMsgTl(dAtA, i, uint64(m.ChatId))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Constructor != 0 {
+		i = encodeVarintMsgTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *TLMsgDeleteHistory) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TLMsgReadHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *TLMsgReadHistory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLMsgReadHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Constructor != 0 {
-		n += 1 + sovMsgTl(uint64(m.Constructor))
-	}
-	if m.UserId != 0 {
-		n += 1 + sovMsgTl(uint64(m.UserId))
-	}
-	if m.AuthKeyId != 0 {
-		n += 1 + sovMsgTl(uint64(m.AuthKeyId))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.PeerType != 0 {
-		n += 1 + sovMsgTl(uint64(m.PeerType))
+	if m.MaxId != 0 {
+		i = encodeVarintMsgTl(dAtA, i, uint64(m.MaxId))
+		i--
+		dAtA[i] = 0x38
 	}
 	if m.PeerId != 0 {
-		n += 1 + sovMsgTl(uint64(m.PeerId))
+		i = encodeVarintMsgTl(dAtA, i, uint64(m.PeerId))
+		i--
+		dAtA[i] = 0x30
 	}
-	if m.JustClear {
-		n += 2
+	if m.PeerType != 0 {
+		i = encodeVarintMsgTl(dAtA, i, uint64(m.PeerType))
+		i--
+		dAtA[i] = 0x28
 	}
-	if m.Revoke {
-		n += 2
+	if m.AuthKeyId != 0 {
+		i = encodeVarintMsgTl(dAtA, i, uint64(m.AuthKeyId))
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.MaxId != 0 {
-		n += 1 + sovMsgTl(uint64(m.MaxId))
+	if m.UserId != 0 {
+		i = encodeVarintMsgTl(dAtA, i, uint64(m.UserId))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Constructor != 0 {
+		i = encodeVarintMsgTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *TLMsgDeletePhoneCallHistory) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Constructor != 0 {
-		n += 1 + sovMsgTl(uint64(m.Constructor))
-	}
-	if m.UserId != 0 {
-		n += 1 + sovMsgTl(uint64(m.UserId))
-	}
-	if m.AuthKeyId != 0 {
-		n += 1 + sovMsgTl(uint64(m.AuthKeyId))
-	}
-	if m.Revoke {
-		n += 2
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *TLMsgUpdatePinnedMessage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *TLMsgDeleteChatHistory) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *TLMsgUpdatePinnedMessage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLMsgUpdatePinnedMessage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Constructor != 0 {
-		n += 1 + sovMsgTl(uint64(m.Constructor))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.ChatId != 0 {
-		n += 1 + sovMsgTl(uint64(m.ChatId))
+	if m.Id != 0 {
+		i = encodeVarintMsgTl(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x50
 	}
-	if m.DeleteUserId != 0 {
-		n += 1 + sovMsgTl(uint64(m.DeleteUserId))
+	if m.PeerId != 0 {
+		i = encodeVarintMsgTl(dAtA, i, uint64(m.PeerId))
+		i--
+		dAtA[i] = 0x48
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.PeerType != 0 {
+		i = encodeVarintMsgTl(dAtA, i, uint64(m.PeerType))
+		i--
+		dAtA[i] = 0x40
 	}
-	return n
-}
-
-func (m *TLMsgReadHistory) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.PmOneside {
+		i--
+		if m.PmOneside {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
 	}
-	var l int
-	_ = l
-	if m.Constructor != 0 {
-		n += 1 + sovMsgTl(uint64(m.Constructor))
+	if m.Unpin {
+		i--
+		if m.Unpin {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
 	}
-	if m.UserId != 0 {
-		n += 1 + sovMsgTl(uint64(m.UserId))
+	if m.Silent {
+		i--
+		if m.Silent {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
 	}
 	if m.AuthKeyId != 0 {
-		n += 1 + sovMsgTl(uint64(m.AuthKeyId))
-	}
-	if m.PeerType != 0 {
-		n += 1 + sovMsgTl(uint64(m.PeerType))
-	}
-	if m.PeerId != 0 {
-		n += 1 + sovMsgTl(uint64(m.PeerId))
+		i = encodeVarintMsgTl(dAtA, i, uint64(m.AuthKeyId))
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.MaxId != 0 {
-		n += 1 + sovMsgTl(uint64(m.MaxId))
+	if m.UserId != 0 {
+		i = encodeVarintMsgTl(dAtA, i, uint64(m.UserId))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Constructor != 0 {
+		i = encodeVarintMsgTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *TLMsgUpdatePinnedMessage) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TLMsgUnpinAllMessages) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *TLMsgUnpinAllMessages) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLMsgUnpinAllMessages) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Constructor != 0 {
-		n += 1 + sovMsgTl(uint64(m.Constructor))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.UserId != 0 {
-		n += 1 + sovMsgTl(uint64(m.UserId))
+	if m.PeerId != 0 {
+		i = encodeVarintMsgTl(dAtA, i, uint64(m.PeerId))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.PeerType != 0 {
+		i = encodeVarintMsgTl(dAtA, i, uint64(m.PeerType))
+		i--
+		dAtA[i] = 0x28
 	}
 	if m.AuthKeyId != 0 {
-		n += 1 + sovMsgTl(uint64(m.AuthKeyId))
+		i = encodeVarintMsgTl(dAtA, i, uint64(m.AuthKeyId))
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.Silent {
-		n += 2
+	if m.UserId != 0 {
+		i = encodeVarintMsgTl(dAtA, i, uint64(m.UserId))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.Unpin {
-		n += 2
+	if m.Constructor != 0 {
+		i = encodeVarintMsgTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
 	}
-	if m.PmOneside {
-		n += 2
+	return len(dAtA) - i, nil
+}
+
+func (m *TLMsgGetScheduledHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.PeerType != 0 {
-		n += 1 + sovMsgTl(uint64(m.PeerType))
+	return dAtA[:n], nil
+}
+
+func (m *TLMsgGetScheduledHistory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLMsgGetScheduledHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if m.PeerId != 0 {
-		n += 1 + sovMsgTl(uint64(m.PeerId))
+		i = encodeVarintMsgTl(dAtA, i, uint64(m.PeerId))
+		i--
+		dAtA[i] = 0x28
 	}
-	if m.Id != 0 {
-		n += 1 + sovMsgTl(uint64(m.Id))
+	if m.PeerType != 0 {
+		i = encodeVarintMsgTl(dAtA, i, uint64(m.PeerType))
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.UserId != 0 {
+		i = encodeVarintMsgTl(dAtA, i, uint64(m.UserId))
+		i--
+		dAtA[i] = 0x18
 	}
-	return n
+	if m.Constructor != 0 {
+		i = encodeVarintMsgTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *TLMsgUnpinAllMessages) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TLMsgGetScheduledMessages) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *TLMsgGetScheduledMessages) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLMsgGetScheduledMessages) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Constructor != 0 {
-		n += 1 + sovMsgTl(uint64(m.Constructor))
-	}
-	if m.UserId != 0 {
-		n += 1 + sovMsgTl(uint64(m.UserId))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.AuthKeyId != 0 {
-		n += 1 + sovMsgTl(uint64(m.AuthKeyId))
+	if len(m.Id) > 0 {
+		dAtA12 := make([]byte, len(m.Id)*10)
+		var j11 int
+		for _, num1 := range m.Id {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA12[j11] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j11++
+			}
+			dAtA12[j11] = uint8(num)
+			j11++
+		}
+		i -= j11
+		copy(dAtA[i:], dAtA12[:j11])
+		i = encodeVarintMsgTl(dAtA, i, uint64(j11))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.PeerId != 0 {
+		i = encodeVarintMsgTl(dAtA, i, uint64(m.PeerId))
+		i--
+		dAtA[i] = 0x28
 	}
 	if m.PeerType != 0 {
-		n += 1 + sovMsgTl(uint64(m.PeerType))
+		i = encodeVarintMsgTl(dAtA, i, uint64(m.PeerType))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.UserId != 0 {
+		i = encodeVarintMsgTl(dAtA, i, uint64(m.UserId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintMsgTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLMsgSendScheduledMessages) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLMsgSendScheduledMessages) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLMsgSendScheduledMessages) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Id) > 0 {
+		dAtA14 := make([]byte, len(m.Id)*10)
+		var j13 int
+		for _, num1 := range m.Id {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA14[j13] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j13++
+			}
+			dAtA14[j13] = uint8(num)
+			j13++
+		}
+		i -= j13
+		copy(dAtA[i:], dAtA14[:j13])
+		i = encodeVarintMsgTl(dAtA, i, uint64(j13))
+		i--
+		dAtA[i] = 0x3a
 	}
 	if m.PeerId != 0 {
-		n += 1 + sovMsgTl(uint64(m.PeerId))
+		i = encodeVarintMsgTl(dAtA, i, uint64(m.PeerId))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.PeerType != 0 {
+		i = encodeVarintMsgTl(dAtA, i, uint64(m.PeerType))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.AuthKeyId != 0 {
+		i = encodeVarintMsgTl(dAtA, i, uint64(m.AuthKeyId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.UserId != 0 {
+		i = encodeVarintMsgTl(dAtA, i, uint64(m.UserId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintMsgTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLMsgDeleteScheduledMessages) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *TLMsgDeleteScheduledMessages) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLMsgDeleteScheduledMessages) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Id) > 0 {
+		dAtA16 := make([]byte, len(m.Id)*10)
+		var j15 int
+		for _, num1 := range m.Id {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA16[j15] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j15++
+			}
+			dAtA16[j15] = uint8(num)
+			j15++
+		}
+		i -= j15
+		copy(dAtA[i:], dAtA16[:j15])
+		i = encodeVarintMsgTl(dAtA, i, uint64(j15))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.PeerId != 0 {
+		i = encodeVarintMsgTl(dAtA, i, uint64(m.PeerId))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.PeerType != 0 {
+		i = encodeVarintMsgTl(dAtA, i, uint64(m.PeerType))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.AuthKeyId != 0 {
+		i = encodeVarintMsgTl(dAtA, i, uint64(m.AuthKeyId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.UserId != 0 {
+		i = encodeVarintMsgTl(dAtA, i, uint64(m.UserId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintMsgTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLMsgSendReaction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLMsgSendReaction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLMsgSendReaction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Reaction != nil {
+		{
+			size, err := m.Reaction.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMsgTl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.MsgId != 0 {
+		i = encodeVarintMsgTl(dAtA, i, uint64(m.MsgId))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.PeerId != 0 {
+		i = encodeVarintMsgTl(dAtA, i, uint64(m.PeerId))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.PeerType != 0 {
+		i = encodeVarintMsgTl(dAtA, i, uint64(m.PeerType))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Big {
+		i--
+		if m.Big {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.AuthKeyId != 0 {
+		i = encodeVarintMsgTl(dAtA, i, uint64(m.AuthKeyId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.UserId != 0 {
+		i = encodeVarintMsgTl(dAtA, i, uint64(m.UserId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintMsgTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLMsgReadReactions) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLMsgReadReactions) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLMsgReadReactions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.PeerId != 0 {
+		i = encodeVarintMsgTl(dAtA, i, uint64(m.PeerId))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.PeerType != 0 {
+		i = encodeVarintMsgTl(dAtA, i, uint64(m.PeerType))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.AuthKeyId != 0 {
+		i = encodeVarintMsgTl(dAtA, i, uint64(m.AuthKeyId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.UserId != 0 {
+		i = encodeVarintMsgTl(dAtA, i, uint64(m.UserId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintMsgTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLMsgGetMessageReactionsList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLMsgGetMessageReactionsList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLMsgGetMessageReactionsList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Limit != 0 {
+		i = encodeVarintMsgTl(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.Offset != nil {
+		{
+			size, err := m.Offset.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMsgTl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Reaction != nil {
+		{
+			size, err := m.Reaction.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMsgTl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.MsgId != 0 {
+		i = encodeVarintMsgTl(dAtA, i, uint64(m.MsgId))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.PeerId != 0 {
+		i = encodeVarintMsgTl(dAtA, i, uint64(m.PeerId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.PeerType != 0 {
+		i = encodeVarintMsgTl(dAtA, i, uint64(m.PeerType))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.UserId != 0 {
+		i = encodeVarintMsgTl(dAtA, i, uint64(m.UserId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintMsgTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Vector_Message) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Vector_Message) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Vector_Message) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Datas) > 0 {
+		for iNdEx := len(m.Datas) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Datas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMsgTl(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMsgTl(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMsgTl(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Sender) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PredicateName)
+	if l > 0 {
+		n += 1 + l + sovMsgTl(uint64(l))
+	}
+	if m.Constructor != 0 {
+		n += 1 + sovMsgTl(uint64(m.Constructor))
+	}
+	if m.UserId != 0 {
+		n += 1 + sovMsgTl(uint64(m.UserId))
+	}
+	if m.Type != 0 {
+		n += 1 + sovMsgTl(uint64(m.Type))
+	}
+	if m.AuthKeyId != 0 {
+		n += 1 + sovMsgTl(uint64(m.AuthKeyId))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLSender) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Data2 != nil {
+		l = m.Data2.Size()
+		n += 1 + l + sovMsgTl(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *OutboxMessage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PredicateName)
+	if l > 0 {
+		n += 1 + l + sovMsgTl(uint64(l))
+	}
+	if m.Constructor != 0 {
+		n += 1 + sovMsgTl(uint64(m.Constructor))
+	}
+	if m.NoWebpage {
+		n += 2
+	}
+	if m.Background {
+		n += 2
+	}
+	if m.RandomId != 0 {
+		n += 1 + sovMsgTl(uint64(m.RandomId))
+	}
+	if m.Message != nil {
+		l = m.Message.Size()
+		n += 1 + l + sovMsgTl(uint64(l))
+	}
+	if m.ScheduleDate != nil {
+		l = m.ScheduleDate.Size()
+		n += 1 + l + sovMsgTl(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLOutboxMessage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Data2 != nil {
+		l = m.Data2.Size()
+		n += 1 + l + sovMsgTl(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ContentMessage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PredicateName)
+	if l > 0 {
+		n += 1 + l + sovMsgTl(uint64(l))
+	}
+	if m.Constructor != 0 {
+		n += 1 + sovMsgTl(uint64(m.Constructor))
+	}
+	if m.Id != 0 {
+		n += 1 + sovMsgTl(uint64(m.Id))
+	}
+	if m.Mentioned {
+		n += 2
+	}
+	if m.MediaUnread {
+		n += 2
+	}
+	if m.Reaction {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLContentMessage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Data2 != nil {
+		l = m.Data2.Size()
+		n += 1 + l + sovMsgTl(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLMsgSendMessage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovMsgTl(uint64(m.Constructor))
+	}
+	if m.UserId != 0 {
+		n += 1 + sovMsgTl(uint64(m.UserId))
+	}
+	if m.AuthKeyId != 0 {
+		n += 1 + sovMsgTl(uint64(m.AuthKeyId))
+	}
+	if m.PeerType != 0 {
+		n += 1 + sovMsgTl(uint64(m.PeerType))
+	}
+	if m.PeerId != 0 {
+		n += 1 + sovMsgTl(uint64(m.PeerId))
+	}
+	if m.Message != nil {
+		l = m.Message.Size()
+		n += 1 + l + sovMsgTl(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLMsgSendMultiMessage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovMsgTl(uint64(m.Constructor))
+	}
+	if m.UserId != 0 {
+		n += 1 + sovMsgTl(uint64(m.UserId))
+	}
+	if m.AuthKeyId != 0 {
+		n += 1 + sovMsgTl(uint64(m.AuthKeyId))
+	}
+	if m.PeerType != 0 {
+		n += 1 + sovMsgTl(uint64(m.PeerType))
+	}
+	if m.PeerId != 0 {
+		n += 1 + sovMsgTl(uint64(m.PeerId))
+	}
+	if len(m.Message) > 0 {
+		for _, e := range m.Message {
+			l = e.Size()
+			n += 1 + l + sovMsgTl(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLMsgPushUserMessage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovMsgTl(uint64(m.Constructor))
+	}
+	if m.UserId != 0 {
+		n += 1 + sovMsgTl(uint64(m.UserId))
+	}
+	if m.AuthKeyId != 0 {
+		n += 1 + sovMsgTl(uint64(m.AuthKeyId))
+	}
+	if m.PeerType != 0 {
+		n += 1 + sovMsgTl(uint64(m.PeerType))
+	}
+	if m.PeerId != 0 {
+		n += 1 + sovMsgTl(uint64(m.PeerId))
+	}
+	if m.PushType != 0 {
+		n += 1 + sovMsgTl(uint64(m.PushType))
+	}
+	if m.Message != nil {
+		l = m.Message.Size()
+		n += 1 + l + sovMsgTl(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLMsgReadMessageContents) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovMsgTl(uint64(m.Constructor))
+	}
+	if m.UserId != 0 {
+		n += 1 + sovMsgTl(uint64(m.UserId))
+	}
+	if m.AuthKeyId != 0 {
+		n += 1 + sovMsgTl(uint64(m.AuthKeyId))
+	}
+	if m.PeerType != 0 {
+		n += 1 + sovMsgTl(uint64(m.PeerType))
+	}
+	if m.PeerId != 0 {
+		n += 1 + sovMsgTl(uint64(m.PeerId))
+	}
+	if len(m.Id) > 0 {
+		for _, e := range m.Id {
+			l = e.Size()
+			n += 1 + l + sovMsgTl(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLMsgSendMessageV2) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovMsgTl(uint64(m.Constructor))
+	}
+	if m.UserId != 0 {
+		n += 1 + sovMsgTl(uint64(m.UserId))
+	}
+	if m.AuthKeyId != 0 {
+		n += 1 + sovMsgTl(uint64(m.AuthKeyId))
+	}
+	if m.PeerType != 0 {
+		n += 1 + sovMsgTl(uint64(m.PeerType))
+	}
+	if m.PeerId != 0 {
+		n += 1 + sovMsgTl(uint64(m.PeerId))
+	}
+	if len(m.Message) > 0 {
+		for _, e := range m.Message {
+			l = e.Size()
+			n += 1 + l + sovMsgTl(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLMsgEditMessage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovMsgTl(uint64(m.Constructor))
+	}
+	if m.UserId != 0 {
+		n += 1 + sovMsgTl(uint64(m.UserId))
+	}
+	if m.AuthKeyId != 0 {
+		n += 1 + sovMsgTl(uint64(m.AuthKeyId))
+	}
+	if m.PeerType != 0 {
+		n += 1 + sovMsgTl(uint64(m.PeerType))
+	}
+	if m.PeerId != 0 {
+		n += 1 + sovMsgTl(uint64(m.PeerId))
+	}
+	if m.Message != nil {
+		l = m.Message.Size()
+		n += 1 + l + sovMsgTl(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLMsgDeleteMessages) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovMsgTl(uint64(m.Constructor))
+	}
+	if m.UserId != 0 {
+		n += 1 + sovMsgTl(uint64(m.UserId))
+	}
+	if m.AuthKeyId != 0 {
+		n += 1 + sovMsgTl(uint64(m.AuthKeyId))
+	}
+	if m.PeerType != 0 {
+		n += 1 + sovMsgTl(uint64(m.PeerType))
+	}
+	if m.PeerId != 0 {
+		n += 1 + sovMsgTl(uint64(m.PeerId))
+	}
+	if m.Revoke {
+		n += 2
+	}
+	if len(m.Id) > 0 {
+		l = 0
+		for _, e := range m.Id {
+			l += sovMsgTl(uint64(e))
+		}
+		n += 1 + sovMsgTl(uint64(l)) + l
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLMsgDeleteHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovMsgTl(uint64(m.Constructor))
+	}
+	if m.UserId != 0 {
+		n += 1 + sovMsgTl(uint64(m.UserId))
+	}
+	if m.AuthKeyId != 0 {
+		n += 1 + sovMsgTl(uint64(m.AuthKeyId))
+	}
+	if m.PeerType != 0 {
+		n += 1 + sovMsgTl(uint64(m.PeerType))
+	}
+	if m.PeerId != 0 {
+		n += 1 + sovMsgTl(uint64(m.PeerId))
+	}
+	if m.JustClear {
+		n += 2
+	}
+	if m.Revoke {
+		n += 2
+	}
+	if m.MaxId != 0 {
+		n += 1 + sovMsgTl(uint64(m.MaxId))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLMsgDeletePhoneCallHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovMsgTl(uint64(m.Constructor))
+	}
+	if m.UserId != 0 {
+		n += 1 + sovMsgTl(uint64(m.UserId))
+	}
+	if m.AuthKeyId != 0 {
+		n += 1 + sovMsgTl(uint64(m.AuthKeyId))
+	}
+	if m.Revoke {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLMsgDeleteChatHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovMsgTl(uint64(m.Constructor))
+	}
+	if m.ChatId != 0 {
+		n += 1 + sovMsgTl(uint64(m.ChatId))
+	}
+	if m.DeleteUserId != 0 {
+		n += 1 + sovMsgTl(uint64(m.DeleteUserId))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLMsgReadHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovMsgTl(uint64(m.Constructor))
+	}
+	if m.UserId != 0 {
+		n += 1 + sovMsgTl(uint64(m.UserId))
+	}
+	if m.AuthKeyId != 0 {
+		n += 1 + sovMsgTl(uint64(m.AuthKeyId))
+	}
+	if m.PeerType != 0 {
+		n += 1 + sovMsgTl(uint64(m.PeerType))
+	}
+	if m.PeerId != 0 {
+		n += 1 + sovMsgTl(uint64(m.PeerId))
+	}
+	if m.MaxId != 0 {
+		n += 1 + sovMsgTl(uint64(m.MaxId))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLMsgUpdatePinnedMessage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovMsgTl(uint64(m.Constructor))
+	}
+	if m.UserId != 0 {
+		n += 1 + sovMsgTl(uint64(m.UserId))
+	}
+	if m.AuthKeyId != 0 {
+		n += 1 + sovMsgTl(uint64(m.AuthKeyId))
+	}
+	if m.Silent {
+		n += 2
+	}
+	if m.Unpin {
+		n += 2
+	}
+	if m.PmOneside {
+		n += 2
+	}
+	if m.PeerType != 0 {
+		n += 1 + sovMsgTl(uint64(m.PeerType))
+	}
+	if m.PeerId != 0 {
+		n += 1 + sovMsgTl(uint64(m.PeerId))
+	}
+	if m.Id != 0 {
+		n += 1 + sovMsgTl(uint64(m.Id))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLMsgUnpinAllMessages) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovMsgTl(uint64(m.Constructor))
+	}
+	if m.UserId != 0 {
+		n += 1 + sovMsgTl(uint64(m.UserId))
+	}
+	if m.AuthKeyId != 0 {
+		n += 1 + sovMsgTl(uint64(m.AuthKeyId))
+	}
+	if m.PeerType != 0 {
+		n += 1 + sovMsgTl(uint64(m.PeerType))
+	}
+	if m.PeerId != 0 {
+		n += 1 + sovMsgTl(uint64(m.PeerId))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLMsgGetScheduledHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovMsgTl(uint64(m.Constructor))
+	}
+	if m.UserId != 0 {
+		n += 1 + sovMsgTl(uint64(m.UserId))
+	}
+	if m.PeerType != 0 {
+		n += 1 + sovMsgTl(uint64(m.PeerType))
+	}
+	if m.PeerId != 0 {
+		n += 1 + sovMsgTl(uint64(m.PeerId))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLMsgGetScheduledMessages) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovMsgTl(uint64(m.Constructor))
+	}
+	if m.UserId != 0 {
+		n += 1 + sovMsgTl(uint64(m.UserId))
+	}
+	if m.PeerType != 0 {
+		n += 1 + sovMsgTl(uint64(m.PeerType))
+	}
+	if m.PeerId != 0 {
+		n += 1 + sovMsgTl(uint64(m.PeerId))
+	}
+	if len(m.Id) > 0 {
+		l = 0
+		for _, e := range m.Id {
+			l += sovMsgTl(uint64(e))
+		}
+		n += 1 + sovMsgTl(uint64(l)) + l
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLMsgSendScheduledMessages) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovMsgTl(uint64(m.Constructor))
+	}
+	if m.UserId != 0 {
+		n += 1 + sovMsgTl(uint64(m.UserId))
+	}
+	if m.AuthKeyId != 0 {
+		n += 1 + sovMsgTl(uint64(m.AuthKeyId))
+	}
+	if m.PeerType != 0 {
+		n += 1 + sovMsgTl(uint64(m.PeerType))
+	}
+	if m.PeerId != 0 {
+		n += 1 + sovMsgTl(uint64(m.PeerId))
+	}
+	if len(m.Id) > 0 {
+		l = 0
+		for _, e := range m.Id {
+			l += sovMsgTl(uint64(e))
+		}
+		n += 1 + sovMsgTl(uint64(l)) + l
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLMsgDeleteScheduledMessages) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovMsgTl(uint64(m.Constructor))
+	}
+	if m.UserId != 0 {
+		n += 1 + sovMsgTl(uint64(m.UserId))
+	}
+	if m.AuthKeyId != 0 {
+		n += 1 + sovMsgTl(uint64(m.AuthKeyId))
+	}
+	if m.PeerType != 0 {
+		n += 1 + sovMsgTl(uint64(m.PeerType))
+	}
+	if m.PeerId != 0 {
+		n += 1 + sovMsgTl(uint64(m.PeerId))
+	}
+	if len(m.Id) > 0 {
+		l = 0
+		for _, e := range m.Id {
+			l += sovMsgTl(uint64(e))
+		}
+		n += 1 + sovMsgTl(uint64(l)) + l
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLMsgSendReaction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovMsgTl(uint64(m.Constructor))
+	}
+	if m.UserId != 0 {
+		n += 1 + sovMsgTl(uint64(m.UserId))
+	}
+	if m.AuthKeyId != 0 {
+		n += 1 + sovMsgTl(uint64(m.AuthKeyId))
+	}
+	if m.Big {
+		n += 2
+	}
+	if m.PeerType != 0 {
+		n += 1 + sovMsgTl(uint64(m.PeerType))
+	}
+	if m.PeerId != 0 {
+		n += 1 + sovMsgTl(uint64(m.PeerId))
+	}
+	if m.MsgId != 0 {
+		n += 1 + sovMsgTl(uint64(m.MsgId))
+	}
+	if m.Reaction != nil {
+		l = m.Reaction.Size()
+		n += 1 + l + sovMsgTl(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLMsgReadReactions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovMsgTl(uint64(m.Constructor))
+	}
+	if m.UserId != 0 {
+		n += 1 + sovMsgTl(uint64(m.UserId))
+	}
+	if m.AuthKeyId != 0 {
+		n += 1 + sovMsgTl(uint64(m.AuthKeyId))
+	}
+	if m.PeerType != 0 {
+		n += 1 + sovMsgTl(uint64(m.PeerType))
+	}
+	if m.PeerId != 0 {
+		n += 1 + sovMsgTl(uint64(m.PeerId))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLMsgGetMessageReactionsList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovMsgTl(uint64(m.Constructor))
+	}
+	if m.UserId != 0 {
+		n += 1 + sovMsgTl(uint64(m.UserId))
+	}
+	if m.PeerType != 0 {
+		n += 1 + sovMsgTl(uint64(m.PeerType))
+	}
+	if m.PeerId != 0 {
+		n += 1 + sovMsgTl(uint64(m.PeerId))
+	}
+	if m.MsgId != 0 {
+		n += 1 + sovMsgTl(uint64(m.MsgId))
+	}
+	if m.Reaction != nil {
+		l = m.Reaction.Size()
+		n += 1 + l + sovMsgTl(uint64(l))
+	}
+	if m.Offset != nil {
+		l = m.Offset.Size()
+		n += 1 + l + sovMsgTl(uint64(l))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovMsgTl(uint64(m.Limit))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Vector_Message) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Datas) > 0 {
+		for _, e := range m.Datas {
+			l = e.Size()
+			n += 1 + l + sovMsgTl(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovMsgTl(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMsgTl(x uint64) (n int) {
+	return sovMsgTl(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Sender) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Sender: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Sender: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PredicateName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgTl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PredicateName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			m.UserId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UserId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthKeyId", wireType)
+			}
+			m.AuthKeyId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuthKeyId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLSender) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_sender: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_sender: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data2", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgTl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Data2 == nil {
+				m.Data2 = &Sender{}
+			}
+			if err := m.Data2.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OutboxMessage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OutboxMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OutboxMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PredicateName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgTl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PredicateName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NoWebpage", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.NoWebpage = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Background", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Background = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RandomId", wireType)
+			}
+			m.RandomId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RandomId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgTl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Message == nil {
+				m.Message = &mtproto.Message{}
+			}
+			if err := m.Message.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleDate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgTl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ScheduleDate == nil {
+				m.ScheduleDate = &types.Int32Value{}
+			}
+			if err := m.ScheduleDate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLOutboxMessage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_outboxMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_outboxMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data2", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgTl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Data2 == nil {
+				m.Data2 = &OutboxMessage{}
+			}
+			if err := m.Data2.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContentMessage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContentMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContentMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PredicateName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgTl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PredicateName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mentioned", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Mentioned = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MediaUnread", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.MediaUnread = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reaction", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Reaction = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLContentMessage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_contentMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_contentMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data2", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgTl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Data2 == nil {
+				m.Data2 = &ContentMessage{}
+			}
+			if err := m.Data2.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLMsgSendMessage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_msg_sendMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_msg_sendMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			m.UserId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UserId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthKeyId", wireType)
+			}
+			m.AuthKeyId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuthKeyId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeerType", wireType)
+			}
+			m.PeerType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PeerType |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeerId", wireType)
+			}
+			m.PeerId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PeerId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgTl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Message == nil {
+				m.Message = &OutboxMessage{}
+			}
+			if err := m.Message.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLMsgSendMultiMessage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_msg_sendMultiMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_msg_sendMultiMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			m.UserId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UserId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthKeyId", wireType)
+			}
+			m.AuthKeyId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuthKeyId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeerType", wireType)
+			}
+			m.PeerType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PeerType |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeerId", wireType)
+			}
+			m.PeerId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PeerId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgTl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = append(m.Message, &OutboxMessage{})
+			if err := m.Message[len(m.Message)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLMsgPushUserMessage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_msg_pushUserMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_msg_pushUserMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			m.UserId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UserId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthKeyId", wireType)
+			}
+			m.AuthKeyId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuthKeyId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeerType", wireType)
+			}
+			m.PeerType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PeerType |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeerId", wireType)
+			}
+			m.PeerId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PeerId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PushType", wireType)
+			}
+			m.PushType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PushType |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgTl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Message == nil {
+				m.Message = &OutboxMessage{}
+			}
+			if err := m.Message.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLMsgReadMessageContents) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_msg_readMessageContents: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_msg_readMessageContents: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			m.UserId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UserId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthKeyId", wireType)
+			}
+			m.AuthKeyId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuthKeyId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeerType", wireType)
+			}
+			m.PeerType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PeerType |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeerId", wireType)
+			}
+			m.PeerId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PeerId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgTl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = append(m.Id, &ContentMessage{})
+			if err := m.Id[len(m.Id)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func sovMsgTl(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozMsgTl(x uint64) (n int) {
-	return sovMsgTl(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *Sender) Unmarshal(dAtA []byte) error {
+func (m *TLMsgSendMessageV2) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4428,17 +7944,17 @@ func (m *Sender) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Sender: wiretype end group for non-group")
+			return fmt.Errorf("proto: TL_msg_sendMessageV2: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Sender: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TL_msg_sendMessageV2: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PredicateName", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
 			}
-			var stringLen uint64
+			m.Constructor = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgTl
@@ -4448,29 +7964,35 @@ func (m *Sender) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Constructor |= TLConstructor(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMsgTl
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMsgTl
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.UserId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UserId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.PredicateName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthKeyId", wireType)
 			}
-			m.Constructor = 0
+			m.AuthKeyId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgTl
@@ -4480,16 +8002,16 @@ func (m *Sender) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Constructor |= TLConstructor(b&0x7F) << shift
+				m.AuthKeyId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PeerType", wireType)
 			}
-			m.UserId = 0
+			m.PeerType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgTl
@@ -4499,16 +8021,16 @@ func (m *Sender) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UserId |= int64(b&0x7F) << shift
+				m.PeerType |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PeerId", wireType)
 			}
-			m.Type = 0
+			m.PeerId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgTl
@@ -4518,16 +8040,16 @@ func (m *Sender) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Type |= int32(b&0x7F) << shift
+				m.PeerId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuthKeyId", wireType)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
-			m.AuthKeyId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgTl
@@ -4537,11 +8059,26 @@ func (m *Sender) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AuthKeyId |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgTl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = append(m.Message, &OutboxMessage{})
+			if err := m.Message[len(m.Message)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMsgTl(dAtA[iNdEx:])
@@ -4564,7 +8101,7 @@ func (m *Sender) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TLSender) Unmarshal(dAtA []byte) error {
+func (m *TLMsgEditMessage) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4587,15 +8124,110 @@ func (m *TLSender) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TL_sender: wiretype end group for non-group")
+			return fmt.Errorf("proto: TL_msg_editMessage: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TL_sender: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TL_msg_editMessage: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			m.UserId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UserId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthKeyId", wireType)
+			}
+			m.AuthKeyId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuthKeyId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeerType", wireType)
+			}
+			m.PeerType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PeerType |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeerId", wireType)
+			}
+			m.PeerId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PeerId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data2", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4622,10 +8254,10 @@ func (m *TLSender) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Data2 == nil {
-				m.Data2 = &Sender{}
+			if m.Message == nil {
+				m.Message = &OutboxMessage{}
 			}
-			if err := m.Data2.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Message.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4651,7 +8283,7 @@ func (m *TLSender) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OutboxMessage) Unmarshal(dAtA []byte) error {
+func (m *TLMsgDeleteMessages) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4673,46 +8305,14 @@ func (m *OutboxMessage) Unmarshal(dAtA []byte) error {
 		}
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: OutboxMessage: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OutboxMessage: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PredicateName", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMsgTl
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMsgTl
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMsgTl
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PredicateName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_msg_deleteMessages: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_msg_deleteMessages: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
 			}
@@ -4733,9 +8333,9 @@ func (m *OutboxMessage) Unmarshal(dAtA []byte) error {
 			}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NoWebpage", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
 			}
-			var v int
+			m.UserId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgTl
@@ -4745,17 +8345,16 @@ func (m *OutboxMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.UserId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.NoWebpage = bool(v != 0)
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Background", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthKeyId", wireType)
 			}
-			var v int
+			m.AuthKeyId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgTl
@@ -4765,17 +8364,16 @@ func (m *OutboxMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.AuthKeyId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Background = bool(v != 0)
 		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RandomId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PeerType", wireType)
 			}
-			m.RandomId = 0
+			m.PeerType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgTl
@@ -4785,16 +8383,16 @@ func (m *OutboxMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RandomId |= int64(b&0x7F) << shift
+				m.PeerType |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeerId", wireType)
 			}
-			var msglen int
+			m.PeerId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgTl
@@ -4804,33 +8402,16 @@ func (m *OutboxMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.PeerId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthMsgTl
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthMsgTl
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Message == nil {
-				m.Message = &mtproto.Message{}
-			}
-			if err := m.Message.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleDate", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revoke", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgTl
@@ -4840,28 +8421,88 @@ func (m *OutboxMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthMsgTl
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthMsgTl
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ScheduleDate == nil {
-				m.ScheduleDate = &types.Int32Value{}
-			}
-			if err := m.ScheduleDate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Revoke = bool(v != 0)
+		case 8:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMsgTl
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Id = append(m.Id, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMsgTl
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthMsgTl
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthMsgTl
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Id) == 0 {
+					m.Id = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMsgTl
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Id = append(m.Id, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMsgTl(dAtA[iNdEx:])
@@ -4884,7 +8525,7 @@ func (m *OutboxMessage) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TLOutboxMessage) Unmarshal(dAtA []byte) error {
+func (m *TLMsgDeleteHistory) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4907,17 +8548,17 @@ func (m *TLOutboxMessage) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TL_outboxMessage: wiretype end group for non-group")
+			return fmt.Errorf("proto: TL_msg_deleteHistory: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TL_outboxMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TL_msg_deleteHistory: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data2", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
 			}
-			var msglen int
+			m.Constructor = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgTl
@@ -4927,84 +8568,35 @@ func (m *TLOutboxMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Constructor |= TLConstructor(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthMsgTl
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthMsgTl
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Data2 == nil {
-				m.Data2 = &OutboxMessage{}
-			}
-			if err := m.Data2.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMsgTl(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthMsgTl
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ContentMessage) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMsgTl
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.UserId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UserId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ContentMessage: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContentMessage: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PredicateName", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthKeyId", wireType)
 			}
-			var stringLen uint64
+			m.AuthKeyId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgTl
@@ -5014,29 +8606,16 @@ func (m *ContentMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.AuthKeyId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMsgTl
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMsgTl
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PredicateName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PeerType", wireType)
 			}
-			m.Constructor = 0
+			m.PeerType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgTl
@@ -5046,16 +8625,16 @@ func (m *ContentMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Constructor |= TLConstructor(b&0x7F) << shift
+				m.PeerType |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PeerId", wireType)
 			}
-			m.Id = 0
+			m.PeerId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgTl
@@ -5065,14 +8644,14 @@ func (m *ContentMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= int32(b&0x7F) << shift
+				m.PeerId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Mentioned", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JustClear", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -5089,10 +8668,10 @@ func (m *ContentMessage) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Mentioned = bool(v != 0)
-		case 5:
+			m.JustClear = bool(v != 0)
+		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MediaUnread", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Revoke", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -5109,12 +8688,12 @@ func (m *ContentMessage) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.MediaUnread = bool(v != 0)
-		case 6:
+			m.Revoke = bool(v != 0)
+		case 9:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reaction", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxId", wireType)
 			}
-			var v int
+			m.MaxId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgTl
@@ -5124,12 +8703,11 @@ func (m *ContentMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.MaxId |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Reaction = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMsgTl(dAtA[iNdEx:])
@@ -5152,7 +8730,7 @@ func (m *ContentMessage) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TLContentMessage) Unmarshal(dAtA []byte) error {
+func (m *TLMsgDeletePhoneCallHistory) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5175,17 +8753,17 @@ func (m *TLContentMessage) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TL_contentMessage: wiretype end group for non-group")
+			return fmt.Errorf("proto: TL_msg_deletePhoneCallHistory: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TL_contentMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TL_msg_deletePhoneCallHistory: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data2", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
 			}
-			var msglen int
+			m.Constructor = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgTl
@@ -5195,28 +8773,69 @@ func (m *TLContentMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Constructor |= TLConstructor(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthMsgTl
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthMsgTl
+			m.UserId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UserId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthKeyId", wireType)
 			}
-			if m.Data2 == nil {
-				m.Data2 = &ContentMessage{}
+			m.AuthKeyId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuthKeyId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if err := m.Data2.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revoke", wireType)
 			}
-			iNdEx = postIndex
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Revoke = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMsgTl(dAtA[iNdEx:])
@@ -5239,7 +8858,7 @@ func (m *TLContentMessage) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TLMsgSendMessage) Unmarshal(dAtA []byte) error {
+func (m *TLMsgDeleteChatHistory) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5262,10 +8881,10 @@ func (m *TLMsgSendMessage) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TL_msg_sendMessage: wiretype end group for non-group")
+			return fmt.Errorf("proto: TL_msg_deleteChatHistory: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TL_msg_sendMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TL_msg_deleteChatHistory: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5289,9 +8908,9 @@ func (m *TLMsgSendMessage) Unmarshal(dAtA []byte) error {
 			}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChatId", wireType)
 			}
-			m.UserId = 0
+			m.ChatId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgTl
@@ -5301,73 +8920,16 @@ func (m *TLMsgSendMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UserId |= int64(b&0x7F) << shift
+				m.ChatId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuthKeyId", wireType)
-			}
-			m.AuthKeyId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMsgTl
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.AuthKeyId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PeerType", wireType)
-			}
-			m.PeerType = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMsgTl
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PeerType |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PeerId", wireType)
-			}
-			m.PeerId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMsgTl
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PeerId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeleteUserId", wireType)
 			}
-			var msglen int
+			m.DeleteUserId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgTl
@@ -5377,28 +8939,11 @@ func (m *TLMsgSendMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.DeleteUserId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthMsgTl
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthMsgTl
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Message == nil {
-				m.Message = &OutboxMessage{}
-			}
-			if err := m.Message.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMsgTl(dAtA[iNdEx:])
@@ -5421,7 +8966,7 @@ func (m *TLMsgSendMessage) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TLMsgSendMultiMessage) Unmarshal(dAtA []byte) error {
+func (m *TLMsgReadHistory) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5444,10 +8989,10 @@ func (m *TLMsgSendMultiMessage) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TL_msg_sendMultiMessage: wiretype end group for non-group")
+			return fmt.Errorf("proto: TL_msg_readHistory: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TL_msg_sendMultiMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TL_msg_readHistory: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5546,10 +9091,10 @@ func (m *TLMsgSendMultiMessage) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxId", wireType)
 			}
-			var msglen int
+			m.MaxId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgTl
@@ -5559,26 +9104,11 @@ func (m *TLMsgSendMultiMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthMsgTl
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthMsgTl
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Message = append(m.Message, &OutboxMessage{})
-			if err := m.Message[len(m.Message)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+				m.MaxId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMsgTl(dAtA[iNdEx:])
@@ -5601,7 +9131,7 @@ func (m *TLMsgSendMultiMessage) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TLMsgPushUserMessage) Unmarshal(dAtA []byte) error {
+func (m *TLMsgUpdatePinnedMessage) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5624,10 +9154,10 @@ func (m *TLMsgPushUserMessage) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TL_msg_pushUserMessage: wiretype end group for non-group")
+			return fmt.Errorf("proto: TL_msg_updatePinnedMessage: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TL_msg_pushUserMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TL_msg_updatePinnedMessage: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5689,9 +9219,9 @@ func (m *TLMsgPushUserMessage) Unmarshal(dAtA []byte) error {
 			}
 		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PeerType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Silent", wireType)
 			}
-			m.PeerType = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgTl
@@ -5701,16 +9231,17 @@ func (m *TLMsgPushUserMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PeerType |= int32(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Silent = bool(v != 0)
 		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PeerId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Unpin", wireType)
 			}
-			m.PeerId = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgTl
@@ -5720,16 +9251,17 @@ func (m *TLMsgPushUserMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PeerId |= int64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Unpin = bool(v != 0)
 		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PushType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PmOneside", wireType)
 			}
-			m.PushType = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgTl
@@ -5739,16 +9271,17 @@ func (m *TLMsgPushUserMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PushType |= int32(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.PmOneside = bool(v != 0)
 		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeerType", wireType)
 			}
-			var msglen int
+			m.PeerType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgTl
@@ -5758,28 +9291,49 @@ func (m *TLMsgPushUserMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.PeerType |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthMsgTl
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthMsgTl
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeerId", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.PeerId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PeerId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if m.Message == nil {
-				m.Message = &OutboxMessage{}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			if err := m.Message.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMsgTl(dAtA[iNdEx:])
@@ -5802,7 +9356,7 @@ func (m *TLMsgPushUserMessage) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TLMsgReadMessageContents) Unmarshal(dAtA []byte) error {
+func (m *TLMsgUnpinAllMessages) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5825,10 +9379,10 @@ func (m *TLMsgReadMessageContents) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TL_msg_readMessageContents: wiretype end group for non-group")
+			return fmt.Errorf("proto: TL_msg_unpinAllMessages: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TL_msg_readMessageContents: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TL_msg_unpinAllMessages: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5926,40 +9480,6 @@ func (m *TLMsgReadMessageContents) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMsgTl
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthMsgTl
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthMsgTl
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Id = append(m.Id, &ContentMessage{})
-			if err := m.Id[len(m.Id)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMsgTl(dAtA[iNdEx:])
@@ -5982,7 +9502,7 @@ func (m *TLMsgReadMessageContents) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TLMsgSendMessageV2) Unmarshal(dAtA []byte) error {
+func (m *TLMsgGetScheduledHistory) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6005,10 +9525,10 @@ func (m *TLMsgSendMessageV2) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TL_msg_sendMessageV2: wiretype end group for non-group")
+			return fmt.Errorf("proto: TL_msg_getScheduledHistory: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TL_msg_sendMessageV2: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TL_msg_getScheduledHistory: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6050,25 +9570,6 @@ func (m *TLMsgSendMessageV2) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuthKeyId", wireType)
-			}
-			m.AuthKeyId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMsgTl
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.AuthKeyId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field PeerType", wireType)
 			}
@@ -6087,7 +9588,7 @@ func (m *TLMsgSendMessageV2) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 6:
+		case 5:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field PeerId", wireType)
 			}
@@ -6106,40 +9607,6 @@ func (m *TLMsgSendMessageV2) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMsgTl
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthMsgTl
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthMsgTl
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Message = append(m.Message, &OutboxMessage{})
-			if err := m.Message[len(m.Message)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMsgTl(dAtA[iNdEx:])
@@ -6162,7 +9629,7 @@ func (m *TLMsgSendMessageV2) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TLMsgEditMessage) Unmarshal(dAtA []byte) error {
+func (m *TLMsgGetScheduledMessages) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6185,10 +9652,10 @@ func (m *TLMsgEditMessage) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TL_msg_editMessage: wiretype end group for non-group")
+			return fmt.Errorf("proto: TL_msg_getScheduledMessages: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TL_msg_editMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TL_msg_getScheduledMessages: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6230,25 +9697,6 @@ func (m *TLMsgEditMessage) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuthKeyId", wireType)
-			}
-			m.AuthKeyId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMsgTl
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.AuthKeyId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field PeerType", wireType)
 			}
@@ -6267,7 +9715,7 @@ func (m *TLMsgEditMessage) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 6:
+		case 5:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field PeerId", wireType)
 			}
@@ -6286,42 +9734,82 @@ func (m *TLMsgEditMessage) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMsgTl
+		case 6:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMsgTl
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Id = append(m.Id, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMsgTl
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthMsgTl
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthMsgTl
 				}
-				if iNdEx >= l {
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
 				}
+				elementCount = count
+				if elementCount != 0 && len(m.Id) == 0 {
+					m.Id = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMsgTl
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Id = append(m.Id, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthMsgTl
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthMsgTl
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Message == nil {
-				m.Message = &OutboxMessage{}
-			}
-			if err := m.Message.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMsgTl(dAtA[iNdEx:])
@@ -6344,7 +9832,7 @@ func (m *TLMsgEditMessage) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TLMsgDeleteMessages) Unmarshal(dAtA []byte) error {
+func (m *TLMsgSendScheduledMessages) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6367,10 +9855,10 @@ func (m *TLMsgDeleteMessages) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TL_msg_deleteMessages: wiretype end group for non-group")
+			return fmt.Errorf("proto: TL_msg_sendScheduledMessages: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TL_msg_deleteMessages: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TL_msg_sendScheduledMessages: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6469,26 +9957,6 @@ func (m *TLMsgDeleteMessages) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Revoke", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMsgTl
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Revoke = bool(v != 0)
-		case 8:
 			if wireType == 0 {
 				var v int32
 				for shift := uint(0); ; shift += 7 {
@@ -6586,7 +10054,7 @@ func (m *TLMsgDeleteMessages) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TLMsgDeleteHistory) Unmarshal(dAtA []byte) error {
+func (m *TLMsgDeleteScheduledMessages) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6609,10 +10077,10 @@ func (m *TLMsgDeleteHistory) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TL_msg_deleteHistory: wiretype end group for non-group")
+			return fmt.Errorf("proto: TL_msg_deleteScheduledMessages: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TL_msg_deleteHistory: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TL_msg_deleteScheduledMessages: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6711,63 +10179,80 @@ func (m *TLMsgDeleteHistory) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JustClear", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMsgTl
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMsgTl
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+				m.Id = append(m.Id, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMsgTl
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				if packedLen < 0 {
+					return ErrInvalidLengthMsgTl
 				}
-			}
-			m.JustClear = bool(v != 0)
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Revoke", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMsgTl
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthMsgTl
 				}
-				if iNdEx >= l {
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Revoke = bool(v != 0)
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxId", wireType)
-			}
-			m.MaxId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMsgTl
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
 				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+				elementCount = count
+				if elementCount != 0 && len(m.Id) == 0 {
+					m.Id = make([]int32, 0, elementCount)
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MaxId |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMsgTl
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Id = append(m.Id, v)
 				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 		default:
 			iNdEx = preIndex
@@ -6791,7 +10276,7 @@ func (m *TLMsgDeleteHistory) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TLMsgDeletePhoneCallHistory) Unmarshal(dAtA []byte) error {
+func (m *TLMsgSendReaction) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6814,10 +10299,10 @@ func (m *TLMsgDeletePhoneCallHistory) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TL_msg_deletePhoneCallHistory: wiretype end group for non-group")
+			return fmt.Errorf("proto: TL_msg_sendReaction: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TL_msg_deletePhoneCallHistory: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TL_msg_sendReaction: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6879,7 +10364,7 @@ func (m *TLMsgDeletePhoneCallHistory) Unmarshal(dAtA []byte) error {
 			}
 		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Revoke", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Big", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -6890,69 +10375,18 @@ func (m *TLMsgDeletePhoneCallHistory) Unmarshal(dAtA []byte) error {
 					return io.ErrUnexpectedEOF
 				}
 				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Revoke = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMsgTl(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthMsgTl
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *TLMsgDeleteChatHistory) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMsgTl
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TL_msg_deleteChatHistory: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TL_msg_deleteChatHistory: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Big = bool(v != 0)
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PeerType", wireType)
 			}
-			m.Constructor = 0
+			m.PeerType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgTl
@@ -6962,16 +10396,16 @@ func (m *TLMsgDeleteChatHistory) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Constructor |= TLConstructor(b&0x7F) << shift
+				m.PeerType |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChatId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PeerId", wireType)
 			}
-			m.ChatId = 0
+			m.PeerId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgTl
@@ -6981,16 +10415,16 @@ func (m *TLMsgDeleteChatHistory) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ChatId |= int64(b&0x7F) << shift
+				m.PeerId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeleteUserId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgId", wireType)
 			}
-			m.DeleteUserId = 0
+			m.MsgId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgTl
@@ -7000,11 +10434,47 @@ func (m *TLMsgDeleteChatHistory) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DeleteUserId |= int64(b&0x7F) << shift
+				m.MsgId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reaction", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgTl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Reaction == nil {
+				m.Reaction = &types.StringValue{}
+			}
+			if err := m.Reaction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMsgTl(dAtA[iNdEx:])
@@ -7027,7 +10497,7 @@ func (m *TLMsgDeleteChatHistory) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TLMsgReadHistory) Unmarshal(dAtA []byte) error {
+func (m *TLMsgReadReactions) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7050,10 +10520,10 @@ func (m *TLMsgReadHistory) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TL_msg_readHistory: wiretype end group for non-group")
+			return fmt.Errorf("proto: TL_msg_readReactions: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TL_msg_readHistory: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TL_msg_readReactions: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7151,25 +10621,6 @@ func (m *TLMsgReadHistory) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxId", wireType)
-			}
-			m.MaxId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMsgTl
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MaxId |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMsgTl(dAtA[iNdEx:])
@@ -7192,7 +10643,7 @@ func (m *TLMsgReadHistory) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TLMsgUpdatePinnedMessage) Unmarshal(dAtA []byte) error {
+func (m *TLMsgGetMessageReactionsList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7215,10 +10666,10 @@ func (m *TLMsgUpdatePinnedMessage) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TL_msg_updatePinnedMessage: wiretype end group for non-group")
+			return fmt.Errorf("proto: TL_msg_getMessageReactionsList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TL_msg_updatePinnedMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TL_msg_getMessageReactionsList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7261,9 +10712,9 @@ func (m *TLMsgUpdatePinnedMessage) Unmarshal(dAtA []byte) error {
 			}
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuthKeyId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PeerType", wireType)
 			}
-			m.AuthKeyId = 0
+			m.PeerType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgTl
@@ -7273,16 +10724,16 @@ func (m *TLMsgUpdatePinnedMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AuthKeyId |= int64(b&0x7F) << shift
+				m.PeerType |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Silent", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PeerId", wireType)
 			}
-			var v int
+			m.PeerId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgTl
@@ -7292,17 +10743,16 @@ func (m *TLMsgUpdatePinnedMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.PeerId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Silent = bool(v != 0)
 		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Unpin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgId", wireType)
 			}
-			var v int
+			m.MsgId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgTl
@@ -7312,17 +10762,16 @@ func (m *TLMsgUpdatePinnedMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.MsgId |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Unpin = bool(v != 0)
 		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PmOneside", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reaction", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgTl
@@ -7332,17 +10781,33 @@ func (m *TLMsgUpdatePinnedMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.PmOneside = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthMsgTl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Reaction == nil {
+				m.Reaction = &types.StringValue{}
+			}
+			if err := m.Reaction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PeerType", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
 			}
-			m.PeerType = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgTl
@@ -7352,35 +10817,33 @@ func (m *TLMsgUpdatePinnedMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PeerType |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PeerId", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthMsgTl
 			}
-			m.PeerId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMsgTl
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PeerId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgTl
 			}
-		case 10:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Offset == nil {
+				m.Offset = &types.StringValue{}
+			}
+			if err := m.Offset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
 			}
-			m.Id = 0
+			m.Limit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgTl
@@ -7390,7 +10853,7 @@ func (m *TLMsgUpdatePinnedMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= int32(b&0x7F) << shift
+				m.Limit |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -7417,7 +10880,7 @@ func (m *TLMsgUpdatePinnedMessage) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TLMsgUnpinAllMessages) Unmarshal(dAtA []byte) error {
+func (m *Vector_Message) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7440,55 +10903,17 @@ func (m *TLMsgUnpinAllMessages) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TL_msg_unpinAllMessages: wiretype end group for non-group")
+			return fmt.Errorf("proto: Vector_Message: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TL_msg_unpinAllMessages: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Vector_Message: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
-			}
-			m.Constructor = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMsgTl
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Constructor |= TLConstructor(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
-			}
-			m.UserId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMsgTl
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.UserId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuthKeyId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Datas", wireType)
 			}
-			m.AuthKeyId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgTl
@@ -7498,49 +10923,26 @@ func (m *TLMsgUnpinAllMessages) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AuthKeyId |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PeerType", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthMsgTl
 			}
-			m.PeerType = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMsgTl
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PeerType |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgTl
 			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PeerId", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.PeerId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMsgTl
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PeerId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.Datas = append(m.Datas, &mtproto.Message{})
+			if err := m.Datas[len(m.Datas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMsgTl(dAtA[iNdEx:])
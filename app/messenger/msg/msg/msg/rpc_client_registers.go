@@ -26,19 +26,26 @@ type RPCContextTuple struct {
 }
 
 var rpcContextRegisters = map[string]RPCContextTuple{
-	"TLMsgSendMessage":            RPCContextTuple{"/mtproto.RPCMsg/msg_sendMessage", func() interface{} { return new(mtproto.Updates) }},
-	"TLMsgSendMultiMessage":       RPCContextTuple{"/mtproto.RPCMsg/msg_sendMultiMessage", func() interface{} { return new(mtproto.Updates) }},
-	"TLMsgPushUserMessage":        RPCContextTuple{"/mtproto.RPCMsg/msg_pushUserMessage", func() interface{} { return new(mtproto.Bool) }},
-	"TLMsgReadMessageContents":    RPCContextTuple{"/mtproto.RPCMsg/msg_readMessageContents", func() interface{} { return new(mtproto.Messages_AffectedMessages) }},
-	"TLMsgSendMessageV2":          RPCContextTuple{"/mtproto.RPCMsg/msg_sendMessageV2", func() interface{} { return new(mtproto.UpdateList) }},
-	"TLMsgEditMessage":            RPCContextTuple{"/mtproto.RPCMsg/msg_editMessage", func() interface{} { return new(mtproto.Updates) }},
-	"TLMsgDeleteMessages":         RPCContextTuple{"/mtproto.RPCMsg/msg_deleteMessages", func() interface{} { return new(mtproto.Messages_AffectedMessages) }},
-	"TLMsgDeleteHistory":          RPCContextTuple{"/mtproto.RPCMsg/msg_deleteHistory", func() interface{} { return new(mtproto.Messages_AffectedHistory) }},
-	"TLMsgDeletePhoneCallHistory": RPCContextTuple{"/mtproto.RPCMsg/msg_deletePhoneCallHistory", func() interface{} { return new(mtproto.Messages_AffectedFoundMessages) }},
-	"TLMsgDeleteChatHistory":      RPCContextTuple{"/mtproto.RPCMsg/msg_deleteChatHistory", func() interface{} { return new(mtproto.Bool) }},
-	"TLMsgReadHistory":            RPCContextTuple{"/mtproto.RPCMsg/msg_readHistory", func() interface{} { return new(mtproto.Messages_AffectedMessages) }},
-	"TLMsgUpdatePinnedMessage":    RPCContextTuple{"/mtproto.RPCMsg/msg_updatePinnedMessage", func() interface{} { return new(mtproto.Updates) }},
-	"TLMsgUnpinAllMessages":       RPCContextTuple{"/mtproto.RPCMsg/msg_unpinAllMessages", func() interface{} { return new(mtproto.Messages_AffectedHistory) }},
+	"TLMsgSendMessage":             RPCContextTuple{"/mtproto.RPCMsg/msg_sendMessage", func() interface{} { return new(mtproto.Updates) }},
+	"TLMsgSendMultiMessage":        RPCContextTuple{"/mtproto.RPCMsg/msg_sendMultiMessage", func() interface{} { return new(mtproto.Updates) }},
+	"TLMsgPushUserMessage":         RPCContextTuple{"/mtproto.RPCMsg/msg_pushUserMessage", func() interface{} { return new(mtproto.Bool) }},
+	"TLMsgReadMessageContents":     RPCContextTuple{"/mtproto.RPCMsg/msg_readMessageContents", func() interface{} { return new(mtproto.Messages_AffectedMessages) }},
+	"TLMsgSendMessageV2":           RPCContextTuple{"/mtproto.RPCMsg/msg_sendMessageV2", func() interface{} { return new(mtproto.UpdateList) }},
+	"TLMsgEditMessage":             RPCContextTuple{"/mtproto.RPCMsg/msg_editMessage", func() interface{} { return new(mtproto.Updates) }},
+	"TLMsgDeleteMessages":          RPCContextTuple{"/mtproto.RPCMsg/msg_deleteMessages", func() interface{} { return new(mtproto.Messages_AffectedMessages) }},
+	"TLMsgDeleteHistory":           RPCContextTuple{"/mtproto.RPCMsg/msg_deleteHistory", func() interface{} { return new(mtproto.Messages_AffectedHistory) }},
+	"TLMsgDeletePhoneCallHistory":  RPCContextTuple{"/mtproto.RPCMsg/msg_deletePhoneCallHistory", func() interface{} { return new(mtproto.Messages_AffectedFoundMessages) }},
+	"TLMsgDeleteChatHistory":       RPCContextTuple{"/mtproto.RPCMsg/msg_deleteChatHistory", func() interface{} { return new(mtproto.Bool) }},
+	"TLMsgReadHistory":             RPCContextTuple{"/mtproto.RPCMsg/msg_readHistory", func() interface{} { return new(mtproto.Messages_AffectedMessages) }},
+	"TLMsgUpdatePinnedMessage":     RPCContextTuple{"/mtproto.RPCMsg/msg_updatePinnedMessage", func() interface{} { return new(mtproto.Updates) }},
+	"TLMsgUnpinAllMessages":        RPCContextTuple{"/mtproto.RPCMsg/msg_unpinAllMessages", func() interface{} { return new(mtproto.Messages_AffectedHistory) }},
+	"TLMsgGetScheduledHistory":     RPCContextTuple{"/mtproto.RPCMsg/msg_getScheduledHistory", func() interface{} { return new(Vector_Message) }},
+	"TLMsgGetScheduledMessages":    RPCContextTuple{"/mtproto.RPCMsg/msg_getScheduledMessages", func() interface{} { return new(Vector_Message) }},
+	"TLMsgSendScheduledMessages":   RPCContextTuple{"/mtproto.RPCMsg/msg_sendScheduledMessages", func() interface{} { return new(mtproto.Updates) }},
+	"TLMsgDeleteScheduledMessages": RPCContextTuple{"/mtproto.RPCMsg/msg_deleteScheduledMessages", func() interface{} { return new(mtproto.Updates) }},
+	"TLMsgSendReaction":            RPCContextTuple{"/mtproto.RPCMsg/msg_sendReaction", func() interface{} { return new(mtproto.Updates) }},
+	"TLMsgReadReactions":           RPCContextTuple{"/mtproto.RPCMsg/msg_readReactions", func() interface{} { return new(mtproto.Messages_AffectedHistory) }},
+	"TLMsgGetMessageReactionsList": RPCContextTuple{"/mtproto.RPCMsg/msg_getMessageReactionsList", func() interface{} { return new(mtproto.Messages_MessageReactionsList) }},
 }
 
 func FindRPCContextTuple(t interface{}) *RPCContextTuple {
@@ -0,0 +1,67 @@
+/*
+ * WARNING! All changes made in this file will be lost!
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package push_client
+
+import (
+	"context"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/messenger/push/push"
+
+	"github.com/zeromicro/go-zero/zrpc"
+)
+
+var _ *mtproto.Bool
+
+type PushClient interface {
+	PushRegisterDevice(ctx context.Context, in *push.TLPushRegisterDevice) (*mtproto.Bool, error)
+	PushUnregisterDevice(ctx context.Context, in *push.TLPushUnregisterDevice) (*mtproto.Bool, error)
+	PushUpdateDeviceLocked(ctx context.Context, in *push.TLPushUpdateDeviceLocked) (*mtproto.Bool, error)
+	PushPushUpdatesIfNot(ctx context.Context, in *push.TLPushPushUpdatesIfNot) (*mtproto.Void, error)
+}
+
+type defaultPushClient struct {
+	cli zrpc.Client
+}
+
+func NewPushClient(cli zrpc.Client) PushClient {
+	return &defaultPushClient{
+		cli: cli,
+	}
+}
+
+// PushRegisterDevice
+// push.registerDevice flags:# user_id:long auth_key_id:long no_muted:flags.0?true token_type:int token:string app_sandbox:Bool secret:bytes other_uids:Vector<long> = Bool;
+func (m *defaultPushClient) PushRegisterDevice(ctx context.Context, in *push.TLPushRegisterDevice) (*mtproto.Bool, error) {
+	client := push.NewRPCPushClient(m.cli.Conn())
+	return client.PushRegisterDevice(ctx, in)
+}
+
+// PushUnregisterDevice
+// push.unregisterDevice user_id:long auth_key_id:long token_type:int token:string other_uids:Vector<long> = Bool;
+func (m *defaultPushClient) PushUnregisterDevice(ctx context.Context, in *push.TLPushUnregisterDevice) (*mtproto.Bool, error) {
+	client := push.NewRPCPushClient(m.cli.Conn())
+	return client.PushUnregisterDevice(ctx, in)
+}
+
+// PushUpdateDeviceLocked
+// push.updateDeviceLocked user_id:long auth_key_id:long period:int = Bool;
+func (m *defaultPushClient) PushUpdateDeviceLocked(ctx context.Context, in *push.TLPushUpdateDeviceLocked) (*mtproto.Bool, error) {
+	client := push.NewRPCPushClient(m.cli.Conn())
+	return client.PushUpdateDeviceLocked(ctx, in)
+}
+
+// PushPushUpdatesIfNot
+// push.pushUpdatesIfNot user_id:long excludes:Vector<long> updates:Updates = Void;
+func (m *defaultPushClient) PushPushUpdatesIfNot(ctx context.Context, in *push.TLPushPushUpdatesIfNot) (*mtproto.Void, error) {
+	client := push.NewRPCPushClient(m.cli.Conn())
+	return client.PushPushUpdatesIfNot(ctx, in)
+}
@@ -0,0 +1,37 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package config
+
+import (
+	kafka "github.com/teamgram/marmota/pkg/mq"
+	"github.com/teamgram/marmota/pkg/stores/sqlx"
+	"github.com/teamgram/teamgram-server/app/messenger/push/internal/sender"
+	"github.com/zeromicro/go-zero/zrpc"
+)
+
+type Config struct {
+	zrpc.RpcServerConf
+	Mysql            sqlx.Config
+	PushConsumer     kafka.KafkaConsumerConf
+	BizServiceClient zrpc.RpcClientConf
+	Apns             *sender.ApnsConf    `json:",optional"`
+	Fcm              *sender.FcmConf     `json:",optional"`
+	Webhook          *sender.WebhookConf `json:",optional"`
+	MockSender       bool                `json:",optional"`
+}
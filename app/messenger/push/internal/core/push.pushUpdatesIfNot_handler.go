@@ -0,0 +1,145 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"time"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/messenger/push/internal/dal/dataobject"
+	"github.com/teamgram/teamgram-server/app/messenger/push/internal/sender"
+	"github.com/teamgram/teamgram-server/app/messenger/push/push"
+	userpb "github.com/teamgram/teamgram-server/app/service/biz/user/user"
+)
+
+// PushPushUpdatesIfNot
+// push.pushUpdatesIfNot user_id:long excludes:Vector<long> updates:Updates = Void;
+func (c *PushCore) PushPushUpdatesIfNot(in *push.TLPushPushUpdatesIfNot) (*mtproto.Void, error) {
+	var (
+		notifications []*messageNotification
+	)
+
+	visitMessage := func(userId int64, update *mtproto.Update, users []*mtproto.User, chats []*mtproto.Chat, date int32) {
+		message := update.GetMessage_MESSAGE()
+		if len(users) == 0 {
+			users = c.getMessageUsers(userId, message)
+		}
+		if n := makeMessageNotification(userId, message, users, chats); n != nil {
+			notifications = append(notifications, n)
+		}
+	}
+	mtproto.VisitUpdates(in.UserId, in.Updates, map[string]mtproto.UpdateVisitedFunc{
+		mtproto.Predicate_updateNewMessage:        visitMessage,
+		mtproto.Predicate_updateNewChannelMessage: visitMessage,
+	})
+	if len(notifications) == 0 {
+		return mtproto.EmptyVoid, nil
+	}
+
+	devices := c.getPushDevices(in.UserId, in.Excludes)
+	if len(devices) == 0 {
+		return mtproto.EmptyVoid, nil
+	}
+
+	var (
+		now          = int32(time.Now().Unix())
+		settingsList = make(map[peerKey]*mtproto.PeerNotifySettings)
+	)
+
+	for _, n := range notifications {
+		key := peerKey{n.peer.PeerType, n.peer.PeerId}
+		settings, ok := settingsList[key]
+		if !ok {
+			settings, _ = c.svcCtx.Dao.UserClient.UserGetNotifySettings(c.ctx, &userpb.TLUserGetNotifySettings{
+				UserId:   in.UserId,
+				PeerType: n.peer.PeerType,
+				PeerId:   n.peer.PeerId,
+			})
+			settingsList[key] = settings
+		}
+
+		for i := 0; i < len(devices); i++ {
+			device := devices[i]
+			if device.State {
+				continue
+			}
+
+			notification := makeDeviceNotification(n, settings, device, now)
+			if notification == nil {
+				continue
+			}
+
+			err := c.svcCtx.Dao.Senders.Send(
+				c.ctx,
+				&sender.Device{
+					TokenType:  device.TokenType,
+					Token:      device.Token,
+					AppSandbox: device.AppSandbox,
+				},
+				notification)
+			switch err {
+			case nil:
+			case sender.ErrInvalidToken:
+				c.Logger.Infof("push.pushUpdatesIfNot - unregister device: {id: %d, user_id: %d, token_type: %d}",
+					device.Id,
+					device.UserId,
+					device.TokenType)
+				c.svcCtx.Dao.DevicesDAO.UpdateStateById(c.ctx, true, device.Id)
+				device.State = true
+			default:
+				c.Logger.Errorf("push.pushUpdatesIfNot - error: %v, device: {id: %d, token_type: %d}",
+					err,
+					device.Id,
+					device.TokenType)
+			}
+		}
+	}
+
+	return mtproto.EmptyVoid, nil
+}
+
+type peerKey struct {
+	peerType int32
+	peerId   int64
+}
+
+// getPushDevices returns the devices of the user except the auth keys with a live session.
+func (c *PushCore) getPushDevices(userId int64, excludes []int64) []*dataobject.DevicesDO {
+	var (
+		devices []*dataobject.DevicesDO
+	)
+
+	c.svcCtx.Dao.DevicesDAO.SelectListByUserIdWithCB(
+		c.ctx,
+		userId,
+		func(i int, v *dataobject.DevicesDO) {
+			for _, id := range excludes {
+				if id == v.AuthKeyId {
+					return
+				}
+			}
+			devices = append(devices, v)
+		})
+
+	return devices
+}
+
+func (c *PushCore) getMessageUsers(userId int64, message *mtproto.Message) []*mtproto.User {
+	fromId := message.GetFromId().GetUserId()
+	if fromId == 0 {
+		return nil
+	}
+
+	users, _ := c.svcCtx.Dao.UserClient.UserGetMutableUsers(c.ctx, &userpb.TLUserGetMutableUsers{
+		Id: []int64{userId, fromId},
+	})
+
+	return users.GetUserListByIdList(userId, fromId)
+}
@@ -0,0 +1,52 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"encoding/base64"
+
+	"github.com/teamgram/marmota/pkg/hack"
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/messenger/push/internal/dal/dataobject"
+	"github.com/teamgram/teamgram-server/app/messenger/push/push"
+
+	"github.com/zeromicro/go-zero/core/jsonx"
+)
+
+// PushRegisterDevice
+// push.registerDevice flags:# user_id:long auth_key_id:long no_muted:flags.0?true token_type:int token:string app_sandbox:Bool secret:bytes other_uids:Vector<long> = Bool;
+func (c *PushCore) PushRegisterDevice(in *push.TLPushRegisterDevice) (*mtproto.Bool, error) {
+	if in.Token == "" {
+		err := mtproto.ErrTokenInvalid
+		c.Logger.Errorf("push.registerDevice - error: %v", err)
+		return nil, err
+	}
+
+	otherUids, _ := jsonx.Marshal(in.OtherUids)
+	_, _, err := c.svcCtx.Dao.DevicesDAO.InsertOrUpdate(c.ctx, &dataobject.DevicesDO{
+		AuthKeyId:  in.AuthKeyId,
+		UserId:     in.UserId,
+		TokenType:  in.TokenType,
+		Token:      in.Token,
+		NoMuted:    in.NoMuted,
+		AppSandbox: mtproto.FromBool(in.AppSandbox),
+		Secret:     base64.StdEncoding.EncodeToString(in.Secret),
+		OtherUids:  hack.String(otherUids),
+	})
+	if err != nil {
+		c.Logger.Errorf("push.registerDevice - error: %v", err)
+		return nil, err
+	}
+
+	// the client is reinstalled, the old auth keys of the same token are stale
+	c.svcCtx.Dao.DevicesDAO.UpdateStateByOtherAuthKey(c.ctx, true, in.UserId, in.TokenType, in.Token, in.AuthKeyId)
+
+	return mtproto.BoolTrue, nil
+}
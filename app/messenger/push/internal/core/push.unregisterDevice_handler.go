@@ -0,0 +1,27 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/messenger/push/push"
+)
+
+// PushUnregisterDevice
+// push.unregisterDevice user_id:long auth_key_id:long token_type:int token:string other_uids:Vector<long> = Bool;
+func (c *PushCore) PushUnregisterDevice(in *push.TLPushUnregisterDevice) (*mtproto.Bool, error) {
+	_, err := c.svcCtx.Dao.DevicesDAO.UpdateState(c.ctx, true, in.AuthKeyId, in.UserId, in.TokenType, in.Token)
+	if err != nil {
+		c.Logger.Errorf("push.unregisterDevice - error: %v", err)
+		return nil, err
+	}
+
+	return mtproto.BoolTrue, nil
+}
@@ -0,0 +1,31 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/messenger/push/push"
+)
+
+// PushUpdateDeviceLocked
+// push.updateDeviceLocked user_id:long auth_key_id:long period:int = Bool;
+func (c *PushCore) PushUpdateDeviceLocked(in *push.TLPushUpdateDeviceLocked) (*mtproto.Bool, error) {
+	if in.Period < 0 {
+		in.Period = 0
+	}
+
+	_, err := c.svcCtx.Dao.DevicesDAO.UpdateLockedPeriod(c.ctx, in.Period, in.AuthKeyId, in.UserId)
+	if err != nil {
+		c.Logger.Errorf("push.updateDeviceLocked - error: %v", err)
+		return nil, err
+	}
+
+	return mtproto.BoolTrue, nil
+}
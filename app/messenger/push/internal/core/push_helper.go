@@ -0,0 +1,167 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"strconv"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/messenger/push/internal/dal/dataobject"
+	"github.com/teamgram/teamgram-server/app/messenger/push/internal/sender"
+)
+
+const (
+	hiddenPreviewBody = "New message"
+)
+
+// messageNotification is a notification of a new message before the notify settings are applied.
+type messageNotification struct {
+	peer         *mtproto.PeerUtil
+	mentioned    bool
+	silent       bool
+	notification sender.Notification
+}
+
+// makeMessageNotification returns nil for the messages we don't push: outgoing and service messages.
+func makeMessageNotification(userId int64, message *mtproto.Message, users []*mtproto.User, chats []*mtproto.Chat) *messageNotification {
+	if message.GetPredicateName() != mtproto.Predicate_message ||
+		message.GetOut() ||
+		message.GetPeerId() == nil {
+		return nil
+	}
+
+	var (
+		peer     = mtproto.FromPeer(message.GetPeerId())
+		fromId   = int64(0)
+		fromName = ""
+		data     = map[string]string{
+			"msg_id": strconv.Itoa(int(message.GetId())),
+		}
+		n = &messageNotification{
+			mentioned: message.GetMentioned(),
+			silent:    message.GetSilent(),
+		}
+	)
+
+	if from := message.GetFromId(); from.GetPredicateName() == mtproto.Predicate_peerUser {
+		fromId = from.GetUserId()
+		fromName = mtproto.GetUserName(findUser(users, fromId))
+		data["from_id"] = strconv.FormatInt(fromId, 10)
+	}
+
+	switch {
+	case peer.IsUser():
+		// an incoming private message, the peer of the dialog is the sender
+		if peer.PeerId == userId && fromId != 0 {
+			peer = mtproto.MakeUserPeerUtil(fromId)
+		}
+		n.notification.Title = fromName
+		n.notification.Body = getMessageText(message)
+	case peer.IsChat(), peer.IsChannel():
+		if peer.IsChat() {
+			data["chat_id"] = strconv.FormatInt(peer.PeerId, 10)
+		} else {
+			data["channel_id"] = strconv.FormatInt(peer.PeerId, 10)
+		}
+		n.notification.Title = findChat(chats, peer.PeerId).GetTitle()
+		if fromName != "" && !message.GetPost() {
+			n.notification.Body = fromName + ": " + getMessageText(message)
+		} else {
+			n.notification.Body = getMessageText(message)
+		}
+	default:
+		return nil
+	}
+
+	n.peer = peer
+	n.notification.UserId = userId
+	n.notification.Data = data
+
+	return n
+}
+
+// makeDeviceNotification applies the notify settings of the peer and the device state,
+// returns nil if the device shouldn't be notified.
+func makeDeviceNotification(n *messageNotification, settings *mtproto.PeerNotifySettings, device *dataobject.DevicesDO, now int32) *sender.Notification {
+	notification := n.notification
+	notification.Silent = n.silent || mtproto.FromBool(settings.GetSilent())
+
+	if settings.GetMuteUntil().GetValue() > now && !n.mentioned {
+		// no_muted devices don't want the notifications of muted chats at all
+		if device.NoMuted {
+			return nil
+		}
+		notification.Silent = true
+	}
+
+	// show_previews is true if not set
+	if (settings.GetShowPreviews() != nil && !mtproto.FromBool(settings.GetShowPreviews())) ||
+		device.LockedPeriod > 0 {
+		notification.Body = hiddenPreviewBody
+	}
+
+	return &notification
+}
+
+func getMessageText(message *mtproto.Message) string {
+	if message.GetMessage() != "" {
+		return message.GetMessage()
+	}
+
+	switch message.GetMedia().GetPredicateName() {
+	case mtproto.Predicate_messageMediaPhoto:
+		return "Photo"
+	case mtproto.Predicate_messageMediaDocument:
+		return "File"
+	case mtproto.Predicate_messageMediaGeo,
+		mtproto.Predicate_messageMediaGeoLive,
+		mtproto.Predicate_messageMediaVenue:
+		return "Location"
+	case mtproto.Predicate_messageMediaContact:
+		return "Contact"
+	case mtproto.Predicate_messageMediaPoll:
+		return "Poll"
+	case mtproto.Predicate_messageMediaDice:
+		return message.GetMedia().GetEmoticon()
+	case mtproto.Predicate_messageMediaGame:
+		return "Game"
+	case mtproto.Predicate_messageMediaInvoice:
+		return "Invoice"
+	default:
+		return hiddenPreviewBody
+	}
+}
+
+func findUser(users []*mtproto.User, id int64) *mtproto.User {
+	for _, u := range users {
+		if u.GetId() == id {
+			return u
+		}
+	}
+	return nil
+}
+
+func findChat(chats []*mtproto.Chat, id int64) *mtproto.Chat {
+	for _, c := range chats {
+		if c.GetId() == id {
+			return c
+		}
+	}
+	return nil
+}
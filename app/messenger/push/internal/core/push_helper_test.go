@@ -0,0 +1,101 @@
+// Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+//  All rights reserved.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/messenger/push/internal/dal/dataobject"
+)
+
+func makeTestMessage(peer *mtproto.Peer, fromId int64, text string) *mtproto.Message {
+	return mtproto.MakeTLMessage(&mtproto.Message{
+		Id:      1,
+		PeerId:  peer,
+		FromId:  mtproto.MakePeerUser(fromId),
+		Message: text,
+	}).To_Message()
+}
+
+func makeTestUser(id int64, firstName string) *mtproto.User {
+	return mtproto.MakeTLUser(&mtproto.User{
+		Id:        id,
+		FirstName: &types.StringValue{Value: firstName},
+	}).To_User()
+}
+
+func TestMakeMessageNotification(t *testing.T) {
+	users := []*mtproto.User{makeTestUser(2, "alice")}
+	chats := []*mtproto.Chat{mtproto.MakeTLChat(&mtproto.Chat{Id: 10, Title: "team"}).To_Chat()}
+
+	n := makeMessageNotification(1, makeTestMessage(mtproto.MakePeerUser(1), 2, "hi"), users, chats)
+	if n == nil || n.peer.PeerId != 2 || n.notification.Title != "alice" || n.notification.Body != "hi" {
+		t.Fatalf("private message: unexpected notification %#v", n)
+	}
+
+	n = makeMessageNotification(1, makeTestMessage(mtproto.MakePeerChat(10), 2, "hi"), users, chats)
+	if n == nil || n.notification.Title != "team" || n.notification.Body != "alice: hi" {
+		t.Fatalf("chat message: unexpected notification %#v", n)
+	}
+
+	out := makeTestMessage(mtproto.MakePeerUser(2), 1, "hi")
+	out.Out = true
+	if n = makeMessageNotification(1, out, users, chats); n != nil {
+		t.Fatalf("outgoing message: want nil, got %#v", n)
+	}
+}
+
+func TestMakeDeviceNotification(t *testing.T) {
+	var (
+		now = int32(1000)
+		n   = makeMessageNotification(
+			1,
+			makeTestMessage(mtproto.MakePeerUser(1), 2, "hi"),
+			[]*mtproto.User{makeTestUser(2, "alice")},
+			nil)
+		muted = mtproto.MakeTLPeerNotifySettings(&mtproto.PeerNotifySettings{
+			MuteUntil: &types.Int32Value{Value: now + 100},
+		}).To_PeerNotifySettings()
+		noPreviews = mtproto.MakeTLPeerNotifySettings(&mtproto.PeerNotifySettings{
+			ShowPreviews: mtproto.BoolFalse,
+		}).To_PeerNotifySettings()
+	)
+
+	tests := []struct {
+		name       string
+		settings   *mtproto.PeerNotifySettings
+		device     *dataobject.DevicesDO
+		wantNil    bool
+		wantSilent bool
+		wantBody   string
+	}{
+		{"default", nil, &dataobject.DevicesDO{}, false, false, "hi"},
+		{"muted", muted, &dataobject.DevicesDO{}, false, true, "hi"},
+		{"muted no_muted device", muted, &dataobject.DevicesDO{NoMuted: true}, true, false, ""},
+		{"no previews", noPreviews, &dataobject.DevicesDO{}, false, false, hiddenPreviewBody},
+		{"locked device", nil, &dataobject.DevicesDO{LockedPeriod: 60}, false, false, hiddenPreviewBody},
+	}
+
+	for _, tt := range tests {
+		got := makeDeviceNotification(n, tt.settings, tt.device, now)
+		if tt.wantNil {
+			if got != nil {
+				t.Errorf("%s: want nil, got %#v", tt.name, got)
+			}
+			continue
+		}
+		if got == nil || got.Silent != tt.wantSilent || got.Body != tt.wantBody {
+			t.Errorf("%s: unexpected notification %#v", tt.name, got)
+		}
+	}
+
+	if n.notification.Body != "hi" {
+		t.Errorf("makeDeviceNotification modified the source notification")
+	}
+}
@@ -0,0 +1 @@
+./dalgen.sh devices
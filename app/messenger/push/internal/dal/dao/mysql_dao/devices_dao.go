@@ -0,0 +1,317 @@
+/*
+ * WARNING! All changes made in this file will be lost!
+ *   Created from by 'dalgen'
+ *
+ * Copyright (c) 2022-present,  Teamgram Authors.
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package mysql_dao
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/teamgram/marmota/pkg/stores/sqlx"
+	"github.com/teamgram/teamgram-server/app/messenger/push/internal/dal/dataobject"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+var _ *sql.Result
+
+type DevicesDAO struct {
+	db *sqlx.DB
+}
+
+func NewDevicesDAO(db *sqlx.DB) *DevicesDAO {
+	return &DevicesDAO{db}
+}
+
+// InsertOrUpdate
+// insert into devices(auth_key_id, user_id, token_type, token, no_muted, app_sandbox, secret, other_uids) values (:auth_key_id, :user_id, :token_type, :token, :no_muted, :app_sandbox, :secret, :other_uids) on duplicate key update token = values(token), no_muted = values(no_muted), app_sandbox = values(app_sandbox), secret = values(secret), other_uids = values(other_uids), state = 0
+// TODO(@benqi): sqlmap
+func (dao *DevicesDAO) InsertOrUpdate(ctx context.Context, do *dataobject.DevicesDO) (lastInsertId, rowsAffected int64, err error) {
+	var (
+		query = "insert into devices(auth_key_id, user_id, token_type, token, no_muted, app_sandbox, secret, other_uids) values (:auth_key_id, :user_id, :token_type, :token, :no_muted, :app_sandbox, :secret, :other_uids) on duplicate key update token = values(token), no_muted = values(no_muted), app_sandbox = values(app_sandbox), secret = values(secret), other_uids = values(other_uids), state = 0"
+		r     sql.Result
+	)
+
+	r, err = dao.db.NamedExec(ctx, query, do)
+	if err != nil {
+		logx.WithContext(ctx).Errorf("namedExec in InsertOrUpdate(%v), error: %v", do, err)
+		return
+	}
+
+	lastInsertId, err = r.LastInsertId()
+	if err != nil {
+		logx.WithContext(ctx).Errorf("lastInsertId in InsertOrUpdate(%v)_error: %v", do, err)
+		return
+	}
+	rowsAffected, err = r.RowsAffected()
+	if err != nil {
+		logx.WithContext(ctx).Errorf("rowsAffected in InsertOrUpdate(%v)_error: %v", do, err)
+	}
+
+	return
+}
+
+// InsertOrUpdateTx
+// insert into devices(auth_key_id, user_id, token_type, token, no_muted, app_sandbox, secret, other_uids) values (:auth_key_id, :user_id, :token_type, :token, :no_muted, :app_sandbox, :secret, :other_uids) on duplicate key update token = values(token), no_muted = values(no_muted), app_sandbox = values(app_sandbox), secret = values(secret), other_uids = values(other_uids), state = 0
+// TODO(@benqi): sqlmap
+func (dao *DevicesDAO) InsertOrUpdateTx(tx *sqlx.Tx, do *dataobject.DevicesDO) (lastInsertId, rowsAffected int64, err error) {
+	var (
+		query = "insert into devices(auth_key_id, user_id, token_type, token, no_muted, app_sandbox, secret, other_uids) values (:auth_key_id, :user_id, :token_type, :token, :no_muted, :app_sandbox, :secret, :other_uids) on duplicate key update token = values(token), no_muted = values(no_muted), app_sandbox = values(app_sandbox), secret = values(secret), other_uids = values(other_uids), state = 0"
+		r     sql.Result
+	)
+
+	r, err = tx.NamedExec(query, do)
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("namedExec in InsertOrUpdate(%v), error: %v", do, err)
+		return
+	}
+
+	lastInsertId, err = r.LastInsertId()
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("lastInsertId in InsertOrUpdate(%v)_error: %v", do, err)
+		return
+	}
+	rowsAffected, err = r.RowsAffected()
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("rowsAffected in InsertOrUpdate(%v)_error: %v", do, err)
+	}
+
+	return
+}
+
+// SelectListByUserId
+// select id, auth_key_id, user_id, token_type, token, no_muted, locked_period, app_sandbox, secret, other_uids, state from devices where user_id = :user_id and state = 0
+// TODO(@benqi): sqlmap
+func (dao *DevicesDAO) SelectListByUserId(ctx context.Context, user_id int64) (rList []dataobject.DevicesDO, err error) {
+	var (
+		query  = "select id, auth_key_id, user_id, token_type, token, no_muted, locked_period, app_sandbox, secret, other_uids, state from devices where user_id = ? and state = 0"
+		values []dataobject.DevicesDO
+	)
+	err = dao.db.QueryRowsPartial(ctx, &values, query, user_id)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("queryx in SelectListByUserId(_), error: %v", err)
+		return
+	}
+
+	rList = values
+
+	return
+}
+
+// SelectListByUserIdWithCB
+// select id, auth_key_id, user_id, token_type, token, no_muted, locked_period, app_sandbox, secret, other_uids, state from devices where user_id = :user_id and state = 0
+// TODO(@benqi): sqlmap
+func (dao *DevicesDAO) SelectListByUserIdWithCB(ctx context.Context, user_id int64, cb func(i int, v *dataobject.DevicesDO)) (rList []dataobject.DevicesDO, err error) {
+	var (
+		query  = "select id, auth_key_id, user_id, token_type, token, no_muted, locked_period, app_sandbox, secret, other_uids, state from devices where user_id = ? and state = 0"
+		values []dataobject.DevicesDO
+	)
+	err = dao.db.QueryRowsPartial(ctx, &values, query, user_id)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("queryx in SelectListByUserId(_), error: %v", err)
+		return
+	}
+
+	rList = values
+
+	if cb != nil {
+		for i := 0; i < len(rList); i++ {
+			cb(i, &rList[i])
+		}
+	}
+
+	return
+}
+
+// UpdateState
+// update devices set state = :state where auth_key_id = :auth_key_id and user_id = :user_id and token_type = :token_type and token = :token
+// TODO(@benqi): sqlmap
+func (dao *DevicesDAO) UpdateState(ctx context.Context, state bool, auth_key_id int64, user_id int64, token_type int32, token string) (rowsAffected int64, err error) {
+	var (
+		query   = "update devices set state = ? where auth_key_id = ? and user_id = ? and token_type = ? and token = ?"
+		rResult sql.Result
+	)
+	rResult, err = dao.db.Exec(ctx, query, state, auth_key_id, user_id, token_type, token)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("exec in UpdateState(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(ctx).Errorf("rowsAffected in UpdateState(_), error: %v", err)
+	}
+
+	return
+}
+
+// update devices set state = :state where auth_key_id = :auth_key_id and user_id = :user_id and token_type = :token_type and token = :token
+// UpdateStateTx
+// TODO(@benqi): sqlmap
+func (dao *DevicesDAO) UpdateStateTx(tx *sqlx.Tx, state bool, auth_key_id int64, user_id int64, token_type int32, token string) (rowsAffected int64, err error) {
+	var (
+		query   = "update devices set state = ? where auth_key_id = ? and user_id = ? and token_type = ? and token = ?"
+		rResult sql.Result
+	)
+	rResult, err = tx.Exec(query, state, auth_key_id, user_id, token_type, token)
+
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("exec in UpdateState(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("rowsAffected in UpdateState(_), error: %v", err)
+	}
+
+	return
+}
+
+// UpdateStateById
+// update devices set state = :state where id = :id
+// TODO(@benqi): sqlmap
+func (dao *DevicesDAO) UpdateStateById(ctx context.Context, state bool, id int64) (rowsAffected int64, err error) {
+	var (
+		query   = "update devices set state = ? where id = ?"
+		rResult sql.Result
+	)
+	rResult, err = dao.db.Exec(ctx, query, state, id)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("exec in UpdateStateById(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(ctx).Errorf("rowsAffected in UpdateStateById(_), error: %v", err)
+	}
+
+	return
+}
+
+// update devices set state = :state where id = :id
+// UpdateStateByIdTx
+// TODO(@benqi): sqlmap
+func (dao *DevicesDAO) UpdateStateByIdTx(tx *sqlx.Tx, state bool, id int64) (rowsAffected int64, err error) {
+	var (
+		query   = "update devices set state = ? where id = ?"
+		rResult sql.Result
+	)
+	rResult, err = tx.Exec(query, state, id)
+
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("exec in UpdateStateById(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("rowsAffected in UpdateStateById(_), error: %v", err)
+	}
+
+	return
+}
+
+// UpdateStateByOtherAuthKey
+// update devices set state = :state where user_id = :user_id and token_type = :token_type and token = :token and auth_key_id <> :auth_key_id
+// TODO(@benqi): sqlmap
+func (dao *DevicesDAO) UpdateStateByOtherAuthKey(ctx context.Context, state bool, user_id int64, token_type int32, token string, auth_key_id int64) (rowsAffected int64, err error) {
+	var (
+		query   = "update devices set state = ? where user_id = ? and token_type = ? and token = ? and auth_key_id <> ?"
+		rResult sql.Result
+	)
+	rResult, err = dao.db.Exec(ctx, query, state, user_id, token_type, token, auth_key_id)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("exec in UpdateStateByOtherAuthKey(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(ctx).Errorf("rowsAffected in UpdateStateByOtherAuthKey(_), error: %v", err)
+	}
+
+	return
+}
+
+// update devices set state = :state where user_id = :user_id and token_type = :token_type and token = :token and auth_key_id <> :auth_key_id
+// UpdateStateByOtherAuthKeyTx
+// TODO(@benqi): sqlmap
+func (dao *DevicesDAO) UpdateStateByOtherAuthKeyTx(tx *sqlx.Tx, state bool, user_id int64, token_type int32, token string, auth_key_id int64) (rowsAffected int64, err error) {
+	var (
+		query   = "update devices set state = ? where user_id = ? and token_type = ? and token = ? and auth_key_id <> ?"
+		rResult sql.Result
+	)
+	rResult, err = tx.Exec(query, state, user_id, token_type, token, auth_key_id)
+
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("exec in UpdateStateByOtherAuthKey(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("rowsAffected in UpdateStateByOtherAuthKey(_), error: %v", err)
+	}
+
+	return
+}
+
+// UpdateLockedPeriod
+// update devices set locked_period = :locked_period where auth_key_id = :auth_key_id and user_id = :user_id
+// TODO(@benqi): sqlmap
+func (dao *DevicesDAO) UpdateLockedPeriod(ctx context.Context, locked_period int32, auth_key_id int64, user_id int64) (rowsAffected int64, err error) {
+	var (
+		query   = "update devices set locked_period = ? where auth_key_id = ? and user_id = ?"
+		rResult sql.Result
+	)
+	rResult, err = dao.db.Exec(ctx, query, locked_period, auth_key_id, user_id)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("exec in UpdateLockedPeriod(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(ctx).Errorf("rowsAffected in UpdateLockedPeriod(_), error: %v", err)
+	}
+
+	return
+}
+
+// update devices set locked_period = :locked_period where auth_key_id = :auth_key_id and user_id = :user_id
+// UpdateLockedPeriodTx
+// TODO(@benqi): sqlmap
+func (dao *DevicesDAO) UpdateLockedPeriodTx(tx *sqlx.Tx, locked_period int32, auth_key_id int64, user_id int64) (rowsAffected int64, err error) {
+	var (
+		query   = "update devices set locked_period = ? where auth_key_id = ? and user_id = ?"
+		rResult sql.Result
+	)
+	rResult, err = tx.Exec(query, locked_period, auth_key_id, user_id)
+
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("exec in UpdateLockedPeriod(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("rowsAffected in UpdateLockedPeriod(_), error: %v", err)
+	}
+
+	return
+}
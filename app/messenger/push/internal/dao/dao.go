@@ -0,0 +1,66 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package dao
+
+import (
+	"github.com/teamgram/marmota/pkg/net/rpcx"
+	"github.com/teamgram/marmota/pkg/stores/sqlx"
+	"github.com/teamgram/teamgram-server/app/messenger/push/internal/config"
+	"github.com/teamgram/teamgram-server/app/messenger/push/internal/sender"
+	user_client "github.com/teamgram/teamgram-server/app/service/biz/user/client"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type Dao struct {
+	*Mysql
+	user_client.UserClient
+	Senders *sender.Senders
+}
+
+func New(c config.Config) *Dao {
+	return &Dao{
+		Mysql:      newMysqlDao(sqlx.NewMySQL(&c.Mysql)),
+		UserClient: user_client.NewUserClient(rpcx.GetCachedRpcClient(c.BizServiceClient)),
+		Senders:    newSenders(c),
+	}
+}
+
+func newSenders(c config.Config) *sender.Senders {
+	senders := sender.NewSenders()
+
+	if c.MockSender {
+		senders.Register(sender.NewMockSender())
+		return senders
+	}
+
+	if c.Apns != nil {
+		apns, err := sender.NewApnsSender(*c.Apns)
+		logx.Must(err)
+		senders.Register(apns, sender.TokenTypeAPNS, sender.TokenTypeAPNSVoIP)
+	}
+	if c.Fcm != nil {
+		senders.Register(sender.NewFcmSender(*c.Fcm), sender.TokenTypeFCM)
+	}
+	if c.Webhook != nil {
+		senders.Register(sender.NewWebhookSender(*c.Webhook))
+	}
+
+	return senders
+}
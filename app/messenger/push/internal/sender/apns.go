@@ -0,0 +1,230 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package sender
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const (
+	apnsProductionHost = "https://api.push.apple.com"
+	apnsSandboxHost    = "https://api.sandbox.push.apple.com"
+
+	// apple rejects the provider tokens older than one hour
+	apnsTokenTTL = 50 * time.Minute
+)
+
+type ApnsConf struct {
+	KeyFile string // .p8 auth key
+	KeyId   string
+	TeamId  string
+	Topic   string        // bundle id
+	Timeout time.Duration `json:",default=10s"`
+}
+
+// ApnsSender sends the notifications by the APNs http/2 provider api with token based authentication.
+type ApnsSender struct {
+	c   ApnsConf
+	key *ecdsa.PrivateKey
+	cli *http.Client
+
+	mu       sync.Mutex
+	token    string
+	issuedAt time.Time
+}
+
+type apnsAlert struct {
+	Title string `json:"title,omitempty"`
+	Body  string `json:"body,omitempty"`
+}
+
+type apnsAps struct {
+	Alert            *apnsAlert `json:"alert,omitempty"`
+	Sound            string     `json:"sound,omitempty"`
+	ContentAvailable int        `json:"content-available,omitempty"`
+}
+
+type apnsReply struct {
+	Reason string `json:"reason"`
+}
+
+func NewApnsSender(c ApnsConf) (*ApnsSender, error) {
+	data, err := ioutil.ReadFile(c.KeyFile)
+	if err != nil {
+		return nil, err
+	}
+
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("apns: invalid auth key file")
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	ecKey, ok := key.(*ecdsa.PrivateKey)
+	if !ok {
+		return nil, errors.New("apns: auth key must be an ecdsa key")
+	}
+
+	return &ApnsSender{
+		c:   c,
+		key: ecKey,
+		cli: &http.Client{
+			Timeout: c.Timeout,
+			Transport: &http.Transport{
+				ForceAttemptHTTP2: true,
+			},
+		},
+	}, nil
+}
+
+func (m *ApnsSender) Name() string {
+	return "apns"
+}
+
+func (m *ApnsSender) Send(ctx context.Context, device *Device, n *Notification) error {
+	var (
+		aps      = &apnsAps{}
+		topic    = m.c.Topic
+		pushType = "alert"
+		priority = "10"
+	)
+
+	switch {
+	case device.TokenType == TokenTypeAPNSVoIP:
+		topic += ".voip"
+		pushType = "voip"
+	case n.Title == "" && n.Body == "":
+		aps.ContentAvailable = 1
+		pushType = "background"
+		priority = "5"
+	default:
+		aps.Alert = &apnsAlert{
+			Title: n.Title,
+			Body:  n.Body,
+		}
+		if !n.Silent {
+			aps.Sound = "default"
+		}
+	}
+
+	payload := map[string]interface{}{
+		"aps": aps,
+	}
+	for k, v := range n.Data {
+		payload[k] = v
+	}
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	host := apnsProductionHost
+	if device.AppSandbox {
+		host = apnsSandboxHost
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, host+"/3/device/"+device.Token, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+
+	token, err := m.providerToken()
+	if err != nil {
+		return err
+	}
+	req.Header.Set("authorization", "bearer "+token)
+	req.Header.Set("apns-topic", topic)
+	req.Header.Set("apns-push-type", pushType)
+	req.Header.Set("apns-priority", priority)
+
+	resp, err := m.cli.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusOK {
+		return nil
+	}
+
+	reply := &apnsReply{}
+	json.NewDecoder(resp.Body).Decode(reply)
+	switch {
+	case resp.StatusCode == http.StatusGone,
+		reply.Reason == "BadDeviceToken",
+		reply.Reason == "Unregistered",
+		reply.Reason == "DeviceTokenNotForTopic":
+		return ErrInvalidToken
+	default:
+		return fmt.Errorf("apns: status %d, reason %s", resp.StatusCode, reply.Reason)
+	}
+}
+
+// providerToken returns the cached ES256 jwt, it's refreshed before apple expires it.
+func (m *ApnsSender) providerToken() (string, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := time.Now()
+	if m.token != "" && now.Sub(m.issuedAt) < apnsTokenTTL {
+		return m.token, nil
+	}
+
+	header, _ := json.Marshal(map[string]string{
+		"alg": "ES256",
+		"kid": m.c.KeyId,
+	})
+	claims, _ := json.Marshal(map[string]interface{}{
+		"iss": m.c.TeamId,
+		"iat": now.Unix(),
+	})
+	unsigned := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(claims)
+
+	digest := sha256.Sum256([]byte(unsigned))
+	r, s, err := ecdsa.Sign(rand.Reader, m.key, digest[:])
+	if err != nil {
+		return "", err
+	}
+
+	// jws signature is r || s, each padded to the curve size
+	size := (m.key.Curve.Params().BitSize + 7) / 8
+	sig := make([]byte, 2*size)
+	r.FillBytes(sig[:size])
+	s.FillBytes(sig[size:])
+
+	m.token = unsigned + "." + base64.RawURLEncoding.EncodeToString(sig)
+	m.issuedAt = now
+
+	return m.token, nil
+}
@@ -0,0 +1,143 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package sender
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+const (
+	fcmEndpoint = "https://fcm.googleapis.com/fcm/send"
+)
+
+type FcmConf struct {
+	ServerKey string
+	Endpoint  string        `json:",optional"`
+	Timeout   time.Duration `json:",default=10s"`
+}
+
+// FcmSender sends the notifications by the FCM http api.
+type FcmSender struct {
+	endpoint  string
+	serverKey string
+	cli       *http.Client
+}
+
+type fcmNotification struct {
+	Title string `json:"title,omitempty"`
+	Body  string `json:"body,omitempty"`
+	Sound string `json:"sound,omitempty"`
+}
+
+type fcmRequest struct {
+	To           string            `json:"to"`
+	Priority     string            `json:"priority"`
+	Notification *fcmNotification  `json:"notification,omitempty"`
+	Data         map[string]string `json:"data,omitempty"`
+}
+
+type fcmReply struct {
+	Failure int `json:"failure"`
+	Results []struct {
+		Error string `json:"error"`
+	} `json:"results"`
+}
+
+func NewFcmSender(c FcmConf) *FcmSender {
+	endpoint := c.Endpoint
+	if endpoint == "" {
+		endpoint = fcmEndpoint
+	}
+
+	return &FcmSender{
+		endpoint:  endpoint,
+		serverKey: c.ServerKey,
+		cli: &http.Client{
+			Timeout: c.Timeout,
+			Transport: &http.Transport{
+				ForceAttemptHTTP2: true,
+			},
+		},
+	}
+}
+
+func (m *FcmSender) Name() string {
+	return "fcm"
+}
+
+func (m *FcmSender) Send(ctx context.Context, device *Device, n *Notification) error {
+	r := &fcmRequest{
+		To:       device.Token,
+		Priority: "high",
+		Data:     n.Data,
+	}
+	if n.Title != "" || n.Body != "" {
+		r.Notification = &fcmNotification{
+			Title: n.Title,
+			Body:  n.Body,
+		}
+		if !n.Silent {
+			r.Notification.Sound = "default"
+		}
+	} else {
+		r.Priority = "normal"
+	}
+
+	body, err := json.Marshal(r)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, m.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "key="+m.serverKey)
+
+	resp, err := m.cli.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("fcm: unexpected status %d", resp.StatusCode)
+	}
+
+	reply := &fcmReply{}
+	if err = json.NewDecoder(resp.Body).Decode(reply); err != nil {
+		return err
+	}
+	if reply.Failure == 0 || len(reply.Results) == 0 {
+		return nil
+	}
+
+	switch reply.Results[0].Error {
+	case "NotRegistered", "InvalidRegistration", "MismatchSenderId":
+		return ErrInvalidToken
+	default:
+		return fmt.Errorf("fcm: %s", reply.Results[0].Error)
+	}
+}
@@ -0,0 +1,74 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package sender
+
+import (
+	"context"
+	"sync"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+const (
+	mockMaxSent = 1024
+)
+
+// MockSender logs the notifications and keeps the last ones in memory, for local testing.
+type MockSender struct {
+	mu   sync.Mutex
+	sent []MockSent
+}
+
+type MockSent struct {
+	Device       Device
+	Notification Notification
+}
+
+func NewMockSender() *MockSender {
+	return &MockSender{}
+}
+
+func (m *MockSender) Name() string {
+	return "mock"
+}
+
+func (m *MockSender) Send(ctx context.Context, device *Device, n *Notification) error {
+	logx.WithContext(ctx).Infof("mock push - device: %#v, notification: %#v", device, n)
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if len(m.sent) >= mockMaxSent {
+		m.sent = m.sent[1:]
+	}
+	m.sent = append(m.sent, MockSent{
+		Device:       *device,
+		Notification: *n,
+	})
+
+	return nil
+}
+
+// Sent returns a copy of the notifications sent so far.
+func (m *MockSender) Sent() []MockSent {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return append([]MockSent(nil), m.sent...)
+}
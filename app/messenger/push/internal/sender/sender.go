@@ -0,0 +1,105 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package sender
+
+import (
+	"context"
+	"errors"
+)
+
+// token_type of account.registerDevice
+const (
+	TokenTypeAPNS       = 1
+	TokenTypeFCM        = 2
+	TokenTypeMPNS       = 3
+	TokenTypeSimplePush = 4
+	TokenTypeUbuntu     = 5
+	TokenTypeBlackberry = 6
+	TokenTypeMTProto    = 7
+	TokenTypeWNS        = 8
+	TokenTypeAPNSVoIP   = 9
+	TokenTypeWebPush    = 10
+	TokenTypeMPNSVoIP   = 11
+	TokenTypeTizen      = 12
+)
+
+var (
+	// ErrInvalidToken the token is rejected by the push provider, the device should be unregistered.
+	ErrInvalidToken = errors.New("push: invalid device token")
+	// ErrNoSender no sender is configured for the token type.
+	ErrNoSender = errors.New("push: no sender for token type")
+)
+
+// Device is the push target.
+type Device struct {
+	TokenType  int32  `json:"token_type"`
+	Token      string `json:"token"`
+	AppSandbox bool   `json:"app_sandbox"`
+}
+
+// Notification is the provider independent payload.
+type Notification struct {
+	UserId int64             `json:"user_id"`
+	Title  string            `json:"title"`
+	Body   string            `json:"body"`
+	Silent bool              `json:"silent"`
+	Data   map[string]string `json:"data,omitempty"`
+}
+
+// Sender delivers a notification to one device.
+type Sender interface {
+	Name() string
+	Send(ctx context.Context, device *Device, n *Notification) error
+}
+
+// Senders routes a device to the sender registered for its token type.
+type Senders struct {
+	senders  map[int32]Sender
+	fallback Sender
+}
+
+func NewSenders() *Senders {
+	return &Senders{
+		senders: make(map[int32]Sender),
+	}
+}
+
+// Register binds s to tokenTypes, without tokenTypes s is used for all the unbound token types.
+func (m *Senders) Register(s Sender, tokenTypes ...int32) {
+	if len(tokenTypes) == 0 {
+		m.fallback = s
+		return
+	}
+
+	for _, tokenType := range tokenTypes {
+		m.senders[tokenType] = s
+	}
+}
+
+func (m *Senders) Send(ctx context.Context, device *Device, n *Notification) error {
+	s, ok := m.senders[device.TokenType]
+	if !ok {
+		s = m.fallback
+	}
+	if s == nil {
+		return ErrNoSender
+	}
+
+	return s.Send(ctx, device, n)
+}
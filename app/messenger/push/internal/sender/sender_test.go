@@ -0,0 +1,65 @@
+// Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+//  All rights reserved.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package sender
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestSendersRoute(t *testing.T) {
+	var (
+		apns  = NewMockSender()
+		other = NewMockSender()
+		s     = NewSenders()
+		n     = &Notification{UserId: 1, Body: "hi"}
+	)
+
+	if err := s.Send(context.Background(), &Device{TokenType: TokenTypeAPNS}, n); err != ErrNoSender {
+		t.Fatalf("empty senders: want ErrNoSender, got %v", err)
+	}
+
+	s.Register(apns, TokenTypeAPNS, TokenTypeAPNSVoIP)
+	s.Register(other)
+
+	s.Send(context.Background(), &Device{TokenType: TokenTypeAPNSVoIP}, n)
+	s.Send(context.Background(), &Device{TokenType: TokenTypeWebPush}, n)
+
+	if len(apns.Sent()) != 1 || len(other.Sent()) != 1 {
+		t.Fatalf("unexpected routing: apns %d, other %d", len(apns.Sent()), len(other.Sent()))
+	}
+}
+
+func TestWebhookSender(t *testing.T) {
+	var got webhookRequest
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewDecoder(r.Body).Decode(&got)
+		if got.Device.Token == "gone" {
+			w.WriteHeader(http.StatusGone)
+		}
+	}))
+	defer srv.Close()
+
+	s := NewWebhookSender(WebhookConf{Url: srv.URL, Timeout: time.Second})
+
+	err := s.Send(context.Background(), &Device{TokenType: TokenTypeFCM, Token: "t1"}, &Notification{UserId: 1, Body: "hi"})
+	if err != nil {
+		t.Fatalf("send: %v", err)
+	}
+	if got.Device.Token != "t1" || got.Notification.Body != "hi" {
+		t.Fatalf("unexpected request %#v", got)
+	}
+
+	err = s.Send(context.Background(), &Device{TokenType: TokenTypeFCM, Token: "gone"}, &Notification{UserId: 1})
+	if err != ErrInvalidToken {
+		t.Fatalf("gone: want ErrInvalidToken, got %v", err)
+	}
+}
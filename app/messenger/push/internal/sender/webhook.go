@@ -0,0 +1,92 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package sender
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"time"
+)
+
+type WebhookConf struct {
+	Url     string
+	Timeout time.Duration `json:",default=5s"`
+}
+
+// WebhookSender posts the notifications as json to an http endpoint,
+// a 410 Gone reply means the token is invalid.
+type WebhookSender struct {
+	url string
+	cli *http.Client
+}
+
+type webhookRequest struct {
+	Device       *Device       `json:"device"`
+	Notification *Notification `json:"notification"`
+}
+
+func NewWebhookSender(c WebhookConf) *WebhookSender {
+	return &WebhookSender{
+		url: c.Url,
+		cli: &http.Client{
+			Timeout: c.Timeout,
+		},
+	}
+}
+
+func (m *WebhookSender) Name() string {
+	return "webhook"
+}
+
+func (m *WebhookSender) Send(ctx context.Context, device *Device, n *Notification) error {
+	body, err := json.Marshal(&webhookRequest{
+		Device:       device,
+		Notification: n,
+	})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, m.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := m.cli.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	io.Copy(ioutil.Discard, resp.Body)
+
+	switch {
+	case resp.StatusCode == http.StatusGone:
+		return ErrInvalidToken
+	case resp.StatusCode/100 != 2:
+		return fmt.Errorf("webhook: unexpected status %d", resp.StatusCode)
+	}
+
+	return nil
+}
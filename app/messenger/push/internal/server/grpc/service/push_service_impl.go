@@ -0,0 +1,79 @@
+/*
+ * WARNING! All changes made in this file will be lost!
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package service
+
+import (
+	"context"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/messenger/push/internal/core"
+	"github.com/teamgram/teamgram-server/app/messenger/push/push"
+)
+
+// PushRegisterDevice
+// push.registerDevice flags:# user_id:long auth_key_id:long no_muted:flags.0?true token_type:int token:string app_sandbox:Bool secret:bytes other_uids:Vector<long> = Bool;
+func (s *Service) PushRegisterDevice(ctx context.Context, request *push.TLPushRegisterDevice) (*mtproto.Bool, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Infof("push.registerDevice - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.PushRegisterDevice(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Infof("push.registerDevice - reply: %s", r.DebugString())
+	return r, err
+}
+
+// PushUnregisterDevice
+// push.unregisterDevice user_id:long auth_key_id:long token_type:int token:string other_uids:Vector<long> = Bool;
+func (s *Service) PushUnregisterDevice(ctx context.Context, request *push.TLPushUnregisterDevice) (*mtproto.Bool, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Infof("push.unregisterDevice - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.PushUnregisterDevice(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Infof("push.unregisterDevice - reply: %s", r.DebugString())
+	return r, err
+}
+
+// PushUpdateDeviceLocked
+// push.updateDeviceLocked user_id:long auth_key_id:long period:int = Bool;
+func (s *Service) PushUpdateDeviceLocked(ctx context.Context, request *push.TLPushUpdateDeviceLocked) (*mtproto.Bool, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Infof("push.updateDeviceLocked - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.PushUpdateDeviceLocked(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Infof("push.updateDeviceLocked - reply: %s", r.DebugString())
+	return r, err
+}
+
+// PushPushUpdatesIfNot
+// push.pushUpdatesIfNot user_id:long excludes:Vector<long> updates:Updates = Void;
+func (s *Service) PushPushUpdatesIfNot(ctx context.Context, request *push.TLPushPushUpdatesIfNot) (*mtproto.Void, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Infof("push.pushUpdatesIfNot - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.PushPushUpdatesIfNot(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Infof("push.pushUpdatesIfNot - reply: %s", r.DebugString())
+	return r, err
+}
@@ -0,0 +1,54 @@
+// Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+//  All rights reserved.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package mq
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	kafka "github.com/teamgram/marmota/pkg/mq"
+	"github.com/teamgram/teamgram-server/app/messenger/push/internal/core"
+	"github.com/teamgram/teamgram-server/app/messenger/push/internal/svc"
+	"github.com/teamgram/teamgram-server/app/messenger/push/push"
+	"github.com/teamgram/teamgram-server/app/messenger/sync/sync"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// New new a mq consumer, sync produces sync.pushUpdatesIfNot for the users without online sessions.
+func New(svcCtx *svc.ServiceContext, conf kafka.KafkaConsumerConf) *kafka.ConsumerGroup {
+	s := kafka.MustKafkaConsumer(&conf)
+	s.RegisterHandlers(
+		conf.Topics[0],
+		func(ctx context.Context, key string, value []byte) {
+			logx.WithContext(ctx).Infof("key: %s, value: %s", key, value)
+
+			switch key {
+			case proto.MessageName((*sync.TLSyncPushUpdatesIfNot)(nil)):
+				c := core.New(ctx, svcCtx)
+
+				r := new(sync.TLSyncPushUpdatesIfNot)
+				if err := json.Unmarshal(value, r); err != nil {
+					c.Logger.Error(err.Error())
+					return
+				}
+				c.Logger.Infof("sync.pushUpdatesIfNot - request: %s", r.DebugString())
+
+				c.PushPushUpdatesIfNot(&push.TLPushPushUpdatesIfNot{
+					UserId:   r.UserId,
+					Excludes: r.Excludes,
+					Updates:  r.Updates,
+				})
+			default:
+				err := fmt.Errorf("invalid key: %s", key)
+				logx.Error(err.Error())
+			}
+		})
+	return s
+}
@@ -0,0 +1,61 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package server
+
+import (
+	"flag"
+
+	kafka "github.com/teamgram/marmota/pkg/mq"
+	"github.com/teamgram/teamgram-server/app/messenger/push/internal/config"
+	"github.com/teamgram/teamgram-server/app/messenger/push/internal/server/grpc"
+	"github.com/teamgram/teamgram-server/app/messenger/push/internal/server/mq"
+	"github.com/teamgram/teamgram-server/app/messenger/push/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/conf"
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/zrpc"
+)
+
+var configFile = flag.String("f", "etc/push.yaml", "the config file")
+
+type Server struct {
+	grpcSrv *zrpc.RpcServer
+	mq      *kafka.ConsumerGroup
+}
+
+func New() *Server {
+	return new(Server)
+}
+
+func (s *Server) Initialize() error {
+	var c config.Config
+	conf.MustLoad(*configFile, &c)
+
+	logx.Infov(c)
+	ctx := svc.NewServiceContext(c)
+	s.grpcSrv = grpc.New(ctx, c.RpcServerConf)
+
+	s.mq = mq.New(ctx, c.PushConsumer)
+
+	go func() {
+		s.grpcSrv.Start()
+	}()
+	go s.mq.Start()
+
+	return nil
+}
+
+func (s *Server) RunLoop() {
+}
+
+func (s *Server) Destroy() {
+	s.grpcSrv.Stop()
+	s.mq.Stop()
+}
@@ -0,0 +1,59 @@
+/*
+ * WARNING! All changes made in this file will be lost!
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2022-present,  Teamgram Authors.
+ *  All rights reserved.
+ *
+ * Author: teagramio (teagram.io@gmail.com)
+ */
+
+package push
+
+const (
+	Predicate_push_registerDevice     = "push_registerDevice"
+	Predicate_push_unregisterDevice   = "push_unregisterDevice"
+	Predicate_push_updateDeviceLocked = "push_updateDeviceLocked"
+	Predicate_push_pushUpdatesIfNot   = "push_pushUpdatesIfNot"
+)
+
+var clazzNameRegisters2 = map[string]map[int]int32{
+	Predicate_push_registerDevice: {
+		0: 1075410680, // 0x401976f8
+
+	},
+	Predicate_push_unregisterDevice: {
+		0: 1593384875, // 0x5ef91fab
+
+	},
+	Predicate_push_updateDeviceLocked: {
+		0: 1227476858, // 0x4929cf7a
+
+	},
+	Predicate_push_pushUpdatesIfNot: {
+		0: -1700316472, // 0x9aa73ac8
+
+	},
+}
+
+var clazzIdNameRegisters2 = map[int32]string{
+	1075410680:  Predicate_push_registerDevice,     // 0x401976f8
+	1593384875:  Predicate_push_unregisterDevice,   // 0x5ef91fab
+	1227476858:  Predicate_push_updateDeviceLocked, // 0x4929cf7a
+	-1700316472: Predicate_push_pushUpdatesIfNot,   // 0x9aa73ac8
+
+}
+
+func GetClazzID(clazzName string, layer int) int32 {
+	if m, ok := clazzNameRegisters2[clazzName]; ok {
+		m2, ok2 := m[layer]
+		if ok2 {
+			return m2
+		}
+		m2, ok2 = m[0]
+		if ok2 {
+			return m2
+		}
+	}
+	return 0
+}
@@ -0,0 +1,327 @@
+/*
+ * WARNING! All changes made in this file will be lost!
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2022-present,  Teamgram Authors.
+ *  All rights reserved.
+ *
+ * Author: teagramio (teagram.io@gmail.com)
+ */
+
+// ConstructorList
+// RequestList
+
+package push
+
+import (
+	"fmt"
+
+	"github.com/teamgram/proto/mtproto"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/types"
+)
+
+//////////////////////////////////////////////////////////////////////////////////////////
+
+var _ *types.Int32Value
+var _ *mtproto.Bool
+var _ fmt.GoStringer
+
+var clazzIdRegisters2 = map[int32]func() mtproto.TLObject{
+	// Constructor
+
+	// Method
+	1075410680: func() mtproto.TLObject { // 0x401976f8
+		return &TLPushRegisterDevice{
+			Constructor: 1075410680,
+		}
+	},
+	1593384875: func() mtproto.TLObject { // 0x5ef91fab
+		return &TLPushUnregisterDevice{
+			Constructor: 1593384875,
+		}
+	},
+	1227476858: func() mtproto.TLObject { // 0x4929cf7a
+		return &TLPushUpdateDeviceLocked{
+			Constructor: 1227476858,
+		}
+	},
+	-1700316472: func() mtproto.TLObject { // 0x9aa73ac8
+		return &TLPushPushUpdatesIfNot{
+			Constructor: -1700316472,
+		}
+	},
+}
+
+func NewTLObjectByClassID(classId int32) mtproto.TLObject {
+	f, ok := clazzIdRegisters2[classId]
+	if !ok {
+		return nil
+	}
+	return f()
+}
+
+func CheckClassID(classId int32) (ok bool) {
+	_, ok = clazzIdRegisters2[classId]
+	return
+}
+
+//----------------------------------------------------------------------------------------------------------------
+
+//----------------------------------------------------------------------------------------------------------------
+// TLPushRegisterDevice
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLPushRegisterDevice) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_push_registerDevice))
+
+	switch uint32(m.Constructor) {
+	case 0x401976f8:
+		// push.registerDevice flags:# user_id:long auth_key_id:long no_muted:flags.0?true token_type:int token:string app_sandbox:Bool secret:bytes other_uids:Vector<long> = Bool;
+		x.UInt(0x401976f8)
+
+		// set flags
+		var flags uint32 = 0
+
+		if m.GetNoMuted() == true {
+			flags |= 1 << 0
+		}
+
+		x.UInt(flags)
+
+		// flags Debug by @benqi
+		x.Long(m.GetUserId())
+		x.Long(m.GetAuthKeyId())
+		x.Int(m.GetTokenType())
+		x.String(m.GetToken())
+		x.Bytes(m.GetAppSandbox().Encode(layer))
+		x.StringBytes(m.GetSecret())
+
+		x.VectorLong(m.GetOtherUids())
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLPushRegisterDevice) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLPushRegisterDevice) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0x401976f8:
+		// push.registerDevice flags:# user_id:long auth_key_id:long no_muted:flags.0?true token_type:int token:string app_sandbox:Bool secret:bytes other_uids:Vector<long> = Bool;
+
+		flags := dBuf.UInt()
+		_ = flags
+
+		// flags Debug by @benqi
+		m.UserId = dBuf.Long()
+		m.AuthKeyId = dBuf.Long()
+		if (flags & (1 << 0)) != 0 {
+			m.NoMuted = true
+		}
+		m.TokenType = dBuf.Int()
+		m.Token = dBuf.String()
+
+		m7 := &mtproto.Bool{}
+		m7.Decode(dBuf)
+		m.AppSandbox = m7
+
+		m.Secret = dBuf.StringBytes()
+
+		m.OtherUids = dBuf.VectorLong()
+
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLPushRegisterDevice) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLPushUnregisterDevice
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLPushUnregisterDevice) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_push_unregisterDevice))
+
+	switch uint32(m.Constructor) {
+	case 0x5ef91fab:
+		// push.unregisterDevice user_id:long auth_key_id:long token_type:int token:string other_uids:Vector<long> = Bool;
+		x.UInt(0x5ef91fab)
+
+		// no flags
+
+		x.Long(m.GetUserId())
+		x.Long(m.GetAuthKeyId())
+		x.Int(m.GetTokenType())
+		x.String(m.GetToken())
+
+		x.VectorLong(m.GetOtherUids())
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLPushUnregisterDevice) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLPushUnregisterDevice) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0x5ef91fab:
+		// push.unregisterDevice user_id:long auth_key_id:long token_type:int token:string other_uids:Vector<long> = Bool;
+
+		// not has flags
+
+		m.UserId = dBuf.Long()
+		m.AuthKeyId = dBuf.Long()
+		m.TokenType = dBuf.Int()
+		m.Token = dBuf.String()
+
+		m.OtherUids = dBuf.VectorLong()
+
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLPushUnregisterDevice) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLPushUpdateDeviceLocked
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLPushUpdateDeviceLocked) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_push_updateDeviceLocked))
+
+	switch uint32(m.Constructor) {
+	case 0x4929cf7a:
+		// push.updateDeviceLocked user_id:long auth_key_id:long period:int = Bool;
+		x.UInt(0x4929cf7a)
+
+		// no flags
+
+		x.Long(m.GetUserId())
+		x.Long(m.GetAuthKeyId())
+		x.Int(m.GetPeriod())
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLPushUpdateDeviceLocked) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLPushUpdateDeviceLocked) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0x4929cf7a:
+		// push.updateDeviceLocked user_id:long auth_key_id:long period:int = Bool;
+
+		// not has flags
+
+		m.UserId = dBuf.Long()
+		m.AuthKeyId = dBuf.Long()
+		m.Period = dBuf.Int()
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLPushUpdateDeviceLocked) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLPushPushUpdatesIfNot
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLPushPushUpdatesIfNot) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_push_pushUpdatesIfNot))
+
+	switch uint32(m.Constructor) {
+	case 0x9aa73ac8:
+		// push.pushUpdatesIfNot user_id:long excludes:Vector<long> updates:Updates = Void;
+		x.UInt(0x9aa73ac8)
+
+		// no flags
+
+		x.Long(m.GetUserId())
+
+		x.VectorLong(m.GetExcludes())
+
+		x.Bytes(m.GetUpdates().Encode(layer))
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLPushPushUpdatesIfNot) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLPushPushUpdatesIfNot) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0x9aa73ac8:
+		// push.pushUpdatesIfNot user_id:long excludes:Vector<long> updates:Updates = Void;
+
+		// not has flags
+
+		m.UserId = dBuf.Long()
+
+		m.Excludes = dBuf.VectorLong()
+
+		m3 := &mtproto.Updates{}
+		m3.Decode(dBuf)
+		m.Updates = m3
+
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLPushPushUpdatesIfNot) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+//----------------------------------------------------------------------------------------------------------------
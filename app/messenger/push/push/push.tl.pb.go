@@ -0,0 +1,2166 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: push.tl.proto
+
+package push
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/types"
+	mtproto "github.com/teamgram/proto/mtproto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	reflect "reflect"
+	strings "strings"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type TLConstructor int32
+
+const (
+	CRC32_UNKNOWN                 TLConstructor = 0
+	CRC32_push_registerDevice     TLConstructor = 1075410680
+	CRC32_push_unregisterDevice   TLConstructor = 1593384875
+	CRC32_push_updateDeviceLocked TLConstructor = 1227476858
+	CRC32_push_pushUpdatesIfNot   TLConstructor = -1700316472
+)
+
+var TLConstructor_name = map[int32]string{
+	0:           "CRC32_UNKNOWN",
+	1075410680:  "CRC32_push_registerDevice",
+	1593384875:  "CRC32_push_unregisterDevice",
+	1227476858:  "CRC32_push_updateDeviceLocked",
+	-1700316472: "CRC32_push_pushUpdatesIfNot",
+}
+
+var TLConstructor_value = map[string]int32{
+	"CRC32_UNKNOWN":                 0,
+	"CRC32_push_registerDevice":     1075410680,
+	"CRC32_push_unregisterDevice":   1593384875,
+	"CRC32_push_updateDeviceLocked": 1227476858,
+	"CRC32_push_pushUpdatesIfNot":   -1700316472,
+}
+
+func (x TLConstructor) String() string {
+	return proto.EnumName(TLConstructor_name, int32(x))
+}
+
+func (TLConstructor) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_43d6f90d12bfdffa, []int{0}
+}
+
+//--------------------------------------------------------------------------------------------
+// push.registerDevice flags:# user_id:long auth_key_id:long no_muted:flags.0?true token_type:int token:string app_sandbox:Bool secret:bytes other_uids:Vector<long> = Bool;
+type TLPushRegisterDevice struct {
+	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=push.TLConstructor" json:"constructor,omitempty"`
+	UserId               int64         `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	AuthKeyId            int64         `protobuf:"varint,4,opt,name=auth_key_id,json=authKeyId,proto3" json:"auth_key_id,omitempty"`
+	NoMuted              bool          `protobuf:"varint,5,opt,name=no_muted,json=noMuted,proto3" json:"no_muted,omitempty"`
+	TokenType            int32         `protobuf:"varint,6,opt,name=token_type,json=tokenType,proto3" json:"token_type,omitempty"`
+	Token                string        `protobuf:"bytes,7,opt,name=token,proto3" json:"token,omitempty"`
+	AppSandbox           *mtproto.Bool `protobuf:"bytes,8,opt,name=app_sandbox,json=appSandbox,proto3" json:"app_sandbox,omitempty"`
+	Secret               []byte        `protobuf:"bytes,9,opt,name=secret,proto3" json:"secret,omitempty"`
+	OtherUids            []int64       `protobuf:"varint,10,rep,packed,name=other_uids,json=otherUids,proto3" json:"other_uids,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *TLPushRegisterDevice) Reset()         { *m = TLPushRegisterDevice{} }
+func (m *TLPushRegisterDevice) String() string { return proto.CompactTextString(m) }
+func (*TLPushRegisterDevice) ProtoMessage()    {}
+func (*TLPushRegisterDevice) Descriptor() ([]byte, []int) {
+	return fileDescriptor_43d6f90d12bfdffa, []int{0}
+}
+func (m *TLPushRegisterDevice) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLPushRegisterDevice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLPushRegisterDevice.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TLPushRegisterDevice) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLPushRegisterDevice.Merge(m, src)
+}
+func (m *TLPushRegisterDevice) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLPushRegisterDevice) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLPushRegisterDevice.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLPushRegisterDevice proto.InternalMessageInfo
+
+func (m *TLPushRegisterDevice) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
+	}
+	return CRC32_UNKNOWN
+}
+
+func (m *TLPushRegisterDevice) GetUserId() int64 {
+	if m != nil {
+		return m.UserId
+	}
+	return 0
+}
+
+func (m *TLPushRegisterDevice) GetAuthKeyId() int64 {
+	if m != nil {
+		return m.AuthKeyId
+	}
+	return 0
+}
+
+func (m *TLPushRegisterDevice) GetNoMuted() bool {
+	if m != nil {
+		return m.NoMuted
+	}
+	return false
+}
+
+func (m *TLPushRegisterDevice) GetTokenType() int32 {
+	if m != nil {
+		return m.TokenType
+	}
+	return 0
+}
+
+func (m *TLPushRegisterDevice) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+func (m *TLPushRegisterDevice) GetAppSandbox() *mtproto.Bool {
+	if m != nil {
+		return m.AppSandbox
+	}
+	return nil
+}
+
+func (m *TLPushRegisterDevice) GetSecret() []byte {
+	if m != nil {
+		return m.Secret
+	}
+	return nil
+}
+
+func (m *TLPushRegisterDevice) GetOtherUids() []int64 {
+	if m != nil {
+		return m.OtherUids
+	}
+	return nil
+}
+
+//--------------------------------------------------------------------------------------------
+// push.unregisterDevice user_id:long auth_key_id:long token_type:int token:string other_uids:Vector<long> = Bool;
+type TLPushUnregisterDevice struct {
+	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=push.TLConstructor" json:"constructor,omitempty"`
+	UserId               int64         `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	AuthKeyId            int64         `protobuf:"varint,4,opt,name=auth_key_id,json=authKeyId,proto3" json:"auth_key_id,omitempty"`
+	TokenType            int32         `protobuf:"varint,5,opt,name=token_type,json=tokenType,proto3" json:"token_type,omitempty"`
+	Token                string        `protobuf:"bytes,6,opt,name=token,proto3" json:"token,omitempty"`
+	OtherUids            []int64       `protobuf:"varint,7,rep,packed,name=other_uids,json=otherUids,proto3" json:"other_uids,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *TLPushUnregisterDevice) Reset()         { *m = TLPushUnregisterDevice{} }
+func (m *TLPushUnregisterDevice) String() string { return proto.CompactTextString(m) }
+func (*TLPushUnregisterDevice) ProtoMessage()    {}
+func (*TLPushUnregisterDevice) Descriptor() ([]byte, []int) {
+	return fileDescriptor_43d6f90d12bfdffa, []int{1}
+}
+func (m *TLPushUnregisterDevice) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLPushUnregisterDevice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLPushUnregisterDevice.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TLPushUnregisterDevice) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLPushUnregisterDevice.Merge(m, src)
+}
+func (m *TLPushUnregisterDevice) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLPushUnregisterDevice) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLPushUnregisterDevice.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLPushUnregisterDevice proto.InternalMessageInfo
+
+func (m *TLPushUnregisterDevice) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
+	}
+	return CRC32_UNKNOWN
+}
+
+func (m *TLPushUnregisterDevice) GetUserId() int64 {
+	if m != nil {
+		return m.UserId
+	}
+	return 0
+}
+
+func (m *TLPushUnregisterDevice) GetAuthKeyId() int64 {
+	if m != nil {
+		return m.AuthKeyId
+	}
+	return 0
+}
+
+func (m *TLPushUnregisterDevice) GetTokenType() int32 {
+	if m != nil {
+		return m.TokenType
+	}
+	return 0
+}
+
+func (m *TLPushUnregisterDevice) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+func (m *TLPushUnregisterDevice) GetOtherUids() []int64 {
+	if m != nil {
+		return m.OtherUids
+	}
+	return nil
+}
+
+//--------------------------------------------------------------------------------------------
+// push.updateDeviceLocked user_id:long auth_key_id:long period:int = Bool;
+type TLPushUpdateDeviceLocked struct {
+	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=push.TLConstructor" json:"constructor,omitempty"`
+	UserId               int64         `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	AuthKeyId            int64         `protobuf:"varint,4,opt,name=auth_key_id,json=authKeyId,proto3" json:"auth_key_id,omitempty"`
+	Period               int32         `protobuf:"varint,5,opt,name=period,proto3" json:"period,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *TLPushUpdateDeviceLocked) Reset()         { *m = TLPushUpdateDeviceLocked{} }
+func (m *TLPushUpdateDeviceLocked) String() string { return proto.CompactTextString(m) }
+func (*TLPushUpdateDeviceLocked) ProtoMessage()    {}
+func (*TLPushUpdateDeviceLocked) Descriptor() ([]byte, []int) {
+	return fileDescriptor_43d6f90d12bfdffa, []int{2}
+}
+func (m *TLPushUpdateDeviceLocked) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLPushUpdateDeviceLocked) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLPushUpdateDeviceLocked.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TLPushUpdateDeviceLocked) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLPushUpdateDeviceLocked.Merge(m, src)
+}
+func (m *TLPushUpdateDeviceLocked) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLPushUpdateDeviceLocked) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLPushUpdateDeviceLocked.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLPushUpdateDeviceLocked proto.InternalMessageInfo
+
+func (m *TLPushUpdateDeviceLocked) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
+	}
+	return CRC32_UNKNOWN
+}
+
+func (m *TLPushUpdateDeviceLocked) GetUserId() int64 {
+	if m != nil {
+		return m.UserId
+	}
+	return 0
+}
+
+func (m *TLPushUpdateDeviceLocked) GetAuthKeyId() int64 {
+	if m != nil {
+		return m.AuthKeyId
+	}
+	return 0
+}
+
+func (m *TLPushUpdateDeviceLocked) GetPeriod() int32 {
+	if m != nil {
+		return m.Period
+	}
+	return 0
+}
+
+//--------------------------------------------------------------------------------------------
+// push.pushUpdatesIfNot user_id:long excludes:Vector<long> updates:Updates = Void;
+type TLPushPushUpdatesIfNot struct {
+	Constructor          TLConstructor    `protobuf:"varint,1,opt,name=constructor,proto3,enum=push.TLConstructor" json:"constructor,omitempty"`
+	UserId               int64            `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Excludes             []int64          `protobuf:"varint,4,rep,packed,name=excludes,proto3" json:"excludes,omitempty"`
+	Updates              *mtproto.Updates `protobuf:"bytes,5,opt,name=updates,proto3" json:"updates,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *TLPushPushUpdatesIfNot) Reset()         { *m = TLPushPushUpdatesIfNot{} }
+func (m *TLPushPushUpdatesIfNot) String() string { return proto.CompactTextString(m) }
+func (*TLPushPushUpdatesIfNot) ProtoMessage()    {}
+func (*TLPushPushUpdatesIfNot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_43d6f90d12bfdffa, []int{3}
+}
+func (m *TLPushPushUpdatesIfNot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLPushPushUpdatesIfNot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLPushPushUpdatesIfNot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TLPushPushUpdatesIfNot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLPushPushUpdatesIfNot.Merge(m, src)
+}
+func (m *TLPushPushUpdatesIfNot) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLPushPushUpdatesIfNot) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLPushPushUpdatesIfNot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLPushPushUpdatesIfNot proto.InternalMessageInfo
+
+func (m *TLPushPushUpdatesIfNot) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
+	}
+	return CRC32_UNKNOWN
+}
+
+func (m *TLPushPushUpdatesIfNot) GetUserId() int64 {
+	if m != nil {
+		return m.UserId
+	}
+	return 0
+}
+
+func (m *TLPushPushUpdatesIfNot) GetExcludes() []int64 {
+	if m != nil {
+		return m.Excludes
+	}
+	return nil
+}
+
+func (m *TLPushPushUpdatesIfNot) GetUpdates() *mtproto.Updates {
+	if m != nil {
+		return m.Updates
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("push.TLConstructor", TLConstructor_name, TLConstructor_value)
+	proto.RegisterType((*TLPushRegisterDevice)(nil), "push.TL_push_registerDevice")
+	proto.RegisterType((*TLPushUnregisterDevice)(nil), "push.TL_push_unregisterDevice")
+	proto.RegisterType((*TLPushUpdateDeviceLocked)(nil), "push.TL_push_updateDeviceLocked")
+	proto.RegisterType((*TLPushPushUpdatesIfNot)(nil), "push.TL_push_pushUpdatesIfNot")
+}
+
+func init() { proto.RegisterFile("push.tl.proto", fileDescriptor_43d6f90d12bfdffa) }
+
+var fileDescriptor_43d6f90d12bfdffa = []byte{
+	// 704 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x55, 0x3d, 0x6f, 0x13, 0x4b,
+	0x14, 0xf5, 0x24, 0xfe, 0x1c, 0x3f, 0x3f, 0xf9, 0x4d, 0xf2, 0x92, 0x8d, 0xdf, 0xcb, 0x6a, 0x31,
+	0x42, 0x5a, 0x45, 0x8a, 0x2d, 0x39, 0xa2, 0xa2, 0x8b, 0x53, 0x10, 0xc5, 0x31, 0xd1, 0x92, 0x80,
+	0x44, 0xb3, 0x5a, 0xef, 0xde, 0xac, 0x57, 0xb1, 0x77, 0x56, 0x33, 0xb3, 0x21, 0xee, 0x28, 0xf9,
+	0x11, 0x88, 0x06, 0x24, 0x0a, 0x2a, 0xf8, 0x03, 0x11, 0x5d, 0xe8, 0x68, 0x68, 0x11, 0x44, 0x50,
+	0x22, 0xd1, 0x20, 0x3e, 0x1a, 0xa3, 0x1d, 0xdb, 0xf1, 0x47, 0x1c, 0xd1, 0x20, 0x68, 0x56, 0x7b,
+	0xee, 0xb9, 0xbe, 0x73, 0xce, 0x99, 0x19, 0x2f, 0xce, 0x05, 0x21, 0x6f, 0x96, 0x44, 0xab, 0x14,
+	0x30, 0x2a, 0x28, 0x89, 0x47, 0xb0, 0xb0, 0xea, 0x7a, 0xa2, 0x19, 0x36, 0x4a, 0x36, 0x6d, 0x97,
+	0x5d, 0xea, 0xd2, 0xb2, 0x24, 0x1b, 0xe1, 0xbe, 0x44, 0x12, 0xc8, 0xb7, 0xde, 0x8f, 0x0a, 0xaa,
+	0x4b, 0xa9, 0xdb, 0x82, 0x61, 0xd7, 0x5d, 0x66, 0x05, 0x01, 0x30, 0xde, 0xe7, 0x0b, 0xdc, 0x6e,
+	0x42, 0xdb, 0x8a, 0x56, 0xb1, 0x29, 0x03, 0x53, 0x74, 0x02, 0x18, 0x70, 0x4b, 0x43, 0x4e, 0x30,
+	0xcb, 0xe7, 0x01, 0x65, 0xa2, 0x4f, 0xcd, 0x0f, 0x29, 0xde, 0xf1, 0xed, 0x5e, 0xb5, 0x78, 0x3c,
+	0x83, 0x17, 0x76, 0x6b, 0x66, 0xa4, 0xd3, 0x64, 0xe0, 0x7a, 0x5c, 0x00, 0xdb, 0x80, 0x43, 0xcf,
+	0x06, 0x72, 0x15, 0x67, 0x6d, 0xea, 0x73, 0xc1, 0x42, 0x5b, 0x50, 0xa6, 0x20, 0x0d, 0xe9, 0x7f,
+	0x57, 0xe6, 0x4a, 0xd2, 0xe1, 0x6e, 0xad, 0x3a, 0xa4, 0x8c, 0xd1, 0x3e, 0xb2, 0x88, 0x53, 0x21,
+	0x07, 0x66, 0x7a, 0x8e, 0x32, 0xab, 0x21, 0x7d, 0xd6, 0x48, 0x46, 0x70, 0xd3, 0x21, 0x2a, 0xce,
+	0x5a, 0xa1, 0x68, 0x9a, 0x07, 0xd0, 0x89, 0xc8, 0xb8, 0x24, 0x33, 0x51, 0x69, 0x0b, 0x3a, 0x9b,
+	0x0e, 0x59, 0xc2, 0x69, 0x9f, 0x9a, 0xed, 0x50, 0x80, 0xa3, 0x24, 0x34, 0xa4, 0xa7, 0x8d, 0x94,
+	0x4f, 0xb7, 0x23, 0x48, 0x96, 0x31, 0x16, 0xf4, 0x00, 0x7c, 0xe9, 0x55, 0x49, 0x6a, 0x48, 0x4f,
+	0x18, 0x19, 0x59, 0xd9, 0xed, 0x04, 0x40, 0xe6, 0x71, 0x42, 0x02, 0x25, 0xa5, 0x21, 0x3d, 0x63,
+	0xf4, 0x00, 0x29, 0xe1, 0xac, 0x15, 0x04, 0x26, 0xb7, 0x7c, 0xa7, 0x41, 0x8f, 0x94, 0xb4, 0x86,
+	0xf4, 0x6c, 0x25, 0x57, 0x6a, 0x0b, 0xe9, 0xbc, 0xb4, 0x4e, 0x69, 0xcb, 0xc0, 0x56, 0x10, 0xdc,
+	0xec, 0x35, 0x90, 0x05, 0x9c, 0xe4, 0x60, 0x33, 0x10, 0x4a, 0x46, 0x43, 0xfa, 0x5f, 0x46, 0x1f,
+	0x45, 0x8b, 0x53, 0xd1, 0x04, 0x66, 0x86, 0x9e, 0xc3, 0x15, 0xac, 0xcd, 0x46, 0xb2, 0x65, 0x65,
+	0xcf, 0x73, 0x78, 0xf1, 0x0d, 0xc2, 0xca, 0x20, 0xc1, 0xd0, 0xff, 0xc3, 0x19, 0x8e, 0x07, 0x95,
+	0xb8, 0x30, 0xa8, 0xe4, 0x68, 0x50, 0xe3, 0x06, 0x53, 0x93, 0x06, 0x1f, 0x23, 0x5c, 0x38, 0x33,
+	0x18, 0x38, 0x96, 0x80, 0x9e, 0xb9, 0x1a, 0xb5, 0x0f, 0xc0, 0xf9, 0xed, 0x16, 0x17, 0x70, 0x32,
+	0x00, 0xe6, 0x51, 0xa7, 0x6f, 0xaf, 0x8f, 0x8a, 0xcf, 0x47, 0xf6, 0x21, 0x7a, 0xec, 0x49, 0xa9,
+	0x7c, 0x73, 0xbf, 0x4e, 0xc5, 0x2f, 0x17, 0x59, 0xc0, 0x69, 0x38, 0xb2, 0x5b, 0xa1, 0x03, 0x5c,
+	0x89, 0xcb, 0xc0, 0xce, 0x30, 0x59, 0xc1, 0xa9, 0x5e, 0x4c, 0x5c, 0x2a, 0xcc, 0x56, 0xf2, 0x67,
+	0x67, 0xae, 0xaf, 0xc9, 0x18, 0x34, 0xac, 0xbc, 0x40, 0x38, 0x37, 0xb6, 0x3e, 0xf9, 0x07, 0xe7,
+	0xaa, 0x46, 0x75, 0xad, 0x62, 0xee, 0xd5, 0xb7, 0xea, 0x37, 0x6e, 0xd7, 0xf3, 0x31, 0x72, 0x09,
+	0x2f, 0xf5, 0x4a, 0x53, 0x6e, 0x69, 0xfe, 0xeb, 0xc7, 0x0f, 0xf7, 0xe2, 0xe4, 0x32, 0xfe, 0x6f,
+	0xa4, 0x65, 0xf2, 0x18, 0xe6, 0x9f, 0x1e, 0xbf, 0xff, 0x92, 0x20, 0x57, 0xf0, 0xf2, 0x68, 0xd3,
+	0xb9, 0xad, 0xcc, 0x7f, 0x7f, 0xf8, 0xe4, 0x65, 0x9c, 0xe8, 0x63, 0xb3, 0x26, 0xa3, 0xcc, 0x9f,
+	0x7c, 0x7e, 0xf0, 0xfa, 0x5b, 0xb7, 0xdb, 0xed, 0xa2, 0x42, 0xfc, 0xfe, 0x23, 0x35, 0x56, 0x79,
+	0x36, 0x83, 0x53, 0xc6, 0x4e, 0x75, 0x27, 0xe4, 0x4d, 0xb2, 0x81, 0xe7, 0xa6, 0xfd, 0x95, 0xfc,
+	0x3f, 0x48, 0x7a, 0x9a, 0x85, 0xc2, 0xf8, 0x9d, 0x2c, 0xc6, 0xc8, 0x75, 0xfc, 0xef, 0xf4, 0xeb,
+	0xa4, 0x8e, 0xcf, 0x09, 0xfd, 0x9f, 0x4d, 0xaa, 0xe1, 0xc5, 0x8b, 0xce, 0xad, 0x36, 0x31, 0xeb,
+	0x5c, 0xc7, 0xc5, 0xba, 0xce, 0x1d, 0xaf, 0x09, 0x5d, 0x93, 0xfc, 0xc8, 0xa4, 0x5b, 0xd4, 0x73,
+	0x8a, 0xb1, 0xf5, 0xed, 0x4f, 0xef, 0x54, 0x74, 0x72, 0xaa, 0xa2, 0x57, 0xa7, 0x2a, 0x7a, 0x7b,
+	0xaa, 0xa2, 0x3b, 0xd7, 0x46, 0x3e, 0x12, 0x02, 0xac, 0xb6, 0xcb, 0xac, 0xe1, 0xcb, 0x2a, 0x07,
+	0x76, 0x08, 0xac, 0x6c, 0x05, 0x41, 0xb9, 0x0d, 0x9c, 0x83, 0xef, 0x02, 0x2b, 0x47, 0xcb, 0xc8,
+	0x47, 0x23, 0x29, 0x87, 0xaf, 0xfd, 0x18, 0x00, 0xc3, 0xfa, 0x62, 0xaa, 0x7f, 0x06, 0x00, 0x00,
+}
+
+func (this *TLPushRegisterDevice) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 13)
+	s = append(s, "&push.TLPushRegisterDevice{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
+	s = append(s, "AuthKeyId: "+fmt.Sprintf("%#v", this.AuthKeyId)+",\n")
+	s = append(s, "NoMuted: "+fmt.Sprintf("%#v", this.NoMuted)+",\n")
+	s = append(s, "TokenType: "+fmt.Sprintf("%#v", this.TokenType)+",\n")
+	s = append(s, "Token: "+fmt.Sprintf("%#v", this.Token)+",\n")
+	if this.AppSandbox != nil {
+		s = append(s, "AppSandbox: "+fmt.Sprintf("%#v", this.AppSandbox)+",\n")
+	}
+	s = append(s, "Secret: "+fmt.Sprintf("%#v", this.Secret)+",\n")
+	s = append(s, "OtherUids: "+fmt.Sprintf("%#v", this.OtherUids)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLPushUnregisterDevice) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 10)
+	s = append(s, "&push.TLPushUnregisterDevice{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
+	s = append(s, "AuthKeyId: "+fmt.Sprintf("%#v", this.AuthKeyId)+",\n")
+	s = append(s, "TokenType: "+fmt.Sprintf("%#v", this.TokenType)+",\n")
+	s = append(s, "Token: "+fmt.Sprintf("%#v", this.Token)+",\n")
+	s = append(s, "OtherUids: "+fmt.Sprintf("%#v", this.OtherUids)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLPushUpdateDeviceLocked) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&push.TLPushUpdateDeviceLocked{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
+	s = append(s, "AuthKeyId: "+fmt.Sprintf("%#v", this.AuthKeyId)+",\n")
+	s = append(s, "Period: "+fmt.Sprintf("%#v", this.Period)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLPushPushUpdatesIfNot) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&push.TLPushPushUpdatesIfNot{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
+	s = append(s, "Excludes: "+fmt.Sprintf("%#v", this.Excludes)+",\n")
+	if this.Updates != nil {
+		s = append(s, "Updates: "+fmt.Sprintf("%#v", this.Updates)+",\n")
+	}
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringPushTl(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// RPCPushClient is the client API for RPCPush service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type RPCPushClient interface {
+	// push.registerDevice flags:# user_id:long auth_key_id:long no_muted:flags.0?true token_type:int token:string app_sandbox:Bool secret:bytes other_uids:Vector<long> = Bool;
+	PushRegisterDevice(ctx context.Context, in *TLPushRegisterDevice, opts ...grpc.CallOption) (*mtproto.Bool, error)
+	// push.unregisterDevice user_id:long auth_key_id:long token_type:int token:string other_uids:Vector<long> = Bool;
+	PushUnregisterDevice(ctx context.Context, in *TLPushUnregisterDevice, opts ...grpc.CallOption) (*mtproto.Bool, error)
+	// push.updateDeviceLocked user_id:long auth_key_id:long period:int = Bool;
+	PushUpdateDeviceLocked(ctx context.Context, in *TLPushUpdateDeviceLocked, opts ...grpc.CallOption) (*mtproto.Bool, error)
+	// push.pushUpdatesIfNot user_id:long excludes:Vector<long> updates:Updates = Void;
+	PushPushUpdatesIfNot(ctx context.Context, in *TLPushPushUpdatesIfNot, opts ...grpc.CallOption) (*mtproto.Void, error)
+}
+
+type rPCPushClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewRPCPushClient(cc *grpc.ClientConn) RPCPushClient {
+	return &rPCPushClient{cc}
+}
+
+func (c *rPCPushClient) PushRegisterDevice(ctx context.Context, in *TLPushRegisterDevice, opts ...grpc.CallOption) (*mtproto.Bool, error) {
+	out := new(mtproto.Bool)
+	err := c.cc.Invoke(ctx, "/push.RPCPush/push_registerDevice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPCPushClient) PushUnregisterDevice(ctx context.Context, in *TLPushUnregisterDevice, opts ...grpc.CallOption) (*mtproto.Bool, error) {
+	out := new(mtproto.Bool)
+	err := c.cc.Invoke(ctx, "/push.RPCPush/push_unregisterDevice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPCPushClient) PushUpdateDeviceLocked(ctx context.Context, in *TLPushUpdateDeviceLocked, opts ...grpc.CallOption) (*mtproto.Bool, error) {
+	out := new(mtproto.Bool)
+	err := c.cc.Invoke(ctx, "/push.RPCPush/push_updateDeviceLocked", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPCPushClient) PushPushUpdatesIfNot(ctx context.Context, in *TLPushPushUpdatesIfNot, opts ...grpc.CallOption) (*mtproto.Void, error) {
+	out := new(mtproto.Void)
+	err := c.cc.Invoke(ctx, "/push.RPCPush/push_pushUpdatesIfNot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RPCPushServer is the server API for RPCPush service.
+type RPCPushServer interface {
+	// push.registerDevice flags:# user_id:long auth_key_id:long no_muted:flags.0?true token_type:int token:string app_sandbox:Bool secret:bytes other_uids:Vector<long> = Bool;
+	PushRegisterDevice(context.Context, *TLPushRegisterDevice) (*mtproto.Bool, error)
+	// push.unregisterDevice user_id:long auth_key_id:long token_type:int token:string other_uids:Vector<long> = Bool;
+	PushUnregisterDevice(context.Context, *TLPushUnregisterDevice) (*mtproto.Bool, error)
+	// push.updateDeviceLocked user_id:long auth_key_id:long period:int = Bool;
+	PushUpdateDeviceLocked(context.Context, *TLPushUpdateDeviceLocked) (*mtproto.Bool, error)
+	// push.pushUpdatesIfNot user_id:long excludes:Vector<long> updates:Updates = Void;
+	PushPushUpdatesIfNot(context.Context, *TLPushPushUpdatesIfNot) (*mtproto.Void, error)
+}
+
+// UnimplementedRPCPushServer can be embedded to have forward compatible implementations.
+type UnimplementedRPCPushServer struct {
+}
+
+func (*UnimplementedRPCPushServer) PushRegisterDevice(ctx context.Context, req *TLPushRegisterDevice) (*mtproto.Bool, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PushRegisterDevice not implemented")
+}
+func (*UnimplementedRPCPushServer) PushUnregisterDevice(ctx context.Context, req *TLPushUnregisterDevice) (*mtproto.Bool, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PushUnregisterDevice not implemented")
+}
+func (*UnimplementedRPCPushServer) PushUpdateDeviceLocked(ctx context.Context, req *TLPushUpdateDeviceLocked) (*mtproto.Bool, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PushUpdateDeviceLocked not implemented")
+}
+func (*UnimplementedRPCPushServer) PushPushUpdatesIfNot(ctx context.Context, req *TLPushPushUpdatesIfNot) (*mtproto.Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PushPushUpdatesIfNot not implemented")
+}
+
+func RegisterRPCPushServer(s *grpc.Server, srv RPCPushServer) {
+	s.RegisterService(&_RPCPush_serviceDesc, srv)
+}
+
+func _RPCPush_PushRegisterDevice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLPushRegisterDevice)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCPushServer).PushRegisterDevice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/push.RPCPush/PushRegisterDevice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCPushServer).PushRegisterDevice(ctx, req.(*TLPushRegisterDevice))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCPush_PushUnregisterDevice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLPushUnregisterDevice)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCPushServer).PushUnregisterDevice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/push.RPCPush/PushUnregisterDevice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCPushServer).PushUnregisterDevice(ctx, req.(*TLPushUnregisterDevice))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCPush_PushUpdateDeviceLocked_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLPushUpdateDeviceLocked)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCPushServer).PushUpdateDeviceLocked(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/push.RPCPush/PushUpdateDeviceLocked",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCPushServer).PushUpdateDeviceLocked(ctx, req.(*TLPushUpdateDeviceLocked))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCPush_PushPushUpdatesIfNot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLPushPushUpdatesIfNot)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCPushServer).PushPushUpdatesIfNot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/push.RPCPush/PushPushUpdatesIfNot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCPushServer).PushPushUpdatesIfNot(ctx, req.(*TLPushPushUpdatesIfNot))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _RPCPush_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "push.RPCPush",
+	HandlerType: (*RPCPushServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "push_registerDevice",
+			Handler:    _RPCPush_PushRegisterDevice_Handler,
+		},
+		{
+			MethodName: "push_unregisterDevice",
+			Handler:    _RPCPush_PushUnregisterDevice_Handler,
+		},
+		{
+			MethodName: "push_updateDeviceLocked",
+			Handler:    _RPCPush_PushUpdateDeviceLocked_Handler,
+		},
+		{
+			MethodName: "push_pushUpdatesIfNot",
+			Handler:    _RPCPush_PushPushUpdatesIfNot_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "push.tl.proto",
+}
+
+func (m *TLPushRegisterDevice) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLPushRegisterDevice) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLPushRegisterDevice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.OtherUids) > 0 {
+		dAtA2 := make([]byte, len(m.OtherUids)*10)
+		var j1 int
+		for _, num1 := range m.OtherUids {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintPushTl(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.Secret) > 0 {
+		i -= len(m.Secret)
+		copy(dAtA[i:], m.Secret)
+		i = encodeVarintPushTl(dAtA, i, uint64(len(m.Secret)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.AppSandbox != nil {
+		{
+			size, err := m.AppSandbox.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPushTl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintPushTl(dAtA, i, uint64(len(m.Token)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.TokenType != 0 {
+		i = encodeVarintPushTl(dAtA, i, uint64(m.TokenType))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.NoMuted {
+		i--
+		if m.NoMuted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.AuthKeyId != 0 {
+		i = encodeVarintPushTl(dAtA, i, uint64(m.AuthKeyId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.UserId != 0 {
+		i = encodeVarintPushTl(dAtA, i, uint64(m.UserId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintPushTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLPushUnregisterDevice) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLPushUnregisterDevice) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLPushUnregisterDevice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.OtherUids) > 0 {
+		dAtA5 := make([]byte, len(m.OtherUids)*10)
+		var j4 int
+		for _, num1 := range m.OtherUids {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j4++
+			}
+			dAtA5[j4] = uint8(num)
+			j4++
+		}
+		i -= j4
+		copy(dAtA[i:], dAtA5[:j4])
+		i = encodeVarintPushTl(dAtA, i, uint64(j4))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintPushTl(dAtA, i, uint64(len(m.Token)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.TokenType != 0 {
+		i = encodeVarintPushTl(dAtA, i, uint64(m.TokenType))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.AuthKeyId != 0 {
+		i = encodeVarintPushTl(dAtA, i, uint64(m.AuthKeyId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.UserId != 0 {
+		i = encodeVarintPushTl(dAtA, i, uint64(m.UserId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintPushTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLPushUpdateDeviceLocked) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLPushUpdateDeviceLocked) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLPushUpdateDeviceLocked) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Period != 0 {
+		i = encodeVarintPushTl(dAtA, i, uint64(m.Period))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.AuthKeyId != 0 {
+		i = encodeVarintPushTl(dAtA, i, uint64(m.AuthKeyId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.UserId != 0 {
+		i = encodeVarintPushTl(dAtA, i, uint64(m.UserId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintPushTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLPushPushUpdatesIfNot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLPushPushUpdatesIfNot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLPushPushUpdatesIfNot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Updates != nil {
+		{
+			size, err := m.Updates.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPushTl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Excludes) > 0 {
+		dAtA8 := make([]byte, len(m.Excludes)*10)
+		var j7 int
+		for _, num1 := range m.Excludes {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA8[j7] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j7++
+			}
+			dAtA8[j7] = uint8(num)
+			j7++
+		}
+		i -= j7
+		copy(dAtA[i:], dAtA8[:j7])
+		i = encodeVarintPushTl(dAtA, i, uint64(j7))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.UserId != 0 {
+		i = encodeVarintPushTl(dAtA, i, uint64(m.UserId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintPushTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPushTl(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPushTl(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *TLPushRegisterDevice) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovPushTl(uint64(m.Constructor))
+	}
+	if m.UserId != 0 {
+		n += 1 + sovPushTl(uint64(m.UserId))
+	}
+	if m.AuthKeyId != 0 {
+		n += 1 + sovPushTl(uint64(m.AuthKeyId))
+	}
+	if m.NoMuted {
+		n += 2
+	}
+	if m.TokenType != 0 {
+		n += 1 + sovPushTl(uint64(m.TokenType))
+	}
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovPushTl(uint64(l))
+	}
+	if m.AppSandbox != nil {
+		l = m.AppSandbox.Size()
+		n += 1 + l + sovPushTl(uint64(l))
+	}
+	l = len(m.Secret)
+	if l > 0 {
+		n += 1 + l + sovPushTl(uint64(l))
+	}
+	if len(m.OtherUids) > 0 {
+		l = 0
+		for _, e := range m.OtherUids {
+			l += sovPushTl(uint64(e))
+		}
+		n += 1 + sovPushTl(uint64(l)) + l
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLPushUnregisterDevice) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovPushTl(uint64(m.Constructor))
+	}
+	if m.UserId != 0 {
+		n += 1 + sovPushTl(uint64(m.UserId))
+	}
+	if m.AuthKeyId != 0 {
+		n += 1 + sovPushTl(uint64(m.AuthKeyId))
+	}
+	if m.TokenType != 0 {
+		n += 1 + sovPushTl(uint64(m.TokenType))
+	}
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovPushTl(uint64(l))
+	}
+	if len(m.OtherUids) > 0 {
+		l = 0
+		for _, e := range m.OtherUids {
+			l += sovPushTl(uint64(e))
+		}
+		n += 1 + sovPushTl(uint64(l)) + l
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLPushUpdateDeviceLocked) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovPushTl(uint64(m.Constructor))
+	}
+	if m.UserId != 0 {
+		n += 1 + sovPushTl(uint64(m.UserId))
+	}
+	if m.AuthKeyId != 0 {
+		n += 1 + sovPushTl(uint64(m.AuthKeyId))
+	}
+	if m.Period != 0 {
+		n += 1 + sovPushTl(uint64(m.Period))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLPushPushUpdatesIfNot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovPushTl(uint64(m.Constructor))
+	}
+	if m.UserId != 0 {
+		n += 1 + sovPushTl(uint64(m.UserId))
+	}
+	if len(m.Excludes) > 0 {
+		l = 0
+		for _, e := range m.Excludes {
+			l += sovPushTl(uint64(e))
+		}
+		n += 1 + sovPushTl(uint64(l)) + l
+	}
+	if m.Updates != nil {
+		l = m.Updates.Size()
+		n += 1 + l + sovPushTl(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovPushTl(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPushTl(x uint64) (n int) {
+	return sovPushTl(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *TLPushRegisterDevice) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPushTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_push_registerDevice: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_push_registerDevice: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPushTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			m.UserId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPushTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UserId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthKeyId", wireType)
+			}
+			m.AuthKeyId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPushTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuthKeyId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NoMuted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPushTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.NoMuted = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenType", wireType)
+			}
+			m.TokenType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPushTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TokenType |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPushTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPushTl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPushTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Token = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppSandbox", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPushTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPushTl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPushTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AppSandbox == nil {
+				m.AppSandbox = &mtproto.Bool{}
+			}
+			if err := m.AppSandbox.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Secret", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPushTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPushTl
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPushTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Secret = append(m.Secret[:0], dAtA[iNdEx:postIndex]...)
+			if m.Secret == nil {
+				m.Secret = []byte{}
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPushTl
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.OtherUids = append(m.OtherUids, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPushTl
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthPushTl
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthPushTl
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.OtherUids) == 0 {
+					m.OtherUids = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPushTl
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.OtherUids = append(m.OtherUids, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field OtherUids", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPushTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPushTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLPushUnregisterDevice) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPushTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_push_unregisterDevice: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_push_unregisterDevice: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPushTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			m.UserId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPushTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UserId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthKeyId", wireType)
+			}
+			m.AuthKeyId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPushTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuthKeyId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenType", wireType)
+			}
+			m.TokenType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPushTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TokenType |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPushTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPushTl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPushTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Token = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPushTl
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.OtherUids = append(m.OtherUids, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPushTl
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthPushTl
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthPushTl
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.OtherUids) == 0 {
+					m.OtherUids = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPushTl
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.OtherUids = append(m.OtherUids, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field OtherUids", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPushTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPushTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLPushUpdateDeviceLocked) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPushTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_push_updateDeviceLocked: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_push_updateDeviceLocked: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPushTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			m.UserId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPushTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UserId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthKeyId", wireType)
+			}
+			m.AuthKeyId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPushTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuthKeyId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			m.Period = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPushTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Period |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPushTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPushTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLPushPushUpdatesIfNot) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPushTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_push_pushUpdatesIfNot: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_push_pushUpdatesIfNot: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPushTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			m.UserId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPushTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UserId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPushTl
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Excludes = append(m.Excludes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPushTl
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthPushTl
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthPushTl
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Excludes) == 0 {
+					m.Excludes = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPushTl
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Excludes = append(m.Excludes, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Excludes", wireType)
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Updates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPushTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPushTl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPushTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Updates == nil {
+				m.Updates = &mtproto.Updates{}
+			}
+			if err := m.Updates.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPushTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPushTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipPushTl(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowPushTl
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPushTl
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPushTl
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthPushTl
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupPushTl
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthPushTl
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthPushTl        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowPushTl          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupPushTl = fmt.Errorf("proto: unexpected end of group")
+)
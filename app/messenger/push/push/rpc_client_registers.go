@@ -0,0 +1,51 @@
+/*
+ * WARNING! All changes made in this file will be lost!
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2022-present,  Teamgram Authors.
+ *  All rights reserved.
+ *
+ * Author: teagramio (teagram.io@gmail.com)
+ */
+
+package push
+
+import (
+	"reflect"
+
+	"github.com/teamgram/proto/mtproto"
+)
+
+var _ *mtproto.Bool
+
+type newRPCReplyFunc func() interface{}
+
+type RPCContextTuple struct {
+	Method       string
+	NewReplyFunc newRPCReplyFunc
+}
+
+var rpcContextRegisters = map[string]RPCContextTuple{
+	"TLPushRegisterDevice":     RPCContextTuple{"/mtproto.RPCPush/push_registerDevice", func() interface{} { return new(mtproto.Bool) }},
+	"TLPushUnregisterDevice":   RPCContextTuple{"/mtproto.RPCPush/push_unregisterDevice", func() interface{} { return new(mtproto.Bool) }},
+	"TLPushUpdateDeviceLocked": RPCContextTuple{"/mtproto.RPCPush/push_updateDeviceLocked", func() interface{} { return new(mtproto.Bool) }},
+	"TLPushPushUpdatesIfNot":   RPCContextTuple{"/mtproto.RPCPush/push_pushUpdatesIfNot", func() interface{} { return new(mtproto.Void) }},
+}
+
+func FindRPCContextTuple(t interface{}) *RPCContextTuple {
+	rt := reflect.TypeOf(t)
+	if rt.Kind() == reflect.Ptr {
+		rt = rt.Elem()
+	}
+
+	m, ok := rpcContextRegisters[rt.Name()]
+	if !ok {
+		// log.Errorf("Can't find name: %s", rt.Name())
+		return nil
+	}
+	return &m
+}
+
+func GetRPCContextRegisters() map[string]RPCContextTuple {
+	return rpcContextRegisters
+}
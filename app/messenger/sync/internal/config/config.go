@@ -20,6 +20,7 @@ package config
 
 import (
 	kafka "github.com/teamgram/marmota/pkg/mq"
+	"github.com/teamgram/teamgram-server/pkg/filereference/conf"
 	"github.com/zeromicro/go-zero/core/stores/cache"
 	"github.com/zeromicro/go-zero/core/stores/kv"
 	"github.com/zeromicro/go-zero/zrpc"
@@ -44,5 +45,9 @@ type Config struct {
 	IdgenClient   zrpc.RpcClientConf
 	StatusClient  zrpc.RpcClientConf
 	ChatClient    zrpc.RpcClientConf
+	ChannelClient zrpc.RpcClientConf
+	UserClient    zrpc.RpcClientConf
 	PushClient    *kafka.KafkaProducerConf `json:",optional"`
+	BotsClient    *kafka.KafkaProducerConf `json:",optional"`
+	FileReference conf.FileReferenceConfig
 }
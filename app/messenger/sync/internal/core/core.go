@@ -28,6 +28,7 @@ const (
 	syncTypeUser      SyncType = 1 // 该用户所有设备
 	syncTypeUserNotMe SyncType = 2 // 该用户除了某个设备
 	syncTypeUserMe    SyncType = 3 // 该用户指定某个设备
+	syncTypeBot       SyncType = 4 // bot所有设备, 不走离线推送
 )
 
 type SyncCore struct {
@@ -167,6 +168,9 @@ func (c *SyncCore) processUpdates(syncType SyncType, userId int64, isBot bool, u
 }
 
 func (c *SyncCore) pushUpdatesToSession(syncType SyncType, userId, authKeyId, clientMsgId int64, pushData *mtproto.Updates, hasServerId string, notification bool) {
+	// the media of the new messages are downloaded by the file references signed for userId
+	c.svcCtx.Dao.FileReference.SetUpdates(userId, pushData)
+
 	if syncType == syncTypeUserMe && hasServerId != "" {
 		logx.Infof("pushUpdatesToSession - pushData: {server_id: %d, auth_key_id: %d}", hasServerId, authKeyId)
 		if clientMsgId != 0 {
@@ -202,6 +206,10 @@ func (c *SyncCore) pushUpdatesToSession(syncType SyncType, userId, authKeyId, cl
 			if syncType == syncTypeUserNotMe && sess.AuthKeyId == authKeyId {
 				continue
 			}
+			// the server_id of the device is unknown, only push to its online sessions
+			if syncType == syncTypeUserMe && sess.AuthKeyId != authKeyId {
+				continue
+			}
 			pushExcludeList = append(pushExcludeList, sess.AuthKeyId)
 			if keyIdList, ok := serverIdKeyIdList[sess.Gateway]; ok {
 				keyIdList = append(keyIdList, sess.AuthKeyId)
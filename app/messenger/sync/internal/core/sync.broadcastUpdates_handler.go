@@ -10,34 +10,109 @@
 package core
 
 import (
-	// "github.com/teamgram/marmota/pkg/container2"
+	"github.com/teamgram/marmota/pkg/container2"
 	"github.com/teamgram/proto/mtproto"
 	"github.com/teamgram/teamgram-server/app/messenger/sync/sync"
-	// channelpb "github.com/teamgram/teamgram-server/app/service/biz/channel/channel"
+	channelpb "github.com/teamgram/teamgram-server/app/service/biz/channel/channel"
 	chatpb "github.com/teamgram/teamgram-server/app/service/biz/chat/chat"
+	userpb "github.com/teamgram/teamgram-server/app/service/biz/user/user"
+
+	"github.com/gogo/protobuf/proto"
 )
 
 // SyncBroadcastUpdates
 // sync.broadcastUpdates broadcast_type:int chat_id:long exclude_id_list:Vector<long> updates:Updates = Void;
 func (c *SyncCore) SyncBroadcastUpdates(in *sync.TLSyncBroadcastUpdates) (*mtproto.Void, error) {
-	pushUpdates := &sync.TLSyncPushUpdates{
-		UserId:  0,
-		Updates: in.Updates,
-	}
+	var (
+		idList []int64
+	)
 
-	if in.BroadcastType != sync.BroadcastTypeChat {
+	switch in.BroadcastType {
+	case sync.BroadcastTypeChat:
+		participants, err := c.svcCtx.Dao.ChatClient.ChatGetChatParticipantIdList(c.ctx, &chatpb.TLChatGetChatParticipantIdList{
+			ChatId: in.ChatId,
+		})
+		if err != nil {
+			c.Logger.Errorf("sync.broadcastUpdates - error: %v", err)
+			return mtproto.EmptyVoid, nil
+		}
+		idList = participants.GetDatas()
+	case sync.BroadcastTypeChannel:
+		participants, err := c.svcCtx.Dao.ChannelClient.ChannelGetChannelParticipantIdList(c.ctx, &channelpb.TLChannelGetChannelParticipantIdList{
+			ChannelId: in.ChatId,
+		})
+		if err != nil {
+			c.Logger.Errorf("sync.broadcastUpdates - error: %v", err)
+			return mtproto.EmptyVoid, nil
+		}
+		idList = participants.GetDatas()
+	default:
 		c.Logger.Errorf("invalid broadcast_type: %s", in.DebugString())
 		return mtproto.EmptyVoid, nil
 	}
 
-	idList, _ := c.svcCtx.Dao.ChatClient.ChatGetChatParticipantIdList(c.ctx, &chatpb.TLChatGetChatParticipantIdList{
-		ChatId: in.ChatId,
-	})
+	for _, id := range idList {
+		if ok, _ := container2.Contains(id, in.ExcludeIdList); ok {
+			continue
+		}
 
-	for _, id := range idList.GetDatas() {
-		pushUpdates.UserId = id
-		c.SyncPushUpdates(pushUpdates)
+		// the updates are signed and queued for every user, each one gets its own copy
+		updates := proto.Clone(in.Updates).(*mtproto.Updates)
+		if in.BroadcastType == sync.BroadcastTypeChannel {
+			c.setBroadcastUpdatesByUserId(id, updates)
+		}
+		c.SyncPushUpdates(&sync.TLSyncPushUpdates{
+			UserId:  id,
+			Updates: updates,
+		})
 	}
 
 	return mtproto.EmptyVoid, nil
 }
+
+// setBroadcastUpdatesByUserId replaces the users and the channels of updates by the ones
+// seen by userId, the updates of a channel are made once for all its participants.
+func (c *SyncCore) setBroadcastUpdatesByUserId(userId int64, updates *mtproto.Updates) {
+	if len(updates.GetUsers()) > 0 {
+		idList := make([]int64, 0, len(updates.GetUsers())+1)
+		for _, v := range updates.GetUsers() {
+			idList = append(idList, v.GetId())
+		}
+
+		users, err := c.svcCtx.Dao.UserClient.UserGetMutableUsers(c.ctx, &userpb.TLUserGetMutableUsers{
+			Id: append(idList, userId),
+		})
+		if err != nil {
+			c.Logger.Errorf("sync.broadcastUpdates - error: %v", err)
+		} else {
+			updates.Users = users.GetUserListByIdList(userId, idList...)
+		}
+	}
+
+	idList := make([]int64, 0, len(updates.GetChats()))
+	for _, v := range updates.GetChats() {
+		if v.GetPredicateName() == mtproto.Predicate_channel {
+			idList = append(idList, v.GetId())
+		}
+	}
+	if len(idList) == 0 {
+		return
+	}
+
+	channels, err := c.svcCtx.Dao.ChannelClient.ChannelGetChannelListByIdList(c.ctx, &channelpb.TLChannelGetChannelListByIdList{
+		SelfUserId: userId,
+		Id:         idList,
+	})
+	if err != nil {
+		c.Logger.Errorf("sync.broadcastUpdates - error: %v", err)
+		return
+	}
+
+	chats := make([]*mtproto.Chat, 0, len(updates.GetChats()))
+	for _, v := range updates.GetChats() {
+		if v.GetPredicateName() != mtproto.Predicate_channel {
+			chats = append(chats, v)
+		}
+	}
+	updates.Chats = append(chats, channels.GetDatas()...)
+}
@@ -0,0 +1,42 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/messenger/sync/sync"
+)
+
+// SyncPushBotUpdates
+// sync.pushBotUpdates user_id:long updates:Updates = Void;
+func (c *SyncCore) SyncPushBotUpdates(in *sync.TLSyncPushBotUpdates) (*mtproto.Void, error) {
+	var (
+		botId   = in.GetUserId()
+		updates = in.GetUpdates()
+	)
+
+	notification, err := c.processUpdates(syncTypeBot, botId, true, updates)
+	if err != nil {
+		c.Logger.Errorf("sync.pushBotUpdates - error: %v", err)
+		return nil, err
+	}
+
+	c.pushUpdatesToSession(syncTypeBot, botId, 0, 0, updates, "", notification)
+
+	// the HTTP Bot API bots receive the updates from app/interface/botapi
+	if c.svcCtx.Dao.BotsClient != nil {
+		_, err = c.svcCtx.Dao.BotsClient.SyncPushBotUpdates(c.ctx, in)
+		if err != nil {
+			c.Logger.Errorf("sync.pushBotUpdates - error: %v", err)
+		}
+	}
+
+	return mtproto.EmptyVoid, nil
+}
@@ -15,9 +15,12 @@ import (
 	"github.com/teamgram/marmota/pkg/stores/sqlx"
 	sync_client "github.com/teamgram/teamgram-server/app/messenger/sync/client"
 	"github.com/teamgram/teamgram-server/app/messenger/sync/internal/config"
+	channel_client "github.com/teamgram/teamgram-server/app/service/biz/channel/client"
 	chat_client "github.com/teamgram/teamgram-server/app/service/biz/chat/client"
+	user_client "github.com/teamgram/teamgram-server/app/service/biz/user/client"
 	idgen_client "github.com/teamgram/teamgram-server/app/service/idgen/client"
 	status_client "github.com/teamgram/teamgram-server/app/service/status/client"
+	"github.com/teamgram/teamgram-server/pkg/filereference"
 	"github.com/zeromicro/go-zero/core/stores/kv"
 	"github.com/zeromicro/go-zero/zrpc"
 )
@@ -30,7 +33,11 @@ type Dao struct {
 	idgen_client.IDGenClient2
 	status_client.StatusClient
 	chat_client.ChatClient
-	PushClient sync_client.SyncClient
+	channel_client.ChannelClient
+	user_client.UserClient
+	PushClient    sync_client.SyncClient
+	BotsClient    sync_client.SyncClient
+	FileReference *filereference.FileReference
 }
 
 func New(c config.Config) *Dao {
@@ -43,10 +50,16 @@ func New(c config.Config) *Dao {
 		IDGenClient2:   idgen_client.NewIDGenClient2(zrpc.MustNewClient(c.IdgenClient)),
 		StatusClient:   status_client.NewStatusClient(zrpc.MustNewClient(c.StatusClient)),
 		ChatClient:     chat_client.NewChatClient(rpcx.GetCachedRpcClient(c.ChatClient)),
+		ChannelClient:  channel_client.NewChannelClient(rpcx.GetCachedRpcClient(c.ChannelClient)),
+		UserClient:     user_client.NewUserClient(rpcx.GetCachedRpcClient(c.UserClient)),
+		FileReference:  filereference.New(&c.FileReference),
 	}
 	if c.PushClient != nil {
 		d.PushClient = sync_client.NewSyncMqClient(kafka.MustKafkaProducer(c.PushClient))
 	}
+	if c.BotsClient != nil {
+		d.BotsClient = sync_client.NewSyncMqClient(kafka.MustKafkaProducer(c.BotsClient))
+	}
 
 	go d.watch(c.SessionClient)
 	return d
@@ -52,6 +52,7 @@ const (
 	CRC32_authsession_unbindAuthKeyUser    TLConstructor = 123258440
 	CRC32_authsession_getPermAuthKeyId     TLConstructor = -1871420202
 	CRC32_authsession_bindTempAuthKey      TLConstructor = 1620004742
+	CRC32_authsession_dropTempAuthKeys     TLConstructor = 2010870522
 	CRC32_authsession_setClientSessionInfo TLConstructor = 47841172
 	CRC32_authsession_getAuthorization     TLConstructor = 1851660579
 	CRC32_authsession_getAuthStateData     TLConstructor = 1331573041
@@ -76,6 +77,7 @@ var TLConstructor_name = map[int32]string{
 	123258440:   "CRC32_authsession_unbindAuthKeyUser",
 	-1871420202: "CRC32_authsession_getPermAuthKeyId",
 	1620004742:  "CRC32_authsession_bindTempAuthKey",
+	2010870522:  "CRC32_authsession_dropTempAuthKeys",
 	47841172:    "CRC32_authsession_setClientSessionInfo",
 	1851660579:  "CRC32_authsession_getAuthorization",
 	1331573041:  "CRC32_authsession_getAuthStateData",
@@ -100,6 +102,7 @@ var TLConstructor_value = map[string]int32{
 	"CRC32_authsession_unbindAuthKeyUser":    123258440,
 	"CRC32_authsession_getPermAuthKeyId":     -1871420202,
 	"CRC32_authsession_bindTempAuthKey":      1620004742,
+	"CRC32_authsession_dropTempAuthKeys":     2010870522,
 	"CRC32_authsession_setClientSessionInfo": 47841172,
 	"CRC32_authsession_getAuthorization":     1851660579,
 	"CRC32_authsession_getAuthStateData":     1331573041,
@@ -1434,6 +1437,71 @@ func (m *TLAuthsessionBindTempAuthKey) GetEncryptedMessage() []byte {
 	return nil
 }
 
+//--------------------------------------------------------------------------------------------
+// authsession.dropTempAuthKeys perm_auth_key_id:long except_auth_keys:Vector<long> = Vector<long>;
+type TLAuthsessionDropTempAuthKeys struct {
+	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=authsession.TLConstructor" json:"constructor,omitempty"`
+	PermAuthKeyId        int64         `protobuf:"varint,3,opt,name=perm_auth_key_id,json=permAuthKeyId,proto3" json:"perm_auth_key_id,omitempty"`
+	ExceptAuthKeys       []int64       `protobuf:"varint,4,rep,packed,name=except_auth_keys,json=exceptAuthKeys,proto3" json:"except_auth_keys,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *TLAuthsessionDropTempAuthKeys) Reset()         { *m = TLAuthsessionDropTempAuthKeys{} }
+func (m *TLAuthsessionDropTempAuthKeys) String() string { return proto.CompactTextString(m) }
+func (*TLAuthsessionDropTempAuthKeys) ProtoMessage()    {}
+func (*TLAuthsessionDropTempAuthKeys) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7cbc1347c4a76ecf, []int{19}
+}
+func (m *TLAuthsessionDropTempAuthKeys) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLAuthsessionDropTempAuthKeys) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLAuthsessionDropTempAuthKeys.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TLAuthsessionDropTempAuthKeys) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLAuthsessionDropTempAuthKeys.Merge(m, src)
+}
+func (m *TLAuthsessionDropTempAuthKeys) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLAuthsessionDropTempAuthKeys) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLAuthsessionDropTempAuthKeys.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLAuthsessionDropTempAuthKeys proto.InternalMessageInfo
+
+func (m *TLAuthsessionDropTempAuthKeys) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
+	}
+	return CRC32_UNKNOWN
+}
+
+func (m *TLAuthsessionDropTempAuthKeys) GetPermAuthKeyId() int64 {
+	if m != nil {
+		return m.PermAuthKeyId
+	}
+	return 0
+}
+
+func (m *TLAuthsessionDropTempAuthKeys) GetExceptAuthKeys() []int64 {
+	if m != nil {
+		return m.ExceptAuthKeys
+	}
+	return nil
+}
+
 //--------------------------------------------------------------------------------------------
 // authsession.setClientSessionInfo data:ClientSession = Bool;
 type TLAuthsessionSetClientSessionInfo struct {
@@ -1448,7 +1516,7 @@ func (m *TLAuthsessionSetClientSessionInfo) Reset()         { *m = TLAuthsession
 func (m *TLAuthsessionSetClientSessionInfo) String() string { return proto.CompactTextString(m) }
 func (*TLAuthsessionSetClientSessionInfo) ProtoMessage()    {}
 func (*TLAuthsessionSetClientSessionInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7cbc1347c4a76ecf, []int{19}
+	return fileDescriptor_7cbc1347c4a76ecf, []int{20}
 }
 func (m *TLAuthsessionSetClientSessionInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1505,7 +1573,7 @@ func (m *TLAuthsessionGetAuthorization) Reset()         { *m = TLAuthsessionGetA
 func (m *TLAuthsessionGetAuthorization) String() string { return proto.CompactTextString(m) }
 func (*TLAuthsessionGetAuthorization) ProtoMessage()    {}
 func (*TLAuthsessionGetAuthorization) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7cbc1347c4a76ecf, []int{20}
+	return fileDescriptor_7cbc1347c4a76ecf, []int{21}
 }
 func (m *TLAuthsessionGetAuthorization) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1562,7 +1630,7 @@ func (m *TLAuthsessionGetAuthStateData) Reset()         { *m = TLAuthsessionGetA
 func (m *TLAuthsessionGetAuthStateData) String() string { return proto.CompactTextString(m) }
 func (*TLAuthsessionGetAuthStateData) ProtoMessage()    {}
 func (*TLAuthsessionGetAuthStateData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7cbc1347c4a76ecf, []int{21}
+	return fileDescriptor_7cbc1347c4a76ecf, []int{22}
 }
 func (m *TLAuthsessionGetAuthStateData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1618,7 +1686,7 @@ func (m *Vector_Long) Reset()         { *m = Vector_Long{} }
 func (m *Vector_Long) String() string { return proto.CompactTextString(m) }
 func (*Vector_Long) ProtoMessage()    {}
 func (*Vector_Long) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7cbc1347c4a76ecf, []int{22}
+	return fileDescriptor_7cbc1347c4a76ecf, []int{23}
 }
 func (m *Vector_Long) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1675,6 +1743,7 @@ func init() {
 	proto.RegisterType((*TLAuthsessionUnbindAuthKeyUser)(nil), "authsession.TL_authsession_unbindAuthKeyUser")
 	proto.RegisterType((*TLAuthsessionGetPermAuthKeyId)(nil), "authsession.TL_authsession_getPermAuthKeyId")
 	proto.RegisterType((*TLAuthsessionBindTempAuthKey)(nil), "authsession.TL_authsession_bindTempAuthKey")
+	proto.RegisterType((*TLAuthsessionDropTempAuthKeys)(nil), "authsession.TL_authsession_dropTempAuthKeys")
 	proto.RegisterType((*TLAuthsessionSetClientSessionInfo)(nil), "authsession.TL_authsession_setClientSessionInfo")
 	proto.RegisterType((*TLAuthsessionGetAuthorization)(nil), "authsession.TL_authsession_getAuthorization")
 	proto.RegisterType((*TLAuthsessionGetAuthStateData)(nil), "authsession.TL_authsession_getAuthStateData")
@@ -1684,116 +1753,119 @@ func init() {
 func init() { proto.RegisterFile("authsession.tl.proto", fileDescriptor_7cbc1347c4a76ecf) }
 
 var fileDescriptor_7cbc1347c4a76ecf = []byte{
-	// 1734 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0x5d, 0x6c, 0x1b, 0x59,
-	0x15, 0xf6, 0xc4, 0xb1, 0x9b, 0x1c, 0xd7, 0x61, 0xf6, 0xd6, 0x9b, 0x78, 0x27, 0x1b, 0xc7, 0x9d,
-	0xf4, 0x27, 0xa4, 0x8d, 0xbd, 0x24, 0x0b, 0x0f, 0x08, 0x09, 0x65, 0xb3, 0x42, 0x32, 0x9b, 0x0d,
-	0x59, 0x27, 0x5d, 0x24, 0x40, 0x3b, 0xdc, 0xcc, 0xdc, 0x38, 0xa3, 0x78, 0x7e, 0x76, 0x66, 0xdc,
-	0x8d, 0x97, 0x07, 0xa4, 0x05, 0x41, 0x1e, 0x90, 0x10, 0x02, 0x84, 0xf6, 0x05, 0x1e, 0xba, 0xfc,
-	0x89, 0x1f, 0x15, 0x5e, 0x60, 0x81, 0x22, 0x55, 0xaa, 0xaa, 0x22, 0x7e, 0x54, 0x24, 0x84, 0x54,
-	0x09, 0x09, 0x9a, 0x4a, 0x45, 0xf4, 0x85, 0x0a, 0x21, 0x51, 0x8a, 0xaa, 0xa0, 0xb9, 0x77, 0x6c,
-	0xcf, 0x9f, 0xed, 0x56, 0xad, 0xd5, 0xbe, 0xcd, 0x3d, 0xf7, 0xbb, 0xe7, 0x7c, 0xf7, 0x9c, 0x73,
-	0xcf, 0x3d, 0x77, 0x20, 0x87, 0x1b, 0xce, 0xb6, 0x4d, 0x6c, 0x5b, 0x35, 0xf4, 0x92, 0x53, 0x2f,
-	0x99, 0x96, 0xe1, 0x18, 0x28, 0xe3, 0x93, 0x0a, 0xf3, 0x35, 0xd5, 0xd9, 0x6e, 0x6c, 0x96, 0x64,
-	0x43, 0x2b, 0xd7, 0x8c, 0x9a, 0x51, 0xa6, 0x98, 0xcd, 0xc6, 0x16, 0x1d, 0xd1, 0x01, 0xfd, 0x62,
-	0x6b, 0x85, 0x42, 0xcd, 0x30, 0x6a, 0x75, 0xd2, 0x41, 0xbd, 0x61, 0x61, 0xd3, 0x24, 0x96, 0xed,
-	0xcd, 0x0b, 0xb6, 0xbc, 0x4d, 0x34, 0xec, 0x1a, 0x93, 0x0d, 0x8b, 0x48, 0x4e, 0xd3, 0x24, 0xad,
-	0xb9, 0x67, 0x3a, 0x73, 0x8e, 0x85, 0x75, 0xdb, 0x34, 0x2c, 0xc7, 0x9b, 0xca, 0x75, 0xa6, 0xec,
-	0xa6, 0x2e, 0x33, 0xa9, 0xf8, 0x87, 0x24, 0x64, 0x97, 0xeb, 0x2a, 0xd1, 0x9d, 0x75, 0xc6, 0x16,
-	0x1d, 0x87, 0x31, 0xd3, 0x22, 0x8a, 0x2a, 0x63, 0x87, 0x48, 0x3a, 0xd6, 0x48, 0x9e, 0x2b, 0x72,
-	0xb3, 0xa3, 0xd5, 0x6c, 0x5b, 0xba, 0x8a, 0x35, 0x82, 0x3e, 0x04, 0x19, 0xd9, 0xd0, 0x6d, 0xc7,
-	0x6a, 0xc8, 0x8e, 0x61, 0xe5, 0x87, 0x8a, 0xdc, 0xec, 0xd8, 0x82, 0x50, 0xf2, 0x7b, 0x63, 0x63,
-	0x65, 0xb9, 0x83, 0xa8, 0xfa, 0xe1, 0xa8, 0x00, 0xd4, 0x43, 0xd2, 0x0e, 0x69, 0x4a, 0xaa, 0x92,
-	0x4f, 0x16, 0xb9, 0xd9, 0x64, 0x75, 0xd4, 0x15, 0xbd, 0x44, 0x9a, 0x15, 0x05, 0x8d, 0xc1, 0x90,
-	0x6a, 0xe6, 0x87, 0xa9, 0xe1, 0x21, 0xd5, 0x44, 0x39, 0x48, 0xd5, 0x71, 0x93, 0x58, 0xf9, 0x54,
-	0x91, 0x9b, 0x4d, 0x55, 0xd9, 0x00, 0x3d, 0x0d, 0x69, 0x6c, 0xaa, 0xae, 0x82, 0x34, 0x13, 0x63,
-	0x53, 0xad, 0x28, 0xe8, 0x28, 0x1c, 0x56, 0xc8, 0x59, 0x55, 0x26, 0x92, 0x66, 0x28, 0xa4, 0x9e,
-	0x3f, 0x44, 0xd5, 0x64, 0x98, 0xec, 0x65, 0x57, 0xe4, 0x6e, 0xd2, 0x6e, 0xda, 0x0e, 0xd1, 0xa4,
-	0xb3, 0xc4, 0x72, 0xc9, 0xe6, 0x47, 0xd8, 0x26, 0x99, 0xf4, 0x55, 0x26, 0x44, 0xd3, 0x90, 0xc1,
-	0xa6, 0xd9, 0xc6, 0x8c, 0x52, 0x0c, 0x60, 0xd3, 0x6c, 0x01, 0x66, 0x81, 0xf7, 0xf4, 0xd4, 0xb1,
-	0x5e, 0x93, 0x64, 0x43, 0x21, 0x79, 0xa0, 0x28, 0x4f, 0xff, 0x0a, 0xd6, 0x6b, 0xcb, 0x86, 0x42,
-	0xd0, 0x24, 0x8c, 0x52, 0x88, 0x89, 0xe5, 0x9d, 0x7c, 0x86, 0x42, 0x46, 0x5c, 0xc1, 0x1a, 0x96,
-	0x77, 0xda, 0x93, 0x74, 0xfd, 0xe1, 0xce, 0x24, 0x5d, 0x99, 0x83, 0x94, 0x69, 0x19, 0xbb, 0xcd,
-	0x7c, 0x96, 0x4e, 0xb0, 0x01, 0x1a, 0x87, 0xb4, 0x89, 0x2d, 0xac, 0xd9, 0xf9, 0x31, 0x2a, 0xf6,
-	0x46, 0xe2, 0x8b, 0xc0, 0x6f, 0xac, 0x48, 0x72, 0x20, 0xa4, 0xcf, 0x41, 0x4a, 0xc1, 0x0e, 0x5e,
-	0xa0, 0x91, 0xcc, 0x84, 0xa2, 0x14, 0x88, 0x7e, 0x95, 0x01, 0xc5, 0x5f, 0x0e, 0x01, 0xbf, 0xc4,
-	0xa2, 0xb1, 0xee, 0x60, 0x87, 0xbc, 0x88, 0x1d, 0xfc, 0x64, 0x64, 0xc6, 0x04, 0x1c, 0x6a, 0xd8,
-	0xc4, 0x72, 0xe7, 0x86, 0xe9, 0x5c, 0xda, 0x1d, 0x56, 0x14, 0xd7, 0x87, 0xee, 0x1a, 0xdb, 0xa5,
-	0xeb, 0xa5, 0xc9, 0xc8, 0x8e, 0x47, 0xbf, 0x93, 0x3f, 0x69, 0x7f, 0xfe, 0x4c, 0x43, 0x86, 0x39,
-	0x8a, 0x9e, 0x21, 0x9a, 0x27, 0xa9, 0x2a, 0x30, 0xd1, 0x46, 0xd3, 0x24, 0xe8, 0xfd, 0x30, 0x81,
-	0x75, 0xc5, 0x32, 0x54, 0x45, 0x32, 0x1b, 0xf6, 0xb6, 0xe4, 0xf1, 0x77, 0x8d, 0x8f, 0x50, 0xe3,
-	0x39, 0x6f, 0x7a, 0xad, 0x61, 0x6f, 0x7b, 0x2e, 0xac, 0x28, 0xe2, 0x47, 0xe1, 0xc8, 0xc6, 0x8a,
-	0x84, 0xc3, 0xfe, 0x5b, 0x0c, 0x86, 0x61, 0x2a, 0xe0, 0x92, 0xb0, 0xb7, 0x5b, 0x91, 0xf8, 0x2e,
-	0x07, 0x45, 0x4f, 0x59, 0xcb, 0x7a, 0x8d, 0x38, 0x2e, 0xda, 0xb0, 0xd4, 0x37, 0xb1, 0xa3, 0x1a,
-	0xba, 0x1d, 0x76, 0x39, 0xf7, 0x60, 0x2e, 0xf7, 0xb9, 0x34, 0x19, 0x70, 0xe9, 0x69, 0x40, 0x64,
-	0x57, 0xae, 0x37, 0x14, 0x22, 0xb5, 0x62, 0x52, 0x69, 0xb9, 0x9d, 0xf7, 0x66, 0x96, 0x5a, 0x91,
-	0x11, 0x7f, 0xcc, 0xc1, 0xd1, 0x10, 0x53, 0x8b, 0xd8, 0x21, 0xae, 0x83, 0xa2, 0x1a, 0x4a, 0x9b,
-	0xe1, 0x70, 0xda, 0x20, 0x18, 0xde, 0xc6, 0xf6, 0x36, 0x4d, 0x8c, 0x64, 0x95, 0x7e, 0x8b, 0x6f,
-	0xc0, 0x44, 0xd4, 0xb3, 0x2b, 0x34, 0x33, 0x1e, 0x8e, 0x65, 0x9f, 0x1c, 0x16, 0xdf, 0x04, 0x21,
-	0xce, 0xb0, 0x57, 0x0c, 0x06, 0x6b, 0x7b, 0x17, 0xf2, 0x51, 0xdb, 0xac, 0x06, 0x3c, 0xae, 0x5d,
-	0xd3, 0x2a, 0xf7, 0x18, 0x76, 0x7d, 0x86, 0xa5, 0xce, 0x60, 0x2d, 0xff, 0x8c, 0x83, 0xe9, 0xa8,
-	0xe9, 0x40, 0xbd, 0x78, 0x5c, 0x67, 0x62, 0x0a, 0xc0, 0x31, 0x76, 0x88, 0xce, 0xaa, 0x1f, 0x2b,
-	0x99, 0xa3, 0x54, 0xe2, 0x16, 0x3f, 0xf1, 0xcb, 0x1c, 0x4c, 0x45, 0x99, 0x7f, 0xa4, 0xe1, 0x34,
-	0x2c, 0xb2, 0x8e, 0xeb, 0x8e, 0x3d, 0x58, 0xcf, 0x21, 0x1e, 0x92, 0x7a, 0x43, 0xa3, 0xb4, 0x53,
-	0x55, 0xf7, 0x53, 0xfc, 0x0c, 0x4c, 0x86, 0x08, 0xbd, 0xde, 0x20, 0x56, 0xd3, 0x2b, 0x41, 0x03,
-	0x0e, 0xe4, 0x5f, 0x39, 0x78, 0x26, 0x64, 0xdd, 0x2b, 0x6e, 0x0f, 0x6f, 0xbb, 0x0c, 0x23, 0x2d,
-	0xdb, 0xd4, 0x70, 0x66, 0x21, 0x57, 0xd2, 0x1c, 0xda, 0xa1, 0xb5, 0x2e, 0x86, 0x8a, 0xbe, 0x65,
-	0x54, 0x0f, 0x79, 0x74, 0xd0, 0xf3, 0x90, 0xd9, 0xa2, 0x81, 0x90, 0x6c, 0x5c, 0x77, 0xa8, 0x8f,
-	0x32, 0x0b, 0x47, 0xda, 0x6b, 0x3a, 0x41, 0xaa, 0xc2, 0x56, 0xfb, 0xdb, 0x0d, 0x38, 0xd9, 0x35,
-	0x55, 0x8b, 0xd8, 0x92, 0xaa, 0xb7, 0x02, 0xee, 0x49, 0x2a, 0xba, 0xf8, 0x0d, 0x0e, 0x0a, 0xa1,
-	0x1d, 0x6e, 0xaa, 0xba, 0xe2, 0x11, 0x70, 0x4f, 0xcb, 0x80, 0x23, 0xde, 0xed, 0x6e, 0x17, 0xdf,
-	0x8e, 0x5e, 0x82, 0x0d, 0xfd, 0x09, 0xe1, 0xf6, 0xd9, 0xd8, 0xf3, 0x4d, 0x2c, 0xad, 0x7d, 0x33,
-	0x0e, 0x38, 0x31, 0xff, 0x11, 0x1f, 0xb6, 0x0d, 0xa2, 0x99, 0x8f, 0x26, 0x3b, 0x4f, 0x02, 0x6f,
-	0x12, 0x4b, 0x93, 0xa2, 0x2c, 0xb2, 0x66, 0x60, 0x9f, 0x39, 0x48, 0xe9, 0x86, 0x2e, 0x13, 0xcf,
-	0x43, 0x6c, 0xe0, 0xcf, 0x3a, 0xec, 0x84, 0xb2, 0x6e, 0xc9, 0x41, 0xa7, 0xe0, 0x29, 0xa2, 0xcb,
-	0x56, 0xd3, 0x74, 0x88, 0x22, 0x69, 0xc4, 0xb6, 0x71, 0x8d, 0xd0, 0x36, 0xed, 0x70, 0x95, 0x6f,
-	0x4f, 0xbc, 0xcc, 0xe4, 0xe2, 0x57, 0x39, 0x98, 0x89, 0x1e, 0xc2, 0x40, 0x0b, 0xeb, 0x1e, 0x94,
-	0x87, 0xdc, 0x70, 0x09, 0x86, 0xdd, 0xe6, 0xcb, 0x3b, 0x8a, 0xbd, 0xda, 0x65, 0x8a, 0x8b, 0x4f,
-	0x81, 0x47, 0xd9, 0xf6, 0xf4, 0x4b, 0x81, 0xae, 0x04, 0x3a, 0xcd, 0xe7, 0x60, 0x09, 0xcc, 0x40,
-	0xe6, 0x55, 0xe2, 0x22, 0xa5, 0x15, 0x43, 0xaf, 0xb9, 0x89, 0xe0, 0x3a, 0xc6, 0xce, 0x73, 0xc5,
-	0xa4, 0x9b, 0x08, 0x74, 0x30, 0xf7, 0x9f, 0x34, 0x64, 0x03, 0x36, 0xd0, 0x53, 0x90, 0x5d, 0xae,
-	0x2e, 0x2f, 0x2e, 0x48, 0x67, 0x56, 0x5f, 0x5a, 0xfd, 0xd8, 0xc7, 0x57, 0xf9, 0x04, 0x2a, 0xc2,
-	0x11, 0x26, 0x0a, 0x3c, 0x61, 0xf8, 0xf3, 0x37, 0xde, 0xfd, 0xd3, 0x7f, 0x0f, 0x0e, 0x0e, 0x0e,
-	0x38, 0x34, 0x03, 0xe3, 0x0c, 0x11, 0x6e, 0xb0, 0xf9, 0xf3, 0xbf, 0xbb, 0xfc, 0xdb, 0x7b, 0x0c,
-	0x74, 0x0a, 0x66, 0x3a, 0xa0, 0xae, 0x8d, 0x33, 0x7f, 0xe9, 0x9d, 0xbd, 0x2f, 0x26, 0xd1, 0xfb,
-	0xe0, 0x58, 0x14, 0x1c, 0xed, 0x5d, 0xf9, 0xef, 0x5c, 0xbb, 0x77, 0xeb, 0x0e, 0xd3, 0x7f, 0x12,
-	0x84, 0x58, 0xfd, 0xb4, 0x7d, 0xe4, 0xbf, 0xff, 0xa3, 0x5f, 0x5d, 0xbc, 0xcb, 0x80, 0xc7, 0x61,
-	0xaa, 0x0b, 0x90, 0xb5, 0x7b, 0xfc, 0xcd, 0xb7, 0xfe, 0xf9, 0xfb, 0x21, 0x34, 0x03, 0x93, 0xb1,
-	0x30, 0x96, 0x6e, 0xfc, 0xaf, 0x7f, 0x70, 0xfd, 0xad, 0x74, 0x4f, 0x5d, 0x6e, 0x13, 0xc5, 0x5f,
-	0xbb, 0x75, 0xf3, 0x52, 0xaa, 0xab, 0x2e, 0xd6, 0xef, 0xf0, 0x3f, 0x7c, 0xf7, 0x9d, 0x5f, 0xa4,
-	0x50, 0x19, 0xc4, 0x58, 0x50, 0xa0, 0x33, 0xe1, 0x2f, 0xee, 0xef, 0x7d, 0xf3, 0x7f, 0x6c, 0x23,
-	0xf3, 0x50, 0x8c, 0x5d, 0xe0, 0x6b, 0x08, 0xf8, 0xaf, 0x9f, 0xbf, 0x70, 0xd9, 0x83, 0x9f, 0x80,
-	0x42, 0x14, 0xee, 0xbf, 0xae, 0xf9, 0xef, 0xdd, 0xfe, 0xcb, 0xb7, 0x53, 0xe8, 0x18, 0x3c, 0x1b,
-	0xc5, 0x75, 0x2e, 0x56, 0xfe, 0x2b, 0x6f, 0x5f, 0xfd, 0x77, 0x12, 0xcd, 0xc2, 0xd1, 0x28, 0x2a,
-	0x74, 0x01, 0xf0, 0xe7, 0xf6, 0x7e, 0xfe, 0x1a, 0x9a, 0x8b, 0x0b, 0x7c, 0xe4, 0xb2, 0xe0, 0xaf,
-	0x7c, 0xe9, 0xc6, 0x07, 0xbb, 0xfb, 0xc0, 0x5f, 0xd5, 0xf8, 0x3f, 0xff, 0xe6, 0x8f, 0x9f, 0xf3,
-	0x52, 0xef, 0xbd, 0xdd, 0x68, 0xf8, 0x8a, 0x2d, 0xff, 0x85, 0x6f, 0x5d, 0xf8, 0x7c, 0x1a, 0xcd,
-	0xc3, 0x89, 0xd8, 0x7d, 0x45, 0x6a, 0x15, 0xff, 0xb5, 0x83, 0xbf, 0x8f, 0xa3, 0xb9, 0x2e, 0x54,
-	0x82, 0x09, 0x78, 0xee, 0xa7, 0x77, 0xfe, 0x95, 0xee, 0x89, 0xed, 0x1c, 0x86, 0x9f, 0xec, 0xdf,
-	0xb9, 0x3b, 0x2c, 0x0c, 0xef, 0x9d, 0x2b, 0x24, 0x16, 0x2e, 0x66, 0x61, 0xac, 0xba, 0xb6, 0xbc,
-	0xd4, 0xc1, 0xa3, 0xd7, 0x61, 0xaa, 0xf7, 0x9b, 0x72, 0x3e, 0x54, 0x1b, 0x7a, 0x9f, 0x24, 0x61,
-	0xba, 0xdd, 0x7c, 0x60, 0x59, 0x36, 0x1a, 0xba, 0x23, 0x05, 0x01, 0x62, 0x02, 0xd5, 0xa1, 0xd0,
-	0xe7, 0x71, 0x58, 0xea, 0x65, 0x33, 0x8a, 0x17, 0xf2, 0x01, 0xbc, 0xaf, 0x02, 0x89, 0x09, 0xb4,
-	0x1a, 0xf8, 0x35, 0xd7, 0x79, 0xda, 0x1d, 0xeb, 0xb3, 0x2f, 0x8a, 0x12, 0xc6, 0xda, 0xdb, 0xa9,
-	0xe8, 0xce, 0xe2, 0x82, 0x98, 0x40, 0x67, 0x60, 0xa2, 0xdb, 0x8b, 0xed, 0x64, 0x5f, 0x95, 0x0c,
-	0x28, 0xbc, 0xa7, 0xad, 0x75, 0xdd, 0xb1, 0x54, 0x4a, 0xf3, 0x15, 0x78, 0x3a, 0xfe, 0x31, 0x76,
-	0xbc, 0x8f, 0x52, 0x06, 0x8b, 0x53, 0x19, 0xcf, 0x94, 0xbe, 0xb2, 0xee, 0x87, 0xa9, 0x0b, 0x8c,
-	0x53, 0xbb, 0x16, 0x61, 0xea, 0x3d, 0xa0, 0xfa, 0x31, 0x65, 0xb0, 0xa0, 0x4b, 0x3f, 0xf0, 0xbc,
-	0x98, 0x40, 0xaf, 0xc1, 0xb3, 0x3d, 0xdf, 0x45, 0xa7, 0xfb, 0x28, 0x0e, 0xa0, 0x63, 0xf4, 0x7f,
-	0x1a, 0x84, 0x1e, 0xaf, 0x97, 0xb9, 0x3e, 0xda, 0x7d, 0x58, 0x21, 0x17, 0xd3, 0x5a, 0xbb, 0x29,
-	0xfd, 0x29, 0xc8, 0x77, 0x7d, 0x8e, 0xcc, 0xf6, 0xd2, 0xef, 0x47, 0x0a, 0xb1, 0xcd, 0x3e, 0xcd,
-	0x8d, 0xf1, 0x2e, 0xcf, 0x8d, 0x13, 0xbd, 0x74, 0x77, 0x70, 0x42, 0xb6, 0xad, 0xf9, 0x05, 0xc3,
-	0xa8, 0x53, 0xc2, 0x93, 0xbd, 0xfa, 0xfb, 0x53, 0xbd, 0xf4, 0x86, 0xc0, 0x31, 0x0e, 0x97, 0x82,
-	0x45, 0x25, 0xda, 0xa3, 0xf7, 0x2c, 0x2a, 0x11, 0x78, 0x94, 0x7e, 0x4c, 0xc6, 0x04, 0x3a, 0xd0,
-	0xbe, 0x19, 0xe3, 0x47, 0xc7, 0x6c, 0xe0, 0x93, 0x51, 0xf7, 0xf8, 0xfb, 0xe8, 0xbe, 0xee, 0xf1,
-	0x81, 0xa3, 0xe4, 0x09, 0x14, 0xfb, 0x36, 0xae, 0xcf, 0xf5, 0x09, 0x6c, 0x64, 0x45, 0xd4, 0xcc,
-	0x56, 0xc4, 0x47, 0xc1, 0x22, 0x7b, 0xfa, 0x41, 0x0a, 0xbb, 0x30, 0x1e, 0xc8, 0xcd, 0xb6, 0x5c,
-	0x4c, 0x20, 0x2d, 0xd6, 0x4e, 0xa7, 0xe3, 0xbc, 0x1f, 0x3b, 0x6d, 0xb4, 0xd0, 0xfb, 0x6f, 0xa8,
-	0x98, 0x78, 0xe1, 0x95, 0xdb, 0xd7, 0x0b, 0xdc, 0x95, 0xfd, 0x02, 0x77, 0x75, 0xbf, 0xc0, 0xfd,
-	0x6d, 0xbf, 0xc0, 0x7d, 0xe2, 0xc3, 0x0e, 0xc1, 0x5a, 0xcd, 0xc2, 0x5a, 0x49, 0x35, 0xca, 0xad,
-	0xef, 0x79, 0x9b, 0x58, 0x67, 0x89, 0x55, 0xc6, 0xa6, 0x59, 0x76, 0x3f, 0x55, 0x99, 0x94, 0x7d,
-	0x9a, 0xfd, 0xdf, 0x9b, 0x69, 0xba, 0xb1, 0xc5, 0xff, 0x07, 0x00, 0x00, 0xff, 0xff, 0x85, 0xa5,
-	0xf1, 0x7d, 0xc4, 0x19, 0x00, 0x00,
+	// 1786 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0x5d, 0x88, 0x24, 0x57,
+	0x15, 0xee, 0x9a, 0xfe, 0x99, 0x99, 0xd3, 0x3b, 0x6d, 0xe5, 0x6e, 0x67, 0xb6, 0x52, 0xbb, 0xd3,
+	0xdb, 0x5b, 0xb3, 0x3f, 0xed, 0xee, 0x4e, 0x77, 0x9c, 0x8d, 0x3e, 0x88, 0x20, 0x9b, 0x09, 0x42,
+	0x9b, 0xc9, 0xb8, 0xe9, 0x9d, 0x8d, 0xa0, 0x92, 0xf2, 0x6e, 0xd5, 0x9d, 0xee, 0x62, 0xba, 0x7e,
+	0x52, 0x55, 0xbd, 0xd9, 0x8e, 0x0f, 0x42, 0x14, 0x9d, 0x07, 0x41, 0x44, 0x45, 0xf2, 0xa2, 0x0f,
+	0x13, 0xff, 0x88, 0xca, 0x9a, 0x17, 0x8d, 0x9a, 0x40, 0x20, 0x84, 0x88, 0x3f, 0x44, 0x10, 0x21,
+	0x20, 0x68, 0x26, 0x18, 0x31, 0x20, 0x06, 0xf1, 0x61, 0x5d, 0x09, 0x23, 0x75, 0x6f, 0x75, 0x77,
+	0xfd, 0x75, 0x75, 0xc2, 0xa6, 0xb3, 0xfb, 0xd6, 0xf7, 0xdc, 0xaf, 0xce, 0xf9, 0xee, 0x39, 0xe7,
+	0x9e, 0x7b, 0x4e, 0x43, 0x19, 0xf7, 0xdc, 0x8e, 0x43, 0x1c, 0x47, 0x33, 0x8d, 0xba, 0xdb, 0xad,
+	0x5b, 0xb6, 0xe9, 0x9a, 0xa8, 0x18, 0x90, 0x8a, 0x2b, 0x6d, 0xcd, 0xed, 0xf4, 0x2e, 0xd7, 0x15,
+	0x53, 0x6f, 0xb4, 0xcd, 0xb6, 0xd9, 0xa0, 0x98, 0xcb, 0xbd, 0x2d, 0xba, 0xa2, 0x0b, 0xfa, 0x8b,
+	0x7d, 0x2b, 0x56, 0xda, 0xa6, 0xd9, 0xee, 0x92, 0x11, 0xea, 0x51, 0x1b, 0x5b, 0x16, 0xb1, 0x1d,
+	0x7f, 0x5f, 0x74, 0x94, 0x0e, 0xd1, 0xb1, 0x67, 0x4c, 0x31, 0x6d, 0x22, 0xbb, 0x7d, 0x8b, 0x0c,
+	0xf6, 0xee, 0x1a, 0xed, 0xb9, 0x36, 0x36, 0x1c, 0xcb, 0xb4, 0x5d, 0x7f, 0xab, 0x3c, 0xda, 0x72,
+	0xfa, 0x86, 0xc2, 0xa4, 0xd2, 0xef, 0xb3, 0xb0, 0xb0, 0xd6, 0xd5, 0x88, 0xe1, 0x5e, 0x64, 0x6c,
+	0xd1, 0x09, 0x28, 0x59, 0x36, 0x51, 0x35, 0x05, 0xbb, 0x44, 0x36, 0xb0, 0x4e, 0x04, 0xae, 0xca,
+	0xd5, 0xe6, 0x5b, 0x0b, 0x43, 0xe9, 0x06, 0xd6, 0x09, 0xfa, 0x08, 0x14, 0x15, 0xd3, 0x70, 0x5c,
+	0xbb, 0xa7, 0xb8, 0xa6, 0x2d, 0xcc, 0x54, 0xb9, 0x5a, 0x69, 0x55, 0xac, 0x07, 0xbd, 0xb1, 0xb9,
+	0xbe, 0x36, 0x42, 0xb4, 0x82, 0x70, 0x54, 0x01, 0xea, 0x21, 0x79, 0x9b, 0xf4, 0x65, 0x4d, 0x15,
+	0xb2, 0x55, 0xae, 0x96, 0x6d, 0xcd, 0x7b, 0xa2, 0xfb, 0x49, 0xbf, 0xa9, 0xa2, 0x12, 0xcc, 0x68,
+	0x96, 0x90, 0xa3, 0x86, 0x67, 0x34, 0x0b, 0x95, 0x21, 0xdf, 0xc5, 0x7d, 0x62, 0x0b, 0xf9, 0x2a,
+	0x57, 0xcb, 0xb7, 0xd8, 0x02, 0xdd, 0x09, 0x05, 0x6c, 0x69, 0x9e, 0x82, 0x02, 0x13, 0x63, 0x4b,
+	0x6b, 0xaa, 0xe8, 0x18, 0x1c, 0x50, 0xc9, 0x15, 0x4d, 0x21, 0xb2, 0x6e, 0xaa, 0xa4, 0x2b, 0xcc,
+	0x52, 0x35, 0x45, 0x26, 0x7b, 0xc0, 0x13, 0x79, 0x87, 0x74, 0xfa, 0x8e, 0x4b, 0x74, 0xf9, 0x0a,
+	0xb1, 0x3d, 0xb2, 0xc2, 0x1c, 0x3b, 0x24, 0x93, 0x3e, 0xc4, 0x84, 0xe8, 0x28, 0x14, 0xb1, 0x65,
+	0x0d, 0x31, 0xf3, 0x14, 0x03, 0xd8, 0xb2, 0x06, 0x80, 0x1a, 0xf0, 0xbe, 0x9e, 0x2e, 0x36, 0xda,
+	0xb2, 0x62, 0xaa, 0x44, 0x00, 0x8a, 0xf2, 0xf5, 0xaf, 0x63, 0xa3, 0xbd, 0x66, 0xaa, 0x04, 0x1d,
+	0x86, 0x79, 0x0a, 0xb1, 0xb0, 0xb2, 0x2d, 0x14, 0x29, 0x64, 0xce, 0x13, 0x5c, 0xc0, 0xca, 0xf6,
+	0x70, 0x93, 0x7e, 0x7f, 0x60, 0xb4, 0x49, 0xbf, 0x2c, 0x43, 0xde, 0xb2, 0xcd, 0xab, 0x7d, 0x61,
+	0x81, 0x6e, 0xb0, 0x05, 0x5a, 0x84, 0x82, 0x85, 0x6d, 0xac, 0x3b, 0x42, 0x89, 0x8a, 0xfd, 0x95,
+	0x74, 0x1f, 0xf0, 0x9b, 0xeb, 0xb2, 0x12, 0x0a, 0xe9, 0xdd, 0x90, 0x57, 0xb1, 0x8b, 0x57, 0x69,
+	0x24, 0x8b, 0x91, 0x28, 0x85, 0xa2, 0xdf, 0x62, 0x40, 0xe9, 0x97, 0x33, 0xc0, 0x9f, 0x67, 0xd1,
+	0xb8, 0xe8, 0x62, 0x97, 0xdc, 0x87, 0x5d, 0x7c, 0x7b, 0x64, 0xc6, 0x21, 0x98, 0xed, 0x39, 0xc4,
+	0xf6, 0xf6, 0x72, 0x74, 0xaf, 0xe0, 0x2d, 0x9b, 0xaa, 0xe7, 0x43, 0xef, 0x1b, 0xc7, 0xa3, 0xeb,
+	0xa7, 0xc9, 0xdc, 0xb6, 0x4f, 0x7f, 0x94, 0x3f, 0x85, 0x60, 0xfe, 0x1c, 0x85, 0x22, 0x73, 0x14,
+	0xbd, 0x43, 0x34, 0x4f, 0xf2, 0x2d, 0x60, 0xa2, 0xcd, 0xbe, 0x45, 0xd0, 0x07, 0xe1, 0x10, 0x36,
+	0x54, 0xdb, 0xd4, 0x54, 0xd9, 0xea, 0x39, 0x1d, 0xd9, 0xe7, 0xef, 0x19, 0x9f, 0xa3, 0xc6, 0xcb,
+	0xfe, 0xf6, 0x85, 0x9e, 0xd3, 0xf1, 0x5d, 0xd8, 0x54, 0xa5, 0x8f, 0xc3, 0xc1, 0xcd, 0x75, 0x19,
+	0x47, 0xfd, 0x77, 0x2e, 0x1c, 0x86, 0xa5, 0x90, 0x4b, 0xa2, 0xde, 0x1e, 0x44, 0xe2, 0xfb, 0x1c,
+	0x54, 0x7d, 0x65, 0x03, 0xeb, 0x6d, 0xe2, 0x7a, 0x68, 0xd3, 0xd6, 0x1e, 0xc3, 0xae, 0x66, 0x1a,
+	0x4e, 0xd4, 0xe5, 0xdc, 0x3b, 0x73, 0x79, 0xc0, 0xa5, 0xd9, 0x90, 0x4b, 0xcf, 0x02, 0x22, 0x57,
+	0x95, 0x6e, 0x4f, 0x25, 0xf2, 0x20, 0x26, 0xcd, 0x81, 0xdb, 0x79, 0x7f, 0xe7, 0xfc, 0x20, 0x32,
+	0xd2, 0x4f, 0x38, 0x38, 0x16, 0x61, 0x6a, 0x13, 0x27, 0xc2, 0x75, 0x5a, 0x54, 0x23, 0x69, 0x93,
+	0x8b, 0xa6, 0x0d, 0x82, 0x5c, 0x07, 0x3b, 0x1d, 0x9a, 0x18, 0xd9, 0x16, 0xfd, 0x2d, 0x3d, 0x0a,
+	0x87, 0xe2, 0x9e, 0x5d, 0xa7, 0x99, 0x71, 0x73, 0x2c, 0x27, 0xe4, 0xb0, 0xf4, 0x18, 0x88, 0x49,
+	0x86, 0xfd, 0x62, 0x30, 0x5d, 0xdb, 0x57, 0x41, 0x88, 0xdb, 0x66, 0x35, 0xe0, 0x56, 0x9d, 0x9a,
+	0x56, 0xb9, 0x5b, 0x70, 0xea, 0x4b, 0x2c, 0x75, 0xa6, 0x6b, 0xf9, 0x67, 0x1c, 0x1c, 0x8d, 0x9b,
+	0x0e, 0xd5, 0x8b, 0x5b, 0x75, 0x27, 0x96, 0x00, 0x5c, 0x73, 0x9b, 0x18, 0xac, 0xfa, 0xb1, 0x92,
+	0x39, 0x4f, 0x25, 0x5e, 0xf1, 0x93, 0xbe, 0xca, 0xc1, 0x52, 0x9c, 0xf9, 0xc7, 0x7a, 0x6e, 0xcf,
+	0x26, 0x17, 0x71, 0xd7, 0x75, 0xa6, 0xeb, 0x39, 0xc4, 0x43, 0xd6, 0xe8, 0xe9, 0x94, 0x76, 0xbe,
+	0xe5, 0xfd, 0x94, 0x3e, 0x07, 0x87, 0x23, 0x84, 0x1e, 0xe9, 0x11, 0xbb, 0xef, 0x97, 0xa0, 0x29,
+	0x07, 0xf2, 0x2f, 0x1c, 0xdc, 0x15, 0xb1, 0xee, 0x17, 0xb7, 0x9b, 0xb7, 0xdd, 0x80, 0xb9, 0x81,
+	0x6d, 0x6a, 0xb8, 0xb8, 0x5a, 0xae, 0xeb, 0x2e, 0xed, 0xd0, 0x06, 0x0f, 0x43, 0xd3, 0xd8, 0x32,
+	0x5b, 0xb3, 0x3e, 0x1d, 0x74, 0x0f, 0x14, 0xb7, 0x68, 0x20, 0x64, 0x07, 0x77, 0x5d, 0xea, 0xa3,
+	0xe2, 0xea, 0xc1, 0xe1, 0x37, 0xa3, 0x20, 0xb5, 0x60, 0x6b, 0xf8, 0xdb, 0x0b, 0x38, 0xb9, 0x6a,
+	0x69, 0x36, 0x71, 0x64, 0xcd, 0x18, 0x04, 0xdc, 0x97, 0x34, 0x0d, 0xe9, 0x5b, 0x1c, 0x54, 0x22,
+	0x27, 0xbc, 0xac, 0x19, 0xaa, 0x4f, 0xc0, 0xbb, 0x2d, 0x53, 0x8e, 0xf8, 0xb8, 0xb7, 0x5d, 0x7a,
+	0x22, 0xfe, 0x08, 0xf6, 0x8c, 0xdb, 0x84, 0xdb, 0xe7, 0x13, 0xef, 0x37, 0xb1, 0xf5, 0xe1, 0xcb,
+	0x38, 0xe5, 0xc4, 0xfc, 0x47, 0x72, 0xd8, 0x36, 0x89, 0x6e, 0xbd, 0x3b, 0xd9, 0x79, 0x0a, 0x78,
+	0x8b, 0xd8, 0xba, 0x1c, 0x67, 0xb1, 0x60, 0x85, 0xce, 0x59, 0x86, 0xbc, 0x61, 0x1a, 0x0a, 0xf1,
+	0x3d, 0xc4, 0x16, 0xc1, 0xac, 0xc3, 0x6e, 0x24, 0xeb, 0xce, 0xbb, 0xe8, 0x0c, 0xdc, 0x41, 0x0c,
+	0xc5, 0xee, 0x5b, 0x2e, 0x51, 0x65, 0x9d, 0x38, 0x0e, 0x6e, 0x13, 0xda, 0xa6, 0x1d, 0x68, 0xf1,
+	0xc3, 0x8d, 0x07, 0x98, 0x5c, 0x7a, 0x3a, 0x5e, 0x4d, 0x55, 0xdb, 0xb4, 0x02, 0x67, 0x75, 0xde,
+	0xab, 0xc3, 0xd6, 0xc0, 0x6b, 0x81, 0x88, 0xe5, 0x0e, 0xa1, 0x8e, 0x90, 0xab, 0x66, 0x6b, 0xd9,
+	0x56, 0x89, 0xc9, 0x07, 0x84, 0xa4, 0xaf, 0x73, 0xb0, 0x1c, 0xaf, 0x1c, 0xa1, 0xbe, 0xdb, 0xbb,
+	0xdd, 0x37, 0x49, 0xbc, 0x0e, 0x39, 0xaf, 0x63, 0xf4, 0xeb, 0x47, 0x5a, 0x8f, 0x4f, 0x71, 0xc9,
+	0x79, 0xfb, 0x6e, 0xf6, 0x6a, 0x93, 0xf2, 0x76, 0x2c, 0x81, 0x51, 0xc7, 0x3c, 0x5d, 0x02, 0xcb,
+	0x50, 0x7c, 0x88, 0x78, 0x48, 0x79, 0xdd, 0x34, 0xda, 0x5e, 0xf6, 0x7a, 0x8e, 0x71, 0x04, 0x8e,
+	0x46, 0x91, 0x2d, 0x4e, 0xef, 0xce, 0xc2, 0x42, 0xc8, 0x06, 0xba, 0x03, 0x16, 0xd6, 0x5a, 0x6b,
+	0xe7, 0x56, 0xe5, 0x4b, 0x1b, 0xf7, 0x6f, 0x7c, 0xe2, 0x93, 0x1b, 0x7c, 0x06, 0x55, 0xe1, 0x20,
+	0x13, 0x85, 0xe6, 0x2e, 0xfe, 0xda, 0x6b, 0xcf, 0xfc, 0xf1, 0xbf, 0xfb, 0xfb, 0xfb, 0xfb, 0x1c,
+	0x5a, 0x86, 0x45, 0x86, 0x88, 0x4e, 0x05, 0xfc, 0xb5, 0xdf, 0xbe, 0xf8, 0x9b, 0xb7, 0x18, 0xe8,
+	0x0c, 0x2c, 0x8f, 0x40, 0x63, 0xbb, 0x7d, 0xfe, 0x85, 0x27, 0x77, 0xbe, 0x9c, 0x45, 0x1f, 0x80,
+	0xe3, 0x71, 0x70, 0xbc, 0xe1, 0xe6, 0xbf, 0xf7, 0xca, 0x5b, 0x6f, 0x5c, 0x67, 0xfa, 0x4f, 0x81,
+	0x98, 0xa8, 0x9f, 0xf6, 0xbc, 0xfc, 0x0f, 0x7f, 0xfc, 0xab, 0xe7, 0x6f, 0x30, 0xe0, 0x09, 0x58,
+	0x1a, 0x03, 0x64, 0x3d, 0x2a, 0xff, 0xfa, 0xe3, 0xff, 0xfa, 0xdd, 0x0c, 0x5a, 0x86, 0xc3, 0x89,
+	0x30, 0x96, 0x6e, 0xfc, 0x73, 0x4f, 0xbd, 0xfa, 0x78, 0x21, 0x55, 0x97, 0xd7, 0xf9, 0xf1, 0xaf,
+	0xbc, 0xf1, 0xfa, 0x0b, 0xf9, 0xb1, 0xba, 0x58, 0x93, 0xc6, 0xff, 0xe8, 0x99, 0x27, 0x7f, 0x91,
+	0x47, 0x0d, 0x90, 0x12, 0x41, 0xa1, 0x76, 0x8a, 0x7f, 0x7e, 0x6f, 0xe7, 0xdb, 0xff, 0x63, 0x07,
+	0x59, 0x81, 0x6a, 0xe2, 0x07, 0x81, 0x2e, 0x86, 0xff, 0xe6, 0xb5, 0x67, 0x5f, 0xf4, 0xe1, 0x27,
+	0xa1, 0x12, 0x87, 0x07, 0x7b, 0x0c, 0xfe, 0x07, 0x6f, 0xfe, 0xf9, 0xbb, 0x79, 0x74, 0x1c, 0x8e,
+	0xc4, 0x71, 0xa3, 0x6e, 0x80, 0xff, 0xda, 0x13, 0x2f, 0xff, 0x27, 0x8b, 0x6a, 0x70, 0x2c, 0x8e,
+	0x8a, 0xbc, 0x5a, 0xfc, 0xee, 0xce, 0xcf, 0x1f, 0x46, 0xa7, 0x93, 0x02, 0x1f, 0x7b, 0xe1, 0xf8,
+	0x97, 0xbe, 0xf2, 0xda, 0x87, 0xc7, 0xfb, 0x20, 0x58, 0x9d, 0xf8, 0x3f, 0xfd, 0xfa, 0x0f, 0x5f,
+	0xf0, 0x53, 0xef, 0xfd, 0xe3, 0x68, 0x04, 0xaa, 0x26, 0xff, 0xa5, 0xef, 0x3c, 0xfb, 0xc5, 0x02,
+	0x3a, 0x9d, 0xa4, 0x3b, 0x5a, 0x60, 0xf9, 0x1b, 0x7f, 0xfb, 0xe7, 0x73, 0xb3, 0x68, 0x05, 0x4e,
+	0x26, 0xfa, 0x20, 0x56, 0xd7, 0xf8, 0x6f, 0xec, 0xff, 0x7d, 0x31, 0x59, 0x75, 0x34, 0xb7, 0xf9,
+	0xdd, 0xa7, 0xaf, 0xff, 0xbb, 0x90, 0x8a, 0x1d, 0x5d, 0x9c, 0x9f, 0xee, 0x5d, 0xbf, 0x91, 0x13,
+	0x73, 0x3b, 0xbb, 0x95, 0xcc, 0xea, 0x53, 0x25, 0x28, 0xb5, 0x2e, 0xac, 0x9d, 0x1f, 0xe1, 0xd1,
+	0x23, 0xb0, 0x94, 0x3e, 0x34, 0xaf, 0x44, 0xea, 0x48, 0xfa, 0xad, 0x13, 0x8f, 0x0e, 0xbb, 0x2b,
+	0xac, 0x28, 0x66, 0xcf, 0x70, 0xe5, 0x30, 0x40, 0xca, 0xa0, 0x2e, 0x54, 0x26, 0x4c, 0xbf, 0xf5,
+	0x34, 0x9b, 0x71, 0xbc, 0x28, 0x84, 0xf0, 0x81, 0x6a, 0x25, 0x65, 0xd0, 0x06, 0x94, 0x23, 0x8c,
+	0xd9, 0xec, 0x7a, 0x7c, 0xc2, 0xb9, 0x28, 0x4a, 0x2c, 0x0d, 0x8f, 0xd3, 0x34, 0xdc, 0x73, 0xab,
+	0x52, 0x06, 0x5d, 0x82, 0x43, 0xe3, 0x46, 0xd2, 0x53, 0x13, 0x55, 0x32, 0xa0, 0xf8, 0xbe, 0xa1,
+	0xd6, 0x8b, 0xae, 0xad, 0x51, 0x9a, 0x0f, 0xc2, 0x9d, 0xc9, 0xd3, 0xe6, 0x89, 0x09, 0x4a, 0x19,
+	0x2c, 0x49, 0x65, 0x32, 0x53, 0x3a, 0x46, 0xbe, 0x1d, 0xa6, 0x1e, 0x30, 0x49, 0xed, 0x85, 0x18,
+	0x53, 0x7f, 0x42, 0x9c, 0xc4, 0x94, 0xc1, 0xc2, 0x2e, 0xfd, 0xd0, 0x3d, 0x52, 0x06, 0x3d, 0x0c,
+	0x47, 0x52, 0x07, 0xbf, 0xb3, 0x13, 0x14, 0x87, 0xd0, 0x09, 0xfa, 0x3f, 0x0b, 0x62, 0xca, 0x78,
+	0x76, 0x7a, 0x82, 0xf6, 0x00, 0x56, 0x2c, 0x27, 0xcc, 0x0e, 0x5e, 0x4a, 0x7f, 0x06, 0x84, 0xb1,
+	0xf3, 0x56, 0x2d, 0x4d, 0x7f, 0x10, 0x29, 0x26, 0x4e, 0x33, 0x34, 0x37, 0x16, 0xc7, 0xcc, 0x53,
+	0x27, 0xd3, 0x74, 0x8f, 0x70, 0xe2, 0xc2, 0x50, 0xf3, 0xbd, 0xa6, 0xd9, 0xa5, 0x84, 0x0f, 0xa7,
+	0x0d, 0x30, 0x67, 0xd2, 0xf4, 0x46, 0xc0, 0x09, 0x0e, 0x97, 0x61, 0x29, 0xb5, 0x44, 0xa7, 0x17,
+	0x95, 0x18, 0x3c, 0x4e, 0x3f, 0x21, 0x63, 0x42, 0x5d, 0xe7, 0xc4, 0x8c, 0x09, 0xa2, 0x13, 0x0e,
+	0xf0, 0xe9, 0xb8, 0x7b, 0x82, 0x83, 0xc2, 0x44, 0xf7, 0x04, 0xc0, 0x71, 0xf2, 0x1d, 0x38, 0x92,
+	0xf6, 0x70, 0xa4, 0x93, 0x8f, 0xa2, 0x53, 0x6b, 0x1f, 0x81, 0xea, 0xc4, 0x76, 0xfa, 0xee, 0x09,
+	0x29, 0x14, 0xfb, 0x22, 0x7e, 0xa0, 0xad, 0x58, 0x34, 0xc2, 0xe5, 0xfc, 0xec, 0x3b, 0x79, 0x42,
+	0xc4, 0xc5, 0xd0, 0x2d, 0x18, 0xca, 0xa5, 0x0c, 0xd2, 0xe1, 0x48, 0xda, 0x53, 0xf7, 0xb6, 0xec,
+	0x0c, 0xd1, 0x62, 0xfa, 0x1f, 0xcb, 0x52, 0xe6, 0xde, 0x07, 0xdf, 0x7c, 0xb5, 0xc2, 0xbd, 0xb4,
+	0x57, 0xe1, 0x5e, 0xde, 0xab, 0x70, 0x7f, 0xdd, 0xab, 0x70, 0x9f, 0xfa, 0xa8, 0x4b, 0xb0, 0xde,
+	0xb6, 0xb1, 0x5e, 0xd7, 0xcc, 0xc6, 0xe0, 0xf7, 0x8a, 0x43, 0xec, 0x2b, 0xc4, 0x6e, 0x60, 0xcb,
+	0x6a, 0x78, 0x3f, 0x35, 0x85, 0x34, 0x02, 0x9a, 0x83, 0xbf, 0x2f, 0x17, 0xe8, 0xc1, 0xce, 0xfd,
+	0x7f, 0x00, 0xdc, 0x7d, 0x20, 0x1d, 0x0f, 0x1b, 0x00, 0x00,
 }
 
 func (this *ClientSession) GoString() string {
@@ -2099,6 +2171,21 @@ func (this *TLAuthsessionBindTempAuthKey) GoString() string {
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
+func (this *TLAuthsessionDropTempAuthKeys) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&authsession.TLAuthsessionDropTempAuthKeys{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "PermAuthKeyId: "+fmt.Sprintf("%#v", this.PermAuthKeyId)+",\n")
+	s = append(s, "ExceptAuthKeys: "+fmt.Sprintf("%#v", this.ExceptAuthKeys)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
 func (this *TLAuthsessionSetClientSessionInfo) GoString() string {
 	if this == nil {
 		return "nil"
@@ -2207,6 +2294,8 @@ type RPCAuthsessionClient interface {
 	AuthsessionGetPermAuthKeyId(ctx context.Context, in *TLAuthsessionGetPermAuthKeyId, opts ...grpc.CallOption) (*mtproto.Int64, error)
 	// authsession.bindTempAuthKey perm_auth_key_id:long nonce:long expires_at:int encrypted_message:bytes = Bool;
 	AuthsessionBindTempAuthKey(ctx context.Context, in *TLAuthsessionBindTempAuthKey, opts ...grpc.CallOption) (*mtproto.Bool, error)
+	// authsession.dropTempAuthKeys perm_auth_key_id:long except_auth_keys:Vector<long> = Vector<long>;
+	AuthsessionDropTempAuthKeys(ctx context.Context, in *TLAuthsessionDropTempAuthKeys, opts ...grpc.CallOption) (*Vector_Long, error)
 	// authsession.setClientSessionInfo data:ClientSession = Bool;
 	AuthsessionSetClientSessionInfo(ctx context.Context, in *TLAuthsessionSetClientSessionInfo, opts ...grpc.CallOption) (*mtproto.Bool, error)
 	// authsession.getAuthorization auth_key_id:long = Authorization;
@@ -2358,6 +2447,15 @@ func (c *rPCAuthsessionClient) AuthsessionBindTempAuthKey(ctx context.Context, i
 	return out, nil
 }
 
+func (c *rPCAuthsessionClient) AuthsessionDropTempAuthKeys(ctx context.Context, in *TLAuthsessionDropTempAuthKeys, opts ...grpc.CallOption) (*Vector_Long, error) {
+	out := new(Vector_Long)
+	err := c.cc.Invoke(ctx, "/authsession.RPCAuthsession/authsession_dropTempAuthKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *rPCAuthsessionClient) AuthsessionSetClientSessionInfo(ctx context.Context, in *TLAuthsessionSetClientSessionInfo, opts ...grpc.CallOption) (*mtproto.Bool, error) {
 	out := new(mtproto.Bool)
 	err := c.cc.Invoke(ctx, "/authsession.RPCAuthsession/authsession_setClientSessionInfo", in, out, opts...)
@@ -2417,6 +2515,8 @@ type RPCAuthsessionServer interface {
 	AuthsessionGetPermAuthKeyId(context.Context, *TLAuthsessionGetPermAuthKeyId) (*mtproto.Int64, error)
 	// authsession.bindTempAuthKey perm_auth_key_id:long nonce:long expires_at:int encrypted_message:bytes = Bool;
 	AuthsessionBindTempAuthKey(context.Context, *TLAuthsessionBindTempAuthKey) (*mtproto.Bool, error)
+	// authsession.dropTempAuthKeys perm_auth_key_id:long except_auth_keys:Vector<long> = Vector<long>;
+	AuthsessionDropTempAuthKeys(context.Context, *TLAuthsessionDropTempAuthKeys) (*Vector_Long, error)
 	// authsession.setClientSessionInfo data:ClientSession = Bool;
 	AuthsessionSetClientSessionInfo(context.Context, *TLAuthsessionSetClientSessionInfo) (*mtproto.Bool, error)
 	// authsession.getAuthorization auth_key_id:long = Authorization;
@@ -2474,6 +2574,9 @@ func (*UnimplementedRPCAuthsessionServer) AuthsessionGetPermAuthKeyId(ctx contex
 func (*UnimplementedRPCAuthsessionServer) AuthsessionBindTempAuthKey(ctx context.Context, req *TLAuthsessionBindTempAuthKey) (*mtproto.Bool, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AuthsessionBindTempAuthKey not implemented")
 }
+func (*UnimplementedRPCAuthsessionServer) AuthsessionDropTempAuthKeys(ctx context.Context, req *TLAuthsessionDropTempAuthKeys) (*Vector_Long, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuthsessionDropTempAuthKeys not implemented")
+}
 func (*UnimplementedRPCAuthsessionServer) AuthsessionSetClientSessionInfo(ctx context.Context, req *TLAuthsessionSetClientSessionInfo) (*mtproto.Bool, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AuthsessionSetClientSessionInfo not implemented")
 }
@@ -2758,6 +2861,24 @@ func _RPCAuthsession_AuthsessionBindTempAuthKey_Handler(srv interface{}, ctx con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RPCAuthsession_AuthsessionDropTempAuthKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLAuthsessionDropTempAuthKeys)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCAuthsessionServer).AuthsessionDropTempAuthKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/authsession.RPCAuthsession/AuthsessionDropTempAuthKeys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCAuthsessionServer).AuthsessionDropTempAuthKeys(ctx, req.(*TLAuthsessionDropTempAuthKeys))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _RPCAuthsession_AuthsessionSetClientSessionInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(TLAuthsessionSetClientSessionInfo)
 	if err := dec(in); err != nil {
@@ -2876,6 +2997,10 @@ var _RPCAuthsession_serviceDesc = grpc.ServiceDesc{
 			MethodName: "authsession_bindTempAuthKey",
 			Handler:    _RPCAuthsession_AuthsessionBindTempAuthKey_Handler,
 		},
+		{
+			MethodName: "authsession_dropTempAuthKeys",
+			Handler:    _RPCAuthsession_AuthsessionDropTempAuthKeys_Handler,
+		},
 		{
 			MethodName: "authsession_setClientSessionInfo",
 			Handler:    _RPCAuthsession_AuthsessionSetClientSessionInfo_Handler,
@@ -3793,6 +3918,62 @@ func (m *TLAuthsessionBindTempAuthKey) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
+func (m *TLAuthsessionDropTempAuthKeys) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLAuthsessionDropTempAuthKeys) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLAuthsessionDropTempAuthKeys) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.ExceptAuthKeys) > 0 {
+		dAtA6 := make([]byte, len(m.ExceptAuthKeys)*10)
+		var j5 int
+		for _, num1 := range m.ExceptAuthKeys {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j5++
+			}
+			dAtA6[j5] = uint8(num)
+			j5++
+		}
+		i -= j5
+		copy(dAtA[i:], dAtA6[:j5])
+		i = encodeVarintAuthsessionTl(dAtA, i, uint64(j5))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.PermAuthKeyId != 0 {
+		i = encodeVarintAuthsessionTl(dAtA, i, uint64(m.PermAuthKeyId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintAuthsessionTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *TLAuthsessionSetClientSessionInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3936,21 +4117,21 @@ func (m *Vector_Long) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if len(m.Datas) > 0 {
-		dAtA7 := make([]byte, len(m.Datas)*10)
-		var j6 int
+		dAtA9 := make([]byte, len(m.Datas)*10)
+		var j8 int
 		for _, num1 := range m.Datas {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA7[j6] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA9[j8] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j6++
+				j8++
 			}
-			dAtA7[j6] = uint8(num)
-			j6++
+			dAtA9[j8] = uint8(num)
+			j8++
 		}
-		i -= j6
-		copy(dAtA[i:], dAtA7[:j6])
-		i = encodeVarintAuthsessionTl(dAtA, i, uint64(j6))
+		i -= j8
+		copy(dAtA[i:], dAtA9[:j8])
+		i = encodeVarintAuthsessionTl(dAtA, i, uint64(j8))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -4413,6 +4594,31 @@ func (m *TLAuthsessionBindTempAuthKey) Size() (n int) {
 	return n
 }
 
+func (m *TLAuthsessionDropTempAuthKeys) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovAuthsessionTl(uint64(m.Constructor))
+	}
+	if m.PermAuthKeyId != 0 {
+		n += 1 + sovAuthsessionTl(uint64(m.PermAuthKeyId))
+	}
+	if len(m.ExceptAuthKeys) > 0 {
+		l = 0
+		for _, e := range m.ExceptAuthKeys {
+			l += sovAuthsessionTl(uint64(e))
+		}
+		n += 1 + sovAuthsessionTl(uint64(l)) + l
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *TLAuthsessionSetClientSessionInfo) Size() (n int) {
 	if m == nil {
 		return 0
@@ -6961,6 +7167,171 @@ func (m *TLAuthsessionBindTempAuthKey) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *TLAuthsessionDropTempAuthKeys) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthsessionTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_authsession_dropTempAuthKeys: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_authsession_dropTempAuthKeys: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthsessionTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PermAuthKeyId", wireType)
+			}
+			m.PermAuthKeyId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthsessionTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PermAuthKeyId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAuthsessionTl
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ExceptAuthKeys = append(m.ExceptAuthKeys, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAuthsessionTl
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthAuthsessionTl
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthAuthsessionTl
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ExceptAuthKeys) == 0 {
+					m.ExceptAuthKeys = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAuthsessionTl
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ExceptAuthKeys = append(m.ExceptAuthKeys, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExceptAuthKeys", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthsessionTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthsessionTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *TLAuthsessionSetClientSessionInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
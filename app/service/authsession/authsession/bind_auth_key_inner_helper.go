@@ -0,0 +1,52 @@
+// Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+//  All rights reserved.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package authsession
+
+import (
+	"encoding/binary"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/proto/mtproto/crypto"
+)
+
+// DecodeBindAuthKeyInner decrypts the encrypted_message of auth.bindTempAuthKey with the perm key.
+//
+// encrypted_message is an MTProto v1 message: perm_auth_key_id, msg_key and the encrypted
+// random:int128 msg_id:long seqno:int length:int bind_auth_key_inner, msg_id must be the
+// msg_id of the auth.bindTempAuthKey request and seqno 0.
+func DecodeBindAuthKeyInner(permKey *crypto.AuthKey, msgId int64, encryptedMessage []byte) (*mtproto.TLBindAuthKeyInner, error) {
+	if len(encryptedMessage) < 8+16+32 || (len(encryptedMessage)-8-16)%16 != 0 {
+		return nil, mtproto.ErrEncryptedMessageInvalid
+	}
+
+	if int64(binary.LittleEndian.Uint64(encryptedMessage)) != permKey.AuthKeyId() {
+		return nil, mtproto.ErrEncryptedMessageInvalid
+	}
+
+	// msg_key is checked by AesIgeDecryptV1
+	x, err := permKey.AesIgeDecryptV1(encryptedMessage[8:8+16], encryptedMessage[8+16:])
+	if err != nil {
+		return nil, mtproto.ErrEncryptedMessageInvalid
+	}
+
+	if int64(binary.LittleEndian.Uint64(x[16:])) != msgId ||
+		binary.LittleEndian.Uint32(x[24:]) != 0 {
+		return nil, mtproto.ErrEncryptedMessageInvalid
+	}
+
+	dBuf := mtproto.NewDecodeBuf(x[32 : 32+binary.LittleEndian.Uint32(x[28:])])
+	o := dBuf.Object()
+	if dBuf.GetError() != nil {
+		return nil, mtproto.ErrEncryptedMessageInvalid
+	}
+	inner, ok := o.(*mtproto.TLBindAuthKeyInner)
+	if !ok {
+		return nil, mtproto.ErrEncryptedMessageInvalid
+	}
+
+	return inner, nil
+}
@@ -0,0 +1,86 @@
+// Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+//  All rights reserved.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package authsession
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/proto/mtproto/crypto"
+)
+
+func makeBindEncryptedMessage(t *testing.T, key *crypto.AuthKey, msgId int64, seqNo int32, inner *mtproto.TLBindAuthKeyInner) []byte {
+	body := inner.Encode(0)
+
+	x := mtproto.NewEncodeBuf(512)
+	x.Bytes(crypto.GenerateNonce(16))
+	x.Long(msgId)
+	x.Int(seqNo)
+	x.Int(int32(len(body)))
+	x.Bytes(body)
+
+	msgKey, data, err := key.AesIgeEncryptV1(x.GetBuf())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	buf := make([]byte, 8, 8+len(msgKey)+len(data))
+	binary.LittleEndian.PutUint64(buf, uint64(key.AuthKeyId()))
+	buf = append(buf, msgKey...)
+	return append(buf, data...)
+}
+
+func TestDecodeBindAuthKeyInner(t *testing.T) {
+	keyData := crypto.GenerateNonce(256)
+	clientKey := crypto.NewClientAuthKey(0, keyData)
+	serverKey := crypto.NewAuthKey(clientKey.AuthKeyId(), keyData)
+
+	inner := mtproto.MakeTLBindAuthKeyInner(&mtproto.BindAuthKeyInner{
+		Nonce:         1,
+		TempAuthKeyId: 2,
+		PermAuthKeyId: clientKey.AuthKeyId(),
+		TempSessionId: 3,
+		ExpiresAt:     4,
+	})
+
+	encrypted := makeBindEncryptedMessage(t, clientKey, 100, 0, inner)
+	r, err := DecodeBindAuthKeyInner(serverKey, 100, encrypted)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.GetNonce() != 1 || r.GetTempAuthKeyId() != 2 || r.GetPermAuthKeyId() != clientKey.AuthKeyId() ||
+		r.GetTempSessionId() != 3 || r.GetExpiresAt() != 4 {
+		t.Errorf("inner: %v", r)
+	}
+
+	// msg_id of another request
+	if _, err = DecodeBindAuthKeyInner(serverKey, 101, encrypted); err != mtproto.ErrEncryptedMessageInvalid {
+		t.Errorf("msg_id: %v", err)
+	}
+
+	// seqno must be 0
+	if _, err = DecodeBindAuthKeyInner(serverKey, 100, makeBindEncryptedMessage(t, clientKey, 100, 1, inner)); err != mtproto.ErrEncryptedMessageInvalid {
+		t.Errorf("seqno: %v", err)
+	}
+
+	// encrypted with another key
+	otherKey := crypto.NewAuthKey(clientKey.AuthKeyId(), crypto.GenerateNonce(256))
+	if _, err = DecodeBindAuthKeyInner(otherKey, 100, encrypted); err != mtproto.ErrEncryptedMessageInvalid {
+		t.Errorf("key: %v", err)
+	}
+
+	// tampered msg_key
+	encrypted[8] ^= 0xff
+	if _, err = DecodeBindAuthKeyInner(serverKey, 100, encrypted); err != mtproto.ErrEncryptedMessageInvalid {
+		t.Errorf("msg_key: %v", err)
+	}
+
+	if _, err = DecodeBindAuthKeyInner(serverKey, 100, encrypted[:40]); err != mtproto.ErrEncryptedMessageInvalid {
+		t.Errorf("short: %v", err)
+	}
+}
@@ -28,6 +28,7 @@ const (
 	Predicate_authsession_unbindAuthKeyUser    = "authsession_unbindAuthKeyUser"
 	Predicate_authsession_getPermAuthKeyId     = "authsession_getPermAuthKeyId"
 	Predicate_authsession_bindTempAuthKey      = "authsession_bindTempAuthKey"
+	Predicate_authsession_dropTempAuthKeys     = "authsession_dropTempAuthKeys"
 	Predicate_authsession_setClientSessionInfo = "authsession_setClientSessionInfo"
 	Predicate_authsession_getAuthorization     = "authsession_getAuthorization"
 	Predicate_authsession_getAuthStateData     = "authsession_getAuthStateData"
@@ -101,6 +102,10 @@ var clazzNameRegisters2 = map[string]map[int]int32{
 	Predicate_authsession_bindTempAuthKey: {
 		0: 1620004742, // 0x608f4f86
 
+	},
+	Predicate_authsession_dropTempAuthKeys: {
+		0: 2010870522, // 0x77db72fa
+
 	},
 	Predicate_authsession_setClientSessionInfo: {
 		0: 47841172, // 0x2d9ff94
@@ -134,6 +139,7 @@ var clazzIdNameRegisters2 = map[int32]string{
 	123258440:   Predicate_authsession_unbindAuthKeyUser,    // 0x758c648
 	-1871420202: Predicate_authsession_getPermAuthKeyId,     // 0x907464d6
 	1620004742:  Predicate_authsession_bindTempAuthKey,      // 0x608f4f86
+	2010870522:  Predicate_authsession_dropTempAuthKeys,     // 0x77db72fa
 	47841172:    Predicate_authsession_setClientSessionInfo, // 0x2d9ff94
 	1851660579:  Predicate_authsession_getAuthorization,     // 0x6e5e1923
 	1331573041:  Predicate_authsession_getAuthStateData,     // 0x4f5e3131
@@ -117,6 +117,11 @@ var clazzIdRegisters2 = map[int32]func() mtproto.TLObject{
 			Constructor: 1620004742,
 		}
 	},
+	2010870522: func() mtproto.TLObject { // 0x77db72fa
+		return &TLAuthsessionDropTempAuthKeys{
+			Constructor: 2010870522,
+		}
+	},
 	47841172: func() mtproto.TLObject { // 0x2d9ff94
 		return &TLAuthsessionSetClientSessionInfo{
 			Constructor: 47841172,
@@ -1283,6 +1288,60 @@ func (m *TLAuthsessionBindTempAuthKey) DebugString() string {
 	return dbgString
 }
 
+// TLAuthsessionDropTempAuthKeys
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLAuthsessionDropTempAuthKeys) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_authsession_dropTempAuthKeys))
+
+	switch uint32(m.Constructor) {
+	case 0x77db72fa:
+		// authsession.dropTempAuthKeys perm_auth_key_id:long except_auth_keys:Vector<long> = Vector<long>;
+		x.UInt(0x77db72fa)
+
+		// no flags
+
+		x.Long(m.GetPermAuthKeyId())
+
+		x.VectorLong(m.GetExceptAuthKeys())
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLAuthsessionDropTempAuthKeys) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLAuthsessionDropTempAuthKeys) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0x77db72fa:
+		// authsession.dropTempAuthKeys perm_auth_key_id:long except_auth_keys:Vector<long> = Vector<long>;
+
+		// not has flags
+
+		m.PermAuthKeyId = dBuf.Long()
+
+		m.ExceptAuthKeys = dBuf.VectorLong()
+
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLAuthsessionDropTempAuthKeys) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
 // TLAuthsessionSetClientSessionInfo
 ///////////////////////////////////////////////////////////////////////////////
 
@@ -41,6 +41,7 @@ var rpcContextRegisters = map[string]RPCContextTuple{
 	"TLAuthsessionUnbindAuthKeyUser":    RPCContextTuple{"/mtproto.RPCAuthsession/authsession_unbindAuthKeyUser", func() interface{} { return new(mtproto.Bool) }},
 	"TLAuthsessionGetPermAuthKeyId":     RPCContextTuple{"/mtproto.RPCAuthsession/authsession_getPermAuthKeyId", func() interface{} { return new(mtproto.Int64) }},
 	"TLAuthsessionBindTempAuthKey":      RPCContextTuple{"/mtproto.RPCAuthsession/authsession_bindTempAuthKey", func() interface{} { return new(mtproto.Bool) }},
+	"TLAuthsessionDropTempAuthKeys":     RPCContextTuple{"/mtproto.RPCAuthsession/authsession_dropTempAuthKeys", func() interface{} { return new(Vector_Long) }},
 	"TLAuthsessionSetClientSessionInfo": RPCContextTuple{"/mtproto.RPCAuthsession/authsession_setClientSessionInfo", func() interface{} { return new(mtproto.Bool) }},
 	"TLAuthsessionGetAuthorization":     RPCContextTuple{"/mtproto.RPCAuthsession/authsession_getAuthorization", func() interface{} { return new(mtproto.Authorization) }},
 	"TLAuthsessionGetAuthStateData":     RPCContextTuple{"/mtproto.RPCAuthsession/authsession_getAuthStateData", func() interface{} { return new(AuthKeyStateData) }},
@@ -37,6 +37,7 @@ type AuthsessionClient interface {
 	AuthsessionUnbindAuthKeyUser(ctx context.Context, in *authsession.TLAuthsessionUnbindAuthKeyUser) (*mtproto.Bool, error)
 	AuthsessionGetPermAuthKeyId(ctx context.Context, in *authsession.TLAuthsessionGetPermAuthKeyId) (*mtproto.Int64, error)
 	AuthsessionBindTempAuthKey(ctx context.Context, in *authsession.TLAuthsessionBindTempAuthKey) (*mtproto.Bool, error)
+	AuthsessionDropTempAuthKeys(ctx context.Context, in *authsession.TLAuthsessionDropTempAuthKeys) (*authsession.Vector_Long, error)
 	AuthsessionSetClientSessionInfo(ctx context.Context, in *authsession.TLAuthsessionSetClientSessionInfo) (*mtproto.Bool, error)
 	AuthsessionGetAuthorization(ctx context.Context, in *authsession.TLAuthsessionGetAuthorization) (*mtproto.Authorization, error)
 	AuthsessionGetAuthStateData(ctx context.Context, in *authsession.TLAuthsessionGetAuthStateData) (*authsession.AuthKeyStateData, error)
@@ -157,6 +158,13 @@ func (m *defaultAuthsessionClient) AuthsessionBindTempAuthKey(ctx context.Contex
 	return client.AuthsessionBindTempAuthKey(ctx, in)
 }
 
+// AuthsessionDropTempAuthKeys
+// authsession.dropTempAuthKeys perm_auth_key_id:long except_auth_keys:Vector<long> = Vector<long>;
+func (m *defaultAuthsessionClient) AuthsessionDropTempAuthKeys(ctx context.Context, in *authsession.TLAuthsessionDropTempAuthKeys) (*authsession.Vector_Long, error) {
+	client := authsession.NewRPCAuthsessionClient(m.cli.Conn())
+	return client.AuthsessionDropTempAuthKeys(ctx, in)
+}
+
 // AuthsessionSetClientSessionInfo
 // authsession.setClientSessionInfo data:ClientSession = Bool;
 func (m *defaultAuthsessionClient) AuthsessionSetClientSessionInfo(ctx context.Context, in *authsession.TLAuthsessionSetClientSessionInfo) (*mtproto.Bool, error) {
@@ -10,6 +10,7 @@
 package config
 
 import (
+	kafka "github.com/teamgram/marmota/pkg/mq"
 	"github.com/teamgram/marmota/pkg/stores/sqlx"
 	"github.com/zeromicro/go-zero/core/stores/cache"
 	"github.com/zeromicro/go-zero/core/stores/kv"
@@ -22,4 +23,6 @@ type Config struct {
 	Mysql sqlx.Config
 	Cache cache.CacheConf
 	KV    kv.KvConf
+	// SyncClient closes the sessions of the dropped and expired temp keys
+	SyncClient *kafka.KafkaProducerConf
 }
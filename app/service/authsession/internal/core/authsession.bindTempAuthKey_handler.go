@@ -10,8 +10,7 @@
 package core
 
 import (
-	"encoding/base64"
-	"fmt"
+	"time"
 
 	"github.com/teamgram/proto/mtproto"
 	"github.com/teamgram/proto/mtproto/crypto"
@@ -104,74 +103,60 @@ func (c *AuthsessionCore) AuthsessionBindTempAuthKey(in *authsession.TLAuthsessi
 	// -503	Timeout	Timeout while fetching data
 	//
 
-	keyData, err := c.svcCtx.Dao.GetAuthKey(c.ctx, in.GetPermAuthKeyId())
+	// the request must be sent encrypted with the temp key which is bound
+	tempKeyData, err := c.svcCtx.Dao.GetAuthKey(c.ctx, c.MD.GetAuthId())
 	if err != nil {
 		c.Logger.Errorf("auth.bindTempAuthKey - error: %v", err)
-		// return nil, err
-		// s.AuthKeysDAO.SelectByAuthKeyId(ctx, request.GetPermAuthKeyId())
-		do, _ := c.svcCtx.Dao.AuthKeysDAO.SelectByAuthKeyId(c.ctx, in.GetPermAuthKeyId())
-		if do == nil {
-			err := fmt.Errorf("not find key - keyId = %d", in.GetPermAuthKeyId())
-			return nil, err
-		}
-		authKey, err := base64.RawStdEncoding.DecodeString(do.Body)
-		if err != nil {
-			c.Logger.Errorf("read keyData error - keyId = %d, %v", in.GetPermAuthKeyId(), err)
-			return nil, err
-		}
-		keyInfo := &mtproto.AuthKeyInfo{
-			AuthKeyId:          in.GetPermAuthKeyId(),
-			AuthKey:            authKey,
-			AuthKeyType:        mtproto.AuthKeyTypePerm,
-			PermAuthKeyId:      in.GetPermAuthKeyId(),
-			TempAuthKeyId:      0,
-			MediaTempAuthKeyId: 0,
-		}
-
-		// TODO(@benqi): add expiredIn
-		c.svcCtx.Dao.PutAuthKey(c.ctx, in.GetPermAuthKeyId(), keyInfo, 0)
+		return nil, mtproto.ErrTempAuthKeyEmpty
+	} else if tempKeyData.AuthKeyType != mtproto.AuthKeyTypeTemp &&
+		tempKeyData.AuthKeyType != mtproto.AuthKeyTypeMediaTemp {
+		c.Logger.Errorf("auth.bindTempAuthKey - error: auth_key(%d) is not a temp key", c.MD.GetAuthId())
+		return nil, mtproto.ErrTempAuthKeyEmpty
+	} else if tempKeyData.PermAuthKeyId != 0 && tempKeyData.PermAuthKeyId != in.GetPermAuthKeyId() {
+		c.Logger.Errorf("auth.bindTempAuthKey - error: temp_auth_key(%d) bound to perm_auth_key(%d)",
+			c.MD.GetAuthId(),
+			tempKeyData.PermAuthKeyId)
+		return nil, mtproto.ErrTempAuthKeyAlreadyBound
 	}
 
-	permAuthKey := crypto.NewAuthKey(in.PermAuthKeyId, keyData.AuthKey)
-	innerData, err := permAuthKey.AesIgeDecryptV1(in.EncryptedMessage[8:8+16], in.EncryptedMessage[8+16:])
+	permKeyData, err := c.svcCtx.Dao.QueryAuthKey(c.ctx, in.GetPermAuthKeyId())
 	if err != nil {
 		c.Logger.Errorf("auth.bindTempAuthKey - error: %v", err)
+		return nil, mtproto.ErrEncryptedMessageInvalid
+	} else if permKeyData.AuthKeyType != mtproto.AuthKeyTypePerm {
+		c.Logger.Errorf("auth.bindTempAuthKey - error: auth_key(%d) is not a perm key", in.GetPermAuthKeyId())
+		return nil, mtproto.ErrEncryptedMessageInvalid
+	}
+
+	// bind_auth_key_inner#75a3f765 nonce:long temp_auth_key_id:long perm_auth_key_id:long temp_session_id:long expires_at:int = BindAuthKeyInner;
+	bindAuthKeyInner, err := authsession.DecodeBindAuthKeyInner(
+		crypto.NewAuthKey(permKeyData.AuthKeyId, permKeyData.AuthKey),
+		c.MD.GetClientMsgId(),
+		in.GetEncryptedMessage())
+	if err != nil {
+		c.Logger.Errorf("auth.bindTempAuthKey - error: invalid encrypted_message")
 		return nil, err
 	}
+	c.Logger.Infof("auth.bindTempAuthKey - bind_auth_key_inner: %s", bindAuthKeyInner.DebugString())
+
+	if bindAuthKeyInner.GetNonce() != in.GetNonce() ||
+		bindAuthKeyInner.GetPermAuthKeyId() != in.GetPermAuthKeyId() ||
+		bindAuthKeyInner.GetExpiresAt() != in.GetExpiresAt() ||
+		bindAuthKeyInner.GetTempAuthKeyId() != c.MD.GetAuthId() ||
+		bindAuthKeyInner.GetTempSessionId() != c.MD.GetSessionId() {
+		c.Logger.Errorf("auth.bindTempAuthKey - error: bind_auth_key_inner mismatch")
+		return nil, mtproto.ErrEncryptedMessageInvalid
+	}
 
-	// 8+8+8+8
+	if int64(in.GetExpiresAt()) <= time.Now().Unix() {
+		c.Logger.Errorf("auth.bindTempAuthKey - error: expires_at(%d) has passed", in.GetExpiresAt())
+		return nil, mtproto.ErrEncryptedMessageInvalid
+	}
 
-	//// 2. 反序列化出pqInnerData
-	dbuf := mtproto.NewDecodeBuf(innerData[32:])
-	o := dbuf.Object()
-	if dbuf.GetError() != nil {
-		c.Logger.Errorf("auth.bindTempAuthKey - error: %v", dbuf.GetError())
-		return nil, dbuf.GetError()
-	} else if bindAuthKeyInner, ok := o.(*mtproto.TLBindAuthKeyInner); !ok {
-		c.Logger.Errorf("auth.bindTempAuthKey - invalid innerData")
+	err = c.svcCtx.Dao.BindTempAuthKey(c.ctx, in.GetPermAuthKeyId(), tempKeyData, int64(in.GetExpiresAt()))
+	if err != nil {
+		c.Logger.Errorf("auth.bindTempAuthKey - error: %v", err)
 		return nil, mtproto.ErrInternelServerError
-	} else {
-		// bind_auth_key_inner#75a3f765 nonce:long temp_auth_key_id:long perm_auth_key_id:long temp_session_id:long expires_at:int = BindAuthKeyInner;
-		// bind
-		c.Logger.Infof("auth.bindTempAuthKey - bind_auth_key_inner: %s", bindAuthKeyInner.DebugString())
-		tempKeyData, err := c.svcCtx.Dao.GetAuthKey(c.ctx, bindAuthKeyInner.GetTempAuthKeyId())
-		if err != nil {
-			c.Logger.Errorf("auth.bindTempAuthKey - invalid innerData")
-			return nil, mtproto.ErrInternelServerError
-		}
-
-		// bindTemp
-		c.svcCtx.Dao.UnsafeBindKeyId(c.ctx,
-			bindAuthKeyInner.GetPermAuthKeyId(),
-			tempKeyData.AuthKeyType,
-			bindAuthKeyInner.GetTempAuthKeyId())
-
-		// TODO: expiredIn int32
-		// bindPerm
-		c.svcCtx.Dao.UnsafeBindKeyId(c.ctx,
-			bindAuthKeyInner.GetTempAuthKeyId(),
-			mtproto.AuthKeyTypePerm,
-			bindAuthKeyInner.GetPermAuthKeyId())
 	}
 
 	return mtproto.BoolTrue, nil
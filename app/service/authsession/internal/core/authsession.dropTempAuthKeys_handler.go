@@ -0,0 +1,104 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/messenger/sync/sync"
+	"github.com/teamgram/teamgram-server/app/service/authsession/authsession"
+	"github.com/teamgram/teamgram-server/app/service/authsession/internal/dao"
+)
+
+// AuthsessionDropTempAuthKeys
+// authsession.dropTempAuthKeys perm_auth_key_id:long except_auth_keys:Vector<long> = Vector<long>;
+func (c *AuthsessionCore) AuthsessionDropTempAuthKeys(in *authsession.TLAuthsessionDropTempAuthKeys) (*authsession.Vector_Long, error) {
+	keys, err := c.svcCtx.Dao.GetTempAuthKeys(c.ctx, in.GetPermAuthKeyId())
+	if err != nil {
+		c.Logger.Errorf("authsession.dropTempAuthKeys - error: %v", err)
+		return nil, err
+	}
+
+	exceptKeys := make(map[int64]bool, len(in.GetExceptAuthKeys()))
+	for _, id := range in.GetExceptAuthKeys() {
+		exceptKeys[id] = true
+	}
+
+	rValues := &authsession.Vector_Long{
+		Datas: []int64{},
+	}
+	for _, k := range keys {
+		if exceptKeys[k.TempAuthKeyId] {
+			continue
+		}
+		if err = c.svcCtx.Dao.DeleteTempAuthKey(c.ctx, k.PermAuthKeyId, k.TempAuthKeyId); err != nil {
+			c.Logger.Errorf("authsession.dropTempAuthKeys - error: %v", err)
+			return nil, err
+		}
+		c.closeTempAuthKeySessions(k)
+		rValues.Datas = append(rValues.Datas, k.TempAuthKeyId)
+	}
+
+	return rValues, nil
+}
+
+// ExpireTempAuthKeys removes the bound temp keys whose expires_at has passed,
+// returns the number of deleted keys. It stops at the first failed delete, the
+// keys left are retried on the next tick instead of being fetched again at once.
+func (c *AuthsessionCore) ExpireTempAuthKeys(now int64, limit int) int {
+	keys, err := c.svcCtx.Dao.GetExpiredTempAuthKeys(c.ctx, now, limit)
+	if err != nil {
+		c.Logger.Errorf("expireTempAuthKeys - error: %v", err)
+		return 0
+	}
+
+	deleted := 0
+	for _, k := range keys {
+		if err = c.svcCtx.Dao.DeleteTempAuthKey(c.ctx, k.PermAuthKeyId, k.TempAuthKeyId); err != nil {
+			c.Logger.Errorf("expireTempAuthKeys - error: %v, temp_auth_key: {perm_auth_key_id: %d, temp_auth_key_id: %d}",
+				err,
+				k.PermAuthKeyId,
+				k.TempAuthKeyId)
+			break
+		}
+		c.closeTempAuthKeySessions(k)
+		deleted++
+	}
+
+	return deleted
+}
+
+// closeTempAuthKeySessions asks the session of the deleted temp key k to close, it
+// evicts the key on the gateways too, instead of waiting for their next recheck.
+func (c *AuthsessionCore) closeTempAuthKeySessions(k *dao.TempAuthKey) {
+	userId := c.svcCtx.Dao.GetAuthKeyUserId(c.ctx, k.PermAuthKeyId)
+	if userId == 0 {
+		// no session of an unauthorized key is online
+		return
+	}
+
+	_, err := c.svcCtx.Dao.SyncClient.SyncUpdatesMe(
+		c.ctx,
+		&sync.TLSyncUpdatesMe{
+			UserId:    userId,
+			AuthKeyId: k.TempAuthKeyId,
+			ServerId:  "",
+			SessionId: nil,
+			Updates: mtproto.MakeTLUpdateAccountResetAuthorization(&mtproto.Updates{
+				UserId:    userId,
+				AuthKeyId: k.TempAuthKeyId,
+			}).To_Updates(),
+		})
+	if err != nil {
+		c.Logger.Errorf("closeTempAuthKeySessions - error: %v, temp_auth_key: {perm_auth_key_id: %d, temp_auth_key_id: %d}",
+			err,
+			k.PermAuthKeyId,
+			k.TempAuthKeyId)
+	}
+}
@@ -38,7 +38,7 @@ func (d *Dao) QueryAuthKey(ctx context.Context, authKeyId int64) (*mtproto.AuthK
 	var keyInfo *mtproto.AuthKeyInfo
 
 	cacheKeyData, err := d.GetAuthKey(ctx, authKeyId)
-	if err != nil {
+	if err != nil && err != errAuthKeyNotCached {
 		logx.WithContext(ctx).Errorf("queryAuthKey - error: %v", err)
 		return nil, err
 	} else if cacheKeyData != nil {
@@ -69,6 +69,11 @@ func (d *Dao) QueryAuthKey(ctx context.Context, authKeyId int64) (*mtproto.AuthK
 			TempAuthKeyId:      0,
 			MediaTempAuthKeyId: 0,
 		}
+
+		// cache it again, the cache may have been flushed
+		if err = d.PutAuthKey(ctx, authKeyId, keyInfo, 0); err != nil {
+			logx.WithContext(ctx).Errorf("queryAuthKey - error: %v", err)
+		}
 	}
 
 	// TODO(@benqi): get salt
@@ -82,16 +87,16 @@ func (d *Dao) InsertAuthKey(ctx context.Context, authKey *mtproto.AuthKeyInfo, s
 		}
 	}()
 
-	// TODO: uncomment
-	// if authKey.AuthKeyType == mtproto.AuthKeyTypePerm {
-	_, _, err = d.AuthKeysDAO.Insert(ctx, &dataobject.AuthKeysDO{
-		AuthKeyId: authKey.AuthKeyId,
-		Body:      base64.RawStdEncoding.EncodeToString(authKey.AuthKey),
-	})
-	if err != nil {
-		return err
+	// temp keys are never persisted, they only live in the cache until expired
+	if authKey.AuthKeyType == mtproto.AuthKeyTypePerm {
+		_, _, err = d.AuthKeysDAO.Insert(ctx, &dataobject.AuthKeysDO{
+			AuthKeyId: authKey.AuthKeyId,
+			Body:      base64.RawStdEncoding.EncodeToString(authKey.AuthKey),
+		})
+		if err != nil {
+			return err
+		}
 	}
-	// }
 
 	if salt != nil {
 		// cache salt
@@ -102,9 +107,10 @@ func (d *Dao) InsertAuthKey(ctx context.Context, authKey *mtproto.AuthKeyInfo, s
 		}
 	}
 
-	// TODO(@benqi): set expiredIn
-	d.PutAuthKey(ctx, authKey.AuthKeyId, authKey, 0)
-	return nil
+	if authKey.AuthKeyType == mtproto.AuthKeyTypePerm {
+		expiredIn = 0
+	}
+	return d.PutAuthKey(ctx, authKey.AuthKeyId, authKey, expiredIn)
 }
 
 func (d *Dao) GetApiLayer(ctx context.Context, authKeyId int64) int32 {
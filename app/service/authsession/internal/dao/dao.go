@@ -12,8 +12,10 @@ package dao
 import (
 	"flag"
 
+	kafka "github.com/teamgram/marmota/pkg/mq"
 	"github.com/teamgram/marmota/pkg/stores/sqlc"
 	"github.com/teamgram/marmota/pkg/stores/sqlx"
+	sync_client "github.com/teamgram/teamgram-server/app/messenger/sync/client"
 	"github.com/teamgram/teamgram-server/app/service/authsession/internal/config"
 
 	"github.com/oschwald/geoip2-golang"
@@ -33,6 +35,7 @@ type Dao struct {
 	sqlc.CachedConn
 	kv   kv.Store
 	MMDB *geoip2.Reader
+	sync_client.SyncClient
 }
 
 func New(c config.Config) *Dao {
@@ -48,5 +51,6 @@ func New(c config.Config) *Dao {
 		CachedConn: sqlc.NewConn(db, c.Cache),
 		kv:         kv.NewStore(c.KV),
 		MMDB:       MMDB,
+		SyncClient: sync_client.NewSyncMqClient(kafka.MustKafkaProducer(c.SyncClient)),
 	}
 }
@@ -20,6 +20,7 @@ package dao
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strconv"
 
@@ -32,6 +33,9 @@ const (
 	cacheAuthKeyPrefix = "auth_keys"
 )
 
+// errAuthKeyNotCached the auth_key is not in the cache, the perm keys are in the db as well
+var errAuthKeyNotCached = errors.New("invalid auth_key")
+
 func genCacheAuthKeyKey(id int64) string {
 	return fmt.Sprintf("%s_%d", cacheAuthKeyPrefix, id)
 }
@@ -101,7 +105,7 @@ func (d *Dao) GetAuthKey(ctx context.Context, keyId int64) (keyData *mtproto.Aut
 		logx.WithContext(ctx).Errorf("conn.Do(HGETALL %s) error(%v)", key, err)
 		return
 	} else if len(values) == 0 {
-		err = fmt.Errorf("invalid auth_key")
+		err = errAuthKeyNotCached
 		return
 	}
 
@@ -0,0 +1,187 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package dao
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/teamgram/proto/mtproto"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+const (
+	// hash of the temp keys bound to a perm key, temp_auth_key_id -> expires_at
+	cacheTempAuthKeysPrefix = "temp_auth_keys"
+	// sorted set of all bound temp keys by expires_at, member perm_auth_key_id:temp_auth_key_id
+	cacheTempAuthKeysExpiresKey = "temp_auth_keys_expires"
+)
+
+func genCacheTempAuthKeysKey(permAuthKeyId int64) string {
+	return fmt.Sprintf("%s_%d", cacheTempAuthKeysPrefix, permAuthKeyId)
+}
+
+func genTempAuthKeysExpiresMember(permAuthKeyId, tempAuthKeyId int64) string {
+	return fmt.Sprintf("%d:%d", permAuthKeyId, tempAuthKeyId)
+}
+
+func parseTempAuthKeysExpiresMember(member string) (permAuthKeyId, tempAuthKeyId int64, err error) {
+	idList := strings.Split(member, ":")
+	if len(idList) != 2 {
+		err = fmt.Errorf("invalid member: %s", member)
+		return
+	}
+	if permAuthKeyId, err = strconv.ParseInt(idList[0], 10, 64); err != nil {
+		return
+	}
+	tempAuthKeyId, err = strconv.ParseInt(idList[1], 10, 64)
+	return
+}
+
+// TempAuthKey a temp key bound to perm_auth_key_id
+type TempAuthKey struct {
+	PermAuthKeyId int64
+	TempAuthKeyId int64
+	ExpiresAt     int64
+}
+
+// BindTempAuthKey binds tempKey to permAuthKeyId until expiresAt, the temp key and its
+// binding are removed when expiresAt has passed.
+func (d *Dao) BindTempAuthKey(ctx context.Context, permAuthKeyId int64, tempKey *mtproto.AuthKeyInfo, expiresAt int64) (err error) {
+	var (
+		tempAuthKeyId = tempKey.AuthKeyId
+		key           = genCacheAuthKeyKey(tempAuthKeyId)
+	)
+
+	// bindPerm
+	if err = d.UnsafeBindKeyId(ctx, tempAuthKeyId, mtproto.AuthKeyTypePerm, permAuthKeyId); err != nil {
+		return
+	}
+	if err = d.kv.Expireat(key, expiresAt); err != nil {
+		logx.WithContext(ctx).Errorf("conn.Do(EXPIREAT %s,%d) error(%v)", key, expiresAt, err)
+		return
+	}
+
+	// bindTemp
+	if err = d.UnsafeBindKeyId(ctx, permAuthKeyId, tempKey.AuthKeyType, tempAuthKeyId); err != nil {
+		return
+	}
+
+	key = genCacheTempAuthKeysKey(permAuthKeyId)
+	if err = d.kv.Hset(key, strconv.FormatInt(tempAuthKeyId, 10), strconv.FormatInt(expiresAt, 10)); err != nil {
+		logx.WithContext(ctx).Errorf("conn.Do(HSET %s,%d,%d) error(%v)", key, tempAuthKeyId, expiresAt, err)
+		return
+	}
+
+	member := genTempAuthKeysExpiresMember(permAuthKeyId, tempAuthKeyId)
+	if _, err = d.kv.Zadd(cacheTempAuthKeysExpiresKey, expiresAt, member); err != nil {
+		logx.WithContext(ctx).Errorf("conn.Do(ZADD %s,%d,%s) error(%v)", cacheTempAuthKeysExpiresKey, expiresAt, member, err)
+	}
+
+	return
+}
+
+// GetTempAuthKeys the temp keys bound to permAuthKeyId
+func (d *Dao) GetTempAuthKeys(ctx context.Context, permAuthKeyId int64) ([]*TempAuthKey, error) {
+	key := genCacheTempAuthKeysKey(permAuthKeyId)
+
+	values, err := d.kv.Hgetall(key)
+	if err != nil {
+		logx.WithContext(ctx).Errorf("conn.Do(HGETALL %s) error(%v)", key, err)
+		return nil, err
+	}
+
+	keys := make([]*TempAuthKey, 0, len(values))
+	for k, v := range values {
+		tempAuthKeyId, _ := strconv.ParseInt(k, 10, 64)
+		expiresAt, _ := strconv.ParseInt(v, 10, 64)
+		keys = append(keys, &TempAuthKey{
+			PermAuthKeyId: permAuthKeyId,
+			TempAuthKeyId: tempAuthKeyId,
+			ExpiresAt:     expiresAt,
+		})
+	}
+
+	return keys, nil
+}
+
+// GetExpiredTempAuthKeys at most limit temp keys whose expires_at is not after now
+func (d *Dao) GetExpiredTempAuthKeys(ctx context.Context, now int64, limit int) ([]*TempAuthKey, error) {
+	pairs, err := d.kv.ZrangebyscoreWithScoresAndLimit(cacheTempAuthKeysExpiresKey, 0, now, 0, limit)
+	if err != nil {
+		logx.WithContext(ctx).Errorf("conn.Do(ZRANGEBYSCORE %s,0,%d) error(%v)", cacheTempAuthKeysExpiresKey, now, err)
+		return nil, err
+	}
+
+	keys := make([]*TempAuthKey, 0, len(pairs))
+	for _, p := range pairs {
+		permAuthKeyId, tempAuthKeyId, err2 := parseTempAuthKeysExpiresMember(p.Key)
+		if err2 != nil {
+			logx.WithContext(ctx).Errorf("getExpiredTempAuthKeys - error: %v", err2)
+			d.kv.Zrem(cacheTempAuthKeysExpiresKey, p.Key)
+			continue
+		}
+		keys = append(keys, &TempAuthKey{
+			PermAuthKeyId: permAuthKeyId,
+			TempAuthKeyId: tempAuthKeyId,
+			ExpiresAt:     p.Score,
+		})
+	}
+
+	return keys, nil
+}
+
+// DeleteTempAuthKey removes the temp key with its salts and its binding to permAuthKeyId
+func (d *Dao) DeleteTempAuthKey(ctx context.Context, permAuthKeyId, tempAuthKeyId int64) (err error) {
+	var (
+		key = genCacheAuthKeyKey(permAuthKeyId)
+	)
+
+	// unbind the perm key if it still points to the temp key
+	for _, field := range []string{"temp_auth_key_id", "media_temp_auth_key_id"} {
+		v, _ := d.kv.Hget(key, field)
+		if v == strconv.FormatInt(tempAuthKeyId, 10) {
+			if err = d.kv.Hset(key, field, "0"); err != nil {
+				logx.WithContext(ctx).Errorf("conn.Do(HSET %s,%s,0) error(%v)", key, field, err)
+				return
+			}
+		}
+	}
+
+	if _, err = d.kv.Del(genCacheAuthKeyKey(tempAuthKeyId), genCacheSaltKey(tempAuthKeyId)); err != nil {
+		logx.WithContext(ctx).Errorf("conn.Do(DEL %d) error(%v)", tempAuthKeyId, err)
+		return
+	}
+
+	key = genCacheTempAuthKeysKey(permAuthKeyId)
+	if _, err = d.kv.Hdel(key, strconv.FormatInt(tempAuthKeyId, 10)); err != nil {
+		logx.WithContext(ctx).Errorf("conn.Do(HDEL %s,%d) error(%v)", key, tempAuthKeyId, err)
+		return
+	}
+
+	member := genTempAuthKeysExpiresMember(permAuthKeyId, tempAuthKeyId)
+	if _, err = d.kv.Zrem(cacheTempAuthKeysExpiresKey, member); err != nil {
+		logx.WithContext(ctx).Errorf("conn.Do(ZREM %s,%s) error(%v)", cacheTempAuthKeysExpiresKey, member, err)
+	}
+
+	return
+}
@@ -243,6 +243,21 @@ func (s *Service) AuthsessionBindTempAuthKey(ctx context.Context, request *auths
 	return r, err
 }
 
+// AuthsessionDropTempAuthKeys
+// authsession.dropTempAuthKeys perm_auth_key_id:long except_auth_keys:Vector<long> = Vector<long>;
+func (s *Service) AuthsessionDropTempAuthKeys(ctx context.Context, request *authsession.TLAuthsessionDropTempAuthKeys) (*authsession.Vector_Long, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Infof("authsession.dropTempAuthKeys - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.AuthsessionDropTempAuthKeys(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Infof("authsession.dropTempAuthKeys - reply: %s", r.DebugString())
+	return r, err
+}
+
 // AuthsessionSetClientSessionInfo
 // authsession.setClientSessionInfo data:ClientSession = Bool;
 func (s *Service) AuthsessionSetClientSessionInfo(ctx context.Context, request *authsession.TLAuthsessionSetClientSessionInfo) (*mtproto.Bool, error) {
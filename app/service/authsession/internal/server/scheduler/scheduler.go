@@ -0,0 +1,73 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package scheduler
+
+import (
+	"context"
+	"time"
+
+	"github.com/teamgram/teamgram-server/app/service/authsession/internal/core"
+	"github.com/teamgram/teamgram-server/app/service/authsession/internal/svc"
+)
+
+const (
+	tickInterval = 10 * time.Second
+	batchSize    = 100
+)
+
+// Scheduler expires the temp keys whose expires_at has passed.
+type Scheduler struct {
+	svcCtx *svc.ServiceContext
+	done   chan struct{}
+}
+
+func New(svcCtx *svc.ServiceContext) *Scheduler {
+	return &Scheduler{
+		svcCtx: svcCtx,
+		done:   make(chan struct{}),
+	}
+}
+
+// Start runs until Stop is called.
+func (s *Scheduler) Start() {
+	ctx := context.Background()
+
+	ticker := time.NewTicker(tickInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.done:
+			return
+		case <-ticker.C:
+			// drain the backlog before waiting for the next tick
+			for core.New(ctx, s.svcCtx).ExpireTempAuthKeys(time.Now().Unix(), batchSize) == batchSize {
+				select {
+				case <-s.done:
+					return
+				default:
+				}
+			}
+		}
+	}
+}
+
+func (s *Scheduler) Stop() {
+	close(s.done)
+}
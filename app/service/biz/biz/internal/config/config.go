@@ -19,7 +19,10 @@
 package config
 
 import (
+	kafka "github.com/teamgram/marmota/pkg/mq"
 	"github.com/teamgram/marmota/pkg/stores/sqlx"
+	"github.com/teamgram/teamgram-server/pkg/email"
+	"github.com/teamgram/teamgram-server/pkg/search"
 	"github.com/zeromicro/go-zero/core/stores/cache"
 	"github.com/zeromicro/go-zero/core/stores/kv"
 	"github.com/zeromicro/go-zero/zrpc"
@@ -33,4 +36,9 @@ type Config struct {
 	MediaClient zrpc.RpcClientConf
 	IdgenClient zrpc.RpcClientConf
 	PollClient  zrpc.RpcClientConf
+	// Search message search index, see message.Config
+	Search         *search.Config           `json:",optional"`
+	SearchConsumer *kafka.KafkaConsumerConf `json:",optional"`
+	// Email sends the two-step verification codes, see user.Config
+	Email *email.Config `json:",optional"`
 }
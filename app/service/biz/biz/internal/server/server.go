@@ -21,9 +21,12 @@ package server
 import (
 	"flag"
 
+	kafka "github.com/teamgram/marmota/pkg/mq"
 	auth_helper "github.com/teamgram/teamgram-server/app/service/biz/auth"
 	"github.com/teamgram/teamgram-server/app/service/biz/auth/auth"
 	"github.com/teamgram/teamgram-server/app/service/biz/biz/internal/config"
+	channel_helper "github.com/teamgram/teamgram-server/app/service/biz/channel"
+	"github.com/teamgram/teamgram-server/app/service/biz/channel/channel"
 	chat_helper "github.com/teamgram/teamgram-server/app/service/biz/chat"
 	"github.com/teamgram/teamgram-server/app/service/biz/chat/chat"
 	code_helper "github.com/teamgram/teamgram-server/app/service/biz/code"
@@ -38,6 +41,7 @@ import (
 	"github.com/teamgram/teamgram-server/app/service/biz/user/user"
 	username_helper "github.com/teamgram/teamgram-server/app/service/biz/username"
 	"github.com/teamgram/teamgram-server/app/service/biz/username/username"
+	"github.com/teamgram/teamgram-server/pkg/groupcall"
 
 	"github.com/zeromicro/go-zero/core/conf"
 	"github.com/zeromicro/go-zero/core/logx"
@@ -49,6 +53,7 @@ var configFile = flag.String("f", "etc/biz.yaml", "the config file")
 
 type Server struct {
 	grpcSrv *zrpc.RpcServer
+	mq      *kafka.ConsumerGroup
 }
 
 func New() *Server {
@@ -73,6 +78,16 @@ func (s *Server) Initialize() error {
 				Cache:         c.Cache,
 			}))
 
+		// channel_helper
+		channel.RegisterRPCChannelServer(
+			grpcServer,
+			channel_helper.New(channel_helper.Config{
+				RpcServerConf: c.RpcServerConf,
+				Mysql:         c.Mysql,
+				IdgenClient:   c.IdgenClient,
+				MediaClient:   c.MediaClient,
+			}))
+
 		// chat_helper
 		chat.RegisterRPCChatServer(
 			grpcServer,
@@ -83,7 +98,7 @@ func (s *Server) Initialize() error {
 					Cache:         c.Cache,
 					MediaClient:   c.MediaClient,
 				},
-				nil))
+				groupcall.NewChatPlugin(c.KV)))
 
 		// code_helper
 		code.RegisterRPCCodeServer(
@@ -105,14 +120,16 @@ func (s *Server) Initialize() error {
 			}))
 
 		// message_helper
-		message.RegisterRPCMessageServer(
-			grpcServer,
-			message_helper.New(message_helper.Config{
-				RpcServerConf: c.RpcServerConf,
-				Mysql:         c.Mysql,
-				Cache:         c.Cache,
-				PollClient:    c.PollClient,
-			}))
+		messageSrv, searchConsumer := message_helper.NewWithSearchConsumer(message_helper.Config{
+			RpcServerConf:  c.RpcServerConf,
+			Mysql:          c.Mysql,
+			Cache:          c.Cache,
+			PollClient:     c.PollClient,
+			Search:         c.Search,
+			SearchConsumer: c.SearchConsumer,
+		})
+		message.RegisterRPCMessageServer(grpcServer, messageSrv)
+		s.mq = searchConsumer
 
 		// updates_helper
 		updates.RegisterRPCUpdatesServer(
@@ -131,7 +148,9 @@ func (s *Server) Initialize() error {
 				RpcServerConf: c.RpcServerConf,
 				Mysql:         c.Mysql,
 				Cache:         c.Cache,
+				KV:            c.KV,
 				MediaClient:   c.MediaClient,
+				Email:         c.Email,
 			}))
 
 		// username_helper
@@ -148,6 +167,12 @@ func (s *Server) Initialize() error {
 	go func() {
 		s.grpcSrv.Start()
 	}()
+
+	if s.mq != nil {
+		go func() {
+			s.mq.Start()
+		}()
+	}
 	return nil
 }
 
@@ -156,4 +181,7 @@ func (s *Server) RunLoop() {
 
 func (s *Server) Destroy() {
 	s.grpcSrv.Stop()
+	if s.mq != nil {
+		s.mq.Stop()
+	}
 }
@@ -0,0 +1,14525 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: channel.tl.proto
+
+package channel
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/types"
+	mtproto "github.com/teamgram/proto/mtproto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	reflect "reflect"
+	strings "strings"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type TLConstructor int32
+
+const (
+	CRC32_UNKNOWN                             TLConstructor = 0
+	CRC32_immutableChannel                    TLConstructor = -2136842669
+	CRC32_immutableChannelParticipant         TLConstructor = 1922395304
+	CRC32_mutableChannel                      TLConstructor = -662027966
+	CRC32_channelParticipants                 TLConstructor = 939154768
+	CRC32_channelDifference                   TLConstructor = -357108184
+	CRC32_channelDeletedMessages              TLConstructor = 844131560
+	CRC32_channel_getMutableChannel           TLConstructor = -437245607
+	CRC32_channel_getChannelListByIdList      TLConstructor = 461926155
+	CRC32_channel_getChannelParticipantIdList TLConstructor = -1962023455
+	CRC32_channel_createChannel               TLConstructor = 1595647274
+	CRC32_channel_joinChannel                 TLConstructor = -868924054
+	CRC32_channel_leaveChannel                TLConstructor = 246600306
+	CRC32_channel_inviteToChannel             TLConstructor = -1728965731
+	CRC32_channel_editAdmin                   TLConstructor = -937752617
+	CRC32_channel_editBanned                  TLConstructor = 1819343561
+	CRC32_channel_getChannelParticipants      TLConstructor = -666199065
+	CRC32_channel_sendMessage                 TLConstructor = -1261001836
+	CRC32_channel_getHistory                  TLConstructor = -2100477061
+	CRC32_channel_getMessages                 TLConstructor = 537158293
+	CRC32_channel_readHistory                 TLConstructor = -1344822315
+	CRC32_channel_getChannelDifference        TLConstructor = 1237596961
+	CRC32_channel_editTitle                   TLConstructor = 558691925
+	CRC32_channel_editPhoto                   TLConstructor = 111840841
+	CRC32_channel_toggleSignatures            TLConstructor = 1029421910
+	CRC32_channel_togglePreHistoryHidden      TLConstructor = -1024282738
+	CRC32_channel_toggleSlowMode              TLConstructor = -405948939
+	CRC32_channel_deleteChannel               TLConstructor = 117753992
+	CRC32_channel_deleteMessages              TLConstructor = 1766934587
+	CRC32_channel_deleteParticipantHistory    TLConstructor = 1496960477
+	CRC32_channel_deleteHistory               TLConstructor = -172047269
+)
+
+var TLConstructor_name = map[int32]string{
+	0:           "CRC32_UNKNOWN",
+	-2136842669: "CRC32_immutableChannel",
+	1922395304:  "CRC32_immutableChannelParticipant",
+	-662027966:  "CRC32_mutableChannel",
+	939154768:   "CRC32_channelParticipants",
+	-357108184:  "CRC32_channelDifference",
+	844131560:   "CRC32_channelDeletedMessages",
+	-437245607:  "CRC32_channel_getMutableChannel",
+	461926155:   "CRC32_channel_getChannelListByIdList",
+	-1962023455: "CRC32_channel_getChannelParticipantIdList",
+	1595647274:  "CRC32_channel_createChannel",
+	-868924054:  "CRC32_channel_joinChannel",
+	246600306:   "CRC32_channel_leaveChannel",
+	-1728965731: "CRC32_channel_inviteToChannel",
+	-937752617:  "CRC32_channel_editAdmin",
+	1819343561:  "CRC32_channel_editBanned",
+	-666199065:  "CRC32_channel_getChannelParticipants",
+	-1261001836: "CRC32_channel_sendMessage",
+	-2100477061: "CRC32_channel_getHistory",
+	537158293:   "CRC32_channel_getMessages",
+	-1344822315: "CRC32_channel_readHistory",
+	1237596961:  "CRC32_channel_getChannelDifference",
+	558691925:   "CRC32_channel_editTitle",
+	111840841:   "CRC32_channel_editPhoto",
+	1029421910:  "CRC32_channel_toggleSignatures",
+	-1024282738: "CRC32_channel_togglePreHistoryHidden",
+	-405948939:  "CRC32_channel_toggleSlowMode",
+	117753992:   "CRC32_channel_deleteChannel",
+	1766934587:  "CRC32_channel_deleteMessages",
+	1496960477:  "CRC32_channel_deleteParticipantHistory",
+	-172047269:  "CRC32_channel_deleteHistory",
+}
+
+var TLConstructor_value = map[string]int32{
+	"CRC32_UNKNOWN":                             0,
+	"CRC32_immutableChannel":                    -2136842669,
+	"CRC32_immutableChannelParticipant":         1922395304,
+	"CRC32_mutableChannel":                      -662027966,
+	"CRC32_channelParticipants":                 939154768,
+	"CRC32_channelDifference":                   -357108184,
+	"CRC32_channelDeletedMessages":              844131560,
+	"CRC32_channel_getMutableChannel":           -437245607,
+	"CRC32_channel_getChannelListByIdList":      461926155,
+	"CRC32_channel_getChannelParticipantIdList": -1962023455,
+	"CRC32_channel_createChannel":               1595647274,
+	"CRC32_channel_joinChannel":                 -868924054,
+	"CRC32_channel_leaveChannel":                246600306,
+	"CRC32_channel_inviteToChannel":             -1728965731,
+	"CRC32_channel_editAdmin":                   -937752617,
+	"CRC32_channel_editBanned":                  1819343561,
+	"CRC32_channel_getChannelParticipants":      -666199065,
+	"CRC32_channel_sendMessage":                 -1261001836,
+	"CRC32_channel_getHistory":                  -2100477061,
+	"CRC32_channel_getMessages":                 537158293,
+	"CRC32_channel_readHistory":                 -1344822315,
+	"CRC32_channel_getChannelDifference":        1237596961,
+	"CRC32_channel_editTitle":                   558691925,
+	"CRC32_channel_editPhoto":                   111840841,
+	"CRC32_channel_toggleSignatures":            1029421910,
+	"CRC32_channel_togglePreHistoryHidden":      -1024282738,
+	"CRC32_channel_toggleSlowMode":              -405948939,
+	"CRC32_channel_deleteChannel":               117753992,
+	"CRC32_channel_deleteMessages":              1766934587,
+	"CRC32_channel_deleteParticipantHistory":    1496960477,
+	"CRC32_channel_deleteHistory":               -172047269,
+}
+
+func (x TLConstructor) String() string {
+	return proto.EnumName(TLConstructor_name, int32(x))
+}
+
+func (TLConstructor) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_51d76c1638b87f93, []int{0}
+}
+
+//--------------------------------------------------------------------------------------------
+// immutableChannel flags:# id:long access_hash:long creator:long title:string about:string photo:Photo broadcast:flags.0?true megagroup:flags.1?true signatures:flags.2?true noforwards:flags.3?true deactivated:flags.4?true hidden_prehistory:flags.5?true username:string participants_count:int default_banned_rights:ChatBannedRights slowmode_seconds:int pts:int top_message:int date:long version:int = ImmutableChannel;
+//
+// ImmutableChannel <--
+//  + TL_immutableChannel
+//
+type ImmutableChannel struct {
+	PredicateName        string                    `protobuf:"bytes,1,opt,name=predicate_name,json=predicateName,proto3" json:"predicate_name,omitempty"`
+	Constructor          TLConstructor             `protobuf:"varint,2,opt,name=constructor,proto3,enum=channel.TLConstructor" json:"constructor,omitempty"`
+	Id                   int64                     `protobuf:"varint,3,opt,name=id,proto3" json:"id,omitempty"`
+	AccessHash           int64                     `protobuf:"varint,4,opt,name=access_hash,json=accessHash,proto3" json:"access_hash,omitempty"`
+	Creator              int64                     `protobuf:"varint,5,opt,name=creator,proto3" json:"creator,omitempty"`
+	Title                string                    `protobuf:"bytes,6,opt,name=title,proto3" json:"title,omitempty"`
+	About                string                    `protobuf:"bytes,7,opt,name=about,proto3" json:"about,omitempty"`
+	Photo                *mtproto.Photo            `protobuf:"bytes,8,opt,name=photo,proto3" json:"photo,omitempty"`
+	Broadcast            bool                      `protobuf:"varint,9,opt,name=broadcast,proto3" json:"broadcast,omitempty"`
+	Megagroup            bool                      `protobuf:"varint,10,opt,name=megagroup,proto3" json:"megagroup,omitempty"`
+	Signatures           bool                      `protobuf:"varint,11,opt,name=signatures,proto3" json:"signatures,omitempty"`
+	Noforwards           bool                      `protobuf:"varint,12,opt,name=noforwards,proto3" json:"noforwards,omitempty"`
+	Deactivated          bool                      `protobuf:"varint,13,opt,name=deactivated,proto3" json:"deactivated,omitempty"`
+	HiddenPrehistory     bool                      `protobuf:"varint,14,opt,name=hidden_prehistory,json=hiddenPrehistory,proto3" json:"hidden_prehistory,omitempty"`
+	Username             string                    `protobuf:"bytes,15,opt,name=username,proto3" json:"username,omitempty"`
+	ParticipantsCount    int32                     `protobuf:"varint,16,opt,name=participants_count,json=participantsCount,proto3" json:"participants_count,omitempty"`
+	DefaultBannedRights  *mtproto.ChatBannedRights `protobuf:"bytes,17,opt,name=default_banned_rights,json=defaultBannedRights,proto3" json:"default_banned_rights,omitempty"`
+	SlowmodeSeconds      int32                     `protobuf:"varint,18,opt,name=slowmode_seconds,json=slowmodeSeconds,proto3" json:"slowmode_seconds,omitempty"`
+	Pts                  int32                     `protobuf:"varint,19,opt,name=pts,proto3" json:"pts,omitempty"`
+	TopMessage           int32                     `protobuf:"varint,20,opt,name=top_message,json=topMessage,proto3" json:"top_message,omitempty"`
+	Date                 int64                     `protobuf:"varint,21,opt,name=date,proto3" json:"date,omitempty"`
+	Version              int32                     `protobuf:"varint,22,opt,name=version,proto3" json:"version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
+}
+
+func (m *ImmutableChannel) Reset()         { *m = ImmutableChannel{} }
+func (m *ImmutableChannel) String() string { return proto.CompactTextString(m) }
+func (*ImmutableChannel) ProtoMessage()    {}
+func (*ImmutableChannel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51d76c1638b87f93, []int{0}
+}
+func (m *ImmutableChannel) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ImmutableChannel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ImmutableChannel.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ImmutableChannel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImmutableChannel.Merge(m, src)
+}
+func (m *ImmutableChannel) XXX_Size() int {
+	return m.Size()
+}
+func (m *ImmutableChannel) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImmutableChannel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImmutableChannel proto.InternalMessageInfo
+
+func (m *ImmutableChannel) GetPredicateName() string {
+	if m != nil {
+		return m.PredicateName
+	}
+	return ""
+}
+
+func (m *ImmutableChannel) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
+	}
+	return CRC32_UNKNOWN
+}
+
+func (m *ImmutableChannel) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *ImmutableChannel) GetAccessHash() int64 {
+	if m != nil {
+		return m.AccessHash
+	}
+	return 0
+}
+
+func (m *ImmutableChannel) GetCreator() int64 {
+	if m != nil {
+		return m.Creator
+	}
+	return 0
+}
+
+func (m *ImmutableChannel) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *ImmutableChannel) GetAbout() string {
+	if m != nil {
+		return m.About
+	}
+	return ""
+}
+
+func (m *ImmutableChannel) GetPhoto() *mtproto.Photo {
+	if m != nil {
+		return m.Photo
+	}
+	return nil
+}
+
+func (m *ImmutableChannel) GetBroadcast() bool {
+	if m != nil {
+		return m.Broadcast
+	}
+	return false
+}
+
+func (m *ImmutableChannel) GetMegagroup() bool {
+	if m != nil {
+		return m.Megagroup
+	}
+	return false
+}
+
+func (m *ImmutableChannel) GetSignatures() bool {
+	if m != nil {
+		return m.Signatures
+	}
+	return false
+}
+
+func (m *ImmutableChannel) GetNoforwards() bool {
+	if m != nil {
+		return m.Noforwards
+	}
+	return false
+}
+
+func (m *ImmutableChannel) GetDeactivated() bool {
+	if m != nil {
+		return m.Deactivated
+	}
+	return false
+}
+
+func (m *ImmutableChannel) GetHiddenPrehistory() bool {
+	if m != nil {
+		return m.HiddenPrehistory
+	}
+	return false
+}
+
+func (m *ImmutableChannel) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *ImmutableChannel) GetParticipantsCount() int32 {
+	if m != nil {
+		return m.ParticipantsCount
+	}
+	return 0
+}
+
+func (m *ImmutableChannel) GetDefaultBannedRights() *mtproto.ChatBannedRights {
+	if m != nil {
+		return m.DefaultBannedRights
+	}
+	return nil
+}
+
+func (m *ImmutableChannel) GetSlowmodeSeconds() int32 {
+	if m != nil {
+		return m.SlowmodeSeconds
+	}
+	return 0
+}
+
+func (m *ImmutableChannel) GetPts() int32 {
+	if m != nil {
+		return m.Pts
+	}
+	return 0
+}
+
+func (m *ImmutableChannel) GetTopMessage() int32 {
+	if m != nil {
+		return m.TopMessage
+	}
+	return 0
+}
+
+func (m *ImmutableChannel) GetDate() int64 {
+	if m != nil {
+		return m.Date
+	}
+	return 0
+}
+
+func (m *ImmutableChannel) GetVersion() int32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+// immutableChannel flags:# id:long access_hash:long creator:long title:string about:string photo:Photo broadcast:flags.0?true megagroup:flags.1?true signatures:flags.2?true noforwards:flags.3?true deactivated:flags.4?true hidden_prehistory:flags.5?true username:string participants_count:int default_banned_rights:ChatBannedRights slowmode_seconds:int pts:int top_message:int date:long version:int = ImmutableChannel;
+type TLImmutableChannel struct {
+	Data2                *ImmutableChannel `protobuf:"bytes,1,opt,name=data2,proto3" json:"data2,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *TLImmutableChannel) Reset()         { *m = TLImmutableChannel{} }
+func (m *TLImmutableChannel) String() string { return proto.CompactTextString(m) }
+func (*TLImmutableChannel) ProtoMessage()    {}
+func (*TLImmutableChannel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51d76c1638b87f93, []int{1}
+}
+func (m *TLImmutableChannel) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLImmutableChannel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLImmutableChannel.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TLImmutableChannel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLImmutableChannel.Merge(m, src)
+}
+func (m *TLImmutableChannel) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLImmutableChannel) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLImmutableChannel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLImmutableChannel proto.InternalMessageInfo
+
+func (m *TLImmutableChannel) GetData2() *ImmutableChannel {
+	if m != nil {
+		return m.Data2
+	}
+	return nil
+}
+
+//--------------------------------------------------------------------------------------------
+// immutableChannelParticipant id:long channel_id:long user_id:long participant_type:int state:int inviter_user_id:long admin_rights:ChatAdminRights banned_rights:ChatBannedRights rank:string promoted_by:long kicked_by:long read_inbox_max_id:int available_min_id:int date:long = ImmutableChannelParticipant;
+//
+// ImmutableChannelParticipant <--
+//  + TL_immutableChannelParticipant
+//
+type ImmutableChannelParticipant struct {
+	PredicateName        string                    `protobuf:"bytes,1,opt,name=predicate_name,json=predicateName,proto3" json:"predicate_name,omitempty"`
+	Constructor          TLConstructor             `protobuf:"varint,2,opt,name=constructor,proto3,enum=channel.TLConstructor" json:"constructor,omitempty"`
+	Id                   int64                     `protobuf:"varint,3,opt,name=id,proto3" json:"id,omitempty"`
+	ChannelId            int64                     `protobuf:"varint,4,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	UserId               int64                     `protobuf:"varint,5,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ParticipantType      int32                     `protobuf:"varint,6,opt,name=participant_type,json=participantType,proto3" json:"participant_type,omitempty"`
+	State                int32                     `protobuf:"varint,7,opt,name=state,proto3" json:"state,omitempty"`
+	InviterUserId        int64                     `protobuf:"varint,8,opt,name=inviter_user_id,json=inviterUserId,proto3" json:"inviter_user_id,omitempty"`
+	AdminRights          *mtproto.ChatAdminRights  `protobuf:"bytes,9,opt,name=admin_rights,json=adminRights,proto3" json:"admin_rights,omitempty"`
+	BannedRights         *mtproto.ChatBannedRights `protobuf:"bytes,10,opt,name=banned_rights,json=bannedRights,proto3" json:"banned_rights,omitempty"`
+	Rank                 string                    `protobuf:"bytes,11,opt,name=rank,proto3" json:"rank,omitempty"`
+	PromotedBy           int64                     `protobuf:"varint,12,opt,name=promoted_by,json=promotedBy,proto3" json:"promoted_by,omitempty"`
+	KickedBy             int64                     `protobuf:"varint,13,opt,name=kicked_by,json=kickedBy,proto3" json:"kicked_by,omitempty"`
+	ReadInboxMaxId       int32                     `protobuf:"varint,14,opt,name=read_inbox_max_id,json=readInboxMaxId,proto3" json:"read_inbox_max_id,omitempty"`
+	AvailableMinId       int32                     `protobuf:"varint,15,opt,name=available_min_id,json=availableMinId,proto3" json:"available_min_id,omitempty"`
+	Date                 int64                     `protobuf:"varint,16,opt,name=date,proto3" json:"date,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
+}
+
+func (m *ImmutableChannelParticipant) Reset()         { *m = ImmutableChannelParticipant{} }
+func (m *ImmutableChannelParticipant) String() string { return proto.CompactTextString(m) }
+func (*ImmutableChannelParticipant) ProtoMessage()    {}
+func (*ImmutableChannelParticipant) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51d76c1638b87f93, []int{2}
+}
+func (m *ImmutableChannelParticipant) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ImmutableChannelParticipant) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ImmutableChannelParticipant.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ImmutableChannelParticipant) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImmutableChannelParticipant.Merge(m, src)
+}
+func (m *ImmutableChannelParticipant) XXX_Size() int {
+	return m.Size()
+}
+func (m *ImmutableChannelParticipant) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImmutableChannelParticipant.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImmutableChannelParticipant proto.InternalMessageInfo
+
+func (m *ImmutableChannelParticipant) GetPredicateName() string {
+	if m != nil {
+		return m.PredicateName
+	}
+	return ""
+}
+
+func (m *ImmutableChannelParticipant) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
+	}
+	return CRC32_UNKNOWN
+}
+
+func (m *ImmutableChannelParticipant) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *ImmutableChannelParticipant) GetChannelId() int64 {
+	if m != nil {
+		return m.ChannelId
+	}
+	return 0
+}
+
+func (m *ImmutableChannelParticipant) GetUserId() int64 {
+	if m != nil {
+		return m.UserId
+	}
+	return 0
+}
+
+func (m *ImmutableChannelParticipant) GetParticipantType() int32 {
+	if m != nil {
+		return m.ParticipantType
+	}
+	return 0
+}
+
+func (m *ImmutableChannelParticipant) GetState() int32 {
+	if m != nil {
+		return m.State
+	}
+	return 0
+}
+
+func (m *ImmutableChannelParticipant) GetInviterUserId() int64 {
+	if m != nil {
+		return m.InviterUserId
+	}
+	return 0
+}
+
+func (m *ImmutableChannelParticipant) GetAdminRights() *mtproto.ChatAdminRights {
+	if m != nil {
+		return m.AdminRights
+	}
+	return nil
+}
+
+func (m *ImmutableChannelParticipant) GetBannedRights() *mtproto.ChatBannedRights {
+	if m != nil {
+		return m.BannedRights
+	}
+	return nil
+}
+
+func (m *ImmutableChannelParticipant) GetRank() string {
+	if m != nil {
+		return m.Rank
+	}
+	return ""
+}
+
+func (m *ImmutableChannelParticipant) GetPromotedBy() int64 {
+	if m != nil {
+		return m.PromotedBy
+	}
+	return 0
+}
+
+func (m *ImmutableChannelParticipant) GetKickedBy() int64 {
+	if m != nil {
+		return m.KickedBy
+	}
+	return 0
+}
+
+func (m *ImmutableChannelParticipant) GetReadInboxMaxId() int32 {
+	if m != nil {
+		return m.ReadInboxMaxId
+	}
+	return 0
+}
+
+func (m *ImmutableChannelParticipant) GetAvailableMinId() int32 {
+	if m != nil {
+		return m.AvailableMinId
+	}
+	return 0
+}
+
+func (m *ImmutableChannelParticipant) GetDate() int64 {
+	if m != nil {
+		return m.Date
+	}
+	return 0
+}
+
+// immutableChannelParticipant id:long channel_id:long user_id:long participant_type:int state:int inviter_user_id:long admin_rights:ChatAdminRights banned_rights:ChatBannedRights rank:string promoted_by:long kicked_by:long read_inbox_max_id:int available_min_id:int date:long = ImmutableChannelParticipant;
+type TLImmutableChannelParticipant struct {
+	Data2                *ImmutableChannelParticipant `protobuf:"bytes,1,opt,name=data2,proto3" json:"data2,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
+	XXX_unrecognized     []byte                       `json:"-"`
+	XXX_sizecache        int32                        `json:"-"`
+}
+
+func (m *TLImmutableChannelParticipant) Reset()         { *m = TLImmutableChannelParticipant{} }
+func (m *TLImmutableChannelParticipant) String() string { return proto.CompactTextString(m) }
+func (*TLImmutableChannelParticipant) ProtoMessage()    {}
+func (*TLImmutableChannelParticipant) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51d76c1638b87f93, []int{3}
+}
+func (m *TLImmutableChannelParticipant) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLImmutableChannelParticipant) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLImmutableChannelParticipant.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TLImmutableChannelParticipant) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLImmutableChannelParticipant.Merge(m, src)
+}
+func (m *TLImmutableChannelParticipant) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLImmutableChannelParticipant) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLImmutableChannelParticipant.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLImmutableChannelParticipant proto.InternalMessageInfo
+
+func (m *TLImmutableChannelParticipant) GetData2() *ImmutableChannelParticipant {
+	if m != nil {
+		return m.Data2
+	}
+	return nil
+}
+
+//--------------------------------------------------------------------------------------------
+// mutableChannel channel:ImmutableChannel participants:Vector<ImmutableChannelParticipant> = MutableChannel;
+//
+// MutableChannel <--
+//  + TL_mutableChannel
+//
+type MutableChannel struct {
+	PredicateName        string                         `protobuf:"bytes,1,opt,name=predicate_name,json=predicateName,proto3" json:"predicate_name,omitempty"`
+	Constructor          TLConstructor                  `protobuf:"varint,2,opt,name=constructor,proto3,enum=channel.TLConstructor" json:"constructor,omitempty"`
+	Channel              *ImmutableChannel              `protobuf:"bytes,3,opt,name=channel,proto3" json:"channel,omitempty"`
+	Participants         []*ImmutableChannelParticipant `protobuf:"bytes,4,rep,name=participants,proto3" json:"participants,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                       `json:"-"`
+	XXX_unrecognized     []byte                         `json:"-"`
+	XXX_sizecache        int32                          `json:"-"`
+}
+
+func (m *MutableChannel) Reset()         { *m = MutableChannel{} }
+func (m *MutableChannel) String() string { return proto.CompactTextString(m) }
+func (*MutableChannel) ProtoMessage()    {}
+func (*MutableChannel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51d76c1638b87f93, []int{4}
+}
+func (m *MutableChannel) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MutableChannel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MutableChannel.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MutableChannel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MutableChannel.Merge(m, src)
+}
+func (m *MutableChannel) XXX_Size() int {
+	return m.Size()
+}
+func (m *MutableChannel) XXX_DiscardUnknown() {
+	xxx_messageInfo_MutableChannel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MutableChannel proto.InternalMessageInfo
+
+func (m *MutableChannel) GetPredicateName() string {
+	if m != nil {
+		return m.PredicateName
+	}
+	return ""
+}
+
+func (m *MutableChannel) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
+	}
+	return CRC32_UNKNOWN
+}
+
+func (m *MutableChannel) GetChannel() *ImmutableChannel {
+	if m != nil {
+		return m.Channel
+	}
+	return nil
+}
+
+func (m *MutableChannel) GetParticipants() []*ImmutableChannelParticipant {
+	if m != nil {
+		return m.Participants
+	}
+	return nil
+}
+
+// mutableChannel channel:ImmutableChannel participants:Vector<ImmutableChannelParticipant> = MutableChannel;
+type TLMutableChannel struct {
+	Data2                *MutableChannel `protobuf:"bytes,1,opt,name=data2,proto3" json:"data2,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *TLMutableChannel) Reset()         { *m = TLMutableChannel{} }
+func (m *TLMutableChannel) String() string { return proto.CompactTextString(m) }
+func (*TLMutableChannel) ProtoMessage()    {}
+func (*TLMutableChannel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51d76c1638b87f93, []int{5}
+}
+func (m *TLMutableChannel) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLMutableChannel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLMutableChannel.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TLMutableChannel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLMutableChannel.Merge(m, src)
+}
+func (m *TLMutableChannel) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLMutableChannel) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLMutableChannel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLMutableChannel proto.InternalMessageInfo
+
+func (m *TLMutableChannel) GetData2() *MutableChannel {
+	if m != nil {
+		return m.Data2
+	}
+	return nil
+}
+
+//--------------------------------------------------------------------------------------------
+// channelParticipants count:int participants:Vector<ImmutableChannelParticipant> = ChannelParticipants;
+//
+// ChannelParticipants <--
+//  + TL_channelParticipants
+//
+type ChannelParticipants struct {
+	PredicateName        string                         `protobuf:"bytes,1,opt,name=predicate_name,json=predicateName,proto3" json:"predicate_name,omitempty"`
+	Constructor          TLConstructor                  `protobuf:"varint,2,opt,name=constructor,proto3,enum=channel.TLConstructor" json:"constructor,omitempty"`
+	Count                int32                          `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	Participants         []*ImmutableChannelParticipant `protobuf:"bytes,4,rep,name=participants,proto3" json:"participants,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                       `json:"-"`
+	XXX_unrecognized     []byte                         `json:"-"`
+	XXX_sizecache        int32                          `json:"-"`
+}
+
+func (m *ChannelParticipants) Reset()         { *m = ChannelParticipants{} }
+func (m *ChannelParticipants) String() string { return proto.CompactTextString(m) }
+func (*ChannelParticipants) ProtoMessage()    {}
+func (*ChannelParticipants) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51d76c1638b87f93, []int{6}
+}
+func (m *ChannelParticipants) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChannelParticipants) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChannelParticipants.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChannelParticipants) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelParticipants.Merge(m, src)
+}
+func (m *ChannelParticipants) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChannelParticipants) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelParticipants.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelParticipants proto.InternalMessageInfo
+
+func (m *ChannelParticipants) GetPredicateName() string {
+	if m != nil {
+		return m.PredicateName
+	}
+	return ""
+}
+
+func (m *ChannelParticipants) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
+	}
+	return CRC32_UNKNOWN
+}
+
+func (m *ChannelParticipants) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *ChannelParticipants) GetParticipants() []*ImmutableChannelParticipant {
+	if m != nil {
+		return m.Participants
+	}
+	return nil
+}
+
+// channelParticipants count:int participants:Vector<ImmutableChannelParticipant> = ChannelParticipants;
+type TLChannelParticipants struct {
+	Data2                *ChannelParticipants `protobuf:"bytes,1,opt,name=data2,proto3" json:"data2,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *TLChannelParticipants) Reset()         { *m = TLChannelParticipants{} }
+func (m *TLChannelParticipants) String() string { return proto.CompactTextString(m) }
+func (*TLChannelParticipants) ProtoMessage()    {}
+func (*TLChannelParticipants) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51d76c1638b87f93, []int{7}
+}
+func (m *TLChannelParticipants) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLChannelParticipants) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLChannelParticipants.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TLChannelParticipants) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLChannelParticipants.Merge(m, src)
+}
+func (m *TLChannelParticipants) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLChannelParticipants) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLChannelParticipants.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLChannelParticipants proto.InternalMessageInfo
+
+func (m *TLChannelParticipants) GetData2() *ChannelParticipants {
+	if m != nil {
+		return m.Data2
+	}
+	return nil
+}
+
+//--------------------------------------------------------------------------------------------
+// channelDifference flags:# final:flags.0?true pts:int new_messages:Vector<MessageBox> = ChannelDifference;
+//
+// ChannelDifference <--
+//  + TL_channelDifference
+//
+type ChannelDifference struct {
+	PredicateName        string                `protobuf:"bytes,1,opt,name=predicate_name,json=predicateName,proto3" json:"predicate_name,omitempty"`
+	Constructor          TLConstructor         `protobuf:"varint,2,opt,name=constructor,proto3,enum=channel.TLConstructor" json:"constructor,omitempty"`
+	Final                bool                  `protobuf:"varint,3,opt,name=final,proto3" json:"final,omitempty"`
+	Pts                  int32                 `protobuf:"varint,4,opt,name=pts,proto3" json:"pts,omitempty"`
+	NewMessages          []*mtproto.MessageBox `protobuf:"bytes,5,rep,name=new_messages,json=newMessages,proto3" json:"new_messages,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *ChannelDifference) Reset()         { *m = ChannelDifference{} }
+func (m *ChannelDifference) String() string { return proto.CompactTextString(m) }
+func (*ChannelDifference) ProtoMessage()    {}
+func (*ChannelDifference) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51d76c1638b87f93, []int{8}
+}
+func (m *ChannelDifference) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChannelDifference) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChannelDifference.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChannelDifference) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelDifference.Merge(m, src)
+}
+func (m *ChannelDifference) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChannelDifference) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelDifference.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelDifference proto.InternalMessageInfo
+
+func (m *ChannelDifference) GetPredicateName() string {
+	if m != nil {
+		return m.PredicateName
+	}
+	return ""
+}
+
+func (m *ChannelDifference) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
+	}
+	return CRC32_UNKNOWN
+}
+
+func (m *ChannelDifference) GetFinal() bool {
+	if m != nil {
+		return m.Final
+	}
+	return false
+}
+
+func (m *ChannelDifference) GetPts() int32 {
+	if m != nil {
+		return m.Pts
+	}
+	return 0
+}
+
+func (m *ChannelDifference) GetNewMessages() []*mtproto.MessageBox {
+	if m != nil {
+		return m.NewMessages
+	}
+	return nil
+}
+
+// channelDifference flags:# final:flags.0?true pts:int new_messages:Vector<MessageBox> = ChannelDifference;
+type TLChannelDifference struct {
+	Data2                *ChannelDifference `protobuf:"bytes,1,opt,name=data2,proto3" json:"data2,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *TLChannelDifference) Reset()         { *m = TLChannelDifference{} }
+func (m *TLChannelDifference) String() string { return proto.CompactTextString(m) }
+func (*TLChannelDifference) ProtoMessage()    {}
+func (*TLChannelDifference) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51d76c1638b87f93, []int{9}
+}
+func (m *TLChannelDifference) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLChannelDifference) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLChannelDifference.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TLChannelDifference) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLChannelDifference.Merge(m, src)
+}
+func (m *TLChannelDifference) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLChannelDifference) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLChannelDifference.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLChannelDifference proto.InternalMessageInfo
+
+func (m *TLChannelDifference) GetData2() *ChannelDifference {
+	if m != nil {
+		return m.Data2
+	}
+	return nil
+}
+
+//--------------------------------------------------------------------------------------------
+// channelDeletedMessages pts:int pts_count:int id:Vector<int> = ChannelDeletedMessages;
+//
+// ChannelDeletedMessages <--
+//  + TL_channelDeletedMessages
+//
+type ChannelDeletedMessages struct {
+	PredicateName        string        `protobuf:"bytes,1,opt,name=predicate_name,json=predicateName,proto3" json:"predicate_name,omitempty"`
+	Constructor          TLConstructor `protobuf:"varint,2,opt,name=constructor,proto3,enum=channel.TLConstructor" json:"constructor,omitempty"`
+	Pts                  int32         `protobuf:"varint,3,opt,name=pts,proto3" json:"pts,omitempty"`
+	PtsCount             int32         `protobuf:"varint,4,opt,name=pts_count,json=ptsCount,proto3" json:"pts_count,omitempty"`
+	Id                   []int32       `protobuf:"varint,5,rep,packed,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *ChannelDeletedMessages) Reset()         { *m = ChannelDeletedMessages{} }
+func (m *ChannelDeletedMessages) String() string { return proto.CompactTextString(m) }
+func (*ChannelDeletedMessages) ProtoMessage()    {}
+func (*ChannelDeletedMessages) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51d76c1638b87f93, []int{10}
+}
+func (m *ChannelDeletedMessages) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChannelDeletedMessages) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChannelDeletedMessages.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChannelDeletedMessages) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelDeletedMessages.Merge(m, src)
+}
+func (m *ChannelDeletedMessages) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChannelDeletedMessages) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelDeletedMessages.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelDeletedMessages proto.InternalMessageInfo
+
+func (m *ChannelDeletedMessages) GetPredicateName() string {
+	if m != nil {
+		return m.PredicateName
+	}
+	return ""
+}
+
+func (m *ChannelDeletedMessages) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
+	}
+	return CRC32_UNKNOWN
+}
+
+func (m *ChannelDeletedMessages) GetPts() int32 {
+	if m != nil {
+		return m.Pts
+	}
+	return 0
+}
+
+func (m *ChannelDeletedMessages) GetPtsCount() int32 {
+	if m != nil {
+		return m.PtsCount
+	}
+	return 0
+}
+
+func (m *ChannelDeletedMessages) GetId() []int32 {
+	if m != nil {
+		return m.Id
+	}
+	return nil
+}
+
+// channelDeletedMessages pts:int pts_count:int id:Vector<int> = ChannelDeletedMessages;
+type TLChannelDeletedMessages struct {
+	Data2                *ChannelDeletedMessages `protobuf:"bytes,1,opt,name=data2,proto3" json:"data2,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *TLChannelDeletedMessages) Reset()         { *m = TLChannelDeletedMessages{} }
+func (m *TLChannelDeletedMessages) String() string { return proto.CompactTextString(m) }
+func (*TLChannelDeletedMessages) ProtoMessage()    {}
+func (*TLChannelDeletedMessages) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51d76c1638b87f93, []int{11}
+}
+func (m *TLChannelDeletedMessages) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLChannelDeletedMessages) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLChannelDeletedMessages.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TLChannelDeletedMessages) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLChannelDeletedMessages.Merge(m, src)
+}
+func (m *TLChannelDeletedMessages) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLChannelDeletedMessages) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLChannelDeletedMessages.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLChannelDeletedMessages proto.InternalMessageInfo
+
+func (m *TLChannelDeletedMessages) GetData2() *ChannelDeletedMessages {
+	if m != nil {
+		return m.Data2
+	}
+	return nil
+}
+
+//--------------------------------------------------------------------------------------------
+// channel.getMutableChannel channel_id:long id:Vector<long> = MutableChannel;
+type TLChannelGetMutableChannel struct {
+	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=channel.TLConstructor" json:"constructor,omitempty"`
+	ChannelId            int64         `protobuf:"varint,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Id                   []int64       `protobuf:"varint,4,rep,packed,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *TLChannelGetMutableChannel) Reset()         { *m = TLChannelGetMutableChannel{} }
+func (m *TLChannelGetMutableChannel) String() string { return proto.CompactTextString(m) }
+func (*TLChannelGetMutableChannel) ProtoMessage()    {}
+func (*TLChannelGetMutableChannel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51d76c1638b87f93, []int{12}
+}
+func (m *TLChannelGetMutableChannel) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLChannelGetMutableChannel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLChannelGetMutableChannel.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TLChannelGetMutableChannel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLChannelGetMutableChannel.Merge(m, src)
+}
+func (m *TLChannelGetMutableChannel) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLChannelGetMutableChannel) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLChannelGetMutableChannel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLChannelGetMutableChannel proto.InternalMessageInfo
+
+func (m *TLChannelGetMutableChannel) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
+	}
+	return CRC32_UNKNOWN
+}
+
+func (m *TLChannelGetMutableChannel) GetChannelId() int64 {
+	if m != nil {
+		return m.ChannelId
+	}
+	return 0
+}
+
+func (m *TLChannelGetMutableChannel) GetId() []int64 {
+	if m != nil {
+		return m.Id
+	}
+	return nil
+}
+
+//--------------------------------------------------------------------------------------------
+// channel.getChannelListByIdList self_user_id:long id:Vector<long> = Vector<Chat>;
+type TLChannelGetChannelListByIdList struct {
+	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=channel.TLConstructor" json:"constructor,omitempty"`
+	SelfUserId           int64         `protobuf:"varint,3,opt,name=self_user_id,json=selfUserId,proto3" json:"self_user_id,omitempty"`
+	Id                   []int64       `protobuf:"varint,4,rep,packed,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *TLChannelGetChannelListByIdList) Reset()         { *m = TLChannelGetChannelListByIdList{} }
+func (m *TLChannelGetChannelListByIdList) String() string { return proto.CompactTextString(m) }
+func (*TLChannelGetChannelListByIdList) ProtoMessage()    {}
+func (*TLChannelGetChannelListByIdList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51d76c1638b87f93, []int{13}
+}
+func (m *TLChannelGetChannelListByIdList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLChannelGetChannelListByIdList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLChannelGetChannelListByIdList.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TLChannelGetChannelListByIdList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLChannelGetChannelListByIdList.Merge(m, src)
+}
+func (m *TLChannelGetChannelListByIdList) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLChannelGetChannelListByIdList) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLChannelGetChannelListByIdList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLChannelGetChannelListByIdList proto.InternalMessageInfo
+
+func (m *TLChannelGetChannelListByIdList) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
+	}
+	return CRC32_UNKNOWN
+}
+
+func (m *TLChannelGetChannelListByIdList) GetSelfUserId() int64 {
+	if m != nil {
+		return m.SelfUserId
+	}
+	return 0
+}
+
+func (m *TLChannelGetChannelListByIdList) GetId() []int64 {
+	if m != nil {
+		return m.Id
+	}
+	return nil
+}
+
+//--------------------------------------------------------------------------------------------
+// channel.getChannelParticipantIdList channel_id:long = Vector<long>;
+type TLChannelGetChannelParticipantIdList struct {
+	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=channel.TLConstructor" json:"constructor,omitempty"`
+	ChannelId            int64         `protobuf:"varint,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *TLChannelGetChannelParticipantIdList) Reset()         { *m = TLChannelGetChannelParticipantIdList{} }
+func (m *TLChannelGetChannelParticipantIdList) String() string { return proto.CompactTextString(m) }
+func (*TLChannelGetChannelParticipantIdList) ProtoMessage()    {}
+func (*TLChannelGetChannelParticipantIdList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51d76c1638b87f93, []int{14}
+}
+func (m *TLChannelGetChannelParticipantIdList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLChannelGetChannelParticipantIdList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLChannelGetChannelParticipantIdList.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TLChannelGetChannelParticipantIdList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLChannelGetChannelParticipantIdList.Merge(m, src)
+}
+func (m *TLChannelGetChannelParticipantIdList) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLChannelGetChannelParticipantIdList) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLChannelGetChannelParticipantIdList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLChannelGetChannelParticipantIdList proto.InternalMessageInfo
+
+func (m *TLChannelGetChannelParticipantIdList) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
+	}
+	return CRC32_UNKNOWN
+}
+
+func (m *TLChannelGetChannelParticipantIdList) GetChannelId() int64 {
+	if m != nil {
+		return m.ChannelId
+	}
+	return 0
+}
+
+//--------------------------------------------------------------------------------------------
+// channel.createChannel flags:# creator_id:long broadcast:flags.0?true megagroup:flags.1?true title:string about:string = MutableChannel;
+type TLChannelCreateChannel struct {
+	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=channel.TLConstructor" json:"constructor,omitempty"`
+	CreatorId            int64         `protobuf:"varint,3,opt,name=creator_id,json=creatorId,proto3" json:"creator_id,omitempty"`
+	Broadcast            bool          `protobuf:"varint,4,opt,name=broadcast,proto3" json:"broadcast,omitempty"`
+	Megagroup            bool          `protobuf:"varint,5,opt,name=megagroup,proto3" json:"megagroup,omitempty"`
+	Title                string        `protobuf:"bytes,6,opt,name=title,proto3" json:"title,omitempty"`
+	About                string        `protobuf:"bytes,7,opt,name=about,proto3" json:"about,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *TLChannelCreateChannel) Reset()         { *m = TLChannelCreateChannel{} }
+func (m *TLChannelCreateChannel) String() string { return proto.CompactTextString(m) }
+func (*TLChannelCreateChannel) ProtoMessage()    {}
+func (*TLChannelCreateChannel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51d76c1638b87f93, []int{15}
+}
+func (m *TLChannelCreateChannel) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLChannelCreateChannel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLChannelCreateChannel.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TLChannelCreateChannel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLChannelCreateChannel.Merge(m, src)
+}
+func (m *TLChannelCreateChannel) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLChannelCreateChannel) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLChannelCreateChannel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLChannelCreateChannel proto.InternalMessageInfo
+
+func (m *TLChannelCreateChannel) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
+	}
+	return CRC32_UNKNOWN
+}
+
+func (m *TLChannelCreateChannel) GetCreatorId() int64 {
+	if m != nil {
+		return m.CreatorId
+	}
+	return 0
+}
+
+func (m *TLChannelCreateChannel) GetBroadcast() bool {
+	if m != nil {
+		return m.Broadcast
+	}
+	return false
+}
+
+func (m *TLChannelCreateChannel) GetMegagroup() bool {
+	if m != nil {
+		return m.Megagroup
+	}
+	return false
+}
+
+func (m *TLChannelCreateChannel) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *TLChannelCreateChannel) GetAbout() string {
+	if m != nil {
+		return m.About
+	}
+	return ""
+}
+
+//--------------------------------------------------------------------------------------------
+// channel.joinChannel channel_id:long user_id:long = MutableChannel;
+type TLChannelJoinChannel struct {
+	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=channel.TLConstructor" json:"constructor,omitempty"`
+	ChannelId            int64         `protobuf:"varint,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	UserId               int64         `protobuf:"varint,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *TLChannelJoinChannel) Reset()         { *m = TLChannelJoinChannel{} }
+func (m *TLChannelJoinChannel) String() string { return proto.CompactTextString(m) }
+func (*TLChannelJoinChannel) ProtoMessage()    {}
+func (*TLChannelJoinChannel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51d76c1638b87f93, []int{16}
+}
+func (m *TLChannelJoinChannel) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLChannelJoinChannel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLChannelJoinChannel.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TLChannelJoinChannel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLChannelJoinChannel.Merge(m, src)
+}
+func (m *TLChannelJoinChannel) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLChannelJoinChannel) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLChannelJoinChannel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLChannelJoinChannel proto.InternalMessageInfo
+
+func (m *TLChannelJoinChannel) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
+	}
+	return CRC32_UNKNOWN
+}
+
+func (m *TLChannelJoinChannel) GetChannelId() int64 {
+	if m != nil {
+		return m.ChannelId
+	}
+	return 0
+}
+
+func (m *TLChannelJoinChannel) GetUserId() int64 {
+	if m != nil {
+		return m.UserId
+	}
+	return 0
+}
+
+//--------------------------------------------------------------------------------------------
+// channel.leaveChannel channel_id:long user_id:long = MutableChannel;
+type TLChannelLeaveChannel struct {
+	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=channel.TLConstructor" json:"constructor,omitempty"`
+	ChannelId            int64         `protobuf:"varint,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	UserId               int64         `protobuf:"varint,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *TLChannelLeaveChannel) Reset()         { *m = TLChannelLeaveChannel{} }
+func (m *TLChannelLeaveChannel) String() string { return proto.CompactTextString(m) }
+func (*TLChannelLeaveChannel) ProtoMessage()    {}
+func (*TLChannelLeaveChannel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51d76c1638b87f93, []int{17}
+}
+func (m *TLChannelLeaveChannel) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLChannelLeaveChannel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLChannelLeaveChannel.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TLChannelLeaveChannel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLChannelLeaveChannel.Merge(m, src)
+}
+func (m *TLChannelLeaveChannel) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLChannelLeaveChannel) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLChannelLeaveChannel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLChannelLeaveChannel proto.InternalMessageInfo
+
+func (m *TLChannelLeaveChannel) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
+	}
+	return CRC32_UNKNOWN
+}
+
+func (m *TLChannelLeaveChannel) GetChannelId() int64 {
+	if m != nil {
+		return m.ChannelId
+	}
+	return 0
+}
+
+func (m *TLChannelLeaveChannel) GetUserId() int64 {
+	if m != nil {
+		return m.UserId
+	}
+	return 0
+}
+
+//--------------------------------------------------------------------------------------------
+// channel.inviteToChannel channel_id:long inviter_id:long id:Vector<long> = MutableChannel;
+type TLChannelInviteToChannel struct {
+	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=channel.TLConstructor" json:"constructor,omitempty"`
+	ChannelId            int64         `protobuf:"varint,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	InviterId            int64         `protobuf:"varint,4,opt,name=inviter_id,json=inviterId,proto3" json:"inviter_id,omitempty"`
+	Id                   []int64       `protobuf:"varint,5,rep,packed,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *TLChannelInviteToChannel) Reset()         { *m = TLChannelInviteToChannel{} }
+func (m *TLChannelInviteToChannel) String() string { return proto.CompactTextString(m) }
+func (*TLChannelInviteToChannel) ProtoMessage()    {}
+func (*TLChannelInviteToChannel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51d76c1638b87f93, []int{18}
+}
+func (m *TLChannelInviteToChannel) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLChannelInviteToChannel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLChannelInviteToChannel.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TLChannelInviteToChannel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLChannelInviteToChannel.Merge(m, src)
+}
+func (m *TLChannelInviteToChannel) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLChannelInviteToChannel) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLChannelInviteToChannel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLChannelInviteToChannel proto.InternalMessageInfo
+
+func (m *TLChannelInviteToChannel) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
+	}
+	return CRC32_UNKNOWN
+}
+
+func (m *TLChannelInviteToChannel) GetChannelId() int64 {
+	if m != nil {
+		return m.ChannelId
+	}
+	return 0
+}
+
+func (m *TLChannelInviteToChannel) GetInviterId() int64 {
+	if m != nil {
+		return m.InviterId
+	}
+	return 0
+}
+
+func (m *TLChannelInviteToChannel) GetId() []int64 {
+	if m != nil {
+		return m.Id
+	}
+	return nil
+}
+
+//--------------------------------------------------------------------------------------------
+// channel.editAdmin channel_id:long operator_id:long user_id:long admin_rights:ChatAdminRights rank:string = MutableChannel;
+type TLChannelEditAdmin struct {
+	Constructor          TLConstructor            `protobuf:"varint,1,opt,name=constructor,proto3,enum=channel.TLConstructor" json:"constructor,omitempty"`
+	ChannelId            int64                    `protobuf:"varint,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	OperatorId           int64                    `protobuf:"varint,4,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`
+	UserId               int64                    `protobuf:"varint,5,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	AdminRights          *mtproto.ChatAdminRights `protobuf:"bytes,6,opt,name=admin_rights,json=adminRights,proto3" json:"admin_rights,omitempty"`
+	Rank                 string                   `protobuf:"bytes,7,opt,name=rank,proto3" json:"rank,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *TLChannelEditAdmin) Reset()         { *m = TLChannelEditAdmin{} }
+func (m *TLChannelEditAdmin) String() string { return proto.CompactTextString(m) }
+func (*TLChannelEditAdmin) ProtoMessage()    {}
+func (*TLChannelEditAdmin) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51d76c1638b87f93, []int{19}
+}
+func (m *TLChannelEditAdmin) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLChannelEditAdmin) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLChannelEditAdmin.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TLChannelEditAdmin) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLChannelEditAdmin.Merge(m, src)
+}
+func (m *TLChannelEditAdmin) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLChannelEditAdmin) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLChannelEditAdmin.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLChannelEditAdmin proto.InternalMessageInfo
+
+func (m *TLChannelEditAdmin) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
+	}
+	return CRC32_UNKNOWN
+}
+
+func (m *TLChannelEditAdmin) GetChannelId() int64 {
+	if m != nil {
+		return m.ChannelId
+	}
+	return 0
+}
+
+func (m *TLChannelEditAdmin) GetOperatorId() int64 {
+	if m != nil {
+		return m.OperatorId
+	}
+	return 0
+}
+
+func (m *TLChannelEditAdmin) GetUserId() int64 {
+	if m != nil {
+		return m.UserId
+	}
+	return 0
+}
+
+func (m *TLChannelEditAdmin) GetAdminRights() *mtproto.ChatAdminRights {
+	if m != nil {
+		return m.AdminRights
+	}
+	return nil
+}
+
+func (m *TLChannelEditAdmin) GetRank() string {
+	if m != nil {
+		return m.Rank
+	}
+	return ""
+}
+
+//--------------------------------------------------------------------------------------------
+// channel.editBanned channel_id:long operator_id:long user_id:long banned_rights:ChatBannedRights = MutableChannel;
+type TLChannelEditBanned struct {
+	Constructor          TLConstructor             `protobuf:"varint,1,opt,name=constructor,proto3,enum=channel.TLConstructor" json:"constructor,omitempty"`
+	ChannelId            int64                     `protobuf:"varint,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	OperatorId           int64                     `protobuf:"varint,4,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`
+	UserId               int64                     `protobuf:"varint,5,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	BannedRights         *mtproto.ChatBannedRights `protobuf:"bytes,6,opt,name=banned_rights,json=bannedRights,proto3" json:"banned_rights,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
+}
+
+func (m *TLChannelEditBanned) Reset()         { *m = TLChannelEditBanned{} }
+func (m *TLChannelEditBanned) String() string { return proto.CompactTextString(m) }
+func (*TLChannelEditBanned) ProtoMessage()    {}
+func (*TLChannelEditBanned) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51d76c1638b87f93, []int{20}
+}
+func (m *TLChannelEditBanned) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLChannelEditBanned) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLChannelEditBanned.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TLChannelEditBanned) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLChannelEditBanned.Merge(m, src)
+}
+func (m *TLChannelEditBanned) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLChannelEditBanned) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLChannelEditBanned.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLChannelEditBanned proto.InternalMessageInfo
+
+func (m *TLChannelEditBanned) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
+	}
+	return CRC32_UNKNOWN
+}
+
+func (m *TLChannelEditBanned) GetChannelId() int64 {
+	if m != nil {
+		return m.ChannelId
+	}
+	return 0
+}
+
+func (m *TLChannelEditBanned) GetOperatorId() int64 {
+	if m != nil {
+		return m.OperatorId
+	}
+	return 0
+}
+
+func (m *TLChannelEditBanned) GetUserId() int64 {
+	if m != nil {
+		return m.UserId
+	}
+	return 0
+}
+
+func (m *TLChannelEditBanned) GetBannedRights() *mtproto.ChatBannedRights {
+	if m != nil {
+		return m.BannedRights
+	}
+	return nil
+}
+
+//--------------------------------------------------------------------------------------------
+// channel.getChannelParticipants channel_id:long filter:ChannelParticipantsFilter offset:int limit:int = ChannelParticipants;
+type TLChannelGetChannelParticipants struct {
+	Constructor          TLConstructor                      `protobuf:"varint,1,opt,name=constructor,proto3,enum=channel.TLConstructor" json:"constructor,omitempty"`
+	ChannelId            int64                              `protobuf:"varint,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Filter               *mtproto.ChannelParticipantsFilter `protobuf:"bytes,4,opt,name=filter,proto3" json:"filter,omitempty"`
+	Offset               int32                              `protobuf:"varint,5,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit                int32                              `protobuf:"varint,6,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                           `json:"-"`
+	XXX_unrecognized     []byte                             `json:"-"`
+	XXX_sizecache        int32                              `json:"-"`
+}
+
+func (m *TLChannelGetChannelParticipants) Reset()         { *m = TLChannelGetChannelParticipants{} }
+func (m *TLChannelGetChannelParticipants) String() string { return proto.CompactTextString(m) }
+func (*TLChannelGetChannelParticipants) ProtoMessage()    {}
+func (*TLChannelGetChannelParticipants) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51d76c1638b87f93, []int{21}
+}
+func (m *TLChannelGetChannelParticipants) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLChannelGetChannelParticipants) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLChannelGetChannelParticipants.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TLChannelGetChannelParticipants) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLChannelGetChannelParticipants.Merge(m, src)
+}
+func (m *TLChannelGetChannelParticipants) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLChannelGetChannelParticipants) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLChannelGetChannelParticipants.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLChannelGetChannelParticipants proto.InternalMessageInfo
+
+func (m *TLChannelGetChannelParticipants) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
+	}
+	return CRC32_UNKNOWN
+}
+
+func (m *TLChannelGetChannelParticipants) GetChannelId() int64 {
+	if m != nil {
+		return m.ChannelId
+	}
+	return 0
+}
+
+func (m *TLChannelGetChannelParticipants) GetFilter() *mtproto.ChannelParticipantsFilter {
+	if m != nil {
+		return m.Filter
+	}
+	return nil
+}
+
+func (m *TLChannelGetChannelParticipants) GetOffset() int32 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *TLChannelGetChannelParticipants) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+//--------------------------------------------------------------------------------------------
+// channel.sendMessage user_id:long channel_id:long random_id:long message:Message = MessageBox;
+type TLChannelSendMessage struct {
+	Constructor          TLConstructor    `protobuf:"varint,1,opt,name=constructor,proto3,enum=channel.TLConstructor" json:"constructor,omitempty"`
+	UserId               int64            `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ChannelId            int64            `protobuf:"varint,4,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	RandomId             int64            `protobuf:"varint,5,opt,name=random_id,json=randomId,proto3" json:"random_id,omitempty"`
+	Message              *mtproto.Message `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *TLChannelSendMessage) Reset()         { *m = TLChannelSendMessage{} }
+func (m *TLChannelSendMessage) String() string { return proto.CompactTextString(m) }
+func (*TLChannelSendMessage) ProtoMessage()    {}
+func (*TLChannelSendMessage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51d76c1638b87f93, []int{22}
+}
+func (m *TLChannelSendMessage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLChannelSendMessage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLChannelSendMessage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TLChannelSendMessage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLChannelSendMessage.Merge(m, src)
+}
+func (m *TLChannelSendMessage) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLChannelSendMessage) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLChannelSendMessage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLChannelSendMessage proto.InternalMessageInfo
+
+func (m *TLChannelSendMessage) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
+	}
+	return CRC32_UNKNOWN
+}
+
+func (m *TLChannelSendMessage) GetUserId() int64 {
+	if m != nil {
+		return m.UserId
+	}
+	return 0
+}
+
+func (m *TLChannelSendMessage) GetChannelId() int64 {
+	if m != nil {
+		return m.ChannelId
+	}
+	return 0
+}
+
+func (m *TLChannelSendMessage) GetRandomId() int64 {
+	if m != nil {
+		return m.RandomId
+	}
+	return 0
+}
+
+func (m *TLChannelSendMessage) GetMessage() *mtproto.Message {
+	if m != nil {
+		return m.Message
+	}
+	return nil
+}
+
+//--------------------------------------------------------------------------------------------
+// channel.getHistory user_id:long channel_id:long offset_id:int add_offset:int limit:int max_id:int min_id:int = Vector<MessageBox>;
+type TLChannelGetHistory struct {
+	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=channel.TLConstructor" json:"constructor,omitempty"`
+	UserId               int64         `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ChannelId            int64         `protobuf:"varint,4,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	OffsetId             int32         `protobuf:"varint,5,opt,name=offset_id,json=offsetId,proto3" json:"offset_id,omitempty"`
+	AddOffset            int32         `protobuf:"varint,6,opt,name=add_offset,json=addOffset,proto3" json:"add_offset,omitempty"`
+	Limit                int32         `protobuf:"varint,7,opt,name=limit,proto3" json:"limit,omitempty"`
+	MaxId                int32         `protobuf:"varint,8,opt,name=max_id,json=maxId,proto3" json:"max_id,omitempty"`
+	MinId                int32         `protobuf:"varint,9,opt,name=min_id,json=minId,proto3" json:"min_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *TLChannelGetHistory) Reset()         { *m = TLChannelGetHistory{} }
+func (m *TLChannelGetHistory) String() string { return proto.CompactTextString(m) }
+func (*TLChannelGetHistory) ProtoMessage()    {}
+func (*TLChannelGetHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51d76c1638b87f93, []int{23}
+}
+func (m *TLChannelGetHistory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLChannelGetHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLChannelGetHistory.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TLChannelGetHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLChannelGetHistory.Merge(m, src)
+}
+func (m *TLChannelGetHistory) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLChannelGetHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLChannelGetHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLChannelGetHistory proto.InternalMessageInfo
+
+func (m *TLChannelGetHistory) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
+	}
+	return CRC32_UNKNOWN
+}
+
+func (m *TLChannelGetHistory) GetUserId() int64 {
+	if m != nil {
+		return m.UserId
+	}
+	return 0
+}
+
+func (m *TLChannelGetHistory) GetChannelId() int64 {
+	if m != nil {
+		return m.ChannelId
+	}
+	return 0
+}
+
+func (m *TLChannelGetHistory) GetOffsetId() int32 {
+	if m != nil {
+		return m.OffsetId
+	}
+	return 0
+}
+
+func (m *TLChannelGetHistory) GetAddOffset() int32 {
+	if m != nil {
+		return m.AddOffset
+	}
+	return 0
+}
+
+func (m *TLChannelGetHistory) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *TLChannelGetHistory) GetMaxId() int32 {
+	if m != nil {
+		return m.MaxId
+	}
+	return 0
+}
+
+func (m *TLChannelGetHistory) GetMinId() int32 {
+	if m != nil {
+		return m.MinId
+	}
+	return 0
+}
+
+//--------------------------------------------------------------------------------------------
+// channel.getMessages user_id:long channel_id:long id:Vector<int> = Vector<MessageBox>;
+type TLChannelGetMessages struct {
+	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=channel.TLConstructor" json:"constructor,omitempty"`
+	UserId               int64         `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ChannelId            int64         `protobuf:"varint,4,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Id                   []int32       `protobuf:"varint,5,rep,packed,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *TLChannelGetMessages) Reset()         { *m = TLChannelGetMessages{} }
+func (m *TLChannelGetMessages) String() string { return proto.CompactTextString(m) }
+func (*TLChannelGetMessages) ProtoMessage()    {}
+func (*TLChannelGetMessages) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51d76c1638b87f93, []int{24}
+}
+func (m *TLChannelGetMessages) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLChannelGetMessages) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLChannelGetMessages.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TLChannelGetMessages) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLChannelGetMessages.Merge(m, src)
+}
+func (m *TLChannelGetMessages) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLChannelGetMessages) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLChannelGetMessages.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLChannelGetMessages proto.InternalMessageInfo
+
+func (m *TLChannelGetMessages) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
+	}
+	return CRC32_UNKNOWN
+}
+
+func (m *TLChannelGetMessages) GetUserId() int64 {
+	if m != nil {
+		return m.UserId
+	}
+	return 0
+}
+
+func (m *TLChannelGetMessages) GetChannelId() int64 {
+	if m != nil {
+		return m.ChannelId
+	}
+	return 0
+}
+
+func (m *TLChannelGetMessages) GetId() []int32 {
+	if m != nil {
+		return m.Id
+	}
+	return nil
+}
+
+//--------------------------------------------------------------------------------------------
+// channel.readHistory user_id:long channel_id:long max_id:int = Bool;
+type TLChannelReadHistory struct {
+	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=channel.TLConstructor" json:"constructor,omitempty"`
+	UserId               int64         `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ChannelId            int64         `protobuf:"varint,4,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	MaxId                int32         `protobuf:"varint,5,opt,name=max_id,json=maxId,proto3" json:"max_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *TLChannelReadHistory) Reset()         { *m = TLChannelReadHistory{} }
+func (m *TLChannelReadHistory) String() string { return proto.CompactTextString(m) }
+func (*TLChannelReadHistory) ProtoMessage()    {}
+func (*TLChannelReadHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51d76c1638b87f93, []int{25}
+}
+func (m *TLChannelReadHistory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLChannelReadHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLChannelReadHistory.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TLChannelReadHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLChannelReadHistory.Merge(m, src)
+}
+func (m *TLChannelReadHistory) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLChannelReadHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLChannelReadHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLChannelReadHistory proto.InternalMessageInfo
+
+func (m *TLChannelReadHistory) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
+	}
+	return CRC32_UNKNOWN
+}
+
+func (m *TLChannelReadHistory) GetUserId() int64 {
+	if m != nil {
+		return m.UserId
+	}
+	return 0
+}
+
+func (m *TLChannelReadHistory) GetChannelId() int64 {
+	if m != nil {
+		return m.ChannelId
+	}
+	return 0
+}
+
+func (m *TLChannelReadHistory) GetMaxId() int32 {
+	if m != nil {
+		return m.MaxId
+	}
+	return 0
+}
+
+//--------------------------------------------------------------------------------------------
+// channel.getChannelDifference user_id:long channel_id:long pts:int limit:int = ChannelDifference;
+type TLChannelGetChannelDifference struct {
+	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=channel.TLConstructor" json:"constructor,omitempty"`
+	UserId               int64         `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ChannelId            int64         `protobuf:"varint,4,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Pts                  int32         `protobuf:"varint,5,opt,name=pts,proto3" json:"pts,omitempty"`
+	Limit                int32         `protobuf:"varint,6,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *TLChannelGetChannelDifference) Reset()         { *m = TLChannelGetChannelDifference{} }
+func (m *TLChannelGetChannelDifference) String() string { return proto.CompactTextString(m) }
+func (*TLChannelGetChannelDifference) ProtoMessage()    {}
+func (*TLChannelGetChannelDifference) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51d76c1638b87f93, []int{26}
+}
+func (m *TLChannelGetChannelDifference) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLChannelGetChannelDifference) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLChannelGetChannelDifference.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TLChannelGetChannelDifference) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLChannelGetChannelDifference.Merge(m, src)
+}
+func (m *TLChannelGetChannelDifference) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLChannelGetChannelDifference) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLChannelGetChannelDifference.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLChannelGetChannelDifference proto.InternalMessageInfo
+
+func (m *TLChannelGetChannelDifference) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
+	}
+	return CRC32_UNKNOWN
+}
+
+func (m *TLChannelGetChannelDifference) GetUserId() int64 {
+	if m != nil {
+		return m.UserId
+	}
+	return 0
+}
+
+func (m *TLChannelGetChannelDifference) GetChannelId() int64 {
+	if m != nil {
+		return m.ChannelId
+	}
+	return 0
+}
+
+func (m *TLChannelGetChannelDifference) GetPts() int32 {
+	if m != nil {
+		return m.Pts
+	}
+	return 0
+}
+
+func (m *TLChannelGetChannelDifference) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+//--------------------------------------------------------------------------------------------
+// channel.editTitle channel_id:long operator_id:long title:string = MutableChannel;
+type TLChannelEditTitle struct {
+	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=channel.TLConstructor" json:"constructor,omitempty"`
+	ChannelId            int64         `protobuf:"varint,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	OperatorId           int64         `protobuf:"varint,4,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`
+	Title                string        `protobuf:"bytes,5,opt,name=title,proto3" json:"title,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *TLChannelEditTitle) Reset()         { *m = TLChannelEditTitle{} }
+func (m *TLChannelEditTitle) String() string { return proto.CompactTextString(m) }
+func (*TLChannelEditTitle) ProtoMessage()    {}
+func (*TLChannelEditTitle) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51d76c1638b87f93, []int{27}
+}
+func (m *TLChannelEditTitle) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLChannelEditTitle) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLChannelEditTitle.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TLChannelEditTitle) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLChannelEditTitle.Merge(m, src)
+}
+func (m *TLChannelEditTitle) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLChannelEditTitle) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLChannelEditTitle.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLChannelEditTitle proto.InternalMessageInfo
+
+func (m *TLChannelEditTitle) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
+	}
+	return CRC32_UNKNOWN
+}
+
+func (m *TLChannelEditTitle) GetChannelId() int64 {
+	if m != nil {
+		return m.ChannelId
+	}
+	return 0
+}
+
+func (m *TLChannelEditTitle) GetOperatorId() int64 {
+	if m != nil {
+		return m.OperatorId
+	}
+	return 0
+}
+
+func (m *TLChannelEditTitle) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+//--------------------------------------------------------------------------------------------
+// channel.editPhoto channel_id:long operator_id:long photo:Photo = MutableChannel;
+type TLChannelEditPhoto struct {
+	Constructor          TLConstructor  `protobuf:"varint,1,opt,name=constructor,proto3,enum=channel.TLConstructor" json:"constructor,omitempty"`
+	ChannelId            int64          `protobuf:"varint,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	OperatorId           int64          `protobuf:"varint,4,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`
+	Photo                *mtproto.Photo `protobuf:"bytes,5,opt,name=photo,proto3" json:"photo,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *TLChannelEditPhoto) Reset()         { *m = TLChannelEditPhoto{} }
+func (m *TLChannelEditPhoto) String() string { return proto.CompactTextString(m) }
+func (*TLChannelEditPhoto) ProtoMessage()    {}
+func (*TLChannelEditPhoto) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51d76c1638b87f93, []int{28}
+}
+func (m *TLChannelEditPhoto) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLChannelEditPhoto) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLChannelEditPhoto.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TLChannelEditPhoto) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLChannelEditPhoto.Merge(m, src)
+}
+func (m *TLChannelEditPhoto) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLChannelEditPhoto) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLChannelEditPhoto.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLChannelEditPhoto proto.InternalMessageInfo
+
+func (m *TLChannelEditPhoto) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
+	}
+	return CRC32_UNKNOWN
+}
+
+func (m *TLChannelEditPhoto) GetChannelId() int64 {
+	if m != nil {
+		return m.ChannelId
+	}
+	return 0
+}
+
+func (m *TLChannelEditPhoto) GetOperatorId() int64 {
+	if m != nil {
+		return m.OperatorId
+	}
+	return 0
+}
+
+func (m *TLChannelEditPhoto) GetPhoto() *mtproto.Photo {
+	if m != nil {
+		return m.Photo
+	}
+	return nil
+}
+
+//--------------------------------------------------------------------------------------------
+// channel.toggleSignatures channel_id:long operator_id:long enabled:Bool = MutableChannel;
+type TLChannelToggleSignatures struct {
+	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=channel.TLConstructor" json:"constructor,omitempty"`
+	ChannelId            int64         `protobuf:"varint,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	OperatorId           int64         `protobuf:"varint,4,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`
+	Enabled              *mtproto.Bool `protobuf:"bytes,5,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *TLChannelToggleSignatures) Reset()         { *m = TLChannelToggleSignatures{} }
+func (m *TLChannelToggleSignatures) String() string { return proto.CompactTextString(m) }
+func (*TLChannelToggleSignatures) ProtoMessage()    {}
+func (*TLChannelToggleSignatures) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51d76c1638b87f93, []int{29}
+}
+func (m *TLChannelToggleSignatures) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLChannelToggleSignatures) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLChannelToggleSignatures.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TLChannelToggleSignatures) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLChannelToggleSignatures.Merge(m, src)
+}
+func (m *TLChannelToggleSignatures) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLChannelToggleSignatures) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLChannelToggleSignatures.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLChannelToggleSignatures proto.InternalMessageInfo
+
+func (m *TLChannelToggleSignatures) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
+	}
+	return CRC32_UNKNOWN
+}
+
+func (m *TLChannelToggleSignatures) GetChannelId() int64 {
+	if m != nil {
+		return m.ChannelId
+	}
+	return 0
+}
+
+func (m *TLChannelToggleSignatures) GetOperatorId() int64 {
+	if m != nil {
+		return m.OperatorId
+	}
+	return 0
+}
+
+func (m *TLChannelToggleSignatures) GetEnabled() *mtproto.Bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return nil
+}
+
+//--------------------------------------------------------------------------------------------
+// channel.togglePreHistoryHidden channel_id:long operator_id:long enabled:Bool = MutableChannel;
+type TLChannelTogglePreHistoryHidden struct {
+	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=channel.TLConstructor" json:"constructor,omitempty"`
+	ChannelId            int64         `protobuf:"varint,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	OperatorId           int64         `protobuf:"varint,4,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`
+	Enabled              *mtproto.Bool `protobuf:"bytes,5,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *TLChannelTogglePreHistoryHidden) Reset()         { *m = TLChannelTogglePreHistoryHidden{} }
+func (m *TLChannelTogglePreHistoryHidden) String() string { return proto.CompactTextString(m) }
+func (*TLChannelTogglePreHistoryHidden) ProtoMessage()    {}
+func (*TLChannelTogglePreHistoryHidden) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51d76c1638b87f93, []int{30}
+}
+func (m *TLChannelTogglePreHistoryHidden) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLChannelTogglePreHistoryHidden) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLChannelTogglePreHistoryHidden.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TLChannelTogglePreHistoryHidden) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLChannelTogglePreHistoryHidden.Merge(m, src)
+}
+func (m *TLChannelTogglePreHistoryHidden) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLChannelTogglePreHistoryHidden) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLChannelTogglePreHistoryHidden.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLChannelTogglePreHistoryHidden proto.InternalMessageInfo
+
+func (m *TLChannelTogglePreHistoryHidden) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
+	}
+	return CRC32_UNKNOWN
+}
+
+func (m *TLChannelTogglePreHistoryHidden) GetChannelId() int64 {
+	if m != nil {
+		return m.ChannelId
+	}
+	return 0
+}
+
+func (m *TLChannelTogglePreHistoryHidden) GetOperatorId() int64 {
+	if m != nil {
+		return m.OperatorId
+	}
+	return 0
+}
+
+func (m *TLChannelTogglePreHistoryHidden) GetEnabled() *mtproto.Bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return nil
+}
+
+//--------------------------------------------------------------------------------------------
+// channel.toggleSlowMode channel_id:long operator_id:long seconds:int = MutableChannel;
+type TLChannelToggleSlowMode struct {
+	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=channel.TLConstructor" json:"constructor,omitempty"`
+	ChannelId            int64         `protobuf:"varint,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	OperatorId           int64         `protobuf:"varint,4,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`
+	Seconds              int32         `protobuf:"varint,5,opt,name=seconds,proto3" json:"seconds,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *TLChannelToggleSlowMode) Reset()         { *m = TLChannelToggleSlowMode{} }
+func (m *TLChannelToggleSlowMode) String() string { return proto.CompactTextString(m) }
+func (*TLChannelToggleSlowMode) ProtoMessage()    {}
+func (*TLChannelToggleSlowMode) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51d76c1638b87f93, []int{31}
+}
+func (m *TLChannelToggleSlowMode) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLChannelToggleSlowMode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLChannelToggleSlowMode.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TLChannelToggleSlowMode) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLChannelToggleSlowMode.Merge(m, src)
+}
+func (m *TLChannelToggleSlowMode) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLChannelToggleSlowMode) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLChannelToggleSlowMode.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLChannelToggleSlowMode proto.InternalMessageInfo
+
+func (m *TLChannelToggleSlowMode) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
+	}
+	return CRC32_UNKNOWN
+}
+
+func (m *TLChannelToggleSlowMode) GetChannelId() int64 {
+	if m != nil {
+		return m.ChannelId
+	}
+	return 0
+}
+
+func (m *TLChannelToggleSlowMode) GetOperatorId() int64 {
+	if m != nil {
+		return m.OperatorId
+	}
+	return 0
+}
+
+func (m *TLChannelToggleSlowMode) GetSeconds() int32 {
+	if m != nil {
+		return m.Seconds
+	}
+	return 0
+}
+
+//--------------------------------------------------------------------------------------------
+// channel.deleteChannel channel_id:long operator_id:long = MutableChannel;
+type TLChannelDeleteChannel struct {
+	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=channel.TLConstructor" json:"constructor,omitempty"`
+	ChannelId            int64         `protobuf:"varint,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	OperatorId           int64         `protobuf:"varint,4,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *TLChannelDeleteChannel) Reset()         { *m = TLChannelDeleteChannel{} }
+func (m *TLChannelDeleteChannel) String() string { return proto.CompactTextString(m) }
+func (*TLChannelDeleteChannel) ProtoMessage()    {}
+func (*TLChannelDeleteChannel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51d76c1638b87f93, []int{32}
+}
+func (m *TLChannelDeleteChannel) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLChannelDeleteChannel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLChannelDeleteChannel.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TLChannelDeleteChannel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLChannelDeleteChannel.Merge(m, src)
+}
+func (m *TLChannelDeleteChannel) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLChannelDeleteChannel) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLChannelDeleteChannel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLChannelDeleteChannel proto.InternalMessageInfo
+
+func (m *TLChannelDeleteChannel) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
+	}
+	return CRC32_UNKNOWN
+}
+
+func (m *TLChannelDeleteChannel) GetChannelId() int64 {
+	if m != nil {
+		return m.ChannelId
+	}
+	return 0
+}
+
+func (m *TLChannelDeleteChannel) GetOperatorId() int64 {
+	if m != nil {
+		return m.OperatorId
+	}
+	return 0
+}
+
+//--------------------------------------------------------------------------------------------
+// channel.deleteMessages user_id:long channel_id:long id:Vector<int> = ChannelDeletedMessages;
+type TLChannelDeleteMessages struct {
+	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=channel.TLConstructor" json:"constructor,omitempty"`
+	UserId               int64         `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ChannelId            int64         `protobuf:"varint,4,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Id                   []int32       `protobuf:"varint,5,rep,packed,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *TLChannelDeleteMessages) Reset()         { *m = TLChannelDeleteMessages{} }
+func (m *TLChannelDeleteMessages) String() string { return proto.CompactTextString(m) }
+func (*TLChannelDeleteMessages) ProtoMessage()    {}
+func (*TLChannelDeleteMessages) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51d76c1638b87f93, []int{33}
+}
+func (m *TLChannelDeleteMessages) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLChannelDeleteMessages) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLChannelDeleteMessages.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TLChannelDeleteMessages) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLChannelDeleteMessages.Merge(m, src)
+}
+func (m *TLChannelDeleteMessages) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLChannelDeleteMessages) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLChannelDeleteMessages.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLChannelDeleteMessages proto.InternalMessageInfo
+
+func (m *TLChannelDeleteMessages) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
+	}
+	return CRC32_UNKNOWN
+}
+
+func (m *TLChannelDeleteMessages) GetUserId() int64 {
+	if m != nil {
+		return m.UserId
+	}
+	return 0
+}
+
+func (m *TLChannelDeleteMessages) GetChannelId() int64 {
+	if m != nil {
+		return m.ChannelId
+	}
+	return 0
+}
+
+func (m *TLChannelDeleteMessages) GetId() []int32 {
+	if m != nil {
+		return m.Id
+	}
+	return nil
+}
+
+//--------------------------------------------------------------------------------------------
+// channel.deleteParticipantHistory operator_id:long channel_id:long participant_id:long limit:int = ChannelDeletedMessages;
+type TLChannelDeleteParticipantHistory struct {
+	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=channel.TLConstructor" json:"constructor,omitempty"`
+	OperatorId           int64         `protobuf:"varint,3,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`
+	ChannelId            int64         `protobuf:"varint,4,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	ParticipantId        int64         `protobuf:"varint,5,opt,name=participant_id,json=participantId,proto3" json:"participant_id,omitempty"`
+	Limit                int32         `protobuf:"varint,6,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *TLChannelDeleteParticipantHistory) Reset()         { *m = TLChannelDeleteParticipantHistory{} }
+func (m *TLChannelDeleteParticipantHistory) String() string { return proto.CompactTextString(m) }
+func (*TLChannelDeleteParticipantHistory) ProtoMessage()    {}
+func (*TLChannelDeleteParticipantHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51d76c1638b87f93, []int{34}
+}
+func (m *TLChannelDeleteParticipantHistory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLChannelDeleteParticipantHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLChannelDeleteParticipantHistory.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TLChannelDeleteParticipantHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLChannelDeleteParticipantHistory.Merge(m, src)
+}
+func (m *TLChannelDeleteParticipantHistory) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLChannelDeleteParticipantHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLChannelDeleteParticipantHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLChannelDeleteParticipantHistory proto.InternalMessageInfo
+
+func (m *TLChannelDeleteParticipantHistory) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
+	}
+	return CRC32_UNKNOWN
+}
+
+func (m *TLChannelDeleteParticipantHistory) GetOperatorId() int64 {
+	if m != nil {
+		return m.OperatorId
+	}
+	return 0
+}
+
+func (m *TLChannelDeleteParticipantHistory) GetChannelId() int64 {
+	if m != nil {
+		return m.ChannelId
+	}
+	return 0
+}
+
+func (m *TLChannelDeleteParticipantHistory) GetParticipantId() int64 {
+	if m != nil {
+		return m.ParticipantId
+	}
+	return 0
+}
+
+func (m *TLChannelDeleteParticipantHistory) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+//--------------------------------------------------------------------------------------------
+// channel.deleteHistory flags:# user_id:long channel_id:long for_everyone:flags.0?true max_id:int = MutableChannel;
+type TLChannelDeleteHistory struct {
+	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=channel.TLConstructor" json:"constructor,omitempty"`
+	UserId               int64         `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ChannelId            int64         `protobuf:"varint,4,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	ForEveryone          bool          `protobuf:"varint,5,opt,name=for_everyone,json=forEveryone,proto3" json:"for_everyone,omitempty"`
+	MaxId                int32         `protobuf:"varint,6,opt,name=max_id,json=maxId,proto3" json:"max_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *TLChannelDeleteHistory) Reset()         { *m = TLChannelDeleteHistory{} }
+func (m *TLChannelDeleteHistory) String() string { return proto.CompactTextString(m) }
+func (*TLChannelDeleteHistory) ProtoMessage()    {}
+func (*TLChannelDeleteHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51d76c1638b87f93, []int{35}
+}
+func (m *TLChannelDeleteHistory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLChannelDeleteHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLChannelDeleteHistory.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TLChannelDeleteHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLChannelDeleteHistory.Merge(m, src)
+}
+func (m *TLChannelDeleteHistory) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLChannelDeleteHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLChannelDeleteHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLChannelDeleteHistory proto.InternalMessageInfo
+
+func (m *TLChannelDeleteHistory) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
+	}
+	return CRC32_UNKNOWN
+}
+
+func (m *TLChannelDeleteHistory) GetUserId() int64 {
+	if m != nil {
+		return m.UserId
+	}
+	return 0
+}
+
+func (m *TLChannelDeleteHistory) GetChannelId() int64 {
+	if m != nil {
+		return m.ChannelId
+	}
+	return 0
+}
+
+func (m *TLChannelDeleteHistory) GetForEveryone() bool {
+	if m != nil {
+		return m.ForEveryone
+	}
+	return false
+}
+
+func (m *TLChannelDeleteHistory) GetMaxId() int32 {
+	if m != nil {
+		return m.MaxId
+	}
+	return 0
+}
+
+//--------------------------------------------------------------------------------------------
+// Vector api result type
+type Vector_Chat struct {
+	Datas                []*mtproto.Chat `protobuf:"bytes,1,rep,name=datas,proto3" json:"datas,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *Vector_Chat) Reset()         { *m = Vector_Chat{} }
+func (m *Vector_Chat) String() string { return proto.CompactTextString(m) }
+func (*Vector_Chat) ProtoMessage()    {}
+func (*Vector_Chat) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51d76c1638b87f93, []int{36}
+}
+func (m *Vector_Chat) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Vector_Chat) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Vector_Chat.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Vector_Chat) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Vector_Chat.Merge(m, src)
+}
+func (m *Vector_Chat) XXX_Size() int {
+	return m.Size()
+}
+func (m *Vector_Chat) XXX_DiscardUnknown() {
+	xxx_messageInfo_Vector_Chat.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Vector_Chat proto.InternalMessageInfo
+
+func (m *Vector_Chat) GetDatas() []*mtproto.Chat {
+	if m != nil {
+		return m.Datas
+	}
+	return nil
+}
+
+type Vector_Long struct {
+	Datas                []int64  `protobuf:"varint,1,rep,packed,name=datas,proto3" json:"datas,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Vector_Long) Reset()         { *m = Vector_Long{} }
+func (m *Vector_Long) String() string { return proto.CompactTextString(m) }
+func (*Vector_Long) ProtoMessage()    {}
+func (*Vector_Long) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51d76c1638b87f93, []int{37}
+}
+func (m *Vector_Long) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Vector_Long) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Vector_Long.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Vector_Long) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Vector_Long.Merge(m, src)
+}
+func (m *Vector_Long) XXX_Size() int {
+	return m.Size()
+}
+func (m *Vector_Long) XXX_DiscardUnknown() {
+	xxx_messageInfo_Vector_Long.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Vector_Long proto.InternalMessageInfo
+
+func (m *Vector_Long) GetDatas() []int64 {
+	if m != nil {
+		return m.Datas
+	}
+	return nil
+}
+
+type Vector_MessageBox struct {
+	Datas                []*mtproto.MessageBox `protobuf:"bytes,1,rep,name=datas,proto3" json:"datas,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *Vector_MessageBox) Reset()         { *m = Vector_MessageBox{} }
+func (m *Vector_MessageBox) String() string { return proto.CompactTextString(m) }
+func (*Vector_MessageBox) ProtoMessage()    {}
+func (*Vector_MessageBox) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51d76c1638b87f93, []int{38}
+}
+func (m *Vector_MessageBox) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Vector_MessageBox) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Vector_MessageBox.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Vector_MessageBox) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Vector_MessageBox.Merge(m, src)
+}
+func (m *Vector_MessageBox) XXX_Size() int {
+	return m.Size()
+}
+func (m *Vector_MessageBox) XXX_DiscardUnknown() {
+	xxx_messageInfo_Vector_MessageBox.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Vector_MessageBox proto.InternalMessageInfo
+
+func (m *Vector_MessageBox) GetDatas() []*mtproto.MessageBox {
+	if m != nil {
+		return m.Datas
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("channel.TLConstructor", TLConstructor_name, TLConstructor_value)
+	proto.RegisterType((*ImmutableChannel)(nil), "channel.ImmutableChannel")
+	proto.RegisterType((*TLImmutableChannel)(nil), "channel.TL_immutableChannel")
+	proto.RegisterType((*ImmutableChannelParticipant)(nil), "channel.ImmutableChannelParticipant")
+	proto.RegisterType((*TLImmutableChannelParticipant)(nil), "channel.TL_immutableChannelParticipant")
+	proto.RegisterType((*MutableChannel)(nil), "channel.MutableChannel")
+	proto.RegisterType((*TLMutableChannel)(nil), "channel.TL_mutableChannel")
+	proto.RegisterType((*ChannelParticipants)(nil), "channel.ChannelParticipants")
+	proto.RegisterType((*TLChannelParticipants)(nil), "channel.TL_channelParticipants")
+	proto.RegisterType((*ChannelDifference)(nil), "channel.ChannelDifference")
+	proto.RegisterType((*TLChannelDifference)(nil), "channel.TL_channelDifference")
+	proto.RegisterType((*ChannelDeletedMessages)(nil), "channel.ChannelDeletedMessages")
+	proto.RegisterType((*TLChannelDeletedMessages)(nil), "channel.TL_channelDeletedMessages")
+	proto.RegisterType((*TLChannelGetMutableChannel)(nil), "channel.TL_channel_getMutableChannel")
+	proto.RegisterType((*TLChannelGetChannelListByIdList)(nil), "channel.TL_channel_getChannelListByIdList")
+	proto.RegisterType((*TLChannelGetChannelParticipantIdList)(nil), "channel.TL_channel_getChannelParticipantIdList")
+	proto.RegisterType((*TLChannelCreateChannel)(nil), "channel.TL_channel_createChannel")
+	proto.RegisterType((*TLChannelJoinChannel)(nil), "channel.TL_channel_joinChannel")
+	proto.RegisterType((*TLChannelLeaveChannel)(nil), "channel.TL_channel_leaveChannel")
+	proto.RegisterType((*TLChannelInviteToChannel)(nil), "channel.TL_channel_inviteToChannel")
+	proto.RegisterType((*TLChannelEditAdmin)(nil), "channel.TL_channel_editAdmin")
+	proto.RegisterType((*TLChannelEditBanned)(nil), "channel.TL_channel_editBanned")
+	proto.RegisterType((*TLChannelGetChannelParticipants)(nil), "channel.TL_channel_getChannelParticipants")
+	proto.RegisterType((*TLChannelSendMessage)(nil), "channel.TL_channel_sendMessage")
+	proto.RegisterType((*TLChannelGetHistory)(nil), "channel.TL_channel_getHistory")
+	proto.RegisterType((*TLChannelGetMessages)(nil), "channel.TL_channel_getMessages")
+	proto.RegisterType((*TLChannelReadHistory)(nil), "channel.TL_channel_readHistory")
+	proto.RegisterType((*TLChannelGetChannelDifference)(nil), "channel.TL_channel_getChannelDifference")
+	proto.RegisterType((*TLChannelEditTitle)(nil), "channel.TL_channel_editTitle")
+	proto.RegisterType((*TLChannelEditPhoto)(nil), "channel.TL_channel_editPhoto")
+	proto.RegisterType((*TLChannelToggleSignatures)(nil), "channel.TL_channel_toggleSignatures")
+	proto.RegisterType((*TLChannelTogglePreHistoryHidden)(nil), "channel.TL_channel_togglePreHistoryHidden")
+	proto.RegisterType((*TLChannelToggleSlowMode)(nil), "channel.TL_channel_toggleSlowMode")
+	proto.RegisterType((*TLChannelDeleteChannel)(nil), "channel.TL_channel_deleteChannel")
+	proto.RegisterType((*TLChannelDeleteMessages)(nil), "channel.TL_channel_deleteMessages")
+	proto.RegisterType((*TLChannelDeleteParticipantHistory)(nil), "channel.TL_channel_deleteParticipantHistory")
+	proto.RegisterType((*TLChannelDeleteHistory)(nil), "channel.TL_channel_deleteHistory")
+	proto.RegisterType((*Vector_Chat)(nil), "channel.Vector_Chat")
+	proto.RegisterType((*Vector_Long)(nil), "channel.Vector_Long")
+	proto.RegisterType((*Vector_MessageBox)(nil), "channel.Vector_MessageBox")
+}
+
+func init() { proto.RegisterFile("channel.tl.proto", fileDescriptor_51d76c1638b87f93) }
+
+var fileDescriptor_51d76c1638b87f93 = []byte{
+	// 2715 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x59, 0x6f, 0x6c, 0x1c, 0x47,
+	0x15, 0xf7, 0xfa, 0x7c, 0x67, 0xdf, 0xf3, 0x9f, 0xac, 0xc7, 0x8e, 0xb3, 0x3e, 0x3b, 0xe7, 0xf3,
+	0xda, 0x0d, 0x8e, 0x69, 0x6c, 0x70, 0xd5, 0xaa, 0x6a, 0xa5, 0x4a, 0xd8, 0x50, 0x62, 0x61, 0xa7,
+	0x61, 0xe3, 0x52, 0xf1, 0x4f, 0xab, 0xf5, 0xed, 0xdc, 0x79, 0xe9, 0xdd, 0xee, 0x69, 0x77, 0x6d,
+	0xc7, 0x7c, 0xa2, 0x48, 0x40, 0x9a, 0x4a, 0xb4, 0x85, 0x00, 0x12, 0xd0, 0x0f, 0x11, 0x55, 0x29,
+	0x91, 0x50, 0x3f, 0x20, 0x5a, 0x44, 0x11, 0x84, 0x42, 0x9b, 0x26, 0x95, 0x50, 0x45, 0x49, 0x21,
+	0xa4, 0x14, 0x12, 0xa4, 0x06, 0x42, 0x54, 0x55, 0x48, 0xad, 0x42, 0x53, 0x1a, 0xb4, 0x33, 0xbb,
+	0xb7, 0xb3, 0x7f, 0x6e, 0x9d, 0x28, 0x75, 0x5c, 0x3e, 0xdd, 0xce, 0x7b, 0x6f, 0xde, 0xbc, 0xf9,
+	0xcd, 0x7b, 0xf3, 0xde, 0xbc, 0x03, 0xbe, 0xb8, 0xa4, 0xe8, 0x3a, 0xae, 0x4c, 0xd8, 0x95, 0x89,
+	0x9a, 0x69, 0xd8, 0x06, 0x6a, 0x75, 0x29, 0xb9, 0x5d, 0x65, 0xcd, 0x5e, 0x5a, 0x5e, 0x9c, 0x28,
+	0x1a, 0xd5, 0xc9, 0xb2, 0x51, 0x36, 0x26, 0x09, 0x7f, 0x71, 0xb9, 0x44, 0x46, 0x64, 0x40, 0xbe,
+	0xe8, 0xbc, 0x5c, 0xbe, 0x6c, 0x18, 0xe5, 0x0a, 0xf6, 0xa5, 0x56, 0x4d, 0xa5, 0x56, 0xc3, 0xa6,
+	0xe5, 0xf2, 0x73, 0x56, 0x71, 0x09, 0x57, 0x15, 0x67, 0xa1, 0xa2, 0x61, 0x62, 0xd9, 0x5e, 0xab,
+	0x61, 0x8f, 0xd7, 0xef, 0xf3, 0x6c, 0x53, 0xd1, 0xad, 0x9a, 0x61, 0xda, 0x2e, 0xab, 0xd7, 0x67,
+	0x59, 0x6b, 0x7a, 0x91, 0x52, 0xc5, 0xfb, 0x32, 0xc0, 0xcf, 0x56, 0xab, 0xcb, 0xb6, 0xb2, 0x58,
+	0xc1, 0x33, 0xd4, 0x60, 0x74, 0x03, 0x74, 0xd5, 0x4c, 0xac, 0x6a, 0x45, 0xc5, 0xc6, 0xb2, 0xae,
+	0x54, 0xb1, 0xc0, 0x15, 0xb8, 0xb1, 0xac, 0xd4, 0x59, 0xa7, 0xee, 0x51, 0xaa, 0x18, 0xdd, 0x0a,
+	0xed, 0x45, 0x43, 0xb7, 0x6c, 0x73, 0xb9, 0x68, 0x1b, 0xa6, 0xd0, 0x5c, 0xe0, 0xc6, 0xba, 0xa6,
+	0xfa, 0x26, 0x3c, 0x20, 0x16, 0xe6, 0x66, 0x7c, 0xae, 0xc4, 0x8a, 0xa2, 0x2e, 0x68, 0xd6, 0x54,
+	0x21, 0x55, 0xe0, 0xc6, 0x52, 0x52, 0xb3, 0xa6, 0xa2, 0x21, 0x68, 0x57, 0x8a, 0x45, 0x6c, 0x59,
+	0xf2, 0x92, 0x62, 0x2d, 0x09, 0x2d, 0x84, 0x01, 0x94, 0xb4, 0x5b, 0xb1, 0x96, 0x90, 0x00, 0xad,
+	0x45, 0x13, 0x2b, 0xce, 0x32, 0x69, 0xc2, 0xf4, 0x86, 0xa8, 0x17, 0xd2, 0xb6, 0x66, 0x57, 0xb0,
+	0x90, 0x21, 0x26, 0xd2, 0x81, 0x43, 0x55, 0x16, 0x8d, 0x65, 0x5b, 0x68, 0xa5, 0x54, 0x32, 0x40,
+	0xa3, 0x90, 0xae, 0x2d, 0x19, 0xb6, 0x21, 0xb4, 0x15, 0xb8, 0xb1, 0xf6, 0xa9, 0xae, 0x89, 0xaa,
+	0x4d, 0x50, 0x98, 0xd8, 0xeb, 0x50, 0x25, 0xca, 0x44, 0x83, 0x90, 0x5d, 0x34, 0x0d, 0x45, 0x2d,
+	0x2a, 0x96, 0x2d, 0x64, 0x0b, 0xdc, 0x58, 0x9b, 0xe4, 0x13, 0x1c, 0x6e, 0x15, 0x97, 0x95, 0xb2,
+	0x69, 0x2c, 0xd7, 0x04, 0xa0, 0xdc, 0x3a, 0x01, 0xe5, 0x01, 0x2c, 0xad, 0xac, 0x2b, 0xf6, 0xb2,
+	0x89, 0x2d, 0xa1, 0x9d, 0xb0, 0x19, 0x8a, 0xc3, 0xd7, 0x8d, 0x92, 0x61, 0xae, 0x2a, 0xa6, 0x6a,
+	0x09, 0x1d, 0x94, 0xef, 0x53, 0x50, 0x01, 0xda, 0x55, 0xac, 0x14, 0x6d, 0x6d, 0x45, 0xb1, 0xb1,
+	0x2a, 0x74, 0x12, 0x01, 0x96, 0x84, 0x3e, 0x08, 0xdd, 0x4b, 0x9a, 0xaa, 0x62, 0x5d, 0xae, 0x99,
+	0x78, 0x49, 0xb3, 0x6c, 0xc3, 0x5c, 0x13, 0xba, 0x88, 0x1c, 0x4f, 0x19, 0x7b, 0xeb, 0x74, 0x94,
+	0x83, 0xb6, 0x65, 0x0b, 0x9b, 0xe4, 0x08, 0xb7, 0x10, 0x24, 0xea, 0x63, 0xb4, 0x0b, 0x50, 0x4d,
+	0x31, 0x6d, 0xad, 0xa8, 0xd5, 0x14, 0xdd, 0xb6, 0xe4, 0xa2, 0xb1, 0xac, 0xdb, 0x02, 0x5f, 0xe0,
+	0xc6, 0xd2, 0x52, 0x37, 0xcb, 0x99, 0x71, 0x18, 0x68, 0x1e, 0xb6, 0xaa, 0xb8, 0xa4, 0x2c, 0x57,
+	0x6c, 0x79, 0xd1, 0x39, 0x5f, 0x55, 0x36, 0xb5, 0xf2, 0x92, 0x6d, 0x09, 0xdd, 0x04, 0xcb, 0xfe,
+	0x3a, 0x96, 0x33, 0x4b, 0x8a, 0x3d, 0x4d, 0x24, 0x24, 0x22, 0x20, 0xf5, 0xb8, 0xf3, 0x58, 0x22,
+	0xda, 0x09, 0xbc, 0x55, 0x31, 0x56, 0xab, 0x86, 0x8a, 0x65, 0x0b, 0x17, 0x0d, 0x5d, 0xb5, 0x04,
+	0x44, 0xd6, 0xde, 0xe2, 0xd1, 0xf7, 0x51, 0x32, 0xe2, 0x21, 0x55, 0xb3, 0x2d, 0xa1, 0x87, 0x70,
+	0x9d, 0x4f, 0xc7, 0x5d, 0x6c, 0xa3, 0x26, 0x57, 0xb1, 0x65, 0x29, 0x65, 0x2c, 0xf4, 0x12, 0x0e,
+	0xd8, 0x46, 0x6d, 0x9e, 0x52, 0x10, 0x82, 0x16, 0x55, 0xb1, 0xb1, 0xb0, 0x95, 0xf8, 0x0a, 0xf9,
+	0x76, 0x5c, 0x68, 0x05, 0x9b, 0x96, 0x66, 0xe8, 0x42, 0x1f, 0x99, 0xe0, 0x0d, 0xc5, 0x3b, 0xa1,
+	0x67, 0x61, 0x4e, 0xd6, 0xc2, 0x51, 0x30, 0x09, 0x69, 0x55, 0xb1, 0x95, 0x29, 0x81, 0x73, 0x77,
+	0xe8, 0x39, 0x76, 0x38, 0x5e, 0x24, 0x2a, 0x27, 0x9e, 0x6b, 0x81, 0x81, 0x30, 0x6f, 0xaf, 0x0f,
+	0xe4, 0xf5, 0x0f, 0xab, 0xed, 0x00, 0xee, 0x2c, 0x59, 0x53, 0xdd, 0xa8, 0xca, 0xba, 0x94, 0x59,
+	0x15, 0x6d, 0x83, 0x56, 0xc7, 0x1b, 0x1c, 0x1e, 0x0d, 0xaa, 0x8c, 0x33, 0x9c, 0x55, 0x9d, 0xc3,
+	0x61, 0x1c, 0x80, 0x5c, 0x30, 0x24, 0xbc, 0xd2, 0xd2, 0x16, 0x86, 0xbe, 0xb0, 0x56, 0x23, 0x81,
+	0x66, 0xd9, 0x0e, 0xd4, 0xad, 0x84, 0x4f, 0x07, 0x68, 0x07, 0x6c, 0xd1, 0xf4, 0x15, 0xcd, 0xc6,
+	0xa6, 0xec, 0xad, 0xd0, 0x46, 0x56, 0xe8, 0x74, 0xc9, 0x77, 0xd3, 0x85, 0x6e, 0x87, 0x0e, 0x45,
+	0xad, 0x6a, 0xba, 0xe7, 0x4b, 0x59, 0x82, 0xb4, 0x10, 0xf0, 0xa5, 0x8f, 0x38, 0x02, 0xae, 0x2b,
+	0xb5, 0x2b, 0xfe, 0x00, 0xdd, 0x01, 0x9d, 0x41, 0x4f, 0x84, 0xf5, 0x3c, 0xb1, 0x63, 0x91, 0x19,
+	0x39, 0x4e, 0x62, 0x2a, 0xfa, 0xbd, 0x24, 0x4a, 0xb3, 0x12, 0xf9, 0x76, 0x3c, 0xab, 0x66, 0x1a,
+	0x55, 0xc3, 0xc6, 0xaa, 0xbc, 0xb8, 0x46, 0x02, 0x34, 0x25, 0x81, 0x47, 0x9a, 0x5e, 0x43, 0x03,
+	0x90, 0xbd, 0x57, 0x2b, 0xde, 0x4b, 0xd9, 0x9d, 0x84, 0xdd, 0x46, 0x09, 0xd3, 0x6b, 0x68, 0x27,
+	0x74, 0x9b, 0x58, 0x51, 0x65, 0x4d, 0x5f, 0x34, 0xf6, 0xcb, 0x55, 0x65, 0xbf, 0xb3, 0xf1, 0x2e,
+	0x02, 0x4c, 0x97, 0xc3, 0x98, 0x75, 0xe8, 0xf3, 0xca, 0xfe, 0x59, 0x15, 0x8d, 0x01, 0xaf, 0xac,
+	0x28, 0x5a, 0xc5, 0x71, 0x15, 0xd9, 0x41, 0x40, 0x53, 0x49, 0x84, 0xa6, 0xa5, 0xae, 0x3a, 0x7d,
+	0x5e, 0xd3, 0x67, 0xd5, 0xba, 0x2f, 0xf3, 0xbe, 0x2f, 0x8b, 0x9f, 0x83, 0x7c, 0x8c, 0xc7, 0xb2,
+	0xbe, 0x76, 0x5b, 0xd0, 0x79, 0x47, 0x1b, 0x3a, 0x2f, 0x33, 0xc9, 0xf3, 0xe3, 0x37, 0x38, 0xe8,
+	0x9a, 0xbf, 0xce, 0x19, 0xe1, 0x26, 0xf0, 0xd2, 0xa5, 0x90, 0x72, 0x8f, 0xb1, 0x61, 0xb8, 0x79,
+	0x92, 0x68, 0x37, 0x74, 0xb0, 0x17, 0x95, 0xd0, 0x52, 0x48, 0x5d, 0xf1, 0x5e, 0x03, 0x33, 0xc5,
+	0x69, 0xe8, 0x5e, 0x98, 0x93, 0x83, 0xd2, 0x68, 0x57, 0x10, 0xc3, 0x6d, 0x75, 0xbd, 0xf3, 0xb1,
+	0xe1, 0xff, 0x32, 0x07, 0x3d, 0xd1, 0x85, 0xac, 0x8d, 0xc7, 0xae, 0x17, 0xd2, 0xf4, 0xf2, 0x4e,
+	0xd1, 0x18, 0x24, 0x83, 0xf7, 0x10, 0x9c, 0x39, 0xe8, 0x5b, 0x98, 0x93, 0x8b, 0x31, 0x5b, 0x9b,
+	0x0a, 0x22, 0x34, 0x58, 0x57, 0x1e, 0x83, 0x83, 0x07, 0xd3, 0x1f, 0x38, 0xe8, 0x76, 0xd9, 0x1f,
+	0xd5, 0x4a, 0x25, 0x6c, 0x62, 0xbd, 0x88, 0xaf, 0x0b, 0x48, 0x25, 0x4d, 0x57, 0xa8, 0x7b, 0xb5,
+	0x49, 0x74, 0xe0, 0xe5, 0x96, 0x16, 0x3f, 0xb7, 0xdc, 0x02, 0x1d, 0x3a, 0x5e, 0xf5, 0x72, 0x8b,
+	0x25, 0xa4, 0x09, 0x6c, 0x3d, 0xf5, 0x4b, 0xc5, 0x4d, 0x31, 0xd3, 0xc6, 0x7e, 0xa9, 0x5d, 0xc7,
+	0xab, 0xee, 0xd0, 0x12, 0x77, 0x43, 0xaf, 0x0f, 0x12, 0xb3, 0xb1, 0x0f, 0x05, 0x21, 0xca, 0x85,
+	0x21, 0xf2, 0x45, 0x3d, 0x80, 0x7e, 0xc6, 0x41, 0x9f, 0xc7, 0xc4, 0x15, 0x6c, 0x63, 0xd5, 0x5b,
+	0x64, 0xe3, 0x51, 0x72, 0xf1, 0x48, 0xf9, 0x78, 0x0c, 0x40, 0xb6, 0x56, 0xaf, 0x0e, 0x28, 0x4e,
+	0x6d, 0x35, 0xaf, 0x28, 0xa0, 0x09, 0xc7, 0x81, 0x28, 0xed, 0x24, 0x1c, 0x51, 0x82, 0x7e, 0x06,
+	0x84, 0x90, 0xf1, 0x37, 0x07, 0x91, 0x18, 0x8a, 0x20, 0x11, 0x94, 0xf7, 0xe0, 0xf8, 0x1a, 0x07,
+	0x83, 0xbe, 0x52, 0xb9, 0x8c, 0xed, 0xd0, 0xdd, 0x14, 0xda, 0x2d, 0x77, 0xe5, 0xbb, 0x0d, 0xe6,
+	0xc7, 0x54, 0x38, 0x3f, 0xd2, 0xdd, 0x39, 0x71, 0x43, 0xd2, 0xa9, 0xf8, 0x20, 0x07, 0xc3, 0x41,
+	0x4b, 0x5c, 0x13, 0xe6, 0x34, 0xcb, 0x9e, 0x5e, 0x9b, 0x55, 0x9d, 0xdf, 0x6b, 0x30, 0xa7, 0x00,
+	0x1d, 0x16, 0xae, 0x94, 0xea, 0x29, 0x93, 0x1a, 0x04, 0x0e, 0xcd, 0xcd, 0x97, 0x61, 0x8b, 0xee,
+	0xe3, 0x60, 0x47, 0xac, 0x45, 0x4c, 0xe0, 0x5d, 0xb3, 0x59, 0xc9, 0x28, 0x89, 0xa7, 0x39, 0x10,
+	0x18, 0x1b, 0x48, 0x5d, 0xfe, 0x1e, 0x9d, 0x0d, 0x2d, 0xf1, 0xd9, 0x55, 0x29, 0x65, 0x56, 0x0d,
+	0x16, 0xe9, 0x2d, 0x89, 0x45, 0x7a, 0x3a, 0x5c, 0xa4, 0x5f, 0xc5, 0x93, 0x41, 0x3c, 0xc8, 0xb1,
+	0x97, 0x9f, 0xfc, 0x05, 0x43, 0xd3, 0x37, 0xdc, 0xef, 0x98, 0xba, 0xac, 0x85, 0xad, 0xcb, 0xc4,
+	0x07, 0x38, 0xd8, 0xc6, 0x18, 0x53, 0xc1, 0xca, 0x0a, 0xde, 0x3c, 0x6b, 0x1e, 0xe5, 0x20, 0xc7,
+	0x58, 0x43, 0x2b, 0xbb, 0x05, 0x63, 0xc3, 0x0d, 0xda, 0x0e, 0xe0, 0x15, 0x97, 0x7e, 0x55, 0xeb,
+	0x52, 0x66, 0x55, 0xe6, 0x4e, 0xa2, 0x31, 0xf2, 0x36, 0xc7, 0xde, 0xcc, 0x32, 0x56, 0x35, 0x5a,
+	0x52, 0x6e, 0x9c, 0x81, 0x43, 0xd0, 0x6e, 0xd4, 0xb0, 0xe9, 0xf9, 0xae, 0xfb, 0x9a, 0xf5, 0x48,
+	0x49, 0x85, 0x77, 0xb8, 0x1e, 0xce, 0x5c, 0x4d, 0x3d, 0xec, 0xd5, 0xb3, 0xad, 0x7e, 0x3d, 0x2b,
+	0x9e, 0xe7, 0x60, 0x6b, 0x68, 0xf3, 0xb4, 0x22, 0x7e, 0x3f, 0xee, 0x3e, 0x52, 0xd0, 0x67, 0xae,
+	0xaa, 0xa0, 0x17, 0x5f, 0x6b, 0x74, 0x3f, 0x07, 0x6a, 0x96, 0x0d, 0xdb, 0xf8, 0x6d, 0x90, 0x29,
+	0x69, 0x15, 0x1b, 0x9b, 0x64, 0xcf, 0xed, 0x53, 0x22, 0x6b, 0x77, 0xd8, 0x8c, 0x3b, 0x89, 0xa4,
+	0xe4, 0xce, 0x40, 0x7d, 0x90, 0x31, 0x4a, 0x25, 0x0b, 0xdb, 0x04, 0x92, 0xb4, 0xe4, 0x8e, 0x9c,
+	0x4b, 0xa9, 0xa2, 0x55, 0x35, 0xdb, 0x7d, 0x7e, 0xd1, 0x81, 0xf8, 0x52, 0xf0, 0x52, 0xb2, 0xb0,
+	0xee, 0x65, 0xcd, 0x6b, 0xd8, 0x1d, 0x73, 0x2c, 0xa9, 0xc0, 0xb1, 0xac, 0xf3, 0x8a, 0x1c, 0x80,
+	0xac, 0xa9, 0xe8, 0xaa, 0x51, 0xf5, 0x0f, 0xb4, 0x8d, 0x12, 0x66, 0x55, 0x34, 0x0e, 0xad, 0xde,
+	0x2b, 0x9d, 0x1e, 0x26, 0x1f, 0x2e, 0xa4, 0x24, 0x4f, 0x40, 0xbc, 0xbf, 0x39, 0xe0, 0xab, 0x65,
+	0x6c, 0xef, 0x76, 0xdb, 0x18, 0x9b, 0xb2, 0x29, 0x7a, 0x02, 0xde, 0xa6, 0xd2, 0x52, 0x1b, 0x25,
+	0xd0, 0xb9, 0x8a, 0xaa, 0xca, 0xee, 0x81, 0xd1, 0x93, 0xc9, 0x2a, 0xaa, 0x7a, 0x57, 0xe8, 0xcc,
+	0x5a, 0x99, 0x33, 0x43, 0x5b, 0x21, 0xe3, 0x3e, 0x08, 0xdb, 0x28, 0xb9, 0x4a, 0xde, 0x81, 0x0e,
+	0x99, 0xbe, 0xfe, 0xb2, 0x2e, 0xd9, 0x79, 0xf4, 0x89, 0xdf, 0x0d, 0x9e, 0xb0, 0x53, 0xf4, 0x78,
+	0x65, 0xd4, 0xf5, 0x07, 0x23, 0x5c, 0xe5, 0x1d, 0x0e, 0x1a, 0xe7, 0xbc, 0x6c, 0x37, 0xef, 0xa4,
+	0x7c, 0x5c, 0xd3, 0x0c, 0xae, 0xe2, 0xd3, 0x1c, 0x0c, 0xc5, 0xde, 0x05, 0x4c, 0x69, 0x7e, 0xfd,
+	0x8d, 0x75, 0xcb, 0xeb, 0xb4, 0x5f, 0x5e, 0xc7, 0x07, 0xf8, 0x63, 0xd1, 0x9c, 0xb5, 0x40, 0x8a,
+	0x94, 0xcd, 0xbb, 0xb5, 0xeb, 0x45, 0x53, 0x9a, 0x29, 0x9a, 0xc4, 0x27, 0xa3, 0x86, 0x92, 0x5e,
+	0xea, 0x26, 0x1a, 0x5a, 0x6f, 0xf2, 0xa6, 0x13, 0x9a, 0xbc, 0xe2, 0x51, 0x0e, 0x06, 0x18, 0xc3,
+	0x6d, 0xa3, 0x5c, 0xae, 0xe0, 0x7d, 0x7e, 0xa3, 0x76, 0xf3, 0xec, 0xff, 0x00, 0xb4, 0x62, 0xdd,
+	0x79, 0xdf, 0xa8, 0xee, 0x0e, 0x3a, 0xeb, 0x3b, 0x98, 0x36, 0x8c, 0x8a, 0xe4, 0x71, 0xc5, 0xe7,
+	0x82, 0xe9, 0x8e, 0x6e, 0x61, 0xaf, 0x89, 0xdd, 0x58, 0xdc, 0x4d, 0x9a, 0xc1, 0xff, 0x0f, 0x1b,
+	0x79, 0x82, 0x83, 0xfe, 0xc8, 0x46, 0xf6, 0x55, 0x8c, 0xd5, 0x79, 0x43, 0xdd, 0x4c, 0x97, 0x17,
+	0xa0, 0xd5, 0x6b, 0x4d, 0xd3, 0x88, 0xf5, 0x86, 0xe2, 0xa1, 0xe0, 0x9b, 0x47, 0x25, 0x2f, 0xd7,
+	0x0d, 0x2f, 0x7c, 0xd7, 0x33, 0x58, 0x7c, 0x24, 0x08, 0x24, 0x35, 0xeb, 0x7d, 0x94, 0x38, 0x5e,
+	0xe1, 0x60, 0x24, 0x62, 0x1f, 0x53, 0x15, 0x5d, 0x7b, 0x16, 0x09, 0x41, 0x94, 0x8a, 0x9c, 0xe9,
+	0x3a, 0x16, 0x3b, 0x0d, 0x16, 0xdf, 0x1e, 0xbf, 0xa2, 0xe9, 0xac, 0xb1, 0x0f, 0xea, 0x06, 0xb7,
+	0xf6, 0x73, 0x71, 0x5e, 0xb1, 0x79, 0x99, 0x71, 0x18, 0x3a, 0x4a, 0x86, 0x29, 0xe3, 0x15, 0x6c,
+	0xae, 0x19, 0x3a, 0x76, 0xdf, 0xc1, 0xed, 0x25, 0xc3, 0xfc, 0x98, 0x4b, 0x62, 0x92, 0x67, 0x86,
+	0x4d, 0x9e, 0x53, 0xd0, 0xfe, 0x29, 0xec, 0xac, 0x2d, 0x3b, 0x15, 0x37, 0x1a, 0xa1, 0x8d, 0x1b,
+	0x4b, 0xe0, 0x0a, 0xa9, 0x40, 0x18, 0x3b, 0x5c, 0xda, 0xa6, 0xb1, 0xc4, 0x91, 0xfa, 0x9c, 0x39,
+	0x43, 0x2f, 0x3b, 0x08, 0xf9, 0x73, 0x52, 0x9e, 0xd0, 0x1d, 0xd0, 0xed, 0x0a, 0xf9, 0x6d, 0x34,
+	0xb4, 0x33, 0xa8, 0x3e, 0xb6, 0xd5, 0x46, 0x25, 0xc6, 0x5f, 0xcb, 0x42, 0x67, 0x00, 0x29, 0xd4,
+	0x0d, 0x9d, 0x33, 0xd2, 0xcc, 0x4d, 0x53, 0xf2, 0xdd, 0x7b, 0x3e, 0xb1, 0xe7, 0xae, 0x7b, 0xf6,
+	0xf0, 0x4d, 0x68, 0x04, 0xfa, 0x28, 0x29, 0xdc, 0x1f, 0xe7, 0x5f, 0xfa, 0xc5, 0xfd, 0x5f, 0xb9,
+	0x78, 0xf9, 0xf2, 0xe5, 0xcb, 0x1c, 0xda, 0x09, 0xc3, 0xf1, 0x42, 0x8c, 0x3b, 0xf2, 0x8f, 0x9f,
+	0x3a, 0x79, 0xa8, 0x15, 0x0d, 0x43, 0x2f, 0x15, 0x0d, 0x69, 0x7b, 0xe6, 0xcb, 0x3f, 0xfa, 0xed,
+	0x7f, 0xa9, 0xb6, 0x61, 0xe8, 0xa7, 0x22, 0x31, 0x4d, 0x52, 0xfe, 0xc5, 0x9f, 0xff, 0xf3, 0x57,
+	0x29, 0x34, 0x0a, 0xdb, 0x02, 0x22, 0x7e, 0x1d, 0xc2, 0x3f, 0x7e, 0xe1, 0xf4, 0xc9, 0x77, 0xa9,
+	0xa2, 0x51, 0x18, 0x0c, 0x4a, 0x05, 0x7b, 0x62, 0xfc, 0x3f, 0x4e, 0xfd, 0xfa, 0x1b, 0x29, 0x74,
+	0x23, 0x0c, 0x05, 0xa4, 0xa2, 0x4d, 0x31, 0xfe, 0xd4, 0xef, 0x8f, 0x3e, 0xe1, 0xea, 0xbc, 0x11,
+	0x46, 0x23, 0xd2, 0x31, 0x8d, 0x2b, 0xfe, 0x81, 0x57, 0x0f, 0xbf, 0xc2, 0xa1, 0x5b, 0x60, 0x67,
+	0x23, 0xe9, 0x48, 0x53, 0x89, 0x3f, 0xf3, 0xc2, 0x53, 0x7f, 0x72, 0x01, 0x1d, 0x81, 0x81, 0xe0,
+	0xbc, 0x40, 0x23, 0x88, 0x3f, 0x72, 0xe2, 0xf5, 0x8b, 0x69, 0xb4, 0x23, 0x84, 0x13, 0xdb, 0x4f,
+	0xe1, 0xcf, 0x1f, 0x3c, 0x79, 0xe6, 0x1d, 0x0f, 0xcf, 0x5c, 0x50, 0x8e, 0x6d, 0x75, 0xf0, 0xff,
+	0x7e, 0xf4, 0x85, 0x65, 0x34, 0x0e, 0xdb, 0x83, 0x22, 0xa1, 0xfe, 0x03, 0xff, 0xc8, 0x0f, 0x9f,
+	0x3f, 0xf6, 0x1f, 0x0f, 0xd5, 0x20, 0xf6, 0x7e, 0x13, 0x80, 0xff, 0xe3, 0x83, 0x17, 0x8e, 0xba,
+	0x8b, 0x16, 0x40, 0x88, 0x4a, 0xd1, 0xe7, 0x26, 0x7f, 0xfc, 0xcd, 0xdf, 0xfc, 0x3d, 0x83, 0x3e,
+	0x0c, 0xa3, 0x57, 0x80, 0x8d, 0xc5, 0x9f, 0x7b, 0xea, 0xc8, 0x33, 0xae, 0x67, 0x44, 0x76, 0xcc,
+	0x3c, 0xd6, 0xf8, 0x43, 0xc7, 0xfe, 0xfc, 0xd8, 0x25, 0x2a, 0x77, 0x43, 0x78, 0x71, 0xff, 0xf9,
+	0xc3, 0x5f, 0xfa, 0xea, 0x4f, 0xbf, 0x7d, 0x31, 0xde, 0xd1, 0xd8, 0x97, 0x01, 0xff, 0xad, 0x67,
+	0x1f, 0xfe, 0x52, 0x73, 0x74, 0x45, 0xa6, 0x3e, 0xe7, 0x4f, 0x1e, 0x7b, 0xf5, 0xdd, 0xb7, 0xa9,
+	0xaa, 0x71, 0x10, 0x1b, 0x6d, 0x86, 0xf1, 0xcd, 0xc3, 0x6f, 0x3d, 0xf4, 0xbb, 0x16, 0x34, 0x14,
+	0x07, 0x20, 0xa9, 0x48, 0xf9, 0x93, 0x17, 0x7e, 0x72, 0xb0, 0x19, 0xe5, 0xe3, 0x04, 0x48, 0xc1,
+	0xc5, 0x1f, 0xff, 0xfe, 0x91, 0x9b, 0xd1, 0x0e, 0xc8, 0x07, 0xf9, 0xe1, 0x82, 0x8b, 0x7f, 0xf9,
+	0xad, 0xd7, 0xcf, 0xa7, 0xa2, 0x08, 0xc7, 0x57, 0x35, 0xfc, 0xd7, 0xff, 0x7a, 0xe2, 0x3b, 0xef,
+	0x78, 0x91, 0x3c, 0x18, 0xab, 0xda, 0xad, 0x1f, 0xf8, 0x37, 0xff, 0xf5, 0xe4, 0x2f, 0xdd, 0x48,
+	0x10, 0xc3, 0x3e, 0x1a, 0x48, 0xdc, 0xfc, 0x81, 0x87, 0xbf, 0x79, 0x6b, 0x24, 0x02, 0x43, 0x59,
+	0x94, 0x7f, 0xfa, 0xa1, 0x67, 0x4f, 0x64, 0xd0, 0x04, 0xec, 0x88, 0x93, 0x8a, 0xe6, 0x32, 0xfe,
+	0x2f, 0xdf, 0x3b, 0x77, 0x3c, 0x8d, 0xc6, 0xe2, 0x57, 0xf6, 0x84, 0x4e, 0x1f, 0xb8, 0xf4, 0x63,
+	0xc7, 0xc4, 0xcb, 0x5c, 0xae, 0xe5, 0xc0, 0x0f, 0xf2, 0x4d, 0x53, 0x87, 0xba, 0x01, 0xa4, 0xbd,
+	0x33, 0x5e, 0x49, 0xf1, 0x79, 0xe8, 0x6f, 0xdc, 0xff, 0xbe, 0x81, 0x49, 0x22, 0x8d, 0x6f, 0x84,
+	0x5c, 0xa3, 0xbf, 0xaf, 0xc4, 0x26, 0xa4, 0x42, 0x7e, 0x9d, 0xa6, 0xf6, 0x78, 0x83, 0x35, 0x62,
+	0x64, 0x73, 0xbd, 0x75, 0x59, 0x26, 0x89, 0x88, 0x4d, 0x48, 0x87, 0x91, 0x2b, 0x69, 0x54, 0x4f,
+	0x26, 0x2f, 0x15, 0x99, 0x10, 0x5d, 0xcf, 0x49, 0x40, 0x62, 0x13, 0xba, 0x1b, 0xb6, 0xc6, 0x37,
+	0xa5, 0x87, 0xe3, 0x56, 0x08, 0x88, 0x24, 0x81, 0xf5, 0x49, 0xe8, 0x89, 0xeb, 0x06, 0x0f, 0xc5,
+	0x29, 0x65, 0x04, 0x92, 0x54, 0xee, 0x83, 0xde, 0xd8, 0x9e, 0x6e, 0x21, 0x4e, 0x27, 0x2b, 0x91,
+	0xa4, 0xf4, 0xd3, 0xb0, 0xad, 0x51, 0x6b, 0x76, 0x24, 0x4e, 0x6f, 0x48, 0x28, 0x49, 0xf5, 0x3c,
+	0x74, 0x47, 0xdb, 0xa9, 0xdb, 0xe3, 0x94, 0xd6, 0xd9, 0x49, 0xea, 0xee, 0x02, 0x14, 0xd3, 0xa0,
+	0xcc, 0x37, 0xd2, 0x47, 0xf9, 0x49, 0x0a, 0x2b, 0x90, 0x4f, 0x74, 0x1c, 0x6b, 0x3d, 0x7f, 0x66,
+	0x65, 0x73, 0x89, 0xff, 0x6a, 0x12, 0x34, 0x7a, 0xe2, 0x3a, 0x71, 0xb1, 0x0e, 0xc1, 0x08, 0xe4,
+	0xe2, 0x0a, 0x1d, 0xb1, 0x09, 0x49, 0x80, 0xa2, 0x39, 0x20, 0x1e, 0x0d, 0x9f, 0x9f, 0xcb, 0x85,
+	0x83, 0x20, 0xa0, 0x73, 0x01, 0x7a, 0xd8, 0x8b, 0xc1, 0x7b, 0x11, 0x0c, 0x35, 0xba, 0x39, 0x5c,
+	0x81, 0x75, 0xb4, 0x7e, 0x1c, 0x7a, 0x62, 0x72, 0x4c, 0xbc, 0x56, 0x46, 0x20, 0x17, 0x7c, 0x07,
+	0x8a, 0x4d, 0xa8, 0x04, 0x83, 0x89, 0x8d, 0x9a, 0xb1, 0xe4, 0xd3, 0xf2, 0x25, 0x73, 0x09, 0x7f,
+	0xaf, 0x46, 0xfd, 0x96, 0xb6, 0x54, 0x1a, 0xfa, 0x2d, 0x61, 0x5f, 0x45, 0x18, 0xd0, 0xc6, 0x47,
+	0x43, 0x75, 0x84, 0x9d, 0xa4, 0xee, 0xb3, 0x20, 0x34, 0x6c, 0x47, 0x8c, 0xc6, 0x69, 0x0d, 0x4b,
+	0x25, 0x29, 0x2f, 0x43, 0x3e, 0x38, 0x2d, 0xd2, 0x28, 0x18, 0x6f, 0xbc, 0x44, 0x58, 0x36, 0x69,
+	0xa1, 0x7b, 0xa0, 0xaf, 0xc1, 0x43, 0x5e, 0x4c, 0xd8, 0x83, 0x2b, 0x93, 0xa4, 0x98, 0xb9, 0xce,
+	0x83, 0xef, 0xed, 0xd8, 0xeb, 0x3c, 0x20, 0x92, 0xa4, 0x56, 0x86, 0xbe, 0xe0, 0x9c, 0x7a, 0x74,
+	0x88, 0x8d, 0xf5, 0xd6, 0x03, 0x64, 0xbd, 0x3f, 0xb1, 0xc5, 0x26, 0x64, 0x41, 0x61, 0xdd, 0x07,
+	0xef, 0x8d, 0x8d, 0x97, 0x8a, 0x4a, 0x5f, 0xc9, 0xa2, 0x11, 0xb0, 0xbc, 0x95, 0x12, 0xc0, 0xf2,
+	0xd4, 0x37, 0x06, 0x6b, 0x7a, 0xfe, 0x8d, 0x33, 0x79, 0xee, 0xf9, 0xb3, 0x79, 0xee, 0xc5, 0xb3,
+	0x79, 0xee, 0x6f, 0x67, 0xf3, 0xdc, 0x67, 0x6e, 0xb7, 0xb1, 0x52, 0x2d, 0x9b, 0x4a, 0x75, 0x42,
+	0x33, 0x26, 0xbd, 0xef, 0x5d, 0x16, 0x36, 0x57, 0xb0, 0x39, 0xa9, 0xd4, 0x6a, 0x93, 0xce, 0xa7,
+	0x56, 0xc4, 0x93, 0x8b, 0xda, 0x17, 0x27, 0x5d, 0xbd, 0xde, 0xef, 0x62, 0x86, 0xdc, 0x02, 0x37,
+	0xfd, 0x6f, 0x00, 0x23, 0x18, 0xc9, 0x5e, 0x3c, 0x2b, 0x00, 0x00,
+}
+
+func (this *ImmutableChannel) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 26)
+	s = append(s, "&channel.ImmutableChannel{")
+	s = append(s, "PredicateName: "+fmt.Sprintf("%#v", this.PredicateName)+",\n")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
+	s = append(s, "AccessHash: "+fmt.Sprintf("%#v", this.AccessHash)+",\n")
+	s = append(s, "Creator: "+fmt.Sprintf("%#v", this.Creator)+",\n")
+	s = append(s, "Title: "+fmt.Sprintf("%#v", this.Title)+",\n")
+	s = append(s, "About: "+fmt.Sprintf("%#v", this.About)+",\n")
+	if this.Photo != nil {
+		s = append(s, "Photo: "+fmt.Sprintf("%#v", this.Photo)+",\n")
+	}
+	s = append(s, "Broadcast: "+fmt.Sprintf("%#v", this.Broadcast)+",\n")
+	s = append(s, "Megagroup: "+fmt.Sprintf("%#v", this.Megagroup)+",\n")
+	s = append(s, "Signatures: "+fmt.Sprintf("%#v", this.Signatures)+",\n")
+	s = append(s, "Noforwards: "+fmt.Sprintf("%#v", this.Noforwards)+",\n")
+	s = append(s, "Deactivated: "+fmt.Sprintf("%#v", this.Deactivated)+",\n")
+	s = append(s, "HiddenPrehistory: "+fmt.Sprintf("%#v", this.HiddenPrehistory)+",\n")
+	s = append(s, "Username: "+fmt.Sprintf("%#v", this.Username)+",\n")
+	s = append(s, "ParticipantsCount: "+fmt.Sprintf("%#v", this.ParticipantsCount)+",\n")
+	if this.DefaultBannedRights != nil {
+		s = append(s, "DefaultBannedRights: "+fmt.Sprintf("%#v", this.DefaultBannedRights)+",\n")
+	}
+	s = append(s, "SlowmodeSeconds: "+fmt.Sprintf("%#v", this.SlowmodeSeconds)+",\n")
+	s = append(s, "Pts: "+fmt.Sprintf("%#v", this.Pts)+",\n")
+	s = append(s, "TopMessage: "+fmt.Sprintf("%#v", this.TopMessage)+",\n")
+	s = append(s, "Date: "+fmt.Sprintf("%#v", this.Date)+",\n")
+	s = append(s, "Version: "+fmt.Sprintf("%#v", this.Version)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLImmutableChannel) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&channel.TLImmutableChannel{")
+	if this.Data2 != nil {
+		s = append(s, "Data2: "+fmt.Sprintf("%#v", this.Data2)+",\n")
+	}
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ImmutableChannelParticipant) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 20)
+	s = append(s, "&channel.ImmutableChannelParticipant{")
+	s = append(s, "PredicateName: "+fmt.Sprintf("%#v", this.PredicateName)+",\n")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
+	s = append(s, "ChannelId: "+fmt.Sprintf("%#v", this.ChannelId)+",\n")
+	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
+	s = append(s, "ParticipantType: "+fmt.Sprintf("%#v", this.ParticipantType)+",\n")
+	s = append(s, "State: "+fmt.Sprintf("%#v", this.State)+",\n")
+	s = append(s, "InviterUserId: "+fmt.Sprintf("%#v", this.InviterUserId)+",\n")
+	if this.AdminRights != nil {
+		s = append(s, "AdminRights: "+fmt.Sprintf("%#v", this.AdminRights)+",\n")
+	}
+	if this.BannedRights != nil {
+		s = append(s, "BannedRights: "+fmt.Sprintf("%#v", this.BannedRights)+",\n")
+	}
+	s = append(s, "Rank: "+fmt.Sprintf("%#v", this.Rank)+",\n")
+	s = append(s, "PromotedBy: "+fmt.Sprintf("%#v", this.PromotedBy)+",\n")
+	s = append(s, "KickedBy: "+fmt.Sprintf("%#v", this.KickedBy)+",\n")
+	s = append(s, "ReadInboxMaxId: "+fmt.Sprintf("%#v", this.ReadInboxMaxId)+",\n")
+	s = append(s, "AvailableMinId: "+fmt.Sprintf("%#v", this.AvailableMinId)+",\n")
+	s = append(s, "Date: "+fmt.Sprintf("%#v", this.Date)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLImmutableChannelParticipant) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&channel.TLImmutableChannelParticipant{")
+	if this.Data2 != nil {
+		s = append(s, "Data2: "+fmt.Sprintf("%#v", this.Data2)+",\n")
+	}
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *MutableChannel) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&channel.MutableChannel{")
+	s = append(s, "PredicateName: "+fmt.Sprintf("%#v", this.PredicateName)+",\n")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	if this.Channel != nil {
+		s = append(s, "Channel: "+fmt.Sprintf("%#v", this.Channel)+",\n")
+	}
+	if this.Participants != nil {
+		s = append(s, "Participants: "+fmt.Sprintf("%#v", this.Participants)+",\n")
+	}
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLMutableChannel) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&channel.TLMutableChannel{")
+	if this.Data2 != nil {
+		s = append(s, "Data2: "+fmt.Sprintf("%#v", this.Data2)+",\n")
+	}
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ChannelParticipants) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&channel.ChannelParticipants{")
+	s = append(s, "PredicateName: "+fmt.Sprintf("%#v", this.PredicateName)+",\n")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "Count: "+fmt.Sprintf("%#v", this.Count)+",\n")
+	if this.Participants != nil {
+		s = append(s, "Participants: "+fmt.Sprintf("%#v", this.Participants)+",\n")
+	}
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLChannelParticipants) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&channel.TLChannelParticipants{")
+	if this.Data2 != nil {
+		s = append(s, "Data2: "+fmt.Sprintf("%#v", this.Data2)+",\n")
+	}
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ChannelDifference) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 9)
+	s = append(s, "&channel.ChannelDifference{")
+	s = append(s, "PredicateName: "+fmt.Sprintf("%#v", this.PredicateName)+",\n")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "Final: "+fmt.Sprintf("%#v", this.Final)+",\n")
+	s = append(s, "Pts: "+fmt.Sprintf("%#v", this.Pts)+",\n")
+	if this.NewMessages != nil {
+		s = append(s, "NewMessages: "+fmt.Sprintf("%#v", this.NewMessages)+",\n")
+	}
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLChannelDifference) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&channel.TLChannelDifference{")
+	if this.Data2 != nil {
+		s = append(s, "Data2: "+fmt.Sprintf("%#v", this.Data2)+",\n")
+	}
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ChannelDeletedMessages) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 9)
+	s = append(s, "&channel.ChannelDeletedMessages{")
+	s = append(s, "PredicateName: "+fmt.Sprintf("%#v", this.PredicateName)+",\n")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "Pts: "+fmt.Sprintf("%#v", this.Pts)+",\n")
+	s = append(s, "PtsCount: "+fmt.Sprintf("%#v", this.PtsCount)+",\n")
+	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLChannelDeletedMessages) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&channel.TLChannelDeletedMessages{")
+	if this.Data2 != nil {
+		s = append(s, "Data2: "+fmt.Sprintf("%#v", this.Data2)+",\n")
+	}
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLChannelGetMutableChannel) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&channel.TLChannelGetMutableChannel{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "ChannelId: "+fmt.Sprintf("%#v", this.ChannelId)+",\n")
+	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLChannelGetChannelListByIdList) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&channel.TLChannelGetChannelListByIdList{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "SelfUserId: "+fmt.Sprintf("%#v", this.SelfUserId)+",\n")
+	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLChannelGetChannelParticipantIdList) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&channel.TLChannelGetChannelParticipantIdList{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "ChannelId: "+fmt.Sprintf("%#v", this.ChannelId)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLChannelCreateChannel) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 10)
+	s = append(s, "&channel.TLChannelCreateChannel{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "CreatorId: "+fmt.Sprintf("%#v", this.CreatorId)+",\n")
+	s = append(s, "Broadcast: "+fmt.Sprintf("%#v", this.Broadcast)+",\n")
+	s = append(s, "Megagroup: "+fmt.Sprintf("%#v", this.Megagroup)+",\n")
+	s = append(s, "Title: "+fmt.Sprintf("%#v", this.Title)+",\n")
+	s = append(s, "About: "+fmt.Sprintf("%#v", this.About)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLChannelJoinChannel) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&channel.TLChannelJoinChannel{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "ChannelId: "+fmt.Sprintf("%#v", this.ChannelId)+",\n")
+	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLChannelLeaveChannel) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&channel.TLChannelLeaveChannel{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "ChannelId: "+fmt.Sprintf("%#v", this.ChannelId)+",\n")
+	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLChannelInviteToChannel) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&channel.TLChannelInviteToChannel{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "ChannelId: "+fmt.Sprintf("%#v", this.ChannelId)+",\n")
+	s = append(s, "InviterId: "+fmt.Sprintf("%#v", this.InviterId)+",\n")
+	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLChannelEditAdmin) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 10)
+	s = append(s, "&channel.TLChannelEditAdmin{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "ChannelId: "+fmt.Sprintf("%#v", this.ChannelId)+",\n")
+	s = append(s, "OperatorId: "+fmt.Sprintf("%#v", this.OperatorId)+",\n")
+	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
+	if this.AdminRights != nil {
+		s = append(s, "AdminRights: "+fmt.Sprintf("%#v", this.AdminRights)+",\n")
+	}
+	s = append(s, "Rank: "+fmt.Sprintf("%#v", this.Rank)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLChannelEditBanned) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 9)
+	s = append(s, "&channel.TLChannelEditBanned{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "ChannelId: "+fmt.Sprintf("%#v", this.ChannelId)+",\n")
+	s = append(s, "OperatorId: "+fmt.Sprintf("%#v", this.OperatorId)+",\n")
+	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
+	if this.BannedRights != nil {
+		s = append(s, "BannedRights: "+fmt.Sprintf("%#v", this.BannedRights)+",\n")
+	}
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLChannelGetChannelParticipants) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 9)
+	s = append(s, "&channel.TLChannelGetChannelParticipants{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "ChannelId: "+fmt.Sprintf("%#v", this.ChannelId)+",\n")
+	if this.Filter != nil {
+		s = append(s, "Filter: "+fmt.Sprintf("%#v", this.Filter)+",\n")
+	}
+	s = append(s, "Offset: "+fmt.Sprintf("%#v", this.Offset)+",\n")
+	s = append(s, "Limit: "+fmt.Sprintf("%#v", this.Limit)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLChannelSendMessage) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 9)
+	s = append(s, "&channel.TLChannelSendMessage{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
+	s = append(s, "ChannelId: "+fmt.Sprintf("%#v", this.ChannelId)+",\n")
+	s = append(s, "RandomId: "+fmt.Sprintf("%#v", this.RandomId)+",\n")
+	if this.Message != nil {
+		s = append(s, "Message: "+fmt.Sprintf("%#v", this.Message)+",\n")
+	}
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLChannelGetHistory) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 12)
+	s = append(s, "&channel.TLChannelGetHistory{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
+	s = append(s, "ChannelId: "+fmt.Sprintf("%#v", this.ChannelId)+",\n")
+	s = append(s, "OffsetId: "+fmt.Sprintf("%#v", this.OffsetId)+",\n")
+	s = append(s, "AddOffset: "+fmt.Sprintf("%#v", this.AddOffset)+",\n")
+	s = append(s, "Limit: "+fmt.Sprintf("%#v", this.Limit)+",\n")
+	s = append(s, "MaxId: "+fmt.Sprintf("%#v", this.MaxId)+",\n")
+	s = append(s, "MinId: "+fmt.Sprintf("%#v", this.MinId)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLChannelGetMessages) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&channel.TLChannelGetMessages{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
+	s = append(s, "ChannelId: "+fmt.Sprintf("%#v", this.ChannelId)+",\n")
+	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLChannelReadHistory) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&channel.TLChannelReadHistory{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
+	s = append(s, "ChannelId: "+fmt.Sprintf("%#v", this.ChannelId)+",\n")
+	s = append(s, "MaxId: "+fmt.Sprintf("%#v", this.MaxId)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLChannelGetChannelDifference) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 9)
+	s = append(s, "&channel.TLChannelGetChannelDifference{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
+	s = append(s, "ChannelId: "+fmt.Sprintf("%#v", this.ChannelId)+",\n")
+	s = append(s, "Pts: "+fmt.Sprintf("%#v", this.Pts)+",\n")
+	s = append(s, "Limit: "+fmt.Sprintf("%#v", this.Limit)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLChannelEditTitle) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&channel.TLChannelEditTitle{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "ChannelId: "+fmt.Sprintf("%#v", this.ChannelId)+",\n")
+	s = append(s, "OperatorId: "+fmt.Sprintf("%#v", this.OperatorId)+",\n")
+	s = append(s, "Title: "+fmt.Sprintf("%#v", this.Title)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLChannelEditPhoto) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&channel.TLChannelEditPhoto{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "ChannelId: "+fmt.Sprintf("%#v", this.ChannelId)+",\n")
+	s = append(s, "OperatorId: "+fmt.Sprintf("%#v", this.OperatorId)+",\n")
+	if this.Photo != nil {
+		s = append(s, "Photo: "+fmt.Sprintf("%#v", this.Photo)+",\n")
+	}
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLChannelToggleSignatures) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&channel.TLChannelToggleSignatures{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "ChannelId: "+fmt.Sprintf("%#v", this.ChannelId)+",\n")
+	s = append(s, "OperatorId: "+fmt.Sprintf("%#v", this.OperatorId)+",\n")
+	if this.Enabled != nil {
+		s = append(s, "Enabled: "+fmt.Sprintf("%#v", this.Enabled)+",\n")
+	}
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLChannelTogglePreHistoryHidden) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&channel.TLChannelTogglePreHistoryHidden{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "ChannelId: "+fmt.Sprintf("%#v", this.ChannelId)+",\n")
+	s = append(s, "OperatorId: "+fmt.Sprintf("%#v", this.OperatorId)+",\n")
+	if this.Enabled != nil {
+		s = append(s, "Enabled: "+fmt.Sprintf("%#v", this.Enabled)+",\n")
+	}
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLChannelToggleSlowMode) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&channel.TLChannelToggleSlowMode{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "ChannelId: "+fmt.Sprintf("%#v", this.ChannelId)+",\n")
+	s = append(s, "OperatorId: "+fmt.Sprintf("%#v", this.OperatorId)+",\n")
+	s = append(s, "Seconds: "+fmt.Sprintf("%#v", this.Seconds)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLChannelDeleteChannel) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&channel.TLChannelDeleteChannel{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "ChannelId: "+fmt.Sprintf("%#v", this.ChannelId)+",\n")
+	s = append(s, "OperatorId: "+fmt.Sprintf("%#v", this.OperatorId)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLChannelDeleteMessages) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&channel.TLChannelDeleteMessages{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
+	s = append(s, "ChannelId: "+fmt.Sprintf("%#v", this.ChannelId)+",\n")
+	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLChannelDeleteParticipantHistory) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 9)
+	s = append(s, "&channel.TLChannelDeleteParticipantHistory{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "OperatorId: "+fmt.Sprintf("%#v", this.OperatorId)+",\n")
+	s = append(s, "ChannelId: "+fmt.Sprintf("%#v", this.ChannelId)+",\n")
+	s = append(s, "ParticipantId: "+fmt.Sprintf("%#v", this.ParticipantId)+",\n")
+	s = append(s, "Limit: "+fmt.Sprintf("%#v", this.Limit)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLChannelDeleteHistory) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 9)
+	s = append(s, "&channel.TLChannelDeleteHistory{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
+	s = append(s, "ChannelId: "+fmt.Sprintf("%#v", this.ChannelId)+",\n")
+	s = append(s, "ForEveryone: "+fmt.Sprintf("%#v", this.ForEveryone)+",\n")
+	s = append(s, "MaxId: "+fmt.Sprintf("%#v", this.MaxId)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *Vector_Chat) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&channel.Vector_Chat{")
+	if this.Datas != nil {
+		s = append(s, "Datas: "+fmt.Sprintf("%#v", this.Datas)+",\n")
+	}
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *Vector_Long) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&channel.Vector_Long{")
+	s = append(s, "Datas: "+fmt.Sprintf("%#v", this.Datas)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *Vector_MessageBox) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&channel.Vector_MessageBox{")
+	if this.Datas != nil {
+		s = append(s, "Datas: "+fmt.Sprintf("%#v", this.Datas)+",\n")
+	}
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringChannelTl(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// RPCChannelClient is the client API for RPCChannel service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type RPCChannelClient interface {
+	// channel.getMutableChannel channel_id:long id:Vector<long> = MutableChannel;
+	ChannelGetMutableChannel(ctx context.Context, in *TLChannelGetMutableChannel, opts ...grpc.CallOption) (*MutableChannel, error)
+	// channel.getChannelListByIdList self_user_id:long id:Vector<long> = Vector<Chat>;
+	ChannelGetChannelListByIdList(ctx context.Context, in *TLChannelGetChannelListByIdList, opts ...grpc.CallOption) (*Vector_Chat, error)
+	// channel.getChannelParticipantIdList channel_id:long = Vector<long>;
+	ChannelGetChannelParticipantIdList(ctx context.Context, in *TLChannelGetChannelParticipantIdList, opts ...grpc.CallOption) (*Vector_Long, error)
+	// channel.createChannel flags:# creator_id:long broadcast:flags.0?true megagroup:flags.1?true title:string about:string = MutableChannel;
+	ChannelCreateChannel(ctx context.Context, in *TLChannelCreateChannel, opts ...grpc.CallOption) (*MutableChannel, error)
+	// channel.joinChannel channel_id:long user_id:long = MutableChannel;
+	ChannelJoinChannel(ctx context.Context, in *TLChannelJoinChannel, opts ...grpc.CallOption) (*MutableChannel, error)
+	// channel.leaveChannel channel_id:long user_id:long = MutableChannel;
+	ChannelLeaveChannel(ctx context.Context, in *TLChannelLeaveChannel, opts ...grpc.CallOption) (*MutableChannel, error)
+	// channel.inviteToChannel channel_id:long inviter_id:long id:Vector<long> = MutableChannel;
+	ChannelInviteToChannel(ctx context.Context, in *TLChannelInviteToChannel, opts ...grpc.CallOption) (*MutableChannel, error)
+	// channel.editAdmin channel_id:long operator_id:long user_id:long admin_rights:ChatAdminRights rank:string = MutableChannel;
+	ChannelEditAdmin(ctx context.Context, in *TLChannelEditAdmin, opts ...grpc.CallOption) (*MutableChannel, error)
+	// channel.editBanned channel_id:long operator_id:long user_id:long banned_rights:ChatBannedRights = MutableChannel;
+	ChannelEditBanned(ctx context.Context, in *TLChannelEditBanned, opts ...grpc.CallOption) (*MutableChannel, error)
+	// channel.getChannelParticipants channel_id:long filter:ChannelParticipantsFilter offset:int limit:int = ChannelParticipants;
+	ChannelGetChannelParticipants(ctx context.Context, in *TLChannelGetChannelParticipants, opts ...grpc.CallOption) (*ChannelParticipants, error)
+	// channel.sendMessage user_id:long channel_id:long random_id:long message:Message = MessageBox;
+	ChannelSendMessage(ctx context.Context, in *TLChannelSendMessage, opts ...grpc.CallOption) (*mtproto.MessageBox, error)
+	// channel.getHistory user_id:long channel_id:long offset_id:int add_offset:int limit:int max_id:int min_id:int = Vector<MessageBox>;
+	ChannelGetHistory(ctx context.Context, in *TLChannelGetHistory, opts ...grpc.CallOption) (*Vector_MessageBox, error)
+	// channel.getMessages user_id:long channel_id:long id:Vector<int> = Vector<MessageBox>;
+	ChannelGetMessages(ctx context.Context, in *TLChannelGetMessages, opts ...grpc.CallOption) (*Vector_MessageBox, error)
+	// channel.readHistory user_id:long channel_id:long max_id:int = Bool;
+	ChannelReadHistory(ctx context.Context, in *TLChannelReadHistory, opts ...grpc.CallOption) (*mtproto.Bool, error)
+	// channel.getChannelDifference user_id:long channel_id:long pts:int limit:int = ChannelDifference;
+	ChannelGetChannelDifference(ctx context.Context, in *TLChannelGetChannelDifference, opts ...grpc.CallOption) (*ChannelDifference, error)
+	// channel.editTitle channel_id:long operator_id:long title:string = MutableChannel;
+	ChannelEditTitle(ctx context.Context, in *TLChannelEditTitle, opts ...grpc.CallOption) (*MutableChannel, error)
+	// channel.editPhoto channel_id:long operator_id:long photo:Photo = MutableChannel;
+	ChannelEditPhoto(ctx context.Context, in *TLChannelEditPhoto, opts ...grpc.CallOption) (*MutableChannel, error)
+	// channel.toggleSignatures channel_id:long operator_id:long enabled:Bool = MutableChannel;
+	ChannelToggleSignatures(ctx context.Context, in *TLChannelToggleSignatures, opts ...grpc.CallOption) (*MutableChannel, error)
+	// channel.togglePreHistoryHidden channel_id:long operator_id:long enabled:Bool = MutableChannel;
+	ChannelTogglePreHistoryHidden(ctx context.Context, in *TLChannelTogglePreHistoryHidden, opts ...grpc.CallOption) (*MutableChannel, error)
+	// channel.toggleSlowMode channel_id:long operator_id:long seconds:int = MutableChannel;
+	ChannelToggleSlowMode(ctx context.Context, in *TLChannelToggleSlowMode, opts ...grpc.CallOption) (*MutableChannel, error)
+	// channel.deleteChannel channel_id:long operator_id:long = MutableChannel;
+	ChannelDeleteChannel(ctx context.Context, in *TLChannelDeleteChannel, opts ...grpc.CallOption) (*MutableChannel, error)
+	// channel.deleteMessages user_id:long channel_id:long id:Vector<int> = ChannelDeletedMessages;
+	ChannelDeleteMessages(ctx context.Context, in *TLChannelDeleteMessages, opts ...grpc.CallOption) (*ChannelDeletedMessages, error)
+	// channel.deleteParticipantHistory operator_id:long channel_id:long participant_id:long limit:int = ChannelDeletedMessages;
+	ChannelDeleteParticipantHistory(ctx context.Context, in *TLChannelDeleteParticipantHistory, opts ...grpc.CallOption) (*ChannelDeletedMessages, error)
+	// channel.deleteHistory flags:# user_id:long channel_id:long for_everyone:flags.0?true max_id:int = MutableChannel;
+	ChannelDeleteHistory(ctx context.Context, in *TLChannelDeleteHistory, opts ...grpc.CallOption) (*MutableChannel, error)
+}
+
+type rPCChannelClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewRPCChannelClient(cc *grpc.ClientConn) RPCChannelClient {
+	return &rPCChannelClient{cc}
+}
+
+func (c *rPCChannelClient) ChannelGetMutableChannel(ctx context.Context, in *TLChannelGetMutableChannel, opts ...grpc.CallOption) (*MutableChannel, error) {
+	out := new(MutableChannel)
+	err := c.cc.Invoke(ctx, "/channel.RPCChannel/channel_getMutableChannel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPCChannelClient) ChannelGetChannelListByIdList(ctx context.Context, in *TLChannelGetChannelListByIdList, opts ...grpc.CallOption) (*Vector_Chat, error) {
+	out := new(Vector_Chat)
+	err := c.cc.Invoke(ctx, "/channel.RPCChannel/channel_getChannelListByIdList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPCChannelClient) ChannelGetChannelParticipantIdList(ctx context.Context, in *TLChannelGetChannelParticipantIdList, opts ...grpc.CallOption) (*Vector_Long, error) {
+	out := new(Vector_Long)
+	err := c.cc.Invoke(ctx, "/channel.RPCChannel/channel_getChannelParticipantIdList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPCChannelClient) ChannelCreateChannel(ctx context.Context, in *TLChannelCreateChannel, opts ...grpc.CallOption) (*MutableChannel, error) {
+	out := new(MutableChannel)
+	err := c.cc.Invoke(ctx, "/channel.RPCChannel/channel_createChannel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPCChannelClient) ChannelJoinChannel(ctx context.Context, in *TLChannelJoinChannel, opts ...grpc.CallOption) (*MutableChannel, error) {
+	out := new(MutableChannel)
+	err := c.cc.Invoke(ctx, "/channel.RPCChannel/channel_joinChannel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPCChannelClient) ChannelLeaveChannel(ctx context.Context, in *TLChannelLeaveChannel, opts ...grpc.CallOption) (*MutableChannel, error) {
+	out := new(MutableChannel)
+	err := c.cc.Invoke(ctx, "/channel.RPCChannel/channel_leaveChannel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPCChannelClient) ChannelInviteToChannel(ctx context.Context, in *TLChannelInviteToChannel, opts ...grpc.CallOption) (*MutableChannel, error) {
+	out := new(MutableChannel)
+	err := c.cc.Invoke(ctx, "/channel.RPCChannel/channel_inviteToChannel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPCChannelClient) ChannelEditAdmin(ctx context.Context, in *TLChannelEditAdmin, opts ...grpc.CallOption) (*MutableChannel, error) {
+	out := new(MutableChannel)
+	err := c.cc.Invoke(ctx, "/channel.RPCChannel/channel_editAdmin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPCChannelClient) ChannelEditBanned(ctx context.Context, in *TLChannelEditBanned, opts ...grpc.CallOption) (*MutableChannel, error) {
+	out := new(MutableChannel)
+	err := c.cc.Invoke(ctx, "/channel.RPCChannel/channel_editBanned", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPCChannelClient) ChannelGetChannelParticipants(ctx context.Context, in *TLChannelGetChannelParticipants, opts ...grpc.CallOption) (*ChannelParticipants, error) {
+	out := new(ChannelParticipants)
+	err := c.cc.Invoke(ctx, "/channel.RPCChannel/channel_getChannelParticipants", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPCChannelClient) ChannelSendMessage(ctx context.Context, in *TLChannelSendMessage, opts ...grpc.CallOption) (*mtproto.MessageBox, error) {
+	out := new(mtproto.MessageBox)
+	err := c.cc.Invoke(ctx, "/channel.RPCChannel/channel_sendMessage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPCChannelClient) ChannelGetHistory(ctx context.Context, in *TLChannelGetHistory, opts ...grpc.CallOption) (*Vector_MessageBox, error) {
+	out := new(Vector_MessageBox)
+	err := c.cc.Invoke(ctx, "/channel.RPCChannel/channel_getHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPCChannelClient) ChannelGetMessages(ctx context.Context, in *TLChannelGetMessages, opts ...grpc.CallOption) (*Vector_MessageBox, error) {
+	out := new(Vector_MessageBox)
+	err := c.cc.Invoke(ctx, "/channel.RPCChannel/channel_getMessages", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPCChannelClient) ChannelReadHistory(ctx context.Context, in *TLChannelReadHistory, opts ...grpc.CallOption) (*mtproto.Bool, error) {
+	out := new(mtproto.Bool)
+	err := c.cc.Invoke(ctx, "/channel.RPCChannel/channel_readHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPCChannelClient) ChannelGetChannelDifference(ctx context.Context, in *TLChannelGetChannelDifference, opts ...grpc.CallOption) (*ChannelDifference, error) {
+	out := new(ChannelDifference)
+	err := c.cc.Invoke(ctx, "/channel.RPCChannel/channel_getChannelDifference", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPCChannelClient) ChannelEditTitle(ctx context.Context, in *TLChannelEditTitle, opts ...grpc.CallOption) (*MutableChannel, error) {
+	out := new(MutableChannel)
+	err := c.cc.Invoke(ctx, "/channel.RPCChannel/channel_editTitle", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPCChannelClient) ChannelEditPhoto(ctx context.Context, in *TLChannelEditPhoto, opts ...grpc.CallOption) (*MutableChannel, error) {
+	out := new(MutableChannel)
+	err := c.cc.Invoke(ctx, "/channel.RPCChannel/channel_editPhoto", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPCChannelClient) ChannelToggleSignatures(ctx context.Context, in *TLChannelToggleSignatures, opts ...grpc.CallOption) (*MutableChannel, error) {
+	out := new(MutableChannel)
+	err := c.cc.Invoke(ctx, "/channel.RPCChannel/channel_toggleSignatures", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPCChannelClient) ChannelTogglePreHistoryHidden(ctx context.Context, in *TLChannelTogglePreHistoryHidden, opts ...grpc.CallOption) (*MutableChannel, error) {
+	out := new(MutableChannel)
+	err := c.cc.Invoke(ctx, "/channel.RPCChannel/channel_togglePreHistoryHidden", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPCChannelClient) ChannelToggleSlowMode(ctx context.Context, in *TLChannelToggleSlowMode, opts ...grpc.CallOption) (*MutableChannel, error) {
+	out := new(MutableChannel)
+	err := c.cc.Invoke(ctx, "/channel.RPCChannel/channel_toggleSlowMode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPCChannelClient) ChannelDeleteChannel(ctx context.Context, in *TLChannelDeleteChannel, opts ...grpc.CallOption) (*MutableChannel, error) {
+	out := new(MutableChannel)
+	err := c.cc.Invoke(ctx, "/channel.RPCChannel/channel_deleteChannel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPCChannelClient) ChannelDeleteMessages(ctx context.Context, in *TLChannelDeleteMessages, opts ...grpc.CallOption) (*ChannelDeletedMessages, error) {
+	out := new(ChannelDeletedMessages)
+	err := c.cc.Invoke(ctx, "/channel.RPCChannel/channel_deleteMessages", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPCChannelClient) ChannelDeleteParticipantHistory(ctx context.Context, in *TLChannelDeleteParticipantHistory, opts ...grpc.CallOption) (*ChannelDeletedMessages, error) {
+	out := new(ChannelDeletedMessages)
+	err := c.cc.Invoke(ctx, "/channel.RPCChannel/channel_deleteParticipantHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPCChannelClient) ChannelDeleteHistory(ctx context.Context, in *TLChannelDeleteHistory, opts ...grpc.CallOption) (*MutableChannel, error) {
+	out := new(MutableChannel)
+	err := c.cc.Invoke(ctx, "/channel.RPCChannel/channel_deleteHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RPCChannelServer is the server API for RPCChannel service.
+type RPCChannelServer interface {
+	// channel.getMutableChannel channel_id:long id:Vector<long> = MutableChannel;
+	ChannelGetMutableChannel(context.Context, *TLChannelGetMutableChannel) (*MutableChannel, error)
+	// channel.getChannelListByIdList self_user_id:long id:Vector<long> = Vector<Chat>;
+	ChannelGetChannelListByIdList(context.Context, *TLChannelGetChannelListByIdList) (*Vector_Chat, error)
+	// channel.getChannelParticipantIdList channel_id:long = Vector<long>;
+	ChannelGetChannelParticipantIdList(context.Context, *TLChannelGetChannelParticipantIdList) (*Vector_Long, error)
+	// channel.createChannel flags:# creator_id:long broadcast:flags.0?true megagroup:flags.1?true title:string about:string = MutableChannel;
+	ChannelCreateChannel(context.Context, *TLChannelCreateChannel) (*MutableChannel, error)
+	// channel.joinChannel channel_id:long user_id:long = MutableChannel;
+	ChannelJoinChannel(context.Context, *TLChannelJoinChannel) (*MutableChannel, error)
+	// channel.leaveChannel channel_id:long user_id:long = MutableChannel;
+	ChannelLeaveChannel(context.Context, *TLChannelLeaveChannel) (*MutableChannel, error)
+	// channel.inviteToChannel channel_id:long inviter_id:long id:Vector<long> = MutableChannel;
+	ChannelInviteToChannel(context.Context, *TLChannelInviteToChannel) (*MutableChannel, error)
+	// channel.editAdmin channel_id:long operator_id:long user_id:long admin_rights:ChatAdminRights rank:string = MutableChannel;
+	ChannelEditAdmin(context.Context, *TLChannelEditAdmin) (*MutableChannel, error)
+	// channel.editBanned channel_id:long operator_id:long user_id:long banned_rights:ChatBannedRights = MutableChannel;
+	ChannelEditBanned(context.Context, *TLChannelEditBanned) (*MutableChannel, error)
+	// channel.getChannelParticipants channel_id:long filter:ChannelParticipantsFilter offset:int limit:int = ChannelParticipants;
+	ChannelGetChannelParticipants(context.Context, *TLChannelGetChannelParticipants) (*ChannelParticipants, error)
+	// channel.sendMessage user_id:long channel_id:long random_id:long message:Message = MessageBox;
+	ChannelSendMessage(context.Context, *TLChannelSendMessage) (*mtproto.MessageBox, error)
+	// channel.getHistory user_id:long channel_id:long offset_id:int add_offset:int limit:int max_id:int min_id:int = Vector<MessageBox>;
+	ChannelGetHistory(context.Context, *TLChannelGetHistory) (*Vector_MessageBox, error)
+	// channel.getMessages user_id:long channel_id:long id:Vector<int> = Vector<MessageBox>;
+	ChannelGetMessages(context.Context, *TLChannelGetMessages) (*Vector_MessageBox, error)
+	// channel.readHistory user_id:long channel_id:long max_id:int = Bool;
+	ChannelReadHistory(context.Context, *TLChannelReadHistory) (*mtproto.Bool, error)
+	// channel.getChannelDifference user_id:long channel_id:long pts:int limit:int = ChannelDifference;
+	ChannelGetChannelDifference(context.Context, *TLChannelGetChannelDifference) (*ChannelDifference, error)
+	// channel.editTitle channel_id:long operator_id:long title:string = MutableChannel;
+	ChannelEditTitle(context.Context, *TLChannelEditTitle) (*MutableChannel, error)
+	// channel.editPhoto channel_id:long operator_id:long photo:Photo = MutableChannel;
+	ChannelEditPhoto(context.Context, *TLChannelEditPhoto) (*MutableChannel, error)
+	// channel.toggleSignatures channel_id:long operator_id:long enabled:Bool = MutableChannel;
+	ChannelToggleSignatures(context.Context, *TLChannelToggleSignatures) (*MutableChannel, error)
+	// channel.togglePreHistoryHidden channel_id:long operator_id:long enabled:Bool = MutableChannel;
+	ChannelTogglePreHistoryHidden(context.Context, *TLChannelTogglePreHistoryHidden) (*MutableChannel, error)
+	// channel.toggleSlowMode channel_id:long operator_id:long seconds:int = MutableChannel;
+	ChannelToggleSlowMode(context.Context, *TLChannelToggleSlowMode) (*MutableChannel, error)
+	// channel.deleteChannel channel_id:long operator_id:long = MutableChannel;
+	ChannelDeleteChannel(context.Context, *TLChannelDeleteChannel) (*MutableChannel, error)
+	// channel.deleteMessages user_id:long channel_id:long id:Vector<int> = ChannelDeletedMessages;
+	ChannelDeleteMessages(context.Context, *TLChannelDeleteMessages) (*ChannelDeletedMessages, error)
+	// channel.deleteParticipantHistory operator_id:long channel_id:long participant_id:long limit:int = ChannelDeletedMessages;
+	ChannelDeleteParticipantHistory(context.Context, *TLChannelDeleteParticipantHistory) (*ChannelDeletedMessages, error)
+	// channel.deleteHistory flags:# user_id:long channel_id:long for_everyone:flags.0?true max_id:int = MutableChannel;
+	ChannelDeleteHistory(context.Context, *TLChannelDeleteHistory) (*MutableChannel, error)
+}
+
+// UnimplementedRPCChannelServer can be embedded to have forward compatible implementations.
+type UnimplementedRPCChannelServer struct {
+}
+
+func (*UnimplementedRPCChannelServer) ChannelGetMutableChannel(ctx context.Context, req *TLChannelGetMutableChannel) (*MutableChannel, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChannelGetMutableChannel not implemented")
+}
+func (*UnimplementedRPCChannelServer) ChannelGetChannelListByIdList(ctx context.Context, req *TLChannelGetChannelListByIdList) (*Vector_Chat, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChannelGetChannelListByIdList not implemented")
+}
+func (*UnimplementedRPCChannelServer) ChannelGetChannelParticipantIdList(ctx context.Context, req *TLChannelGetChannelParticipantIdList) (*Vector_Long, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChannelGetChannelParticipantIdList not implemented")
+}
+func (*UnimplementedRPCChannelServer) ChannelCreateChannel(ctx context.Context, req *TLChannelCreateChannel) (*MutableChannel, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChannelCreateChannel not implemented")
+}
+func (*UnimplementedRPCChannelServer) ChannelJoinChannel(ctx context.Context, req *TLChannelJoinChannel) (*MutableChannel, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChannelJoinChannel not implemented")
+}
+func (*UnimplementedRPCChannelServer) ChannelLeaveChannel(ctx context.Context, req *TLChannelLeaveChannel) (*MutableChannel, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChannelLeaveChannel not implemented")
+}
+func (*UnimplementedRPCChannelServer) ChannelInviteToChannel(ctx context.Context, req *TLChannelInviteToChannel) (*MutableChannel, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChannelInviteToChannel not implemented")
+}
+func (*UnimplementedRPCChannelServer) ChannelEditAdmin(ctx context.Context, req *TLChannelEditAdmin) (*MutableChannel, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChannelEditAdmin not implemented")
+}
+func (*UnimplementedRPCChannelServer) ChannelEditBanned(ctx context.Context, req *TLChannelEditBanned) (*MutableChannel, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChannelEditBanned not implemented")
+}
+func (*UnimplementedRPCChannelServer) ChannelGetChannelParticipants(ctx context.Context, req *TLChannelGetChannelParticipants) (*ChannelParticipants, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChannelGetChannelParticipants not implemented")
+}
+func (*UnimplementedRPCChannelServer) ChannelSendMessage(ctx context.Context, req *TLChannelSendMessage) (*mtproto.MessageBox, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChannelSendMessage not implemented")
+}
+func (*UnimplementedRPCChannelServer) ChannelGetHistory(ctx context.Context, req *TLChannelGetHistory) (*Vector_MessageBox, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChannelGetHistory not implemented")
+}
+func (*UnimplementedRPCChannelServer) ChannelGetMessages(ctx context.Context, req *TLChannelGetMessages) (*Vector_MessageBox, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChannelGetMessages not implemented")
+}
+func (*UnimplementedRPCChannelServer) ChannelReadHistory(ctx context.Context, req *TLChannelReadHistory) (*mtproto.Bool, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChannelReadHistory not implemented")
+}
+func (*UnimplementedRPCChannelServer) ChannelGetChannelDifference(ctx context.Context, req *TLChannelGetChannelDifference) (*ChannelDifference, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChannelGetChannelDifference not implemented")
+}
+func (*UnimplementedRPCChannelServer) ChannelEditTitle(ctx context.Context, req *TLChannelEditTitle) (*MutableChannel, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChannelEditTitle not implemented")
+}
+func (*UnimplementedRPCChannelServer) ChannelEditPhoto(ctx context.Context, req *TLChannelEditPhoto) (*MutableChannel, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChannelEditPhoto not implemented")
+}
+func (*UnimplementedRPCChannelServer) ChannelToggleSignatures(ctx context.Context, req *TLChannelToggleSignatures) (*MutableChannel, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChannelToggleSignatures not implemented")
+}
+func (*UnimplementedRPCChannelServer) ChannelTogglePreHistoryHidden(ctx context.Context, req *TLChannelTogglePreHistoryHidden) (*MutableChannel, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChannelTogglePreHistoryHidden not implemented")
+}
+func (*UnimplementedRPCChannelServer) ChannelToggleSlowMode(ctx context.Context, req *TLChannelToggleSlowMode) (*MutableChannel, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChannelToggleSlowMode not implemented")
+}
+func (*UnimplementedRPCChannelServer) ChannelDeleteChannel(ctx context.Context, req *TLChannelDeleteChannel) (*MutableChannel, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChannelDeleteChannel not implemented")
+}
+func (*UnimplementedRPCChannelServer) ChannelDeleteMessages(ctx context.Context, req *TLChannelDeleteMessages) (*ChannelDeletedMessages, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChannelDeleteMessages not implemented")
+}
+func (*UnimplementedRPCChannelServer) ChannelDeleteParticipantHistory(ctx context.Context, req *TLChannelDeleteParticipantHistory) (*ChannelDeletedMessages, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChannelDeleteParticipantHistory not implemented")
+}
+func (*UnimplementedRPCChannelServer) ChannelDeleteHistory(ctx context.Context, req *TLChannelDeleteHistory) (*MutableChannel, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChannelDeleteHistory not implemented")
+}
+
+func RegisterRPCChannelServer(s *grpc.Server, srv RPCChannelServer) {
+	s.RegisterService(&_RPCChannel_serviceDesc, srv)
+}
+
+func _RPCChannel_ChannelGetMutableChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLChannelGetMutableChannel)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCChannelServer).ChannelGetMutableChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/channel.RPCChannel/ChannelGetMutableChannel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCChannelServer).ChannelGetMutableChannel(ctx, req.(*TLChannelGetMutableChannel))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCChannel_ChannelGetChannelListByIdList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLChannelGetChannelListByIdList)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCChannelServer).ChannelGetChannelListByIdList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/channel.RPCChannel/ChannelGetChannelListByIdList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCChannelServer).ChannelGetChannelListByIdList(ctx, req.(*TLChannelGetChannelListByIdList))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCChannel_ChannelGetChannelParticipantIdList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLChannelGetChannelParticipantIdList)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCChannelServer).ChannelGetChannelParticipantIdList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/channel.RPCChannel/ChannelGetChannelParticipantIdList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCChannelServer).ChannelGetChannelParticipantIdList(ctx, req.(*TLChannelGetChannelParticipantIdList))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCChannel_ChannelCreateChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLChannelCreateChannel)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCChannelServer).ChannelCreateChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/channel.RPCChannel/ChannelCreateChannel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCChannelServer).ChannelCreateChannel(ctx, req.(*TLChannelCreateChannel))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCChannel_ChannelJoinChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLChannelJoinChannel)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCChannelServer).ChannelJoinChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/channel.RPCChannel/ChannelJoinChannel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCChannelServer).ChannelJoinChannel(ctx, req.(*TLChannelJoinChannel))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCChannel_ChannelLeaveChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLChannelLeaveChannel)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCChannelServer).ChannelLeaveChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/channel.RPCChannel/ChannelLeaveChannel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCChannelServer).ChannelLeaveChannel(ctx, req.(*TLChannelLeaveChannel))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCChannel_ChannelInviteToChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLChannelInviteToChannel)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCChannelServer).ChannelInviteToChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/channel.RPCChannel/ChannelInviteToChannel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCChannelServer).ChannelInviteToChannel(ctx, req.(*TLChannelInviteToChannel))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCChannel_ChannelEditAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLChannelEditAdmin)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCChannelServer).ChannelEditAdmin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/channel.RPCChannel/ChannelEditAdmin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCChannelServer).ChannelEditAdmin(ctx, req.(*TLChannelEditAdmin))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCChannel_ChannelEditBanned_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLChannelEditBanned)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCChannelServer).ChannelEditBanned(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/channel.RPCChannel/ChannelEditBanned",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCChannelServer).ChannelEditBanned(ctx, req.(*TLChannelEditBanned))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCChannel_ChannelGetChannelParticipants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLChannelGetChannelParticipants)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCChannelServer).ChannelGetChannelParticipants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/channel.RPCChannel/ChannelGetChannelParticipants",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCChannelServer).ChannelGetChannelParticipants(ctx, req.(*TLChannelGetChannelParticipants))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCChannel_ChannelSendMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLChannelSendMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCChannelServer).ChannelSendMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/channel.RPCChannel/ChannelSendMessage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCChannelServer).ChannelSendMessage(ctx, req.(*TLChannelSendMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCChannel_ChannelGetHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLChannelGetHistory)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCChannelServer).ChannelGetHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/channel.RPCChannel/ChannelGetHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCChannelServer).ChannelGetHistory(ctx, req.(*TLChannelGetHistory))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCChannel_ChannelGetMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLChannelGetMessages)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCChannelServer).ChannelGetMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/channel.RPCChannel/ChannelGetMessages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCChannelServer).ChannelGetMessages(ctx, req.(*TLChannelGetMessages))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCChannel_ChannelReadHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLChannelReadHistory)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCChannelServer).ChannelReadHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/channel.RPCChannel/ChannelReadHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCChannelServer).ChannelReadHistory(ctx, req.(*TLChannelReadHistory))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCChannel_ChannelGetChannelDifference_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLChannelGetChannelDifference)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCChannelServer).ChannelGetChannelDifference(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/channel.RPCChannel/ChannelGetChannelDifference",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCChannelServer).ChannelGetChannelDifference(ctx, req.(*TLChannelGetChannelDifference))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCChannel_ChannelEditTitle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLChannelEditTitle)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCChannelServer).ChannelEditTitle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/channel.RPCChannel/ChannelEditTitle",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCChannelServer).ChannelEditTitle(ctx, req.(*TLChannelEditTitle))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCChannel_ChannelEditPhoto_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLChannelEditPhoto)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCChannelServer).ChannelEditPhoto(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/channel.RPCChannel/ChannelEditPhoto",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCChannelServer).ChannelEditPhoto(ctx, req.(*TLChannelEditPhoto))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCChannel_ChannelToggleSignatures_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLChannelToggleSignatures)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCChannelServer).ChannelToggleSignatures(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/channel.RPCChannel/ChannelToggleSignatures",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCChannelServer).ChannelToggleSignatures(ctx, req.(*TLChannelToggleSignatures))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCChannel_ChannelTogglePreHistoryHidden_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLChannelTogglePreHistoryHidden)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCChannelServer).ChannelTogglePreHistoryHidden(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/channel.RPCChannel/ChannelTogglePreHistoryHidden",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCChannelServer).ChannelTogglePreHistoryHidden(ctx, req.(*TLChannelTogglePreHistoryHidden))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCChannel_ChannelToggleSlowMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLChannelToggleSlowMode)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCChannelServer).ChannelToggleSlowMode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/channel.RPCChannel/ChannelToggleSlowMode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCChannelServer).ChannelToggleSlowMode(ctx, req.(*TLChannelToggleSlowMode))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCChannel_ChannelDeleteChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLChannelDeleteChannel)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCChannelServer).ChannelDeleteChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/channel.RPCChannel/ChannelDeleteChannel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCChannelServer).ChannelDeleteChannel(ctx, req.(*TLChannelDeleteChannel))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCChannel_ChannelDeleteMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLChannelDeleteMessages)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCChannelServer).ChannelDeleteMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/channel.RPCChannel/ChannelDeleteMessages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCChannelServer).ChannelDeleteMessages(ctx, req.(*TLChannelDeleteMessages))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCChannel_ChannelDeleteParticipantHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLChannelDeleteParticipantHistory)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCChannelServer).ChannelDeleteParticipantHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/channel.RPCChannel/ChannelDeleteParticipantHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCChannelServer).ChannelDeleteParticipantHistory(ctx, req.(*TLChannelDeleteParticipantHistory))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCChannel_ChannelDeleteHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLChannelDeleteHistory)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCChannelServer).ChannelDeleteHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/channel.RPCChannel/ChannelDeleteHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCChannelServer).ChannelDeleteHistory(ctx, req.(*TLChannelDeleteHistory))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _RPCChannel_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "channel.RPCChannel",
+	HandlerType: (*RPCChannelServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "channel_getMutableChannel",
+			Handler:    _RPCChannel_ChannelGetMutableChannel_Handler,
+		},
+		{
+			MethodName: "channel_getChannelListByIdList",
+			Handler:    _RPCChannel_ChannelGetChannelListByIdList_Handler,
+		},
+		{
+			MethodName: "channel_getChannelParticipantIdList",
+			Handler:    _RPCChannel_ChannelGetChannelParticipantIdList_Handler,
+		},
+		{
+			MethodName: "channel_createChannel",
+			Handler:    _RPCChannel_ChannelCreateChannel_Handler,
+		},
+		{
+			MethodName: "channel_joinChannel",
+			Handler:    _RPCChannel_ChannelJoinChannel_Handler,
+		},
+		{
+			MethodName: "channel_leaveChannel",
+			Handler:    _RPCChannel_ChannelLeaveChannel_Handler,
+		},
+		{
+			MethodName: "channel_inviteToChannel",
+			Handler:    _RPCChannel_ChannelInviteToChannel_Handler,
+		},
+		{
+			MethodName: "channel_editAdmin",
+			Handler:    _RPCChannel_ChannelEditAdmin_Handler,
+		},
+		{
+			MethodName: "channel_editBanned",
+			Handler:    _RPCChannel_ChannelEditBanned_Handler,
+		},
+		{
+			MethodName: "channel_getChannelParticipants",
+			Handler:    _RPCChannel_ChannelGetChannelParticipants_Handler,
+		},
+		{
+			MethodName: "channel_sendMessage",
+			Handler:    _RPCChannel_ChannelSendMessage_Handler,
+		},
+		{
+			MethodName: "channel_getHistory",
+			Handler:    _RPCChannel_ChannelGetHistory_Handler,
+		},
+		{
+			MethodName: "channel_getMessages",
+			Handler:    _RPCChannel_ChannelGetMessages_Handler,
+		},
+		{
+			MethodName: "channel_readHistory",
+			Handler:    _RPCChannel_ChannelReadHistory_Handler,
+		},
+		{
+			MethodName: "channel_getChannelDifference",
+			Handler:    _RPCChannel_ChannelGetChannelDifference_Handler,
+		},
+		{
+			MethodName: "channel_editTitle",
+			Handler:    _RPCChannel_ChannelEditTitle_Handler,
+		},
+		{
+			MethodName: "channel_editPhoto",
+			Handler:    _RPCChannel_ChannelEditPhoto_Handler,
+		},
+		{
+			MethodName: "channel_toggleSignatures",
+			Handler:    _RPCChannel_ChannelToggleSignatures_Handler,
+		},
+		{
+			MethodName: "channel_togglePreHistoryHidden",
+			Handler:    _RPCChannel_ChannelTogglePreHistoryHidden_Handler,
+		},
+		{
+			MethodName: "channel_toggleSlowMode",
+			Handler:    _RPCChannel_ChannelToggleSlowMode_Handler,
+		},
+		{
+			MethodName: "channel_deleteChannel",
+			Handler:    _RPCChannel_ChannelDeleteChannel_Handler,
+		},
+		{
+			MethodName: "channel_deleteMessages",
+			Handler:    _RPCChannel_ChannelDeleteMessages_Handler,
+		},
+		{
+			MethodName: "channel_deleteParticipantHistory",
+			Handler:    _RPCChannel_ChannelDeleteParticipantHistory_Handler,
+		},
+		{
+			MethodName: "channel_deleteHistory",
+			Handler:    _RPCChannel_ChannelDeleteHistory_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "channel.tl.proto",
+}
+
+func (m *ImmutableChannel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ImmutableChannel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ImmutableChannel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Version != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
+	}
+	if m.Date != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.Date))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
+	if m.TopMessage != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.TopMessage))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if m.Pts != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.Pts))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if m.SlowmodeSeconds != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.SlowmodeSeconds))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.DefaultBannedRights != nil {
+		{
+			size, err := m.DefaultBannedRights.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintChannelTl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if m.ParticipantsCount != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.ParticipantsCount))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if len(m.Username) > 0 {
+		i -= len(m.Username)
+		copy(dAtA[i:], m.Username)
+		i = encodeVarintChannelTl(dAtA, i, uint64(len(m.Username)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.HiddenPrehistory {
+		i--
+		if m.HiddenPrehistory {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.Deactivated {
+		i--
+		if m.Deactivated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.Noforwards {
+		i--
+		if m.Noforwards {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.Signatures {
+		i--
+		if m.Signatures {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.Megagroup {
+		i--
+		if m.Megagroup {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.Broadcast {
+		i--
+		if m.Broadcast {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.Photo != nil {
+		{
+			size, err := m.Photo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintChannelTl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.About) > 0 {
+		i -= len(m.About)
+		copy(dAtA[i:], m.About)
+		i = encodeVarintChannelTl(dAtA, i, uint64(len(m.About)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintChannelTl(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Creator != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.Creator))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.AccessHash != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.AccessHash))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Id != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.PredicateName) > 0 {
+		i -= len(m.PredicateName)
+		copy(dAtA[i:], m.PredicateName)
+		i = encodeVarintChannelTl(dAtA, i, uint64(len(m.PredicateName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLImmutableChannel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLImmutableChannel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLImmutableChannel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Data2 != nil {
+		{
+			size, err := m.Data2.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintChannelTl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ImmutableChannelParticipant) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ImmutableChannelParticipant) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ImmutableChannelParticipant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Date != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.Date))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.AvailableMinId != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.AvailableMinId))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.ReadInboxMaxId != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.ReadInboxMaxId))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.KickedBy != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.KickedBy))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.PromotedBy != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.PromotedBy))
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.Rank) > 0 {
+		i -= len(m.Rank)
+		copy(dAtA[i:], m.Rank)
+		i = encodeVarintChannelTl(dAtA, i, uint64(len(m.Rank)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.BannedRights != nil {
+		{
+			size, err := m.BannedRights.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintChannelTl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.AdminRights != nil {
+		{
+			size, err := m.AdminRights.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintChannelTl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.InviterUserId != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.InviterUserId))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.State != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.State))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.ParticipantType != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.ParticipantType))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.UserId != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.UserId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ChannelId != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.ChannelId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Id != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.PredicateName) > 0 {
+		i -= len(m.PredicateName)
+		copy(dAtA[i:], m.PredicateName)
+		i = encodeVarintChannelTl(dAtA, i, uint64(len(m.PredicateName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLImmutableChannelParticipant) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLImmutableChannelParticipant) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLImmutableChannelParticipant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Data2 != nil {
+		{
+			size, err := m.Data2.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintChannelTl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MutableChannel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MutableChannel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MutableChannel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Participants) > 0 {
+		for iNdEx := len(m.Participants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Participants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintChannelTl(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Channel != nil {
+		{
+			size, err := m.Channel.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintChannelTl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.PredicateName) > 0 {
+		i -= len(m.PredicateName)
+		copy(dAtA[i:], m.PredicateName)
+		i = encodeVarintChannelTl(dAtA, i, uint64(len(m.PredicateName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLMutableChannel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLMutableChannel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLMutableChannel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Data2 != nil {
+		{
+			size, err := m.Data2.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintChannelTl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ChannelParticipants) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChannelParticipants) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChannelParticipants) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Participants) > 0 {
+		for iNdEx := len(m.Participants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Participants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintChannelTl(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Count != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.PredicateName) > 0 {
+		i -= len(m.PredicateName)
+		copy(dAtA[i:], m.PredicateName)
+		i = encodeVarintChannelTl(dAtA, i, uint64(len(m.PredicateName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLChannelParticipants) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLChannelParticipants) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLChannelParticipants) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Data2 != nil {
+		{
+			size, err := m.Data2.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintChannelTl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ChannelDifference) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChannelDifference) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChannelDifference) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.NewMessages) > 0 {
+		for iNdEx := len(m.NewMessages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NewMessages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintChannelTl(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.Pts != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.Pts))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Final {
+		i--
+		if m.Final {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.PredicateName) > 0 {
+		i -= len(m.PredicateName)
+		copy(dAtA[i:], m.PredicateName)
+		i = encodeVarintChannelTl(dAtA, i, uint64(len(m.PredicateName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLChannelDifference) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLChannelDifference) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLChannelDifference) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Data2 != nil {
+		{
+			size, err := m.Data2.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintChannelTl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ChannelDeletedMessages) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChannelDeletedMessages) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChannelDeletedMessages) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Id) > 0 {
+		dAtA12 := make([]byte, len(m.Id)*10)
+		var j11 int
+		for _, num1 := range m.Id {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA12[j11] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j11++
+			}
+			dAtA12[j11] = uint8(num)
+			j11++
+		}
+		i -= j11
+		copy(dAtA[i:], dAtA12[:j11])
+		i = encodeVarintChannelTl(dAtA, i, uint64(j11))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.PtsCount != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.PtsCount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Pts != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.Pts))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.PredicateName) > 0 {
+		i -= len(m.PredicateName)
+		copy(dAtA[i:], m.PredicateName)
+		i = encodeVarintChannelTl(dAtA, i, uint64(len(m.PredicateName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLChannelDeletedMessages) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLChannelDeletedMessages) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLChannelDeletedMessages) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Data2 != nil {
+		{
+			size, err := m.Data2.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintChannelTl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLChannelGetMutableChannel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLChannelGetMutableChannel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLChannelGetMutableChannel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Id) > 0 {
+		dAtA15 := make([]byte, len(m.Id)*10)
+		var j14 int
+		for _, num1 := range m.Id {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA15[j14] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j14++
+			}
+			dAtA15[j14] = uint8(num)
+			j14++
+		}
+		i -= j14
+		copy(dAtA[i:], dAtA15[:j14])
+		i = encodeVarintChannelTl(dAtA, i, uint64(j14))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ChannelId != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.ChannelId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLChannelGetChannelListByIdList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLChannelGetChannelListByIdList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLChannelGetChannelListByIdList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Id) > 0 {
+		dAtA17 := make([]byte, len(m.Id)*10)
+		var j16 int
+		for _, num1 := range m.Id {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA17[j16] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j16++
+			}
+			dAtA17[j16] = uint8(num)
+			j16++
+		}
+		i -= j16
+		copy(dAtA[i:], dAtA17[:j16])
+		i = encodeVarintChannelTl(dAtA, i, uint64(j16))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.SelfUserId != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.SelfUserId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLChannelGetChannelParticipantIdList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLChannelGetChannelParticipantIdList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLChannelGetChannelParticipantIdList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ChannelId != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.ChannelId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLChannelCreateChannel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLChannelCreateChannel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLChannelCreateChannel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.About) > 0 {
+		i -= len(m.About)
+		copy(dAtA[i:], m.About)
+		i = encodeVarintChannelTl(dAtA, i, uint64(len(m.About)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintChannelTl(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Megagroup {
+		i--
+		if m.Megagroup {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Broadcast {
+		i--
+		if m.Broadcast {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.CreatorId != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.CreatorId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLChannelJoinChannel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLChannelJoinChannel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLChannelJoinChannel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.UserId != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.UserId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ChannelId != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.ChannelId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLChannelLeaveChannel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLChannelLeaveChannel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLChannelLeaveChannel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.UserId != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.UserId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ChannelId != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.ChannelId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLChannelInviteToChannel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLChannelInviteToChannel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLChannelInviteToChannel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Id) > 0 {
+		dAtA19 := make([]byte, len(m.Id)*10)
+		var j18 int
+		for _, num1 := range m.Id {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA19[j18] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j18++
+			}
+			dAtA19[j18] = uint8(num)
+			j18++
+		}
+		i -= j18
+		copy(dAtA[i:], dAtA19[:j18])
+		i = encodeVarintChannelTl(dAtA, i, uint64(j18))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.InviterId != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.InviterId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ChannelId != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.ChannelId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLChannelEditAdmin) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLChannelEditAdmin) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLChannelEditAdmin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Rank) > 0 {
+		i -= len(m.Rank)
+		copy(dAtA[i:], m.Rank)
+		i = encodeVarintChannelTl(dAtA, i, uint64(len(m.Rank)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.AdminRights != nil {
+		{
+			size, err := m.AdminRights.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintChannelTl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.UserId != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.UserId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.OperatorId != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.OperatorId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ChannelId != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.ChannelId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLChannelEditBanned) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLChannelEditBanned) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLChannelEditBanned) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.BannedRights != nil {
+		{
+			size, err := m.BannedRights.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintChannelTl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.UserId != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.UserId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.OperatorId != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.OperatorId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ChannelId != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.ChannelId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLChannelGetChannelParticipants) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLChannelGetChannelParticipants) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLChannelGetChannelParticipants) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Limit != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Offset != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.Offset))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Filter != nil {
+		{
+			size, err := m.Filter.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintChannelTl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ChannelId != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.ChannelId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLChannelSendMessage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLChannelSendMessage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLChannelSendMessage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Message != nil {
+		{
+			size, err := m.Message.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintChannelTl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.RandomId != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.RandomId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ChannelId != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.ChannelId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.UserId != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.UserId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLChannelGetHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLChannelGetHistory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLChannelGetHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.MinId != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.MinId))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.MaxId != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.MaxId))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Limit != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.AddOffset != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.AddOffset))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.OffsetId != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.OffsetId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ChannelId != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.ChannelId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.UserId != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.UserId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLChannelGetMessages) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLChannelGetMessages) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLChannelGetMessages) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Id) > 0 {
+		dAtA25 := make([]byte, len(m.Id)*10)
+		var j24 int
+		for _, num1 := range m.Id {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA25[j24] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j24++
+			}
+			dAtA25[j24] = uint8(num)
+			j24++
+		}
+		i -= j24
+		copy(dAtA[i:], dAtA25[:j24])
+		i = encodeVarintChannelTl(dAtA, i, uint64(j24))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.ChannelId != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.ChannelId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.UserId != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.UserId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLChannelReadHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLChannelReadHistory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLChannelReadHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.MaxId != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.MaxId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ChannelId != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.ChannelId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.UserId != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.UserId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLChannelGetChannelDifference) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLChannelGetChannelDifference) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLChannelGetChannelDifference) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Limit != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Pts != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.Pts))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ChannelId != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.ChannelId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.UserId != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.UserId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLChannelEditTitle) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLChannelEditTitle) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLChannelEditTitle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintChannelTl(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.OperatorId != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.OperatorId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ChannelId != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.ChannelId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLChannelEditPhoto) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLChannelEditPhoto) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLChannelEditPhoto) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Photo != nil {
+		{
+			size, err := m.Photo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintChannelTl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.OperatorId != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.OperatorId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ChannelId != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.ChannelId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLChannelToggleSignatures) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLChannelToggleSignatures) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLChannelToggleSignatures) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Enabled != nil {
+		{
+			size, err := m.Enabled.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintChannelTl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.OperatorId != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.OperatorId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ChannelId != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.ChannelId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLChannelTogglePreHistoryHidden) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLChannelTogglePreHistoryHidden) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLChannelTogglePreHistoryHidden) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Enabled != nil {
+		{
+			size, err := m.Enabled.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintChannelTl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.OperatorId != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.OperatorId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ChannelId != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.ChannelId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLChannelToggleSlowMode) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLChannelToggleSlowMode) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLChannelToggleSlowMode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Seconds != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.Seconds))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.OperatorId != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.OperatorId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ChannelId != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.ChannelId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLChannelDeleteChannel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLChannelDeleteChannel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLChannelDeleteChannel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.OperatorId != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.OperatorId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ChannelId != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.ChannelId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLChannelDeleteMessages) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLChannelDeleteMessages) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLChannelDeleteMessages) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Id) > 0 {
+		dAtA30 := make([]byte, len(m.Id)*10)
+		var j29 int
+		for _, num1 := range m.Id {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA30[j29] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j29++
+			}
+			dAtA30[j29] = uint8(num)
+			j29++
+		}
+		i -= j29
+		copy(dAtA[i:], dAtA30[:j29])
+		i = encodeVarintChannelTl(dAtA, i, uint64(j29))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.ChannelId != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.ChannelId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.UserId != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.UserId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLChannelDeleteParticipantHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLChannelDeleteParticipantHistory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLChannelDeleteParticipantHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Limit != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ParticipantId != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.ParticipantId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ChannelId != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.ChannelId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.OperatorId != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.OperatorId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLChannelDeleteHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLChannelDeleteHistory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLChannelDeleteHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.MaxId != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.MaxId))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ForEveryone {
+		i--
+		if m.ForEveryone {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ChannelId != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.ChannelId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.UserId != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.UserId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintChannelTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Vector_Chat) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Vector_Chat) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Vector_Chat) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Datas) > 0 {
+		for iNdEx := len(m.Datas) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Datas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintChannelTl(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Vector_Long) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Vector_Long) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Vector_Long) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Datas) > 0 {
+		dAtA32 := make([]byte, len(m.Datas)*10)
+		var j31 int
+		for _, num1 := range m.Datas {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA32[j31] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j31++
+			}
+			dAtA32[j31] = uint8(num)
+			j31++
+		}
+		i -= j31
+		copy(dAtA[i:], dAtA32[:j31])
+		i = encodeVarintChannelTl(dAtA, i, uint64(j31))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Vector_MessageBox) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Vector_MessageBox) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Vector_MessageBox) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Datas) > 0 {
+		for iNdEx := len(m.Datas) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Datas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintChannelTl(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintChannelTl(dAtA []byte, offset int, v uint64) int {
+	offset -= sovChannelTl(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ImmutableChannel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PredicateName)
+	if l > 0 {
+		n += 1 + l + sovChannelTl(uint64(l))
+	}
+	if m.Constructor != 0 {
+		n += 1 + sovChannelTl(uint64(m.Constructor))
+	}
+	if m.Id != 0 {
+		n += 1 + sovChannelTl(uint64(m.Id))
+	}
+	if m.AccessHash != 0 {
+		n += 1 + sovChannelTl(uint64(m.AccessHash))
+	}
+	if m.Creator != 0 {
+		n += 1 + sovChannelTl(uint64(m.Creator))
+	}
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovChannelTl(uint64(l))
+	}
+	l = len(m.About)
+	if l > 0 {
+		n += 1 + l + sovChannelTl(uint64(l))
+	}
+	if m.Photo != nil {
+		l = m.Photo.Size()
+		n += 1 + l + sovChannelTl(uint64(l))
+	}
+	if m.Broadcast {
+		n += 2
+	}
+	if m.Megagroup {
+		n += 2
+	}
+	if m.Signatures {
+		n += 2
+	}
+	if m.Noforwards {
+		n += 2
+	}
+	if m.Deactivated {
+		n += 2
+	}
+	if m.HiddenPrehistory {
+		n += 2
+	}
+	l = len(m.Username)
+	if l > 0 {
+		n += 1 + l + sovChannelTl(uint64(l))
+	}
+	if m.ParticipantsCount != 0 {
+		n += 2 + sovChannelTl(uint64(m.ParticipantsCount))
+	}
+	if m.DefaultBannedRights != nil {
+		l = m.DefaultBannedRights.Size()
+		n += 2 + l + sovChannelTl(uint64(l))
+	}
+	if m.SlowmodeSeconds != 0 {
+		n += 2 + sovChannelTl(uint64(m.SlowmodeSeconds))
+	}
+	if m.Pts != 0 {
+		n += 2 + sovChannelTl(uint64(m.Pts))
+	}
+	if m.TopMessage != 0 {
+		n += 2 + sovChannelTl(uint64(m.TopMessage))
+	}
+	if m.Date != 0 {
+		n += 2 + sovChannelTl(uint64(m.Date))
+	}
+	if m.Version != 0 {
+		n += 2 + sovChannelTl(uint64(m.Version))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLImmutableChannel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Data2 != nil {
+		l = m.Data2.Size()
+		n += 1 + l + sovChannelTl(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ImmutableChannelParticipant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PredicateName)
+	if l > 0 {
+		n += 1 + l + sovChannelTl(uint64(l))
+	}
+	if m.Constructor != 0 {
+		n += 1 + sovChannelTl(uint64(m.Constructor))
+	}
+	if m.Id != 0 {
+		n += 1 + sovChannelTl(uint64(m.Id))
+	}
+	if m.ChannelId != 0 {
+		n += 1 + sovChannelTl(uint64(m.ChannelId))
+	}
+	if m.UserId != 0 {
+		n += 1 + sovChannelTl(uint64(m.UserId))
+	}
+	if m.ParticipantType != 0 {
+		n += 1 + sovChannelTl(uint64(m.ParticipantType))
+	}
+	if m.State != 0 {
+		n += 1 + sovChannelTl(uint64(m.State))
+	}
+	if m.InviterUserId != 0 {
+		n += 1 + sovChannelTl(uint64(m.InviterUserId))
+	}
+	if m.AdminRights != nil {
+		l = m.AdminRights.Size()
+		n += 1 + l + sovChannelTl(uint64(l))
+	}
+	if m.BannedRights != nil {
+		l = m.BannedRights.Size()
+		n += 1 + l + sovChannelTl(uint64(l))
+	}
+	l = len(m.Rank)
+	if l > 0 {
+		n += 1 + l + sovChannelTl(uint64(l))
+	}
+	if m.PromotedBy != 0 {
+		n += 1 + sovChannelTl(uint64(m.PromotedBy))
+	}
+	if m.KickedBy != 0 {
+		n += 1 + sovChannelTl(uint64(m.KickedBy))
+	}
+	if m.ReadInboxMaxId != 0 {
+		n += 1 + sovChannelTl(uint64(m.ReadInboxMaxId))
+	}
+	if m.AvailableMinId != 0 {
+		n += 1 + sovChannelTl(uint64(m.AvailableMinId))
+	}
+	if m.Date != 0 {
+		n += 2 + sovChannelTl(uint64(m.Date))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLImmutableChannelParticipant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Data2 != nil {
+		l = m.Data2.Size()
+		n += 1 + l + sovChannelTl(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *MutableChannel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PredicateName)
+	if l > 0 {
+		n += 1 + l + sovChannelTl(uint64(l))
+	}
+	if m.Constructor != 0 {
+		n += 1 + sovChannelTl(uint64(m.Constructor))
+	}
+	if m.Channel != nil {
+		l = m.Channel.Size()
+		n += 1 + l + sovChannelTl(uint64(l))
+	}
+	if len(m.Participants) > 0 {
+		for _, e := range m.Participants {
+			l = e.Size()
+			n += 1 + l + sovChannelTl(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLMutableChannel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Data2 != nil {
+		l = m.Data2.Size()
+		n += 1 + l + sovChannelTl(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ChannelParticipants) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PredicateName)
+	if l > 0 {
+		n += 1 + l + sovChannelTl(uint64(l))
+	}
+	if m.Constructor != 0 {
+		n += 1 + sovChannelTl(uint64(m.Constructor))
+	}
+	if m.Count != 0 {
+		n += 1 + sovChannelTl(uint64(m.Count))
+	}
+	if len(m.Participants) > 0 {
+		for _, e := range m.Participants {
+			l = e.Size()
+			n += 1 + l + sovChannelTl(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLChannelParticipants) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Data2 != nil {
+		l = m.Data2.Size()
+		n += 1 + l + sovChannelTl(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ChannelDifference) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PredicateName)
+	if l > 0 {
+		n += 1 + l + sovChannelTl(uint64(l))
+	}
+	if m.Constructor != 0 {
+		n += 1 + sovChannelTl(uint64(m.Constructor))
+	}
+	if m.Final {
+		n += 2
+	}
+	if m.Pts != 0 {
+		n += 1 + sovChannelTl(uint64(m.Pts))
+	}
+	if len(m.NewMessages) > 0 {
+		for _, e := range m.NewMessages {
+			l = e.Size()
+			n += 1 + l + sovChannelTl(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLChannelDifference) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Data2 != nil {
+		l = m.Data2.Size()
+		n += 1 + l + sovChannelTl(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ChannelDeletedMessages) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PredicateName)
+	if l > 0 {
+		n += 1 + l + sovChannelTl(uint64(l))
+	}
+	if m.Constructor != 0 {
+		n += 1 + sovChannelTl(uint64(m.Constructor))
+	}
+	if m.Pts != 0 {
+		n += 1 + sovChannelTl(uint64(m.Pts))
+	}
+	if m.PtsCount != 0 {
+		n += 1 + sovChannelTl(uint64(m.PtsCount))
+	}
+	if len(m.Id) > 0 {
+		l = 0
+		for _, e := range m.Id {
+			l += sovChannelTl(uint64(e))
+		}
+		n += 1 + sovChannelTl(uint64(l)) + l
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLChannelDeletedMessages) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Data2 != nil {
+		l = m.Data2.Size()
+		n += 1 + l + sovChannelTl(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLChannelGetMutableChannel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovChannelTl(uint64(m.Constructor))
+	}
+	if m.ChannelId != 0 {
+		n += 1 + sovChannelTl(uint64(m.ChannelId))
+	}
+	if len(m.Id) > 0 {
+		l = 0
+		for _, e := range m.Id {
+			l += sovChannelTl(uint64(e))
+		}
+		n += 1 + sovChannelTl(uint64(l)) + l
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLChannelGetChannelListByIdList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovChannelTl(uint64(m.Constructor))
+	}
+	if m.SelfUserId != 0 {
+		n += 1 + sovChannelTl(uint64(m.SelfUserId))
+	}
+	if len(m.Id) > 0 {
+		l = 0
+		for _, e := range m.Id {
+			l += sovChannelTl(uint64(e))
+		}
+		n += 1 + sovChannelTl(uint64(l)) + l
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLChannelGetChannelParticipantIdList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovChannelTl(uint64(m.Constructor))
+	}
+	if m.ChannelId != 0 {
+		n += 1 + sovChannelTl(uint64(m.ChannelId))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLChannelCreateChannel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovChannelTl(uint64(m.Constructor))
+	}
+	if m.CreatorId != 0 {
+		n += 1 + sovChannelTl(uint64(m.CreatorId))
+	}
+	if m.Broadcast {
+		n += 2
+	}
+	if m.Megagroup {
+		n += 2
+	}
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovChannelTl(uint64(l))
+	}
+	l = len(m.About)
+	if l > 0 {
+		n += 1 + l + sovChannelTl(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLChannelJoinChannel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovChannelTl(uint64(m.Constructor))
+	}
+	if m.ChannelId != 0 {
+		n += 1 + sovChannelTl(uint64(m.ChannelId))
+	}
+	if m.UserId != 0 {
+		n += 1 + sovChannelTl(uint64(m.UserId))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLChannelLeaveChannel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovChannelTl(uint64(m.Constructor))
+	}
+	if m.ChannelId != 0 {
+		n += 1 + sovChannelTl(uint64(m.ChannelId))
+	}
+	if m.UserId != 0 {
+		n += 1 + sovChannelTl(uint64(m.UserId))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLChannelInviteToChannel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovChannelTl(uint64(m.Constructor))
+	}
+	if m.ChannelId != 0 {
+		n += 1 + sovChannelTl(uint64(m.ChannelId))
+	}
+	if m.InviterId != 0 {
+		n += 1 + sovChannelTl(uint64(m.InviterId))
+	}
+	if len(m.Id) > 0 {
+		l = 0
+		for _, e := range m.Id {
+			l += sovChannelTl(uint64(e))
+		}
+		n += 1 + sovChannelTl(uint64(l)) + l
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLChannelEditAdmin) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovChannelTl(uint64(m.Constructor))
+	}
+	if m.ChannelId != 0 {
+		n += 1 + sovChannelTl(uint64(m.ChannelId))
+	}
+	if m.OperatorId != 0 {
+		n += 1 + sovChannelTl(uint64(m.OperatorId))
+	}
+	if m.UserId != 0 {
+		n += 1 + sovChannelTl(uint64(m.UserId))
+	}
+	if m.AdminRights != nil {
+		l = m.AdminRights.Size()
+		n += 1 + l + sovChannelTl(uint64(l))
+	}
+	l = len(m.Rank)
+	if l > 0 {
+		n += 1 + l + sovChannelTl(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLChannelEditBanned) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovChannelTl(uint64(m.Constructor))
+	}
+	if m.ChannelId != 0 {
+		n += 1 + sovChannelTl(uint64(m.ChannelId))
+	}
+	if m.OperatorId != 0 {
+		n += 1 + sovChannelTl(uint64(m.OperatorId))
+	}
+	if m.UserId != 0 {
+		n += 1 + sovChannelTl(uint64(m.UserId))
+	}
+	if m.BannedRights != nil {
+		l = m.BannedRights.Size()
+		n += 1 + l + sovChannelTl(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLChannelGetChannelParticipants) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovChannelTl(uint64(m.Constructor))
+	}
+	if m.ChannelId != 0 {
+		n += 1 + sovChannelTl(uint64(m.ChannelId))
+	}
+	if m.Filter != nil {
+		l = m.Filter.Size()
+		n += 1 + l + sovChannelTl(uint64(l))
+	}
+	if m.Offset != 0 {
+		n += 1 + sovChannelTl(uint64(m.Offset))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovChannelTl(uint64(m.Limit))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLChannelSendMessage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovChannelTl(uint64(m.Constructor))
+	}
+	if m.UserId != 0 {
+		n += 1 + sovChannelTl(uint64(m.UserId))
+	}
+	if m.ChannelId != 0 {
+		n += 1 + sovChannelTl(uint64(m.ChannelId))
+	}
+	if m.RandomId != 0 {
+		n += 1 + sovChannelTl(uint64(m.RandomId))
+	}
+	if m.Message != nil {
+		l = m.Message.Size()
+		n += 1 + l + sovChannelTl(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLChannelGetHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovChannelTl(uint64(m.Constructor))
+	}
+	if m.UserId != 0 {
+		n += 1 + sovChannelTl(uint64(m.UserId))
+	}
+	if m.ChannelId != 0 {
+		n += 1 + sovChannelTl(uint64(m.ChannelId))
+	}
+	if m.OffsetId != 0 {
+		n += 1 + sovChannelTl(uint64(m.OffsetId))
+	}
+	if m.AddOffset != 0 {
+		n += 1 + sovChannelTl(uint64(m.AddOffset))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovChannelTl(uint64(m.Limit))
+	}
+	if m.MaxId != 0 {
+		n += 1 + sovChannelTl(uint64(m.MaxId))
+	}
+	if m.MinId != 0 {
+		n += 1 + sovChannelTl(uint64(m.MinId))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLChannelGetMessages) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovChannelTl(uint64(m.Constructor))
+	}
+	if m.UserId != 0 {
+		n += 1 + sovChannelTl(uint64(m.UserId))
+	}
+	if m.ChannelId != 0 {
+		n += 1 + sovChannelTl(uint64(m.ChannelId))
+	}
+	if len(m.Id) > 0 {
+		l = 0
+		for _, e := range m.Id {
+			l += sovChannelTl(uint64(e))
+		}
+		n += 1 + sovChannelTl(uint64(l)) + l
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLChannelReadHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovChannelTl(uint64(m.Constructor))
+	}
+	if m.UserId != 0 {
+		n += 1 + sovChannelTl(uint64(m.UserId))
+	}
+	if m.ChannelId != 0 {
+		n += 1 + sovChannelTl(uint64(m.ChannelId))
+	}
+	if m.MaxId != 0 {
+		n += 1 + sovChannelTl(uint64(m.MaxId))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLChannelGetChannelDifference) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovChannelTl(uint64(m.Constructor))
+	}
+	if m.UserId != 0 {
+		n += 1 + sovChannelTl(uint64(m.UserId))
+	}
+	if m.ChannelId != 0 {
+		n += 1 + sovChannelTl(uint64(m.ChannelId))
+	}
+	if m.Pts != 0 {
+		n += 1 + sovChannelTl(uint64(m.Pts))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovChannelTl(uint64(m.Limit))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLChannelEditTitle) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovChannelTl(uint64(m.Constructor))
+	}
+	if m.ChannelId != 0 {
+		n += 1 + sovChannelTl(uint64(m.ChannelId))
+	}
+	if m.OperatorId != 0 {
+		n += 1 + sovChannelTl(uint64(m.OperatorId))
+	}
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovChannelTl(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLChannelEditPhoto) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovChannelTl(uint64(m.Constructor))
+	}
+	if m.ChannelId != 0 {
+		n += 1 + sovChannelTl(uint64(m.ChannelId))
+	}
+	if m.OperatorId != 0 {
+		n += 1 + sovChannelTl(uint64(m.OperatorId))
+	}
+	if m.Photo != nil {
+		l = m.Photo.Size()
+		n += 1 + l + sovChannelTl(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLChannelToggleSignatures) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovChannelTl(uint64(m.Constructor))
+	}
+	if m.ChannelId != 0 {
+		n += 1 + sovChannelTl(uint64(m.ChannelId))
+	}
+	if m.OperatorId != 0 {
+		n += 1 + sovChannelTl(uint64(m.OperatorId))
+	}
+	if m.Enabled != nil {
+		l = m.Enabled.Size()
+		n += 1 + l + sovChannelTl(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLChannelTogglePreHistoryHidden) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovChannelTl(uint64(m.Constructor))
+	}
+	if m.ChannelId != 0 {
+		n += 1 + sovChannelTl(uint64(m.ChannelId))
+	}
+	if m.OperatorId != 0 {
+		n += 1 + sovChannelTl(uint64(m.OperatorId))
+	}
+	if m.Enabled != nil {
+		l = m.Enabled.Size()
+		n += 1 + l + sovChannelTl(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLChannelToggleSlowMode) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovChannelTl(uint64(m.Constructor))
+	}
+	if m.ChannelId != 0 {
+		n += 1 + sovChannelTl(uint64(m.ChannelId))
+	}
+	if m.OperatorId != 0 {
+		n += 1 + sovChannelTl(uint64(m.OperatorId))
+	}
+	if m.Seconds != 0 {
+		n += 1 + sovChannelTl(uint64(m.Seconds))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLChannelDeleteChannel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovChannelTl(uint64(m.Constructor))
+	}
+	if m.ChannelId != 0 {
+		n += 1 + sovChannelTl(uint64(m.ChannelId))
+	}
+	if m.OperatorId != 0 {
+		n += 1 + sovChannelTl(uint64(m.OperatorId))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLChannelDeleteMessages) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovChannelTl(uint64(m.Constructor))
+	}
+	if m.UserId != 0 {
+		n += 1 + sovChannelTl(uint64(m.UserId))
+	}
+	if m.ChannelId != 0 {
+		n += 1 + sovChannelTl(uint64(m.ChannelId))
+	}
+	if len(m.Id) > 0 {
+		l = 0
+		for _, e := range m.Id {
+			l += sovChannelTl(uint64(e))
+		}
+		n += 1 + sovChannelTl(uint64(l)) + l
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLChannelDeleteParticipantHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovChannelTl(uint64(m.Constructor))
+	}
+	if m.OperatorId != 0 {
+		n += 1 + sovChannelTl(uint64(m.OperatorId))
+	}
+	if m.ChannelId != 0 {
+		n += 1 + sovChannelTl(uint64(m.ChannelId))
+	}
+	if m.ParticipantId != 0 {
+		n += 1 + sovChannelTl(uint64(m.ParticipantId))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovChannelTl(uint64(m.Limit))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLChannelDeleteHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovChannelTl(uint64(m.Constructor))
+	}
+	if m.UserId != 0 {
+		n += 1 + sovChannelTl(uint64(m.UserId))
+	}
+	if m.ChannelId != 0 {
+		n += 1 + sovChannelTl(uint64(m.ChannelId))
+	}
+	if m.ForEveryone {
+		n += 2
+	}
+	if m.MaxId != 0 {
+		n += 1 + sovChannelTl(uint64(m.MaxId))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Vector_Chat) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Datas) > 0 {
+		for _, e := range m.Datas {
+			l = e.Size()
+			n += 1 + l + sovChannelTl(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Vector_Long) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Datas) > 0 {
+		l = 0
+		for _, e := range m.Datas {
+			l += sovChannelTl(uint64(e))
+		}
+		n += 1 + sovChannelTl(uint64(l)) + l
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Vector_MessageBox) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Datas) > 0 {
+		for _, e := range m.Datas {
+			l = e.Size()
+			n += 1 + l + sovChannelTl(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovChannelTl(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozChannelTl(x uint64) (n int) {
+	return sovChannelTl(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ImmutableChannel) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChannelTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ImmutableChannel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ImmutableChannel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PredicateName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PredicateName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessHash", wireType)
+			}
+			m.AccessHash = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AccessHash |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			m.Creator = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Creator |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field About", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.About = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Photo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Photo == nil {
+				m.Photo = &mtproto.Photo{}
+			}
+			if err := m.Photo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Broadcast", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Broadcast = bool(v != 0)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Megagroup", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Megagroup = bool(v != 0)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Signatures = bool(v != 0)
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Noforwards", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Noforwards = bool(v != 0)
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deactivated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Deactivated = bool(v != 0)
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HiddenPrehistory", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HiddenPrehistory = bool(v != 0)
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Username = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParticipantsCount", wireType)
+			}
+			m.ParticipantsCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ParticipantsCount |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultBannedRights", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DefaultBannedRights == nil {
+				m.DefaultBannedRights = &mtproto.ChatBannedRights{}
+			}
+			if err := m.DefaultBannedRights.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlowmodeSeconds", wireType)
+			}
+			m.SlowmodeSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlowmodeSeconds |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pts", wireType)
+			}
+			m.Pts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Pts |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopMessage", wireType)
+			}
+			m.TopMessage = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopMessage |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Date", wireType)
+			}
+			m.Date = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Date |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChannelTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLImmutableChannel) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChannelTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_immutableChannel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_immutableChannel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data2", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Data2 == nil {
+				m.Data2 = &ImmutableChannel{}
+			}
+			if err := m.Data2.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChannelTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ImmutableChannelParticipant) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChannelTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ImmutableChannelParticipant: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ImmutableChannelParticipant: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PredicateName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PredicateName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			m.ChannelId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChannelId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			m.UserId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UserId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParticipantType", wireType)
+			}
+			m.ParticipantType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ParticipantType |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InviterUserId", wireType)
+			}
+			m.InviterUserId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InviterUserId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdminRights", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AdminRights == nil {
+				m.AdminRights = &mtproto.ChatAdminRights{}
+			}
+			if err := m.AdminRights.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BannedRights", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BannedRights == nil {
+				m.BannedRights = &mtproto.ChatBannedRights{}
+			}
+			if err := m.BannedRights.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rank", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rank = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PromotedBy", wireType)
+			}
+			m.PromotedBy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PromotedBy |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KickedBy", wireType)
+			}
+			m.KickedBy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KickedBy |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadInboxMaxId", wireType)
+			}
+			m.ReadInboxMaxId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReadInboxMaxId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AvailableMinId", wireType)
+			}
+			m.AvailableMinId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AvailableMinId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Date", wireType)
+			}
+			m.Date = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Date |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChannelTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLImmutableChannelParticipant) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChannelTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_immutableChannelParticipant: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_immutableChannelParticipant: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data2", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Data2 == nil {
+				m.Data2 = &ImmutableChannelParticipant{}
+			}
+			if err := m.Data2.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChannelTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MutableChannel) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChannelTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MutableChannel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MutableChannel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PredicateName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PredicateName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Channel == nil {
+				m.Channel = &ImmutableChannel{}
+			}
+			if err := m.Channel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Participants", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Participants = append(m.Participants, &ImmutableChannelParticipant{})
+			if err := m.Participants[len(m.Participants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChannelTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLMutableChannel) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChannelTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_mutableChannel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_mutableChannel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data2", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Data2 == nil {
+				m.Data2 = &MutableChannel{}
+			}
+			if err := m.Data2.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChannelTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChannelParticipants) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChannelTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChannelParticipants: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChannelParticipants: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PredicateName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PredicateName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Participants", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Participants = append(m.Participants, &ImmutableChannelParticipant{})
+			if err := m.Participants[len(m.Participants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChannelTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLChannelParticipants) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChannelTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_channelParticipants: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_channelParticipants: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data2", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Data2 == nil {
+				m.Data2 = &ChannelParticipants{}
+			}
+			if err := m.Data2.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChannelTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChannelDifference) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChannelTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChannelDifference: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChannelDifference: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PredicateName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PredicateName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Final", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Final = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pts", wireType)
+			}
+			m.Pts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Pts |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewMessages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewMessages = append(m.NewMessages, &mtproto.MessageBox{})
+			if err := m.NewMessages[len(m.NewMessages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChannelTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLChannelDifference) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChannelTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_channelDifference: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_channelDifference: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data2", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Data2 == nil {
+				m.Data2 = &ChannelDifference{}
+			}
+			if err := m.Data2.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChannelTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChannelDeletedMessages) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChannelTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChannelDeletedMessages: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChannelDeletedMessages: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PredicateName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PredicateName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pts", wireType)
+			}
+			m.Pts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Pts |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PtsCount", wireType)
+			}
+			m.PtsCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PtsCount |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowChannelTl
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Id = append(m.Id, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowChannelTl
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthChannelTl
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthChannelTl
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Id) == 0 {
+					m.Id = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowChannelTl
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Id = append(m.Id, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChannelTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLChannelDeletedMessages) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChannelTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_channelDeletedMessages: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_channelDeletedMessages: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data2", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Data2 == nil {
+				m.Data2 = &ChannelDeletedMessages{}
+			}
+			if err := m.Data2.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChannelTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLChannelGetMutableChannel) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChannelTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_channel_getMutableChannel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_channel_getMutableChannel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			m.ChannelId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChannelId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowChannelTl
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Id = append(m.Id, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowChannelTl
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthChannelTl
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthChannelTl
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Id) == 0 {
+					m.Id = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowChannelTl
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Id = append(m.Id, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChannelTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLChannelGetChannelListByIdList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChannelTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_channel_getChannelListByIdList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_channel_getChannelListByIdList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SelfUserId", wireType)
+			}
+			m.SelfUserId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SelfUserId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowChannelTl
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Id = append(m.Id, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowChannelTl
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthChannelTl
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthChannelTl
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Id) == 0 {
+					m.Id = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowChannelTl
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Id = append(m.Id, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChannelTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLChannelGetChannelParticipantIdList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChannelTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_channel_getChannelParticipantIdList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_channel_getChannelParticipantIdList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			m.ChannelId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChannelId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChannelTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLChannelCreateChannel) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChannelTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_channel_createChannel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_channel_createChannel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatorId", wireType)
+			}
+			m.CreatorId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatorId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Broadcast", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Broadcast = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Megagroup", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Megagroup = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field About", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.About = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChannelTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLChannelJoinChannel) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChannelTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_channel_joinChannel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_channel_joinChannel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			m.ChannelId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChannelId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			m.UserId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UserId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChannelTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLChannelLeaveChannel) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChannelTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_channel_leaveChannel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_channel_leaveChannel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			m.ChannelId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChannelId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			m.UserId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UserId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChannelTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLChannelInviteToChannel) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChannelTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_channel_inviteToChannel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_channel_inviteToChannel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			m.ChannelId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChannelId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InviterId", wireType)
+			}
+			m.InviterId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InviterId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowChannelTl
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Id = append(m.Id, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowChannelTl
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthChannelTl
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthChannelTl
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Id) == 0 {
+					m.Id = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowChannelTl
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Id = append(m.Id, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChannelTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLChannelEditAdmin) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChannelTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_channel_editAdmin: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_channel_editAdmin: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			m.ChannelId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChannelId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorId", wireType)
+			}
+			m.OperatorId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OperatorId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			m.UserId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UserId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdminRights", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AdminRights == nil {
+				m.AdminRights = &mtproto.ChatAdminRights{}
+			}
+			if err := m.AdminRights.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rank", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rank = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChannelTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLChannelEditBanned) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChannelTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_channel_editBanned: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_channel_editBanned: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			m.ChannelId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChannelId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorId", wireType)
+			}
+			m.OperatorId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OperatorId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			m.UserId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UserId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BannedRights", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BannedRights == nil {
+				m.BannedRights = &mtproto.ChatBannedRights{}
+			}
+			if err := m.BannedRights.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChannelTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLChannelGetChannelParticipants) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChannelTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_channel_getChannelParticipants: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_channel_getChannelParticipants: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			m.ChannelId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChannelId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Filter == nil {
+				m.Filter = &mtproto.ChannelParticipantsFilter{}
+			}
+			if err := m.Filter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			}
+			m.Offset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Offset |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChannelTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLChannelSendMessage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChannelTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_channel_sendMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_channel_sendMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			m.UserId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UserId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			m.ChannelId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChannelId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RandomId", wireType)
+			}
+			m.RandomId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RandomId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Message == nil {
+				m.Message = &mtproto.Message{}
+			}
+			if err := m.Message.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChannelTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLChannelGetHistory) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChannelTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_channel_getHistory: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_channel_getHistory: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			m.UserId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UserId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			m.ChannelId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChannelId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OffsetId", wireType)
+			}
+			m.OffsetId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OffsetId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddOffset", wireType)
+			}
+			m.AddOffset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AddOffset |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxId", wireType)
+			}
+			m.MaxId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinId", wireType)
+			}
+			m.MinId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChannelTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLChannelGetMessages) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChannelTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_channel_getMessages: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_channel_getMessages: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			m.UserId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UserId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			m.ChannelId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChannelId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowChannelTl
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Id = append(m.Id, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowChannelTl
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthChannelTl
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthChannelTl
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Id) == 0 {
+					m.Id = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowChannelTl
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Id = append(m.Id, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChannelTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLChannelReadHistory) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChannelTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_channel_readHistory: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_channel_readHistory: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			m.UserId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UserId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			m.ChannelId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChannelId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxId", wireType)
+			}
+			m.MaxId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChannelTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLChannelGetChannelDifference) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChannelTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_channel_getChannelDifference: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_channel_getChannelDifference: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			m.UserId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UserId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			m.ChannelId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChannelId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pts", wireType)
+			}
+			m.Pts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Pts |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChannelTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLChannelEditTitle) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChannelTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_channel_editTitle: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_channel_editTitle: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			m.ChannelId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChannelId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorId", wireType)
+			}
+			m.OperatorId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OperatorId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChannelTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLChannelEditPhoto) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChannelTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_channel_editPhoto: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_channel_editPhoto: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			m.ChannelId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChannelId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorId", wireType)
+			}
+			m.OperatorId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OperatorId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Photo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Photo == nil {
+				m.Photo = &mtproto.Photo{}
+			}
+			if err := m.Photo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChannelTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLChannelToggleSignatures) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChannelTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_channel_toggleSignatures: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_channel_toggleSignatures: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			m.ChannelId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChannelId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorId", wireType)
+			}
+			m.OperatorId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OperatorId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Enabled == nil {
+				m.Enabled = &mtproto.Bool{}
+			}
+			if err := m.Enabled.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChannelTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLChannelTogglePreHistoryHidden) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChannelTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_channel_togglePreHistoryHidden: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_channel_togglePreHistoryHidden: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			m.ChannelId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChannelId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorId", wireType)
+			}
+			m.OperatorId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OperatorId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Enabled == nil {
+				m.Enabled = &mtproto.Bool{}
+			}
+			if err := m.Enabled.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChannelTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLChannelToggleSlowMode) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChannelTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_channel_toggleSlowMode: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_channel_toggleSlowMode: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			m.ChannelId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChannelId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorId", wireType)
+			}
+			m.OperatorId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OperatorId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seconds", wireType)
+			}
+			m.Seconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Seconds |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChannelTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLChannelDeleteChannel) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChannelTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_channel_deleteChannel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_channel_deleteChannel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			m.ChannelId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChannelId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorId", wireType)
+			}
+			m.OperatorId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OperatorId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChannelTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLChannelDeleteMessages) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChannelTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_channel_deleteMessages: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_channel_deleteMessages: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			m.UserId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UserId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			m.ChannelId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChannelId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowChannelTl
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Id = append(m.Id, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowChannelTl
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthChannelTl
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthChannelTl
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Id) == 0 {
+					m.Id = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowChannelTl
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Id = append(m.Id, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChannelTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLChannelDeleteParticipantHistory) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChannelTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_channel_deleteParticipantHistory: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_channel_deleteParticipantHistory: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorId", wireType)
+			}
+			m.OperatorId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OperatorId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			m.ChannelId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChannelId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParticipantId", wireType)
+			}
+			m.ParticipantId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ParticipantId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChannelTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLChannelDeleteHistory) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChannelTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_channel_deleteHistory: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_channel_deleteHistory: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			m.UserId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UserId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			m.ChannelId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChannelId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForEveryone", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ForEveryone = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxId", wireType)
+			}
+			m.MaxId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChannelTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Vector_Chat) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChannelTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Vector_Chat: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Vector_Chat: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Datas", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Datas = append(m.Datas, &mtproto.Chat{})
+			if err := m.Datas[len(m.Datas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChannelTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Vector_Long) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChannelTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Vector_Long: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Vector_Long: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowChannelTl
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Datas = append(m.Datas, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowChannelTl
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthChannelTl
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthChannelTl
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Datas) == 0 {
+					m.Datas = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowChannelTl
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Datas = append(m.Datas, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Datas", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChannelTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Vector_MessageBox) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChannelTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Vector_MessageBox: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Vector_MessageBox: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Datas", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Datas = append(m.Datas, &mtproto.MessageBox{})
+			if err := m.Datas[len(m.Datas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChannelTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthChannelTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipChannelTl(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowChannelTl
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowChannelTl
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthChannelTl
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupChannelTl
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthChannelTl
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthChannelTl        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowChannelTl          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupChannelTl = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,215 @@
+/*
+ * WARNING! All changes made in this file will be lost!
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2022-present,  Teamgram Authors.
+ *  All rights reserved.
+ *
+ * Author: teagramio (teagram.io@gmail.com)
+ */
+
+package channel
+
+const (
+	Predicate_immutableChannel                    = "immutableChannel"
+	Predicate_immutableChannelParticipant         = "immutableChannelParticipant"
+	Predicate_mutableChannel                      = "mutableChannel"
+	Predicate_channelParticipants                 = "channelParticipants"
+	Predicate_channelDifference                   = "channelDifference"
+	Predicate_channelDeletedMessages              = "channelDeletedMessages"
+	Predicate_channel_getMutableChannel           = "channel_getMutableChannel"
+	Predicate_channel_getChannelListByIdList      = "channel_getChannelListByIdList"
+	Predicate_channel_getChannelParticipantIdList = "channel_getChannelParticipantIdList"
+	Predicate_channel_createChannel               = "channel_createChannel"
+	Predicate_channel_joinChannel                 = "channel_joinChannel"
+	Predicate_channel_leaveChannel                = "channel_leaveChannel"
+	Predicate_channel_inviteToChannel             = "channel_inviteToChannel"
+	Predicate_channel_editAdmin                   = "channel_editAdmin"
+	Predicate_channel_editBanned                  = "channel_editBanned"
+	Predicate_channel_getChannelParticipants      = "channel_getChannelParticipants"
+	Predicate_channel_sendMessage                 = "channel_sendMessage"
+	Predicate_channel_getHistory                  = "channel_getHistory"
+	Predicate_channel_getMessages                 = "channel_getMessages"
+	Predicate_channel_readHistory                 = "channel_readHistory"
+	Predicate_channel_getChannelDifference        = "channel_getChannelDifference"
+	Predicate_channel_editTitle                   = "channel_editTitle"
+	Predicate_channel_editPhoto                   = "channel_editPhoto"
+	Predicate_channel_toggleSignatures            = "channel_toggleSignatures"
+	Predicate_channel_togglePreHistoryHidden      = "channel_togglePreHistoryHidden"
+	Predicate_channel_toggleSlowMode              = "channel_toggleSlowMode"
+	Predicate_channel_deleteChannel               = "channel_deleteChannel"
+	Predicate_channel_deleteMessages              = "channel_deleteMessages"
+	Predicate_channel_deleteParticipantHistory    = "channel_deleteParticipantHistory"
+	Predicate_channel_deleteHistory               = "channel_deleteHistory"
+)
+
+var clazzNameRegisters2 = map[string]map[int]int32{
+	Predicate_immutableChannel: {
+		0: -2136842669, // 0x80a25e53
+
+	},
+	Predicate_immutableChannelParticipant: {
+		0: 1922395304, // 0x72956ca8
+
+	},
+	Predicate_mutableChannel: {
+		0: -662027966, // 0xd88a4142
+
+	},
+	Predicate_channelParticipants: {
+		0: 939154768, // 0x37fa5d50
+
+	},
+	Predicate_channelDifference: {
+		0: -357108184, // 0xeab6f628
+
+	},
+	Predicate_channelDeletedMessages: {
+		0: 844131560, // 0x32506ce8
+
+	},
+	Predicate_channel_getMutableChannel: {
+		0: -437245607, // 0xe5f02959
+
+	},
+	Predicate_channel_getChannelListByIdList: {
+		0: 461926155, // 0x1b886f0b
+
+	},
+	Predicate_channel_getChannelParticipantIdList: {
+		0: -1962023455, // 0x8b0de5e1
+
+	},
+	Predicate_channel_createChannel: {
+		0: 1595647274, // 0x5f1ba52a
+
+	},
+	Predicate_channel_joinChannel: {
+		0: -868924054, // 0xcc35456a
+
+	},
+	Predicate_channel_leaveChannel: {
+		0: 246600306, // 0xeb2d272
+
+	},
+	Predicate_channel_inviteToChannel: {
+		0: -1728965731, // 0x98f2139d
+
+	},
+	Predicate_channel_editAdmin: {
+		0: -937752617, // 0xc81b07d7
+
+	},
+	Predicate_channel_editBanned: {
+		0: 1819343561, // 0x6c70fac9
+
+	},
+	Predicate_channel_getChannelParticipants: {
+		0: -666199065, // 0xd84a9be7
+
+	},
+	Predicate_channel_sendMessage: {
+		0: -1261001836, // 0xb4d6a394
+
+	},
+	Predicate_channel_getHistory: {
+		0: -2100477061, // 0x82cd437b
+
+	},
+	Predicate_channel_getMessages: {
+		0: 537158293, // 0x20046295
+
+	},
+	Predicate_channel_readHistory: {
+		0: -1344822315, // 0xafd7a3d5
+
+	},
+	Predicate_channel_getChannelDifference: {
+		0: 1237596961, // 0x49c43b21
+
+	},
+	Predicate_channel_editTitle: {
+		0: 558691925, // 0x214cf655
+
+	},
+	Predicate_channel_editPhoto: {
+		0: 111840841, // 0x6aa8e49
+
+	},
+	Predicate_channel_toggleSignatures: {
+		0: 1029421910, // 0x3d5bbb56
+
+	},
+	Predicate_channel_togglePreHistoryHidden: {
+		0: -1024282738, // 0xc2f2af8e
+
+	},
+	Predicate_channel_toggleSlowMode: {
+		0: -405948939, // 0xe7cdb5f5
+
+	},
+	Predicate_channel_deleteChannel: {
+		0: 117753992, // 0x704c888
+
+	},
+	Predicate_channel_deleteMessages: {
+		0: 1766934587, // 0x6951483b
+
+	},
+	Predicate_channel_deleteParticipantHistory: {
+		0: 1496960477, // 0x5939cddd
+
+	},
+	Predicate_channel_deleteHistory: {
+		0: -172047269, // 0xf5bec45b
+
+	},
+}
+
+var clazzIdNameRegisters2 = map[int32]string{
+	-2136842669: Predicate_immutableChannel,                    // 0x80a25e53
+	1922395304:  Predicate_immutableChannelParticipant,         // 0x72956ca8
+	-662027966:  Predicate_mutableChannel,                      // 0xd88a4142
+	939154768:   Predicate_channelParticipants,                 // 0x37fa5d50
+	-357108184:  Predicate_channelDifference,                   // 0xeab6f628
+	844131560:   Predicate_channelDeletedMessages,              // 0x32506ce8
+	-437245607:  Predicate_channel_getMutableChannel,           // 0xe5f02959
+	461926155:   Predicate_channel_getChannelListByIdList,      // 0x1b886f0b
+	-1962023455: Predicate_channel_getChannelParticipantIdList, // 0x8b0de5e1
+	1595647274:  Predicate_channel_createChannel,               // 0x5f1ba52a
+	-868924054:  Predicate_channel_joinChannel,                 // 0xcc35456a
+	246600306:   Predicate_channel_leaveChannel,                // 0xeb2d272
+	-1728965731: Predicate_channel_inviteToChannel,             // 0x98f2139d
+	-937752617:  Predicate_channel_editAdmin,                   // 0xc81b07d7
+	1819343561:  Predicate_channel_editBanned,                  // 0x6c70fac9
+	-666199065:  Predicate_channel_getChannelParticipants,      // 0xd84a9be7
+	-1261001836: Predicate_channel_sendMessage,                 // 0xb4d6a394
+	-2100477061: Predicate_channel_getHistory,                  // 0x82cd437b
+	537158293:   Predicate_channel_getMessages,                 // 0x20046295
+	-1344822315: Predicate_channel_readHistory,                 // 0xafd7a3d5
+	1237596961:  Predicate_channel_getChannelDifference,        // 0x49c43b21
+	558691925:   Predicate_channel_editTitle,                   // 0x214cf655
+	111840841:   Predicate_channel_editPhoto,                   // 0x6aa8e49
+	1029421910:  Predicate_channel_toggleSignatures,            // 0x3d5bbb56
+	-1024282738: Predicate_channel_togglePreHistoryHidden,      // 0xc2f2af8e
+	-405948939:  Predicate_channel_toggleSlowMode,              // 0xe7cdb5f5
+	117753992:   Predicate_channel_deleteChannel,               // 0x704c888
+	1766934587:  Predicate_channel_deleteMessages,              // 0x6951483b
+	1496960477:  Predicate_channel_deleteParticipantHistory,    // 0x5939cddd
+	-172047269:  Predicate_channel_deleteHistory,               // 0xf5bec45b
+
+}
+
+func GetClazzID(clazzName string, layer int) int32 {
+	if m, ok := clazzNameRegisters2[clazzName]; ok {
+		m2, ok2 := m[layer]
+		if ok2 {
+			return m2
+		}
+		m2, ok2 = m[0]
+		if ok2 {
+			return m2
+		}
+	}
+	return 0
+}
@@ -0,0 +1,2855 @@
+/*
+ * WARNING! All changes made in this file will be lost!
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2022-present,  Teamgram Authors.
+ *  All rights reserved.
+ *
+ * Author: teagramio (teagram.io@gmail.com)
+ */
+
+// ConstructorList
+// RequestList
+
+package channel
+
+import (
+	"fmt"
+
+	"github.com/teamgram/proto/mtproto"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/types"
+)
+
+//////////////////////////////////////////////////////////////////////////////////////////
+
+var _ *types.Int32Value
+var _ *mtproto.Bool
+var _ fmt.GoStringer
+
+var clazzIdRegisters2 = map[int32]func() mtproto.TLObject{
+	// Constructor
+	-2136842669: func() mtproto.TLObject { // 0x80a25e53
+		o := MakeTLImmutableChannel(nil)
+		o.Data2.Constructor = -2136842669
+		return o
+	},
+	1922395304: func() mtproto.TLObject { // 0x72956ca8
+		o := MakeTLImmutableChannelParticipant(nil)
+		o.Data2.Constructor = 1922395304
+		return o
+	},
+	-662027966: func() mtproto.TLObject { // 0xd88a4142
+		o := MakeTLMutableChannel(nil)
+		o.Data2.Constructor = -662027966
+		return o
+	},
+	939154768: func() mtproto.TLObject { // 0x37fa5d50
+		o := MakeTLChannelParticipants(nil)
+		o.Data2.Constructor = 939154768
+		return o
+	},
+	-357108184: func() mtproto.TLObject { // 0xeab6f628
+		o := MakeTLChannelDifference(nil)
+		o.Data2.Constructor = -357108184
+		return o
+	},
+	844131560: func() mtproto.TLObject { // 0x32506ce8
+		o := MakeTLChannelDeletedMessages(nil)
+		o.Data2.Constructor = 844131560
+		return o
+	},
+
+	// Method
+	-437245607: func() mtproto.TLObject { // 0xe5f02959
+		return &TLChannelGetMutableChannel{
+			Constructor: -437245607,
+		}
+	},
+	461926155: func() mtproto.TLObject { // 0x1b886f0b
+		return &TLChannelGetChannelListByIdList{
+			Constructor: 461926155,
+		}
+	},
+	-1962023455: func() mtproto.TLObject { // 0x8b0de5e1
+		return &TLChannelGetChannelParticipantIdList{
+			Constructor: -1962023455,
+		}
+	},
+	1595647274: func() mtproto.TLObject { // 0x5f1ba52a
+		return &TLChannelCreateChannel{
+			Constructor: 1595647274,
+		}
+	},
+	-868924054: func() mtproto.TLObject { // 0xcc35456a
+		return &TLChannelJoinChannel{
+			Constructor: -868924054,
+		}
+	},
+	246600306: func() mtproto.TLObject { // 0xeb2d272
+		return &TLChannelLeaveChannel{
+			Constructor: 246600306,
+		}
+	},
+	-1728965731: func() mtproto.TLObject { // 0x98f2139d
+		return &TLChannelInviteToChannel{
+			Constructor: -1728965731,
+		}
+	},
+	-937752617: func() mtproto.TLObject { // 0xc81b07d7
+		return &TLChannelEditAdmin{
+			Constructor: -937752617,
+		}
+	},
+	1819343561: func() mtproto.TLObject { // 0x6c70fac9
+		return &TLChannelEditBanned{
+			Constructor: 1819343561,
+		}
+	},
+	-666199065: func() mtproto.TLObject { // 0xd84a9be7
+		return &TLChannelGetChannelParticipants{
+			Constructor: -666199065,
+		}
+	},
+	-1261001836: func() mtproto.TLObject { // 0xb4d6a394
+		return &TLChannelSendMessage{
+			Constructor: -1261001836,
+		}
+	},
+	-2100477061: func() mtproto.TLObject { // 0x82cd437b
+		return &TLChannelGetHistory{
+			Constructor: -2100477061,
+		}
+	},
+	537158293: func() mtproto.TLObject { // 0x20046295
+		return &TLChannelGetMessages{
+			Constructor: 537158293,
+		}
+	},
+	-1344822315: func() mtproto.TLObject { // 0xafd7a3d5
+		return &TLChannelReadHistory{
+			Constructor: -1344822315,
+		}
+	},
+	1237596961: func() mtproto.TLObject { // 0x49c43b21
+		return &TLChannelGetChannelDifference{
+			Constructor: 1237596961,
+		}
+	},
+	558691925: func() mtproto.TLObject { // 0x214cf655
+		return &TLChannelEditTitle{
+			Constructor: 558691925,
+		}
+	},
+	111840841: func() mtproto.TLObject { // 0x6aa8e49
+		return &TLChannelEditPhoto{
+			Constructor: 111840841,
+		}
+	},
+	1029421910: func() mtproto.TLObject { // 0x3d5bbb56
+		return &TLChannelToggleSignatures{
+			Constructor: 1029421910,
+		}
+	},
+	-1024282738: func() mtproto.TLObject { // 0xc2f2af8e
+		return &TLChannelTogglePreHistoryHidden{
+			Constructor: -1024282738,
+		}
+	},
+	-405948939: func() mtproto.TLObject { // 0xe7cdb5f5
+		return &TLChannelToggleSlowMode{
+			Constructor: -405948939,
+		}
+	},
+	117753992: func() mtproto.TLObject { // 0x704c888
+		return &TLChannelDeleteChannel{
+			Constructor: 117753992,
+		}
+	},
+	1766934587: func() mtproto.TLObject { // 0x6951483b
+		return &TLChannelDeleteMessages{
+			Constructor: 1766934587,
+		}
+	},
+	1496960477: func() mtproto.TLObject { // 0x5939cddd
+		return &TLChannelDeleteParticipantHistory{
+			Constructor: 1496960477,
+		}
+	},
+	-172047269: func() mtproto.TLObject { // 0xf5bec45b
+		return &TLChannelDeleteHistory{
+			Constructor: -172047269,
+		}
+	},
+}
+
+func NewTLObjectByClassID(classId int32) mtproto.TLObject {
+	f, ok := clazzIdRegisters2[classId]
+	if !ok {
+		return nil
+	}
+	return f()
+}
+
+func CheckClassID(classId int32) (ok bool) {
+	_, ok = clazzIdRegisters2[classId]
+	return
+}
+
+//----------------------------------------------------------------------------------------------------------------
+
+///////////////////////////////////////////////////////////////////////////////
+// ImmutableChannel <--
+//  + TL_ImmutableChannel
+//
+
+func (m *ImmutableChannel) Encode(layer int32) []byte {
+	predicateName := m.PredicateName
+	if predicateName == "" {
+		if n, ok := clazzIdNameRegisters2[int32(m.Constructor)]; ok {
+			predicateName = n
+		}
+	}
+
+	var (
+		xBuf []byte
+	)
+
+	switch predicateName {
+	case Predicate_immutableChannel:
+		t := m.To_ImmutableChannel()
+		xBuf = t.Encode(layer)
+
+	default:
+		// logx.Errorf("invalid predicate error: %s",  m.PredicateName)
+		return []byte{}
+	}
+
+	return xBuf
+}
+
+func (m *ImmutableChannel) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *ImmutableChannel) Decode(dBuf *mtproto.DecodeBuf) error {
+	m.Constructor = TLConstructor(dBuf.Int())
+	switch uint32(m.Constructor) {
+	case 0x80a25e53:
+		m2 := MakeTLImmutableChannel(m)
+		m2.Decode(dBuf)
+
+	default:
+		return fmt.Errorf("invalid constructorId: 0x%x", uint32(m.Constructor))
+	}
+	return dBuf.GetError()
+}
+
+func (m *ImmutableChannel) DebugString() string {
+	switch m.PredicateName {
+	case Predicate_immutableChannel:
+		t := m.To_ImmutableChannel()
+		return t.DebugString()
+
+	default:
+		return "{}"
+	}
+}
+
+// To_ImmutableChannel
+// immutableChannel flags:# id:long access_hash:long creator:long title:string about:string photo:Photo broadcast:flags.0?true megagroup:flags.1?true signatures:flags.2?true noforwards:flags.3?true deactivated:flags.4?true hidden_prehistory:flags.5?true username:string participants_count:int default_banned_rights:ChatBannedRights slowmode_seconds:int pts:int top_message:int date:long version:int = ImmutableChannel;
+func (m *ImmutableChannel) To_ImmutableChannel() *TLImmutableChannel {
+	m.PredicateName = Predicate_immutableChannel
+	return &TLImmutableChannel{
+		Data2: m,
+	}
+}
+
+// MakeTLImmutableChannel
+// immutableChannel flags:# id:long access_hash:long creator:long title:string about:string photo:Photo broadcast:flags.0?true megagroup:flags.1?true signatures:flags.2?true noforwards:flags.3?true deactivated:flags.4?true hidden_prehistory:flags.5?true username:string participants_count:int default_banned_rights:ChatBannedRights slowmode_seconds:int pts:int top_message:int date:long version:int = ImmutableChannel;
+func MakeTLImmutableChannel(data2 *ImmutableChannel) *TLImmutableChannel {
+	if data2 == nil {
+		return &TLImmutableChannel{Data2: &ImmutableChannel{
+			PredicateName: Predicate_immutableChannel,
+		}}
+	} else {
+		data2.PredicateName = Predicate_immutableChannel
+		return &TLImmutableChannel{Data2: data2}
+	}
+}
+
+func (m *TLImmutableChannel) To_ImmutableChannel() *ImmutableChannel {
+	m.Data2.PredicateName = Predicate_immutableChannel
+	return m.Data2
+}
+
+//// flags
+func (m *TLImmutableChannel) SetId(v int64) { m.Data2.Id = v }
+func (m *TLImmutableChannel) GetId() int64  { return m.Data2.Id }
+
+func (m *TLImmutableChannel) SetAccessHash(v int64) { m.Data2.AccessHash = v }
+func (m *TLImmutableChannel) GetAccessHash() int64  { return m.Data2.AccessHash }
+
+func (m *TLImmutableChannel) SetCreator(v int64) { m.Data2.Creator = v }
+func (m *TLImmutableChannel) GetCreator() int64  { return m.Data2.Creator }
+
+func (m *TLImmutableChannel) SetTitle(v string) { m.Data2.Title = v }
+func (m *TLImmutableChannel) GetTitle() string  { return m.Data2.Title }
+
+func (m *TLImmutableChannel) SetAbout(v string) { m.Data2.About = v }
+func (m *TLImmutableChannel) GetAbout() string  { return m.Data2.About }
+
+func (m *TLImmutableChannel) SetPhoto(v *mtproto.Photo) { m.Data2.Photo = v }
+func (m *TLImmutableChannel) GetPhoto() *mtproto.Photo  { return m.Data2.Photo }
+
+func (m *TLImmutableChannel) SetBroadcast(v bool) { m.Data2.Broadcast = v }
+func (m *TLImmutableChannel) GetBroadcast() bool  { return m.Data2.Broadcast }
+
+func (m *TLImmutableChannel) SetMegagroup(v bool) { m.Data2.Megagroup = v }
+func (m *TLImmutableChannel) GetMegagroup() bool  { return m.Data2.Megagroup }
+
+func (m *TLImmutableChannel) SetSignatures(v bool) { m.Data2.Signatures = v }
+func (m *TLImmutableChannel) GetSignatures() bool  { return m.Data2.Signatures }
+
+func (m *TLImmutableChannel) SetNoforwards(v bool) { m.Data2.Noforwards = v }
+func (m *TLImmutableChannel) GetNoforwards() bool  { return m.Data2.Noforwards }
+
+func (m *TLImmutableChannel) SetDeactivated(v bool) { m.Data2.Deactivated = v }
+func (m *TLImmutableChannel) GetDeactivated() bool  { return m.Data2.Deactivated }
+
+func (m *TLImmutableChannel) SetHiddenPrehistory(v bool) { m.Data2.HiddenPrehistory = v }
+func (m *TLImmutableChannel) GetHiddenPrehistory() bool  { return m.Data2.HiddenPrehistory }
+
+func (m *TLImmutableChannel) SetUsername(v string) { m.Data2.Username = v }
+func (m *TLImmutableChannel) GetUsername() string  { return m.Data2.Username }
+
+func (m *TLImmutableChannel) SetParticipantsCount(v int32) { m.Data2.ParticipantsCount = v }
+func (m *TLImmutableChannel) GetParticipantsCount() int32  { return m.Data2.ParticipantsCount }
+
+func (m *TLImmutableChannel) SetDefaultBannedRights(v *mtproto.ChatBannedRights) {
+	m.Data2.DefaultBannedRights = v
+}
+func (m *TLImmutableChannel) GetDefaultBannedRights() *mtproto.ChatBannedRights {
+	return m.Data2.DefaultBannedRights
+}
+
+func (m *TLImmutableChannel) SetSlowmodeSeconds(v int32) { m.Data2.SlowmodeSeconds = v }
+func (m *TLImmutableChannel) GetSlowmodeSeconds() int32  { return m.Data2.SlowmodeSeconds }
+
+func (m *TLImmutableChannel) SetPts(v int32) { m.Data2.Pts = v }
+func (m *TLImmutableChannel) GetPts() int32  { return m.Data2.Pts }
+
+func (m *TLImmutableChannel) SetTopMessage(v int32) { m.Data2.TopMessage = v }
+func (m *TLImmutableChannel) GetTopMessage() int32  { return m.Data2.TopMessage }
+
+func (m *TLImmutableChannel) SetDate(v int64) { m.Data2.Date = v }
+func (m *TLImmutableChannel) GetDate() int64  { return m.Data2.Date }
+
+func (m *TLImmutableChannel) SetVersion(v int32) { m.Data2.Version = v }
+func (m *TLImmutableChannel) GetVersion() int32  { return m.Data2.Version }
+
+func (m *TLImmutableChannel) GetPredicateName() string {
+	return Predicate_immutableChannel
+}
+
+func (m *TLImmutableChannel) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+
+	var encodeF = map[uint32]func() []byte{
+		0x80a25e53: func() []byte {
+			// immutableChannel flags:# id:long access_hash:long creator:long title:string about:string photo:Photo broadcast:flags.0?true megagroup:flags.1?true signatures:flags.2?true noforwards:flags.3?true deactivated:flags.4?true hidden_prehistory:flags.5?true username:string participants_count:int default_banned_rights:ChatBannedRights slowmode_seconds:int pts:int top_message:int date:long version:int = ImmutableChannel;
+			x.UInt(0x80a25e53)
+
+			// set flags
+			var getFlags = func() uint32 {
+				var flags uint32 = 0
+
+				if m.GetBroadcast() == true {
+					flags |= 1 << 0
+				}
+				if m.GetMegagroup() == true {
+					flags |= 1 << 1
+				}
+				if m.GetSignatures() == true {
+					flags |= 1 << 2
+				}
+				if m.GetNoforwards() == true {
+					flags |= 1 << 3
+				}
+				if m.GetDeactivated() == true {
+					flags |= 1 << 4
+				}
+				if m.GetHiddenPrehistory() == true {
+					flags |= 1 << 5
+				}
+
+				return flags
+			}
+
+			// set flags
+			var flags = getFlags()
+			x.UInt(flags)
+			x.Long(m.GetId())
+			x.Long(m.GetAccessHash())
+			x.Long(m.GetCreator())
+			x.String(m.GetTitle())
+			x.String(m.GetAbout())
+			x.Bytes(m.GetPhoto().Encode(layer))
+			x.String(m.GetUsername())
+			x.Int(m.GetParticipantsCount())
+			x.Bytes(m.GetDefaultBannedRights().Encode(layer))
+			x.Int(m.GetSlowmodeSeconds())
+			x.Int(m.GetPts())
+			x.Int(m.GetTopMessage())
+			x.Long(m.GetDate())
+			x.Int(m.GetVersion())
+			return x.GetBuf()
+		},
+	}
+
+	clazzId := GetClazzID(Predicate_immutableChannel, int(layer))
+	if f, ok := encodeF[uint32(clazzId)]; ok {
+		return f()
+	} else {
+		// TODO(@benqi): handle error
+		// log.Errorf("not found clazzId by (%s, %d)", Predicate_immutableChannel, layer)
+		return x.GetBuf()
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLImmutableChannel) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLImmutableChannel) Decode(dBuf *mtproto.DecodeBuf) error {
+	var decodeF = map[uint32]func() error{
+		0x80a25e53: func() error {
+			// immutableChannel flags:# id:long access_hash:long creator:long title:string about:string photo:Photo broadcast:flags.0?true megagroup:flags.1?true signatures:flags.2?true noforwards:flags.3?true deactivated:flags.4?true hidden_prehistory:flags.5?true username:string participants_count:int default_banned_rights:ChatBannedRights slowmode_seconds:int pts:int top_message:int date:long version:int = ImmutableChannel;
+			var flags = dBuf.UInt()
+			_ = flags
+			m.SetId(dBuf.Long())
+			m.SetAccessHash(dBuf.Long())
+			m.SetCreator(dBuf.Long())
+			m.SetTitle(dBuf.String())
+			m.SetAbout(dBuf.String())
+
+			m6 := &mtproto.Photo{}
+			m6.Decode(dBuf)
+			m.SetPhoto(m6)
+
+			if (flags & (1 << 0)) != 0 {
+				m.SetBroadcast(true)
+			}
+			if (flags & (1 << 1)) != 0 {
+				m.SetMegagroup(true)
+			}
+			if (flags & (1 << 2)) != 0 {
+				m.SetSignatures(true)
+			}
+			if (flags & (1 << 3)) != 0 {
+				m.SetNoforwards(true)
+			}
+			if (flags & (1 << 4)) != 0 {
+				m.SetDeactivated(true)
+			}
+			if (flags & (1 << 5)) != 0 {
+				m.SetHiddenPrehistory(true)
+			}
+			m.SetUsername(dBuf.String())
+			m.SetParticipantsCount(dBuf.Int())
+
+			m15 := &mtproto.ChatBannedRights{}
+			m15.Decode(dBuf)
+			m.SetDefaultBannedRights(m15)
+
+			m.SetSlowmodeSeconds(dBuf.Int())
+			m.SetPts(dBuf.Int())
+			m.SetTopMessage(dBuf.Int())
+			m.SetDate(dBuf.Long())
+			m.SetVersion(dBuf.Int())
+			return dBuf.GetError()
+		},
+	}
+
+	if f, ok := decodeF[uint32(m.Data2.Constructor)]; ok {
+		return f()
+	} else {
+		return fmt.Errorf("invalid constructor: %x", uint32(m.Data2.Constructor))
+	}
+}
+
+func (m *TLImmutableChannel) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+///////////////////////////////////////////////////////////////////////////////
+// ImmutableChannelParticipant <--
+//  + TL_ImmutableChannelParticipant
+//
+
+func (m *ImmutableChannelParticipant) Encode(layer int32) []byte {
+	predicateName := m.PredicateName
+	if predicateName == "" {
+		if n, ok := clazzIdNameRegisters2[int32(m.Constructor)]; ok {
+			predicateName = n
+		}
+	}
+
+	var (
+		xBuf []byte
+	)
+
+	switch predicateName {
+	case Predicate_immutableChannelParticipant:
+		t := m.To_ImmutableChannelParticipant()
+		xBuf = t.Encode(layer)
+
+	default:
+		// logx.Errorf("invalid predicate error: %s",  m.PredicateName)
+		return []byte{}
+	}
+
+	return xBuf
+}
+
+func (m *ImmutableChannelParticipant) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *ImmutableChannelParticipant) Decode(dBuf *mtproto.DecodeBuf) error {
+	m.Constructor = TLConstructor(dBuf.Int())
+	switch uint32(m.Constructor) {
+	case 0x72956ca8:
+		m2 := MakeTLImmutableChannelParticipant(m)
+		m2.Decode(dBuf)
+
+	default:
+		return fmt.Errorf("invalid constructorId: 0x%x", uint32(m.Constructor))
+	}
+	return dBuf.GetError()
+}
+
+func (m *ImmutableChannelParticipant) DebugString() string {
+	switch m.PredicateName {
+	case Predicate_immutableChannelParticipant:
+		t := m.To_ImmutableChannelParticipant()
+		return t.DebugString()
+
+	default:
+		return "{}"
+	}
+}
+
+// To_ImmutableChannelParticipant
+// immutableChannelParticipant id:long channel_id:long user_id:long participant_type:int state:int inviter_user_id:long admin_rights:ChatAdminRights banned_rights:ChatBannedRights rank:string promoted_by:long kicked_by:long read_inbox_max_id:int available_min_id:int date:long = ImmutableChannelParticipant;
+func (m *ImmutableChannelParticipant) To_ImmutableChannelParticipant() *TLImmutableChannelParticipant {
+	m.PredicateName = Predicate_immutableChannelParticipant
+	return &TLImmutableChannelParticipant{
+		Data2: m,
+	}
+}
+
+// MakeTLImmutableChannelParticipant
+// immutableChannelParticipant id:long channel_id:long user_id:long participant_type:int state:int inviter_user_id:long admin_rights:ChatAdminRights banned_rights:ChatBannedRights rank:string promoted_by:long kicked_by:long read_inbox_max_id:int available_min_id:int date:long = ImmutableChannelParticipant;
+func MakeTLImmutableChannelParticipant(data2 *ImmutableChannelParticipant) *TLImmutableChannelParticipant {
+	if data2 == nil {
+		return &TLImmutableChannelParticipant{Data2: &ImmutableChannelParticipant{
+			PredicateName: Predicate_immutableChannelParticipant,
+		}}
+	} else {
+		data2.PredicateName = Predicate_immutableChannelParticipant
+		return &TLImmutableChannelParticipant{Data2: data2}
+	}
+}
+
+func (m *TLImmutableChannelParticipant) To_ImmutableChannelParticipant() *ImmutableChannelParticipant {
+	m.Data2.PredicateName = Predicate_immutableChannelParticipant
+	return m.Data2
+}
+
+func (m *TLImmutableChannelParticipant) SetId(v int64) { m.Data2.Id = v }
+func (m *TLImmutableChannelParticipant) GetId() int64  { return m.Data2.Id }
+
+func (m *TLImmutableChannelParticipant) SetChannelId(v int64) { m.Data2.ChannelId = v }
+func (m *TLImmutableChannelParticipant) GetChannelId() int64  { return m.Data2.ChannelId }
+
+func (m *TLImmutableChannelParticipant) SetUserId(v int64) { m.Data2.UserId = v }
+func (m *TLImmutableChannelParticipant) GetUserId() int64  { return m.Data2.UserId }
+
+func (m *TLImmutableChannelParticipant) SetParticipantType(v int32) { m.Data2.ParticipantType = v }
+func (m *TLImmutableChannelParticipant) GetParticipantType() int32  { return m.Data2.ParticipantType }
+
+func (m *TLImmutableChannelParticipant) SetState(v int32) { m.Data2.State = v }
+func (m *TLImmutableChannelParticipant) GetState() int32  { return m.Data2.State }
+
+func (m *TLImmutableChannelParticipant) SetInviterUserId(v int64) { m.Data2.InviterUserId = v }
+func (m *TLImmutableChannelParticipant) GetInviterUserId() int64  { return m.Data2.InviterUserId }
+
+func (m *TLImmutableChannelParticipant) SetAdminRights(v *mtproto.ChatAdminRights) {
+	m.Data2.AdminRights = v
+}
+func (m *TLImmutableChannelParticipant) GetAdminRights() *mtproto.ChatAdminRights {
+	return m.Data2.AdminRights
+}
+
+func (m *TLImmutableChannelParticipant) SetBannedRights(v *mtproto.ChatBannedRights) {
+	m.Data2.BannedRights = v
+}
+func (m *TLImmutableChannelParticipant) GetBannedRights() *mtproto.ChatBannedRights {
+	return m.Data2.BannedRights
+}
+
+func (m *TLImmutableChannelParticipant) SetRank(v string) { m.Data2.Rank = v }
+func (m *TLImmutableChannelParticipant) GetRank() string  { return m.Data2.Rank }
+
+func (m *TLImmutableChannelParticipant) SetPromotedBy(v int64) { m.Data2.PromotedBy = v }
+func (m *TLImmutableChannelParticipant) GetPromotedBy() int64  { return m.Data2.PromotedBy }
+
+func (m *TLImmutableChannelParticipant) SetKickedBy(v int64) { m.Data2.KickedBy = v }
+func (m *TLImmutableChannelParticipant) GetKickedBy() int64  { return m.Data2.KickedBy }
+
+func (m *TLImmutableChannelParticipant) SetReadInboxMaxId(v int32) { m.Data2.ReadInboxMaxId = v }
+func (m *TLImmutableChannelParticipant) GetReadInboxMaxId() int32  { return m.Data2.ReadInboxMaxId }
+
+func (m *TLImmutableChannelParticipant) SetAvailableMinId(v int32) { m.Data2.AvailableMinId = v }
+func (m *TLImmutableChannelParticipant) GetAvailableMinId() int32  { return m.Data2.AvailableMinId }
+
+func (m *TLImmutableChannelParticipant) SetDate(v int64) { m.Data2.Date = v }
+func (m *TLImmutableChannelParticipant) GetDate() int64  { return m.Data2.Date }
+
+func (m *TLImmutableChannelParticipant) GetPredicateName() string {
+	return Predicate_immutableChannelParticipant
+}
+
+func (m *TLImmutableChannelParticipant) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+
+	var encodeF = map[uint32]func() []byte{
+		0x72956ca8: func() []byte {
+			// immutableChannelParticipant id:long channel_id:long user_id:long participant_type:int state:int inviter_user_id:long admin_rights:ChatAdminRights banned_rights:ChatBannedRights rank:string promoted_by:long kicked_by:long read_inbox_max_id:int available_min_id:int date:long = ImmutableChannelParticipant;
+			x.UInt(0x72956ca8)
+
+			x.Long(m.GetId())
+			x.Long(m.GetChannelId())
+			x.Long(m.GetUserId())
+			x.Int(m.GetParticipantType())
+			x.Int(m.GetState())
+			x.Long(m.GetInviterUserId())
+			x.Bytes(m.GetAdminRights().Encode(layer))
+			x.Bytes(m.GetBannedRights().Encode(layer))
+			x.String(m.GetRank())
+			x.Long(m.GetPromotedBy())
+			x.Long(m.GetKickedBy())
+			x.Int(m.GetReadInboxMaxId())
+			x.Int(m.GetAvailableMinId())
+			x.Long(m.GetDate())
+			return x.GetBuf()
+		},
+	}
+
+	clazzId := GetClazzID(Predicate_immutableChannelParticipant, int(layer))
+	if f, ok := encodeF[uint32(clazzId)]; ok {
+		return f()
+	} else {
+		// TODO(@benqi): handle error
+		// log.Errorf("not found clazzId by (%s, %d)", Predicate_immutableChannelParticipant, layer)
+		return x.GetBuf()
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLImmutableChannelParticipant) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLImmutableChannelParticipant) Decode(dBuf *mtproto.DecodeBuf) error {
+	var decodeF = map[uint32]func() error{
+		0x72956ca8: func() error {
+			// immutableChannelParticipant id:long channel_id:long user_id:long participant_type:int state:int inviter_user_id:long admin_rights:ChatAdminRights banned_rights:ChatBannedRights rank:string promoted_by:long kicked_by:long read_inbox_max_id:int available_min_id:int date:long = ImmutableChannelParticipant;
+			m.SetId(dBuf.Long())
+			m.SetChannelId(dBuf.Long())
+			m.SetUserId(dBuf.Long())
+			m.SetParticipantType(dBuf.Int())
+			m.SetState(dBuf.Int())
+			m.SetInviterUserId(dBuf.Long())
+
+			m6 := &mtproto.ChatAdminRights{}
+			m6.Decode(dBuf)
+			m.SetAdminRights(m6)
+
+			m7 := &mtproto.ChatBannedRights{}
+			m7.Decode(dBuf)
+			m.SetBannedRights(m7)
+
+			m.SetRank(dBuf.String())
+			m.SetPromotedBy(dBuf.Long())
+			m.SetKickedBy(dBuf.Long())
+			m.SetReadInboxMaxId(dBuf.Int())
+			m.SetAvailableMinId(dBuf.Int())
+			m.SetDate(dBuf.Long())
+			return dBuf.GetError()
+		},
+	}
+
+	if f, ok := decodeF[uint32(m.Data2.Constructor)]; ok {
+		return f()
+	} else {
+		return fmt.Errorf("invalid constructor: %x", uint32(m.Data2.Constructor))
+	}
+}
+
+func (m *TLImmutableChannelParticipant) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+///////////////////////////////////////////////////////////////////////////////
+// MutableChannel <--
+//  + TL_MutableChannel
+//
+
+func (m *MutableChannel) Encode(layer int32) []byte {
+	predicateName := m.PredicateName
+	if predicateName == "" {
+		if n, ok := clazzIdNameRegisters2[int32(m.Constructor)]; ok {
+			predicateName = n
+		}
+	}
+
+	var (
+		xBuf []byte
+	)
+
+	switch predicateName {
+	case Predicate_mutableChannel:
+		t := m.To_MutableChannel()
+		xBuf = t.Encode(layer)
+
+	default:
+		// logx.Errorf("invalid predicate error: %s",  m.PredicateName)
+		return []byte{}
+	}
+
+	return xBuf
+}
+
+func (m *MutableChannel) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *MutableChannel) Decode(dBuf *mtproto.DecodeBuf) error {
+	m.Constructor = TLConstructor(dBuf.Int())
+	switch uint32(m.Constructor) {
+	case 0xd88a4142:
+		m2 := MakeTLMutableChannel(m)
+		m2.Decode(dBuf)
+
+	default:
+		return fmt.Errorf("invalid constructorId: 0x%x", uint32(m.Constructor))
+	}
+	return dBuf.GetError()
+}
+
+func (m *MutableChannel) DebugString() string {
+	switch m.PredicateName {
+	case Predicate_mutableChannel:
+		t := m.To_MutableChannel()
+		return t.DebugString()
+
+	default:
+		return "{}"
+	}
+}
+
+// To_MutableChannel
+// mutableChannel channel:ImmutableChannel participants:Vector<ImmutableChannelParticipant> = MutableChannel;
+func (m *MutableChannel) To_MutableChannel() *TLMutableChannel {
+	m.PredicateName = Predicate_mutableChannel
+	return &TLMutableChannel{
+		Data2: m,
+	}
+}
+
+// MakeTLMutableChannel
+// mutableChannel channel:ImmutableChannel participants:Vector<ImmutableChannelParticipant> = MutableChannel;
+func MakeTLMutableChannel(data2 *MutableChannel) *TLMutableChannel {
+	if data2 == nil {
+		return &TLMutableChannel{Data2: &MutableChannel{
+			PredicateName: Predicate_mutableChannel,
+		}}
+	} else {
+		data2.PredicateName = Predicate_mutableChannel
+		return &TLMutableChannel{Data2: data2}
+	}
+}
+
+func (m *TLMutableChannel) To_MutableChannel() *MutableChannel {
+	m.Data2.PredicateName = Predicate_mutableChannel
+	return m.Data2
+}
+
+func (m *TLMutableChannel) SetChannel(v *ImmutableChannel) { m.Data2.Channel = v }
+func (m *TLMutableChannel) GetChannel() *ImmutableChannel  { return m.Data2.Channel }
+
+func (m *TLMutableChannel) SetParticipants(v []*ImmutableChannelParticipant) {
+	m.Data2.Participants = v
+}
+func (m *TLMutableChannel) GetParticipants() []*ImmutableChannelParticipant {
+	return m.Data2.Participants
+}
+
+func (m *TLMutableChannel) GetPredicateName() string {
+	return Predicate_mutableChannel
+}
+
+func (m *TLMutableChannel) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+
+	var encodeF = map[uint32]func() []byte{
+		0xd88a4142: func() []byte {
+			// mutableChannel channel:ImmutableChannel participants:Vector<ImmutableChannelParticipant> = MutableChannel;
+			x.UInt(0xd88a4142)
+
+			x.Bytes(m.GetChannel().Encode(layer))
+
+			x.Int(int32(mtproto.CRC32_vector))
+			x.Int(int32(len(m.GetParticipants())))
+			for _, v := range m.GetParticipants() {
+				x.Bytes((*v).Encode(layer))
+			}
+
+			return x.GetBuf()
+		},
+	}
+
+	clazzId := GetClazzID(Predicate_mutableChannel, int(layer))
+	if f, ok := encodeF[uint32(clazzId)]; ok {
+		return f()
+	} else {
+		// TODO(@benqi): handle error
+		// log.Errorf("not found clazzId by (%s, %d)", Predicate_mutableChannel, layer)
+		return x.GetBuf()
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLMutableChannel) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLMutableChannel) Decode(dBuf *mtproto.DecodeBuf) error {
+	var decodeF = map[uint32]func() error{
+		0xd88a4142: func() error {
+			// mutableChannel channel:ImmutableChannel participants:Vector<ImmutableChannelParticipant> = MutableChannel;
+
+			m0 := &ImmutableChannel{}
+			m0.Decode(dBuf)
+			m.SetChannel(m0)
+
+			c1 := dBuf.Int()
+			if c1 != int32(mtproto.CRC32_vector) {
+				// dBuf.err = fmt.Errorf("invalid mtproto.CRC32_vector, c%d: %d", 1, c1)
+				return fmt.Errorf("invalid mtproto.CRC32_vector, c%d: %d", 1, c1)
+			}
+			l1 := dBuf.Int()
+			v1 := make([]*ImmutableChannelParticipant, l1)
+			for i := int32(0); i < l1; i++ {
+				v1[i] = &ImmutableChannelParticipant{}
+				v1[i].Decode(dBuf)
+			}
+			m.SetParticipants(v1)
+
+			return dBuf.GetError()
+		},
+	}
+
+	if f, ok := decodeF[uint32(m.Data2.Constructor)]; ok {
+		return f()
+	} else {
+		return fmt.Errorf("invalid constructor: %x", uint32(m.Data2.Constructor))
+	}
+}
+
+func (m *TLMutableChannel) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+///////////////////////////////////////////////////////////////////////////////
+// ChannelParticipants <--
+//  + TL_ChannelParticipants
+//
+
+func (m *ChannelParticipants) Encode(layer int32) []byte {
+	predicateName := m.PredicateName
+	if predicateName == "" {
+		if n, ok := clazzIdNameRegisters2[int32(m.Constructor)]; ok {
+			predicateName = n
+		}
+	}
+
+	var (
+		xBuf []byte
+	)
+
+	switch predicateName {
+	case Predicate_channelParticipants:
+		t := m.To_ChannelParticipants()
+		xBuf = t.Encode(layer)
+
+	default:
+		// logx.Errorf("invalid predicate error: %s",  m.PredicateName)
+		return []byte{}
+	}
+
+	return xBuf
+}
+
+func (m *ChannelParticipants) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *ChannelParticipants) Decode(dBuf *mtproto.DecodeBuf) error {
+	m.Constructor = TLConstructor(dBuf.Int())
+	switch uint32(m.Constructor) {
+	case 0x37fa5d50:
+		m2 := MakeTLChannelParticipants(m)
+		m2.Decode(dBuf)
+
+	default:
+		return fmt.Errorf("invalid constructorId: 0x%x", uint32(m.Constructor))
+	}
+	return dBuf.GetError()
+}
+
+func (m *ChannelParticipants) DebugString() string {
+	switch m.PredicateName {
+	case Predicate_channelParticipants:
+		t := m.To_ChannelParticipants()
+		return t.DebugString()
+
+	default:
+		return "{}"
+	}
+}
+
+// To_ChannelParticipants
+// channelParticipants count:int participants:Vector<ImmutableChannelParticipant> = ChannelParticipants;
+func (m *ChannelParticipants) To_ChannelParticipants() *TLChannelParticipants {
+	m.PredicateName = Predicate_channelParticipants
+	return &TLChannelParticipants{
+		Data2: m,
+	}
+}
+
+// MakeTLChannelParticipants
+// channelParticipants count:int participants:Vector<ImmutableChannelParticipant> = ChannelParticipants;
+func MakeTLChannelParticipants(data2 *ChannelParticipants) *TLChannelParticipants {
+	if data2 == nil {
+		return &TLChannelParticipants{Data2: &ChannelParticipants{
+			PredicateName: Predicate_channelParticipants,
+		}}
+	} else {
+		data2.PredicateName = Predicate_channelParticipants
+		return &TLChannelParticipants{Data2: data2}
+	}
+}
+
+func (m *TLChannelParticipants) To_ChannelParticipants() *ChannelParticipants {
+	m.Data2.PredicateName = Predicate_channelParticipants
+	return m.Data2
+}
+
+func (m *TLChannelParticipants) SetCount(v int32) { m.Data2.Count = v }
+func (m *TLChannelParticipants) GetCount() int32  { return m.Data2.Count }
+
+func (m *TLChannelParticipants) SetParticipants(v []*ImmutableChannelParticipant) {
+	m.Data2.Participants = v
+}
+func (m *TLChannelParticipants) GetParticipants() []*ImmutableChannelParticipant {
+	return m.Data2.Participants
+}
+
+func (m *TLChannelParticipants) GetPredicateName() string {
+	return Predicate_channelParticipants
+}
+
+func (m *TLChannelParticipants) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+
+	var encodeF = map[uint32]func() []byte{
+		0x37fa5d50: func() []byte {
+			// channelParticipants count:int participants:Vector<ImmutableChannelParticipant> = ChannelParticipants;
+			x.UInt(0x37fa5d50)
+
+			x.Int(m.GetCount())
+
+			x.Int(int32(mtproto.CRC32_vector))
+			x.Int(int32(len(m.GetParticipants())))
+			for _, v := range m.GetParticipants() {
+				x.Bytes((*v).Encode(layer))
+			}
+
+			return x.GetBuf()
+		},
+	}
+
+	clazzId := GetClazzID(Predicate_channelParticipants, int(layer))
+	if f, ok := encodeF[uint32(clazzId)]; ok {
+		return f()
+	} else {
+		// TODO(@benqi): handle error
+		// log.Errorf("not found clazzId by (%s, %d)", Predicate_channelParticipants, layer)
+		return x.GetBuf()
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLChannelParticipants) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLChannelParticipants) Decode(dBuf *mtproto.DecodeBuf) error {
+	var decodeF = map[uint32]func() error{
+		0x37fa5d50: func() error {
+			// channelParticipants count:int participants:Vector<ImmutableChannelParticipant> = ChannelParticipants;
+			m.SetCount(dBuf.Int())
+
+			c1 := dBuf.Int()
+			if c1 != int32(mtproto.CRC32_vector) {
+				// dBuf.err = fmt.Errorf("invalid mtproto.CRC32_vector, c%d: %d", 1, c1)
+				return fmt.Errorf("invalid mtproto.CRC32_vector, c%d: %d", 1, c1)
+			}
+			l1 := dBuf.Int()
+			v1 := make([]*ImmutableChannelParticipant, l1)
+			for i := int32(0); i < l1; i++ {
+				v1[i] = &ImmutableChannelParticipant{}
+				v1[i].Decode(dBuf)
+			}
+			m.SetParticipants(v1)
+
+			return dBuf.GetError()
+		},
+	}
+
+	if f, ok := decodeF[uint32(m.Data2.Constructor)]; ok {
+		return f()
+	} else {
+		return fmt.Errorf("invalid constructor: %x", uint32(m.Data2.Constructor))
+	}
+}
+
+func (m *TLChannelParticipants) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+///////////////////////////////////////////////////////////////////////////////
+// ChannelDifference <--
+//  + TL_ChannelDifference
+//
+
+func (m *ChannelDifference) Encode(layer int32) []byte {
+	predicateName := m.PredicateName
+	if predicateName == "" {
+		if n, ok := clazzIdNameRegisters2[int32(m.Constructor)]; ok {
+			predicateName = n
+		}
+	}
+
+	var (
+		xBuf []byte
+	)
+
+	switch predicateName {
+	case Predicate_channelDifference:
+		t := m.To_ChannelDifference()
+		xBuf = t.Encode(layer)
+
+	default:
+		// logx.Errorf("invalid predicate error: %s",  m.PredicateName)
+		return []byte{}
+	}
+
+	return xBuf
+}
+
+func (m *ChannelDifference) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *ChannelDifference) Decode(dBuf *mtproto.DecodeBuf) error {
+	m.Constructor = TLConstructor(dBuf.Int())
+	switch uint32(m.Constructor) {
+	case 0xeab6f628:
+		m2 := MakeTLChannelDifference(m)
+		m2.Decode(dBuf)
+
+	default:
+		return fmt.Errorf("invalid constructorId: 0x%x", uint32(m.Constructor))
+	}
+	return dBuf.GetError()
+}
+
+func (m *ChannelDifference) DebugString() string {
+	switch m.PredicateName {
+	case Predicate_channelDifference:
+		t := m.To_ChannelDifference()
+		return t.DebugString()
+
+	default:
+		return "{}"
+	}
+}
+
+// To_ChannelDifference
+// channelDifference flags:# final:flags.0?true pts:int new_messages:Vector<MessageBox> = ChannelDifference;
+func (m *ChannelDifference) To_ChannelDifference() *TLChannelDifference {
+	m.PredicateName = Predicate_channelDifference
+	return &TLChannelDifference{
+		Data2: m,
+	}
+}
+
+// MakeTLChannelDifference
+// channelDifference flags:# final:flags.0?true pts:int new_messages:Vector<MessageBox> = ChannelDifference;
+func MakeTLChannelDifference(data2 *ChannelDifference) *TLChannelDifference {
+	if data2 == nil {
+		return &TLChannelDifference{Data2: &ChannelDifference{
+			PredicateName: Predicate_channelDifference,
+		}}
+	} else {
+		data2.PredicateName = Predicate_channelDifference
+		return &TLChannelDifference{Data2: data2}
+	}
+}
+
+func (m *TLChannelDifference) To_ChannelDifference() *ChannelDifference {
+	m.Data2.PredicateName = Predicate_channelDifference
+	return m.Data2
+}
+
+//// flags
+func (m *TLChannelDifference) SetFinal(v bool) { m.Data2.Final = v }
+func (m *TLChannelDifference) GetFinal() bool  { return m.Data2.Final }
+
+func (m *TLChannelDifference) SetPts(v int32) { m.Data2.Pts = v }
+func (m *TLChannelDifference) GetPts() int32  { return m.Data2.Pts }
+
+func (m *TLChannelDifference) SetNewMessages(v []*mtproto.MessageBox) { m.Data2.NewMessages = v }
+func (m *TLChannelDifference) GetNewMessages() []*mtproto.MessageBox  { return m.Data2.NewMessages }
+
+func (m *TLChannelDifference) GetPredicateName() string {
+	return Predicate_channelDifference
+}
+
+func (m *TLChannelDifference) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+
+	var encodeF = map[uint32]func() []byte{
+		0xeab6f628: func() []byte {
+			// channelDifference flags:# final:flags.0?true pts:int new_messages:Vector<MessageBox> = ChannelDifference;
+			x.UInt(0xeab6f628)
+
+			// set flags
+			var getFlags = func() uint32 {
+				var flags uint32 = 0
+
+				if m.GetFinal() == true {
+					flags |= 1 << 0
+				}
+
+				return flags
+			}
+
+			// set flags
+			var flags = getFlags()
+			x.UInt(flags)
+			x.Int(m.GetPts())
+
+			x.Int(int32(mtproto.CRC32_vector))
+			x.Int(int32(len(m.GetNewMessages())))
+			for _, v := range m.GetNewMessages() {
+				x.Bytes((*v).Encode(layer))
+			}
+
+			return x.GetBuf()
+		},
+	}
+
+	clazzId := GetClazzID(Predicate_channelDifference, int(layer))
+	if f, ok := encodeF[uint32(clazzId)]; ok {
+		return f()
+	} else {
+		// TODO(@benqi): handle error
+		// log.Errorf("not found clazzId by (%s, %d)", Predicate_channelDifference, layer)
+		return x.GetBuf()
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLChannelDifference) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLChannelDifference) Decode(dBuf *mtproto.DecodeBuf) error {
+	var decodeF = map[uint32]func() error{
+		0xeab6f628: func() error {
+			// channelDifference flags:# final:flags.0?true pts:int new_messages:Vector<MessageBox> = ChannelDifference;
+			var flags = dBuf.UInt()
+			_ = flags
+			if (flags & (1 << 0)) != 0 {
+				m.SetFinal(true)
+			}
+			m.SetPts(dBuf.Int())
+
+			c3 := dBuf.Int()
+			if c3 != int32(mtproto.CRC32_vector) {
+				// dBuf.err = fmt.Errorf("invalid mtproto.CRC32_vector, c%d: %d", 3, c3)
+				return fmt.Errorf("invalid mtproto.CRC32_vector, c%d: %d", 3, c3)
+			}
+			l3 := dBuf.Int()
+			v3 := make([]*mtproto.MessageBox, l3)
+			for i := int32(0); i < l3; i++ {
+				v3[i] = &mtproto.MessageBox{}
+				v3[i].Decode(dBuf)
+			}
+			m.SetNewMessages(v3)
+
+			return dBuf.GetError()
+		},
+	}
+
+	if f, ok := decodeF[uint32(m.Data2.Constructor)]; ok {
+		return f()
+	} else {
+		return fmt.Errorf("invalid constructor: %x", uint32(m.Data2.Constructor))
+	}
+}
+
+func (m *TLChannelDifference) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+///////////////////////////////////////////////////////////////////////////////
+// ChannelDeletedMessages <--
+//  + TL_ChannelDeletedMessages
+//
+
+func (m *ChannelDeletedMessages) Encode(layer int32) []byte {
+	predicateName := m.PredicateName
+	if predicateName == "" {
+		if n, ok := clazzIdNameRegisters2[int32(m.Constructor)]; ok {
+			predicateName = n
+		}
+	}
+
+	var (
+		xBuf []byte
+	)
+
+	switch predicateName {
+	case Predicate_channelDeletedMessages:
+		t := m.To_ChannelDeletedMessages()
+		xBuf = t.Encode(layer)
+
+	default:
+		// logx.Errorf("invalid predicate error: %s",  m.PredicateName)
+		return []byte{}
+	}
+
+	return xBuf
+}
+
+func (m *ChannelDeletedMessages) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *ChannelDeletedMessages) Decode(dBuf *mtproto.DecodeBuf) error {
+	m.Constructor = TLConstructor(dBuf.Int())
+	switch uint32(m.Constructor) {
+	case 0x32506ce8:
+		m2 := MakeTLChannelDeletedMessages(m)
+		m2.Decode(dBuf)
+
+	default:
+		return fmt.Errorf("invalid constructorId: 0x%x", uint32(m.Constructor))
+	}
+	return dBuf.GetError()
+}
+
+func (m *ChannelDeletedMessages) DebugString() string {
+	switch m.PredicateName {
+	case Predicate_channelDeletedMessages:
+		t := m.To_ChannelDeletedMessages()
+		return t.DebugString()
+
+	default:
+		return "{}"
+	}
+}
+
+// To_ChannelDeletedMessages
+// channelDeletedMessages pts:int pts_count:int id:Vector<int> = ChannelDeletedMessages;
+func (m *ChannelDeletedMessages) To_ChannelDeletedMessages() *TLChannelDeletedMessages {
+	m.PredicateName = Predicate_channelDeletedMessages
+	return &TLChannelDeletedMessages{
+		Data2: m,
+	}
+}
+
+// MakeTLChannelDeletedMessages
+// channelDeletedMessages pts:int pts_count:int id:Vector<int> = ChannelDeletedMessages;
+func MakeTLChannelDeletedMessages(data2 *ChannelDeletedMessages) *TLChannelDeletedMessages {
+	if data2 == nil {
+		return &TLChannelDeletedMessages{Data2: &ChannelDeletedMessages{
+			PredicateName: Predicate_channelDeletedMessages,
+		}}
+	} else {
+		data2.PredicateName = Predicate_channelDeletedMessages
+		return &TLChannelDeletedMessages{Data2: data2}
+	}
+}
+
+func (m *TLChannelDeletedMessages) To_ChannelDeletedMessages() *ChannelDeletedMessages {
+	m.Data2.PredicateName = Predicate_channelDeletedMessages
+	return m.Data2
+}
+
+func (m *TLChannelDeletedMessages) SetPts(v int32) { m.Data2.Pts = v }
+func (m *TLChannelDeletedMessages) GetPts() int32  { return m.Data2.Pts }
+
+func (m *TLChannelDeletedMessages) SetPtsCount(v int32) { m.Data2.PtsCount = v }
+func (m *TLChannelDeletedMessages) GetPtsCount() int32  { return m.Data2.PtsCount }
+
+func (m *TLChannelDeletedMessages) SetId(v []int32) { m.Data2.Id = v }
+func (m *TLChannelDeletedMessages) GetId() []int32  { return m.Data2.Id }
+
+func (m *TLChannelDeletedMessages) GetPredicateName() string {
+	return Predicate_channelDeletedMessages
+}
+
+func (m *TLChannelDeletedMessages) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+
+	var encodeF = map[uint32]func() []byte{
+		0x32506ce8: func() []byte {
+			// channelDeletedMessages pts:int pts_count:int id:Vector<int> = ChannelDeletedMessages;
+			x.UInt(0x32506ce8)
+
+			x.Int(m.GetPts())
+			x.Int(m.GetPtsCount())
+
+			x.VectorInt(m.GetId())
+
+			return x.GetBuf()
+		},
+	}
+
+	clazzId := GetClazzID(Predicate_channelDeletedMessages, int(layer))
+	if f, ok := encodeF[uint32(clazzId)]; ok {
+		return f()
+	} else {
+		// TODO(@benqi): handle error
+		// log.Errorf("not found clazzId by (%s, %d)", Predicate_channelDeletedMessages, layer)
+		return x.GetBuf()
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLChannelDeletedMessages) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLChannelDeletedMessages) Decode(dBuf *mtproto.DecodeBuf) error {
+	var decodeF = map[uint32]func() error{
+		0x32506ce8: func() error {
+			// channelDeletedMessages pts:int pts_count:int id:Vector<int> = ChannelDeletedMessages;
+			m.SetPts(dBuf.Int())
+			m.SetPtsCount(dBuf.Int())
+
+			m.SetId(dBuf.VectorInt())
+
+			return dBuf.GetError()
+		},
+	}
+
+	if f, ok := decodeF[uint32(m.Data2.Constructor)]; ok {
+		return f()
+	} else {
+		return fmt.Errorf("invalid constructor: %x", uint32(m.Data2.Constructor))
+	}
+}
+
+func (m *TLChannelDeletedMessages) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+//----------------------------------------------------------------------------------------------------------------
+// TLChannelGetMutableChannel
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLChannelGetMutableChannel) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_channel_getMutableChannel))
+
+	switch uint32(m.Constructor) {
+	case 0xe5f02959:
+		// channel.getMutableChannel channel_id:long id:Vector<long> = MutableChannel;
+		x.UInt(0xe5f02959)
+
+		// no flags
+
+		x.Long(m.GetChannelId())
+
+		x.VectorLong(m.GetId())
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLChannelGetMutableChannel) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLChannelGetMutableChannel) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0xe5f02959:
+		// channel.getMutableChannel channel_id:long id:Vector<long> = MutableChannel;
+
+		// not has flags
+
+		m.ChannelId = dBuf.Long()
+
+		m.Id = dBuf.VectorLong()
+
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLChannelGetMutableChannel) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLChannelGetChannelListByIdList
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLChannelGetChannelListByIdList) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_channel_getChannelListByIdList))
+
+	switch uint32(m.Constructor) {
+	case 0x1b886f0b:
+		// channel.getChannelListByIdList self_user_id:long id:Vector<long> = Vector<Chat>;
+		x.UInt(0x1b886f0b)
+
+		// no flags
+
+		x.Long(m.GetSelfUserId())
+
+		x.VectorLong(m.GetId())
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLChannelGetChannelListByIdList) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLChannelGetChannelListByIdList) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0x1b886f0b:
+		// channel.getChannelListByIdList self_user_id:long id:Vector<long> = Vector<Chat>;
+
+		// not has flags
+
+		m.SelfUserId = dBuf.Long()
+
+		m.Id = dBuf.VectorLong()
+
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLChannelGetChannelListByIdList) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLChannelGetChannelParticipantIdList
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLChannelGetChannelParticipantIdList) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_channel_getChannelParticipantIdList))
+
+	switch uint32(m.Constructor) {
+	case 0x8b0de5e1:
+		// channel.getChannelParticipantIdList channel_id:long = Vector<long>;
+		x.UInt(0x8b0de5e1)
+
+		// no flags
+
+		x.Long(m.GetChannelId())
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLChannelGetChannelParticipantIdList) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLChannelGetChannelParticipantIdList) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0x8b0de5e1:
+		// channel.getChannelParticipantIdList channel_id:long = Vector<long>;
+
+		// not has flags
+
+		m.ChannelId = dBuf.Long()
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLChannelGetChannelParticipantIdList) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLChannelCreateChannel
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLChannelCreateChannel) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_channel_createChannel))
+
+	switch uint32(m.Constructor) {
+	case 0x5f1ba52a:
+		// channel.createChannel flags:# creator_id:long broadcast:flags.0?true megagroup:flags.1?true title:string about:string = MutableChannel;
+		x.UInt(0x5f1ba52a)
+
+		// set flags
+		var flags uint32 = 0
+
+		if m.GetBroadcast() == true {
+			flags |= 1 << 0
+		}
+		if m.GetMegagroup() == true {
+			flags |= 1 << 1
+		}
+
+		x.UInt(flags)
+
+		// flags Debug by @benqi
+		x.Long(m.GetCreatorId())
+		x.String(m.GetTitle())
+		x.String(m.GetAbout())
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLChannelCreateChannel) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLChannelCreateChannel) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0x5f1ba52a:
+		// channel.createChannel flags:# creator_id:long broadcast:flags.0?true megagroup:flags.1?true title:string about:string = MutableChannel;
+
+		flags := dBuf.UInt()
+		_ = flags
+
+		// flags Debug by @benqi
+		m.CreatorId = dBuf.Long()
+		if (flags & (1 << 0)) != 0 {
+			m.Broadcast = true
+		}
+		if (flags & (1 << 1)) != 0 {
+			m.Megagroup = true
+		}
+		m.Title = dBuf.String()
+		m.About = dBuf.String()
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLChannelCreateChannel) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLChannelJoinChannel
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLChannelJoinChannel) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_channel_joinChannel))
+
+	switch uint32(m.Constructor) {
+	case 0xcc35456a:
+		// channel.joinChannel channel_id:long user_id:long = MutableChannel;
+		x.UInt(0xcc35456a)
+
+		// no flags
+
+		x.Long(m.GetChannelId())
+		x.Long(m.GetUserId())
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLChannelJoinChannel) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLChannelJoinChannel) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0xcc35456a:
+		// channel.joinChannel channel_id:long user_id:long = MutableChannel;
+
+		// not has flags
+
+		m.ChannelId = dBuf.Long()
+		m.UserId = dBuf.Long()
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLChannelJoinChannel) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLChannelLeaveChannel
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLChannelLeaveChannel) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_channel_leaveChannel))
+
+	switch uint32(m.Constructor) {
+	case 0xeb2d272:
+		// channel.leaveChannel channel_id:long user_id:long = MutableChannel;
+		x.UInt(0xeb2d272)
+
+		// no flags
+
+		x.Long(m.GetChannelId())
+		x.Long(m.GetUserId())
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLChannelLeaveChannel) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLChannelLeaveChannel) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0xeb2d272:
+		// channel.leaveChannel channel_id:long user_id:long = MutableChannel;
+
+		// not has flags
+
+		m.ChannelId = dBuf.Long()
+		m.UserId = dBuf.Long()
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLChannelLeaveChannel) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLChannelInviteToChannel
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLChannelInviteToChannel) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_channel_inviteToChannel))
+
+	switch uint32(m.Constructor) {
+	case 0x98f2139d:
+		// channel.inviteToChannel channel_id:long inviter_id:long id:Vector<long> = MutableChannel;
+		x.UInt(0x98f2139d)
+
+		// no flags
+
+		x.Long(m.GetChannelId())
+		x.Long(m.GetInviterId())
+
+		x.VectorLong(m.GetId())
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLChannelInviteToChannel) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLChannelInviteToChannel) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0x98f2139d:
+		// channel.inviteToChannel channel_id:long inviter_id:long id:Vector<long> = MutableChannel;
+
+		// not has flags
+
+		m.ChannelId = dBuf.Long()
+		m.InviterId = dBuf.Long()
+
+		m.Id = dBuf.VectorLong()
+
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLChannelInviteToChannel) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLChannelEditAdmin
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLChannelEditAdmin) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_channel_editAdmin))
+
+	switch uint32(m.Constructor) {
+	case 0xc81b07d7:
+		// channel.editAdmin channel_id:long operator_id:long user_id:long admin_rights:ChatAdminRights rank:string = MutableChannel;
+		x.UInt(0xc81b07d7)
+
+		// no flags
+
+		x.Long(m.GetChannelId())
+		x.Long(m.GetOperatorId())
+		x.Long(m.GetUserId())
+		x.Bytes(m.GetAdminRights().Encode(layer))
+		x.String(m.GetRank())
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLChannelEditAdmin) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLChannelEditAdmin) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0xc81b07d7:
+		// channel.editAdmin channel_id:long operator_id:long user_id:long admin_rights:ChatAdminRights rank:string = MutableChannel;
+
+		// not has flags
+
+		m.ChannelId = dBuf.Long()
+		m.OperatorId = dBuf.Long()
+		m.UserId = dBuf.Long()
+
+		m4 := &mtproto.ChatAdminRights{}
+		m4.Decode(dBuf)
+		m.AdminRights = m4
+
+		m.Rank = dBuf.String()
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLChannelEditAdmin) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLChannelEditBanned
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLChannelEditBanned) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_channel_editBanned))
+
+	switch uint32(m.Constructor) {
+	case 0x6c70fac9:
+		// channel.editBanned channel_id:long operator_id:long user_id:long banned_rights:ChatBannedRights = MutableChannel;
+		x.UInt(0x6c70fac9)
+
+		// no flags
+
+		x.Long(m.GetChannelId())
+		x.Long(m.GetOperatorId())
+		x.Long(m.GetUserId())
+		x.Bytes(m.GetBannedRights().Encode(layer))
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLChannelEditBanned) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLChannelEditBanned) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0x6c70fac9:
+		// channel.editBanned channel_id:long operator_id:long user_id:long banned_rights:ChatBannedRights = MutableChannel;
+
+		// not has flags
+
+		m.ChannelId = dBuf.Long()
+		m.OperatorId = dBuf.Long()
+		m.UserId = dBuf.Long()
+
+		m4 := &mtproto.ChatBannedRights{}
+		m4.Decode(dBuf)
+		m.BannedRights = m4
+
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLChannelEditBanned) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLChannelGetChannelParticipants
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLChannelGetChannelParticipants) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_channel_getChannelParticipants))
+
+	switch uint32(m.Constructor) {
+	case 0xd84a9be7:
+		// channel.getChannelParticipants channel_id:long filter:ChannelParticipantsFilter offset:int limit:int = ChannelParticipants;
+		x.UInt(0xd84a9be7)
+
+		// no flags
+
+		x.Long(m.GetChannelId())
+		x.Bytes(m.GetFilter().Encode(layer))
+		x.Int(m.GetOffset())
+		x.Int(m.GetLimit())
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLChannelGetChannelParticipants) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLChannelGetChannelParticipants) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0xd84a9be7:
+		// channel.getChannelParticipants channel_id:long filter:ChannelParticipantsFilter offset:int limit:int = ChannelParticipants;
+
+		// not has flags
+
+		m.ChannelId = dBuf.Long()
+
+		m2 := &mtproto.ChannelParticipantsFilter{}
+		m2.Decode(dBuf)
+		m.Filter = m2
+
+		m.Offset = dBuf.Int()
+		m.Limit = dBuf.Int()
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLChannelGetChannelParticipants) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLChannelSendMessage
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLChannelSendMessage) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_channel_sendMessage))
+
+	switch uint32(m.Constructor) {
+	case 0xb4d6a394:
+		// channel.sendMessage user_id:long channel_id:long random_id:long message:Message = MessageBox;
+		x.UInt(0xb4d6a394)
+
+		// no flags
+
+		x.Long(m.GetUserId())
+		x.Long(m.GetChannelId())
+		x.Long(m.GetRandomId())
+		x.Bytes(m.GetMessage().Encode(layer))
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLChannelSendMessage) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLChannelSendMessage) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0xb4d6a394:
+		// channel.sendMessage user_id:long channel_id:long random_id:long message:Message = MessageBox;
+
+		// not has flags
+
+		m.UserId = dBuf.Long()
+		m.ChannelId = dBuf.Long()
+		m.RandomId = dBuf.Long()
+
+		m4 := &mtproto.Message{}
+		m4.Decode(dBuf)
+		m.Message = m4
+
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLChannelSendMessage) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLChannelGetHistory
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLChannelGetHistory) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_channel_getHistory))
+
+	switch uint32(m.Constructor) {
+	case 0x82cd437b:
+		// channel.getHistory user_id:long channel_id:long offset_id:int add_offset:int limit:int max_id:int min_id:int = Vector<MessageBox>;
+		x.UInt(0x82cd437b)
+
+		// no flags
+
+		x.Long(m.GetUserId())
+		x.Long(m.GetChannelId())
+		x.Int(m.GetOffsetId())
+		x.Int(m.GetAddOffset())
+		x.Int(m.GetLimit())
+		x.Int(m.GetMaxId())
+		x.Int(m.GetMinId())
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLChannelGetHistory) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLChannelGetHistory) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0x82cd437b:
+		// channel.getHistory user_id:long channel_id:long offset_id:int add_offset:int limit:int max_id:int min_id:int = Vector<MessageBox>;
+
+		// not has flags
+
+		m.UserId = dBuf.Long()
+		m.ChannelId = dBuf.Long()
+		m.OffsetId = dBuf.Int()
+		m.AddOffset = dBuf.Int()
+		m.Limit = dBuf.Int()
+		m.MaxId = dBuf.Int()
+		m.MinId = dBuf.Int()
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLChannelGetHistory) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLChannelGetMessages
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLChannelGetMessages) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_channel_getMessages))
+
+	switch uint32(m.Constructor) {
+	case 0x20046295:
+		// channel.getMessages user_id:long channel_id:long id:Vector<int> = Vector<MessageBox>;
+		x.UInt(0x20046295)
+
+		// no flags
+
+		x.Long(m.GetUserId())
+		x.Long(m.GetChannelId())
+
+		x.VectorInt(m.GetId())
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLChannelGetMessages) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLChannelGetMessages) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0x20046295:
+		// channel.getMessages user_id:long channel_id:long id:Vector<int> = Vector<MessageBox>;
+
+		// not has flags
+
+		m.UserId = dBuf.Long()
+		m.ChannelId = dBuf.Long()
+
+		m.Id = dBuf.VectorInt()
+
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLChannelGetMessages) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLChannelReadHistory
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLChannelReadHistory) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_channel_readHistory))
+
+	switch uint32(m.Constructor) {
+	case 0xafd7a3d5:
+		// channel.readHistory user_id:long channel_id:long max_id:int = Bool;
+		x.UInt(0xafd7a3d5)
+
+		// no flags
+
+		x.Long(m.GetUserId())
+		x.Long(m.GetChannelId())
+		x.Int(m.GetMaxId())
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLChannelReadHistory) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLChannelReadHistory) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0xafd7a3d5:
+		// channel.readHistory user_id:long channel_id:long max_id:int = Bool;
+
+		// not has flags
+
+		m.UserId = dBuf.Long()
+		m.ChannelId = dBuf.Long()
+		m.MaxId = dBuf.Int()
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLChannelReadHistory) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLChannelGetChannelDifference
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLChannelGetChannelDifference) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_channel_getChannelDifference))
+
+	switch uint32(m.Constructor) {
+	case 0x49c43b21:
+		// channel.getChannelDifference user_id:long channel_id:long pts:int limit:int = ChannelDifference;
+		x.UInt(0x49c43b21)
+
+		// no flags
+
+		x.Long(m.GetUserId())
+		x.Long(m.GetChannelId())
+		x.Int(m.GetPts())
+		x.Int(m.GetLimit())
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLChannelGetChannelDifference) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLChannelGetChannelDifference) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0x49c43b21:
+		// channel.getChannelDifference user_id:long channel_id:long pts:int limit:int = ChannelDifference;
+
+		// not has flags
+
+		m.UserId = dBuf.Long()
+		m.ChannelId = dBuf.Long()
+		m.Pts = dBuf.Int()
+		m.Limit = dBuf.Int()
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLChannelGetChannelDifference) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLChannelEditTitle
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLChannelEditTitle) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_channel_editTitle))
+
+	switch uint32(m.Constructor) {
+	case 0x214cf655:
+		// channel.editTitle channel_id:long operator_id:long title:string = MutableChannel;
+		x.UInt(0x214cf655)
+
+		// no flags
+
+		x.Long(m.GetChannelId())
+		x.Long(m.GetOperatorId())
+		x.String(m.GetTitle())
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLChannelEditTitle) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLChannelEditTitle) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0x214cf655:
+		// channel.editTitle channel_id:long operator_id:long title:string = MutableChannel;
+
+		// not has flags
+
+		m.ChannelId = dBuf.Long()
+		m.OperatorId = dBuf.Long()
+		m.Title = dBuf.String()
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLChannelEditTitle) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLChannelEditPhoto
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLChannelEditPhoto) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_channel_editPhoto))
+
+	switch uint32(m.Constructor) {
+	case 0x6aa8e49:
+		// channel.editPhoto channel_id:long operator_id:long photo:Photo = MutableChannel;
+		x.UInt(0x6aa8e49)
+
+		// no flags
+
+		x.Long(m.GetChannelId())
+		x.Long(m.GetOperatorId())
+		x.Bytes(m.GetPhoto().Encode(layer))
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLChannelEditPhoto) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLChannelEditPhoto) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0x6aa8e49:
+		// channel.editPhoto channel_id:long operator_id:long photo:Photo = MutableChannel;
+
+		// not has flags
+
+		m.ChannelId = dBuf.Long()
+		m.OperatorId = dBuf.Long()
+
+		m3 := &mtproto.Photo{}
+		m3.Decode(dBuf)
+		m.Photo = m3
+
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLChannelEditPhoto) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLChannelToggleSignatures
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLChannelToggleSignatures) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_channel_toggleSignatures))
+
+	switch uint32(m.Constructor) {
+	case 0x3d5bbb56:
+		// channel.toggleSignatures channel_id:long operator_id:long enabled:Bool = MutableChannel;
+		x.UInt(0x3d5bbb56)
+
+		// no flags
+
+		x.Long(m.GetChannelId())
+		x.Long(m.GetOperatorId())
+		x.Bytes(m.GetEnabled().Encode(layer))
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLChannelToggleSignatures) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLChannelToggleSignatures) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0x3d5bbb56:
+		// channel.toggleSignatures channel_id:long operator_id:long enabled:Bool = MutableChannel;
+
+		// not has flags
+
+		m.ChannelId = dBuf.Long()
+		m.OperatorId = dBuf.Long()
+
+		m3 := &mtproto.Bool{}
+		m3.Decode(dBuf)
+		m.Enabled = m3
+
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLChannelToggleSignatures) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLChannelTogglePreHistoryHidden
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLChannelTogglePreHistoryHidden) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_channel_togglePreHistoryHidden))
+
+	switch uint32(m.Constructor) {
+	case 0xc2f2af8e:
+		// channel.togglePreHistoryHidden channel_id:long operator_id:long enabled:Bool = MutableChannel;
+		x.UInt(0xc2f2af8e)
+
+		// no flags
+
+		x.Long(m.GetChannelId())
+		x.Long(m.GetOperatorId())
+		x.Bytes(m.GetEnabled().Encode(layer))
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLChannelTogglePreHistoryHidden) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLChannelTogglePreHistoryHidden) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0xc2f2af8e:
+		// channel.togglePreHistoryHidden channel_id:long operator_id:long enabled:Bool = MutableChannel;
+
+		// not has flags
+
+		m.ChannelId = dBuf.Long()
+		m.OperatorId = dBuf.Long()
+
+		m3 := &mtproto.Bool{}
+		m3.Decode(dBuf)
+		m.Enabled = m3
+
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLChannelTogglePreHistoryHidden) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLChannelToggleSlowMode
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLChannelToggleSlowMode) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_channel_toggleSlowMode))
+
+	switch uint32(m.Constructor) {
+	case 0xe7cdb5f5:
+		// channel.toggleSlowMode channel_id:long operator_id:long seconds:int = MutableChannel;
+		x.UInt(0xe7cdb5f5)
+
+		// no flags
+
+		x.Long(m.GetChannelId())
+		x.Long(m.GetOperatorId())
+		x.Int(m.GetSeconds())
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLChannelToggleSlowMode) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLChannelToggleSlowMode) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0xe7cdb5f5:
+		// channel.toggleSlowMode channel_id:long operator_id:long seconds:int = MutableChannel;
+
+		// not has flags
+
+		m.ChannelId = dBuf.Long()
+		m.OperatorId = dBuf.Long()
+		m.Seconds = dBuf.Int()
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLChannelToggleSlowMode) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLChannelDeleteChannel
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLChannelDeleteChannel) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_channel_deleteChannel))
+
+	switch uint32(m.Constructor) {
+	case 0x704c888:
+		// channel.deleteChannel channel_id:long operator_id:long = MutableChannel;
+		x.UInt(0x704c888)
+
+		// no flags
+
+		x.Long(m.GetChannelId())
+		x.Long(m.GetOperatorId())
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLChannelDeleteChannel) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLChannelDeleteChannel) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0x704c888:
+		// channel.deleteChannel channel_id:long operator_id:long = MutableChannel;
+
+		// not has flags
+
+		m.ChannelId = dBuf.Long()
+		m.OperatorId = dBuf.Long()
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLChannelDeleteChannel) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLChannelDeleteMessages
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLChannelDeleteMessages) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_channel_deleteMessages))
+
+	switch uint32(m.Constructor) {
+	case 0x6951483b:
+		// channel.deleteMessages user_id:long channel_id:long id:Vector<int> = ChannelDeletedMessages;
+		x.UInt(0x6951483b)
+
+		// no flags
+
+		x.Long(m.GetUserId())
+		x.Long(m.GetChannelId())
+
+		x.VectorInt(m.GetId())
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLChannelDeleteMessages) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLChannelDeleteMessages) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0x6951483b:
+		// channel.deleteMessages user_id:long channel_id:long id:Vector<int> = ChannelDeletedMessages;
+
+		// not has flags
+
+		m.UserId = dBuf.Long()
+		m.ChannelId = dBuf.Long()
+
+		m.Id = dBuf.VectorInt()
+
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLChannelDeleteMessages) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLChannelDeleteParticipantHistory
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLChannelDeleteParticipantHistory) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_channel_deleteParticipantHistory))
+
+	switch uint32(m.Constructor) {
+	case 0x5939cddd:
+		// channel.deleteParticipantHistory operator_id:long channel_id:long participant_id:long limit:int = ChannelDeletedMessages;
+		x.UInt(0x5939cddd)
+
+		// no flags
+
+		x.Long(m.GetOperatorId())
+		x.Long(m.GetChannelId())
+		x.Long(m.GetParticipantId())
+		x.Int(m.GetLimit())
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLChannelDeleteParticipantHistory) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLChannelDeleteParticipantHistory) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0x5939cddd:
+		// channel.deleteParticipantHistory operator_id:long channel_id:long participant_id:long limit:int = ChannelDeletedMessages;
+
+		// not has flags
+
+		m.OperatorId = dBuf.Long()
+		m.ChannelId = dBuf.Long()
+		m.ParticipantId = dBuf.Long()
+		m.Limit = dBuf.Int()
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLChannelDeleteParticipantHistory) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLChannelDeleteHistory
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLChannelDeleteHistory) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_channel_deleteHistory))
+
+	switch uint32(m.Constructor) {
+	case 0xf5bec45b:
+		// channel.deleteHistory flags:# user_id:long channel_id:long for_everyone:flags.0?true max_id:int = MutableChannel;
+		x.UInt(0xf5bec45b)
+
+		// set flags
+		var flags uint32 = 0
+
+		if m.GetForEveryone() == true {
+			flags |= 1 << 0
+		}
+
+		x.UInt(flags)
+
+		// flags Debug by @benqi
+		x.Long(m.GetUserId())
+		x.Long(m.GetChannelId())
+		x.Int(m.GetMaxId())
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLChannelDeleteHistory) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLChannelDeleteHistory) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0xf5bec45b:
+		// channel.deleteHistory flags:# user_id:long channel_id:long for_everyone:flags.0?true max_id:int = MutableChannel;
+
+		flags := dBuf.UInt()
+		_ = flags
+
+		// flags Debug by @benqi
+		m.UserId = dBuf.Long()
+		m.ChannelId = dBuf.Long()
+		if (flags & (1 << 0)) != 0 {
+			m.ForEveryone = true
+		}
+		m.MaxId = dBuf.Int()
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLChannelDeleteHistory) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+//----------------------------------------------------------------------------------------------------------------
+// Vector_Chat
+///////////////////////////////////////////////////////////////////////////////
+func (m *Vector_Chat) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	x.Int(int32(mtproto.CRC32_vector))
+	x.Int(int32(len(m.Datas)))
+	for _, v := range m.Datas {
+		x.Bytes((*v).Encode(layer))
+	}
+
+	return x.GetBuf()
+}
+
+func (m *Vector_Chat) Decode(dBuf *mtproto.DecodeBuf) error {
+	dBuf.Int() // TODO(@benqi): Check crc32 invalid
+	l1 := dBuf.Int()
+	m.Datas = make([]*mtproto.Chat, l1)
+	for i := int32(0); i < l1; i++ {
+		m.Datas[i] = new(mtproto.Chat)
+		(*m.Datas[i]).Decode(dBuf)
+	}
+
+	return dBuf.GetError()
+}
+
+func (m *Vector_Chat) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *Vector_Chat) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// Vector_Long
+///////////////////////////////////////////////////////////////////////////////
+func (m *Vector_Long) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	x.VectorLong(m.Datas)
+
+	return x.GetBuf()
+}
+
+func (m *Vector_Long) Decode(dBuf *mtproto.DecodeBuf) error {
+	m.Datas = dBuf.VectorLong()
+
+	return dBuf.GetError()
+}
+
+func (m *Vector_Long) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *Vector_Long) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// Vector_MessageBox
+///////////////////////////////////////////////////////////////////////////////
+func (m *Vector_MessageBox) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	x.Int(int32(mtproto.CRC32_vector))
+	x.Int(int32(len(m.Datas)))
+	for _, v := range m.Datas {
+		x.Bytes((*v).Encode(layer))
+	}
+
+	return x.GetBuf()
+}
+
+func (m *Vector_MessageBox) Decode(dBuf *mtproto.DecodeBuf) error {
+	dBuf.Int() // TODO(@benqi): Check crc32 invalid
+	l1 := dBuf.Int()
+	m.Datas = make([]*mtproto.MessageBox, l1)
+	for i := int32(0); i < l1; i++ {
+		m.Datas[i] = new(mtproto.MessageBox)
+		(*m.Datas[i]).Decode(dBuf)
+	}
+
+	return dBuf.GetError()
+}
+
+func (m *Vector_MessageBox) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *Vector_MessageBox) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
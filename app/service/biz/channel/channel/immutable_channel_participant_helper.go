@@ -0,0 +1,148 @@
+// Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+//  All rights reserved.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package channel
+
+import (
+	"github.com/teamgram/proto/mtproto"
+
+	"github.com/gogo/protobuf/types"
+)
+
+func (m *ImmutableChannelParticipant) IsChatMemberNormal() bool {
+	return m.ParticipantType == mtproto.ChatMemberNormal
+}
+
+func (m *ImmutableChannelParticipant) IsChatMemberAdmin() bool {
+	return m.ParticipantType == mtproto.ChatMemberAdmin
+}
+
+func (m *ImmutableChannelParticipant) IsChatMemberCreator() bool {
+	return m.ParticipantType == mtproto.ChatMemberCreator
+}
+
+func (m *ImmutableChannelParticipant) IsChatMemberBanned() bool {
+	return m.ParticipantType == mtproto.ChatMemberBanned
+}
+
+func (m *ImmutableChannelParticipant) IsChatMemberStateNormal() bool {
+	return m.State == mtproto.ChatMemberStateNormal
+}
+
+func (m *ImmutableChannelParticipant) IsChatMemberStateLeft() bool {
+	return m.State == mtproto.ChatMemberStateLeft
+}
+
+func (m *ImmutableChannelParticipant) IsChatMemberStateKicked() bool {
+	return m.State == mtproto.ChatMemberStateKicked
+}
+
+func (m *ImmutableChannelParticipant) CanSendMessages(broadcast bool) bool {
+	if !m.IsChatMemberStateNormal() {
+		return false
+	}
+	if m.IsChatMemberCreator() {
+		return true
+	}
+	if broadcast {
+		return m.IsChatMemberAdmin() && m.GetAdminRights().GetPostMessages()
+	}
+	if m.IsChatMemberBanned() {
+		return !m.GetBannedRights().GetSendMessages()
+	}
+	return true
+}
+
+func (m *ImmutableChannelParticipant) CanChangeInfo() bool {
+	if m.IsChatMemberCreator() {
+		return true
+	}
+	return m.IsChatMemberAdmin() && m.GetAdminRights().GetChangeInfo()
+}
+
+func (m *ImmutableChannelParticipant) CanDeleteMessages() bool {
+	if m.IsChatMemberCreator() {
+		return true
+	}
+	return m.IsChatMemberAdmin() && m.GetAdminRights().GetDeleteMessages()
+}
+
+func (m *ImmutableChannelParticipant) CanInviteUsers() bool {
+	if m.IsChatMemberCreator() {
+		return true
+	}
+	return m.IsChatMemberAdmin() && m.GetAdminRights().GetInviteUsers()
+}
+
+func (m *ImmutableChannelParticipant) CanBanUsers() bool {
+	if m.IsChatMemberCreator() {
+		return true
+	}
+	return m.IsChatMemberAdmin() && m.GetAdminRights().GetBanUsers()
+}
+
+func (m *ImmutableChannelParticipant) CanAddAdmins() bool {
+	if m.IsChatMemberCreator() {
+		return true
+	}
+	return m.IsChatMemberAdmin() && m.GetAdminRights().GetAddAdmins()
+}
+
+func (m *ImmutableChannelParticipant) ToChannelParticipant(selfId int64) *mtproto.ChannelParticipant {
+	var (
+		rank *types.StringValue
+	)
+
+	if m.Rank != "" {
+		rank = &types.StringValue{Value: m.Rank}
+	}
+
+	switch {
+	case m.IsChatMemberStateKicked() || m.IsChatMemberBanned():
+		return mtproto.MakeTLChannelParticipantBanned(&mtproto.ChannelParticipant{
+			Left:         !m.IsChatMemberStateNormal(),
+			Peer:         mtproto.MakePeerUser(m.UserId),
+			KickedBy:     m.KickedBy,
+			Date:         int32(m.Date),
+			BannedRights: m.BannedRights,
+		}).To_ChannelParticipant()
+	case !m.IsChatMemberStateNormal():
+		return mtproto.MakeTLChannelParticipantLeft(&mtproto.ChannelParticipant{
+			Peer: mtproto.MakePeerUser(m.UserId),
+		}).To_ChannelParticipant()
+	case m.IsChatMemberCreator():
+		return mtproto.MakeTLChannelParticipantCreator(&mtproto.ChannelParticipant{
+			UserId:      m.UserId,
+			AdminRights: m.AdminRights,
+			Rank:        rank,
+		}).To_ChannelParticipant()
+	case m.IsChatMemberAdmin():
+		participant := mtproto.MakeTLChannelParticipantAdmin(&mtproto.ChannelParticipant{
+			CanEdit:     false,
+			Self:        m.UserId == selfId,
+			UserId:      m.UserId,
+			PromotedBy:  m.PromotedBy,
+			Date:        int32(m.Date),
+			AdminRights: m.AdminRights,
+			Rank:        rank,
+		})
+		if m.InviterUserId != 0 {
+			participant.SetInviterId_FLAGINT64(&types.Int64Value{Value: m.InviterUserId})
+		}
+		return participant.To_ChannelParticipant()
+	case m.UserId == selfId && m.InviterUserId != 0:
+		return mtproto.MakeTLChannelParticipantSelf(&mtproto.ChannelParticipant{
+			UserId:          m.UserId,
+			InviterId_INT64: m.InviterUserId,
+			Date:            int32(m.Date),
+		}).To_ChannelParticipant()
+	default:
+		return mtproto.MakeTLChannelParticipant(&mtproto.ChannelParticipant{
+			UserId: m.UserId,
+			Date:   int32(m.Date),
+		}).To_ChannelParticipant()
+	}
+}
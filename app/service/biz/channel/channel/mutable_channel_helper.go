@@ -0,0 +1,245 @@
+// Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+//  All rights reserved.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package channel
+
+import (
+	"time"
+
+	"github.com/teamgram/proto/mtproto"
+
+	"github.com/gogo/protobuf/types"
+)
+
+func (m *MutableChannel) Id() int64 {
+	return m.GetChannel().GetId()
+}
+
+func (m *MutableChannel) AccessHash() int64 {
+	return m.GetChannel().GetAccessHash()
+}
+
+func (m *MutableChannel) Creator() int64 {
+	return m.GetChannel().GetCreator()
+}
+
+func (m *MutableChannel) Title() string {
+	return m.GetChannel().GetTitle()
+}
+
+func (m *MutableChannel) About() string {
+	return m.GetChannel().GetAbout()
+}
+
+func (m *MutableChannel) Photo() *mtproto.Photo {
+	return m.GetChannel().GetPhoto()
+}
+
+func (m *MutableChannel) ChatPhoto() *mtproto.ChatPhoto {
+	return mtproto.MakeChatPhotoByPhoto(m.GetChannel().GetPhoto())
+}
+
+func (m *MutableChannel) Broadcast() bool {
+	return m.GetChannel().GetBroadcast()
+}
+
+func (m *MutableChannel) Megagroup() bool {
+	return m.GetChannel().GetMegagroup()
+}
+
+func (m *MutableChannel) Signatures() bool {
+	return m.GetChannel().GetSignatures()
+}
+
+func (m *MutableChannel) Noforwards() bool {
+	return m.GetChannel().GetNoforwards()
+}
+
+func (m *MutableChannel) Deactivated() bool {
+	return m.GetChannel().GetDeactivated()
+}
+
+func (m *MutableChannel) HiddenPrehistory() bool {
+	return m.GetChannel().GetHiddenPrehistory()
+}
+
+func (m *MutableChannel) Username() string {
+	return m.GetChannel().GetUsername()
+}
+
+func (m *MutableChannel) ParticipantsCount() int32 {
+	return m.GetChannel().GetParticipantsCount()
+}
+
+func (m *MutableChannel) DefaultBannedRights() *mtproto.ChatBannedRights {
+	return m.GetChannel().GetDefaultBannedRights()
+}
+
+func (m *MutableChannel) SlowmodeSeconds() int32 {
+	return m.GetChannel().GetSlowmodeSeconds()
+}
+
+func (m *MutableChannel) Pts() int32 {
+	return m.GetChannel().GetPts()
+}
+
+func (m *MutableChannel) TopMessage() int32 {
+	return m.GetChannel().GetTopMessage()
+}
+
+func (m *MutableChannel) Date() int32 {
+	return int32(m.GetChannel().GetDate())
+}
+
+func (m *MutableChannel) Version() int32 {
+	return m.GetChannel().GetVersion()
+}
+
+// JoinAvailableMinId the new participants don't see the messages sent before they joined
+// if the pre-history is hidden.
+func (m *MutableChannel) JoinAvailableMinId() int32 {
+	if m.Megagroup() && m.HiddenPrehistory() {
+		return m.TopMessage()
+	}
+	return 0
+}
+
+func (m *MutableChannel) GetImmutableChannelParticipant(id int64) (u *ImmutableChannelParticipant, ok bool) {
+	for _, v := range m.Participants {
+		if v.UserId == id {
+			return v, true
+		}
+	}
+
+	return nil, false
+}
+
+func (m *MutableChannel) Walk(visit func(userId int64, participant *ImmutableChannelParticipant) error) {
+	if visit == nil {
+		return
+	}
+	for _, v := range m.GetParticipants() {
+		visit(v.UserId, v)
+	}
+}
+
+func (m *MutableChannel) MakeMessageService(fromId int64, action *mtproto.MessageAction) *mtproto.Message {
+	message := mtproto.MakeTLMessageService(&mtproto.Message{
+		Out:         true,
+		Mentioned:   false,
+		MediaUnread: false,
+		Silent:      false,
+		Post:        m.Broadcast(),
+		Legacy:      false,
+		Id:          0,
+		FromId:      nil,
+		PeerId:      mtproto.MakePeerChannel(m.Id()),
+		ReplyTo:     nil,
+		Date:        int32(time.Now().Unix()),
+		Action:      action,
+		TtlPeriod:   nil,
+	})
+	if !m.Broadcast() {
+		message.SetFromId(mtproto.MakePeerUser(fromId))
+	}
+	return message.To_Message()
+}
+
+func (m *MutableChannel) ToChannelForbidden() *mtproto.Chat {
+	return mtproto.MakeTLChannelForbidden(&mtproto.Chat{
+		Broadcast:        m.Broadcast(),
+		Megagroup:        m.Megagroup(),
+		Id:               m.Id(),
+		AccessHash_INT64: m.AccessHash(),
+		Title:            m.Title(),
+		UntilDate:        nil,
+	}).To_Chat()
+}
+
+func (m *MutableChannel) ToUnsafeChat(id int64) *mtproto.Chat {
+	if m.Deactivated() {
+		return m.ToChannelForbidden()
+	}
+
+	channel := &mtproto.Chat{
+		Creator:                     false,
+		Left:                        true,
+		Broadcast:                   m.Broadcast(),
+		Megagroup:                   m.Megagroup(),
+		Signatures:                  m.Signatures(),
+		Noforwards:                  m.Noforwards(),
+		SlowmodeEnabled:             m.SlowmodeSeconds() > 0,
+		Id:                          m.Id(),
+		AccessHash_FLAGINT64:        &types.Int64Value{Value: m.AccessHash()},
+		Title:                       m.Title(),
+		Username:                    nil,
+		Photo:                       m.ChatPhoto(),
+		Date:                        m.Date(),
+		AdminRights:                 nil,
+		BannedRights:                nil,
+		DefaultBannedRights:         nil,
+		ParticipantsCount_FLAGINT32: &types.Int32Value{Value: m.ParticipantsCount()},
+	}
+	if m.Username() != "" {
+		channel.Username = &types.StringValue{Value: m.Username()}
+	}
+	if m.Megagroup() {
+		channel.DefaultBannedRights = m.DefaultBannedRights()
+	}
+
+	if me, ok := m.GetImmutableChannelParticipant(id); ok {
+		if me.IsChatMemberStateKicked() {
+			return m.ToChannelForbidden()
+		}
+		channel.Creator = me.IsChatMemberCreator()
+		channel.Left = !me.IsChatMemberStateNormal()
+		if me.IsChatMemberCreator() || me.IsChatMemberAdmin() {
+			channel.AdminRights = me.GetAdminRights()
+		}
+		if me.IsChatMemberBanned() {
+			channel.BannedRights = me.GetBannedRights()
+		}
+	}
+
+	return mtproto.MakeTLChannel(channel).To_Chat()
+}
+
+func (m *Vector_MessageBox) Visit(toUserId int64,
+	cb1 func(messageList []*mtproto.Message),
+	cb2 func(userIdList []int64),
+	cb3 func(chatIdList []int64),
+	cb4 func(channelIdList []int64)) {
+	var (
+		idHelper    = mtproto.NewIDListHelper()
+		messageList = make([]*mtproto.Message, 0, len(m.GetDatas()))
+	)
+
+	for _, box := range m.GetDatas() {
+		message := box.ToMessage(toUserId)
+		messageList = append(messageList, message)
+		idHelper.PickByMessage(message)
+	}
+
+	if cb1 != nil {
+		cb1(messageList)
+	}
+
+	idHelper.Visit(cb2, cb3, cb4)
+}
+
+func (m *Vector_MessageBox) Length() int32 {
+	return int32(len(m.GetDatas()))
+}
+
+func (m *Vector_MessageBox) Walk(cb func(idx int, v *mtproto.MessageBox)) {
+	if cb == nil {
+		return
+	}
+
+	for i, v := range m.Datas {
+		cb(i, v)
+	}
+}
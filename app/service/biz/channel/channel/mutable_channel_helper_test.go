@@ -0,0 +1,116 @@
+// Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+//  All rights reserved.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package channel
+
+import (
+	"testing"
+
+	"github.com/teamgram/proto/mtproto"
+)
+
+func makeTestChannel(broadcast bool, participants ...*ImmutableChannelParticipant) *MutableChannel {
+	return MakeTLMutableChannel(&MutableChannel{
+		Channel: MakeTLImmutableChannel(&ImmutableChannel{
+			Id:                1,
+			AccessHash:        2,
+			Creator:           100,
+			Title:             "test",
+			Photo:             mtproto.MakeTLPhotoEmpty(nil).To_Photo(),
+			Broadcast:         broadcast,
+			Megagroup:         !broadcast,
+			ParticipantsCount: int32(len(participants)),
+		}).To_ImmutableChannel(),
+		Participants: participants,
+	}).To_MutableChannel()
+}
+
+func makeTestParticipant(userId int64, participantType, state int32) *ImmutableChannelParticipant {
+	return MakeTLImmutableChannelParticipant(&ImmutableChannelParticipant{
+		ChannelId:       1,
+		UserId:          userId,
+		ParticipantType: participantType,
+		State:           state,
+		AdminRights:     mtproto.MakeTLChatAdminRights(nil).To_ChatAdminRights(),
+		BannedRights:    mtproto.MakeTLChatBannedRights(nil).To_ChatBannedRights(),
+	}).To_ImmutableChannelParticipant()
+}
+
+func TestCanSendMessages(t *testing.T) {
+	creator := makeTestParticipant(100, mtproto.ChatMemberCreator, mtproto.ChatMemberStateNormal)
+	member := makeTestParticipant(101, mtproto.ChatMemberNormal, mtproto.ChatMemberStateNormal)
+	left := makeTestParticipant(102, mtproto.ChatMemberNormal, mtproto.ChatMemberStateLeft)
+	banned := makeTestParticipant(103, mtproto.ChatMemberBanned, mtproto.ChatMemberStateNormal)
+	banned.BannedRights.SendMessages = true
+
+	tests := []struct {
+		name        string
+		participant *ImmutableChannelParticipant
+		broadcast   bool
+		want        bool
+	}{
+		{"creator in broadcast", creator, true, true},
+		{"member in broadcast", member, true, false},
+		{"member in megagroup", member, false, true},
+		{"left member in megagroup", left, false, false},
+		{"banned member in megagroup", banned, false, false},
+	}
+
+	for _, tt := range tests {
+		if got := tt.participant.CanSendMessages(tt.broadcast); got != tt.want {
+			t.Errorf("%s: CanSendMessages() = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestToUnsafeChat(t *testing.T) {
+	creator := makeTestParticipant(100, mtproto.ChatMemberCreator, mtproto.ChatMemberStateNormal)
+	kicked := makeTestParticipant(101, mtproto.ChatMemberBanned, mtproto.ChatMemberStateKicked)
+	mChannel := makeTestChannel(true, creator, kicked)
+
+	if chat := mChannel.ToUnsafeChat(100); chat.PredicateName != mtproto.Predicate_channel || !chat.Creator || chat.Left {
+		t.Errorf("creator: unexpected chat %s", chat.DebugString())
+	}
+	if chat := mChannel.ToUnsafeChat(101); chat.PredicateName != mtproto.Predicate_channelForbidden {
+		t.Errorf("kicked: want channelForbidden, got %s", chat.PredicateName)
+	}
+	if chat := mChannel.ToUnsafeChat(102); chat.PredicateName != mtproto.Predicate_channel || !chat.Left {
+		t.Errorf("stranger: unexpected chat %s", chat.DebugString())
+	}
+}
+
+func TestJoinAvailableMinId(t *testing.T) {
+	mChannel := makeTestChannel(false)
+	mChannel.Channel.TopMessage = 10
+
+	if id := mChannel.JoinAvailableMinId(); id != 0 {
+		t.Errorf("visible pre-history: JoinAvailableMinId() = %d, want 0", id)
+	}
+	mChannel.Channel.HiddenPrehistory = true
+	if id := mChannel.JoinAvailableMinId(); id != 10 {
+		t.Errorf("hidden pre-history: JoinAvailableMinId() = %d, want 10", id)
+	}
+}
+
+func TestCanDeleteMessages(t *testing.T) {
+	creator := makeTestParticipant(100, mtproto.ChatMemberCreator, mtproto.ChatMemberStateNormal)
+	admin := makeTestParticipant(101, mtproto.ChatMemberAdmin, mtproto.ChatMemberStateNormal)
+	member := makeTestParticipant(102, mtproto.ChatMemberNormal, mtproto.ChatMemberStateNormal)
+
+	if !creator.CanDeleteMessages() {
+		t.Errorf("creator: CanDeleteMessages() = false, want true")
+	}
+	if admin.CanDeleteMessages() {
+		t.Errorf("admin without delete_messages: CanDeleteMessages() = true, want false")
+	}
+	admin.AdminRights.DeleteMessages = true
+	if !admin.CanDeleteMessages() {
+		t.Errorf("admin with delete_messages: CanDeleteMessages() = false, want true")
+	}
+	if member.CanDeleteMessages() {
+		t.Errorf("member: CanDeleteMessages() = true, want false")
+	}
+}
@@ -0,0 +1,71 @@
+/*
+ * WARNING! All changes made in this file will be lost!
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2022-present,  Teamgram Authors.
+ *  All rights reserved.
+ *
+ * Author: teagramio (teagram.io@gmail.com)
+ */
+
+package channel
+
+import (
+	"reflect"
+
+	"github.com/teamgram/proto/mtproto"
+)
+
+var _ *mtproto.Bool
+
+type newRPCReplyFunc func() interface{}
+
+type RPCContextTuple struct {
+	Method       string
+	NewReplyFunc newRPCReplyFunc
+}
+
+var rpcContextRegisters = map[string]RPCContextTuple{
+	"TLChannelGetMutableChannel":           RPCContextTuple{"/mtproto.RPCChannel/channel_getMutableChannel", func() interface{} { return new(MutableChannel) }},
+	"TLChannelGetChannelListByIdList":      RPCContextTuple{"/mtproto.RPCChannel/channel_getChannelListByIdList", func() interface{} { return new(Vector_Chat) }},
+	"TLChannelGetChannelParticipantIdList": RPCContextTuple{"/mtproto.RPCChannel/channel_getChannelParticipantIdList", func() interface{} { return new(Vector_Long) }},
+	"TLChannelCreateChannel":               RPCContextTuple{"/mtproto.RPCChannel/channel_createChannel", func() interface{} { return new(MutableChannel) }},
+	"TLChannelJoinChannel":                 RPCContextTuple{"/mtproto.RPCChannel/channel_joinChannel", func() interface{} { return new(MutableChannel) }},
+	"TLChannelLeaveChannel":                RPCContextTuple{"/mtproto.RPCChannel/channel_leaveChannel", func() interface{} { return new(MutableChannel) }},
+	"TLChannelInviteToChannel":             RPCContextTuple{"/mtproto.RPCChannel/channel_inviteToChannel", func() interface{} { return new(MutableChannel) }},
+	"TLChannelEditAdmin":                   RPCContextTuple{"/mtproto.RPCChannel/channel_editAdmin", func() interface{} { return new(MutableChannel) }},
+	"TLChannelEditBanned":                  RPCContextTuple{"/mtproto.RPCChannel/channel_editBanned", func() interface{} { return new(MutableChannel) }},
+	"TLChannelGetChannelParticipants":      RPCContextTuple{"/mtproto.RPCChannel/channel_getChannelParticipants", func() interface{} { return new(ChannelParticipants) }},
+	"TLChannelSendMessage":                 RPCContextTuple{"/mtproto.RPCChannel/channel_sendMessage", func() interface{} { return new(mtproto.MessageBox) }},
+	"TLChannelGetHistory":                  RPCContextTuple{"/mtproto.RPCChannel/channel_getHistory", func() interface{} { return new(Vector_MessageBox) }},
+	"TLChannelGetMessages":                 RPCContextTuple{"/mtproto.RPCChannel/channel_getMessages", func() interface{} { return new(Vector_MessageBox) }},
+	"TLChannelReadHistory":                 RPCContextTuple{"/mtproto.RPCChannel/channel_readHistory", func() interface{} { return new(mtproto.Bool) }},
+	"TLChannelGetChannelDifference":        RPCContextTuple{"/mtproto.RPCChannel/channel_getChannelDifference", func() interface{} { return new(ChannelDifference) }},
+	"TLChannelEditTitle":                   RPCContextTuple{"/mtproto.RPCChannel/channel_editTitle", func() interface{} { return new(MutableChannel) }},
+	"TLChannelEditPhoto":                   RPCContextTuple{"/mtproto.RPCChannel/channel_editPhoto", func() interface{} { return new(MutableChannel) }},
+	"TLChannelToggleSignatures":            RPCContextTuple{"/mtproto.RPCChannel/channel_toggleSignatures", func() interface{} { return new(MutableChannel) }},
+	"TLChannelTogglePreHistoryHidden":      RPCContextTuple{"/mtproto.RPCChannel/channel_togglePreHistoryHidden", func() interface{} { return new(MutableChannel) }},
+	"TLChannelToggleSlowMode":              RPCContextTuple{"/mtproto.RPCChannel/channel_toggleSlowMode", func() interface{} { return new(MutableChannel) }},
+	"TLChannelDeleteChannel":               RPCContextTuple{"/mtproto.RPCChannel/channel_deleteChannel", func() interface{} { return new(MutableChannel) }},
+	"TLChannelDeleteMessages":              RPCContextTuple{"/mtproto.RPCChannel/channel_deleteMessages", func() interface{} { return new(ChannelDeletedMessages) }},
+	"TLChannelDeleteParticipantHistory":    RPCContextTuple{"/mtproto.RPCChannel/channel_deleteParticipantHistory", func() interface{} { return new(ChannelDeletedMessages) }},
+	"TLChannelDeleteHistory":               RPCContextTuple{"/mtproto.RPCChannel/channel_deleteHistory", func() interface{} { return new(MutableChannel) }},
+}
+
+func FindRPCContextTuple(t interface{}) *RPCContextTuple {
+	rt := reflect.TypeOf(t)
+	if rt.Kind() == reflect.Ptr {
+		rt = rt.Elem()
+	}
+
+	m, ok := rpcContextRegisters[rt.Name()]
+	if !ok {
+		// log.Errorf("Can't find name: %s", rt.Name())
+		return nil
+	}
+	return &m
+}
+
+func GetRPCContextRegisters() map[string]RPCContextTuple {
+	return rpcContextRegisters
+}
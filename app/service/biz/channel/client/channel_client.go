@@ -0,0 +1,227 @@
+/*
+ * WARNING! All changes made in this file will be lost!
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package channel_client
+
+import (
+	"context"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/biz/channel/channel"
+
+	"github.com/zeromicro/go-zero/zrpc"
+)
+
+var _ *mtproto.Bool
+
+type ChannelClient interface {
+	ChannelGetMutableChannel(ctx context.Context, in *channel.TLChannelGetMutableChannel) (*channel.MutableChannel, error)
+	ChannelGetChannelListByIdList(ctx context.Context, in *channel.TLChannelGetChannelListByIdList) (*channel.Vector_Chat, error)
+	ChannelGetChannelParticipantIdList(ctx context.Context, in *channel.TLChannelGetChannelParticipantIdList) (*channel.Vector_Long, error)
+	ChannelCreateChannel(ctx context.Context, in *channel.TLChannelCreateChannel) (*channel.MutableChannel, error)
+	ChannelJoinChannel(ctx context.Context, in *channel.TLChannelJoinChannel) (*channel.MutableChannel, error)
+	ChannelLeaveChannel(ctx context.Context, in *channel.TLChannelLeaveChannel) (*channel.MutableChannel, error)
+	ChannelInviteToChannel(ctx context.Context, in *channel.TLChannelInviteToChannel) (*channel.MutableChannel, error)
+	ChannelEditAdmin(ctx context.Context, in *channel.TLChannelEditAdmin) (*channel.MutableChannel, error)
+	ChannelEditBanned(ctx context.Context, in *channel.TLChannelEditBanned) (*channel.MutableChannel, error)
+	ChannelGetChannelParticipants(ctx context.Context, in *channel.TLChannelGetChannelParticipants) (*channel.ChannelParticipants, error)
+	ChannelSendMessage(ctx context.Context, in *channel.TLChannelSendMessage) (*mtproto.MessageBox, error)
+	ChannelGetHistory(ctx context.Context, in *channel.TLChannelGetHistory) (*channel.Vector_MessageBox, error)
+	ChannelGetMessages(ctx context.Context, in *channel.TLChannelGetMessages) (*channel.Vector_MessageBox, error)
+	ChannelReadHistory(ctx context.Context, in *channel.TLChannelReadHistory) (*mtproto.Bool, error)
+	ChannelGetChannelDifference(ctx context.Context, in *channel.TLChannelGetChannelDifference) (*channel.ChannelDifference, error)
+	ChannelEditTitle(ctx context.Context, in *channel.TLChannelEditTitle) (*channel.MutableChannel, error)
+	ChannelEditPhoto(ctx context.Context, in *channel.TLChannelEditPhoto) (*channel.MutableChannel, error)
+	ChannelToggleSignatures(ctx context.Context, in *channel.TLChannelToggleSignatures) (*channel.MutableChannel, error)
+	ChannelTogglePreHistoryHidden(ctx context.Context, in *channel.TLChannelTogglePreHistoryHidden) (*channel.MutableChannel, error)
+	ChannelToggleSlowMode(ctx context.Context, in *channel.TLChannelToggleSlowMode) (*channel.MutableChannel, error)
+	ChannelDeleteChannel(ctx context.Context, in *channel.TLChannelDeleteChannel) (*channel.MutableChannel, error)
+	ChannelDeleteMessages(ctx context.Context, in *channel.TLChannelDeleteMessages) (*channel.ChannelDeletedMessages, error)
+	ChannelDeleteParticipantHistory(ctx context.Context, in *channel.TLChannelDeleteParticipantHistory) (*channel.ChannelDeletedMessages, error)
+	ChannelDeleteHistory(ctx context.Context, in *channel.TLChannelDeleteHistory) (*channel.MutableChannel, error)
+}
+
+type defaultChannelClient struct {
+	cli zrpc.Client
+}
+
+func NewChannelClient(cli zrpc.Client) ChannelClient {
+	return &defaultChannelClient{
+		cli: cli,
+	}
+}
+
+// ChannelGetMutableChannel
+// channel.getMutableChannel channel_id:long id:Vector<long> = MutableChannel;
+func (m *defaultChannelClient) ChannelGetMutableChannel(ctx context.Context, in *channel.TLChannelGetMutableChannel) (*channel.MutableChannel, error) {
+	client := channel.NewRPCChannelClient(m.cli.Conn())
+	return client.ChannelGetMutableChannel(ctx, in)
+}
+
+// ChannelGetChannelListByIdList
+// channel.getChannelListByIdList self_user_id:long id:Vector<long> = Vector<Chat>;
+func (m *defaultChannelClient) ChannelGetChannelListByIdList(ctx context.Context, in *channel.TLChannelGetChannelListByIdList) (*channel.Vector_Chat, error) {
+	client := channel.NewRPCChannelClient(m.cli.Conn())
+	return client.ChannelGetChannelListByIdList(ctx, in)
+}
+
+// ChannelGetChannelParticipantIdList
+// channel.getChannelParticipantIdList channel_id:long = Vector<long>;
+func (m *defaultChannelClient) ChannelGetChannelParticipantIdList(ctx context.Context, in *channel.TLChannelGetChannelParticipantIdList) (*channel.Vector_Long, error) {
+	client := channel.NewRPCChannelClient(m.cli.Conn())
+	return client.ChannelGetChannelParticipantIdList(ctx, in)
+}
+
+// ChannelCreateChannel
+// channel.createChannel flags:# creator_id:long broadcast:flags.0?true megagroup:flags.1?true title:string about:string = MutableChannel;
+func (m *defaultChannelClient) ChannelCreateChannel(ctx context.Context, in *channel.TLChannelCreateChannel) (*channel.MutableChannel, error) {
+	client := channel.NewRPCChannelClient(m.cli.Conn())
+	return client.ChannelCreateChannel(ctx, in)
+}
+
+// ChannelJoinChannel
+// channel.joinChannel channel_id:long user_id:long = MutableChannel;
+func (m *defaultChannelClient) ChannelJoinChannel(ctx context.Context, in *channel.TLChannelJoinChannel) (*channel.MutableChannel, error) {
+	client := channel.NewRPCChannelClient(m.cli.Conn())
+	return client.ChannelJoinChannel(ctx, in)
+}
+
+// ChannelLeaveChannel
+// channel.leaveChannel channel_id:long user_id:long = MutableChannel;
+func (m *defaultChannelClient) ChannelLeaveChannel(ctx context.Context, in *channel.TLChannelLeaveChannel) (*channel.MutableChannel, error) {
+	client := channel.NewRPCChannelClient(m.cli.Conn())
+	return client.ChannelLeaveChannel(ctx, in)
+}
+
+// ChannelInviteToChannel
+// channel.inviteToChannel channel_id:long inviter_id:long id:Vector<long> = MutableChannel;
+func (m *defaultChannelClient) ChannelInviteToChannel(ctx context.Context, in *channel.TLChannelInviteToChannel) (*channel.MutableChannel, error) {
+	client := channel.NewRPCChannelClient(m.cli.Conn())
+	return client.ChannelInviteToChannel(ctx, in)
+}
+
+// ChannelEditAdmin
+// channel.editAdmin channel_id:long operator_id:long user_id:long admin_rights:ChatAdminRights rank:string = MutableChannel;
+func (m *defaultChannelClient) ChannelEditAdmin(ctx context.Context, in *channel.TLChannelEditAdmin) (*channel.MutableChannel, error) {
+	client := channel.NewRPCChannelClient(m.cli.Conn())
+	return client.ChannelEditAdmin(ctx, in)
+}
+
+// ChannelEditBanned
+// channel.editBanned channel_id:long operator_id:long user_id:long banned_rights:ChatBannedRights = MutableChannel;
+func (m *defaultChannelClient) ChannelEditBanned(ctx context.Context, in *channel.TLChannelEditBanned) (*channel.MutableChannel, error) {
+	client := channel.NewRPCChannelClient(m.cli.Conn())
+	return client.ChannelEditBanned(ctx, in)
+}
+
+// ChannelGetChannelParticipants
+// channel.getChannelParticipants channel_id:long filter:ChannelParticipantsFilter offset:int limit:int = ChannelParticipants;
+func (m *defaultChannelClient) ChannelGetChannelParticipants(ctx context.Context, in *channel.TLChannelGetChannelParticipants) (*channel.ChannelParticipants, error) {
+	client := channel.NewRPCChannelClient(m.cli.Conn())
+	return client.ChannelGetChannelParticipants(ctx, in)
+}
+
+// ChannelSendMessage
+// channel.sendMessage user_id:long channel_id:long random_id:long message:Message = MessageBox;
+func (m *defaultChannelClient) ChannelSendMessage(ctx context.Context, in *channel.TLChannelSendMessage) (*mtproto.MessageBox, error) {
+	client := channel.NewRPCChannelClient(m.cli.Conn())
+	return client.ChannelSendMessage(ctx, in)
+}
+
+// ChannelGetHistory
+// channel.getHistory user_id:long channel_id:long offset_id:int add_offset:int limit:int max_id:int min_id:int = Vector<MessageBox>;
+func (m *defaultChannelClient) ChannelGetHistory(ctx context.Context, in *channel.TLChannelGetHistory) (*channel.Vector_MessageBox, error) {
+	client := channel.NewRPCChannelClient(m.cli.Conn())
+	return client.ChannelGetHistory(ctx, in)
+}
+
+// ChannelGetMessages
+// channel.getMessages user_id:long channel_id:long id:Vector<int> = Vector<MessageBox>;
+func (m *defaultChannelClient) ChannelGetMessages(ctx context.Context, in *channel.TLChannelGetMessages) (*channel.Vector_MessageBox, error) {
+	client := channel.NewRPCChannelClient(m.cli.Conn())
+	return client.ChannelGetMessages(ctx, in)
+}
+
+// ChannelReadHistory
+// channel.readHistory user_id:long channel_id:long max_id:int = Bool;
+func (m *defaultChannelClient) ChannelReadHistory(ctx context.Context, in *channel.TLChannelReadHistory) (*mtproto.Bool, error) {
+	client := channel.NewRPCChannelClient(m.cli.Conn())
+	return client.ChannelReadHistory(ctx, in)
+}
+
+// ChannelGetChannelDifference
+// channel.getChannelDifference user_id:long channel_id:long pts:int limit:int = ChannelDifference;
+func (m *defaultChannelClient) ChannelGetChannelDifference(ctx context.Context, in *channel.TLChannelGetChannelDifference) (*channel.ChannelDifference, error) {
+	client := channel.NewRPCChannelClient(m.cli.Conn())
+	return client.ChannelGetChannelDifference(ctx, in)
+}
+
+// ChannelEditTitle
+// channel.editTitle channel_id:long operator_id:long title:string = MutableChannel;
+func (m *defaultChannelClient) ChannelEditTitle(ctx context.Context, in *channel.TLChannelEditTitle) (*channel.MutableChannel, error) {
+	client := channel.NewRPCChannelClient(m.cli.Conn())
+	return client.ChannelEditTitle(ctx, in)
+}
+
+// ChannelEditPhoto
+// channel.editPhoto channel_id:long operator_id:long photo:Photo = MutableChannel;
+func (m *defaultChannelClient) ChannelEditPhoto(ctx context.Context, in *channel.TLChannelEditPhoto) (*channel.MutableChannel, error) {
+	client := channel.NewRPCChannelClient(m.cli.Conn())
+	return client.ChannelEditPhoto(ctx, in)
+}
+
+// ChannelToggleSignatures
+// channel.toggleSignatures channel_id:long operator_id:long enabled:Bool = MutableChannel;
+func (m *defaultChannelClient) ChannelToggleSignatures(ctx context.Context, in *channel.TLChannelToggleSignatures) (*channel.MutableChannel, error) {
+	client := channel.NewRPCChannelClient(m.cli.Conn())
+	return client.ChannelToggleSignatures(ctx, in)
+}
+
+// ChannelTogglePreHistoryHidden
+// channel.togglePreHistoryHidden channel_id:long operator_id:long enabled:Bool = MutableChannel;
+func (m *defaultChannelClient) ChannelTogglePreHistoryHidden(ctx context.Context, in *channel.TLChannelTogglePreHistoryHidden) (*channel.MutableChannel, error) {
+	client := channel.NewRPCChannelClient(m.cli.Conn())
+	return client.ChannelTogglePreHistoryHidden(ctx, in)
+}
+
+// ChannelToggleSlowMode
+// channel.toggleSlowMode channel_id:long operator_id:long seconds:int = MutableChannel;
+func (m *defaultChannelClient) ChannelToggleSlowMode(ctx context.Context, in *channel.TLChannelToggleSlowMode) (*channel.MutableChannel, error) {
+	client := channel.NewRPCChannelClient(m.cli.Conn())
+	return client.ChannelToggleSlowMode(ctx, in)
+}
+
+// ChannelDeleteChannel
+// channel.deleteChannel channel_id:long operator_id:long = MutableChannel;
+func (m *defaultChannelClient) ChannelDeleteChannel(ctx context.Context, in *channel.TLChannelDeleteChannel) (*channel.MutableChannel, error) {
+	client := channel.NewRPCChannelClient(m.cli.Conn())
+	return client.ChannelDeleteChannel(ctx, in)
+}
+
+// ChannelDeleteMessages
+// channel.deleteMessages user_id:long channel_id:long id:Vector<int> = ChannelDeletedMessages;
+func (m *defaultChannelClient) ChannelDeleteMessages(ctx context.Context, in *channel.TLChannelDeleteMessages) (*channel.ChannelDeletedMessages, error) {
+	client := channel.NewRPCChannelClient(m.cli.Conn())
+	return client.ChannelDeleteMessages(ctx, in)
+}
+
+// ChannelDeleteParticipantHistory
+// channel.deleteParticipantHistory operator_id:long channel_id:long participant_id:long limit:int = ChannelDeletedMessages;
+func (m *defaultChannelClient) ChannelDeleteParticipantHistory(ctx context.Context, in *channel.TLChannelDeleteParticipantHistory) (*channel.ChannelDeletedMessages, error) {
+	client := channel.NewRPCChannelClient(m.cli.Conn())
+	return client.ChannelDeleteParticipantHistory(ctx, in)
+}
+
+// ChannelDeleteHistory
+// channel.deleteHistory flags:# user_id:long channel_id:long for_everyone:flags.0?true max_id:int = MutableChannel;
+func (m *defaultChannelClient) ChannelDeleteHistory(ctx context.Context, in *channel.TLChannelDeleteHistory) (*channel.MutableChannel, error) {
+	client := channel.NewRPCChannelClient(m.cli.Conn())
+	return client.ChannelDeleteHistory(ctx, in)
+}
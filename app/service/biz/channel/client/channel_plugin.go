@@ -0,0 +1,118 @@
+// Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+//  All rights reserved.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package channel_client
+
+import (
+	"context"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/biz/channel/channel"
+	"github.com/teamgram/teamgram-server/app/service/biz/dialog/dialog"
+
+	"github.com/zeromicro/go-zero/zrpc"
+)
+
+// ChannelPlugin implements the channel hooks of the bff plugin interfaces
+// (contacts, dialogs, drafts, notification and usernames).
+type ChannelPlugin struct {
+	cli ChannelClient
+}
+
+func NewChannelPlugin(cli zrpc.Client) *ChannelPlugin {
+	return &ChannelPlugin{
+		cli: NewChannelClient(cli),
+	}
+}
+
+func (m *ChannelPlugin) Client() ChannelClient {
+	return m.cli
+}
+
+func (m *ChannelPlugin) GetChannelListByIdList(ctx context.Context, selfId int64, id ...int64) []*mtproto.Chat {
+	if len(id) == 0 {
+		return []*mtproto.Chat{}
+	}
+
+	chats, err := m.cli.ChannelGetChannelListByIdList(ctx, &channel.TLChannelGetChannelListByIdList{
+		SelfUserId: selfId,
+		Id:         id,
+	})
+	if err != nil {
+		return []*mtproto.Chat{}
+	}
+
+	return chats.GetDatas()
+}
+
+func (m *ChannelPlugin) GetChannelById(ctx context.Context, selfId int64, id int64) (*mtproto.Chat, error) {
+	mChannel, err := m.cli.ChannelGetMutableChannel(ctx, &channel.TLChannelGetMutableChannel{
+		ChannelId: id,
+		Id:        []int64{selfId},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return mChannel.ToUnsafeChat(selfId), nil
+}
+
+func (m *ChannelPlugin) GetChannelDialogById(ctx context.Context, selfId int64, id int64) (*dialog.DialogExt, error) {
+	mChannel, err := m.cli.ChannelGetMutableChannel(ctx, &channel.TLChannelGetMutableChannel{
+		ChannelId: id,
+		Id:        []int64{selfId},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	me, ok := mChannel.GetImmutableChannelParticipant(selfId)
+	if !ok || !me.IsChatMemberStateNormal() {
+		return nil, mtproto.ErrChannelPrivate
+	}
+
+	dialogExt := &dialog.DialogExt{
+		Order: int64(mChannel.Date()),
+		Dialog: mtproto.MakeTLDialog(&mtproto.Dialog{
+			Peer:           mtproto.MakePeerChannel(id),
+			TopMessage:     mChannel.TopMessage(),
+			ReadInboxMaxId: me.ReadInboxMaxId,
+			UnreadCount:    mChannel.TopMessage() - me.ReadInboxMaxId,
+			Pts:            mtproto.MakeFlagsInt32(mChannel.Pts()),
+			NotifySettings: mtproto.MakeTLPeerNotifySettings(nil).To_PeerNotifySettings(),
+		}).To_Dialog(),
+		AvailableMinId: me.AvailableMinId,
+		Date:           int64(mChannel.Date()),
+	}
+	if dialogExt.Dialog.UnreadCount < 0 {
+		dialogExt.Dialog.UnreadCount = 0
+	}
+
+	// order by the date of the top message
+	if mChannel.TopMessage() > 0 {
+		if box, _ := m.GetChannelMessage(ctx, selfId, id, mChannel.TopMessage()); box != nil {
+			dialogExt.Order = int64(box.GetMessage().GetDate())
+			dialogExt.Date = dialogExt.Order
+		}
+	}
+
+	return dialogExt, nil
+}
+
+func (m *ChannelPlugin) GetChannelMessage(ctx context.Context, selfId, channelId int64, id int32) (*mtproto.MessageBox, error) {
+	boxList, err := m.cli.ChannelGetMessages(ctx, &channel.TLChannelGetMessages{
+		UserId:    selfId,
+		ChannelId: channelId,
+		Id:        []int32{id},
+	})
+	if err != nil {
+		return nil, err
+	} else if len(boxList.GetDatas()) == 0 {
+		return nil, mtproto.ErrMessageIdInvalid
+	}
+
+	return boxList.GetDatas()[0], nil
+}
@@ -0,0 +1,82 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"math/rand"
+	"time"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/biz/channel/channel"
+	"github.com/teamgram/teamgram-server/app/service/biz/channel/internal/dal/dataobject"
+)
+
+// ChannelCreateChannel
+// channel.createChannel flags:# creator_id:long broadcast:flags.0?true megagroup:flags.1?true title:string about:string = MutableChannel;
+func (c *ChannelCore) ChannelCreateChannel(in *channel.TLChannelCreateChannel) (*channel.MutableChannel, error) {
+	if in.Title == "" {
+		err := mtproto.ErrChatTitleEmpty
+		c.Logger.Errorf("channel.createChannel - error: %v", err)
+		return nil, err
+	}
+
+	var (
+		now       = time.Now().Unix()
+		megagroup = in.Megagroup
+		broadcast = in.Broadcast || !megagroup
+	)
+
+	channelsDO := &dataobject.ChannelsDO{
+		CreatorUserId:     in.CreatorId,
+		AccessHash:        rand.Int63(),
+		RandomId:          rand.Int63(),
+		Broadcast:         broadcast,
+		Megagroup:         megagroup && !broadcast,
+		Title:             in.Title,
+		About:             in.About,
+		ParticipantsCount: 1,
+		Date2:             now,
+	}
+	channelId, _, err := c.svcCtx.Dao.ChannelsDAO.Insert(c.ctx, channelsDO)
+	if err != nil {
+		c.Logger.Errorf("channel.createChannel - error: %v", err)
+		return nil, mtproto.ErrInternelServerError
+	}
+
+	_, _, err = c.svcCtx.Dao.ChannelParticipantsDAO.InsertOrUpdate(c.ctx, &dataobject.ChannelParticipantsDO{
+		ChannelId:       channelId,
+		UserId:          in.CreatorId,
+		ParticipantType: mtproto.ChatMemberCreator,
+		AdminRights:     int32(mtproto.MakeChatAdminRightsHelper(makeCreatorAdminRights(broadcast))),
+		Date2:           now,
+	})
+	if err != nil {
+		c.Logger.Errorf("channel.createChannel - error: %v", err)
+		return nil, mtproto.ErrInternelServerError
+	}
+
+	return c.svcCtx.Dao.GetMutableChannel(c.ctx, channelId, in.CreatorId)
+}
+
+func makeCreatorAdminRights(broadcast bool) *mtproto.ChatAdminRights {
+	return mtproto.MakeTLChatAdminRights(&mtproto.ChatAdminRights{
+		ChangeInfo:     true,
+		PostMessages:   broadcast,
+		EditMessages:   broadcast,
+		DeleteMessages: true,
+		BanUsers:       true,
+		InviteUsers:    true,
+		PinMessages:    true,
+		AddAdmins:      true,
+		Anonymous:      false,
+		ManageCall:     true,
+		Other:          true,
+	}).To_ChatAdminRights()
+}
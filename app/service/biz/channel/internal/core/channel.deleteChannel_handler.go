@@ -0,0 +1,46 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/biz/channel/channel"
+)
+
+// ChannelDeleteChannel
+// channel.deleteChannel channel_id:long operator_id:long = MutableChannel;
+func (c *ChannelCore) ChannelDeleteChannel(in *channel.TLChannelDeleteChannel) (*channel.MutableChannel, error) {
+	mChannel, err := c.svcCtx.Dao.GetMutableChannel(c.ctx, in.ChannelId, in.OperatorId)
+	if err != nil {
+		c.Logger.Errorf("channel.deleteChannel - error: %v", err)
+		return nil, err
+	}
+
+	if mChannel.Deactivated() {
+		err = mtproto.ErrChannelPrivate
+		c.Logger.Errorf("channel.deleteChannel - error: %v", err)
+		return nil, err
+	}
+
+	operator, ok := mChannel.GetImmutableChannelParticipant(in.OperatorId)
+	if !ok || !operator.IsChatMemberStateNormal() || !operator.IsChatMemberCreator() {
+		err = mtproto.ErrChatAdminRequired
+		c.Logger.Errorf("channel.deleteChannel - error: %v", err)
+		return nil, err
+	}
+
+	// the participants are kept, they see the channel as channelForbidden
+	if _, err = c.svcCtx.Dao.ChannelsDAO.UpdateDeactivated(c.ctx, true, in.ChannelId); err != nil {
+		c.Logger.Errorf("channel.deleteChannel - error: %v", err)
+		return nil, mtproto.ErrInternelServerError
+	}
+
+	return c.svcCtx.Dao.GetMutableChannel(c.ctx, in.ChannelId, in.OperatorId)
+}
@@ -0,0 +1,57 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/biz/channel/channel"
+)
+
+// ChannelDeleteHistory
+// channel.deleteHistory flags:# user_id:long channel_id:long for_everyone:flags.0?true max_id:int = MutableChannel;
+func (c *ChannelCore) ChannelDeleteHistory(in *channel.TLChannelDeleteHistory) (*channel.MutableChannel, error) {
+	mChannel, err := c.svcCtx.Dao.GetMutableChannel(c.ctx, in.ChannelId, in.UserId)
+	if err != nil {
+		c.Logger.Errorf("channel.deleteHistory - error: %v", err)
+		return nil, err
+	}
+
+	me, ok := mChannel.GetImmutableChannelParticipant(in.UserId)
+	if mChannel.Deactivated() || !ok || !me.IsChatMemberStateNormal() {
+		err = mtproto.ErrChannelPrivate
+		c.Logger.Errorf("channel.deleteHistory - error: %v", err)
+		return nil, err
+	}
+
+	maxId := in.MaxId
+	if maxId == 0 || maxId > mChannel.TopMessage() {
+		maxId = mChannel.TopMessage()
+	}
+
+	if in.ForEveryone {
+		if !mChannel.Megagroup() || !me.CanDeleteMessages() {
+			err = mtproto.ErrChatAdminRequired
+			c.Logger.Errorf("channel.deleteHistory - error: %v", err)
+			return nil, err
+		}
+		// the messages are gone for everyone, also for who joins later
+		if _, err = c.svcCtx.Dao.ChannelMessagesDAO.DeleteHistory(c.ctx, in.ChannelId, maxId); err != nil {
+			c.Logger.Errorf("channel.deleteHistory - error: %v", err)
+			return nil, mtproto.ErrInternelServerError
+		}
+	}
+
+	if _, err = c.svcCtx.Dao.ChannelParticipantsDAO.UpdateAvailableMinId(c.ctx, maxId, in.ChannelId, in.UserId); err != nil {
+		c.Logger.Errorf("channel.deleteHistory - error: %v", err)
+		return nil, mtproto.ErrInternelServerError
+	}
+
+	return c.svcCtx.Dao.GetMutableChannel(c.ctx, in.ChannelId, in.UserId)
+}
@@ -0,0 +1,69 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/biz/channel/channel"
+	"github.com/teamgram/teamgram-server/app/service/biz/channel/internal/dal/dataobject"
+)
+
+// ChannelDeleteMessages
+// channel.deleteMessages user_id:long channel_id:long id:Vector<int> = ChannelDeletedMessages;
+func (c *ChannelCore) ChannelDeleteMessages(in *channel.TLChannelDeleteMessages) (*channel.ChannelDeletedMessages, error) {
+	mChannel, err := c.svcCtx.Dao.GetMutableChannel(c.ctx, in.ChannelId, in.UserId)
+	if err != nil {
+		c.Logger.Errorf("channel.deleteMessages - error: %v", err)
+		return nil, err
+	}
+
+	me, ok := mChannel.GetImmutableChannelParticipant(in.UserId)
+	if mChannel.Deactivated() || !ok || !me.IsChatMemberStateNormal() {
+		err = mtproto.ErrChannelPrivate
+		c.Logger.Errorf("channel.deleteMessages - error: %v", err)
+		return nil, err
+	}
+
+	idList := make([]int32, 0, len(in.Id))
+	if len(in.Id) > 0 {
+		c.svcCtx.Dao.ChannelMessagesDAO.SelectByMessageIdListWithCB(
+			c.ctx,
+			in.ChannelId,
+			in.Id,
+			func(i int, v *dataobject.ChannelMessagesDO) {
+				if v.SenderUserId != in.UserId && !me.CanDeleteMessages() {
+					err = mtproto.ErrMessageDeleteForbidden
+				}
+				idList = append(idList, v.ChannelMessageId)
+			})
+	}
+	if err != nil {
+		c.Logger.Errorf("channel.deleteMessages - error: %v", err)
+		return nil, err
+	}
+
+	deleted := channel.MakeTLChannelDeletedMessages(&channel.ChannelDeletedMessages{
+		Pts:      mChannel.Pts(),
+		PtsCount: 0,
+		Id:       idList,
+	}).To_ChannelDeletedMessages()
+	if len(idList) == 0 {
+		return deleted, nil
+	}
+
+	deleted.Pts, err = c.svcCtx.Dao.DeleteChannelMessages(c.ctx, in.ChannelId, idList)
+	if err != nil {
+		c.Logger.Errorf("channel.deleteMessages - error: %v", err)
+		return nil, err
+	}
+	deleted.PtsCount = int32(len(idList))
+
+	return deleted, nil
+}
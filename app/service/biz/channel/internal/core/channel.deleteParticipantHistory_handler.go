@@ -0,0 +1,70 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/biz/channel/channel"
+)
+
+// ChannelDeleteParticipantHistory
+// channel.deleteParticipantHistory operator_id:long channel_id:long participant_id:long limit:int = ChannelDeletedMessages;
+func (c *ChannelCore) ChannelDeleteParticipantHistory(in *channel.TLChannelDeleteParticipantHistory) (*channel.ChannelDeletedMessages, error) {
+	mChannel, err := c.svcCtx.Dao.GetMutableChannel(c.ctx, in.ChannelId, in.OperatorId)
+	if err != nil {
+		c.Logger.Errorf("channel.deleteParticipantHistory - error: %v", err)
+		return nil, err
+	}
+
+	operator, ok := mChannel.GetImmutableChannelParticipant(in.OperatorId)
+	if mChannel.Deactivated() || !ok || !operator.IsChatMemberStateNormal() {
+		err = mtproto.ErrChannelPrivate
+		c.Logger.Errorf("channel.deleteParticipantHistory - error: %v", err)
+		return nil, err
+	} else if !mChannel.Megagroup() {
+		err = mtproto.ErrChannelInvalid
+		c.Logger.Errorf("channel.deleteParticipantHistory - error: %v", err)
+		return nil, err
+	} else if !operator.CanDeleteMessages() {
+		err = mtproto.ErrChatAdminRequired
+		c.Logger.Errorf("channel.deleteParticipantHistory - error: %v", err)
+		return nil, err
+	}
+
+	limit := in.Limit
+	if limit <= 0 || limit > 100 {
+		limit = 100
+	}
+
+	idList, err := c.svcCtx.Dao.ChannelMessagesDAO.SelectIdListBySender(c.ctx, in.ChannelId, in.ParticipantId, limit)
+	if err != nil {
+		c.Logger.Errorf("channel.deleteParticipantHistory - error: %v", err)
+		return nil, mtproto.ErrInternelServerError
+	}
+
+	deleted := channel.MakeTLChannelDeletedMessages(&channel.ChannelDeletedMessages{
+		Pts:      mChannel.Pts(),
+		PtsCount: 0,
+		Id:       idList,
+	}).To_ChannelDeletedMessages()
+	if len(idList) == 0 {
+		deleted.Id = []int32{}
+		return deleted, nil
+	}
+
+	deleted.Pts, err = c.svcCtx.Dao.DeleteChannelMessages(c.ctx, in.ChannelId, idList)
+	if err != nil {
+		c.Logger.Errorf("channel.deleteParticipantHistory - error: %v", err)
+		return nil, err
+	}
+	deleted.PtsCount = int32(len(idList))
+
+	return deleted, nil
+}
@@ -0,0 +1,70 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/biz/channel/channel"
+)
+
+// ChannelEditAdmin
+// channel.editAdmin channel_id:long operator_id:long user_id:long admin_rights:ChatAdminRights rank:string = MutableChannel;
+func (c *ChannelCore) ChannelEditAdmin(in *channel.TLChannelEditAdmin) (*channel.MutableChannel, error) {
+	mChannel, err := c.svcCtx.Dao.GetMutableChannel(c.ctx, in.ChannelId, in.OperatorId, in.UserId)
+	if err != nil {
+		c.Logger.Errorf("channel.editAdmin - error: %v", err)
+		return nil, err
+	}
+
+	operator, ok := mChannel.GetImmutableChannelParticipant(in.OperatorId)
+	if !ok || !operator.IsChatMemberStateNormal() || !operator.CanAddAdmins() {
+		err = mtproto.ErrChatAdminRequired
+		c.Logger.Errorf("channel.editAdmin - error: %v", err)
+		return nil, err
+	}
+
+	participant, ok := mChannel.GetImmutableChannelParticipant(in.UserId)
+	if !ok || !participant.IsChatMemberStateNormal() {
+		err = mtproto.ErrUserNotParticipant
+		c.Logger.Errorf("channel.editAdmin - error: %v", err)
+		return nil, err
+	} else if participant.IsChatMemberCreator() {
+		err = mtproto.ErrUserCreator
+		c.Logger.Errorf("channel.editAdmin - error: %v", err)
+		return nil, err
+	}
+
+	var (
+		participantType = int32(mtproto.ChatMemberAdmin)
+		adminRights     = mtproto.MakeChatAdminRightsHelper(in.AdminRights)
+		rank            = in.Rank
+	)
+
+	if !adminRights.HasAdminRights() {
+		participantType = mtproto.ChatMemberNormal
+		adminRights = 0
+		rank = ""
+	}
+
+	_, err = c.svcCtx.Dao.ChannelParticipantsDAO.UpdateAdmin(
+		c.ctx,
+		participantType,
+		int32(adminRights),
+		rank,
+		in.OperatorId,
+		in.ChannelId,
+		in.UserId)
+	if err != nil {
+		c.Logger.Errorf("channel.editAdmin - error: %v", err)
+		return nil, mtproto.ErrInternelServerError
+	}
+
+	return c.svcCtx.Dao.GetMutableChannel(c.ctx, in.ChannelId, in.OperatorId, in.UserId)
+}
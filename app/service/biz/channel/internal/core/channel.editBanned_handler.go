@@ -0,0 +1,63 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"math"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/biz/channel/channel"
+)
+
+// ChannelEditBanned
+// channel.editBanned channel_id:long operator_id:long user_id:long banned_rights:ChatBannedRights = MutableChannel;
+func (c *ChannelCore) ChannelEditBanned(in *channel.TLChannelEditBanned) (*channel.MutableChannel, error) {
+	mChannel, err := c.svcCtx.Dao.GetMutableChannel(c.ctx, in.ChannelId, in.OperatorId, in.UserId)
+	if err != nil {
+		c.Logger.Errorf("channel.editBanned - error: %v", err)
+		return nil, err
+	}
+
+	operator, ok := mChannel.GetImmutableChannelParticipant(in.OperatorId)
+	if !ok || !operator.IsChatMemberStateNormal() || !operator.CanBanUsers() {
+		err = mtproto.ErrChatAdminRequired
+		c.Logger.Errorf("channel.editBanned - error: %v", err)
+		return nil, err
+	}
+
+	participant, ok := mChannel.GetImmutableChannelParticipant(in.UserId)
+	if !ok {
+		err = mtproto.ErrUserNotParticipant
+		c.Logger.Errorf("channel.editBanned - error: %v", err)
+		return nil, err
+	} else if participant.IsChatMemberCreator() {
+		err = mtproto.ErrUserCreator
+		c.Logger.Errorf("channel.editBanned - error: %v", err)
+		return nil, err
+	}
+
+	bannedRights := in.GetBannedRights().ToBannedRights()
+	switch {
+	case in.GetBannedRights().GetViewMessages():
+		_, err = c.svcCtx.Dao.ChannelParticipantsDAO.UpdateKicked(c.ctx, int64(bannedRights), in.OperatorId, in.ChannelId, in.UserId)
+	case int64(bannedRights)&math.MaxUint32 == 0:
+		// all rights are restored
+		_, err = c.svcCtx.Dao.ChannelParticipantsDAO.UpdateBanned(c.ctx, mtproto.ChatMemberNormal, 0, 0, in.ChannelId, in.UserId)
+	default:
+		_, err = c.svcCtx.Dao.ChannelParticipantsDAO.UpdateBanned(c.ctx, mtproto.ChatMemberBanned, int64(bannedRights), in.OperatorId, in.ChannelId, in.UserId)
+	}
+	if err != nil {
+		c.Logger.Errorf("channel.editBanned - error: %v", err)
+		return nil, mtproto.ErrInternelServerError
+	}
+	c.svcCtx.Dao.UpdateParticipantsCount(c.ctx, in.ChannelId)
+
+	return c.svcCtx.Dao.GetMutableChannel(c.ctx, in.ChannelId, in.OperatorId, in.UserId)
+}
@@ -0,0 +1,44 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/biz/channel/channel"
+)
+
+// ChannelEditPhoto
+// channel.editPhoto channel_id:long operator_id:long photo:Photo = MutableChannel;
+func (c *ChannelCore) ChannelEditPhoto(in *channel.TLChannelEditPhoto) (*channel.MutableChannel, error) {
+	mChannel, err := c.svcCtx.Dao.GetMutableChannel(c.ctx, in.ChannelId, in.OperatorId)
+	if err != nil {
+		c.Logger.Errorf("channel.editPhoto - error: %v", err)
+		return nil, err
+	}
+
+	operator, ok := mChannel.GetImmutableChannelParticipant(in.OperatorId)
+	if mChannel.Deactivated() || !ok || !operator.IsChatMemberStateNormal() {
+		err = mtproto.ErrChannelPrivate
+		c.Logger.Errorf("channel.editPhoto - error: %v", err)
+		return nil, err
+	} else if !operator.CanChangeInfo() {
+		err = mtproto.ErrChatAdminRequired
+		c.Logger.Errorf("channel.editPhoto - error: %v", err)
+		return nil, err
+	}
+
+	// photoEmpty has the id 0, which removes the photo
+	if _, err = c.svcCtx.Dao.ChannelsDAO.UpdatePhotoId(c.ctx, in.GetPhoto().GetId(), in.ChannelId); err != nil {
+		c.Logger.Errorf("channel.editPhoto - error: %v", err)
+		return nil, mtproto.ErrInternelServerError
+	}
+
+	return c.svcCtx.Dao.GetMutableChannel(c.ctx, in.ChannelId, in.OperatorId)
+}
@@ -0,0 +1,55 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/biz/channel/channel"
+)
+
+// ChannelEditTitle
+// channel.editTitle channel_id:long operator_id:long title:string = MutableChannel;
+func (c *ChannelCore) ChannelEditTitle(in *channel.TLChannelEditTitle) (*channel.MutableChannel, error) {
+	if in.Title == "" {
+		err := mtproto.ErrChatTitleEmpty
+		c.Logger.Errorf("channel.editTitle - error: %v", err)
+		return nil, err
+	}
+
+	mChannel, err := c.svcCtx.Dao.GetMutableChannel(c.ctx, in.ChannelId, in.OperatorId)
+	if err != nil {
+		c.Logger.Errorf("channel.editTitle - error: %v", err)
+		return nil, err
+	}
+
+	operator, ok := mChannel.GetImmutableChannelParticipant(in.OperatorId)
+	if mChannel.Deactivated() || !ok || !operator.IsChatMemberStateNormal() {
+		err = mtproto.ErrChannelPrivate
+		c.Logger.Errorf("channel.editTitle - error: %v", err)
+		return nil, err
+	} else if !operator.CanChangeInfo() {
+		err = mtproto.ErrChatAdminRequired
+		c.Logger.Errorf("channel.editTitle - error: %v", err)
+		return nil, err
+	}
+
+	if mChannel.Title() == in.Title {
+		err = mtproto.ErrChatNotModified
+		c.Logger.Errorf("channel.editTitle - error: %v", err)
+		return nil, err
+	}
+
+	if _, err = c.svcCtx.Dao.ChannelsDAO.UpdateTitle(c.ctx, in.Title, in.ChannelId); err != nil {
+		c.Logger.Errorf("channel.editTitle - error: %v", err)
+		return nil, mtproto.ErrInternelServerError
+	}
+
+	return c.svcCtx.Dao.GetMutableChannel(c.ctx, in.ChannelId, in.OperatorId)
+}
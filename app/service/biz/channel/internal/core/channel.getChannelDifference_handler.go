@@ -0,0 +1,62 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/biz/channel/channel"
+)
+
+// ChannelGetChannelDifference
+// channel.getChannelDifference user_id:long channel_id:long pts:int limit:int = ChannelDifference;
+func (c *ChannelCore) ChannelGetChannelDifference(in *channel.TLChannelGetChannelDifference) (*channel.ChannelDifference, error) {
+	mChannel, err := c.svcCtx.Dao.GetMutableChannel(c.ctx, in.ChannelId, in.UserId)
+	if err != nil {
+		c.Logger.Errorf("channel.getChannelDifference - error: %v", err)
+		return nil, err
+	}
+
+	me, ok := mChannel.GetImmutableChannelParticipant(in.UserId)
+	if !ok || me.IsChatMemberStateKicked() {
+		err = mtproto.ErrChannelPrivate
+		c.Logger.Errorf("channel.getChannelDifference - error: %v", err)
+		return nil, err
+	}
+
+	limit := in.Limit
+	if limit <= 0 || limit > 100 {
+		limit = 100
+	}
+
+	doList, err := c.svcCtx.Dao.ChannelMessagesDAO.SelectByPts(c.ctx, in.ChannelId, in.Pts, limit)
+	if err != nil {
+		c.Logger.Errorf("channel.getChannelDifference - error: %v", err)
+		return nil, mtproto.ErrInternelServerError
+	}
+
+	difference := channel.MakeTLChannelDifference(&channel.ChannelDifference{
+		Final:       true,
+		Pts:         mChannel.Pts(),
+		NewMessages: make([]*mtproto.MessageBox, 0, len(doList)),
+	}).To_ChannelDifference()
+
+	for i := 0; i < len(doList); i++ {
+		if doList[i].ChannelMessageId <= me.AvailableMinId {
+			continue
+		}
+		difference.NewMessages = append(difference.NewMessages, c.svcCtx.Dao.MakeChannelMessageBox(&doList[i]))
+	}
+	if len(doList) == int(limit) {
+		difference.Final = false
+		difference.Pts = doList[len(doList)-1].Pts
+	}
+
+	return difference, nil
+}
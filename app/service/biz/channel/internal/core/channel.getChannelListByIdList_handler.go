@@ -0,0 +1,49 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/biz/channel/channel"
+	"github.com/teamgram/teamgram-server/app/service/biz/channel/internal/dal/dataobject"
+)
+
+// ChannelGetChannelListByIdList
+// channel.getChannelListByIdList self_user_id:long id:Vector<long> = Vector<Chat>;
+func (c *ChannelCore) ChannelGetChannelListByIdList(in *channel.TLChannelGetChannelListByIdList) (*channel.Vector_Chat, error) {
+	rValues := &channel.Vector_Chat{
+		Datas: make([]*mtproto.Chat, 0, len(in.Id)),
+	}
+
+	if len(in.Id) == 0 {
+		return rValues, nil
+	}
+
+	c.svcCtx.Dao.ChannelsDAO.SelectByIdListWithCB(
+		c.ctx,
+		in.Id,
+		func(i int, v *dataobject.ChannelsDO) {
+			mChannel := channel.MakeTLMutableChannel(&channel.MutableChannel{
+				Channel:      c.svcCtx.Dao.MakeImmutableChannelByDOWithPhoto(c.ctx, v),
+				Participants: []*channel.ImmutableChannelParticipant{},
+			}).To_MutableChannel()
+
+			if in.SelfUserId != 0 {
+				participantDO, _ := c.svcCtx.Dao.ChannelParticipantsDAO.SelectByUserId(c.ctx, v.Id, in.SelfUserId)
+				if participantDO != nil {
+					mChannel.Participants = append(mChannel.Participants, c.svcCtx.Dao.MakeImmutableChannelParticipant(participantDO))
+				}
+			}
+
+			rValues.Datas = append(rValues.Datas, mChannel.ToUnsafeChat(in.SelfUserId))
+		})
+
+	return rValues, nil
+}
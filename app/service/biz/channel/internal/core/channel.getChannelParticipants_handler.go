@@ -0,0 +1,66 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/biz/channel/channel"
+	"github.com/teamgram/teamgram-server/app/service/biz/channel/internal/dal/dataobject"
+)
+
+// ChannelGetChannelParticipants
+// channel.getChannelParticipants channel_id:long filter:ChannelParticipantsFilter offset:int limit:int = ChannelParticipants;
+func (c *ChannelCore) ChannelGetChannelParticipants(in *channel.TLChannelGetChannelParticipants) (*channel.ChannelParticipants, error) {
+	var (
+		doList []dataobject.ChannelParticipantsDO
+		count  int32
+		err    error
+		limit  = in.Limit
+	)
+
+	if limit <= 0 || limit > 200 {
+		limit = 200
+	}
+
+	switch in.GetFilter().GetPredicateName() {
+	case mtproto.Predicate_channelParticipantsAdmins:
+		doList, err = c.svcCtx.Dao.ChannelParticipantsDAO.SelectAdminList(c.ctx, in.ChannelId)
+		count = int32(len(doList))
+	case mtproto.Predicate_channelParticipantsKicked:
+		doList, err = c.svcCtx.Dao.ChannelParticipantsDAO.SelectKickedList(c.ctx, in.ChannelId)
+		count = int32(len(doList))
+	case mtproto.Predicate_channelParticipantsBanned:
+		doList, err = c.svcCtx.Dao.ChannelParticipantsDAO.SelectBannedList(c.ctx, in.ChannelId)
+		count = int32(len(doList))
+	default:
+		// channelParticipantsRecent and the unsupported filters
+		var channel2 *channel.ImmutableChannel
+		if channel2, err = c.svcCtx.Dao.GetImmutableChannel(c.ctx, in.ChannelId); err != nil {
+			c.Logger.Errorf("channel.getChannelParticipants - error: %v", err)
+			return nil, err
+		}
+		count = channel2.ParticipantsCount
+		doList, err = c.svcCtx.Dao.ChannelParticipantsDAO.SelectRecentList(c.ctx, in.ChannelId, in.Offset, limit)
+	}
+	if err != nil {
+		c.Logger.Errorf("channel.getChannelParticipants - error: %v", err)
+		return nil, mtproto.ErrInternelServerError
+	}
+
+	participants := channel.MakeTLChannelParticipants(&channel.ChannelParticipants{
+		Count:        count,
+		Participants: make([]*channel.ImmutableChannelParticipant, 0, len(doList)),
+	}).To_ChannelParticipants()
+	for i := 0; i < len(doList); i++ {
+		participants.Participants = append(participants.Participants, c.svcCtx.Dao.MakeImmutableChannelParticipant(&doList[i]))
+	}
+
+	return participants, nil
+}
@@ -0,0 +1,89 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"math"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/biz/channel/channel"
+)
+
+const (
+	loadTypeBackward        = 0
+	loadTypeForward         = 1
+	loadTypeFirstAroundDate = 4
+)
+
+// ChannelGetHistory
+// channel.getHistory user_id:long channel_id:long offset_id:int add_offset:int limit:int max_id:int min_id:int = Vector<MessageBox>;
+func (c *ChannelCore) ChannelGetHistory(in *channel.TLChannelGetHistory) (*channel.Vector_MessageBox, error) {
+	var (
+		addOffset = in.AddOffset
+		limit     = in.Limit
+		offsetId  = in.OffsetId
+		loadType  = loadTypeBackward
+		boxList   []*mtproto.MessageBox
+	)
+
+	me, err := c.svcCtx.Dao.ChannelParticipantsDAO.SelectByUserId(c.ctx, in.ChannelId, in.UserId)
+	if err != nil {
+		c.Logger.Errorf("channel.getHistory - error: %v", err)
+		return nil, mtproto.ErrInternelServerError
+	} else if me == nil || me.State == mtproto.ChatMemberStateKicked {
+		err = mtproto.ErrChannelPrivate
+		c.Logger.Errorf("channel.getHistory - error: %v", err)
+		return nil, err
+	}
+
+	if addOffset >= 0 {
+		loadType = loadTypeBackward
+	} else if addOffset+limit > 0 {
+		loadType = loadTypeFirstAroundDate
+	} else {
+		loadType = loadTypeForward
+	}
+
+	if offsetId == 0 {
+		offsetId = math.MaxInt32
+	}
+
+	switch loadType {
+	case loadTypeBackward:
+		boxList = c.getBackwardHistory(in.ChannelId, offsetId, me.AvailableMinId, addOffset+limit)
+	case loadTypeFirstAroundDate:
+		boxList1 := c.getForwardHistory(in.ChannelId, offsetId, me.AvailableMinId, -addOffset)
+		for i, j := 0, len(boxList1)-1; i < j; i, j = i+1, j-1 {
+			boxList1[i], boxList1[j] = boxList1[j], boxList1[i]
+		}
+		boxList = append(boxList, boxList1...)
+		boxList2 := c.getBackwardHistory(in.ChannelId, offsetId, me.AvailableMinId, limit+addOffset)
+		boxList = append(boxList, boxList2...)
+	case loadTypeForward:
+		boxList = c.getForwardHistory(in.ChannelId, offsetId, me.AvailableMinId, -addOffset)
+		for i, j := 0, len(boxList)-1; i < j; i, j = i+1, j-1 {
+			boxList[i], boxList[j] = boxList[j], boxList[i]
+		}
+	}
+
+	return &channel.Vector_MessageBox{
+		Datas: boxList,
+	}, nil
+}
+
+func (c *ChannelCore) getBackwardHistory(channelId int64, offsetId, availableMinId, limit int32) []*mtproto.MessageBox {
+	doList, _ := c.svcCtx.Dao.ChannelMessagesDAO.SelectBackwardByOffsetIdLimit(c.ctx, channelId, offsetId, availableMinId, limit)
+	return c.svcCtx.Dao.MakeChannelMessageBoxList(doList)
+}
+
+func (c *ChannelCore) getForwardHistory(channelId int64, offsetId, availableMinId, limit int32) []*mtproto.MessageBox {
+	doList, _ := c.svcCtx.Dao.ChannelMessagesDAO.SelectForwardByOffsetIdLimit(c.ctx, channelId, offsetId, availableMinId, limit)
+	return c.svcCtx.Dao.MakeChannelMessageBoxList(doList)
+}
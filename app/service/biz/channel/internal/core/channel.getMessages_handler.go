@@ -0,0 +1,47 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/biz/channel/channel"
+)
+
+// ChannelGetMessages
+// channel.getMessages user_id:long channel_id:long id:Vector<int> = Vector<MessageBox>;
+func (c *ChannelCore) ChannelGetMessages(in *channel.TLChannelGetMessages) (*channel.Vector_MessageBox, error) {
+	me, err := c.svcCtx.Dao.ChannelParticipantsDAO.SelectByUserId(c.ctx, in.ChannelId, in.UserId)
+	if err != nil {
+		c.Logger.Errorf("channel.getMessages - error: %v", err)
+		return nil, mtproto.ErrInternelServerError
+	} else if me == nil || me.State == mtproto.ChatMemberStateKicked {
+		err = mtproto.ErrChannelPrivate
+		c.Logger.Errorf("channel.getMessages - error: %v", err)
+		return nil, err
+	}
+
+	doList, err := c.svcCtx.Dao.ChannelMessagesDAO.SelectByMessageIdList(c.ctx, in.ChannelId, in.Id)
+	if err != nil {
+		c.Logger.Errorf("channel.getMessages - error: %v", err)
+		return nil, mtproto.ErrInternelServerError
+	}
+
+	rValues := &channel.Vector_MessageBox{
+		Datas: make([]*mtproto.MessageBox, 0, len(doList)),
+	}
+	for i := 0; i < len(doList); i++ {
+		if doList[i].ChannelMessageId <= me.AvailableMinId {
+			continue
+		}
+		rValues.Datas = append(rValues.Datas, c.svcCtx.Dao.MakeChannelMessageBox(&doList[i]))
+	}
+
+	return rValues, nil
+}
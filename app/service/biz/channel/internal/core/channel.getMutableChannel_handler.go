@@ -0,0 +1,26 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"github.com/teamgram/teamgram-server/app/service/biz/channel/channel"
+)
+
+// ChannelGetMutableChannel
+// channel.getMutableChannel channel_id:long id:Vector<long> = MutableChannel;
+func (c *ChannelCore) ChannelGetMutableChannel(in *channel.TLChannelGetMutableChannel) (*channel.MutableChannel, error) {
+	mChannel, err := c.svcCtx.Dao.GetMutableChannel(c.ctx, in.ChannelId, in.Id...)
+	if err != nil {
+		c.Logger.Errorf("channel.getMutableChannel - error: %v", err)
+		return nil, err
+	}
+
+	return mChannel, nil
+}
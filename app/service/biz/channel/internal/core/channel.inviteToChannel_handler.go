@@ -0,0 +1,68 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"time"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/biz/channel/channel"
+	"github.com/teamgram/teamgram-server/app/service/biz/channel/internal/dal/dataobject"
+)
+
+// ChannelInviteToChannel
+// channel.inviteToChannel channel_id:long inviter_id:long id:Vector<long> = MutableChannel;
+func (c *ChannelCore) ChannelInviteToChannel(in *channel.TLChannelInviteToChannel) (*channel.MutableChannel, error) {
+	mChannel, err := c.svcCtx.Dao.GetMutableChannel(c.ctx, in.ChannelId, append([]int64{in.InviterId}, in.Id...)...)
+	if err != nil {
+		c.Logger.Errorf("channel.inviteToChannel - error: %v", err)
+		return nil, err
+	}
+
+	inviter, ok := mChannel.GetImmutableChannelParticipant(in.InviterId)
+	if !ok || !inviter.IsChatMemberStateNormal() {
+		err = mtproto.ErrChannelPrivate
+		c.Logger.Errorf("channel.inviteToChannel - error: %v", err)
+		return nil, err
+	}
+
+	if mChannel.Broadcast() && !inviter.CanInviteUsers() {
+		err = mtproto.ErrChatAdminRequired
+		c.Logger.Errorf("channel.inviteToChannel - error: %v", err)
+		return nil, err
+	}
+
+	for _, id := range in.Id {
+		if participant, ok := mChannel.GetImmutableChannelParticipant(id); ok {
+			if participant.IsChatMemberStateNormal() {
+				continue
+			}
+			if participant.IsChatMemberStateKicked() && !inviter.CanBanUsers() {
+				continue
+			}
+		}
+
+		_, _, err = c.svcCtx.Dao.ChannelParticipantsDAO.InsertOrUpdate(c.ctx, &dataobject.ChannelParticipantsDO{
+			ChannelId:       in.ChannelId,
+			UserId:          id,
+			ParticipantType: mtproto.ChatMemberNormal,
+			InviterUserId:   in.InviterId,
+			AvailableMinId:  mChannel.JoinAvailableMinId(),
+			Date2:           time.Now().Unix(),
+		})
+		if err != nil {
+			c.Logger.Errorf("channel.inviteToChannel - error: %v", err)
+			return nil, mtproto.ErrInternelServerError
+		}
+	}
+	c.svcCtx.Dao.UpdateParticipantsCount(c.ctx, in.ChannelId)
+
+	return c.svcCtx.Dao.GetMutableChannel(c.ctx, in.ChannelId, append([]int64{in.InviterId}, in.Id...)...)
+}
@@ -0,0 +1,61 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"time"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/biz/channel/channel"
+	"github.com/teamgram/teamgram-server/app/service/biz/channel/internal/dal/dataobject"
+)
+
+// ChannelJoinChannel
+// channel.joinChannel channel_id:long user_id:long = MutableChannel;
+func (c *ChannelCore) ChannelJoinChannel(in *channel.TLChannelJoinChannel) (*channel.MutableChannel, error) {
+	mChannel, err := c.svcCtx.Dao.GetMutableChannel(c.ctx, in.ChannelId, in.UserId)
+	if err != nil {
+		c.Logger.Errorf("channel.joinChannel - error: %v", err)
+		return nil, err
+	}
+
+	if mChannel.Deactivated() {
+		err = mtproto.ErrChannelPrivate
+		c.Logger.Errorf("channel.joinChannel - error: %v", err)
+		return nil, err
+	}
+
+	if me, ok := mChannel.GetImmutableChannelParticipant(in.UserId); ok {
+		if me.IsChatMemberStateKicked() {
+			err = mtproto.ErrUserBannedInChannel
+			c.Logger.Errorf("channel.joinChannel - error: %v", err)
+			return nil, err
+		} else if me.IsChatMemberStateNormal() {
+			err = mtproto.ErrUserAlreadyParticipant
+			c.Logger.Errorf("channel.joinChannel - error: %v", err)
+			return nil, err
+		}
+	}
+
+	_, _, err = c.svcCtx.Dao.ChannelParticipantsDAO.InsertOrUpdate(c.ctx, &dataobject.ChannelParticipantsDO{
+		ChannelId:       in.ChannelId,
+		UserId:          in.UserId,
+		ParticipantType: mtproto.ChatMemberNormal,
+		AvailableMinId:  mChannel.JoinAvailableMinId(),
+		Date2:           time.Now().Unix(),
+	})
+	if err != nil {
+		c.Logger.Errorf("channel.joinChannel - error: %v", err)
+		return nil, mtproto.ErrInternelServerError
+	}
+	c.svcCtx.Dao.UpdateParticipantsCount(c.ctx, in.ChannelId)
+
+	return c.svcCtx.Dao.GetMutableChannel(c.ctx, in.ChannelId, in.UserId)
+}
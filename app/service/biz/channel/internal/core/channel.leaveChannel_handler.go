@@ -0,0 +1,47 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/biz/channel/channel"
+)
+
+// ChannelLeaveChannel
+// channel.leaveChannel channel_id:long user_id:long = MutableChannel;
+func (c *ChannelCore) ChannelLeaveChannel(in *channel.TLChannelLeaveChannel) (*channel.MutableChannel, error) {
+	mChannel, err := c.svcCtx.Dao.GetMutableChannel(c.ctx, in.ChannelId, in.UserId)
+	if err != nil {
+		c.Logger.Errorf("channel.leaveChannel - error: %v", err)
+		return nil, err
+	}
+
+	me, ok := mChannel.GetImmutableChannelParticipant(in.UserId)
+	if !ok || !me.IsChatMemberStateNormal() {
+		err = mtproto.ErrUserNotParticipant
+		c.Logger.Errorf("channel.leaveChannel - error: %v", err)
+		return nil, err
+	}
+
+	// TODO(@benqi): transfer the ownership before the creator leaves
+	if me.IsChatMemberCreator() {
+		err = mtproto.ErrUserCreator
+		c.Logger.Errorf("channel.leaveChannel - error: %v", err)
+		return nil, err
+	}
+
+	if _, err = c.svcCtx.Dao.ChannelParticipantsDAO.UpdateLeft(c.ctx, in.ChannelId, in.UserId); err != nil {
+		c.Logger.Errorf("channel.leaveChannel - error: %v", err)
+		return nil, mtproto.ErrInternelServerError
+	}
+	c.svcCtx.Dao.UpdateParticipantsCount(c.ctx, in.ChannelId)
+
+	return c.svcCtx.Dao.GetMutableChannel(c.ctx, in.ChannelId, in.UserId)
+}
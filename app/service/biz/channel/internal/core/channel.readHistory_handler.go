@@ -0,0 +1,27 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/biz/channel/channel"
+)
+
+// ChannelReadHistory
+// channel.readHistory user_id:long channel_id:long max_id:int = Bool;
+func (c *ChannelCore) ChannelReadHistory(in *channel.TLChannelReadHistory) (*mtproto.Bool, error) {
+	rowsAffected, err := c.svcCtx.Dao.ChannelParticipantsDAO.UpdateReadInboxMaxId(c.ctx, in.MaxId, in.ChannelId, in.UserId)
+	if err != nil {
+		c.Logger.Errorf("channel.readHistory - error: %v", err)
+		return nil, mtproto.ErrInternelServerError
+	}
+
+	return mtproto.ToBool(rowsAffected > 0), nil
+}
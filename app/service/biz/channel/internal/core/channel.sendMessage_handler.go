@@ -0,0 +1,59 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"time"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/biz/channel/channel"
+)
+
+// ChannelSendMessage
+// channel.sendMessage user_id:long channel_id:long random_id:long message:Message = MessageBox;
+func (c *ChannelCore) ChannelSendMessage(in *channel.TLChannelSendMessage) (*mtproto.MessageBox, error) {
+	mChannel, err := c.svcCtx.Dao.GetMutableChannel(c.ctx, in.ChannelId, in.UserId)
+	if err != nil {
+		c.Logger.Errorf("channel.sendMessage - error: %v", err)
+		return nil, err
+	}
+
+	me, ok := mChannel.GetImmutableChannelParticipant(in.UserId)
+	if !ok || !me.IsChatMemberStateNormal() {
+		err = mtproto.ErrChannelPrivate
+		c.Logger.Errorf("channel.sendMessage - error: %v", err)
+		return nil, err
+	}
+
+	if !me.CanSendMessages(mChannel.Broadcast()) {
+		err = mtproto.ErrChatWriteForbidden
+		c.Logger.Errorf("channel.sendMessage - error: %v", err)
+		return nil, err
+	}
+
+	if mChannel.SlowmodeSeconds() > 0 && !me.IsChatMemberCreator() && !me.IsChatMemberAdmin() {
+		lastDO, _ := c.svcCtx.Dao.ChannelMessagesDAO.SelectLastBySender(c.ctx, in.ChannelId, in.UserId)
+		if lastDO != nil {
+			if wait := lastDO.Date2 + int64(mChannel.SlowmodeSeconds()) - time.Now().Unix(); wait > 0 {
+				err = mtproto.NewErrSlowModeWaitX(int32(wait))
+				c.Logger.Errorf("channel.sendMessage - error: %v", err)
+				return nil, err
+			}
+		}
+	}
+
+	box, err := c.svcCtx.Dao.SendChannelMessage(c.ctx, in.UserId, in.ChannelId, mChannel.Broadcast(), in.RandomId, in.Message)
+	if err != nil {
+		c.Logger.Errorf("channel.sendMessage - error: %v", err)
+		return nil, err
+	}
+
+	return box, nil
+}
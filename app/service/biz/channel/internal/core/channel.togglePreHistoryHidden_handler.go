@@ -0,0 +1,54 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/biz/channel/channel"
+)
+
+// ChannelTogglePreHistoryHidden
+// channel.togglePreHistoryHidden channel_id:long operator_id:long enabled:Bool = MutableChannel;
+func (c *ChannelCore) ChannelTogglePreHistoryHidden(in *channel.TLChannelTogglePreHistoryHidden) (*channel.MutableChannel, error) {
+	mChannel, err := c.svcCtx.Dao.GetMutableChannel(c.ctx, in.ChannelId, in.OperatorId)
+	if err != nil {
+		c.Logger.Errorf("channel.togglePreHistoryHidden - error: %v", err)
+		return nil, err
+	}
+
+	operator, ok := mChannel.GetImmutableChannelParticipant(in.OperatorId)
+	if mChannel.Deactivated() || !ok || !operator.IsChatMemberStateNormal() {
+		err = mtproto.ErrChannelPrivate
+		c.Logger.Errorf("channel.togglePreHistoryHidden - error: %v", err)
+		return nil, err
+	} else if !mChannel.Megagroup() {
+		err = mtproto.ErrChannelInvalid
+		c.Logger.Errorf("channel.togglePreHistoryHidden - error: %v", err)
+		return nil, err
+	} else if !operator.CanBanUsers() {
+		err = mtproto.ErrChatAdminRequired
+		c.Logger.Errorf("channel.togglePreHistoryHidden - error: %v", err)
+		return nil, err
+	}
+
+	enabled := mtproto.FromBool(in.Enabled)
+	if mChannel.HiddenPrehistory() == enabled {
+		err = mtproto.ErrChatNotModified
+		c.Logger.Errorf("channel.togglePreHistoryHidden - error: %v", err)
+		return nil, err
+	}
+
+	if _, err = c.svcCtx.Dao.ChannelsDAO.UpdateHiddenPrehistory(c.ctx, enabled, in.ChannelId); err != nil {
+		c.Logger.Errorf("channel.togglePreHistoryHidden - error: %v", err)
+		return nil, mtproto.ErrInternelServerError
+	}
+
+	return c.svcCtx.Dao.GetMutableChannel(c.ctx, in.ChannelId, in.OperatorId)
+}
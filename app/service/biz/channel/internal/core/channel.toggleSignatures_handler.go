@@ -0,0 +1,55 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/biz/channel/channel"
+)
+
+// ChannelToggleSignatures
+// channel.toggleSignatures channel_id:long operator_id:long enabled:Bool = MutableChannel;
+func (c *ChannelCore) ChannelToggleSignatures(in *channel.TLChannelToggleSignatures) (*channel.MutableChannel, error) {
+	mChannel, err := c.svcCtx.Dao.GetMutableChannel(c.ctx, in.ChannelId, in.OperatorId)
+	if err != nil {
+		c.Logger.Errorf("channel.toggleSignatures - error: %v", err)
+		return nil, err
+	}
+
+	operator, ok := mChannel.GetImmutableChannelParticipant(in.OperatorId)
+	if mChannel.Deactivated() || !ok || !operator.IsChatMemberStateNormal() {
+		err = mtproto.ErrChannelPrivate
+		c.Logger.Errorf("channel.toggleSignatures - error: %v", err)
+		return nil, err
+	} else if !mChannel.Broadcast() {
+		// only the posts of a broadcast channel are signed
+		err = mtproto.ErrChannelInvalid
+		c.Logger.Errorf("channel.toggleSignatures - error: %v", err)
+		return nil, err
+	} else if !operator.CanChangeInfo() {
+		err = mtproto.ErrChatAdminRequired
+		c.Logger.Errorf("channel.toggleSignatures - error: %v", err)
+		return nil, err
+	}
+
+	enabled := mtproto.FromBool(in.Enabled)
+	if mChannel.Signatures() == enabled {
+		err = mtproto.ErrChatNotModified
+		c.Logger.Errorf("channel.toggleSignatures - error: %v", err)
+		return nil, err
+	}
+
+	if _, err = c.svcCtx.Dao.ChannelsDAO.UpdateSignatures(c.ctx, enabled, in.ChannelId); err != nil {
+		c.Logger.Errorf("channel.toggleSignatures - error: %v", err)
+		return nil, mtproto.ErrInternelServerError
+	}
+
+	return c.svcCtx.Dao.GetMutableChannel(c.ctx, in.ChannelId, in.OperatorId)
+}
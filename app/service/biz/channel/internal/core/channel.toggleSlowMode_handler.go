@@ -0,0 +1,61 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/biz/channel/channel"
+)
+
+// ChannelToggleSlowMode
+// channel.toggleSlowMode channel_id:long operator_id:long seconds:int = MutableChannel;
+func (c *ChannelCore) ChannelToggleSlowMode(in *channel.TLChannelToggleSlowMode) (*channel.MutableChannel, error) {
+	switch in.Seconds {
+	case 0, 10, 30, 60, 300, 900, 3600:
+	default:
+		err := mtproto.ErrSecondsInvalid
+		c.Logger.Errorf("channel.toggleSlowMode - error: %v", err)
+		return nil, err
+	}
+
+	mChannel, err := c.svcCtx.Dao.GetMutableChannel(c.ctx, in.ChannelId, in.OperatorId)
+	if err != nil {
+		c.Logger.Errorf("channel.toggleSlowMode - error: %v", err)
+		return nil, err
+	}
+
+	operator, ok := mChannel.GetImmutableChannelParticipant(in.OperatorId)
+	if mChannel.Deactivated() || !ok || !operator.IsChatMemberStateNormal() {
+		err = mtproto.ErrChannelPrivate
+		c.Logger.Errorf("channel.toggleSlowMode - error: %v", err)
+		return nil, err
+	} else if !mChannel.Megagroup() {
+		err = mtproto.ErrChannelInvalid
+		c.Logger.Errorf("channel.toggleSlowMode - error: %v", err)
+		return nil, err
+	} else if !operator.CanBanUsers() {
+		err = mtproto.ErrChatAdminRequired
+		c.Logger.Errorf("channel.toggleSlowMode - error: %v", err)
+		return nil, err
+	}
+
+	if mChannel.SlowmodeSeconds() == in.Seconds {
+		err = mtproto.ErrChatNotModified
+		c.Logger.Errorf("channel.toggleSlowMode - error: %v", err)
+		return nil, err
+	}
+
+	if _, err = c.svcCtx.Dao.ChannelsDAO.UpdateSlowmodeSeconds(c.ctx, in.Seconds, in.ChannelId); err != nil {
+		c.Logger.Errorf("channel.toggleSlowMode - error: %v", err)
+		return nil, mtproto.ErrInternelServerError
+	}
+
+	return c.svcCtx.Dao.GetMutableChannel(c.ctx, in.ChannelId, in.OperatorId)
+}
@@ -0,0 +1,3 @@
+./dalgen.sh channel_messages
+./dalgen.sh channel_participants
+./dalgen.sh channels
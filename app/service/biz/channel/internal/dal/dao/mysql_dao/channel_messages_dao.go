@@ -0,0 +1,495 @@
+/*
+ * WARNING! All changes made in this file will be lost!
+ *   Created from by 'dalgen'
+ *
+ * Copyright (c) 2022-present,  Teamgram Authors.
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package mysql_dao
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/teamgram/marmota/pkg/stores/sqlx"
+	"github.com/teamgram/teamgram-server/app/service/biz/channel/internal/dal/dataobject"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+var _ *sql.Result
+
+type ChannelMessagesDAO struct {
+	db *sqlx.DB
+}
+
+func NewChannelMessagesDAO(db *sqlx.DB) *ChannelMessagesDAO {
+	return &ChannelMessagesDAO{db}
+}
+
+// Insert
+// insert into channel_messages(channel_id, channel_message_id, sender_user_id, random_id, pts, message_filter_type, message_data, message, views, date2) values (:channel_id, :channel_message_id, :sender_user_id, :random_id, :pts, :message_filter_type, :message_data, :message, :views, :date2)
+// TODO(@benqi): sqlmap
+func (dao *ChannelMessagesDAO) Insert(ctx context.Context, do *dataobject.ChannelMessagesDO) (lastInsertId, rowsAffected int64, err error) {
+	var (
+		query = "insert into channel_messages(channel_id, channel_message_id, sender_user_id, random_id, pts, message_filter_type, message_data, message, views, date2) values (:channel_id, :channel_message_id, :sender_user_id, :random_id, :pts, :message_filter_type, :message_data, :message, :views, :date2)"
+		r     sql.Result
+	)
+
+	r, err = dao.db.NamedExec(ctx, query, do)
+	if err != nil {
+		logx.WithContext(ctx).Errorf("namedExec in Insert(%v), error: %v", do, err)
+		return
+	}
+
+	lastInsertId, err = r.LastInsertId()
+	if err != nil {
+		logx.WithContext(ctx).Errorf("lastInsertId in Insert(%v)_error: %v", do, err)
+		return
+	}
+	rowsAffected, err = r.RowsAffected()
+	if err != nil {
+		logx.WithContext(ctx).Errorf("rowsAffected in Insert(%v)_error: %v", do, err)
+	}
+
+	return
+}
+
+// InsertTx
+// insert into channel_messages(channel_id, channel_message_id, sender_user_id, random_id, pts, message_filter_type, message_data, message, views, date2) values (:channel_id, :channel_message_id, :sender_user_id, :random_id, :pts, :message_filter_type, :message_data, :message, :views, :date2)
+// TODO(@benqi): sqlmap
+func (dao *ChannelMessagesDAO) InsertTx(tx *sqlx.Tx, do *dataobject.ChannelMessagesDO) (lastInsertId, rowsAffected int64, err error) {
+	var (
+		query = "insert into channel_messages(channel_id, channel_message_id, sender_user_id, random_id, pts, message_filter_type, message_data, message, views, date2) values (:channel_id, :channel_message_id, :sender_user_id, :random_id, :pts, :message_filter_type, :message_data, :message, :views, :date2)"
+		r     sql.Result
+	)
+
+	r, err = tx.NamedExec(query, do)
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("namedExec in Insert(%v), error: %v", do, err)
+		return
+	}
+
+	lastInsertId, err = r.LastInsertId()
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("lastInsertId in Insert(%v)_error: %v", do, err)
+		return
+	}
+	rowsAffected, err = r.RowsAffected()
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("rowsAffected in Insert(%v)_error: %v", do, err)
+	}
+
+	return
+}
+
+// SelectByRandomId
+// select channel_id, channel_message_id, sender_user_id, random_id, pts, message_filter_type, message_data, message, views, date2 from channel_messages where sender_user_id = :sender_user_id and random_id = :random_id and channel_id = :channel_id and deleted = 0 limit 1
+// TODO(@benqi): sqlmap
+func (dao *ChannelMessagesDAO) SelectByRandomId(ctx context.Context, sender_user_id int64, random_id int64, channel_id int64) (rValue *dataobject.ChannelMessagesDO, err error) {
+	var (
+		query = "select channel_id, channel_message_id, sender_user_id, random_id, pts, message_filter_type, message_data, message, views, date2 from channel_messages where sender_user_id = ? and random_id = ? and channel_id = ? and deleted = 0 limit 1"
+		do    = &dataobject.ChannelMessagesDO{}
+	)
+	err = dao.db.QueryRowPartial(ctx, do, query, sender_user_id, random_id, channel_id)
+
+	if err != nil {
+		if err != sqlx.ErrNotFound {
+			logx.WithContext(ctx).Errorf("queryx in SelectByRandomId(_), error: %v", err)
+			return
+		} else {
+			err = nil
+		}
+	} else {
+		rValue = do
+	}
+
+	return
+}
+
+// SelectByMessageIdList
+// select channel_id, channel_message_id, sender_user_id, random_id, pts, message_filter_type, message_data, message, views, date2 from channel_messages where channel_id = :channel_id and channel_message_id in (:idList) and deleted = 0 order by channel_message_id desc
+// TODO(@benqi): sqlmap
+func (dao *ChannelMessagesDAO) SelectByMessageIdList(ctx context.Context, channel_id int64, idList []int32) (rList []dataobject.ChannelMessagesDO, err error) {
+	var (
+		query  = "select channel_id, channel_message_id, sender_user_id, random_id, pts, message_filter_type, message_data, message, views, date2 from channel_messages where channel_id = ? and channel_message_id in (?) and deleted = 0 order by channel_message_id desc"
+		a      []interface{}
+		values []dataobject.ChannelMessagesDO
+	)
+
+	if len(idList) == 0 {
+		rList = []dataobject.ChannelMessagesDO{}
+		return
+	}
+
+	query, a, err = sqlx.In(query, channel_id, idList)
+	if err != nil {
+		// r sql.Result
+		logx.WithContext(ctx).Errorf("sqlx.In in SelectByMessageIdList(_), error: %v", err)
+		return
+	}
+	err = dao.db.QueryRowsPartial(ctx, &values, query, a...)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("queryx in SelectByMessageIdList(_), error: %v", err)
+		return
+	}
+
+	rList = values
+
+	return
+}
+
+// SelectByMessageIdListWithCB
+// select channel_id, channel_message_id, sender_user_id, random_id, pts, message_filter_type, message_data, message, views, date2 from channel_messages where channel_id = :channel_id and channel_message_id in (:idList) and deleted = 0 order by channel_message_id desc
+// TODO(@benqi): sqlmap
+func (dao *ChannelMessagesDAO) SelectByMessageIdListWithCB(ctx context.Context, channel_id int64, idList []int32, cb func(i int, v *dataobject.ChannelMessagesDO)) (rList []dataobject.ChannelMessagesDO, err error) {
+	var (
+		query  = "select channel_id, channel_message_id, sender_user_id, random_id, pts, message_filter_type, message_data, message, views, date2 from channel_messages where channel_id = ? and channel_message_id in (?) and deleted = 0 order by channel_message_id desc"
+		a      []interface{}
+		values []dataobject.ChannelMessagesDO
+	)
+
+	if len(idList) == 0 {
+		rList = []dataobject.ChannelMessagesDO{}
+		return
+	}
+
+	query, a, err = sqlx.In(query, channel_id, idList)
+	if err != nil {
+		// r sql.Result
+		logx.WithContext(ctx).Errorf("sqlx.In in SelectByMessageIdList(_), error: %v", err)
+		return
+	}
+	err = dao.db.QueryRowsPartial(ctx, &values, query, a...)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("queryx in SelectByMessageIdList(_), error: %v", err)
+		return
+	}
+
+	rList = values
+
+	if cb != nil {
+		for i := 0; i < len(rList); i++ {
+			cb(i, &rList[i])
+		}
+	}
+
+	return
+}
+
+// SelectBackwardByOffsetIdLimit
+// select channel_id, channel_message_id, sender_user_id, random_id, pts, message_filter_type, message_data, message, views, date2 from channel_messages where channel_id = :channel_id and channel_message_id < :channel_message_id and channel_message_id > :available_min_id and deleted = 0 order by channel_message_id desc limit :limit
+// TODO(@benqi): sqlmap
+func (dao *ChannelMessagesDAO) SelectBackwardByOffsetIdLimit(ctx context.Context, channel_id int64, channel_message_id int32, available_min_id int32, limit int32) (rList []dataobject.ChannelMessagesDO, err error) {
+	var (
+		query  = "select channel_id, channel_message_id, sender_user_id, random_id, pts, message_filter_type, message_data, message, views, date2 from channel_messages where channel_id = ? and channel_message_id < ? and channel_message_id > ? and deleted = 0 order by channel_message_id desc limit ?"
+		values []dataobject.ChannelMessagesDO
+	)
+	err = dao.db.QueryRowsPartial(ctx, &values, query, channel_id, channel_message_id, available_min_id, limit)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("queryx in SelectBackwardByOffsetIdLimit(_), error: %v", err)
+		return
+	}
+
+	rList = values
+
+	return
+}
+
+// SelectBackwardByOffsetIdLimitWithCB
+// select channel_id, channel_message_id, sender_user_id, random_id, pts, message_filter_type, message_data, message, views, date2 from channel_messages where channel_id = :channel_id and channel_message_id < :channel_message_id and channel_message_id > :available_min_id and deleted = 0 order by channel_message_id desc limit :limit
+// TODO(@benqi): sqlmap
+func (dao *ChannelMessagesDAO) SelectBackwardByOffsetIdLimitWithCB(ctx context.Context, channel_id int64, channel_message_id int32, available_min_id int32, limit int32, cb func(i int, v *dataobject.ChannelMessagesDO)) (rList []dataobject.ChannelMessagesDO, err error) {
+	var (
+		query  = "select channel_id, channel_message_id, sender_user_id, random_id, pts, message_filter_type, message_data, message, views, date2 from channel_messages where channel_id = ? and channel_message_id < ? and channel_message_id > ? and deleted = 0 order by channel_message_id desc limit ?"
+		values []dataobject.ChannelMessagesDO
+	)
+	err = dao.db.QueryRowsPartial(ctx, &values, query, channel_id, channel_message_id, available_min_id, limit)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("queryx in SelectBackwardByOffsetIdLimit(_), error: %v", err)
+		return
+	}
+
+	rList = values
+
+	if cb != nil {
+		for i := 0; i < len(rList); i++ {
+			cb(i, &rList[i])
+		}
+	}
+
+	return
+}
+
+// SelectForwardByOffsetIdLimit
+// select channel_id, channel_message_id, sender_user_id, random_id, pts, message_filter_type, message_data, message, views, date2 from channel_messages where channel_id = :channel_id and channel_message_id >= :channel_message_id and channel_message_id > :available_min_id and deleted = 0 order by channel_message_id limit :limit
+// TODO(@benqi): sqlmap
+func (dao *ChannelMessagesDAO) SelectForwardByOffsetIdLimit(ctx context.Context, channel_id int64, channel_message_id int32, available_min_id int32, limit int32) (rList []dataobject.ChannelMessagesDO, err error) {
+	var (
+		query  = "select channel_id, channel_message_id, sender_user_id, random_id, pts, message_filter_type, message_data, message, views, date2 from channel_messages where channel_id = ? and channel_message_id >= ? and channel_message_id > ? and deleted = 0 order by channel_message_id limit ?"
+		values []dataobject.ChannelMessagesDO
+	)
+	err = dao.db.QueryRowsPartial(ctx, &values, query, channel_id, channel_message_id, available_min_id, limit)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("queryx in SelectForwardByOffsetIdLimit(_), error: %v", err)
+		return
+	}
+
+	rList = values
+
+	return
+}
+
+// SelectForwardByOffsetIdLimitWithCB
+// select channel_id, channel_message_id, sender_user_id, random_id, pts, message_filter_type, message_data, message, views, date2 from channel_messages where channel_id = :channel_id and channel_message_id >= :channel_message_id and channel_message_id > :available_min_id and deleted = 0 order by channel_message_id limit :limit
+// TODO(@benqi): sqlmap
+func (dao *ChannelMessagesDAO) SelectForwardByOffsetIdLimitWithCB(ctx context.Context, channel_id int64, channel_message_id int32, available_min_id int32, limit int32, cb func(i int, v *dataobject.ChannelMessagesDO)) (rList []dataobject.ChannelMessagesDO, err error) {
+	var (
+		query  = "select channel_id, channel_message_id, sender_user_id, random_id, pts, message_filter_type, message_data, message, views, date2 from channel_messages where channel_id = ? and channel_message_id >= ? and channel_message_id > ? and deleted = 0 order by channel_message_id limit ?"
+		values []dataobject.ChannelMessagesDO
+	)
+	err = dao.db.QueryRowsPartial(ctx, &values, query, channel_id, channel_message_id, available_min_id, limit)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("queryx in SelectForwardByOffsetIdLimit(_), error: %v", err)
+		return
+	}
+
+	rList = values
+
+	if cb != nil {
+		for i := 0; i < len(rList); i++ {
+			cb(i, &rList[i])
+		}
+	}
+
+	return
+}
+
+// SelectByPts
+// select channel_id, channel_message_id, sender_user_id, random_id, pts, message_filter_type, message_data, message, views, date2 from channel_messages where channel_id = :channel_id and pts > :pts and deleted = 0 order by pts limit :limit
+// TODO(@benqi): sqlmap
+func (dao *ChannelMessagesDAO) SelectByPts(ctx context.Context, channel_id int64, pts int32, limit int32) (rList []dataobject.ChannelMessagesDO, err error) {
+	var (
+		query  = "select channel_id, channel_message_id, sender_user_id, random_id, pts, message_filter_type, message_data, message, views, date2 from channel_messages where channel_id = ? and pts > ? and deleted = 0 order by pts limit ?"
+		values []dataobject.ChannelMessagesDO
+	)
+	err = dao.db.QueryRowsPartial(ctx, &values, query, channel_id, pts, limit)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("queryx in SelectByPts(_), error: %v", err)
+		return
+	}
+
+	rList = values
+
+	return
+}
+
+// SelectByPtsWithCB
+// select channel_id, channel_message_id, sender_user_id, random_id, pts, message_filter_type, message_data, message, views, date2 from channel_messages where channel_id = :channel_id and pts > :pts and deleted = 0 order by pts limit :limit
+// TODO(@benqi): sqlmap
+func (dao *ChannelMessagesDAO) SelectByPtsWithCB(ctx context.Context, channel_id int64, pts int32, limit int32, cb func(i int, v *dataobject.ChannelMessagesDO)) (rList []dataobject.ChannelMessagesDO, err error) {
+	var (
+		query  = "select channel_id, channel_message_id, sender_user_id, random_id, pts, message_filter_type, message_data, message, views, date2 from channel_messages where channel_id = ? and pts > ? and deleted = 0 order by pts limit ?"
+		values []dataobject.ChannelMessagesDO
+	)
+	err = dao.db.QueryRowsPartial(ctx, &values, query, channel_id, pts, limit)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("queryx in SelectByPts(_), error: %v", err)
+		return
+	}
+
+	rList = values
+
+	if cb != nil {
+		for i := 0; i < len(rList); i++ {
+			cb(i, &rList[i])
+		}
+	}
+
+	return
+}
+
+// SelectLastBySender
+// select channel_message_id, date2 from channel_messages where channel_id = :channel_id and sender_user_id = :sender_user_id and deleted = 0 order by channel_message_id desc limit 1
+// TODO(@benqi): sqlmap
+func (dao *ChannelMessagesDAO) SelectLastBySender(ctx context.Context, channel_id int64, sender_user_id int64) (rValue *dataobject.ChannelMessagesDO, err error) {
+	var (
+		query = "select channel_message_id, date2 from channel_messages where channel_id = ? and sender_user_id = ? and deleted = 0 order by channel_message_id desc limit 1"
+		do    = &dataobject.ChannelMessagesDO{}
+	)
+	err = dao.db.QueryRowPartial(ctx, do, query, channel_id, sender_user_id)
+
+	if err != nil {
+		if err != sqlx.ErrNotFound {
+			logx.WithContext(ctx).Errorf("queryx in SelectLastBySender(_), error: %v", err)
+			return
+		} else {
+			err = nil
+		}
+	} else {
+		rValue = do
+	}
+
+	return
+}
+
+// SelectIdListBySender
+// select channel_message_id from channel_messages where channel_id = :channel_id and sender_user_id = :sender_user_id and deleted = 0 order by channel_message_id desc limit :limit
+// TODO(@benqi): sqlmap
+func (dao *ChannelMessagesDAO) SelectIdListBySender(ctx context.Context, channel_id int64, sender_user_id int64, limit int32) (rList []int32, err error) {
+	var query = "select channel_message_id from channel_messages where channel_id = ? and sender_user_id = ? and deleted = 0 order by channel_message_id desc limit ?"
+	err = dao.db.QueryRowsPartial(ctx, &rList, query, channel_id, sender_user_id, limit)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("select in SelectIdListBySender(_), error: %v", err)
+	}
+
+	return
+}
+
+// SelectIdListBySenderWithCB
+// select channel_message_id from channel_messages where channel_id = :channel_id and sender_user_id = :sender_user_id and deleted = 0 order by channel_message_id desc limit :limit
+// TODO(@benqi): sqlmap
+func (dao *ChannelMessagesDAO) SelectIdListBySenderWithCB(ctx context.Context, channel_id int64, sender_user_id int64, limit int32, cb func(i int, v int32)) (rList []int32, err error) {
+	var query = "select channel_message_id from channel_messages where channel_id = ? and sender_user_id = ? and deleted = 0 order by channel_message_id desc limit ?"
+	err = dao.db.QueryRowsPartial(ctx, &rList, query, channel_id, sender_user_id, limit)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("select in SelectIdListBySender(_), error: %v", err)
+	}
+
+	if cb != nil {
+		for i := 0; i < len(rList); i++ {
+			cb(i, rList[i])
+		}
+	}
+
+	return
+}
+
+// DeleteMessages
+// update channel_messages set deleted = 1 where channel_id = :channel_id and channel_message_id in (:idList) and deleted = 0
+// TODO(@benqi): sqlmap
+func (dao *ChannelMessagesDAO) DeleteMessages(ctx context.Context, channel_id int64, idList []int32) (rowsAffected int64, err error) {
+	var (
+		query   = "update channel_messages set deleted = 1 where channel_id = ? and channel_message_id in (?) and deleted = 0"
+		a       []interface{}
+		rResult sql.Result
+	)
+
+	if len(idList) == 0 {
+		return
+	}
+
+	query, a, err = sqlx.In(query, channel_id, idList)
+	if err != nil {
+		// r sql.Result
+		logx.WithContext(ctx).Errorf("sqlx.In in DeleteMessages(_), error: %v", err)
+		return
+	}
+	rResult, err = dao.db.Exec(ctx, query, a...)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("exec in DeleteMessages(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(ctx).Errorf("rowsAffected in DeleteMessages(_), error: %v", err)
+	}
+
+	return
+}
+
+// update channel_messages set deleted = 1 where channel_id = :channel_id and channel_message_id in (:idList) and deleted = 0
+// DeleteMessagesTx
+// TODO(@benqi): sqlmap
+func (dao *ChannelMessagesDAO) DeleteMessagesTx(tx *sqlx.Tx, channel_id int64, idList []int32) (rowsAffected int64, err error) {
+	var (
+		query   = "update channel_messages set deleted = 1 where channel_id = ? and channel_message_id in (?) and deleted = 0"
+		a       []interface{}
+		rResult sql.Result
+	)
+
+	if len(idList) == 0 {
+		return
+	}
+
+	query, a, err = sqlx.In(query, channel_id, idList)
+	if err != nil {
+		// r sql.Result
+		logx.WithContext(tx.Context()).Errorf("sqlx.In in DeleteMessages(_), error: %v", err)
+		return
+	}
+	rResult, err = tx.Exec(query, a...)
+
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("exec in DeleteMessages(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("rowsAffected in DeleteMessages(_), error: %v", err)
+	}
+
+	return
+}
+
+// DeleteHistory
+// update channel_messages set deleted = 1 where channel_id = :channel_id and channel_message_id <= :channel_message_id and deleted = 0
+// TODO(@benqi): sqlmap
+func (dao *ChannelMessagesDAO) DeleteHistory(ctx context.Context, channel_id int64, channel_message_id int32) (rowsAffected int64, err error) {
+	var (
+		query   = "update channel_messages set deleted = 1 where channel_id = ? and channel_message_id <= ? and deleted = 0"
+		rResult sql.Result
+	)
+	rResult, err = dao.db.Exec(ctx, query, channel_id, channel_message_id)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("exec in DeleteHistory(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(ctx).Errorf("rowsAffected in DeleteHistory(_), error: %v", err)
+	}
+
+	return
+}
+
+// update channel_messages set deleted = 1 where channel_id = :channel_id and channel_message_id <= :channel_message_id and deleted = 0
+// DeleteHistoryTx
+// TODO(@benqi): sqlmap
+func (dao *ChannelMessagesDAO) DeleteHistoryTx(tx *sqlx.Tx, channel_id int64, channel_message_id int32) (rowsAffected int64, err error) {
+	var (
+		query   = "update channel_messages set deleted = 1 where channel_id = ? and channel_message_id <= ? and deleted = 0"
+		rResult sql.Result
+	)
+	rResult, err = tx.Exec(query, channel_id, channel_message_id)
+
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("exec in DeleteHistory(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("rowsAffected in DeleteHistory(_), error: %v", err)
+	}
+
+	return
+}
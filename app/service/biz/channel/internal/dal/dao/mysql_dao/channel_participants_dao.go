@@ -0,0 +1,711 @@
+/*
+ * WARNING! All changes made in this file will be lost!
+ *   Created from by 'dalgen'
+ *
+ * Copyright (c) 2022-present,  Teamgram Authors.
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package mysql_dao
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/teamgram/marmota/pkg/stores/sqlx"
+	"github.com/teamgram/teamgram-server/app/service/biz/channel/internal/dal/dataobject"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+var _ *sql.Result
+
+type ChannelParticipantsDAO struct {
+	db *sqlx.DB
+}
+
+func NewChannelParticipantsDAO(db *sqlx.DB) *ChannelParticipantsDAO {
+	return &ChannelParticipantsDAO{db}
+}
+
+// InsertOrUpdate
+// insert into channel_participants(channel_id, user_id, participant_type, inviter_user_id, admin_rights, available_min_id, date2) values (:channel_id, :user_id, :participant_type, :inviter_user_id, :admin_rights, :available_min_id, :date2) on duplicate key update participant_type = values(participant_type), inviter_user_id = values(inviter_user_id), admin_rights = values(admin_rights), available_min_id = values(available_min_id), banned_rights = 0, kicked_by = 0, state = 0, date2 = values(date2)
+// TODO(@benqi): sqlmap
+func (dao *ChannelParticipantsDAO) InsertOrUpdate(ctx context.Context, do *dataobject.ChannelParticipantsDO) (lastInsertId, rowsAffected int64, err error) {
+	var (
+		query = "insert into channel_participants(channel_id, user_id, participant_type, inviter_user_id, admin_rights, available_min_id, date2) values (:channel_id, :user_id, :participant_type, :inviter_user_id, :admin_rights, :available_min_id, :date2) on duplicate key update participant_type = values(participant_type), inviter_user_id = values(inviter_user_id), admin_rights = values(admin_rights), available_min_id = values(available_min_id), banned_rights = 0, kicked_by = 0, state = 0, date2 = values(date2)"
+		r     sql.Result
+	)
+
+	r, err = dao.db.NamedExec(ctx, query, do)
+	if err != nil {
+		logx.WithContext(ctx).Errorf("namedExec in InsertOrUpdate(%v), error: %v", do, err)
+		return
+	}
+
+	lastInsertId, err = r.LastInsertId()
+	if err != nil {
+		logx.WithContext(ctx).Errorf("lastInsertId in InsertOrUpdate(%v)_error: %v", do, err)
+		return
+	}
+	rowsAffected, err = r.RowsAffected()
+	if err != nil {
+		logx.WithContext(ctx).Errorf("rowsAffected in InsertOrUpdate(%v)_error: %v", do, err)
+	}
+
+	return
+}
+
+// InsertOrUpdateTx
+// insert into channel_participants(channel_id, user_id, participant_type, inviter_user_id, admin_rights, available_min_id, date2) values (:channel_id, :user_id, :participant_type, :inviter_user_id, :admin_rights, :available_min_id, :date2) on duplicate key update participant_type = values(participant_type), inviter_user_id = values(inviter_user_id), admin_rights = values(admin_rights), available_min_id = values(available_min_id), banned_rights = 0, kicked_by = 0, state = 0, date2 = values(date2)
+// TODO(@benqi): sqlmap
+func (dao *ChannelParticipantsDAO) InsertOrUpdateTx(tx *sqlx.Tx, do *dataobject.ChannelParticipantsDO) (lastInsertId, rowsAffected int64, err error) {
+	var (
+		query = "insert into channel_participants(channel_id, user_id, participant_type, inviter_user_id, admin_rights, available_min_id, date2) values (:channel_id, :user_id, :participant_type, :inviter_user_id, :admin_rights, :available_min_id, :date2) on duplicate key update participant_type = values(participant_type), inviter_user_id = values(inviter_user_id), admin_rights = values(admin_rights), available_min_id = values(available_min_id), banned_rights = 0, kicked_by = 0, state = 0, date2 = values(date2)"
+		r     sql.Result
+	)
+
+	r, err = tx.NamedExec(query, do)
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("namedExec in InsertOrUpdate(%v), error: %v", do, err)
+		return
+	}
+
+	lastInsertId, err = r.LastInsertId()
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("lastInsertId in InsertOrUpdate(%v)_error: %v", do, err)
+		return
+	}
+	rowsAffected, err = r.RowsAffected()
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("rowsAffected in InsertOrUpdate(%v)_error: %v", do, err)
+	}
+
+	return
+}
+
+// SelectByUserId
+// select id, channel_id, user_id, participant_type, state, inviter_user_id, admin_rights, banned_rights, `rank`, promoted_by, kicked_by, read_inbox_max_id, available_min_id, date2 from channel_participants where channel_id = :channel_id and user_id = :user_id
+// TODO(@benqi): sqlmap
+func (dao *ChannelParticipantsDAO) SelectByUserId(ctx context.Context, channel_id int64, user_id int64) (rValue *dataobject.ChannelParticipantsDO, err error) {
+	var (
+		query = "select id, channel_id, user_id, participant_type, state, inviter_user_id, admin_rights, banned_rights, `rank`, promoted_by, kicked_by, read_inbox_max_id, available_min_id, date2 from channel_participants where channel_id = ? and user_id = ?"
+		do    = &dataobject.ChannelParticipantsDO{}
+	)
+	err = dao.db.QueryRowPartial(ctx, do, query, channel_id, user_id)
+
+	if err != nil {
+		if err != sqlx.ErrNotFound {
+			logx.WithContext(ctx).Errorf("queryx in SelectByUserId(_), error: %v", err)
+			return
+		} else {
+			err = nil
+		}
+	} else {
+		rValue = do
+	}
+
+	return
+}
+
+// SelectListByUserIdList
+// select id, channel_id, user_id, participant_type, state, inviter_user_id, admin_rights, banned_rights, `rank`, promoted_by, kicked_by, read_inbox_max_id, available_min_id, date2 from channel_participants where channel_id = :channel_id and user_id in (:idList)
+// TODO(@benqi): sqlmap
+func (dao *ChannelParticipantsDAO) SelectListByUserIdList(ctx context.Context, channel_id int64, idList []int64) (rList []dataobject.ChannelParticipantsDO, err error) {
+	var (
+		query  = "select id, channel_id, user_id, participant_type, state, inviter_user_id, admin_rights, banned_rights, `rank`, promoted_by, kicked_by, read_inbox_max_id, available_min_id, date2 from channel_participants where channel_id = ? and user_id in (?)"
+		a      []interface{}
+		values []dataobject.ChannelParticipantsDO
+	)
+
+	if len(idList) == 0 {
+		rList = []dataobject.ChannelParticipantsDO{}
+		return
+	}
+
+	query, a, err = sqlx.In(query, channel_id, idList)
+	if err != nil {
+		// r sql.Result
+		logx.WithContext(ctx).Errorf("sqlx.In in SelectListByUserIdList(_), error: %v", err)
+		return
+	}
+	err = dao.db.QueryRowsPartial(ctx, &values, query, a...)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("queryx in SelectListByUserIdList(_), error: %v", err)
+		return
+	}
+
+	rList = values
+
+	return
+}
+
+// SelectListByUserIdListWithCB
+// select id, channel_id, user_id, participant_type, state, inviter_user_id, admin_rights, banned_rights, `rank`, promoted_by, kicked_by, read_inbox_max_id, available_min_id, date2 from channel_participants where channel_id = :channel_id and user_id in (:idList)
+// TODO(@benqi): sqlmap
+func (dao *ChannelParticipantsDAO) SelectListByUserIdListWithCB(ctx context.Context, channel_id int64, idList []int64, cb func(i int, v *dataobject.ChannelParticipantsDO)) (rList []dataobject.ChannelParticipantsDO, err error) {
+	var (
+		query  = "select id, channel_id, user_id, participant_type, state, inviter_user_id, admin_rights, banned_rights, `rank`, promoted_by, kicked_by, read_inbox_max_id, available_min_id, date2 from channel_participants where channel_id = ? and user_id in (?)"
+		a      []interface{}
+		values []dataobject.ChannelParticipantsDO
+	)
+
+	if len(idList) == 0 {
+		rList = []dataobject.ChannelParticipantsDO{}
+		return
+	}
+
+	query, a, err = sqlx.In(query, channel_id, idList)
+	if err != nil {
+		// r sql.Result
+		logx.WithContext(ctx).Errorf("sqlx.In in SelectListByUserIdList(_), error: %v", err)
+		return
+	}
+	err = dao.db.QueryRowsPartial(ctx, &values, query, a...)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("queryx in SelectListByUserIdList(_), error: %v", err)
+		return
+	}
+
+	rList = values
+
+	if cb != nil {
+		for i := 0; i < len(rList); i++ {
+			cb(i, &rList[i])
+		}
+	}
+
+	return
+}
+
+// SelectParticipantIdList
+// select user_id from channel_participants where channel_id = :channel_id and state = 0
+// TODO(@benqi): sqlmap
+func (dao *ChannelParticipantsDAO) SelectParticipantIdList(ctx context.Context, channel_id int64) (rList []int64, err error) {
+	var query = "select user_id from channel_participants where channel_id = ? and state = 0"
+	err = dao.db.QueryRowsPartial(ctx, &rList, query, channel_id)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("select in SelectParticipantIdList(_), error: %v", err)
+	}
+
+	return
+}
+
+// SelectParticipantIdListWithCB
+// select user_id from channel_participants where channel_id = :channel_id and state = 0
+// TODO(@benqi): sqlmap
+func (dao *ChannelParticipantsDAO) SelectParticipantIdListWithCB(ctx context.Context, channel_id int64, cb func(i int, v int64)) (rList []int64, err error) {
+	var query = "select user_id from channel_participants where channel_id = ? and state = 0"
+	err = dao.db.QueryRowsPartial(ctx, &rList, query, channel_id)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("select in SelectParticipantIdList(_), error: %v", err)
+	}
+
+	if cb != nil {
+		for i := 0; i < len(rList); i++ {
+			cb(i, rList[i])
+		}
+	}
+
+	return
+}
+
+// SelectRecentList
+// select id, channel_id, user_id, participant_type, state, inviter_user_id, admin_rights, banned_rights, `rank`, promoted_by, kicked_by, read_inbox_max_id, available_min_id, date2 from channel_participants where channel_id = :channel_id and state = 0 order by id limit :offset, :limit
+// TODO(@benqi): sqlmap
+func (dao *ChannelParticipantsDAO) SelectRecentList(ctx context.Context, channel_id int64, offset int32, limit int32) (rList []dataobject.ChannelParticipantsDO, err error) {
+	var (
+		query  = "select id, channel_id, user_id, participant_type, state, inviter_user_id, admin_rights, banned_rights, `rank`, promoted_by, kicked_by, read_inbox_max_id, available_min_id, date2 from channel_participants where channel_id = ? and state = 0 order by id limit ?, ?"
+		values []dataobject.ChannelParticipantsDO
+	)
+	err = dao.db.QueryRowsPartial(ctx, &values, query, channel_id, offset, limit)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("queryx in SelectRecentList(_), error: %v", err)
+		return
+	}
+
+	rList = values
+
+	return
+}
+
+// SelectRecentListWithCB
+// select id, channel_id, user_id, participant_type, state, inviter_user_id, admin_rights, banned_rights, `rank`, promoted_by, kicked_by, read_inbox_max_id, available_min_id, date2 from channel_participants where channel_id = :channel_id and state = 0 order by id limit :offset, :limit
+// TODO(@benqi): sqlmap
+func (dao *ChannelParticipantsDAO) SelectRecentListWithCB(ctx context.Context, channel_id int64, offset int32, limit int32, cb func(i int, v *dataobject.ChannelParticipantsDO)) (rList []dataobject.ChannelParticipantsDO, err error) {
+	var (
+		query  = "select id, channel_id, user_id, participant_type, state, inviter_user_id, admin_rights, banned_rights, `rank`, promoted_by, kicked_by, read_inbox_max_id, available_min_id, date2 from channel_participants where channel_id = ? and state = 0 order by id limit ?, ?"
+		values []dataobject.ChannelParticipantsDO
+	)
+	err = dao.db.QueryRowsPartial(ctx, &values, query, channel_id, offset, limit)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("queryx in SelectRecentList(_), error: %v", err)
+		return
+	}
+
+	rList = values
+
+	if cb != nil {
+		for i := 0; i < len(rList); i++ {
+			cb(i, &rList[i])
+		}
+	}
+
+	return
+}
+
+// SelectAdminList
+// select id, channel_id, user_id, participant_type, state, inviter_user_id, admin_rights, banned_rights, `rank`, promoted_by, kicked_by, read_inbox_max_id, available_min_id, date2 from channel_participants where channel_id = :channel_id and participant_type in (1, 2) and state = 0
+// TODO(@benqi): sqlmap
+func (dao *ChannelParticipantsDAO) SelectAdminList(ctx context.Context, channel_id int64) (rList []dataobject.ChannelParticipantsDO, err error) {
+	var (
+		query  = "select id, channel_id, user_id, participant_type, state, inviter_user_id, admin_rights, banned_rights, `rank`, promoted_by, kicked_by, read_inbox_max_id, available_min_id, date2 from channel_participants where channel_id = ? and participant_type in (1, 2) and state = 0"
+		values []dataobject.ChannelParticipantsDO
+	)
+	err = dao.db.QueryRowsPartial(ctx, &values, query, channel_id)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("queryx in SelectAdminList(_), error: %v", err)
+		return
+	}
+
+	rList = values
+
+	return
+}
+
+// SelectAdminListWithCB
+// select id, channel_id, user_id, participant_type, state, inviter_user_id, admin_rights, banned_rights, `rank`, promoted_by, kicked_by, read_inbox_max_id, available_min_id, date2 from channel_participants where channel_id = :channel_id and participant_type in (1, 2) and state = 0
+// TODO(@benqi): sqlmap
+func (dao *ChannelParticipantsDAO) SelectAdminListWithCB(ctx context.Context, channel_id int64, cb func(i int, v *dataobject.ChannelParticipantsDO)) (rList []dataobject.ChannelParticipantsDO, err error) {
+	var (
+		query  = "select id, channel_id, user_id, participant_type, state, inviter_user_id, admin_rights, banned_rights, `rank`, promoted_by, kicked_by, read_inbox_max_id, available_min_id, date2 from channel_participants where channel_id = ? and participant_type in (1, 2) and state = 0"
+		values []dataobject.ChannelParticipantsDO
+	)
+	err = dao.db.QueryRowsPartial(ctx, &values, query, channel_id)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("queryx in SelectAdminList(_), error: %v", err)
+		return
+	}
+
+	rList = values
+
+	if cb != nil {
+		for i := 0; i < len(rList); i++ {
+			cb(i, &rList[i])
+		}
+	}
+
+	return
+}
+
+// SelectBannedList
+// select id, channel_id, user_id, participant_type, state, inviter_user_id, admin_rights, banned_rights, `rank`, promoted_by, kicked_by, read_inbox_max_id, available_min_id, date2 from channel_participants where channel_id = :channel_id and participant_type = 4 and state = 0
+// TODO(@benqi): sqlmap
+func (dao *ChannelParticipantsDAO) SelectBannedList(ctx context.Context, channel_id int64) (rList []dataobject.ChannelParticipantsDO, err error) {
+	var (
+		query  = "select id, channel_id, user_id, participant_type, state, inviter_user_id, admin_rights, banned_rights, `rank`, promoted_by, kicked_by, read_inbox_max_id, available_min_id, date2 from channel_participants where channel_id = ? and participant_type = 4 and state = 0"
+		values []dataobject.ChannelParticipantsDO
+	)
+	err = dao.db.QueryRowsPartial(ctx, &values, query, channel_id)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("queryx in SelectBannedList(_), error: %v", err)
+		return
+	}
+
+	rList = values
+
+	return
+}
+
+// SelectBannedListWithCB
+// select id, channel_id, user_id, participant_type, state, inviter_user_id, admin_rights, banned_rights, `rank`, promoted_by, kicked_by, read_inbox_max_id, available_min_id, date2 from channel_participants where channel_id = :channel_id and participant_type = 4 and state = 0
+// TODO(@benqi): sqlmap
+func (dao *ChannelParticipantsDAO) SelectBannedListWithCB(ctx context.Context, channel_id int64, cb func(i int, v *dataobject.ChannelParticipantsDO)) (rList []dataobject.ChannelParticipantsDO, err error) {
+	var (
+		query  = "select id, channel_id, user_id, participant_type, state, inviter_user_id, admin_rights, banned_rights, `rank`, promoted_by, kicked_by, read_inbox_max_id, available_min_id, date2 from channel_participants where channel_id = ? and participant_type = 4 and state = 0"
+		values []dataobject.ChannelParticipantsDO
+	)
+	err = dao.db.QueryRowsPartial(ctx, &values, query, channel_id)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("queryx in SelectBannedList(_), error: %v", err)
+		return
+	}
+
+	rList = values
+
+	if cb != nil {
+		for i := 0; i < len(rList); i++ {
+			cb(i, &rList[i])
+		}
+	}
+
+	return
+}
+
+// SelectKickedList
+// select id, channel_id, user_id, participant_type, state, inviter_user_id, admin_rights, banned_rights, `rank`, promoted_by, kicked_by, read_inbox_max_id, available_min_id, date2 from channel_participants where channel_id = :channel_id and state = 2
+// TODO(@benqi): sqlmap
+func (dao *ChannelParticipantsDAO) SelectKickedList(ctx context.Context, channel_id int64) (rList []dataobject.ChannelParticipantsDO, err error) {
+	var (
+		query  = "select id, channel_id, user_id, participant_type, state, inviter_user_id, admin_rights, banned_rights, `rank`, promoted_by, kicked_by, read_inbox_max_id, available_min_id, date2 from channel_participants where channel_id = ? and state = 2"
+		values []dataobject.ChannelParticipantsDO
+	)
+	err = dao.db.QueryRowsPartial(ctx, &values, query, channel_id)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("queryx in SelectKickedList(_), error: %v", err)
+		return
+	}
+
+	rList = values
+
+	return
+}
+
+// SelectKickedListWithCB
+// select id, channel_id, user_id, participant_type, state, inviter_user_id, admin_rights, banned_rights, `rank`, promoted_by, kicked_by, read_inbox_max_id, available_min_id, date2 from channel_participants where channel_id = :channel_id and state = 2
+// TODO(@benqi): sqlmap
+func (dao *ChannelParticipantsDAO) SelectKickedListWithCB(ctx context.Context, channel_id int64, cb func(i int, v *dataobject.ChannelParticipantsDO)) (rList []dataobject.ChannelParticipantsDO, err error) {
+	var (
+		query  = "select id, channel_id, user_id, participant_type, state, inviter_user_id, admin_rights, banned_rights, `rank`, promoted_by, kicked_by, read_inbox_max_id, available_min_id, date2 from channel_participants where channel_id = ? and state = 2"
+		values []dataobject.ChannelParticipantsDO
+	)
+	err = dao.db.QueryRowsPartial(ctx, &values, query, channel_id)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("queryx in SelectKickedList(_), error: %v", err)
+		return
+	}
+
+	rList = values
+
+	if cb != nil {
+		for i := 0; i < len(rList); i++ {
+			cb(i, &rList[i])
+		}
+	}
+
+	return
+}
+
+// SelectMyChannelIdList
+// select channel_id from channel_participants where user_id = :user_id and state = 0
+// TODO(@benqi): sqlmap
+func (dao *ChannelParticipantsDAO) SelectMyChannelIdList(ctx context.Context, user_id int64) (rList []int64, err error) {
+	var query = "select channel_id from channel_participants where user_id = ? and state = 0"
+	err = dao.db.QueryRowsPartial(ctx, &rList, query, user_id)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("select in SelectMyChannelIdList(_), error: %v", err)
+	}
+
+	return
+}
+
+// SelectMyChannelIdListWithCB
+// select channel_id from channel_participants where user_id = :user_id and state = 0
+// TODO(@benqi): sqlmap
+func (dao *ChannelParticipantsDAO) SelectMyChannelIdListWithCB(ctx context.Context, user_id int64, cb func(i int, v int64)) (rList []int64, err error) {
+	var query = "select channel_id from channel_participants where user_id = ? and state = 0"
+	err = dao.db.QueryRowsPartial(ctx, &rList, query, user_id)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("select in SelectMyChannelIdList(_), error: %v", err)
+	}
+
+	if cb != nil {
+		for i := 0; i < len(rList); i++ {
+			cb(i, rList[i])
+		}
+	}
+
+	return
+}
+
+// UpdateLeft
+// update channel_participants set state = 1 where channel_id = :channel_id and user_id = :user_id
+// TODO(@benqi): sqlmap
+func (dao *ChannelParticipantsDAO) UpdateLeft(ctx context.Context, channel_id int64, user_id int64) (rowsAffected int64, err error) {
+	var (
+		query   = "update channel_participants set state = 1 where channel_id = ? and user_id = ?"
+		rResult sql.Result
+	)
+	rResult, err = dao.db.Exec(ctx, query, channel_id, user_id)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("exec in UpdateLeft(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(ctx).Errorf("rowsAffected in UpdateLeft(_), error: %v", err)
+	}
+
+	return
+}
+
+// update channel_participants set state = 1 where channel_id = :channel_id and user_id = :user_id
+// UpdateLeftTx
+// TODO(@benqi): sqlmap
+func (dao *ChannelParticipantsDAO) UpdateLeftTx(tx *sqlx.Tx, channel_id int64, user_id int64) (rowsAffected int64, err error) {
+	var (
+		query   = "update channel_participants set state = 1 where channel_id = ? and user_id = ?"
+		rResult sql.Result
+	)
+	rResult, err = tx.Exec(query, channel_id, user_id)
+
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("exec in UpdateLeft(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("rowsAffected in UpdateLeft(_), error: %v", err)
+	}
+
+	return
+}
+
+// UpdateKicked
+// update channel_participants set state = 2, banned_rights = :banned_rights, kicked_by = :kicked_by where channel_id = :channel_id and user_id = :user_id
+// TODO(@benqi): sqlmap
+func (dao *ChannelParticipantsDAO) UpdateKicked(ctx context.Context, banned_rights int64, kicked_by int64, channel_id int64, user_id int64) (rowsAffected int64, err error) {
+	var (
+		query   = "update channel_participants set state = 2, banned_rights = ?, kicked_by = ? where channel_id = ? and user_id = ?"
+		rResult sql.Result
+	)
+	rResult, err = dao.db.Exec(ctx, query, banned_rights, kicked_by, channel_id, user_id)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("exec in UpdateKicked(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(ctx).Errorf("rowsAffected in UpdateKicked(_), error: %v", err)
+	}
+
+	return
+}
+
+// update channel_participants set state = 2, banned_rights = :banned_rights, kicked_by = :kicked_by where channel_id = :channel_id and user_id = :user_id
+// UpdateKickedTx
+// TODO(@benqi): sqlmap
+func (dao *ChannelParticipantsDAO) UpdateKickedTx(tx *sqlx.Tx, banned_rights int64, kicked_by int64, channel_id int64, user_id int64) (rowsAffected int64, err error) {
+	var (
+		query   = "update channel_participants set state = 2, banned_rights = ?, kicked_by = ? where channel_id = ? and user_id = ?"
+		rResult sql.Result
+	)
+	rResult, err = tx.Exec(query, banned_rights, kicked_by, channel_id, user_id)
+
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("exec in UpdateKicked(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("rowsAffected in UpdateKicked(_), error: %v", err)
+	}
+
+	return
+}
+
+// UpdateBanned
+// update channel_participants set participant_type = :participant_type, banned_rights = :banned_rights, kicked_by = :kicked_by where channel_id = :channel_id and user_id = :user_id
+// TODO(@benqi): sqlmap
+func (dao *ChannelParticipantsDAO) UpdateBanned(ctx context.Context, participant_type int32, banned_rights int64, kicked_by int64, channel_id int64, user_id int64) (rowsAffected int64, err error) {
+	var (
+		query   = "update channel_participants set participant_type = ?, banned_rights = ?, kicked_by = ? where channel_id = ? and user_id = ?"
+		rResult sql.Result
+	)
+	rResult, err = dao.db.Exec(ctx, query, participant_type, banned_rights, kicked_by, channel_id, user_id)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("exec in UpdateBanned(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(ctx).Errorf("rowsAffected in UpdateBanned(_), error: %v", err)
+	}
+
+	return
+}
+
+// update channel_participants set participant_type = :participant_type, banned_rights = :banned_rights, kicked_by = :kicked_by where channel_id = :channel_id and user_id = :user_id
+// UpdateBannedTx
+// TODO(@benqi): sqlmap
+func (dao *ChannelParticipantsDAO) UpdateBannedTx(tx *sqlx.Tx, participant_type int32, banned_rights int64, kicked_by int64, channel_id int64, user_id int64) (rowsAffected int64, err error) {
+	var (
+		query   = "update channel_participants set participant_type = ?, banned_rights = ?, kicked_by = ? where channel_id = ? and user_id = ?"
+		rResult sql.Result
+	)
+	rResult, err = tx.Exec(query, participant_type, banned_rights, kicked_by, channel_id, user_id)
+
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("exec in UpdateBanned(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("rowsAffected in UpdateBanned(_), error: %v", err)
+	}
+
+	return
+}
+
+// UpdateAdmin
+// update channel_participants set participant_type = :participant_type, admin_rights = :admin_rights, `rank` = :rank, promoted_by = :promoted_by where channel_id = :channel_id and user_id = :user_id
+// TODO(@benqi): sqlmap
+func (dao *ChannelParticipantsDAO) UpdateAdmin(ctx context.Context, participant_type int32, admin_rights int32, rank string, promoted_by int64, channel_id int64, user_id int64) (rowsAffected int64, err error) {
+	var (
+		query   = "update channel_participants set participant_type = ?, admin_rights = ?, `rank` = ?, promoted_by = ? where channel_id = ? and user_id = ?"
+		rResult sql.Result
+	)
+	rResult, err = dao.db.Exec(ctx, query, participant_type, admin_rights, rank, promoted_by, channel_id, user_id)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("exec in UpdateAdmin(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(ctx).Errorf("rowsAffected in UpdateAdmin(_), error: %v", err)
+	}
+
+	return
+}
+
+// update channel_participants set participant_type = :participant_type, admin_rights = :admin_rights, `rank` = :rank, promoted_by = :promoted_by where channel_id = :channel_id and user_id = :user_id
+// UpdateAdminTx
+// TODO(@benqi): sqlmap
+func (dao *ChannelParticipantsDAO) UpdateAdminTx(tx *sqlx.Tx, participant_type int32, admin_rights int32, rank string, promoted_by int64, channel_id int64, user_id int64) (rowsAffected int64, err error) {
+	var (
+		query   = "update channel_participants set participant_type = ?, admin_rights = ?, `rank` = ?, promoted_by = ? where channel_id = ? and user_id = ?"
+		rResult sql.Result
+	)
+	rResult, err = tx.Exec(query, participant_type, admin_rights, rank, promoted_by, channel_id, user_id)
+
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("exec in UpdateAdmin(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("rowsAffected in UpdateAdmin(_), error: %v", err)
+	}
+
+	return
+}
+
+// UpdateReadInboxMaxId
+// update channel_participants set read_inbox_max_id = :read_inbox_max_id where channel_id = :channel_id and user_id = :user_id and read_inbox_max_id < :read_inbox_max_id
+// TODO(@benqi): sqlmap
+func (dao *ChannelParticipantsDAO) UpdateReadInboxMaxId(ctx context.Context, read_inbox_max_id int32, channel_id int64, user_id int64) (rowsAffected int64, err error) {
+	var (
+		query   = "update channel_participants set read_inbox_max_id = ? where channel_id = ? and user_id = ? and read_inbox_max_id < ?"
+		rResult sql.Result
+	)
+	rResult, err = dao.db.Exec(ctx, query, read_inbox_max_id, channel_id, user_id, read_inbox_max_id)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("exec in UpdateReadInboxMaxId(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(ctx).Errorf("rowsAffected in UpdateReadInboxMaxId(_), error: %v", err)
+	}
+
+	return
+}
+
+// update channel_participants set read_inbox_max_id = :read_inbox_max_id where channel_id = :channel_id and user_id = :user_id and read_inbox_max_id < :read_inbox_max_id
+// UpdateReadInboxMaxIdTx
+// TODO(@benqi): sqlmap
+func (dao *ChannelParticipantsDAO) UpdateReadInboxMaxIdTx(tx *sqlx.Tx, read_inbox_max_id int32, channel_id int64, user_id int64) (rowsAffected int64, err error) {
+	var (
+		query   = "update channel_participants set read_inbox_max_id = ? where channel_id = ? and user_id = ? and read_inbox_max_id < ?"
+		rResult sql.Result
+	)
+	rResult, err = tx.Exec(query, read_inbox_max_id, channel_id, user_id, read_inbox_max_id)
+
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("exec in UpdateReadInboxMaxId(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("rowsAffected in UpdateReadInboxMaxId(_), error: %v", err)
+	}
+
+	return
+}
+
+// UpdateAvailableMinId
+// update channel_participants set available_min_id = :available_min_id where channel_id = :channel_id and user_id = :user_id and available_min_id < :available_min_id
+// TODO(@benqi): sqlmap
+func (dao *ChannelParticipantsDAO) UpdateAvailableMinId(ctx context.Context, available_min_id int32, channel_id int64, user_id int64) (rowsAffected int64, err error) {
+	var (
+		query   = "update channel_participants set available_min_id = ? where channel_id = ? and user_id = ? and available_min_id < ?"
+		rResult sql.Result
+	)
+	rResult, err = dao.db.Exec(ctx, query, available_min_id, channel_id, user_id, available_min_id)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("exec in UpdateAvailableMinId(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(ctx).Errorf("rowsAffected in UpdateAvailableMinId(_), error: %v", err)
+	}
+
+	return
+}
+
+// update channel_participants set available_min_id = :available_min_id where channel_id = :channel_id and user_id = :user_id and available_min_id < :available_min_id
+// UpdateAvailableMinIdTx
+// TODO(@benqi): sqlmap
+func (dao *ChannelParticipantsDAO) UpdateAvailableMinIdTx(tx *sqlx.Tx, available_min_id int32, channel_id int64, user_id int64) (rowsAffected int64, err error) {
+	var (
+		query   = "update channel_participants set available_min_id = ? where channel_id = ? and user_id = ? and available_min_id < ?"
+		rResult sql.Result
+	)
+	rResult, err = tx.Exec(query, available_min_id, channel_id, user_id, available_min_id)
+
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("exec in UpdateAvailableMinId(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("rowsAffected in UpdateAvailableMinId(_), error: %v", err)
+	}
+
+	return
+}
@@ -0,0 +1,597 @@
+/*
+ * WARNING! All changes made in this file will be lost!
+ *   Created from by 'dalgen'
+ *
+ * Copyright (c) 2022-present,  Teamgram Authors.
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package mysql_dao
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/teamgram/marmota/pkg/stores/sqlx"
+	"github.com/teamgram/teamgram-server/app/service/biz/channel/internal/dal/dataobject"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+var _ *sql.Result
+
+type ChannelsDAO struct {
+	db *sqlx.DB
+}
+
+func NewChannelsDAO(db *sqlx.DB) *ChannelsDAO {
+	return &ChannelsDAO{db}
+}
+
+// Insert
+// insert into channels(creator_user_id, access_hash, random_id, broadcast, megagroup, title, about, participants_count, default_banned_rights, date2) values (:creator_user_id, :access_hash, :random_id, :broadcast, :megagroup, :title, :about, :participants_count, :default_banned_rights, :date2)
+// TODO(@benqi): sqlmap
+func (dao *ChannelsDAO) Insert(ctx context.Context, do *dataobject.ChannelsDO) (lastInsertId, rowsAffected int64, err error) {
+	var (
+		query = "insert into channels(creator_user_id, access_hash, random_id, broadcast, megagroup, title, about, participants_count, default_banned_rights, date2) values (:creator_user_id, :access_hash, :random_id, :broadcast, :megagroup, :title, :about, :participants_count, :default_banned_rights, :date2)"
+		r     sql.Result
+	)
+
+	r, err = dao.db.NamedExec(ctx, query, do)
+	if err != nil {
+		logx.WithContext(ctx).Errorf("namedExec in Insert(%v), error: %v", do, err)
+		return
+	}
+
+	lastInsertId, err = r.LastInsertId()
+	if err != nil {
+		logx.WithContext(ctx).Errorf("lastInsertId in Insert(%v)_error: %v", do, err)
+		return
+	}
+	rowsAffected, err = r.RowsAffected()
+	if err != nil {
+		logx.WithContext(ctx).Errorf("rowsAffected in Insert(%v)_error: %v", do, err)
+	}
+
+	return
+}
+
+// InsertTx
+// insert into channels(creator_user_id, access_hash, random_id, broadcast, megagroup, title, about, participants_count, default_banned_rights, date2) values (:creator_user_id, :access_hash, :random_id, :broadcast, :megagroup, :title, :about, :participants_count, :default_banned_rights, :date2)
+// TODO(@benqi): sqlmap
+func (dao *ChannelsDAO) InsertTx(tx *sqlx.Tx, do *dataobject.ChannelsDO) (lastInsertId, rowsAffected int64, err error) {
+	var (
+		query = "insert into channels(creator_user_id, access_hash, random_id, broadcast, megagroup, title, about, participants_count, default_banned_rights, date2) values (:creator_user_id, :access_hash, :random_id, :broadcast, :megagroup, :title, :about, :participants_count, :default_banned_rights, :date2)"
+		r     sql.Result
+	)
+
+	r, err = tx.NamedExec(query, do)
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("namedExec in Insert(%v), error: %v", do, err)
+		return
+	}
+
+	lastInsertId, err = r.LastInsertId()
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("lastInsertId in Insert(%v)_error: %v", do, err)
+		return
+	}
+	rowsAffected, err = r.RowsAffected()
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("rowsAffected in Insert(%v)_error: %v", do, err)
+	}
+
+	return
+}
+
+// Select
+// select id, creator_user_id, access_hash, broadcast, megagroup, signatures, noforwards, title, about, photo_id, username, participants_count, default_banned_rights, pts, top_message, deactivated, hidden_prehistory, slowmode_seconds, version, date2 from channels where id = :id
+// TODO(@benqi): sqlmap
+func (dao *ChannelsDAO) Select(ctx context.Context, id int64) (rValue *dataobject.ChannelsDO, err error) {
+	var (
+		query = "select id, creator_user_id, access_hash, broadcast, megagroup, signatures, noforwards, title, about, photo_id, username, participants_count, default_banned_rights, pts, top_message, deactivated, hidden_prehistory, slowmode_seconds, version, date2 from channels where id = ?"
+		do    = &dataobject.ChannelsDO{}
+	)
+	err = dao.db.QueryRowPartial(ctx, do, query, id)
+
+	if err != nil {
+		if err != sqlx.ErrNotFound {
+			logx.WithContext(ctx).Errorf("queryx in Select(_), error: %v", err)
+			return
+		} else {
+			err = nil
+		}
+	} else {
+		rValue = do
+	}
+
+	return
+}
+
+// SelectByIdList
+// select id, creator_user_id, access_hash, broadcast, megagroup, signatures, noforwards, title, about, photo_id, username, participants_count, default_banned_rights, pts, top_message, deactivated, hidden_prehistory, slowmode_seconds, version, date2 from channels where id in (:idList)
+// TODO(@benqi): sqlmap
+func (dao *ChannelsDAO) SelectByIdList(ctx context.Context, idList []int64) (rList []dataobject.ChannelsDO, err error) {
+	var (
+		query  = "select id, creator_user_id, access_hash, broadcast, megagroup, signatures, noforwards, title, about, photo_id, username, participants_count, default_banned_rights, pts, top_message, deactivated, hidden_prehistory, slowmode_seconds, version, date2 from channels where id in (?)"
+		a      []interface{}
+		values []dataobject.ChannelsDO
+	)
+
+	if len(idList) == 0 {
+		rList = []dataobject.ChannelsDO{}
+		return
+	}
+
+	query, a, err = sqlx.In(query, idList)
+	if err != nil {
+		// r sql.Result
+		logx.WithContext(ctx).Errorf("sqlx.In in SelectByIdList(_), error: %v", err)
+		return
+	}
+	err = dao.db.QueryRowsPartial(ctx, &values, query, a...)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("queryx in SelectByIdList(_), error: %v", err)
+		return
+	}
+
+	rList = values
+
+	return
+}
+
+// SelectByIdListWithCB
+// select id, creator_user_id, access_hash, broadcast, megagroup, signatures, noforwards, title, about, photo_id, username, participants_count, default_banned_rights, pts, top_message, deactivated, hidden_prehistory, slowmode_seconds, version, date2 from channels where id in (:idList)
+// TODO(@benqi): sqlmap
+func (dao *ChannelsDAO) SelectByIdListWithCB(ctx context.Context, idList []int64, cb func(i int, v *dataobject.ChannelsDO)) (rList []dataobject.ChannelsDO, err error) {
+	var (
+		query  = "select id, creator_user_id, access_hash, broadcast, megagroup, signatures, noforwards, title, about, photo_id, username, participants_count, default_banned_rights, pts, top_message, deactivated, hidden_prehistory, slowmode_seconds, version, date2 from channels where id in (?)"
+		a      []interface{}
+		values []dataobject.ChannelsDO
+	)
+
+	if len(idList) == 0 {
+		rList = []dataobject.ChannelsDO{}
+		return
+	}
+
+	query, a, err = sqlx.In(query, idList)
+	if err != nil {
+		// r sql.Result
+		logx.WithContext(ctx).Errorf("sqlx.In in SelectByIdList(_), error: %v", err)
+		return
+	}
+	err = dao.db.QueryRowsPartial(ctx, &values, query, a...)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("queryx in SelectByIdList(_), error: %v", err)
+		return
+	}
+
+	rList = values
+
+	if cb != nil {
+		for i := 0; i < len(rList); i++ {
+			cb(i, &rList[i])
+		}
+	}
+
+	return
+}
+
+// UpdateTopMessage
+// update channels set top_message = :top_message, pts = :pts where id = :id and top_message < :top_message
+// TODO(@benqi): sqlmap
+func (dao *ChannelsDAO) UpdateTopMessage(ctx context.Context, top_message int32, pts int32, id int64) (rowsAffected int64, err error) {
+	var (
+		query   = "update channels set top_message = ?, pts = ? where id = ? and top_message < ?"
+		rResult sql.Result
+	)
+	rResult, err = dao.db.Exec(ctx, query, top_message, pts, id, top_message)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("exec in UpdateTopMessage(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(ctx).Errorf("rowsAffected in UpdateTopMessage(_), error: %v", err)
+	}
+
+	return
+}
+
+// update channels set top_message = :top_message, pts = :pts where id = :id and top_message < :top_message
+// UpdateTopMessageTx
+// TODO(@benqi): sqlmap
+func (dao *ChannelsDAO) UpdateTopMessageTx(tx *sqlx.Tx, top_message int32, pts int32, id int64) (rowsAffected int64, err error) {
+	var (
+		query   = "update channels set top_message = ?, pts = ? where id = ? and top_message < ?"
+		rResult sql.Result
+	)
+	rResult, err = tx.Exec(query, top_message, pts, id, top_message)
+
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("exec in UpdateTopMessage(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("rowsAffected in UpdateTopMessage(_), error: %v", err)
+	}
+
+	return
+}
+
+// UpdateParticipantsCount
+// update channels set participants_count = :participants_count, version = version + 1 where id = :id
+// TODO(@benqi): sqlmap
+func (dao *ChannelsDAO) UpdateParticipantsCount(ctx context.Context, participants_count int32, id int64) (rowsAffected int64, err error) {
+	var (
+		query   = "update channels set participants_count = ?, version = version + 1 where id = ?"
+		rResult sql.Result
+	)
+	rResult, err = dao.db.Exec(ctx, query, participants_count, id)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("exec in UpdateParticipantsCount(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(ctx).Errorf("rowsAffected in UpdateParticipantsCount(_), error: %v", err)
+	}
+
+	return
+}
+
+// update channels set participants_count = :participants_count, version = version + 1 where id = :id
+// UpdateParticipantsCountTx
+// TODO(@benqi): sqlmap
+func (dao *ChannelsDAO) UpdateParticipantsCountTx(tx *sqlx.Tx, participants_count int32, id int64) (rowsAffected int64, err error) {
+	var (
+		query   = "update channels set participants_count = ?, version = version + 1 where id = ?"
+		rResult sql.Result
+	)
+	rResult, err = tx.Exec(query, participants_count, id)
+
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("exec in UpdateParticipantsCount(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("rowsAffected in UpdateParticipantsCount(_), error: %v", err)
+	}
+
+	return
+}
+
+// UpdateTitle
+// update channels set title = :title, version = version + 1 where id = :id
+// TODO(@benqi): sqlmap
+func (dao *ChannelsDAO) UpdateTitle(ctx context.Context, title string, id int64) (rowsAffected int64, err error) {
+	var (
+		query   = "update channels set title = ?, version = version + 1 where id = ?"
+		rResult sql.Result
+	)
+	rResult, err = dao.db.Exec(ctx, query, title, id)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("exec in UpdateTitle(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(ctx).Errorf("rowsAffected in UpdateTitle(_), error: %v", err)
+	}
+
+	return
+}
+
+// update channels set title = :title, version = version + 1 where id = :id
+// UpdateTitleTx
+// TODO(@benqi): sqlmap
+func (dao *ChannelsDAO) UpdateTitleTx(tx *sqlx.Tx, title string, id int64) (rowsAffected int64, err error) {
+	var (
+		query   = "update channels set title = ?, version = version + 1 where id = ?"
+		rResult sql.Result
+	)
+	rResult, err = tx.Exec(query, title, id)
+
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("exec in UpdateTitle(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("rowsAffected in UpdateTitle(_), error: %v", err)
+	}
+
+	return
+}
+
+// UpdatePhotoId
+// update channels set photo_id = :photo_id, version = version + 1 where id = :id
+// TODO(@benqi): sqlmap
+func (dao *ChannelsDAO) UpdatePhotoId(ctx context.Context, photo_id int64, id int64) (rowsAffected int64, err error) {
+	var (
+		query   = "update channels set photo_id = ?, version = version + 1 where id = ?"
+		rResult sql.Result
+	)
+	rResult, err = dao.db.Exec(ctx, query, photo_id, id)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("exec in UpdatePhotoId(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(ctx).Errorf("rowsAffected in UpdatePhotoId(_), error: %v", err)
+	}
+
+	return
+}
+
+// update channels set photo_id = :photo_id, version = version + 1 where id = :id
+// UpdatePhotoIdTx
+// TODO(@benqi): sqlmap
+func (dao *ChannelsDAO) UpdatePhotoIdTx(tx *sqlx.Tx, photo_id int64, id int64) (rowsAffected int64, err error) {
+	var (
+		query   = "update channels set photo_id = ?, version = version + 1 where id = ?"
+		rResult sql.Result
+	)
+	rResult, err = tx.Exec(query, photo_id, id)
+
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("exec in UpdatePhotoId(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("rowsAffected in UpdatePhotoId(_), error: %v", err)
+	}
+
+	return
+}
+
+// UpdateSignatures
+// update channels set signatures = :signatures, version = version + 1 where id = :id
+// TODO(@benqi): sqlmap
+func (dao *ChannelsDAO) UpdateSignatures(ctx context.Context, signatures bool, id int64) (rowsAffected int64, err error) {
+	var (
+		query   = "update channels set signatures = ?, version = version + 1 where id = ?"
+		rResult sql.Result
+	)
+	rResult, err = dao.db.Exec(ctx, query, signatures, id)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("exec in UpdateSignatures(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(ctx).Errorf("rowsAffected in UpdateSignatures(_), error: %v", err)
+	}
+
+	return
+}
+
+// update channels set signatures = :signatures, version = version + 1 where id = :id
+// UpdateSignaturesTx
+// TODO(@benqi): sqlmap
+func (dao *ChannelsDAO) UpdateSignaturesTx(tx *sqlx.Tx, signatures bool, id int64) (rowsAffected int64, err error) {
+	var (
+		query   = "update channels set signatures = ?, version = version + 1 where id = ?"
+		rResult sql.Result
+	)
+	rResult, err = tx.Exec(query, signatures, id)
+
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("exec in UpdateSignatures(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("rowsAffected in UpdateSignatures(_), error: %v", err)
+	}
+
+	return
+}
+
+// UpdateHiddenPrehistory
+// update channels set hidden_prehistory = :hidden_prehistory, version = version + 1 where id = :id
+// TODO(@benqi): sqlmap
+func (dao *ChannelsDAO) UpdateHiddenPrehistory(ctx context.Context, hidden_prehistory bool, id int64) (rowsAffected int64, err error) {
+	var (
+		query   = "update channels set hidden_prehistory = ?, version = version + 1 where id = ?"
+		rResult sql.Result
+	)
+	rResult, err = dao.db.Exec(ctx, query, hidden_prehistory, id)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("exec in UpdateHiddenPrehistory(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(ctx).Errorf("rowsAffected in UpdateHiddenPrehistory(_), error: %v", err)
+	}
+
+	return
+}
+
+// update channels set hidden_prehistory = :hidden_prehistory, version = version + 1 where id = :id
+// UpdateHiddenPrehistoryTx
+// TODO(@benqi): sqlmap
+func (dao *ChannelsDAO) UpdateHiddenPrehistoryTx(tx *sqlx.Tx, hidden_prehistory bool, id int64) (rowsAffected int64, err error) {
+	var (
+		query   = "update channels set hidden_prehistory = ?, version = version + 1 where id = ?"
+		rResult sql.Result
+	)
+	rResult, err = tx.Exec(query, hidden_prehistory, id)
+
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("exec in UpdateHiddenPrehistory(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("rowsAffected in UpdateHiddenPrehistory(_), error: %v", err)
+	}
+
+	return
+}
+
+// UpdateSlowmodeSeconds
+// update channels set slowmode_seconds = :slowmode_seconds, version = version + 1 where id = :id
+// TODO(@benqi): sqlmap
+func (dao *ChannelsDAO) UpdateSlowmodeSeconds(ctx context.Context, slowmode_seconds int32, id int64) (rowsAffected int64, err error) {
+	var (
+		query   = "update channels set slowmode_seconds = ?, version = version + 1 where id = ?"
+		rResult sql.Result
+	)
+	rResult, err = dao.db.Exec(ctx, query, slowmode_seconds, id)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("exec in UpdateSlowmodeSeconds(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(ctx).Errorf("rowsAffected in UpdateSlowmodeSeconds(_), error: %v", err)
+	}
+
+	return
+}
+
+// update channels set slowmode_seconds = :slowmode_seconds, version = version + 1 where id = :id
+// UpdateSlowmodeSecondsTx
+// TODO(@benqi): sqlmap
+func (dao *ChannelsDAO) UpdateSlowmodeSecondsTx(tx *sqlx.Tx, slowmode_seconds int32, id int64) (rowsAffected int64, err error) {
+	var (
+		query   = "update channels set slowmode_seconds = ?, version = version + 1 where id = ?"
+		rResult sql.Result
+	)
+	rResult, err = tx.Exec(query, slowmode_seconds, id)
+
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("exec in UpdateSlowmodeSeconds(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("rowsAffected in UpdateSlowmodeSeconds(_), error: %v", err)
+	}
+
+	return
+}
+
+// UpdateDeactivated
+// update channels set deactivated = :deactivated, version = version + 1 where id = :id
+// TODO(@benqi): sqlmap
+func (dao *ChannelsDAO) UpdateDeactivated(ctx context.Context, deactivated bool, id int64) (rowsAffected int64, err error) {
+	var (
+		query   = "update channels set deactivated = ?, version = version + 1 where id = ?"
+		rResult sql.Result
+	)
+	rResult, err = dao.db.Exec(ctx, query, deactivated, id)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("exec in UpdateDeactivated(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(ctx).Errorf("rowsAffected in UpdateDeactivated(_), error: %v", err)
+	}
+
+	return
+}
+
+// update channels set deactivated = :deactivated, version = version + 1 where id = :id
+// UpdateDeactivatedTx
+// TODO(@benqi): sqlmap
+func (dao *ChannelsDAO) UpdateDeactivatedTx(tx *sqlx.Tx, deactivated bool, id int64) (rowsAffected int64, err error) {
+	var (
+		query   = "update channels set deactivated = ?, version = version + 1 where id = ?"
+		rResult sql.Result
+	)
+	rResult, err = tx.Exec(query, deactivated, id)
+
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("exec in UpdateDeactivated(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("rowsAffected in UpdateDeactivated(_), error: %v", err)
+	}
+
+	return
+}
+
+// UpdatePts
+// update channels set pts = :pts where id = :id and pts < :pts
+// TODO(@benqi): sqlmap
+func (dao *ChannelsDAO) UpdatePts(ctx context.Context, pts int32, id int64) (rowsAffected int64, err error) {
+	var (
+		query   = "update channels set pts = ? where id = ? and pts < ?"
+		rResult sql.Result
+	)
+	rResult, err = dao.db.Exec(ctx, query, pts, id, pts)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("exec in UpdatePts(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(ctx).Errorf("rowsAffected in UpdatePts(_), error: %v", err)
+	}
+
+	return
+}
+
+// update channels set pts = :pts where id = :id and pts < :pts
+// UpdatePtsTx
+// TODO(@benqi): sqlmap
+func (dao *ChannelsDAO) UpdatePtsTx(tx *sqlx.Tx, pts int32, id int64) (rowsAffected int64, err error) {
+	var (
+		query   = "update channels set pts = ? where id = ? and pts < ?"
+		rResult sql.Result
+	)
+	rResult, err = tx.Exec(query, pts, id, pts)
+
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("exec in UpdatePts(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("rowsAffected in UpdatePts(_), error: %v", err)
+	}
+
+	return
+}
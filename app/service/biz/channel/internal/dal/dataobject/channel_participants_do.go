@@ -0,0 +1,28 @@
+/*
+ * WARNING! All changes made in this file will be lost!
+ *   Created from by 'dalgen'
+ *
+ * Copyright (c) 2022-present,  Teamgram Authors.
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package dataobject
+
+type ChannelParticipantsDO struct {
+	Id              int64  `db:"id"`
+	ChannelId       int64  `db:"channel_id"`
+	UserId          int64  `db:"user_id"`
+	ParticipantType int32  `db:"participant_type"`
+	State           int32  `db:"state"`
+	InviterUserId   int64  `db:"inviter_user_id"`
+	AdminRights     int32  `db:"admin_rights"`
+	BannedRights    int64  `db:"banned_rights"`
+	Rank            string `db:"rank"`
+	PromotedBy      int64  `db:"promoted_by"`
+	KickedBy        int64  `db:"kicked_by"`
+	ReadInboxMaxId  int32  `db:"read_inbox_max_id"`
+	AvailableMinId  int32  `db:"available_min_id"`
+	Date2           int64  `db:"date2"`
+}
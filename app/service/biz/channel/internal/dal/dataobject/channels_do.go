@@ -0,0 +1,35 @@
+/*
+ * WARNING! All changes made in this file will be lost!
+ *   Created from by 'dalgen'
+ *
+ * Copyright (c) 2022-present,  Teamgram Authors.
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package dataobject
+
+type ChannelsDO struct {
+	Id                  int64  `db:"id"`
+	CreatorUserId       int64  `db:"creator_user_id"`
+	AccessHash          int64  `db:"access_hash"`
+	RandomId            int64  `db:"random_id"`
+	Broadcast           bool   `db:"broadcast"`
+	Megagroup           bool   `db:"megagroup"`
+	Signatures          bool   `db:"signatures"`
+	Noforwards          bool   `db:"noforwards"`
+	Title               string `db:"title"`
+	About               string `db:"about"`
+	PhotoId             int64  `db:"photo_id"`
+	Username            string `db:"username"`
+	ParticipantsCount   int32  `db:"participants_count"`
+	DefaultBannedRights int64  `db:"default_banned_rights"`
+	Pts                 int32  `db:"pts"`
+	TopMessage          int32  `db:"top_message"`
+	Deactivated         bool   `db:"deactivated"`
+	HiddenPrehistory    bool   `db:"hidden_prehistory"`
+	SlowmodeSeconds     int32  `db:"slowmode_seconds"`
+	Version             int32  `db:"version"`
+	Date2               int64  `db:"date2"`
+}
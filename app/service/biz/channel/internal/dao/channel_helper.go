@@ -0,0 +1,145 @@
+// Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+//  All rights reserved.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package dao
+
+import (
+	"context"
+
+	"github.com/teamgram/proto/mtproto"
+	channelpb "github.com/teamgram/teamgram-server/app/service/biz/channel/channel"
+	"github.com/teamgram/teamgram-server/app/service/biz/channel/internal/dal/dataobject"
+	"github.com/teamgram/teamgram-server/app/service/media/media"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+func makeChatAdminRights(rights int32) *mtproto.ChatAdminRights {
+	if adminRights := mtproto.AdminRights(rights).ToChatAdminRights(); adminRights != nil {
+		return adminRights
+	}
+	return mtproto.MakeTLChatAdminRights(&mtproto.ChatAdminRights{}).To_ChatAdminRights()
+}
+
+func makeChatBannedRights(rights int64) *mtproto.ChatBannedRights {
+	if bannedRights := mtproto.BannedRights(rights).ToChatBannedRights(); bannedRights != nil {
+		return bannedRights
+	}
+	return mtproto.MakeTLChatBannedRights(&mtproto.ChatBannedRights{}).To_ChatBannedRights()
+}
+
+func (d *Dao) MakeImmutableChannelByDO(channelsDO *dataobject.ChannelsDO) *channelpb.ImmutableChannel {
+	return channelpb.MakeTLImmutableChannel(&channelpb.ImmutableChannel{
+		Id:                  channelsDO.Id,
+		AccessHash:          channelsDO.AccessHash,
+		Creator:             channelsDO.CreatorUserId,
+		Title:               channelsDO.Title,
+		About:               channelsDO.About,
+		Photo:               mtproto.MakeTLPhotoEmpty(nil).To_Photo(),
+		Broadcast:           channelsDO.Broadcast,
+		Megagroup:           channelsDO.Megagroup,
+		Signatures:          channelsDO.Signatures,
+		Noforwards:          channelsDO.Noforwards,
+		Deactivated:         channelsDO.Deactivated,
+		HiddenPrehistory:    channelsDO.HiddenPrehistory,
+		Username:            channelsDO.Username,
+		ParticipantsCount:   channelsDO.ParticipantsCount,
+		DefaultBannedRights: makeChatBannedRights(channelsDO.DefaultBannedRights),
+		SlowmodeSeconds:     channelsDO.SlowmodeSeconds,
+		Pts:                 channelsDO.Pts,
+		TopMessage:          channelsDO.TopMessage,
+		Date:                channelsDO.Date2,
+		Version:             channelsDO.Version,
+	}).To_ImmutableChannel()
+}
+
+func (d *Dao) MakeImmutableChannelParticipant(participantsDO *dataobject.ChannelParticipantsDO) *channelpb.ImmutableChannelParticipant {
+	return channelpb.MakeTLImmutableChannelParticipant(&channelpb.ImmutableChannelParticipant{
+		Id:              participantsDO.Id,
+		ChannelId:       participantsDO.ChannelId,
+		UserId:          participantsDO.UserId,
+		ParticipantType: participantsDO.ParticipantType,
+		State:           participantsDO.State,
+		InviterUserId:   participantsDO.InviterUserId,
+		AdminRights:     makeChatAdminRights(participantsDO.AdminRights),
+		BannedRights:    makeChatBannedRights(participantsDO.BannedRights),
+		Rank:            participantsDO.Rank,
+		PromotedBy:      participantsDO.PromotedBy,
+		KickedBy:        participantsDO.KickedBy,
+		ReadInboxMaxId:  participantsDO.ReadInboxMaxId,
+		AvailableMinId:  participantsDO.AvailableMinId,
+		Date:            participantsDO.Date2,
+	}).To_ImmutableChannelParticipant()
+}
+
+func (d *Dao) GetImmutableChannel(ctx context.Context, channelId int64) (*channelpb.ImmutableChannel, error) {
+	channelsDO, err := d.ChannelsDAO.Select(ctx, channelId)
+	if err != nil {
+		logx.WithContext(ctx).Errorf("getImmutableChannel - error: %v", err)
+		return nil, mtproto.ErrInternelServerError
+	} else if channelsDO == nil {
+		return nil, mtproto.ErrChannelInvalid
+	}
+
+	return d.MakeImmutableChannelByDOWithPhoto(ctx, channelsDO), nil
+}
+
+// MakeImmutableChannelByDOWithPhoto loads the photo of the channel from the media service.
+func (d *Dao) MakeImmutableChannelByDOWithPhoto(ctx context.Context, channelsDO *dataobject.ChannelsDO) *channelpb.ImmutableChannel {
+	channel := d.MakeImmutableChannelByDO(channelsDO)
+	if channelsDO.PhotoId != 0 {
+		photo, err := d.MediaClient.MediaGetPhoto(ctx, &media.TLMediaGetPhoto{
+			PhotoId: channelsDO.PhotoId,
+		})
+		if err != nil {
+			logx.WithContext(ctx).Errorf("getPhoto(%d) - error: %v", channelsDO.PhotoId, err)
+		} else {
+			channel.Photo = photo
+		}
+	}
+
+	return channel
+}
+
+// GetMutableChannel returns the channel and the participant rows of idList, users
+// that have never joined the channel are omitted.
+func (d *Dao) GetMutableChannel(ctx context.Context, channelId int64, idList ...int64) (*channelpb.MutableChannel, error) {
+	channel, err := d.GetImmutableChannel(ctx, channelId)
+	if err != nil {
+		return nil, err
+	}
+
+	mChannel := channelpb.MakeTLMutableChannel(&channelpb.MutableChannel{
+		Channel:      channel,
+		Participants: []*channelpb.ImmutableChannelParticipant{},
+	}).To_MutableChannel()
+
+	if len(idList) > 0 {
+		d.ChannelParticipantsDAO.SelectListByUserIdListWithCB(
+			ctx,
+			channelId,
+			idList,
+			func(i int, v *dataobject.ChannelParticipantsDO) {
+				mChannel.Participants = append(mChannel.Participants, d.MakeImmutableChannelParticipant(v))
+			})
+	}
+
+	return mChannel, nil
+}
+
+// UpdateParticipantsCount recounts the active participants of channelId.
+func (d *Dao) UpdateParticipantsCount(ctx context.Context, channelId int64) (int32, error) {
+	idList, err := d.ChannelParticipantsDAO.SelectParticipantIdList(ctx, channelId)
+	if err != nil {
+		return 0, err
+	}
+
+	if _, err = d.ChannelsDAO.UpdateParticipantsCount(ctx, int32(len(idList)), channelId); err != nil {
+		return 0, err
+	}
+
+	return int32(len(idList)), nil
+}
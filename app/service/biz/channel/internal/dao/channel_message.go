@@ -0,0 +1,142 @@
+// Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+//  All rights reserved.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package dao
+
+import (
+	"context"
+	"time"
+
+	"github.com/teamgram/marmota/pkg/hack"
+	"github.com/teamgram/marmota/pkg/stores/sqlx"
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/biz/channel/internal/dal/dataobject"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/zeromicro/go-zero/core/jsonx"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// MakeChannelMessageBox
+// All members of a channel share a single message box, the box is rendered for
+// a given user by MessageBox.ToMessage.
+func (d *Dao) MakeChannelMessageBox(do *dataobject.ChannelMessagesDO) *mtproto.MessageBox {
+	box := mtproto.MakeTLMessageBox(&mtproto.MessageBox{
+		UserId:            do.SenderUserId,
+		MessageId:         do.ChannelMessageId,
+		SenderUserId:      do.SenderUserId,
+		PeerType:          mtproto.PEER_CHANNEL,
+		PeerId:            do.ChannelId,
+		RandomId:          do.RandomId,
+		MessageFilterType: do.MessageFilterType,
+		Message:           nil,
+		Pts:               do.Pts,
+		PtsCount:          1,
+		Views:             do.Views,
+	}).To_MessageBox()
+	_ = jsonx.UnmarshalFromString(do.MessageData, &box.Message)
+
+	return box
+}
+
+func (d *Dao) MakeChannelMessageBoxList(doList []dataobject.ChannelMessagesDO) []*mtproto.MessageBox {
+	boxList := make([]*mtproto.MessageBox, 0, len(doList))
+	for i := 0; i < len(doList); i++ {
+		boxList = append(boxList, d.MakeChannelMessageBox(&doList[i]))
+	}
+
+	return boxList
+}
+
+// SendChannelMessage stores message in the shared message box of channelId, a
+// new channel message id and pts are allocated from idgen.
+func (d *Dao) SendChannelMessage(ctx context.Context, fromId int64, channelId int64, broadcast bool, randomId int64, message *mtproto.Message) (*mtproto.MessageBox, error) {
+	if randomId != 0 {
+		do, err := d.ChannelMessagesDAO.SelectByRandomId(ctx, fromId, randomId, channelId)
+		if err != nil {
+			return nil, mtproto.ErrInternelServerError
+		} else if do != nil {
+			return d.MakeChannelMessageBox(do), nil
+		}
+	}
+
+	var (
+		messageId = d.IDGenClient2.NextChannelMessageBoxId(ctx, channelId)
+		pts       = d.IDGenClient2.NextChannelPtsId(ctx, channelId)
+	)
+
+	if messageId == 0 || pts == 0 {
+		return nil, mtproto.ErrInternelServerError
+	}
+
+	message.Id = messageId
+	message.Out = false
+	message.PeerId = mtproto.MakePeerChannel(channelId)
+	if broadcast {
+		message.Post = true
+		message.FromId = nil
+		if message.PredicateName == mtproto.Predicate_message {
+			message.Views = &types.Int32Value{Value: 1}
+			message.Forwards = &types.Int32Value{Value: 0}
+		}
+	} else {
+		message.FromId = mtproto.MakePeerUser(fromId)
+	}
+	if message.Date == 0 {
+		message.Date = int32(time.Now().Unix())
+	}
+
+	mData, _ := jsonx.Marshal(message)
+	do := &dataobject.ChannelMessagesDO{
+		ChannelId:         channelId,
+		ChannelMessageId:  messageId,
+		SenderUserId:      fromId,
+		RandomId:          randomId,
+		Pts:               pts,
+		MessageFilterType: int32(mtproto.GetMediaType(message)),
+		MessageData:       hack.String(mData),
+		Message:           message.Message,
+		Views:             message.GetViews().GetValue(),
+		Date2:             time.Now().Unix(),
+	}
+
+	tR := sqlx.TxWrapper(ctx, d.DB, func(tx *sqlx.Tx, result *sqlx.StoreResult) {
+		_, _, result.Err = d.ChannelMessagesDAO.InsertTx(tx, do)
+		if result.Err != nil {
+			return
+		}
+		_, result.Err = d.ChannelsDAO.UpdateTopMessageTx(tx, messageId, pts, channelId)
+	})
+	if tR.Err != nil {
+		logx.WithContext(ctx).Errorf("sendChannelMessage - error: %v", tR.Err)
+		return nil, mtproto.ErrInternelServerError
+	}
+
+	return d.MakeChannelMessageBox(do), nil
+}
+
+// DeleteChannelMessages deletes idList of the channel, a pts is taken per deleted message,
+// the returned pts is the last one.
+func (d *Dao) DeleteChannelMessages(ctx context.Context, channelId int64, idList []int32) (int32, error) {
+	pts := d.IDGenClient2.NextChannelNPtsId(ctx, channelId, len(idList))
+	if pts == 0 {
+		return 0, mtproto.ErrInternelServerError
+	}
+
+	tR := sqlx.TxWrapper(ctx, d.DB, func(tx *sqlx.Tx, result *sqlx.StoreResult) {
+		_, result.Err = d.ChannelMessagesDAO.DeleteMessagesTx(tx, channelId, idList)
+		if result.Err != nil {
+			return
+		}
+		_, result.Err = d.ChannelsDAO.UpdatePtsTx(tx, pts, channelId)
+	})
+	if tR.Err != nil {
+		logx.WithContext(ctx).Errorf("deleteChannelMessages - error: %v", tR.Err)
+		return 0, mtproto.ErrInternelServerError
+	}
+
+	return pts, nil
+}
@@ -0,0 +1,44 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package dao
+
+import (
+	"github.com/teamgram/marmota/pkg/net/rpcx"
+	"github.com/teamgram/marmota/pkg/stores/sqlx"
+	"github.com/teamgram/teamgram-server/app/service/biz/channel/internal/config"
+	idgen_client "github.com/teamgram/teamgram-server/app/service/idgen/client"
+	media_client "github.com/teamgram/teamgram-server/app/service/media/client"
+)
+
+// Dao dao.
+type Dao struct {
+	*Mysql
+	idgen_client.IDGenClient2
+	media_client.MediaClient
+}
+
+// New new a dao and return.
+func New(c config.Config) (dao *Dao) {
+	db := sqlx.NewMySQL(&c.Mysql)
+	return &Dao{
+		Mysql:        newMysqlDao(db),
+		IDGenClient2: idgen_client.NewIDGenClient2(rpcx.GetCachedRpcClient(c.IdgenClient)),
+		MediaClient:  media_client.NewMediaClient(rpcx.GetCachedRpcClient(c.MediaClient)),
+	}
+}
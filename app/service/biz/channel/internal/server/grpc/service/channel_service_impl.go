@@ -0,0 +1,379 @@
+/*
+ * WARNING! All changes made in this file will be lost!
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package service
+
+import (
+	"context"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/biz/channel/channel"
+	"github.com/teamgram/teamgram-server/app/service/biz/channel/internal/core"
+)
+
+// ChannelGetMutableChannel
+// channel.getMutableChannel channel_id:long id:Vector<long> = MutableChannel;
+func (s *Service) ChannelGetMutableChannel(ctx context.Context, request *channel.TLChannelGetMutableChannel) (*channel.MutableChannel, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Infof("channel.getMutableChannel - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.ChannelGetMutableChannel(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Infof("channel.getMutableChannel - reply: %s", r.DebugString())
+	return r, err
+}
+
+// ChannelGetChannelListByIdList
+// channel.getChannelListByIdList self_user_id:long id:Vector<long> = Vector<Chat>;
+func (s *Service) ChannelGetChannelListByIdList(ctx context.Context, request *channel.TLChannelGetChannelListByIdList) (*channel.Vector_Chat, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Infof("channel.getChannelListByIdList - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.ChannelGetChannelListByIdList(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Infof("channel.getChannelListByIdList - reply: %s", r.DebugString())
+	return r, err
+}
+
+// ChannelGetChannelParticipantIdList
+// channel.getChannelParticipantIdList channel_id:long = Vector<long>;
+func (s *Service) ChannelGetChannelParticipantIdList(ctx context.Context, request *channel.TLChannelGetChannelParticipantIdList) (*channel.Vector_Long, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Infof("channel.getChannelParticipantIdList - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.ChannelGetChannelParticipantIdList(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Infof("channel.getChannelParticipantIdList - reply: %s", r.DebugString())
+	return r, err
+}
+
+// ChannelCreateChannel
+// channel.createChannel flags:# creator_id:long broadcast:flags.0?true megagroup:flags.1?true title:string about:string = MutableChannel;
+func (s *Service) ChannelCreateChannel(ctx context.Context, request *channel.TLChannelCreateChannel) (*channel.MutableChannel, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Infof("channel.createChannel - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.ChannelCreateChannel(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Infof("channel.createChannel - reply: %s", r.DebugString())
+	return r, err
+}
+
+// ChannelJoinChannel
+// channel.joinChannel channel_id:long user_id:long = MutableChannel;
+func (s *Service) ChannelJoinChannel(ctx context.Context, request *channel.TLChannelJoinChannel) (*channel.MutableChannel, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Infof("channel.joinChannel - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.ChannelJoinChannel(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Infof("channel.joinChannel - reply: %s", r.DebugString())
+	return r, err
+}
+
+// ChannelLeaveChannel
+// channel.leaveChannel channel_id:long user_id:long = MutableChannel;
+func (s *Service) ChannelLeaveChannel(ctx context.Context, request *channel.TLChannelLeaveChannel) (*channel.MutableChannel, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Infof("channel.leaveChannel - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.ChannelLeaveChannel(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Infof("channel.leaveChannel - reply: %s", r.DebugString())
+	return r, err
+}
+
+// ChannelInviteToChannel
+// channel.inviteToChannel channel_id:long inviter_id:long id:Vector<long> = MutableChannel;
+func (s *Service) ChannelInviteToChannel(ctx context.Context, request *channel.TLChannelInviteToChannel) (*channel.MutableChannel, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Infof("channel.inviteToChannel - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.ChannelInviteToChannel(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Infof("channel.inviteToChannel - reply: %s", r.DebugString())
+	return r, err
+}
+
+// ChannelEditAdmin
+// channel.editAdmin channel_id:long operator_id:long user_id:long admin_rights:ChatAdminRights rank:string = MutableChannel;
+func (s *Service) ChannelEditAdmin(ctx context.Context, request *channel.TLChannelEditAdmin) (*channel.MutableChannel, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Infof("channel.editAdmin - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.ChannelEditAdmin(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Infof("channel.editAdmin - reply: %s", r.DebugString())
+	return r, err
+}
+
+// ChannelEditBanned
+// channel.editBanned channel_id:long operator_id:long user_id:long banned_rights:ChatBannedRights = MutableChannel;
+func (s *Service) ChannelEditBanned(ctx context.Context, request *channel.TLChannelEditBanned) (*channel.MutableChannel, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Infof("channel.editBanned - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.ChannelEditBanned(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Infof("channel.editBanned - reply: %s", r.DebugString())
+	return r, err
+}
+
+// ChannelGetChannelParticipants
+// channel.getChannelParticipants channel_id:long filter:ChannelParticipantsFilter offset:int limit:int = ChannelParticipants;
+func (s *Service) ChannelGetChannelParticipants(ctx context.Context, request *channel.TLChannelGetChannelParticipants) (*channel.ChannelParticipants, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Infof("channel.getChannelParticipants - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.ChannelGetChannelParticipants(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Infof("channel.getChannelParticipants - reply: %s", r.DebugString())
+	return r, err
+}
+
+// ChannelSendMessage
+// channel.sendMessage user_id:long channel_id:long random_id:long message:Message = MessageBox;
+func (s *Service) ChannelSendMessage(ctx context.Context, request *channel.TLChannelSendMessage) (*mtproto.MessageBox, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Infof("channel.sendMessage - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.ChannelSendMessage(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Infof("channel.sendMessage - reply: %s", r.DebugString())
+	return r, err
+}
+
+// ChannelGetHistory
+// channel.getHistory user_id:long channel_id:long offset_id:int add_offset:int limit:int max_id:int min_id:int = Vector<MessageBox>;
+func (s *Service) ChannelGetHistory(ctx context.Context, request *channel.TLChannelGetHistory) (*channel.Vector_MessageBox, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Infof("channel.getHistory - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.ChannelGetHistory(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Infof("channel.getHistory - reply: %s", r.DebugString())
+	return r, err
+}
+
+// ChannelGetMessages
+// channel.getMessages user_id:long channel_id:long id:Vector<int> = Vector<MessageBox>;
+func (s *Service) ChannelGetMessages(ctx context.Context, request *channel.TLChannelGetMessages) (*channel.Vector_MessageBox, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Infof("channel.getMessages - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.ChannelGetMessages(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Infof("channel.getMessages - reply: %s", r.DebugString())
+	return r, err
+}
+
+// ChannelReadHistory
+// channel.readHistory user_id:long channel_id:long max_id:int = Bool;
+func (s *Service) ChannelReadHistory(ctx context.Context, request *channel.TLChannelReadHistory) (*mtproto.Bool, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Infof("channel.readHistory - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.ChannelReadHistory(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Infof("channel.readHistory - reply: %s", r.DebugString())
+	return r, err
+}
+
+// ChannelGetChannelDifference
+// channel.getChannelDifference user_id:long channel_id:long pts:int limit:int = ChannelDifference;
+func (s *Service) ChannelGetChannelDifference(ctx context.Context, request *channel.TLChannelGetChannelDifference) (*channel.ChannelDifference, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Infof("channel.getChannelDifference - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.ChannelGetChannelDifference(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Infof("channel.getChannelDifference - reply: %s", r.DebugString())
+	return r, err
+}
+
+// ChannelEditTitle
+// channel.editTitle channel_id:long operator_id:long title:string = MutableChannel;
+func (s *Service) ChannelEditTitle(ctx context.Context, request *channel.TLChannelEditTitle) (*channel.MutableChannel, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Infof("channel.editTitle - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.ChannelEditTitle(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Infof("channel.editTitle - reply: %s", r.DebugString())
+	return r, err
+}
+
+// ChannelEditPhoto
+// channel.editPhoto channel_id:long operator_id:long photo:Photo = MutableChannel;
+func (s *Service) ChannelEditPhoto(ctx context.Context, request *channel.TLChannelEditPhoto) (*channel.MutableChannel, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Infof("channel.editPhoto - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.ChannelEditPhoto(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Infof("channel.editPhoto - reply: %s", r.DebugString())
+	return r, err
+}
+
+// ChannelToggleSignatures
+// channel.toggleSignatures channel_id:long operator_id:long enabled:Bool = MutableChannel;
+func (s *Service) ChannelToggleSignatures(ctx context.Context, request *channel.TLChannelToggleSignatures) (*channel.MutableChannel, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Infof("channel.toggleSignatures - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.ChannelToggleSignatures(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Infof("channel.toggleSignatures - reply: %s", r.DebugString())
+	return r, err
+}
+
+// ChannelTogglePreHistoryHidden
+// channel.togglePreHistoryHidden channel_id:long operator_id:long enabled:Bool = MutableChannel;
+func (s *Service) ChannelTogglePreHistoryHidden(ctx context.Context, request *channel.TLChannelTogglePreHistoryHidden) (*channel.MutableChannel, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Infof("channel.togglePreHistoryHidden - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.ChannelTogglePreHistoryHidden(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Infof("channel.togglePreHistoryHidden - reply: %s", r.DebugString())
+	return r, err
+}
+
+// ChannelToggleSlowMode
+// channel.toggleSlowMode channel_id:long operator_id:long seconds:int = MutableChannel;
+func (s *Service) ChannelToggleSlowMode(ctx context.Context, request *channel.TLChannelToggleSlowMode) (*channel.MutableChannel, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Infof("channel.toggleSlowMode - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.ChannelToggleSlowMode(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Infof("channel.toggleSlowMode - reply: %s", r.DebugString())
+	return r, err
+}
+
+// ChannelDeleteChannel
+// channel.deleteChannel channel_id:long operator_id:long = MutableChannel;
+func (s *Service) ChannelDeleteChannel(ctx context.Context, request *channel.TLChannelDeleteChannel) (*channel.MutableChannel, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Infof("channel.deleteChannel - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.ChannelDeleteChannel(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Infof("channel.deleteChannel - reply: %s", r.DebugString())
+	return r, err
+}
+
+// ChannelDeleteMessages
+// channel.deleteMessages user_id:long channel_id:long id:Vector<int> = ChannelDeletedMessages;
+func (s *Service) ChannelDeleteMessages(ctx context.Context, request *channel.TLChannelDeleteMessages) (*channel.ChannelDeletedMessages, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Infof("channel.deleteMessages - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.ChannelDeleteMessages(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Infof("channel.deleteMessages - reply: %s", r.DebugString())
+	return r, err
+}
+
+// ChannelDeleteParticipantHistory
+// channel.deleteParticipantHistory operator_id:long channel_id:long participant_id:long limit:int = ChannelDeletedMessages;
+func (s *Service) ChannelDeleteParticipantHistory(ctx context.Context, request *channel.TLChannelDeleteParticipantHistory) (*channel.ChannelDeletedMessages, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Infof("channel.deleteParticipantHistory - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.ChannelDeleteParticipantHistory(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Infof("channel.deleteParticipantHistory - reply: %s", r.DebugString())
+	return r, err
+}
+
+// ChannelDeleteHistory
+// channel.deleteHistory flags:# user_id:long channel_id:long for_everyone:flags.0?true max_id:int = MutableChannel;
+func (s *Service) ChannelDeleteHistory(ctx context.Context, request *channel.TLChannelDeleteHistory) (*channel.MutableChannel, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Infof("channel.deleteHistory - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.ChannelDeleteHistory(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Infof("channel.deleteHistory - reply: %s", r.DebugString())
+	return r, err
+}
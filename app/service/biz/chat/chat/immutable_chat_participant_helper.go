@@ -186,6 +186,14 @@ func (m *ImmutableChatParticipant) CanAdminAddAdmins() bool {
 	return m.IsChatMemberAdmin() && m.AdminRights.GetAddAdmins()
 }
 
+func (m *ImmutableChatParticipant) CanAdminManageCall() bool {
+	if m.IsChatMemberCreator() {
+		return true
+	}
+
+	return m.IsChatMemberAdmin() && m.AdminRights.GetManageCall()
+}
+
 func (m *ImmutableChatParticipant) ToChatParticipant() *mtproto.ChatParticipant {
 	switch m.ParticipantType {
 	case mtproto.ChatMemberCreator:
@@ -0,0 +1,59 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package message_client
+
+import (
+	"context"
+
+	kafka "github.com/teamgram/marmota/pkg/mq"
+	"github.com/teamgram/teamgram-server/pkg/search"
+
+	"github.com/zeromicro/go-zero/core/jsonx"
+)
+
+const (
+	// SearchUpdateIndexKey all updates share one key to keep them in order
+	SearchUpdateIndexKey = "search.updateIndex"
+)
+
+type SearchIndexClient interface {
+	SearchUpdateIndex(ctx context.Context, in *search.IndexUpdates) error
+}
+
+type defaultSearchIndexMqClient struct {
+	cli *kafka.Producer
+}
+
+func NewSearchIndexMqClient(cli *kafka.Producer) SearchIndexClient {
+	return &defaultSearchIndexMqClient{
+		cli: cli,
+	}
+}
+
+// SearchUpdateIndex
+// publishes changes of the messages box to the search index of biz.message
+func (m *defaultSearchIndexMqClient) SearchUpdateIndex(ctx context.Context, in *search.IndexUpdates) error {
+	b, err := jsonx.Marshal(in)
+	if err != nil {
+		return err
+	}
+
+	_, _, err = m.cli.SendMessage(ctx, SearchUpdateIndexKey, b)
+	return err
+}
@@ -11,10 +11,12 @@
 package message_helper
 
 import (
+	kafka "github.com/teamgram/marmota/pkg/mq"
 	"github.com/teamgram/teamgram-server/app/service/biz/message/internal/config"
 	"github.com/teamgram/teamgram-server/app/service/biz/message/internal/dal/dao/mysql_dao"
 	"github.com/teamgram/teamgram-server/app/service/biz/message/internal/dal/dataobject"
 	"github.com/teamgram/teamgram-server/app/service/biz/message/internal/server/grpc/service"
+	"github.com/teamgram/teamgram-server/app/service/biz/message/internal/server/mq"
 	"github.com/teamgram/teamgram-server/app/service/biz/message/internal/svc"
 )
 
@@ -23,12 +25,30 @@ type (
 )
 
 func New(c Config) *service.Service {
-	return service.New(svc.NewServiceContext(c))
+	srv, _ := NewWithSearchConsumer(c)
+	return srv
+}
+
+// NewWithSearchConsumer also returns the consumer feeding the search index,
+// it's nil if search or SearchConsumer is not configured.
+func NewWithSearchConsumer(c Config) (*service.Service, *kafka.ConsumerGroup) {
+	var (
+		svcCtx = svc.NewServiceContext(c)
+		mqSrv  *kafka.ConsumerGroup
+	)
+
+	if svcCtx.Dao.Search != nil && c.SearchConsumer != nil {
+		mqSrv = mq.New(svcCtx, *c.SearchConsumer)
+	}
+
+	return service.New(svcCtx), mqSrv
 }
 
 type (
-	MessagesDAO = mysql_dao.MessagesDAO
-	MessagesDO  = dataobject.MessagesDO
+	MessagesDAO         = mysql_dao.MessagesDAO
+	MessagesDO          = dataobject.MessagesDO
+	MessageReactionsDAO = mysql_dao.MessageReactionsDAO
+	MessageReactionsDO  = dataobject.MessageReactionsDO
 	// ChannelMessagesDAO   = mysql_dao.ChannelMessagesDAO
 	// ChannelMessagesDO    = dataobject.ChannelMessagesDO
 	// ScheduledMessagesDAO = mysql_dao.ScheduledMessagesDAO
@@ -36,7 +56,8 @@ type (
 )
 
 var (
-	NewMessagesDAO = mysql_dao.NewMessagesDAO
+	NewMessagesDAO         = mysql_dao.NewMessagesDAO
+	NewMessageReactionsDAO = mysql_dao.NewMessageReactionsDAO
 	// NewChannelMessagesDAO   = mysql_dao.NewChannelMessagesDAO
 	// NewScheduledMessagesDAO = mysql_dao.NewScheduledMessagesDAO
 )
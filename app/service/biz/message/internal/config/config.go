@@ -19,7 +19,9 @@
 package config
 
 import (
+	kafka "github.com/teamgram/marmota/pkg/mq"
 	"github.com/teamgram/marmota/pkg/stores/sqlx"
+	"github.com/teamgram/teamgram-server/pkg/search"
 	"github.com/zeromicro/go-zero/core/stores/cache"
 	"github.com/zeromicro/go-zero/zrpc"
 )
@@ -29,4 +31,7 @@ type Config struct {
 	Mysql      sqlx.Config
 	Cache      cache.CacheConf
 	PollClient zrpc.RpcClientConf
+	// Search, if not set messages are searched by LIKE in mysql
+	Search         *search.Config           `json:",optional"`
+	SearchConsumer *kafka.KafkaConsumerConf `json:",optional"`
 }
@@ -12,6 +12,7 @@ package core
 import (
 	"github.com/teamgram/proto/mtproto"
 	"github.com/teamgram/teamgram-server/app/service/biz/message/message"
+	"github.com/teamgram/teamgram-server/pkg/search"
 )
 
 // MessageGetSearchCounter
@@ -21,6 +22,17 @@ func (c *MessageCore) MessageGetSearchCounter(in *message.TLMessageGetSearchCoun
 		dialogId = mtproto.MakeDialogId(in.UserId, in.PeerType, in.PeerId)
 	)
 
+	if sz, ok := c.svcCtx.Dao.CountMessages(c.ctx, &search.Query{
+		UserId:     in.UserId,
+		DialogId1:  dialogId.A,
+		DialogId2:  dialogId.B,
+		MediaTypes: []int32{in.MediaType},
+	}); ok {
+		return &mtproto.Int32{
+			V: sz,
+		}, nil
+	}
+
 	sz := c.svcCtx.Dao.CommonDAO.CalcSize(c.ctx, "messages", map[string]interface{}{
 		"user_id":             in.UserId,
 		"dialog_id1":          dialogId.A,
@@ -15,6 +15,7 @@ import (
 	"github.com/teamgram/proto/mtproto"
 	"github.com/teamgram/teamgram-server/app/service/biz/message/internal/dal/dataobject"
 	"github.com/teamgram/teamgram-server/app/service/biz/message/message"
+	"github.com/teamgram/teamgram-server/pkg/search"
 )
 
 // MessageSearchGlobal
@@ -29,6 +30,17 @@ func (c *MessageCore) MessageSearchGlobal(in *message.TLMessageSearchGlobal) (*m
 		offset = math.MaxInt32
 	}
 
+	if boxList, ok := c.svcCtx.Dao.SearchMessages(c.ctx, &search.Query{
+		UserId:   in.UserId,
+		Q:        in.Q,
+		OffsetId: offset,
+		Limit:    in.Limit,
+	}); ok {
+		return &message.Vector_MessageBox{
+			Datas: boxList,
+		}, nil
+	}
+
 	rList, _ := c.svcCtx.Dao.MessagesDAO.SearchGlobalWithCB(
 		c.ctx,
 		in.UserId,
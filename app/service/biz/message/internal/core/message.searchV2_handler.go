@@ -22,12 +22,52 @@ import (
 	"github.com/teamgram/proto/mtproto"
 	"github.com/teamgram/teamgram-server/app/service/biz/message/internal/dal/dataobject"
 	"github.com/teamgram/teamgram-server/app/service/biz/message/message"
+	"github.com/teamgram/teamgram-server/pkg/search"
 	"math"
 )
 
 // MessageSearchV2
 // message.searchV2 user_id:long peer_type:int peer_id:long q:string from_id:long min_date:int max_date:int offset_id:int add_offset:int limit:int max_id:int min_id:int hash:long = Vector<MessageBox>;
 func (c *MessageCore) MessageSearchV2(in *message.TLMessageSearchV2) (*message.Vector_MessageBox, error) {
+	switch in.PeerType {
+	case mtproto.PEER_SELF, mtproto.PEER_USER, mtproto.PEER_CHAT:
+		if len(in.Q) > 0 && in.Q[0] == '#' {
+			break
+		}
+
+		var (
+			dialogId = mtproto.MakeDialogId(in.UserId, in.PeerType, in.PeerId)
+			offsetId = in.OffsetId
+			limit    = in.Limit
+		)
+
+		if offsetId == 0 {
+			offsetId = math.MaxInt32
+		}
+		if limit > 50 {
+			limit = 50
+		}
+
+		if boxList, ok := c.svcCtx.Dao.SearchMessages(c.ctx, &search.Query{
+			UserId:    in.UserId,
+			DialogId1: dialogId.A,
+			DialogId2: dialogId.B,
+			FromId:    in.FromId,
+			Q:         in.Q,
+			MinDate:   int64(in.MinDate),
+			MaxDate:   int64(in.MaxDate),
+			OffsetId:  offsetId,
+			AddOffset: in.AddOffset,
+			MaxId:     in.MaxId,
+			MinId:     in.MinId,
+			Limit:     limit,
+		}); ok {
+			return &message.Vector_MessageBox{
+				Datas: boxList,
+			}, nil
+		}
+	}
+
 	if in.FromId == 0 {
 		return c.MessageSearch(&message.TLMessageSearch{
 			UserId:   in.UserId,
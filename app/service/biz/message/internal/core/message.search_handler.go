@@ -15,6 +15,7 @@ import (
 	"github.com/teamgram/proto/mtproto"
 	"github.com/teamgram/teamgram-server/app/service/biz/message/internal/dal/dataobject"
 	"github.com/teamgram/teamgram-server/app/service/biz/message/message"
+	"github.com/teamgram/teamgram-server/pkg/search"
 )
 
 // MessageSearch
@@ -37,7 +38,7 @@ func (c *MessageCore) MessageSearch(in *message.TLMessageSearch) (*message.Vecto
 
 	switch in.PeerType {
 	case mtproto.PEER_SELF, mtproto.PEER_USER, mtproto.PEER_CHAT:
-		if q[0] == '#' {
+		if len(q) > 0 && q[0] == '#' {
 			idList, _ := c.svcCtx.Dao.HashTagsDAO.SelectPeerHashTagList(
 				c.ctx,
 				in.UserId,
@@ -56,6 +57,18 @@ func (c *MessageCore) MessageSearch(in *message.TLMessageSearch) (*message.Vecto
 			}
 		} else {
 			dialogId := mtproto.MakeDialogId(in.UserId, in.PeerType, in.PeerId)
+			if rList, ok := c.svcCtx.Dao.SearchMessages(c.ctx, &search.Query{
+				UserId:    in.UserId,
+				DialogId1: dialogId.A,
+				DialogId2: dialogId.B,
+				Q:         q,
+				OffsetId:  offset,
+				Limit:     limit,
+			}); ok {
+				boxList = rList
+				break
+			}
+
 			c.svcCtx.Dao.MessagesDAO.SearchWithCB(
 				c.ctx,
 				in.UserId,
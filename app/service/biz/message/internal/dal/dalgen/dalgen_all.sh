@@ -1,4 +1,5 @@
 ./dalgen.sh chat_participants
 ./dalgen.sh chats
 ./dalgen.sh hash_tags
+./dalgen.sh message_reactions
 ./dalgen.sh messages
@@ -0,0 +1,271 @@
+/*
+ * WARNING! All changes made in this file will be lost!
+ *   Created from by 'dalgen'
+ *
+ * Copyright (c) 2022-present,  Teamgram Authors.
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package mysql_dao
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/teamgram/marmota/pkg/stores/sqlx"
+	"github.com/teamgram/teamgram-server/app/service/biz/message/internal/dal/dataobject"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+var _ *sql.Result
+
+type MessageReactionsDAO struct {
+	db *sqlx.DB
+}
+
+func NewMessageReactionsDAO(db *sqlx.DB) *MessageReactionsDAO {
+	return &MessageReactionsDAO{db}
+}
+
+// Insert
+// insert into message_reactions(dialog_message_id, user_id, reaction, big, date2) values (:dialog_message_id, :user_id, :reaction, :big, :date2)
+// TODO(@benqi): sqlmap
+func (dao *MessageReactionsDAO) Insert(ctx context.Context, do *dataobject.MessageReactionsDO) (lastInsertId, rowsAffected int64, err error) {
+	var (
+		query = "insert into message_reactions(dialog_message_id, user_id, reaction, big, date2) values (:dialog_message_id, :user_id, :reaction, :big, :date2)"
+		r     sql.Result
+	)
+
+	r, err = dao.db.NamedExec(ctx, query, do)
+	if err != nil {
+		logx.WithContext(ctx).Errorf("namedExec in Insert(%v), error: %v", do, err)
+		return
+	}
+
+	lastInsertId, err = r.LastInsertId()
+	if err != nil {
+		logx.WithContext(ctx).Errorf("lastInsertId in Insert(%v)_error: %v", do, err)
+		return
+	}
+	rowsAffected, err = r.RowsAffected()
+	if err != nil {
+		logx.WithContext(ctx).Errorf("rowsAffected in Insert(%v)_error: %v", do, err)
+	}
+
+	return
+}
+
+// InsertTx
+// insert into message_reactions(dialog_message_id, user_id, reaction, big, date2) values (:dialog_message_id, :user_id, :reaction, :big, :date2)
+// TODO(@benqi): sqlmap
+func (dao *MessageReactionsDAO) InsertTx(tx *sqlx.Tx, do *dataobject.MessageReactionsDO) (lastInsertId, rowsAffected int64, err error) {
+	var (
+		query = "insert into message_reactions(dialog_message_id, user_id, reaction, big, date2) values (:dialog_message_id, :user_id, :reaction, :big, :date2)"
+		r     sql.Result
+	)
+
+	r, err = tx.NamedExec(query, do)
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("namedExec in Insert(%v), error: %v", do, err)
+		return
+	}
+
+	lastInsertId, err = r.LastInsertId()
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("lastInsertId in Insert(%v)_error: %v", do, err)
+		return
+	}
+	rowsAffected, err = r.RowsAffected()
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("rowsAffected in Insert(%v)_error: %v", do, err)
+	}
+
+	return
+}
+
+// SelectList
+// select id, dialog_message_id, user_id, reaction, big, date2 from message_reactions where dialog_message_id = :dialog_message_id order by id desc
+// TODO(@benqi): sqlmap
+func (dao *MessageReactionsDAO) SelectList(ctx context.Context, dialog_message_id int64) (rList []dataobject.MessageReactionsDO, err error) {
+	var (
+		query  = "select id, dialog_message_id, user_id, reaction, big, date2 from message_reactions where dialog_message_id = ? order by id desc"
+		values []dataobject.MessageReactionsDO
+	)
+	err = dao.db.QueryRowsPartial(ctx, &values, query, dialog_message_id)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("queryx in SelectList(_), error: %v", err)
+		return
+	}
+
+	rList = values
+
+	return
+}
+
+// SelectListWithCB
+// select id, dialog_message_id, user_id, reaction, big, date2 from message_reactions where dialog_message_id = :dialog_message_id order by id desc
+// TODO(@benqi): sqlmap
+func (dao *MessageReactionsDAO) SelectListWithCB(ctx context.Context, dialog_message_id int64, cb func(i int, v *dataobject.MessageReactionsDO)) (rList []dataobject.MessageReactionsDO, err error) {
+	var (
+		query  = "select id, dialog_message_id, user_id, reaction, big, date2 from message_reactions where dialog_message_id = ? order by id desc"
+		values []dataobject.MessageReactionsDO
+	)
+	err = dao.db.QueryRowsPartial(ctx, &values, query, dialog_message_id)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("queryx in SelectList(_), error: %v", err)
+		return
+	}
+
+	rList = values
+
+	if cb != nil {
+		for i := 0; i < len(rList); i++ {
+			cb(i, &rList[i])
+		}
+	}
+
+	return
+}
+
+// SelectListByOffset
+// select id, dialog_message_id, user_id, reaction, big, date2 from message_reactions where dialog_message_id = :dialog_message_id and id < :offset order by id desc limit :limit
+// TODO(@benqi): sqlmap
+func (dao *MessageReactionsDAO) SelectListByOffset(ctx context.Context, dialog_message_id int64, offset int64, limit int32) (rList []dataobject.MessageReactionsDO, err error) {
+	var (
+		query  = "select id, dialog_message_id, user_id, reaction, big, date2 from message_reactions where dialog_message_id = ? and id < ? order by id desc limit ?"
+		values []dataobject.MessageReactionsDO
+	)
+	err = dao.db.QueryRowsPartial(ctx, &values, query, dialog_message_id, offset, limit)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("queryx in SelectListByOffset(_), error: %v", err)
+		return
+	}
+
+	rList = values
+
+	return
+}
+
+// SelectListByOffsetWithCB
+// select id, dialog_message_id, user_id, reaction, big, date2 from message_reactions where dialog_message_id = :dialog_message_id and id < :offset order by id desc limit :limit
+// TODO(@benqi): sqlmap
+func (dao *MessageReactionsDAO) SelectListByOffsetWithCB(ctx context.Context, dialog_message_id int64, offset int64, limit int32, cb func(i int, v *dataobject.MessageReactionsDO)) (rList []dataobject.MessageReactionsDO, err error) {
+	var (
+		query  = "select id, dialog_message_id, user_id, reaction, big, date2 from message_reactions where dialog_message_id = ? and id < ? order by id desc limit ?"
+		values []dataobject.MessageReactionsDO
+	)
+	err = dao.db.QueryRowsPartial(ctx, &values, query, dialog_message_id, offset, limit)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("queryx in SelectListByOffset(_), error: %v", err)
+		return
+	}
+
+	rList = values
+
+	if cb != nil {
+		for i := 0; i < len(rList); i++ {
+			cb(i, &rList[i])
+		}
+	}
+
+	return
+}
+
+// SelectListByReaction
+// select id, dialog_message_id, user_id, reaction, big, date2 from message_reactions where dialog_message_id = :dialog_message_id and reaction = :reaction and id < :offset order by id desc limit :limit
+// TODO(@benqi): sqlmap
+func (dao *MessageReactionsDAO) SelectListByReaction(ctx context.Context, dialog_message_id int64, reaction string, offset int64, limit int32) (rList []dataobject.MessageReactionsDO, err error) {
+	var (
+		query  = "select id, dialog_message_id, user_id, reaction, big, date2 from message_reactions where dialog_message_id = ? and reaction = ? and id < ? order by id desc limit ?"
+		values []dataobject.MessageReactionsDO
+	)
+	err = dao.db.QueryRowsPartial(ctx, &values, query, dialog_message_id, reaction, offset, limit)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("queryx in SelectListByReaction(_), error: %v", err)
+		return
+	}
+
+	rList = values
+
+	return
+}
+
+// SelectListByReactionWithCB
+// select id, dialog_message_id, user_id, reaction, big, date2 from message_reactions where dialog_message_id = :dialog_message_id and reaction = :reaction and id < :offset order by id desc limit :limit
+// TODO(@benqi): sqlmap
+func (dao *MessageReactionsDAO) SelectListByReactionWithCB(ctx context.Context, dialog_message_id int64, reaction string, offset int64, limit int32, cb func(i int, v *dataobject.MessageReactionsDO)) (rList []dataobject.MessageReactionsDO, err error) {
+	var (
+		query  = "select id, dialog_message_id, user_id, reaction, big, date2 from message_reactions where dialog_message_id = ? and reaction = ? and id < ? order by id desc limit ?"
+		values []dataobject.MessageReactionsDO
+	)
+	err = dao.db.QueryRowsPartial(ctx, &values, query, dialog_message_id, reaction, offset, limit)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("queryx in SelectListByReaction(_), error: %v", err)
+		return
+	}
+
+	rList = values
+
+	if cb != nil {
+		for i := 0; i < len(rList); i++ {
+			cb(i, &rList[i])
+		}
+	}
+
+	return
+}
+
+// Delete
+// delete from message_reactions where dialog_message_id = :dialog_message_id and user_id = :user_id
+// TODO(@benqi): sqlmap
+func (dao *MessageReactionsDAO) Delete(ctx context.Context, dialog_message_id int64, user_id int64) (rowsAffected int64, err error) {
+	var (
+		query   = "delete from message_reactions where dialog_message_id = ? and user_id = ?"
+		rResult sql.Result
+	)
+	rResult, err = dao.db.Exec(ctx, query, dialog_message_id, user_id)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("exec in Delete(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(ctx).Errorf("rowsAffected in Delete(_), error: %v", err)
+	}
+
+	return
+}
+
+// DeleteTx
+// delete from message_reactions where dialog_message_id = :dialog_message_id and user_id = :user_id
+// TODO(@benqi): sqlmap
+func (dao *MessageReactionsDAO) DeleteTx(tx *sqlx.Tx, dialog_message_id int64, user_id int64) (rowsAffected int64, err error) {
+	var (
+		query   = "delete from message_reactions where dialog_message_id = ? and user_id = ?"
+		rResult sql.Result
+	)
+	rResult, err = tx.Exec(query, dialog_message_id, user_id)
+
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("exec in Delete(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("rowsAffected in Delete(_), error: %v", err)
+	}
+
+	return
+}
@@ -1742,3 +1742,233 @@ func (dao *MessagesDAO) SelectBackwardBySendUserIdOffsetIdLimitWithCB(ctx contex
 
 	return
 }
+
+// SelectAfterId
+// select id, user_id, user_message_box_id, dialog_id1, dialog_id2, sender_user_id, message_filter_type, message, date2 from messages where id > :id and deleted = 0 order by id asc limit :limit
+// TODO(@benqi): sqlmap
+func (dao *MessagesDAO) SelectAfterId(ctx context.Context, id int64, limit int32) (rList []dataobject.MessagesDO, err error) {
+	var (
+		query  = "select id, user_id, user_message_box_id, dialog_id1, dialog_id2, sender_user_id, message_filter_type, message, date2 from messages where id > ? and deleted = 0 order by id asc limit ?"
+		values []dataobject.MessagesDO
+	)
+	err = dao.db.QueryRowsPartial(ctx, &values, query, id, limit)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("queryx in SelectAfterId(_), error: %v", err)
+		return
+	}
+
+	rList = values
+
+	return
+}
+
+// SelectAfterIdWithCB
+// select id, user_id, user_message_box_id, dialog_id1, dialog_id2, sender_user_id, message_filter_type, message, date2 from messages where id > :id and deleted = 0 order by id asc limit :limit
+// TODO(@benqi): sqlmap
+func (dao *MessagesDAO) SelectAfterIdWithCB(ctx context.Context, id int64, limit int32, cb func(i int, v *dataobject.MessagesDO)) (rList []dataobject.MessagesDO, err error) {
+	var (
+		query  = "select id, user_id, user_message_box_id, dialog_id1, dialog_id2, sender_user_id, message_filter_type, message, date2 from messages where id > ? and deleted = 0 order by id asc limit ?"
+		values []dataobject.MessagesDO
+	)
+	err = dao.db.QueryRowsPartial(ctx, &values, query, id, limit)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("queryx in SelectAfterId(_), error: %v", err)
+		return
+	}
+
+	rList = values
+
+	if cb != nil {
+		for i := 0; i < len(rList); i++ {
+			cb(i, &rList[i])
+		}
+	}
+
+	return
+}
+
+// UpdateHasReaction
+// update messages set has_reaction = :has_reaction where dialog_message_id = :dialog_message_id
+// TODO(@benqi): sqlmap
+func (dao *MessagesDAO) UpdateHasReaction(ctx context.Context, has_reaction bool, dialog_message_id int64) (rowsAffected int64, err error) {
+	var (
+		query   = "update messages set has_reaction = ? where dialog_message_id = ?"
+		rResult sql.Result
+	)
+	rResult, err = dao.db.Exec(ctx, query, has_reaction, dialog_message_id)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("exec in UpdateHasReaction(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(ctx).Errorf("rowsAffected in UpdateHasReaction(_), error: %v", err)
+	}
+
+	return
+}
+
+// update messages set has_reaction = :has_reaction where dialog_message_id = :dialog_message_id
+// UpdateHasReactionTx
+// TODO(@benqi): sqlmap
+func (dao *MessagesDAO) UpdateHasReactionTx(tx *sqlx.Tx, has_reaction bool, dialog_message_id int64) (rowsAffected int64, err error) {
+	var (
+		query   = "update messages set has_reaction = ? where dialog_message_id = ?"
+		rResult sql.Result
+	)
+	rResult, err = tx.Exec(query, has_reaction, dialog_message_id)
+
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("exec in UpdateHasReaction(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("rowsAffected in UpdateHasReaction(_), error: %v", err)
+	}
+
+	return
+}
+
+// UpdateReaction
+// update messages set reaction = :reaction, reaction_date = :reaction_date, reaction_unread = :reaction_unread where user_id = :user_id and user_message_box_id = :user_message_box_id
+// TODO(@benqi): sqlmap
+func (dao *MessagesDAO) UpdateReaction(ctx context.Context, reaction string, reaction_date int64, reaction_unread bool, user_id int64, user_message_box_id int32) (rowsAffected int64, err error) {
+	var (
+		query   = "update messages set reaction = ?, reaction_date = ?, reaction_unread = ? where user_id = ? and user_message_box_id = ?"
+		rResult sql.Result
+	)
+	rResult, err = dao.db.Exec(ctx, query, reaction, reaction_date, reaction_unread, user_id, user_message_box_id)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("exec in UpdateReaction(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(ctx).Errorf("rowsAffected in UpdateReaction(_), error: %v", err)
+	}
+
+	return
+}
+
+// update messages set reaction = :reaction, reaction_date = :reaction_date, reaction_unread = :reaction_unread where user_id = :user_id and user_message_box_id = :user_message_box_id
+// UpdateReactionTx
+// TODO(@benqi): sqlmap
+func (dao *MessagesDAO) UpdateReactionTx(tx *sqlx.Tx, reaction string, reaction_date int64, reaction_unread bool, user_id int64, user_message_box_id int32) (rowsAffected int64, err error) {
+	var (
+		query   = "update messages set reaction = ?, reaction_date = ?, reaction_unread = ? where user_id = ? and user_message_box_id = ?"
+		rResult sql.Result
+	)
+	rResult, err = tx.Exec(query, reaction, reaction_date, reaction_unread, user_id, user_message_box_id)
+
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("exec in UpdateReaction(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("rowsAffected in UpdateReaction(_), error: %v", err)
+	}
+
+	return
+}
+
+// UpdateReactionUnread
+// update messages set reaction_unread = 0 where user_id = :user_id and user_message_box_id = :user_message_box_id
+// TODO(@benqi): sqlmap
+func (dao *MessagesDAO) UpdateReactionUnread(ctx context.Context, user_id int64, user_message_box_id int32) (rowsAffected int64, err error) {
+	var (
+		query   = "update messages set reaction_unread = 0 where user_id = ? and user_message_box_id = ?"
+		rResult sql.Result
+	)
+	rResult, err = dao.db.Exec(ctx, query, user_id, user_message_box_id)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("exec in UpdateReactionUnread(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(ctx).Errorf("rowsAffected in UpdateReactionUnread(_), error: %v", err)
+	}
+
+	return
+}
+
+// update messages set reaction_unread = 0 where user_id = :user_id and user_message_box_id = :user_message_box_id
+// UpdateReactionUnreadTx
+// TODO(@benqi): sqlmap
+func (dao *MessagesDAO) UpdateReactionUnreadTx(tx *sqlx.Tx, user_id int64, user_message_box_id int32) (rowsAffected int64, err error) {
+	var (
+		query   = "update messages set reaction_unread = 0 where user_id = ? and user_message_box_id = ?"
+		rResult sql.Result
+	)
+	rResult, err = tx.Exec(query, user_id, user_message_box_id)
+
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("exec in UpdateReactionUnread(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("rowsAffected in UpdateReactionUnread(_), error: %v", err)
+	}
+
+	return
+}
+
+// UpdateReactionUnreadByPeer
+// update messages set reaction_unread = 0 where user_id = :user_id and peer_type = :peer_type and peer_id = :peer_id and reaction_unread = 1
+// TODO(@benqi): sqlmap
+func (dao *MessagesDAO) UpdateReactionUnreadByPeer(ctx context.Context, user_id int64, peer_type int32, peer_id int64) (rowsAffected int64, err error) {
+	var (
+		query   = "update messages set reaction_unread = 0 where user_id = ? and peer_type = ? and peer_id = ? and reaction_unread = 1"
+		rResult sql.Result
+	)
+	rResult, err = dao.db.Exec(ctx, query, user_id, peer_type, peer_id)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("exec in UpdateReactionUnreadByPeer(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(ctx).Errorf("rowsAffected in UpdateReactionUnreadByPeer(_), error: %v", err)
+	}
+
+	return
+}
+
+// update messages set reaction_unread = 0 where user_id = :user_id and peer_type = :peer_type and peer_id = :peer_id and reaction_unread = 1
+// UpdateReactionUnreadByPeerTx
+// TODO(@benqi): sqlmap
+func (dao *MessagesDAO) UpdateReactionUnreadByPeerTx(tx *sqlx.Tx, user_id int64, peer_type int32, peer_id int64) (rowsAffected int64, err error) {
+	var (
+		query   = "update messages set reaction_unread = 0 where user_id = ? and peer_type = ? and peer_id = ? and reaction_unread = 1"
+		rResult sql.Result
+	)
+	rResult, err = tx.Exec(query, user_id, peer_type, peer_id)
+
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("exec in UpdateReactionUnreadByPeer(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("rowsAffected in UpdateReactionUnreadByPeer(_), error: %v", err)
+	}
+
+	return
+}
@@ -10,26 +10,36 @@
 package dao
 
 import (
-	// "github.com/teamgram/marmota/pkg/net/rpcx"
+	"github.com/teamgram/marmota/pkg/net/rpcx"
 	"github.com/teamgram/marmota/pkg/stores/sqlc"
 	"github.com/teamgram/marmota/pkg/stores/sqlx"
 	"github.com/teamgram/teamgram-server/app/service/biz/message/internal/config"
-	// poll_client "github.com/teamgram/teamgram-server/app/service/poll/client"
+	poll_client "github.com/teamgram/teamgram-server/app/service/poll/client"
+	"github.com/teamgram/teamgram-server/pkg/search"
 )
 
 // Dao dao.
 type Dao struct {
 	*Mysql
 	sqlc.CachedConn
-	// poll_client.PollClient
+	Search search.Index
+	poll_client.PollClient
 }
 
 // New new a dao and return.
 func New(c config.Config) (dao *Dao) {
 	db := sqlx.NewMySQL(&c.Mysql)
-	return &Dao{
+	dao = &Dao{
 		Mysql:      newMysqlDao(db),
 		CachedConn: sqlc.NewConn(db, c.Cache),
-		// PollClient: poll_client.NewPollClient(rpcx.GetCachedRpcClient(c.PollClient)),
+		PollClient: poll_client.NewPollClient(rpcx.GetCachedRpcClient(c.PollClient)),
 	}
+	if c.Search != nil {
+		dao.Search = search.MustNew(*c.Search)
+		if dao.Search != nil {
+			go dao.backfillSearchIndex()
+		}
+	}
+
+	return
 }
@@ -10,7 +10,7 @@ import (
 	"context"
 	"github.com/teamgram/proto/mtproto"
 	"github.com/teamgram/teamgram-server/app/service/biz/message/internal/dal/dataobject"
-	// pollpb "github.com/teamgram/teamgram-server/app/service/poll/poll"
+	pollpb "github.com/teamgram/teamgram-server/app/service/poll/poll"
 	"github.com/zeromicro/go-zero/core/jsonx"
 )
 
@@ -35,14 +35,21 @@ func (d *Dao) MakeMessageBox(ctx context.Context, selfUserId int64, do *dataobje
 	// Message
 	_ = jsonx.UnmarshalFromString(do.MessageData, &box.Message)
 
-	//// poll
-	//pollId, _ := mtproto.GetPollIdByMessage(box.Message.Media)
-	//if pollId != 0 {
-	//	box.Message.Media, _ = d.PollClient.PollGetMediaPoll(ctx, &pollpb.TLPollGetMediaPoll{
-	//		UserId: selfUserId,
-	//		PollId: pollId,
-	//	})
-	//}
+	// poll, the results of the stored media are refreshed for selfUserId
+	pollId, _ := mtproto.GetPollIdByMessage(box.Message.GetMedia())
+	if pollId != 0 {
+		mediaPoll, err := d.PollClient.PollGetMediaPoll(ctx, &pollpb.TLPollGetMediaPoll{
+			UserId: selfUserId,
+			PollId: pollId,
+		})
+		if err == nil {
+			box.Message.Media = mediaPoll
+		}
+	}
+
+	if do.HasReaction {
+		box.Message.Reactions = d.GetMessageReactions(ctx, selfUserId, do)
+	}
 
 	return
 }
@@ -27,14 +27,16 @@ type Mysql struct {
 	*sqlx.DB
 	*mysql_dao.MessagesDAO
 	*mysql_dao.HashTagsDAO
+	*mysql_dao.MessageReactionsDAO
 	*sqlx.CommonDAO
 }
 
 func newMysqlDao(db *sqlx.DB) *Mysql {
 	return &Mysql{
-		DB:          db,
-		MessagesDAO: mysql_dao.NewMessagesDAO(db),
-		HashTagsDAO: mysql_dao.NewHashTagsDAO(db),
-		CommonDAO:   sqlx.NewCommonDAO(db),
+		DB:                  db,
+		MessagesDAO:         mysql_dao.NewMessagesDAO(db),
+		HashTagsDAO:         mysql_dao.NewHashTagsDAO(db),
+		MessageReactionsDAO: mysql_dao.NewMessageReactionsDAO(db),
+		CommonDAO:           sqlx.NewCommonDAO(db),
 	}
 }
@@ -0,0 +1,38 @@
+// Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+//  All rights reserved.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package dao
+
+import (
+	"context"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/biz/message/internal/dal/dataobject"
+	"github.com/teamgram/teamgram-server/app/service/biz/message/message"
+)
+
+// GetMessageReactions returns the reactions of the message seen by selfUserId,
+// the sender of the message sees the last reaction as unread until it's read.
+func (d *Dao) GetMessageReactions(ctx context.Context, selfUserId int64, do *dataobject.MessagesDO) *mtproto.MessageReactions {
+	doList, err := d.MessageReactionsDAO.SelectList(ctx, do.DialogMessageId)
+	if err != nil {
+		return nil
+	}
+
+	var (
+		unread    = do.ReactionUnread && do.UserId == do.SenderUserId
+		reactions = make([]*mtproto.MessagePeerReaction, 0, len(doList))
+	)
+	for i := 0; i < len(doList); i++ {
+		reactions = append(reactions, message.MakeMessagePeerReaction(
+			doList[i].UserId,
+			doList[i].Reaction,
+			doList[i].Big,
+			unread && doList[i].UserId != do.SenderUserId && doList[i].Date2 == do.ReactionDate))
+	}
+
+	return message.MakeMessageReactions(selfUserId, do.PeerType == mtproto.PEER_CHAT, reactions)
+}
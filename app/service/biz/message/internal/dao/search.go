@@ -0,0 +1,158 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package dao
+
+import (
+	"context"
+	"time"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/biz/message/internal/dal/dataobject"
+	"github.com/teamgram/teamgram-server/pkg/search"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+const (
+	backfillBatchSize = 1000
+)
+
+// SearchMessages returns the message boxes found by the search index in index order,
+// it returns false if the index is disabled or fails so the caller can fall back to mysql.
+func (d *Dao) SearchMessages(ctx context.Context, q *search.Query) ([]*mtproto.MessageBox, bool) {
+	if d.Search == nil {
+		return nil, false
+	}
+
+	idList, err := d.Search.Search(ctx, q)
+	if err != nil {
+		logx.WithContext(ctx).Errorf("search.Search(%v) - error: %v", q, err)
+		return nil, false
+	}
+
+	boxList := make([]*mtproto.MessageBox, 0, len(idList))
+	if len(idList) == 0 {
+		return boxList, true
+	}
+
+	boxMap := make(map[int32]*mtproto.MessageBox, len(idList))
+	d.MessagesDAO.SelectByMessageIdListWithCB(
+		ctx,
+		q.UserId,
+		idList,
+		func(i int, v *dataobject.MessagesDO) {
+			boxMap[v.UserMessageBoxId] = d.MakeMessageBox(ctx, q.UserId, v)
+		})
+
+	// the index may lag behind deletes, skip what is gone
+	for _, id := range idList {
+		if box, ok := boxMap[id]; ok {
+			boxList = append(boxList, box)
+		}
+	}
+
+	return boxList, true
+}
+
+// CountMessages counts the messages matched by q, it returns false if the index is disabled.
+func (d *Dao) CountMessages(ctx context.Context, q *search.Query) (int32, bool) {
+	if d.Search == nil {
+		return 0, false
+	}
+
+	n, err := d.Search.Count(ctx, q)
+	if err != nil {
+		logx.WithContext(ctx).Errorf("search.Count(%v) - error: %v", q, err)
+		return 0, false
+	}
+
+	return n, true
+}
+
+// UpdateSearchIndex applies a batch of changes published by msg.
+func (d *Dao) UpdateSearchIndex(ctx context.Context, in *search.IndexUpdates) error {
+	if d.Search == nil {
+		return nil
+	}
+
+	if err := d.Search.Index(ctx, in.Documents...); err != nil {
+		return err
+	}
+	for _, v := range in.Deleted {
+		if err := d.Search.Delete(ctx, v.UserId, v.IdList...); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// backfillSearchIndex indexes the rows written before the index existed,
+// it resumes from the checkpoint stored in the index.
+func (d *Dao) backfillSearchIndex() {
+	var (
+		ctx        = context.Background()
+		checkpoint = d.Search.Checkpoint()
+		start      = time.Now()
+		total      int
+	)
+
+	logx.Infof("backfillSearchIndex - start from: %d", checkpoint)
+	for {
+		rList, err := d.MessagesDAO.SelectAfterId(ctx, checkpoint, backfillBatchSize)
+		if err != nil {
+			logx.Errorf("backfillSearchIndex - error: %v", err)
+			time.Sleep(5 * time.Second)
+			continue
+		}
+		if len(rList) == 0 {
+			break
+		}
+
+		docs := make([]*search.Document, 0, len(rList))
+		for i := 0; i < len(rList); i++ {
+			docs = append(docs, &search.Document{
+				UserId:    rList[i].UserId,
+				MessageId: rList[i].UserMessageBoxId,
+				DialogId1: rList[i].DialogId1,
+				DialogId2: rList[i].DialogId2,
+				FromId:    rList[i].SenderUserId,
+				MediaType: rList[i].MessageFilterType,
+				Date:      rList[i].Date2,
+				Text:      rList[i].Message,
+			})
+		}
+		if err = d.Search.Index(ctx, docs...); err != nil {
+			logx.Errorf("backfillSearchIndex - error: %v", err)
+			return
+		}
+
+		checkpoint = rList[len(rList)-1].Id
+		if err = d.Search.SetCheckpoint(checkpoint); err != nil {
+			logx.Errorf("backfillSearchIndex - error: %v", err)
+			return
+		}
+
+		total += len(rList)
+		if len(rList) < backfillBatchSize {
+			break
+		}
+	}
+	logx.Infof("backfillSearchIndex - indexed %d messages in %v, checkpoint: %d", total, time.Since(start), checkpoint)
+}
@@ -0,0 +1,58 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package mq
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	kafka "github.com/teamgram/marmota/pkg/mq"
+	message_client "github.com/teamgram/teamgram-server/app/service/biz/message/client"
+	"github.com/teamgram/teamgram-server/app/service/biz/message/internal/svc"
+	"github.com/teamgram/teamgram-server/pkg/search"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// New new a search index consumer.
+func New(svcCtx *svc.ServiceContext, conf kafka.KafkaConsumerConf) *kafka.ConsumerGroup {
+	s := kafka.MustKafkaConsumer(&conf)
+	s.RegisterHandlers(
+		conf.Topics[0],
+		func(ctx context.Context, key string, value []byte) {
+			switch key {
+			case message_client.SearchUpdateIndexKey:
+				r := new(search.IndexUpdates)
+				if err := json.Unmarshal(value, r); err != nil {
+					logx.WithContext(ctx).Errorf("search.updateIndex - error: %v", err)
+					return
+				}
+				logx.WithContext(ctx).Infof("search.updateIndex - documents: %d, deleted: %d", len(r.Documents), len(r.Deleted))
+
+				if err := svcCtx.Dao.UpdateSearchIndex(ctx, r); err != nil {
+					logx.WithContext(ctx).Errorf("search.updateIndex - error: %v", err)
+				}
+			default:
+				err := fmt.Errorf("invalid key: %s", key)
+				logx.Error(err.Error())
+			}
+		})
+	return s
+}
@@ -12,8 +12,10 @@ package server
 import (
 	"flag"
 
+	kafka "github.com/teamgram/marmota/pkg/mq"
 	"github.com/teamgram/teamgram-server/app/service/biz/message/internal/config"
 	"github.com/teamgram/teamgram-server/app/service/biz/message/internal/server/grpc"
+	"github.com/teamgram/teamgram-server/app/service/biz/message/internal/server/mq"
 	"github.com/teamgram/teamgram-server/app/service/biz/message/internal/svc"
 
 	"github.com/zeromicro/go-zero/core/conf"
@@ -25,6 +27,7 @@ var configFile = flag.String("f", "etc/message.yaml", "the config file")
 
 type Server struct {
 	grpcSrv *zrpc.RpcServer
+	mq      *kafka.ConsumerGroup
 }
 
 func New() *Server {
@@ -42,6 +45,13 @@ func (s *Server) Initialize() error {
 	go func() {
 		go s.grpcSrv.Start()
 	}()
+
+	if ctx.Dao.Search != nil && c.SearchConsumer != nil {
+		s.mq = mq.New(ctx, *c.SearchConsumer)
+		go func() {
+			s.mq.Start()
+		}()
+	}
 	return nil
 }
 
@@ -50,4 +60,7 @@ func (s *Server) RunLoop() {
 
 func (s *Server) Destroy() {
 	s.grpcSrv.Stop()
+	if s.mq != nil {
+		s.mq.Stop()
+	}
 }
@@ -0,0 +1,87 @@
+// Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+//  All rights reserved.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package message
+
+import (
+	"sort"
+
+	"github.com/teamgram/proto/mtproto"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	// ErrReactionInvalid the reaction is not available in the chat
+	ErrReactionInvalid = status.Error(mtproto.ErrBadRequest, "REACTION_INVALID")
+)
+
+const (
+	recentReactionsMax = 3
+)
+
+// DefaultAvailableReactions are the reactions of the private chats, the basic groups
+// only allow the reactions set by messages.setChatAvailableReactions.
+var DefaultAvailableReactions = []string{
+	"👍", "👎", "❤", "🔥", "🥰", "👏", "😁", "🤔",
+	"🤯", "😱", "🤬", "😢", "🎉", "🤩", "🤮", "💩",
+}
+
+func MakeMessagePeerReaction(userId int64, reaction string, big, unread bool) *mtproto.MessagePeerReaction {
+	return mtproto.MakeTLMessagePeerReaction(&mtproto.MessagePeerReaction{
+		Big:      big,
+		Unread:   unread,
+		PeerId:   mtproto.MakePeerUser(userId),
+		Reaction: reaction,
+	}).To_MessagePeerReaction()
+}
+
+// MakeMessageReactions counts the reactions seen by selfUserId, reactions is ordered from
+// the newest to the oldest. The recent reactions are only listed when canSeeList is set.
+func MakeMessageReactions(selfUserId int64, canSeeList bool, reactions []*mtproto.MessagePeerReaction) *mtproto.MessageReactions {
+	messageReactions := mtproto.MakeTLMessageReactions(&mtproto.MessageReactions{
+		Min:             false,
+		CanSeeList:      canSeeList,
+		Results:         make([]*mtproto.ReactionCount, 0),
+		RecentReactions: nil,
+	}).To_MessageReactions()
+	if canSeeList {
+		messageReactions.RecentReactions = make([]*mtproto.MessagePeerReaction, 0, recentReactionsMax)
+	}
+
+	for _, r := range reactions {
+		var (
+			count *mtproto.ReactionCount
+		)
+		for _, v := range messageReactions.Results {
+			if v.Reaction == r.Reaction {
+				count = v
+				break
+			}
+		}
+		if count == nil {
+			count = mtproto.MakeTLReactionCount(&mtproto.ReactionCount{
+				Chosen:   false,
+				Reaction: r.Reaction,
+				Count:    0,
+			}).To_ReactionCount()
+			messageReactions.Results = append(messageReactions.Results, count)
+		}
+		count.Count++
+		if r.GetPeerId().GetUserId() == selfUserId {
+			count.Chosen = true
+		}
+
+		if canSeeList && len(messageReactions.RecentReactions) < recentReactionsMax {
+			messageReactions.RecentReactions = append(messageReactions.RecentReactions, r)
+		}
+	}
+
+	sort.SliceStable(messageReactions.Results, func(i, j int) bool {
+		return messageReactions.Results[i].Count > messageReactions.Results[j].Count
+	})
+
+	return messageReactions
+}
@@ -0,0 +1,47 @@
+// Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+//  All rights reserved.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package message
+
+import (
+	"testing"
+
+	"github.com/teamgram/proto/mtproto"
+)
+
+func TestMakeMessageReactions(t *testing.T) {
+	reactions := []*mtproto.MessagePeerReaction{
+		MakeMessagePeerReaction(104, "🔥", false, false),
+		MakeMessagePeerReaction(103, "👍", true, true),
+		MakeMessagePeerReaction(102, "🔥", false, false),
+		MakeMessagePeerReaction(101, "🔥", false, false),
+	}
+
+	r := MakeMessageReactions(103, true, reactions)
+	if !r.CanSeeList || len(r.Results) != 2 {
+		t.Fatalf("bad reactions: %v", r)
+	}
+	if r.Results[0].Reaction != "🔥" || r.Results[0].Count != 3 || r.Results[0].Chosen {
+		t.Errorf("bad results[0]: %v", r.Results[0])
+	}
+	if r.Results[1].Reaction != "👍" || r.Results[1].Count != 1 || !r.Results[1].Chosen {
+		t.Errorf("bad results[1]: %v", r.Results[1])
+	}
+	if len(r.RecentReactions) != recentReactionsMax || r.RecentReactions[0].GetPeerId().GetUserId() != 104 {
+		t.Errorf("bad recent reactions: %v", r.RecentReactions)
+	}
+
+	// private chats don't list the reactions
+	r = MakeMessageReactions(101, false, reactions[3:])
+	if r.CanSeeList || r.RecentReactions != nil || len(r.Results) != 1 || !r.Results[0].Chosen {
+		t.Errorf("bad reactions: %v", r)
+	}
+
+	r = MakeMessageReactions(101, false, nil)
+	if len(r.Results) != 0 {
+		t.Errorf("bad reactions: %v", r)
+	}
+}
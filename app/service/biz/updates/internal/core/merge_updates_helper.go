@@ -42,6 +42,7 @@ func equalPeer(p, o *mtproto.Peer) bool {
 
 type mergeUpdatesHelper struct {
 	newMessages           []*mtproto.Message
+	newEncryptedMessages  []*mtproto.EncryptedMessage
 	deleteMessages        *list.Element
 	readHistoryInBoxList  []*list.Element
 	readHistoryOutBoxList []*list.Element
@@ -53,8 +54,9 @@ type mergeUpdatesHelper struct {
 
 func newMergeUpdatesHelper() *mergeUpdatesHelper {
 	return &mergeUpdatesHelper{
-		newMessages:  []*mtproto.Message{},
-		otherUpdates: list.New(),
+		newMessages:          []*mtproto.Message{},
+		newEncryptedMessages: []*mtproto.EncryptedMessage{},
+		otherUpdates:         list.New(),
 	}
 }
 
@@ -63,6 +65,9 @@ func (m *mergeUpdatesHelper) merge(update *mtproto.Update, pts int32) {
 	case mtproto.Predicate_updateNewMessage:
 		// updateNewMessage#1f2b0afd message:Message pts:int pts_count:int = Update;
 		m.newMessages = append(m.newMessages, update.Message_MESSAGE)
+	case mtproto.Predicate_updateNewEncryptedMessage:
+		// updateNewEncryptedMessage#12bcbd9a message:EncryptedMessage qts:int = Update;
+		m.newEncryptedMessages = append(m.newEncryptedMessages, update.Message_ENCRYPTEDMESSAGE)
 	case mtproto.Predicate_updateDeleteMessages:
 		// updateDeleteMessages#a20db0e5 messages:Vector<int> pts:int pts_count:int = Update;
 		if m.deleteMessages == nil {
@@ -166,7 +171,23 @@ func (m *mergeUpdatesHelper) merge(update *mtproto.Update, pts int32) {
 	case mtproto.Predicate_updateFolderPeers:
 		// updateFolderPeers#19360dc0 folder_peers:Vector<FolderPeer> pts:int pts_count:int = Update;
 		if m.folderPeers != nil {
+			// the peers moved by the earlier updates are kept, a peer moved again is in its last folder
+			tmp := m.folderPeers.Value.(*mtproto.Update)
 			m.otherUpdates.Remove(m.folderPeers)
+			folderPeers := make([]*mtproto.FolderPeer, 0, len(tmp.FolderPeers)+len(update.FolderPeers))
+			for _, v := range tmp.FolderPeers {
+				moved := false
+				for _, v2 := range update.FolderPeers {
+					if equalPeer(v.GetPeer(), v2.GetPeer()) {
+						moved = true
+						break
+					}
+				}
+				if !moved {
+					folderPeers = append(folderPeers, v)
+				}
+			}
+			update.FolderPeers = append(folderPeers, update.FolderPeers...)
 		}
 		update.Pts_INT32 = pts
 		update.PtsCount = 0
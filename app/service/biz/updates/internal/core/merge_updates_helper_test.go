@@ -0,0 +1,70 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"testing"
+
+	"github.com/teamgram/proto/mtproto"
+)
+
+func makeTestUpdateFolderPeers(pts int32, folderId int32, userIdList ...int64) *mtproto.Update {
+	folderPeers := make([]*mtproto.FolderPeer, 0, len(userIdList))
+	for _, id := range userIdList {
+		folderPeers = append(folderPeers, mtproto.MakeTLFolderPeer(&mtproto.FolderPeer{
+			Peer:     mtproto.MakePeerUser(id),
+			FolderId: folderId,
+		}).To_FolderPeer())
+	}
+
+	return mtproto.MakeTLUpdateFolderPeers(&mtproto.Update{
+		FolderPeers: folderPeers,
+		Pts_INT32:   pts,
+		PtsCount:    1,
+	}).To_Update()
+}
+
+func TestMergeUpdateFolderPeers(t *testing.T) {
+	merge := newMergeUpdatesHelper()
+	merge.merge(makeTestUpdateFolderPeers(1, 1, 100, 101), 3)
+	merge.merge(makeTestUpdateFolderPeers(2, 1, 102), 3)
+	merge.merge(makeTestUpdateFolderPeers(3, 0, 100), 3)
+
+	updates := merge.toUpdates()
+	if len(updates) != 1 {
+		t.Fatalf("expected 1 update, got %d", len(updates))
+	}
+
+	folders := map[int64]int32{}
+	for _, v := range updates[0].GetFolderPeers() {
+		folders[v.GetPeer().GetUserId()] = v.GetFolderId()
+	}
+	expected := map[int64]int32{100: 0, 101: 1, 102: 1}
+	if len(folders) != len(expected) {
+		t.Fatalf("expected %v, got %v", expected, folders)
+	}
+	for id, folderId := range expected {
+		if folders[id] != folderId {
+			t.Errorf("peer %d: expected folder %d, got %d", id, folderId, folders[id])
+		}
+	}
+	if updates[0].GetPts_INT32() != 3 {
+		t.Errorf("expected pts 3, got %d", updates[0].GetPts_INT32())
+	}
+}
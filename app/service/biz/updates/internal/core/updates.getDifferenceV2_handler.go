@@ -18,7 +18,7 @@ import (
 )
 
 // UpdatesGetDifferenceV2
-// updates.getDifferenceV2 flags:# auth_key_id:long user_id:long pts:int pts_total_limit:flags.0?int date:long = Difference;
+// updates.getDifferenceV2 flags:# auth_key_id:long user_id:long pts:int pts_total_limit:flags.0?int date:long qts:flags.1?int = Difference;
 func (c *UpdatesCore) UpdatesGetDifferenceV2(in *updates.TLUpdatesGetDifferenceV2) (*updates.Difference, error) {
 	limit := in.GetPtsTotalLimit().GetValue()
 	// check pts_total_limit
@@ -31,7 +31,19 @@ func (c *UpdatesCore) UpdatesGetDifferenceV2(in *updates.TLUpdatesGetDifferenceV
 		lastDate = time.Now().Unix()
 	}
 
-	if len(updateList) == 0 {
+	// the secret chat messages are only returned to the clients passing qts
+	var (
+		qtsUpdateList []*mtproto.Update
+		lastQts       int32
+	)
+	if in.Qts != nil {
+		qtsUpdateList, lastQts = c.GetQtsUpdateList(in.AuthKeyId, in.Qts.Value, limit)
+	}
+	if lastQts == 0 {
+		lastQts = c.svcCtx.Dao.IDGenClient2.CurrentQtsId(c.ctx, in.AuthKeyId)
+	}
+
+	if len(updateList) == 0 && len(qtsUpdateList) == 0 {
 		// 1. updates.differenceTooLong#4afe8f6d pts:int = updates.Difference;
 		if lastSeq == 0 {
 			lastSeq = c.svcCtx.Dao.IDGenClient2.CurrentSeqId(c.ctx, in.AuthKeyId)
@@ -40,6 +52,7 @@ func (c *UpdatesCore) UpdatesGetDifferenceV2(in *updates.TLUpdatesGetDifferenceV
 		return updates.MakeTLDifferenceEmpty(&updates.Difference{
 			State: mtproto.MakeTLUpdatesState(&mtproto.Updates_State{
 				Pts:         lastPts,
+				Qts:         lastQts,
 				Date:        int32(lastDate),
 				UnreadCount: 0,
 				Seq:         lastSeq,
@@ -80,12 +93,16 @@ func (c *UpdatesCore) UpdatesGetDifferenceV2(in *updates.TLUpdatesGetDifferenceV
 	for _, update := range updateList {
 		merge.merge(update, lastPts)
 	}
+	for _, update := range qtsUpdateList {
+		merge.merge(update, lastPts)
+	}
 
 	if lastSeq == 0 {
 		lastSeq = c.svcCtx.Dao.IDGenClient2.CurrentSeqId(c.ctx, in.AuthKeyId)
 	}
 	state := mtproto.MakeTLUpdatesState(&mtproto.Updates_State{
 		Pts:         lastPts,
+		Qts:         lastQts,
 		Date:        int32(lastDate),
 		UnreadCount: 0,
 		Seq:         lastSeq,
@@ -101,9 +118,10 @@ func (c *UpdatesCore) UpdatesGetDifferenceV2(in *updates.TLUpdatesGetDifferenceV
 		//	intermediate_state:updates.State = updates.Difference;
 		//
 		return updates.MakeTLDifferenceSlice(&updates.Difference{
-			NewMessages:       merge.newMessages,
-			OtherUpdates:      merge.toUpdates(),
-			IntermediateState: state,
+			NewMessages:          merge.newMessages,
+			NewEncryptedMessages: merge.newEncryptedMessages,
+			OtherUpdates:         merge.toUpdates(),
+			IntermediateState:    state,
 		}).To_Difference(), nil
 	} else {
 		// 2. updates.difference#f49ca0
@@ -114,9 +132,10 @@ func (c *UpdatesCore) UpdatesGetDifferenceV2(in *updates.TLUpdatesGetDifferenceV
 		//	users:Vector<User>
 		//	state:updates.State = updates.Difference;
 		return updates.MakeTLDifference(&updates.Difference{
-			NewMessages:  merge.newMessages,
-			OtherUpdates: merge.toUpdates(),
-			State:        state,
+			NewMessages:          merge.newMessages,
+			NewEncryptedMessages: merge.newEncryptedMessages,
+			OtherUpdates:         merge.toUpdates(),
+			State:                state,
 		}).To_Difference(), nil
 	}
 
@@ -148,6 +167,39 @@ func (c *UpdatesCore) addSeqUpdate(updates []*mtproto.Update, do *dataobject.Aut
 	return updates
 }
 
+func (c *UpdatesCore) addQtsUpdate(updates []*mtproto.Update, do *dataobject.AuthQtsUpdatesDO) []*mtproto.Update {
+	update := &mtproto.Update{}
+	err := jsonx.UnmarshalFromString(do.UpdateData, update)
+	if err != nil {
+		c.Logger.Errorf("unmarshal qts's update(%d)error: %v", do.Id, err)
+		return updates
+	}
+	if mtproto.GetUpdateType(update) != do.UpdateType {
+		c.Logger.Errorf("update data error.")
+		return updates
+	}
+	updates = append(updates, update)
+	return updates
+}
+
+// GetQtsUpdateList returns the queued updates of the device after qts and the last qts of them.
+func (c *UpdatesCore) GetQtsUpdateList(authId int64, qts, limit int32) ([]*mtproto.Update, int32) {
+	var (
+		lastQts int32 = 0
+		rList         = make([]*mtproto.Update, 0)
+	)
+
+	doList, _ := c.svcCtx.Dao.AuthQtsUpdatesDAO.SelectByGtQts(c.ctx, authId, qts, limit)
+	for i := 0; i < len(doList); i++ {
+		rList = c.addQtsUpdate(rList, &doList[i])
+		if doList[i].Qts > lastQts {
+			lastQts = doList[i].Qts
+		}
+	}
+
+	return rList, lastQts
+}
+
 func (c *UpdatesCore) GetMergedUpdateList(userId int64, authId int64, pts, date, limit int32) ([]*mtproto.Update, int32, int32, int64) {
 	// ptsDOList, _ := m.ChannelPtsUpdatesDAO.SelectByGtPts(ctx, channelId, pts)
 	a, _ := c.svcCtx.Dao.UserPtsUpdatesDAO.SelectByGtPts(c.ctx, userId, pts, limit)
@@ -29,7 +29,7 @@ func (c *UpdatesCore) UpdatesGetState(in *updates.TLUpdatesGetState) (*mtproto.U
 	}
 	return mtproto.MakeTLUpdatesState(&mtproto.Updates_State{
 		Pts:         pts,
-		Qts:         0,
+		Qts:         c.svcCtx.Dao.IDGenClient2.CurrentQtsId(c.ctx, in.AuthKeyId),
 		Seq:         seq,
 		Date:        int32(time.Now().Unix()), // TODO(@benqi): do.Date2???
 		UnreadCount: 0,
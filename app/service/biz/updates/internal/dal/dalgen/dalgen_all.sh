@@ -1,2 +1,3 @@
+./dalgen.sh auth_qts_updates
 ./dalgen.sh auth_seq_updates
 ./dalgen.sh user_pts_updates
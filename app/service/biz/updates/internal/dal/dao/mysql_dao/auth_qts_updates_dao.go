@@ -0,0 +1,77 @@
+/*
+ * WARNING! All changes made in this file will be lost!
+ *   Created from by 'dalgen'
+ *
+ * Copyright (c) 2022-present,  Teamgram Authors.
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package mysql_dao
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/teamgram/marmota/pkg/stores/sqlx"
+	"github.com/teamgram/teamgram-server/app/service/biz/updates/internal/dal/dataobject"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+var _ *sql.Result
+
+type AuthQtsUpdatesDAO struct {
+	db *sqlx.DB
+}
+
+func NewAuthQtsUpdatesDAO(db *sqlx.DB) *AuthQtsUpdatesDAO {
+	return &AuthQtsUpdatesDAO{db}
+}
+
+// SelectByGtQts
+// select auth_id, user_id, qts, update_type, update_data, date2 from auth_qts_updates where auth_id = :auth_id and qts > :qts order by qts limit :limit
+// TODO(@benqi): sqlmap
+func (dao *AuthQtsUpdatesDAO) SelectByGtQts(ctx context.Context, auth_id int64, qts int32, limit int32) (rList []dataobject.AuthQtsUpdatesDO, err error) {
+	var (
+		query  = "select auth_id, user_id, qts, update_type, update_data, date2 from auth_qts_updates where auth_id = ? and qts > ? order by qts limit ?"
+		values []dataobject.AuthQtsUpdatesDO
+	)
+	err = dao.db.QueryRowsPartial(ctx, &values, query, auth_id, qts, limit)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("queryx in SelectByGtQts(_), error: %v", err)
+		return
+	}
+
+	rList = values
+
+	return
+}
+
+// SelectByGtQtsWithCB
+// select auth_id, user_id, qts, update_type, update_data, date2 from auth_qts_updates where auth_id = :auth_id and qts > :qts order by qts limit :limit
+// TODO(@benqi): sqlmap
+func (dao *AuthQtsUpdatesDAO) SelectByGtQtsWithCB(ctx context.Context, auth_id int64, qts int32, limit int32, cb func(i int, v *dataobject.AuthQtsUpdatesDO)) (rList []dataobject.AuthQtsUpdatesDO, err error) {
+	var (
+		query  = "select auth_id, user_id, qts, update_type, update_data, date2 from auth_qts_updates where auth_id = ? and qts > ? order by qts limit ?"
+		values []dataobject.AuthQtsUpdatesDO
+	)
+	err = dao.db.QueryRowsPartial(ctx, &values, query, auth_id, qts, limit)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("queryx in SelectByGtQts(_), error: %v", err)
+		return
+	}
+
+	rList = values
+
+	if cb != nil {
+		for i := 0; i < len(rList); i++ {
+			cb(i, &rList[i])
+		}
+	}
+
+	return
+}
@@ -0,0 +1,21 @@
+/*
+ * WARNING! All changes made in this file will be lost!
+ *   Created from by 'dalgen'
+ *
+ * Copyright (c) 2022-present,  Teamgram Authors.
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package dataobject
+
+type AuthQtsUpdatesDO struct {
+	Id         int64  `db:"id"`
+	AuthId     int64  `db:"auth_id"`
+	UserId     int64  `db:"user_id"`
+	Qts        int32  `db:"qts"`
+	UpdateType int32  `db:"update_type"`
+	UpdateData string `db:"update_data"`
+	Date2      int64  `db:"date2"`
+}
@@ -25,6 +25,7 @@ import (
 
 type Mysql struct {
 	*sqlx.DB
+	*mysql_dao.AuthQtsUpdatesDAO
 	*mysql_dao.AuthSeqUpdatesDAO
 	*mysql_dao.UserPtsUpdatesDAO
 	*sqlx.CommonDAO
@@ -33,6 +34,7 @@ type Mysql struct {
 func newMysqlDao(db *sqlx.DB) *Mysql {
 	return &Mysql{
 		DB:                db,
+		AuthQtsUpdatesDAO: mysql_dao.NewAuthQtsUpdatesDAO(db),
 		AuthSeqUpdatesDAO: mysql_dao.NewAuthSeqUpdatesDAO(db),
 		UserPtsUpdatesDAO: mysql_dao.NewUserPtsUpdatesDAO(db),
 		CommonDAO:         sqlx.NewCommonDAO(db),
@@ -31,11 +31,11 @@ var clazzNameRegisters2 = map[string]map[int]int32{
 
 	},
 	Predicate_difference: {
-		0: 1417839403, // 0x5482832b
+		0: 987239574, // 0x3ad81496
 
 	},
 	Predicate_differenceSlice: {
-		0: -879338017, // 0xcb965ddf
+		0: -639099782, // 0xd9e81c7a
 
 	},
 	Predicate_differenceTooLong: {
@@ -47,7 +47,7 @@ var clazzNameRegisters2 = map[string]map[int]int32{
 
 	},
 	Predicate_updates_getDifferenceV2: {
-		0: -1217698151, // 0xb76b6699
+		0: -317862110, // 0xed0dcf22
 
 	},
 	Predicate_updates_getChannelDifferenceV2: {
@@ -59,11 +59,11 @@ var clazzNameRegisters2 = map[string]map[int]int32{
 var clazzIdNameRegisters2 = map[int32]string{
 	-853998774:  Predicate_channelDifference,              // 0xcd19034a
 	-1948526002: Predicate_differenceEmpty,                // 0x8bdbda4e
-	1417839403:  Predicate_difference,                     // 0x5482832b
-	-879338017:  Predicate_differenceSlice,                // 0xcb965ddf
+	987239574:   Predicate_difference,                     // 0x3ad81496
+	-639099782:  Predicate_differenceSlice,                // 0xd9e81c7a
 	896724528:   Predicate_differenceTooLong,              // 0x3572ee30
 	524332412:   Predicate_updates_getState,               // 0x1f40ad7c
-	-1217698151: Predicate_updates_getDifferenceV2,        // 0xb76b6699
+	-317862110:  Predicate_updates_getDifferenceV2,        // 0xed0dcf22
 	1302540682:  Predicate_updates_getChannelDifferenceV2, // 0x4da3318a
 
 }
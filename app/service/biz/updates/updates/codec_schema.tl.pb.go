@@ -40,14 +40,14 @@ var clazzIdRegisters2 = map[int32]func() mtproto.TLObject{
 		o.Data2.Constructor = -1948526002
 		return o
 	},
-	1417839403: func() mtproto.TLObject { // 0x5482832b
+	987239574: func() mtproto.TLObject { // 0x3ad81496
 		o := MakeTLDifference(nil)
-		o.Data2.Constructor = 1417839403
+		o.Data2.Constructor = 987239574
 		return o
 	},
-	-879338017: func() mtproto.TLObject { // 0xcb965ddf
+	-639099782: func() mtproto.TLObject { // 0xd9e81c7a
 		o := MakeTLDifferenceSlice(nil)
-		o.Data2.Constructor = -879338017
+		o.Data2.Constructor = -639099782
 		return o
 	},
 	896724528: func() mtproto.TLObject { // 0x3572ee30
@@ -62,9 +62,9 @@ var clazzIdRegisters2 = map[int32]func() mtproto.TLObject{
 			Constructor: 524332412,
 		}
 	},
-	-1217698151: func() mtproto.TLObject { // 0xb76b6699
+	-317862110: func() mtproto.TLObject { // 0xed0dcf22
 		return &TLUpdatesGetDifferenceV2{
-			Constructor: -1217698151,
+			Constructor: -317862110,
 		}
 	},
 	1302540682: func() mtproto.TLObject { // 0x4da3318a
@@ -257,6 +257,7 @@ func (m *TLChannelDifference) Decode(dBuf *mtproto.DecodeBuf) error {
 				m.SetFinal(true)
 			}
 			m.SetPts(dBuf.Int())
+
 			c3 := dBuf.Int()
 			if c3 != int32(mtproto.CRC32_vector) {
 				// dBuf.err = fmt.Errorf("invalid mtproto.CRC32_vector, c%d: %d", 3, c3)
@@ -352,10 +353,10 @@ func (m *Difference) Decode(dBuf *mtproto.DecodeBuf) error {
 	case 0x8bdbda4e:
 		m2 := MakeTLDifferenceEmpty(m)
 		m2.Decode(dBuf)
-	case 0x5482832b:
+	case 0x3ad81496:
 		m2 := MakeTLDifference(m)
 		m2.Decode(dBuf)
-	case 0xcb965ddf:
+	case 0xd9e81c7a:
 		m2 := MakeTLDifferenceSlice(m)
 		m2.Decode(dBuf)
 	case 0x3572ee30:
@@ -398,7 +399,7 @@ func (m *Difference) To_DifferenceEmpty() *TLDifferenceEmpty {
 }
 
 // To_Difference
-// difference new_messages:Vector<Message> other_updates:Vector<Update> state:updates.State = Difference;
+// difference new_messages:Vector<Message> new_encrypted_messages:Vector<EncryptedMessage> other_updates:Vector<Update> state:updates.State = Difference;
 func (m *Difference) To_Difference() *TLDifference {
 	m.PredicateName = Predicate_difference
 	return &TLDifference{
@@ -407,7 +408,7 @@ func (m *Difference) To_Difference() *TLDifference {
 }
 
 // To_DifferenceSlice
-// differenceSlice new_messages:Vector<Message> other_updates:Vector<Update> intermediate_state:updates.State = Difference;
+// differenceSlice new_messages:Vector<Message> new_encrypted_messages:Vector<EncryptedMessage> other_updates:Vector<Update> intermediate_state:updates.State = Difference;
 func (m *Difference) To_DifferenceSlice() *TLDifferenceSlice {
 	m.PredicateName = Predicate_differenceSlice
 	return &TLDifferenceSlice{
@@ -505,7 +506,7 @@ func (m *TLDifferenceEmpty) DebugString() string {
 }
 
 // MakeTLDifference
-// difference new_messages:Vector<Message> other_updates:Vector<Update> state:updates.State = Difference;
+// difference new_messages:Vector<Message> new_encrypted_messages:Vector<EncryptedMessage> other_updates:Vector<Update> state:updates.State = Difference;
 func MakeTLDifference(data2 *Difference) *TLDifference {
 	if data2 == nil {
 		return &TLDifference{Data2: &Difference{
@@ -525,6 +526,13 @@ func (m *TLDifference) To_Difference() *Difference {
 func (m *TLDifference) SetNewMessages(v []*mtproto.Message) { m.Data2.NewMessages = v }
 func (m *TLDifference) GetNewMessages() []*mtproto.Message  { return m.Data2.NewMessages }
 
+func (m *TLDifference) SetNewEncryptedMessages(v []*mtproto.EncryptedMessage) {
+	m.Data2.NewEncryptedMessages = v
+}
+func (m *TLDifference) GetNewEncryptedMessages() []*mtproto.EncryptedMessage {
+	return m.Data2.NewEncryptedMessages
+}
+
 func (m *TLDifference) SetOtherUpdates(v []*mtproto.Update) { m.Data2.OtherUpdates = v }
 func (m *TLDifference) GetOtherUpdates() []*mtproto.Update  { return m.Data2.OtherUpdates }
 
@@ -539,9 +547,9 @@ func (m *TLDifference) Encode(layer int32) []byte {
 	x := mtproto.NewEncodeBuf(512)
 
 	var encodeF = map[uint32]func() []byte{
-		0x5482832b: func() []byte {
-			// difference new_messages:Vector<Message> other_updates:Vector<Update> state:updates.State = Difference;
-			x.UInt(0x5482832b)
+		0x3ad81496: func() []byte {
+			// difference new_messages:Vector<Message> new_encrypted_messages:Vector<EncryptedMessage> other_updates:Vector<Update> state:updates.State = Difference;
+			x.UInt(0x3ad81496)
 
 			x.Int(int32(mtproto.CRC32_vector))
 			x.Int(int32(len(m.GetNewMessages())))
@@ -549,6 +557,12 @@ func (m *TLDifference) Encode(layer int32) []byte {
 				x.Bytes((*v).Encode(layer))
 			}
 
+			x.Int(int32(mtproto.CRC32_vector))
+			x.Int(int32(len(m.GetNewEncryptedMessages())))
+			for _, v := range m.GetNewEncryptedMessages() {
+				x.Bytes((*v).Encode(layer))
+			}
+
 			x.Int(int32(mtproto.CRC32_vector))
 			x.Int(int32(len(m.GetOtherUpdates())))
 			for _, v := range m.GetOtherUpdates() {
@@ -578,20 +592,34 @@ func (m *TLDifference) CalcByteSize(layer int32) int {
 
 func (m *TLDifference) Decode(dBuf *mtproto.DecodeBuf) error {
 	var decodeF = map[uint32]func() error{
-		0x5482832b: func() error {
-			// difference new_messages:Vector<Message> other_updates:Vector<Update> state:updates.State = Difference;
+		0x3ad81496: func() error {
+			// difference new_messages:Vector<Message> new_encrypted_messages:Vector<EncryptedMessage> other_updates:Vector<Update> state:updates.State = Difference;
+
+			c0 := dBuf.Int()
+			if c0 != int32(mtproto.CRC32_vector) {
+				// dBuf.err = fmt.Errorf("invalid mtproto.CRC32_vector, c%d: %d", 0, c0)
+				return fmt.Errorf("invalid mtproto.CRC32_vector, c%d: %d", 0, c0)
+			}
+			l0 := dBuf.Int()
+			v0 := make([]*mtproto.Message, l0)
+			for i := int32(0); i < l0; i++ {
+				v0[i] = &mtproto.Message{}
+				v0[i].Decode(dBuf)
+			}
+			m.SetNewMessages(v0)
+
 			c1 := dBuf.Int()
 			if c1 != int32(mtproto.CRC32_vector) {
 				// dBuf.err = fmt.Errorf("invalid mtproto.CRC32_vector, c%d: %d", 1, c1)
 				return fmt.Errorf("invalid mtproto.CRC32_vector, c%d: %d", 1, c1)
 			}
 			l1 := dBuf.Int()
-			v1 := make([]*mtproto.Message, l1)
+			v1 := make([]*mtproto.EncryptedMessage, l1)
 			for i := int32(0); i < l1; i++ {
-				v1[i] = &mtproto.Message{}
+				v1[i] = &mtproto.EncryptedMessage{}
 				v1[i].Decode(dBuf)
 			}
-			m.SetNewMessages(v1)
+			m.SetNewEncryptedMessages(v1)
 
 			c2 := dBuf.Int()
 			if c2 != int32(mtproto.CRC32_vector) {
@@ -606,9 +634,9 @@ func (m *TLDifference) Decode(dBuf *mtproto.DecodeBuf) error {
 			}
 			m.SetOtherUpdates(v2)
 
-			m0 := &mtproto.Updates_State{}
-			m0.Decode(dBuf)
-			m.SetState(m0)
+			m3 := &mtproto.Updates_State{}
+			m3.Decode(dBuf)
+			m.SetState(m3)
 
 			return dBuf.GetError()
 		},
@@ -628,7 +656,7 @@ func (m *TLDifference) DebugString() string {
 }
 
 // MakeTLDifferenceSlice
-// differenceSlice new_messages:Vector<Message> other_updates:Vector<Update> intermediate_state:updates.State = Difference;
+// differenceSlice new_messages:Vector<Message> new_encrypted_messages:Vector<EncryptedMessage> other_updates:Vector<Update> intermediate_state:updates.State = Difference;
 func MakeTLDifferenceSlice(data2 *Difference) *TLDifferenceSlice {
 	if data2 == nil {
 		return &TLDifferenceSlice{Data2: &Difference{
@@ -648,6 +676,13 @@ func (m *TLDifferenceSlice) To_Difference() *Difference {
 func (m *TLDifferenceSlice) SetNewMessages(v []*mtproto.Message) { m.Data2.NewMessages = v }
 func (m *TLDifferenceSlice) GetNewMessages() []*mtproto.Message  { return m.Data2.NewMessages }
 
+func (m *TLDifferenceSlice) SetNewEncryptedMessages(v []*mtproto.EncryptedMessage) {
+	m.Data2.NewEncryptedMessages = v
+}
+func (m *TLDifferenceSlice) GetNewEncryptedMessages() []*mtproto.EncryptedMessage {
+	return m.Data2.NewEncryptedMessages
+}
+
 func (m *TLDifferenceSlice) SetOtherUpdates(v []*mtproto.Update) { m.Data2.OtherUpdates = v }
 func (m *TLDifferenceSlice) GetOtherUpdates() []*mtproto.Update  { return m.Data2.OtherUpdates }
 
@@ -666,9 +701,9 @@ func (m *TLDifferenceSlice) Encode(layer int32) []byte {
 	x := mtproto.NewEncodeBuf(512)
 
 	var encodeF = map[uint32]func() []byte{
-		0xcb965ddf: func() []byte {
-			// differenceSlice new_messages:Vector<Message> other_updates:Vector<Update> intermediate_state:updates.State = Difference;
-			x.UInt(0xcb965ddf)
+		0xd9e81c7a: func() []byte {
+			// differenceSlice new_messages:Vector<Message> new_encrypted_messages:Vector<EncryptedMessage> other_updates:Vector<Update> intermediate_state:updates.State = Difference;
+			x.UInt(0xd9e81c7a)
 
 			x.Int(int32(mtproto.CRC32_vector))
 			x.Int(int32(len(m.GetNewMessages())))
@@ -676,6 +711,12 @@ func (m *TLDifferenceSlice) Encode(layer int32) []byte {
 				x.Bytes((*v).Encode(layer))
 			}
 
+			x.Int(int32(mtproto.CRC32_vector))
+			x.Int(int32(len(m.GetNewEncryptedMessages())))
+			for _, v := range m.GetNewEncryptedMessages() {
+				x.Bytes((*v).Encode(layer))
+			}
+
 			x.Int(int32(mtproto.CRC32_vector))
 			x.Int(int32(len(m.GetOtherUpdates())))
 			for _, v := range m.GetOtherUpdates() {
@@ -705,20 +746,34 @@ func (m *TLDifferenceSlice) CalcByteSize(layer int32) int {
 
 func (m *TLDifferenceSlice) Decode(dBuf *mtproto.DecodeBuf) error {
 	var decodeF = map[uint32]func() error{
-		0xcb965ddf: func() error {
-			// differenceSlice new_messages:Vector<Message> other_updates:Vector<Update> intermediate_state:updates.State = Difference;
+		0xd9e81c7a: func() error {
+			// differenceSlice new_messages:Vector<Message> new_encrypted_messages:Vector<EncryptedMessage> other_updates:Vector<Update> intermediate_state:updates.State = Difference;
+
+			c0 := dBuf.Int()
+			if c0 != int32(mtproto.CRC32_vector) {
+				// dBuf.err = fmt.Errorf("invalid mtproto.CRC32_vector, c%d: %d", 0, c0)
+				return fmt.Errorf("invalid mtproto.CRC32_vector, c%d: %d", 0, c0)
+			}
+			l0 := dBuf.Int()
+			v0 := make([]*mtproto.Message, l0)
+			for i := int32(0); i < l0; i++ {
+				v0[i] = &mtproto.Message{}
+				v0[i].Decode(dBuf)
+			}
+			m.SetNewMessages(v0)
+
 			c1 := dBuf.Int()
 			if c1 != int32(mtproto.CRC32_vector) {
 				// dBuf.err = fmt.Errorf("invalid mtproto.CRC32_vector, c%d: %d", 1, c1)
 				return fmt.Errorf("invalid mtproto.CRC32_vector, c%d: %d", 1, c1)
 			}
 			l1 := dBuf.Int()
-			v1 := make([]*mtproto.Message, l1)
+			v1 := make([]*mtproto.EncryptedMessage, l1)
 			for i := int32(0); i < l1; i++ {
-				v1[i] = &mtproto.Message{}
+				v1[i] = &mtproto.EncryptedMessage{}
 				v1[i].Decode(dBuf)
 			}
-			m.SetNewMessages(v1)
+			m.SetNewEncryptedMessages(v1)
 
 			c2 := dBuf.Int()
 			if c2 != int32(mtproto.CRC32_vector) {
@@ -890,9 +945,9 @@ func (m *TLUpdatesGetDifferenceV2) Encode(layer int32) []byte {
 	// x.Int(int32(CRC32_updates_getDifferenceV2))
 
 	switch uint32(m.Constructor) {
-	case 0xb76b6699:
-		// updates.getDifferenceV2 flags:# auth_key_id:long user_id:long pts:int pts_total_limit:flags.0?int date:long = Difference;
-		x.UInt(0xb76b6699)
+	case 0xed0dcf22:
+		// updates.getDifferenceV2 flags:# auth_key_id:long user_id:long pts:int pts_total_limit:flags.0?int date:long qts:flags.1?int = Difference;
+		x.UInt(0xed0dcf22)
 
 		// set flags
 		var flags uint32 = 0
@@ -900,6 +955,9 @@ func (m *TLUpdatesGetDifferenceV2) Encode(layer int32) []byte {
 		if m.GetPtsTotalLimit() != nil {
 			flags |= 1 << 0
 		}
+		if m.GetQts() != nil {
+			flags |= 1 << 1
+		}
 
 		x.UInt(flags)
 
@@ -912,6 +970,9 @@ func (m *TLUpdatesGetDifferenceV2) Encode(layer int32) []byte {
 		}
 
 		x.Long(m.GetDate())
+		if m.GetQts() != nil {
+			x.Int(m.GetQts().Value)
+		}
 
 	default:
 		// log.Errorf("")
@@ -926,8 +987,8 @@ func (m *TLUpdatesGetDifferenceV2) CalcByteSize(layer int32) int {
 
 func (m *TLUpdatesGetDifferenceV2) Decode(dBuf *mtproto.DecodeBuf) error {
 	switch uint32(m.Constructor) {
-	case 0xb76b6699:
-		// updates.getDifferenceV2 flags:# auth_key_id:long user_id:long pts:int pts_total_limit:flags.0?int date:long = Difference;
+	case 0xed0dcf22:
+		// updates.getDifferenceV2 flags:# auth_key_id:long user_id:long pts:int pts_total_limit:flags.0?int date:long qts:flags.1?int = Difference;
 
 		flags := dBuf.UInt()
 		_ = flags
@@ -941,6 +1002,10 @@ func (m *TLUpdatesGetDifferenceV2) Decode(dBuf *mtproto.DecodeBuf) error {
 		}
 
 		m.Date = dBuf.Long()
+		if (flags & (1 << 1)) != 0 {
+			m.Qts = &types.Int32Value{Value: dBuf.Int()}
+		}
+
 		return dBuf.GetError()
 
 	default:
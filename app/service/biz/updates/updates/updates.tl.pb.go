@@ -37,11 +37,11 @@ const (
 	CRC32_UNKNOWN                        TLConstructor = 0
 	CRC32_channelDifference              TLConstructor = -853998774
 	CRC32_differenceEmpty                TLConstructor = -1948526002
-	CRC32_difference                     TLConstructor = 1417839403
-	CRC32_differenceSlice                TLConstructor = -879338017
+	CRC32_difference                     TLConstructor = 987239574
+	CRC32_differenceSlice                TLConstructor = -639099782
 	CRC32_differenceTooLong              TLConstructor = 896724528
 	CRC32_updates_getState               TLConstructor = 524332412
-	CRC32_updates_getDifferenceV2        TLConstructor = -1217698151
+	CRC32_updates_getDifferenceV2        TLConstructor = -317862110
 	CRC32_updates_getChannelDifferenceV2 TLConstructor = 1302540682
 )
 
@@ -49,11 +49,11 @@ var TLConstructor_name = map[int32]string{
 	0:           "CRC32_UNKNOWN",
 	-853998774:  "CRC32_channelDifference",
 	-1948526002: "CRC32_differenceEmpty",
-	1417839403:  "CRC32_difference",
-	-879338017:  "CRC32_differenceSlice",
+	987239574:   "CRC32_difference",
+	-639099782:  "CRC32_differenceSlice",
 	896724528:   "CRC32_differenceTooLong",
 	524332412:   "CRC32_updates_getState",
-	-1217698151: "CRC32_updates_getDifferenceV2",
+	-317862110:  "CRC32_updates_getDifferenceV2",
 	1302540682:  "CRC32_updates_getChannelDifferenceV2",
 }
 
@@ -61,11 +61,11 @@ var TLConstructor_value = map[string]int32{
 	"CRC32_UNKNOWN":                        0,
 	"CRC32_channelDifference":              -853998774,
 	"CRC32_differenceEmpty":                -1948526002,
-	"CRC32_difference":                     1417839403,
-	"CRC32_differenceSlice":                -879338017,
+	"CRC32_difference":                     987239574,
+	"CRC32_differenceSlice":                -639099782,
 	"CRC32_differenceTooLong":              896724528,
 	"CRC32_updates_getState":               524332412,
-	"CRC32_updates_getDifferenceV2":        -1217698151,
+	"CRC32_updates_getDifferenceV2":        -317862110,
 	"CRC32_updates_getChannelDifferenceV2": 1302540682,
 }
 
@@ -220,8 +220,8 @@ func (m *TLChannelDifference) GetData2() *ChannelDifference {
 
 //--------------------------------------------------------------------------------------------
 // differenceEmpty state:updates.State = Difference;
-// difference new_messages:Vector<Message> other_updates:Vector<Update> state:updates.State = Difference;
-// differenceSlice new_messages:Vector<Message> other_updates:Vector<Update> intermediate_state:updates.State = Difference;
+// difference new_messages:Vector<Message> new_encrypted_messages:Vector<EncryptedMessage> other_updates:Vector<Update> state:updates.State = Difference;
+// differenceSlice new_messages:Vector<Message> new_encrypted_messages:Vector<EncryptedMessage> other_updates:Vector<Update> intermediate_state:updates.State = Difference;
 // differenceTooLong pts:int = Difference;
 //
 // Difference <--
@@ -231,16 +231,17 @@ func (m *TLChannelDifference) GetData2() *ChannelDifference {
 //  + TL_differenceTooLong
 //
 type Difference struct {
-	PredicateName        string                 `protobuf:"bytes,1,opt,name=predicate_name,json=predicateName,proto3" json:"predicate_name,omitempty"`
-	Constructor          TLConstructor          `protobuf:"varint,2,opt,name=constructor,proto3,enum=updates.TLConstructor" json:"constructor,omitempty"`
-	State                *mtproto.Updates_State `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
-	NewMessages          []*mtproto.Message     `protobuf:"bytes,4,rep,name=new_messages,json=newMessages,proto3" json:"new_messages,omitempty"`
-	OtherUpdates         []*mtproto.Update      `protobuf:"bytes,5,rep,name=other_updates,json=otherUpdates,proto3" json:"other_updates,omitempty"`
-	IntermediateState    *mtproto.Updates_State `protobuf:"bytes,6,opt,name=intermediate_state,json=intermediateState,proto3" json:"intermediate_state,omitempty"`
-	Pts                  int32                  `protobuf:"varint,7,opt,name=pts,proto3" json:"pts,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
-	XXX_unrecognized     []byte                 `json:"-"`
-	XXX_sizecache        int32                  `json:"-"`
+	PredicateName        string                      `protobuf:"bytes,1,opt,name=predicate_name,json=predicateName,proto3" json:"predicate_name,omitempty"`
+	Constructor          TLConstructor               `protobuf:"varint,2,opt,name=constructor,proto3,enum=updates.TLConstructor" json:"constructor,omitempty"`
+	State                *mtproto.Updates_State      `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
+	NewMessages          []*mtproto.Message          `protobuf:"bytes,4,rep,name=new_messages,json=newMessages,proto3" json:"new_messages,omitempty"`
+	NewEncryptedMessages []*mtproto.EncryptedMessage `protobuf:"bytes,5,rep,name=new_encrypted_messages,json=newEncryptedMessages,proto3" json:"new_encrypted_messages,omitempty"`
+	OtherUpdates         []*mtproto.Update           `protobuf:"bytes,6,rep,name=other_updates,json=otherUpdates,proto3" json:"other_updates,omitempty"`
+	IntermediateState    *mtproto.Updates_State      `protobuf:"bytes,7,opt,name=intermediate_state,json=intermediateState,proto3" json:"intermediate_state,omitempty"`
+	Pts                  int32                       `protobuf:"varint,8,opt,name=pts,proto3" json:"pts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
+	XXX_unrecognized     []byte                      `json:"-"`
+	XXX_sizecache        int32                       `json:"-"`
 }
 
 func (m *Difference) Reset()         { *m = Difference{} }
@@ -304,6 +305,13 @@ func (m *Difference) GetNewMessages() []*mtproto.Message {
 	return nil
 }
 
+func (m *Difference) GetNewEncryptedMessages() []*mtproto.EncryptedMessage {
+	if m != nil {
+		return m.NewEncryptedMessages
+	}
+	return nil
+}
+
 func (m *Difference) GetOtherUpdates() []*mtproto.Update {
 	if m != nil {
 		return m.OtherUpdates
@@ -373,7 +381,7 @@ func (m *TLDifferenceEmpty) GetData2() *Difference {
 	return nil
 }
 
-// difference new_messages:Vector<Message> other_updates:Vector<Update> state:updates.State = Difference;
+// difference new_messages:Vector<Message> new_encrypted_messages:Vector<EncryptedMessage> other_updates:Vector<Update> state:updates.State = Difference;
 type TLDifference struct {
 	Data2                *Difference `protobuf:"bytes,1,opt,name=data2,proto3" json:"data2,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
@@ -421,7 +429,7 @@ func (m *TLDifference) GetData2() *Difference {
 	return nil
 }
 
-// differenceSlice new_messages:Vector<Message> other_updates:Vector<Update> intermediate_state:updates.State = Difference;
+// differenceSlice new_messages:Vector<Message> new_encrypted_messages:Vector<EncryptedMessage> other_updates:Vector<Update> intermediate_state:updates.State = Difference;
 type TLDifferenceSlice struct {
 	Data2                *Difference `protobuf:"bytes,1,opt,name=data2,proto3" json:"data2,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
@@ -583,7 +591,7 @@ func (m *TLUpdatesGetState) GetUserId() int64 {
 }
 
 //--------------------------------------------------------------------------------------------
-// updates.getDifferenceV2 flags:# auth_key_id:long user_id:long pts:int pts_total_limit:flags.0?int date:long = Difference;
+// updates.getDifferenceV2 flags:# auth_key_id:long user_id:long pts:int pts_total_limit:flags.0?int date:long qts:flags.1?int = Difference;
 type TLUpdatesGetDifferenceV2 struct {
 	Constructor          TLConstructor     `protobuf:"varint,1,opt,name=constructor,proto3,enum=updates.TLConstructor" json:"constructor,omitempty"`
 	AuthKeyId            int64             `protobuf:"varint,3,opt,name=auth_key_id,json=authKeyId,proto3" json:"auth_key_id,omitempty"`
@@ -591,6 +599,7 @@ type TLUpdatesGetDifferenceV2 struct {
 	Pts                  int32             `protobuf:"varint,5,opt,name=pts,proto3" json:"pts,omitempty"`
 	PtsTotalLimit        *types.Int32Value `protobuf:"bytes,6,opt,name=pts_total_limit,json=ptsTotalLimit,proto3" json:"pts_total_limit,omitempty"`
 	Date                 int64             `protobuf:"varint,7,opt,name=date,proto3" json:"date,omitempty"`
+	Qts                  *types.Int32Value `protobuf:"bytes,8,opt,name=qts,proto3" json:"qts,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
 	XXX_sizecache        int32             `json:"-"`
@@ -671,6 +680,13 @@ func (m *TLUpdatesGetDifferenceV2) GetDate() int64 {
 	return 0
 }
 
+func (m *TLUpdatesGetDifferenceV2) GetQts() *types.Int32Value {
+	if m != nil {
+		return m.Qts
+	}
+	return nil
+}
+
 //--------------------------------------------------------------------------------------------
 // updates.getChannelDifferenceV2 auth_key_id:long user_id:long channel_id:long pts:int limit:int = ChannelDifference;
 type TLUpdatesGetChannelDifferenceV2 struct {
@@ -777,65 +793,67 @@ func init() {
 func init() { proto.RegisterFile("updates.tl.proto", fileDescriptor_a220846e90de680d) }
 
 var fileDescriptor_a220846e90de680d = []byte{
-	// 925 bytes of a gzipped FileDescriptorProto
+	// 960 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0xcf, 0x8f, 0xdb, 0x44,
-	0x14, 0x8e, 0x93, 0x38, 0x4b, 0x5f, 0x9a, 0xd6, 0x3b, 0x5d, 0x76, 0x83, 0xa1, 0x26, 0x98, 0x22,
-	0x85, 0x55, 0xeb, 0x20, 0x2f, 0x07, 0xc4, 0x05, 0x41, 0xa8, 0xc4, 0xd2, 0xb0, 0x20, 0x37, 0x5b,
-	0x24, 0x2e, 0xd6, 0xac, 0x33, 0xeb, 0x18, 0xe2, 0x1f, 0xf2, 0x4c, 0x58, 0x85, 0x23, 0x87, 0x52,
-	0x55, 0xfc, 0x03, 0x88, 0x23, 0xdc, 0x2a, 0x24, 0x0e, 0xdc, 0xfa, 0x0f, 0x20, 0x2a, 0x10, 0x57,
-	0x50, 0xa1, 0xb0, 0x70, 0xe0, 0x82, 0xe0, 0x88, 0x4a, 0xa5, 0x45, 0x9e, 0xb1, 0xe3, 0x6c, 0xbc,
-	0x5b, 0xba, 0x1c, 0xd8, 0x53, 0xde, 0xbc, 0xef, 0x7b, 0x33, 0x6f, 0xbe, 0x79, 0xef, 0xc5, 0xa0,
-	0x8c, 0xa3, 0x01, 0x66, 0x84, 0x1a, 0x6c, 0x64, 0x44, 0x71, 0xc8, 0x42, 0xb4, 0x90, 0x7a, 0xd4,
-	0x0b, 0xae, 0xc7, 0x86, 0xe3, 0x2d, 0xc3, 0x09, 0xfd, 0x8e, 0x1b, 0xba, 0x61, 0x87, 0xe3, 0x5b,
-	0xe3, 0x6d, 0xbe, 0xe2, 0x0b, 0x6e, 0x89, 0x38, 0x55, 0x73, 0xc3, 0xd0, 0x1d, 0x91, 0x9c, 0xb5,
-	0x13, 0xe3, 0x28, 0x22, 0x31, 0x4d, 0x71, 0x95, 0x3a, 0x43, 0xe2, 0xe3, 0xe4, 0x20, 0x27, 0x8c,
-	0x89, 0xcd, 0x26, 0x11, 0xc9, 0xb0, 0x47, 0x72, 0x8c, 0xc5, 0x38, 0xa0, 0x51, 0x18, 0xb3, 0x14,
-	0x5a, 0xca, 0x21, 0x3a, 0x09, 0x1c, 0xe1, 0xd5, 0x3f, 0x28, 0xc3, 0x62, 0x77, 0x88, 0x83, 0x80,
-	0x8c, 0x5e, 0xf6, 0xb6, 0xb7, 0x49, 0x4c, 0x02, 0x87, 0xa0, 0xa7, 0xe0, 0x54, 0x14, 0x93, 0x81,
-	0xe7, 0x60, 0x46, 0xec, 0x00, 0xfb, 0xa4, 0x29, 0xb5, 0xa4, 0xf6, 0x09, 0xab, 0x31, 0xf5, 0x6e,
-	0x60, 0x9f, 0xa0, 0xe7, 0xa0, 0xee, 0x84, 0x01, 0x65, 0xf1, 0xd8, 0x61, 0x61, 0xdc, 0x2c, 0xb7,
-	0xa4, 0xf6, 0x29, 0x73, 0xd9, 0xc8, 0x94, 0xe8, 0xf7, 0xba, 0x39, 0x6a, 0xcd, 0x52, 0xd1, 0x12,
-	0xc8, 0xdb, 0x5e, 0x80, 0x47, 0xcd, 0x4a, 0x4b, 0x6a, 0x3f, 0x64, 0x89, 0x05, 0x52, 0xa0, 0x12,
-	0x31, 0xda, 0xac, 0xb6, 0xa4, 0xb6, 0x6c, 0x25, 0x26, 0x5a, 0x83, 0x93, 0x01, 0xd9, 0xb1, 0x7d,
-	0x42, 0x29, 0x76, 0x09, 0x6d, 0xca, 0xad, 0x4a, 0xbb, 0x6e, 0x2a, 0x86, 0xcf, 0x78, 0xfa, 0xc6,
-	0x6b, 0x02, 0xb0, 0xea, 0x01, 0xd9, 0x49, 0x6d, 0x8a, 0x9e, 0x85, 0x46, 0xc8, 0x86, 0x24, 0xb6,
-	0xd3, 0x44, 0x9a, 0x35, 0x1e, 0x75, 0x7a, 0x1a, 0xb5, 0xc9, 0xfd, 0xd6, 0x49, 0xce, 0x12, 0x0b,
-	0xaa, 0xbf, 0x02, 0x4b, 0xfd, 0x9e, 0xed, 0x14, 0xb4, 0x78, 0x06, 0xe4, 0x01, 0x66, 0xd8, 0xe4,
-	0x12, 0xd4, 0x4d, 0x75, 0x7a, 0xbd, 0x82, 0x6c, 0x96, 0x20, 0xea, 0x77, 0xca, 0x00, 0xff, 0xa7,
-	0x98, 0xe7, 0x41, 0xa6, 0x0c, 0x33, 0xc2, 0xc5, 0xac, 0x9b, 0xcb, 0xd3, 0x7b, 0xa6, 0xb1, 0xf6,
-	0xe5, 0x04, 0xb5, 0x04, 0xa9, 0x20, 0x69, 0xf5, 0x3f, 0x49, 0x2a, 0x3f, 0x80, 0xa4, 0xe8, 0x22,
-	0x20, 0x2f, 0x60, 0x24, 0xf6, 0xc9, 0xc0, 0x4b, 0x2e, 0x2f, 0xb2, 0xac, 0xdd, 0x37, 0xcb, 0xc5,
-	0xd9, 0x08, 0xee, 0xca, 0xca, 0x62, 0x61, 0x5a, 0x16, 0xfa, 0x0b, 0x80, 0xfa, 0x3d, 0x7b, 0x30,
-	0xd5, 0xf8, 0xa2, 0x1f, 0xb1, 0x09, 0x7a, 0x7a, 0xff, 0x4b, 0x9d, 0x99, 0x6a, 0x57, 0x7c, 0xa2,
-	0xe7, 0xa1, 0xb1, 0x6f, 0x83, 0xa3, 0xc4, 0xce, 0x1f, 0x7e, 0x79, 0xe4, 0x1d, 0x6d, 0x83, 0x17,
-	0x79, 0xa5, 0xe5, 0x1b, 0xf4, 0xc3, 0xb0, 0x17, 0x06, 0xee, 0x51, 0xb6, 0xb8, 0x26, 0xc1, 0x99,
-	0x7e, 0x2f, 0x7b, 0x0d, 0xdb, 0x25, 0x4c, 0x48, 0x35, 0x57, 0x44, 0xd2, 0x83, 0x17, 0x91, 0x06,
-	0x75, 0x3c, 0x66, 0x43, 0xfb, 0x1d, 0x32, 0xb1, 0xbd, 0x01, 0x2f, 0xa5, 0x8a, 0x75, 0x22, 0x71,
-	0x5d, 0x22, 0x93, 0xf5, 0x01, 0x5a, 0x81, 0x85, 0x31, 0x25, 0x71, 0x82, 0x55, 0x39, 0x56, 0x4b,
-	0x96, 0xeb, 0x03, 0xfd, 0xae, 0x04, 0xea, 0xfe, 0x54, 0xf2, 0x74, 0xaf, 0x98, 0xc7, 0x90, 0x51,
-	0x56, 0x2f, 0x72, 0x3e, 0x46, 0xba, 0x70, 0x3a, 0x62, 0xd4, 0x66, 0x21, 0xc3, 0x23, 0x7b, 0xe4,
-	0xf9, 0x1e, 0x4b, 0xab, 0xf0, 0x51, 0x43, 0x0c, 0x5b, 0x23, 0x1b, 0xb6, 0xc6, 0x7a, 0xc0, 0xd6,
-	0xcc, 0x2b, 0x78, 0x34, 0x26, 0x56, 0x23, 0x62, 0xb4, 0x9f, 0x84, 0xf4, 0x92, 0x08, 0x84, 0xa0,
-	0x9a, 0xe4, 0xcc, 0xeb, 0xb0, 0x62, 0x71, 0x5b, 0xff, 0x41, 0x82, 0x27, 0xf6, 0x5f, 0xbe, 0x30,
-	0x15, 0x8e, 0x47, 0x83, 0xb3, 0x00, 0xe9, 0x28, 0x4b, 0x30, 0x59, 0xc4, 0xa5, 0x9e, 0x5c, 0xa2,
-	0x5a, 0x2e, 0xd1, 0x12, 0xc8, 0x42, 0x18, 0xd1, 0x66, 0x62, 0xb1, 0x7a, 0xab, 0x9c, 0x34, 0xca,
-	0x4c, 0x7a, 0x68, 0x11, 0x1a, 0x5d, 0xab, 0xbb, 0x66, 0xda, 0x9b, 0x1b, 0x97, 0x36, 0x5e, 0x7f,
-	0x73, 0x43, 0x29, 0xa1, 0x73, 0xb0, 0x22, 0x5c, 0x85, 0xe1, 0xa9, 0x7c, 0x75, 0xf5, 0xd7, 0xdf,
-	0xee, 0xed, 0xed, 0xed, 0xed, 0x49, 0x48, 0x87, 0x87, 0x05, 0x6b, 0xae, 0x6d, 0x95, 0x6f, 0xbe,
-	0xf8, 0xe3, 0xc7, 0xbf, 0x04, 0xa7, 0x09, 0xca, 0x3c, 0x47, 0xb9, 0x71, 0xf5, 0xfa, 0xa7, 0xf2,
-	0x41, 0xd1, 0xbc, 0xef, 0x94, 0x3b, 0x37, 0xbf, 0xbb, 0x9d, 0x9e, 0xf0, 0x78, 0x96, 0x47, 0xa1,
-	0xb5, 0x94, 0xcf, 0x6f, 0xdf, 0xba, 0x51, 0x41, 0x1a, 0x2c, 0x0b, 0xc2, 0x7c, 0xdf, 0x28, 0xf7,
-	0xbe, 0x7f, 0xff, 0xae, 0x84, 0x56, 0xe1, 0x6c, 0x01, 0x9f, 0x7d, 0x48, 0xe5, 0xa3, 0xaf, 0x3f,
-	0xbb, 0xf9, 0xb7, 0x38, 0xec, 0x3c, 0x9c, 0x2b, 0x70, 0x0f, 0x78, 0x7b, 0xe5, 0xfa, 0x2f, 0x1f,
-	0xfe, 0x5e, 0x55, 0xab, 0xd7, 0x3e, 0xd1, 0x4a, 0xe6, 0xc7, 0x65, 0x00, 0xeb, 0x8d, 0x6e, 0x36,
-	0x1e, 0x5f, 0x9d, 0x7e, 0x34, 0xe4, 0x0d, 0xfc, 0xd8, 0x4c, 0x55, 0x14, 0xd2, 0x54, 0x0f, 0x19,
-	0x9a, 0x7a, 0x09, 0x6d, 0xc2, 0xca, 0x61, 0x1d, 0xf8, 0xe4, 0x21, 0x5b, 0xce, 0x92, 0xd4, 0x83,
-	0x86, 0x8d, 0x5e, 0x42, 0x6f, 0x83, 0xf6, 0x2f, 0xb5, 0xbd, 0x7a, 0xc8, 0xee, 0x07, 0x70, 0xd5,
-	0xfb, 0xfc, 0x77, 0xea, 0xa5, 0x97, 0x36, 0xff, 0xfc, 0x59, 0x93, 0xbe, 0xdc, 0xd5, 0xa4, 0x6f,
-	0x77, 0x35, 0xe9, 0xa7, 0x5d, 0x4d, 0x7a, 0xab, 0xcb, 0x08, 0xf6, 0xdd, 0x18, 0xfb, 0x86, 0x17,
-	0x76, 0x32, 0xfb, 0x02, 0x25, 0xf1, 0xbb, 0x24, 0xee, 0xe0, 0x28, 0xea, 0x24, 0xa6, 0xe7, 0x90,
-	0xce, 0x96, 0xf7, 0x9e, 0x9d, 0xd9, 0xe9, 0x11, 0xd9, 0xef, 0x56, 0x8d, 0x0b, 0xb6, 0xf6, 0x4f,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0x08, 0x80, 0x57, 0x14, 0xa1, 0x09, 0x00, 0x00,
+	0x14, 0x8e, 0xf3, 0x6b, 0xdb, 0x97, 0xa6, 0xf5, 0x4e, 0xc3, 0x6e, 0x6a, 0xa8, 0x09, 0xa6, 0x48,
+	0x61, 0xd5, 0x75, 0x90, 0x97, 0x03, 0xe2, 0x82, 0x20, 0xac, 0xc4, 0xd2, 0xb0, 0x45, 0x6e, 0xb6,
+	0x48, 0x5c, 0xac, 0x59, 0x7b, 0xd6, 0x31, 0xc4, 0x3f, 0xb0, 0x27, 0xac, 0xc2, 0x91, 0x03, 0x54,
+	0x88, 0x33, 0x17, 0x24, 0x84, 0xe0, 0x54, 0x71, 0xe9, 0x01, 0x09, 0xfe, 0x04, 0x44, 0x25, 0xe0,
+	0xc4, 0x01, 0x01, 0x2a, 0x0b, 0x87, 0x5e, 0x10, 0x1c, 0x51, 0x55, 0x58, 0xe4, 0x19, 0x3b, 0x4e,
+	0xe2, 0xdd, 0xb6, 0xcb, 0x81, 0x9e, 0x32, 0xf3, 0xde, 0xf7, 0xbd, 0x79, 0xf3, 0xbd, 0x37, 0x2f,
+	0x06, 0x71, 0x14, 0x58, 0x98, 0x92, 0x48, 0xa5, 0x43, 0x35, 0x08, 0x7d, 0xea, 0xa3, 0x85, 0xc4,
+	0x22, 0xad, 0xda, 0x0e, 0x1d, 0x8c, 0xb6, 0x55, 0xd3, 0x77, 0x3b, 0xb6, 0x6f, 0xfb, 0x1d, 0xe6,
+	0xdf, 0x1e, 0xed, 0xb0, 0x1d, 0xdb, 0xb0, 0x15, 0xe7, 0x49, 0xb2, 0xed, 0xfb, 0xf6, 0x90, 0x64,
+	0xa8, 0xdd, 0x10, 0x07, 0x01, 0x09, 0xa3, 0xc4, 0x2f, 0x45, 0xe6, 0x80, 0xb8, 0x38, 0x3e, 0xc8,
+	0xf4, 0x43, 0x62, 0xd0, 0x71, 0x40, 0x52, 0xdf, 0x99, 0xcc, 0x47, 0x43, 0xec, 0x45, 0x81, 0x1f,
+	0xd2, 0xc4, 0xd5, 0xc8, 0x5c, 0xd1, 0xd8, 0x33, 0xb9, 0x55, 0x79, 0xb7, 0x08, 0x8b, 0xdd, 0x01,
+	0xf6, 0x3c, 0x32, 0x7c, 0xde, 0xd9, 0xd9, 0x21, 0x21, 0xf1, 0x4c, 0x82, 0x1e, 0x83, 0x93, 0x41,
+	0x48, 0x2c, 0xc7, 0xc4, 0x94, 0x18, 0x1e, 0x76, 0x49, 0x53, 0x68, 0x09, 0xed, 0xe3, 0x7a, 0x7d,
+	0x62, 0xdd, 0xc4, 0x2e, 0x41, 0x4f, 0x41, 0xcd, 0xf4, 0xbd, 0x88, 0x86, 0x23, 0x93, 0xfa, 0x61,
+	0xb3, 0xd8, 0x12, 0xda, 0x27, 0xb5, 0x25, 0x35, 0x55, 0xa2, 0xdf, 0xeb, 0x66, 0x5e, 0x7d, 0x1a,
+	0x8a, 0x1a, 0x50, 0xd9, 0x71, 0x3c, 0x3c, 0x6c, 0x96, 0x5a, 0x42, 0xfb, 0x98, 0xce, 0x37, 0x48,
+	0x84, 0x52, 0x40, 0xa3, 0x66, 0xb9, 0x25, 0xb4, 0x2b, 0x7a, 0xbc, 0x44, 0x6b, 0x70, 0xc2, 0x23,
+	0xbb, 0x86, 0x4b, 0xa2, 0x08, 0xdb, 0x24, 0x6a, 0x56, 0x5a, 0xa5, 0x76, 0x4d, 0x13, 0x55, 0x97,
+	0xb2, 0xf4, 0xd5, 0x97, 0xb8, 0x43, 0xaf, 0x79, 0x64, 0x37, 0x59, 0x47, 0xe8, 0x49, 0xa8, 0xfb,
+	0x74, 0x40, 0x42, 0x23, 0x49, 0xa4, 0x59, 0x65, 0xac, 0x53, 0x13, 0xd6, 0x16, 0xb3, 0xeb, 0x27,
+	0x18, 0x8a, 0x6f, 0x22, 0xe5, 0x05, 0x68, 0xf4, 0x7b, 0x86, 0x99, 0xd3, 0xe2, 0x09, 0xa8, 0x58,
+	0x98, 0x62, 0x8d, 0x49, 0x50, 0xd3, 0xa4, 0xc9, 0xf5, 0x72, 0xb2, 0xe9, 0x1c, 0xa8, 0x5c, 0x2b,
+	0x01, 0xfc, 0x9f, 0x62, 0x9e, 0x87, 0x4a, 0x44, 0x31, 0x25, 0x4c, 0xcc, 0x9a, 0xb6, 0x34, 0xb9,
+	0x67, 0xc2, 0x35, 0x2e, 0xc5, 0x5e, 0x9d, 0x83, 0x72, 0x92, 0x96, 0xef, 0x45, 0xd2, 0x8b, 0xb0,
+	0x14, 0x93, 0x88, 0x67, 0x86, 0xe3, 0x80, 0x12, 0x6b, 0xbe, 0x22, 0x67, 0x26, 0xf4, 0xf5, 0x14,
+	0x92, 0xc6, 0x69, 0x78, 0x64, 0x77, 0xde, 0xf8, 0x1f, 0x6b, 0x84, 0xd6, 0x01, 0x39, 0x1e, 0x25,
+	0xa1, 0x4b, 0x2c, 0x27, 0x56, 0x93, 0x5f, 0x7b, 0xe1, 0x8e, 0xd7, 0x5e, 0x9c, 0x66, 0x30, 0x53,
+	0xda, 0x67, 0xc7, 0x26, 0x7d, 0xa6, 0x3c, 0x03, 0xa8, 0xdf, 0x33, 0xac, 0x49, 0xd1, 0xd6, 0xdd,
+	0x80, 0x8e, 0xd1, 0xe3, 0xb3, 0xa5, 0x3f, 0x3d, 0x29, 0x46, 0xbe, 0xe6, 0x4f, 0x43, 0x7d, 0x26,
+	0xc0, 0x51, 0xb8, 0xf3, 0x87, 0x5f, 0x1a, 0x3a, 0x47, 0x0b, 0xf0, 0x2c, 0x34, 0x66, 0x02, 0xf4,
+	0x7d, 0xbf, 0xe7, 0x7b, 0xf6, 0x51, 0x42, 0x5c, 0x11, 0xe0, 0x74, 0xbf, 0x97, 0x56, 0xc3, 0xb0,
+	0x09, 0xe5, 0x52, 0xcd, 0x75, 0xa5, 0x70, 0xef, 0x5d, 0x29, 0x43, 0x0d, 0x8f, 0xe8, 0xc0, 0x78,
+	0x9d, 0x8c, 0x0d, 0xc7, 0x62, 0xbd, 0x59, 0xd2, 0x8f, 0xc7, 0xa6, 0x0b, 0x64, 0xbc, 0x61, 0xa1,
+	0x65, 0x58, 0x18, 0x45, 0x24, 0x8c, 0x7d, 0x65, 0xe6, 0xab, 0xc6, 0xdb, 0x0d, 0x4b, 0xb9, 0x5a,
+	0x04, 0x69, 0x36, 0x95, 0x2c, 0xdd, 0xcb, 0xda, 0x7d, 0xc8, 0x28, 0xed, 0x97, 0x4a, 0x36, 0x97,
+	0xba, 0x70, 0x2a, 0xa0, 0x91, 0x41, 0x7d, 0x8a, 0x87, 0xc6, 0xd0, 0x71, 0x1d, 0xda, 0xac, 0x32,
+	0x8d, 0x1f, 0x54, 0xf9, 0xf4, 0x56, 0xd3, 0xe9, 0xad, 0x6e, 0x78, 0x74, 0x4d, 0xbb, 0x8c, 0x87,
+	0x23, 0xa2, 0xd7, 0x03, 0x1a, 0xf5, 0x63, 0x4a, 0x2f, 0x66, 0x20, 0x04, 0x65, 0x2b, 0xed, 0xdf,
+	0x92, 0xce, 0xd6, 0x68, 0x15, 0x4a, 0x6f, 0x24, 0xad, 0x79, 0x97, 0x60, 0x31, 0x4e, 0xf9, 0x49,
+	0x80, 0x47, 0x66, 0xb5, 0xca, 0x4d, 0xa5, 0xfb, 0x23, 0xd9, 0x59, 0x80, 0x64, 0x94, 0xc6, 0xbe,
+	0x0a, 0xe7, 0x25, 0x96, 0x4c, 0xd1, 0x6a, 0xa6, 0x68, 0x03, 0x2a, 0x5c, 0xc7, 0x05, 0x66, 0xe3,
+	0x9b, 0x95, 0xeb, 0x45, 0xa8, 0xcf, 0xa4, 0x87, 0x16, 0xa1, 0xde, 0xd5, 0xbb, 0x6b, 0x9a, 0xb1,
+	0xb5, 0x79, 0x61, 0xf3, 0xe2, 0x2b, 0x9b, 0x62, 0x01, 0x9d, 0x83, 0x65, 0x6e, 0xca, 0x0d, 0x6f,
+	0xf1, 0xeb, 0x77, 0x7e, 0xbb, 0x79, 0x7b, 0x7f, 0x7f, 0x7f, 0x5f, 0x40, 0x0a, 0x3c, 0xc0, 0x51,
+	0x73, 0xaf, 0x5c, 0xfc, 0xe6, 0xf3, 0x3f, 0x7e, 0xfe, 0x8b, 0x63, 0x9a, 0x20, 0xce, 0x63, 0xc4,
+	0x0f, 0xae, 0xde, 0xf8, 0xbe, 0x74, 0x10, 0x9b, 0x3d, 0x53, 0xf1, 0xd6, 0x97, 0x1f, 0x7f, 0xfb,
+	0x37, 0x67, 0x3f, 0x0c, 0xcb, 0xf3, 0x98, 0xe4, 0x25, 0x8a, 0xd7, 0x7e, 0xbc, 0xfe, 0x59, 0x09,
+	0xc9, 0xb0, 0xc4, 0x01, 0xf3, 0xcf, 0x4c, 0xbc, 0xfd, 0xc3, 0xdb, 0xb7, 0x04, 0xb4, 0x02, 0x67,
+	0x73, 0xfe, 0xe9, 0x42, 0x8a, 0x9f, 0x7c, 0xf4, 0xc5, 0xcd, 0x7f, 0xf8, 0x61, 0xe7, 0xe1, 0x5c,
+	0x0e, 0x7b, 0x40, 0xed, 0xc5, 0xf7, 0x7e, 0x7d, 0xff, 0xf7, 0xb2, 0x54, 0xbe, 0xf2, 0xa9, 0x5c,
+	0xd0, 0x3e, 0x2c, 0x02, 0xe8, 0x2f, 0x77, 0xd3, 0x69, 0xfa, 0x22, 0x88, 0x53, 0x64, 0xfe, 0xde,
+	0x1f, 0x9a, 0xea, 0x8a, 0x5c, 0x9a, 0xd2, 0x21, 0x33, 0x56, 0x29, 0xa0, 0x2d, 0x58, 0x3e, 0xec,
+	0xc1, 0x3e, 0x7a, 0x48, 0xc8, 0x69, 0x90, 0x74, 0xd0, 0x6c, 0x52, 0x0a, 0xe8, 0x35, 0x90, 0xef,
+	0xd2, 0xdb, 0x2b, 0x87, 0x44, 0x3f, 0x00, 0x2b, 0xdd, 0xe1, 0xbf, 0x5b, 0x29, 0x3c, 0xb7, 0xf5,
+	0xe7, 0x2f, 0xb2, 0xf0, 0xd5, 0x9e, 0x2c, 0x7c, 0xb7, 0x27, 0x0b, 0x37, 0xf6, 0x64, 0xe1, 0xd5,
+	0xee, 0xd4, 0x87, 0x1b, 0x25, 0xd8, 0xb5, 0x43, 0x9c, 0x2d, 0x56, 0x23, 0x12, 0xbe, 0x49, 0xc2,
+	0x0e, 0x0e, 0x82, 0x4e, 0xbc, 0x74, 0x4c, 0xd2, 0xd9, 0x76, 0xde, 0xea, 0x24, 0x47, 0xa4, 0xbf,
+	0xdb, 0x55, 0x26, 0xd8, 0xda, 0xbf, 0x03, 0x00, 0x7d, 0x13, 0x89, 0x3f, 0x21, 0x0a, 0x00, 0x00,
 }
 
 func (this *ChannelDifference) GoString() string {
@@ -879,7 +897,7 @@ func (this *Difference) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 11)
+	s := make([]string, 0, 12)
 	s = append(s, "&updates.Difference{")
 	s = append(s, "PredicateName: "+fmt.Sprintf("%#v", this.PredicateName)+",\n")
 	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
@@ -889,6 +907,9 @@ func (this *Difference) GoString() string {
 	if this.NewMessages != nil {
 		s = append(s, "NewMessages: "+fmt.Sprintf("%#v", this.NewMessages)+",\n")
 	}
+	if this.NewEncryptedMessages != nil {
+		s = append(s, "NewEncryptedMessages: "+fmt.Sprintf("%#v", this.NewEncryptedMessages)+",\n")
+	}
 	if this.OtherUpdates != nil {
 		s = append(s, "OtherUpdates: "+fmt.Sprintf("%#v", this.OtherUpdates)+",\n")
 	}
@@ -981,7 +1002,7 @@ func (this *TLUpdatesGetDifferenceV2) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 10)
+	s := make([]string, 0, 11)
 	s = append(s, "&updates.TLUpdatesGetDifferenceV2{")
 	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
 	s = append(s, "AuthKeyId: "+fmt.Sprintf("%#v", this.AuthKeyId)+",\n")
@@ -991,6 +1012,9 @@ func (this *TLUpdatesGetDifferenceV2) GoString() string {
 		s = append(s, "PtsTotalLimit: "+fmt.Sprintf("%#v", this.PtsTotalLimit)+",\n")
 	}
 	s = append(s, "Date: "+fmt.Sprintf("%#v", this.Date)+",\n")
+	if this.Qts != nil {
+		s = append(s, "Qts: "+fmt.Sprintf("%#v", this.Qts)+",\n")
+	}
 	if this.XXX_unrecognized != nil {
 		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
@@ -1038,7 +1062,7 @@ const _ = grpc.SupportPackageIsVersion4
 type RPCUpdatesClient interface {
 	// updates.getState auth_key_id:long user_id:long = updates.State;
 	UpdatesGetState(ctx context.Context, in *TLUpdatesGetState, opts ...grpc.CallOption) (*mtproto.Updates_State, error)
-	// updates.getDifferenceV2 flags:# auth_key_id:long user_id:long pts:int pts_total_limit:flags.0?int date:long = Difference;
+	// updates.getDifferenceV2 flags:# auth_key_id:long user_id:long pts:int pts_total_limit:flags.0?int date:long qts:flags.1?int = Difference;
 	UpdatesGetDifferenceV2(ctx context.Context, in *TLUpdatesGetDifferenceV2, opts ...grpc.CallOption) (*Difference, error)
 	// updates.getChannelDifferenceV2 auth_key_id:long user_id:long channel_id:long pts:int limit:int = ChannelDifference;
 	UpdatesGetChannelDifferenceV2(ctx context.Context, in *TLUpdatesGetChannelDifferenceV2, opts ...grpc.CallOption) (*ChannelDifference, error)
@@ -1083,7 +1107,7 @@ func (c *rPCUpdatesClient) UpdatesGetChannelDifferenceV2(ctx context.Context, in
 type RPCUpdatesServer interface {
 	// updates.getState auth_key_id:long user_id:long = updates.State;
 	UpdatesGetState(context.Context, *TLUpdatesGetState) (*mtproto.Updates_State, error)
-	// updates.getDifferenceV2 flags:# auth_key_id:long user_id:long pts:int pts_total_limit:flags.0?int date:long = Difference;
+	// updates.getDifferenceV2 flags:# auth_key_id:long user_id:long pts:int pts_total_limit:flags.0?int date:long qts:flags.1?int = Difference;
 	UpdatesGetDifferenceV2(context.Context, *TLUpdatesGetDifferenceV2) (*Difference, error)
 	// updates.getChannelDifferenceV2 auth_key_id:long user_id:long channel_id:long pts:int limit:int = ChannelDifference;
 	UpdatesGetChannelDifferenceV2(context.Context, *TLUpdatesGetChannelDifferenceV2) (*ChannelDifference, error)
@@ -1330,7 +1354,7 @@ func (m *Difference) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	if m.Pts != 0 {
 		i = encodeVarintUpdatesTl(dAtA, i, uint64(m.Pts))
 		i--
-		dAtA[i] = 0x38
+		dAtA[i] = 0x40
 	}
 	if m.IntermediateState != nil {
 		{
@@ -1342,7 +1366,7 @@ func (m *Difference) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintUpdatesTl(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x32
+		dAtA[i] = 0x3a
 	}
 	if len(m.OtherUpdates) > 0 {
 		for iNdEx := len(m.OtherUpdates) - 1; iNdEx >= 0; iNdEx-- {
@@ -1355,6 +1379,20 @@ func (m *Difference) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintUpdatesTl(dAtA, i, uint64(size))
 			}
 			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.NewEncryptedMessages) > 0 {
+		for iNdEx := len(m.NewEncryptedMessages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NewEncryptedMessages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintUpdatesTl(dAtA, i, uint64(size))
+			}
+			i--
 			dAtA[i] = 0x2a
 		}
 	}
@@ -1621,6 +1659,18 @@ func (m *TLUpdatesGetDifferenceV2) MarshalToSizedBuffer(dAtA []byte) (int, error
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Qts != nil {
+		{
+			size, err := m.Qts.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintUpdatesTl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
 	if m.Date != 0 {
 		i = encodeVarintUpdatesTl(dAtA, i, uint64(m.Date))
 		i--
@@ -1805,6 +1855,12 @@ func (m *Difference) Size() (n int) {
 			n += 1 + l + sovUpdatesTl(uint64(l))
 		}
 	}
+	if len(m.NewEncryptedMessages) > 0 {
+		for _, e := range m.NewEncryptedMessages {
+			l = e.Size()
+			n += 1 + l + sovUpdatesTl(uint64(l))
+		}
+	}
 	if len(m.OtherUpdates) > 0 {
 		for _, e := range m.OtherUpdates {
 			l = e.Size()
@@ -1934,6 +1990,10 @@ func (m *TLUpdatesGetDifferenceV2) Size() (n int) {
 	if m.Date != 0 {
 		n += 1 + sovUpdatesTl(uint64(m.Date))
 	}
+	if m.Qts != nil {
+		l = m.Qts.Size()
+		n += 1 + l + sovUpdatesTl(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -2423,6 +2483,40 @@ func (m *Difference) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewEncryptedMessages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpdatesTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthUpdatesTl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthUpdatesTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewEncryptedMessages = append(m.NewEncryptedMessages, &mtproto.EncryptedMessage{})
+			if err := m.NewEncryptedMessages[len(m.NewEncryptedMessages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field OtherUpdates", wireType)
 			}
@@ -2456,7 +2550,7 @@ func (m *Difference) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 7:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field IntermediateState", wireType)
 			}
@@ -2492,7 +2586,7 @@ func (m *Difference) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 8:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pts", wireType)
 			}
@@ -3149,6 +3243,42 @@ func (m *TLUpdatesGetDifferenceV2) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Qts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpdatesTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthUpdatesTl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthUpdatesTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Qts == nil {
+				m.Qts = &types.Int32Value{}
+			}
+			if err := m.Qts.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipUpdatesTl(dAtA[iNdEx:])
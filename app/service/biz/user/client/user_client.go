@@ -82,6 +82,22 @@ type UserClient interface {
 	UserIsBot(ctx context.Context, in *user.TLUserIsBot) (*mtproto.Bool, error)
 	UserGetBotInfo(ctx context.Context, in *user.TLUserGetBotInfo) (*mtproto.BotInfo, error)
 	UserGetFullUser(ctx context.Context, in *user.TLUserGetFullUser) (*mtproto.Users_UserFull, error)
+	UserGetPassword(ctx context.Context, in *user.TLUserGetPassword) (*mtproto.Account_Password, error)
+	UserGetPasswordSettings(ctx context.Context, in *user.TLUserGetPasswordSettings) (*mtproto.Account_PasswordSettings, error)
+	UserUpdatePasswordSettings(ctx context.Context, in *user.TLUserUpdatePasswordSettings) (*mtproto.Bool, error)
+	UserCheckPassword(ctx context.Context, in *user.TLUserCheckPassword) (*mtproto.Bool, error)
+	UserConfirmPasswordEmail(ctx context.Context, in *user.TLUserConfirmPasswordEmail) (*mtproto.Bool, error)
+	UserResendPasswordEmail(ctx context.Context, in *user.TLUserResendPasswordEmail) (*mtproto.Bool, error)
+	UserCancelPasswordEmail(ctx context.Context, in *user.TLUserCancelPasswordEmail) (*mtproto.Bool, error)
+	UserRequestPasswordRecovery(ctx context.Context, in *user.TLUserRequestPasswordRecovery) (*mtproto.Auth_PasswordRecovery, error)
+	UserCheckRecoveryPassword(ctx context.Context, in *user.TLUserCheckRecoveryPassword) (*mtproto.Bool, error)
+	UserRecoverPassword(ctx context.Context, in *user.TLUserRecoverPassword) (*mtproto.Bool, error)
+	UserResetPassword(ctx context.Context, in *user.TLUserResetPassword) (*mtproto.Account_ResetPasswordResult, error)
+	UserDeclinePasswordReset(ctx context.Context, in *user.TLUserDeclinePasswordReset) (*mtproto.Bool, error)
+	UserCheckSessionPasswordNeeded(ctx context.Context, in *user.TLUserCheckSessionPasswordNeeded) (*mtproto.Bool, error)
+	UserCreateBot(ctx context.Context, in *user.TLUserCreateBot) (*user.ImmutableUser, error)
+	UserGetBotsByCreator(ctx context.Context, in *user.TLUserGetBotsByCreator) (*user.Vector_Long, error)
+	UserResetBotToken(ctx context.Context, in *user.TLUserResetBotToken) (*mtproto.String, error)
 }
 
 type defaultUserClient struct {
@@ -513,3 +529,115 @@ func (m *defaultUserClient) UserGetFullUser(ctx context.Context, in *user.TLUser
 	client := user.NewRPCUserClient(m.cli.Conn())
 	return client.UserGetFullUser(ctx, in)
 }
+
+// UserGetPassword
+// user.getPassword user_id:long = account.Password;
+func (m *defaultUserClient) UserGetPassword(ctx context.Context, in *user.TLUserGetPassword) (*mtproto.Account_Password, error) {
+	client := user.NewRPCUserClient(m.cli.Conn())
+	return client.UserGetPassword(ctx, in)
+}
+
+// UserGetPasswordSettings
+// user.getPasswordSettings user_id:long password:InputCheckPasswordSRP = account.PasswordSettings;
+func (m *defaultUserClient) UserGetPasswordSettings(ctx context.Context, in *user.TLUserGetPasswordSettings) (*mtproto.Account_PasswordSettings, error) {
+	client := user.NewRPCUserClient(m.cli.Conn())
+	return client.UserGetPasswordSettings(ctx, in)
+}
+
+// UserUpdatePasswordSettings
+// user.updatePasswordSettings user_id:long password:InputCheckPasswordSRP new_settings:account.PasswordInputSettings = Bool;
+func (m *defaultUserClient) UserUpdatePasswordSettings(ctx context.Context, in *user.TLUserUpdatePasswordSettings) (*mtproto.Bool, error) {
+	client := user.NewRPCUserClient(m.cli.Conn())
+	return client.UserUpdatePasswordSettings(ctx, in)
+}
+
+// UserCheckPassword
+// user.checkPassword user_id:long password:InputCheckPasswordSRP = Bool;
+func (m *defaultUserClient) UserCheckPassword(ctx context.Context, in *user.TLUserCheckPassword) (*mtproto.Bool, error) {
+	client := user.NewRPCUserClient(m.cli.Conn())
+	return client.UserCheckPassword(ctx, in)
+}
+
+// UserConfirmPasswordEmail
+// user.confirmPasswordEmail user_id:long code:string = Bool;
+func (m *defaultUserClient) UserConfirmPasswordEmail(ctx context.Context, in *user.TLUserConfirmPasswordEmail) (*mtproto.Bool, error) {
+	client := user.NewRPCUserClient(m.cli.Conn())
+	return client.UserConfirmPasswordEmail(ctx, in)
+}
+
+// UserResendPasswordEmail
+// user.resendPasswordEmail user_id:long = Bool;
+func (m *defaultUserClient) UserResendPasswordEmail(ctx context.Context, in *user.TLUserResendPasswordEmail) (*mtproto.Bool, error) {
+	client := user.NewRPCUserClient(m.cli.Conn())
+	return client.UserResendPasswordEmail(ctx, in)
+}
+
+// UserCancelPasswordEmail
+// user.cancelPasswordEmail user_id:long = Bool;
+func (m *defaultUserClient) UserCancelPasswordEmail(ctx context.Context, in *user.TLUserCancelPasswordEmail) (*mtproto.Bool, error) {
+	client := user.NewRPCUserClient(m.cli.Conn())
+	return client.UserCancelPasswordEmail(ctx, in)
+}
+
+// UserRequestPasswordRecovery
+// user.requestPasswordRecovery user_id:long = auth.PasswordRecovery;
+func (m *defaultUserClient) UserRequestPasswordRecovery(ctx context.Context, in *user.TLUserRequestPasswordRecovery) (*mtproto.Auth_PasswordRecovery, error) {
+	client := user.NewRPCUserClient(m.cli.Conn())
+	return client.UserRequestPasswordRecovery(ctx, in)
+}
+
+// UserCheckRecoveryPassword
+// user.checkRecoveryPassword user_id:long code:string = Bool;
+func (m *defaultUserClient) UserCheckRecoveryPassword(ctx context.Context, in *user.TLUserCheckRecoveryPassword) (*mtproto.Bool, error) {
+	client := user.NewRPCUserClient(m.cli.Conn())
+	return client.UserCheckRecoveryPassword(ctx, in)
+}
+
+// UserRecoverPassword
+// user.recoverPassword flags:# user_id:long code:string new_settings:flags.0?account.PasswordInputSettings = Bool;
+func (m *defaultUserClient) UserRecoverPassword(ctx context.Context, in *user.TLUserRecoverPassword) (*mtproto.Bool, error) {
+	client := user.NewRPCUserClient(m.cli.Conn())
+	return client.UserRecoverPassword(ctx, in)
+}
+
+// UserResetPassword
+// user.resetPassword user_id:long = account.ResetPasswordResult;
+func (m *defaultUserClient) UserResetPassword(ctx context.Context, in *user.TLUserResetPassword) (*mtproto.Account_ResetPasswordResult, error) {
+	client := user.NewRPCUserClient(m.cli.Conn())
+	return client.UserResetPassword(ctx, in)
+}
+
+// UserDeclinePasswordReset
+// user.declinePasswordReset user_id:long = Bool;
+func (m *defaultUserClient) UserDeclinePasswordReset(ctx context.Context, in *user.TLUserDeclinePasswordReset) (*mtproto.Bool, error) {
+	client := user.NewRPCUserClient(m.cli.Conn())
+	return client.UserDeclinePasswordReset(ctx, in)
+}
+
+// UserCheckSessionPasswordNeeded
+// user.checkSessionPasswordNeeded user_id:long = Bool;
+func (m *defaultUserClient) UserCheckSessionPasswordNeeded(ctx context.Context, in *user.TLUserCheckSessionPasswordNeeded) (*mtproto.Bool, error) {
+	client := user.NewRPCUserClient(m.cli.Conn())
+	return client.UserCheckSessionPasswordNeeded(ctx, in)
+}
+
+// UserCreateBot
+// user.createBot creator_id:long first_name:string username:string = ImmutableUser;
+func (m *defaultUserClient) UserCreateBot(ctx context.Context, in *user.TLUserCreateBot) (*user.ImmutableUser, error) {
+	client := user.NewRPCUserClient(m.cli.Conn())
+	return client.UserCreateBot(ctx, in)
+}
+
+// UserGetBotsByCreator
+// user.getBotsByCreator creator_id:long = Vector<long>;
+func (m *defaultUserClient) UserGetBotsByCreator(ctx context.Context, in *user.TLUserGetBotsByCreator) (*user.Vector_Long, error) {
+	client := user.NewRPCUserClient(m.cli.Conn())
+	return client.UserGetBotsByCreator(ctx, in)
+}
+
+// UserResetBotToken
+// user.resetBotToken creator_id:long bot_id:long = String;
+func (m *defaultUserClient) UserResetBotToken(ctx context.Context, in *user.TLUserResetBotToken) (*mtproto.String, error) {
+	client := user.NewRPCUserClient(m.cli.Conn())
+	return client.UserResetBotToken(ctx, in)
+}
@@ -0,0 +1,56 @@
+// Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+//  All rights reserved.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package user_client
+
+import (
+	"context"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/biz/user/user"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/zrpc"
+)
+
+// UserPlugin implements the authorization hooks of the bff plugin interfaces,
+// only the two-step verification check is backed by the user service.
+type UserPlugin struct {
+	cli UserClient
+}
+
+func NewUserPlugin(cli zrpc.Client) *UserPlugin {
+	return &UserPlugin{
+		cli: NewUserClient(cli),
+	}
+}
+
+func (m *UserPlugin) Client() UserClient {
+	return m.cli
+}
+
+func (m *UserPlugin) OnAuthLogout(ctx context.Context, userId int64, keys ...int64) error {
+	return nil
+}
+
+func (m *UserPlugin) OnAuthAction(ctx context.Context, authKeyId, msgId int64, clientIp string, phoneNumber string, actionType int, log string) {
+}
+
+func (m *UserPlugin) CheckPhoneNumberBanned(ctx context.Context, phoneNumber string) (bool, error) {
+	return false, nil
+}
+
+func (m *UserPlugin) CheckSessionPasswordNeeded(ctx context.Context, userId int64) bool {
+	needed, err := m.cli.UserCheckSessionPasswordNeeded(ctx, &user.TLUserCheckSessionPasswordNeeded{
+		UserId: userId,
+	})
+	if err != nil {
+		logx.WithContext(ctx).Errorf("checkSessionPasswordNeeded(%d) - error: %v", userId, err)
+		return false
+	}
+
+	return mtproto.FromBool(needed)
+}
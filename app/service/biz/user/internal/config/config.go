@@ -11,7 +11,9 @@ package config
 
 import (
 	"github.com/teamgram/marmota/pkg/stores/sqlx"
+	"github.com/teamgram/teamgram-server/pkg/email"
 	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/kv"
 	"github.com/zeromicro/go-zero/zrpc"
 )
 
@@ -19,5 +21,8 @@ type Config struct {
 	zrpc.RpcServerConf
 	Mysql       sqlx.Config
 	Cache       cache.CacheConf
+	KV          kv.KvConf
 	MediaClient zrpc.RpcClientConf
+	// Email sends the two-step verification codes, they're logged if not set
+	Email *email.Config `json:",optional"`
 }
@@ -0,0 +1,28 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/biz/user/internal/dao"
+	"github.com/teamgram/teamgram-server/app/service/biz/user/user"
+)
+
+// UserCancelPasswordEmail
+// user.cancelPasswordEmail user_id:long = Bool;
+func (c *UserCore) UserCancelPasswordEmail(in *user.TLUserCancelPasswordEmail) (*mtproto.Bool, error) {
+	if _, err := c.svcCtx.Dao.UserPasswordsDAO.UpdateEmailUnconfirmed(c.ctx, "", in.UserId); err != nil {
+		c.Logger.Errorf("user.cancelPasswordEmail - error: %v", err)
+		return nil, err
+	}
+	c.svcCtx.Dao.DeletePasswordCode(c.ctx, in.UserId, dao.PasswordCodeConfirmEmail)
+
+	return mtproto.BoolTrue, nil
+}
@@ -0,0 +1,44 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/biz/user/internal/dao"
+	"github.com/teamgram/teamgram-server/app/service/biz/user/user"
+)
+
+// UserCheckPassword
+// user.checkPassword user_id:long password:InputCheckPasswordSRP = Bool;
+func (c *UserCore) UserCheckPassword(in *user.TLUserCheckPassword) (*mtproto.Bool, error) {
+	do, err := c.svcCtx.Dao.UserPasswordsDAO.SelectByUserId(c.ctx, in.UserId)
+	if err != nil {
+		c.Logger.Errorf("user.checkPassword - error: %v", err)
+		return nil, err
+	} else if !dao.HasPassword(do) {
+		err = mtproto.ErrPasswordEmpty
+		c.Logger.Errorf("user.checkPassword - error: %v", err)
+		return nil, err
+	}
+
+	if err = c.svcCtx.Dao.CheckPassword(c.ctx, in.UserId, do, in.Password); err != nil {
+		c.Logger.Errorf("user.checkPassword - error: %v", err)
+		return nil, err
+	}
+
+	// logging in with the password cancels the pending reset
+	if do.PendingResetDate > 0 {
+		if _, err = c.svcCtx.Dao.UserPasswordsDAO.UpdateResetDate(c.ctx, 0, do.ResetRetryDate, in.UserId); err != nil {
+			c.Logger.Errorf("user.checkPassword - error: %v", err)
+		}
+	}
+
+	return mtproto.BoolTrue, nil
+}
@@ -0,0 +1,27 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/biz/user/internal/dao"
+	"github.com/teamgram/teamgram-server/app/service/biz/user/user"
+)
+
+// UserCheckRecoveryPassword
+// user.checkRecoveryPassword user_id:long code:string = Bool;
+func (c *UserCore) UserCheckRecoveryPassword(in *user.TLUserCheckRecoveryPassword) (*mtproto.Bool, error) {
+	if err := c.svcCtx.Dao.CheckPasswordCode(c.ctx, in.UserId, dao.PasswordCodeRecovery, in.Code, false); err != nil {
+		c.Logger.Errorf("user.checkRecoveryPassword - error: %v", err)
+		return nil, err
+	}
+
+	return mtproto.BoolTrue, nil
+}
@@ -0,0 +1,28 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/biz/user/internal/dao"
+	"github.com/teamgram/teamgram-server/app/service/biz/user/user"
+)
+
+// UserCheckSessionPasswordNeeded
+// user.checkSessionPasswordNeeded user_id:long = Bool;
+func (c *UserCore) UserCheckSessionPasswordNeeded(in *user.TLUserCheckSessionPasswordNeeded) (*mtproto.Bool, error) {
+	do, err := c.svcCtx.Dao.UserPasswordsDAO.SelectByUserId(c.ctx, in.UserId)
+	if err != nil {
+		c.Logger.Errorf("user.checkSessionPasswordNeeded - error: %v", err)
+		return nil, err
+	}
+
+	return mtproto.ToBool(dao.HasPassword(do)), nil
+}
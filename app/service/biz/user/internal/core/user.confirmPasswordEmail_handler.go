@@ -0,0 +1,42 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/biz/user/internal/dao"
+	"github.com/teamgram/teamgram-server/app/service/biz/user/user"
+)
+
+// UserConfirmPasswordEmail
+// user.confirmPasswordEmail user_id:long code:string = Bool;
+func (c *UserCore) UserConfirmPasswordEmail(in *user.TLUserConfirmPasswordEmail) (*mtproto.Bool, error) {
+	do, err := c.svcCtx.Dao.UserPasswordsDAO.SelectByUserId(c.ctx, in.UserId)
+	if err != nil {
+		c.Logger.Errorf("user.confirmPasswordEmail - error: %v", err)
+		return nil, err
+	} else if do == nil || do.EmailUnconfirmed == "" {
+		err = mtproto.ErrEmailHashExpired
+		c.Logger.Errorf("user.confirmPasswordEmail - error: %v", err)
+		return nil, err
+	}
+
+	if err = c.svcCtx.Dao.CheckPasswordCode(c.ctx, in.UserId, dao.PasswordCodeConfirmEmail, in.Code, true); err != nil {
+		c.Logger.Errorf("user.confirmPasswordEmail - error: %v", err)
+		return nil, err
+	}
+
+	if _, err = c.svcCtx.Dao.UserPasswordsDAO.UpdateEmail(c.ctx, do.EmailUnconfirmed, in.UserId); err != nil {
+		c.Logger.Errorf("user.confirmPasswordEmail - error: %v", err)
+		return nil, err
+	}
+
+	return mtproto.BoolTrue, nil
+}
@@ -0,0 +1,53 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"math/rand"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/biz/user/user"
+)
+
+// UserCreateBot
+// user.createBot creator_id:long first_name:string username:string = ImmutableUser;
+func (c *UserCore) UserCreateBot(in *user.TLUserCreateBot) (*user.ImmutableUser, error) {
+	if !user.CheckBotUsername(in.Username) {
+		err := mtproto.ErrUsernameInvalid
+		c.Logger.Errorf("user.createBot - error: %v", err)
+		return nil, err
+	}
+
+	if firstName := []rune(in.FirstName); len(firstName) == 0 || len(firstName) > user.MaxBotFirstNameLen {
+		err := mtproto.ErrFirstnameInvalid
+		c.Logger.Errorf("user.createBot - error: %v", err)
+		return nil, err
+	}
+
+	botList, err := c.svcCtx.Dao.BotsDAO.SelectListByCreator(c.ctx, in.CreatorId)
+	if err != nil {
+		c.Logger.Errorf("user.createBot - error: %v", err)
+		return nil, err
+	} else if len(botList) >= user.MaxBotsPerCreator {
+		err = mtproto.ErrBotsTooMuch
+		c.Logger.Errorf("user.createBot - error: %v", err)
+		return nil, err
+	}
+
+	botId, err := c.svcCtx.Dao.CreateBot(c.ctx, in.CreatorId, rand.Int63(), in.FirstName, in.Username)
+	if err != nil {
+		c.Logger.Errorf("user.createBot - error: %v", err)
+		return nil, err
+	}
+
+	return c.UserGetImmutableUser(&user.TLUserGetImmutableUser{
+		Id: botId,
+	})
+}
@@ -0,0 +1,38 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"time"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/biz/user/internal/dao"
+	"github.com/teamgram/teamgram-server/app/service/biz/user/user"
+)
+
+// UserDeclinePasswordReset
+// user.declinePasswordReset user_id:long = Bool;
+func (c *UserCore) UserDeclinePasswordReset(in *user.TLUserDeclinePasswordReset) (*mtproto.Bool, error) {
+	do, err := c.svcCtx.Dao.UserPasswordsDAO.SelectByUserId(c.ctx, in.UserId)
+	if err != nil {
+		c.Logger.Errorf("user.declinePasswordReset - error: %v", err)
+		return nil, err
+	} else if do == nil || do.PendingResetDate == 0 {
+		return mtproto.BoolFalse, nil
+	}
+
+	retryDate := int32(time.Now().Unix()) + dao.PasswordResetRetryWait
+	if _, err = c.svcCtx.Dao.UserPasswordsDAO.UpdateResetDate(c.ctx, 0, retryDate, in.UserId); err != nil {
+		c.Logger.Errorf("user.declinePasswordReset - error: %v", err)
+		return nil, err
+	}
+
+	return mtproto.BoolTrue, nil
+}
@@ -0,0 +1,36 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"github.com/teamgram/teamgram-server/app/service/biz/user/internal/dal/dataobject"
+	"github.com/teamgram/teamgram-server/app/service/biz/user/user"
+)
+
+// UserGetBotsByCreator
+// user.getBotsByCreator creator_id:long = Vector<long>;
+func (c *UserCore) UserGetBotsByCreator(in *user.TLUserGetBotsByCreator) (*user.Vector_Long, error) {
+	rValues := &user.Vector_Long{
+		Datas: []int64{},
+	}
+
+	_, err := c.svcCtx.Dao.BotsDAO.SelectListByCreatorWithCB(
+		c.ctx,
+		in.CreatorId,
+		func(i int, v *dataobject.BotsDO) {
+			rValues.Datas = append(rValues.Datas, v.BotId)
+		})
+	if err != nil {
+		c.Logger.Errorf("user.getBotsByCreator - error: %v", err)
+		return nil, err
+	}
+
+	return rValues, nil
+}
@@ -0,0 +1,50 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/biz/user/internal/dao"
+	"github.com/teamgram/teamgram-server/app/service/biz/user/user"
+
+	"github.com/gogo/protobuf/types"
+)
+
+// UserGetPasswordSettings
+// user.getPasswordSettings user_id:long password:InputCheckPasswordSRP = account.PasswordSettings;
+func (c *UserCore) UserGetPasswordSettings(in *user.TLUserGetPasswordSettings) (*mtproto.Account_PasswordSettings, error) {
+	do, err := c.svcCtx.Dao.UserPasswordsDAO.SelectByUserId(c.ctx, in.UserId)
+	if err != nil {
+		c.Logger.Errorf("user.getPasswordSettings - error: %v", err)
+		return nil, err
+	} else if !dao.HasPassword(do) {
+		err = mtproto.ErrPasswordEmpty
+		c.Logger.Errorf("user.getPasswordSettings - error: %v", err)
+		return nil, err
+	}
+
+	if err = c.svcCtx.Dao.CheckPassword(c.ctx, in.UserId, do, in.Password); err != nil {
+		c.Logger.Errorf("user.getPasswordSettings - error: %v", err)
+		return nil, err
+	}
+
+	settings := mtproto.MakeTLAccountPasswordSettings(&mtproto.Account_PasswordSettings{
+		Email:          nil,
+		SecureSettings: nil,
+	}).To_Account_PasswordSettings()
+	if do.Email != "" {
+		settings.Email = &types.StringValue{Value: do.Email}
+	}
+	if do.SecureSecret != "" {
+		settings.SecureSettings = dao.MakeSecureSecretSettings(do)
+	}
+
+	return settings, nil
+}
@@ -0,0 +1,27 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/biz/user/user"
+)
+
+// UserGetPassword
+// user.getPassword user_id:long = account.Password;
+func (c *UserCore) UserGetPassword(in *user.TLUserGetPassword) (*mtproto.Account_Password, error) {
+	do, err := c.svcCtx.Dao.UserPasswordsDAO.SelectByUserId(c.ctx, in.UserId)
+	if err != nil {
+		c.Logger.Errorf("user.getPassword - error: %v", err)
+		return nil, err
+	}
+
+	return c.svcCtx.Dao.MakeAccountPassword(c.ctx, in.UserId, do), nil
+}
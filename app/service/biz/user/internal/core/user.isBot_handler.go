@@ -17,8 +17,12 @@ import (
 // UserIsBot
 // user.isBot id:long = Bool;
 func (c *UserCore) UserIsBot(in *user.TLUserIsBot) (*mtproto.Bool, error) {
-	// TODO: not impl
-	c.Logger.Errorf("user.isBot - error: method UserIsBot not impl")
+	userData := c.svcCtx.Dao.GetUserData(c.ctx, in.Id)
+	if userData == nil {
+		err := mtproto.ErrUserIdInvalid
+		c.Logger.Errorf("user.isBot - error: %v", err)
+		return nil, err
+	}
 
-	return nil, mtproto.ErrMethodNotImpl
+	return mtproto.ToBool(userData.GetBot() != nil), nil
 }
@@ -0,0 +1,61 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"time"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/biz/user/internal/dao"
+	"github.com/teamgram/teamgram-server/app/service/biz/user/user"
+)
+
+// UserRecoverPassword
+// user.recoverPassword flags:# user_id:long code:string new_settings:flags.0?account.PasswordInputSettings = Bool;
+func (c *UserCore) UserRecoverPassword(in *user.TLUserRecoverPassword) (*mtproto.Bool, error) {
+	var (
+		newSettings = in.GetNewSettings()
+		newAlgo     = newSettings.GetNewAlgo()
+	)
+
+	// check the new password before the code is used
+	if newAlgo != nil && newAlgo.GetPredicateName() != mtproto.Predicate_passwordKdfAlgoUnknown {
+		if err := dao.CheckNewPasswordKdfAlgo(newAlgo, newSettings.GetNewPasswordHash()); err != nil {
+			c.Logger.Errorf("user.recoverPassword - error: %v", err)
+			return nil, err
+		}
+	} else {
+		newAlgo = nil
+	}
+
+	if err := c.svcCtx.Dao.CheckPasswordCode(c.ctx, in.UserId, dao.PasswordCodeRecovery, in.Code, true); err != nil {
+		c.Logger.Errorf("user.recoverPassword - error: %v", err)
+		return nil, err
+	}
+
+	if newAlgo == nil {
+		if _, err := c.svcCtx.Dao.UserPasswordsDAO.Clear(c.ctx, time.Now().Unix(), in.UserId); err != nil {
+			c.Logger.Errorf("user.recoverPassword - error: %v", err)
+			return nil, err
+		}
+	} else {
+		if err := c.svcCtx.Dao.SavePassword(
+			c.ctx,
+			in.UserId,
+			newAlgo,
+			newSettings.GetNewPasswordHash(),
+			newSettings.GetHint().GetValue()); err != nil {
+			c.Logger.Errorf("user.recoverPassword - error: %v", err)
+			return nil, err
+		}
+	}
+
+	return mtproto.BoolTrue, nil
+}
@@ -0,0 +1,44 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/biz/user/internal/dao"
+	"github.com/teamgram/teamgram-server/app/service/biz/user/user"
+	"github.com/teamgram/teamgram-server/pkg/email"
+)
+
+// UserRequestPasswordRecovery
+// user.requestPasswordRecovery user_id:long = auth.PasswordRecovery;
+func (c *UserCore) UserRequestPasswordRecovery(in *user.TLUserRequestPasswordRecovery) (*mtproto.Auth_PasswordRecovery, error) {
+	do, err := c.svcCtx.Dao.UserPasswordsDAO.SelectByUserId(c.ctx, in.UserId)
+	if err != nil {
+		c.Logger.Errorf("user.requestPasswordRecovery - error: %v", err)
+		return nil, err
+	} else if !dao.HasPassword(do) {
+		err = mtproto.ErrPasswordEmpty
+		c.Logger.Errorf("user.requestPasswordRecovery - error: %v", err)
+		return nil, err
+	} else if do.Email == "" {
+		err = dao.ErrPasswordRecoveryNa
+		c.Logger.Errorf("user.requestPasswordRecovery - error: %v", err)
+		return nil, err
+	}
+
+	if _, err = c.svcCtx.Dao.SendPasswordCode(c.ctx, in.UserId, dao.PasswordCodeRecovery, do.Email); err != nil {
+		c.Logger.Errorf("user.requestPasswordRecovery - error: %v", err)
+		return nil, err
+	}
+
+	return mtproto.MakeTLAuthPasswordRecovery(&mtproto.Auth_PasswordRecovery{
+		EmailPattern: email.MaskAddress(do.Email),
+	}).To_Auth_PasswordRecovery(), nil
+}
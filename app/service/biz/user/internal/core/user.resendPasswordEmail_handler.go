@@ -0,0 +1,37 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/biz/user/internal/dao"
+	"github.com/teamgram/teamgram-server/app/service/biz/user/user"
+)
+
+// UserResendPasswordEmail
+// user.resendPasswordEmail user_id:long = Bool;
+func (c *UserCore) UserResendPasswordEmail(in *user.TLUserResendPasswordEmail) (*mtproto.Bool, error) {
+	do, err := c.svcCtx.Dao.UserPasswordsDAO.SelectByUserId(c.ctx, in.UserId)
+	if err != nil {
+		c.Logger.Errorf("user.resendPasswordEmail - error: %v", err)
+		return nil, err
+	} else if do == nil || do.EmailUnconfirmed == "" {
+		err = mtproto.ErrEmailHashExpired
+		c.Logger.Errorf("user.resendPasswordEmail - error: %v", err)
+		return nil, err
+	}
+
+	if _, err = c.svcCtx.Dao.SendPasswordCode(c.ctx, in.UserId, dao.PasswordCodeConfirmEmail, do.EmailUnconfirmed); err != nil {
+		c.Logger.Errorf("user.resendPasswordEmail - error: %v", err)
+		return nil, err
+	}
+
+	return mtproto.BoolTrue, nil
+}
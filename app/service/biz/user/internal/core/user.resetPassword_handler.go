@@ -0,0 +1,64 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"time"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/biz/user/internal/dao"
+	"github.com/teamgram/teamgram-server/app/service/biz/user/user"
+)
+
+// UserResetPassword
+// user.resetPassword user_id:long = account.ResetPasswordResult;
+func (c *UserCore) UserResetPassword(in *user.TLUserResetPassword) (*mtproto.Account_ResetPasswordResult, error) {
+	var (
+		now = int32(time.Now().Unix())
+	)
+
+	do, err := c.svcCtx.Dao.UserPasswordsDAO.SelectByUserId(c.ctx, in.UserId)
+	if err != nil {
+		c.Logger.Errorf("user.resetPassword - error: %v", err)
+		return nil, err
+	} else if !dao.HasPassword(do) {
+		return mtproto.MakeTLAccountResetPasswordOk(nil).To_Account_ResetPasswordResult(), nil
+	}
+
+	switch {
+	case do.PendingResetDate == 0 && do.ResetRetryDate > now:
+		// the last reset was declined recently
+		return mtproto.MakeTLAccountResetPasswordFailedWait(&mtproto.Account_ResetPasswordResult{
+			RetryDate: do.ResetRetryDate,
+		}).To_Account_ResetPasswordResult(), nil
+	case do.PendingResetDate == 0:
+		untilDate := now + dao.PasswordResetWait
+		if _, err = c.svcCtx.Dao.UserPasswordsDAO.UpdateResetDate(c.ctx, untilDate, 0, in.UserId); err != nil {
+			c.Logger.Errorf("user.resetPassword - error: %v", err)
+			return nil, err
+		}
+		return mtproto.MakeTLAccountResetPasswordRequestedWait(&mtproto.Account_ResetPasswordResult{
+			UntilDate: untilDate,
+		}).To_Account_ResetPasswordResult(), nil
+	case do.PendingResetDate > now:
+		return mtproto.MakeTLAccountResetPasswordRequestedWait(&mtproto.Account_ResetPasswordResult{
+			UntilDate: do.PendingResetDate,
+		}).To_Account_ResetPasswordResult(), nil
+	}
+
+	if _, err = c.svcCtx.Dao.UserPasswordsDAO.Clear(c.ctx, time.Now().Unix(), in.UserId); err != nil {
+		c.Logger.Errorf("user.resetPassword - error: %v", err)
+		return nil, err
+	}
+	c.svcCtx.Dao.DeletePasswordCode(c.ctx, in.UserId, dao.PasswordCodeConfirmEmail)
+	c.svcCtx.Dao.DeletePasswordCode(c.ctx, in.UserId, dao.PasswordCodeRecovery)
+
+	return mtproto.MakeTLAccountResetPasswordOk(nil).To_Account_ResetPasswordResult(), nil
+}
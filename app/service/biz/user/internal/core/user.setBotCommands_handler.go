@@ -17,8 +17,39 @@ import (
 // UserSetBotCommands
 // user.setBotCommands user_id:long bot_id:long commands:Vector<BotCommand> = Bool;
 func (c *UserCore) UserSetBotCommands(in *user.TLUserSetBotCommands) (*mtproto.Bool, error) {
-	// TODO: not impl
-	c.Logger.Errorf("user.setBotCommands - error: method UserSetBotCommands not impl")
+	botsDO, err := c.svcCtx.Dao.BotsDAO.Select(c.ctx, in.BotId)
+	if err != nil {
+		c.Logger.Errorf("user.setBotCommands - error: %v", err)
+		return nil, err
+	} else if botsDO == nil {
+		err = mtproto.ErrBotInvalid
+		c.Logger.Errorf("user.setBotCommands - error: %v", err)
+		return nil, err
+	}
 
-	return nil, mtproto.ErrMethodNotImpl
+	// the bot itself or its creator (via @BotFather)
+	if in.UserId != in.BotId && in.UserId != botsDO.CreatorUserId {
+		err = mtproto.ErrBotInvalid
+		c.Logger.Errorf("user.setBotCommands - error: %v", err)
+		return nil, err
+	}
+
+	if len(in.Commands) > user.MaxBotCommands {
+		err = mtproto.ErrBotCommandInvalid
+		c.Logger.Errorf("user.setBotCommands - error: %v", err)
+		return nil, err
+	}
+	for _, command := range in.Commands {
+		if err = user.CheckBotCommand(command); err != nil {
+			c.Logger.Errorf("user.setBotCommands - error: %v", err)
+			return nil, err
+		}
+	}
+
+	if err = c.svcCtx.Dao.SetBotCommands(c.ctx, in.BotId, in.Commands); err != nil {
+		c.Logger.Errorf("user.setBotCommands - error: %v", err)
+		return nil, err
+	}
+
+	return mtproto.BoolTrue, nil
 }
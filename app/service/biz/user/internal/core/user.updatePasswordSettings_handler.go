@@ -0,0 +1,112 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"strings"
+	"time"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/biz/user/internal/dao"
+	"github.com/teamgram/teamgram-server/app/service/biz/user/user"
+)
+
+// UserUpdatePasswordSettings
+// user.updatePasswordSettings user_id:long password:InputCheckPasswordSRP new_settings:account.PasswordInputSettings = Bool;
+func (c *UserCore) UserUpdatePasswordSettings(in *user.TLUserUpdatePasswordSettings) (*mtproto.Bool, error) {
+	var (
+		newSettings = in.GetNewSettings()
+	)
+
+	do, err := c.svcCtx.Dao.UserPasswordsDAO.SelectByUserId(c.ctx, in.UserId)
+	if err != nil {
+		c.Logger.Errorf("user.updatePasswordSettings - error: %v", err)
+		return nil, err
+	}
+
+	if err = c.svcCtx.Dao.CheckPassword(c.ctx, in.UserId, do, in.Password); err != nil {
+		c.Logger.Errorf("user.updatePasswordSettings - error: %v", err)
+		return nil, err
+	}
+
+	hasPassword := dao.HasPassword(do)
+	if newAlgo := newSettings.GetNewAlgo(); newAlgo != nil {
+		if newAlgo.GetPredicateName() == mtproto.Predicate_passwordKdfAlgoUnknown {
+			// remove the password, the recovery email and the secure settings
+			if _, err = c.svcCtx.Dao.UserPasswordsDAO.Clear(c.ctx, time.Now().Unix(), in.UserId); err != nil {
+				c.Logger.Errorf("user.updatePasswordSettings - error: %v", err)
+				return nil, err
+			}
+			c.svcCtx.Dao.DeletePasswordCode(c.ctx, in.UserId, dao.PasswordCodeConfirmEmail)
+			c.svcCtx.Dao.DeletePasswordCode(c.ctx, in.UserId, dao.PasswordCodeRecovery)
+
+			return mtproto.BoolTrue, nil
+		}
+
+		if err = dao.CheckNewPasswordKdfAlgo(newAlgo, newSettings.GetNewPasswordHash()); err != nil {
+			c.Logger.Errorf("user.updatePasswordSettings - error: %v", err)
+			return nil, err
+		}
+		if err = c.svcCtx.Dao.SavePassword(
+			c.ctx,
+			in.UserId,
+			newAlgo,
+			newSettings.GetNewPasswordHash(),
+			newSettings.GetHint().GetValue()); err != nil {
+			c.Logger.Errorf("user.updatePasswordSettings - error: %v", err)
+			return nil, err
+		}
+		hasPassword = true
+	}
+
+	if !hasPassword {
+		err = mtproto.ErrPasswordEmpty
+		c.Logger.Errorf("user.updatePasswordSettings - error: %v", err)
+		return nil, err
+	}
+
+	if secureSettings := newSettings.GetNewSecureSettings(); secureSettings != nil {
+		if err = c.svcCtx.Dao.SaveSecureSettings(c.ctx, in.UserId, secureSettings); err != nil {
+			c.Logger.Errorf("user.updatePasswordSettings - error: %v", err)
+			return nil, err
+		}
+	}
+
+	if newSettings.GetEmail() != nil {
+		email := strings.TrimSpace(newSettings.GetEmail().GetValue())
+		if email == "" {
+			if _, err = c.svcCtx.Dao.UserPasswordsDAO.UpdateEmail(c.ctx, "", in.UserId); err != nil {
+				c.Logger.Errorf("user.updatePasswordSettings - error: %v", err)
+				return nil, err
+			}
+			c.svcCtx.Dao.DeletePasswordCode(c.ctx, in.UserId, dao.PasswordCodeConfirmEmail)
+		} else {
+			if !strings.Contains(email, "@") {
+				err = mtproto.ErrEmailInvalid
+				c.Logger.Errorf("user.updatePasswordSettings - error: %v", err)
+				return nil, err
+			}
+			if _, err = c.svcCtx.Dao.UserPasswordsDAO.UpdateEmailUnconfirmed(c.ctx, email, in.UserId); err != nil {
+				c.Logger.Errorf("user.updatePasswordSettings - error: %v", err)
+				return nil, err
+			}
+			codeLength, err := c.svcCtx.Dao.SendPasswordCode(c.ctx, in.UserId, dao.PasswordCodeConfirmEmail, email)
+			if err != nil {
+				c.Logger.Errorf("user.updatePasswordSettings - error: %v", err)
+				return nil, err
+			}
+
+			// the settings are saved, the client asks for the code sent to the new email
+			return nil, mtproto.NewEmailUnconfirmedX(codeLength)
+		}
+	}
+
+	return mtproto.BoolTrue, nil
+}
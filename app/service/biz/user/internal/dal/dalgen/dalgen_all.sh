@@ -15,3 +15,4 @@
 ./dalgen.sh user_settings
 ./dalgen.sh users
 ./dalgen.sh user_profile_photos
+./dalgen.sh user_passwords
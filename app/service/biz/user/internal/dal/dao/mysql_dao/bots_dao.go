@@ -30,6 +30,62 @@ func NewBotsDAO(db *sqlx.DB) *BotsDAO {
 	return &BotsDAO{db}
 }
 
+// Insert
+// insert into bots(bot_id, bot_type, creator_user_id, token, description, bot_chat_history, bot_nochats, bot_inline_geo, bot_info_version, bot_inline_placeholder) values (:bot_id, :bot_type, :creator_user_id, :token, :description, :bot_chat_history, :bot_nochats, :bot_inline_geo, :bot_info_version, :bot_inline_placeholder)
+// TODO(@benqi): sqlmap
+func (dao *BotsDAO) Insert(ctx context.Context, do *dataobject.BotsDO) (lastInsertId, rowsAffected int64, err error) {
+	var (
+		query = "insert into bots(bot_id, bot_type, creator_user_id, token, description, bot_chat_history, bot_nochats, bot_inline_geo, bot_info_version, bot_inline_placeholder) values (:bot_id, :bot_type, :creator_user_id, :token, :description, :bot_chat_history, :bot_nochats, :bot_inline_geo, :bot_info_version, :bot_inline_placeholder)"
+		r     sql.Result
+	)
+
+	r, err = dao.db.NamedExec(ctx, query, do)
+	if err != nil {
+		logx.WithContext(ctx).Errorf("namedExec in Insert(%v), error: %v", do, err)
+		return
+	}
+
+	lastInsertId, err = r.LastInsertId()
+	if err != nil {
+		logx.WithContext(ctx).Errorf("lastInsertId in Insert(%v)_error: %v", do, err)
+		return
+	}
+	rowsAffected, err = r.RowsAffected()
+	if err != nil {
+		logx.WithContext(ctx).Errorf("rowsAffected in Insert(%v)_error: %v", do, err)
+	}
+
+	return
+}
+
+// InsertTx
+// insert into bots(bot_id, bot_type, creator_user_id, token, description, bot_chat_history, bot_nochats, bot_inline_geo, bot_info_version, bot_inline_placeholder) values (:bot_id, :bot_type, :creator_user_id, :token, :description, :bot_chat_history, :bot_nochats, :bot_inline_geo, :bot_info_version, :bot_inline_placeholder)
+// TODO(@benqi): sqlmap
+func (dao *BotsDAO) InsertTx(tx *sqlx.Tx, do *dataobject.BotsDO) (lastInsertId, rowsAffected int64, err error) {
+	var (
+		query = "insert into bots(bot_id, bot_type, creator_user_id, token, description, bot_chat_history, bot_nochats, bot_inline_geo, bot_info_version, bot_inline_placeholder) values (:bot_id, :bot_type, :creator_user_id, :token, :description, :bot_chat_history, :bot_nochats, :bot_inline_geo, :bot_info_version, :bot_inline_placeholder)"
+		r     sql.Result
+	)
+
+	r, err = tx.NamedExec(query, do)
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("namedExec in Insert(%v), error: %v", do, err)
+		return
+	}
+
+	lastInsertId, err = r.LastInsertId()
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("lastInsertId in Insert(%v)_error: %v", do, err)
+		return
+	}
+	rowsAffected, err = r.RowsAffected()
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("rowsAffected in Insert(%v)_error: %v", do, err)
+	}
+
+	return
+}
+
 // Select
 // select id, bot_id, bot_type, creator_user_id, token, description, bot_chat_history, bot_nochats, bot_inline_geo, bot_info_version, bot_inline_placeholder from bots where bot_id = :bot_id
 // TODO(@benqi): sqlmap
@@ -82,6 +138,7 @@ func (dao *BotsDAO) SelectByIdList(ctx context.Context, id_list []int32) (rList
 		a      []interface{}
 		values []dataobject.BotsDO
 	)
+
 	if len(id_list) == 0 {
 		rList = []dataobject.BotsDO{}
 		return
@@ -114,6 +171,7 @@ func (dao *BotsDAO) SelectByIdListWithCB(ctx context.Context, id_list []int32, c
 		a      []interface{}
 		values []dataobject.BotsDO
 	)
+
 	if len(id_list) == 0 {
 		rList = []dataobject.BotsDO{}
 		return
@@ -142,3 +200,141 @@ func (dao *BotsDAO) SelectByIdListWithCB(ctx context.Context, id_list []int32, c
 
 	return
 }
+
+// SelectListByCreator
+// select id, bot_id, bot_type, creator_user_id, token, description, bot_chat_history, bot_nochats, bot_inline_geo, bot_info_version, bot_inline_placeholder from bots where creator_user_id = :creator_user_id order by id asc
+// TODO(@benqi): sqlmap
+func (dao *BotsDAO) SelectListByCreator(ctx context.Context, creator_user_id int64) (rList []dataobject.BotsDO, err error) {
+	var (
+		query  = "select id, bot_id, bot_type, creator_user_id, token, description, bot_chat_history, bot_nochats, bot_inline_geo, bot_info_version, bot_inline_placeholder from bots where creator_user_id = ? order by id asc"
+		values []dataobject.BotsDO
+	)
+	err = dao.db.QueryRowsPartial(ctx, &values, query, creator_user_id)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("queryx in SelectListByCreator(_), error: %v", err)
+		return
+	}
+
+	rList = values
+
+	return
+}
+
+// SelectListByCreatorWithCB
+// select id, bot_id, bot_type, creator_user_id, token, description, bot_chat_history, bot_nochats, bot_inline_geo, bot_info_version, bot_inline_placeholder from bots where creator_user_id = :creator_user_id order by id asc
+// TODO(@benqi): sqlmap
+func (dao *BotsDAO) SelectListByCreatorWithCB(ctx context.Context, creator_user_id int64, cb func(i int, v *dataobject.BotsDO)) (rList []dataobject.BotsDO, err error) {
+	var (
+		query  = "select id, bot_id, bot_type, creator_user_id, token, description, bot_chat_history, bot_nochats, bot_inline_geo, bot_info_version, bot_inline_placeholder from bots where creator_user_id = ? order by id asc"
+		values []dataobject.BotsDO
+	)
+	err = dao.db.QueryRowsPartial(ctx, &values, query, creator_user_id)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("queryx in SelectListByCreator(_), error: %v", err)
+		return
+	}
+
+	rList = values
+
+	if cb != nil {
+		for i := 0; i < len(rList); i++ {
+			cb(i, &rList[i])
+		}
+	}
+
+	return
+}
+
+// UpdateToken
+// update bots set token = :token where bot_id = :bot_id
+// TODO(@benqi): sqlmap
+func (dao *BotsDAO) UpdateToken(ctx context.Context, token string, bot_id int64) (rowsAffected int64, err error) {
+	var (
+		query   = "update bots set token = ? where bot_id = ?"
+		rResult sql.Result
+	)
+	rResult, err = dao.db.Exec(ctx, query, token, bot_id)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("exec in UpdateToken(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(ctx).Errorf("rowsAffected in UpdateToken(_), error: %v", err)
+	}
+
+	return
+}
+
+// update bots set token = :token where bot_id = :bot_id
+// UpdateTokenTx
+// TODO(@benqi): sqlmap
+func (dao *BotsDAO) UpdateTokenTx(tx *sqlx.Tx, token string, bot_id int64) (rowsAffected int64, err error) {
+	var (
+		query   = "update bots set token = ? where bot_id = ?"
+		rResult sql.Result
+	)
+	rResult, err = tx.Exec(query, token, bot_id)
+
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("exec in UpdateToken(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("rowsAffected in UpdateToken(_), error: %v", err)
+	}
+
+	return
+}
+
+// UpdateBotInfoVersion
+// update bots set bot_info_version = bot_info_version + 1 where bot_id = :bot_id
+// TODO(@benqi): sqlmap
+func (dao *BotsDAO) UpdateBotInfoVersion(ctx context.Context, bot_id int64) (rowsAffected int64, err error) {
+	var (
+		query   = "update bots set bot_info_version = bot_info_version + 1 where bot_id = ?"
+		rResult sql.Result
+	)
+	rResult, err = dao.db.Exec(ctx, query, bot_id)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("exec in UpdateBotInfoVersion(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(ctx).Errorf("rowsAffected in UpdateBotInfoVersion(_), error: %v", err)
+	}
+
+	return
+}
+
+// update bots set bot_info_version = bot_info_version + 1 where bot_id = :bot_id
+// UpdateBotInfoVersionTx
+// TODO(@benqi): sqlmap
+func (dao *BotsDAO) UpdateBotInfoVersionTx(tx *sqlx.Tx, bot_id int64) (rowsAffected int64, err error) {
+	var (
+		query   = "update bots set bot_info_version = bot_info_version + 1 where bot_id = ?"
+		rResult sql.Result
+	)
+	rResult, err = tx.Exec(query, bot_id)
+
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("exec in UpdateBotInfoVersion(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("rowsAffected in UpdateBotInfoVersion(_), error: %v", err)
+	}
+
+	return
+}
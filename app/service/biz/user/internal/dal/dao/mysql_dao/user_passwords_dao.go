@@ -0,0 +1,341 @@
+/*
+ * WARNING! All changes made in this file will be lost!
+ *   Created from by 'dalgen'
+ *
+ * Copyright (c) 2022-present,  Teamgram Authors.
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package mysql_dao
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/teamgram/marmota/pkg/stores/sqlx"
+	"github.com/teamgram/teamgram-server/app/service/biz/user/internal/dal/dataobject"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+var _ *sql.Result
+
+type UserPasswordsDAO struct {
+	db *sqlx.DB
+}
+
+func NewUserPasswordsDAO(db *sqlx.DB) *UserPasswordsDAO {
+	return &UserPasswordsDAO{db}
+}
+
+// InsertOrUpdate
+// insert into user_passwords(user_id, salt1, salt2, v, hint, date2) values (:user_id, :salt1, :salt2, :v, :hint, :date2) on duplicate key update salt1 = values(salt1), salt2 = values(salt2), v = values(v), hint = values(hint), pending_reset_date = 0, date2 = values(date2)
+// TODO(@benqi): sqlmap
+func (dao *UserPasswordsDAO) InsertOrUpdate(ctx context.Context, do *dataobject.UserPasswordsDO) (lastInsertId, rowsAffected int64, err error) {
+	var (
+		query = "insert into user_passwords(user_id, salt1, salt2, v, hint, date2) values (:user_id, :salt1, :salt2, :v, :hint, :date2) on duplicate key update salt1 = values(salt1), salt2 = values(salt2), v = values(v), hint = values(hint), pending_reset_date = 0, date2 = values(date2)"
+		r     sql.Result
+	)
+
+	r, err = dao.db.NamedExec(ctx, query, do)
+	if err != nil {
+		logx.WithContext(ctx).Errorf("namedExec in InsertOrUpdate(%v), error: %v", do, err)
+		return
+	}
+
+	lastInsertId, err = r.LastInsertId()
+	if err != nil {
+		logx.WithContext(ctx).Errorf("lastInsertId in InsertOrUpdate(%v)_error: %v", do, err)
+		return
+	}
+	rowsAffected, err = r.RowsAffected()
+	if err != nil {
+		logx.WithContext(ctx).Errorf("rowsAffected in InsertOrUpdate(%v)_error: %v", do, err)
+	}
+
+	return
+}
+
+// InsertOrUpdateTx
+// insert into user_passwords(user_id, salt1, salt2, v, hint, date2) values (:user_id, :salt1, :salt2, :v, :hint, :date2) on duplicate key update salt1 = values(salt1), salt2 = values(salt2), v = values(v), hint = values(hint), pending_reset_date = 0, date2 = values(date2)
+// TODO(@benqi): sqlmap
+func (dao *UserPasswordsDAO) InsertOrUpdateTx(tx *sqlx.Tx, do *dataobject.UserPasswordsDO) (lastInsertId, rowsAffected int64, err error) {
+	var (
+		query = "insert into user_passwords(user_id, salt1, salt2, v, hint, date2) values (:user_id, :salt1, :salt2, :v, :hint, :date2) on duplicate key update salt1 = values(salt1), salt2 = values(salt2), v = values(v), hint = values(hint), pending_reset_date = 0, date2 = values(date2)"
+		r     sql.Result
+	)
+
+	r, err = tx.NamedExec(query, do)
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("namedExec in InsertOrUpdate(%v), error: %v", do, err)
+		return
+	}
+
+	lastInsertId, err = r.LastInsertId()
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("lastInsertId in InsertOrUpdate(%v)_error: %v", do, err)
+		return
+	}
+	rowsAffected, err = r.RowsAffected()
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("rowsAffected in InsertOrUpdate(%v)_error: %v", do, err)
+	}
+
+	return
+}
+
+// SelectByUserId
+// select id, user_id, salt1, salt2, v, hint, email, email_unconfirmed, secure_salt, secure_secret, secure_secret_id, pending_reset_date, reset_retry_date, date2 from user_passwords where user_id = :user_id
+// TODO(@benqi): sqlmap
+func (dao *UserPasswordsDAO) SelectByUserId(ctx context.Context, user_id int64) (rValue *dataobject.UserPasswordsDO, err error) {
+	var (
+		query = "select id, user_id, salt1, salt2, v, hint, email, email_unconfirmed, secure_salt, secure_secret, secure_secret_id, pending_reset_date, reset_retry_date, date2 from user_passwords where user_id = ?"
+		do    = &dataobject.UserPasswordsDO{}
+	)
+	err = dao.db.QueryRowPartial(ctx, do, query, user_id)
+
+	if err != nil {
+		if err != sqlx.ErrNotFound {
+			logx.WithContext(ctx).Errorf("queryx in SelectByUserId(_), error: %v", err)
+			return
+		} else {
+			err = nil
+		}
+	} else {
+		rValue = do
+	}
+
+	return
+}
+
+// UpdateEmailUnconfirmed
+// update user_passwords set email_unconfirmed = :email_unconfirmed where user_id = :user_id
+// TODO(@benqi): sqlmap
+func (dao *UserPasswordsDAO) UpdateEmailUnconfirmed(ctx context.Context, email_unconfirmed string, user_id int64) (rowsAffected int64, err error) {
+	var (
+		query   = "update user_passwords set email_unconfirmed = ? where user_id = ?"
+		rResult sql.Result
+	)
+	rResult, err = dao.db.Exec(ctx, query, email_unconfirmed, user_id)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("exec in UpdateEmailUnconfirmed(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(ctx).Errorf("rowsAffected in UpdateEmailUnconfirmed(_), error: %v", err)
+	}
+
+	return
+}
+
+// update user_passwords set email_unconfirmed = :email_unconfirmed where user_id = :user_id
+// UpdateEmailUnconfirmedTx
+// TODO(@benqi): sqlmap
+func (dao *UserPasswordsDAO) UpdateEmailUnconfirmedTx(tx *sqlx.Tx, email_unconfirmed string, user_id int64) (rowsAffected int64, err error) {
+	var (
+		query   = "update user_passwords set email_unconfirmed = ? where user_id = ?"
+		rResult sql.Result
+	)
+	rResult, err = tx.Exec(query, email_unconfirmed, user_id)
+
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("exec in UpdateEmailUnconfirmed(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("rowsAffected in UpdateEmailUnconfirmed(_), error: %v", err)
+	}
+
+	return
+}
+
+// UpdateEmail
+// update user_passwords set email = :email, email_unconfirmed = ” where user_id = :user_id
+// TODO(@benqi): sqlmap
+func (dao *UserPasswordsDAO) UpdateEmail(ctx context.Context, email string, user_id int64) (rowsAffected int64, err error) {
+	var (
+		query   = "update user_passwords set email = ?, email_unconfirmed = '' where user_id = ?"
+		rResult sql.Result
+	)
+	rResult, err = dao.db.Exec(ctx, query, email, user_id)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("exec in UpdateEmail(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(ctx).Errorf("rowsAffected in UpdateEmail(_), error: %v", err)
+	}
+
+	return
+}
+
+// update user_passwords set email = :email, email_unconfirmed = ” where user_id = :user_id
+// UpdateEmailTx
+// TODO(@benqi): sqlmap
+func (dao *UserPasswordsDAO) UpdateEmailTx(tx *sqlx.Tx, email string, user_id int64) (rowsAffected int64, err error) {
+	var (
+		query   = "update user_passwords set email = ?, email_unconfirmed = '' where user_id = ?"
+		rResult sql.Result
+	)
+	rResult, err = tx.Exec(query, email, user_id)
+
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("exec in UpdateEmail(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("rowsAffected in UpdateEmail(_), error: %v", err)
+	}
+
+	return
+}
+
+// UpdateSecureSettings
+// update user_passwords set secure_salt = :secure_salt, secure_secret = :secure_secret, secure_secret_id = :secure_secret_id where user_id = :user_id
+// TODO(@benqi): sqlmap
+func (dao *UserPasswordsDAO) UpdateSecureSettings(ctx context.Context, secure_salt string, secure_secret string, secure_secret_id int64, user_id int64) (rowsAffected int64, err error) {
+	var (
+		query   = "update user_passwords set secure_salt = ?, secure_secret = ?, secure_secret_id = ? where user_id = ?"
+		rResult sql.Result
+	)
+	rResult, err = dao.db.Exec(ctx, query, secure_salt, secure_secret, secure_secret_id, user_id)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("exec in UpdateSecureSettings(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(ctx).Errorf("rowsAffected in UpdateSecureSettings(_), error: %v", err)
+	}
+
+	return
+}
+
+// update user_passwords set secure_salt = :secure_salt, secure_secret = :secure_secret, secure_secret_id = :secure_secret_id where user_id = :user_id
+// UpdateSecureSettingsTx
+// TODO(@benqi): sqlmap
+func (dao *UserPasswordsDAO) UpdateSecureSettingsTx(tx *sqlx.Tx, secure_salt string, secure_secret string, secure_secret_id int64, user_id int64) (rowsAffected int64, err error) {
+	var (
+		query   = "update user_passwords set secure_salt = ?, secure_secret = ?, secure_secret_id = ? where user_id = ?"
+		rResult sql.Result
+	)
+	rResult, err = tx.Exec(query, secure_salt, secure_secret, secure_secret_id, user_id)
+
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("exec in UpdateSecureSettings(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("rowsAffected in UpdateSecureSettings(_), error: %v", err)
+	}
+
+	return
+}
+
+// UpdateResetDate
+// update user_passwords set pending_reset_date = :pending_reset_date, reset_retry_date = :reset_retry_date where user_id = :user_id
+// TODO(@benqi): sqlmap
+func (dao *UserPasswordsDAO) UpdateResetDate(ctx context.Context, pending_reset_date int32, reset_retry_date int32, user_id int64) (rowsAffected int64, err error) {
+	var (
+		query   = "update user_passwords set pending_reset_date = ?, reset_retry_date = ? where user_id = ?"
+		rResult sql.Result
+	)
+	rResult, err = dao.db.Exec(ctx, query, pending_reset_date, reset_retry_date, user_id)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("exec in UpdateResetDate(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(ctx).Errorf("rowsAffected in UpdateResetDate(_), error: %v", err)
+	}
+
+	return
+}
+
+// update user_passwords set pending_reset_date = :pending_reset_date, reset_retry_date = :reset_retry_date where user_id = :user_id
+// UpdateResetDateTx
+// TODO(@benqi): sqlmap
+func (dao *UserPasswordsDAO) UpdateResetDateTx(tx *sqlx.Tx, pending_reset_date int32, reset_retry_date int32, user_id int64) (rowsAffected int64, err error) {
+	var (
+		query   = "update user_passwords set pending_reset_date = ?, reset_retry_date = ? where user_id = ?"
+		rResult sql.Result
+	)
+	rResult, err = tx.Exec(query, pending_reset_date, reset_retry_date, user_id)
+
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("exec in UpdateResetDate(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("rowsAffected in UpdateResetDate(_), error: %v", err)
+	}
+
+	return
+}
+
+// Clear
+// update user_passwords set salt1 = ”, salt2 = ”, v = ”, hint = ”, email = ”, email_unconfirmed = ”, secure_salt = ”, secure_secret = ”, secure_secret_id = 0, pending_reset_date = 0, date2 = :date2 where user_id = :user_id
+// TODO(@benqi): sqlmap
+func (dao *UserPasswordsDAO) Clear(ctx context.Context, date2 int64, user_id int64) (rowsAffected int64, err error) {
+	var (
+		query   = "update user_passwords set salt1 = '', salt2 = '', v = '', hint = '', email = '', email_unconfirmed = '', secure_salt = '', secure_secret = '', secure_secret_id = 0, pending_reset_date = 0, date2 = ? where user_id = ?"
+		rResult sql.Result
+	)
+	rResult, err = dao.db.Exec(ctx, query, date2, user_id)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("exec in Clear(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(ctx).Errorf("rowsAffected in Clear(_), error: %v", err)
+	}
+
+	return
+}
+
+// update user_passwords set salt1 = ”, salt2 = ”, v = ”, hint = ”, email = ”, email_unconfirmed = ”, secure_salt = ”, secure_secret = ”, secure_secret_id = 0, pending_reset_date = 0, date2 = :date2 where user_id = :user_id
+// ClearTx
+// TODO(@benqi): sqlmap
+func (dao *UserPasswordsDAO) ClearTx(tx *sqlx.Tx, date2 int64, user_id int64) (rowsAffected int64, err error) {
+	var (
+		query   = "update user_passwords set salt1 = '', salt2 = '', v = '', hint = '', email = '', email_unconfirmed = '', secure_salt = '', secure_secret = '', secure_secret_id = 0, pending_reset_date = 0, date2 = ? where user_id = ?"
+		rResult sql.Result
+	)
+	rResult, err = tx.Exec(query, date2, user_id)
+
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("exec in Clear(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("rowsAffected in Clear(_), error: %v", err)
+	}
+
+	return
+}
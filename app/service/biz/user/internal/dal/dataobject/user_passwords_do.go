@@ -0,0 +1,28 @@
+/*
+ * WARNING! All changes made in this file will be lost!
+ *   Created from by 'dalgen'
+ *
+ * Copyright (c) 2022-present,  Teamgram Authors.
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package dataobject
+
+type UserPasswordsDO struct {
+	Id               int64  `db:"id"`
+	UserId           int64  `db:"user_id"`
+	Salt1            string `db:"salt1"`
+	Salt2            string `db:"salt2"`
+	V                string `db:"v"`
+	Hint             string `db:"hint"`
+	Email            string `db:"email"`
+	EmailUnconfirmed string `db:"email_unconfirmed"`
+	SecureSalt       string `db:"secure_salt"`
+	SecureSecret     string `db:"secure_secret"`
+	SecureSecretId   int64  `db:"secure_secret_id"`
+	PendingResetDate int32  `db:"pending_reset_date"`
+	ResetRetryDate   int32  `db:"reset_retry_date"`
+	Date2            int64  `db:"date2"`
+}
@@ -24,6 +24,9 @@ import (
 	"github.com/teamgram/marmota/pkg/stores/sqlx"
 	"github.com/teamgram/teamgram-server/app/service/biz/user/internal/config"
 	media_client "github.com/teamgram/teamgram-server/app/service/media/client"
+	"github.com/teamgram/teamgram-server/pkg/email"
+
+	"github.com/zeromicro/go-zero/core/stores/kv"
 )
 
 // Dao dao.
@@ -31,6 +34,8 @@ type Dao struct {
 	*Mysql
 	sqlc.CachedConn
 	media_client.MediaClient
+	kv          kv.Store
+	EmailSender *email.Sender
 }
 
 // New new a dao and return.
@@ -40,5 +45,7 @@ func New(c config.Config) *Dao {
 		Mysql:       newMysqlDao(db),
 		CachedConn:  sqlc.NewConn(db, c.Cache),
 		MediaClient: media_client.NewMediaClient(rpcx.GetCachedRpcClient(c.MediaClient)),
+		kv:          kv.NewStore(c.KV),
+		EmailSender: email.New(c.Email),
 	}
 }
@@ -0,0 +1,165 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package dao
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/teamgram/marmota/pkg/stores/sqlx"
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/biz/user/internal/dal/dataobject"
+	"github.com/teamgram/teamgram-server/app/service/biz/user/user"
+)
+
+const (
+	botTokenSecretLen = 26 // 35 chars after base64
+)
+
+// makeBotToken returns a Bot API style token: "<bot_id>:<secret>"
+func makeBotToken(botId int64) (string, error) {
+	secret := make([]byte, botTokenSecretLen)
+	if _, err := rand.Read(secret); err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%d:%s", botId, base64.RawURLEncoding.EncodeToString(secret)), nil
+}
+
+// CreateBot creates the bot's users and bots rows.
+// bots have no phone, users.phone is unique so the lowercased username is used as a placeholder.
+func (d *Dao) CreateBot(ctx context.Context, creatorId, accessHash int64, firstName, username string) (int64, error) {
+	var (
+		botId int64
+	)
+
+	tR := sqlx.TxWrapper(ctx, d.DB, func(tx *sqlx.Tx, result *sqlx.StoreResult) {
+		userDO := &dataobject.UsersDO{
+			UserType:   user.UserTypeBot,
+			AccessHash: accessHash,
+			FirstName:  firstName,
+			Username:   username,
+			Phone:      strings.ToLower(username),
+			IsBot:      true,
+		}
+		lastInsertId, _, err := d.UsersDAO.InsertTx(tx, userDO)
+		if err != nil {
+			if sqlx.IsDuplicate(err) {
+				result.Err = mtproto.ErrUsernameOccupied
+				return
+			}
+			result.Err = err
+			return
+		}
+		botId = lastInsertId
+
+		token, err := makeBotToken(botId)
+		if err != nil {
+			result.Err = err
+			return
+		}
+
+		_, _, result.Err = d.BotsDAO.InsertTx(tx, &dataobject.BotsDO{
+			BotId:          botId,
+			BotType:        0,
+			CreatorUserId:  creatorId,
+			Token:          token,
+			BotNochats:     false,
+			BotInfoVersion: 1,
+		})
+		if result.Err != nil {
+			return
+		}
+
+		_, _, result.Err = d.UserPresencesDAO.InsertTx(tx, &dataobject.UserPresencesDO{
+			UserId:     botId,
+			LastSeenAt: time.Now().Unix(),
+			Expires:    0,
+		})
+	})
+	if tR.Err != nil {
+		return 0, tR.Err
+	}
+
+	return botId, nil
+}
+
+func (d *Dao) ResetBotToken(ctx context.Context, botId int64) (string, error) {
+	token, err := makeBotToken(botId)
+	if err != nil {
+		return "", err
+	}
+
+	_, _, err = d.CachedConn.Exec(
+		ctx,
+		func(ctx context.Context, conn *sqlx.DB) (int64, int64, error) {
+			rowsAffected, err := d.BotsDAO.UpdateToken(ctx, token, botId)
+			if err != nil {
+				return 0, 0, err
+			}
+
+			return 0, rowsAffected, nil
+		},
+		genUserDataCacheKey(botId))
+	if err != nil {
+		return "", err
+	}
+
+	return token, nil
+}
+
+func (d *Dao) SetBotCommands(ctx context.Context, botId int64, commands []*mtproto.BotCommand) error {
+	_, _, err := d.CachedConn.Exec(
+		ctx,
+		func(ctx context.Context, conn *sqlx.DB) (int64, int64, error) {
+			tR := sqlx.TxWrapper(ctx, d.DB, func(tx *sqlx.Tx, result *sqlx.StoreResult) {
+				_, result.Err = d.BotCommandsDAO.DeleteTx(tx, botId)
+				if result.Err != nil {
+					return
+				}
+
+				if len(commands) > 0 {
+					doList := make([]*dataobject.BotCommandsDO, 0, len(commands))
+					for _, v := range commands {
+						doList = append(doList, &dataobject.BotCommandsDO{
+							BotId:       botId,
+							Command:     v.GetCommand(),
+							Description: v.GetDescription(),
+						})
+					}
+					_, _, result.Err = d.BotCommandsDAO.InsertBulkTx(tx, doList)
+					if result.Err != nil {
+						return
+					}
+				}
+
+				// clients refetch the bot info when bot_info_version changes
+				_, result.Err = d.BotsDAO.UpdateBotInfoVersionTx(tx, botId)
+			})
+
+			return 0, 0, tR.Err
+		},
+		genUserDataCacheKey(botId))
+
+	return err
+}
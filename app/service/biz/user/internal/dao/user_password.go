@@ -0,0 +1,433 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package dao
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/teamgram/marmota/pkg/random2"
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/biz/user/internal/dal/dataobject"
+	"github.com/teamgram/teamgram-server/pkg/email"
+	"github.com/teamgram/teamgram-server/pkg/srp"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	srpTimeout          = 10 * 60 // srp_id is valid for 10 minutes
+	passwordCodeTimeout = 15 * 60
+	passwordCodeLength  = 6
+	// passwordCodeMaxAttempts the code is dropped after so many wrong guesses
+	passwordCodeMaxAttempts = 5
+	// passwordMaxAttempts the password checks get FLOOD_WAIT_X after so many wrong passwords
+	// in passwordAttemptsTimeout
+	passwordMaxAttempts     = 5
+	passwordAttemptsTimeout = 60 * 60
+
+	// PasswordResetWait account.resetPassword deletes the password after 7 days
+	PasswordResetWait = 7 * 24 * 60 * 60
+	// PasswordResetRetryWait a declined reset can be requested again after 1 day
+	PasswordResetRetryWait = 24 * 60 * 60
+
+	salt1Length  = 8
+	salt2Length  = 16
+	secureLength = 256
+)
+
+const (
+	PasswordCodeConfirmEmail = "confirm_email"
+	PasswordCodeRecovery     = "recovery"
+)
+
+var (
+	// ErrPasswordRecoveryNa no recovery email
+	ErrPasswordRecoveryNa = status.Error(mtproto.ErrBadRequest, "PASSWORD_RECOVERY_NA")
+)
+
+var (
+	cachePasswordSrpPrefix          = "user_password_srp"
+	cachePasswordCodePrefix         = "user_password_code"
+	cachePasswordCodeAttemptsPrefix = "user_password_code_attempts"
+	cachePasswordAttemptsPrefix     = "user_password_attempts"
+)
+
+func genCachePasswordSrpKey(userId int64) string {
+	return fmt.Sprintf("%s_%d", cachePasswordSrpPrefix, userId)
+}
+
+func genCachePasswordCodeKey(userId int64, purpose string) string {
+	return fmt.Sprintf("%s_%s_%d", cachePasswordCodePrefix, purpose, userId)
+}
+
+func genCachePasswordCodeAttemptsKey(userId int64, purpose string) string {
+	return fmt.Sprintf("%s_%s_%d", cachePasswordCodeAttemptsPrefix, purpose, userId)
+}
+
+func genCachePasswordAttemptsKey(userId int64) string {
+	return fmt.Sprintf("%s_%d", cachePasswordAttemptsPrefix, userId)
+}
+
+func encodeBytes(b []byte) string {
+	return base64.StdEncoding.EncodeToString(b)
+}
+
+func decodeBytes(s string) []byte {
+	b, _ := base64.StdEncoding.DecodeString(s)
+	return b
+}
+
+// HasPassword the password is set
+func HasPassword(do *dataobject.UserPasswordsDO) bool {
+	return do != nil && do.V != ""
+}
+
+// MakePasswordKdfAlgo returns current_algo if do is not nil, or else new_algo,
+// the client appends 32 random bytes to the salt1 of new_algo.
+func MakePasswordKdfAlgo(do *dataobject.UserPasswordsDO) *mtproto.PasswordKdfAlgo {
+	var (
+		salt1, salt2 []byte
+	)
+
+	if do != nil {
+		salt1 = decodeBytes(do.Salt1)
+		salt2 = decodeBytes(do.Salt2)
+	} else {
+		salt1 = srp.RandomBytes(salt1Length)
+		salt2 = srp.RandomBytes(salt2Length)
+	}
+
+	return mtproto.MakeTLPasswordKdfAlgoModPow(&mtproto.PasswordKdfAlgo{
+		Salt1: salt1,
+		Salt2: salt2,
+		G:     srp.G,
+		P:     srp.P,
+	}).To_PasswordKdfAlgo()
+}
+
+// MakeAccountPassword makes account.password, a new srp_B and srp_id is generated for every call.
+func (d *Dao) MakeAccountPassword(ctx context.Context, userId int64, do *dataobject.UserPasswordsDO) *mtproto.Account_Password {
+	password := mtproto.MakeTLAccountPassword(&mtproto.Account_Password{
+		HasRecovery:     false,
+		HasSecureValues: false,
+		HasPassword:     false,
+		NewAlgo:         MakePasswordKdfAlgo(nil),
+		NewSecureAlgo: mtproto.MakeTLSecurePasswordKdfAlgoPBKDF2(&mtproto.SecurePasswordKdfAlgo{
+			Salt: srp.RandomBytes(salt1Length),
+		}).To_SecurePasswordKdfAlgo(),
+		SecureRandom: srp.RandomBytes(secureLength),
+	}).To_Account_Password()
+
+	if do == nil {
+		return password
+	}
+
+	if do.EmailUnconfirmed != "" {
+		password.EmailUnconfirmedPattern = &types.StringValue{Value: email.MaskAddress(do.EmailUnconfirmed)}
+	}
+
+	if !HasPassword(do) {
+		return password
+	}
+
+	b, B := srp.MakeB(decodeBytes(do.V))
+	srpId := int64(binary.BigEndian.Uint64(srp.RandomBytes(8)) >> 1)
+	if err := d.putCachePasswordSrp(ctx, userId, srpId, b); err != nil {
+		return password
+	}
+
+	password.HasPassword = true
+	password.HasRecovery = do.Email != ""
+	password.HasSecureValues = do.SecureSecret != ""
+	password.CurrentAlgo = MakePasswordKdfAlgo(do)
+	password.Srp_B = B
+	password.SrpId = &types.Int64Value{Value: srpId}
+	if do.Hint != "" {
+		password.Hint = &types.StringValue{Value: do.Hint}
+	}
+	if do.PendingResetDate > 0 {
+		password.PendingResetDate = &types.Int32Value{Value: do.PendingResetDate}
+	}
+
+	return password
+}
+
+func (d *Dao) putCachePasswordSrp(ctx context.Context, userId, srpId int64, b []byte) error {
+	key := genCachePasswordSrpKey(userId)
+
+	if err := d.kv.Hmset(key, map[string]string{
+		"srp_id": strconv.FormatInt(srpId, 10),
+		"b":      encodeBytes(b),
+	}); err != nil {
+		logx.WithContext(ctx).Errorf("conn.HMSET(%s) error(%v)", key, err)
+		return err
+	}
+	if err := d.kv.Expire(key, srpTimeout); err != nil {
+		logx.WithContext(ctx).Errorf("conn.EXPIRE(%s) error(%v)", key, err)
+		return err
+	}
+
+	return nil
+}
+
+// CheckPassword checks the InputCheckPasswordSRP, every srp_id can be used only once.
+// After passwordMaxAttempts wrong passwords the checks get FLOOD_WAIT_X until the
+// attempts expire.
+func (d *Dao) CheckPassword(ctx context.Context, userId int64, do *dataobject.UserPasswordsDO, password *mtproto.InputCheckPasswordSRP) error {
+	if !HasPassword(do) {
+		if password.GetPredicateName() == mtproto.Predicate_inputCheckPasswordSRP {
+			return mtproto.ErrPasswordEmpty
+		}
+		return nil
+	}
+
+	if password.GetPredicateName() != mtproto.Predicate_inputCheckPasswordSRP {
+		return mtproto.ErrPasswordHashInvalid
+	}
+
+	if err := d.checkPasswordAttempts(ctx, userId); err != nil {
+		return err
+	}
+
+	key := genCachePasswordSrpKey(userId)
+	values, err := d.kv.Hgetall(key)
+	if err != nil {
+		logx.WithContext(ctx).Errorf("conn.HGETALL(%s) error(%v)", key, err)
+		return err
+	}
+	d.kv.Del(key)
+
+	srpId, _ := strconv.ParseInt(values["srp_id"], 10, 64)
+	if srpId == 0 || srpId != password.GetSrpId() {
+		return mtproto.ErrSrpIdInvalid
+	}
+
+	ok, err := srp.CheckM1(
+		decodeBytes(do.Salt1),
+		decodeBytes(do.Salt2),
+		decodeBytes(do.V),
+		decodeBytes(values["b"]),
+		password.GetA(),
+		password.GetM1())
+	if err != nil || !ok {
+		d.incrPasswordAttempts(ctx, userId)
+		return mtproto.ErrPasswordHashInvalid
+	}
+
+	attemptsKey := genCachePasswordAttemptsKey(userId)
+	if _, err = d.kv.Del(attemptsKey); err != nil {
+		logx.WithContext(ctx).Errorf("conn.DEL(%s) error(%v)", attemptsKey, err)
+	}
+
+	return nil
+}
+
+// checkPasswordAttempts returns FLOOD_WAIT_X if userId made passwordMaxAttempts wrong
+// passwords, X is the time left until the attempts expire.
+func (d *Dao) checkPasswordAttempts(ctx context.Context, userId int64) error {
+	key := genCachePasswordAttemptsKey(userId)
+
+	v, err := d.kv.Get(key)
+	if err != nil {
+		logx.WithContext(ctx).Errorf("conn.GET(%s) error(%v)", key, err)
+		return err
+	}
+	if n, _ := strconv.Atoi(v); n < passwordMaxAttempts {
+		return nil
+	}
+
+	ttl, err := d.kv.Ttl(key)
+	if err != nil {
+		logx.WithContext(ctx).Errorf("conn.TTL(%s) error(%v)", key, err)
+		return err
+	}
+	if ttl <= 0 {
+		// the EXPIRE of the first attempt failed, the attempts must not block forever
+		if err = d.kv.Expire(key, passwordAttemptsTimeout); err != nil {
+			logx.WithContext(ctx).Errorf("conn.EXPIRE(%s) error(%v)", key, err)
+		}
+		ttl = passwordAttemptsTimeout
+	}
+
+	return mtproto.NewErrFloodWaitX(int32(ttl))
+}
+
+func (d *Dao) incrPasswordAttempts(ctx context.Context, userId int64) {
+	key := genCachePasswordAttemptsKey(userId)
+
+	n, err := d.kv.Incr(key)
+	if err != nil {
+		logx.WithContext(ctx).Errorf("conn.INCR(%s) error(%v)", key, err)
+		return
+	}
+	if n == 1 {
+		if err = d.kv.Expire(key, passwordAttemptsTimeout); err != nil {
+			logx.WithContext(ctx).Errorf("conn.EXPIRE(%s) error(%v)", key, err)
+		}
+	}
+}
+
+// CheckNewPasswordKdfAlgo checks the new_algo of account.passwordInputSettings,
+// salt1 must start with the salt1 of the new_algo we sent.
+func CheckNewPasswordKdfAlgo(algo *mtproto.PasswordKdfAlgo, newPasswordHash []byte) error {
+	if algo.GetPredicateName() != mtproto.Predicate_passwordKdfAlgoModPow {
+		return mtproto.ErrNewSettingsInvalid
+	}
+	if algo.GetG() != srp.G || string(algo.GetP()) != string(srp.P) {
+		return mtproto.ErrNewSettingsInvalid
+	}
+	if len(algo.GetSalt1()) < salt1Length+32 || len(algo.GetSalt2()) < salt2Length {
+		return mtproto.ErrNewSaltInvalid
+	}
+	if err := srp.CheckVerifier(newPasswordHash); err != nil {
+		return mtproto.ErrNewSettingsInvalid
+	}
+
+	return nil
+}
+
+// SavePassword sets a new password, the recovery email is kept.
+func (d *Dao) SavePassword(ctx context.Context, userId int64, algo *mtproto.PasswordKdfAlgo, newPasswordHash []byte, hint string) error {
+	_, _, err := d.UserPasswordsDAO.InsertOrUpdate(ctx, &dataobject.UserPasswordsDO{
+		UserId: userId,
+		Salt1:  encodeBytes(algo.GetSalt1()),
+		Salt2:  encodeBytes(algo.GetSalt2()),
+		V:      encodeBytes(srp.Pad(newPasswordHash)),
+		Hint:   hint,
+		Date2:  time.Now().Unix(),
+	})
+
+	return err
+}
+
+// SaveSecureSettings saves the encrypted secure secret of telegram passport
+func (d *Dao) SaveSecureSettings(ctx context.Context, userId int64, settings *mtproto.SecureSecretSettings) error {
+	_, err := d.UserPasswordsDAO.UpdateSecureSettings(
+		ctx,
+		encodeBytes(settings.GetSecureAlgo().GetSalt()),
+		encodeBytes(settings.GetSecureSecret()),
+		settings.GetSecureSecretId(),
+		userId)
+
+	return err
+}
+
+// MakeSecureSecretSettings makes the secure_settings of account.passwordSettings
+func MakeSecureSecretSettings(do *dataobject.UserPasswordsDO) *mtproto.SecureSecretSettings {
+	return mtproto.MakeTLSecureSecretSettings(&mtproto.SecureSecretSettings{
+		SecureAlgo: mtproto.MakeTLSecurePasswordKdfAlgoPBKDF2(&mtproto.SecurePasswordKdfAlgo{
+			Salt: decodeBytes(do.SecureSalt),
+		}).To_SecurePasswordKdfAlgo(),
+		SecureSecret:   decodeBytes(do.SecureSecret),
+		SecureSecretId: do.SecureSecretId,
+	}).To_SecureSecretSettings()
+}
+
+// SendPasswordCode sends a new code for purpose to the email address, returns the code length.
+func (d *Dao) SendPasswordCode(ctx context.Context, userId int64, purpose, address string) (int, error) {
+	var (
+		key  = genCachePasswordCodeKey(userId, purpose)
+		code = random2.RandomNumeric(passwordCodeLength)
+	)
+
+	if err := d.kv.Setex(key, code, passwordCodeTimeout); err != nil {
+		logx.WithContext(ctx).Errorf("conn.SETEX(%s) error(%v)", key, err)
+		return 0, err
+	}
+
+	// a new code gets a new set of attempts
+	attemptsKey := genCachePasswordCodeAttemptsKey(userId, purpose)
+	if _, err := d.kv.Del(attemptsKey); err != nil {
+		logx.WithContext(ctx).Errorf("conn.DEL(%s) error(%v)", attemptsKey, err)
+	}
+
+	if err := d.EmailSender.SendCode(ctx, address, code); err != nil {
+		logx.WithContext(ctx).Errorf("sendCode(%s) error(%v)", address, err)
+		return 0, err
+	}
+
+	return len(code), nil
+}
+
+// CheckPasswordCode checks the code sent by SendPasswordCode, the code is deleted if del is true.
+// The code is deleted after passwordCodeMaxAttempts wrong guesses.
+func (d *Dao) CheckPasswordCode(ctx context.Context, userId int64, purpose, code string, del bool) error {
+	key := genCachePasswordCodeKey(userId, purpose)
+
+	if code == "" {
+		return mtproto.ErrCodeEmpty
+	}
+
+	v, err := d.kv.Get(key)
+	if err != nil {
+		logx.WithContext(ctx).Errorf("conn.GET(%s) error(%v)", key, err)
+		return err
+	} else if v == "" {
+		if purpose == PasswordCodeRecovery {
+			return mtproto.ErrPasswordRecoveryExpired
+		}
+		return mtproto.ErrEmailHashExpired
+	} else if subtle.ConstantTimeCompare([]byte(v), []byte(code)) != 1 {
+		d.incrPasswordCodeAttempts(ctx, userId, purpose)
+		return mtproto.ErrCodeInvalid
+	}
+
+	if del {
+		d.DeletePasswordCode(ctx, userId, purpose)
+	}
+
+	return nil
+}
+
+func (d *Dao) incrPasswordCodeAttempts(ctx context.Context, userId int64, purpose string) {
+	key := genCachePasswordCodeAttemptsKey(userId, purpose)
+
+	n, err := d.kv.Incr(key)
+	if err != nil {
+		logx.WithContext(ctx).Errorf("conn.INCR(%s) error(%v)", key, err)
+		return
+	}
+	if n == 1 {
+		if err = d.kv.Expire(key, passwordCodeTimeout); err != nil {
+			logx.WithContext(ctx).Errorf("conn.EXPIRE(%s) error(%v)", key, err)
+		}
+	}
+	if n >= passwordCodeMaxAttempts {
+		d.DeletePasswordCode(ctx, userId, purpose)
+	}
+}
+
+func (d *Dao) DeletePasswordCode(ctx context.Context, userId int64, purpose string) {
+	var (
+		key         = genCachePasswordCodeKey(userId, purpose)
+		attemptsKey = genCachePasswordCodeAttemptsKey(userId, purpose)
+	)
+
+	if _, err := d.kv.Del(key, attemptsKey); err != nil {
+		logx.WithContext(ctx).Errorf("conn.DEL(%s, %s) error(%v)", key, attemptsKey, err)
+	}
+}
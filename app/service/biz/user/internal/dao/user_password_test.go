@@ -0,0 +1,131 @@
+// Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+//  All rights reserved.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package dao
+
+import (
+	"context"
+	"testing"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/biz/user/internal/dal/dataobject"
+	"github.com/teamgram/teamgram-server/pkg/srp"
+
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/kv"
+	"github.com/zeromicro/go-zero/core/stores/redis"
+	"github.com/zeromicro/go-zero/core/stores/redis/redistest"
+	"google.golang.org/grpc/status"
+)
+
+func newTestPasswordDao(t *testing.T) (*Dao, func()) {
+	r, clean, err := redistest.CreateRedis()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return &Dao{
+		kv: kv.NewStore(cache.ClusterConf{
+			{
+				RedisConf: redis.RedisConf{Host: r.Addr, Type: redis.NodeType},
+				Weight:    100,
+			},
+		}),
+	}, clean
+}
+
+func TestCheckPasswordCode(t *testing.T) {
+	d, clean := newTestPasswordDao(t)
+	defer clean()
+
+	ctx := context.Background()
+	if err := d.kv.Setex(genCachePasswordCodeKey(1, PasswordCodeRecovery), "123456", passwordCodeTimeout); err != nil {
+		t.Fatal(err)
+	}
+
+	// checkRecoveryPassword keeps the code
+	if err := d.CheckPasswordCode(ctx, 1, PasswordCodeRecovery, "123456", false); err != nil {
+		t.Fatalf("right code: %v", err)
+	}
+	if err := d.CheckPasswordCode(ctx, 1, PasswordCodeRecovery, "", false); err != mtproto.ErrCodeEmpty {
+		t.Fatalf("empty code: want %v, got %v", mtproto.ErrCodeEmpty, err)
+	}
+
+	for i := 0; i < passwordCodeMaxAttempts; i++ {
+		if err := d.CheckPasswordCode(ctx, 1, PasswordCodeRecovery, "000000", false); err != mtproto.ErrCodeInvalid {
+			t.Fatalf("wrong code %d: want %v, got %v", i, mtproto.ErrCodeInvalid, err)
+		}
+	}
+
+	// the code is gone after too many wrong guesses, even the right one is rejected
+	if err := d.CheckPasswordCode(ctx, 1, PasswordCodeRecovery, "123456", false); err != mtproto.ErrPasswordRecoveryExpired {
+		t.Fatalf("after attempts: want %v, got %v", mtproto.ErrPasswordRecoveryExpired, err)
+	}
+}
+
+func TestCheckPasswordCodeAttemptsPerPurpose(t *testing.T) {
+	d, clean := newTestPasswordDao(t)
+	defer clean()
+
+	ctx := context.Background()
+	d.kv.Setex(genCachePasswordCodeKey(1, PasswordCodeRecovery), "123456", passwordCodeTimeout)
+	d.kv.Setex(genCachePasswordCodeKey(1, PasswordCodeConfirmEmail), "654321", passwordCodeTimeout)
+
+	for i := 0; i < passwordCodeMaxAttempts; i++ {
+		d.CheckPasswordCode(ctx, 1, PasswordCodeConfirmEmail, "000000", false)
+	}
+	if err := d.CheckPasswordCode(ctx, 1, PasswordCodeConfirmEmail, "654321", true); err != mtproto.ErrEmailHashExpired {
+		t.Fatalf("confirm_email: want %v, got %v", mtproto.ErrEmailHashExpired, err)
+	}
+
+	// the misses of confirm_email don't count for recovery
+	if err := d.CheckPasswordCode(ctx, 1, PasswordCodeRecovery, "123456", true); err != nil {
+		t.Fatalf("recovery: %v", err)
+	}
+	if err := d.CheckPasswordCode(ctx, 1, PasswordCodeRecovery, "123456", true); err != mtproto.ErrPasswordRecoveryExpired {
+		t.Fatalf("deleted code: want %v, got %v", mtproto.ErrPasswordRecoveryExpired, err)
+	}
+}
+
+func TestCheckPasswordAttempts(t *testing.T) {
+	d, clean := newTestPasswordDao(t)
+	defer clean()
+
+	var (
+		ctx = context.Background()
+		do  = &dataobject.UserPasswordsDO{
+			Salt1: encodeBytes(srp.RandomBytes(salt1Length)),
+			Salt2: encodeBytes(srp.RandomBytes(salt2Length)),
+			V:     encodeBytes(srp.RandomBytes(secureLength)),
+		}
+		password = mtproto.MakeTLInputCheckPasswordSRP(&mtproto.InputCheckPasswordSRP{
+			SrpId: 1,
+			A:     srp.RandomBytes(secureLength),
+			M1:    srp.RandomBytes(32),
+		}).To_InputCheckPasswordSRP()
+	)
+
+	for i := 0; i < passwordMaxAttempts; i++ {
+		if err := d.putCachePasswordSrp(ctx, 1, 1, srp.RandomBytes(secureLength)); err != nil {
+			t.Fatal(err)
+		}
+		if err := d.CheckPassword(ctx, 1, do, password); err != mtproto.ErrPasswordHashInvalid {
+			t.Fatalf("wrong password %d: want %v, got %v", i, mtproto.ErrPasswordHashInvalid, err)
+		}
+	}
+
+	// a fresh srp_id doesn't help after too many wrong passwords
+	d.putCachePasswordSrp(ctx, 1, 1, srp.RandomBytes(secureLength))
+	if s := status.Convert(d.CheckPassword(ctx, 1, do, password)); s.Code() != 420 || s.Message() != "FLOOD_WAIT_3600" {
+		t.Fatalf("after attempts: want FLOOD_WAIT_3600, got %v", s)
+	}
+
+	// the attempts are per user
+	d.putCachePasswordSrp(ctx, 2, 1, srp.RandomBytes(secureLength))
+	if err := d.CheckPassword(ctx, 2, do, password); err != mtproto.ErrPasswordHashInvalid {
+		t.Fatalf("other user: want %v, got %v", mtproto.ErrPasswordHashInvalid, err)
+	}
+}
@@ -917,3 +917,243 @@ func (s *Service) UserGetFullUser(ctx context.Context, request *user.TLUserGetFu
 	c.Infof("user.getFullUser - reply: %s", r.DebugString())
 	return r, err
 }
+
+// UserGetPassword
+// user.getPassword user_id:long = account.Password;
+func (s *Service) UserGetPassword(ctx context.Context, request *user.TLUserGetPassword) (*mtproto.Account_Password, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Infof("user.getPassword - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.UserGetPassword(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Infof("user.getPassword - reply: %s", r.DebugString())
+	return r, err
+}
+
+// UserGetPasswordSettings
+// user.getPasswordSettings user_id:long password:InputCheckPasswordSRP = account.PasswordSettings;
+func (s *Service) UserGetPasswordSettings(ctx context.Context, request *user.TLUserGetPasswordSettings) (*mtproto.Account_PasswordSettings, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Infof("user.getPasswordSettings - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.UserGetPasswordSettings(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Infof("user.getPasswordSettings - reply: %s", r.DebugString())
+	return r, err
+}
+
+// UserUpdatePasswordSettings
+// user.updatePasswordSettings user_id:long password:InputCheckPasswordSRP new_settings:account.PasswordInputSettings = Bool;
+func (s *Service) UserUpdatePasswordSettings(ctx context.Context, request *user.TLUserUpdatePasswordSettings) (*mtproto.Bool, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Infof("user.updatePasswordSettings - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.UserUpdatePasswordSettings(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Infof("user.updatePasswordSettings - reply: %s", r.DebugString())
+	return r, err
+}
+
+// UserCheckPassword
+// user.checkPassword user_id:long password:InputCheckPasswordSRP = Bool;
+func (s *Service) UserCheckPassword(ctx context.Context, request *user.TLUserCheckPassword) (*mtproto.Bool, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Infof("user.checkPassword - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.UserCheckPassword(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Infof("user.checkPassword - reply: %s", r.DebugString())
+	return r, err
+}
+
+// UserConfirmPasswordEmail
+// user.confirmPasswordEmail user_id:long code:string = Bool;
+func (s *Service) UserConfirmPasswordEmail(ctx context.Context, request *user.TLUserConfirmPasswordEmail) (*mtproto.Bool, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Infof("user.confirmPasswordEmail - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.UserConfirmPasswordEmail(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Infof("user.confirmPasswordEmail - reply: %s", r.DebugString())
+	return r, err
+}
+
+// UserResendPasswordEmail
+// user.resendPasswordEmail user_id:long = Bool;
+func (s *Service) UserResendPasswordEmail(ctx context.Context, request *user.TLUserResendPasswordEmail) (*mtproto.Bool, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Infof("user.resendPasswordEmail - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.UserResendPasswordEmail(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Infof("user.resendPasswordEmail - reply: %s", r.DebugString())
+	return r, err
+}
+
+// UserCancelPasswordEmail
+// user.cancelPasswordEmail user_id:long = Bool;
+func (s *Service) UserCancelPasswordEmail(ctx context.Context, request *user.TLUserCancelPasswordEmail) (*mtproto.Bool, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Infof("user.cancelPasswordEmail - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.UserCancelPasswordEmail(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Infof("user.cancelPasswordEmail - reply: %s", r.DebugString())
+	return r, err
+}
+
+// UserRequestPasswordRecovery
+// user.requestPasswordRecovery user_id:long = auth.PasswordRecovery;
+func (s *Service) UserRequestPasswordRecovery(ctx context.Context, request *user.TLUserRequestPasswordRecovery) (*mtproto.Auth_PasswordRecovery, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Infof("user.requestPasswordRecovery - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.UserRequestPasswordRecovery(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Infof("user.requestPasswordRecovery - reply: %s", r.DebugString())
+	return r, err
+}
+
+// UserCheckRecoveryPassword
+// user.checkRecoveryPassword user_id:long code:string = Bool;
+func (s *Service) UserCheckRecoveryPassword(ctx context.Context, request *user.TLUserCheckRecoveryPassword) (*mtproto.Bool, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Infof("user.checkRecoveryPassword - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.UserCheckRecoveryPassword(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Infof("user.checkRecoveryPassword - reply: %s", r.DebugString())
+	return r, err
+}
+
+// UserRecoverPassword
+// user.recoverPassword flags:# user_id:long code:string new_settings:flags.0?account.PasswordInputSettings = Bool;
+func (s *Service) UserRecoverPassword(ctx context.Context, request *user.TLUserRecoverPassword) (*mtproto.Bool, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Infof("user.recoverPassword - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.UserRecoverPassword(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Infof("user.recoverPassword - reply: %s", r.DebugString())
+	return r, err
+}
+
+// UserResetPassword
+// user.resetPassword user_id:long = account.ResetPasswordResult;
+func (s *Service) UserResetPassword(ctx context.Context, request *user.TLUserResetPassword) (*mtproto.Account_ResetPasswordResult, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Infof("user.resetPassword - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.UserResetPassword(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Infof("user.resetPassword - reply: %s", r.DebugString())
+	return r, err
+}
+
+// UserDeclinePasswordReset
+// user.declinePasswordReset user_id:long = Bool;
+func (s *Service) UserDeclinePasswordReset(ctx context.Context, request *user.TLUserDeclinePasswordReset) (*mtproto.Bool, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Infof("user.declinePasswordReset - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.UserDeclinePasswordReset(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Infof("user.declinePasswordReset - reply: %s", r.DebugString())
+	return r, err
+}
+
+// UserCheckSessionPasswordNeeded
+// user.checkSessionPasswordNeeded user_id:long = Bool;
+func (s *Service) UserCheckSessionPasswordNeeded(ctx context.Context, request *user.TLUserCheckSessionPasswordNeeded) (*mtproto.Bool, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Infof("user.checkSessionPasswordNeeded - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.UserCheckSessionPasswordNeeded(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Infof("user.checkSessionPasswordNeeded - reply: %s", r.DebugString())
+	return r, err
+}
+
+// UserCreateBot
+// user.createBot creator_id:long first_name:string username:string = ImmutableUser;
+func (s *Service) UserCreateBot(ctx context.Context, request *user.TLUserCreateBot) (*user.ImmutableUser, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Infof("user.createBot - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.UserCreateBot(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Infof("user.createBot - reply: %s", r.DebugString())
+	return r, err
+}
+
+// UserGetBotsByCreator
+// user.getBotsByCreator creator_id:long = Vector<long>;
+func (s *Service) UserGetBotsByCreator(ctx context.Context, request *user.TLUserGetBotsByCreator) (*user.Vector_Long, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Infof("user.getBotsByCreator - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.UserGetBotsByCreator(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Infof("user.getBotsByCreator - reply: %s", r.DebugString())
+	return r, err
+}
+
+// UserResetBotToken
+// user.resetBotToken creator_id:long bot_id:long = String;
+func (s *Service) UserResetBotToken(ctx context.Context, request *user.TLUserResetBotToken) (*mtproto.String, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Infof("user.resetBotToken - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.UserResetBotToken(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Infof("user.resetBotToken - reply: %s", r.DebugString())
+	return r, err
+}
@@ -0,0 +1,78 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package user
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/biz/username/username"
+)
+
+const (
+	MaxBotsPerCreator       = 20
+	MaxBotCommands          = 100
+	MaxBotCommandLen        = 32
+	MaxBotCommandDescLen    = 256
+	MaxBotFirstNameLen      = 64
+	botUsernameSuffix       = "bot"
+	botTokenSecretMinLength = 32
+)
+
+// CheckBotUsername bot usernames follow the username rules and must end in 'bot'
+func CheckBotUsername(v string) bool {
+	return username.CheckUsernameInvalid(v) &&
+		strings.HasSuffix(strings.ToLower(v), botUsernameSuffix)
+}
+
+// CheckBotCommand command is 1-32 chars of a-z, 0-9 and _, description is 1-256 chars
+func CheckBotCommand(command *mtproto.BotCommand) error {
+	cmd := command.GetCommand()
+	if len(cmd) == 0 || len(cmd) > MaxBotCommandLen {
+		return mtproto.ErrBotCommandInvalid
+	}
+	for _, ch := range cmd {
+		if !(ch >= '0' && ch <= '9' || ch >= 'a' && ch <= 'z' || ch == '_') {
+			return mtproto.ErrBotCommandInvalid
+		}
+	}
+
+	desc := []rune(command.GetDescription())
+	if len(desc) == 0 || len(desc) > MaxBotCommandDescLen {
+		return mtproto.ErrBotCommandDescriptionInvalid
+	}
+
+	return nil
+}
+
+// GetBotIdByToken returns the bot_id part of a '<bot_id>:<secret>' token
+func GetBotIdByToken(token string) (int64, bool) {
+	idx := strings.IndexByte(token, ':')
+	if idx <= 0 || len(token)-idx-1 < botTokenSecretMinLength {
+		return 0, false
+	}
+
+	botId, err := strconv.ParseInt(token[:idx], 10, 64)
+	if err != nil || botId <= 0 {
+		return 0, false
+	}
+
+	return botId, true
+}
@@ -0,0 +1,87 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package user
+
+import (
+	"testing"
+
+	"github.com/teamgram/proto/mtproto"
+)
+
+func TestCheckBotUsername(t *testing.T) {
+	for _, c := range []struct {
+		name string
+		ok   bool
+	}{
+		{"TetrisBot", true},
+		{"tetris_bot", true},
+		{"tetrisbot", true},
+		{"Tetris", false},
+		{"abot", false},
+		{"_tetrisbot", false},
+		{"1tetrisbot", false},
+		{"tetris-bot", false},
+	} {
+		if ok := CheckBotUsername(c.name); ok != c.ok {
+			t.Errorf("CheckBotUsername(%q) = %v, want %v", c.name, ok, c.ok)
+		}
+	}
+}
+
+func TestCheckBotCommand(t *testing.T) {
+	for _, c := range []struct {
+		command     string
+		description string
+		err         error
+	}{
+		{"start", "Start the bot", nil},
+		{"set_name2", "Change name", nil},
+		{"", "Empty", mtproto.ErrBotCommandInvalid},
+		{"Start", "Upper case", mtproto.ErrBotCommandInvalid},
+		{"/start", "Slash", mtproto.ErrBotCommandInvalid},
+		{"start", "", mtproto.ErrBotCommandDescriptionInvalid},
+	} {
+		err := CheckBotCommand(mtproto.MakeTLBotCommand(&mtproto.BotCommand{
+			Command:     c.command,
+			Description: c.description,
+		}).To_BotCommand())
+		if err != c.err {
+			t.Errorf("CheckBotCommand(%q, %q) = %v, want %v", c.command, c.description, err, c.err)
+		}
+	}
+}
+
+func TestGetBotIdByToken(t *testing.T) {
+	for _, c := range []struct {
+		token string
+		botId int64
+		ok    bool
+	}{
+		{"136907713:AAHdqTcvCH1vGWJxfSeofSAs0K5PALDsaw1", 136907713, true},
+		{"136907713:short", 0, false},
+		{":AAHdqTcvCH1vGWJxfSeofSAs0K5PALDsaw1", 0, false},
+		{"bot:AAHdqTcvCH1vGWJxfSeofSAs0K5PALDsaw1", 0, false},
+		{"AAHdqTcvCH1vGWJxfSeofSAs0K5PALDsaw1", 0, false},
+	} {
+		botId, ok := GetBotIdByToken(c.token)
+		if botId != c.botId || ok != c.ok {
+			t.Errorf("GetBotIdByToken(%q) = (%d, %v), want (%d, %v)", c.token, botId, ok, c.botId, c.ok)
+		}
+	}
+}
@@ -79,6 +79,22 @@ const (
 	Predicate_user_isBot                            = "user_isBot"
 	Predicate_user_getBotInfo                       = "user_getBotInfo"
 	Predicate_user_getFullUser                      = "user_getFullUser"
+	Predicate_user_getPassword                      = "user_getPassword"
+	Predicate_user_getPasswordSettings              = "user_getPasswordSettings"
+	Predicate_user_updatePasswordSettings           = "user_updatePasswordSettings"
+	Predicate_user_checkPassword                    = "user_checkPassword"
+	Predicate_user_confirmPasswordEmail             = "user_confirmPasswordEmail"
+	Predicate_user_resendPasswordEmail              = "user_resendPasswordEmail"
+	Predicate_user_cancelPasswordEmail              = "user_cancelPasswordEmail"
+	Predicate_user_requestPasswordRecovery          = "user_requestPasswordRecovery"
+	Predicate_user_checkRecoveryPassword            = "user_checkRecoveryPassword"
+	Predicate_user_recoverPassword                  = "user_recoverPassword"
+	Predicate_user_resetPassword                    = "user_resetPassword"
+	Predicate_user_declinePasswordReset             = "user_declinePasswordReset"
+	Predicate_user_checkSessionPasswordNeeded       = "user_checkSessionPasswordNeeded"
+	Predicate_user_createBot                        = "user_createBot"
+	Predicate_user_getBotsByCreator                 = "user_getBotsByCreator"
+	Predicate_user_resetBotToken                    = "user_resetBotToken"
 )
 
 var clazzNameRegisters2 = map[string]map[int]int32{
@@ -354,6 +370,70 @@ var clazzNameRegisters2 = map[string]map[int]int32{
 		0: -49225414, // 0xfd10e13a
 
 	},
+	Predicate_user_getPassword: {
+		0: -2007460369, // 0x885895ef
+
+	},
+	Predicate_user_getPasswordSettings: {
+		0: -1380506385, // 0xadb724ef
+
+	},
+	Predicate_user_updatePasswordSettings: {
+		0: -1765661297, // 0x96c2258f
+
+	},
+	Predicate_user_checkPassword: {
+		0: -489384446, // 0xe2d49602
+
+	},
+	Predicate_user_confirmPasswordEmail: {
+		0: 2066898477, // 0x7b325e2d
+
+	},
+	Predicate_user_resendPasswordEmail: {
+		0: 1782814347, // 0x6a43968b
+
+	},
+	Predicate_user_cancelPasswordEmail: {
+		0: 1807783949, // 0x6bc0980d
+
+	},
+	Predicate_user_requestPasswordRecovery: {
+		0: 104358405, // 0x6386205
+
+	},
+	Predicate_user_checkRecoveryPassword: {
+		0: -1657475449, // 0x9d34ee87
+
+	},
+	Predicate_user_recoverPassword: {
+		0: 788841274, // 0x2f04c33a
+
+	},
+	Predicate_user_resetPassword: {
+		0: 1237548075, // 0x49c37c2b
+
+	},
+	Predicate_user_declinePasswordReset: {
+		0: 185116490, // 0xb08a74a
+
+	},
+	Predicate_user_checkSessionPasswordNeeded: {
+		0: 1124749943, // 0x430a5277
+
+	},
+	Predicate_user_createBot: {
+		0: -1197203689, // 0xb8a41f17
+
+	},
+	Predicate_user_getBotsByCreator: {
+		0: 1074529934, // 0x400c068e
+
+	},
+	Predicate_user_resetBotToken: {
+		0: 685009574, // 0x28d46aa6
+
+	},
 }
 
 var clazzIdNameRegisters2 = map[int32]string{
@@ -425,6 +505,22 @@ var clazzIdNameRegisters2 = map[int32]string{
 	-948779026:  Predicate_user_isBot,                            // 0xc772c7ee
 	879114000:   Predicate_user_getBotInfo,                       // 0x34663710
 	-49225414:   Predicate_user_getFullUser,                      // 0xfd10e13a
+	-2007460369: Predicate_user_getPassword,                      // 0x885895ef
+	-1380506385: Predicate_user_getPasswordSettings,              // 0xadb724ef
+	-1765661297: Predicate_user_updatePasswordSettings,           // 0x96c2258f
+	-489384446:  Predicate_user_checkPassword,                    // 0xe2d49602
+	2066898477:  Predicate_user_confirmPasswordEmail,             // 0x7b325e2d
+	1782814347:  Predicate_user_resendPasswordEmail,              // 0x6a43968b
+	1807783949:  Predicate_user_cancelPasswordEmail,              // 0x6bc0980d
+	104358405:   Predicate_user_requestPasswordRecovery,          // 0x6386205
+	-1657475449: Predicate_user_checkRecoveryPassword,            // 0x9d34ee87
+	788841274:   Predicate_user_recoverPassword,                  // 0x2f04c33a
+	1237548075:  Predicate_user_resetPassword,                    // 0x49c37c2b
+	185116490:   Predicate_user_declinePasswordReset,             // 0xb08a74a
+	1124749943:  Predicate_user_checkSessionPasswordNeeded,       // 0x430a5277
+	-1197203689: Predicate_user_createBot,                        // 0xb8a41f17
+	1074529934:  Predicate_user_getBotsByCreator,                 // 0x400c068e
+	685009574:   Predicate_user_resetBotToken,                    // 0x28d46aa6
 
 }
 
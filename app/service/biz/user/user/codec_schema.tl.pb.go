@@ -372,6 +372,86 @@ var clazzIdRegisters2 = map[int32]func() mtproto.TLObject{
 			Constructor: -49225414,
 		}
 	},
+	-2007460369: func() mtproto.TLObject { // 0x885895ef
+		return &TLUserGetPassword{
+			Constructor: -2007460369,
+		}
+	},
+	-1380506385: func() mtproto.TLObject { // 0xadb724ef
+		return &TLUserGetPasswordSettings{
+			Constructor: -1380506385,
+		}
+	},
+	-1765661297: func() mtproto.TLObject { // 0x96c2258f
+		return &TLUserUpdatePasswordSettings{
+			Constructor: -1765661297,
+		}
+	},
+	-489384446: func() mtproto.TLObject { // 0xe2d49602
+		return &TLUserCheckPassword{
+			Constructor: -489384446,
+		}
+	},
+	2066898477: func() mtproto.TLObject { // 0x7b325e2d
+		return &TLUserConfirmPasswordEmail{
+			Constructor: 2066898477,
+		}
+	},
+	1782814347: func() mtproto.TLObject { // 0x6a43968b
+		return &TLUserResendPasswordEmail{
+			Constructor: 1782814347,
+		}
+	},
+	1807783949: func() mtproto.TLObject { // 0x6bc0980d
+		return &TLUserCancelPasswordEmail{
+			Constructor: 1807783949,
+		}
+	},
+	104358405: func() mtproto.TLObject { // 0x6386205
+		return &TLUserRequestPasswordRecovery{
+			Constructor: 104358405,
+		}
+	},
+	-1657475449: func() mtproto.TLObject { // 0x9d34ee87
+		return &TLUserCheckRecoveryPassword{
+			Constructor: -1657475449,
+		}
+	},
+	788841274: func() mtproto.TLObject { // 0x2f04c33a
+		return &TLUserRecoverPassword{
+			Constructor: 788841274,
+		}
+	},
+	1237548075: func() mtproto.TLObject { // 0x49c37c2b
+		return &TLUserResetPassword{
+			Constructor: 1237548075,
+		}
+	},
+	185116490: func() mtproto.TLObject { // 0xb08a74a
+		return &TLUserDeclinePasswordReset{
+			Constructor: 185116490,
+		}
+	},
+	1124749943: func() mtproto.TLObject { // 0x430a5277
+		return &TLUserCheckSessionPasswordNeeded{
+			Constructor: 1124749943,
+		}
+	},
+	-1197203689: func() mtproto.TLObject { // 0xb8a41f17
+		return &TLUserCreateBot{
+			Constructor: -1197203689,
+		}
+	},
+	1074529934: func() mtproto.TLObject { // 0x400c068e
+		return &TLUserGetBotsByCreator{
+			Constructor: 1074529934,
+		}
+	},
+	685009574: func() mtproto.TLObject { // 0x28d46aa6
+		return &TLUserResetBotToken{
+			Constructor: 685009574,
+		}
+	},
 }
 
 func NewTLObjectByClassID(classId int32) mtproto.TLObject {
@@ -913,6 +993,7 @@ func (m *TLImmutableUser) Decode(dBuf *mtproto.DecodeBuf) error {
 				}
 				m.SetContacts(v3)
 			}
+
 			c4 := dBuf.Int()
 			if c4 != int32(mtproto.CRC32_vector) {
 				// dBuf.err = fmt.Errorf("invalid mtproto.CRC32_vector, c%d: %d", 4, c4)
@@ -1105,6 +1186,7 @@ func (m *TLUserImportedContacts) Decode(dBuf *mtproto.DecodeBuf) error {
 	var decodeF = map[uint32]func() error{
 		0x4adf7bc0: func() error {
 			// userImportedContacts imported:Vector<ImportedContact> popular_invites:Vector<PopularContact> retry_contacts:Vector<long> users:Vector<User> update_id_list:Vector<long> = UserImportedContacts;
+
 			c0 := dBuf.Int()
 			if c0 != int32(mtproto.CRC32_vector) {
 				// dBuf.err = fmt.Errorf("invalid mtproto.CRC32_vector, c%d: %d", 0, c0)
@@ -1458,6 +1540,7 @@ func (m *TLPrivacyKeyRules) Decode(dBuf *mtproto.DecodeBuf) error {
 		0x9412add6: func() error {
 			// privacyKeyRules key:int rules:Vector<PrivacyRule> = PrivacyKeyRules;
 			m.SetKey(dBuf.Int())
+
 			c1 := dBuf.Int()
 			if c1 != int32(mtproto.CRC32_vector) {
 				// dBuf.err = fmt.Errorf("invalid mtproto.CRC32_vector, c%d: %d", 1, c1)
@@ -2922,6 +3005,7 @@ func (m *TLUserSetPrivacy) Decode(dBuf *mtproto.DecodeBuf) error {
 
 		m.UserId = dBuf.Long()
 		m.KeyType = dBuf.Int()
+
 		c3 := dBuf.Int()
 		if c3 != int32(mtproto.CRC32_vector) {
 			// dBuf.err = fmt.Errorf("invalid mtproto.CRC32_vector, c%d: %d", 3, c3)
@@ -4639,6 +4723,7 @@ func (m *TLUserImportContacts) Decode(dBuf *mtproto.DecodeBuf) error {
 		// not has flags
 
 		m.UserId = dBuf.Long()
+
 		c2 := dBuf.Int()
 		if c2 != int32(mtproto.CRC32_vector) {
 			// dBuf.err = fmt.Errorf("invalid mtproto.CRC32_vector, c%d: %d", 2, c2)
@@ -5122,6 +5207,7 @@ func (m *TLUserSetBotCommands) Decode(dBuf *mtproto.DecodeBuf) error {
 
 		m.UserId = dBuf.Long()
 		m.BotId = dBuf.Long()
+
 		c3 := dBuf.Int()
 		if c3 != int32(mtproto.CRC32_vector) {
 			// dBuf.err = fmt.Errorf("invalid mtproto.CRC32_vector, c%d: %d", 3, c3)
@@ -5298,6 +5384,843 @@ func (m *TLUserGetFullUser) DebugString() string {
 	return dbgString
 }
 
+// TLUserGetPassword
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLUserGetPassword) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_user_getPassword))
+
+	switch uint32(m.Constructor) {
+	case 0x885895ef:
+		// user.getPassword user_id:long = account.Password;
+		x.UInt(0x885895ef)
+
+		// no flags
+
+		x.Long(m.GetUserId())
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLUserGetPassword) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLUserGetPassword) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0x885895ef:
+		// user.getPassword user_id:long = account.Password;
+
+		// not has flags
+
+		m.UserId = dBuf.Long()
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLUserGetPassword) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLUserGetPasswordSettings
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLUserGetPasswordSettings) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_user_getPasswordSettings))
+
+	switch uint32(m.Constructor) {
+	case 0xadb724ef:
+		// user.getPasswordSettings user_id:long password:InputCheckPasswordSRP = account.PasswordSettings;
+		x.UInt(0xadb724ef)
+
+		// no flags
+
+		x.Long(m.GetUserId())
+		x.Bytes(m.GetPassword().Encode(layer))
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLUserGetPasswordSettings) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLUserGetPasswordSettings) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0xadb724ef:
+		// user.getPasswordSettings user_id:long password:InputCheckPasswordSRP = account.PasswordSettings;
+
+		// not has flags
+
+		m.UserId = dBuf.Long()
+
+		m2 := &mtproto.InputCheckPasswordSRP{}
+		m2.Decode(dBuf)
+		m.Password = m2
+
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLUserGetPasswordSettings) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLUserUpdatePasswordSettings
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLUserUpdatePasswordSettings) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_user_updatePasswordSettings))
+
+	switch uint32(m.Constructor) {
+	case 0x96c2258f:
+		// user.updatePasswordSettings user_id:long password:InputCheckPasswordSRP new_settings:account.PasswordInputSettings = Bool;
+		x.UInt(0x96c2258f)
+
+		// no flags
+
+		x.Long(m.GetUserId())
+		x.Bytes(m.GetPassword().Encode(layer))
+		x.Bytes(m.GetNewSettings().Encode(layer))
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLUserUpdatePasswordSettings) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLUserUpdatePasswordSettings) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0x96c2258f:
+		// user.updatePasswordSettings user_id:long password:InputCheckPasswordSRP new_settings:account.PasswordInputSettings = Bool;
+
+		// not has flags
+
+		m.UserId = dBuf.Long()
+
+		m2 := &mtproto.InputCheckPasswordSRP{}
+		m2.Decode(dBuf)
+		m.Password = m2
+
+		m3 := &mtproto.Account_PasswordInputSettings{}
+		m3.Decode(dBuf)
+		m.NewSettings = m3
+
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLUserUpdatePasswordSettings) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLUserCheckPassword
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLUserCheckPassword) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_user_checkPassword))
+
+	switch uint32(m.Constructor) {
+	case 0xe2d49602:
+		// user.checkPassword user_id:long password:InputCheckPasswordSRP = Bool;
+		x.UInt(0xe2d49602)
+
+		// no flags
+
+		x.Long(m.GetUserId())
+		x.Bytes(m.GetPassword().Encode(layer))
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLUserCheckPassword) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLUserCheckPassword) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0xe2d49602:
+		// user.checkPassword user_id:long password:InputCheckPasswordSRP = Bool;
+
+		// not has flags
+
+		m.UserId = dBuf.Long()
+
+		m2 := &mtproto.InputCheckPasswordSRP{}
+		m2.Decode(dBuf)
+		m.Password = m2
+
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLUserCheckPassword) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLUserConfirmPasswordEmail
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLUserConfirmPasswordEmail) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_user_confirmPasswordEmail))
+
+	switch uint32(m.Constructor) {
+	case 0x7b325e2d:
+		// user.confirmPasswordEmail user_id:long code:string = Bool;
+		x.UInt(0x7b325e2d)
+
+		// no flags
+
+		x.Long(m.GetUserId())
+		x.String(m.GetCode())
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLUserConfirmPasswordEmail) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLUserConfirmPasswordEmail) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0x7b325e2d:
+		// user.confirmPasswordEmail user_id:long code:string = Bool;
+
+		// not has flags
+
+		m.UserId = dBuf.Long()
+		m.Code = dBuf.String()
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLUserConfirmPasswordEmail) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLUserResendPasswordEmail
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLUserResendPasswordEmail) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_user_resendPasswordEmail))
+
+	switch uint32(m.Constructor) {
+	case 0x6a43968b:
+		// user.resendPasswordEmail user_id:long = Bool;
+		x.UInt(0x6a43968b)
+
+		// no flags
+
+		x.Long(m.GetUserId())
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLUserResendPasswordEmail) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLUserResendPasswordEmail) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0x6a43968b:
+		// user.resendPasswordEmail user_id:long = Bool;
+
+		// not has flags
+
+		m.UserId = dBuf.Long()
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLUserResendPasswordEmail) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLUserCancelPasswordEmail
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLUserCancelPasswordEmail) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_user_cancelPasswordEmail))
+
+	switch uint32(m.Constructor) {
+	case 0x6bc0980d:
+		// user.cancelPasswordEmail user_id:long = Bool;
+		x.UInt(0x6bc0980d)
+
+		// no flags
+
+		x.Long(m.GetUserId())
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLUserCancelPasswordEmail) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLUserCancelPasswordEmail) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0x6bc0980d:
+		// user.cancelPasswordEmail user_id:long = Bool;
+
+		// not has flags
+
+		m.UserId = dBuf.Long()
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLUserCancelPasswordEmail) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLUserRequestPasswordRecovery
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLUserRequestPasswordRecovery) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_user_requestPasswordRecovery))
+
+	switch uint32(m.Constructor) {
+	case 0x6386205:
+		// user.requestPasswordRecovery user_id:long = auth.PasswordRecovery;
+		x.UInt(0x6386205)
+
+		// no flags
+
+		x.Long(m.GetUserId())
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLUserRequestPasswordRecovery) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLUserRequestPasswordRecovery) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0x6386205:
+		// user.requestPasswordRecovery user_id:long = auth.PasswordRecovery;
+
+		// not has flags
+
+		m.UserId = dBuf.Long()
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLUserRequestPasswordRecovery) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLUserCheckRecoveryPassword
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLUserCheckRecoveryPassword) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_user_checkRecoveryPassword))
+
+	switch uint32(m.Constructor) {
+	case 0x9d34ee87:
+		// user.checkRecoveryPassword user_id:long code:string = Bool;
+		x.UInt(0x9d34ee87)
+
+		// no flags
+
+		x.Long(m.GetUserId())
+		x.String(m.GetCode())
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLUserCheckRecoveryPassword) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLUserCheckRecoveryPassword) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0x9d34ee87:
+		// user.checkRecoveryPassword user_id:long code:string = Bool;
+
+		// not has flags
+
+		m.UserId = dBuf.Long()
+		m.Code = dBuf.String()
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLUserCheckRecoveryPassword) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLUserRecoverPassword
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLUserRecoverPassword) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_user_recoverPassword))
+
+	switch uint32(m.Constructor) {
+	case 0x2f04c33a:
+		// user.recoverPassword flags:# user_id:long code:string new_settings:flags.0?account.PasswordInputSettings = Bool;
+		x.UInt(0x2f04c33a)
+
+		// set flags
+		var flags uint32 = 0
+
+		if m.GetNewSettings() != nil {
+			flags |= 1 << 0
+		}
+
+		x.UInt(flags)
+
+		// flags Debug by @benqi
+		x.Long(m.GetUserId())
+		x.String(m.GetCode())
+		if m.GetNewSettings() != nil {
+			x.Bytes(m.GetNewSettings().Encode(layer))
+		}
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLUserRecoverPassword) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLUserRecoverPassword) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0x2f04c33a:
+		// user.recoverPassword flags:# user_id:long code:string new_settings:flags.0?account.PasswordInputSettings = Bool;
+
+		flags := dBuf.UInt()
+		_ = flags
+
+		// flags Debug by @benqi
+		m.UserId = dBuf.Long()
+		m.Code = dBuf.String()
+		if (flags & (1 << 0)) != 0 {
+			m4 := &mtproto.Account_PasswordInputSettings{}
+			m4.Decode(dBuf)
+			m.NewSettings = m4
+		}
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLUserRecoverPassword) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLUserResetPassword
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLUserResetPassword) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_user_resetPassword))
+
+	switch uint32(m.Constructor) {
+	case 0x49c37c2b:
+		// user.resetPassword user_id:long = account.ResetPasswordResult;
+		x.UInt(0x49c37c2b)
+
+		// no flags
+
+		x.Long(m.GetUserId())
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLUserResetPassword) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLUserResetPassword) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0x49c37c2b:
+		// user.resetPassword user_id:long = account.ResetPasswordResult;
+
+		// not has flags
+
+		m.UserId = dBuf.Long()
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLUserResetPassword) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLUserDeclinePasswordReset
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLUserDeclinePasswordReset) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_user_declinePasswordReset))
+
+	switch uint32(m.Constructor) {
+	case 0xb08a74a:
+		// user.declinePasswordReset user_id:long = Bool;
+		x.UInt(0xb08a74a)
+
+		// no flags
+
+		x.Long(m.GetUserId())
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLUserDeclinePasswordReset) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLUserDeclinePasswordReset) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0xb08a74a:
+		// user.declinePasswordReset user_id:long = Bool;
+
+		// not has flags
+
+		m.UserId = dBuf.Long()
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLUserDeclinePasswordReset) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLUserCheckSessionPasswordNeeded
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLUserCheckSessionPasswordNeeded) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_user_checkSessionPasswordNeeded))
+
+	switch uint32(m.Constructor) {
+	case 0x430a5277:
+		// user.checkSessionPasswordNeeded user_id:long = Bool;
+		x.UInt(0x430a5277)
+
+		// no flags
+
+		x.Long(m.GetUserId())
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLUserCheckSessionPasswordNeeded) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLUserCheckSessionPasswordNeeded) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0x430a5277:
+		// user.checkSessionPasswordNeeded user_id:long = Bool;
+
+		// not has flags
+
+		m.UserId = dBuf.Long()
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLUserCheckSessionPasswordNeeded) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLUserCreateBot
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLUserCreateBot) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_user_createBot))
+
+	switch uint32(m.Constructor) {
+	case 0xb8a41f17:
+		// user.createBot creator_id:long first_name:string username:string = ImmutableUser;
+		x.UInt(0xb8a41f17)
+
+		// no flags
+
+		x.Long(m.GetCreatorId())
+		x.String(m.GetFirstName())
+		x.String(m.GetUsername())
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLUserCreateBot) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLUserCreateBot) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0xb8a41f17:
+		// user.createBot creator_id:long first_name:string username:string = ImmutableUser;
+
+		// not has flags
+
+		m.CreatorId = dBuf.Long()
+		m.FirstName = dBuf.String()
+		m.Username = dBuf.String()
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLUserCreateBot) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLUserGetBotsByCreator
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLUserGetBotsByCreator) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_user_getBotsByCreator))
+
+	switch uint32(m.Constructor) {
+	case 0x400c068e:
+		// user.getBotsByCreator creator_id:long = Vector<long>;
+		x.UInt(0x400c068e)
+
+		// no flags
+
+		x.Long(m.GetCreatorId())
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLUserGetBotsByCreator) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLUserGetBotsByCreator) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0x400c068e:
+		// user.getBotsByCreator creator_id:long = Vector<long>;
+
+		// not has flags
+
+		m.CreatorId = dBuf.Long()
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLUserGetBotsByCreator) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLUserResetBotToken
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLUserResetBotToken) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_user_resetBotToken))
+
+	switch uint32(m.Constructor) {
+	case 0x28d46aa6:
+		// user.resetBotToken creator_id:long bot_id:long = String;
+		x.UInt(0x28d46aa6)
+
+		// no flags
+
+		x.Long(m.GetCreatorId())
+		x.Long(m.GetBotId())
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLUserResetBotToken) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLUserResetBotToken) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0x28d46aa6:
+		// user.resetBotToken creator_id:long bot_id:long = String;
+
+		// not has flags
+
+		m.CreatorId = dBuf.Long()
+		m.BotId = dBuf.Long()
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLUserResetBotToken) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
 //----------------------------------------------------------------------------------------------------------------
 // Vector_LastSeenData
 ///////////////////////////////////////////////////////////////////////////////
@@ -55,6 +55,10 @@ func (m *ImmutableUser) SetUsername(v string) {
 }
 
 func (m *ImmutableUser) Phone() string {
+	// bots have no phone, users.phone only keeps a unique placeholder
+	if m.IsBot() {
+		return ""
+	}
 	return m.User.Phone
 }
 
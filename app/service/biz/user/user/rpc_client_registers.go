@@ -86,6 +86,22 @@ var rpcContextRegisters = map[string]RPCContextTuple{
 	"TLUserIsBot":                            RPCContextTuple{"/mtproto.RPCUser/user_isBot", func() interface{} { return new(mtproto.Bool) }},
 	"TLUserGetBotInfo":                       RPCContextTuple{"/mtproto.RPCUser/user_getBotInfo", func() interface{} { return new(mtproto.BotInfo) }},
 	"TLUserGetFullUser":                      RPCContextTuple{"/mtproto.RPCUser/user_getFullUser", func() interface{} { return new(mtproto.Users_UserFull) }},
+	"TLUserGetPassword":                      RPCContextTuple{"/mtproto.RPCUser/user_getPassword", func() interface{} { return new(mtproto.Account_Password) }},
+	"TLUserGetPasswordSettings":              RPCContextTuple{"/mtproto.RPCUser/user_getPasswordSettings", func() interface{} { return new(mtproto.Account_PasswordSettings) }},
+	"TLUserUpdatePasswordSettings":           RPCContextTuple{"/mtproto.RPCUser/user_updatePasswordSettings", func() interface{} { return new(mtproto.Bool) }},
+	"TLUserCheckPassword":                    RPCContextTuple{"/mtproto.RPCUser/user_checkPassword", func() interface{} { return new(mtproto.Bool) }},
+	"TLUserConfirmPasswordEmail":             RPCContextTuple{"/mtproto.RPCUser/user_confirmPasswordEmail", func() interface{} { return new(mtproto.Bool) }},
+	"TLUserResendPasswordEmail":              RPCContextTuple{"/mtproto.RPCUser/user_resendPasswordEmail", func() interface{} { return new(mtproto.Bool) }},
+	"TLUserCancelPasswordEmail":              RPCContextTuple{"/mtproto.RPCUser/user_cancelPasswordEmail", func() interface{} { return new(mtproto.Bool) }},
+	"TLUserRequestPasswordRecovery":          RPCContextTuple{"/mtproto.RPCUser/user_requestPasswordRecovery", func() interface{} { return new(mtproto.Auth_PasswordRecovery) }},
+	"TLUserCheckRecoveryPassword":            RPCContextTuple{"/mtproto.RPCUser/user_checkRecoveryPassword", func() interface{} { return new(mtproto.Bool) }},
+	"TLUserRecoverPassword":                  RPCContextTuple{"/mtproto.RPCUser/user_recoverPassword", func() interface{} { return new(mtproto.Bool) }},
+	"TLUserResetPassword":                    RPCContextTuple{"/mtproto.RPCUser/user_resetPassword", func() interface{} { return new(mtproto.Account_ResetPasswordResult) }},
+	"TLUserDeclinePasswordReset":             RPCContextTuple{"/mtproto.RPCUser/user_declinePasswordReset", func() interface{} { return new(mtproto.Bool) }},
+	"TLUserCheckSessionPasswordNeeded":       RPCContextTuple{"/mtproto.RPCUser/user_checkSessionPasswordNeeded", func() interface{} { return new(mtproto.Bool) }},
+	"TLUserCreateBot":                        RPCContextTuple{"/mtproto.RPCUser/user_createBot", func() interface{} { return new(ImmutableUser) }},
+	"TLUserGetBotsByCreator":                 RPCContextTuple{"/mtproto.RPCUser/user_getBotsByCreator", func() interface{} { return new(Vector_Long) }},
+	"TLUserResetBotToken":                    RPCContextTuple{"/mtproto.RPCUser/user_resetBotToken", func() interface{} { return new(mtproto.String) }},
 }
 
 func FindRPCContextTuple(t interface{}) *RPCContextTuple {
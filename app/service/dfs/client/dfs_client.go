@@ -32,6 +32,11 @@ type DfsClient interface {
 	DfsUploadMp4DocumentMedia(ctx context.Context, in *dfs.TLDfsUploadMp4DocumentMedia) (*mtproto.Document, error)
 	DfsUploadWallPaperFile(ctx context.Context, in *dfs.TLDfsUploadWallPaperFile) (*mtproto.Document, error)
 	DfsUploadThemeFile(ctx context.Context, in *dfs.TLDfsUploadThemeFile) (*mtproto.Document, error)
+	DfsGetFileHashes(ctx context.Context, in *dfs.TLDfsGetFileHashes) (*dfs.Vector_FileHash, error)
+	DfsDownloadFileV2(ctx context.Context, in *dfs.TLDfsDownloadFileV2) (*mtproto.Upload_File, error)
+	DfsReuploadCdnFile(ctx context.Context, in *dfs.TLDfsReuploadCdnFile) (*dfs.Vector_FileHash, error)
+	DfsGetCdnFileHashes(ctx context.Context, in *dfs.TLDfsGetCdnFileHashes) (*dfs.Vector_FileHash, error)
+	DfsGetCdnConfig(ctx context.Context, in *dfs.TLDfsGetCdnConfig) (*mtproto.CdnConfig, error)
 }
 
 type defaultDfsClient struct {
@@ -113,3 +118,38 @@ func (m *defaultDfsClient) DfsUploadThemeFile(ctx context.Context, in *dfs.TLDfs
 	client := dfs.NewRPCDfsClient(m.cli.Conn())
 	return client.DfsUploadThemeFile(ctx, in)
 }
+
+// DfsGetFileHashes
+// dfs.getFileHashes location:InputFileLocation offset:int = Vector<FileHash>;
+func (m *defaultDfsClient) DfsGetFileHashes(ctx context.Context, in *dfs.TLDfsGetFileHashes) (*dfs.Vector_FileHash, error) {
+	client := dfs.NewRPCDfsClient(m.cli.Conn())
+	return client.DfsGetFileHashes(ctx, in)
+}
+
+// DfsDownloadFileV2
+// dfs.downloadFileV2 flags:# cdn_supported:flags.0?true location:InputFileLocation offset:int limit:int = upload.File;
+func (m *defaultDfsClient) DfsDownloadFileV2(ctx context.Context, in *dfs.TLDfsDownloadFileV2) (*mtproto.Upload_File, error) {
+	client := dfs.NewRPCDfsClient(m.cli.Conn())
+	return client.DfsDownloadFileV2(ctx, in)
+}
+
+// DfsReuploadCdnFile
+// dfs.reuploadCdnFile file_token:bytes request_token:bytes = Vector<FileHash>;
+func (m *defaultDfsClient) DfsReuploadCdnFile(ctx context.Context, in *dfs.TLDfsReuploadCdnFile) (*dfs.Vector_FileHash, error) {
+	client := dfs.NewRPCDfsClient(m.cli.Conn())
+	return client.DfsReuploadCdnFile(ctx, in)
+}
+
+// DfsGetCdnFileHashes
+// dfs.getCdnFileHashes file_token:bytes offset:int = Vector<FileHash>;
+func (m *defaultDfsClient) DfsGetCdnFileHashes(ctx context.Context, in *dfs.TLDfsGetCdnFileHashes) (*dfs.Vector_FileHash, error) {
+	client := dfs.NewRPCDfsClient(m.cli.Conn())
+	return client.DfsGetCdnFileHashes(ctx, in)
+}
+
+// DfsGetCdnConfig
+// dfs.getCdnConfig = CdnConfig;
+func (m *defaultDfsClient) DfsGetCdnConfig(ctx context.Context, in *dfs.TLDfsGetCdnConfig) (*mtproto.CdnConfig, error) {
+	client := dfs.NewRPCDfsClient(m.cli.Conn())
+	return client.DfsGetCdnConfig(ctx, in)
+}
@@ -0,0 +1,74 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+// dfsmigrate copies the objects of dfs from a storage backend to another, e.g.
+//
+//	dfsmigrate -f etc/dfsmigrate.yaml
+//
+// the objects already copied are skipped, so it can be run again after dfs has been
+// switched to the new backend to copy the files uploaded in between.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/teamgram/teamgram-server/app/service/dfs/internal/storage"
+	"github.com/teamgram/teamgram-server/app/service/dfs/internal/storage/conf"
+
+	zconf "github.com/zeromicro/go-zero/core/conf"
+)
+
+var configFile = flag.String("f", "etc/dfsmigrate.yaml", "the config file")
+
+type Config struct {
+	From conf.StorageConfig
+	To   conf.StorageConfig
+	// Buckets default to all the buckets of dfs
+	Buckets []string `json:",optional"`
+	// Overwrite copies the objects already in To with the same size too
+	Overwrite bool `json:",optional"`
+}
+
+func main() {
+	flag.Parse()
+
+	var c Config
+	zconf.MustLoad(*configFile, &c)
+
+	if len(c.Buckets) == 0 {
+		c.Buckets = storage.Buckets
+	}
+
+	var (
+		ctx = context.Background()
+		src = storage.New(&c.From)
+		dst = storage.New(&c.To)
+	)
+
+	for _, bucket := range c.Buckets {
+		r, err := storage.Migrate(ctx, src, dst, bucket, c.Overwrite)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "migrate %s: %v (copied: %d, skipped: %d)\n", bucket, err, r.Copied, r.Skipped)
+			os.Exit(1)
+		}
+		fmt.Printf("migrate %s: copied: %d, skipped: %d\n", bucket, r.Copied, r.Skipped)
+	}
+}
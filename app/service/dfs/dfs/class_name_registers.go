@@ -21,6 +21,11 @@ const (
 	Predicate_dfs_uploadMp4DocumentMedia   = "dfs_uploadMp4DocumentMedia"
 	Predicate_dfs_uploadWallPaperFile      = "dfs_uploadWallPaperFile"
 	Predicate_dfs_uploadThemeFile          = "dfs_uploadThemeFile"
+	Predicate_dfs_getFileHashes            = "dfs_getFileHashes"
+	Predicate_dfs_downloadFileV2           = "dfs_downloadFileV2"
+	Predicate_dfs_reuploadCdnFile          = "dfs_reuploadCdnFile"
+	Predicate_dfs_getCdnFileHashes         = "dfs_getCdnFileHashes"
+	Predicate_dfs_getCdnConfig             = "dfs_getCdnConfig"
 )
 
 var clazzNameRegisters2 = map[string]map[int]int32{
@@ -64,6 +69,26 @@ var clazzNameRegisters2 = map[string]map[int]int32{
 		0: -559525993, // 0xdea64f97
 
 	},
+	Predicate_dfs_getFileHashes: {
+		0: 201743636, // 0xc065d14
+
+	},
+	Predicate_dfs_downloadFileV2: {
+		0: -1816022961, // 0x93c1b04f
+
+	},
+	Predicate_dfs_reuploadCdnFile: {
+		0: -773849538, // 0xd1dffe3e
+
+	},
+	Predicate_dfs_getCdnFileHashes: {
+		0: -523192659, // 0xe0d0b6ad
+
+	},
+	Predicate_dfs_getCdnConfig: {
+		0: 1003697758, // 0x3bd3365e
+
+	},
 }
 
 var clazzIdNameRegisters2 = map[int32]string{
@@ -77,6 +102,11 @@ var clazzIdNameRegisters2 = map[int32]string{
 	-1566246888: Predicate_dfs_uploadMp4DocumentMedia,   // 0xa2a4f818
 	-1046081450: Predicate_dfs_uploadWallPaperFile,      // 0xc1a61056
 	-559525993:  Predicate_dfs_uploadThemeFile,          // 0xdea64f97
+	201743636:   Predicate_dfs_getFileHashes,            // 0xc065d14
+	-1816022961: Predicate_dfs_downloadFileV2,           // 0x93c1b04f
+	-773849538:  Predicate_dfs_reuploadCdnFile,          // 0xd1dffe3e
+	-523192659:  Predicate_dfs_getCdnFileHashes,         // 0xe0d0b6ad
+	1003697758:  Predicate_dfs_getCdnConfig,             // 0x3bd3365e
 
 }
 
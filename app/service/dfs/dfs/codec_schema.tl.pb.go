@@ -81,6 +81,31 @@ var clazzIdRegisters2 = map[int32]func() mtproto.TLObject{
 			Constructor: -559525993,
 		}
 	},
+	201743636: func() mtproto.TLObject { // 0xc065d14
+		return &TLDfsGetFileHashes{
+			Constructor: 201743636,
+		}
+	},
+	-1816022961: func() mtproto.TLObject { // 0x93c1b04f
+		return &TLDfsDownloadFileV2{
+			Constructor: -1816022961,
+		}
+	},
+	-773849538: func() mtproto.TLObject { // 0xd1dffe3e
+		return &TLDfsReuploadCdnFile{
+			Constructor: -773849538,
+		}
+	},
+	-523192659: func() mtproto.TLObject { // 0xe0d0b6ad
+		return &TLDfsGetCdnFileHashes{
+			Constructor: -523192659,
+		}
+	},
+	1003697758: func() mtproto.TLObject { // 0x3bd3365e
+		return &TLDfsGetCdnConfig{
+			Constructor: 1003697758,
+		}
+	},
 }
 
 func NewTLObjectByClassID(classId int32) mtproto.TLObject {
@@ -729,4 +754,305 @@ func (m *TLDfsUploadThemeFile) DebugString() string {
 	return dbgString
 }
 
+// TLDfsGetFileHashes
+///////////////////////////////////////////////////////////////////////////////
+func (m *TLDfsGetFileHashes) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_dfs_getFileHashes))
+
+	switch uint32(m.Constructor) {
+	case 0xc065d14:
+		// dfs.getFileHashes location:InputFileLocation offset:int = Vector<FileHash>;
+		x.UInt(0xc065d14)
+
+		// no flags
+
+		x.Bytes(m.GetLocation().Encode(layer))
+		x.Int(m.GetOffset())
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLDfsGetFileHashes) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLDfsGetFileHashes) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0xc065d14:
+		// dfs.getFileHashes location:InputFileLocation offset:int = Vector<FileHash>;
+
+		// not has flags
+
+		m1 := &mtproto.InputFileLocation{}
+		m1.Decode(dBuf)
+		m.Location = m1
+
+		m.Offset = dBuf.Int()
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLDfsGetFileHashes) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLDfsDownloadFileV2
+///////////////////////////////////////////////////////////////////////////////
+func (m *TLDfsDownloadFileV2) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_dfs_downloadFileV2))
+
+	switch uint32(m.Constructor) {
+	case 0x93c1b04f:
+		// dfs.downloadFileV2 flags:# cdn_supported:flags.0?true location:InputFileLocation offset:int limit:int = upload.File;
+		x.UInt(0x93c1b04f)
+
+		// set flags
+		var flags uint32 = 0
+
+		if m.GetCdnSupported() == true {
+			flags |= 1 << 0
+		}
+
+		x.UInt(flags)
+
+		// flags Debug by @benqi
+		x.Bytes(m.GetLocation().Encode(layer))
+		x.Int(m.GetOffset())
+		x.Int(m.GetLimit())
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLDfsDownloadFileV2) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLDfsDownloadFileV2) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0x93c1b04f:
+		// dfs.downloadFileV2 flags:# cdn_supported:flags.0?true location:InputFileLocation offset:int limit:int = upload.File;
+
+		flags := dBuf.UInt()
+		_ = flags
+
+		// flags Debug by @benqi
+		if (flags & (1 << 0)) != 0 {
+			m.CdnSupported = true
+		}
+
+		m3 := &mtproto.InputFileLocation{}
+		m3.Decode(dBuf)
+		m.Location = m3
+
+		m.Offset = dBuf.Int()
+		m.Limit = dBuf.Int()
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLDfsDownloadFileV2) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLDfsReuploadCdnFile
+///////////////////////////////////////////////////////////////////////////////
+func (m *TLDfsReuploadCdnFile) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_dfs_reuploadCdnFile))
+
+	switch uint32(m.Constructor) {
+	case 0xd1dffe3e:
+		// dfs.reuploadCdnFile file_token:bytes request_token:bytes = Vector<FileHash>;
+		x.UInt(0xd1dffe3e)
+
+		// no flags
+
+		x.StringBytes(m.GetFileToken())
+		x.StringBytes(m.GetRequestToken())
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLDfsReuploadCdnFile) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLDfsReuploadCdnFile) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0xd1dffe3e:
+		// dfs.reuploadCdnFile file_token:bytes request_token:bytes = Vector<FileHash>;
+
+		// not has flags
+
+		m.FileToken = dBuf.StringBytes()
+		m.RequestToken = dBuf.StringBytes()
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLDfsReuploadCdnFile) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLDfsGetCdnFileHashes
+///////////////////////////////////////////////////////////////////////////////
+func (m *TLDfsGetCdnFileHashes) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_dfs_getCdnFileHashes))
+
+	switch uint32(m.Constructor) {
+	case 0xe0d0b6ad:
+		// dfs.getCdnFileHashes file_token:bytes offset:int = Vector<FileHash>;
+		x.UInt(0xe0d0b6ad)
+
+		// no flags
+
+		x.StringBytes(m.GetFileToken())
+		x.Int(m.GetOffset())
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLDfsGetCdnFileHashes) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLDfsGetCdnFileHashes) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0xe0d0b6ad:
+		// dfs.getCdnFileHashes file_token:bytes offset:int = Vector<FileHash>;
+
+		// not has flags
+
+		m.FileToken = dBuf.StringBytes()
+		m.Offset = dBuf.Int()
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLDfsGetCdnFileHashes) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLDfsGetCdnConfig
+///////////////////////////////////////////////////////////////////////////////
+func (m *TLDfsGetCdnConfig) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_dfs_getCdnConfig))
+
+	switch uint32(m.Constructor) {
+	case 0x3bd3365e:
+		// dfs.getCdnConfig = CdnConfig;
+		x.UInt(0x3bd3365e)
+
+		// no flags
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLDfsGetCdnConfig) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLDfsGetCdnConfig) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0x3bd3365e:
+		// dfs.getCdnConfig = CdnConfig;
+
+		// not has flags
+
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLDfsGetCdnConfig) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
 //----------------------------------------------------------------------------------------------------------------
+// Vector_FileHash
+///////////////////////////////////////////////////////////////////////////////
+func (m *Vector_FileHash) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	x.Int(int32(mtproto.CRC32_vector))
+	x.Int(int32(len(m.Datas)))
+	for _, v := range m.Datas {
+		x.Bytes((*v).Encode(layer))
+	}
+
+	return x.GetBuf()
+}
+
+func (m *Vector_FileHash) Decode(dBuf *mtproto.DecodeBuf) error {
+	dBuf.Int() // TODO(@benqi): Check crc32 invalid
+	l1 := dBuf.Int()
+	m.Datas = make([]*mtproto.FileHash, l1)
+	for i := int32(0); i < l1; i++ {
+		m.Datas[i] = new(mtproto.FileHash)
+		(*m.Datas[i]).Decode(dBuf)
+	}
+
+	return dBuf.GetError()
+}
+
+func (m *Vector_FileHash) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *Vector_FileHash) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
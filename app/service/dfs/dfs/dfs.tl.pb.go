@@ -45,6 +45,11 @@ const (
 	CRC32_dfs_uploadMp4DocumentMedia   TLConstructor = -1566246888
 	CRC32_dfs_uploadWallPaperFile      TLConstructor = -1046081450
 	CRC32_dfs_uploadThemeFile          TLConstructor = -559525993
+	CRC32_dfs_getFileHashes            TLConstructor = 201743636
+	CRC32_dfs_downloadFileV2           TLConstructor = -1816022961
+	CRC32_dfs_reuploadCdnFile          TLConstructor = -773849538
+	CRC32_dfs_getCdnFileHashes         TLConstructor = -523192659
+	CRC32_dfs_getCdnConfig             TLConstructor = 1003697758
 )
 
 var TLConstructor_name = map[int32]string{
@@ -59,6 +64,11 @@ var TLConstructor_name = map[int32]string{
 	-1566246888: "CRC32_dfs_uploadMp4DocumentMedia",
 	-1046081450: "CRC32_dfs_uploadWallPaperFile",
 	-559525993:  "CRC32_dfs_uploadThemeFile",
+	201743636:   "CRC32_dfs_getFileHashes",
+	-1816022961: "CRC32_dfs_downloadFileV2",
+	-773849538:  "CRC32_dfs_reuploadCdnFile",
+	-523192659:  "CRC32_dfs_getCdnFileHashes",
+	1003697758:  "CRC32_dfs_getCdnConfig",
 }
 
 var TLConstructor_value = map[string]int32{
@@ -73,6 +83,11 @@ var TLConstructor_value = map[string]int32{
 	"CRC32_dfs_uploadMp4DocumentMedia":   -1566246888,
 	"CRC32_dfs_uploadWallPaperFile":      -1046081450,
 	"CRC32_dfs_uploadThemeFile":          -559525993,
+	"CRC32_dfs_getFileHashes":            201743636,
+	"CRC32_dfs_downloadFileV2":           -1816022961,
+	"CRC32_dfs_reuploadCdnFile":          -773849538,
+	"CRC32_dfs_getCdnFileHashes":         -523192659,
+	"CRC32_dfs_getCdnConfig":             1003697758,
 }
 
 func (x TLConstructor) String() string {
@@ -829,6 +844,380 @@ func (m *TLDfsUploadThemeFile) GetFileName() string {
 	return ""
 }
 
+//--------------------------------------------------------------------------------------------
+// dfs.getFileHashes location:InputFileLocation offset:int = Vector<FileHash>;
+type TLDfsGetFileHashes struct {
+	Constructor          TLConstructor              `protobuf:"varint,1,opt,name=constructor,proto3,enum=dfs.TLConstructor" json:"constructor,omitempty"`
+	Location             *mtproto.InputFileLocation `protobuf:"bytes,3,opt,name=location,proto3" json:"location,omitempty"`
+	Offset               int32                      `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
+	XXX_unrecognized     []byte                     `json:"-"`
+	XXX_sizecache        int32                      `json:"-"`
+}
+
+func (m *TLDfsGetFileHashes) Reset()         { *m = TLDfsGetFileHashes{} }
+func (m *TLDfsGetFileHashes) String() string { return proto.CompactTextString(m) }
+func (*TLDfsGetFileHashes) ProtoMessage()    {}
+func (*TLDfsGetFileHashes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c9cc97391f90775, []int{10}
+}
+func (m *TLDfsGetFileHashes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLDfsGetFileHashes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLDfsGetFileHashes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TLDfsGetFileHashes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLDfsGetFileHashes.Merge(m, src)
+}
+func (m *TLDfsGetFileHashes) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLDfsGetFileHashes) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLDfsGetFileHashes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLDfsGetFileHashes proto.InternalMessageInfo
+
+func (m *TLDfsGetFileHashes) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
+	}
+	return CRC32_UNKNOWN
+}
+
+func (m *TLDfsGetFileHashes) GetLocation() *mtproto.InputFileLocation {
+	if m != nil {
+		return m.Location
+	}
+	return nil
+}
+
+func (m *TLDfsGetFileHashes) GetOffset() int32 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+//--------------------------------------------------------------------------------------------
+// dfs.downloadFileV2 flags:# cdn_supported:flags.0?true location:InputFileLocation offset:int limit:int = upload.File;
+type TLDfsDownloadFileV2 struct {
+	Constructor          TLConstructor              `protobuf:"varint,1,opt,name=constructor,proto3,enum=dfs.TLConstructor" json:"constructor,omitempty"`
+	CdnSupported         bool                       `protobuf:"varint,3,opt,name=cdn_supported,json=cdnSupported,proto3" json:"cdn_supported,omitempty"`
+	Location             *mtproto.InputFileLocation `protobuf:"bytes,4,opt,name=location,proto3" json:"location,omitempty"`
+	Offset               int32                      `protobuf:"varint,5,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit                int32                      `protobuf:"varint,6,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
+	XXX_unrecognized     []byte                     `json:"-"`
+	XXX_sizecache        int32                      `json:"-"`
+}
+
+func (m *TLDfsDownloadFileV2) Reset()         { *m = TLDfsDownloadFileV2{} }
+func (m *TLDfsDownloadFileV2) String() string { return proto.CompactTextString(m) }
+func (*TLDfsDownloadFileV2) ProtoMessage()    {}
+func (*TLDfsDownloadFileV2) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c9cc97391f90775, []int{11}
+}
+func (m *TLDfsDownloadFileV2) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLDfsDownloadFileV2) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLDfsDownloadFileV2.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TLDfsDownloadFileV2) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLDfsDownloadFileV2.Merge(m, src)
+}
+func (m *TLDfsDownloadFileV2) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLDfsDownloadFileV2) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLDfsDownloadFileV2.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLDfsDownloadFileV2 proto.InternalMessageInfo
+
+func (m *TLDfsDownloadFileV2) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
+	}
+	return CRC32_UNKNOWN
+}
+
+func (m *TLDfsDownloadFileV2) GetCdnSupported() bool {
+	if m != nil {
+		return m.CdnSupported
+	}
+	return false
+}
+
+func (m *TLDfsDownloadFileV2) GetLocation() *mtproto.InputFileLocation {
+	if m != nil {
+		return m.Location
+	}
+	return nil
+}
+
+func (m *TLDfsDownloadFileV2) GetOffset() int32 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *TLDfsDownloadFileV2) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+//--------------------------------------------------------------------------------------------
+// dfs.reuploadCdnFile file_token:bytes request_token:bytes = Vector<FileHash>;
+type TLDfsReuploadCdnFile struct {
+	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=dfs.TLConstructor" json:"constructor,omitempty"`
+	FileToken            []byte        `protobuf:"bytes,3,opt,name=file_token,json=fileToken,proto3" json:"file_token,omitempty"`
+	RequestToken         []byte        `protobuf:"bytes,4,opt,name=request_token,json=requestToken,proto3" json:"request_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *TLDfsReuploadCdnFile) Reset()         { *m = TLDfsReuploadCdnFile{} }
+func (m *TLDfsReuploadCdnFile) String() string { return proto.CompactTextString(m) }
+func (*TLDfsReuploadCdnFile) ProtoMessage()    {}
+func (*TLDfsReuploadCdnFile) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c9cc97391f90775, []int{12}
+}
+func (m *TLDfsReuploadCdnFile) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLDfsReuploadCdnFile) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLDfsReuploadCdnFile.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TLDfsReuploadCdnFile) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLDfsReuploadCdnFile.Merge(m, src)
+}
+func (m *TLDfsReuploadCdnFile) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLDfsReuploadCdnFile) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLDfsReuploadCdnFile.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLDfsReuploadCdnFile proto.InternalMessageInfo
+
+func (m *TLDfsReuploadCdnFile) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
+	}
+	return CRC32_UNKNOWN
+}
+
+func (m *TLDfsReuploadCdnFile) GetFileToken() []byte {
+	if m != nil {
+		return m.FileToken
+	}
+	return nil
+}
+
+func (m *TLDfsReuploadCdnFile) GetRequestToken() []byte {
+	if m != nil {
+		return m.RequestToken
+	}
+	return nil
+}
+
+//--------------------------------------------------------------------------------------------
+// dfs.getCdnFileHashes file_token:bytes offset:int = Vector<FileHash>;
+type TLDfsGetCdnFileHashes struct {
+	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=dfs.TLConstructor" json:"constructor,omitempty"`
+	FileToken            []byte        `protobuf:"bytes,3,opt,name=file_token,json=fileToken,proto3" json:"file_token,omitempty"`
+	Offset               int32         `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *TLDfsGetCdnFileHashes) Reset()         { *m = TLDfsGetCdnFileHashes{} }
+func (m *TLDfsGetCdnFileHashes) String() string { return proto.CompactTextString(m) }
+func (*TLDfsGetCdnFileHashes) ProtoMessage()    {}
+func (*TLDfsGetCdnFileHashes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c9cc97391f90775, []int{13}
+}
+func (m *TLDfsGetCdnFileHashes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLDfsGetCdnFileHashes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLDfsGetCdnFileHashes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TLDfsGetCdnFileHashes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLDfsGetCdnFileHashes.Merge(m, src)
+}
+func (m *TLDfsGetCdnFileHashes) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLDfsGetCdnFileHashes) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLDfsGetCdnFileHashes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLDfsGetCdnFileHashes proto.InternalMessageInfo
+
+func (m *TLDfsGetCdnFileHashes) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
+	}
+	return CRC32_UNKNOWN
+}
+
+func (m *TLDfsGetCdnFileHashes) GetFileToken() []byte {
+	if m != nil {
+		return m.FileToken
+	}
+	return nil
+}
+
+func (m *TLDfsGetCdnFileHashes) GetOffset() int32 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+//--------------------------------------------------------------------------------------------
+// dfs.getCdnConfig = CdnConfig;
+type TLDfsGetCdnConfig struct {
+	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=dfs.TLConstructor" json:"constructor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *TLDfsGetCdnConfig) Reset()         { *m = TLDfsGetCdnConfig{} }
+func (m *TLDfsGetCdnConfig) String() string { return proto.CompactTextString(m) }
+func (*TLDfsGetCdnConfig) ProtoMessage()    {}
+func (*TLDfsGetCdnConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c9cc97391f90775, []int{14}
+}
+func (m *TLDfsGetCdnConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLDfsGetCdnConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLDfsGetCdnConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TLDfsGetCdnConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLDfsGetCdnConfig.Merge(m, src)
+}
+func (m *TLDfsGetCdnConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLDfsGetCdnConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLDfsGetCdnConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLDfsGetCdnConfig proto.InternalMessageInfo
+
+func (m *TLDfsGetCdnConfig) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
+	}
+	return CRC32_UNKNOWN
+}
+
+//--------------------------------------------------------------------------------------------
+// Vector api result type
+type Vector_FileHash struct {
+	Datas                []*mtproto.FileHash `protobuf:"bytes,1,rep,name=datas,proto3" json:"datas,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *Vector_FileHash) Reset()         { *m = Vector_FileHash{} }
+func (m *Vector_FileHash) String() string { return proto.CompactTextString(m) }
+func (*Vector_FileHash) ProtoMessage()    {}
+func (*Vector_FileHash) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c9cc97391f90775, []int{15}
+}
+func (m *Vector_FileHash) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Vector_FileHash) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Vector_FileHash.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Vector_FileHash) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Vector_FileHash.Merge(m, src)
+}
+func (m *Vector_FileHash) XXX_Size() int {
+	return m.Size()
+}
+func (m *Vector_FileHash) XXX_DiscardUnknown() {
+	xxx_messageInfo_Vector_FileHash.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Vector_FileHash proto.InternalMessageInfo
+
+func (m *Vector_FileHash) GetDatas() []*mtproto.FileHash {
+	if m != nil {
+		return m.Datas
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("dfs.TLConstructor", TLConstructor_name, TLConstructor_value)
 	proto.RegisterType((*TLDfsWriteFilePartData)(nil), "dfs.TL_dfs_writeFilePartData")
@@ -841,81 +1230,107 @@ func init() {
 	proto.RegisterType((*TLDfsUploadMp4DocumentMedia)(nil), "dfs.TL_dfs_uploadMp4DocumentMedia")
 	proto.RegisterType((*TLDfsUploadWallPaperFile)(nil), "dfs.TL_dfs_uploadWallPaperFile")
 	proto.RegisterType((*TLDfsUploadThemeFile)(nil), "dfs.TL_dfs_uploadThemeFile")
+	proto.RegisterType((*TLDfsGetFileHashes)(nil), "dfs.TL_dfs_getFileHashes")
+	proto.RegisterType((*TLDfsDownloadFileV2)(nil), "dfs.TL_dfs_downloadFileV2")
+	proto.RegisterType((*TLDfsReuploadCdnFile)(nil), "dfs.TL_dfs_reuploadCdnFile")
+	proto.RegisterType((*TLDfsGetCdnFileHashes)(nil), "dfs.TL_dfs_getCdnFileHashes")
+	proto.RegisterType((*TLDfsGetCdnConfig)(nil), "dfs.TL_dfs_getCdnConfig")
+	proto.RegisterType((*Vector_FileHash)(nil), "dfs.Vector_FileHash")
 }
 
 func init() { proto.RegisterFile("dfs.tl.proto", fileDescriptor_1c9cc97391f90775) }
 
 var fileDescriptor_1c9cc97391f90775 = []byte{
-	// 1098 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0xcd, 0x6f, 0xdc, 0xc4,
-	0x1b, 0x5e, 0x27, 0xd9, 0x4d, 0xf2, 0xe6, 0x43, 0xee, 0x24, 0xbf, 0x74, 0xe3, 0x4d, 0x36, 0xfb,
-	0x73, 0x50, 0x59, 0x2a, 0x65, 0x57, 0xda, 0x54, 0xdc, 0x49, 0x52, 0x42, 0x44, 0x9a, 0x06, 0xb3,
-	0x4d, 0x11, 0x97, 0xd5, 0xac, 0x3d, 0xde, 0x58, 0xb2, 0x3d, 0x96, 0x3d, 0xdb, 0x28, 0x37, 0xe0,
-	0x52, 0x84, 0x04, 0x48, 0x54, 0x88, 0xd0, 0x4b, 0x0b, 0xb9, 0x57, 0x2a, 0x17, 0xfe, 0x00, 0x3e,
-	0x84, 0x84, 0xf8, 0x10, 0x87, 0x5e, 0xb8, 0x94, 0xfc, 0x05, 0x3d, 0x00, 0x42, 0xa8, 0x28, 0xc8,
-	0x63, 0xaf, 0x13, 0x7f, 0x24, 0x42, 0x94, 0xaa, 0x3d, 0xac, 0x34, 0xe3, 0xe7, 0x99, 0x67, 0x9e,
-	0xf7, 0x9d, 0x99, 0xf7, 0x5d, 0x18, 0xd5, 0x74, 0xaf, 0xc6, 0xcc, 0x9a, 0xe3, 0x52, 0x46, 0x51,
-	0xbf, 0xa6, 0x7b, 0xd2, 0x42, 0xc7, 0x60, 0xdb, 0xdd, 0x76, 0x4d, 0xa5, 0x56, 0xbd, 0x43, 0x3b,
-	0xb4, 0xce, 0xb1, 0x76, 0x57, 0xe7, 0x33, 0x3e, 0xe1, 0xa3, 0x60, 0x8d, 0x54, 0xee, 0x50, 0xda,
-	0x31, 0xc9, 0x11, 0x6b, 0xc7, 0xc5, 0x8e, 0x43, 0x5c, 0x2f, 0xc4, 0x25, 0x4f, 0xdd, 0x26, 0x16,
-	0xf6, 0x37, 0x51, 0xa9, 0x4b, 0x5a, 0x6c, 0xd7, 0x21, 0x3d, 0x6c, 0xfa, 0x08, 0x63, 0x2e, 0xb6,
-	0x3d, 0x87, 0xba, 0x2c, 0x84, 0x26, 0x8f, 0x20, 0x6f, 0xd7, 0x56, 0x83, 0xaf, 0xf2, 0xfb, 0x7d,
-	0x50, 0x6c, 0xae, 0xb7, 0x34, 0xdd, 0x6b, 0xed, 0xb8, 0x06, 0x23, 0x2f, 0x1a, 0x26, 0xd9, 0xc4,
-	0x2e, 0x5b, 0xc1, 0x0c, 0xa3, 0x0b, 0x30, 0xa2, 0x52, 0xdb, 0x63, 0x6e, 0x57, 0x65, 0xd4, 0x2d,
-	0x0a, 0x15, 0xa1, 0x3a, 0xde, 0x40, 0x35, 0x3f, 0xc2, 0xe6, 0xfa, 0xf2, 0x11, 0xa2, 0x1c, 0xa7,
-	0xa1, 0x22, 0x0c, 0xaa, 0x2e, 0xc1, 0xfe, 0x8a, 0xfe, 0x8a, 0x50, 0xed, 0x57, 0x7a, 0x53, 0x74,
-	0x16, 0x06, 0x75, 0xc3, 0x24, 0x2d, 0x43, 0x2b, 0x0e, 0x70, 0xa4, 0xe0, 0x4f, 0xd7, 0x34, 0x54,
-	0x82, 0x61, 0x0e, 0x38, 0xd8, 0x65, 0xc5, 0x7c, 0x45, 0xa8, 0xe6, 0x95, 0x21, 0x3d, 0x74, 0x82,
-	0x26, 0x21, 0xdf, 0xde, 0x65, 0xc4, 0x2b, 0x16, 0x2a, 0x42, 0x75, 0x54, 0x09, 0x26, 0x48, 0x84,
-	0xfe, 0xb6, 0xd1, 0x29, 0x0e, 0x56, 0x84, 0xea, 0x90, 0xe2, 0x0f, 0xd1, 0x45, 0x10, 0xb9, 0x08,
-	0xa3, 0x0c, 0x9b, 0x5c, 0xca, 0x2b, 0x0e, 0x55, 0x84, 0xea, 0x48, 0xa3, 0x54, 0x0b, 0x52, 0x5a,
-	0xeb, 0xa5, 0xb4, 0xb6, 0x66, 0xb3, 0xc5, 0xc6, 0x16, 0x36, 0xbb, 0x44, 0x19, 0xf7, 0x17, 0x35,
-	0xfd, 0x35, 0xfe, 0x6e, 0x9e, 0xfc, 0x81, 0x10, 0x65, 0xa4, 0xeb, 0x98, 0x14, 0x6b, 0x9b, 0xdb,
-	0x94, 0x51, 0x3f, 0x2f, 0x5b, 0x8d, 0xff, 0x3c, 0x23, 0xe7, 0x60, 0xc0, 0xdf, 0x9e, 0xa7, 0x63,
-	0xa4, 0x81, 0x6a, 0x16, 0xe3, 0x16, 0x6b, 0x6b, 0xb6, 0xd3, 0x65, 0xfe, 0x9e, 0x0a, 0xc7, 0xe5,
-	0xeb, 0x7d, 0x30, 0x17, 0x37, 0xe5, 0x52, 0x9e, 0xa0, 0x27, 0xef, 0x0d, 0x55, 0x21, 0x7f, 0xcd,
-	0xd0, 0x08, 0xe5, 0x07, 0x97, 0x4d, 0x0c, 0x08, 0x68, 0x09, 0xc6, 0xf9, 0xa0, 0xe5, 0x31, 0xec,
-	0xb2, 0x16, 0x0b, 0x8e, 0x74, 0xa4, 0x31, 0x93, 0x3a, 0x9f, 0x15, 0xda, 0x6d, 0x9b, 0x24, 0x38,
-	0xa0, 0x51, 0xbe, 0xe6, 0x55, 0x7f, 0x49, 0xd3, 0x93, 0x6f, 0x09, 0x30, 0x13, 0xcb, 0xc4, 0x45,
-	0x5b, 0x75, 0x77, 0x1d, 0x46, 0xb4, 0xc7, 0x94, 0x86, 0x7a, 0x2c, 0x0d, 0xa5, 0x78, 0x74, 0xb1,
-	0xcd, 0xc3, 0xb3, 0xba, 0x2b, 0xc0, 0x44, 0xe8, 0x50, 0xa3, 0x3b, 0xb6, 0xef, 0xd1, 0x47, 0xff,
-	0xa5, 0xb1, 0xe7, 0x61, 0xc8, 0xa4, 0x2a, 0x66, 0x06, 0xb5, 0xb9, 0xb3, 0x91, 0x86, 0x94, 0x4e,
-	0xf0, 0x7a, 0xc8, 0x50, 0x22, 0x2e, 0x9a, 0x82, 0x02, 0xd5, 0x75, 0x8f, 0x30, 0x6e, 0x3c, 0xaf,
-	0x84, 0x33, 0xff, 0x35, 0x99, 0x86, 0x65, 0xf4, 0x9e, 0x59, 0x30, 0x91, 0xf7, 0x04, 0x28, 0xc5,
-	0xb2, 0xba, 0x42, 0xd5, 0xae, 0x45, 0x6c, 0xf6, 0x98, 0x92, 0xfa, 0x1c, 0xe4, 0x2d, 0xa2, 0x19,
-	0x38, 0xcc, 0xea, 0x44, 0x3c, 0xa4, 0x4b, 0x3e, 0xa4, 0x04, 0x0c, 0xf9, 0xa6, 0x00, 0xb3, 0x31,
-	0x6b, 0xab, 0x86, 0xde, 0x73, 0xc7, 0x89, 0x4f, 0x95, 0xb9, 0x4b, 0xce, 0x85, 0xa7, 0xc6, 0xdc,
-	0x3d, 0x01, 0xa4, 0x98, 0xb9, 0xab, 0xd8, 0x34, 0x37, 0xb1, 0x43, 0xdc, 0x47, 0xb8, 0x8f, 0x8f,
-	0x5e, 0x2f, 0x4a, 0x30, 0x6c, 0x19, 0x56, 0xd0, 0xb7, 0xf8, 0x2d, 0x1c, 0x56, 0x86, 0xfc, 0x0f,
-	0xcd, 0x5d, 0x87, 0xa0, 0x79, 0xc8, 0x63, 0xcd, 0x32, 0xec, 0xb0, 0x32, 0x8c, 0x45, 0x2a, 0x4b,
-	0x94, 0x9a, 0x4a, 0x80, 0xc9, 0xbf, 0x0a, 0x30, 0x15, 0x0b, 0xac, 0xb9, 0x4d, 0x2c, 0xf2, 0x44,
-	0x83, 0xaa, 0x42, 0x9e, 0x6d, 0x77, 0xad, 0xf6, 0x69, 0x45, 0x90, 0x13, 0xe2, 0xe1, 0x17, 0x12,
-	0xe1, 0xf7, 0x1a, 0xa1, 0x8d, 0x2d, 0xc2, 0x7b, 0xdb, 0x70, 0xd0, 0x08, 0x37, 0xb0, 0x45, 0xce,
-	0x7f, 0xdd, 0x0f, 0x63, 0xb1, 0x20, 0xd0, 0x19, 0x18, 0x5b, 0x56, 0x96, 0x17, 0x1b, 0xad, 0x2b,
-	0x1b, 0x2f, 0x6f, 0x5c, 0xbe, 0xba, 0x21, 0xe6, 0xd0, 0x3c, 0x94, 0x82, 0x4f, 0x99, 0x2d, 0x5d,
-	0xbc, 0xfe, 0xd6, 0x27, 0x3f, 0x09, 0x71, 0x52, 0xaa, 0xcb, 0x89, 0x9f, 0xee, 0x7f, 0x71, 0xbb,
-	0x0f, 0xd5, 0x41, 0x4e, 0x91, 0x52, 0x5d, 0x47, 0xfc, 0xec, 0xab, 0xdf, 0xef, 0x3f, 0x3c, 0x3c,
-	0x3c, 0x3c, 0x14, 0xd0, 0xb3, 0x30, 0x97, 0x5c, 0x90, 0x28, 0xce, 0xe2, 0xfe, 0x3b, 0x3f, 0x7c,
-	0x3f, 0x88, 0xe6, 0x61, 0xea, 0x88, 0x78, 0xbc, 0x46, 0x8a, 0x77, 0xbe, 0xfc, 0xf6, 0xcd, 0x3f,
-	0x02, 0xb5, 0x73, 0x50, 0x4e, 0xaa, 0xc5, 0x8b, 0x92, 0xf8, 0xf9, 0xcf, 0xdf, 0xdd, 0x1d, 0x44,
-	0x55, 0xa8, 0x24, 0x79, 0xc9, 0x0a, 0x21, 0xbe, 0x71, 0xf3, 0xc6, 0x7b, 0x03, 0x68, 0x21, 0xcd,
-	0x4c, 0x3e, 0x57, 0x71, 0xef, 0xc1, 0x8d, 0x0f, 0xff, 0x0c, 0x0c, 0x9c, 0x87, 0xd9, 0x24, 0x3d,
-	0xf6, 0x80, 0xc4, 0x7b, 0xb7, 0xf7, 0xde, 0x7d, 0xd8, 0x33, 0x3b, 0x9d, 0xe4, 0x46, 0x77, 0x52,
-	0xfc, 0xe8, 0xd6, 0xc7, 0xbf, 0xfd, 0xc5, 0x79, 0xd2, 0xc0, 0xdb, 0xfb, 0xe5, 0x5c, 0xe3, 0x4e,
-	0x01, 0x0a, 0xca, 0xe6, 0xf2, 0x8a, 0xee, 0xa1, 0x55, 0xf8, 0x5f, 0xf6, 0x7f, 0xaf, 0xd9, 0xf0,
-	0xce, 0x66, 0x9f, 0xa3, 0x14, 0x7f, 0x18, 0x72, 0x0e, 0xbd, 0x14, 0x08, 0xa5, 0xff, 0xb2, 0xc4,
-	0x84, 0x52, 0xb0, 0x34, 0x1e, 0x09, 0xf1, 0xaf, 0x72, 0x0e, 0x6d, 0xc1, 0xcc, 0xa9, 0xff, 0x33,
-	0x9e, 0xc9, 0x10, 0x4c, 0xb1, 0x32, 0x74, 0x5f, 0x83, 0xe9, 0x93, 0xbb, 0xf6, 0xff, 0xd3, 0xa2,
-	0x09, 0x8a, 0x34, 0x15, 0x29, 0xc6, 0x10, 0x39, 0x87, 0x56, 0x40, 0x4c, 0x75, 0xdb, 0xe2, 0x71,
-	0xc1, 0xe3, 0x88, 0x34, 0x19, 0xe9, 0x04, 0xfb, 0xb4, 0x42, 0x95, 0x57, 0xa0, 0x78, 0x62, 0xff,
-	0xab, 0xa4, 0xed, 0xc5, 0x19, 0xd2, 0x99, 0x48, 0xb5, 0x07, 0xc8, 0x39, 0x74, 0x05, 0xa4, 0x53,
-	0xfa, 0x96, 0x9c, 0x16, 0x4d, 0x72, 0xfe, 0x81, 0x6c, 0xaa, 0xe3, 0x64, 0xc8, 0x26, 0x39, 0xd9,
-	0xb2, 0x97, 0xe1, 0xec, 0x49, 0xbd, 0x62, 0x2e, 0xad, 0x19, 0x23, 0x64, 0x0b, 0xae, 0xc2, 0x44,
-	0x56, 0x8d, 0x2e, 0xa5, 0xc5, 0x22, 0x30, 0x53, 0x68, 0xe9, 0x85, 0x07, 0xbf, 0x94, 0x85, 0x6f,
-	0x0e, 0xca, 0xc2, 0x8f, 0x07, 0x65, 0xe1, 0xfe, 0x41, 0x59, 0x78, 0xbd, 0xce, 0x08, 0xb6, 0x3a,
-	0x2e, 0xb6, 0x6a, 0x06, 0x8d, 0xc6, 0x0b, 0x1e, 0x71, 0xaf, 0x11, 0xb7, 0x8e, 0x1d, 0xa7, 0xee,
-	0x0f, 0x0d, 0x95, 0xd4, 0x35, 0xdd, 0xf3, 0x7f, 0xed, 0x02, 0x17, 0x5d, 0xfc, 0x3b, 0x00, 0x00,
-	0xff, 0xff, 0x9b, 0x66, 0x8b, 0x97, 0xa0, 0x0d, 0x00, 0x00,
+	// 1414 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0x4d, 0x6c, 0x1b, 0x45,
+	0x1b, 0xce, 0x36, 0xb6, 0x93, 0xbc, 0xf9, 0xf9, 0xb6, 0x93, 0x34, 0xd9, 0xac, 0x13, 0xd7, 0xdf,
+	0xe6, 0xfb, 0x5a, 0x7f, 0x95, 0x6a, 0x4b, 0x6e, 0xf5, 0x1d, 0xb8, 0x91, 0xa4, 0x7f, 0x34, 0x4d,
+	0xc3, 0x36, 0x4d, 0x11, 0x17, 0xb3, 0xf6, 0x8e, 0x9d, 0x55, 0xbd, 0x3b, 0xcb, 0xee, 0xb8, 0x55,
+	0x6e, 0xc0, 0xa1, 0x45, 0x48, 0x14, 0xa9, 0x05, 0xd1, 0x56, 0xa8, 0x2d, 0xf4, 0x0c, 0x52, 0xb9,
+	0x20, 0x71, 0xe0, 0xc0, 0x8f, 0x84, 0xc4, 0x4f, 0x11, 0x87, 0x0a, 0x09, 0xa9, 0x2a, 0x3d, 0x83,
+	0xd4, 0x03, 0x20, 0x40, 0x6d, 0x83, 0x76, 0x76, 0x6d, 0x67, 0x76, 0xd7, 0x05, 0x25, 0x54, 0xed,
+	0xc1, 0xd2, 0xce, 0x3c, 0xcf, 0x3c, 0xf3, 0xbc, 0xef, 0xfc, 0xbd, 0x86, 0x01, 0xbd, 0xea, 0xe6,
+	0x69, 0x3d, 0x6f, 0x3b, 0x84, 0x12, 0xd4, 0xad, 0x57, 0x5d, 0x79, 0x67, 0xcd, 0xa0, 0xcb, 0x8d,
+	0x72, 0xbe, 0x42, 0xcc, 0x42, 0x8d, 0xd4, 0x48, 0x81, 0x61, 0xe5, 0x46, 0x95, 0xb5, 0x58, 0x83,
+	0x7d, 0xf9, 0x63, 0xe4, 0x4c, 0x8d, 0x90, 0x5a, 0x1d, 0xb7, 0x59, 0x27, 0x1d, 0xcd, 0xb6, 0xb1,
+	0xe3, 0x06, 0xb8, 0xec, 0x56, 0x96, 0xb1, 0xa9, 0x79, 0x93, 0x54, 0x88, 0x83, 0x4b, 0x74, 0xc5,
+	0xc6, 0x4d, 0x6c, 0xbc, 0x8d, 0x51, 0x47, 0xb3, 0x5c, 0x9b, 0x38, 0x34, 0x80, 0x46, 0xda, 0x90,
+	0xbb, 0x62, 0x55, 0xfc, 0x5e, 0xe5, 0xb5, 0x4d, 0x20, 0x2d, 0xce, 0x95, 0xf4, 0xaa, 0x5b, 0x3a,
+	0xe9, 0x18, 0x14, 0xef, 0x35, 0xea, 0x78, 0x41, 0x73, 0xe8, 0xac, 0x46, 0x35, 0xb4, 0x1b, 0xfa,
+	0x2b, 0xc4, 0x72, 0xa9, 0xd3, 0xa8, 0x50, 0xe2, 0x48, 0x42, 0x56, 0xc8, 0x0d, 0x15, 0x51, 0xde,
+	0x8b, 0x70, 0x71, 0x6e, 0xa6, 0x8d, 0xa8, 0x6b, 0x69, 0x48, 0x82, 0x9e, 0x8a, 0x83, 0x35, 0x6f,
+	0x44, 0x77, 0x56, 0xc8, 0x75, 0xab, 0xcd, 0x26, 0x1a, 0x83, 0x9e, 0xaa, 0x51, 0xc7, 0x25, 0x43,
+	0x97, 0x12, 0x0c, 0x49, 0x79, 0xcd, 0x03, 0x3a, 0x4a, 0x43, 0x1f, 0x03, 0x6c, 0xcd, 0xa1, 0x52,
+	0x32, 0x2b, 0xe4, 0x92, 0x6a, 0x6f, 0x35, 0x70, 0x82, 0x46, 0x20, 0x59, 0x5e, 0xa1, 0xd8, 0x95,
+	0x52, 0x59, 0x21, 0x37, 0xa0, 0xfa, 0x0d, 0x24, 0x42, 0x77, 0xd9, 0xa8, 0x49, 0x3d, 0x59, 0x21,
+	0xd7, 0xab, 0x7a, 0x9f, 0x68, 0x0f, 0x88, 0x4c, 0x84, 0x12, 0xaa, 0xd5, 0x99, 0x94, 0x2b, 0xf5,
+	0x66, 0x85, 0x5c, 0x7f, 0x31, 0x9d, 0xf7, 0x53, 0x9a, 0x6f, 0xa6, 0x34, 0x7f, 0xc0, 0xa2, 0xbb,
+	0x8a, 0x4b, 0x5a, 0xbd, 0x81, 0xd5, 0x21, 0x6f, 0xd0, 0xa2, 0x37, 0xc6, 0x9b, 0xcd, 0x55, 0xce,
+	0x0a, 0xad, 0x8c, 0x34, 0xec, 0x3a, 0xd1, 0xf4, 0x85, 0x65, 0x42, 0x89, 0x97, 0x97, 0xa5, 0xe2,
+	0x3f, 0x9e, 0x91, 0x6d, 0x90, 0xf0, 0xa6, 0x67, 0xe9, 0xe8, 0x2f, 0xa2, 0xbc, 0x49, 0x99, 0xc5,
+	0xfc, 0x01, 0xcb, 0x6e, 0x50, 0x6f, 0x4e, 0x95, 0xe1, 0xca, 0xe9, 0x4d, 0xb0, 0x95, 0x37, 0xe5,
+	0x10, 0x96, 0xa0, 0x47, 0xef, 0x0d, 0xe5, 0x20, 0x79, 0xc2, 0xd0, 0x31, 0x91, 0x92, 0x1d, 0x89,
+	0x3e, 0x01, 0x4d, 0xc3, 0x10, 0xfb, 0x28, 0xb9, 0x54, 0x73, 0x68, 0x89, 0xfa, 0x4b, 0xda, 0x5f,
+	0x9c, 0x88, 0xac, 0xcf, 0x2c, 0x69, 0x94, 0xeb, 0xd8, 0x5f, 0xa0, 0x01, 0x36, 0xe6, 0x88, 0x37,
+	0x64, 0xd1, 0x55, 0x2e, 0x0b, 0x30, 0xc1, 0x65, 0x62, 0x8f, 0x55, 0x71, 0x56, 0x6c, 0x8a, 0xf5,
+	0x87, 0x94, 0x86, 0x02, 0x97, 0x86, 0x34, 0x1f, 0x1d, 0x37, 0x79, 0xb0, 0x56, 0xd7, 0x04, 0x18,
+	0x0e, 0x1c, 0xea, 0xe4, 0xa4, 0xe5, 0x79, 0xf4, 0xd0, 0x75, 0x1a, 0xfb, 0x3f, 0xf4, 0xd6, 0x49,
+	0x45, 0xa3, 0x06, 0xb1, 0x98, 0xb3, 0xfe, 0xa2, 0x1c, 0x4d, 0xf0, 0x5c, 0xc0, 0x50, 0x5b, 0x5c,
+	0x34, 0x0a, 0x29, 0x52, 0xad, 0xba, 0x98, 0x32, 0xe3, 0x49, 0x35, 0x68, 0x79, 0xa7, 0xa9, 0x6e,
+	0x98, 0x46, 0xf3, 0x98, 0xf9, 0x0d, 0xe5, 0xbc, 0x00, 0x69, 0x2e, 0xab, 0xb3, 0xa4, 0xd2, 0x30,
+	0xb1, 0x45, 0x1f, 0x52, 0x52, 0xff, 0x07, 0x49, 0x13, 0xeb, 0x86, 0x16, 0x64, 0x75, 0x98, 0x0f,
+	0xe9, 0x90, 0x07, 0xa9, 0x3e, 0x43, 0xb9, 0x28, 0xc0, 0x24, 0x67, 0x6d, 0x9f, 0x51, 0x6d, 0xba,
+	0x63, 0xc4, 0xc7, 0xca, 0xdc, 0x21, 0x7b, 0xf7, 0x63, 0x63, 0xee, 0x86, 0x00, 0x32, 0x67, 0xee,
+	0x98, 0x56, 0xaf, 0x2f, 0x68, 0x36, 0x76, 0x36, 0xb0, 0x1f, 0x37, 0x7e, 0x5f, 0xa4, 0xa1, 0xcf,
+	0x34, 0x4c, 0xff, 0xdd, 0x62, 0xbb, 0xb0, 0x4f, 0xed, 0xf5, 0x3a, 0x16, 0x57, 0x6c, 0x8c, 0xa6,
+	0x20, 0xa9, 0xe9, 0xa6, 0x61, 0x05, 0x37, 0xc3, 0x60, 0x4b, 0x65, 0x9a, 0x90, 0xba, 0xea, 0x63,
+	0xca, 0xcf, 0x02, 0x8c, 0x72, 0x81, 0x2d, 0x2e, 0x63, 0x13, 0x3f, 0xd2, 0xa0, 0x72, 0x90, 0xa4,
+	0xcb, 0x0d, 0xb3, 0xfc, 0xa0, 0x4b, 0x90, 0x11, 0xf8, 0xf0, 0x53, 0xa1, 0xf0, 0x9b, 0x0f, 0xa1,
+	0xa5, 0x99, 0x98, 0xbd, 0x6d, 0x7d, 0xfe, 0x43, 0x38, 0xaf, 0x99, 0x58, 0x79, 0x4b, 0x80, 0x91,
+	0x20, 0xec, 0x1a, 0x66, 0x9a, 0xfb, 0x35, 0x77, 0x19, 0xbb, 0x8f, 0xc7, 0xcd, 0xa2, 0x7c, 0x27,
+	0xc0, 0x96, 0x98, 0x7b, 0x6f, 0xdd, 0xb7, 0xc7, 0x14, 0x0c, 0x56, 0x74, 0xab, 0xe4, 0x36, 0x6c,
+	0xaf, 0x8a, 0xc1, 0x3a, 0x33, 0xd9, 0xab, 0x0e, 0x54, 0x74, 0xeb, 0x48, 0xb3, 0x8f, 0x0b, 0x22,
+	0xb1, 0xae, 0x20, 0x92, 0xf1, 0xd7, 0x63, 0x6a, 0xed, 0xf5, 0x78, 0xb6, 0xbd, 0xe1, 0x1c, 0xec,
+	0x6f, 0xb9, 0x19, 0xdd, 0xda, 0xc0, 0x86, 0x9b, 0x04, 0x08, 0x6a, 0x95, 0xe3, 0xd8, 0xcf, 0xfe,
+	0x80, 0xda, 0xe7, 0x17, 0x22, 0xc7, 0xb1, 0xe5, 0x85, 0xee, 0xe0, 0xe7, 0x1b, 0xd8, 0xa5, 0x01,
+	0x23, 0xc1, 0x18, 0x03, 0x41, 0x27, 0x23, 0x29, 0xa7, 0x04, 0x18, 0x6b, 0x6f, 0x87, 0xc0, 0xcf,
+	0x86, 0x76, 0xc4, 0x5f, 0xb8, 0xea, 0xb4, 0xf0, 0x07, 0x61, 0x98, 0xf3, 0x31, 0x43, 0xac, 0xaa,
+	0x51, 0x5b, 0x9f, 0x07, 0xe5, 0x09, 0xf8, 0xd7, 0x12, 0xf6, 0xbe, 0x4a, 0xcd, 0x70, 0xd0, 0x76,
+	0x48, 0xea, 0x1a, 0xd5, 0x5c, 0x49, 0xc8, 0x76, 0xe7, 0xfa, 0x8b, 0x9b, 0x5b, 0x0b, 0xdc, 0x64,
+	0xa8, 0x3e, 0xbe, 0xe3, 0x7e, 0x02, 0x06, 0x39, 0x69, 0xb4, 0x19, 0x06, 0x67, 0xd4, 0x99, 0x5d,
+	0xc5, 0xd2, 0xd1, 0xf9, 0x83, 0xf3, 0x87, 0x8f, 0xcd, 0x8b, 0x5d, 0x68, 0x0a, 0xd2, 0x7e, 0x57,
+	0x6c, 0xcd, 0x2b, 0x9e, 0x7e, 0xe9, 0xed, 0x6f, 0x05, 0x9e, 0x14, 0x29, 0x03, 0xc5, 0x77, 0xae,
+	0x7e, 0x72, 0x65, 0x13, 0x2a, 0x80, 0x12, 0x21, 0x45, 0xca, 0x32, 0xf1, 0xfd, 0xcf, 0x7e, 0xbd,
+	0x75, 0x77, 0x75, 0x75, 0x75, 0x55, 0x40, 0xdb, 0x61, 0x6b, 0x78, 0x40, 0xa8, 0x7a, 0x11, 0xaf,
+	0xbe, 0x72, 0xfd, 0xeb, 0x1e, 0x34, 0x05, 0xa3, 0x6d, 0xe2, 0xda, 0xc3, 0x24, 0xbe, 0xf7, 0xe9,
+	0x17, 0x2f, 0xfe, 0xe6, 0xab, 0x6d, 0x83, 0x4c, 0x58, 0x8d, 0x7f, 0xb5, 0xc5, 0x0f, 0xbe, 0xff,
+	0xea, 0x5a, 0x0f, 0xca, 0x41, 0x36, 0xcc, 0x0b, 0x3f, 0xa1, 0xe2, 0x0b, 0x17, 0xcf, 0x9d, 0x49,
+	0xa0, 0x9d, 0x51, 0x66, 0xf8, 0x3d, 0x13, 0xcf, 0xdf, 0x39, 0xf7, 0xc6, 0x1f, 0xbe, 0x81, 0x1d,
+	0x30, 0x19, 0xa6, 0x73, 0x2f, 0x8c, 0x78, 0xe3, 0xca, 0xf9, 0x57, 0xef, 0x36, 0xcd, 0x8e, 0x87,
+	0xb9, 0xad, 0x4b, 0x5b, 0x7c, 0xf3, 0xf2, 0x85, 0x5f, 0xee, 0xf9, 0xbc, 0x0c, 0x8c, 0xb5, 0x79,
+	0xdc, 0x2d, 0x27, 0xbe, 0xfe, 0xe1, 0x85, 0xe7, 0xd0, 0x7f, 0x41, 0x8a, 0xcf, 0xcc, 0x52, 0x51,
+	0xbc, 0x7e, 0xeb, 0xd4, 0xa5, 0xdf, 0x63, 0xa6, 0x0b, 0x1d, 0x59, 0xf1, 0xa3, 0xbb, 0xab, 0x67,
+	0xee, 0x35, 0x57, 0x44, 0xe6, 0xa6, 0xe3, 0x4e, 0x91, 0xf8, 0xee, 0x4f, 0x1f, 0x9f, 0xba, 0xdf,
+	0xf4, 0x35, 0x1a, 0x26, 0xfa, 0xdb, 0x5c, 0xbc, 0xf9, 0xe3, 0x97, 0x37, 0xbb, 0xe5, 0xc4, 0xcb,
+	0x57, 0x33, 0x5d, 0xc5, 0x4b, 0x7d, 0x90, 0x52, 0x17, 0x66, 0x66, 0xab, 0x2e, 0xda, 0x07, 0x5b,
+	0xe2, 0xff, 0x54, 0x4d, 0x06, 0x27, 0x20, 0x7e, 0xff, 0xc9, 0xfc, 0x8b, 0xa7, 0x74, 0xa1, 0xfd,
+	0xbe, 0x50, 0xf4, 0xbf, 0x08, 0x27, 0x14, 0x81, 0xe5, 0xa1, 0x96, 0x10, 0xeb, 0x55, 0xba, 0xd0,
+	0x12, 0x4c, 0x3c, 0xf0, 0x0f, 0xc4, 0x7f, 0x62, 0x04, 0x23, 0xac, 0x18, 0xdd, 0x67, 0x60, 0xbc,
+	0x73, 0x39, 0xfe, 0xef, 0xa8, 0x68, 0x88, 0x22, 0x8f, 0xb6, 0x14, 0x39, 0x44, 0xe9, 0x42, 0xb3,
+	0x20, 0x46, 0xca, 0x68, 0x69, 0xad, 0xe0, 0x5a, 0x44, 0x1e, 0x69, 0xe9, 0xf8, 0xf3, 0x94, 0x02,
+	0x95, 0xa7, 0x41, 0xea, 0x58, 0xd8, 0x66, 0xa3, 0xf6, 0x78, 0x86, 0xdc, 0xbe, 0x6e, 0x9a, 0x80,
+	0xd2, 0x85, 0x8e, 0x82, 0xfc, 0x80, 0x82, 0x54, 0x89, 0x8a, 0x86, 0x39, 0x7f, 0x43, 0x36, 0x52,
+	0x4a, 0xc6, 0xc8, 0x86, 0x39, 0xf1, 0xb2, 0x87, 0x61, 0xac, 0x53, 0x11, 0xb8, 0x35, 0xaa, 0xc9,
+	0x11, 0xe2, 0x05, 0xf7, 0xc1, 0x70, 0x5c, 0xf1, 0x95, 0x8e, 0x8a, 0xb5, 0xc0, 0x78, 0xa1, 0xbd,
+	0xb0, 0x39, 0x5a, 0xce, 0x8c, 0xaf, 0x95, 0xe1, 0x20, 0x79, 0x84, 0x41, 0xa1, 0x07, 0x82, 0x1d,
+	0x12, 0x14, 0x53, 0x77, 0xc8, 0x9d, 0xb6, 0xca, 0x52, 0xb1, 0xe3, 0x66, 0x79, 0x0a, 0x86, 0x63,
+	0xee, 0x0c, 0x3e, 0xb4, 0x10, 0xd8, 0xd1, 0xd5, 0x1c, 0x8c, 0xc4, 0xbe, 0xce, 0x13, 0xa1, 0x00,
+	0x39, 0xb4, 0xa3, 0xda, 0x34, 0x88, 0x6d, 0x7e, 0xf0, 0xc6, 0x4a, 0x51, 0x25, 0x1f, 0x91, 0xdb,
+	0x15, 0x68, 0xab, 0x4f, 0xe9, 0x9a, 0x7e, 0xf2, 0xce, 0x0f, 0x19, 0xe1, 0xf3, 0xdb, 0x19, 0xe1,
+	0x9b, 0xdb, 0x19, 0xe1, 0xd6, 0xed, 0x8c, 0xf0, 0x6c, 0x81, 0x62, 0xcd, 0xac, 0x39, 0x9a, 0x99,
+	0x37, 0x48, 0xeb, 0x7b, 0xa7, 0x8b, 0x9d, 0x13, 0xd8, 0x29, 0x68, 0xb6, 0x5d, 0xf0, 0x3e, 0x8d,
+	0x0a, 0x2e, 0xe8, 0x55, 0xd7, 0xfb, 0x95, 0x53, 0x4c, 0x75, 0xd7, 0x9f, 0x03, 0x00, 0x00, 0x09,
+	0x5d, 0x31, 0xe9, 0x12, 0x00, 0x00,
 }
 
 func (this *TLDfsWriteFilePartData) GoString() string {
@@ -1108,23 +1523,117 @@ func (this *TLDfsUploadThemeFile) GoString() string {
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
-func valueToGoStringDfsTl(v interface{}, typ string) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
+func (this *TLDfsGetFileHashes) GoString() string {
+	if this == nil {
 		return "nil"
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+	s := make([]string, 0, 7)
+	s = append(s, "&dfs.TLDfsGetFileHashes{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	if this.Location != nil {
+		s = append(s, "Location: "+fmt.Sprintf("%#v", this.Location)+",\n")
+	}
+	s = append(s, "Offset: "+fmt.Sprintf("%#v", this.Offset)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
+func (this *TLDfsDownloadFileV2) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 9)
+	s = append(s, "&dfs.TLDfsDownloadFileV2{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "CdnSupported: "+fmt.Sprintf("%#v", this.CdnSupported)+",\n")
+	if this.Location != nil {
+		s = append(s, "Location: "+fmt.Sprintf("%#v", this.Location)+",\n")
+	}
+	s = append(s, "Offset: "+fmt.Sprintf("%#v", this.Offset)+",\n")
+	s = append(s, "Limit: "+fmt.Sprintf("%#v", this.Limit)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLDfsReuploadCdnFile) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&dfs.TLDfsReuploadCdnFile{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "FileToken: "+fmt.Sprintf("%#v", this.FileToken)+",\n")
+	s = append(s, "RequestToken: "+fmt.Sprintf("%#v", this.RequestToken)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLDfsGetCdnFileHashes) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&dfs.TLDfsGetCdnFileHashes{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "FileToken: "+fmt.Sprintf("%#v", this.FileToken)+",\n")
+	s = append(s, "Offset: "+fmt.Sprintf("%#v", this.Offset)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLDfsGetCdnConfig) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&dfs.TLDfsGetCdnConfig{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *Vector_FileHash) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&dfs.Vector_FileHash{")
+	if this.Datas != nil {
+		s = append(s, "Datas: "+fmt.Sprintf("%#v", this.Datas)+",\n")
+	}
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringDfsTl(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
 // RPCDfsClient is the client API for RPCDfs service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
@@ -1149,6 +1658,16 @@ type RPCDfsClient interface {
 	DfsUploadWallPaperFile(ctx context.Context, in *TLDfsUploadWallPaperFile, opts ...grpc.CallOption) (*mtproto.Document, error)
 	// dfs.uploadThemeFile flags:# creator:long file:InputFile thumb:flags.0?InputFile mime_type:string file_name:string = Document;
 	DfsUploadThemeFile(ctx context.Context, in *TLDfsUploadThemeFile, opts ...grpc.CallOption) (*mtproto.Document, error)
+	// dfs.getFileHashes location:InputFileLocation offset:int = Vector<FileHash>;
+	DfsGetFileHashes(ctx context.Context, in *TLDfsGetFileHashes, opts ...grpc.CallOption) (*Vector_FileHash, error)
+	// dfs.downloadFileV2 flags:# cdn_supported:flags.0?true location:InputFileLocation offset:int limit:int = upload.File;
+	DfsDownloadFileV2(ctx context.Context, in *TLDfsDownloadFileV2, opts ...grpc.CallOption) (*mtproto.Upload_File, error)
+	// dfs.reuploadCdnFile file_token:bytes request_token:bytes = Vector<FileHash>;
+	DfsReuploadCdnFile(ctx context.Context, in *TLDfsReuploadCdnFile, opts ...grpc.CallOption) (*Vector_FileHash, error)
+	// dfs.getCdnFileHashes file_token:bytes offset:int = Vector<FileHash>;
+	DfsGetCdnFileHashes(ctx context.Context, in *TLDfsGetCdnFileHashes, opts ...grpc.CallOption) (*Vector_FileHash, error)
+	// dfs.getCdnConfig = CdnConfig;
+	DfsGetCdnConfig(ctx context.Context, in *TLDfsGetCdnConfig, opts ...grpc.CallOption) (*mtproto.CdnConfig, error)
 }
 
 type rPCDfsClient struct {
@@ -1249,6 +1768,51 @@ func (c *rPCDfsClient) DfsUploadThemeFile(ctx context.Context, in *TLDfsUploadTh
 	return out, nil
 }
 
+func (c *rPCDfsClient) DfsGetFileHashes(ctx context.Context, in *TLDfsGetFileHashes, opts ...grpc.CallOption) (*Vector_FileHash, error) {
+	out := new(Vector_FileHash)
+	err := c.cc.Invoke(ctx, "/dfs.RPCDfs/dfs_getFileHashes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPCDfsClient) DfsDownloadFileV2(ctx context.Context, in *TLDfsDownloadFileV2, opts ...grpc.CallOption) (*mtproto.Upload_File, error) {
+	out := new(mtproto.Upload_File)
+	err := c.cc.Invoke(ctx, "/dfs.RPCDfs/dfs_downloadFileV2", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPCDfsClient) DfsReuploadCdnFile(ctx context.Context, in *TLDfsReuploadCdnFile, opts ...grpc.CallOption) (*Vector_FileHash, error) {
+	out := new(Vector_FileHash)
+	err := c.cc.Invoke(ctx, "/dfs.RPCDfs/dfs_reuploadCdnFile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPCDfsClient) DfsGetCdnFileHashes(ctx context.Context, in *TLDfsGetCdnFileHashes, opts ...grpc.CallOption) (*Vector_FileHash, error) {
+	out := new(Vector_FileHash)
+	err := c.cc.Invoke(ctx, "/dfs.RPCDfs/dfs_getCdnFileHashes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPCDfsClient) DfsGetCdnConfig(ctx context.Context, in *TLDfsGetCdnConfig, opts ...grpc.CallOption) (*mtproto.CdnConfig, error) {
+	out := new(mtproto.CdnConfig)
+	err := c.cc.Invoke(ctx, "/dfs.RPCDfs/dfs_getCdnConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // RPCDfsServer is the server API for RPCDfs service.
 type RPCDfsServer interface {
 	// dfs.writeFilePartData flags:# creator:long file_id:long file_part:int bytes:bytes big:flags.0?true file_total_parts:flags.1?int = Bool;
@@ -1271,6 +1835,16 @@ type RPCDfsServer interface {
 	DfsUploadWallPaperFile(context.Context, *TLDfsUploadWallPaperFile) (*mtproto.Document, error)
 	// dfs.uploadThemeFile flags:# creator:long file:InputFile thumb:flags.0?InputFile mime_type:string file_name:string = Document;
 	DfsUploadThemeFile(context.Context, *TLDfsUploadThemeFile) (*mtproto.Document, error)
+	// dfs.getFileHashes location:InputFileLocation offset:int = Vector<FileHash>;
+	DfsGetFileHashes(context.Context, *TLDfsGetFileHashes) (*Vector_FileHash, error)
+	// dfs.downloadFileV2 flags:# cdn_supported:flags.0?true location:InputFileLocation offset:int limit:int = upload.File;
+	DfsDownloadFileV2(context.Context, *TLDfsDownloadFileV2) (*mtproto.Upload_File, error)
+	// dfs.reuploadCdnFile file_token:bytes request_token:bytes = Vector<FileHash>;
+	DfsReuploadCdnFile(context.Context, *TLDfsReuploadCdnFile) (*Vector_FileHash, error)
+	// dfs.getCdnFileHashes file_token:bytes offset:int = Vector<FileHash>;
+	DfsGetCdnFileHashes(context.Context, *TLDfsGetCdnFileHashes) (*Vector_FileHash, error)
+	// dfs.getCdnConfig = CdnConfig;
+	DfsGetCdnConfig(context.Context, *TLDfsGetCdnConfig) (*mtproto.CdnConfig, error)
 }
 
 // UnimplementedRPCDfsServer can be embedded to have forward compatible implementations.
@@ -1307,6 +1881,21 @@ func (*UnimplementedRPCDfsServer) DfsUploadWallPaperFile(ctx context.Context, re
 func (*UnimplementedRPCDfsServer) DfsUploadThemeFile(ctx context.Context, req *TLDfsUploadThemeFile) (*mtproto.Document, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DfsUploadThemeFile not implemented")
 }
+func (*UnimplementedRPCDfsServer) DfsGetFileHashes(ctx context.Context, req *TLDfsGetFileHashes) (*Vector_FileHash, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DfsGetFileHashes not implemented")
+}
+func (*UnimplementedRPCDfsServer) DfsDownloadFileV2(ctx context.Context, req *TLDfsDownloadFileV2) (*mtproto.Upload_File, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DfsDownloadFileV2 not implemented")
+}
+func (*UnimplementedRPCDfsServer) DfsReuploadCdnFile(ctx context.Context, req *TLDfsReuploadCdnFile) (*Vector_FileHash, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DfsReuploadCdnFile not implemented")
+}
+func (*UnimplementedRPCDfsServer) DfsGetCdnFileHashes(ctx context.Context, req *TLDfsGetCdnFileHashes) (*Vector_FileHash, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DfsGetCdnFileHashes not implemented")
+}
+func (*UnimplementedRPCDfsServer) DfsGetCdnConfig(ctx context.Context, req *TLDfsGetCdnConfig) (*mtproto.CdnConfig, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DfsGetCdnConfig not implemented")
+}
 
 func RegisterRPCDfsServer(s *grpc.Server, srv RPCDfsServer) {
 	s.RegisterService(&_RPCDfs_serviceDesc, srv)
@@ -1492,6 +2081,96 @@ func _RPCDfs_DfsUploadThemeFile_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RPCDfs_DfsGetFileHashes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLDfsGetFileHashes)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCDfsServer).DfsGetFileHashes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dfs.RPCDfs/DfsGetFileHashes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCDfsServer).DfsGetFileHashes(ctx, req.(*TLDfsGetFileHashes))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCDfs_DfsDownloadFileV2_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLDfsDownloadFileV2)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCDfsServer).DfsDownloadFileV2(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dfs.RPCDfs/DfsDownloadFileV2",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCDfsServer).DfsDownloadFileV2(ctx, req.(*TLDfsDownloadFileV2))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCDfs_DfsReuploadCdnFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLDfsReuploadCdnFile)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCDfsServer).DfsReuploadCdnFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dfs.RPCDfs/DfsReuploadCdnFile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCDfsServer).DfsReuploadCdnFile(ctx, req.(*TLDfsReuploadCdnFile))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCDfs_DfsGetCdnFileHashes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLDfsGetCdnFileHashes)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCDfsServer).DfsGetCdnFileHashes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dfs.RPCDfs/DfsGetCdnFileHashes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCDfsServer).DfsGetCdnFileHashes(ctx, req.(*TLDfsGetCdnFileHashes))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCDfs_DfsGetCdnConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLDfsGetCdnConfig)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCDfsServer).DfsGetCdnConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dfs.RPCDfs/DfsGetCdnConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCDfsServer).DfsGetCdnConfig(ctx, req.(*TLDfsGetCdnConfig))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _RPCDfs_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "dfs.RPCDfs",
 	HandlerType: (*RPCDfsServer)(nil),
@@ -1536,6 +2215,26 @@ var _RPCDfs_serviceDesc = grpc.ServiceDesc{
 			MethodName: "dfs_uploadThemeFile",
 			Handler:    _RPCDfs_DfsUploadThemeFile_Handler,
 		},
+		{
+			MethodName: "dfs_getFileHashes",
+			Handler:    _RPCDfs_DfsGetFileHashes_Handler,
+		},
+		{
+			MethodName: "dfs_downloadFileV2",
+			Handler:    _RPCDfs_DfsDownloadFileV2_Handler,
+		},
+		{
+			MethodName: "dfs_reuploadCdnFile",
+			Handler:    _RPCDfs_DfsReuploadCdnFile_Handler,
+		},
+		{
+			MethodName: "dfs_getCdnFileHashes",
+			Handler:    _RPCDfs_DfsGetCdnFileHashes_Handler,
+		},
+		{
+			MethodName: "dfs_getCdnConfig",
+			Handler:    _RPCDfs_DfsGetCdnConfig_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "dfs.tl.proto",
@@ -2132,41 +2831,317 @@ func (m *TLDfsUploadThemeFile) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintDfsTl(dAtA []byte, offset int, v uint64) int {
-	offset -= sovDfsTl(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *TLDfsGetFileHashes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *TLDfsWriteFilePartData) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *TLDfsGetFileHashes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLDfsGetFileHashes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Constructor != 0 {
-		n += 1 + sovDfsTl(uint64(m.Constructor))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Creator != 0 {
-		n += 1 + sovDfsTl(uint64(m.Creator))
+	if m.Offset != 0 {
+		i = encodeVarintDfsTl(dAtA, i, uint64(m.Offset))
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.FileId != 0 {
-		n += 1 + sovDfsTl(uint64(m.FileId))
+	if m.Location != nil {
+		{
+			size, err := m.Location.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDfsTl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.FilePart != 0 {
-		n += 1 + sovDfsTl(uint64(m.FilePart))
+	if m.Constructor != 0 {
+		i = encodeVarintDfsTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
 	}
-	l = len(m.Bytes)
-	if l > 0 {
-		n += 1 + l + sovDfsTl(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *TLDfsDownloadFileV2) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.Big {
-		n += 2
+	return dAtA[:n], nil
+}
+
+func (m *TLDfsDownloadFileV2) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLDfsDownloadFileV2) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Limit != 0 {
+		i = encodeVarintDfsTl(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Offset != 0 {
+		i = encodeVarintDfsTl(dAtA, i, uint64(m.Offset))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Location != nil {
+		{
+			size, err := m.Location.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDfsTl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.CdnSupported {
+		i--
+		if m.CdnSupported {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintDfsTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLDfsReuploadCdnFile) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLDfsReuploadCdnFile) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLDfsReuploadCdnFile) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.RequestToken) > 0 {
+		i -= len(m.RequestToken)
+		copy(dAtA[i:], m.RequestToken)
+		i = encodeVarintDfsTl(dAtA, i, uint64(len(m.RequestToken)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.FileToken) > 0 {
+		i -= len(m.FileToken)
+		copy(dAtA[i:], m.FileToken)
+		i = encodeVarintDfsTl(dAtA, i, uint64(len(m.FileToken)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintDfsTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLDfsGetCdnFileHashes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLDfsGetCdnFileHashes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLDfsGetCdnFileHashes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Offset != 0 {
+		i = encodeVarintDfsTl(dAtA, i, uint64(m.Offset))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.FileToken) > 0 {
+		i -= len(m.FileToken)
+		copy(dAtA[i:], m.FileToken)
+		i = encodeVarintDfsTl(dAtA, i, uint64(len(m.FileToken)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintDfsTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLDfsGetCdnConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLDfsGetCdnConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLDfsGetCdnConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintDfsTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Vector_FileHash) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Vector_FileHash) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Vector_FileHash) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Datas) > 0 {
+		for iNdEx := len(m.Datas) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Datas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDfsTl(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintDfsTl(dAtA []byte, offset int, v uint64) int {
+	offset -= sovDfsTl(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *TLDfsWriteFilePartData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovDfsTl(uint64(m.Constructor))
+	}
+	if m.Creator != 0 {
+		n += 1 + sovDfsTl(uint64(m.Creator))
+	}
+	if m.FileId != 0 {
+		n += 1 + sovDfsTl(uint64(m.FileId))
+	}
+	if m.FilePart != 0 {
+		n += 1 + sovDfsTl(uint64(m.FilePart))
+	}
+	l = len(m.Bytes)
+	if l > 0 {
+		n += 1 + l + sovDfsTl(uint64(l))
+	}
+	if m.Big {
+		n += 2
 	}
 	if m.FileTotalParts != nil {
 		l = m.FileTotalParts.Size()
@@ -2407,28 +3382,156 @@ func (m *TLDfsUploadThemeFile) Size() (n int) {
 	return n
 }
 
-func sovDfsTl(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozDfsTl(x uint64) (n int) {
-	return sovDfsTl(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *TLDfsGetFileHashes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovDfsTl(uint64(m.Constructor))
+	}
+	if m.Location != nil {
+		l = m.Location.Size()
+		n += 1 + l + sovDfsTl(uint64(l))
+	}
+	if m.Offset != 0 {
+		n += 1 + sovDfsTl(uint64(m.Offset))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
 }
-func (m *TLDfsWriteFilePartData) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowDfsTl
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
+
+func (m *TLDfsDownloadFileV2) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovDfsTl(uint64(m.Constructor))
+	}
+	if m.CdnSupported {
+		n += 2
+	}
+	if m.Location != nil {
+		l = m.Location.Size()
+		n += 1 + l + sovDfsTl(uint64(l))
+	}
+	if m.Offset != 0 {
+		n += 1 + sovDfsTl(uint64(m.Offset))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovDfsTl(uint64(m.Limit))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLDfsReuploadCdnFile) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovDfsTl(uint64(m.Constructor))
+	}
+	l = len(m.FileToken)
+	if l > 0 {
+		n += 1 + l + sovDfsTl(uint64(l))
+	}
+	l = len(m.RequestToken)
+	if l > 0 {
+		n += 1 + l + sovDfsTl(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLDfsGetCdnFileHashes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovDfsTl(uint64(m.Constructor))
+	}
+	l = len(m.FileToken)
+	if l > 0 {
+		n += 1 + l + sovDfsTl(uint64(l))
+	}
+	if m.Offset != 0 {
+		n += 1 + sovDfsTl(uint64(m.Offset))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLDfsGetCdnConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovDfsTl(uint64(m.Constructor))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Vector_FileHash) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Datas) > 0 {
+		for _, e := range m.Datas {
+			l = e.Size()
+			n += 1 + l + sovDfsTl(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovDfsTl(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozDfsTl(x uint64) (n int) {
+	return sovDfsTl(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *TLDfsWriteFilePartData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDfsTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
 				break
 			}
@@ -2501,9 +3604,616 @@ func (m *TLDfsWriteFilePartData) Unmarshal(dAtA []byte) error {
 			}
 		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FilePart", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FilePart", wireType)
+			}
+			m.FilePart = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDfsTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FilePart |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bytes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDfsTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDfsTl
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDfsTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bytes = append(m.Bytes[:0], dAtA[iNdEx:postIndex]...)
+			if m.Bytes == nil {
+				m.Bytes = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Big", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDfsTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Big = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FileTotalParts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDfsTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDfsTl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDfsTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FileTotalParts == nil {
+				m.FileTotalParts = &types.Int32Value{}
+			}
+			if err := m.FileTotalParts.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDfsTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDfsTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLDfsUploadPhotoFileV2) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDfsTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_dfs_uploadPhotoFileV2: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_dfs_uploadPhotoFileV2: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDfsTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			m.Creator = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDfsTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Creator |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDfsTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDfsTl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDfsTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.File == nil {
+				m.File = &mtproto.InputFile{}
+			}
+			if err := m.File.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDfsTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDfsTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLDfsUploadProfilePhotoFileV2) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDfsTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_dfs_uploadProfilePhotoFileV2: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_dfs_uploadProfilePhotoFileV2: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDfsTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			m.Creator = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDfsTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Creator |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDfsTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDfsTl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDfsTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.File == nil {
+				m.File = &mtproto.InputFile{}
+			}
+			if err := m.File.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Video", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDfsTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDfsTl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDfsTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Video == nil {
+				m.Video = &mtproto.InputFile{}
+			}
+			if err := m.Video.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VideoStartTs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDfsTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDfsTl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDfsTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VideoStartTs == nil {
+				m.VideoStartTs = &types.DoubleValue{}
+			}
+			if err := m.VideoStartTs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDfsTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDfsTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLDfsUploadEncryptedFileV2) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDfsTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_dfs_uploadEncryptedFileV2: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_dfs_uploadEncryptedFileV2: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDfsTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			m.Creator = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDfsTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Creator |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDfsTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDfsTl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDfsTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.File == nil {
+				m.File = &mtproto.InputEncryptedFile{}
+			}
+			if err := m.File.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDfsTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDfsTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLDfsDownloadFile) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDfsTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_dfs_downloadFile: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_dfs_downloadFile: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
 			}
-			m.FilePart = 0
+			m.Constructor = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDfsTl
@@ -2513,16 +4223,16 @@ func (m *TLDfsWriteFilePartData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.FilePart |= int32(b&0x7F) << shift
+				m.Constructor |= TLConstructor(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bytes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Location", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDfsTl
@@ -2532,31 +4242,33 @@ func (m *TLDfsWriteFilePartData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthDfsTl
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthDfsTl
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Bytes = append(m.Bytes[:0], dAtA[iNdEx:postIndex]...)
-			if m.Bytes == nil {
-				m.Bytes = []byte{}
+			if m.Location == nil {
+				m.Location = &mtproto.InputFileLocation{}
+			}
+			if err := m.Location.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Big", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
 			}
-			var v int
+			m.Offset = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDfsTl
@@ -2566,17 +4278,16 @@ func (m *TLDfsWriteFilePartData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Offset |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Big = bool(v != 0)
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FileTotalParts", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
 			}
-			var msglen int
+			m.Limit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDfsTl
@@ -2586,28 +4297,11 @@ func (m *TLDfsWriteFilePartData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Limit |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthDfsTl
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthDfsTl
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.FileTotalParts == nil {
-				m.FileTotalParts = &types.Int32Value{}
-			}
-			if err := m.FileTotalParts.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDfsTl(dAtA[iNdEx:])
@@ -2630,7 +4324,7 @@ func (m *TLDfsWriteFilePartData) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TLDfsUploadPhotoFileV2) Unmarshal(dAtA []byte) error {
+func (m *TLDfsUploadDocumentFileV2) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2653,10 +4347,10 @@ func (m *TLDfsUploadPhotoFileV2) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TL_dfs_uploadPhotoFileV2: wiretype end group for non-group")
+			return fmt.Errorf("proto: TL_dfs_uploadDocumentFileV2: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TL_dfs_uploadPhotoFileV2: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TL_dfs_uploadDocumentFileV2: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2699,7 +4393,7 @@ func (m *TLDfsUploadPhotoFileV2) Unmarshal(dAtA []byte) error {
 			}
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Media", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2726,10 +4420,10 @@ func (m *TLDfsUploadPhotoFileV2) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.File == nil {
-				m.File = &mtproto.InputFile{}
+			if m.Media == nil {
+				m.Media = &mtproto.InputMedia{}
 			}
-			if err := m.File.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Media.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2755,7 +4449,7 @@ func (m *TLDfsUploadPhotoFileV2) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TLDfsUploadProfilePhotoFileV2) Unmarshal(dAtA []byte) error {
+func (m *TLDfsUploadGifDocumentMedia) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2778,10 +4472,10 @@ func (m *TLDfsUploadProfilePhotoFileV2) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TL_dfs_uploadProfilePhotoFileV2: wiretype end group for non-group")
+			return fmt.Errorf("proto: TL_dfs_uploadGifDocumentMedia: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TL_dfs_uploadProfilePhotoFileV2: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TL_dfs_uploadGifDocumentMedia: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2824,79 +4518,7 @@ func (m *TLDfsUploadProfilePhotoFileV2) Unmarshal(dAtA []byte) error {
 			}
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDfsTl
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthDfsTl
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthDfsTl
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.File == nil {
-				m.File = &mtproto.InputFile{}
-			}
-			if err := m.File.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Video", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDfsTl
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthDfsTl
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthDfsTl
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Video == nil {
-				m.Video = &mtproto.InputFile{}
-			}
-			if err := m.Video.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VideoStartTs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Media", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2923,10 +4545,10 @@ func (m *TLDfsUploadProfilePhotoFileV2) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.VideoStartTs == nil {
-				m.VideoStartTs = &types.DoubleValue{}
+			if m.Media == nil {
+				m.Media = &mtproto.InputMedia{}
 			}
-			if err := m.VideoStartTs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Media.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2952,7 +4574,7 @@ func (m *TLDfsUploadProfilePhotoFileV2) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TLDfsUploadEncryptedFileV2) Unmarshal(dAtA []byte) error {
+func (m *TLDfsUploadMp4DocumentMedia) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2975,10 +4597,10 @@ func (m *TLDfsUploadEncryptedFileV2) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TL_dfs_uploadEncryptedFileV2: wiretype end group for non-group")
+			return fmt.Errorf("proto: TL_dfs_uploadMp4DocumentMedia: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TL_dfs_uploadEncryptedFileV2: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TL_dfs_uploadMp4DocumentMedia: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3021,7 +4643,7 @@ func (m *TLDfsUploadEncryptedFileV2) Unmarshal(dAtA []byte) error {
 			}
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Media", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3048,10 +4670,10 @@ func (m *TLDfsUploadEncryptedFileV2) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.File == nil {
-				m.File = &mtproto.InputEncryptedFile{}
+			if m.Media == nil {
+				m.Media = &mtproto.InputMedia{}
 			}
-			if err := m.File.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Media.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3077,7 +4699,7 @@ func (m *TLDfsUploadEncryptedFileV2) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TLDfsDownloadFile) Unmarshal(dAtA []byte) error {
+func (m *TLDfsUploadWallPaperFile) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3100,10 +4722,10 @@ func (m *TLDfsDownloadFile) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TL_dfs_downloadFile: wiretype end group for non-group")
+			return fmt.Errorf("proto: TL_dfs_uploadWallPaperFile: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TL_dfs_downloadFile: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TL_dfs_uploadWallPaperFile: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3126,8 +4748,27 @@ func (m *TLDfsDownloadFile) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			m.Creator = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDfsTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Creator |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Location", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3154,18 +4795,50 @@ func (m *TLDfsDownloadFile) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Location == nil {
-				m.Location = &mtproto.InputFileLocation{}
+			if m.File == nil {
+				m.File = &mtproto.InputFile{}
 			}
-			if err := m.Location.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.File.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MimeType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDfsTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDfsTl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDfsTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MimeType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
 			}
-			m.Offset = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDfsTl
@@ -3175,30 +4848,28 @@ func (m *TLDfsDownloadFile) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Offset |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthDfsTl
 			}
-			m.Limit = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDfsTl
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Limit |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDfsTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Admin == nil {
+				m.Admin = &mtproto.Bool{}
+			}
+			if err := m.Admin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDfsTl(dAtA[iNdEx:])
@@ -3221,7 +4892,7 @@ func (m *TLDfsDownloadFile) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TLDfsUploadDocumentFileV2) Unmarshal(dAtA []byte) error {
+func (m *TLDfsUploadThemeFile) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3244,10 +4915,10 @@ func (m *TLDfsUploadDocumentFileV2) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TL_dfs_uploadDocumentFileV2: wiretype end group for non-group")
+			return fmt.Errorf("proto: TL_dfs_uploadThemeFile: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TL_dfs_uploadDocumentFileV2: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TL_dfs_uploadThemeFile: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3290,7 +4961,7 @@ func (m *TLDfsUploadDocumentFileV2) Unmarshal(dAtA []byte) error {
 			}
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Media", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3317,13 +4988,113 @@ func (m *TLDfsUploadDocumentFileV2) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Media == nil {
-				m.Media = &mtproto.InputMedia{}
+			if m.File == nil {
+				m.File = &mtproto.InputFile{}
 			}
-			if err := m.Media.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.File.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Thumb", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDfsTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDfsTl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDfsTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Thumb == nil {
+				m.Thumb = &mtproto.InputFile{}
+			}
+			if err := m.Thumb.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MimeType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDfsTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDfsTl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDfsTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MimeType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FileName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDfsTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDfsTl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDfsTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FileName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDfsTl(dAtA[iNdEx:])
@@ -3346,7 +5117,7 @@ func (m *TLDfsUploadDocumentFileV2) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TLDfsUploadGifDocumentMedia) Unmarshal(dAtA []byte) error {
+func (m *TLDfsGetFileHashes) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3369,10 +5140,10 @@ func (m *TLDfsUploadGifDocumentMedia) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TL_dfs_uploadGifDocumentMedia: wiretype end group for non-group")
+			return fmt.Errorf("proto: TL_dfs_getFileHashes: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TL_dfs_uploadGifDocumentMedia: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TL_dfs_getFileHashes: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3395,27 +5166,8 @@ func (m *TLDfsUploadGifDocumentMedia) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
-			}
-			m.Creator = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDfsTl
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Creator |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Media", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Location", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3442,13 +5194,32 @@ func (m *TLDfsUploadGifDocumentMedia) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Media == nil {
-				m.Media = &mtproto.InputMedia{}
+			if m.Location == nil {
+				m.Location = &mtproto.InputFileLocation{}
 			}
-			if err := m.Media.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Location.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			}
+			m.Offset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDfsTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Offset |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDfsTl(dAtA[iNdEx:])
@@ -3471,7 +5242,7 @@ func (m *TLDfsUploadGifDocumentMedia) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TLDfsUploadMp4DocumentMedia) Unmarshal(dAtA []byte) error {
+func (m *TLDfsDownloadFileV2) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3494,10 +5265,10 @@ func (m *TLDfsUploadMp4DocumentMedia) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TL_dfs_uploadMp4DocumentMedia: wiretype end group for non-group")
+			return fmt.Errorf("proto: TL_dfs_downloadFileV2: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TL_dfs_uploadMp4DocumentMedia: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TL_dfs_downloadFileV2: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3521,9 +5292,9 @@ func (m *TLDfsUploadMp4DocumentMedia) Unmarshal(dAtA []byte) error {
 			}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CdnSupported", wireType)
 			}
-			m.Creator = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDfsTl
@@ -3533,14 +5304,15 @@ func (m *TLDfsUploadMp4DocumentMedia) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Creator |= int64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.CdnSupported = bool(v != 0)
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Media", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Location", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3567,13 +5339,51 @@ func (m *TLDfsUploadMp4DocumentMedia) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Media == nil {
-				m.Media = &mtproto.InputMedia{}
+			if m.Location == nil {
+				m.Location = &mtproto.InputFileLocation{}
 			}
-			if err := m.Media.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Location.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			}
+			m.Offset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDfsTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Offset |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDfsTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDfsTl(dAtA[iNdEx:])
@@ -3596,7 +5406,7 @@ func (m *TLDfsUploadMp4DocumentMedia) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TLDfsUploadWallPaperFile) Unmarshal(dAtA []byte) error {
+func (m *TLDfsReuploadCdnFile) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3619,10 +5429,10 @@ func (m *TLDfsUploadWallPaperFile) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TL_dfs_uploadWallPaperFile: wiretype end group for non-group")
+			return fmt.Errorf("proto: TL_dfs_reuploadCdnFile: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TL_dfs_uploadWallPaperFile: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TL_dfs_reuploadCdnFile: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3645,29 +5455,10 @@ func (m *TLDfsUploadWallPaperFile) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
-			}
-			m.Creator = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDfsTl
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Creator |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FileToken", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDfsTl
@@ -3677,65 +5468,31 @@ func (m *TLDfsUploadWallPaperFile) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthDfsTl
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthDfsTl
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.File == nil {
-				m.File = &mtproto.InputFile{}
-			}
-			if err := m.File.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MimeType", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDfsTl
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthDfsTl
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthDfsTl
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.FileToken = append(m.FileToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.FileToken == nil {
+				m.FileToken = []byte{}
 			}
-			m.MimeType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestToken", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDfsTl
@@ -3745,26 +5502,24 @@ func (m *TLDfsUploadWallPaperFile) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthDfsTl
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthDfsTl
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Admin == nil {
-				m.Admin = &mtproto.Bool{}
-			}
-			if err := m.Admin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.RequestToken = append(m.RequestToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.RequestToken == nil {
+				m.RequestToken = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -3789,7 +5544,7 @@ func (m *TLDfsUploadWallPaperFile) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TLDfsUploadThemeFile) Unmarshal(dAtA []byte) error {
+func (m *TLDfsGetCdnFileHashes) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3812,10 +5567,10 @@ func (m *TLDfsUploadThemeFile) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TL_dfs_uploadThemeFile: wiretype end group for non-group")
+			return fmt.Errorf("proto: TL_dfs_getCdnFileHashes: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TL_dfs_uploadThemeFile: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TL_dfs_getCdnFileHashes: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3838,29 +5593,10 @@ func (m *TLDfsUploadThemeFile) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
-			}
-			m.Creator = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDfsTl
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Creator |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FileToken", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDfsTl
@@ -3870,33 +5606,31 @@ func (m *TLDfsUploadThemeFile) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthDfsTl
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthDfsTl
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.File == nil {
-				m.File = &mtproto.InputFile{}
-			}
-			if err := m.File.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.FileToken = append(m.FileToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.FileToken == nil {
+				m.FileToken = []byte{}
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Thumb", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
 			}
-			var msglen int
+			m.Offset = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDfsTl
@@ -3906,33 +5640,67 @@ func (m *TLDfsUploadThemeFile) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Offset |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthDfsTl
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDfsTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthDfsTl
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Thumb == nil {
-				m.Thumb = &mtproto.InputFile{}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLDfsGetCdnConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDfsTl
 			}
-			if err := m.Thumb.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MimeType", wireType)
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			var stringLen uint64
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_dfs_getCdnConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_dfs_getCdnConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDfsTl
@@ -3942,29 +5710,67 @@ func (m *TLDfsUploadThemeFile) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Constructor |= TLConstructor(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthDfsTl
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDfsTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthDfsTl
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MimeType = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Vector_FileHash) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDfsTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Vector_FileHash: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Vector_FileHash: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FileName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Datas", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDfsTl
@@ -3974,23 +5780,25 @@ func (m *TLDfsUploadThemeFile) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthDfsTl
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthDfsTl
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FileName = string(dAtA[iNdEx:postIndex])
+			m.Datas = append(m.Datas, &mtproto.FileHash{})
+			if err := m.Datas[len(m.Datas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
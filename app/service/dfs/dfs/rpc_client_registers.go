@@ -36,6 +36,11 @@ var rpcContextRegisters = map[string]RPCContextTuple{
 	"TLDfsUploadMp4DocumentMedia":   RPCContextTuple{"/mtproto.RPCDfs/dfs_uploadMp4DocumentMedia", func() interface{} { return new(mtproto.Document) }},
 	"TLDfsUploadWallPaperFile":      RPCContextTuple{"/mtproto.RPCDfs/dfs_uploadWallPaperFile", func() interface{} { return new(mtproto.Document) }},
 	"TLDfsUploadThemeFile":          RPCContextTuple{"/mtproto.RPCDfs/dfs_uploadThemeFile", func() interface{} { return new(mtproto.Document) }},
+	"TLDfsGetFileHashes":            RPCContextTuple{"/mtproto.RPCDfs/dfs_getFileHashes", func() interface{} { return new(Vector_FileHash) }},
+	"TLDfsDownloadFileV2":           RPCContextTuple{"/mtproto.RPCDfs/dfs_downloadFileV2", func() interface{} { return new(mtproto.Upload_File) }},
+	"TLDfsReuploadCdnFile":          RPCContextTuple{"/mtproto.RPCDfs/dfs_reuploadCdnFile", func() interface{} { return new(Vector_FileHash) }},
+	"TLDfsGetCdnFileHashes":         RPCContextTuple{"/mtproto.RPCDfs/dfs_getCdnFileHashes", func() interface{} { return new(Vector_FileHash) }},
+	"TLDfsGetCdnConfig":             RPCContextTuple{"/mtproto.RPCDfs/dfs_getCdnConfig", func() interface{} { return new(mtproto.CdnConfig) }},
 }
 
 func FindRPCContextTuple(t interface{}) *RPCContextTuple {
@@ -11,6 +11,7 @@ package config
 
 import (
 	"github.com/teamgram/teamgram-server/app/service/dfs/internal/minio_util"
+	storage_conf "github.com/teamgram/teamgram-server/app/service/dfs/internal/storage/conf"
 	"github.com/zeromicro/go-zero/core/stores/cache"
 	"github.com/zeromicro/go-zero/core/stores/kv"
 	"github.com/zeromicro/go-zero/rest"
@@ -22,7 +23,20 @@ type Config struct {
 	zrpc.RpcServerConf
 	MiniHttp rest.RestConf
 	Cache    cache.CacheConf
-	Minio    minio_util.MinioConfig
-	IdGen    zrpc.RpcClientConf
-	SSDB     kv.KvConf
+	Storage  storage_conf.StorageConfig `json:",optional"`
+	// Minio deprecated, the s3 backend uses it if Storage.S3 is not set
+	Minio *minio_util.MinioConfig `json:",optional"`
+	IdGen zrpc.RpcClientConf
+	SSDB  kv.KvConf
+	Cdn   *CdnConfig `json:",optional"`
+}
+
+// CdnConfig the large documents are downloaded from the cdn dc by the clients
+// which support upload.fileCdnRedirect.
+type CdnConfig struct {
+	DcId int32
+	// PublicKey the RSA public key of the cdn dc gateway in PEM, returned by help.getCdnConfig
+	PublicKey   string
+	MinFileSize int64 `json:",default=10485760"`
+	CdnClient   zrpc.RpcClientConf
 }
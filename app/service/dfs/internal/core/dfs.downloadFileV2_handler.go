@@ -0,0 +1,75 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"fmt"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/dfs/dfs"
+	"github.com/teamgram/teamgram-server/app/service/dfs/internal/storage"
+)
+
+// DfsDownloadFileV2
+// dfs.downloadFileV2 flags:# cdn_supported:flags.0?true location:InputFileLocation offset:int limit:int = upload.File;
+func (c *DfsCore) DfsDownloadFileV2(in *dfs.TLDfsDownloadFileV2) (*mtproto.Upload_File, error) {
+	if in.GetCdnSupported() && c.svcCtx.Config.Cdn != nil {
+		if rValue := c.makeCdnFileRedirect(in.GetLocation()); rValue != nil {
+			return rValue, nil
+		}
+	}
+
+	return c.DfsDownloadFile(&dfs.TLDfsDownloadFile{
+		Location: in.GetLocation(),
+		Offset:   in.GetOffset(),
+		Limit:    in.GetLimit(),
+	})
+}
+
+// makeCdnFileRedirect returns upload.fileCdnRedirect for the documents larger than Cdn.MinFileSize,
+// or nil if the file is downloaded from the master dc.
+func (c *DfsCore) makeCdnFileRedirect(location *mtproto.InputFileLocation) *mtproto.Upload_File {
+	if location.GetPredicateName() != mtproto.Predicate_inputDocumentFileLocation ||
+		location.GetThumbSize() != "" {
+		return nil
+	}
+
+	var (
+		cdnConfig = c.svcCtx.Config.Cdn
+		bucket    = storage.BucketDocuments
+		path      = fmt.Sprintf("%d.dat", location.GetId())
+	)
+
+	// the files still in the upload cache are not in the storage yet
+	size, err := c.svcCtx.Dao.StatFile(c.ctx, bucket, path)
+	if err != nil || size < cdnConfig.MinFileSize {
+		return nil
+	}
+
+	cdnFile, err := c.svcCtx.Dao.GetOrMakeCdnFile(c.ctx, cdnConfig.DcId, bucket, path, location.GetId())
+	if err != nil {
+		c.Logger.Errorf("dfs.downloadFileV2 - error: %v", err)
+		return nil
+	}
+
+	hashes, err := c.svcCtx.Dao.GetOrMakeFileHashes(c.ctx, bucket, path, location.GetId())
+	if err != nil {
+		c.Logger.Errorf("dfs.downloadFileV2 - error: %v", err)
+		return nil
+	}
+
+	return mtproto.MakeTLUploadFileCdnRedirect(&mtproto.Upload_File{
+		DcId:          cdnFile.DcId,
+		FileToken:     cdnFile.FileToken,
+		EncryptionKey: cdnFile.EncryptionKey,
+		EncryptionIv:  cdnFile.EncryptionIv,
+		FileHashes:    makeFileHashList(hashes, 0),
+	}).To_Upload_File()
+}
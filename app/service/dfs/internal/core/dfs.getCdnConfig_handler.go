@@ -0,0 +1,32 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/dfs/dfs"
+)
+
+// DfsGetCdnConfig
+// dfs.getCdnConfig = CdnConfig;
+func (c *DfsCore) DfsGetCdnConfig(in *dfs.TLDfsGetCdnConfig) (*mtproto.CdnConfig, error) {
+	rValue := mtproto.MakeTLCdnConfig(&mtproto.CdnConfig{
+		PublicKeys: []*mtproto.CdnPublicKey{},
+	}).To_CdnConfig()
+
+	if cdnConfig := c.svcCtx.Config.Cdn; cdnConfig != nil {
+		rValue.PublicKeys = append(rValue.PublicKeys, mtproto.MakeTLCdnPublicKey(&mtproto.CdnPublicKey{
+			DcId:      cdnConfig.DcId,
+			PublicKey: cdnConfig.PublicKey,
+		}).To_CdnPublicKey())
+	}
+
+	return rValue, nil
+}
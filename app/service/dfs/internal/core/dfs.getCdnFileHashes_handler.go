@@ -0,0 +1,46 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/interface/cdn/cdn"
+	"github.com/teamgram/teamgram-server/app/service/dfs/dfs"
+)
+
+// DfsGetCdnFileHashes
+// dfs.getCdnFileHashes file_token:bytes offset:int = Vector<FileHash>;
+func (c *DfsCore) DfsGetCdnFileHashes(in *dfs.TLDfsGetCdnFileHashes) (*dfs.Vector_FileHash, error) {
+	if in.GetOffset() < 0 {
+		err := mtproto.ErrOffsetInvalid
+		c.Logger.Errorf("dfs.getCdnFileHashes - error: %v", err)
+		return nil, err
+	}
+
+	cdnFile, err := c.svcCtx.Dao.GetCdnFile(c.ctx, in.GetFileToken())
+	if err != nil {
+		c.Logger.Errorf("dfs.getCdnFileHashes - error: %v", err)
+		return nil, err
+	} else if cdnFile == nil {
+		err = cdn.ErrFileTokenInvalid
+		c.Logger.Errorf("dfs.getCdnFileHashes - error: %v", err)
+		return nil, err
+	}
+
+	hashes, err := c.svcCtx.Dao.GetOrMakeFileHashes(c.ctx, cdnFile.Bucket, cdnFile.Path, cdnFile.CacheId)
+	if err != nil {
+		c.Logger.Errorf("dfs.getCdnFileHashes - error: %v", err)
+		return nil, err
+	}
+
+	return &dfs.Vector_FileHash{
+		Datas: makeFileHashList(hashes, in.GetOffset()),
+	}, nil
+}
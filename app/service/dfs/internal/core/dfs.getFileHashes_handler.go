@@ -0,0 +1,109 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"crypto/sha256"
+	"fmt"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/dfs/dfs"
+	"github.com/teamgram/teamgram-server/app/service/dfs/internal/dao"
+	"github.com/teamgram/teamgram-server/app/service/dfs/internal/storage"
+)
+
+const (
+	// fileHashesLimit hashes of 1 MB are returned every call
+	fileHashesLimit = 8
+)
+
+// DfsGetFileHashes
+// dfs.getFileHashes location:InputFileLocation offset:int = Vector<FileHash>;
+func (c *DfsCore) DfsGetFileHashes(in *dfs.TLDfsGetFileHashes) (*dfs.Vector_FileHash, error) {
+	var (
+		bucket  string
+		path    string
+		cacheId int64
+
+		location = in.GetLocation()
+		offset   = in.GetOffset()
+	)
+
+	if offset < 0 {
+		err := mtproto.ErrOffsetInvalid
+		c.Logger.Errorf("dfs.getFileHashes - error: %v", err)
+		return nil, err
+	}
+
+	switch location.GetPredicateName() {
+	case mtproto.Predicate_inputEncryptedFileLocation:
+		bucket = storage.BucketEncryptedFiles
+		path = fmt.Sprintf("%d.dat", location.GetId())
+		cacheId = location.GetId()
+	case mtproto.Predicate_inputDocumentFileLocation:
+		if location.GetThumbSize() == "" {
+			bucket = storage.BucketDocuments
+			path = fmt.Sprintf("%d.dat", location.GetId())
+			cacheId = location.GetId()
+		} else {
+			if mtproto.PhotoSizeIsVideo(location.GetThumbSize()) {
+				bucket = storage.BucketVideos
+			} else {
+				bucket = storage.BucketPhotos
+			}
+			path = fmt.Sprintf("%s/%d.dat", location.GetThumbSize(), location.GetId())
+		}
+	case mtproto.Predicate_inputPhotoFileLocation:
+		if mtproto.PhotoSizeIsVideo(location.GetThumbSize()) {
+			bucket = storage.BucketVideos
+		} else {
+			bucket = storage.BucketPhotos
+		}
+		path = fmt.Sprintf("%s/%d.dat", location.GetThumbSize(), location.GetId())
+	case mtproto.Predicate_inputPeerPhotoFileLocation:
+		bucket = storage.BucketPhotos
+		if location.GetBig() {
+			path = fmt.Sprintf("c/%d.dat", location.GetPhotoId())
+		} else {
+			path = fmt.Sprintf("a/%d.dat", location.GetPhotoId())
+		}
+	case mtproto.Predicate_inputStickerSetThumb:
+		bucket = storage.BucketPhotos
+		path = fmt.Sprintf("m/%d.dat", location.GetId())
+	default:
+		err := mtproto.ErrInputRequestInvalid
+		c.Logger.Errorf("dfs.getFileHashes - error: %v", err)
+		return nil, err
+	}
+
+	hashes, err := c.svcCtx.Dao.GetOrMakeFileHashes(c.ctx, bucket, path, cacheId)
+	if err != nil {
+		c.Logger.Errorf("dfs.getFileHashes - error: %v", err)
+		return nil, err
+	}
+
+	return &dfs.Vector_FileHash{
+		Datas: makeFileHashList(hashes, offset),
+	}, nil
+}
+
+// makeFileHashList returns the hashes of 1 MB from offset.
+func makeFileHashList(hashes []byte, offset int32) []*mtproto.FileHash {
+	fileHashes := make([]*mtproto.FileHash, 0, fileHashesLimit)
+	for i := int(offset / dao.FileHashPartSize); i*sha256.Size < len(hashes) && len(fileHashes) < fileHashesLimit; i++ {
+		fileHashes = append(fileHashes, mtproto.MakeTLFileHash(&mtproto.FileHash{
+			Offset: int32(i * dao.FileHashPartSize),
+			Limit:  dao.FileHashPartSize,
+			Hash:   hashes[i*sha256.Size : (i+1)*sha256.Size],
+		}).To_FileHash())
+	}
+
+	return fileHashes
+}
@@ -0,0 +1,67 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"github.com/teamgram/teamgram-server/app/interface/cdn/cdn"
+	"github.com/teamgram/teamgram-server/app/service/dfs/dfs"
+)
+
+// DfsReuploadCdnFile
+// dfs.reuploadCdnFile file_token:bytes request_token:bytes = Vector<FileHash>;
+func (c *DfsCore) DfsReuploadCdnFile(in *dfs.TLDfsReuploadCdnFile) (*dfs.Vector_FileHash, error) {
+	if c.svcCtx.Config.Cdn == nil {
+		err := cdn.ErrFileTokenInvalid
+		c.Logger.Errorf("dfs.reuploadCdnFile - error: %v", err)
+		return nil, err
+	}
+
+	cdnFile, err := c.svcCtx.Dao.GetCdnFile(c.ctx, in.GetFileToken())
+	if err != nil {
+		c.Logger.Errorf("dfs.reuploadCdnFile - error: %v", err)
+		return nil, err
+	} else if cdnFile == nil {
+		err = cdn.ErrFileTokenInvalid
+		c.Logger.Errorf("dfs.reuploadCdnFile - error: %v", err)
+		return nil, err
+	}
+
+	offset, err := cdn.ParseRequestToken(in.GetRequestToken())
+	if err != nil {
+		c.Logger.Errorf("dfs.reuploadCdnFile - error: %v", err)
+		return nil, err
+	}
+
+	bytes, err := c.svcCtx.Dao.ReadCdnFilePart(c.ctx, cdnFile, offset, cdn.CdnFilePartSize)
+	if err != nil {
+		c.Logger.Errorf("dfs.reuploadCdnFile - error: %v", err)
+		return nil, err
+	}
+
+	_, err = c.svcCtx.Dao.CdnUploadCdnFilePart(c.ctx, &cdn.TLCdnUploadCdnFilePart{
+		FileToken: cdnFile.FileToken,
+		Offset:    offset,
+		Bytes:     bytes,
+	})
+	if err != nil {
+		c.Logger.Errorf("dfs.reuploadCdnFile - error: %v", err)
+		return nil, err
+	}
+
+	hashes, err := c.svcCtx.Dao.GetOrMakeFileHashes(c.ctx, cdnFile.Bucket, cdnFile.Path, cdnFile.CacheId)
+	if err != nil {
+		c.Logger.Errorf("dfs.reuploadCdnFile - error: %v", err)
+		return nil, err
+	}
+
+	return &dfs.Vector_FileHash{
+		Datas: makeFileHashList(hashes, offset),
+	}, nil
+}
@@ -10,16 +10,21 @@
 package core
 
 import (
-	"bytes"
 	"fmt"
 	"image"
+	"math"
 	"math/rand"
 
 	"github.com/teamgram/marmota/pkg/bytes2"
 	"github.com/teamgram/proto/mtproto"
 	"github.com/teamgram/teamgram-server/app/service/dfs/dfs"
+	"github.com/teamgram/teamgram-server/app/service/dfs/internal/dao"
 	"github.com/teamgram/teamgram-server/app/service/dfs/internal/imaging"
 	"github.com/teamgram/teamgram-server/app/service/dfs/internal/model"
+	"github.com/teamgram/teamgram-server/app/service/dfs/internal/storage"
+
+	"github.com/zeromicro/go-zero/core/contextx"
+	"github.com/zeromicro/go-zero/core/threading"
 )
 
 // DfsUploadDocumentFileV2
@@ -28,8 +33,7 @@ func (c *DfsCore) DfsUploadDocumentFileV2(in *dfs.TLDfsUploadDocumentFileV2) (*m
 	var (
 		documentId = c.svcCtx.Dao.IDGenClient2.NextId(c.ctx)
 		//idgen.GetUUID()
-		file      = in.GetMedia().GetFile()
-		cacheData []byte
+		file = in.GetMedia().GetFile()
 	)
 
 	// 有点难理解，主要是为了不在这里引入snowflake
@@ -67,6 +71,12 @@ func (c *DfsCore) DfsUploadDocumentFileV2(in *dfs.TLDfsUploadDocumentFileV2) (*m
 		}
 	}
 
+	attributes, err = c.checkStickerFile(r, in.GetMedia().GetMimeType(), attributes)
+	if err != nil {
+		c.Logger.Errorf("dfs.uploadDocumentFile - error: %v", err)
+		return nil, err
+	}
+
 	// document#1e87342b flags:#
 	//	id:long
 	//	access_hash:long
@@ -100,18 +110,9 @@ func (c *DfsCore) DfsUploadDocumentFileV2(in *dfs.TLDfsUploadDocumentFileV2) (*m
 			// secretId = int64(extType2)<<32 | int64(rand.Uint32())
 		)
 
-		cacheData, err = r.ReadAll(c.ctx)
+		// build photoStrippedSize, the image is decoded from the upload cache part by part
+		thumb, err = imaging.Decode(c.svcCtx.Dao.NewSSDBReader(r.DfsFileInfo))
 		if err != nil {
-			c.Logger.Errorf("dfs.uploadDocumentFile - %v", err)
-			return nil, mtproto.ErrWallpaperFileInvalid
-		} else {
-			// log.Debugf("cacheData: %s", hex.EncodeToString(cacheData))
-		}
-
-		// build photoStrippedSize
-		thumb, err = imaging.Decode(bytes.NewReader(cacheData))
-		if err != nil {
-			// ioutil.WriteFile("./t.jpg", cacheData, 0644)
 			c.Logger.Errorf("dfs.uploadDocumentFile - error: %v", err)
 			return nil, err
 
@@ -129,7 +130,7 @@ func (c *DfsCore) DfsUploadDocumentFileV2(in *dfs.TLDfsUploadDocumentFileV2) (*m
 
 		// upload thumb
 		var (
-			mThumbData = bytes2.NewBuffer(make([]byte, 0, len(cacheData)))
+			mThumbData = bytes2.NewBuffer(make([]byte, 0, 64*1024))
 			mThumb     image.Image
 		)
 		if thumb.Bounds().Dx() >= thumb.Bounds().Dy() {
@@ -165,25 +166,83 @@ func (c *DfsCore) DfsUploadDocumentFileV2(in *dfs.TLDfsUploadDocumentFileV2) (*m
 		}
 	}
 
-	defer func() {
-		go func() {
-			if mtproto.IsMimeAcceptedForPhotoVideoAlbum(document.MimeType) && model.IsFileExtImage(ext) {
-				_, err2 := c.svcCtx.Dao.PutDocumentFile(c.ctx,
-					fmt.Sprintf("%d.dat", documentId),
-					bytes.NewReader(cacheData))
-				if err2 != nil {
-					c.Logger.Errorf("dfs.uploadDocumentFile - error: %v", err2)
-				}
-			} else {
-				_, err2 := c.svcCtx.Dao.PutDocumentFile(c.ctx,
-					fmt.Sprintf("%d.dat", documentId),
-					c.svcCtx.Dao.NewSSDBReader(r.DfsFileInfo))
-				if err2 != nil {
-					c.Logger.Errorf("dfs.uploadDocumentFile - error: %v", err2)
-				}
-			}
-		}()
-	}()
+	threading.GoSafe(func() {
+		_, err2 := c.svcCtx.Dao.PutUploadFile(
+			contextx.ValueOnlyFrom(c.ctx),
+			storage.BucketDocuments,
+			fmt.Sprintf("%d.dat", documentId),
+			r.DfsFileInfo,
+			file.GetMd5Checksum())
+		if err2 != nil {
+			c.Logger.Errorf("dfs.uploadDocumentFile - error: %v", err2)
+		}
+	})
 
 	return document, nil
 }
+
+// checkStickerFile validates the animated (.tgs) and the video (.webm) stickers,
+// and adds the size attributes the clients expect on them.
+func (c *DfsCore) checkStickerFile(r *dao.SSDBReader, mimeType string, attributes []*mtproto.DocumentAttribute) ([]*mtproto.DocumentAttribute, error) {
+	var (
+		isSticker bool
+		info      *model.StickerFileInfo
+	)
+
+	// the attribute read from the file replaces the one sent by the client
+	setAttribute := func(attr *mtproto.DocumentAttribute) {
+		for i, v := range attributes {
+			if v.GetPredicateName() == attr.GetPredicateName() {
+				attributes[i] = attr
+				return
+			}
+		}
+		attributes = append(attributes, attr)
+	}
+
+	for _, attr := range attributes {
+		if attr.GetPredicateName() == mtproto.Predicate_documentAttributeSticker {
+			isSticker = true
+			break
+		}
+	}
+	if !isSticker {
+		return attributes, nil
+	}
+
+	switch mimeType {
+	case "application/x-tgsticker":
+		if r.DfsFileInfo.GetFileSize() > model.TgsStickerMaxSize {
+			return nil, mtproto.ErrStickerFileInvalid
+		}
+		data, err := r.ReadAll(c.ctx)
+		if err != nil {
+			return nil, mtproto.ErrStickerFileInvalid
+		}
+		if info, err = model.CheckTgsSticker(data); err != nil {
+			return nil, err
+		}
+		setAttribute(mtproto.MakeTLDocumentAttributeImageSize(&mtproto.DocumentAttribute{
+			W: info.W,
+			H: info.H,
+		}).To_DocumentAttribute())
+	case "video/webm":
+		if r.DfsFileInfo.GetFileSize() > model.WebmStickerMaxSize {
+			return nil, model.ErrStickerVideoBig
+		}
+		data, err := r.ReadAll(c.ctx)
+		if err != nil {
+			return nil, mtproto.ErrStickerFileInvalid
+		}
+		if info, err = model.CheckWebmSticker(data); err != nil {
+			return nil, err
+		}
+		setAttribute(mtproto.MakeTLDocumentAttributeVideo(&mtproto.DocumentAttribute{
+			Duration: int32(math.Ceil(info.Duration)),
+			W:        info.W,
+			H:        info.H,
+		}).To_DocumentAttribute())
+	}
+
+	return attributes, nil
+}
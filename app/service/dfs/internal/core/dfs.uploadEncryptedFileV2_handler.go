@@ -15,6 +15,10 @@ import (
 
 	"github.com/teamgram/proto/mtproto"
 	"github.com/teamgram/teamgram-server/app/service/dfs/dfs"
+	"github.com/teamgram/teamgram-server/app/service/dfs/internal/storage"
+
+	"github.com/zeromicro/go-zero/core/contextx"
+	"github.com/zeromicro/go-zero/core/threading"
 )
 
 // DfsUploadEncryptedFileV2
@@ -35,12 +39,17 @@ func (c *DfsCore) DfsUploadEncryptedFileV2(in *dfs.TLDfsUploadEncryptedFileV2) (
 	c.svcCtx.Dao.SetCacheFileInfo(c.ctx, encryptedFileId, fileInfo)
 	path := fmt.Sprintf("%d.dat", encryptedFileId)
 
-	go func() {
-		_, err2 := c.svcCtx.Dao.PutEncryptedFile(c.ctx, path, c.svcCtx.Dao.NewSSDBReader(fileInfo))
+	threading.GoSafe(func() {
+		_, err2 := c.svcCtx.Dao.PutUploadFile(
+			contextx.ValueOnlyFrom(c.ctx),
+			storage.BucketEncryptedFiles,
+			path,
+			fileInfo,
+			file.GetMd5Checksum())
 		if err2 != nil {
-			c.Logger.Errorf("dfs.uploadEncryptedFile - error: %v", err)
+			c.Logger.Errorf("dfs.uploadEncryptedFile - error: %v", err2)
 		}
-	}()
+	})
 
 	encryptedFile := mtproto.MakeTLEncryptedFile(&mtproto.EncryptedFile{
 		Id:             encryptedFileId,
@@ -21,6 +21,7 @@ import (
 	"github.com/teamgram/teamgram-server/app/service/dfs/dfs"
 	"github.com/teamgram/teamgram-server/app/service/dfs/internal/imaging"
 	"github.com/teamgram/teamgram-server/app/service/dfs/internal/model"
+	"github.com/teamgram/teamgram-server/app/service/dfs/internal/storage"
 
 	"github.com/zeromicro/go-zero/core/contextx"
 	"github.com/zeromicro/go-zero/core/threading"
@@ -66,12 +67,14 @@ func (c *DfsCore) DfsUploadMp4DocumentMedia(in *dfs.TLDfsUploadMp4DocumentMedia)
 		path = fmt.Sprintf("%d.dat", documentId)
 
 		threading.RunSafe(func() {
-			_, err2 := c.svcCtx.Dao.PutDocumentFile(
+			_, err2 := c.svcCtx.Dao.PutUploadFile(
 				contextx.ValueOnlyFrom(c.ctx),
+				storage.BucketDocuments,
 				path,
-				c.svcCtx.Dao.NewSSDBReader(fileInfo))
+				fileInfo,
+				file.GetMd5Checksum())
 			if err2 != nil {
-				c.Logger.Errorf("dfs.PutDocumentFile - error: %v", err)
+				c.Logger.Errorf("dfs.PutUploadFile - error: %v", err2)
 			}
 		})
 		//c.Logger.Errorf("getFirstFrameByPipe - error: %v", err)
@@ -157,12 +160,14 @@ func (c *DfsCore) DfsUploadMp4DocumentMedia(in *dfs.TLDfsUploadMp4DocumentMedia)
 		path = fmt.Sprintf("%d.dat", documentId)
 
 		threading.RunSafe(func() {
-			_, err2 := c.svcCtx.Dao.PutDocumentFile(
+			_, err2 := c.svcCtx.Dao.PutUploadFile(
 				contextx.ValueOnlyFrom(c.ctx),
+				storage.BucketDocuments,
 				path,
-				c.svcCtx.Dao.NewSSDBReader(fileInfo))
+				fileInfo,
+				file.GetMd5Checksum())
 			if err2 != nil {
-				c.Logger.Errorf("dfs.PutDocumentFile - error: %v", err)
+				c.Logger.Errorf("dfs.PutUploadFile - error: %v", err2)
 			}
 		})
 
@@ -12,6 +12,7 @@ package core
 import (
 	"crypto/md5"
 	"fmt"
+	"io"
 	"math/rand"
 	"time"
 
@@ -87,14 +88,14 @@ func (c *DfsCore) uploadVideoSizeListV2(creatorId int64, video *mtproto.InputFil
 		return nil, mtproto.ErrMediaInvalid
 	}
 
-	videoMp4Data, err = r.ReadAll(c.ctx)
-	if err != nil {
-		c.Logger.Errorf("dfs.uploadVideoSizeList - %v", err)
-		return nil, mtproto.ErrMediaInvalid
-	}
-
 	if len(video.Md5Checksum) > 0 {
-		digest := fmt.Sprintf("%x", md5.Sum(videoMp4Data))
+		// the video is hashed part by part instead of being read into memory
+		h := md5.New()
+		if _, err = io.Copy(h, r); err != nil {
+			c.Logger.Errorf("dfs.uploadVideoSizeList - %v", err)
+			return nil, mtproto.ErrMediaInvalid
+		}
+		digest := fmt.Sprintf("%x", h.Sum(nil))
 		if digest != video.Md5Checksum {
 			c.Logger.Errorf("dfs.uploadPhotoFile - (%s, %s) error: %v", digest, video.Md5Checksum, err)
 			return nil, mtproto.ErrCheckSumInvalid
@@ -23,6 +23,10 @@ import (
 	"github.com/teamgram/teamgram-server/app/service/dfs/internal/dao"
 	"github.com/teamgram/teamgram-server/app/service/dfs/internal/imaging"
 	"github.com/teamgram/teamgram-server/app/service/dfs/internal/model"
+	"github.com/teamgram/teamgram-server/app/service/dfs/internal/storage"
+
+	"github.com/zeromicro/go-zero/core/contextx"
+	"github.com/zeromicro/go-zero/core/threading"
 )
 
 // DfsUploadThemeFile
@@ -62,14 +66,17 @@ func (c *DfsCore) DfsUploadThemeFile(in *dfs.TLDfsUploadThemeFile) (*mtproto.Doc
 	}
 	c.svcCtx.Dao.SetCacheFileInfo(c.ctx, documentId, fileInfo)
 
-	go func() {
-		_, err2 := c.svcCtx.Dao.PutDocumentFile(c.ctx,
+	threading.GoSafe(func() {
+		_, err2 := c.svcCtx.Dao.PutUploadFile(
+			contextx.ValueOnlyFrom(c.ctx),
+			storage.BucketDocuments,
 			fmt.Sprintf("%d.dat", documentId),
-			c.svcCtx.Dao.NewSSDBReader(fileInfo))
+			fileInfo,
+			file.GetMd5Checksum())
 		if err2 != nil {
 			c.Logger.Errorf("dfs.uploadThemeFile - error: %v", err2)
 		}
-	}()
+	})
 
 	// upload thumb file
 	if thumbFile != nil {
@@ -85,5 +85,14 @@ func (c *DfsCore) DfsWriteFilePartData(in *dfs.TLDfsWriteFilePartData) (*mtproto
 		}
 	}
 
+	// the parts of the big files are flushed to the storage as they arrive
+	if in.Big && in.GetFileTotalParts() != nil {
+		err = c.svcCtx.Dao.AssembleBigFilePart(c.ctx, in.Creator, in.FileId, in.FilePart, in.GetFileTotalParts().GetValue(), in.Bytes)
+		if err != nil {
+			c.Logger.Errorf("dfs.writeFilePartData - error: %v", err)
+			return nil, err
+		}
+	}
+
 	return mtproto.BoolTrue, nil
 }
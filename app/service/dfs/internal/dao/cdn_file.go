@@ -0,0 +1,135 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package dao
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/teamgram/teamgram-server/app/interface/cdn/cdn"
+	"github.com/teamgram/teamgram-server/app/service/dfs/internal/model"
+
+	"github.com/zeromicro/go-zero/core/jsonx"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+const (
+	// cdnFileExpire upload.reuploadCdnFile returns FILE_TOKEN_INVALID after the file_token expired,
+	// the clients download the file from the master dc then.
+	cdnFileExpire = 24 * 60 * 60
+
+	_cdnFileTokenKeyPrefix = "cdn_file_token_%s"
+	_cdnFileKeyPrefix      = "cdn_file_%s_%s"
+)
+
+func getCdnFileTokenKey(fileToken []byte) string {
+	return fmt.Sprintf(_cdnFileTokenKeyPrefix, hex.EncodeToString(fileToken))
+}
+
+func getCdnFileKey(bucket, path string) string {
+	return fmt.Sprintf(_cdnFileKeyPrefix, bucket, path)
+}
+
+func randomBytes(n int) []byte {
+	b := make([]byte, n)
+	rand.Read(b)
+	return b
+}
+
+// GetCdnFile returns nil if the file_token is expired.
+func (d *Dao) GetCdnFile(ctx context.Context, fileToken []byte) (*model.CdnFile, error) {
+	var (
+		key = getCdnFileTokenKey(fileToken)
+	)
+
+	v, err := d.ssdb.Get(key)
+	if err != nil {
+		logx.WithContext(ctx).Errorf("conn.Do(GET %s) error(%v)", key, err)
+		return nil, err
+	} else if v == "" {
+		return nil, nil
+	}
+
+	cdnFile := new(model.CdnFile)
+	if err = jsonx.UnmarshalFromString(v, cdnFile); err != nil {
+		logx.WithContext(ctx).Errorf("jsonx.UnmarshalFromString(%s) error(%v)", v, err)
+		return nil, err
+	}
+
+	return cdnFile, nil
+}
+
+// GetOrMakeCdnFile returns the file_token of bucket/path, a new file_token and key are made
+// if there is no file_token or it is expired.
+func (d *Dao) GetOrMakeCdnFile(ctx context.Context, dcId int32, bucket, path string, cacheId int64) (*model.CdnFile, error) {
+	var (
+		key = getCdnFileKey(bucket, path)
+	)
+
+	v, err := d.ssdb.Get(key)
+	if err != nil {
+		logx.WithContext(ctx).Errorf("conn.Do(GET %s) error(%v)", key, err)
+		return nil, err
+	}
+	if v != "" {
+		fileToken, _ := hex.DecodeString(v)
+		if cdnFile, err := d.GetCdnFile(ctx, fileToken); err != nil {
+			return nil, err
+		} else if cdnFile != nil && cdnFile.DcId == dcId {
+			return cdnFile, nil
+		}
+	}
+
+	cdnFile := &model.CdnFile{
+		FileToken:     randomBytes(16),
+		DcId:          dcId,
+		Bucket:        bucket,
+		Path:          path,
+		CacheId:       cacheId,
+		EncryptionKey: randomBytes(32),
+		EncryptionIv:  randomBytes(16),
+	}
+
+	tokenKey := getCdnFileTokenKey(cdnFile.FileToken)
+	data, _ := jsonx.Marshal(cdnFile)
+	if err = d.ssdb.Setex(tokenKey, string(data), cdnFileExpire); err != nil {
+		logx.WithContext(ctx).Errorf("conn.Do(SETEX %s) error(%v)", tokenKey, err)
+		return nil, err
+	}
+	// a new file_token is made a minute before the old one expires
+	if err = d.ssdb.Setex(key, hex.EncodeToString(cdnFile.FileToken), cdnFileExpire-60); err != nil {
+		logx.WithContext(ctx).Errorf("conn.Do(SETEX %s) error(%v)", key, err)
+		return nil, err
+	}
+
+	return cdnFile, nil
+}
+
+// ReadCdnFilePart reads the part of the file at offset and encrypts it by the key of the file_token,
+// the part is empty at the end of the file.
+func (d *Dao) ReadCdnFilePart(ctx context.Context, cdnFile *model.CdnFile, offset, limit int32) ([]byte, error) {
+	b, err := d.getFileOrCacheFile(ctx, cdnFile.Bucket, cdnFile.Path, cdnFile.CacheId, offset, limit)
+	if err != nil || len(b) == 0 {
+		return []byte{}, nil
+	}
+
+	return cdn.EncryptCdnFilePart(cdnFile.EncryptionKey, cdnFile.EncryptionIv, offset, b)
+}
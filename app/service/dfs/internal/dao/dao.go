@@ -10,32 +10,57 @@
 package dao
 
 import (
-	"github.com/minio/minio-go"
+	cdn_client "github.com/teamgram/teamgram-server/app/interface/cdn/client"
 	"github.com/teamgram/teamgram-server/app/service/dfs/internal/config"
 	"github.com/teamgram/teamgram-server/app/service/dfs/internal/minio_util"
+	"github.com/teamgram/teamgram-server/app/service/dfs/internal/storage"
+	storage_conf "github.com/teamgram/teamgram-server/app/service/dfs/internal/storage/conf"
 	idgen_client "github.com/teamgram/teamgram-server/app/service/idgen/client"
 	"github.com/zeromicro/go-zero/core/stores/kv"
 	"github.com/zeromicro/go-zero/zrpc"
 )
 
 type Dao struct {
-	minio *minio.Core
+	storage storage.Storage
 	idgen_client.IDGenClient2
 	ssdb kv.Store
+	cdn_client.CdnClient
+	assemblers *uploadAssemblers
 }
 
 func New(c config.Config) *Dao {
-	return &Dao{
-		minio:        minio_util.MustNewMinioClient(&c.Minio),
+	d := &Dao{
+		storage:      newStorage(c.Storage, c.Minio),
 		IDGenClient2: idgen_client.NewIDGenClient2(zrpc.MustNewClient(c.IdGen)),
 		ssdb:         kv.NewStore(c.SSDB),
+		assemblers:   newUploadAssemblers(),
+	}
+	if c.Cdn != nil {
+		d.CdnClient = cdn_client.NewCdnClient(zrpc.MustNewClient(c.Cdn.CdnClient))
 	}
+
+	return d
 }
 
 func NewDFSHelper(minio *minio_util.MinioConfig, idgen zrpc.RpcClientConf, ssdb kv.KvConf) *Dao {
 	return &Dao{
-		minio:        minio_util.MustNewMinioClient(minio),
+		storage:      newStorage(storage_conf.StorageConfig{Name: "s3"}, minio),
 		IDGenClient2: idgen_client.NewIDGenClient2(zrpc.MustNewClient(idgen)),
 		ssdb:         kv.NewStore(ssdb),
+		assemblers:   newUploadAssemblers(),
 	}
 }
+
+// newStorage the legacy Minio section is used if Storage.S3 is not set.
+func newStorage(c storage_conf.StorageConfig, minio *minio_util.MinioConfig) storage.Storage {
+	if c.Name != "local" && c.S3 == nil && minio != nil {
+		c.S3 = &storage_conf.S3Config{
+			Endpoint:        minio.Endpoint,
+			AccessKeyID:     minio.AccessKeyID,
+			SecretAccessKey: minio.SecretAccessKey,
+			UseSSL:          minio.UseSSL,
+		}
+	}
+
+	return storage.New(&c)
+}
@@ -0,0 +1,161 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package dao
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"hash"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+const (
+	// FileHashPartSize every fileHash covers 128 KB of the file
+	FileHashPartSize = 128 * 1024
+
+	_fileHashesKeyPrefix = "file_hashes_%s_%s"
+)
+
+func getFileHashesKey(bucket, path string) string {
+	return fmt.Sprintf(_fileHashesKeyPrefix, bucket, path)
+}
+
+// FileHasher computes the sha256 of every FileHashPartSize range written to it,
+// Sum returns the concatenated hashes.
+type FileHasher struct {
+	h      hash.Hash
+	n      int
+	hashes []byte
+}
+
+func NewFileHasher() *FileHasher {
+	return &FileHasher{
+		h: sha256.New(),
+	}
+}
+
+func (w *FileHasher) Write(p []byte) (int, error) {
+	l := len(p)
+	for len(p) > 0 {
+		n := FileHashPartSize - w.n
+		if n > len(p) {
+			n = len(p)
+		}
+		w.h.Write(p[:n])
+		w.n += n
+		p = p[n:]
+		if w.n == FileHashPartSize {
+			w.hashes = w.h.Sum(w.hashes)
+			w.h.Reset()
+			w.n = 0
+		}
+	}
+
+	return l, nil
+}
+
+func (w *FileHasher) Sum() []byte {
+	if w.n > 0 {
+		w.hashes = w.h.Sum(w.hashes)
+		w.h.Reset()
+		w.n = 0
+	}
+
+	return w.hashes
+}
+
+// MakeFileHashes returns the concatenated sha256 hashes of data.
+func MakeFileHashes(data []byte) []byte {
+	w := NewFileHasher()
+	w.Write(data)
+	return w.Sum()
+}
+
+func (d *Dao) SetFileHashes(ctx context.Context, bucket, path string, hashes []byte) (err error) {
+	var (
+		key = getFileHashesKey(bucket, path)
+	)
+
+	if err = d.ssdb.Set(key, string(hashes)); err != nil {
+		logx.WithContext(ctx).Errorf("conn.Do(SET %s) error(%v)", key, err)
+	}
+
+	return
+}
+
+// GetFileHashes returns nil if the hashes of bucket/path are not computed yet.
+func (d *Dao) GetFileHashes(ctx context.Context, bucket, path string) (hashes []byte, err error) {
+	var (
+		key = getFileHashesKey(bucket, path)
+		s   string
+	)
+
+	s, err = d.ssdb.Get(key)
+	if err != nil {
+		logx.WithContext(ctx).Errorf("conn.Do(GET %s) error(%v)", key, err)
+		return
+	}
+	if s != "" {
+		hashes = []byte(s)
+	}
+
+	return
+}
+
+// GetOrMakeFileHashes returns the hashes of bucket/path, the files uploaded before
+// the hashes were computed on upload are read and hashed on the first request.
+// cacheId is the id of a file which can still be in the upload cache (documents and
+// encrypted files, path is "<cacheId>.dat"), or 0.
+func (d *Dao) GetOrMakeFileHashes(ctx context.Context, bucket, path string, cacheId int64) ([]byte, error) {
+	hashes, err := d.GetFileHashes(ctx, bucket, path)
+	if err != nil {
+		return nil, err
+	} else if hashes != nil {
+		return hashes, nil
+	}
+
+	w := NewFileHasher()
+	for offset := int32(0); ; offset += FileHashPartSize {
+		b, err2 := d.getFileOrCacheFile(ctx, bucket, path, cacheId, offset, FileHashPartSize)
+		if err2 != nil || len(b) == 0 {
+			break
+		}
+		w.Write(b)
+		if len(b) < FileHashPartSize {
+			break
+		}
+	}
+
+	hashes = w.Sum()
+	if len(hashes) > 0 {
+		d.SetFileHashes(ctx, bucket, path, hashes)
+	}
+
+	return hashes, nil
+}
+
+func (d *Dao) getFileOrCacheFile(ctx context.Context, bucket, path string, cacheId int64, offset, limit int32) ([]byte, error) {
+	if cacheId != 0 {
+		return d.GetCacheFile(ctx, bucket, cacheId, offset, limit)
+	}
+
+	return d.GetFile(ctx, bucket, path, offset, limit)
+}
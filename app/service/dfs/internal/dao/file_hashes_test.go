@@ -0,0 +1,64 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package dao
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"testing"
+)
+
+// go test -v -run=TestFileHasher
+func TestFileHasher(t *testing.T) {
+	data := make([]byte, 2*FileHashPartSize+100)
+	for i := range data {
+		data[i] = byte(i * 7)
+	}
+
+	var want []byte
+	for i := 0; i < len(data); i += FileHashPartSize {
+		j := i + FileHashPartSize
+		if j > len(data) {
+			j = len(data)
+		}
+		h := sha256.Sum256(data[i:j])
+		want = append(want, h[:]...)
+	}
+
+	if got := MakeFileHashes(data); !bytes.Equal(got, want) {
+		t.Fatalf("MakeFileHashes: got %d bytes, want %d bytes", len(got), len(want))
+	}
+
+	// odd sized writes
+	w := NewFileHasher()
+	for i := 0; i < len(data); i += 1000 {
+		j := i + 1000
+		if j > len(data) {
+			j = len(data)
+		}
+		w.Write(data[i:j])
+	}
+	if got := w.Sum(); !bytes.Equal(got, want) {
+		t.Fatal("FileHasher: hashes mismatch")
+	}
+
+	if got := MakeFileHashes(nil); len(got) != 0 {
+		t.Fatalf("MakeFileHashes(nil): got %d bytes", len(got))
+	}
+}
@@ -61,32 +61,19 @@ func (d *Dao) WriteFilePartData(ctx context.Context, ownerId, fileId int64, file
 	return
 }
 
-func (d *Dao) ReadFile(ctx context.Context, ownerId, fileId int64, parts int32) (partLength int32, bytes []byte, err error) {
+// readFilePart reads a part of the file in the upload cache.
+func (d *Dao) readFilePart(ctx context.Context, ownerId, fileId int64, filePart int32) ([]byte, error) {
 	var (
 		k = getFileKey(ownerId, fileId)
 	)
 
-	for i := int32(0); i < parts; i++ {
-		var (
-			bBuf string
-			b    []byte
-		)
-		bBuf, err = d.ssdb.Hget(k, strconv.Itoa(int(i)))
-		if err != nil {
-			logx.WithContext(ctx).Errorf("conn.Send(HGET %s, %d) error(%v)", k, i, err)
-			return 0, nil, err
-		}
-		b = []byte(bBuf)
-		if bytes == nil {
-			bytes = make([]byte, 0, len(b)*int(parts))
-		}
-		if i == 0 {
-			partLength = int32(len(b))
-		}
-		bytes = append(bytes, b...)
+	bBuf, err := d.ssdb.Hget(k, strconv.Itoa(int(filePart)))
+	if err != nil {
+		logx.WithContext(ctx).Errorf("conn.Do(HGET %s, %d) error(%v)", k, filePart, err)
+		return nil, err
 	}
 
-	return
+	return []byte(bBuf), nil
 }
 
 func (d *Dao) ReadFileCB(ctx context.Context, ownerId, fileId int64, parts int32, cb func(part int32, bytes []byte) error) (err error) {
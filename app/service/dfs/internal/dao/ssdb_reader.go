@@ -30,6 +30,9 @@ import (
 	"github.com/zeromicro/go-zero/core/stores/kv"
 )
 
+// readAllMaxSize the files uploaded by upload.saveBigFilePart are never read into memory
+const readAllMaxSize = 10 * 1024 * 1024
+
 type SSDBReader struct {
 	*model.DfsFileInfo
 	ssdb kv.Store
@@ -135,7 +138,11 @@ func (r *SSDBReader) readFile(ctx context.Context, filePart int) ([]byte, error)
 	return []byte(bBuf), nil
 }
 
+// ReadAll reads the whole file into memory, it fails if the file is larger than 10 MB.
 func (r *SSDBReader) ReadAll(ctx context.Context) ([]byte, error) {
+	if r.GetFileSize() > readAllMaxSize {
+		return nil, fmt.Errorf("ssdb.Reader.ReadAll: file too big: %d", r.GetFileSize())
+	}
 
 	var (
 		bytes []byte
@@ -0,0 +1,126 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package dao
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"os"
+
+	"github.com/teamgram/teamgram-server/app/service/dfs/internal/storage"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+func (d *Dao) GetFile(ctx context.Context, bucket, path string, offset, limit int32) (bytes []byte, err error) {
+	bytes, err = d.storage.GetObject(ctx, bucket, path, int64(offset), int64(limit))
+	if err != nil {
+		logx.WithContext(ctx).Errorf("GetFile (%s) error: %v", path, err)
+	}
+	return
+}
+
+// StatFile returns the size of bucket/path.
+func (d *Dao) StatFile(ctx context.Context, bucket, path string) (int64, error) {
+	size, err := d.storage.StatObject(ctx, bucket, path)
+	if err != nil {
+		logx.WithContext(ctx).Errorf("StatFile (%s) error: %v", path, err)
+		return 0, err
+	}
+
+	return size, nil
+}
+
+func (d *Dao) PutPhotoFile(ctx context.Context, path string, buf []byte) (n int64, err error) {
+	n, err = d.storage.PutObject(ctx, storage.BucketPhotos, path, bytes.NewReader(buf), int64(len(buf)))
+	if err != nil {
+		logx.WithContext(ctx).Errorf("PutPhotoFile (%s) error: %v", path, err)
+	} else {
+		d.SetFileHashes(ctx, storage.BucketPhotos, path, MakeFileHashes(buf))
+	}
+	return
+}
+
+func (d *Dao) PutPhotoFileV2(ctx context.Context, path string, r io.Reader) (n int64, err error) {
+	hasher := NewFileHasher()
+	n, err = d.storage.PutObject(ctx, storage.BucketPhotos, path, io.TeeReader(r, hasher), -1)
+	if err != nil {
+		logx.WithContext(ctx).Errorf("PutPhotoFile (%s) error: %v", path, err)
+	} else {
+		d.SetFileHashes(ctx, storage.BucketPhotos, path, hasher.Sum())
+	}
+	return
+}
+
+func (d *Dao) PutVideoFile(ctx context.Context, path string, buf []byte) (n int64, err error) {
+	n, err = d.storage.PutObject(ctx, storage.BucketVideos, path, bytes.NewReader(buf), int64(len(buf)))
+	if err != nil {
+		logx.WithContext(ctx).Errorf("PutVideoFile (%s) error: %v", path, err)
+	} else {
+		d.SetFileHashes(ctx, storage.BucketVideos, path, MakeFileHashes(buf))
+	}
+	return
+}
+
+func (d *Dao) PutDocumentFile(ctx context.Context, path string, r io.Reader) (n int64, err error) {
+	hasher := NewFileHasher()
+	n, err = d.storage.PutObject(ctx, storage.BucketDocuments, path, io.TeeReader(r, hasher), -1)
+	if err != nil {
+		logx.WithContext(ctx).Errorf("PutDocumentFile (%s) error: %v", path, err)
+	} else {
+		d.SetFileHashes(ctx, storage.BucketDocuments, path, hasher.Sum())
+	}
+	return
+}
+
+func (d *Dao) FPutDocumentFile(ctx context.Context, path string, r string) (n int64, err error) {
+	var (
+		f  *os.File
+		fi os.FileInfo
+	)
+
+	if f, err = os.Open(r); err != nil {
+		logx.WithContext(ctx).Errorf("PutDocumentFile (%s) error: %v", path, err)
+		return
+	}
+	defer f.Close()
+
+	if fi, err = f.Stat(); err != nil {
+		logx.WithContext(ctx).Errorf("PutDocumentFile (%s) error: %v", path, err)
+		return
+	}
+
+	n, err = d.storage.PutObject(ctx, storage.BucketDocuments, path, f, fi.Size())
+	if err != nil {
+		logx.WithContext(ctx).Errorf("PutDocumentFile (%s) error: %v", path, err)
+	}
+	return
+}
+
+func (d *Dao) PutEncryptedFile(ctx context.Context, path string, r io.Reader) (n int64, err error) {
+	hasher := NewFileHasher()
+	n, err = d.storage.PutObject(ctx, storage.BucketEncryptedFiles, path, io.TeeReader(r, hasher), -1)
+	if err != nil {
+		logx.WithContext(ctx).Errorf("PutEncryptedFile (%s) error: %v", path, err)
+	} else {
+		d.SetFileHashes(ctx, storage.BucketEncryptedFiles, path, hasher.Sum())
+	}
+	return
+}
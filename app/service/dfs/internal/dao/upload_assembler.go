@@ -0,0 +1,369 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package dao
+
+import (
+	"context"
+	"crypto/md5"
+	"fmt"
+	"hash"
+	"io"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/dfs/internal/model"
+	"github.com/teamgram/teamgram-server/app/service/dfs/internal/storage"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+const (
+	// uploadAssemblerMaxCount at most the big files assembled at the same time on a node,
+	// the others are streamed from ssdb when they are finished
+	uploadAssemblerMaxCount = 64
+	// uploadAssemblerMaxPending the out-of-order parts kept in memory by an assembler, the
+	// others are read back from ssdb when it is their turn
+	uploadAssemblerMaxPending = 8 << 20
+	// uploadAssemblerIdleTimeout the parts in ssdb have expired by then
+	uploadAssemblerIdleTimeout = ssdbExpire * time.Second
+
+	// uploadMaxPartSize the part size must divide it and be divisible by 1 KB
+	uploadMaxPartSize = 512 * 1024
+)
+
+// uploadAssembler writes the parts of a big file to a staging object of the storage
+// while they are uploaded, finish moves it to its final path.
+type uploadAssembler struct {
+	mu          sync.Mutex
+	creator     int64
+	fileId      int64
+	totalParts  int32
+	partSize    int
+	stagingPath string
+	w           io.WriteCloser
+	cancel      context.CancelFunc
+	next        int32
+	pending     map[int32][]byte
+	pendingSize int
+	spilled     map[int32]struct{}
+	size        int64
+	md5         hash.Hash
+	hasher      *FileHasher
+	lastActive  time.Time
+	err         error
+}
+
+type uploadAssemblers struct {
+	mu        sync.Mutex
+	m         map[string]*uploadAssembler
+	lastSweep time.Time
+}
+
+func newUploadAssemblers() *uploadAssemblers {
+	return &uploadAssemblers{
+		m: make(map[string]*uploadAssembler),
+	}
+}
+
+func uploadAssemblerKey(creator, fileId int64) string {
+	return fmt.Sprintf("%d_%d", creator, fileId)
+}
+
+// checkBigFilePart checks the part of upload.saveBigFilePart, every part but the last has the same size.
+func (a *uploadAssembler) checkBigFilePart(filePart, totalParts int32, size int) error {
+	if filePart < 0 || filePart >= totalParts {
+		return mtproto.ErrFilePartInvalid
+	}
+
+	if filePart == totalParts-1 {
+		if a.partSize > 0 && size > a.partSize {
+			return mtproto.ErrFilePartSizeInvalid
+		}
+		return nil
+	}
+
+	if size%1024 != 0 || uploadMaxPartSize%size != 0 {
+		return mtproto.ErrFilePartSizeInvalid
+	}
+	if a.partSize == 0 {
+		a.partSize = size
+	} else if size != a.partSize {
+		return mtproto.ErrFilePartSizeChanged
+	}
+
+	return nil
+}
+
+func (a *uploadAssembler) write(b []byte) {
+	if _, a.err = a.w.Write(b); a.err != nil {
+		a.abort()
+		return
+	}
+
+	a.hasher.Write(b)
+	a.md5.Write(b)
+	a.size += int64(len(b))
+	a.next++
+}
+
+// abort discards the staging object, the file is streamed from ssdb when it is finished.
+func (a *uploadAssembler) abort() {
+	a.cancel()
+	a.w.Close()
+	a.pending = nil
+	a.pendingSize = 0
+}
+
+// flush writes the parts following the last written part which are in memory.
+func (a *uploadAssembler) flush(ctx context.Context, d *Dao, fromSSDB bool) {
+	for a.err == nil && a.next < a.totalParts {
+		b, ok := a.pending[a.next]
+		if ok {
+			delete(a.pending, a.next)
+			a.pendingSize -= len(b)
+		} else {
+			_, spilled := a.spilled[a.next]
+			if !spilled && !fromSSDB {
+				return
+			}
+			delete(a.spilled, a.next)
+
+			var err error
+			if b, err = d.readFilePart(ctx, a.creator, a.fileId, a.next); err != nil || len(b) == 0 {
+				if fromSSDB {
+					a.err = fmt.Errorf("part %d missing: %v", a.next, err)
+					a.abort()
+				}
+				return
+			}
+		}
+
+		a.write(b)
+	}
+}
+
+// AssembleBigFilePart is called with every part of upload.saveBigFilePart after it is
+// written to ssdb, the parts are flushed to the storage in order.
+func (d *Dao) AssembleBigFilePart(ctx context.Context, creator, fileId int64, filePart, totalParts int32, b []byte) error {
+	// the streamed uploads send -1 until the size is known, they are not assembled
+	if totalParts <= 0 {
+		return nil
+	}
+
+	a := d.getOrNewUploadAssembler(creator, fileId, totalParts)
+	if a == nil {
+		return nil
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	failed := a.err != nil
+	a.lastActive = time.Now()
+	if a.err == nil && totalParts != a.totalParts {
+		a.err = fmt.Errorf("file_total_parts changed: %d != %d", totalParts, a.totalParts)
+		a.abort()
+	}
+	if err := a.checkBigFilePart(filePart, totalParts, len(b)); err != nil {
+		return err
+	}
+	if a.err != nil || filePart < a.next {
+		return nil
+	}
+
+	if filePart == a.next {
+		a.write(b)
+		a.flush(ctx, d, false)
+	} else if _, ok := a.pending[filePart]; !ok {
+		if a.pendingSize+len(b) <= uploadAssemblerMaxPending {
+			a.pending[filePart] = b
+			a.pendingSize += len(b)
+		} else {
+			a.spilled[filePart] = struct{}{}
+		}
+	}
+
+	if !failed && a.err != nil {
+		logx.WithContext(ctx).Errorf("assembleBigFilePart(%d, %d) - error: %v", creator, fileId, a.err)
+	}
+
+	return nil
+}
+
+func (d *Dao) getOrNewUploadAssembler(creator, fileId int64, totalParts int32) *uploadAssembler {
+	var (
+		s   = d.assemblers
+		key = uploadAssemblerKey(creator, fileId)
+		now = time.Now()
+	)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if now.Sub(s.lastSweep) >= time.Minute {
+		s.lastSweep = now
+		for k, a := range s.m {
+			a.mu.Lock()
+			if now.Sub(a.lastActive) >= uploadAssemblerIdleTimeout {
+				delete(s.m, k)
+				if a.err == nil {
+					a.abort()
+				}
+			}
+			a.mu.Unlock()
+		}
+	}
+
+	if a, ok := s.m[key]; ok {
+		return a
+	}
+	if len(s.m) >= uploadAssemblerMaxCount {
+		return nil
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stagingPath := fmt.Sprintf("uploads/%s_%d.dat", key, rand.Uint32())
+	w, err := d.storage.NewWriter(ctx, storage.BucketDocuments, stagingPath)
+	if err != nil {
+		cancel()
+		logx.Errorf("newUploadAssembler(%s) - error: %v", key, err)
+		return nil
+	}
+
+	a := &uploadAssembler{
+		creator:     creator,
+		fileId:      fileId,
+		totalParts:  totalParts,
+		stagingPath: stagingPath,
+		w:           w,
+		cancel:      cancel,
+		pending:     make(map[int32][]byte),
+		spilled:     make(map[int32]struct{}),
+		md5:         md5.New(),
+		hasher:      NewFileHasher(),
+		lastActive:  now,
+	}
+	s.m[key] = a
+
+	return a
+}
+
+func (d *Dao) removeUploadAssembler(creator, fileId int64) *uploadAssembler {
+	var (
+		s   = d.assemblers
+		key = uploadAssemblerKey(creator, fileId)
+	)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	a := s.m[key]
+	delete(s.m, key)
+	return a
+}
+
+// finishUploadAssembler moves the assembled file to bucket/path, ok is false if the
+// file was not assembled on this node.
+func (d *Dao) finishUploadAssembler(ctx context.Context, bucket, path string, fileInfo *model.DfsFileInfo, md5Checksum string) (n int64, ok bool, err error) {
+	a := d.removeUploadAssembler(fileInfo.Creator, fileInfo.FileId)
+	if a == nil {
+		return 0, false, nil
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.err != nil {
+		return 0, false, nil
+	}
+	if int(a.totalParts) != fileInfo.FileTotalParts {
+		a.abort()
+		return 0, false, nil
+	}
+
+	// the parts not uploaded to this node or not kept in memory
+	a.flush(ctx, d, true)
+	if a.err != nil {
+		logx.WithContext(ctx).Errorf("finishUploadAssembler(%s/%s) - error: %v", bucket, path, a.err)
+		return 0, false, nil
+	}
+
+	if md5Checksum != "" && fmt.Sprintf("%x", a.md5.Sum(nil)) != md5Checksum {
+		a.abort()
+		return 0, true, mtproto.ErrCheckSumInvalid
+	}
+
+	if err = a.w.Close(); err != nil {
+		logx.WithContext(ctx).Errorf("finishUploadAssembler(%s/%s) - error: %v", bucket, path, err)
+		a.cancel()
+		return 0, false, nil
+	}
+	a.cancel()
+
+	if err = d.storage.MoveObject(ctx, storage.BucketDocuments, a.stagingPath, bucket, path); err != nil {
+		logx.WithContext(ctx).Errorf("finishUploadAssembler(%s/%s) - error: %v", bucket, path, err)
+		return 0, true, err
+	}
+	d.SetFileHashes(ctx, bucket, path, a.hasher.Sum())
+
+	return a.size, true, nil
+}
+
+// PutUploadFile stores the uploaded file as bucket/path, the big files already assembled
+// are moved, the others are streamed from ssdb part by part. md5Checksum is verified if set.
+func (d *Dao) PutUploadFile(ctx context.Context, bucket, path string, fileInfo *model.DfsFileInfo, md5Checksum string) (n int64, err error) {
+	var ok bool
+	if n, ok, err = d.finishUploadAssembler(ctx, bucket, path, fileInfo, md5Checksum); ok {
+		return
+	}
+
+	ctx2, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	w, err := d.storage.NewWriter(ctx2, bucket, path)
+	if err != nil {
+		logx.WithContext(ctx).Errorf("PutUploadFile (%s) error: %v", path, err)
+		return 0, err
+	}
+
+	var (
+		hasher = NewFileHasher()
+		digest = md5.New()
+	)
+	n, err = io.Copy(io.MultiWriter(w, hasher, digest), d.NewSSDBReader(fileInfo))
+	if err == nil && md5Checksum != "" && fmt.Sprintf("%x", digest.Sum(nil)) != md5Checksum {
+		err = mtproto.ErrCheckSumInvalid
+	}
+	if err != nil {
+		cancel()
+		w.Close()
+		logx.WithContext(ctx).Errorf("PutUploadFile (%s) error: %v", path, err)
+		return 0, err
+	}
+
+	if err = w.Close(); err != nil {
+		logx.WithContext(ctx).Errorf("PutUploadFile (%s) error: %v", path, err)
+		return 0, err
+	}
+	d.SetFileHashes(ctx, bucket, path, hasher.Sum())
+
+	return n, nil
+}
@@ -0,0 +1,134 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package dao
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"io"
+	"testing"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/dfs/internal/storage"
+	"github.com/teamgram/teamgram-server/app/service/dfs/internal/storage/conf"
+)
+
+func newTestDao(t *testing.T) *Dao {
+	return &Dao{
+		storage: storage.New(&conf.StorageConfig{
+			Name: "local",
+			Local: &conf.LocalConfig{
+				Root: t.TempDir(),
+			},
+		}),
+		assemblers: newUploadAssemblers(),
+	}
+}
+
+// go test -v -run=TestUploadAssembler
+func TestUploadAssembler(t *testing.T) {
+	const (
+		partSize   = 64 * 1024
+		totalParts = 5
+	)
+
+	var (
+		ctx  = context.Background()
+		d    = newTestDao(t)
+		data = make([]byte, (totalParts-1)*partSize+1000)
+	)
+	for i := range data {
+		data[i] = byte(i * 13)
+	}
+
+	part := func(i int32) []byte {
+		j := (int(i) + 1) * partSize
+		if j > len(data) {
+			j = len(data)
+		}
+		return data[int(i)*partSize : j]
+	}
+
+	// the parts out of order are kept in memory until their turn
+	for _, i := range []int32{0, 2, 4, 1, 3} {
+		if err := d.AssembleBigFilePart(ctx, 1, 2, i, totalParts, part(i)); err != nil {
+			t.Fatalf("AssembleBigFilePart(%d): %v", i, err)
+		}
+	}
+
+	a := d.assemblers.m[uploadAssemblerKey(1, 2)]
+	if a == nil || a.err != nil || a.next != totalParts || len(a.pending) != 0 {
+		t.Fatalf("uploadAssembler: %+v", a)
+	}
+	if a.size != int64(len(data)) || !bytes.Equal(a.md5.Sum(nil), md5Sum(data)) {
+		t.Errorf("uploadAssembler: size %d, md5 mismatch", a.size)
+	}
+	if !bytes.Equal(a.hasher.Sum(), MakeFileHashes(data)) {
+		t.Errorf("uploadAssembler: file hashes mismatch")
+	}
+
+	if err := a.w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	rc, _, err := d.storage.OpenObject(ctx, storage.BucketDocuments, a.stagingPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, _ := io.ReadAll(rc)
+	rc.Close()
+	if !bytes.Equal(b, data) {
+		t.Errorf("staging object: %d bytes, want %d", len(b), len(data))
+	}
+
+	// the streamed uploads are not assembled
+	if err = d.AssembleBigFilePart(ctx, 1, 3, 0, -1, part(0)); err != nil || d.assemblers.m[uploadAssemblerKey(1, 3)] != nil {
+		t.Errorf("AssembleBigFilePart with unknown total parts: %v", err)
+	}
+}
+
+func TestCheckBigFilePart(t *testing.T) {
+	a := &uploadAssembler{totalParts: 4}
+
+	for _, c := range []struct {
+		filePart int32
+		size     int
+		want     error
+	}{
+		{-1, 1024, mtproto.ErrFilePartInvalid},
+		{4, 1024, mtproto.ErrFilePartInvalid},
+		{0, 1000, mtproto.ErrFilePartSizeInvalid},
+		{0, 3 * 1024, mtproto.ErrFilePartSizeInvalid},
+		{0, 1024 * 1024, mtproto.ErrFilePartSizeInvalid},
+		{0, 8 * 1024, nil},
+		{1, 16 * 1024, mtproto.ErrFilePartSizeChanged},
+		{2, 8 * 1024, nil},
+		{3, 9 * 1024, mtproto.ErrFilePartSizeInvalid},
+		{3, 100, nil},
+	} {
+		if err := a.checkBigFilePart(c.filePart, a.totalParts, c.size); err != c.want {
+			t.Errorf("checkBigFilePart(%d, %d): %v, want %v", c.filePart, c.size, err, c.want)
+		}
+	}
+}
+
+func md5Sum(b []byte) []byte {
+	h := md5.Sum(b)
+	return h[:]
+}
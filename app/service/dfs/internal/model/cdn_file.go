@@ -0,0 +1,31 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package model
+
+// CdnFile a file which can be downloaded from the cdn dc, every file has its own
+// AES-256-CTR key and file_token, shared by all the users.
+type CdnFile struct {
+	FileToken     []byte `json:"file_token"`
+	DcId          int32  `json:"dc_id"`
+	Bucket        string `json:"bucket"`
+	Path          string `json:"path"`
+	CacheId       int64  `json:"cache_id"`
+	EncryptionKey []byte `json:"encryption_key"`
+	EncryptionIv  []byte `json:"encryption_iv"`
+}
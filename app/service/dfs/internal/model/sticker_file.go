@@ -0,0 +1,311 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package model
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"io"
+	"io/ioutil"
+	"math"
+
+	"github.com/teamgram/proto/mtproto"
+	"google.golang.org/grpc/status"
+)
+
+// https://core.telegram.org/stickers#animated-stickers
+// https://core.telegram.org/stickers#video-stickers
+const (
+	StickerSide         = 512
+	StickerMaxDuration  = 3.0
+	TgsStickerMaxSize   = 64 * 1024
+	TgsStickerMaxFps    = 60
+	WebmStickerMaxSize  = 256 * 1024
+	tgsStickerMaxUnzip  = 1024 * 1024
+	webmStickerCodecVP9 = "V_VP9"
+)
+
+var (
+	ErrStickerVideoNowebm = status.Error(mtproto.ErrBadRequest, "STICKER_VIDEO_NOWEBM")
+	ErrStickerVideoBig    = status.Error(mtproto.ErrBadRequest, "STICKER_VIDEO_BIG")
+	ErrStickerVideoLong   = status.Error(mtproto.ErrBadRequest, "STICKER_VIDEO_LONG")
+)
+
+type StickerFileInfo struct {
+	W        int32
+	H        int32
+	Duration float64
+}
+
+// CheckTgsSticker checks an animated sticker, a gzipped lottie animation of 512x512
+// with the tgs:1 marker, at most 60 fps and 3 seconds.
+func CheckTgsSticker(data []byte) (*StickerFileInfo, error) {
+	if len(data) > TgsStickerMaxSize {
+		return nil, mtproto.ErrStickerFileInvalid
+	}
+
+	gr, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, mtproto.ErrStickerTgsNotgs
+	}
+	defer gr.Close()
+
+	jsonData, err := ioutil.ReadAll(io.LimitReader(gr, tgsStickerMaxUnzip+1))
+	if err != nil || len(jsonData) > tgsStickerMaxUnzip {
+		return nil, mtproto.ErrStickerTgsNotgs
+	}
+
+	var (
+		lottie struct {
+			Tgs int     `json:"tgs"`
+			W   int32   `json:"w"`
+			H   int32   `json:"h"`
+			Fr  float64 `json:"fr"`
+			Ip  float64 `json:"ip"`
+			Op  float64 `json:"op"`
+		}
+	)
+	if err = json.Unmarshal(jsonData, &lottie); err != nil || lottie.Tgs != 1 {
+		return nil, mtproto.ErrStickerTgsNotgs
+	}
+	if lottie.W != StickerSide || lottie.H != StickerSide {
+		return nil, mtproto.ErrStickerFileInvalid
+	}
+	if lottie.Fr <= 0 || lottie.Fr > TgsStickerMaxFps {
+		return nil, mtproto.ErrStickerFileInvalid
+	}
+
+	duration := (lottie.Op - lottie.Ip) / lottie.Fr
+	if duration <= 0 || duration > StickerMaxDuration {
+		return nil, mtproto.ErrStickerFileInvalid
+	}
+
+	return &StickerFileInfo{
+		W:        lottie.W,
+		H:        lottie.H,
+		Duration: duration,
+	}, nil
+}
+
+// ebml ids of the webm elements read by CheckWebmSticker
+const (
+	ebmlIdHeader        = 0x1A45DFA3
+	ebmlIdDocType       = 0x4282
+	ebmlIdSegment       = 0x18538067
+	ebmlIdInfo          = 0x1549A966
+	ebmlIdTimecodeScale = 0x2AD7B1
+	ebmlIdDuration      = 0x4489
+	ebmlIdTracks        = 0x1654AE6B
+	ebmlIdTrackEntry    = 0xAE
+	ebmlIdTrackType     = 0x83
+	ebmlIdCodecID       = 0x86
+	ebmlIdVideo         = 0xE0
+	ebmlIdPixelWidth    = 0xB0
+	ebmlIdPixelHeight   = 0xBA
+	ebmlIdCluster       = 0x1F43B675
+
+	ebmlTrackTypeVideo = 1
+	ebmlTrackTypeAudio = 2
+)
+
+var errEbmlInvalid = errors.New("invalid ebml")
+
+// readEbmlVint reads a variable length integer, keepMarker is set for the element ids.
+// An unknown size (all the bits set) is returned as -1.
+func readEbmlVint(data []byte, keepMarker bool) (v int64, n int, err error) {
+	if len(data) == 0 || data[0] == 0 {
+		return 0, 0, errEbmlInvalid
+	}
+
+	n = 1
+	for mask := byte(0x80); data[0]&mask == 0; mask >>= 1 {
+		n++
+	}
+	if n > 8 || len(data) < n {
+		return 0, 0, errEbmlInvalid
+	}
+
+	v = int64(data[0])
+	if !keepMarker {
+		v &= int64(0xFF >> uint(n))
+	}
+	allOnes := v == int64(0xFF>>uint(n))
+	for i := 1; i < n; i++ {
+		v = v<<8 | int64(data[i])
+		allOnes = allOnes && data[i] == 0xFF
+	}
+	if !keepMarker && allOnes {
+		v = -1
+	}
+
+	return v, n, nil
+}
+
+// walkEbml calls cb for every element of data, an element of unknown size runs to the end of data.
+// cb stops the walk by returning false.
+func walkEbml(data []byte, cb func(id int64, body []byte) bool) error {
+	for len(data) > 0 {
+		id, n, err := readEbmlVint(data, true)
+		if err != nil {
+			return err
+		}
+		data = data[n:]
+		size, n, err := readEbmlVint(data, false)
+		if err != nil {
+			return err
+		}
+		data = data[n:]
+		if size < 0 || size > int64(len(data)) {
+			size = int64(len(data))
+		}
+		if !cb(id, data[:size]) {
+			return nil
+		}
+		data = data[size:]
+	}
+	return nil
+}
+
+func ebmlUint(body []byte) int64 {
+	var v int64
+	for _, b := range body {
+		v = v<<8 | int64(b)
+	}
+	return v
+}
+
+func ebmlFloat(body []byte) float64 {
+	switch len(body) {
+	case 4:
+		return float64(math.Float32frombits(binary.BigEndian.Uint32(body)))
+	case 8:
+		return math.Float64frombits(binary.BigEndian.Uint64(body))
+	default:
+		return 0
+	}
+}
+
+// CheckWebmSticker checks a video sticker, a vp9 webm without audio of at most 3 seconds,
+// one side of 512 pixels and the other one 512 pixels or less.
+func CheckWebmSticker(data []byte) (*StickerFileInfo, error) {
+	if len(data) > WebmStickerMaxSize {
+		return nil, ErrStickerVideoBig
+	}
+
+	var (
+		docType       string
+		timecodeScale int64 = 1000000
+		duration      float64
+		info          = &StickerFileInfo{}
+		hasVideo      bool
+		hasAudio      bool
+		codecId       string
+	)
+
+	err := walkEbml(data, func(id int64, body []byte) bool {
+		switch id {
+		case ebmlIdHeader:
+			_ = walkEbml(body, func(id int64, body []byte) bool {
+				if id == ebmlIdDocType {
+					docType = string(body)
+				}
+				return true
+			})
+		case ebmlIdSegment:
+			_ = walkEbml(body, func(id int64, body []byte) bool {
+				switch id {
+				case ebmlIdInfo:
+					_ = walkEbml(body, func(id int64, body []byte) bool {
+						switch id {
+						case ebmlIdTimecodeScale:
+							timecodeScale = ebmlUint(body)
+						case ebmlIdDuration:
+							duration = ebmlFloat(body)
+						}
+						return true
+					})
+				case ebmlIdTracks:
+					_ = walkEbml(body, func(id int64, body []byte) bool {
+						if id != ebmlIdTrackEntry {
+							return true
+						}
+						var (
+							trackType int64
+							codec     string
+							w, h      int64
+						)
+						_ = walkEbml(body, func(id int64, body []byte) bool {
+							switch id {
+							case ebmlIdTrackType:
+								trackType = ebmlUint(body)
+							case ebmlIdCodecID:
+								codec = string(body)
+							case ebmlIdVideo:
+								_ = walkEbml(body, func(id int64, body []byte) bool {
+									switch id {
+									case ebmlIdPixelWidth:
+										w = ebmlUint(body)
+									case ebmlIdPixelHeight:
+										h = ebmlUint(body)
+									}
+									return true
+								})
+							}
+							return true
+						})
+						switch trackType {
+						case ebmlTrackTypeVideo:
+							hasVideo = true
+							codecId = codec
+							info.W, info.H = int32(w), int32(h)
+						case ebmlTrackTypeAudio:
+							hasAudio = true
+						}
+						return true
+					})
+				case ebmlIdCluster:
+					// the frames follow the headers
+					return false
+				}
+				return true
+			})
+			return false
+		}
+		return true
+	})
+	if err != nil || docType != "webm" || !hasVideo {
+		return nil, ErrStickerVideoNowebm
+	}
+	if codecId != webmStickerCodecVP9 || hasAudio {
+		return nil, mtproto.ErrStickerFileInvalid
+	}
+	if info.W > StickerSide || info.H > StickerSide || (info.W != StickerSide && info.H != StickerSide) {
+		return nil, mtproto.ErrStickerFileInvalid
+	}
+
+	info.Duration = duration * float64(timecodeScale) / 1e9
+	if info.Duration <= 0 || info.Duration > StickerMaxDuration {
+		return nil, ErrStickerVideoLong
+	}
+
+	return info, nil
+}
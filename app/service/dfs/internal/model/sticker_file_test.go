@@ -0,0 +1,130 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package model
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"math"
+	"testing"
+
+	"github.com/teamgram/proto/mtproto"
+)
+
+func makeTgs(t *testing.T, lottie string) []byte {
+	var buf bytes.Buffer
+	gw := gzip.NewWriter(&buf)
+	if _, err := gw.Write([]byte(lottie)); err != nil {
+		t.Fatal(err)
+	}
+	gw.Close()
+	return buf.Bytes()
+}
+
+func TestCheckTgsSticker(t *testing.T) {
+	info, err := CheckTgsSticker(makeTgs(t, `{"tgs":1,"v":"5.5.2","fr":60,"ip":0,"op":180,"w":512,"h":512,"layers":[]}`))
+	if err != nil || info.W != 512 || info.Duration != 3 {
+		t.Errorf("CheckTgsSticker - %v, %v", info, err)
+	}
+
+	for _, lottie := range []string{
+		`{"v":"5.5.2","fr":60,"ip":0,"op":180,"w":512,"h":512}`,
+		`{"tgs":1,"fr":60,"ip":0,"op":181,"w":512,"h":512}`,
+		`{"tgs":1,"fr":30,"ip":0,"op":60,"w":256,"h":256}`,
+	} {
+		if _, err = CheckTgsSticker(makeTgs(t, lottie)); err == nil {
+			t.Errorf("CheckTgsSticker(%s) - no error", lottie)
+		}
+	}
+
+	if _, err = CheckTgsSticker([]byte(`{"tgs":1}`)); err != mtproto.ErrStickerTgsNotgs {
+		t.Errorf("CheckTgsSticker - %v", err)
+	}
+}
+
+func ebmlElement(id uint32, body []byte) []byte {
+	var buf bytes.Buffer
+	idBytes := make([]byte, 4)
+	binary.BigEndian.PutUint32(idBytes, id)
+	buf.Write(bytes.TrimLeft(idBytes, "\x00"))
+	// 8 bytes size
+	size := make([]byte, 8)
+	binary.BigEndian.PutUint64(size, uint64(len(body)))
+	size[0] = 0x01
+	buf.Write(size)
+	buf.Write(body)
+	return buf.Bytes()
+}
+
+func ebmlUintBody(v uint64) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, v)
+	return b
+}
+
+func makeWebm(docType string, w, h uint64, duration float64, withAudio bool) []byte {
+	durationBody := make([]byte, 8)
+	binary.BigEndian.PutUint64(durationBody, math.Float64bits(duration))
+
+	tracks := ebmlElement(ebmlIdTrackEntry, bytes.Join([][]byte{
+		ebmlElement(ebmlIdTrackType, ebmlUintBody(ebmlTrackTypeVideo)),
+		ebmlElement(ebmlIdCodecID, []byte(webmStickerCodecVP9)),
+		ebmlElement(ebmlIdVideo, append(ebmlElement(ebmlIdPixelWidth, ebmlUintBody(w)), ebmlElement(ebmlIdPixelHeight, ebmlUintBody(h))...)),
+	}, nil))
+	if withAudio {
+		tracks = append(tracks, ebmlElement(ebmlIdTrackEntry, ebmlElement(ebmlIdTrackType, ebmlUintBody(ebmlTrackTypeAudio)))...)
+	}
+
+	segment := bytes.Join([][]byte{
+		ebmlElement(ebmlIdInfo, append(ebmlElement(ebmlIdTimecodeScale, ebmlUintBody(1000000)), ebmlElement(ebmlIdDuration, durationBody)...)),
+		ebmlElement(ebmlIdTracks, tracks),
+		ebmlElement(ebmlIdCluster, []byte{0x01, 0x02}),
+	}, nil)
+
+	// the segment of a live webm has an unknown size
+	return bytes.Join([][]byte{
+		ebmlElement(ebmlIdHeader, ebmlElement(ebmlIdDocType, []byte(docType))),
+		{0x18, 0x53, 0x80, 0x67, 0x01, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF},
+		segment,
+	}, nil)
+}
+
+func TestCheckWebmSticker(t *testing.T) {
+	info, err := CheckWebmSticker(makeWebm("webm", 512, 320, 2900, false))
+	if err != nil || info.W != 512 || info.H != 320 || info.Duration != 2.9 {
+		t.Errorf("CheckWebmSticker - %v, %v", info, err)
+	}
+
+	if _, err = CheckWebmSticker(makeWebm("matroska", 512, 512, 2900, false)); err != ErrStickerVideoNowebm {
+		t.Errorf("CheckWebmSticker - %v", err)
+	}
+	if _, err = CheckWebmSticker(makeWebm("webm", 512, 512, 3100, false)); err != ErrStickerVideoLong {
+		t.Errorf("CheckWebmSticker - %v", err)
+	}
+	if _, err = CheckWebmSticker(makeWebm("webm", 500, 320, 2900, false)); err != mtproto.ErrStickerFileInvalid {
+		t.Errorf("CheckWebmSticker - %v", err)
+	}
+	if _, err = CheckWebmSticker(makeWebm("webm", 512, 512, 2900, true)); err != mtproto.ErrStickerFileInvalid {
+		t.Errorf("CheckWebmSticker - %v", err)
+	}
+	if _, err = CheckWebmSticker([]byte("not a webm")); err != ErrStickerVideoNowebm {
+		t.Errorf("CheckWebmSticker - %v", err)
+	}
+}
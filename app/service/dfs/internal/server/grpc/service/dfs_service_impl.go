@@ -175,3 +175,81 @@ func (s *Service) DfsUploadThemeFile(ctx context.Context, request *dfs.TLDfsUplo
 	c.Infof("dfs.uploadThemeFile - reply: %s", r.DebugString())
 	return r, err
 }
+
+// DfsGetFileHashes
+// dfs.getFileHashes location:InputFileLocation offset:int = Vector<FileHash>;
+func (s *Service) DfsGetFileHashes(ctx context.Context, request *dfs.TLDfsGetFileHashes) (*dfs.Vector_FileHash, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Infof("dfs.getFileHashes - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.DfsGetFileHashes(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Infof("dfs.getFileHashes - reply: %s", r.DebugString())
+	return r, err
+}
+
+// DfsDownloadFileV2
+// dfs.downloadFileV2 flags:# cdn_supported:flags.0?true location:InputFileLocation offset:int limit:int = upload.File;
+func (s *Service) DfsDownloadFileV2(ctx context.Context, request *dfs.TLDfsDownloadFileV2) (*mtproto.Upload_File, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Infof("dfs.downloadFileV2 - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.DfsDownloadFileV2(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Infof("dfs.downloadFileV2 - reply: {predicate: %s, bytes: %d}",
+		r.GetPredicateName(),
+		len(r.GetBytes()))
+
+	return r, err
+}
+
+// DfsReuploadCdnFile
+// dfs.reuploadCdnFile file_token:bytes request_token:bytes = Vector<FileHash>;
+func (s *Service) DfsReuploadCdnFile(ctx context.Context, request *dfs.TLDfsReuploadCdnFile) (*dfs.Vector_FileHash, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Infof("dfs.reuploadCdnFile - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.DfsReuploadCdnFile(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Infof("dfs.reuploadCdnFile - reply: %s", r.DebugString())
+	return r, err
+}
+
+// DfsGetCdnFileHashes
+// dfs.getCdnFileHashes file_token:bytes offset:int = Vector<FileHash>;
+func (s *Service) DfsGetCdnFileHashes(ctx context.Context, request *dfs.TLDfsGetCdnFileHashes) (*dfs.Vector_FileHash, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Infof("dfs.getCdnFileHashes - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.DfsGetCdnFileHashes(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Infof("dfs.getCdnFileHashes - reply: %s", r.DebugString())
+	return r, err
+}
+
+// DfsGetCdnConfig
+// dfs.getCdnConfig = CdnConfig;
+func (s *Service) DfsGetCdnConfig(ctx context.Context, request *dfs.TLDfsGetCdnConfig) (*mtproto.CdnConfig, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Infof("dfs.getCdnConfig - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.DfsGetCdnConfig(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Infof("dfs.getCdnConfig - reply: %s", r.DebugString())
+	return r, err
+}
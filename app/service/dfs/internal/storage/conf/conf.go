@@ -0,0 +1,44 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package conf
+
+type StorageConfig struct {
+	// Name of the object storage backend, local keeps the objects in a directory of
+	// the dfs node, s3 in minio or any other S3-compatible storage
+	Name  string       `json:",default=s3,options=local|s3"`
+	Local *LocalConfig `json:",optional"`
+	S3    *S3Config    `json:",optional"`
+}
+
+type LocalConfig struct {
+	// Root the objects are stored in Root/bucket/path
+	Root string
+}
+
+type S3Config struct {
+	Endpoint        string
+	AccessKeyID     string
+	SecretAccessKey string
+	UseSSL          bool   `json:",optional"`
+	Region          string `json:",optional"`
+	// PathStyle forces path-style requests, most of the self-hosted storages need it
+	PathStyle bool `json:",optional"`
+	// BucketPrefix is prepended to the bucket names photos, documents, videos and encryptedfiles
+	BucketPrefix string `json:",optional"`
+}
@@ -0,0 +1,272 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package local
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/teamgram/teamgram-server/app/service/dfs/internal/storage/conf"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// tmpFilePrefix the objects being written, they are skipped by ListObjects
+const tmpFilePrefix = ".put-"
+
+type localStorage struct {
+	root string
+}
+
+// New the objects are stored in Local.Root/bucket/path, for the single node installs and tests.
+func New(c *conf.StorageConfig) *localStorage {
+	if c.Local == nil || c.Local.Root == "" {
+		logx.Must(errors.New("storage: local backend requires Storage.Local.Root"))
+	}
+
+	root, err := filepath.Abs(c.Local.Root)
+	logx.Must(err)
+	logx.Must(os.MkdirAll(root, 0755))
+
+	return &localStorage{
+		root: root,
+	}
+}
+
+func checkBucket(bucket string) error {
+	if bucket == "" || bucket == "." || bucket == ".." || strings.ContainsAny(bucket, `/\`) {
+		return fmt.Errorf("storage: invalid bucket %q", bucket)
+	}
+
+	return nil
+}
+
+// objectPath rejects the paths escaping from the bucket
+func (s *localStorage) objectPath(bucket, path string) (string, error) {
+	if err := checkBucket(bucket); err != nil {
+		return "", err
+	}
+
+	name := filepath.Clean(filepath.FromSlash(path))
+	if path == "" || filepath.IsAbs(name) || name == "." || name == ".." ||
+		strings.HasPrefix(name, ".."+string(filepath.Separator)) ||
+		strings.HasPrefix(filepath.Base(name), tmpFilePrefix) {
+		return "", fmt.Errorf("storage: invalid path %q", path)
+	}
+
+	return filepath.Join(s.root, bucket, name), nil
+}
+
+func (s *localStorage) PutObject(ctx context.Context, bucket, path string, r io.Reader, size int64) (int64, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	w, err := s.NewWriter(ctx, bucket, path)
+	if err != nil {
+		return 0, err
+	}
+
+	n, err := io.Copy(w, r)
+	if err == nil && size >= 0 && n != size {
+		err = fmt.Errorf("storage: short write of %s/%s: %d != %d", bucket, path, n, size)
+	}
+	if err != nil {
+		cancel()
+		w.Close()
+		return 0, err
+	}
+
+	if err = w.Close(); err != nil {
+		return 0, err
+	}
+
+	return n, nil
+}
+
+type localWriter struct {
+	ctx  context.Context
+	f    *os.File
+	name string
+}
+
+func (w *localWriter) Write(p []byte) (int, error) {
+	if err := w.ctx.Err(); err != nil {
+		return 0, err
+	}
+
+	return w.f.Write(p)
+}
+
+func (w *localWriter) Close() error {
+	defer os.Remove(w.f.Name())
+
+	err := w.f.Close()
+	if err == nil {
+		err = w.ctx.Err()
+	}
+	if err == nil {
+		err = os.Chmod(w.f.Name(), 0644)
+	}
+	if err == nil {
+		err = os.Rename(w.f.Name(), w.name)
+	}
+
+	return err
+}
+
+func (s *localStorage) NewWriter(ctx context.Context, bucket, path string) (io.WriteCloser, error) {
+	name, err := s.objectPath(bucket, path)
+	if err != nil {
+		return nil, err
+	}
+
+	dir := filepath.Dir(name)
+	if err = os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+
+	// written to a temp file first, the readers never see a partial object
+	f, err := os.CreateTemp(dir, tmpFilePrefix+"*")
+	if err != nil {
+		return nil, err
+	}
+
+	return &localWriter{
+		ctx:  ctx,
+		f:    f,
+		name: name,
+	}, nil
+}
+
+func (s *localStorage) MoveObject(ctx context.Context, srcBucket, srcPath, dstBucket, dstPath string) error {
+	src, err := s.objectPath(srcBucket, srcPath)
+	if err != nil {
+		return err
+	}
+	dst, err := s.objectPath(dstBucket, dstPath)
+	if err != nil {
+		return err
+	}
+
+	if err = os.MkdirAll(filepath.Dir(dst), 0755); err != nil {
+		return err
+	}
+
+	return os.Rename(src, dst)
+}
+
+func (s *localStorage) GetObject(ctx context.Context, bucket, path string, offset, limit int64) ([]byte, error) {
+	if offset < 0 || limit <= 0 {
+		return nil, fmt.Errorf("storage: invalid range of %s/%s: offset %d, limit %d", bucket, path, offset, limit)
+	}
+
+	name, err := s.objectPath(bucket, path)
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	b := make([]byte, limit)
+	n, err := f.ReadAt(b, offset)
+	if n > 0 {
+		return b[:n], nil
+	}
+	if err == nil {
+		err = io.EOF
+	}
+
+	return nil, err
+}
+
+func (s *localStorage) OpenObject(ctx context.Context, bucket, path string) (io.ReadCloser, int64, error) {
+	name, err := s.objectPath(bucket, path)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, 0, err
+	}
+	fi, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return nil, 0, err
+	}
+
+	return f, fi.Size(), nil
+}
+
+func (s *localStorage) StatObject(ctx context.Context, bucket, path string) (int64, error) {
+	name, err := s.objectPath(bucket, path)
+	if err != nil {
+		return 0, err
+	}
+
+	fi, err := os.Stat(name)
+	if err != nil {
+		return 0, err
+	}
+	if fi.IsDir() {
+		return 0, fmt.Errorf("storage: %s/%s is not an object", bucket, path)
+	}
+
+	return fi.Size(), nil
+}
+
+func (s *localStorage) ListObjects(ctx context.Context, bucket string, fn func(path string) error) error {
+	if err := checkBucket(bucket); err != nil {
+		return err
+	}
+
+	dir := filepath.Join(s.root, bucket)
+	if _, err := os.Stat(dir); os.IsNotExist(err) {
+		// nothing has been put into bucket yet
+		return nil
+	}
+
+	return filepath.WalkDir(dir, func(name string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if err = ctx.Err(); err != nil {
+			return err
+		}
+		if d.IsDir() || strings.HasPrefix(d.Name(), tmpFilePrefix) {
+			return nil
+		}
+
+		path, err := filepath.Rel(dir, name)
+		if err != nil {
+			return err
+		}
+		return fn(filepath.ToSlash(path))
+	})
+}
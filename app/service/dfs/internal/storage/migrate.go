@@ -0,0 +1,59 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package storage
+
+import (
+	"context"
+	"fmt"
+)
+
+type MigrateResult struct {
+	Copied  int
+	Skipped int
+}
+
+// Migrate copies the objects of bucket from src to dst, the objects already in dst
+// with the same size are skipped unless overwrite.
+func Migrate(ctx context.Context, src, dst Storage, bucket string, overwrite bool) (*MigrateResult, error) {
+	r := new(MigrateResult)
+
+	err := src.ListObjects(ctx, bucket, func(path string) error {
+		reader, size, err := src.OpenObject(ctx, bucket, path)
+		if err != nil {
+			return fmt.Errorf("open %s/%s: %w", bucket, path, err)
+		}
+		defer reader.Close()
+
+		if !overwrite {
+			if dstSize, err2 := dst.StatObject(ctx, bucket, path); err2 == nil && dstSize == size {
+				r.Skipped++
+				return nil
+			}
+		}
+
+		if _, err = dst.PutObject(ctx, bucket, path, reader, size); err != nil {
+			return fmt.Errorf("put %s/%s: %w", bucket, path, err)
+		}
+		r.Copied++
+
+		return nil
+	})
+
+	return r, err
+}
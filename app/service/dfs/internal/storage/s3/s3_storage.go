@@ -0,0 +1,322 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package s3
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"path/filepath"
+
+	"github.com/teamgram/teamgram-server/app/service/dfs/internal/model"
+	"github.com/teamgram/teamgram-server/app/service/dfs/internal/storage/conf"
+
+	"github.com/minio/minio-go"
+	"github.com/minio/minio-go/pkg/credentials"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// partSize the parts of the multipart uploads, every part but the last must be at least 5 MB
+const partSize = 8 << 20
+
+type s3Storage struct {
+	core         *minio.Core
+	bucketPrefix string
+}
+
+// New the objects are stored in minio or any other S3-compatible storage, the buckets must exist.
+func New(c *conf.StorageConfig) *s3Storage {
+	if c.S3 == nil || c.S3.Endpoint == "" {
+		logx.Must(errors.New("storage: s3 backend requires Storage.S3.Endpoint"))
+	}
+
+	options := &minio.Options{
+		Creds:        credentials.NewStaticV4(c.S3.AccessKeyID, c.S3.SecretAccessKey, ""),
+		Secure:       c.S3.UseSSL,
+		Region:       c.S3.Region,
+		BucketLookup: minio.BucketLookupAuto,
+	}
+	if c.S3.PathStyle {
+		options.BucketLookup = minio.BucketLookupPath
+	}
+
+	client, err := minio.NewWithOptions(c.S3.Endpoint, options)
+	logx.Must(err)
+
+	return &s3Storage{
+		core:         &minio.Core{Client: client},
+		bucketPrefix: c.S3.BucketPrefix,
+	}
+}
+
+func (s *s3Storage) bucketName(bucket string) string {
+	return s.bucketPrefix + bucket
+}
+
+func contentType(path string) string {
+	if ext := filepath.Ext(path); model.IsFileExtImage(ext) {
+		return model.GetImageMimeType(ext)
+	}
+
+	return "binary/octet-stream"
+}
+
+func (s *s3Storage) PutObject(ctx context.Context, bucket, path string, r io.Reader, size int64) (int64, error) {
+	if size >= 0 && size <= partSize {
+		return s.core.Client.PutObjectWithContext(
+			ctx,
+			s.bucketName(bucket),
+			path,
+			r,
+			size,
+			minio.PutObjectOptions{
+				ContentType: contentType(path),
+			})
+	}
+
+	// minio-go buffers a part of 550 MB for the objects of unknown size
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	w, err := s.NewWriter(ctx, bucket, path)
+	if err != nil {
+		return 0, err
+	}
+
+	n, err := io.Copy(w, r)
+	if err == nil && size >= 0 && n != size {
+		err = fmt.Errorf("storage: short write of %s/%s: %d != %d", bucket, path, n, size)
+	}
+	if err != nil {
+		cancel()
+		w.Close()
+		return 0, err
+	}
+
+	if err = w.Close(); err != nil {
+		return 0, err
+	}
+
+	return n, nil
+}
+
+// s3Writer uploads the object in parts of partSize, an object smaller than
+// a part is put at Close without a multipart upload.
+type s3Writer struct {
+	ctx      context.Context
+	s        *s3Storage
+	bucket   string
+	path     string
+	uploadId string
+	buf      []byte
+	parts    []minio.CompletePart
+	err      error
+}
+
+func (w *s3Writer) Write(p []byte) (int, error) {
+	if w.err != nil {
+		return 0, w.err
+	}
+	if w.err = w.ctx.Err(); w.err != nil {
+		return 0, w.err
+	}
+
+	if w.buf == nil {
+		w.buf = make([]byte, 0, partSize)
+	}
+
+	n := len(p)
+	for len(p) > 0 {
+		l := copy(w.buf[len(w.buf):cap(w.buf)], p)
+		w.buf = w.buf[:len(w.buf)+l]
+		p = p[l:]
+		if len(w.buf) == cap(w.buf) {
+			if w.err = w.flushPart(); w.err != nil {
+				return 0, w.err
+			}
+		}
+	}
+
+	return n, nil
+}
+
+func (w *s3Writer) flushPart() error {
+	if w.uploadId == "" {
+		uploadId, err := w.s.core.NewMultipartUpload(w.bucket, w.path, minio.PutObjectOptions{
+			ContentType: contentType(w.path),
+		})
+		if err != nil {
+			return err
+		}
+		w.uploadId = uploadId
+	}
+
+	part, err := w.s.core.PutObjectPart(
+		w.bucket,
+		w.path,
+		w.uploadId,
+		len(w.parts)+1,
+		bytes.NewReader(w.buf),
+		int64(len(w.buf)),
+		"",
+		"",
+		nil)
+	if err != nil {
+		return err
+	}
+
+	w.parts = append(w.parts, minio.CompletePart{
+		PartNumber: part.PartNumber,
+		ETag:       part.ETag,
+	})
+	w.buf = w.buf[:0]
+
+	return nil
+}
+
+func (w *s3Writer) Close() error {
+	err := w.err
+	if err == nil {
+		err = w.ctx.Err()
+	}
+
+	if err == nil && w.uploadId == "" {
+		_, err = w.s.core.Client.PutObjectWithContext(
+			w.ctx,
+			w.bucket,
+			w.path,
+			bytes.NewReader(w.buf),
+			int64(len(w.buf)),
+			minio.PutObjectOptions{
+				ContentType: contentType(w.path),
+			})
+		w.buf = nil
+		return err
+	}
+
+	if err == nil && len(w.buf) > 0 {
+		err = w.flushPart()
+	}
+	if err == nil {
+		_, err = w.s.core.CompleteMultipartUpload(w.bucket, w.path, w.uploadId, w.parts)
+	}
+	if err != nil && w.uploadId != "" {
+		if err2 := w.s.core.AbortMultipartUpload(w.bucket, w.path, w.uploadId); err2 != nil {
+			logx.Errorf("storage: abort the upload of %s/%s error: %v", w.bucket, w.path, err2)
+		}
+	}
+	w.buf = nil
+
+	return err
+}
+
+func (s *s3Storage) NewWriter(ctx context.Context, bucket, path string) (io.WriteCloser, error) {
+	return &s3Writer{
+		ctx:    ctx,
+		s:      s,
+		bucket: s.bucketName(bucket),
+		path:   path,
+	}, nil
+}
+
+func (s *s3Storage) MoveObject(ctx context.Context, srcBucket, srcPath, dstBucket, dstPath string) error {
+	dst, err := minio.NewDestinationInfo(s.bucketName(dstBucket), dstPath, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	// the objects larger than 5 GB are copied in parts by the storage
+	err = s.core.Client.ComposeObject(dst, []minio.SourceInfo{minio.NewSourceInfo(s.bucketName(srcBucket), srcPath, nil)})
+	if err != nil {
+		return err
+	}
+
+	return s.core.Client.RemoveObject(s.bucketName(srcBucket), srcPath)
+}
+
+func (s *s3Storage) GetObject(ctx context.Context, bucket, path string, offset, limit int64) ([]byte, error) {
+	if offset < 0 || limit <= 0 {
+		return nil, fmt.Errorf("storage: invalid range of %s/%s: offset %d, limit %d", bucket, path, offset, limit)
+	}
+
+	// only the range is transferred
+	options := minio.GetObjectOptions{}
+	if err := options.SetRange(offset, offset+limit-1); err != nil {
+		return nil, err
+	}
+
+	object, _, err := s.core.GetObject(s.bucketName(bucket), path, options)
+	if err != nil {
+		if minio.ToErrorResponse(err).Code == "InvalidRange" {
+			return nil, io.EOF
+		}
+		return nil, err
+	}
+	defer object.Close()
+
+	b, err := ioutil.ReadAll(io.LimitReader(object, limit))
+	if err != nil {
+		return nil, err
+	}
+	if len(b) == 0 {
+		return nil, io.EOF
+	}
+
+	return b, nil
+}
+
+func (s *s3Storage) OpenObject(ctx context.Context, bucket, path string) (io.ReadCloser, int64, error) {
+	object, info, err := s.core.GetObject(s.bucketName(bucket), path, minio.GetObjectOptions{})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return object, info.Size, nil
+}
+
+func (s *s3Storage) StatObject(ctx context.Context, bucket, path string) (int64, error) {
+	info, err := s.core.Client.StatObject(s.bucketName(bucket), path, minio.StatObjectOptions{})
+	if err != nil {
+		return 0, err
+	}
+
+	return info.Size, nil
+}
+
+func (s *s3Storage) ListObjects(ctx context.Context, bucket string, fn func(path string) error) error {
+	doneCh := make(chan struct{})
+	defer close(doneCh)
+
+	for object := range s.core.Client.ListObjectsV2(s.bucketName(bucket), "", true, doneCh) {
+		if object.Err != nil {
+			return object.Err
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := fn(object.Key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,75 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package storage
+
+import (
+	"context"
+	"io"
+
+	"github.com/teamgram/teamgram-server/app/service/dfs/internal/storage/conf"
+	"github.com/teamgram/teamgram-server/app/service/dfs/internal/storage/local"
+	"github.com/teamgram/teamgram-server/app/service/dfs/internal/storage/s3"
+)
+
+const (
+	BucketPhotos         = "photos"
+	BucketDocuments      = "documents"
+	BucketVideos         = "videos"
+	BucketEncryptedFiles = "encryptedfiles"
+)
+
+// Buckets all the buckets of dfs
+var Buckets = []string{
+	BucketPhotos,
+	BucketDocuments,
+	BucketVideos,
+	BucketEncryptedFiles,
+}
+
+type Storage interface {
+	// PutObject stores r as bucket/path, size is -1 if unknown.
+	PutObject(ctx context.Context, bucket, path string, r io.Reader, size int64) (int64, error)
+	// NewWriter returns a writer of bucket/path which keeps at most a part of the object in
+	// memory, the object is visible after Close. Cancel ctx before Close to abort the write.
+	NewWriter(ctx context.Context, bucket, path string) (io.WriteCloser, error)
+	// MoveObject renames srcBucket/srcPath to dstBucket/dstPath without transferring it through dfs.
+	MoveObject(ctx context.Context, srcBucket, srcPath, dstBucket, dstPath string) error
+	// GetObject reads at most limit bytes of bucket/path from offset, it returns
+	// io.EOF if offset is not before the end of the object.
+	GetObject(ctx context.Context, bucket, path string, offset, limit int64) ([]byte, error)
+	// OpenObject opens the whole bucket/path for reading and returns its size.
+	OpenObject(ctx context.Context, bucket, path string) (io.ReadCloser, int64, error)
+	StatObject(ctx context.Context, bucket, path string) (int64, error)
+	// ListObjects calls fn with the path of every object in bucket.
+	ListObjects(ctx context.Context, bucket string, fn func(path string) error) error
+}
+
+func New(c *conf.StorageConfig) Storage {
+	if c == nil {
+		c = new(conf.StorageConfig)
+	}
+
+	switch c.Name {
+	case "local":
+		return local.New(c)
+	case "s3":
+		return s3.New(c)
+	}
+	return s3.New(c)
+}
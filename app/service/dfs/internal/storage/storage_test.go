@@ -0,0 +1,173 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package storage
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"testing"
+
+	"github.com/teamgram/teamgram-server/app/service/dfs/internal/storage/conf"
+)
+
+func newLocalStorage(t *testing.T) Storage {
+	return New(&conf.StorageConfig{
+		Name: "local",
+		Local: &conf.LocalConfig{
+			Root: t.TempDir(),
+		},
+	})
+}
+
+func TestLocalStorage(t *testing.T) {
+	var (
+		ctx  = context.Background()
+		s    = newLocalStorage(t)
+		data = bytes.Repeat([]byte("0123456789"), 100)
+	)
+
+	n, err := s.PutObject(ctx, BucketDocuments, "1.dat", bytes.NewReader(data), int64(len(data)))
+	if err != nil || n != int64(len(data)) {
+		t.Fatalf("PutObject: %d, %v", n, err)
+	}
+
+	if size, err := s.StatObject(ctx, BucketDocuments, "1.dat"); err != nil || size != int64(len(data)) {
+		t.Errorf("StatObject: %d, %v", size, err)
+	}
+
+	for _, c := range []struct {
+		offset, limit int64
+		want          []byte
+	}{
+		{0, 10, data[:10]},
+		{995, 10, data[995:]},
+		{512, 256, data[512:768]},
+	} {
+		b, err := s.GetObject(ctx, BucketDocuments, "1.dat", c.offset, c.limit)
+		if err != nil || !bytes.Equal(b, c.want) {
+			t.Errorf("GetObject(%d, %d): %d bytes, %v", c.offset, c.limit, len(b), err)
+		}
+	}
+
+	if _, err = s.GetObject(ctx, BucketDocuments, "1.dat", 1000, 10); err != io.EOF {
+		t.Errorf("GetObject after the end: %v", err)
+	}
+	if _, err = s.GetObject(ctx, BucketPhotos, "1.dat", 0, 10); err == nil {
+		t.Errorf("GetObject from another bucket")
+	}
+
+	for _, path := range []string{"", "../1.dat", "a/../../1.dat", "/etc/passwd"} {
+		if _, err = s.PutObject(ctx, BucketDocuments, path, bytes.NewReader(data), -1); err == nil {
+			t.Errorf("PutObject(%q) must fail", path)
+		}
+	}
+	if _, err = s.PutObject(ctx, "../"+BucketDocuments, "1.dat", bytes.NewReader(data), -1); err == nil {
+		t.Errorf("PutObject to an invalid bucket must fail")
+	}
+}
+
+func TestLocalWriter(t *testing.T) {
+	var (
+		s    = newLocalStorage(t)
+		data = bytes.Repeat([]byte("0123456789"), 100)
+	)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	w, err := s.NewWriter(ctx, BucketDocuments, "uploads/1.dat")
+	if err != nil {
+		t.Fatal(err)
+	}
+	w.Write(data)
+	cancel()
+	if err = w.Close(); err == nil {
+		t.Errorf("Close after cancel must fail")
+	}
+	if _, err = s.StatObject(context.Background(), BucketDocuments, "uploads/1.dat"); err == nil {
+		t.Errorf("the aborted object is visible")
+	}
+
+	ctx = context.Background()
+	if w, err = s.NewWriter(ctx, BucketDocuments, "uploads/1.dat"); err != nil {
+		t.Fatal(err)
+	}
+	w.Write(data[:500])
+	w.Write(data[500:])
+	if err = w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = s.MoveObject(ctx, BucketDocuments, "uploads/1.dat", BucketVideos, "1.dat"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = s.StatObject(ctx, BucketDocuments, "uploads/1.dat"); err == nil {
+		t.Errorf("MoveObject: the source is still visible")
+	}
+	if b, err := s.GetObject(ctx, BucketVideos, "1.dat", 0, 2000); err != nil || !bytes.Equal(b, data) {
+		t.Errorf("MoveObject: %d bytes, %v", len(b), err)
+	}
+}
+
+func TestMigrate(t *testing.T) {
+	var (
+		ctx = context.Background()
+		src = newLocalStorage(t)
+		dst = newLocalStorage(t)
+	)
+
+	objects := map[string][]byte{
+		"1.dat":       []byte("document"),
+		"a/2.jpg":     []byte("photo"),
+		"a/b/3.dat":   bytes.Repeat([]byte{1}, 4096),
+		"a/b/4.empty": {},
+	}
+	for path, data := range objects {
+		if _, err := src.PutObject(ctx, BucketDocuments, path, bytes.NewReader(data), int64(len(data))); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := dst.PutObject(ctx, BucketDocuments, "1.dat", bytes.NewReader(objects["1.dat"]), -1); err != nil {
+		t.Fatal(err)
+	}
+
+	r, err := Migrate(ctx, src, dst, BucketDocuments, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.Copied != 3 || r.Skipped != 1 {
+		t.Errorf("Migrate: %+v", r)
+	}
+
+	for path, data := range objects {
+		rc, size, err := dst.OpenObject(ctx, BucketDocuments, path)
+		if err != nil {
+			t.Fatalf("OpenObject(%s): %v", path, err)
+		}
+		b, _ := io.ReadAll(rc)
+		rc.Close()
+		if size != int64(len(data)) || !bytes.Equal(b, data) {
+			t.Errorf("%s: %d bytes", path, len(b))
+		}
+	}
+
+	// an empty bucket
+	if r, err = Migrate(ctx, src, dst, BucketVideos, false); err != nil || r.Copied != 0 {
+		t.Errorf("Migrate(%s): %+v, %v", BucketVideos, r, err)
+	}
+}
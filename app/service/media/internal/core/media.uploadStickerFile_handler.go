@@ -11,14 +11,53 @@ package core
 
 import (
 	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/dfs/dfs"
 	"github.com/teamgram/teamgram-server/app/service/media/media"
 )
 
 // MediaUploadStickerFile
 // media.uploadStickerFile flags:# owner_id:long file:InputFile thumb:flags.0?InputFile mime_type:string file_name:string document_attribute_sticker:DocumentAttribute = Document;
 func (c *MediaCore) MediaUploadStickerFile(in *media.TLMediaUploadStickerFile) (*mtproto.Document, error) {
-	// TODO: not impl
-	c.Logger.Errorf("media.uploadStickerFile - error: method MediaUploadStickerFile not impl")
+	switch in.GetMimeType() {
+	case "image/webp", "image/png", "application/x-tgsticker", "video/webm":
+	default:
+		err := mtproto.ErrStickerFileInvalid
+		c.Logger.Errorf("media.uploadStickerFile - error: %v", err)
+		return nil, err
+	}
 
-	return nil, mtproto.ErrMethodNotImpl
+	if in.GetDocumentAttributeSticker().GetPredicateName() != mtproto.Predicate_documentAttributeSticker {
+		err := mtproto.ErrStickerFileInvalid
+		c.Logger.Errorf("media.uploadStickerFile - error: %v", err)
+		return nil, err
+	}
+
+	media := mtproto.MakeTLInputMediaUploadedDocument(&mtproto.InputMedia{
+		File:     in.GetFile(),
+		Thumb:    in.GetThumb(),
+		MimeType: in.GetMimeType(),
+		Attributes: []*mtproto.DocumentAttribute{
+			in.GetDocumentAttributeSticker(),
+			mtproto.MakeTLDocumentAttributeFilename(&mtproto.DocumentAttribute{
+				FileName: in.GetFileName(),
+			}).To_DocumentAttribute(),
+		},
+	}).To_InputMedia()
+
+	// dfs checks the animated and the video stickers
+	document, err := c.svcCtx.Dao.DfsClient.DfsUploadDocumentFileV2(c.ctx, &dfs.TLDfsUploadDocumentFileV2{
+		Creator: in.GetOwnerId(),
+		Media:   media,
+	})
+	if err != nil {
+		c.Logger.Errorf("media.uploadStickerFile - error: %v", err)
+		return nil, err
+	}
+
+	if len(document.GetThumbs()) > 0 {
+		c.svcCtx.Dao.SavePhotoSizeV2(c.ctx, document.GetId(), document.GetThumbs())
+	}
+	c.svcCtx.Dao.SaveDocumentV2(c.ctx, in.GetFileName(), document)
+
+	return document, nil
 }
@@ -0,0 +1,75 @@
+/*
+ * WARNING! All changes made in this file will be lost!
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package poll_client
+
+import (
+	"context"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/poll/poll"
+
+	"github.com/zeromicro/go-zero/zrpc"
+)
+
+var _ *mtproto.Bool
+
+type PollClient interface {
+	PollCreateMediaPoll(ctx context.Context, in *poll.TLPollCreateMediaPoll) (*mtproto.MessageMedia, error)
+	PollGetMediaPoll(ctx context.Context, in *poll.TLPollGetMediaPoll) (*mtproto.MessageMedia, error)
+	PollCloseMediaPoll(ctx context.Context, in *poll.TLPollCloseMediaPoll) (*mtproto.MessageMedia, error)
+	PollSendVote(ctx context.Context, in *poll.TLPollSendVote) (*mtproto.MessageMedia, error)
+	PollGetPollVotes(ctx context.Context, in *poll.TLPollGetPollVotes) (*mtproto.Messages_VotesList, error)
+}
+
+type defaultPollClient struct {
+	cli zrpc.Client
+}
+
+func NewPollClient(cli zrpc.Client) PollClient {
+	return &defaultPollClient{
+		cli: cli,
+	}
+}
+
+// PollCreateMediaPoll
+// poll.createMediaPoll flags:# user_id:long correct_answers:flags.0?Vector<bytes> poll:Poll solution:flags.1?string solution_entities:flags.1?Vector<MessageEntity> = MessageMedia;
+func (m *defaultPollClient) PollCreateMediaPoll(ctx context.Context, in *poll.TLPollCreateMediaPoll) (*mtproto.MessageMedia, error) {
+	client := poll.NewRPCPollClient(m.cli.Conn())
+	return client.PollCreateMediaPoll(ctx, in)
+}
+
+// PollGetMediaPoll
+// poll.getMediaPoll user_id:long poll_id:long = MessageMedia;
+func (m *defaultPollClient) PollGetMediaPoll(ctx context.Context, in *poll.TLPollGetMediaPoll) (*mtproto.MessageMedia, error) {
+	client := poll.NewRPCPollClient(m.cli.Conn())
+	return client.PollGetMediaPoll(ctx, in)
+}
+
+// PollCloseMediaPoll
+// poll.closeMediaPoll user_id:long poll_id:long = MessageMedia;
+func (m *defaultPollClient) PollCloseMediaPoll(ctx context.Context, in *poll.TLPollCloseMediaPoll) (*mtproto.MessageMedia, error) {
+	client := poll.NewRPCPollClient(m.cli.Conn())
+	return client.PollCloseMediaPoll(ctx, in)
+}
+
+// PollSendVote
+// poll.sendVote user_id:long poll_id:long options:Vector<bytes> = MessageMedia;
+func (m *defaultPollClient) PollSendVote(ctx context.Context, in *poll.TLPollSendVote) (*mtproto.MessageMedia, error) {
+	client := poll.NewRPCPollClient(m.cli.Conn())
+	return client.PollSendVote(ctx, in)
+}
+
+// PollGetPollVotes
+// poll.getPollVotes flags:# user_id:long poll_id:long option:flags.0?bytes offset:flags.1?string limit:int = messages.VotesList;
+func (m *defaultPollClient) PollGetPollVotes(ctx context.Context, in *poll.TLPollGetPollVotes) (*mtproto.Messages_VotesList, error) {
+	client := poll.NewRPCPollClient(m.cli.Conn())
+	return client.PollGetPollVotes(ctx, in)
+}
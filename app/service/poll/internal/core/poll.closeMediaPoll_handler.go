@@ -0,0 +1,47 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"time"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/poll/internal/dao"
+	"github.com/teamgram/teamgram-server/app/service/poll/poll"
+)
+
+// PollCloseMediaPoll
+// poll.closeMediaPoll user_id:long poll_id:long = MessageMedia;
+func (c *PollCore) PollCloseMediaPoll(in *poll.TLPollCloseMediaPoll) (*mtproto.MessageMedia, error) {
+	pollsDO, err := c.svcCtx.Dao.GetPoll(c.ctx, in.PollId)
+	if err != nil {
+		c.Logger.Errorf("poll.closeMediaPoll - error: %v", err)
+		return nil, err
+	}
+
+	if pollsDO.Creator != in.UserId {
+		err = mtproto.ErrMessageAuthorRequired
+		c.Logger.Errorf("poll.closeMediaPoll - error: %v", err)
+		return nil, err
+	}
+	if dao.IsPollClosed(pollsDO, time.Now().Unix()) {
+		err = mtproto.ErrMessagePollClosed
+		c.Logger.Errorf("poll.closeMediaPoll - error: %v", err)
+		return nil, err
+	}
+
+	if _, err = c.svcCtx.Dao.PollsDAO.UpdateClosed(c.ctx, in.PollId); err != nil {
+		c.Logger.Errorf("poll.closeMediaPoll - error: %v", err)
+		return nil, err
+	}
+	pollsDO.Closed = true
+
+	return c.svcCtx.Dao.MakeMediaPoll(c.ctx, in.UserId, pollsDO)
+}
@@ -0,0 +1,79 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"time"
+
+	"github.com/teamgram/marmota/pkg/hack"
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/poll/internal/dal/dataobject"
+	"github.com/teamgram/teamgram-server/app/service/poll/poll"
+
+	"github.com/zeromicro/go-zero/core/jsonx"
+)
+
+// PollCreateMediaPoll
+// poll.createMediaPoll flags:# user_id:long correct_answers:flags.0?Vector<bytes> poll:Poll solution:flags.1?string solution_entities:flags.1?Vector<MessageEntity> = MessageMedia;
+func (c *PollCore) PollCreateMediaPoll(in *poll.TLPollCreateMediaPoll) (*mtproto.MessageMedia, error) {
+	var (
+		inPoll = in.GetPoll()
+		now    = time.Now().Unix()
+	)
+
+	if err := checkPoll(inPoll, in.CorrectAnswers); err != nil {
+		c.Logger.Errorf("poll.createMediaPoll - error: %v", err)
+		return nil, err
+	}
+
+	pollId := c.svcCtx.Dao.IDGenClient2.NextId(c.ctx)
+	if pollId == 0 {
+		err := mtproto.ErrInternelServerError
+		c.Logger.Errorf("poll.createMediaPoll - error: %v", err)
+		return nil, err
+	}
+
+	answers, _ := jsonx.Marshal(inPoll.Answers)
+	pollsDO := &dataobject.PollsDO{
+		PollId:           pollId,
+		Creator:          in.UserId,
+		Question:         inPoll.Question,
+		Answers:          hack.String(answers),
+		Closed:           inPoll.Closed,
+		PublicVoters:     inPoll.PublicVoters,
+		MultipleChoice:   inPoll.MultipleChoice,
+		Quiz:             inPoll.Quiz,
+		ClosePeriod:      inPoll.GetClosePeriod().GetValue(),
+		CloseDate:        int64(inPoll.GetCloseDate().GetValue()),
+		CorrectAnswers:   "[]",
+		Solution:         "",
+		SolutionEntities: "[]",
+		Date2:            now,
+	}
+	if pollsDO.CloseDate == 0 && pollsDO.ClosePeriod > 0 {
+		pollsDO.CloseDate = now + int64(pollsDO.ClosePeriod)
+	}
+	if inPoll.Quiz {
+		correctAnswers, _ := jsonx.Marshal(in.CorrectAnswers)
+		pollsDO.CorrectAnswers = hack.String(correctAnswers)
+		pollsDO.Solution = in.GetSolution().GetValue()
+		if len(in.SolutionEntities) > 0 {
+			solutionEntities, _ := jsonx.Marshal(in.SolutionEntities)
+			pollsDO.SolutionEntities = hack.String(solutionEntities)
+		}
+	}
+
+	if _, _, err := c.svcCtx.Dao.PollsDAO.Insert(c.ctx, pollsDO); err != nil {
+		c.Logger.Errorf("poll.createMediaPoll - error: %v", err)
+		return nil, err
+	}
+
+	return c.svcCtx.Dao.MakeMediaPoll(c.ctx, in.UserId, pollsDO)
+}
@@ -0,0 +1,27 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/poll/poll"
+)
+
+// PollGetMediaPoll
+// poll.getMediaPoll user_id:long poll_id:long = MessageMedia;
+func (c *PollCore) PollGetMediaPoll(in *poll.TLPollGetMediaPoll) (*mtproto.MessageMedia, error) {
+	pollsDO, err := c.svcCtx.Dao.GetPoll(c.ctx, in.PollId)
+	if err != nil {
+		c.Logger.Errorf("poll.getMediaPoll - error: %v", err)
+		return nil, err
+	}
+
+	return c.svcCtx.Dao.MakeMediaPoll(c.ctx, in.UserId, pollsDO)
+}
@@ -0,0 +1,158 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"math"
+	"strconv"
+
+	"github.com/teamgram/marmota/pkg/hack"
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/poll/internal/dal/dataobject"
+	"github.com/teamgram/teamgram-server/app/service/poll/poll"
+)
+
+const (
+	pollVotesLimitMax = 50
+)
+
+// PollGetPollVotes
+// poll.getPollVotes flags:# user_id:long poll_id:long option:flags.0?bytes offset:flags.1?string limit:int = messages.VotesList;
+func (c *PollCore) PollGetPollVotes(in *poll.TLPollGetPollVotes) (*mtproto.Messages_VotesList, error) {
+	pollsDO, err := c.svcCtx.Dao.GetPoll(c.ctx, in.PollId)
+	if err != nil {
+		c.Logger.Errorf("poll.getPollVotes - error: %v", err)
+		return nil, err
+	}
+
+	if !pollsDO.PublicVoters {
+		err = mtproto.ErrBroadcastForbidden
+		c.Logger.Errorf("poll.getPollVotes - error: %v", err)
+		return nil, err
+	}
+
+	voters, err := c.svcCtx.Dao.PollAnswerVotersDAO.SelectList(c.ctx, in.PollId)
+	if err != nil {
+		c.Logger.Errorf("poll.getPollVotes - error: %v", err)
+		return nil, err
+	}
+
+	// the voters are seen after voting
+	voted := false
+	for i := range voters {
+		if voters[i].VoteUserId == in.UserId {
+			voted = true
+			break
+		}
+	}
+	if !voted && pollsDO.Creator != in.UserId {
+		err = mtproto.ErrPollVoteRequired
+		c.Logger.Errorf("poll.getPollVotes - error: %v", err)
+		return nil, err
+	}
+
+	var (
+		offset int64 = math.MaxInt64
+		limit        = in.Limit
+		rList  []dataobject.PollAnswerVotersDO
+	)
+	if in.GetOffset().GetValue() != "" {
+		offset, err = strconv.ParseInt(in.GetOffset().GetValue(), 10, 64)
+		if err != nil {
+			err = mtproto.ErrOffsetInvalid
+			c.Logger.Errorf("poll.getPollVotes - error: %v", err)
+			return nil, err
+		}
+	}
+	if limit <= 0 || limit > pollVotesLimitMax {
+		limit = pollVotesLimitMax
+	}
+
+	votesList := mtproto.MakeTLMessagesVotesList(&mtproto.Messages_VotesList{
+		Count:      0,
+		Votes:      []*mtproto.MessageUserVote{},
+		Users:      []*mtproto.User{},
+		NextOffset: nil,
+	}).To_Messages_VotesList()
+
+	if in.Option != nil {
+		option := hack.String(in.Option)
+		for i := range voters {
+			if voters[i].AnswerOption == option {
+				votesList.Count++
+			}
+		}
+		rList, err = c.svcCtx.Dao.PollAnswerVotersDAO.SelectVotesListByOption(c.ctx, in.PollId, option, offset, limit)
+		if err != nil {
+			c.Logger.Errorf("poll.getPollVotes - error: %v", err)
+			return nil, err
+		}
+		for i := range rList {
+			votesList.Votes = append(votesList.Votes, mtproto.MakeTLMessageUserVoteInputOption(&mtproto.MessageUserVote{
+				UserId: rList[i].VoteUserId,
+				Date:   int32(rList[i].Date2),
+			}).To_MessageUserVote())
+		}
+		if len(rList) == int(limit) {
+			votesList.NextOffset = mtproto.MakeFlagsString(strconv.FormatInt(rList[len(rList)-1].Id, 10))
+		}
+
+		return votesList, nil
+	}
+
+	users := make(map[int64]bool)
+	for i := range voters {
+		users[voters[i].VoteUserId] = true
+	}
+	votesList.Count = int32(len(users))
+
+	// one vote has up to len(answers) rows, the rows of one vote are inserted together
+	rList, err = c.svcCtx.Dao.PollAnswerVotersDAO.SelectVotesList(c.ctx, in.PollId, offset, limit*pollAnswersMax)
+	if err != nil {
+		c.Logger.Errorf("poll.getPollVotes - error: %v", err)
+		return nil, err
+	}
+	full := len(rList) == int(limit*pollAnswersMax)
+	for i := 0; i < len(rList); {
+		j := i + 1
+		for j < len(rList) && rList[j].VoteUserId == rList[i].VoteUserId {
+			j++
+		}
+		// the last vote of a full page may continue on the next page
+		if len(votesList.Votes) == int(limit) || (full && j == len(rList) && i > 0) {
+			votesList.NextOffset = mtproto.MakeFlagsString(strconv.FormatInt(rList[i-1].Id, 10))
+			break
+		}
+		votesList.Votes = append(votesList.Votes, makeMessageUserVote(pollsDO.MultipleChoice, rList[i:j]))
+		i = j
+	}
+
+	return votesList, nil
+}
+
+func makeMessageUserVote(multipleChoice bool, rows []dataobject.PollAnswerVotersDO) *mtproto.MessageUserVote {
+	if !multipleChoice {
+		return mtproto.MakeTLMessageUserVote(&mtproto.MessageUserVote{
+			UserId: rows[0].VoteUserId,
+			Option: []byte(rows[0].AnswerOption),
+			Date:   int32(rows[0].Date2),
+		}).To_MessageUserVote()
+	}
+
+	options := make([][]byte, 0, len(rows))
+	for i := len(rows) - 1; i >= 0; i-- {
+		options = append(options, []byte(rows[i].AnswerOption))
+	}
+	return mtproto.MakeTLMessageUserVoteMultiple(&mtproto.MessageUserVote{
+		UserId:  rows[0].VoteUserId,
+		Options: options,
+		Date:    int32(rows[0].Date2),
+	}).To_MessageUserVote()
+}
@@ -0,0 +1,81 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"time"
+
+	"github.com/teamgram/marmota/pkg/hack"
+	"github.com/teamgram/marmota/pkg/stores/sqlx"
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/poll/internal/dal/dataobject"
+	"github.com/teamgram/teamgram-server/app/service/poll/internal/dao"
+	"github.com/teamgram/teamgram-server/app/service/poll/poll"
+)
+
+// PollSendVote
+// poll.sendVote user_id:long poll_id:long options:Vector<bytes> = MessageMedia;
+func (c *PollCore) PollSendVote(in *poll.TLPollSendVote) (*mtproto.MessageMedia, error) {
+	var (
+		now = time.Now().Unix()
+	)
+
+	pollsDO, err := c.svcCtx.Dao.GetPoll(c.ctx, in.PollId)
+	if err != nil {
+		c.Logger.Errorf("poll.sendVote - error: %v", err)
+		return nil, err
+	}
+	if dao.IsPollClosed(pollsDO, now) {
+		err = mtproto.ErrMessagePollClosed
+		c.Logger.Errorf("poll.sendVote - error: %v", err)
+		return nil, err
+	}
+
+	poll := dao.MakePoll(pollsDO)
+	if err = checkVoteOptions(poll, in.Options); err != nil {
+		c.Logger.Errorf("poll.sendVote - error: %v", err)
+		return nil, err
+	}
+
+	// the answer of a quiz can't be changed or retracted
+	if poll.Quiz {
+		voted, _ := c.svcCtx.Dao.PollAnswerVotersDAO.SelectListByVoteUserId(c.ctx, in.PollId, in.UserId)
+		if len(voted) > 0 || len(in.Options) == 0 {
+			err = mtproto.ErrRevoteNotAllowed
+			c.Logger.Errorf("poll.sendVote - error: %v", err)
+			return nil, err
+		}
+	}
+
+	doList := make([]*dataobject.PollAnswerVotersDO, 0, len(in.Options))
+	for _, option := range in.Options {
+		doList = append(doList, &dataobject.PollAnswerVotersDO{
+			PollId:       in.PollId,
+			VoteUserId:   in.UserId,
+			AnswerOption: hack.String(option),
+			Date2:        now,
+		})
+	}
+
+	// empty options retract the vote
+	tR := sqlx.TxWrapper(c.ctx, c.svcCtx.Dao.DB, func(tx *sqlx.Tx, result *sqlx.StoreResult) {
+		_, result.Err = c.svcCtx.Dao.PollAnswerVotersDAO.DeleteTx(tx, in.PollId, in.UserId)
+		if result.Err != nil {
+			return
+		}
+		_, _, result.Err = c.svcCtx.Dao.PollAnswerVotersDAO.InsertBulkTx(tx, doList)
+	})
+	if tR.Err != nil {
+		c.Logger.Errorf("poll.sendVote - error: %v", tR.Err)
+		return nil, tR.Err
+	}
+
+	return c.svcCtx.Dao.MakeMediaPoll(c.ctx, in.UserId, pollsDO)
+}
@@ -0,0 +1,101 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"github.com/teamgram/marmota/pkg/hack"
+	"github.com/teamgram/proto/mtproto"
+)
+
+const (
+	pollAnswersMin   = 2
+	pollAnswersMax   = 10
+	pollOptionMaxLen = 100
+)
+
+// checkPoll the answers of a quiz have only one correct answer.
+func checkPoll(poll *mtproto.Poll, correctAnswers [][]byte) error {
+	if poll == nil {
+		return mtproto.ErrMediaInvalid
+	}
+	if poll.Question == "" {
+		return mtproto.ErrPollQuestionInvalid
+	}
+	if len(poll.Answers) < pollAnswersMin || len(poll.Answers) > pollAnswersMax {
+		return mtproto.ErrPollAnswersInvalid
+	}
+
+	options := make(map[string]bool, len(poll.Answers))
+	for _, answer := range poll.Answers {
+		if answer.Text == "" {
+			return mtproto.ErrPollAnswerInvalid
+		}
+		if len(answer.Option) == 0 || len(answer.Option) > pollOptionMaxLen {
+			return mtproto.ErrPollOptionInvalid
+		}
+		if options[hack.String(answer.Option)] {
+			return mtproto.ErrPollOptionDuplicate
+		}
+		options[hack.String(answer.Option)] = true
+	}
+
+	if poll.Quiz {
+		if poll.MultipleChoice {
+			return mtproto.ErrQuizMultipleInvalid
+		}
+		if len(correctAnswers) == 0 {
+			return mtproto.ErrQuizCorrectAnswersEmpty
+		}
+		if len(correctAnswers) > 1 {
+			return mtproto.ErrQuizCorrectAnswersTooMuch
+		}
+		if !options[hack.String(correctAnswers[0])] {
+			return mtproto.ErrQuizCorrectAnswerInvalid
+		}
+	}
+
+	return nil
+}
+
+// checkVoteOptions empty options retract the vote.
+func checkVoteOptions(poll *mtproto.Poll, voteOptions [][]byte) error {
+	if len(voteOptions) > 1 && !poll.MultipleChoice {
+		return mtproto.ErrOptionsTooMuch
+	}
+
+	voted := make(map[string]bool, len(voteOptions))
+	for _, option := range voteOptions {
+		found := false
+		for _, answer := range poll.Answers {
+			if hack.String(answer.Option) == hack.String(option) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return mtproto.ErrOptionInvalid
+		}
+		if voted[hack.String(option)] {
+			return mtproto.ErrOptionDuplicate
+		}
+		voted[hack.String(option)] = true
+	}
+
+	return nil
+}
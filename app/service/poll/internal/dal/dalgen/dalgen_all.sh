@@ -0,0 +1,2 @@
+./dalgen.sh polls
+./dalgen.sh poll_answer_voters
@@ -0,0 +1,325 @@
+/*
+ * WARNING! All changes made in this file will be lost!
+ *   Created from by 'dalgen'
+ *
+ * Copyright (c) 2022-present,  Teamgram Authors.
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package mysql_dao
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/teamgram/marmota/pkg/stores/sqlx"
+	"github.com/teamgram/teamgram-server/app/service/poll/internal/dal/dataobject"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+var _ *sql.Result
+
+type PollAnswerVotersDAO struct {
+	db *sqlx.DB
+}
+
+func NewPollAnswerVotersDAO(db *sqlx.DB) *PollAnswerVotersDAO {
+	return &PollAnswerVotersDAO{db}
+}
+
+// InsertBulk
+// insert into poll_answer_voters(poll_id, vote_user_id, answer_option, date2) values (:poll_id, :vote_user_id, :answer_option, :date2)
+// TODO(@benqi): sqlmap
+func (dao *PollAnswerVotersDAO) InsertBulk(ctx context.Context, doList []*dataobject.PollAnswerVotersDO) (lastInsertId, rowsAffected int64, err error) {
+	var (
+		query = "insert into poll_answer_voters(poll_id, vote_user_id, answer_option, date2) values (:poll_id, :vote_user_id, :answer_option, :date2)"
+		r     sql.Result
+	)
+
+	if len(doList) == 0 {
+		return
+	}
+
+	r, err = dao.db.NamedExec(ctx, query, doList)
+	if err != nil {
+		logx.WithContext(ctx).Errorf("namedExec in InsertBulk(%v), error: %v", doList, err)
+		return
+	}
+
+	lastInsertId, err = r.LastInsertId()
+	if err != nil {
+		logx.WithContext(ctx).Errorf("lastInsertId in InsertBulk(%v)_error: %v", doList, err)
+		return
+	}
+	rowsAffected, err = r.RowsAffected()
+	if err != nil {
+		logx.WithContext(ctx).Errorf("rowsAffected in InsertBulk(%v)_error: %v", doList, err)
+	}
+
+	return
+}
+
+// InsertBulkTx
+// insert into poll_answer_voters(poll_id, vote_user_id, answer_option, date2) values (:poll_id, :vote_user_id, :answer_option, :date2)
+// TODO(@benqi): sqlmap
+func (dao *PollAnswerVotersDAO) InsertBulkTx(tx *sqlx.Tx, doList []*dataobject.PollAnswerVotersDO) (lastInsertId, rowsAffected int64, err error) {
+	var (
+		query = "insert into poll_answer_voters(poll_id, vote_user_id, answer_option, date2) values (:poll_id, :vote_user_id, :answer_option, :date2)"
+		r     sql.Result
+	)
+
+	if len(doList) == 0 {
+		return
+	}
+
+	r, err = tx.NamedExec(query, doList)
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("namedExec in InsertBulk(%v), error: %v", doList, err)
+		return
+	}
+
+	lastInsertId, err = r.LastInsertId()
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("lastInsertId in InsertBulk(%v)_error: %v", doList, err)
+		return
+	}
+	rowsAffected, err = r.RowsAffected()
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("rowsAffected in InsertBulk(%v)_error: %v", doList, err)
+	}
+
+	return
+}
+
+// SelectList
+// select id, poll_id, vote_user_id, answer_option, date2 from poll_answer_voters where poll_id = :poll_id order by id desc
+// TODO(@benqi): sqlmap
+func (dao *PollAnswerVotersDAO) SelectList(ctx context.Context, poll_id int64) (rList []dataobject.PollAnswerVotersDO, err error) {
+	var (
+		query  = "select id, poll_id, vote_user_id, answer_option, date2 from poll_answer_voters where poll_id = ? order by id desc"
+		values []dataobject.PollAnswerVotersDO
+	)
+	err = dao.db.QueryRowsPartial(ctx, &values, query, poll_id)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("queryx in SelectList(_), error: %v", err)
+		return
+	}
+
+	rList = values
+
+	return
+}
+
+// SelectListWithCB
+// select id, poll_id, vote_user_id, answer_option, date2 from poll_answer_voters where poll_id = :poll_id order by id desc
+// TODO(@benqi): sqlmap
+func (dao *PollAnswerVotersDAO) SelectListWithCB(ctx context.Context, poll_id int64, cb func(i int, v *dataobject.PollAnswerVotersDO)) (rList []dataobject.PollAnswerVotersDO, err error) {
+	var (
+		query  = "select id, poll_id, vote_user_id, answer_option, date2 from poll_answer_voters where poll_id = ? order by id desc"
+		values []dataobject.PollAnswerVotersDO
+	)
+	err = dao.db.QueryRowsPartial(ctx, &values, query, poll_id)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("queryx in SelectList(_), error: %v", err)
+		return
+	}
+
+	rList = values
+
+	if cb != nil {
+		for i := 0; i < len(rList); i++ {
+			cb(i, &rList[i])
+		}
+	}
+
+	return
+}
+
+// SelectListByVoteUserId
+// select id, poll_id, vote_user_id, answer_option, date2 from poll_answer_voters where poll_id = :poll_id and vote_user_id = :vote_user_id
+// TODO(@benqi): sqlmap
+func (dao *PollAnswerVotersDAO) SelectListByVoteUserId(ctx context.Context, poll_id int64, vote_user_id int64) (rList []dataobject.PollAnswerVotersDO, err error) {
+	var (
+		query  = "select id, poll_id, vote_user_id, answer_option, date2 from poll_answer_voters where poll_id = ? and vote_user_id = ?"
+		values []dataobject.PollAnswerVotersDO
+	)
+	err = dao.db.QueryRowsPartial(ctx, &values, query, poll_id, vote_user_id)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("queryx in SelectListByVoteUserId(_), error: %v", err)
+		return
+	}
+
+	rList = values
+
+	return
+}
+
+// SelectListByVoteUserIdWithCB
+// select id, poll_id, vote_user_id, answer_option, date2 from poll_answer_voters where poll_id = :poll_id and vote_user_id = :vote_user_id
+// TODO(@benqi): sqlmap
+func (dao *PollAnswerVotersDAO) SelectListByVoteUserIdWithCB(ctx context.Context, poll_id int64, vote_user_id int64, cb func(i int, v *dataobject.PollAnswerVotersDO)) (rList []dataobject.PollAnswerVotersDO, err error) {
+	var (
+		query  = "select id, poll_id, vote_user_id, answer_option, date2 from poll_answer_voters where poll_id = ? and vote_user_id = ?"
+		values []dataobject.PollAnswerVotersDO
+	)
+	err = dao.db.QueryRowsPartial(ctx, &values, query, poll_id, vote_user_id)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("queryx in SelectListByVoteUserId(_), error: %v", err)
+		return
+	}
+
+	rList = values
+
+	if cb != nil {
+		for i := 0; i < len(rList); i++ {
+			cb(i, &rList[i])
+		}
+	}
+
+	return
+}
+
+// SelectVotesList
+// select id, poll_id, vote_user_id, answer_option, date2 from poll_answer_voters where poll_id = :poll_id and id < :offset order by id desc limit :limit
+// TODO(@benqi): sqlmap
+func (dao *PollAnswerVotersDAO) SelectVotesList(ctx context.Context, poll_id int64, offset int64, limit int32) (rList []dataobject.PollAnswerVotersDO, err error) {
+	var (
+		query  = "select id, poll_id, vote_user_id, answer_option, date2 from poll_answer_voters where poll_id = ? and id < ? order by id desc limit ?"
+		values []dataobject.PollAnswerVotersDO
+	)
+	err = dao.db.QueryRowsPartial(ctx, &values, query, poll_id, offset, limit)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("queryx in SelectVotesList(_), error: %v", err)
+		return
+	}
+
+	rList = values
+
+	return
+}
+
+// SelectVotesListWithCB
+// select id, poll_id, vote_user_id, answer_option, date2 from poll_answer_voters where poll_id = :poll_id and id < :offset order by id desc limit :limit
+// TODO(@benqi): sqlmap
+func (dao *PollAnswerVotersDAO) SelectVotesListWithCB(ctx context.Context, poll_id int64, offset int64, limit int32, cb func(i int, v *dataobject.PollAnswerVotersDO)) (rList []dataobject.PollAnswerVotersDO, err error) {
+	var (
+		query  = "select id, poll_id, vote_user_id, answer_option, date2 from poll_answer_voters where poll_id = ? and id < ? order by id desc limit ?"
+		values []dataobject.PollAnswerVotersDO
+	)
+	err = dao.db.QueryRowsPartial(ctx, &values, query, poll_id, offset, limit)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("queryx in SelectVotesList(_), error: %v", err)
+		return
+	}
+
+	rList = values
+
+	if cb != nil {
+		for i := 0; i < len(rList); i++ {
+			cb(i, &rList[i])
+		}
+	}
+
+	return
+}
+
+// SelectVotesListByOption
+// select id, poll_id, vote_user_id, answer_option, date2 from poll_answer_voters where poll_id = :poll_id and answer_option = :answer_option and id < :offset order by id desc limit :limit
+// TODO(@benqi): sqlmap
+func (dao *PollAnswerVotersDAO) SelectVotesListByOption(ctx context.Context, poll_id int64, answer_option string, offset int64, limit int32) (rList []dataobject.PollAnswerVotersDO, err error) {
+	var (
+		query  = "select id, poll_id, vote_user_id, answer_option, date2 from poll_answer_voters where poll_id = ? and answer_option = ? and id < ? order by id desc limit ?"
+		values []dataobject.PollAnswerVotersDO
+	)
+	err = dao.db.QueryRowsPartial(ctx, &values, query, poll_id, answer_option, offset, limit)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("queryx in SelectVotesListByOption(_), error: %v", err)
+		return
+	}
+
+	rList = values
+
+	return
+}
+
+// SelectVotesListByOptionWithCB
+// select id, poll_id, vote_user_id, answer_option, date2 from poll_answer_voters where poll_id = :poll_id and answer_option = :answer_option and id < :offset order by id desc limit :limit
+// TODO(@benqi): sqlmap
+func (dao *PollAnswerVotersDAO) SelectVotesListByOptionWithCB(ctx context.Context, poll_id int64, answer_option string, offset int64, limit int32, cb func(i int, v *dataobject.PollAnswerVotersDO)) (rList []dataobject.PollAnswerVotersDO, err error) {
+	var (
+		query  = "select id, poll_id, vote_user_id, answer_option, date2 from poll_answer_voters where poll_id = ? and answer_option = ? and id < ? order by id desc limit ?"
+		values []dataobject.PollAnswerVotersDO
+	)
+	err = dao.db.QueryRowsPartial(ctx, &values, query, poll_id, answer_option, offset, limit)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("queryx in SelectVotesListByOption(_), error: %v", err)
+		return
+	}
+
+	rList = values
+
+	if cb != nil {
+		for i := 0; i < len(rList); i++ {
+			cb(i, &rList[i])
+		}
+	}
+
+	return
+}
+
+// Delete
+// delete from poll_answer_voters where poll_id = :poll_id and vote_user_id = :vote_user_id
+// TODO(@benqi): sqlmap
+func (dao *PollAnswerVotersDAO) Delete(ctx context.Context, poll_id int64, vote_user_id int64) (rowsAffected int64, err error) {
+	var (
+		query   = "delete from poll_answer_voters where poll_id = ? and vote_user_id = ?"
+		rResult sql.Result
+	)
+	rResult, err = dao.db.Exec(ctx, query, poll_id, vote_user_id)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("exec in Delete(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(ctx).Errorf("rowsAffected in Delete(_), error: %v", err)
+	}
+
+	return
+}
+
+// DeleteTx
+// delete from poll_answer_voters where poll_id = :poll_id and vote_user_id = :vote_user_id
+// TODO(@benqi): sqlmap
+func (dao *PollAnswerVotersDAO) DeleteTx(tx *sqlx.Tx, poll_id int64, vote_user_id int64) (rowsAffected int64, err error) {
+	var (
+		query   = "delete from poll_answer_voters where poll_id = ? and vote_user_id = ?"
+		rResult sql.Result
+	)
+	rResult, err = tx.Exec(query, poll_id, vote_user_id)
+
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("exec in Delete(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("rowsAffected in Delete(_), error: %v", err)
+	}
+
+	return
+}
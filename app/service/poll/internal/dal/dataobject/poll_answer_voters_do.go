@@ -0,0 +1,19 @@
+/*
+ * WARNING! All changes made in this file will be lost!
+ *   Created from by 'dalgen'
+ *
+ * Copyright (c) 2022-present,  Teamgram Authors.
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package dataobject
+
+type PollAnswerVotersDO struct {
+	Id           int64  `db:"id"`
+	PollId       int64  `db:"poll_id"`
+	VoteUserId   int64  `db:"vote_user_id"`
+	AnswerOption string `db:"answer_option"`
+	Date2        int64  `db:"date2"`
+}
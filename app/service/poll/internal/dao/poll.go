@@ -0,0 +1,145 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package dao
+
+import (
+	"context"
+	"time"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/poll/internal/dal/dataobject"
+
+	"github.com/zeromicro/go-zero/core/jsonx"
+)
+
+const (
+	// recentVotersMax the avatars of the latest voters shown under a public poll
+	recentVotersMax = 3
+)
+
+// IsPollClosed a poll with a past close_date is closed even if nobody closed it.
+func IsPollClosed(do *dataobject.PollsDO, now int64) bool {
+	return do.Closed || (do.CloseDate > 0 && do.CloseDate <= now)
+}
+
+func (d *Dao) GetPoll(ctx context.Context, pollId int64) (*dataobject.PollsDO, error) {
+	do, err := d.PollsDAO.SelectByPollId(ctx, pollId)
+	if err != nil {
+		return nil, err
+	} else if do == nil {
+		return nil, mtproto.ErrMediaInvalid
+	}
+
+	return do, nil
+}
+
+// MakeMediaPoll returns the messageMediaPoll seen by selfUserId.
+func (d *Dao) MakeMediaPoll(ctx context.Context, selfUserId int64, do *dataobject.PollsDO) (*mtproto.MessageMedia, error) {
+	voters, err := d.PollAnswerVotersDAO.SelectList(ctx, do.PollId)
+	if err != nil {
+		return nil, err
+	}
+
+	poll := MakePoll(do)
+	return mtproto.MakeTLMessageMediaPoll(&mtproto.MessageMedia{
+		Poll:    poll,
+		Results: MakePollResults(do, poll.Answers, voters, selfUserId, poll.Closed),
+	}).To_MessageMedia(), nil
+}
+
+func MakePoll(do *dataobject.PollsDO) *mtproto.Poll {
+	poll := mtproto.MakeTLPoll(&mtproto.Poll{
+		Id:             do.PollId,
+		Closed:         IsPollClosed(do, time.Now().Unix()),
+		PublicVoters:   do.PublicVoters,
+		MultipleChoice: do.MultipleChoice,
+		Quiz:           do.Quiz,
+		Question:       do.Question,
+		Answers:        nil,
+		ClosePeriod:    nil,
+		CloseDate:      nil,
+	}).To_Poll()
+	_ = jsonx.UnmarshalFromString(do.Answers, &poll.Answers)
+	if do.ClosePeriod > 0 {
+		poll.ClosePeriod = mtproto.MakeFlagsInt32(do.ClosePeriod)
+	}
+	if do.CloseDate > 0 {
+		poll.CloseDate = mtproto.MakeFlagsInt32(int32(do.CloseDate))
+	}
+
+	return poll
+}
+
+// MakePollResults counts the votes of every answer, voters are sorted by id desc.
+// The correct answer and the solution of a quiz are shown after voting or closing.
+func MakePollResults(do *dataobject.PollsDO, answers []*mtproto.PollAnswer, voters []dataobject.PollAnswerVotersDO, selfUserId int64, closed bool) *mtproto.PollResults {
+	var (
+		counts       = make(map[string]int32, len(answers))
+		chosen       = make(map[string]bool)
+		users        = make(map[int64]bool)
+		recentVoters []int64
+		correct      = make(map[string]bool)
+	)
+
+	for i := range voters {
+		v := &voters[i]
+		counts[v.AnswerOption]++
+		if v.VoteUserId == selfUserId {
+			chosen[v.AnswerOption] = true
+		}
+		if !users[v.VoteUserId] {
+			users[v.VoteUserId] = true
+			if do.PublicVoters && len(recentVoters) < recentVotersMax {
+				recentVoters = append(recentVoters, v.VoteUserId)
+			}
+		}
+	}
+
+	showCorrect := do.Quiz && (len(chosen) > 0 || closed)
+	if showCorrect {
+		var correctAnswers [][]byte
+		_ = jsonx.UnmarshalFromString(do.CorrectAnswers, &correctAnswers)
+		for _, option := range correctAnswers {
+			correct[string(option)] = true
+		}
+	}
+
+	results := mtproto.MakeTLPollResults(&mtproto.PollResults{
+		Min:              false,
+		Results:          make([]*mtproto.PollAnswerVoters, 0, len(answers)),
+		TotalVoters:      mtproto.MakeFlagsInt32(int32(len(users))),
+		RecentVoters:     recentVoters,
+		Solution:         nil,
+		SolutionEntities: nil,
+	}).To_PollResults()
+	for _, answer := range answers {
+		results.Results = append(results.Results, mtproto.MakeTLPollAnswerVoters(&mtproto.PollAnswerVoters{
+			Chosen:  chosen[string(answer.Option)],
+			Correct: correct[string(answer.Option)],
+			Option:  answer.Option,
+			Voters:  counts[string(answer.Option)],
+		}).To_PollAnswerVoters())
+	}
+	if showCorrect && do.Solution != "" {
+		results.Solution = mtproto.MakeFlagsString(do.Solution)
+		_ = jsonx.UnmarshalFromString(do.SolutionEntities, &results.SolutionEntities)
+	}
+
+	return results
+}
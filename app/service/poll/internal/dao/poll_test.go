@@ -0,0 +1,107 @@
+// Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+//  All rights reserved.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package dao
+
+import (
+	"testing"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/poll/internal/dal/dataobject"
+)
+
+func makeTestAnswers(options ...string) []*mtproto.PollAnswer {
+	answers := make([]*mtproto.PollAnswer, 0, len(options))
+	for _, option := range options {
+		answers = append(answers, mtproto.MakeTLPollAnswer(&mtproto.PollAnswer{
+			Text:   "answer " + option,
+			Option: []byte(option),
+		}).To_PollAnswer())
+	}
+	return answers
+}
+
+func TestMakePollResults(t *testing.T) {
+	var (
+		do = &dataobject.PollsDO{
+			PollId:         1,
+			PublicVoters:   true,
+			MultipleChoice: true,
+		}
+		answers = makeTestAnswers("0", "1", "2")
+		voters  = []dataobject.PollAnswerVotersDO{
+			{Id: 5, VoteUserId: 4, AnswerOption: "2"},
+			{Id: 4, VoteUserId: 3, AnswerOption: "1"},
+			{Id: 3, VoteUserId: 2, AnswerOption: "1"},
+			{Id: 2, VoteUserId: 1, AnswerOption: "0"},
+			{Id: 1, VoteUserId: 1, AnswerOption: "1"},
+		}
+	)
+
+	r := MakePollResults(do, answers, voters, 1, false)
+	if r.GetTotalVoters().GetValue() != 4 {
+		t.Fatalf("total voters = %d, want 4", r.GetTotalVoters().GetValue())
+	}
+	if len(r.RecentVoters) != recentVotersMax || r.RecentVoters[0] != 4 || r.RecentVoters[2] != 2 {
+		t.Fatalf("recent voters = %v", r.RecentVoters)
+	}
+	for i, want := range []struct {
+		voters int32
+		chosen bool
+	}{{1, true}, {3, true}, {1, false}} {
+		if v := r.Results[i]; v.Voters != want.voters || v.Chosen != want.chosen || v.Correct {
+			t.Fatalf("results[%d] = %v", i, v)
+		}
+	}
+
+	do.PublicVoters = false
+	if r = MakePollResults(do, answers, voters, 1, false); len(r.RecentVoters) != 0 {
+		t.Fatalf("anonymous poll: recent voters = %v", r.RecentVoters)
+	}
+}
+
+func TestMakePollResultsQuiz(t *testing.T) {
+	var (
+		do = &dataobject.PollsDO{
+			PollId:         1,
+			Quiz:           true,
+			CorrectAnswers: `["MQ=="]`,
+			Solution:       "because",
+		}
+		answers = makeTestAnswers("0", "1")
+		voters  = []dataobject.PollAnswerVotersDO{
+			{Id: 1, VoteUserId: 2, AnswerOption: "0"},
+		}
+	)
+
+	// the correct answer is hidden before voting
+	r := MakePollResults(do, answers, voters, 1, false)
+	if r.Results[1].Correct || r.Solution != nil {
+		t.Fatalf("not voted: results = %v", r)
+	}
+
+	r = MakePollResults(do, answers, voters, 2, false)
+	if !r.Results[0].Chosen || r.Results[0].Correct || !r.Results[1].Correct || r.GetSolution().GetValue() != "because" {
+		t.Fatalf("voted: results = %v", r)
+	}
+
+	r = MakePollResults(do, answers, voters, 1, true)
+	if !r.Results[1].Correct {
+		t.Fatalf("closed: results = %v", r)
+	}
+}
+
+func TestIsPollClosed(t *testing.T) {
+	if IsPollClosed(&dataobject.PollsDO{}, 100) {
+		t.Fatal("open poll is closed")
+	}
+	if !IsPollClosed(&dataobject.PollsDO{CloseDate: 100}, 100) {
+		t.Fatal("expired poll is not closed")
+	}
+	if !IsPollClosed(&dataobject.PollsDO{Closed: true, CloseDate: 200}, 100) {
+		t.Fatal("closed poll is not closed")
+	}
+}
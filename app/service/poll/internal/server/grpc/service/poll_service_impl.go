@@ -0,0 +1,94 @@
+/*
+ * WARNING! All changes made in this file will be lost!
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package service
+
+import (
+	"context"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/poll/internal/core"
+	"github.com/teamgram/teamgram-server/app/service/poll/poll"
+)
+
+// PollCreateMediaPoll
+// poll.createMediaPoll flags:# user_id:long correct_answers:flags.0?Vector<bytes> poll:Poll solution:flags.1?string solution_entities:flags.1?Vector<MessageEntity> = MessageMedia;
+func (s *Service) PollCreateMediaPoll(ctx context.Context, request *poll.TLPollCreateMediaPoll) (*mtproto.MessageMedia, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Infof("poll.createMediaPoll - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.PollCreateMediaPoll(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Infof("poll.createMediaPoll - reply: %s", r.DebugString())
+	return r, err
+}
+
+// PollGetMediaPoll
+// poll.getMediaPoll user_id:long poll_id:long = MessageMedia;
+func (s *Service) PollGetMediaPoll(ctx context.Context, request *poll.TLPollGetMediaPoll) (*mtproto.MessageMedia, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Infof("poll.getMediaPoll - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.PollGetMediaPoll(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Infof("poll.getMediaPoll - reply: %s", r.DebugString())
+	return r, err
+}
+
+// PollCloseMediaPoll
+// poll.closeMediaPoll user_id:long poll_id:long = MessageMedia;
+func (s *Service) PollCloseMediaPoll(ctx context.Context, request *poll.TLPollCloseMediaPoll) (*mtproto.MessageMedia, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Infof("poll.closeMediaPoll - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.PollCloseMediaPoll(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Infof("poll.closeMediaPoll - reply: %s", r.DebugString())
+	return r, err
+}
+
+// PollSendVote
+// poll.sendVote user_id:long poll_id:long options:Vector<bytes> = MessageMedia;
+func (s *Service) PollSendVote(ctx context.Context, request *poll.TLPollSendVote) (*mtproto.MessageMedia, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Infof("poll.sendVote - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.PollSendVote(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Infof("poll.sendVote - reply: %s", r.DebugString())
+	return r, err
+}
+
+// PollGetPollVotes
+// poll.getPollVotes flags:# user_id:long poll_id:long option:flags.0?bytes offset:flags.1?string limit:int = messages.VotesList;
+func (s *Service) PollGetPollVotes(ctx context.Context, request *poll.TLPollGetPollVotes) (*mtproto.Messages_VotesList, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Infof("poll.getPollVotes - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.PollGetPollVotes(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Infof("poll.getPollVotes - reply: %s", r.DebugString())
+	return r, err
+}
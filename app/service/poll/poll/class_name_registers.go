@@ -0,0 +1,65 @@
+/*
+ * WARNING! All changes made in this file will be lost!
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2022-present,  Teamgram Authors.
+ *  All rights reserved.
+ *
+ * Author: teagramio (teagram.io@gmail.com)
+ */
+
+package poll
+
+const (
+	Predicate_poll_createMediaPoll = "poll_createMediaPoll"
+	Predicate_poll_getMediaPoll    = "poll_getMediaPoll"
+	Predicate_poll_closeMediaPoll  = "poll_closeMediaPoll"
+	Predicate_poll_sendVote        = "poll_sendVote"
+	Predicate_poll_getPollVotes    = "poll_getPollVotes"
+)
+
+var clazzNameRegisters2 = map[string]map[int]int32{
+	Predicate_poll_createMediaPoll: {
+		0: -112750541, // 0xf9479033
+
+	},
+	Predicate_poll_getMediaPoll: {
+		0: 1491271431, // 0x58e2ff07
+
+	},
+	Predicate_poll_closeMediaPoll: {
+		0: -601648461, // 0xdc2392b3
+
+	},
+	Predicate_poll_sendVote: {
+		0: -932415633, // 0xc86c776f
+
+	},
+	Predicate_poll_getPollVotes: {
+		0: 1214841594, // 0x486902fa
+
+	},
+}
+
+var clazzIdNameRegisters2 = map[int32]string{
+	-112750541: Predicate_poll_createMediaPoll, // 0xf9479033
+	1491271431: Predicate_poll_getMediaPoll,    // 0x58e2ff07
+	-601648461: Predicate_poll_closeMediaPoll,  // 0xdc2392b3
+	-932415633: Predicate_poll_sendVote,        // 0xc86c776f
+	1214841594: Predicate_poll_getPollVotes,    // 0x486902fa
+
+}
+
+func GetClazzID(clazzName string, layer int) int32 {
+	if m, ok := clazzNameRegisters2[clazzName]; ok {
+		m2, ok2 := m[layer]
+		if ok2 {
+			return m2
+		}
+		m2, ok2 = m[0]
+		if ok2 {
+			return m2
+		}
+	}
+	return 0
+}
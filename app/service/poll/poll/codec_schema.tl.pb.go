@@ -0,0 +1,424 @@
+/*
+ * WARNING! All changes made in this file will be lost!
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2022-present,  Teamgram Authors.
+ *  All rights reserved.
+ *
+ * Author: teagramio (teagram.io@gmail.com)
+ */
+
+// ConstructorList
+// RequestList
+
+package poll
+
+import (
+	"fmt"
+
+	"github.com/teamgram/proto/mtproto"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/types"
+)
+
+//////////////////////////////////////////////////////////////////////////////////////////
+
+var _ *types.Int32Value
+var _ *mtproto.Bool
+var _ fmt.GoStringer
+
+var clazzIdRegisters2 = map[int32]func() mtproto.TLObject{
+	// Constructor
+
+	// Method
+	-112750541: func() mtproto.TLObject { // 0xf9479033
+		return &TLPollCreateMediaPoll{
+			Constructor: -112750541,
+		}
+	},
+	1491271431: func() mtproto.TLObject { // 0x58e2ff07
+		return &TLPollGetMediaPoll{
+			Constructor: 1491271431,
+		}
+	},
+	-601648461: func() mtproto.TLObject { // 0xdc2392b3
+		return &TLPollCloseMediaPoll{
+			Constructor: -601648461,
+		}
+	},
+	-932415633: func() mtproto.TLObject { // 0xc86c776f
+		return &TLPollSendVote{
+			Constructor: -932415633,
+		}
+	},
+	1214841594: func() mtproto.TLObject { // 0x486902fa
+		return &TLPollGetPollVotes{
+			Constructor: 1214841594,
+		}
+	},
+}
+
+func NewTLObjectByClassID(classId int32) mtproto.TLObject {
+	f, ok := clazzIdRegisters2[classId]
+	if !ok {
+		return nil
+	}
+	return f()
+}
+
+func CheckClassID(classId int32) (ok bool) {
+	_, ok = clazzIdRegisters2[classId]
+	return
+}
+
+//----------------------------------------------------------------------------------------------------------------
+
+//----------------------------------------------------------------------------------------------------------------
+// TLPollCreateMediaPoll
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLPollCreateMediaPoll) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_poll_createMediaPoll))
+
+	switch uint32(m.Constructor) {
+	case 0xf9479033:
+		// poll.createMediaPoll flags:# user_id:long correct_answers:flags.0?Vector<bytes> poll:Poll solution:flags.1?string solution_entities:flags.1?Vector<MessageEntity> = MessageMedia;
+		x.UInt(0xf9479033)
+
+		// set flags
+		var flags uint32 = 0
+
+		if m.GetCorrectAnswers() != nil {
+			flags |= 1 << 0
+		}
+		if m.GetSolution() != nil {
+			flags |= 1 << 1
+		}
+		if m.GetSolutionEntities() != nil {
+			flags |= 1 << 1
+		}
+
+		x.UInt(flags)
+
+		// flags Debug by @benqi
+		x.Long(m.GetUserId())
+		if m.GetCorrectAnswers() != nil {
+			x.VectorBytes(m.GetCorrectAnswers())
+		}
+		x.Bytes(m.GetPoll().Encode(layer))
+		if m.GetSolution() != nil {
+			x.String(m.GetSolution().Value)
+		}
+
+		if m.GetSolutionEntities() != nil {
+			x.Int(int32(mtproto.CRC32_vector))
+			x.Int(int32(len(m.GetSolutionEntities())))
+			for _, v := range m.GetSolutionEntities() {
+				x.Bytes((*v).Encode(layer))
+			}
+		}
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLPollCreateMediaPoll) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLPollCreateMediaPoll) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0xf9479033:
+		// poll.createMediaPoll flags:# user_id:long correct_answers:flags.0?Vector<bytes> poll:Poll solution:flags.1?string solution_entities:flags.1?Vector<MessageEntity> = MessageMedia;
+
+		flags := dBuf.UInt()
+		_ = flags
+
+		// flags Debug by @benqi
+		m.UserId = dBuf.Long()
+		if (flags & (1 << 0)) != 0 {
+			m.CorrectAnswers = dBuf.VectorBytes()
+		}
+
+		m4 := &mtproto.Poll{}
+		m4.Decode(dBuf)
+		m.Poll = m4
+
+		if (flags & (1 << 1)) != 0 {
+			m.Solution = &types.StringValue{Value: dBuf.String()}
+		}
+
+		if (flags & (1 << 1)) != 0 {
+			c6 := dBuf.Int()
+			if c6 != int32(mtproto.CRC32_vector) {
+				// dBuf.err = fmt.Errorf("invalid mtproto.CRC32_vector, c%d: %d", 6, c6)
+				return fmt.Errorf("invalid mtproto.CRC32_vector, c%d: %d", 6, c6)
+			}
+			l6 := dBuf.Int()
+			v6 := make([]*mtproto.MessageEntity, l6)
+			for i := int32(0); i < l6; i++ {
+				v6[i] = &mtproto.MessageEntity{}
+				v6[i].Decode(dBuf)
+			}
+			m.SolutionEntities = v6
+		}
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLPollCreateMediaPoll) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLPollGetMediaPoll
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLPollGetMediaPoll) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_poll_getMediaPoll))
+
+	switch uint32(m.Constructor) {
+	case 0x58e2ff07:
+		// poll.getMediaPoll user_id:long poll_id:long = MessageMedia;
+		x.UInt(0x58e2ff07)
+
+		// no flags
+
+		x.Long(m.GetUserId())
+		x.Long(m.GetPollId())
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLPollGetMediaPoll) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLPollGetMediaPoll) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0x58e2ff07:
+		// poll.getMediaPoll user_id:long poll_id:long = MessageMedia;
+
+		// not has flags
+
+		m.UserId = dBuf.Long()
+		m.PollId = dBuf.Long()
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLPollGetMediaPoll) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLPollCloseMediaPoll
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLPollCloseMediaPoll) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_poll_closeMediaPoll))
+
+	switch uint32(m.Constructor) {
+	case 0xdc2392b3:
+		// poll.closeMediaPoll user_id:long poll_id:long = MessageMedia;
+		x.UInt(0xdc2392b3)
+
+		// no flags
+
+		x.Long(m.GetUserId())
+		x.Long(m.GetPollId())
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLPollCloseMediaPoll) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLPollCloseMediaPoll) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0xdc2392b3:
+		// poll.closeMediaPoll user_id:long poll_id:long = MessageMedia;
+
+		// not has flags
+
+		m.UserId = dBuf.Long()
+		m.PollId = dBuf.Long()
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLPollCloseMediaPoll) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLPollSendVote
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLPollSendVote) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_poll_sendVote))
+
+	switch uint32(m.Constructor) {
+	case 0xc86c776f:
+		// poll.sendVote user_id:long poll_id:long options:Vector<bytes> = MessageMedia;
+		x.UInt(0xc86c776f)
+
+		// no flags
+
+		x.Long(m.GetUserId())
+		x.Long(m.GetPollId())
+
+		x.VectorBytes(m.GetOptions())
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLPollSendVote) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLPollSendVote) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0xc86c776f:
+		// poll.sendVote user_id:long poll_id:long options:Vector<bytes> = MessageMedia;
+
+		// not has flags
+
+		m.UserId = dBuf.Long()
+		m.PollId = dBuf.Long()
+
+		m.Options = dBuf.VectorBytes()
+
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLPollSendVote) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLPollGetPollVotes
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLPollGetPollVotes) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_poll_getPollVotes))
+
+	switch uint32(m.Constructor) {
+	case 0x486902fa:
+		// poll.getPollVotes flags:# user_id:long poll_id:long option:flags.0?bytes offset:flags.1?string limit:int = messages.VotesList;
+		x.UInt(0x486902fa)
+
+		// set flags
+		var flags uint32 = 0
+
+		if m.GetOption() != nil {
+			flags |= 1 << 0
+		}
+		if m.GetOffset() != nil {
+			flags |= 1 << 1
+		}
+
+		x.UInt(flags)
+
+		// flags Debug by @benqi
+		x.Long(m.GetUserId())
+		x.Long(m.GetPollId())
+		if m.GetOption() != nil {
+			x.StringBytes(m.GetOption())
+		}
+
+		if m.GetOffset() != nil {
+			x.String(m.GetOffset().Value)
+		}
+
+		x.Int(m.GetLimit())
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLPollGetPollVotes) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLPollGetPollVotes) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0x486902fa:
+		// poll.getPollVotes flags:# user_id:long poll_id:long option:flags.0?bytes offset:flags.1?string limit:int = messages.VotesList;
+
+		flags := dBuf.UInt()
+		_ = flags
+
+		// flags Debug by @benqi
+		m.UserId = dBuf.Long()
+		m.PollId = dBuf.Long()
+		if (flags & (1 << 0)) != 0 {
+			m.Option = dBuf.StringBytes()
+		}
+
+		if (flags & (1 << 1)) != 0 {
+			m.Offset = &types.StringValue{Value: dBuf.String()}
+		}
+
+		m.Limit = dBuf.Int()
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLPollGetPollVotes) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+//----------------------------------------------------------------------------------------------------------------
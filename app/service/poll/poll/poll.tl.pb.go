@@ -0,0 +1,2150 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: poll.tl.proto
+
+package poll
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	types "github.com/gogo/protobuf/types"
+	mtproto "github.com/teamgram/proto/mtproto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	reflect "reflect"
+	strings "strings"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type TLConstructor int32
+
+const (
+	CRC32_UNKNOWN              TLConstructor = 0
+	CRC32_poll_createMediaPoll TLConstructor = -112750541
+	CRC32_poll_getMediaPoll    TLConstructor = 1491271431
+	CRC32_poll_closeMediaPoll  TLConstructor = -601648461
+	CRC32_poll_sendVote        TLConstructor = -932415633
+	CRC32_poll_getPollVotes    TLConstructor = 1214841594
+)
+
+var TLConstructor_name = map[int32]string{
+	0:          "CRC32_UNKNOWN",
+	-112750541: "CRC32_poll_createMediaPoll",
+	1491271431: "CRC32_poll_getMediaPoll",
+	-601648461: "CRC32_poll_closeMediaPoll",
+	-932415633: "CRC32_poll_sendVote",
+	1214841594: "CRC32_poll_getPollVotes",
+}
+
+var TLConstructor_value = map[string]int32{
+	"CRC32_UNKNOWN":              0,
+	"CRC32_poll_createMediaPoll": -112750541,
+	"CRC32_poll_getMediaPoll":    1491271431,
+	"CRC32_poll_closeMediaPoll":  -601648461,
+	"CRC32_poll_sendVote":        -932415633,
+	"CRC32_poll_getPollVotes":    1214841594,
+}
+
+func (x TLConstructor) String() string {
+	return proto.EnumName(TLConstructor_name, int32(x))
+}
+
+func (TLConstructor) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_144fbf276ed9a72d, []int{0}
+}
+
+//--------------------------------------------------------------------------------------------
+// poll.createMediaPoll flags:# user_id:long correct_answers:flags.0?Vector<bytes> poll:Poll solution:flags.1?string solution_entities:flags.1?Vector<MessageEntity> = MessageMedia;
+type TLPollCreateMediaPoll struct {
+	Constructor          TLConstructor            `protobuf:"varint,1,opt,name=constructor,proto3,enum=poll.TLConstructor" json:"constructor,omitempty"`
+	UserId               int64                    `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CorrectAnswers       [][]byte                 `protobuf:"bytes,4,rep,name=correct_answers,json=correctAnswers,proto3" json:"correct_answers,omitempty"`
+	Poll                 *mtproto.Poll            `protobuf:"bytes,5,opt,name=poll,proto3" json:"poll,omitempty"`
+	Solution             *types.StringValue       `protobuf:"bytes,6,opt,name=solution,proto3" json:"solution,omitempty"`
+	SolutionEntities     []*mtproto.MessageEntity `protobuf:"bytes,7,rep,name=solution_entities,json=solutionEntities,proto3" json:"solution_entities,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *TLPollCreateMediaPoll) Reset()         { *m = TLPollCreateMediaPoll{} }
+func (m *TLPollCreateMediaPoll) String() string { return proto.CompactTextString(m) }
+func (*TLPollCreateMediaPoll) ProtoMessage()    {}
+func (*TLPollCreateMediaPoll) Descriptor() ([]byte, []int) {
+	return fileDescriptor_144fbf276ed9a72d, []int{0}
+}
+func (m *TLPollCreateMediaPoll) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLPollCreateMediaPoll) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLPollCreateMediaPoll.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TLPollCreateMediaPoll) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLPollCreateMediaPoll.Merge(m, src)
+}
+func (m *TLPollCreateMediaPoll) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLPollCreateMediaPoll) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLPollCreateMediaPoll.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLPollCreateMediaPoll proto.InternalMessageInfo
+
+func (m *TLPollCreateMediaPoll) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
+	}
+	return CRC32_UNKNOWN
+}
+
+func (m *TLPollCreateMediaPoll) GetUserId() int64 {
+	if m != nil {
+		return m.UserId
+	}
+	return 0
+}
+
+func (m *TLPollCreateMediaPoll) GetCorrectAnswers() [][]byte {
+	if m != nil {
+		return m.CorrectAnswers
+	}
+	return nil
+}
+
+func (m *TLPollCreateMediaPoll) GetPoll() *mtproto.Poll {
+	if m != nil {
+		return m.Poll
+	}
+	return nil
+}
+
+func (m *TLPollCreateMediaPoll) GetSolution() *types.StringValue {
+	if m != nil {
+		return m.Solution
+	}
+	return nil
+}
+
+func (m *TLPollCreateMediaPoll) GetSolutionEntities() []*mtproto.MessageEntity {
+	if m != nil {
+		return m.SolutionEntities
+	}
+	return nil
+}
+
+//--------------------------------------------------------------------------------------------
+// poll.getMediaPoll user_id:long poll_id:long = MessageMedia;
+type TLPollGetMediaPoll struct {
+	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=poll.TLConstructor" json:"constructor,omitempty"`
+	UserId               int64         `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PollId               int64         `protobuf:"varint,4,opt,name=poll_id,json=pollId,proto3" json:"poll_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *TLPollGetMediaPoll) Reset()         { *m = TLPollGetMediaPoll{} }
+func (m *TLPollGetMediaPoll) String() string { return proto.CompactTextString(m) }
+func (*TLPollGetMediaPoll) ProtoMessage()    {}
+func (*TLPollGetMediaPoll) Descriptor() ([]byte, []int) {
+	return fileDescriptor_144fbf276ed9a72d, []int{1}
+}
+func (m *TLPollGetMediaPoll) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLPollGetMediaPoll) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLPollGetMediaPoll.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TLPollGetMediaPoll) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLPollGetMediaPoll.Merge(m, src)
+}
+func (m *TLPollGetMediaPoll) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLPollGetMediaPoll) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLPollGetMediaPoll.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLPollGetMediaPoll proto.InternalMessageInfo
+
+func (m *TLPollGetMediaPoll) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
+	}
+	return CRC32_UNKNOWN
+}
+
+func (m *TLPollGetMediaPoll) GetUserId() int64 {
+	if m != nil {
+		return m.UserId
+	}
+	return 0
+}
+
+func (m *TLPollGetMediaPoll) GetPollId() int64 {
+	if m != nil {
+		return m.PollId
+	}
+	return 0
+}
+
+//--------------------------------------------------------------------------------------------
+// poll.closeMediaPoll user_id:long poll_id:long = MessageMedia;
+type TLPollCloseMediaPoll struct {
+	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=poll.TLConstructor" json:"constructor,omitempty"`
+	UserId               int64         `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PollId               int64         `protobuf:"varint,4,opt,name=poll_id,json=pollId,proto3" json:"poll_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *TLPollCloseMediaPoll) Reset()         { *m = TLPollCloseMediaPoll{} }
+func (m *TLPollCloseMediaPoll) String() string { return proto.CompactTextString(m) }
+func (*TLPollCloseMediaPoll) ProtoMessage()    {}
+func (*TLPollCloseMediaPoll) Descriptor() ([]byte, []int) {
+	return fileDescriptor_144fbf276ed9a72d, []int{2}
+}
+func (m *TLPollCloseMediaPoll) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLPollCloseMediaPoll) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLPollCloseMediaPoll.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TLPollCloseMediaPoll) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLPollCloseMediaPoll.Merge(m, src)
+}
+func (m *TLPollCloseMediaPoll) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLPollCloseMediaPoll) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLPollCloseMediaPoll.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLPollCloseMediaPoll proto.InternalMessageInfo
+
+func (m *TLPollCloseMediaPoll) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
+	}
+	return CRC32_UNKNOWN
+}
+
+func (m *TLPollCloseMediaPoll) GetUserId() int64 {
+	if m != nil {
+		return m.UserId
+	}
+	return 0
+}
+
+func (m *TLPollCloseMediaPoll) GetPollId() int64 {
+	if m != nil {
+		return m.PollId
+	}
+	return 0
+}
+
+//--------------------------------------------------------------------------------------------
+// poll.sendVote user_id:long poll_id:long options:Vector<bytes> = MessageMedia;
+type TLPollSendVote struct {
+	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=poll.TLConstructor" json:"constructor,omitempty"`
+	UserId               int64         `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PollId               int64         `protobuf:"varint,4,opt,name=poll_id,json=pollId,proto3" json:"poll_id,omitempty"`
+	Options              [][]byte      `protobuf:"bytes,5,rep,name=options,proto3" json:"options,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *TLPollSendVote) Reset()         { *m = TLPollSendVote{} }
+func (m *TLPollSendVote) String() string { return proto.CompactTextString(m) }
+func (*TLPollSendVote) ProtoMessage()    {}
+func (*TLPollSendVote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_144fbf276ed9a72d, []int{3}
+}
+func (m *TLPollSendVote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLPollSendVote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLPollSendVote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TLPollSendVote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLPollSendVote.Merge(m, src)
+}
+func (m *TLPollSendVote) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLPollSendVote) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLPollSendVote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLPollSendVote proto.InternalMessageInfo
+
+func (m *TLPollSendVote) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
+	}
+	return CRC32_UNKNOWN
+}
+
+func (m *TLPollSendVote) GetUserId() int64 {
+	if m != nil {
+		return m.UserId
+	}
+	return 0
+}
+
+func (m *TLPollSendVote) GetPollId() int64 {
+	if m != nil {
+		return m.PollId
+	}
+	return 0
+}
+
+func (m *TLPollSendVote) GetOptions() [][]byte {
+	if m != nil {
+		return m.Options
+	}
+	return nil
+}
+
+//--------------------------------------------------------------------------------------------
+// poll.getPollVotes flags:# user_id:long poll_id:long option:flags.0?bytes offset:flags.1?string limit:int = messages.VotesList;
+type TLPollGetPollVotes struct {
+	Constructor          TLConstructor      `protobuf:"varint,1,opt,name=constructor,proto3,enum=poll.TLConstructor" json:"constructor,omitempty"`
+	UserId               int64              `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PollId               int64              `protobuf:"varint,4,opt,name=poll_id,json=pollId,proto3" json:"poll_id,omitempty"`
+	Option               []byte             `protobuf:"bytes,5,opt,name=option,proto3" json:"option,omitempty"`
+	Offset               *types.StringValue `protobuf:"bytes,6,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit                int32              `protobuf:"varint,7,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *TLPollGetPollVotes) Reset()         { *m = TLPollGetPollVotes{} }
+func (m *TLPollGetPollVotes) String() string { return proto.CompactTextString(m) }
+func (*TLPollGetPollVotes) ProtoMessage()    {}
+func (*TLPollGetPollVotes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_144fbf276ed9a72d, []int{4}
+}
+func (m *TLPollGetPollVotes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLPollGetPollVotes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLPollGetPollVotes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TLPollGetPollVotes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLPollGetPollVotes.Merge(m, src)
+}
+func (m *TLPollGetPollVotes) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLPollGetPollVotes) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLPollGetPollVotes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLPollGetPollVotes proto.InternalMessageInfo
+
+func (m *TLPollGetPollVotes) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
+	}
+	return CRC32_UNKNOWN
+}
+
+func (m *TLPollGetPollVotes) GetUserId() int64 {
+	if m != nil {
+		return m.UserId
+	}
+	return 0
+}
+
+func (m *TLPollGetPollVotes) GetPollId() int64 {
+	if m != nil {
+		return m.PollId
+	}
+	return 0
+}
+
+func (m *TLPollGetPollVotes) GetOption() []byte {
+	if m != nil {
+		return m.Option
+	}
+	return nil
+}
+
+func (m *TLPollGetPollVotes) GetOffset() *types.StringValue {
+	if m != nil {
+		return m.Offset
+	}
+	return nil
+}
+
+func (m *TLPollGetPollVotes) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("poll.TLConstructor", TLConstructor_name, TLConstructor_value)
+	proto.RegisterType((*TLPollCreateMediaPoll)(nil), "poll.TL_poll_createMediaPoll")
+	proto.RegisterType((*TLPollGetMediaPoll)(nil), "poll.TL_poll_getMediaPoll")
+	proto.RegisterType((*TLPollCloseMediaPoll)(nil), "poll.TL_poll_closeMediaPoll")
+	proto.RegisterType((*TLPollSendVote)(nil), "poll.TL_poll_sendVote")
+	proto.RegisterType((*TLPollGetPollVotes)(nil), "poll.TL_poll_getPollVotes")
+}
+
+func init() { proto.RegisterFile("poll.tl.proto", fileDescriptor_144fbf276ed9a72d) }
+
+var fileDescriptor_144fbf276ed9a72d = []byte{
+	// 721 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x55, 0x4d, 0x6b, 0x13, 0x4f,
+	0x18, 0xcf, 0xfc, 0xf3, 0xf6, 0x67, 0xda, 0xd4, 0xed, 0x34, 0x26, 0xdb, 0xb5, 0xc6, 0x98, 0x83,
+	0x0d, 0x42, 0x37, 0x90, 0x2a, 0xe8, 0x4d, 0x0d, 0x05, 0x4b, 0xd3, 0x58, 0xd6, 0x5a, 0xc1, 0xcb,
+	0xb2, 0xdd, 0x4c, 0xb7, 0x0b, 0x9b, 0x9d, 0x65, 0x66, 0x62, 0xe9, 0x49, 0x44, 0x44, 0x41, 0xf0,
+	0xe6, 0x55, 0x04, 0xf5, 0x03, 0x48, 0xfd, 0x06, 0x3d, 0x28, 0x9e, 0xfc, 0x02, 0x82, 0xad, 0x77,
+	0xf5, 0x2c, 0xbe, 0xb1, 0xb3, 0xd9, 0x64, 0xb7, 0x4d, 0xc0, 0x8b, 0x35, 0x87, 0x30, 0xcf, 0xf3,
+	0x7b, 0xe6, 0xf7, 0xfc, 0xe6, 0x79, 0x49, 0x60, 0xce, 0x23, 0x8e, 0xa3, 0x72, 0x47, 0xf5, 0x28,
+	0xe1, 0x04, 0xa5, 0x7c, 0x53, 0x99, 0xb3, 0x6c, 0xbe, 0xd9, 0x5d, 0x57, 0x4d, 0xd2, 0xa9, 0x59,
+	0xc4, 0x22, 0x35, 0x01, 0xae, 0x77, 0x37, 0x84, 0x25, 0x0c, 0x71, 0x0a, 0x2e, 0x29, 0x25, 0x8b,
+	0x10, 0xcb, 0xc1, 0x83, 0xa8, 0x2d, 0x6a, 0x78, 0x1e, 0xa6, 0xac, 0x87, 0x2b, 0xcc, 0xdc, 0xc4,
+	0x1d, 0xc3, 0xcf, 0x62, 0x12, 0x8a, 0x75, 0xbe, 0xed, 0xe1, 0x10, 0x9b, 0x1e, 0x60, 0x9c, 0x1a,
+	0x2e, 0xf3, 0x08, 0xe5, 0x3d, 0x28, 0x3f, 0x80, 0xd8, 0xb6, 0x6b, 0x06, 0xde, 0xca, 0xeb, 0xff,
+	0x60, 0x71, 0xb5, 0xa9, 0xfb, 0x3a, 0x75, 0x93, 0x62, 0x83, 0xe3, 0x65, 0xdc, 0xb6, 0x8d, 0x15,
+	0xe2, 0x38, 0xe8, 0x3c, 0x1c, 0x33, 0x89, 0xcb, 0x38, 0xed, 0x9a, 0x9c, 0x50, 0x19, 0x94, 0x41,
+	0x75, 0xa2, 0x3e, 0xa5, 0x8a, 0x27, 0xae, 0x36, 0x1b, 0x03, 0x48, 0x8b, 0xc6, 0xa1, 0x22, 0xcc,
+	0x76, 0x19, 0xa6, 0xba, 0xdd, 0x96, 0x93, 0x65, 0x50, 0x4d, 0x6a, 0x19, 0xdf, 0x5c, 0x6c, 0xa3,
+	0x59, 0x78, 0xcc, 0x24, 0x94, 0x62, 0x93, 0xeb, 0x86, 0xcb, 0xb6, 0x30, 0x65, 0x72, 0xaa, 0x9c,
+	0xac, 0x8e, 0x6b, 0x13, 0x3d, 0xf7, 0xe5, 0xc0, 0x8b, 0x4e, 0x43, 0x51, 0x38, 0x39, 0x5d, 0x06,
+	0xd5, 0xb1, 0x7a, 0x4e, 0xed, 0x70, 0x21, 0x56, 0xf5, 0x55, 0x69, 0x02, 0x42, 0x17, 0xe0, 0xff,
+	0x8c, 0x38, 0x5d, 0x6e, 0x13, 0x57, 0xce, 0x88, 0xb0, 0x19, 0x35, 0xa8, 0x9b, 0x1a, 0xd6, 0x4d,
+	0xbd, 0xce, 0xa9, 0xed, 0x5a, 0x6b, 0x86, 0xd3, 0xc5, 0x5a, 0x3f, 0x1a, 0x35, 0xe0, 0x64, 0x78,
+	0xd6, 0xb1, 0xcb, 0x6d, 0x6e, 0x63, 0x26, 0x67, 0xcb, 0xc9, 0xea, 0x58, 0xbd, 0xd0, 0xcf, 0xb4,
+	0x8c, 0x19, 0x33, 0x2c, 0xbc, 0xe0, 0xe3, 0xdb, 0x9a, 0x14, 0x5e, 0x58, 0xe8, 0xc5, 0x57, 0xee,
+	0xc0, 0x7c, 0x58, 0x35, 0x0b, 0xf3, 0xbf, 0x57, 0xb2, 0x22, 0xcc, 0x8a, 0x24, 0x76, 0x5b, 0x4e,
+	0x05, 0x80, 0x6f, 0x2e, 0xb6, 0x2b, 0x77, 0x01, 0x2c, 0xf4, 0xfb, 0xe6, 0x10, 0x86, 0xff, 0x81,
+	0x86, 0x27, 0x00, 0x4a, 0xa1, 0x06, 0x86, 0xdd, 0xf6, 0x1a, 0xe1, 0xf8, 0xc8, 0xb2, 0x23, 0x19,
+	0x66, 0x89, 0xe7, 0x37, 0x85, 0xc9, 0x69, 0x31, 0x45, 0xa1, 0x59, 0xf9, 0x04, 0x62, 0xdd, 0xf1,
+	0x8b, 0xe2, 0x4b, 0x63, 0x47, 0xa7, 0xad, 0x00, 0x33, 0x81, 0x18, 0x31, 0xc2, 0xe3, 0x5a, 0xcf,
+	0x42, 0xe7, 0x60, 0x86, 0x6c, 0x6c, 0x30, 0xcc, 0xff, 0x68, 0x66, 0x7b, 0xb1, 0x28, 0x0f, 0xd3,
+	0x8e, 0xdd, 0xb1, 0xb9, 0x9c, 0x2d, 0x83, 0x6a, 0x5a, 0x0b, 0x8c, 0xb3, 0x1f, 0x00, 0xcc, 0xc5,
+	0x44, 0xa3, 0x49, 0x98, 0x6b, 0x68, 0x8d, 0xf9, 0xba, 0x7e, 0xa3, 0xb5, 0xd4, 0xba, 0x76, 0xb3,
+	0x25, 0x25, 0xd0, 0x2c, 0x54, 0x02, 0xd7, 0xb0, 0x05, 0x97, 0x76, 0x9e, 0x3d, 0x7d, 0xf7, 0xcb,
+	0xff, 0x00, 0x74, 0x0a, 0x16, 0x23, 0x81, 0xd1, 0x99, 0x96, 0x1e, 0xfc, 0x7c, 0xf4, 0x26, 0x8d,
+	0xce, 0xc0, 0xe9, 0x28, 0x53, 0x6c, 0xe4, 0xa4, 0x9d, 0x97, 0x8f, 0xf7, 0x7e, 0x04, 0x44, 0x65,
+	0x38, 0x15, 0x89, 0x0b, 0xc7, 0x42, 0xfa, 0xf2, 0xf9, 0xd5, 0xee, 0xf7, 0x11, 0xa9, 0xfa, 0x0d,
+	0x92, 0xbe, 0xdd, 0x7f, 0xb1, 0x9b, 0x52, 0x52, 0x0f, 0x9f, 0x97, 0x12, 0xf5, 0x7b, 0x49, 0x98,
+	0xd5, 0x56, 0x1a, 0x62, 0xa4, 0x5b, 0x30, 0x3f, 0xf4, 0x17, 0xea, 0x64, 0xd8, 0xbb, 0xa1, 0xef,
+	0x53, 0x8e, 0x1f, 0xdc, 0x67, 0x01, 0x55, 0x12, 0xe8, 0x2a, 0x9c, 0x3c, 0xbc, 0xbb, 0x4a, 0x9c,
+	0x2c, 0x8a, 0x8d, 0x66, 0x6a, 0xc2, 0xa9, 0x61, 0x3b, 0x38, 0x73, 0x40, 0x58, 0x0c, 0x1d, 0xcd,
+	0x76, 0x09, 0xe6, 0x62, 0x65, 0x43, 0x85, 0x38, 0x4f, 0xe8, 0x1f, 0xcd, 0xd0, 0x1a, 0xbc, 0x6c,
+	0x30, 0xf7, 0x87, 0x5f, 0xd6, 0xc7, 0x94, 0x13, 0x7d, 0xa6, 0x4e, 0xc0, 0xc4, 0x74, 0x01, 0x34,
+	0x6d, 0xc6, 0x2b, 0x89, 0x2b, 0x4b, 0x5f, 0xf7, 0x4a, 0xe0, 0xed, 0x7e, 0x09, 0xbc, 0xdf, 0x2f,
+	0x81, 0x8f, 0xfb, 0x25, 0x70, 0xeb, 0x62, 0xe4, 0xdf, 0x8c, 0x63, 0xa3, 0x63, 0x51, 0x63, 0x70,
+	0x98, 0x63, 0x98, 0xde, 0xc6, 0xb4, 0x66, 0x78, 0x5e, 0xcd, 0x3f, 0xda, 0x26, 0xae, 0xf9, 0x39,
+	0xc5, 0xd7, 0x7a, 0x46, 0x24, 0x9a, 0xff, 0x3d, 0x00, 0xb3, 0x87, 0x57, 0xa9, 0x26, 0x07, 0x00,
+	0x00,
+}
+
+func (this *TLPollCreateMediaPoll) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 10)
+	s = append(s, "&poll.TLPollCreateMediaPoll{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
+	s = append(s, "CorrectAnswers: "+fmt.Sprintf("%#v", this.CorrectAnswers)+",\n")
+	if this.Poll != nil {
+		s = append(s, "Poll: "+fmt.Sprintf("%#v", this.Poll)+",\n")
+	}
+	if this.Solution != nil {
+		s = append(s, "Solution: "+fmt.Sprintf("%#v", this.Solution)+",\n")
+	}
+	if this.SolutionEntities != nil {
+		s = append(s, "SolutionEntities: "+fmt.Sprintf("%#v", this.SolutionEntities)+",\n")
+	}
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLPollGetMediaPoll) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&poll.TLPollGetMediaPoll{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
+	s = append(s, "PollId: "+fmt.Sprintf("%#v", this.PollId)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLPollCloseMediaPoll) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&poll.TLPollCloseMediaPoll{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
+	s = append(s, "PollId: "+fmt.Sprintf("%#v", this.PollId)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLPollSendVote) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&poll.TLPollSendVote{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
+	s = append(s, "PollId: "+fmt.Sprintf("%#v", this.PollId)+",\n")
+	s = append(s, "Options: "+fmt.Sprintf("%#v", this.Options)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLPollGetPollVotes) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 10)
+	s = append(s, "&poll.TLPollGetPollVotes{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
+	s = append(s, "PollId: "+fmt.Sprintf("%#v", this.PollId)+",\n")
+	s = append(s, "Option: "+fmt.Sprintf("%#v", this.Option)+",\n")
+	if this.Offset != nil {
+		s = append(s, "Offset: "+fmt.Sprintf("%#v", this.Offset)+",\n")
+	}
+	s = append(s, "Limit: "+fmt.Sprintf("%#v", this.Limit)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringPollTl(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// RPCPollClient is the client API for RPCPoll service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type RPCPollClient interface {
+	// poll.createMediaPoll flags:# user_id:long correct_answers:flags.0?Vector<bytes> poll:Poll solution:flags.1?string solution_entities:flags.1?Vector<MessageEntity> = MessageMedia;
+	PollCreateMediaPoll(ctx context.Context, in *TLPollCreateMediaPoll, opts ...grpc.CallOption) (*mtproto.MessageMedia, error)
+	// poll.getMediaPoll user_id:long poll_id:long = MessageMedia;
+	PollGetMediaPoll(ctx context.Context, in *TLPollGetMediaPoll, opts ...grpc.CallOption) (*mtproto.MessageMedia, error)
+	// poll.closeMediaPoll user_id:long poll_id:long = MessageMedia;
+	PollCloseMediaPoll(ctx context.Context, in *TLPollCloseMediaPoll, opts ...grpc.CallOption) (*mtproto.MessageMedia, error)
+	// poll.sendVote user_id:long poll_id:long options:Vector<bytes> = MessageMedia;
+	PollSendVote(ctx context.Context, in *TLPollSendVote, opts ...grpc.CallOption) (*mtproto.MessageMedia, error)
+	// poll.getPollVotes flags:# user_id:long poll_id:long option:flags.0?bytes offset:flags.1?string limit:int = messages.VotesList;
+	PollGetPollVotes(ctx context.Context, in *TLPollGetPollVotes, opts ...grpc.CallOption) (*mtproto.Messages_VotesList, error)
+}
+
+type rPCPollClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewRPCPollClient(cc *grpc.ClientConn) RPCPollClient {
+	return &rPCPollClient{cc}
+}
+
+func (c *rPCPollClient) PollCreateMediaPoll(ctx context.Context, in *TLPollCreateMediaPoll, opts ...grpc.CallOption) (*mtproto.MessageMedia, error) {
+	out := new(mtproto.MessageMedia)
+	err := c.cc.Invoke(ctx, "/poll.RPCPoll/poll_createMediaPoll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPCPollClient) PollGetMediaPoll(ctx context.Context, in *TLPollGetMediaPoll, opts ...grpc.CallOption) (*mtproto.MessageMedia, error) {
+	out := new(mtproto.MessageMedia)
+	err := c.cc.Invoke(ctx, "/poll.RPCPoll/poll_getMediaPoll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPCPollClient) PollCloseMediaPoll(ctx context.Context, in *TLPollCloseMediaPoll, opts ...grpc.CallOption) (*mtproto.MessageMedia, error) {
+	out := new(mtproto.MessageMedia)
+	err := c.cc.Invoke(ctx, "/poll.RPCPoll/poll_closeMediaPoll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPCPollClient) PollSendVote(ctx context.Context, in *TLPollSendVote, opts ...grpc.CallOption) (*mtproto.MessageMedia, error) {
+	out := new(mtproto.MessageMedia)
+	err := c.cc.Invoke(ctx, "/poll.RPCPoll/poll_sendVote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPCPollClient) PollGetPollVotes(ctx context.Context, in *TLPollGetPollVotes, opts ...grpc.CallOption) (*mtproto.Messages_VotesList, error) {
+	out := new(mtproto.Messages_VotesList)
+	err := c.cc.Invoke(ctx, "/poll.RPCPoll/poll_getPollVotes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RPCPollServer is the server API for RPCPoll service.
+type RPCPollServer interface {
+	// poll.createMediaPoll flags:# user_id:long correct_answers:flags.0?Vector<bytes> poll:Poll solution:flags.1?string solution_entities:flags.1?Vector<MessageEntity> = MessageMedia;
+	PollCreateMediaPoll(context.Context, *TLPollCreateMediaPoll) (*mtproto.MessageMedia, error)
+	// poll.getMediaPoll user_id:long poll_id:long = MessageMedia;
+	PollGetMediaPoll(context.Context, *TLPollGetMediaPoll) (*mtproto.MessageMedia, error)
+	// poll.closeMediaPoll user_id:long poll_id:long = MessageMedia;
+	PollCloseMediaPoll(context.Context, *TLPollCloseMediaPoll) (*mtproto.MessageMedia, error)
+	// poll.sendVote user_id:long poll_id:long options:Vector<bytes> = MessageMedia;
+	PollSendVote(context.Context, *TLPollSendVote) (*mtproto.MessageMedia, error)
+	// poll.getPollVotes flags:# user_id:long poll_id:long option:flags.0?bytes offset:flags.1?string limit:int = messages.VotesList;
+	PollGetPollVotes(context.Context, *TLPollGetPollVotes) (*mtproto.Messages_VotesList, error)
+}
+
+// UnimplementedRPCPollServer can be embedded to have forward compatible implementations.
+type UnimplementedRPCPollServer struct {
+}
+
+func (*UnimplementedRPCPollServer) PollCreateMediaPoll(ctx context.Context, req *TLPollCreateMediaPoll) (*mtproto.MessageMedia, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PollCreateMediaPoll not implemented")
+}
+func (*UnimplementedRPCPollServer) PollGetMediaPoll(ctx context.Context, req *TLPollGetMediaPoll) (*mtproto.MessageMedia, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PollGetMediaPoll not implemented")
+}
+func (*UnimplementedRPCPollServer) PollCloseMediaPoll(ctx context.Context, req *TLPollCloseMediaPoll) (*mtproto.MessageMedia, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PollCloseMediaPoll not implemented")
+}
+func (*UnimplementedRPCPollServer) PollSendVote(ctx context.Context, req *TLPollSendVote) (*mtproto.MessageMedia, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PollSendVote not implemented")
+}
+func (*UnimplementedRPCPollServer) PollGetPollVotes(ctx context.Context, req *TLPollGetPollVotes) (*mtproto.Messages_VotesList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PollGetPollVotes not implemented")
+}
+
+func RegisterRPCPollServer(s *grpc.Server, srv RPCPollServer) {
+	s.RegisterService(&_RPCPoll_serviceDesc, srv)
+}
+
+func _RPCPoll_PollCreateMediaPoll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLPollCreateMediaPoll)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCPollServer).PollCreateMediaPoll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/poll.RPCPoll/PollCreateMediaPoll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCPollServer).PollCreateMediaPoll(ctx, req.(*TLPollCreateMediaPoll))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCPoll_PollGetMediaPoll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLPollGetMediaPoll)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCPollServer).PollGetMediaPoll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/poll.RPCPoll/PollGetMediaPoll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCPollServer).PollGetMediaPoll(ctx, req.(*TLPollGetMediaPoll))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCPoll_PollCloseMediaPoll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLPollCloseMediaPoll)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCPollServer).PollCloseMediaPoll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/poll.RPCPoll/PollCloseMediaPoll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCPollServer).PollCloseMediaPoll(ctx, req.(*TLPollCloseMediaPoll))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCPoll_PollSendVote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLPollSendVote)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCPollServer).PollSendVote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/poll.RPCPoll/PollSendVote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCPollServer).PollSendVote(ctx, req.(*TLPollSendVote))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCPoll_PollGetPollVotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLPollGetPollVotes)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCPollServer).PollGetPollVotes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/poll.RPCPoll/PollGetPollVotes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCPollServer).PollGetPollVotes(ctx, req.(*TLPollGetPollVotes))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _RPCPoll_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "poll.RPCPoll",
+	HandlerType: (*RPCPollServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "poll_createMediaPoll",
+			Handler:    _RPCPoll_PollCreateMediaPoll_Handler,
+		},
+		{
+			MethodName: "poll_getMediaPoll",
+			Handler:    _RPCPoll_PollGetMediaPoll_Handler,
+		},
+		{
+			MethodName: "poll_closeMediaPoll",
+			Handler:    _RPCPoll_PollCloseMediaPoll_Handler,
+		},
+		{
+			MethodName: "poll_sendVote",
+			Handler:    _RPCPoll_PollSendVote_Handler,
+		},
+		{
+			MethodName: "poll_getPollVotes",
+			Handler:    _RPCPoll_PollGetPollVotes_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "poll.tl.proto",
+}
+
+func (m *TLPollCreateMediaPoll) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLPollCreateMediaPoll) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLPollCreateMediaPoll) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.SolutionEntities) > 0 {
+		for iNdEx := len(m.SolutionEntities) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SolutionEntities[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPollTl(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.Solution != nil {
+		{
+			size, err := m.Solution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPollTl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Poll != nil {
+		{
+			size, err := m.Poll.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPollTl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.CorrectAnswers) > 0 {
+		for iNdEx := len(m.CorrectAnswers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CorrectAnswers[iNdEx])
+			copy(dAtA[i:], m.CorrectAnswers[iNdEx])
+			i = encodeVarintPollTl(dAtA, i, uint64(len(m.CorrectAnswers[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.UserId != 0 {
+		i = encodeVarintPollTl(dAtA, i, uint64(m.UserId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintPollTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLPollGetMediaPoll) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLPollGetMediaPoll) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLPollGetMediaPoll) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.PollId != 0 {
+		i = encodeVarintPollTl(dAtA, i, uint64(m.PollId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.UserId != 0 {
+		i = encodeVarintPollTl(dAtA, i, uint64(m.UserId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintPollTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLPollCloseMediaPoll) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLPollCloseMediaPoll) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLPollCloseMediaPoll) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.PollId != 0 {
+		i = encodeVarintPollTl(dAtA, i, uint64(m.PollId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.UserId != 0 {
+		i = encodeVarintPollTl(dAtA, i, uint64(m.UserId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintPollTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLPollSendVote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLPollSendVote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLPollSendVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Options) > 0 {
+		for iNdEx := len(m.Options) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Options[iNdEx])
+			copy(dAtA[i:], m.Options[iNdEx])
+			i = encodeVarintPollTl(dAtA, i, uint64(len(m.Options[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.PollId != 0 {
+		i = encodeVarintPollTl(dAtA, i, uint64(m.PollId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.UserId != 0 {
+		i = encodeVarintPollTl(dAtA, i, uint64(m.UserId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintPollTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLPollGetPollVotes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLPollGetPollVotes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLPollGetPollVotes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Limit != 0 {
+		i = encodeVarintPollTl(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Offset != nil {
+		{
+			size, err := m.Offset.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPollTl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Option) > 0 {
+		i -= len(m.Option)
+		copy(dAtA[i:], m.Option)
+		i = encodeVarintPollTl(dAtA, i, uint64(len(m.Option)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.PollId != 0 {
+		i = encodeVarintPollTl(dAtA, i, uint64(m.PollId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.UserId != 0 {
+		i = encodeVarintPollTl(dAtA, i, uint64(m.UserId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintPollTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPollTl(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPollTl(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *TLPollCreateMediaPoll) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovPollTl(uint64(m.Constructor))
+	}
+	if m.UserId != 0 {
+		n += 1 + sovPollTl(uint64(m.UserId))
+	}
+	if len(m.CorrectAnswers) > 0 {
+		for _, b := range m.CorrectAnswers {
+			l = len(b)
+			n += 1 + l + sovPollTl(uint64(l))
+		}
+	}
+	if m.Poll != nil {
+		l = m.Poll.Size()
+		n += 1 + l + sovPollTl(uint64(l))
+	}
+	if m.Solution != nil {
+		l = m.Solution.Size()
+		n += 1 + l + sovPollTl(uint64(l))
+	}
+	if len(m.SolutionEntities) > 0 {
+		for _, e := range m.SolutionEntities {
+			l = e.Size()
+			n += 1 + l + sovPollTl(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLPollGetMediaPoll) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovPollTl(uint64(m.Constructor))
+	}
+	if m.UserId != 0 {
+		n += 1 + sovPollTl(uint64(m.UserId))
+	}
+	if m.PollId != 0 {
+		n += 1 + sovPollTl(uint64(m.PollId))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLPollCloseMediaPoll) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovPollTl(uint64(m.Constructor))
+	}
+	if m.UserId != 0 {
+		n += 1 + sovPollTl(uint64(m.UserId))
+	}
+	if m.PollId != 0 {
+		n += 1 + sovPollTl(uint64(m.PollId))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLPollSendVote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovPollTl(uint64(m.Constructor))
+	}
+	if m.UserId != 0 {
+		n += 1 + sovPollTl(uint64(m.UserId))
+	}
+	if m.PollId != 0 {
+		n += 1 + sovPollTl(uint64(m.PollId))
+	}
+	if len(m.Options) > 0 {
+		for _, b := range m.Options {
+			l = len(b)
+			n += 1 + l + sovPollTl(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLPollGetPollVotes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovPollTl(uint64(m.Constructor))
+	}
+	if m.UserId != 0 {
+		n += 1 + sovPollTl(uint64(m.UserId))
+	}
+	if m.PollId != 0 {
+		n += 1 + sovPollTl(uint64(m.PollId))
+	}
+	l = len(m.Option)
+	if l > 0 {
+		n += 1 + l + sovPollTl(uint64(l))
+	}
+	if m.Offset != nil {
+		l = m.Offset.Size()
+		n += 1 + l + sovPollTl(uint64(l))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovPollTl(uint64(m.Limit))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovPollTl(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPollTl(x uint64) (n int) {
+	return sovPollTl(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *TLPollCreateMediaPoll) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPollTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_poll_createMediaPoll: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_poll_createMediaPoll: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPollTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			m.UserId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPollTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UserId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CorrectAnswers", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPollTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPollTl
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPollTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CorrectAnswers = append(m.CorrectAnswers, make([]byte, postIndex-iNdEx))
+			copy(m.CorrectAnswers[len(m.CorrectAnswers)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Poll", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPollTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPollTl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPollTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Poll == nil {
+				m.Poll = &mtproto.Poll{}
+			}
+			if err := m.Poll.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Solution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPollTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPollTl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPollTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Solution == nil {
+				m.Solution = &types.StringValue{}
+			}
+			if err := m.Solution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SolutionEntities", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPollTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPollTl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPollTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SolutionEntities = append(m.SolutionEntities, &mtproto.MessageEntity{})
+			if err := m.SolutionEntities[len(m.SolutionEntities)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPollTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPollTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLPollGetMediaPoll) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPollTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_poll_getMediaPoll: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_poll_getMediaPoll: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPollTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			m.UserId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPollTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UserId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PollId", wireType)
+			}
+			m.PollId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPollTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PollId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPollTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPollTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLPollCloseMediaPoll) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPollTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_poll_closeMediaPoll: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_poll_closeMediaPoll: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPollTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			m.UserId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPollTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UserId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PollId", wireType)
+			}
+			m.PollId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPollTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PollId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPollTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPollTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLPollSendVote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPollTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_poll_sendVote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_poll_sendVote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPollTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			m.UserId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPollTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UserId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PollId", wireType)
+			}
+			m.PollId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPollTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PollId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPollTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPollTl
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPollTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Options = append(m.Options, make([]byte, postIndex-iNdEx))
+			copy(m.Options[len(m.Options)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPollTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPollTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLPollGetPollVotes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPollTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_poll_getPollVotes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_poll_getPollVotes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPollTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			m.UserId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPollTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UserId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PollId", wireType)
+			}
+			m.PollId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPollTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PollId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Option", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPollTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPollTl
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPollTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Option = append(m.Option[:0], dAtA[iNdEx:postIndex]...)
+			if m.Option == nil {
+				m.Option = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPollTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPollTl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPollTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Offset == nil {
+				m.Offset = &types.StringValue{}
+			}
+			if err := m.Offset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPollTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPollTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPollTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipPollTl(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowPollTl
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPollTl
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPollTl
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthPollTl
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupPollTl
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthPollTl
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthPollTl        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowPollTl          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupPollTl = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,34 @@
+// Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+//  All rights reserved.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package poll
+
+import (
+	"github.com/teamgram/proto/mtproto"
+)
+
+// MakeMinPollResults strips the results of one voter for the other participants,
+// the clients keep their own chosen answers when they get min results.
+func MakeMinPollResults(results *mtproto.PollResults) *mtproto.PollResults {
+	minResults := mtproto.MakeTLPollResults(&mtproto.PollResults{
+		Min:              true,
+		Results:          make([]*mtproto.PollAnswerVoters, 0, len(results.GetResults())),
+		TotalVoters:      results.GetTotalVoters(),
+		RecentVoters:     results.GetRecentVoters(),
+		Solution:         nil,
+		SolutionEntities: nil,
+	}).To_PollResults()
+	for _, v := range results.GetResults() {
+		minResults.Results = append(minResults.Results, mtproto.MakeTLPollAnswerVoters(&mtproto.PollAnswerVoters{
+			Chosen:  false,
+			Correct: false,
+			Option:  v.Option,
+			Voters:  v.Voters,
+		}).To_PollAnswerVoters())
+	}
+
+	return minResults
+}
@@ -0,0 +1,52 @@
+/*
+ * WARNING! All changes made in this file will be lost!
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2022-present,  Teamgram Authors.
+ *  All rights reserved.
+ *
+ * Author: teagramio (teagram.io@gmail.com)
+ */
+
+package poll
+
+import (
+	"reflect"
+
+	"github.com/teamgram/proto/mtproto"
+)
+
+var _ *mtproto.Bool
+
+type newRPCReplyFunc func() interface{}
+
+type RPCContextTuple struct {
+	Method       string
+	NewReplyFunc newRPCReplyFunc
+}
+
+var rpcContextRegisters = map[string]RPCContextTuple{
+	"TLPollCreateMediaPoll": RPCContextTuple{"/mtproto.RPCPoll/poll_createMediaPoll", func() interface{} { return new(mtproto.MessageMedia) }},
+	"TLPollGetMediaPoll":    RPCContextTuple{"/mtproto.RPCPoll/poll_getMediaPoll", func() interface{} { return new(mtproto.MessageMedia) }},
+	"TLPollCloseMediaPoll":  RPCContextTuple{"/mtproto.RPCPoll/poll_closeMediaPoll", func() interface{} { return new(mtproto.MessageMedia) }},
+	"TLPollSendVote":        RPCContextTuple{"/mtproto.RPCPoll/poll_sendVote", func() interface{} { return new(mtproto.MessageMedia) }},
+	"TLPollGetPollVotes":    RPCContextTuple{"/mtproto.RPCPoll/poll_getPollVotes", func() interface{} { return new(mtproto.Messages_VotesList) }},
+}
+
+func FindRPCContextTuple(t interface{}) *RPCContextTuple {
+	rt := reflect.TypeOf(t)
+	if rt.Kind() == reflect.Ptr {
+		rt = rt.Elem()
+	}
+
+	m, ok := rpcContextRegisters[rt.Name()]
+	if !ok {
+		// log.Errorf("Can't find name: %s", rt.Name())
+		return nil
+	}
+	return &m
+}
+
+func GetRPCContextRegisters() map[string]RPCContextTuple {
+	return rpcContextRegisters
+}
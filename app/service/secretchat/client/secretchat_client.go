@@ -0,0 +1,83 @@
+/*
+ * WARNING! All changes made in this file will be lost!
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package secretchat_client
+
+import (
+	"context"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/secretchat/secretchat"
+
+	"github.com/zeromicro/go-zero/zrpc"
+)
+
+var _ *mtproto.Bool
+
+type SecretchatClient interface {
+	SecretchatRequestEncryption(ctx context.Context, in *secretchat.TLSecretchatRequestEncryption) (*secretchat.SecretChat, error)
+	SecretchatAcceptEncryption(ctx context.Context, in *secretchat.TLSecretchatAcceptEncryption) (*secretchat.SecretChat, error)
+	SecretchatDiscardEncryption(ctx context.Context, in *secretchat.TLSecretchatDiscardEncryption) (*secretchat.SecretChat, error)
+	SecretchatGetSecretChat(ctx context.Context, in *secretchat.TLSecretchatGetSecretChat) (*secretchat.SecretChat, error)
+	SecretchatPushEncryptedMessage(ctx context.Context, in *secretchat.TLSecretchatPushEncryptedMessage) (*mtproto.Update, error)
+	SecretchatReceivedQueue(ctx context.Context, in *secretchat.TLSecretchatReceivedQueue) (*secretchat.Vector_Long, error)
+}
+
+type defaultSecretchatClient struct {
+	cli zrpc.Client
+}
+
+func NewSecretchatClient(cli zrpc.Client) SecretchatClient {
+	return &defaultSecretchatClient{
+		cli: cli,
+	}
+}
+
+// SecretchatRequestEncryption
+// secretchat.requestEncryption user_id:long auth_key_id:long participant_id:long random_id:int g_a:bytes = SecretChat;
+func (m *defaultSecretchatClient) SecretchatRequestEncryption(ctx context.Context, in *secretchat.TLSecretchatRequestEncryption) (*secretchat.SecretChat, error) {
+	client := secretchat.NewRPCSecretchatClient(m.cli.Conn())
+	return client.SecretchatRequestEncryption(ctx, in)
+}
+
+// SecretchatAcceptEncryption
+// secretchat.acceptEncryption user_id:long auth_key_id:long chat_id:int g_b:bytes key_fingerprint:long = SecretChat;
+func (m *defaultSecretchatClient) SecretchatAcceptEncryption(ctx context.Context, in *secretchat.TLSecretchatAcceptEncryption) (*secretchat.SecretChat, error) {
+	client := secretchat.NewRPCSecretchatClient(m.cli.Conn())
+	return client.SecretchatAcceptEncryption(ctx, in)
+}
+
+// SecretchatDiscardEncryption
+// secretchat.discardEncryption flags:# user_id:long chat_id:int delete_history:flags.0?true = SecretChat;
+func (m *defaultSecretchatClient) SecretchatDiscardEncryption(ctx context.Context, in *secretchat.TLSecretchatDiscardEncryption) (*secretchat.SecretChat, error) {
+	client := secretchat.NewRPCSecretchatClient(m.cli.Conn())
+	return client.SecretchatDiscardEncryption(ctx, in)
+}
+
+// SecretchatGetSecretChat
+// secretchat.getSecretChat user_id:long chat_id:int = SecretChat;
+func (m *defaultSecretchatClient) SecretchatGetSecretChat(ctx context.Context, in *secretchat.TLSecretchatGetSecretChat) (*secretchat.SecretChat, error) {
+	client := secretchat.NewRPCSecretchatClient(m.cli.Conn())
+	return client.SecretchatGetSecretChat(ctx, in)
+}
+
+// SecretchatPushEncryptedMessage
+// secretchat.pushEncryptedMessage user_id:long auth_key_id:long message:EncryptedMessage = Update;
+func (m *defaultSecretchatClient) SecretchatPushEncryptedMessage(ctx context.Context, in *secretchat.TLSecretchatPushEncryptedMessage) (*mtproto.Update, error) {
+	client := secretchat.NewRPCSecretchatClient(m.cli.Conn())
+	return client.SecretchatPushEncryptedMessage(ctx, in)
+}
+
+// SecretchatReceivedQueue
+// secretchat.receivedQueue auth_key_id:long max_qts:int = Vector<long>;
+func (m *defaultSecretchatClient) SecretchatReceivedQueue(ctx context.Context, in *secretchat.TLSecretchatReceivedQueue) (*secretchat.Vector_Long, error) {
+	client := secretchat.NewRPCSecretchatClient(m.cli.Conn())
+	return client.SecretchatReceivedQueue(ctx, in)
+}
@@ -0,0 +1,62 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"github.com/teamgram/marmota/pkg/hack"
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/secretchat/secretchat"
+)
+
+// SecretchatAcceptEncryption
+// secretchat.acceptEncryption user_id:long auth_key_id:long chat_id:int g_b:bytes key_fingerprint:long = SecretChat;
+func (c *SecretchatCore) SecretchatAcceptEncryption(in *secretchat.TLSecretchatAcceptEncryption) (*secretchat.SecretChat, error) {
+	chat, err := c.svcCtx.Dao.GetSecretChat(c.ctx, in.UserId, in.ChatId)
+	if err != nil {
+		c.Logger.Errorf("secretchat.acceptEncryption - error: %v", err)
+		return nil, err
+	}
+
+	// only the invited user accepts
+	if chat.ParticipantId != in.UserId {
+		err = mtproto.ErrEncryptionIdInvalid
+		c.Logger.Errorf("secretchat.acceptEncryption - error: %v", err)
+		return nil, err
+	}
+
+	switch chat.State {
+	case secretchat.EncryptedChatStateDiscarded:
+		err = mtproto.ErrEncryptionAlreadyDeclined
+	case secretchat.EncryptedChatStateAccepted:
+		err = mtproto.ErrEncryptionAlreadyAccepted
+	}
+	if err != nil {
+		c.Logger.Errorf("secretchat.acceptEncryption - error: %v", err)
+		return nil, err
+	}
+
+	rowsAffected, err := c.svcCtx.Dao.EncryptedChatsDAO.UpdateAccepted(c.ctx, in.AuthKeyId, hack.String(in.GB), in.KeyFingerprint, in.ChatId)
+	if err != nil {
+		c.Logger.Errorf("secretchat.acceptEncryption - error: %v", err)
+		return nil, err
+	} else if rowsAffected == 0 {
+		// accepted by another device in the meantime
+		err = mtproto.ErrEncryptionAlreadyAccepted
+		c.Logger.Errorf("secretchat.acceptEncryption - error: %v", err)
+		return nil, err
+	}
+
+	chat.ParticipantAuthKeyId = in.AuthKeyId
+	chat.GB = in.GB
+	chat.KeyFingerprint = in.KeyFingerprint
+	chat.State = secretchat.EncryptedChatStateAccepted
+
+	return chat, nil
+}
@@ -0,0 +1,41 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/secretchat/secretchat"
+)
+
+// SecretchatDiscardEncryption
+// secretchat.discardEncryption flags:# user_id:long chat_id:int delete_history:flags.0?true = SecretChat;
+func (c *SecretchatCore) SecretchatDiscardEncryption(in *secretchat.TLSecretchatDiscardEncryption) (*secretchat.SecretChat, error) {
+	chat, err := c.svcCtx.Dao.GetSecretChat(c.ctx, in.UserId, in.ChatId)
+	if err != nil {
+		c.Logger.Errorf("secretchat.discardEncryption - error: %v", err)
+		return nil, err
+	}
+
+	if chat.State == secretchat.EncryptedChatStateDiscarded {
+		err = mtproto.ErrEncryptionAlreadyDeclined
+		c.Logger.Errorf("secretchat.discardEncryption - error: %v", err)
+		return nil, err
+	}
+
+	if _, err = c.svcCtx.Dao.EncryptedChatsDAO.UpdateDiscarded(c.ctx, in.DeleteHistory, in.ChatId); err != nil {
+		c.Logger.Errorf("secretchat.discardEncryption - error: %v", err)
+		return nil, err
+	}
+
+	chat.State = secretchat.EncryptedChatStateDiscarded
+	chat.HistoryDeleted = in.DeleteHistory
+
+	return chat, nil
+}
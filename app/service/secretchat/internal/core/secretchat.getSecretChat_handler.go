@@ -0,0 +1,26 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"github.com/teamgram/teamgram-server/app/service/secretchat/secretchat"
+)
+
+// SecretchatGetSecretChat
+// secretchat.getSecretChat user_id:long chat_id:int = SecretChat;
+func (c *SecretchatCore) SecretchatGetSecretChat(in *secretchat.TLSecretchatGetSecretChat) (*secretchat.SecretChat, error) {
+	chat, err := c.svcCtx.Dao.GetSecretChat(c.ctx, in.UserId, in.ChatId)
+	if err != nil {
+		c.Logger.Errorf("secretchat.getSecretChat - error: %v", err)
+		return nil, err
+	}
+
+	return chat, nil
+}
@@ -0,0 +1,46 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"time"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/secretchat/internal/dal/dataobject"
+	"github.com/teamgram/teamgram-server/app/service/secretchat/secretchat"
+
+	"github.com/zeromicro/go-zero/core/jsonx"
+)
+
+// SecretchatPushEncryptedMessage
+// secretchat.pushEncryptedMessage user_id:long auth_key_id:long message:EncryptedMessage = Update;
+func (c *SecretchatCore) SecretchatPushEncryptedMessage(in *secretchat.TLSecretchatPushEncryptedMessage) (*mtproto.Update, error) {
+	// the qts queue belongs to the device the secret chat is bound to
+	update := mtproto.MakeTLUpdateNewEncryptedMessage(&mtproto.Update{
+		Message_ENCRYPTEDMESSAGE: in.Message,
+		Qts:                      c.svcCtx.Dao.IDGenClient2.NextQtsId(c.ctx, in.AuthKeyId),
+	}).To_Update()
+
+	updateData, _ := jsonx.Marshal(update)
+	_, _, err := c.svcCtx.Dao.AuthQtsUpdatesDAO.Insert(c.ctx, &dataobject.AuthQtsUpdatesDO{
+		AuthId:     in.AuthKeyId,
+		UserId:     in.UserId,
+		Qts:        update.Qts,
+		UpdateType: mtproto.GetUpdateType(update),
+		UpdateData: string(updateData),
+		Date2:      time.Now().Unix(),
+	})
+	if err != nil {
+		c.Logger.Errorf("secretchat.pushEncryptedMessage - error: %v", err)
+		return nil, err
+	}
+
+	return update, nil
+}
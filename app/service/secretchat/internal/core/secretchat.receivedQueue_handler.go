@@ -0,0 +1,56 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/secretchat/internal/dal/dataobject"
+	"github.com/teamgram/teamgram-server/app/service/secretchat/secretchat"
+
+	"github.com/zeromicro/go-zero/core/jsonx"
+)
+
+// SecretchatReceivedQueue
+// secretchat.receivedQueue auth_key_id:long max_qts:int = Vector<long>;
+func (c *SecretchatCore) SecretchatReceivedQueue(in *secretchat.TLSecretchatReceivedQueue) (*secretchat.Vector_Long, error) {
+	if in.MaxQts < 0 || in.MaxQts > c.svcCtx.Dao.IDGenClient2.CurrentQtsId(c.ctx, in.AuthKeyId) {
+		err := mtproto.ErrMaxQtsInvalid
+		c.Logger.Errorf("secretchat.receivedQueue - error: %v", err)
+		return nil, err
+	}
+
+	rValues := &secretchat.Vector_Long{
+		Datas: make([]int64, 0),
+	}
+	_, err := c.svcCtx.Dao.AuthQtsUpdatesDAO.SelectByLeQtsWithCB(
+		c.ctx,
+		in.AuthKeyId,
+		in.MaxQts,
+		func(i int, v *dataobject.AuthQtsUpdatesDO) {
+			update := new(mtproto.Update)
+			if err := jsonx.UnmarshalFromString(v.UpdateData, update); err != nil {
+				c.Logger.Errorf("secretchat.receivedQueue - error: %v", err)
+				return
+			}
+			rValues.Datas = append(rValues.Datas, update.GetMessage_ENCRYPTEDMESSAGE().GetRandomId())
+		})
+	if err != nil {
+		c.Logger.Errorf("secretchat.receivedQueue - error: %v", err)
+		return nil, err
+	}
+
+	// the acknowledged messages leave the queue
+	if _, err = c.svcCtx.Dao.AuthQtsUpdatesDAO.DeleteByLeQts(c.ctx, in.AuthKeyId, in.MaxQts); err != nil {
+		c.Logger.Errorf("secretchat.receivedQueue - error: %v", err)
+		return nil, err
+	}
+
+	return rValues, nil
+}
@@ -0,0 +1,52 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"math/rand"
+	"time"
+
+	"github.com/teamgram/marmota/pkg/hack"
+	"github.com/teamgram/teamgram-server/app/service/secretchat/internal/dal/dataobject"
+	"github.com/teamgram/teamgram-server/app/service/secretchat/internal/dao"
+	"github.com/teamgram/teamgram-server/app/service/secretchat/secretchat"
+)
+
+// SecretchatRequestEncryption
+// secretchat.requestEncryption user_id:long auth_key_id:long participant_id:long random_id:int g_a:bytes = SecretChat;
+func (c *SecretchatCore) SecretchatRequestEncryption(in *secretchat.TLSecretchatRequestEncryption) (*secretchat.SecretChat, error) {
+	// random_id makes the request idempotent
+	chatDO, err := c.svcCtx.Dao.EncryptedChatsDAO.SelectByRandomId(c.ctx, in.UserId, in.RandomId)
+	if err != nil {
+		c.Logger.Errorf("secretchat.requestEncryption - error: %v", err)
+		return nil, err
+	} else if chatDO != nil {
+		return dao.MakeSecretChat(chatDO), nil
+	}
+
+	chatDO = &dataobject.EncryptedChatsDO{
+		AccessHash:     rand.Int63(),
+		RandomId:       in.RandomId,
+		AdminId:        in.UserId,
+		AdminAuthKeyId: in.AuthKeyId,
+		ParticipantId:  in.ParticipantId,
+		GA:             hack.String(in.GA),
+		State:          secretchat.EncryptedChatStateRequested,
+		Date2:          time.Now().Unix(),
+	}
+	lastInsertId, _, err := c.svcCtx.Dao.EncryptedChatsDAO.Insert(c.ctx, chatDO)
+	if err != nil {
+		c.Logger.Errorf("secretchat.requestEncryption - error: %v", err)
+		return nil, err
+	}
+	chatDO.Id = int32(lastInsertId)
+
+	return dao.MakeSecretChat(chatDO), nil
+}
@@ -0,0 +1,2 @@
+./dalgen.sh encrypted_chats
+./dalgen.sh auth_qts_updates
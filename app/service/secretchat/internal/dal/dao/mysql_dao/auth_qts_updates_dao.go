@@ -0,0 +1,179 @@
+/*
+ * WARNING! All changes made in this file will be lost!
+ *   Created from by 'dalgen'
+ *
+ * Copyright (c) 2022-present,  Teamgram Authors.
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package mysql_dao
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/teamgram/marmota/pkg/stores/sqlx"
+	"github.com/teamgram/teamgram-server/app/service/secretchat/internal/dal/dataobject"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+var _ *sql.Result
+
+type AuthQtsUpdatesDAO struct {
+	db *sqlx.DB
+}
+
+func NewAuthQtsUpdatesDAO(db *sqlx.DB) *AuthQtsUpdatesDAO {
+	return &AuthQtsUpdatesDAO{db}
+}
+
+// Insert
+// insert into auth_qts_updates(auth_id, user_id, qts, update_type, update_data, date2) values (:auth_id, :user_id, :qts, :update_type, :update_data, :date2)
+// TODO(@benqi): sqlmap
+func (dao *AuthQtsUpdatesDAO) Insert(ctx context.Context, do *dataobject.AuthQtsUpdatesDO) (lastInsertId, rowsAffected int64, err error) {
+	var (
+		query = "insert into auth_qts_updates(auth_id, user_id, qts, update_type, update_data, date2) values (:auth_id, :user_id, :qts, :update_type, :update_data, :date2)"
+		r     sql.Result
+	)
+
+	r, err = dao.db.NamedExec(ctx, query, do)
+	if err != nil {
+		logx.WithContext(ctx).Errorf("namedExec in Insert(%v), error: %v", do, err)
+		return
+	}
+
+	lastInsertId, err = r.LastInsertId()
+	if err != nil {
+		logx.WithContext(ctx).Errorf("lastInsertId in Insert(%v)_error: %v", do, err)
+		return
+	}
+	rowsAffected, err = r.RowsAffected()
+	if err != nil {
+		logx.WithContext(ctx).Errorf("rowsAffected in Insert(%v)_error: %v", do, err)
+	}
+
+	return
+}
+
+// InsertTx
+// insert into auth_qts_updates(auth_id, user_id, qts, update_type, update_data, date2) values (:auth_id, :user_id, :qts, :update_type, :update_data, :date2)
+// TODO(@benqi): sqlmap
+func (dao *AuthQtsUpdatesDAO) InsertTx(tx *sqlx.Tx, do *dataobject.AuthQtsUpdatesDO) (lastInsertId, rowsAffected int64, err error) {
+	var (
+		query = "insert into auth_qts_updates(auth_id, user_id, qts, update_type, update_data, date2) values (:auth_id, :user_id, :qts, :update_type, :update_data, :date2)"
+		r     sql.Result
+	)
+
+	r, err = tx.NamedExec(query, do)
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("namedExec in Insert(%v), error: %v", do, err)
+		return
+	}
+
+	lastInsertId, err = r.LastInsertId()
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("lastInsertId in Insert(%v)_error: %v", do, err)
+		return
+	}
+	rowsAffected, err = r.RowsAffected()
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("rowsAffected in Insert(%v)_error: %v", do, err)
+	}
+
+	return
+}
+
+// SelectByLeQts
+// select auth_id, user_id, qts, update_type, update_data, date2 from auth_qts_updates where auth_id = :auth_id and qts <= :qts order by qts
+// TODO(@benqi): sqlmap
+func (dao *AuthQtsUpdatesDAO) SelectByLeQts(ctx context.Context, auth_id int64, qts int32) (rList []dataobject.AuthQtsUpdatesDO, err error) {
+	var (
+		query  = "select auth_id, user_id, qts, update_type, update_data, date2 from auth_qts_updates where auth_id = ? and qts <= ? order by qts"
+		values []dataobject.AuthQtsUpdatesDO
+	)
+	err = dao.db.QueryRowsPartial(ctx, &values, query, auth_id, qts)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("queryx in SelectByLeQts(_), error: %v", err)
+		return
+	}
+
+	rList = values
+
+	return
+}
+
+// SelectByLeQtsWithCB
+// select auth_id, user_id, qts, update_type, update_data, date2 from auth_qts_updates where auth_id = :auth_id and qts <= :qts order by qts
+// TODO(@benqi): sqlmap
+func (dao *AuthQtsUpdatesDAO) SelectByLeQtsWithCB(ctx context.Context, auth_id int64, qts int32, cb func(i int, v *dataobject.AuthQtsUpdatesDO)) (rList []dataobject.AuthQtsUpdatesDO, err error) {
+	var (
+		query  = "select auth_id, user_id, qts, update_type, update_data, date2 from auth_qts_updates where auth_id = ? and qts <= ? order by qts"
+		values []dataobject.AuthQtsUpdatesDO
+	)
+	err = dao.db.QueryRowsPartial(ctx, &values, query, auth_id, qts)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("queryx in SelectByLeQts(_), error: %v", err)
+		return
+	}
+
+	rList = values
+
+	if cb != nil {
+		for i := 0; i < len(rList); i++ {
+			cb(i, &rList[i])
+		}
+	}
+
+	return
+}
+
+// DeleteByLeQts
+// delete from auth_qts_updates where auth_id = :auth_id and qts <= :qts
+// TODO(@benqi): sqlmap
+func (dao *AuthQtsUpdatesDAO) DeleteByLeQts(ctx context.Context, auth_id int64, qts int32) (rowsAffected int64, err error) {
+	var (
+		query   = "delete from auth_qts_updates where auth_id = ? and qts <= ?"
+		rResult sql.Result
+	)
+	rResult, err = dao.db.Exec(ctx, query, auth_id, qts)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("exec in DeleteByLeQts(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(ctx).Errorf("rowsAffected in DeleteByLeQts(_), error: %v", err)
+	}
+
+	return
+}
+
+// DeleteByLeQtsTx
+// delete from auth_qts_updates where auth_id = :auth_id and qts <= :qts
+// TODO(@benqi): sqlmap
+func (dao *AuthQtsUpdatesDAO) DeleteByLeQtsTx(tx *sqlx.Tx, auth_id int64, qts int32) (rowsAffected int64, err error) {
+	var (
+		query   = "delete from auth_qts_updates where auth_id = ? and qts <= ?"
+		rResult sql.Result
+	)
+	rResult, err = tx.Exec(query, auth_id, qts)
+
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("exec in DeleteByLeQts(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("rowsAffected in DeleteByLeQts(_), error: %v", err)
+	}
+
+	return
+}
@@ -0,0 +1,27 @@
+/*
+ * WARNING! All changes made in this file will be lost!
+ *   Created from by 'dalgen'
+ *
+ * Copyright (c) 2022-present,  Teamgram Authors.
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package dataobject
+
+type EncryptedChatsDO struct {
+	Id                   int32  `db:"id"`
+	AccessHash           int64  `db:"access_hash"`
+	RandomId             int32  `db:"random_id"`
+	AdminId              int64  `db:"admin_id"`
+	AdminAuthKeyId       int64  `db:"admin_auth_key_id"`
+	ParticipantId        int64  `db:"participant_id"`
+	ParticipantAuthKeyId int64  `db:"participant_auth_key_id"`
+	GA                   string `db:"g_a"`
+	GB                   string `db:"g_b"`
+	KeyFingerprint       int64  `db:"key_fingerprint"`
+	State                int32  `db:"state"`
+	HistoryDeleted       bool   `db:"history_deleted"`
+	Date2                int64  `db:"date2"`
+}
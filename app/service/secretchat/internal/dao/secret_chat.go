@@ -0,0 +1,61 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package dao
+
+import (
+	"context"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/secretchat/internal/dal/dataobject"
+	"github.com/teamgram/teamgram-server/app/service/secretchat/secretchat"
+)
+
+func MakeSecretChat(do *dataobject.EncryptedChatsDO) *secretchat.SecretChat {
+	return secretchat.MakeTLSecretChat(&secretchat.SecretChat{
+		Id:                   do.Id,
+		AccessHash:           do.AccessHash,
+		Date:                 int32(do.Date2),
+		AdminId:              do.AdminId,
+		AdminAuthKeyId:       do.AdminAuthKeyId,
+		ParticipantId:        do.ParticipantId,
+		ParticipantAuthKeyId: do.ParticipantAuthKeyId,
+		GA:                   []byte(do.GA),
+		GB:                   []byte(do.GB),
+		KeyFingerprint:       do.KeyFingerprint,
+		State:                do.State,
+		HistoryDeleted:       do.HistoryDeleted,
+	}).To_SecretChat()
+}
+
+// GetSecretChat returns the secret chat if userId is one of its two sides.
+func (d *Dao) GetSecretChat(ctx context.Context, userId int64, chatId int32) (*secretchat.SecretChat, error) {
+	do, err := d.EncryptedChatsDAO.Select(ctx, chatId)
+	if err != nil {
+		return nil, err
+	} else if do == nil {
+		return nil, mtproto.ErrEncryptionIdInvalid
+	}
+
+	chat := MakeSecretChat(do)
+	if !chat.IsParticipant(userId) {
+		return nil, mtproto.ErrEncryptionIdInvalid
+	}
+
+	return chat, nil
+}
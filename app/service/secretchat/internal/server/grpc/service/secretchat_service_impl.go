@@ -0,0 +1,109 @@
+/*
+ * WARNING! All changes made in this file will be lost!
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package service
+
+import (
+	"context"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/secretchat/internal/core"
+	"github.com/teamgram/teamgram-server/app/service/secretchat/secretchat"
+)
+
+// SecretchatRequestEncryption
+// secretchat.requestEncryption user_id:long auth_key_id:long participant_id:long random_id:int g_a:bytes = SecretChat;
+func (s *Service) SecretchatRequestEncryption(ctx context.Context, request *secretchat.TLSecretchatRequestEncryption) (*secretchat.SecretChat, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Infof("secretchat.requestEncryption - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.SecretchatRequestEncryption(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Infof("secretchat.requestEncryption - reply: %s", r.DebugString())
+	return r, err
+}
+
+// SecretchatAcceptEncryption
+// secretchat.acceptEncryption user_id:long auth_key_id:long chat_id:int g_b:bytes key_fingerprint:long = SecretChat;
+func (s *Service) SecretchatAcceptEncryption(ctx context.Context, request *secretchat.TLSecretchatAcceptEncryption) (*secretchat.SecretChat, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Infof("secretchat.acceptEncryption - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.SecretchatAcceptEncryption(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Infof("secretchat.acceptEncryption - reply: %s", r.DebugString())
+	return r, err
+}
+
+// SecretchatDiscardEncryption
+// secretchat.discardEncryption flags:# user_id:long chat_id:int delete_history:flags.0?true = SecretChat;
+func (s *Service) SecretchatDiscardEncryption(ctx context.Context, request *secretchat.TLSecretchatDiscardEncryption) (*secretchat.SecretChat, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Infof("secretchat.discardEncryption - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.SecretchatDiscardEncryption(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Infof("secretchat.discardEncryption - reply: %s", r.DebugString())
+	return r, err
+}
+
+// SecretchatGetSecretChat
+// secretchat.getSecretChat user_id:long chat_id:int = SecretChat;
+func (s *Service) SecretchatGetSecretChat(ctx context.Context, request *secretchat.TLSecretchatGetSecretChat) (*secretchat.SecretChat, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Infof("secretchat.getSecretChat - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.SecretchatGetSecretChat(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Infof("secretchat.getSecretChat - reply: %s", r.DebugString())
+	return r, err
+}
+
+// SecretchatPushEncryptedMessage
+// secretchat.pushEncryptedMessage user_id:long auth_key_id:long message:EncryptedMessage = Update;
+func (s *Service) SecretchatPushEncryptedMessage(ctx context.Context, request *secretchat.TLSecretchatPushEncryptedMessage) (*mtproto.Update, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Infof("secretchat.pushEncryptedMessage - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.SecretchatPushEncryptedMessage(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Infof("secretchat.pushEncryptedMessage - reply: %s", r.DebugString())
+	return r, err
+}
+
+// SecretchatReceivedQueue
+// secretchat.receivedQueue auth_key_id:long max_qts:int = Vector<long>;
+func (s *Service) SecretchatReceivedQueue(ctx context.Context, request *secretchat.TLSecretchatReceivedQueue) (*secretchat.Vector_Long, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Infof("secretchat.receivedQueue - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.SecretchatReceivedQueue(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Infof("secretchat.receivedQueue - reply: %s", r.DebugString())
+	return r, err
+}
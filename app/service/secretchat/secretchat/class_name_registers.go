@@ -0,0 +1,77 @@
+/*
+ * WARNING! All changes made in this file will be lost!
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2022-present,  Teamgram Authors.
+ *  All rights reserved.
+ *
+ * Author: teagramio (teagram.io@gmail.com)
+ */
+
+package secretchat
+
+const (
+	Predicate_secretChat                      = "secretChat"
+	Predicate_secretchat_requestEncryption    = "secretchat_requestEncryption"
+	Predicate_secretchat_acceptEncryption     = "secretchat_acceptEncryption"
+	Predicate_secretchat_discardEncryption    = "secretchat_discardEncryption"
+	Predicate_secretchat_getSecretChat        = "secretchat_getSecretChat"
+	Predicate_secretchat_pushEncryptedMessage = "secretchat_pushEncryptedMessage"
+	Predicate_secretchat_receivedQueue        = "secretchat_receivedQueue"
+)
+
+var clazzNameRegisters2 = map[string]map[int]int32{
+	Predicate_secretChat: {
+		0: -210504031, // 0xf373f6a1
+
+	},
+	Predicate_secretchat_requestEncryption: {
+		0: -1706123918, // 0x9a4e9d72
+
+	},
+	Predicate_secretchat_acceptEncryption: {
+		0: 1539508730, // 0x5bc309fa
+
+	},
+	Predicate_secretchat_discardEncryption: {
+		0: -474687651, // 0xe3b4d75d
+
+	},
+	Predicate_secretchat_getSecretChat: {
+		0: -440911579, // 0xe5b83925
+
+	},
+	Predicate_secretchat_pushEncryptedMessage: {
+		0: 852875594, // 0x32d5d94a
+
+	},
+	Predicate_secretchat_receivedQueue: {
+		0: -466385968, // 0xe43383d0
+
+	},
+}
+
+var clazzIdNameRegisters2 = map[int32]string{
+	-210504031:  Predicate_secretChat,                      // 0xf373f6a1
+	-1706123918: Predicate_secretchat_requestEncryption,    // 0x9a4e9d72
+	1539508730:  Predicate_secretchat_acceptEncryption,     // 0x5bc309fa
+	-474687651:  Predicate_secretchat_discardEncryption,    // 0xe3b4d75d
+	-440911579:  Predicate_secretchat_getSecretChat,        // 0xe5b83925
+	852875594:   Predicate_secretchat_pushEncryptedMessage, // 0x32d5d94a
+	-466385968:  Predicate_secretchat_receivedQueue,        // 0xe43383d0
+
+}
+
+func GetClazzID(clazzName string, layer int) int32 {
+	if m, ok := clazzNameRegisters2[clazzName]; ok {
+		m2, ok2 := m[layer]
+		if ok2 {
+			return m2
+		}
+		m2, ok2 = m[0]
+		if ok2 {
+			return m2
+		}
+	}
+	return 0
+}
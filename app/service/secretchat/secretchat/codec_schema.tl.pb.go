@@ -0,0 +1,665 @@
+/*
+ * WARNING! All changes made in this file will be lost!
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2022-present,  Teamgram Authors.
+ *  All rights reserved.
+ *
+ * Author: teagramio (teagram.io@gmail.com)
+ */
+
+// ConstructorList
+// RequestList
+
+package secretchat
+
+import (
+	"fmt"
+
+	"github.com/teamgram/proto/mtproto"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/types"
+)
+
+//////////////////////////////////////////////////////////////////////////////////////////
+
+var _ *types.Int32Value
+var _ *mtproto.Bool
+var _ fmt.GoStringer
+
+var clazzIdRegisters2 = map[int32]func() mtproto.TLObject{
+	// Constructor
+	-210504031: func() mtproto.TLObject { // 0xf373f6a1
+		o := MakeTLSecretChat(nil)
+		o.Data2.Constructor = -210504031
+		return o
+	},
+
+	// Method
+	-1706123918: func() mtproto.TLObject { // 0x9a4e9d72
+		return &TLSecretchatRequestEncryption{
+			Constructor: -1706123918,
+		}
+	},
+	1539508730: func() mtproto.TLObject { // 0x5bc309fa
+		return &TLSecretchatAcceptEncryption{
+			Constructor: 1539508730,
+		}
+	},
+	-474687651: func() mtproto.TLObject { // 0xe3b4d75d
+		return &TLSecretchatDiscardEncryption{
+			Constructor: -474687651,
+		}
+	},
+	-440911579: func() mtproto.TLObject { // 0xe5b83925
+		return &TLSecretchatGetSecretChat{
+			Constructor: -440911579,
+		}
+	},
+	852875594: func() mtproto.TLObject { // 0x32d5d94a
+		return &TLSecretchatPushEncryptedMessage{
+			Constructor: 852875594,
+		}
+	},
+	-466385968: func() mtproto.TLObject { // 0xe43383d0
+		return &TLSecretchatReceivedQueue{
+			Constructor: -466385968,
+		}
+	},
+}
+
+func NewTLObjectByClassID(classId int32) mtproto.TLObject {
+	f, ok := clazzIdRegisters2[classId]
+	if !ok {
+		return nil
+	}
+	return f()
+}
+
+func CheckClassID(classId int32) (ok bool) {
+	_, ok = clazzIdRegisters2[classId]
+	return
+}
+
+//----------------------------------------------------------------------------------------------------------------
+
+///////////////////////////////////////////////////////////////////////////////
+// SecretChat <--
+//  + TL_SecretChat
+//
+
+func (m *SecretChat) Encode(layer int32) []byte {
+	predicateName := m.PredicateName
+	if predicateName == "" {
+		if n, ok := clazzIdNameRegisters2[int32(m.Constructor)]; ok {
+			predicateName = n
+		}
+	}
+
+	var (
+		xBuf []byte
+	)
+
+	switch predicateName {
+	case Predicate_secretChat:
+		t := m.To_SecretChat()
+		xBuf = t.Encode(layer)
+
+	default:
+		// logx.Errorf("invalid predicate error: %s",  m.PredicateName)
+		return []byte{}
+	}
+
+	return xBuf
+}
+
+func (m *SecretChat) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *SecretChat) Decode(dBuf *mtproto.DecodeBuf) error {
+	m.Constructor = TLConstructor(dBuf.Int())
+	switch uint32(m.Constructor) {
+	case 0xf373f6a1:
+		m2 := MakeTLSecretChat(m)
+		m2.Decode(dBuf)
+
+	default:
+		return fmt.Errorf("invalid constructorId: 0x%x", uint32(m.Constructor))
+	}
+	return dBuf.GetError()
+}
+
+func (m *SecretChat) DebugString() string {
+	switch m.PredicateName {
+	case Predicate_secretChat:
+		t := m.To_SecretChat()
+		return t.DebugString()
+
+	default:
+		return "{}"
+	}
+}
+
+// To_SecretChat
+// secretChat flags:# id:int access_hash:long date:int admin_id:long admin_auth_key_id:long participant_id:long participant_auth_key_id:long g_a:bytes g_b:bytes key_fingerprint:long state:int history_deleted:flags.0?true = SecretChat;
+func (m *SecretChat) To_SecretChat() *TLSecretChat {
+	m.PredicateName = Predicate_secretChat
+	return &TLSecretChat{
+		Data2: m,
+	}
+}
+
+// MakeTLSecretChat
+// secretChat flags:# id:int access_hash:long date:int admin_id:long admin_auth_key_id:long participant_id:long participant_auth_key_id:long g_a:bytes g_b:bytes key_fingerprint:long state:int history_deleted:flags.0?true = SecretChat;
+func MakeTLSecretChat(data2 *SecretChat) *TLSecretChat {
+	if data2 == nil {
+		return &TLSecretChat{Data2: &SecretChat{
+			PredicateName: Predicate_secretChat,
+		}}
+	} else {
+		data2.PredicateName = Predicate_secretChat
+		return &TLSecretChat{Data2: data2}
+	}
+}
+
+func (m *TLSecretChat) To_SecretChat() *SecretChat {
+	m.Data2.PredicateName = Predicate_secretChat
+	return m.Data2
+}
+
+//// flags
+func (m *TLSecretChat) SetId(v int32) { m.Data2.Id = v }
+func (m *TLSecretChat) GetId() int32  { return m.Data2.Id }
+
+func (m *TLSecretChat) SetAccessHash(v int64) { m.Data2.AccessHash = v }
+func (m *TLSecretChat) GetAccessHash() int64  { return m.Data2.AccessHash }
+
+func (m *TLSecretChat) SetDate(v int32) { m.Data2.Date = v }
+func (m *TLSecretChat) GetDate() int32  { return m.Data2.Date }
+
+func (m *TLSecretChat) SetAdminId(v int64) { m.Data2.AdminId = v }
+func (m *TLSecretChat) GetAdminId() int64  { return m.Data2.AdminId }
+
+func (m *TLSecretChat) SetAdminAuthKeyId(v int64) { m.Data2.AdminAuthKeyId = v }
+func (m *TLSecretChat) GetAdminAuthKeyId() int64  { return m.Data2.AdminAuthKeyId }
+
+func (m *TLSecretChat) SetParticipantId(v int64) { m.Data2.ParticipantId = v }
+func (m *TLSecretChat) GetParticipantId() int64  { return m.Data2.ParticipantId }
+
+func (m *TLSecretChat) SetParticipantAuthKeyId(v int64) { m.Data2.ParticipantAuthKeyId = v }
+func (m *TLSecretChat) GetParticipantAuthKeyId() int64  { return m.Data2.ParticipantAuthKeyId }
+
+func (m *TLSecretChat) SetGA(v []byte) { m.Data2.GA = v }
+func (m *TLSecretChat) GetGA() []byte  { return m.Data2.GA }
+
+func (m *TLSecretChat) SetGB(v []byte) { m.Data2.GB = v }
+func (m *TLSecretChat) GetGB() []byte  { return m.Data2.GB }
+
+func (m *TLSecretChat) SetKeyFingerprint(v int64) { m.Data2.KeyFingerprint = v }
+func (m *TLSecretChat) GetKeyFingerprint() int64  { return m.Data2.KeyFingerprint }
+
+func (m *TLSecretChat) SetState(v int32) { m.Data2.State = v }
+func (m *TLSecretChat) GetState() int32  { return m.Data2.State }
+
+func (m *TLSecretChat) SetHistoryDeleted(v bool) { m.Data2.HistoryDeleted = v }
+func (m *TLSecretChat) GetHistoryDeleted() bool  { return m.Data2.HistoryDeleted }
+
+func (m *TLSecretChat) GetPredicateName() string {
+	return Predicate_secretChat
+}
+
+func (m *TLSecretChat) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+
+	var encodeF = map[uint32]func() []byte{
+		0xf373f6a1: func() []byte {
+			// secretChat flags:# id:int access_hash:long date:int admin_id:long admin_auth_key_id:long participant_id:long participant_auth_key_id:long g_a:bytes g_b:bytes key_fingerprint:long state:int history_deleted:flags.0?true = SecretChat;
+			x.UInt(0xf373f6a1)
+
+			// set flags
+			var getFlags = func() uint32 {
+				var flags uint32 = 0
+
+				if m.GetHistoryDeleted() == true {
+					flags |= 1 << 0
+				}
+
+				return flags
+			}
+
+			// set flags
+			var flags = getFlags()
+			x.UInt(flags)
+			x.Int(m.GetId())
+			x.Long(m.GetAccessHash())
+			x.Int(m.GetDate())
+			x.Long(m.GetAdminId())
+			x.Long(m.GetAdminAuthKeyId())
+			x.Long(m.GetParticipantId())
+			x.Long(m.GetParticipantAuthKeyId())
+			x.StringBytes(m.GetGA())
+			x.StringBytes(m.GetGB())
+			x.Long(m.GetKeyFingerprint())
+			x.Int(m.GetState())
+			return x.GetBuf()
+		},
+	}
+
+	clazzId := GetClazzID(Predicate_secretChat, int(layer))
+	if f, ok := encodeF[uint32(clazzId)]; ok {
+		return f()
+	} else {
+		// TODO(@benqi): handle error
+		// log.Errorf("not found clazzId by (%s, %d)", Predicate_secretChat, layer)
+		return x.GetBuf()
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLSecretChat) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLSecretChat) Decode(dBuf *mtproto.DecodeBuf) error {
+	var decodeF = map[uint32]func() error{
+		0xf373f6a1: func() error {
+			// secretChat flags:# id:int access_hash:long date:int admin_id:long admin_auth_key_id:long participant_id:long participant_auth_key_id:long g_a:bytes g_b:bytes key_fingerprint:long state:int history_deleted:flags.0?true = SecretChat;
+			var flags = dBuf.UInt()
+			_ = flags
+			m.SetId(dBuf.Int())
+			m.SetAccessHash(dBuf.Long())
+			m.SetDate(dBuf.Int())
+			m.SetAdminId(dBuf.Long())
+			m.SetAdminAuthKeyId(dBuf.Long())
+			m.SetParticipantId(dBuf.Long())
+			m.SetParticipantAuthKeyId(dBuf.Long())
+			m.SetGA(dBuf.StringBytes())
+			m.SetGB(dBuf.StringBytes())
+			m.SetKeyFingerprint(dBuf.Long())
+			m.SetState(dBuf.Int())
+			if (flags & (1 << 0)) != 0 {
+				m.SetHistoryDeleted(true)
+			}
+			return dBuf.GetError()
+		},
+	}
+
+	if f, ok := decodeF[uint32(m.Data2.Constructor)]; ok {
+		return f()
+	} else {
+		return fmt.Errorf("invalid constructor: %x", uint32(m.Data2.Constructor))
+	}
+}
+
+func (m *TLSecretChat) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+//----------------------------------------------------------------------------------------------------------------
+// TLSecretchatRequestEncryption
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLSecretchatRequestEncryption) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_secretchat_requestEncryption))
+
+	switch uint32(m.Constructor) {
+	case 0x9a4e9d72:
+		// secretchat.requestEncryption user_id:long auth_key_id:long participant_id:long random_id:int g_a:bytes = SecretChat;
+		x.UInt(0x9a4e9d72)
+
+		// no flags
+
+		x.Long(m.GetUserId())
+		x.Long(m.GetAuthKeyId())
+		x.Long(m.GetParticipantId())
+		x.Int(m.GetRandomId())
+		x.StringBytes(m.GetGA())
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLSecretchatRequestEncryption) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLSecretchatRequestEncryption) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0x9a4e9d72:
+		// secretchat.requestEncryption user_id:long auth_key_id:long participant_id:long random_id:int g_a:bytes = SecretChat;
+
+		// not has flags
+
+		m.UserId = dBuf.Long()
+		m.AuthKeyId = dBuf.Long()
+		m.ParticipantId = dBuf.Long()
+		m.RandomId = dBuf.Int()
+		m.GA = dBuf.StringBytes()
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLSecretchatRequestEncryption) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLSecretchatAcceptEncryption
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLSecretchatAcceptEncryption) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_secretchat_acceptEncryption))
+
+	switch uint32(m.Constructor) {
+	case 0x5bc309fa:
+		// secretchat.acceptEncryption user_id:long auth_key_id:long chat_id:int g_b:bytes key_fingerprint:long = SecretChat;
+		x.UInt(0x5bc309fa)
+
+		// no flags
+
+		x.Long(m.GetUserId())
+		x.Long(m.GetAuthKeyId())
+		x.Int(m.GetChatId())
+		x.StringBytes(m.GetGB())
+		x.Long(m.GetKeyFingerprint())
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLSecretchatAcceptEncryption) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLSecretchatAcceptEncryption) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0x5bc309fa:
+		// secretchat.acceptEncryption user_id:long auth_key_id:long chat_id:int g_b:bytes key_fingerprint:long = SecretChat;
+
+		// not has flags
+
+		m.UserId = dBuf.Long()
+		m.AuthKeyId = dBuf.Long()
+		m.ChatId = dBuf.Int()
+		m.GB = dBuf.StringBytes()
+		m.KeyFingerprint = dBuf.Long()
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLSecretchatAcceptEncryption) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLSecretchatDiscardEncryption
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLSecretchatDiscardEncryption) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_secretchat_discardEncryption))
+
+	switch uint32(m.Constructor) {
+	case 0xe3b4d75d:
+		// secretchat.discardEncryption flags:# user_id:long chat_id:int delete_history:flags.0?true = SecretChat;
+		x.UInt(0xe3b4d75d)
+
+		// set flags
+		var flags uint32 = 0
+
+		if m.GetDeleteHistory() == true {
+			flags |= 1 << 0
+		}
+
+		x.UInt(flags)
+
+		// flags Debug by @benqi
+		x.Long(m.GetUserId())
+		x.Int(m.GetChatId())
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLSecretchatDiscardEncryption) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLSecretchatDiscardEncryption) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0xe3b4d75d:
+		// secretchat.discardEncryption flags:# user_id:long chat_id:int delete_history:flags.0?true = SecretChat;
+
+		flags := dBuf.UInt()
+		_ = flags
+
+		// flags Debug by @benqi
+		m.UserId = dBuf.Long()
+		m.ChatId = dBuf.Int()
+		if (flags & (1 << 0)) != 0 {
+			m.DeleteHistory = true
+		}
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLSecretchatDiscardEncryption) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLSecretchatGetSecretChat
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLSecretchatGetSecretChat) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_secretchat_getSecretChat))
+
+	switch uint32(m.Constructor) {
+	case 0xe5b83925:
+		// secretchat.getSecretChat user_id:long chat_id:int = SecretChat;
+		x.UInt(0xe5b83925)
+
+		// no flags
+
+		x.Long(m.GetUserId())
+		x.Int(m.GetChatId())
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLSecretchatGetSecretChat) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLSecretchatGetSecretChat) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0xe5b83925:
+		// secretchat.getSecretChat user_id:long chat_id:int = SecretChat;
+
+		// not has flags
+
+		m.UserId = dBuf.Long()
+		m.ChatId = dBuf.Int()
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLSecretchatGetSecretChat) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLSecretchatPushEncryptedMessage
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLSecretchatPushEncryptedMessage) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_secretchat_pushEncryptedMessage))
+
+	switch uint32(m.Constructor) {
+	case 0x32d5d94a:
+		// secretchat.pushEncryptedMessage user_id:long auth_key_id:long message:EncryptedMessage = Update;
+		x.UInt(0x32d5d94a)
+
+		// no flags
+
+		x.Long(m.GetUserId())
+		x.Long(m.GetAuthKeyId())
+		x.Bytes(m.GetMessage().Encode(layer))
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLSecretchatPushEncryptedMessage) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLSecretchatPushEncryptedMessage) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0x32d5d94a:
+		// secretchat.pushEncryptedMessage user_id:long auth_key_id:long message:EncryptedMessage = Update;
+
+		// not has flags
+
+		m.UserId = dBuf.Long()
+		m.AuthKeyId = dBuf.Long()
+
+		m3 := &mtproto.EncryptedMessage{}
+		m3.Decode(dBuf)
+		m.Message = m3
+
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLSecretchatPushEncryptedMessage) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLSecretchatReceivedQueue
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLSecretchatReceivedQueue) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_secretchat_receivedQueue))
+
+	switch uint32(m.Constructor) {
+	case 0xe43383d0:
+		// secretchat.receivedQueue auth_key_id:long max_qts:int = Vector<long>;
+		x.UInt(0xe43383d0)
+
+		// no flags
+
+		x.Long(m.GetAuthKeyId())
+		x.Int(m.GetMaxQts())
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLSecretchatReceivedQueue) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLSecretchatReceivedQueue) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0xe43383d0:
+		// secretchat.receivedQueue auth_key_id:long max_qts:int = Vector<long>;
+
+		// not has flags
+
+		m.AuthKeyId = dBuf.Long()
+		m.MaxQts = dBuf.Int()
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLSecretchatReceivedQueue) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+//----------------------------------------------------------------------------------------------------------------
+// Vector_Long
+///////////////////////////////////////////////////////////////////////////////
+func (m *Vector_Long) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	x.VectorLong(m.Datas)
+
+	return x.GetBuf()
+}
+
+func (m *Vector_Long) Decode(dBuf *mtproto.DecodeBuf) error {
+	m.Datas = dBuf.VectorLong()
+
+	return dBuf.GetError()
+}
+
+func (m *Vector_Long) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *Vector_Long) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
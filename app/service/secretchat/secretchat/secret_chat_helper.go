@@ -0,0 +1,87 @@
+// Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+//  All rights reserved.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package secretchat
+
+import (
+	"github.com/teamgram/proto/mtproto"
+)
+
+const (
+	EncryptedChatStateRequested = 0
+	EncryptedChatStateAccepted  = 1
+	EncryptedChatStateDiscarded = 2
+)
+
+func (m *SecretChat) IsParticipant(userId int64) bool {
+	return m.GetAdminId() == userId || m.GetParticipantId() == userId
+}
+
+// PeerId the other side of the secret chat
+func (m *SecretChat) PeerId(selfId int64) int64 {
+	if m.GetAdminId() == selfId {
+		return m.GetParticipantId()
+	} else {
+		return m.GetAdminId()
+	}
+}
+
+// AuthKeyId the device the secret chat of selfId is bound to, 0 if the chat is not accepted yet
+func (m *SecretChat) AuthKeyId(selfId int64) int64 {
+	if m.GetAdminId() == selfId {
+		return m.GetAdminAuthKeyId()
+	} else {
+		return m.GetParticipantAuthKeyId()
+	}
+}
+
+func (m *SecretChat) PeerAuthKeyId(selfId int64) int64 {
+	return m.AuthKeyId(m.PeerId(selfId))
+}
+
+// ToEncryptedChat the secret chat seen by selfId
+func (m *SecretChat) ToEncryptedChat(selfId int64) *mtproto.EncryptedChat {
+	switch m.GetState() {
+	case EncryptedChatStateRequested:
+		if m.GetAdminId() == selfId {
+			return mtproto.MakeTLEncryptedChatWaiting(&mtproto.EncryptedChat{
+				Id:            m.GetId(),
+				AccessHash:    m.GetAccessHash(),
+				Date:          m.GetDate(),
+				AdminId:       m.GetAdminId(),
+				ParticipantId: m.GetParticipantId(),
+			}).To_EncryptedChat()
+		} else {
+			return mtproto.MakeTLEncryptedChatRequested(&mtproto.EncryptedChat{
+				Id:            m.GetId(),
+				AccessHash:    m.GetAccessHash(),
+				Date:          m.GetDate(),
+				AdminId:       m.GetAdminId(),
+				ParticipantId: m.GetParticipantId(),
+				GA:            m.GetGA(),
+			}).To_EncryptedChat()
+		}
+	case EncryptedChatStateAccepted:
+		chat := mtproto.MakeTLEncryptedChat(&mtproto.EncryptedChat{
+			Id:             m.GetId(),
+			AccessHash:     m.GetAccessHash(),
+			Date:           m.GetDate(),
+			AdminId:        m.GetAdminId(),
+			ParticipantId:  m.GetParticipantId(),
+			GAOrB:          m.GetGA(),
+			KeyFingerprint: m.GetKeyFingerprint(),
+		}).To_EncryptedChat()
+		if m.GetAdminId() == selfId {
+			chat.GAOrB = m.GetGB()
+		}
+		return chat
+	default:
+		return mtproto.MakeTLEncryptedChatDiscarded(&mtproto.EncryptedChat{
+			HistoryDeleted: m.GetHistoryDeleted(),
+			Id:             m.GetId(),
+		}).To_EncryptedChat()
+	}
+}
@@ -0,0 +1,53 @@
+// Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+//  All rights reserved.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package secretchat
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/teamgram/proto/mtproto"
+)
+
+func TestSecretChatToEncryptedChat(t *testing.T) {
+	chat := MakeTLSecretChat(&SecretChat{
+		Id:                   1,
+		AccessHash:           2,
+		AdminId:              100,
+		AdminAuthKeyId:       1000,
+		ParticipantId:        200,
+		ParticipantAuthKeyId: 0,
+		GA:                   []byte{1},
+		GB:                   []byte{2},
+		State:                EncryptedChatStateRequested,
+	}).To_SecretChat()
+
+	if c := chat.ToEncryptedChat(100); c.PredicateName != mtproto.Predicate_encryptedChatWaiting {
+		t.Errorf("admin: %v", c)
+	}
+	if c := chat.ToEncryptedChat(200); c.PredicateName != mtproto.Predicate_encryptedChatRequested || !bytes.Equal(c.GA, []byte{1}) {
+		t.Errorf("participant: %v", c)
+	}
+	if chat.PeerId(200) != 100 || chat.PeerAuthKeyId(200) != 1000 || chat.PeerAuthKeyId(100) != 0 {
+		t.Errorf("peer: %v", chat)
+	}
+
+	chat.State = EncryptedChatStateAccepted
+	chat.ParticipantAuthKeyId = 2000
+	if c := chat.ToEncryptedChat(100); c.PredicateName != mtproto.Predicate_encryptedChat || !bytes.Equal(c.GAOrB, []byte{2}) {
+		t.Errorf("admin: %v", c)
+	}
+	if c := chat.ToEncryptedChat(200); c.PredicateName != mtproto.Predicate_encryptedChat || !bytes.Equal(c.GAOrB, []byte{1}) {
+		t.Errorf("participant: %v", c)
+	}
+
+	chat.State = EncryptedChatStateDiscarded
+	chat.HistoryDeleted = true
+	if c := chat.ToEncryptedChat(200); c.PredicateName != mtproto.Predicate_encryptedChatDiscarded || !c.HistoryDeleted {
+		t.Errorf("discarded: %v", c)
+	}
+}
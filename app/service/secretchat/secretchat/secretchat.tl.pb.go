@@ -0,0 +1,3572 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: secretchat.tl.proto
+
+package secretchat
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/types"
+	mtproto "github.com/teamgram/proto/mtproto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	reflect "reflect"
+	strings "strings"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type TLConstructor int32
+
+const (
+	CRC32_UNKNOWN                         TLConstructor = 0
+	CRC32_secretChat                      TLConstructor = -210504031
+	CRC32_secretchat_requestEncryption    TLConstructor = -1706123918
+	CRC32_secretchat_acceptEncryption     TLConstructor = 1539508730
+	CRC32_secretchat_discardEncryption    TLConstructor = -474687651
+	CRC32_secretchat_getSecretChat        TLConstructor = -440911579
+	CRC32_secretchat_pushEncryptedMessage TLConstructor = 852875594
+	CRC32_secretchat_receivedQueue        TLConstructor = -466385968
+)
+
+var TLConstructor_name = map[int32]string{
+	0:           "CRC32_UNKNOWN",
+	-210504031:  "CRC32_secretChat",
+	-1706123918: "CRC32_secretchat_requestEncryption",
+	1539508730:  "CRC32_secretchat_acceptEncryption",
+	-474687651:  "CRC32_secretchat_discardEncryption",
+	-440911579:  "CRC32_secretchat_getSecretChat",
+	852875594:   "CRC32_secretchat_pushEncryptedMessage",
+	-466385968:  "CRC32_secretchat_receivedQueue",
+}
+
+var TLConstructor_value = map[string]int32{
+	"CRC32_UNKNOWN":                         0,
+	"CRC32_secretChat":                      -210504031,
+	"CRC32_secretchat_requestEncryption":    -1706123918,
+	"CRC32_secretchat_acceptEncryption":     1539508730,
+	"CRC32_secretchat_discardEncryption":    -474687651,
+	"CRC32_secretchat_getSecretChat":        -440911579,
+	"CRC32_secretchat_pushEncryptedMessage": 852875594,
+	"CRC32_secretchat_receivedQueue":        -466385968,
+}
+
+func (x TLConstructor) String() string {
+	return proto.EnumName(TLConstructor_name, int32(x))
+}
+
+func (TLConstructor) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_7a7ba6dea2cc539a, []int{0}
+}
+
+//--------------------------------------------------------------------------------------------
+// secretChat flags:# id:int access_hash:long date:int admin_id:long admin_auth_key_id:long participant_id:long participant_auth_key_id:long g_a:bytes g_b:bytes key_fingerprint:long state:int history_deleted:flags.0?true = SecretChat;
+//
+// SecretChat <--
+//  + TL_secretChat
+//
+type SecretChat struct {
+	PredicateName        string        `protobuf:"bytes,1,opt,name=predicate_name,json=predicateName,proto3" json:"predicate_name,omitempty"`
+	Constructor          TLConstructor `protobuf:"varint,2,opt,name=constructor,proto3,enum=secretchat.TLConstructor" json:"constructor,omitempty"`
+	Id                   int32         `protobuf:"varint,3,opt,name=id,proto3" json:"id,omitempty"`
+	AccessHash           int64         `protobuf:"varint,4,opt,name=access_hash,json=accessHash,proto3" json:"access_hash,omitempty"`
+	Date                 int32         `protobuf:"varint,5,opt,name=date,proto3" json:"date,omitempty"`
+	AdminId              int64         `protobuf:"varint,6,opt,name=admin_id,json=adminId,proto3" json:"admin_id,omitempty"`
+	AdminAuthKeyId       int64         `protobuf:"varint,7,opt,name=admin_auth_key_id,json=adminAuthKeyId,proto3" json:"admin_auth_key_id,omitempty"`
+	ParticipantId        int64         `protobuf:"varint,8,opt,name=participant_id,json=participantId,proto3" json:"participant_id,omitempty"`
+	ParticipantAuthKeyId int64         `protobuf:"varint,9,opt,name=participant_auth_key_id,json=participantAuthKeyId,proto3" json:"participant_auth_key_id,omitempty"`
+	GA                   []byte        `protobuf:"bytes,10,opt,name=g_a,json=gA,proto3" json:"g_a,omitempty"`
+	GB                   []byte        `protobuf:"bytes,11,opt,name=g_b,json=gB,proto3" json:"g_b,omitempty"`
+	KeyFingerprint       int64         `protobuf:"varint,12,opt,name=key_fingerprint,json=keyFingerprint,proto3" json:"key_fingerprint,omitempty"`
+	State                int32         `protobuf:"varint,13,opt,name=state,proto3" json:"state,omitempty"`
+	HistoryDeleted       bool          `protobuf:"varint,14,opt,name=history_deleted,json=historyDeleted,proto3" json:"history_deleted,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *SecretChat) Reset()         { *m = SecretChat{} }
+func (m *SecretChat) String() string { return proto.CompactTextString(m) }
+func (*SecretChat) ProtoMessage()    {}
+func (*SecretChat) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a7ba6dea2cc539a, []int{0}
+}
+func (m *SecretChat) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SecretChat) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SecretChat.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SecretChat) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SecretChat.Merge(m, src)
+}
+func (m *SecretChat) XXX_Size() int {
+	return m.Size()
+}
+func (m *SecretChat) XXX_DiscardUnknown() {
+	xxx_messageInfo_SecretChat.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SecretChat proto.InternalMessageInfo
+
+func (m *SecretChat) GetPredicateName() string {
+	if m != nil {
+		return m.PredicateName
+	}
+	return ""
+}
+
+func (m *SecretChat) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
+	}
+	return CRC32_UNKNOWN
+}
+
+func (m *SecretChat) GetId() int32 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *SecretChat) GetAccessHash() int64 {
+	if m != nil {
+		return m.AccessHash
+	}
+	return 0
+}
+
+func (m *SecretChat) GetDate() int32 {
+	if m != nil {
+		return m.Date
+	}
+	return 0
+}
+
+func (m *SecretChat) GetAdminId() int64 {
+	if m != nil {
+		return m.AdminId
+	}
+	return 0
+}
+
+func (m *SecretChat) GetAdminAuthKeyId() int64 {
+	if m != nil {
+		return m.AdminAuthKeyId
+	}
+	return 0
+}
+
+func (m *SecretChat) GetParticipantId() int64 {
+	if m != nil {
+		return m.ParticipantId
+	}
+	return 0
+}
+
+func (m *SecretChat) GetParticipantAuthKeyId() int64 {
+	if m != nil {
+		return m.ParticipantAuthKeyId
+	}
+	return 0
+}
+
+func (m *SecretChat) GetGA() []byte {
+	if m != nil {
+		return m.GA
+	}
+	return nil
+}
+
+func (m *SecretChat) GetGB() []byte {
+	if m != nil {
+		return m.GB
+	}
+	return nil
+}
+
+func (m *SecretChat) GetKeyFingerprint() int64 {
+	if m != nil {
+		return m.KeyFingerprint
+	}
+	return 0
+}
+
+func (m *SecretChat) GetState() int32 {
+	if m != nil {
+		return m.State
+	}
+	return 0
+}
+
+func (m *SecretChat) GetHistoryDeleted() bool {
+	if m != nil {
+		return m.HistoryDeleted
+	}
+	return false
+}
+
+// secretChat flags:# id:int access_hash:long date:int admin_id:long admin_auth_key_id:long participant_id:long participant_auth_key_id:long g_a:bytes g_b:bytes key_fingerprint:long state:int history_deleted:flags.0?true = SecretChat;
+type TLSecretChat struct {
+	Data2                *SecretChat `protobuf:"bytes,1,opt,name=data2,proto3" json:"data2,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *TLSecretChat) Reset()         { *m = TLSecretChat{} }
+func (m *TLSecretChat) String() string { return proto.CompactTextString(m) }
+func (*TLSecretChat) ProtoMessage()    {}
+func (*TLSecretChat) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a7ba6dea2cc539a, []int{1}
+}
+func (m *TLSecretChat) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLSecretChat) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLSecretChat.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TLSecretChat) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLSecretChat.Merge(m, src)
+}
+func (m *TLSecretChat) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLSecretChat) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLSecretChat.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLSecretChat proto.InternalMessageInfo
+
+func (m *TLSecretChat) GetData2() *SecretChat {
+	if m != nil {
+		return m.Data2
+	}
+	return nil
+}
+
+//--------------------------------------------------------------------------------------------
+// secretchat.requestEncryption user_id:long auth_key_id:long participant_id:long random_id:int g_a:bytes = SecretChat;
+type TLSecretchatRequestEncryption struct {
+	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=secretchat.TLConstructor" json:"constructor,omitempty"`
+	UserId               int64         `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	AuthKeyId            int64         `protobuf:"varint,4,opt,name=auth_key_id,json=authKeyId,proto3" json:"auth_key_id,omitempty"`
+	ParticipantId        int64         `protobuf:"varint,5,opt,name=participant_id,json=participantId,proto3" json:"participant_id,omitempty"`
+	RandomId             int32         `protobuf:"varint,6,opt,name=random_id,json=randomId,proto3" json:"random_id,omitempty"`
+	GA                   []byte        `protobuf:"bytes,7,opt,name=g_a,json=gA,proto3" json:"g_a,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *TLSecretchatRequestEncryption) Reset()         { *m = TLSecretchatRequestEncryption{} }
+func (m *TLSecretchatRequestEncryption) String() string { return proto.CompactTextString(m) }
+func (*TLSecretchatRequestEncryption) ProtoMessage()    {}
+func (*TLSecretchatRequestEncryption) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a7ba6dea2cc539a, []int{2}
+}
+func (m *TLSecretchatRequestEncryption) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLSecretchatRequestEncryption) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLSecretchatRequestEncryption.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TLSecretchatRequestEncryption) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLSecretchatRequestEncryption.Merge(m, src)
+}
+func (m *TLSecretchatRequestEncryption) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLSecretchatRequestEncryption) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLSecretchatRequestEncryption.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLSecretchatRequestEncryption proto.InternalMessageInfo
+
+func (m *TLSecretchatRequestEncryption) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
+	}
+	return CRC32_UNKNOWN
+}
+
+func (m *TLSecretchatRequestEncryption) GetUserId() int64 {
+	if m != nil {
+		return m.UserId
+	}
+	return 0
+}
+
+func (m *TLSecretchatRequestEncryption) GetAuthKeyId() int64 {
+	if m != nil {
+		return m.AuthKeyId
+	}
+	return 0
+}
+
+func (m *TLSecretchatRequestEncryption) GetParticipantId() int64 {
+	if m != nil {
+		return m.ParticipantId
+	}
+	return 0
+}
+
+func (m *TLSecretchatRequestEncryption) GetRandomId() int32 {
+	if m != nil {
+		return m.RandomId
+	}
+	return 0
+}
+
+func (m *TLSecretchatRequestEncryption) GetGA() []byte {
+	if m != nil {
+		return m.GA
+	}
+	return nil
+}
+
+//--------------------------------------------------------------------------------------------
+// secretchat.acceptEncryption user_id:long auth_key_id:long chat_id:int g_b:bytes key_fingerprint:long = SecretChat;
+type TLSecretchatAcceptEncryption struct {
+	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=secretchat.TLConstructor" json:"constructor,omitempty"`
+	UserId               int64         `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	AuthKeyId            int64         `protobuf:"varint,4,opt,name=auth_key_id,json=authKeyId,proto3" json:"auth_key_id,omitempty"`
+	ChatId               int32         `protobuf:"varint,5,opt,name=chat_id,json=chatId,proto3" json:"chat_id,omitempty"`
+	GB                   []byte        `protobuf:"bytes,6,opt,name=g_b,json=gB,proto3" json:"g_b,omitempty"`
+	KeyFingerprint       int64         `protobuf:"varint,7,opt,name=key_fingerprint,json=keyFingerprint,proto3" json:"key_fingerprint,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *TLSecretchatAcceptEncryption) Reset()         { *m = TLSecretchatAcceptEncryption{} }
+func (m *TLSecretchatAcceptEncryption) String() string { return proto.CompactTextString(m) }
+func (*TLSecretchatAcceptEncryption) ProtoMessage()    {}
+func (*TLSecretchatAcceptEncryption) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a7ba6dea2cc539a, []int{3}
+}
+func (m *TLSecretchatAcceptEncryption) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLSecretchatAcceptEncryption) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLSecretchatAcceptEncryption.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TLSecretchatAcceptEncryption) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLSecretchatAcceptEncryption.Merge(m, src)
+}
+func (m *TLSecretchatAcceptEncryption) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLSecretchatAcceptEncryption) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLSecretchatAcceptEncryption.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLSecretchatAcceptEncryption proto.InternalMessageInfo
+
+func (m *TLSecretchatAcceptEncryption) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
+	}
+	return CRC32_UNKNOWN
+}
+
+func (m *TLSecretchatAcceptEncryption) GetUserId() int64 {
+	if m != nil {
+		return m.UserId
+	}
+	return 0
+}
+
+func (m *TLSecretchatAcceptEncryption) GetAuthKeyId() int64 {
+	if m != nil {
+		return m.AuthKeyId
+	}
+	return 0
+}
+
+func (m *TLSecretchatAcceptEncryption) GetChatId() int32 {
+	if m != nil {
+		return m.ChatId
+	}
+	return 0
+}
+
+func (m *TLSecretchatAcceptEncryption) GetGB() []byte {
+	if m != nil {
+		return m.GB
+	}
+	return nil
+}
+
+func (m *TLSecretchatAcceptEncryption) GetKeyFingerprint() int64 {
+	if m != nil {
+		return m.KeyFingerprint
+	}
+	return 0
+}
+
+//--------------------------------------------------------------------------------------------
+// secretchat.discardEncryption flags:# user_id:long chat_id:int delete_history:flags.0?true = SecretChat;
+type TLSecretchatDiscardEncryption struct {
+	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=secretchat.TLConstructor" json:"constructor,omitempty"`
+	UserId               int64         `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ChatId               int32         `protobuf:"varint,4,opt,name=chat_id,json=chatId,proto3" json:"chat_id,omitempty"`
+	DeleteHistory        bool          `protobuf:"varint,5,opt,name=delete_history,json=deleteHistory,proto3" json:"delete_history,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *TLSecretchatDiscardEncryption) Reset()         { *m = TLSecretchatDiscardEncryption{} }
+func (m *TLSecretchatDiscardEncryption) String() string { return proto.CompactTextString(m) }
+func (*TLSecretchatDiscardEncryption) ProtoMessage()    {}
+func (*TLSecretchatDiscardEncryption) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a7ba6dea2cc539a, []int{4}
+}
+func (m *TLSecretchatDiscardEncryption) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLSecretchatDiscardEncryption) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLSecretchatDiscardEncryption.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TLSecretchatDiscardEncryption) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLSecretchatDiscardEncryption.Merge(m, src)
+}
+func (m *TLSecretchatDiscardEncryption) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLSecretchatDiscardEncryption) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLSecretchatDiscardEncryption.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLSecretchatDiscardEncryption proto.InternalMessageInfo
+
+func (m *TLSecretchatDiscardEncryption) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
+	}
+	return CRC32_UNKNOWN
+}
+
+func (m *TLSecretchatDiscardEncryption) GetUserId() int64 {
+	if m != nil {
+		return m.UserId
+	}
+	return 0
+}
+
+func (m *TLSecretchatDiscardEncryption) GetChatId() int32 {
+	if m != nil {
+		return m.ChatId
+	}
+	return 0
+}
+
+func (m *TLSecretchatDiscardEncryption) GetDeleteHistory() bool {
+	if m != nil {
+		return m.DeleteHistory
+	}
+	return false
+}
+
+//--------------------------------------------------------------------------------------------
+// secretchat.getSecretChat user_id:long chat_id:int = SecretChat;
+type TLSecretchatGetSecretChat struct {
+	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=secretchat.TLConstructor" json:"constructor,omitempty"`
+	UserId               int64         `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ChatId               int32         `protobuf:"varint,4,opt,name=chat_id,json=chatId,proto3" json:"chat_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *TLSecretchatGetSecretChat) Reset()         { *m = TLSecretchatGetSecretChat{} }
+func (m *TLSecretchatGetSecretChat) String() string { return proto.CompactTextString(m) }
+func (*TLSecretchatGetSecretChat) ProtoMessage()    {}
+func (*TLSecretchatGetSecretChat) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a7ba6dea2cc539a, []int{5}
+}
+func (m *TLSecretchatGetSecretChat) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLSecretchatGetSecretChat) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLSecretchatGetSecretChat.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TLSecretchatGetSecretChat) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLSecretchatGetSecretChat.Merge(m, src)
+}
+func (m *TLSecretchatGetSecretChat) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLSecretchatGetSecretChat) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLSecretchatGetSecretChat.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLSecretchatGetSecretChat proto.InternalMessageInfo
+
+func (m *TLSecretchatGetSecretChat) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
+	}
+	return CRC32_UNKNOWN
+}
+
+func (m *TLSecretchatGetSecretChat) GetUserId() int64 {
+	if m != nil {
+		return m.UserId
+	}
+	return 0
+}
+
+func (m *TLSecretchatGetSecretChat) GetChatId() int32 {
+	if m != nil {
+		return m.ChatId
+	}
+	return 0
+}
+
+//--------------------------------------------------------------------------------------------
+// secretchat.pushEncryptedMessage user_id:long auth_key_id:long message:EncryptedMessage = Update;
+type TLSecretchatPushEncryptedMessage struct {
+	Constructor          TLConstructor             `protobuf:"varint,1,opt,name=constructor,proto3,enum=secretchat.TLConstructor" json:"constructor,omitempty"`
+	UserId               int64                     `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	AuthKeyId            int64                     `protobuf:"varint,4,opt,name=auth_key_id,json=authKeyId,proto3" json:"auth_key_id,omitempty"`
+	Message              *mtproto.EncryptedMessage `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
+}
+
+func (m *TLSecretchatPushEncryptedMessage) Reset()         { *m = TLSecretchatPushEncryptedMessage{} }
+func (m *TLSecretchatPushEncryptedMessage) String() string { return proto.CompactTextString(m) }
+func (*TLSecretchatPushEncryptedMessage) ProtoMessage()    {}
+func (*TLSecretchatPushEncryptedMessage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a7ba6dea2cc539a, []int{6}
+}
+func (m *TLSecretchatPushEncryptedMessage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLSecretchatPushEncryptedMessage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLSecretchatPushEncryptedMessage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TLSecretchatPushEncryptedMessage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLSecretchatPushEncryptedMessage.Merge(m, src)
+}
+func (m *TLSecretchatPushEncryptedMessage) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLSecretchatPushEncryptedMessage) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLSecretchatPushEncryptedMessage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLSecretchatPushEncryptedMessage proto.InternalMessageInfo
+
+func (m *TLSecretchatPushEncryptedMessage) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
+	}
+	return CRC32_UNKNOWN
+}
+
+func (m *TLSecretchatPushEncryptedMessage) GetUserId() int64 {
+	if m != nil {
+		return m.UserId
+	}
+	return 0
+}
+
+func (m *TLSecretchatPushEncryptedMessage) GetAuthKeyId() int64 {
+	if m != nil {
+		return m.AuthKeyId
+	}
+	return 0
+}
+
+func (m *TLSecretchatPushEncryptedMessage) GetMessage() *mtproto.EncryptedMessage {
+	if m != nil {
+		return m.Message
+	}
+	return nil
+}
+
+//--------------------------------------------------------------------------------------------
+// secretchat.receivedQueue auth_key_id:long max_qts:int = Vector<long>;
+type TLSecretchatReceivedQueue struct {
+	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=secretchat.TLConstructor" json:"constructor,omitempty"`
+	AuthKeyId            int64         `protobuf:"varint,3,opt,name=auth_key_id,json=authKeyId,proto3" json:"auth_key_id,omitempty"`
+	MaxQts               int32         `protobuf:"varint,4,opt,name=max_qts,json=maxQts,proto3" json:"max_qts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *TLSecretchatReceivedQueue) Reset()         { *m = TLSecretchatReceivedQueue{} }
+func (m *TLSecretchatReceivedQueue) String() string { return proto.CompactTextString(m) }
+func (*TLSecretchatReceivedQueue) ProtoMessage()    {}
+func (*TLSecretchatReceivedQueue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a7ba6dea2cc539a, []int{7}
+}
+func (m *TLSecretchatReceivedQueue) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLSecretchatReceivedQueue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLSecretchatReceivedQueue.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TLSecretchatReceivedQueue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLSecretchatReceivedQueue.Merge(m, src)
+}
+func (m *TLSecretchatReceivedQueue) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLSecretchatReceivedQueue) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLSecretchatReceivedQueue.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLSecretchatReceivedQueue proto.InternalMessageInfo
+
+func (m *TLSecretchatReceivedQueue) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
+	}
+	return CRC32_UNKNOWN
+}
+
+func (m *TLSecretchatReceivedQueue) GetAuthKeyId() int64 {
+	if m != nil {
+		return m.AuthKeyId
+	}
+	return 0
+}
+
+func (m *TLSecretchatReceivedQueue) GetMaxQts() int32 {
+	if m != nil {
+		return m.MaxQts
+	}
+	return 0
+}
+
+type Vector_Long struct {
+	Datas                []int64  `protobuf:"varint,1,rep,packed,name=datas,proto3" json:"datas,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Vector_Long) Reset()         { *m = Vector_Long{} }
+func (m *Vector_Long) String() string { return proto.CompactTextString(m) }
+func (*Vector_Long) ProtoMessage()    {}
+func (*Vector_Long) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a7ba6dea2cc539a, []int{8}
+}
+func (m *Vector_Long) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Vector_Long) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Vector_Long.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Vector_Long) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Vector_Long.Merge(m, src)
+}
+func (m *Vector_Long) XXX_Size() int {
+	return m.Size()
+}
+func (m *Vector_Long) XXX_DiscardUnknown() {
+	xxx_messageInfo_Vector_Long.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Vector_Long proto.InternalMessageInfo
+
+func (m *Vector_Long) GetDatas() []int64 {
+	if m != nil {
+		return m.Datas
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("secretchat.TLConstructor", TLConstructor_name, TLConstructor_value)
+	proto.RegisterType((*SecretChat)(nil), "secretchat.SecretChat")
+	proto.RegisterType((*TLSecretChat)(nil), "secretchat.TL_secretChat")
+	proto.RegisterType((*TLSecretchatRequestEncryption)(nil), "secretchat.TL_secretchat_requestEncryption")
+	proto.RegisterType((*TLSecretchatAcceptEncryption)(nil), "secretchat.TL_secretchat_acceptEncryption")
+	proto.RegisterType((*TLSecretchatDiscardEncryption)(nil), "secretchat.TL_secretchat_discardEncryption")
+	proto.RegisterType((*TLSecretchatGetSecretChat)(nil), "secretchat.TL_secretchat_getSecretChat")
+	proto.RegisterType((*TLSecretchatPushEncryptedMessage)(nil), "secretchat.TL_secretchat_pushEncryptedMessage")
+	proto.RegisterType((*TLSecretchatReceivedQueue)(nil), "secretchat.TL_secretchat_receivedQueue")
+	proto.RegisterType((*Vector_Long)(nil), "secretchat.Vector_Long")
+}
+
+func init() { proto.RegisterFile("secretchat.tl.proto", fileDescriptor_7a7ba6dea2cc539a) }
+
+var fileDescriptor_7a7ba6dea2cc539a = []byte{
+	// 1041 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0xcf, 0x6f, 0x1b, 0xc5,
+	0x17, 0xcf, 0xc6, 0x71, 0x9c, 0x3c, 0xd7, 0x8e, 0xbb, 0xdf, 0xe8, 0x9b, 0x8d, 0x03, 0x8e, 0x59,
+	0x14, 0xd5, 0x6d, 0x89, 0x2d, 0x39, 0xe2, 0x84, 0x38, 0xb4, 0xa6, 0xa8, 0x51, 0x43, 0xa0, 0xdb,
+	0x96, 0x4a, 0x48, 0xb0, 0x1a, 0xef, 0x4c, 0x77, 0x57, 0xcd, 0xfe, 0xe8, 0xcc, 0x6c, 0xa9, 0xff,
+	0x02, 0x38, 0xf4, 0x56, 0x89, 0x0b, 0xe2, 0x50, 0x21, 0x8e, 0x70, 0xe0, 0x82, 0xd4, 0xbf, 0x00,
+	0x71, 0xa0, 0x15, 0x1c, 0x38, 0x15, 0x95, 0x70, 0x40, 0x48, 0x20, 0xa1, 0xde, 0xe0, 0x00, 0x68,
+	0x67, 0xd6, 0xd9, 0x5d, 0xff, 0x42, 0x08, 0x41, 0x73, 0x9a, 0x79, 0xef, 0xf3, 0xde, 0xbc, 0xcf,
+	0x7b, 0x1f, 0xbf, 0x0d, 0xfc, 0x8f, 0x11, 0x8b, 0x12, 0x6e, 0x39, 0x88, 0xb7, 0xf9, 0x41, 0x3b,
+	0xa4, 0x01, 0x0f, 0x54, 0x48, 0x8d, 0xf5, 0x6d, 0xdb, 0xe5, 0x4e, 0xd4, 0x6f, 0x5b, 0x81, 0xd7,
+	0xb1, 0x03, 0x3b, 0xe8, 0x08, 0x48, 0x3f, 0xba, 0x26, 0x6e, 0xe2, 0x22, 0x4e, 0x32, 0xb4, 0xde,
+	0xb0, 0x83, 0xc0, 0x3e, 0x20, 0x29, 0xea, 0x6d, 0x8a, 0xc2, 0x90, 0x50, 0x96, 0xf8, 0xeb, 0xcc,
+	0x72, 0x88, 0x87, 0xe2, 0xb7, 0xac, 0x80, 0x12, 0x93, 0x0f, 0x42, 0x32, 0xf4, 0xad, 0xa7, 0x3e,
+	0x4e, 0x91, 0xcf, 0xc2, 0x80, 0xf2, 0xc4, 0xb5, 0x9a, 0xba, 0xd8, 0xc0, 0xb7, 0xa4, 0x55, 0xff,
+	0xbe, 0x00, 0x70, 0x49, 0x94, 0xda, 0x73, 0x10, 0x57, 0xb7, 0xa0, 0x1a, 0x52, 0x82, 0x5d, 0x0b,
+	0x71, 0x62, 0xfa, 0xc8, 0x23, 0x9a, 0xd2, 0x54, 0x5a, 0xcb, 0x46, 0xe5, 0xc8, 0xba, 0x8f, 0x3c,
+	0xa2, 0xbe, 0x00, 0x65, 0x2b, 0xf0, 0x19, 0xa7, 0x91, 0xc5, 0x03, 0xaa, 0xcd, 0x37, 0x95, 0x56,
+	0xb5, 0xbb, 0xde, 0xce, 0x34, 0xe2, 0xf2, 0x5e, 0x2f, 0x05, 0x18, 0x59, 0xb4, 0x5a, 0x85, 0x79,
+	0x17, 0x6b, 0x85, 0xa6, 0xd2, 0x2a, 0x1a, 0xf3, 0x2e, 0x56, 0x37, 0xa1, 0x8c, 0x2c, 0x8b, 0x30,
+	0x66, 0x3a, 0x88, 0x39, 0xda, 0x42, 0x53, 0x69, 0x15, 0x0c, 0x90, 0xa6, 0xf3, 0x88, 0x39, 0xaa,
+	0x0a, 0x0b, 0x18, 0x71, 0xa2, 0x15, 0x45, 0x88, 0x38, 0xab, 0xeb, 0xb0, 0x84, 0xb0, 0xe7, 0xfa,
+	0xa6, 0x8b, 0xb5, 0x45, 0x11, 0x51, 0x12, 0xf7, 0x5d, 0xac, 0x9e, 0x84, 0xe3, 0xd2, 0x85, 0x22,
+	0xee, 0x98, 0xd7, 0xc9, 0x20, 0xc6, 0x94, 0x04, 0xa6, 0x2a, 0x1c, 0x67, 0x22, 0xee, 0x5c, 0x20,
+	0x83, 0x5d, 0x2c, 0xe8, 0x22, 0xca, 0x5d, 0xcb, 0x0d, 0x91, 0xcf, 0x63, 0xdc, 0x92, 0xc0, 0x55,
+	0x32, 0xd6, 0x5d, 0xac, 0x3e, 0x0f, 0x6b, 0x59, 0x58, 0x36, 0xef, 0xb2, 0xc0, 0xaf, 0x66, 0xdc,
+	0x69, 0xf6, 0x15, 0x28, 0xd8, 0x26, 0xd2, 0xa0, 0xa9, 0xb4, 0x8e, 0x19, 0xf3, 0xf6, 0x19, 0x69,
+	0xe8, 0x6b, 0xe5, 0xc4, 0x70, 0x56, 0x3d, 0x01, 0x2b, 0x71, 0x9e, 0x6b, 0xae, 0x6f, 0x13, 0x1a,
+	0x52, 0xd7, 0xe7, 0xda, 0x31, 0x59, 0xe8, 0x75, 0x32, 0x78, 0x39, 0xb5, 0xaa, 0xab, 0x50, 0x64,
+	0x3c, 0xee, 0x41, 0x45, 0xf4, 0x40, 0x5e, 0xe2, 0x70, 0xc7, 0x65, 0x3c, 0xa0, 0x03, 0x13, 0x93,
+	0x03, 0xc2, 0x09, 0xd6, 0xaa, 0x4d, 0xa5, 0xb5, 0x64, 0x54, 0x13, 0xf3, 0x4b, 0xd2, 0xaa, 0xbf,
+	0x08, 0x95, 0xcb, 0x7b, 0x26, 0x4b, 0xe7, 0xfc, 0x1c, 0x14, 0x31, 0xe2, 0xa8, 0x2b, 0xc6, 0x5b,
+	0xee, 0xfe, 0x3f, 0x3b, 0xba, 0x54, 0x0e, 0x86, 0x04, 0xe9, 0x3f, 0x29, 0xb0, 0x79, 0x14, 0x1f,
+	0x63, 0x4c, 0x4a, 0x6e, 0x44, 0x84, 0xf1, 0x73, 0xbe, 0x45, 0x07, 0x21, 0x77, 0x03, 0x7f, 0x54,
+	0x12, 0xca, 0xdf, 0x92, 0xc4, 0x1a, 0x94, 0x22, 0x46, 0xa8, 0x99, 0xe8, 0xa2, 0x60, 0x2c, 0xc6,
+	0xd7, 0x5d, 0xac, 0x36, 0xa0, 0x9c, 0xed, 0xb6, 0xd4, 0xc6, 0x32, 0x9a, 0x31, 0xc0, 0xe2, 0xa4,
+	0x01, 0x6e, 0xc0, 0x32, 0x45, 0x3e, 0x0e, 0xbc, 0xa1, 0x5c, 0x8a, 0xc6, 0x92, 0x34, 0xa4, 0x63,
+	0x2a, 0x0d, 0xc7, 0xa4, 0xff, 0xa8, 0x40, 0x23, 0x4f, 0x37, 0x16, 0x63, 0xf8, 0xe4, 0xd9, 0xae,
+	0x41, 0x49, 0x94, 0x93, 0xd0, 0x2c, 0x1a, 0x8b, 0xf1, 0x75, 0x48, 0xa1, 0xaf, 0x2d, 0x26, 0x14,
+	0x26, 0x0a, 0xab, 0x34, 0x49, 0x58, 0xfa, 0x67, 0x63, 0xa3, 0xc5, 0x2e, 0xb3, 0x10, 0xc5, 0xff,
+	0x3a, 0xd9, 0x0c, 0x99, 0x85, 0x1c, 0x99, 0x2d, 0xa8, 0x4a, 0x35, 0x9b, 0x89, 0x8a, 0x05, 0xd9,
+	0x25, 0xa3, 0x22, 0xad, 0xe7, 0xa5, 0x51, 0xbf, 0xad, 0xc0, 0x46, 0xbe, 0x72, 0x9b, 0xf0, 0xcc,
+	0x2a, 0xfb, 0x6f, 0xab, 0xd6, 0xef, 0x2b, 0xa0, 0xe7, 0xcb, 0x09, 0x23, 0xe6, 0x24, 0x5d, 0x24,
+	0xf8, 0x15, 0xc2, 0x18, 0xb2, 0xc9, 0x13, 0x12, 0xce, 0x0e, 0x94, 0x3c, 0x59, 0x80, 0xe8, 0x65,
+	0xb9, 0xbb, 0xde, 0xf6, 0xb8, 0xf8, 0x00, 0xb4, 0x47, 0x2b, 0x34, 0x86, 0x48, 0xfd, 0xce, 0x58,
+	0x83, 0x29, 0xb1, 0x88, 0x7b, 0x93, 0xe0, 0x8b, 0x11, 0x89, 0xfe, 0x21, 0x95, 0x91, 0x8a, 0x0b,
+	0x13, 0xa4, 0xee, 0xa1, 0x5b, 0xe6, 0x0d, 0xce, 0x86, 0x7d, 0xf6, 0xd0, 0xad, 0x8b, 0x9c, 0xe9,
+	0xcf, 0x42, 0xf9, 0x75, 0x12, 0xa7, 0x30, 0xf7, 0x02, 0xdf, 0x8e, 0x17, 0x23, 0x46, 0x1c, 0x31,
+	0x4d, 0x69, 0x16, 0x5a, 0x05, 0xb9, 0xb0, 0xd8, 0xa9, 0x87, 0xf3, 0x50, 0xc9, 0x3d, 0xae, 0x1e,
+	0x87, 0x4a, 0xcf, 0xe8, 0xed, 0x74, 0xcd, 0x2b, 0xfb, 0x17, 0xf6, 0x5f, 0xbd, 0xba, 0x5f, 0x9b,
+	0x53, 0x9f, 0x86, 0x9a, 0x34, 0xa5, 0x7b, 0xb1, 0x76, 0xf7, 0xe7, 0xfb, 0xef, 0xff, 0x11, 0xff,
+	0x29, 0x6a, 0x07, 0xf4, 0xac, 0x7b, 0xf2, 0xda, 0xab, 0x3d, 0xbe, 0x77, 0xef, 0xab, 0x5f, 0x65,
+	0xc0, 0x49, 0x78, 0x66, 0x2c, 0x60, 0x74, 0x71, 0xd4, 0x7e, 0xbb, 0x73, 0xfb, 0xdb, 0xe2, 0xc4,
+	0xdc, 0x63, 0xbf, 0xbb, 0xda, 0xc3, 0x4f, 0xbe, 0xfe, 0xe0, 0x77, 0x99, 0xfb, 0x34, 0x34, 0xc6,
+	0x02, 0x72, 0x72, 0xaf, 0x7d, 0xf4, 0xf8, 0xd1, 0xc7, 0x09, 0x78, 0x1b, 0xb6, 0xc6, 0xc0, 0x93,
+	0xc4, 0x58, 0xfb, 0xe2, 0xd3, 0x6f, 0xde, 0x2b, 0x4c, 0xcc, 0x9d, 0x9b, 0x74, 0xed, 0xc1, 0x3b,
+	0x5f, 0xde, 0x95, 0xb9, 0xeb, 0x0b, 0xef, 0x7e, 0xd8, 0x98, 0xeb, 0xfe, 0xb0, 0x00, 0x15, 0xe3,
+	0xb5, 0xde, 0xa5, 0xa3, 0x00, 0x95, 0xc0, 0x53, 0x33, 0x3f, 0x0f, 0xa7, 0xf3, 0xba, 0x98, 0xd9,
+	0xd4, 0xfa, 0x94, 0xcf, 0x91, 0x3e, 0xa7, 0x5a, 0xb0, 0x31, 0x6b, 0x2d, 0x9f, 0x9a, 0xfe, 0xca,
+	0x28, 0x76, 0xc6, 0x23, 0x79, 0x2e, 0xe3, 0xfb, 0x70, 0x06, 0x97, 0x31, 0xf0, 0x8c, 0x67, 0xde,
+	0x04, 0x6d, 0xea, 0xf2, 0x3a, 0x31, 0xfd, 0x89, 0x1c, 0x70, 0x46, 0x7a, 0x0c, 0x9b, 0x7f, 0xb5,
+	0x8c, 0xda, 0xd3, 0x5f, 0x99, 0x84, 0xaf, 0xaf, 0x1c, 0x6d, 0x8d, 0x2b, 0x21, 0x46, 0x9c, 0xe8,
+	0x73, 0xea, 0x5b, 0xa0, 0x4d, 0x93, 0xcd, 0x2c, 0x12, 0x39, 0x60, 0x7d, 0x2d, 0x0b, 0xcc, 0xfc,
+	0xba, 0xf5, 0xb9, 0xb3, 0x57, 0x7f, 0xf9, 0xae, 0xa1, 0x7c, 0x7e, 0xd8, 0x50, 0x1e, 0x1c, 0x36,
+	0x94, 0x47, 0x87, 0x0d, 0xe5, 0x8d, 0x73, 0x99, 0xff, 0xa6, 0x39, 0x41, 0x9e, 0x4d, 0x51, 0x7a,
+	0xd8, 0x66, 0x84, 0xde, 0x24, 0xb4, 0x83, 0xc2, 0xb0, 0x13, 0x1f, 0x5d, 0x8b, 0x74, 0xd2, 0xdc,
+	0x99, 0x63, 0x7f, 0x51, 0x10, 0xd9, 0xf9, 0x73, 0x00, 0xca, 0x5b, 0xa5, 0xbf, 0xbe, 0x0b, 0x00,
+	0x00,
+}
+
+func (this *SecretChat) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 18)
+	s = append(s, "&secretchat.SecretChat{")
+	s = append(s, "PredicateName: "+fmt.Sprintf("%#v", this.PredicateName)+",\n")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
+	s = append(s, "AccessHash: "+fmt.Sprintf("%#v", this.AccessHash)+",\n")
+	s = append(s, "Date: "+fmt.Sprintf("%#v", this.Date)+",\n")
+	s = append(s, "AdminId: "+fmt.Sprintf("%#v", this.AdminId)+",\n")
+	s = append(s, "AdminAuthKeyId: "+fmt.Sprintf("%#v", this.AdminAuthKeyId)+",\n")
+	s = append(s, "ParticipantId: "+fmt.Sprintf("%#v", this.ParticipantId)+",\n")
+	s = append(s, "ParticipantAuthKeyId: "+fmt.Sprintf("%#v", this.ParticipantAuthKeyId)+",\n")
+	s = append(s, "GA: "+fmt.Sprintf("%#v", this.GA)+",\n")
+	s = append(s, "GB: "+fmt.Sprintf("%#v", this.GB)+",\n")
+	s = append(s, "KeyFingerprint: "+fmt.Sprintf("%#v", this.KeyFingerprint)+",\n")
+	s = append(s, "State: "+fmt.Sprintf("%#v", this.State)+",\n")
+	s = append(s, "HistoryDeleted: "+fmt.Sprintf("%#v", this.HistoryDeleted)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLSecretChat) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&secretchat.TLSecretChat{")
+	if this.Data2 != nil {
+		s = append(s, "Data2: "+fmt.Sprintf("%#v", this.Data2)+",\n")
+	}
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLSecretchatRequestEncryption) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 10)
+	s = append(s, "&secretchat.TLSecretchatRequestEncryption{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
+	s = append(s, "AuthKeyId: "+fmt.Sprintf("%#v", this.AuthKeyId)+",\n")
+	s = append(s, "ParticipantId: "+fmt.Sprintf("%#v", this.ParticipantId)+",\n")
+	s = append(s, "RandomId: "+fmt.Sprintf("%#v", this.RandomId)+",\n")
+	s = append(s, "GA: "+fmt.Sprintf("%#v", this.GA)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLSecretchatAcceptEncryption) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 10)
+	s = append(s, "&secretchat.TLSecretchatAcceptEncryption{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
+	s = append(s, "AuthKeyId: "+fmt.Sprintf("%#v", this.AuthKeyId)+",\n")
+	s = append(s, "ChatId: "+fmt.Sprintf("%#v", this.ChatId)+",\n")
+	s = append(s, "GB: "+fmt.Sprintf("%#v", this.GB)+",\n")
+	s = append(s, "KeyFingerprint: "+fmt.Sprintf("%#v", this.KeyFingerprint)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLSecretchatDiscardEncryption) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&secretchat.TLSecretchatDiscardEncryption{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
+	s = append(s, "ChatId: "+fmt.Sprintf("%#v", this.ChatId)+",\n")
+	s = append(s, "DeleteHistory: "+fmt.Sprintf("%#v", this.DeleteHistory)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLSecretchatGetSecretChat) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&secretchat.TLSecretchatGetSecretChat{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
+	s = append(s, "ChatId: "+fmt.Sprintf("%#v", this.ChatId)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLSecretchatPushEncryptedMessage) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&secretchat.TLSecretchatPushEncryptedMessage{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
+	s = append(s, "AuthKeyId: "+fmt.Sprintf("%#v", this.AuthKeyId)+",\n")
+	if this.Message != nil {
+		s = append(s, "Message: "+fmt.Sprintf("%#v", this.Message)+",\n")
+	}
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLSecretchatReceivedQueue) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&secretchat.TLSecretchatReceivedQueue{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "AuthKeyId: "+fmt.Sprintf("%#v", this.AuthKeyId)+",\n")
+	s = append(s, "MaxQts: "+fmt.Sprintf("%#v", this.MaxQts)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *Vector_Long) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&secretchat.Vector_Long{")
+	s = append(s, "Datas: "+fmt.Sprintf("%#v", this.Datas)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringSecretchatTl(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// RPCSecretchatClient is the client API for RPCSecretchat service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type RPCSecretchatClient interface {
+	// secretchat.requestEncryption user_id:long auth_key_id:long participant_id:long random_id:int g_a:bytes = SecretChat;
+	SecretchatRequestEncryption(ctx context.Context, in *TLSecretchatRequestEncryption, opts ...grpc.CallOption) (*SecretChat, error)
+	// secretchat.acceptEncryption user_id:long auth_key_id:long chat_id:int g_b:bytes key_fingerprint:long = SecretChat;
+	SecretchatAcceptEncryption(ctx context.Context, in *TLSecretchatAcceptEncryption, opts ...grpc.CallOption) (*SecretChat, error)
+	// secretchat.discardEncryption flags:# user_id:long chat_id:int delete_history:flags.0?true = SecretChat;
+	SecretchatDiscardEncryption(ctx context.Context, in *TLSecretchatDiscardEncryption, opts ...grpc.CallOption) (*SecretChat, error)
+	// secretchat.getSecretChat user_id:long chat_id:int = SecretChat;
+	SecretchatGetSecretChat(ctx context.Context, in *TLSecretchatGetSecretChat, opts ...grpc.CallOption) (*SecretChat, error)
+	// secretchat.pushEncryptedMessage user_id:long auth_key_id:long message:EncryptedMessage = Update;
+	SecretchatPushEncryptedMessage(ctx context.Context, in *TLSecretchatPushEncryptedMessage, opts ...grpc.CallOption) (*mtproto.Update, error)
+	// secretchat.receivedQueue auth_key_id:long max_qts:int = Vector<long>;
+	SecretchatReceivedQueue(ctx context.Context, in *TLSecretchatReceivedQueue, opts ...grpc.CallOption) (*Vector_Long, error)
+}
+
+type rPCSecretchatClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewRPCSecretchatClient(cc *grpc.ClientConn) RPCSecretchatClient {
+	return &rPCSecretchatClient{cc}
+}
+
+func (c *rPCSecretchatClient) SecretchatRequestEncryption(ctx context.Context, in *TLSecretchatRequestEncryption, opts ...grpc.CallOption) (*SecretChat, error) {
+	out := new(SecretChat)
+	err := c.cc.Invoke(ctx, "/secretchat.RPCSecretchat/secretchat_requestEncryption", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPCSecretchatClient) SecretchatAcceptEncryption(ctx context.Context, in *TLSecretchatAcceptEncryption, opts ...grpc.CallOption) (*SecretChat, error) {
+	out := new(SecretChat)
+	err := c.cc.Invoke(ctx, "/secretchat.RPCSecretchat/secretchat_acceptEncryption", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPCSecretchatClient) SecretchatDiscardEncryption(ctx context.Context, in *TLSecretchatDiscardEncryption, opts ...grpc.CallOption) (*SecretChat, error) {
+	out := new(SecretChat)
+	err := c.cc.Invoke(ctx, "/secretchat.RPCSecretchat/secretchat_discardEncryption", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPCSecretchatClient) SecretchatGetSecretChat(ctx context.Context, in *TLSecretchatGetSecretChat, opts ...grpc.CallOption) (*SecretChat, error) {
+	out := new(SecretChat)
+	err := c.cc.Invoke(ctx, "/secretchat.RPCSecretchat/secretchat_getSecretChat", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPCSecretchatClient) SecretchatPushEncryptedMessage(ctx context.Context, in *TLSecretchatPushEncryptedMessage, opts ...grpc.CallOption) (*mtproto.Update, error) {
+	out := new(mtproto.Update)
+	err := c.cc.Invoke(ctx, "/secretchat.RPCSecretchat/secretchat_pushEncryptedMessage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPCSecretchatClient) SecretchatReceivedQueue(ctx context.Context, in *TLSecretchatReceivedQueue, opts ...grpc.CallOption) (*Vector_Long, error) {
+	out := new(Vector_Long)
+	err := c.cc.Invoke(ctx, "/secretchat.RPCSecretchat/secretchat_receivedQueue", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RPCSecretchatServer is the server API for RPCSecretchat service.
+type RPCSecretchatServer interface {
+	// secretchat.requestEncryption user_id:long auth_key_id:long participant_id:long random_id:int g_a:bytes = SecretChat;
+	SecretchatRequestEncryption(context.Context, *TLSecretchatRequestEncryption) (*SecretChat, error)
+	// secretchat.acceptEncryption user_id:long auth_key_id:long chat_id:int g_b:bytes key_fingerprint:long = SecretChat;
+	SecretchatAcceptEncryption(context.Context, *TLSecretchatAcceptEncryption) (*SecretChat, error)
+	// secretchat.discardEncryption flags:# user_id:long chat_id:int delete_history:flags.0?true = SecretChat;
+	SecretchatDiscardEncryption(context.Context, *TLSecretchatDiscardEncryption) (*SecretChat, error)
+	// secretchat.getSecretChat user_id:long chat_id:int = SecretChat;
+	SecretchatGetSecretChat(context.Context, *TLSecretchatGetSecretChat) (*SecretChat, error)
+	// secretchat.pushEncryptedMessage user_id:long auth_key_id:long message:EncryptedMessage = Update;
+	SecretchatPushEncryptedMessage(context.Context, *TLSecretchatPushEncryptedMessage) (*mtproto.Update, error)
+	// secretchat.receivedQueue auth_key_id:long max_qts:int = Vector<long>;
+	SecretchatReceivedQueue(context.Context, *TLSecretchatReceivedQueue) (*Vector_Long, error)
+}
+
+// UnimplementedRPCSecretchatServer can be embedded to have forward compatible implementations.
+type UnimplementedRPCSecretchatServer struct {
+}
+
+func (*UnimplementedRPCSecretchatServer) SecretchatRequestEncryption(ctx context.Context, req *TLSecretchatRequestEncryption) (*SecretChat, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SecretchatRequestEncryption not implemented")
+}
+func (*UnimplementedRPCSecretchatServer) SecretchatAcceptEncryption(ctx context.Context, req *TLSecretchatAcceptEncryption) (*SecretChat, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SecretchatAcceptEncryption not implemented")
+}
+func (*UnimplementedRPCSecretchatServer) SecretchatDiscardEncryption(ctx context.Context, req *TLSecretchatDiscardEncryption) (*SecretChat, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SecretchatDiscardEncryption not implemented")
+}
+func (*UnimplementedRPCSecretchatServer) SecretchatGetSecretChat(ctx context.Context, req *TLSecretchatGetSecretChat) (*SecretChat, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SecretchatGetSecretChat not implemented")
+}
+func (*UnimplementedRPCSecretchatServer) SecretchatPushEncryptedMessage(ctx context.Context, req *TLSecretchatPushEncryptedMessage) (*mtproto.Update, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SecretchatPushEncryptedMessage not implemented")
+}
+func (*UnimplementedRPCSecretchatServer) SecretchatReceivedQueue(ctx context.Context, req *TLSecretchatReceivedQueue) (*Vector_Long, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SecretchatReceivedQueue not implemented")
+}
+
+func RegisterRPCSecretchatServer(s *grpc.Server, srv RPCSecretchatServer) {
+	s.RegisterService(&_RPCSecretchat_serviceDesc, srv)
+}
+
+func _RPCSecretchat_SecretchatRequestEncryption_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLSecretchatRequestEncryption)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCSecretchatServer).SecretchatRequestEncryption(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/secretchat.RPCSecretchat/SecretchatRequestEncryption",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCSecretchatServer).SecretchatRequestEncryption(ctx, req.(*TLSecretchatRequestEncryption))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCSecretchat_SecretchatAcceptEncryption_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLSecretchatAcceptEncryption)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCSecretchatServer).SecretchatAcceptEncryption(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/secretchat.RPCSecretchat/SecretchatAcceptEncryption",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCSecretchatServer).SecretchatAcceptEncryption(ctx, req.(*TLSecretchatAcceptEncryption))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCSecretchat_SecretchatDiscardEncryption_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLSecretchatDiscardEncryption)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCSecretchatServer).SecretchatDiscardEncryption(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/secretchat.RPCSecretchat/SecretchatDiscardEncryption",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCSecretchatServer).SecretchatDiscardEncryption(ctx, req.(*TLSecretchatDiscardEncryption))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCSecretchat_SecretchatGetSecretChat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLSecretchatGetSecretChat)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCSecretchatServer).SecretchatGetSecretChat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/secretchat.RPCSecretchat/SecretchatGetSecretChat",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCSecretchatServer).SecretchatGetSecretChat(ctx, req.(*TLSecretchatGetSecretChat))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCSecretchat_SecretchatPushEncryptedMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLSecretchatPushEncryptedMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCSecretchatServer).SecretchatPushEncryptedMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/secretchat.RPCSecretchat/SecretchatPushEncryptedMessage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCSecretchatServer).SecretchatPushEncryptedMessage(ctx, req.(*TLSecretchatPushEncryptedMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCSecretchat_SecretchatReceivedQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLSecretchatReceivedQueue)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCSecretchatServer).SecretchatReceivedQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/secretchat.RPCSecretchat/SecretchatReceivedQueue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCSecretchatServer).SecretchatReceivedQueue(ctx, req.(*TLSecretchatReceivedQueue))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _RPCSecretchat_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "secretchat.RPCSecretchat",
+	HandlerType: (*RPCSecretchatServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "secretchat_requestEncryption",
+			Handler:    _RPCSecretchat_SecretchatRequestEncryption_Handler,
+		},
+		{
+			MethodName: "secretchat_acceptEncryption",
+			Handler:    _RPCSecretchat_SecretchatAcceptEncryption_Handler,
+		},
+		{
+			MethodName: "secretchat_discardEncryption",
+			Handler:    _RPCSecretchat_SecretchatDiscardEncryption_Handler,
+		},
+		{
+			MethodName: "secretchat_getSecretChat",
+			Handler:    _RPCSecretchat_SecretchatGetSecretChat_Handler,
+		},
+		{
+			MethodName: "secretchat_pushEncryptedMessage",
+			Handler:    _RPCSecretchat_SecretchatPushEncryptedMessage_Handler,
+		},
+		{
+			MethodName: "secretchat_receivedQueue",
+			Handler:    _RPCSecretchat_SecretchatReceivedQueue_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "secretchat.tl.proto",
+}
+
+func (m *SecretChat) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SecretChat) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SecretChat) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.HistoryDeleted {
+		i--
+		if m.HistoryDeleted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.State != 0 {
+		i = encodeVarintSecretchatTl(dAtA, i, uint64(m.State))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.KeyFingerprint != 0 {
+		i = encodeVarintSecretchatTl(dAtA, i, uint64(m.KeyFingerprint))
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.GB) > 0 {
+		i -= len(m.GB)
+		copy(dAtA[i:], m.GB)
+		i = encodeVarintSecretchatTl(dAtA, i, uint64(len(m.GB)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.GA) > 0 {
+		i -= len(m.GA)
+		copy(dAtA[i:], m.GA)
+		i = encodeVarintSecretchatTl(dAtA, i, uint64(len(m.GA)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.ParticipantAuthKeyId != 0 {
+		i = encodeVarintSecretchatTl(dAtA, i, uint64(m.ParticipantAuthKeyId))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.ParticipantId != 0 {
+		i = encodeVarintSecretchatTl(dAtA, i, uint64(m.ParticipantId))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.AdminAuthKeyId != 0 {
+		i = encodeVarintSecretchatTl(dAtA, i, uint64(m.AdminAuthKeyId))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.AdminId != 0 {
+		i = encodeVarintSecretchatTl(dAtA, i, uint64(m.AdminId))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Date != 0 {
+		i = encodeVarintSecretchatTl(dAtA, i, uint64(m.Date))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.AccessHash != 0 {
+		i = encodeVarintSecretchatTl(dAtA, i, uint64(m.AccessHash))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Id != 0 {
+		i = encodeVarintSecretchatTl(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintSecretchatTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.PredicateName) > 0 {
+		i -= len(m.PredicateName)
+		copy(dAtA[i:], m.PredicateName)
+		i = encodeVarintSecretchatTl(dAtA, i, uint64(len(m.PredicateName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLSecretChat) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLSecretChat) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLSecretChat) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Data2 != nil {
+		{
+			size, err := m.Data2.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSecretchatTl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLSecretchatRequestEncryption) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLSecretchatRequestEncryption) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLSecretchatRequestEncryption) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.GA) > 0 {
+		i -= len(m.GA)
+		copy(dAtA[i:], m.GA)
+		i = encodeVarintSecretchatTl(dAtA, i, uint64(len(m.GA)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.RandomId != 0 {
+		i = encodeVarintSecretchatTl(dAtA, i, uint64(m.RandomId))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ParticipantId != 0 {
+		i = encodeVarintSecretchatTl(dAtA, i, uint64(m.ParticipantId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.AuthKeyId != 0 {
+		i = encodeVarintSecretchatTl(dAtA, i, uint64(m.AuthKeyId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.UserId != 0 {
+		i = encodeVarintSecretchatTl(dAtA, i, uint64(m.UserId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintSecretchatTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLSecretchatAcceptEncryption) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLSecretchatAcceptEncryption) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLSecretchatAcceptEncryption) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.KeyFingerprint != 0 {
+		i = encodeVarintSecretchatTl(dAtA, i, uint64(m.KeyFingerprint))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.GB) > 0 {
+		i -= len(m.GB)
+		copy(dAtA[i:], m.GB)
+		i = encodeVarintSecretchatTl(dAtA, i, uint64(len(m.GB)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.ChatId != 0 {
+		i = encodeVarintSecretchatTl(dAtA, i, uint64(m.ChatId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.AuthKeyId != 0 {
+		i = encodeVarintSecretchatTl(dAtA, i, uint64(m.AuthKeyId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.UserId != 0 {
+		i = encodeVarintSecretchatTl(dAtA, i, uint64(m.UserId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintSecretchatTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLSecretchatDiscardEncryption) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLSecretchatDiscardEncryption) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLSecretchatDiscardEncryption) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.DeleteHistory {
+		i--
+		if m.DeleteHistory {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ChatId != 0 {
+		i = encodeVarintSecretchatTl(dAtA, i, uint64(m.ChatId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.UserId != 0 {
+		i = encodeVarintSecretchatTl(dAtA, i, uint64(m.UserId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintSecretchatTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLSecretchatGetSecretChat) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLSecretchatGetSecretChat) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLSecretchatGetSecretChat) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ChatId != 0 {
+		i = encodeVarintSecretchatTl(dAtA, i, uint64(m.ChatId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.UserId != 0 {
+		i = encodeVarintSecretchatTl(dAtA, i, uint64(m.UserId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintSecretchatTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLSecretchatPushEncryptedMessage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLSecretchatPushEncryptedMessage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLSecretchatPushEncryptedMessage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Message != nil {
+		{
+			size, err := m.Message.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSecretchatTl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.AuthKeyId != 0 {
+		i = encodeVarintSecretchatTl(dAtA, i, uint64(m.AuthKeyId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.UserId != 0 {
+		i = encodeVarintSecretchatTl(dAtA, i, uint64(m.UserId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintSecretchatTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLSecretchatReceivedQueue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLSecretchatReceivedQueue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLSecretchatReceivedQueue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.MaxQts != 0 {
+		i = encodeVarintSecretchatTl(dAtA, i, uint64(m.MaxQts))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.AuthKeyId != 0 {
+		i = encodeVarintSecretchatTl(dAtA, i, uint64(m.AuthKeyId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintSecretchatTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Vector_Long) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Vector_Long) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Vector_Long) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Datas) > 0 {
+		dAtA4 := make([]byte, len(m.Datas)*10)
+		var j3 int
+		for _, num1 := range m.Datas {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA4[j3] = uint8(num)
+			j3++
+		}
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintSecretchatTl(dAtA, i, uint64(j3))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSecretchatTl(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSecretchatTl(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SecretChat) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PredicateName)
+	if l > 0 {
+		n += 1 + l + sovSecretchatTl(uint64(l))
+	}
+	if m.Constructor != 0 {
+		n += 1 + sovSecretchatTl(uint64(m.Constructor))
+	}
+	if m.Id != 0 {
+		n += 1 + sovSecretchatTl(uint64(m.Id))
+	}
+	if m.AccessHash != 0 {
+		n += 1 + sovSecretchatTl(uint64(m.AccessHash))
+	}
+	if m.Date != 0 {
+		n += 1 + sovSecretchatTl(uint64(m.Date))
+	}
+	if m.AdminId != 0 {
+		n += 1 + sovSecretchatTl(uint64(m.AdminId))
+	}
+	if m.AdminAuthKeyId != 0 {
+		n += 1 + sovSecretchatTl(uint64(m.AdminAuthKeyId))
+	}
+	if m.ParticipantId != 0 {
+		n += 1 + sovSecretchatTl(uint64(m.ParticipantId))
+	}
+	if m.ParticipantAuthKeyId != 0 {
+		n += 1 + sovSecretchatTl(uint64(m.ParticipantAuthKeyId))
+	}
+	l = len(m.GA)
+	if l > 0 {
+		n += 1 + l + sovSecretchatTl(uint64(l))
+	}
+	l = len(m.GB)
+	if l > 0 {
+		n += 1 + l + sovSecretchatTl(uint64(l))
+	}
+	if m.KeyFingerprint != 0 {
+		n += 1 + sovSecretchatTl(uint64(m.KeyFingerprint))
+	}
+	if m.State != 0 {
+		n += 1 + sovSecretchatTl(uint64(m.State))
+	}
+	if m.HistoryDeleted {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLSecretChat) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Data2 != nil {
+		l = m.Data2.Size()
+		n += 1 + l + sovSecretchatTl(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLSecretchatRequestEncryption) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovSecretchatTl(uint64(m.Constructor))
+	}
+	if m.UserId != 0 {
+		n += 1 + sovSecretchatTl(uint64(m.UserId))
+	}
+	if m.AuthKeyId != 0 {
+		n += 1 + sovSecretchatTl(uint64(m.AuthKeyId))
+	}
+	if m.ParticipantId != 0 {
+		n += 1 + sovSecretchatTl(uint64(m.ParticipantId))
+	}
+	if m.RandomId != 0 {
+		n += 1 + sovSecretchatTl(uint64(m.RandomId))
+	}
+	l = len(m.GA)
+	if l > 0 {
+		n += 1 + l + sovSecretchatTl(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLSecretchatAcceptEncryption) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovSecretchatTl(uint64(m.Constructor))
+	}
+	if m.UserId != 0 {
+		n += 1 + sovSecretchatTl(uint64(m.UserId))
+	}
+	if m.AuthKeyId != 0 {
+		n += 1 + sovSecretchatTl(uint64(m.AuthKeyId))
+	}
+	if m.ChatId != 0 {
+		n += 1 + sovSecretchatTl(uint64(m.ChatId))
+	}
+	l = len(m.GB)
+	if l > 0 {
+		n += 1 + l + sovSecretchatTl(uint64(l))
+	}
+	if m.KeyFingerprint != 0 {
+		n += 1 + sovSecretchatTl(uint64(m.KeyFingerprint))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLSecretchatDiscardEncryption) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovSecretchatTl(uint64(m.Constructor))
+	}
+	if m.UserId != 0 {
+		n += 1 + sovSecretchatTl(uint64(m.UserId))
+	}
+	if m.ChatId != 0 {
+		n += 1 + sovSecretchatTl(uint64(m.ChatId))
+	}
+	if m.DeleteHistory {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLSecretchatGetSecretChat) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovSecretchatTl(uint64(m.Constructor))
+	}
+	if m.UserId != 0 {
+		n += 1 + sovSecretchatTl(uint64(m.UserId))
+	}
+	if m.ChatId != 0 {
+		n += 1 + sovSecretchatTl(uint64(m.ChatId))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLSecretchatPushEncryptedMessage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovSecretchatTl(uint64(m.Constructor))
+	}
+	if m.UserId != 0 {
+		n += 1 + sovSecretchatTl(uint64(m.UserId))
+	}
+	if m.AuthKeyId != 0 {
+		n += 1 + sovSecretchatTl(uint64(m.AuthKeyId))
+	}
+	if m.Message != nil {
+		l = m.Message.Size()
+		n += 1 + l + sovSecretchatTl(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLSecretchatReceivedQueue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovSecretchatTl(uint64(m.Constructor))
+	}
+	if m.AuthKeyId != 0 {
+		n += 1 + sovSecretchatTl(uint64(m.AuthKeyId))
+	}
+	if m.MaxQts != 0 {
+		n += 1 + sovSecretchatTl(uint64(m.MaxQts))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Vector_Long) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Datas) > 0 {
+		l = 0
+		for _, e := range m.Datas {
+			l += sovSecretchatTl(uint64(e))
+		}
+		n += 1 + sovSecretchatTl(uint64(l)) + l
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovSecretchatTl(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSecretchatTl(x uint64) (n int) {
+	return sovSecretchatTl(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SecretChat) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSecretchatTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SecretChat: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SecretChat: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PredicateName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecretchatTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSecretchatTl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSecretchatTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PredicateName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecretchatTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecretchatTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessHash", wireType)
+			}
+			m.AccessHash = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecretchatTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AccessHash |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Date", wireType)
+			}
+			m.Date = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecretchatTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Date |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdminId", wireType)
+			}
+			m.AdminId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecretchatTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AdminId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdminAuthKeyId", wireType)
+			}
+			m.AdminAuthKeyId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecretchatTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AdminAuthKeyId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParticipantId", wireType)
+			}
+			m.ParticipantId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecretchatTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ParticipantId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParticipantAuthKeyId", wireType)
+			}
+			m.ParticipantAuthKeyId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecretchatTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ParticipantAuthKeyId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GA", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecretchatTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSecretchatTl
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSecretchatTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GA = append(m.GA[:0], dAtA[iNdEx:postIndex]...)
+			if m.GA == nil {
+				m.GA = []byte{}
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GB", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecretchatTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSecretchatTl
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSecretchatTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GB = append(m.GB[:0], dAtA[iNdEx:postIndex]...)
+			if m.GB == nil {
+				m.GB = []byte{}
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyFingerprint", wireType)
+			}
+			m.KeyFingerprint = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecretchatTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KeyFingerprint |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecretchatTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoryDeleted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecretchatTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HistoryDeleted = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSecretchatTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSecretchatTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLSecretChat) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSecretchatTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_secretChat: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_secretChat: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data2", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecretchatTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSecretchatTl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSecretchatTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Data2 == nil {
+				m.Data2 = &SecretChat{}
+			}
+			if err := m.Data2.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSecretchatTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSecretchatTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLSecretchatRequestEncryption) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSecretchatTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_secretchat_requestEncryption: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_secretchat_requestEncryption: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecretchatTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			m.UserId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecretchatTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UserId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthKeyId", wireType)
+			}
+			m.AuthKeyId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecretchatTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuthKeyId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParticipantId", wireType)
+			}
+			m.ParticipantId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecretchatTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ParticipantId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RandomId", wireType)
+			}
+			m.RandomId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecretchatTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RandomId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GA", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecretchatTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSecretchatTl
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSecretchatTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GA = append(m.GA[:0], dAtA[iNdEx:postIndex]...)
+			if m.GA == nil {
+				m.GA = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSecretchatTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSecretchatTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLSecretchatAcceptEncryption) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSecretchatTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_secretchat_acceptEncryption: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_secretchat_acceptEncryption: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecretchatTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			m.UserId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecretchatTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UserId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthKeyId", wireType)
+			}
+			m.AuthKeyId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecretchatTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuthKeyId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChatId", wireType)
+			}
+			m.ChatId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecretchatTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChatId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GB", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecretchatTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSecretchatTl
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSecretchatTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GB = append(m.GB[:0], dAtA[iNdEx:postIndex]...)
+			if m.GB == nil {
+				m.GB = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyFingerprint", wireType)
+			}
+			m.KeyFingerprint = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecretchatTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KeyFingerprint |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSecretchatTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSecretchatTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLSecretchatDiscardEncryption) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSecretchatTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_secretchat_discardEncryption: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_secretchat_discardEncryption: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecretchatTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			m.UserId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecretchatTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UserId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChatId", wireType)
+			}
+			m.ChatId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecretchatTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChatId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeleteHistory", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecretchatTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DeleteHistory = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSecretchatTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSecretchatTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLSecretchatGetSecretChat) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSecretchatTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_secretchat_getSecretChat: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_secretchat_getSecretChat: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecretchatTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			m.UserId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecretchatTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UserId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChatId", wireType)
+			}
+			m.ChatId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecretchatTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChatId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSecretchatTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSecretchatTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLSecretchatPushEncryptedMessage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSecretchatTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_secretchat_pushEncryptedMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_secretchat_pushEncryptedMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecretchatTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			m.UserId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecretchatTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UserId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthKeyId", wireType)
+			}
+			m.AuthKeyId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecretchatTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuthKeyId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecretchatTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSecretchatTl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSecretchatTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Message == nil {
+				m.Message = &mtproto.EncryptedMessage{}
+			}
+			if err := m.Message.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSecretchatTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSecretchatTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLSecretchatReceivedQueue) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSecretchatTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_secretchat_receivedQueue: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_secretchat_receivedQueue: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecretchatTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthKeyId", wireType)
+			}
+			m.AuthKeyId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecretchatTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuthKeyId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxQts", wireType)
+			}
+			m.MaxQts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecretchatTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxQts |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSecretchatTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSecretchatTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Vector_Long) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSecretchatTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Vector_Long: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Vector_Long: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSecretchatTl
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Datas = append(m.Datas, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSecretchatTl
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthSecretchatTl
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthSecretchatTl
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Datas) == 0 {
+					m.Datas = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSecretchatTl
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Datas = append(m.Datas, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Datas", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSecretchatTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSecretchatTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipSecretchatTl(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowSecretchatTl
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSecretchatTl
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSecretchatTl
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthSecretchatTl
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupSecretchatTl
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthSecretchatTl
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthSecretchatTl        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowSecretchatTl          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupSecretchatTl = fmt.Errorf("proto: unexpected end of group")
+)
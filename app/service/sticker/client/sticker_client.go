@@ -0,0 +1,171 @@
+/*
+ * WARNING! All changes made in this file will be lost!
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package sticker_client
+
+import (
+	"context"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/sticker/sticker"
+
+	"github.com/zeromicro/go-zero/zrpc"
+)
+
+var _ *mtproto.Bool
+
+type StickerClient interface {
+	StickerCreateStickerSet(ctx context.Context, in *sticker.TLStickerCreateStickerSet) (*sticker.StickerSetData, error)
+	StickerAddStickerToSet(ctx context.Context, in *sticker.TLStickerAddStickerToSet) (*sticker.StickerSetData, error)
+	StickerRemoveStickerFromSet(ctx context.Context, in *sticker.TLStickerRemoveStickerFromSet) (*sticker.StickerSetData, error)
+	StickerCheckShortName(ctx context.Context, in *sticker.TLStickerCheckShortName) (*mtproto.Bool, error)
+	StickerGetStickerSet(ctx context.Context, in *sticker.TLStickerGetStickerSet) (*sticker.StickerSetData, error)
+	StickerGetStickerSetThumb(ctx context.Context, in *sticker.TLStickerGetStickerSetThumb) (*mtproto.InputFileLocation, error)
+	StickerInstallStickerSet(ctx context.Context, in *sticker.TLStickerInstallStickerSet) (*mtproto.StickerSet, error)
+	StickerUninstallStickerSet(ctx context.Context, in *sticker.TLStickerUninstallStickerSet) (*mtproto.Bool, error)
+	StickerGetInstalledStickerSets(ctx context.Context, in *sticker.TLStickerGetInstalledStickerSets) (*sticker.Vector_StickerSet, error)
+	StickerGetRecentStickers(ctx context.Context, in *sticker.TLStickerGetRecentStickers) (*sticker.Vector_StickerSetDocument, error)
+	StickerSaveRecentSticker(ctx context.Context, in *sticker.TLStickerSaveRecentSticker) (*mtproto.Bool, error)
+	StickerClearRecentStickers(ctx context.Context, in *sticker.TLStickerClearRecentStickers) (*mtproto.Bool, error)
+	StickerGetFavedStickers(ctx context.Context, in *sticker.TLStickerGetFavedStickers) (*sticker.Vector_StickerSetDocument, error)
+	StickerFaveSticker(ctx context.Context, in *sticker.TLStickerFaveSticker) (*mtproto.Bool, error)
+	StickerGetStickersByEmoji(ctx context.Context, in *sticker.TLStickerGetStickersByEmoji) (*sticker.Vector_StickerSetDocument, error)
+	StickerChangeStickerPosition(ctx context.Context, in *sticker.TLStickerChangeStickerPosition) (*sticker.StickerSetData, error)
+	StickerSetStickerSetThumb(ctx context.Context, in *sticker.TLStickerSetStickerSetThumb) (*sticker.StickerSetData, error)
+}
+
+type defaultStickerClient struct {
+	cli zrpc.Client
+}
+
+func NewStickerClient(cli zrpc.Client) StickerClient {
+	return &defaultStickerClient{
+		cli: cli,
+	}
+}
+
+// StickerCreateStickerSet
+// sticker.createStickerSet flags:# user_id:long masks:flags.0?true animated:flags.1?true videos:flags.2?true title:string short_name:string thumb:flags.3?Document stickers:Vector<StickerSetDocument> = StickerSetData;
+func (m *defaultStickerClient) StickerCreateStickerSet(ctx context.Context, in *sticker.TLStickerCreateStickerSet) (*sticker.StickerSetData, error) {
+	client := sticker.NewRPCStickerClient(m.cli.Conn())
+	return client.StickerCreateStickerSet(ctx, in)
+}
+
+// StickerAddStickerToSet
+// sticker.addStickerToSet user_id:long stickerset:InputStickerSet sticker:StickerSetDocument = StickerSetData;
+func (m *defaultStickerClient) StickerAddStickerToSet(ctx context.Context, in *sticker.TLStickerAddStickerToSet) (*sticker.StickerSetData, error) {
+	client := sticker.NewRPCStickerClient(m.cli.Conn())
+	return client.StickerAddStickerToSet(ctx, in)
+}
+
+// StickerRemoveStickerFromSet
+// sticker.removeStickerFromSet user_id:long document_id:long = StickerSetData;
+func (m *defaultStickerClient) StickerRemoveStickerFromSet(ctx context.Context, in *sticker.TLStickerRemoveStickerFromSet) (*sticker.StickerSetData, error) {
+	client := sticker.NewRPCStickerClient(m.cli.Conn())
+	return client.StickerRemoveStickerFromSet(ctx, in)
+}
+
+// StickerCheckShortName
+// sticker.checkShortName short_name:string = Bool;
+func (m *defaultStickerClient) StickerCheckShortName(ctx context.Context, in *sticker.TLStickerCheckShortName) (*mtproto.Bool, error) {
+	client := sticker.NewRPCStickerClient(m.cli.Conn())
+	return client.StickerCheckShortName(ctx, in)
+}
+
+// StickerGetStickerSet
+// sticker.getStickerSet user_id:long stickerset:InputStickerSet = StickerSetData;
+func (m *defaultStickerClient) StickerGetStickerSet(ctx context.Context, in *sticker.TLStickerGetStickerSet) (*sticker.StickerSetData, error) {
+	client := sticker.NewRPCStickerClient(m.cli.Conn())
+	return client.StickerGetStickerSet(ctx, in)
+}
+
+// StickerGetStickerSetThumb
+// sticker.getStickerSetThumb stickerset:InputStickerSet thumb_version:int = InputFileLocation;
+func (m *defaultStickerClient) StickerGetStickerSetThumb(ctx context.Context, in *sticker.TLStickerGetStickerSetThumb) (*mtproto.InputFileLocation, error) {
+	client := sticker.NewRPCStickerClient(m.cli.Conn())
+	return client.StickerGetStickerSetThumb(ctx, in)
+}
+
+// StickerInstallStickerSet
+// sticker.installStickerSet user_id:long stickerset:InputStickerSet archived:Bool = StickerSet;
+func (m *defaultStickerClient) StickerInstallStickerSet(ctx context.Context, in *sticker.TLStickerInstallStickerSet) (*mtproto.StickerSet, error) {
+	client := sticker.NewRPCStickerClient(m.cli.Conn())
+	return client.StickerInstallStickerSet(ctx, in)
+}
+
+// StickerUninstallStickerSet
+// sticker.uninstallStickerSet user_id:long stickerset:InputStickerSet = Bool;
+func (m *defaultStickerClient) StickerUninstallStickerSet(ctx context.Context, in *sticker.TLStickerUninstallStickerSet) (*mtproto.Bool, error) {
+	client := sticker.NewRPCStickerClient(m.cli.Conn())
+	return client.StickerUninstallStickerSet(ctx, in)
+}
+
+// StickerGetInstalledStickerSets
+// sticker.getInstalledStickerSets user_id:long masks:Bool = Vector<StickerSet>;
+func (m *defaultStickerClient) StickerGetInstalledStickerSets(ctx context.Context, in *sticker.TLStickerGetInstalledStickerSets) (*sticker.Vector_StickerSet, error) {
+	client := sticker.NewRPCStickerClient(m.cli.Conn())
+	return client.StickerGetInstalledStickerSets(ctx, in)
+}
+
+// StickerGetRecentStickers
+// sticker.getRecentStickers user_id:long attached:Bool = Vector<StickerSetDocument>;
+func (m *defaultStickerClient) StickerGetRecentStickers(ctx context.Context, in *sticker.TLStickerGetRecentStickers) (*sticker.Vector_StickerSetDocument, error) {
+	client := sticker.NewRPCStickerClient(m.cli.Conn())
+	return client.StickerGetRecentStickers(ctx, in)
+}
+
+// StickerSaveRecentSticker
+// sticker.saveRecentSticker user_id:long attached:Bool document_id:long unsave:Bool = Bool;
+func (m *defaultStickerClient) StickerSaveRecentSticker(ctx context.Context, in *sticker.TLStickerSaveRecentSticker) (*mtproto.Bool, error) {
+	client := sticker.NewRPCStickerClient(m.cli.Conn())
+	return client.StickerSaveRecentSticker(ctx, in)
+}
+
+// StickerClearRecentStickers
+// sticker.clearRecentStickers user_id:long attached:Bool = Bool;
+func (m *defaultStickerClient) StickerClearRecentStickers(ctx context.Context, in *sticker.TLStickerClearRecentStickers) (*mtproto.Bool, error) {
+	client := sticker.NewRPCStickerClient(m.cli.Conn())
+	return client.StickerClearRecentStickers(ctx, in)
+}
+
+// StickerGetFavedStickers
+// sticker.getFavedStickers user_id:long = Vector<StickerSetDocument>;
+func (m *defaultStickerClient) StickerGetFavedStickers(ctx context.Context, in *sticker.TLStickerGetFavedStickers) (*sticker.Vector_StickerSetDocument, error) {
+	client := sticker.NewRPCStickerClient(m.cli.Conn())
+	return client.StickerGetFavedStickers(ctx, in)
+}
+
+// StickerFaveSticker
+// sticker.faveSticker user_id:long document_id:long unfave:Bool = Bool;
+func (m *defaultStickerClient) StickerFaveSticker(ctx context.Context, in *sticker.TLStickerFaveSticker) (*mtproto.Bool, error) {
+	client := sticker.NewRPCStickerClient(m.cli.Conn())
+	return client.StickerFaveSticker(ctx, in)
+}
+
+// StickerGetStickersByEmoji
+// sticker.getStickersByEmoji user_id:long emoticon:string = Vector<StickerSetDocument>;
+func (m *defaultStickerClient) StickerGetStickersByEmoji(ctx context.Context, in *sticker.TLStickerGetStickersByEmoji) (*sticker.Vector_StickerSetDocument, error) {
+	client := sticker.NewRPCStickerClient(m.cli.Conn())
+	return client.StickerGetStickersByEmoji(ctx, in)
+}
+
+// StickerChangeStickerPosition
+// sticker.changeStickerPosition user_id:long document_id:long position:int = StickerSetData;
+func (m *defaultStickerClient) StickerChangeStickerPosition(ctx context.Context, in *sticker.TLStickerChangeStickerPosition) (*sticker.StickerSetData, error) {
+	client := sticker.NewRPCStickerClient(m.cli.Conn())
+	return client.StickerChangeStickerPosition(ctx, in)
+}
+
+// StickerSetStickerSetThumb
+// sticker.setStickerSetThumb user_id:long stickerset:InputStickerSet thumb:Document = StickerSetData;
+func (m *defaultStickerClient) StickerSetStickerSetThumb(ctx context.Context, in *sticker.TLStickerSetStickerSetThumb) (*sticker.StickerSetData, error) {
+	client := sticker.NewRPCStickerClient(m.cli.Conn())
+	return client.StickerSetStickerSetThumb(ctx, in)
+}
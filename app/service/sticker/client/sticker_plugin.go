@@ -0,0 +1,43 @@
+// Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+//  All rights reserved.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package sticker_client
+
+import (
+	"context"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/sticker/sticker"
+
+	"github.com/zeromicro/go-zero/zrpc"
+)
+
+// StickerPlugin implements the files plugin, it resolves the thumbs of the sticker sets.
+type StickerPlugin struct {
+	cli StickerClient
+}
+
+func NewStickerPlugin(cli zrpc.Client) *StickerPlugin {
+	return &StickerPlugin{
+		cli: NewStickerClient(cli),
+	}
+}
+
+func (m *StickerPlugin) Client() StickerClient {
+	return m.cli
+}
+
+func (m *StickerPlugin) GetStickerSetThumbFileLocation(ctx context.Context, userId int64, stickerset *mtproto.InputStickerSet, version int32) (*mtproto.InputFileLocation, error) {
+	return m.cli.StickerGetStickerSetThumb(ctx, &sticker.TLStickerGetStickerSetThumb{
+		Stickerset:   stickerset,
+		ThumbVersion: version,
+	})
+}
+
+// GetGroupCallStreamFile group calls are not supported
+func (m *StickerPlugin) GetGroupCallStreamFile(ctx context.Context, userId int64, file *mtproto.InputFileLocation) (*mtproto.Upload_File, error) {
+	return nil, mtproto.ErrGroupCallInvalid
+}
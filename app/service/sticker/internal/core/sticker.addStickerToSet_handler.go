@@ -0,0 +1,74 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"time"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/sticker/internal/dal/dataobject"
+	"github.com/teamgram/teamgram-server/app/service/sticker/sticker"
+)
+
+// StickerAddStickerToSet
+// sticker.addStickerToSet user_id:long stickerset:InputStickerSet sticker:StickerSetDocument = StickerSetData;
+func (c *StickerCore) StickerAddStickerToSet(in *sticker.TLStickerAddStickerToSet) (*sticker.StickerSetData, error) {
+	setDO, err := c.svcCtx.Dao.GetStickerSetByInput(c.ctx, in.Stickerset)
+	if err != nil {
+		c.Logger.Errorf("sticker.addStickerToSet - error: %v", err)
+		return nil, err
+	}
+	if setDO.CreatorId != in.UserId {
+		err = mtproto.ErrStickersetInvalid
+		c.Logger.Errorf("sticker.addStickerToSet - error: %v", err)
+		return nil, err
+	}
+	if setDO.Count >= sticker.StickerSetStickersMax {
+		err = sticker.ErrStickersTooMuch
+		c.Logger.Errorf("sticker.addStickerToSet - error: %v", err)
+		return nil, err
+	}
+
+	// the new sticker goes to the end of the set
+	var (
+		position int32
+	)
+	_, err = c.svcCtx.Dao.StickerSetDocumentsDAO.SelectListBySetIdWithCB(
+		c.ctx,
+		setDO.SetId,
+		func(i int, v *dataobject.StickerSetDocumentsDO) {
+			if v.Position >= position {
+				position = v.Position + 1
+			}
+		})
+	if err != nil {
+		c.Logger.Errorf("sticker.addStickerToSet - error: %v", err)
+		return nil, err
+	}
+
+	_, _, err = c.svcCtx.Dao.StickerSetDocumentsDAO.InsertOrUpdate(c.ctx, &dataobject.StickerSetDocumentsDO{
+		SetId:      setDO.SetId,
+		DocumentId: in.Sticker.DocumentId,
+		Emoji:      in.Sticker.Emoji,
+		Position:   position,
+		Date2:      time.Now().Unix(),
+	})
+	if err != nil {
+		c.Logger.Errorf("sticker.addStickerToSet - error: %v", err)
+		return nil, err
+	}
+
+	if err = c.svcCtx.Dao.UpdateStickerSetCount(c.ctx, setDO); err != nil {
+		c.Logger.Errorf("sticker.addStickerToSet - error: %v", err)
+		return nil, err
+	}
+
+	return c.svcCtx.Dao.MakeStickerSetData(c.ctx, in.UserId, setDO)
+}
@@ -0,0 +1,81 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"github.com/teamgram/marmota/pkg/stores/sqlx"
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/sticker/sticker"
+)
+
+// StickerChangeStickerPosition
+// sticker.changeStickerPosition user_id:long document_id:long position:int = StickerSetData;
+func (c *StickerCore) StickerChangeStickerPosition(in *sticker.TLStickerChangeStickerPosition) (*sticker.StickerSetData, error) {
+	docDO, err := c.svcCtx.Dao.StickerSetDocumentsDAO.SelectByDocumentId(c.ctx, in.DocumentId)
+	if err != nil {
+		c.Logger.Errorf("sticker.changeStickerPosition - error: %v", err)
+		return nil, err
+	} else if docDO == nil {
+		err = mtproto.ErrStickerInvalid
+		c.Logger.Errorf("sticker.changeStickerPosition - error: %v", err)
+		return nil, err
+	}
+
+	setDO, err := c.svcCtx.Dao.StickerSetsDAO.SelectBySetId(c.ctx, docDO.SetId)
+	if err != nil {
+		c.Logger.Errorf("sticker.changeStickerPosition - error: %v", err)
+		return nil, err
+	} else if setDO == nil || setDO.CreatorId != in.UserId {
+		err = mtproto.ErrStickersetInvalid
+		c.Logger.Errorf("sticker.changeStickerPosition - error: %v", err)
+		return nil, err
+	}
+
+	documents, err := c.svcCtx.Dao.GetStickerSetDocumentList(c.ctx, setDO)
+	if err != nil {
+		c.Logger.Errorf("sticker.changeStickerPosition - error: %v", err)
+		return nil, err
+	}
+	if in.Position < 0 || int(in.Position) >= len(documents) {
+		err = mtproto.ErrStickerInvalid
+		c.Logger.Errorf("sticker.changeStickerPosition - error: %v", err)
+		return nil, err
+	}
+
+	// the positions are renumbered from 0, the sticker moves to its new position
+	idList := make([]int64, 0, len(documents))
+	for _, v := range documents {
+		if v.DocumentId != in.DocumentId {
+			idList = append(idList, v.DocumentId)
+		}
+	}
+	idList = append(idList[:in.Position], append([]int64{in.DocumentId}, idList[in.Position:]...)...)
+
+	tR := sqlx.TxWrapper(c.ctx, c.svcCtx.Dao.DB, func(tx *sqlx.Tx, result *sqlx.StoreResult) {
+		for i, id := range idList {
+			_, result.Err = c.svcCtx.Dao.StickerSetDocumentsDAO.UpdatePositionTx(tx, int32(i), setDO.SetId, id)
+			if result.Err != nil {
+				return
+			}
+		}
+	})
+	if tR.Err != nil {
+		c.Logger.Errorf("sticker.changeStickerPosition - error: %v", tR.Err)
+		return nil, tR.Err
+	}
+
+	// the hash follows the order of the stickers
+	if err = c.svcCtx.Dao.UpdateStickerSetCount(c.ctx, setDO); err != nil {
+		c.Logger.Errorf("sticker.changeStickerPosition - error: %v", err)
+		return nil, err
+	}
+
+	return c.svcCtx.Dao.MakeStickerSetData(c.ctx, in.UserId, setDO)
+}
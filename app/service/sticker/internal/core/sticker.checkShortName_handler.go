@@ -0,0 +1,37 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/sticker/sticker"
+)
+
+// StickerCheckShortName
+// sticker.checkShortName short_name:string = Bool;
+func (c *StickerCore) StickerCheckShortName(in *sticker.TLStickerCheckShortName) (*mtproto.Bool, error) {
+	if !sticker.CheckShortName(in.ShortName) {
+		err := mtproto.ErrShortNameInvalid
+		c.Logger.Errorf("sticker.checkShortName - error: %v", err)
+		return nil, err
+	}
+
+	do, err := c.svcCtx.Dao.StickerSetsDAO.SelectByShortName(c.ctx, in.ShortName)
+	if err != nil {
+		c.Logger.Errorf("sticker.checkShortName - error: %v", err)
+		return nil, err
+	} else if do != nil {
+		err = mtproto.ErrShortNameOccupied
+		c.Logger.Errorf("sticker.checkShortName - error: %v", err)
+		return nil, err
+	}
+
+	return mtproto.BoolTrue, nil
+}
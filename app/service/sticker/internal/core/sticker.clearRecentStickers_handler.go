@@ -0,0 +1,26 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/sticker/sticker"
+)
+
+// StickerClearRecentStickers
+// sticker.clearRecentStickers user_id:long attached:Bool = Bool;
+func (c *StickerCore) StickerClearRecentStickers(in *sticker.TLStickerClearRecentStickers) (*mtproto.Bool, error) {
+	if _, err := c.svcCtx.Dao.UserRecentStickersDAO.DeleteAll(c.ctx, in.UserId, mtproto.FromBool(in.Attached)); err != nil {
+		c.Logger.Errorf("sticker.clearRecentStickers - error: %v", err)
+		return nil, err
+	}
+
+	return mtproto.BoolTrue, nil
+}
@@ -0,0 +1,116 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"math/rand"
+	"time"
+
+	"github.com/teamgram/marmota/pkg/stores/sqlx"
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/sticker/internal/dal/dataobject"
+	"github.com/teamgram/teamgram-server/app/service/sticker/sticker"
+)
+
+// StickerCreateStickerSet
+// sticker.createStickerSet flags:# user_id:long masks:flags.0?true animated:flags.1?true videos:flags.2?true title:string short_name:string thumb:flags.3?Document stickers:Vector<StickerSetDocument> = StickerSetData;
+func (c *StickerCore) StickerCreateStickerSet(in *sticker.TLStickerCreateStickerSet) (*sticker.StickerSetData, error) {
+	if l := len(in.Title); l == 0 || l > sticker.StickerSetTitleMaxLen {
+		err := mtproto.ErrPackTitleInvalid
+		c.Logger.Errorf("sticker.createStickerSet - error: %v", err)
+		return nil, err
+	}
+	if !sticker.CheckShortName(in.ShortName) {
+		err := mtproto.ErrPackShortNameInvalid
+		c.Logger.Errorf("sticker.createStickerSet - error: %v", err)
+		return nil, err
+	}
+	if len(in.Stickers) == 0 {
+		err := mtproto.ErrStickersEmpty
+		c.Logger.Errorf("sticker.createStickerSet - error: %v", err)
+		return nil, err
+	} else if len(in.Stickers) > sticker.StickerSetStickersMax {
+		err := sticker.ErrStickersTooMuch
+		c.Logger.Errorf("sticker.createStickerSet - error: %v", err)
+		return nil, err
+	}
+
+	if do, err := c.svcCtx.Dao.StickerSetsDAO.SelectByShortName(c.ctx, in.ShortName); err != nil {
+		c.Logger.Errorf("sticker.createStickerSet - error: %v", err)
+		return nil, err
+	} else if do != nil {
+		err = mtproto.ErrPackShortNameOccupied
+		c.Logger.Errorf("sticker.createStickerSet - error: %v", err)
+		return nil, err
+	}
+
+	setId := c.svcCtx.Dao.IDGenClient2.NextId(c.ctx)
+	if setId == 0 {
+		err := mtproto.ErrInternelServerError
+		c.Logger.Errorf("sticker.createStickerSet - error: %v", err)
+		return nil, err
+	}
+
+	var (
+		date  = time.Now().Unix()
+		setDO = &dataobject.StickerSetsDO{
+			SetId:      setId,
+			AccessHash: rand.Int63(),
+			CreatorId:  in.UserId,
+			Title:      in.Title,
+			ShortName:  in.ShortName,
+			Masks:      in.Masks,
+			Animated:   in.Animated,
+			Videos:     in.Videos,
+			Count:      int32(len(in.Stickers)),
+			Hash:       sticker.MakeStickerSetHash(sticker.GetDocumentIdList(in.Stickers)),
+			Date2:      date,
+		}
+	)
+	if thumb := in.GetThumb(); thumb != nil {
+		setDO.ThumbDocumentId = thumb.GetId()
+		setDO.ThumbAccessHash = thumb.GetAccessHash()
+		setDO.ThumbSize = thumb.GetSize2()
+		setDO.ThumbVersion = 1
+	}
+
+	tR := sqlx.TxWrapper(c.ctx, c.svcCtx.Dao.DB, func(tx *sqlx.Tx, result *sqlx.StoreResult) {
+		_, _, result.Err = c.svcCtx.Dao.StickerSetsDAO.InsertTx(tx, setDO)
+		if result.Err != nil {
+			return
+		}
+		for i, v := range in.Stickers {
+			_, _, result.Err = c.svcCtx.Dao.StickerSetDocumentsDAO.InsertOrUpdateTx(tx, &dataobject.StickerSetDocumentsDO{
+				SetId:      setId,
+				DocumentId: v.DocumentId,
+				Emoji:      v.Emoji,
+				Position:   int32(i),
+				Date2:      date,
+			})
+			if result.Err != nil {
+				return
+			}
+		}
+		// the creator has the new set installed
+		_, _, result.Err = c.svcCtx.Dao.UserInstalledStickerSetsDAO.InsertOrUpdateTx(tx, &dataobject.UserInstalledStickerSetsDO{
+			UserId:   in.UserId,
+			SetId:    setId,
+			Masks:    in.Masks,
+			Archived: false,
+			Date2:    date,
+		})
+	})
+	if tR.Err != nil {
+		c.Logger.Errorf("sticker.createStickerSet - error: %v", tR.Err)
+		return nil, tR.Err
+	}
+
+	return c.svcCtx.Dao.MakeStickerSetData(c.ctx, in.UserId, setDO)
+}
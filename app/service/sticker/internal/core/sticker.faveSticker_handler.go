@@ -0,0 +1,53 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"time"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/sticker/internal/dal/dataobject"
+	"github.com/teamgram/teamgram-server/app/service/sticker/sticker"
+)
+
+// StickerFaveSticker
+// sticker.faveSticker user_id:long document_id:long unfave:Bool = Bool;
+func (c *StickerCore) StickerFaveSticker(in *sticker.TLStickerFaveSticker) (*mtproto.Bool, error) {
+	if mtproto.FromBool(in.Unfave) {
+		if _, err := c.svcCtx.Dao.UserFavedStickersDAO.Delete(c.ctx, in.UserId, in.DocumentId); err != nil {
+			c.Logger.Errorf("sticker.faveSticker - error: %v", err)
+			return nil, err
+		}
+		return mtproto.BoolTrue, nil
+	}
+
+	// only the stickers of the sticker sets are kept
+	docDO, err := c.svcCtx.Dao.StickerSetDocumentsDAO.SelectByDocumentId(c.ctx, in.DocumentId)
+	if err != nil {
+		c.Logger.Errorf("sticker.faveSticker - error: %v", err)
+		return nil, err
+	} else if docDO == nil {
+		err = mtproto.ErrStickerIdInvalid
+		c.Logger.Errorf("sticker.faveSticker - error: %v", err)
+		return nil, err
+	}
+
+	_, _, err = c.svcCtx.Dao.UserFavedStickersDAO.InsertOrUpdate(c.ctx, &dataobject.UserFavedStickersDO{
+		UserId:     in.UserId,
+		DocumentId: in.DocumentId,
+		Date2:      time.Now().Unix(),
+	})
+	if err != nil {
+		c.Logger.Errorf("sticker.faveSticker - error: %v", err)
+		return nil, err
+	}
+
+	return mtproto.BoolTrue, nil
+}
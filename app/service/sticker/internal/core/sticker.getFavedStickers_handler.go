@@ -0,0 +1,48 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"github.com/teamgram/teamgram-server/app/service/sticker/internal/dal/dataobject"
+	"github.com/teamgram/teamgram-server/app/service/sticker/internal/dao"
+	"github.com/teamgram/teamgram-server/app/service/sticker/sticker"
+)
+
+// StickerGetFavedStickers
+// sticker.getFavedStickers user_id:long = Vector<StickerSetDocument>;
+func (c *StickerCore) StickerGetFavedStickers(in *sticker.TLStickerGetFavedStickers) (*sticker.Vector_StickerSetDocument, error) {
+	var (
+		idList   []int64
+		dateList []int64
+	)
+
+	_, err := c.svcCtx.Dao.UserFavedStickersDAO.SelectListWithCB(
+		c.ctx,
+		in.UserId,
+		dao.FavedStickersLimit,
+		func(i int, v *dataobject.UserFavedStickersDO) {
+			idList = append(idList, v.DocumentId)
+			dateList = append(dateList, v.Date2)
+		})
+	if err != nil {
+		c.Logger.Errorf("sticker.getFavedStickers - error: %v", err)
+		return nil, err
+	}
+
+	documents, err := c.svcCtx.Dao.GetStickerSetDocumentListByIdList(c.ctx, idList, dateList)
+	if err != nil {
+		c.Logger.Errorf("sticker.getFavedStickers - error: %v", err)
+		return nil, err
+	}
+
+	return &sticker.Vector_StickerSetDocument{
+		Datas: documents,
+	}, nil
+}
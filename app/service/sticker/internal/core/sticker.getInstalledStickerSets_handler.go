@@ -0,0 +1,62 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/sticker/internal/dal/dataobject"
+	"github.com/teamgram/teamgram-server/app/service/sticker/internal/dao"
+	"github.com/teamgram/teamgram-server/app/service/sticker/sticker"
+)
+
+// StickerGetInstalledStickerSets
+// sticker.getInstalledStickerSets user_id:long masks:Bool = Vector<StickerSet>;
+func (c *StickerCore) StickerGetInstalledStickerSets(in *sticker.TLStickerGetInstalledStickerSets) (*sticker.Vector_StickerSet, error) {
+	var (
+		setIdList []int64
+		setMap    = make(map[int64]*dataobject.StickerSetsDO)
+		sets      = make([]*mtproto.StickerSet, 0)
+	)
+
+	installedList, err := c.svcCtx.Dao.UserInstalledStickerSetsDAO.SelectInstalledList(c.ctx, in.UserId, mtproto.FromBool(in.Masks))
+	if err != nil {
+		c.Logger.Errorf("sticker.getInstalledStickerSets - error: %v", err)
+		return nil, err
+	} else if len(installedList) == 0 {
+		return &sticker.Vector_StickerSet{
+			Datas: sets,
+		}, nil
+	}
+
+	for _, v := range installedList {
+		setIdList = append(setIdList, v.SetId)
+	}
+	_, err = c.svcCtx.Dao.StickerSetsDAO.SelectListBySetIdListWithCB(
+		c.ctx,
+		setIdList,
+		func(i int, v *dataobject.StickerSetsDO) {
+			setMap[v.SetId] = v
+		})
+	if err != nil {
+		c.Logger.Errorf("sticker.getInstalledStickerSets - error: %v", err)
+		return nil, err
+	}
+
+	// the latest installed sets first
+	for i := range installedList {
+		if setDO, ok := setMap[installedList[i].SetId]; ok {
+			sets = append(sets, dao.MakeStickerSet(setDO, &installedList[i]))
+		}
+	}
+
+	return &sticker.Vector_StickerSet{
+		Datas: sets,
+	}, nil
+}
@@ -0,0 +1,50 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/sticker/internal/dal/dataobject"
+	"github.com/teamgram/teamgram-server/app/service/sticker/internal/dao"
+	"github.com/teamgram/teamgram-server/app/service/sticker/sticker"
+)
+
+// StickerGetRecentStickers
+// sticker.getRecentStickers user_id:long attached:Bool = Vector<StickerSetDocument>;
+func (c *StickerCore) StickerGetRecentStickers(in *sticker.TLStickerGetRecentStickers) (*sticker.Vector_StickerSetDocument, error) {
+	var (
+		idList   []int64
+		dateList []int64
+	)
+
+	_, err := c.svcCtx.Dao.UserRecentStickersDAO.SelectListWithCB(
+		c.ctx,
+		in.UserId,
+		mtproto.FromBool(in.Attached),
+		dao.RecentStickersLimit,
+		func(i int, v *dataobject.UserRecentStickersDO) {
+			idList = append(idList, v.DocumentId)
+			dateList = append(dateList, v.Date2)
+		})
+	if err != nil {
+		c.Logger.Errorf("sticker.getRecentStickers - error: %v", err)
+		return nil, err
+	}
+
+	documents, err := c.svcCtx.Dao.GetStickerSetDocumentListByIdList(c.ctx, idList, dateList)
+	if err != nil {
+		c.Logger.Errorf("sticker.getRecentStickers - error: %v", err)
+		return nil, err
+	}
+
+	return &sticker.Vector_StickerSetDocument{
+		Datas: documents,
+	}, nil
+}
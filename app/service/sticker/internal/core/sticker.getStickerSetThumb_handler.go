@@ -0,0 +1,38 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/sticker/sticker"
+)
+
+// StickerGetStickerSetThumb
+// sticker.getStickerSetThumb stickerset:InputStickerSet thumb_version:int = InputFileLocation;
+func (c *StickerCore) StickerGetStickerSetThumb(in *sticker.TLStickerGetStickerSetThumb) (*mtproto.InputFileLocation, error) {
+	setDO, err := c.svcCtx.Dao.GetStickerSetByInput(c.ctx, in.Stickerset)
+	if err != nil {
+		c.Logger.Errorf("sticker.getStickerSetThumb - error: %v", err)
+		return nil, err
+	}
+	if setDO.ThumbVersion == 0 {
+		err = mtproto.ErrStickerIdInvalid
+		c.Logger.Errorf("sticker.getStickerSetThumb - error: %v", err)
+		return nil, err
+	}
+
+	// the thumb of a set is a document of its own, downloaded as a whole
+	return mtproto.MakeTLInputDocumentFileLocation(&mtproto.InputFileLocation{
+		Id:            setDO.ThumbDocumentId,
+		AccessHash:    setDO.ThumbAccessHash,
+		FileReference: []byte{},
+		ThumbSize:     "",
+	}).To_InputFileLocation(), nil
+}
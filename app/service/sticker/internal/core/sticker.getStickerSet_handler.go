@@ -0,0 +1,26 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"github.com/teamgram/teamgram-server/app/service/sticker/sticker"
+)
+
+// StickerGetStickerSet
+// sticker.getStickerSet user_id:long stickerset:InputStickerSet = StickerSetData;
+func (c *StickerCore) StickerGetStickerSet(in *sticker.TLStickerGetStickerSet) (*sticker.StickerSetData, error) {
+	setDO, err := c.svcCtx.Dao.GetStickerSetByInput(c.ctx, in.Stickerset)
+	if err != nil {
+		c.Logger.Errorf("sticker.getStickerSet - error: %v", err)
+		return nil, err
+	}
+
+	return c.svcCtx.Dao.MakeStickerSetData(c.ctx, in.UserId, setDO)
+}
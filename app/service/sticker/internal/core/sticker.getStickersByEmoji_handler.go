@@ -0,0 +1,76 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"github.com/teamgram/teamgram-server/app/service/sticker/internal/dal/dataobject"
+	"github.com/teamgram/teamgram-server/app/service/sticker/internal/dao"
+	"github.com/teamgram/teamgram-server/app/service/sticker/sticker"
+)
+
+// StickerGetStickersByEmoji
+// sticker.getStickersByEmoji user_id:long emoticon:string = Vector<StickerSetDocument>;
+func (c *StickerCore) StickerGetStickersByEmoji(in *sticker.TLStickerGetStickersByEmoji) (*sticker.Vector_StickerSetDocument, error) {
+	var (
+		setIdList []int64
+		setMap    = make(map[int64]*dataobject.StickerSetsDO)
+		documents = make([]*sticker.StickerSetDocument, 0)
+	)
+
+	// the stickers of the installed sets associated with the emoji
+	_, err := c.svcCtx.Dao.UserInstalledStickerSetsDAO.SelectInstalledListWithCB(
+		c.ctx,
+		in.UserId,
+		false,
+		func(i int, v *dataobject.UserInstalledStickerSetsDO) {
+			setIdList = append(setIdList, v.SetId)
+		})
+	if err != nil {
+		c.Logger.Errorf("sticker.getStickersByEmoji - error: %v", err)
+		return nil, err
+	} else if len(setIdList) == 0 {
+		return &sticker.Vector_StickerSetDocument{
+			Datas: documents,
+		}, nil
+	}
+
+	_, err = c.svcCtx.Dao.StickerSetsDAO.SelectListBySetIdListWithCB(
+		c.ctx,
+		setIdList,
+		func(i int, v *dataobject.StickerSetsDO) {
+			setMap[v.SetId] = v
+		})
+	if err != nil {
+		c.Logger.Errorf("sticker.getStickersByEmoji - error: %v", err)
+		return nil, err
+	}
+
+	_, err = c.svcCtx.Dao.StickerSetDocumentsDAO.SelectListBySetIdListWithCB(
+		c.ctx,
+		setIdList,
+		func(i int, v *dataobject.StickerSetDocumentsDO) {
+			setDO, ok := setMap[v.SetId]
+			if !ok {
+				return
+			}
+			doc := dao.MakeStickerSetDocument(setDO, v, v.Date2)
+			if doc.HasEmoji(in.Emoticon) {
+				documents = append(documents, doc)
+			}
+		})
+	if err != nil {
+		c.Logger.Errorf("sticker.getStickersByEmoji - error: %v", err)
+		return nil, err
+	}
+
+	return &sticker.Vector_StickerSetDocument{
+		Datas: documents,
+	}, nil
+}
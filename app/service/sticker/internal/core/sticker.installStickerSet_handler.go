@@ -0,0 +1,43 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"time"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/sticker/internal/dal/dataobject"
+	"github.com/teamgram/teamgram-server/app/service/sticker/internal/dao"
+	"github.com/teamgram/teamgram-server/app/service/sticker/sticker"
+)
+
+// StickerInstallStickerSet
+// sticker.installStickerSet user_id:long stickerset:InputStickerSet archived:Bool = StickerSet;
+func (c *StickerCore) StickerInstallStickerSet(in *sticker.TLStickerInstallStickerSet) (*mtproto.StickerSet, error) {
+	setDO, err := c.svcCtx.Dao.GetStickerSetByInput(c.ctx, in.Stickerset)
+	if err != nil {
+		c.Logger.Errorf("sticker.installStickerSet - error: %v", err)
+		return nil, err
+	}
+
+	installedDO := &dataobject.UserInstalledStickerSetsDO{
+		UserId:   in.UserId,
+		SetId:    setDO.SetId,
+		Masks:    setDO.Masks,
+		Archived: mtproto.FromBool(in.Archived),
+		Date2:    time.Now().Unix(),
+	}
+	if _, _, err = c.svcCtx.Dao.UserInstalledStickerSetsDAO.InsertOrUpdate(c.ctx, installedDO); err != nil {
+		c.Logger.Errorf("sticker.installStickerSet - error: %v", err)
+		return nil, err
+	}
+
+	return dao.MakeStickerSet(setDO, installedDO), nil
+}
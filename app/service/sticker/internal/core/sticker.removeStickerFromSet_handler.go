@@ -0,0 +1,51 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/sticker/sticker"
+)
+
+// StickerRemoveStickerFromSet
+// sticker.removeStickerFromSet user_id:long document_id:long = StickerSetData;
+func (c *StickerCore) StickerRemoveStickerFromSet(in *sticker.TLStickerRemoveStickerFromSet) (*sticker.StickerSetData, error) {
+	docDO, err := c.svcCtx.Dao.StickerSetDocumentsDAO.SelectByDocumentId(c.ctx, in.DocumentId)
+	if err != nil {
+		c.Logger.Errorf("sticker.removeStickerFromSet - error: %v", err)
+		return nil, err
+	} else if docDO == nil {
+		err = mtproto.ErrStickerInvalid
+		c.Logger.Errorf("sticker.removeStickerFromSet - error: %v", err)
+		return nil, err
+	}
+
+	setDO, err := c.svcCtx.Dao.StickerSetsDAO.SelectBySetId(c.ctx, docDO.SetId)
+	if err != nil {
+		c.Logger.Errorf("sticker.removeStickerFromSet - error: %v", err)
+		return nil, err
+	} else if setDO == nil || setDO.CreatorId != in.UserId {
+		err = mtproto.ErrStickersetInvalid
+		c.Logger.Errorf("sticker.removeStickerFromSet - error: %v", err)
+		return nil, err
+	}
+
+	if _, err = c.svcCtx.Dao.StickerSetDocumentsDAO.Delete(c.ctx, setDO.SetId, in.DocumentId); err != nil {
+		c.Logger.Errorf("sticker.removeStickerFromSet - error: %v", err)
+		return nil, err
+	}
+
+	if err = c.svcCtx.Dao.UpdateStickerSetCount(c.ctx, setDO); err != nil {
+		c.Logger.Errorf("sticker.removeStickerFromSet - error: %v", err)
+		return nil, err
+	}
+
+	return c.svcCtx.Dao.MakeStickerSetData(c.ctx, in.UserId, setDO)
+}
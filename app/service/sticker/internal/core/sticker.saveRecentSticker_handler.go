@@ -0,0 +1,54 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"time"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/sticker/internal/dal/dataobject"
+	"github.com/teamgram/teamgram-server/app/service/sticker/sticker"
+)
+
+// StickerSaveRecentSticker
+// sticker.saveRecentSticker user_id:long attached:Bool document_id:long unsave:Bool = Bool;
+func (c *StickerCore) StickerSaveRecentSticker(in *sticker.TLStickerSaveRecentSticker) (*mtproto.Bool, error) {
+	if mtproto.FromBool(in.Unsave) {
+		if _, err := c.svcCtx.Dao.UserRecentStickersDAO.Delete(c.ctx, in.UserId, mtproto.FromBool(in.Attached), in.DocumentId); err != nil {
+			c.Logger.Errorf("sticker.saveRecentSticker - error: %v", err)
+			return nil, err
+		}
+		return mtproto.BoolTrue, nil
+	}
+
+	// only the stickers of the sticker sets are kept
+	docDO, err := c.svcCtx.Dao.StickerSetDocumentsDAO.SelectByDocumentId(c.ctx, in.DocumentId)
+	if err != nil {
+		c.Logger.Errorf("sticker.saveRecentSticker - error: %v", err)
+		return nil, err
+	} else if docDO == nil {
+		err = mtproto.ErrStickerIdInvalid
+		c.Logger.Errorf("sticker.saveRecentSticker - error: %v", err)
+		return nil, err
+	}
+
+	_, _, err = c.svcCtx.Dao.UserRecentStickersDAO.InsertOrUpdate(c.ctx, &dataobject.UserRecentStickersDO{
+		UserId:     in.UserId,
+		Attached:   mtproto.FromBool(in.Attached),
+		DocumentId: in.DocumentId,
+		Date2:      time.Now().Unix(),
+	})
+	if err != nil {
+		c.Logger.Errorf("sticker.saveRecentSticker - error: %v", err)
+		return nil, err
+	}
+
+	return mtproto.BoolTrue, nil
+}
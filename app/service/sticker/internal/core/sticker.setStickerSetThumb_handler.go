@@ -0,0 +1,48 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/sticker/sticker"
+)
+
+// StickerSetStickerSetThumb
+// sticker.setStickerSetThumb user_id:long stickerset:InputStickerSet thumb:Document = StickerSetData;
+func (c *StickerCore) StickerSetStickerSetThumb(in *sticker.TLStickerSetStickerSetThumb) (*sticker.StickerSetData, error) {
+	setDO, err := c.svcCtx.Dao.GetStickerSetByInput(c.ctx, in.Stickerset)
+	if err != nil {
+		c.Logger.Errorf("sticker.setStickerSetThumb - error: %v", err)
+		return nil, err
+	} else if setDO.CreatorId != in.UserId {
+		err = mtproto.ErrStickersetInvalid
+		c.Logger.Errorf("sticker.setStickerSetThumb - error: %v", err)
+		return nil, err
+	}
+
+	// a new version makes the clients download the thumb again
+	setDO.ThumbDocumentId = in.GetThumb().GetId()
+	setDO.ThumbAccessHash = in.GetThumb().GetAccessHash()
+	setDO.ThumbSize = in.GetThumb().GetSize2()
+	setDO.ThumbVersion++
+	_, err = c.svcCtx.Dao.StickerSetsDAO.UpdateThumb(
+		c.ctx,
+		setDO.ThumbDocumentId,
+		setDO.ThumbAccessHash,
+		setDO.ThumbSize,
+		setDO.ThumbVersion,
+		setDO.SetId)
+	if err != nil {
+		c.Logger.Errorf("sticker.setStickerSetThumb - error: %v", err)
+		return nil, err
+	}
+
+	return c.svcCtx.Dao.MakeStickerSetData(c.ctx, in.UserId, setDO)
+}
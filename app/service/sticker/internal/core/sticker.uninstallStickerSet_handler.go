@@ -0,0 +1,32 @@
+/*
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package core
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/sticker/sticker"
+)
+
+// StickerUninstallStickerSet
+// sticker.uninstallStickerSet user_id:long stickerset:InputStickerSet = Bool;
+func (c *StickerCore) StickerUninstallStickerSet(in *sticker.TLStickerUninstallStickerSet) (*mtproto.Bool, error) {
+	setDO, err := c.svcCtx.Dao.GetStickerSetByInput(c.ctx, in.Stickerset)
+	if err != nil {
+		c.Logger.Errorf("sticker.uninstallStickerSet - error: %v", err)
+		return nil, err
+	}
+
+	if _, err = c.svcCtx.Dao.UserInstalledStickerSetsDAO.Delete(c.ctx, in.UserId, setDO.SetId); err != nil {
+		c.Logger.Errorf("sticker.uninstallStickerSet - error: %v", err)
+		return nil, err
+	}
+
+	return mtproto.BoolTrue, nil
+}
@@ -0,0 +1,5 @@
+./dalgen.sh sticker_sets
+./dalgen.sh sticker_set_documents
+./dalgen.sh user_installed_sticker_sets
+./dalgen.sh user_recent_stickers
+./dalgen.sh user_faved_stickers
@@ -0,0 +1,393 @@
+/*
+ * WARNING! All changes made in this file will be lost!
+ *   Created from by 'dalgen'
+ *
+ * Copyright (c) 2022-present,  Teamgram Authors.
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package mysql_dao
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/teamgram/marmota/pkg/stores/sqlx"
+	"github.com/teamgram/teamgram-server/app/service/sticker/internal/dal/dataobject"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+var _ *sql.Result
+
+type StickerSetDocumentsDAO struct {
+	db *sqlx.DB
+}
+
+func NewStickerSetDocumentsDAO(db *sqlx.DB) *StickerSetDocumentsDAO {
+	return &StickerSetDocumentsDAO{db}
+}
+
+// InsertOrUpdate
+// insert into sticker_set_documents(set_id, document_id, emoji, position, date2) values (:set_id, :document_id, :emoji, :position, :date2) on duplicate key update emoji = values(emoji), position = values(position), date2 = values(date2), deleted = 0
+// TODO(@benqi): sqlmap
+func (dao *StickerSetDocumentsDAO) InsertOrUpdate(ctx context.Context, do *dataobject.StickerSetDocumentsDO) (lastInsertId, rowsAffected int64, err error) {
+	var (
+		query = "insert into sticker_set_documents(set_id, document_id, emoji, position, date2) values (:set_id, :document_id, :emoji, :position, :date2) on duplicate key update emoji = values(emoji), position = values(position), date2 = values(date2), deleted = 0"
+		r     sql.Result
+	)
+
+	r, err = dao.db.NamedExec(ctx, query, do)
+	if err != nil {
+		logx.WithContext(ctx).Errorf("namedExec in InsertOrUpdate(%v), error: %v", do, err)
+		return
+	}
+
+	lastInsertId, err = r.LastInsertId()
+	if err != nil {
+		logx.WithContext(ctx).Errorf("lastInsertId in InsertOrUpdate(%v)_error: %v", do, err)
+		return
+	}
+	rowsAffected, err = r.RowsAffected()
+	if err != nil {
+		logx.WithContext(ctx).Errorf("rowsAffected in InsertOrUpdate(%v)_error: %v", do, err)
+	}
+
+	return
+}
+
+// InsertOrUpdateTx
+// insert into sticker_set_documents(set_id, document_id, emoji, position, date2) values (:set_id, :document_id, :emoji, :position, :date2) on duplicate key update emoji = values(emoji), position = values(position), date2 = values(date2), deleted = 0
+// TODO(@benqi): sqlmap
+func (dao *StickerSetDocumentsDAO) InsertOrUpdateTx(tx *sqlx.Tx, do *dataobject.StickerSetDocumentsDO) (lastInsertId, rowsAffected int64, err error) {
+	var (
+		query = "insert into sticker_set_documents(set_id, document_id, emoji, position, date2) values (:set_id, :document_id, :emoji, :position, :date2) on duplicate key update emoji = values(emoji), position = values(position), date2 = values(date2), deleted = 0"
+		r     sql.Result
+	)
+
+	r, err = tx.NamedExec(query, do)
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("namedExec in InsertOrUpdate(%v), error: %v", do, err)
+		return
+	}
+
+	lastInsertId, err = r.LastInsertId()
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("lastInsertId in InsertOrUpdate(%v)_error: %v", do, err)
+		return
+	}
+	rowsAffected, err = r.RowsAffected()
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("rowsAffected in InsertOrUpdate(%v)_error: %v", do, err)
+	}
+
+	return
+}
+
+// SelectListBySetId
+// select id, set_id, document_id, emoji, position, date2 from sticker_set_documents where set_id = :set_id and deleted = 0 order by position asc
+// TODO(@benqi): sqlmap
+func (dao *StickerSetDocumentsDAO) SelectListBySetId(ctx context.Context, set_id int64) (rList []dataobject.StickerSetDocumentsDO, err error) {
+	var (
+		query  = "select id, set_id, document_id, emoji, position, date2 from sticker_set_documents where set_id = ? and deleted = 0 order by position asc"
+		values []dataobject.StickerSetDocumentsDO
+	)
+	err = dao.db.QueryRowsPartial(ctx, &values, query, set_id)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("queryx in SelectListBySetId(_), error: %v", err)
+		return
+	}
+
+	rList = values
+
+	return
+}
+
+// SelectListBySetIdWithCB
+// select id, set_id, document_id, emoji, position, date2 from sticker_set_documents where set_id = :set_id and deleted = 0 order by position asc
+// TODO(@benqi): sqlmap
+func (dao *StickerSetDocumentsDAO) SelectListBySetIdWithCB(ctx context.Context, set_id int64, cb func(i int, v *dataobject.StickerSetDocumentsDO)) (rList []dataobject.StickerSetDocumentsDO, err error) {
+	var (
+		query  = "select id, set_id, document_id, emoji, position, date2 from sticker_set_documents where set_id = ? and deleted = 0 order by position asc"
+		values []dataobject.StickerSetDocumentsDO
+	)
+	err = dao.db.QueryRowsPartial(ctx, &values, query, set_id)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("queryx in SelectListBySetId(_), error: %v", err)
+		return
+	}
+
+	rList = values
+
+	if cb != nil {
+		for i := 0; i < len(rList); i++ {
+			cb(i, &rList[i])
+		}
+	}
+
+	return
+}
+
+// SelectListBySetIdList
+// select id, set_id, document_id, emoji, position, date2 from sticker_set_documents where set_id in (:idList) and deleted = 0 order by position asc
+// TODO(@benqi): sqlmap
+func (dao *StickerSetDocumentsDAO) SelectListBySetIdList(ctx context.Context, idList []int64) (rList []dataobject.StickerSetDocumentsDO, err error) {
+	var (
+		query  = "select id, set_id, document_id, emoji, position, date2 from sticker_set_documents where set_id in (?) and deleted = 0 order by position asc"
+		a      []interface{}
+		values []dataobject.StickerSetDocumentsDO
+	)
+
+	if len(idList) == 0 {
+		rList = []dataobject.StickerSetDocumentsDO{}
+		return
+	}
+
+	query, a, err = sqlx.In(query, idList)
+	if err != nil {
+		// r sql.Result
+		logx.WithContext(ctx).Errorf("sqlx.In in SelectListBySetIdList(_), error: %v", err)
+		return
+	}
+	err = dao.db.QueryRowsPartial(ctx, &values, query, a...)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("queryx in SelectListBySetIdList(_), error: %v", err)
+		return
+	}
+
+	rList = values
+
+	return
+}
+
+// SelectListBySetIdListWithCB
+// select id, set_id, document_id, emoji, position, date2 from sticker_set_documents where set_id in (:idList) and deleted = 0 order by position asc
+// TODO(@benqi): sqlmap
+func (dao *StickerSetDocumentsDAO) SelectListBySetIdListWithCB(ctx context.Context, idList []int64, cb func(i int, v *dataobject.StickerSetDocumentsDO)) (rList []dataobject.StickerSetDocumentsDO, err error) {
+	var (
+		query  = "select id, set_id, document_id, emoji, position, date2 from sticker_set_documents where set_id in (?) and deleted = 0 order by position asc"
+		a      []interface{}
+		values []dataobject.StickerSetDocumentsDO
+	)
+
+	if len(idList) == 0 {
+		rList = []dataobject.StickerSetDocumentsDO{}
+		return
+	}
+
+	query, a, err = sqlx.In(query, idList)
+	if err != nil {
+		// r sql.Result
+		logx.WithContext(ctx).Errorf("sqlx.In in SelectListBySetIdList(_), error: %v", err)
+		return
+	}
+	err = dao.db.QueryRowsPartial(ctx, &values, query, a...)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("queryx in SelectListBySetIdList(_), error: %v", err)
+		return
+	}
+
+	rList = values
+
+	if cb != nil {
+		for i := 0; i < len(rList); i++ {
+			cb(i, &rList[i])
+		}
+	}
+
+	return
+}
+
+// SelectByDocumentId
+// select id, set_id, document_id, emoji, position, date2 from sticker_set_documents where document_id = :document_id and deleted = 0 limit 1
+// TODO(@benqi): sqlmap
+func (dao *StickerSetDocumentsDAO) SelectByDocumentId(ctx context.Context, document_id int64) (rValue *dataobject.StickerSetDocumentsDO, err error) {
+	var (
+		query = "select id, set_id, document_id, emoji, position, date2 from sticker_set_documents where document_id = ? and deleted = 0 limit 1"
+		do    = &dataobject.StickerSetDocumentsDO{}
+	)
+	err = dao.db.QueryRowPartial(ctx, do, query, document_id)
+
+	if err != nil {
+		if err != sqlx.ErrNotFound {
+			logx.WithContext(ctx).Errorf("queryx in SelectByDocumentId(_), error: %v", err)
+			return
+		} else {
+			err = nil
+		}
+	} else {
+		rValue = do
+	}
+
+	return
+}
+
+// SelectListByDocumentIdList
+// select id, set_id, document_id, emoji, position, date2 from sticker_set_documents where document_id in (:idList) and deleted = 0
+// TODO(@benqi): sqlmap
+func (dao *StickerSetDocumentsDAO) SelectListByDocumentIdList(ctx context.Context, idList []int64) (rList []dataobject.StickerSetDocumentsDO, err error) {
+	var (
+		query  = "select id, set_id, document_id, emoji, position, date2 from sticker_set_documents where document_id in (?) and deleted = 0"
+		a      []interface{}
+		values []dataobject.StickerSetDocumentsDO
+	)
+
+	if len(idList) == 0 {
+		rList = []dataobject.StickerSetDocumentsDO{}
+		return
+	}
+
+	query, a, err = sqlx.In(query, idList)
+	if err != nil {
+		// r sql.Result
+		logx.WithContext(ctx).Errorf("sqlx.In in SelectListByDocumentIdList(_), error: %v", err)
+		return
+	}
+	err = dao.db.QueryRowsPartial(ctx, &values, query, a...)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("queryx in SelectListByDocumentIdList(_), error: %v", err)
+		return
+	}
+
+	rList = values
+
+	return
+}
+
+// SelectListByDocumentIdListWithCB
+// select id, set_id, document_id, emoji, position, date2 from sticker_set_documents where document_id in (:idList) and deleted = 0
+// TODO(@benqi): sqlmap
+func (dao *StickerSetDocumentsDAO) SelectListByDocumentIdListWithCB(ctx context.Context, idList []int64, cb func(i int, v *dataobject.StickerSetDocumentsDO)) (rList []dataobject.StickerSetDocumentsDO, err error) {
+	var (
+		query  = "select id, set_id, document_id, emoji, position, date2 from sticker_set_documents where document_id in (?) and deleted = 0"
+		a      []interface{}
+		values []dataobject.StickerSetDocumentsDO
+	)
+
+	if len(idList) == 0 {
+		rList = []dataobject.StickerSetDocumentsDO{}
+		return
+	}
+
+	query, a, err = sqlx.In(query, idList)
+	if err != nil {
+		// r sql.Result
+		logx.WithContext(ctx).Errorf("sqlx.In in SelectListByDocumentIdList(_), error: %v", err)
+		return
+	}
+	err = dao.db.QueryRowsPartial(ctx, &values, query, a...)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("queryx in SelectListByDocumentIdList(_), error: %v", err)
+		return
+	}
+
+	rList = values
+
+	if cb != nil {
+		for i := 0; i < len(rList); i++ {
+			cb(i, &rList[i])
+		}
+	}
+
+	return
+}
+
+// Delete
+// update sticker_set_documents set deleted = 1 where set_id = :set_id and document_id = :document_id
+// TODO(@benqi): sqlmap
+func (dao *StickerSetDocumentsDAO) Delete(ctx context.Context, set_id int64, document_id int64) (rowsAffected int64, err error) {
+	var (
+		query   = "update sticker_set_documents set deleted = 1 where set_id = ? and document_id = ?"
+		rResult sql.Result
+	)
+	rResult, err = dao.db.Exec(ctx, query, set_id, document_id)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("exec in Delete(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(ctx).Errorf("rowsAffected in Delete(_), error: %v", err)
+	}
+
+	return
+}
+
+// update sticker_set_documents set deleted = 1 where set_id = :set_id and document_id = :document_id
+// DeleteTx
+// TODO(@benqi): sqlmap
+func (dao *StickerSetDocumentsDAO) DeleteTx(tx *sqlx.Tx, set_id int64, document_id int64) (rowsAffected int64, err error) {
+	var (
+		query   = "update sticker_set_documents set deleted = 1 where set_id = ? and document_id = ?"
+		rResult sql.Result
+	)
+	rResult, err = tx.Exec(query, set_id, document_id)
+
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("exec in Delete(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("rowsAffected in Delete(_), error: %v", err)
+	}
+
+	return
+}
+
+// UpdatePosition
+// update sticker_set_documents set position = :position where set_id = :set_id and document_id = :document_id
+// TODO(@benqi): sqlmap
+func (dao *StickerSetDocumentsDAO) UpdatePosition(ctx context.Context, position int32, set_id int64, document_id int64) (rowsAffected int64, err error) {
+	var (
+		query   = "update sticker_set_documents set position = ? where set_id = ? and document_id = ?"
+		rResult sql.Result
+	)
+	rResult, err = dao.db.Exec(ctx, query, position, set_id, document_id)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("exec in UpdatePosition(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(ctx).Errorf("rowsAffected in UpdatePosition(_), error: %v", err)
+	}
+
+	return
+}
+
+// update sticker_set_documents set position = :position where set_id = :set_id and document_id = :document_id
+// UpdatePositionTx
+// TODO(@benqi): sqlmap
+func (dao *StickerSetDocumentsDAO) UpdatePositionTx(tx *sqlx.Tx, position int32, set_id int64, document_id int64) (rowsAffected int64, err error) {
+	var (
+		query   = "update sticker_set_documents set position = ? where set_id = ? and document_id = ?"
+		rResult sql.Result
+	)
+	rResult, err = tx.Exec(query, position, set_id, document_id)
+
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("exec in UpdatePosition(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("rowsAffected in UpdatePosition(_), error: %v", err)
+	}
+
+	return
+}
@@ -0,0 +1,299 @@
+/*
+ * WARNING! All changes made in this file will be lost!
+ *   Created from by 'dalgen'
+ *
+ * Copyright (c) 2022-present,  Teamgram Authors.
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package mysql_dao
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/teamgram/marmota/pkg/stores/sqlx"
+	"github.com/teamgram/teamgram-server/app/service/sticker/internal/dal/dataobject"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+var _ *sql.Result
+
+type StickerSetsDAO struct {
+	db *sqlx.DB
+}
+
+func NewStickerSetsDAO(db *sqlx.DB) *StickerSetsDAO {
+	return &StickerSetsDAO{db}
+}
+
+// Insert
+// insert into sticker_sets(set_id, access_hash, creator_id, title, short_name, masks, animated, videos, thumb_document_id, thumb_access_hash, thumb_size, thumb_version, count, hash, date2) values (:set_id, :access_hash, :creator_id, :title, :short_name, :masks, :animated, :videos, :thumb_document_id, :thumb_access_hash, :thumb_size, :thumb_version, :count, :hash, :date2)
+// TODO(@benqi): sqlmap
+func (dao *StickerSetsDAO) Insert(ctx context.Context, do *dataobject.StickerSetsDO) (lastInsertId, rowsAffected int64, err error) {
+	var (
+		query = "insert into sticker_sets(set_id, access_hash, creator_id, title, short_name, masks, animated, videos, thumb_document_id, thumb_access_hash, thumb_size, thumb_version, count, hash, date2) values (:set_id, :access_hash, :creator_id, :title, :short_name, :masks, :animated, :videos, :thumb_document_id, :thumb_access_hash, :thumb_size, :thumb_version, :count, :hash, :date2)"
+		r     sql.Result
+	)
+
+	r, err = dao.db.NamedExec(ctx, query, do)
+	if err != nil {
+		logx.WithContext(ctx).Errorf("namedExec in Insert(%v), error: %v", do, err)
+		return
+	}
+
+	lastInsertId, err = r.LastInsertId()
+	if err != nil {
+		logx.WithContext(ctx).Errorf("lastInsertId in Insert(%v)_error: %v", do, err)
+		return
+	}
+	rowsAffected, err = r.RowsAffected()
+	if err != nil {
+		logx.WithContext(ctx).Errorf("rowsAffected in Insert(%v)_error: %v", do, err)
+	}
+
+	return
+}
+
+// InsertTx
+// insert into sticker_sets(set_id, access_hash, creator_id, title, short_name, masks, animated, videos, thumb_document_id, thumb_access_hash, thumb_size, thumb_version, count, hash, date2) values (:set_id, :access_hash, :creator_id, :title, :short_name, :masks, :animated, :videos, :thumb_document_id, :thumb_access_hash, :thumb_size, :thumb_version, :count, :hash, :date2)
+// TODO(@benqi): sqlmap
+func (dao *StickerSetsDAO) InsertTx(tx *sqlx.Tx, do *dataobject.StickerSetsDO) (lastInsertId, rowsAffected int64, err error) {
+	var (
+		query = "insert into sticker_sets(set_id, access_hash, creator_id, title, short_name, masks, animated, videos, thumb_document_id, thumb_access_hash, thumb_size, thumb_version, count, hash, date2) values (:set_id, :access_hash, :creator_id, :title, :short_name, :masks, :animated, :videos, :thumb_document_id, :thumb_access_hash, :thumb_size, :thumb_version, :count, :hash, :date2)"
+		r     sql.Result
+	)
+
+	r, err = tx.NamedExec(query, do)
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("namedExec in Insert(%v), error: %v", do, err)
+		return
+	}
+
+	lastInsertId, err = r.LastInsertId()
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("lastInsertId in Insert(%v)_error: %v", do, err)
+		return
+	}
+	rowsAffected, err = r.RowsAffected()
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("rowsAffected in Insert(%v)_error: %v", do, err)
+	}
+
+	return
+}
+
+// SelectBySetId
+// select id, set_id, access_hash, creator_id, title, short_name, masks, animated, videos, thumb_document_id, thumb_access_hash, thumb_size, thumb_version, count, hash, date2 from sticker_sets where set_id = :set_id and deleted = 0
+// TODO(@benqi): sqlmap
+func (dao *StickerSetsDAO) SelectBySetId(ctx context.Context, set_id int64) (rValue *dataobject.StickerSetsDO, err error) {
+	var (
+		query = "select id, set_id, access_hash, creator_id, title, short_name, masks, animated, videos, thumb_document_id, thumb_access_hash, thumb_size, thumb_version, count, hash, date2 from sticker_sets where set_id = ? and deleted = 0"
+		do    = &dataobject.StickerSetsDO{}
+	)
+	err = dao.db.QueryRowPartial(ctx, do, query, set_id)
+
+	if err != nil {
+		if err != sqlx.ErrNotFound {
+			logx.WithContext(ctx).Errorf("queryx in SelectBySetId(_), error: %v", err)
+			return
+		} else {
+			err = nil
+		}
+	} else {
+		rValue = do
+	}
+
+	return
+}
+
+// SelectByShortName
+// select id, set_id, access_hash, creator_id, title, short_name, masks, animated, videos, thumb_document_id, thumb_access_hash, thumb_size, thumb_version, count, hash, date2 from sticker_sets where short_name = :short_name and deleted = 0
+// TODO(@benqi): sqlmap
+func (dao *StickerSetsDAO) SelectByShortName(ctx context.Context, short_name string) (rValue *dataobject.StickerSetsDO, err error) {
+	var (
+		query = "select id, set_id, access_hash, creator_id, title, short_name, masks, animated, videos, thumb_document_id, thumb_access_hash, thumb_size, thumb_version, count, hash, date2 from sticker_sets where short_name = ? and deleted = 0"
+		do    = &dataobject.StickerSetsDO{}
+	)
+	err = dao.db.QueryRowPartial(ctx, do, query, short_name)
+
+	if err != nil {
+		if err != sqlx.ErrNotFound {
+			logx.WithContext(ctx).Errorf("queryx in SelectByShortName(_), error: %v", err)
+			return
+		} else {
+			err = nil
+		}
+	} else {
+		rValue = do
+	}
+
+	return
+}
+
+// SelectListBySetIdList
+// select id, set_id, access_hash, creator_id, title, short_name, masks, animated, videos, thumb_document_id, thumb_access_hash, thumb_size, thumb_version, count, hash, date2 from sticker_sets where set_id in (:idList) and deleted = 0
+// TODO(@benqi): sqlmap
+func (dao *StickerSetsDAO) SelectListBySetIdList(ctx context.Context, idList []int64) (rList []dataobject.StickerSetsDO, err error) {
+	var (
+		query  = "select id, set_id, access_hash, creator_id, title, short_name, masks, animated, videos, thumb_document_id, thumb_access_hash, thumb_size, thumb_version, count, hash, date2 from sticker_sets where set_id in (?) and deleted = 0"
+		a      []interface{}
+		values []dataobject.StickerSetsDO
+	)
+
+	if len(idList) == 0 {
+		rList = []dataobject.StickerSetsDO{}
+		return
+	}
+
+	query, a, err = sqlx.In(query, idList)
+	if err != nil {
+		// r sql.Result
+		logx.WithContext(ctx).Errorf("sqlx.In in SelectListBySetIdList(_), error: %v", err)
+		return
+	}
+	err = dao.db.QueryRowsPartial(ctx, &values, query, a...)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("queryx in SelectListBySetIdList(_), error: %v", err)
+		return
+	}
+
+	rList = values
+
+	return
+}
+
+// SelectListBySetIdListWithCB
+// select id, set_id, access_hash, creator_id, title, short_name, masks, animated, videos, thumb_document_id, thumb_access_hash, thumb_size, thumb_version, count, hash, date2 from sticker_sets where set_id in (:idList) and deleted = 0
+// TODO(@benqi): sqlmap
+func (dao *StickerSetsDAO) SelectListBySetIdListWithCB(ctx context.Context, idList []int64, cb func(i int, v *dataobject.StickerSetsDO)) (rList []dataobject.StickerSetsDO, err error) {
+	var (
+		query  = "select id, set_id, access_hash, creator_id, title, short_name, masks, animated, videos, thumb_document_id, thumb_access_hash, thumb_size, thumb_version, count, hash, date2 from sticker_sets where set_id in (?) and deleted = 0"
+		a      []interface{}
+		values []dataobject.StickerSetsDO
+	)
+
+	if len(idList) == 0 {
+		rList = []dataobject.StickerSetsDO{}
+		return
+	}
+
+	query, a, err = sqlx.In(query, idList)
+	if err != nil {
+		// r sql.Result
+		logx.WithContext(ctx).Errorf("sqlx.In in SelectListBySetIdList(_), error: %v", err)
+		return
+	}
+	err = dao.db.QueryRowsPartial(ctx, &values, query, a...)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("queryx in SelectListBySetIdList(_), error: %v", err)
+		return
+	}
+
+	rList = values
+
+	if cb != nil {
+		for i := 0; i < len(rList); i++ {
+			cb(i, &rList[i])
+		}
+	}
+
+	return
+}
+
+// UpdateCount
+// update sticker_sets set count = :count, hash = :hash where set_id = :set_id
+// TODO(@benqi): sqlmap
+func (dao *StickerSetsDAO) UpdateCount(ctx context.Context, count int32, hash int32, set_id int64) (rowsAffected int64, err error) {
+	var (
+		query   = "update sticker_sets set count = ?, hash = ? where set_id = ?"
+		rResult sql.Result
+	)
+	rResult, err = dao.db.Exec(ctx, query, count, hash, set_id)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("exec in UpdateCount(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(ctx).Errorf("rowsAffected in UpdateCount(_), error: %v", err)
+	}
+
+	return
+}
+
+// update sticker_sets set count = :count, hash = :hash where set_id = :set_id
+// UpdateCountTx
+// TODO(@benqi): sqlmap
+func (dao *StickerSetsDAO) UpdateCountTx(tx *sqlx.Tx, count int32, hash int32, set_id int64) (rowsAffected int64, err error) {
+	var (
+		query   = "update sticker_sets set count = ?, hash = ? where set_id = ?"
+		rResult sql.Result
+	)
+	rResult, err = tx.Exec(query, count, hash, set_id)
+
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("exec in UpdateCount(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("rowsAffected in UpdateCount(_), error: %v", err)
+	}
+
+	return
+}
+
+// UpdateThumb
+// update sticker_sets set thumb_document_id = :thumb_document_id, thumb_access_hash = :thumb_access_hash, thumb_size = :thumb_size, thumb_version = :thumb_version where set_id = :set_id
+// TODO(@benqi): sqlmap
+func (dao *StickerSetsDAO) UpdateThumb(ctx context.Context, thumb_document_id int64, thumb_access_hash int64, thumb_size int32, thumb_version int32, set_id int64) (rowsAffected int64, err error) {
+	var (
+		query   = "update sticker_sets set thumb_document_id = ?, thumb_access_hash = ?, thumb_size = ?, thumb_version = ? where set_id = ?"
+		rResult sql.Result
+	)
+	rResult, err = dao.db.Exec(ctx, query, thumb_document_id, thumb_access_hash, thumb_size, thumb_version, set_id)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("exec in UpdateThumb(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(ctx).Errorf("rowsAffected in UpdateThumb(_), error: %v", err)
+	}
+
+	return
+}
+
+// update sticker_sets set thumb_document_id = :thumb_document_id, thumb_access_hash = :thumb_access_hash, thumb_size = :thumb_size, thumb_version = :thumb_version where set_id = :set_id
+// UpdateThumbTx
+// TODO(@benqi): sqlmap
+func (dao *StickerSetsDAO) UpdateThumbTx(tx *sqlx.Tx, thumb_document_id int64, thumb_access_hash int64, thumb_size int32, thumb_version int32, set_id int64) (rowsAffected int64, err error) {
+	var (
+		query   = "update sticker_sets set thumb_document_id = ?, thumb_access_hash = ?, thumb_size = ?, thumb_version = ? where set_id = ?"
+		rResult sql.Result
+	)
+	rResult, err = tx.Exec(query, thumb_document_id, thumb_access_hash, thumb_size, thumb_version, set_id)
+
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("exec in UpdateThumb(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("rowsAffected in UpdateThumb(_), error: %v", err)
+	}
+
+	return
+}
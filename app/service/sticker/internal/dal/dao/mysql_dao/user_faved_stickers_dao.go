@@ -0,0 +1,179 @@
+/*
+ * WARNING! All changes made in this file will be lost!
+ *   Created from by 'dalgen'
+ *
+ * Copyright (c) 2022-present,  Teamgram Authors.
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package mysql_dao
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/teamgram/marmota/pkg/stores/sqlx"
+	"github.com/teamgram/teamgram-server/app/service/sticker/internal/dal/dataobject"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+var _ *sql.Result
+
+type UserFavedStickersDAO struct {
+	db *sqlx.DB
+}
+
+func NewUserFavedStickersDAO(db *sqlx.DB) *UserFavedStickersDAO {
+	return &UserFavedStickersDAO{db}
+}
+
+// InsertOrUpdate
+// insert into user_faved_stickers(user_id, document_id, date2) values (:user_id, :document_id, :date2) on duplicate key update date2 = values(date2)
+// TODO(@benqi): sqlmap
+func (dao *UserFavedStickersDAO) InsertOrUpdate(ctx context.Context, do *dataobject.UserFavedStickersDO) (lastInsertId, rowsAffected int64, err error) {
+	var (
+		query = "insert into user_faved_stickers(user_id, document_id, date2) values (:user_id, :document_id, :date2) on duplicate key update date2 = values(date2)"
+		r     sql.Result
+	)
+
+	r, err = dao.db.NamedExec(ctx, query, do)
+	if err != nil {
+		logx.WithContext(ctx).Errorf("namedExec in InsertOrUpdate(%v), error: %v", do, err)
+		return
+	}
+
+	lastInsertId, err = r.LastInsertId()
+	if err != nil {
+		logx.WithContext(ctx).Errorf("lastInsertId in InsertOrUpdate(%v)_error: %v", do, err)
+		return
+	}
+	rowsAffected, err = r.RowsAffected()
+	if err != nil {
+		logx.WithContext(ctx).Errorf("rowsAffected in InsertOrUpdate(%v)_error: %v", do, err)
+	}
+
+	return
+}
+
+// InsertOrUpdateTx
+// insert into user_faved_stickers(user_id, document_id, date2) values (:user_id, :document_id, :date2) on duplicate key update date2 = values(date2)
+// TODO(@benqi): sqlmap
+func (dao *UserFavedStickersDAO) InsertOrUpdateTx(tx *sqlx.Tx, do *dataobject.UserFavedStickersDO) (lastInsertId, rowsAffected int64, err error) {
+	var (
+		query = "insert into user_faved_stickers(user_id, document_id, date2) values (:user_id, :document_id, :date2) on duplicate key update date2 = values(date2)"
+		r     sql.Result
+	)
+
+	r, err = tx.NamedExec(query, do)
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("namedExec in InsertOrUpdate(%v), error: %v", do, err)
+		return
+	}
+
+	lastInsertId, err = r.LastInsertId()
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("lastInsertId in InsertOrUpdate(%v)_error: %v", do, err)
+		return
+	}
+	rowsAffected, err = r.RowsAffected()
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("rowsAffected in InsertOrUpdate(%v)_error: %v", do, err)
+	}
+
+	return
+}
+
+// SelectList
+// select id, user_id, document_id, date2 from user_faved_stickers where user_id = :user_id order by date2 desc limit :limit
+// TODO(@benqi): sqlmap
+func (dao *UserFavedStickersDAO) SelectList(ctx context.Context, user_id int64, limit int32) (rList []dataobject.UserFavedStickersDO, err error) {
+	var (
+		query  = "select id, user_id, document_id, date2 from user_faved_stickers where user_id = ? order by date2 desc limit ?"
+		values []dataobject.UserFavedStickersDO
+	)
+	err = dao.db.QueryRowsPartial(ctx, &values, query, user_id, limit)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("queryx in SelectList(_), error: %v", err)
+		return
+	}
+
+	rList = values
+
+	return
+}
+
+// SelectListWithCB
+// select id, user_id, document_id, date2 from user_faved_stickers where user_id = :user_id order by date2 desc limit :limit
+// TODO(@benqi): sqlmap
+func (dao *UserFavedStickersDAO) SelectListWithCB(ctx context.Context, user_id int64, limit int32, cb func(i int, v *dataobject.UserFavedStickersDO)) (rList []dataobject.UserFavedStickersDO, err error) {
+	var (
+		query  = "select id, user_id, document_id, date2 from user_faved_stickers where user_id = ? order by date2 desc limit ?"
+		values []dataobject.UserFavedStickersDO
+	)
+	err = dao.db.QueryRowsPartial(ctx, &values, query, user_id, limit)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("queryx in SelectList(_), error: %v", err)
+		return
+	}
+
+	rList = values
+
+	if cb != nil {
+		for i := 0; i < len(rList); i++ {
+			cb(i, &rList[i])
+		}
+	}
+
+	return
+}
+
+// Delete
+// delete from user_faved_stickers where user_id = :user_id and document_id = :document_id
+// TODO(@benqi): sqlmap
+func (dao *UserFavedStickersDAO) Delete(ctx context.Context, user_id int64, document_id int64) (rowsAffected int64, err error) {
+	var (
+		query   = "delete from user_faved_stickers where user_id = ? and document_id = ?"
+		rResult sql.Result
+	)
+	rResult, err = dao.db.Exec(ctx, query, user_id, document_id)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("exec in Delete(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(ctx).Errorf("rowsAffected in Delete(_), error: %v", err)
+	}
+
+	return
+}
+
+// DeleteTx
+// delete from user_faved_stickers where user_id = :user_id and document_id = :document_id
+// TODO(@benqi): sqlmap
+func (dao *UserFavedStickersDAO) DeleteTx(tx *sqlx.Tx, user_id int64, document_id int64) (rowsAffected int64, err error) {
+	var (
+		query   = "delete from user_faved_stickers where user_id = ? and document_id = ?"
+		rResult sql.Result
+	)
+	rResult, err = tx.Exec(query, user_id, document_id)
+
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("exec in Delete(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("rowsAffected in Delete(_), error: %v", err)
+	}
+
+	return
+}
@@ -0,0 +1,275 @@
+/*
+ * WARNING! All changes made in this file will be lost!
+ *   Created from by 'dalgen'
+ *
+ * Copyright (c) 2022-present,  Teamgram Authors.
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package mysql_dao
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/teamgram/marmota/pkg/stores/sqlx"
+	"github.com/teamgram/teamgram-server/app/service/sticker/internal/dal/dataobject"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+var _ *sql.Result
+
+type UserInstalledStickerSetsDAO struct {
+	db *sqlx.DB
+}
+
+func NewUserInstalledStickerSetsDAO(db *sqlx.DB) *UserInstalledStickerSetsDAO {
+	return &UserInstalledStickerSetsDAO{db}
+}
+
+// InsertOrUpdate
+// insert into user_installed_sticker_sets(user_id, set_id, masks, archived, date2) values (:user_id, :set_id, :masks, :archived, :date2) on duplicate key update archived = values(archived), date2 = values(date2), deleted = 0
+// TODO(@benqi): sqlmap
+func (dao *UserInstalledStickerSetsDAO) InsertOrUpdate(ctx context.Context, do *dataobject.UserInstalledStickerSetsDO) (lastInsertId, rowsAffected int64, err error) {
+	var (
+		query = "insert into user_installed_sticker_sets(user_id, set_id, masks, archived, date2) values (:user_id, :set_id, :masks, :archived, :date2) on duplicate key update archived = values(archived), date2 = values(date2), deleted = 0"
+		r     sql.Result
+	)
+
+	r, err = dao.db.NamedExec(ctx, query, do)
+	if err != nil {
+		logx.WithContext(ctx).Errorf("namedExec in InsertOrUpdate(%v), error: %v", do, err)
+		return
+	}
+
+	lastInsertId, err = r.LastInsertId()
+	if err != nil {
+		logx.WithContext(ctx).Errorf("lastInsertId in InsertOrUpdate(%v)_error: %v", do, err)
+		return
+	}
+	rowsAffected, err = r.RowsAffected()
+	if err != nil {
+		logx.WithContext(ctx).Errorf("rowsAffected in InsertOrUpdate(%v)_error: %v", do, err)
+	}
+
+	return
+}
+
+// InsertOrUpdateTx
+// insert into user_installed_sticker_sets(user_id, set_id, masks, archived, date2) values (:user_id, :set_id, :masks, :archived, :date2) on duplicate key update archived = values(archived), date2 = values(date2), deleted = 0
+// TODO(@benqi): sqlmap
+func (dao *UserInstalledStickerSetsDAO) InsertOrUpdateTx(tx *sqlx.Tx, do *dataobject.UserInstalledStickerSetsDO) (lastInsertId, rowsAffected int64, err error) {
+	var (
+		query = "insert into user_installed_sticker_sets(user_id, set_id, masks, archived, date2) values (:user_id, :set_id, :masks, :archived, :date2) on duplicate key update archived = values(archived), date2 = values(date2), deleted = 0"
+		r     sql.Result
+	)
+
+	r, err = tx.NamedExec(query, do)
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("namedExec in InsertOrUpdate(%v), error: %v", do, err)
+		return
+	}
+
+	lastInsertId, err = r.LastInsertId()
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("lastInsertId in InsertOrUpdate(%v)_error: %v", do, err)
+		return
+	}
+	rowsAffected, err = r.RowsAffected()
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("rowsAffected in InsertOrUpdate(%v)_error: %v", do, err)
+	}
+
+	return
+}
+
+// SelectByUserSetId
+// select id, user_id, set_id, masks, archived, date2 from user_installed_sticker_sets where user_id = :user_id and set_id = :set_id and deleted = 0
+// TODO(@benqi): sqlmap
+func (dao *UserInstalledStickerSetsDAO) SelectByUserSetId(ctx context.Context, user_id int64, set_id int64) (rValue *dataobject.UserInstalledStickerSetsDO, err error) {
+	var (
+		query = "select id, user_id, set_id, masks, archived, date2 from user_installed_sticker_sets where user_id = ? and set_id = ? and deleted = 0"
+		do    = &dataobject.UserInstalledStickerSetsDO{}
+	)
+	err = dao.db.QueryRowPartial(ctx, do, query, user_id, set_id)
+
+	if err != nil {
+		if err != sqlx.ErrNotFound {
+			logx.WithContext(ctx).Errorf("queryx in SelectByUserSetId(_), error: %v", err)
+			return
+		} else {
+			err = nil
+		}
+	} else {
+		rValue = do
+	}
+
+	return
+}
+
+// SelectListByUserSetIdList
+// select id, user_id, set_id, masks, archived, date2 from user_installed_sticker_sets where user_id = :user_id and set_id in (:idList) and deleted = 0
+// TODO(@benqi): sqlmap
+func (dao *UserInstalledStickerSetsDAO) SelectListByUserSetIdList(ctx context.Context, user_id int64, idList []int64) (rList []dataobject.UserInstalledStickerSetsDO, err error) {
+	var (
+		query  = "select id, user_id, set_id, masks, archived, date2 from user_installed_sticker_sets where user_id = ? and set_id in (?) and deleted = 0"
+		a      []interface{}
+		values []dataobject.UserInstalledStickerSetsDO
+	)
+
+	if len(idList) == 0 {
+		rList = []dataobject.UserInstalledStickerSetsDO{}
+		return
+	}
+
+	query, a, err = sqlx.In(query, user_id, idList)
+	if err != nil {
+		// r sql.Result
+		logx.WithContext(ctx).Errorf("sqlx.In in SelectListByUserSetIdList(_), error: %v", err)
+		return
+	}
+	err = dao.db.QueryRowsPartial(ctx, &values, query, a...)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("queryx in SelectListByUserSetIdList(_), error: %v", err)
+		return
+	}
+
+	rList = values
+
+	return
+}
+
+// SelectListByUserSetIdListWithCB
+// select id, user_id, set_id, masks, archived, date2 from user_installed_sticker_sets where user_id = :user_id and set_id in (:idList) and deleted = 0
+// TODO(@benqi): sqlmap
+func (dao *UserInstalledStickerSetsDAO) SelectListByUserSetIdListWithCB(ctx context.Context, user_id int64, idList []int64, cb func(i int, v *dataobject.UserInstalledStickerSetsDO)) (rList []dataobject.UserInstalledStickerSetsDO, err error) {
+	var (
+		query  = "select id, user_id, set_id, masks, archived, date2 from user_installed_sticker_sets where user_id = ? and set_id in (?) and deleted = 0"
+		a      []interface{}
+		values []dataobject.UserInstalledStickerSetsDO
+	)
+
+	if len(idList) == 0 {
+		rList = []dataobject.UserInstalledStickerSetsDO{}
+		return
+	}
+
+	query, a, err = sqlx.In(query, user_id, idList)
+	if err != nil {
+		// r sql.Result
+		logx.WithContext(ctx).Errorf("sqlx.In in SelectListByUserSetIdList(_), error: %v", err)
+		return
+	}
+	err = dao.db.QueryRowsPartial(ctx, &values, query, a...)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("queryx in SelectListByUserSetIdList(_), error: %v", err)
+		return
+	}
+
+	rList = values
+
+	if cb != nil {
+		for i := 0; i < len(rList); i++ {
+			cb(i, &rList[i])
+		}
+	}
+
+	return
+}
+
+// SelectInstalledList
+// select id, user_id, set_id, masks, archived, date2 from user_installed_sticker_sets where user_id = :user_id and masks = :masks and archived = 0 and deleted = 0 order by date2 desc
+// TODO(@benqi): sqlmap
+func (dao *UserInstalledStickerSetsDAO) SelectInstalledList(ctx context.Context, user_id int64, masks bool) (rList []dataobject.UserInstalledStickerSetsDO, err error) {
+	var (
+		query  = "select id, user_id, set_id, masks, archived, date2 from user_installed_sticker_sets where user_id = ? and masks = ? and archived = 0 and deleted = 0 order by date2 desc"
+		values []dataobject.UserInstalledStickerSetsDO
+	)
+	err = dao.db.QueryRowsPartial(ctx, &values, query, user_id, masks)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("queryx in SelectInstalledList(_), error: %v", err)
+		return
+	}
+
+	rList = values
+
+	return
+}
+
+// SelectInstalledListWithCB
+// select id, user_id, set_id, masks, archived, date2 from user_installed_sticker_sets where user_id = :user_id and masks = :masks and archived = 0 and deleted = 0 order by date2 desc
+// TODO(@benqi): sqlmap
+func (dao *UserInstalledStickerSetsDAO) SelectInstalledListWithCB(ctx context.Context, user_id int64, masks bool, cb func(i int, v *dataobject.UserInstalledStickerSetsDO)) (rList []dataobject.UserInstalledStickerSetsDO, err error) {
+	var (
+		query  = "select id, user_id, set_id, masks, archived, date2 from user_installed_sticker_sets where user_id = ? and masks = ? and archived = 0 and deleted = 0 order by date2 desc"
+		values []dataobject.UserInstalledStickerSetsDO
+	)
+	err = dao.db.QueryRowsPartial(ctx, &values, query, user_id, masks)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("queryx in SelectInstalledList(_), error: %v", err)
+		return
+	}
+
+	rList = values
+
+	if cb != nil {
+		for i := 0; i < len(rList); i++ {
+			cb(i, &rList[i])
+		}
+	}
+
+	return
+}
+
+// Delete
+// update user_installed_sticker_sets set deleted = 1 where user_id = :user_id and set_id = :set_id
+// TODO(@benqi): sqlmap
+func (dao *UserInstalledStickerSetsDAO) Delete(ctx context.Context, user_id int64, set_id int64) (rowsAffected int64, err error) {
+	var (
+		query   = "update user_installed_sticker_sets set deleted = 1 where user_id = ? and set_id = ?"
+		rResult sql.Result
+	)
+	rResult, err = dao.db.Exec(ctx, query, user_id, set_id)
+
+	if err != nil {
+		logx.WithContext(ctx).Errorf("exec in Delete(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(ctx).Errorf("rowsAffected in Delete(_), error: %v", err)
+	}
+
+	return
+}
+
+// update user_installed_sticker_sets set deleted = 1 where user_id = :user_id and set_id = :set_id
+// DeleteTx
+// TODO(@benqi): sqlmap
+func (dao *UserInstalledStickerSetsDAO) DeleteTx(tx *sqlx.Tx, user_id int64, set_id int64) (rowsAffected int64, err error) {
+	var (
+		query   = "update user_installed_sticker_sets set deleted = 1 where user_id = ? and set_id = ?"
+		rResult sql.Result
+	)
+	rResult, err = tx.Exec(query, user_id, set_id)
+
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("exec in Delete(_), error: %v", err)
+		return
+	}
+
+	rowsAffected, err = rResult.RowsAffected()
+	if err != nil {
+		logx.WithContext(tx.Context()).Errorf("rowsAffected in Delete(_), error: %v", err)
+	}
+
+	return
+}
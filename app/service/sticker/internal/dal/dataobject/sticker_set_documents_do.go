@@ -0,0 +1,20 @@
+/*
+ * WARNING! All changes made in this file will be lost!
+ *   Created from by 'dalgen'
+ *
+ * Copyright (c) 2022-present,  Teamgram Authors.
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package dataobject
+
+type StickerSetDocumentsDO struct {
+	Id         int64  `db:"id"`
+	SetId      int64  `db:"set_id"`
+	DocumentId int64  `db:"document_id"`
+	Emoji      string `db:"emoji"`
+	Position   int32  `db:"position"`
+	Date2      int64  `db:"date2"`
+}
@@ -0,0 +1,30 @@
+/*
+ * WARNING! All changes made in this file will be lost!
+ *   Created from by 'dalgen'
+ *
+ * Copyright (c) 2022-present,  Teamgram Authors.
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package dataobject
+
+type StickerSetsDO struct {
+	Id              int64  `db:"id"`
+	SetId           int64  `db:"set_id"`
+	AccessHash      int64  `db:"access_hash"`
+	CreatorId       int64  `db:"creator_id"`
+	Title           string `db:"title"`
+	ShortName       string `db:"short_name"`
+	Masks           bool   `db:"masks"`
+	Animated        bool   `db:"animated"`
+	Videos          bool   `db:"videos"`
+	ThumbDocumentId int64  `db:"thumb_document_id"`
+	ThumbAccessHash int64  `db:"thumb_access_hash"`
+	ThumbSize       int32  `db:"thumb_size"`
+	ThumbVersion    int32  `db:"thumb_version"`
+	Count           int32  `db:"count"`
+	Hash            int32  `db:"hash"`
+	Date2           int64  `db:"date2"`
+}
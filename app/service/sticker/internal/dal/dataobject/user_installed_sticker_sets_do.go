@@ -0,0 +1,20 @@
+/*
+ * WARNING! All changes made in this file will be lost!
+ *   Created from by 'dalgen'
+ *
+ * Copyright (c) 2022-present,  Teamgram Authors.
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package dataobject
+
+type UserInstalledStickerSetsDO struct {
+	Id       int64 `db:"id"`
+	UserId   int64 `db:"user_id"`
+	SetId    int64 `db:"set_id"`
+	Masks    bool  `db:"masks"`
+	Archived bool  `db:"archived"`
+	Date2    int64 `db:"date2"`
+}
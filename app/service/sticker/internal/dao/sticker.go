@@ -0,0 +1,204 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package dao
+
+import (
+	"context"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/sticker/internal/dal/dataobject"
+	"github.com/teamgram/teamgram-server/app/service/sticker/sticker"
+)
+
+const (
+	// the stickers_recent_limit and stickers_faved_limit of help.getConfig
+	RecentStickersLimit = 200
+	FavedStickersLimit  = 5
+)
+
+func (d *Dao) GetStickerSetByInput(ctx context.Context, stickerset *mtproto.InputStickerSet) (*dataobject.StickerSetsDO, error) {
+	var (
+		do  *dataobject.StickerSetsDO
+		err error
+	)
+
+	switch stickerset.GetPredicateName() {
+	case mtproto.Predicate_inputStickerSetID:
+		do, err = d.StickerSetsDAO.SelectBySetId(ctx, stickerset.GetId())
+		if err == nil && do != nil && do.AccessHash != stickerset.GetAccessHash() {
+			do = nil
+		}
+	case mtproto.Predicate_inputStickerSetShortName:
+		do, err = d.StickerSetsDAO.SelectByShortName(ctx, stickerset.GetShortName())
+	default:
+		return nil, mtproto.ErrStickersetInvalid
+	}
+
+	if err != nil {
+		return nil, err
+	} else if do == nil {
+		return nil, mtproto.ErrStickersetInvalid
+	}
+
+	return do, nil
+}
+
+// MakeStickerSet the sticker set seen by a user, installed is nil if the user has not installed it.
+func MakeStickerSet(do *dataobject.StickerSetsDO, installed *dataobject.UserInstalledStickerSetsDO) *mtproto.StickerSet {
+	set := mtproto.MakeTLStickerSet(&mtproto.StickerSet{
+		Archived:      false,
+		Official:      false,
+		Masks:         do.Masks,
+		Animated:      do.Animated,
+		Videos:        do.Videos,
+		InstalledDate: nil,
+		Id:            do.SetId,
+		AccessHash:    do.AccessHash,
+		Title:         do.Title,
+		ShortName:     do.ShortName,
+		Thumbs:        nil,
+		ThumbDcId:     nil,
+		ThumbVersion:  nil,
+		Count:         do.Count,
+		Hash:          do.Hash,
+	}).To_StickerSet()
+
+	if installed != nil {
+		set.Archived = installed.Archived
+		set.InstalledDate = mtproto.MakeFlagsInt32(int32(installed.Date2))
+	}
+
+	// without a thumb the clients use the first sticker of the set
+	if do.ThumbVersion > 0 {
+		set.Thumbs = []*mtproto.PhotoSize{
+			mtproto.MakeTLPhotoSize(&mtproto.PhotoSize{
+				Type:  "s",
+				W:     100,
+				H:     100,
+				Size2: do.ThumbSize,
+			}).To_PhotoSize(),
+		}
+		set.ThumbDcId = mtproto.MakeFlagsInt32(1)
+		set.ThumbVersion = mtproto.MakeFlagsInt32(do.ThumbVersion)
+	}
+
+	return set
+}
+
+func MakeStickerSetDocument(setDO *dataobject.StickerSetsDO, do *dataobject.StickerSetDocumentsDO, date int64) *sticker.StickerSetDocument {
+	return sticker.MakeTLStickerSetDocument(&sticker.StickerSetDocument{
+		SetId:         setDO.SetId,
+		SetAccessHash: setDO.AccessHash,
+		DocumentId:    do.DocumentId,
+		Emoji:         do.Emoji,
+		Date:          int32(date),
+	}).To_StickerSetDocument()
+}
+
+func (d *Dao) GetStickerSetDocumentList(ctx context.Context, setDO *dataobject.StickerSetsDO) ([]*sticker.StickerSetDocument, error) {
+	documents := make([]*sticker.StickerSetDocument, 0, setDO.Count)
+	_, err := d.StickerSetDocumentsDAO.SelectListBySetIdWithCB(
+		ctx,
+		setDO.SetId,
+		func(i int, v *dataobject.StickerSetDocumentsDO) {
+			documents = append(documents, MakeStickerSetDocument(setDO, v, v.Date2))
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return documents, nil
+}
+
+func (d *Dao) MakeStickerSetData(ctx context.Context, userId int64, setDO *dataobject.StickerSetsDO) (*sticker.StickerSetData, error) {
+	installed, err := d.UserInstalledStickerSetsDAO.SelectByUserSetId(ctx, userId, setDO.SetId)
+	if err != nil {
+		return nil, err
+	}
+
+	documents, err := d.GetStickerSetDocumentList(ctx, setDO)
+	if err != nil {
+		return nil, err
+	}
+
+	return sticker.MakeTLStickerSetData(&sticker.StickerSetData{
+		Set:       MakeStickerSet(setDO, installed),
+		Documents: documents,
+	}).To_StickerSetData(), nil
+}
+
+// UpdateStickerSetCount refreshes the count and the hash of a set after its stickers changed.
+func (d *Dao) UpdateStickerSetCount(ctx context.Context, setDO *dataobject.StickerSetsDO) error {
+	documents, err := d.GetStickerSetDocumentList(ctx, setDO)
+	if err != nil {
+		return err
+	}
+
+	setDO.Count = int32(len(documents))
+	setDO.Hash = sticker.MakeStickerSetHash(sticker.GetDocumentIdList(documents))
+	_, err = d.StickerSetsDAO.UpdateCount(ctx, setDO.Count, setDO.Hash, setDO.SetId)
+
+	return err
+}
+
+// GetStickerSetDocumentListByIdList the stickers of idList in the same order, the stickers
+// removed from their sets are skipped. dateList is the date the stickers were used.
+func (d *Dao) GetStickerSetDocumentListByIdList(ctx context.Context, idList []int64, dateList []int64) ([]*sticker.StickerSetDocument, error) {
+	if len(idList) == 0 {
+		return []*sticker.StickerSetDocument{}, nil
+	}
+
+	docList, err := d.StickerSetDocumentsDAO.SelectListByDocumentIdList(ctx, idList)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		docMap    = make(map[int64]*dataobject.StickerSetDocumentsDO, len(docList))
+		setIdList = make([]int64, 0, len(docList))
+		setMap    = make(map[int64]*dataobject.StickerSetsDO)
+	)
+	for i := range docList {
+		docMap[docList[i].DocumentId] = &docList[i]
+		setIdList = append(setIdList, docList[i].SetId)
+	}
+
+	if len(setIdList) > 0 {
+		_, err = d.StickerSetsDAO.SelectListBySetIdListWithCB(
+			ctx,
+			setIdList,
+			func(i int, v *dataobject.StickerSetsDO) {
+				setMap[v.SetId] = v
+			})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	documents := make([]*sticker.StickerSetDocument, 0, len(idList))
+	for i, id := range idList {
+		if doc, ok := docMap[id]; ok {
+			if setDO, ok2 := setMap[doc.SetId]; ok2 {
+				documents = append(documents, MakeStickerSetDocument(setDO, doc, dateList[i]))
+			}
+		}
+	}
+
+	return documents, nil
+}
@@ -0,0 +1,274 @@
+/*
+ * WARNING! All changes made in this file will be lost!
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package service
+
+import (
+	"context"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/app/service/sticker/internal/core"
+	"github.com/teamgram/teamgram-server/app/service/sticker/sticker"
+)
+
+// StickerCreateStickerSet
+// sticker.createStickerSet flags:# user_id:long masks:flags.0?true animated:flags.1?true videos:flags.2?true title:string short_name:string thumb:flags.3?Document stickers:Vector<StickerSetDocument> = StickerSetData;
+func (s *Service) StickerCreateStickerSet(ctx context.Context, request *sticker.TLStickerCreateStickerSet) (*sticker.StickerSetData, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Infof("sticker.createStickerSet - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.StickerCreateStickerSet(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Infof("sticker.createStickerSet - reply: %s", r.DebugString())
+	return r, err
+}
+
+// StickerAddStickerToSet
+// sticker.addStickerToSet user_id:long stickerset:InputStickerSet sticker:StickerSetDocument = StickerSetData;
+func (s *Service) StickerAddStickerToSet(ctx context.Context, request *sticker.TLStickerAddStickerToSet) (*sticker.StickerSetData, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Infof("sticker.addStickerToSet - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.StickerAddStickerToSet(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Infof("sticker.addStickerToSet - reply: %s", r.DebugString())
+	return r, err
+}
+
+// StickerRemoveStickerFromSet
+// sticker.removeStickerFromSet user_id:long document_id:long = StickerSetData;
+func (s *Service) StickerRemoveStickerFromSet(ctx context.Context, request *sticker.TLStickerRemoveStickerFromSet) (*sticker.StickerSetData, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Infof("sticker.removeStickerFromSet - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.StickerRemoveStickerFromSet(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Infof("sticker.removeStickerFromSet - reply: %s", r.DebugString())
+	return r, err
+}
+
+// StickerCheckShortName
+// sticker.checkShortName short_name:string = Bool;
+func (s *Service) StickerCheckShortName(ctx context.Context, request *sticker.TLStickerCheckShortName) (*mtproto.Bool, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Infof("sticker.checkShortName - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.StickerCheckShortName(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Infof("sticker.checkShortName - reply: %s", r.DebugString())
+	return r, err
+}
+
+// StickerGetStickerSet
+// sticker.getStickerSet user_id:long stickerset:InputStickerSet = StickerSetData;
+func (s *Service) StickerGetStickerSet(ctx context.Context, request *sticker.TLStickerGetStickerSet) (*sticker.StickerSetData, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Infof("sticker.getStickerSet - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.StickerGetStickerSet(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Infof("sticker.getStickerSet - reply: %s", r.DebugString())
+	return r, err
+}
+
+// StickerGetStickerSetThumb
+// sticker.getStickerSetThumb stickerset:InputStickerSet thumb_version:int = InputFileLocation;
+func (s *Service) StickerGetStickerSetThumb(ctx context.Context, request *sticker.TLStickerGetStickerSetThumb) (*mtproto.InputFileLocation, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Infof("sticker.getStickerSetThumb - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.StickerGetStickerSetThumb(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Infof("sticker.getStickerSetThumb - reply: %s", r.DebugString())
+	return r, err
+}
+
+// StickerInstallStickerSet
+// sticker.installStickerSet user_id:long stickerset:InputStickerSet archived:Bool = StickerSet;
+func (s *Service) StickerInstallStickerSet(ctx context.Context, request *sticker.TLStickerInstallStickerSet) (*mtproto.StickerSet, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Infof("sticker.installStickerSet - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.StickerInstallStickerSet(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Infof("sticker.installStickerSet - reply: %s", r.DebugString())
+	return r, err
+}
+
+// StickerUninstallStickerSet
+// sticker.uninstallStickerSet user_id:long stickerset:InputStickerSet = Bool;
+func (s *Service) StickerUninstallStickerSet(ctx context.Context, request *sticker.TLStickerUninstallStickerSet) (*mtproto.Bool, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Infof("sticker.uninstallStickerSet - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.StickerUninstallStickerSet(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Infof("sticker.uninstallStickerSet - reply: %s", r.DebugString())
+	return r, err
+}
+
+// StickerGetInstalledStickerSets
+// sticker.getInstalledStickerSets user_id:long masks:Bool = Vector<StickerSet>;
+func (s *Service) StickerGetInstalledStickerSets(ctx context.Context, request *sticker.TLStickerGetInstalledStickerSets) (*sticker.Vector_StickerSet, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Infof("sticker.getInstalledStickerSets - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.StickerGetInstalledStickerSets(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Infof("sticker.getInstalledStickerSets - reply: %s", r.DebugString())
+	return r, err
+}
+
+// StickerGetRecentStickers
+// sticker.getRecentStickers user_id:long attached:Bool = Vector<StickerSetDocument>;
+func (s *Service) StickerGetRecentStickers(ctx context.Context, request *sticker.TLStickerGetRecentStickers) (*sticker.Vector_StickerSetDocument, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Infof("sticker.getRecentStickers - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.StickerGetRecentStickers(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Infof("sticker.getRecentStickers - reply: %s", r.DebugString())
+	return r, err
+}
+
+// StickerSaveRecentSticker
+// sticker.saveRecentSticker user_id:long attached:Bool document_id:long unsave:Bool = Bool;
+func (s *Service) StickerSaveRecentSticker(ctx context.Context, request *sticker.TLStickerSaveRecentSticker) (*mtproto.Bool, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Infof("sticker.saveRecentSticker - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.StickerSaveRecentSticker(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Infof("sticker.saveRecentSticker - reply: %s", r.DebugString())
+	return r, err
+}
+
+// StickerClearRecentStickers
+// sticker.clearRecentStickers user_id:long attached:Bool = Bool;
+func (s *Service) StickerClearRecentStickers(ctx context.Context, request *sticker.TLStickerClearRecentStickers) (*mtproto.Bool, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Infof("sticker.clearRecentStickers - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.StickerClearRecentStickers(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Infof("sticker.clearRecentStickers - reply: %s", r.DebugString())
+	return r, err
+}
+
+// StickerGetFavedStickers
+// sticker.getFavedStickers user_id:long = Vector<StickerSetDocument>;
+func (s *Service) StickerGetFavedStickers(ctx context.Context, request *sticker.TLStickerGetFavedStickers) (*sticker.Vector_StickerSetDocument, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Infof("sticker.getFavedStickers - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.StickerGetFavedStickers(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Infof("sticker.getFavedStickers - reply: %s", r.DebugString())
+	return r, err
+}
+
+// StickerFaveSticker
+// sticker.faveSticker user_id:long document_id:long unfave:Bool = Bool;
+func (s *Service) StickerFaveSticker(ctx context.Context, request *sticker.TLStickerFaveSticker) (*mtproto.Bool, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Infof("sticker.faveSticker - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.StickerFaveSticker(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Infof("sticker.faveSticker - reply: %s", r.DebugString())
+	return r, err
+}
+
+// StickerGetStickersByEmoji
+// sticker.getStickersByEmoji user_id:long emoticon:string = Vector<StickerSetDocument>;
+func (s *Service) StickerGetStickersByEmoji(ctx context.Context, request *sticker.TLStickerGetStickersByEmoji) (*sticker.Vector_StickerSetDocument, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Infof("sticker.getStickersByEmoji - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.StickerGetStickersByEmoji(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Infof("sticker.getStickersByEmoji - reply: %s", r.DebugString())
+	return r, err
+}
+
+// StickerChangeStickerPosition
+// sticker.changeStickerPosition user_id:long document_id:long position:int = StickerSetData;
+func (s *Service) StickerChangeStickerPosition(ctx context.Context, request *sticker.TLStickerChangeStickerPosition) (*sticker.StickerSetData, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Infof("sticker.changeStickerPosition - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.StickerChangeStickerPosition(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Infof("sticker.changeStickerPosition - reply: %s", r.DebugString())
+	return r, err
+}
+
+// StickerSetStickerSetThumb
+// sticker.setStickerSetThumb user_id:long stickerset:InputStickerSet thumb:Document = StickerSetData;
+func (s *Service) StickerSetStickerSetThumb(ctx context.Context, request *sticker.TLStickerSetStickerSetThumb) (*sticker.StickerSetData, error) {
+	c := core.New(ctx, s.svcCtx)
+	c.Infof("sticker.setStickerSetThumb - metadata: %s, request: %s", c.MD.DebugString(), request.DebugString())
+
+	r, err := c.StickerSetStickerSetThumb(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Infof("sticker.setStickerSetThumb - reply: %s", r.DebugString())
+	return r, err
+}
@@ -0,0 +1,149 @@
+/*
+ * WARNING! All changes made in this file will be lost!
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2022-present,  Teamgram Authors.
+ *  All rights reserved.
+ *
+ * Author: teagramio (teagram.io@gmail.com)
+ */
+
+package sticker
+
+const (
+	Predicate_stickerSetDocument              = "stickerSetDocument"
+	Predicate_stickerSetData                  = "stickerSetData"
+	Predicate_sticker_createStickerSet        = "sticker_createStickerSet"
+	Predicate_sticker_addStickerToSet         = "sticker_addStickerToSet"
+	Predicate_sticker_removeStickerFromSet    = "sticker_removeStickerFromSet"
+	Predicate_sticker_checkShortName          = "sticker_checkShortName"
+	Predicate_sticker_getStickerSet           = "sticker_getStickerSet"
+	Predicate_sticker_getStickerSetThumb      = "sticker_getStickerSetThumb"
+	Predicate_sticker_installStickerSet       = "sticker_installStickerSet"
+	Predicate_sticker_uninstallStickerSet     = "sticker_uninstallStickerSet"
+	Predicate_sticker_getInstalledStickerSets = "sticker_getInstalledStickerSets"
+	Predicate_sticker_getRecentStickers       = "sticker_getRecentStickers"
+	Predicate_sticker_saveRecentSticker       = "sticker_saveRecentSticker"
+	Predicate_sticker_clearRecentStickers     = "sticker_clearRecentStickers"
+	Predicate_sticker_getFavedStickers        = "sticker_getFavedStickers"
+	Predicate_sticker_faveSticker             = "sticker_faveSticker"
+	Predicate_sticker_getStickersByEmoji      = "sticker_getStickersByEmoji"
+	Predicate_sticker_changeStickerPosition   = "sticker_changeStickerPosition"
+	Predicate_sticker_setStickerSetThumb      = "sticker_setStickerSetThumb"
+)
+
+var clazzNameRegisters2 = map[string]map[int]int32{
+	Predicate_stickerSetDocument: {
+		0: -334514215, // 0xec0fb7d9
+
+	},
+	Predicate_stickerSetData: {
+		0: 948201815, // 0x38846957
+
+	},
+	Predicate_sticker_createStickerSet: {
+		0: -2003713060, // 0x8891c3dc
+
+	},
+	Predicate_sticker_addStickerToSet: {
+		0: 45345711, // 0x2b3ebaf
+
+	},
+	Predicate_sticker_removeStickerFromSet: {
+		0: 2146540240, // 0x7ff19ad0
+
+	},
+	Predicate_sticker_checkShortName: {
+		0: -657601968, // 0xd8cdca50
+
+	},
+	Predicate_sticker_getStickerSet: {
+		0: -406132953, // 0xe7cae727
+
+	},
+	Predicate_sticker_getStickerSetThumb: {
+		0: -1653573960, // 0x9d7076b8
+
+	},
+	Predicate_sticker_installStickerSet: {
+		0: -2030490898, // 0x86f92aee
+
+	},
+	Predicate_sticker_uninstallStickerSet: {
+		0: 1975590147, // 0x75c11d03
+
+	},
+	Predicate_sticker_getInstalledStickerSets: {
+		0: -118567221, // 0xf8eececb
+
+	},
+	Predicate_sticker_getRecentStickers: {
+		0: -42094018, // 0xfd7db23e
+
+	},
+	Predicate_sticker_saveRecentSticker: {
+		0: 45145886, // 0x2b0df1e
+
+	},
+	Predicate_sticker_clearRecentStickers: {
+		0: 610423709, // 0x2462539d
+
+	},
+	Predicate_sticker_getFavedStickers: {
+		0: -1394164484, // 0xace6bcfc
+
+	},
+	Predicate_sticker_faveSticker: {
+		0: 141962210, // 0x8762be2
+
+	},
+	Predicate_sticker_getStickersByEmoji: {
+		0: 860894680, // 0x335035d8
+
+	},
+	Predicate_sticker_changeStickerPosition: {
+		0: 183577690, // 0xaf12c5a
+
+	},
+	Predicate_sticker_setStickerSetThumb: {
+		0: -1126334974, // 0xbcdd7e02
+
+	},
+}
+
+var clazzIdNameRegisters2 = map[int32]string{
+	-334514215:  Predicate_stickerSetDocument,              // 0xec0fb7d9
+	948201815:   Predicate_stickerSetData,                  // 0x38846957
+	-2003713060: Predicate_sticker_createStickerSet,        // 0x8891c3dc
+	45345711:    Predicate_sticker_addStickerToSet,         // 0x2b3ebaf
+	2146540240:  Predicate_sticker_removeStickerFromSet,    // 0x7ff19ad0
+	-657601968:  Predicate_sticker_checkShortName,          // 0xd8cdca50
+	-406132953:  Predicate_sticker_getStickerSet,           // 0xe7cae727
+	-1653573960: Predicate_sticker_getStickerSetThumb,      // 0x9d7076b8
+	-2030490898: Predicate_sticker_installStickerSet,       // 0x86f92aee
+	1975590147:  Predicate_sticker_uninstallStickerSet,     // 0x75c11d03
+	-118567221:  Predicate_sticker_getInstalledStickerSets, // 0xf8eececb
+	-42094018:   Predicate_sticker_getRecentStickers,       // 0xfd7db23e
+	45145886:    Predicate_sticker_saveRecentSticker,       // 0x2b0df1e
+	610423709:   Predicate_sticker_clearRecentStickers,     // 0x2462539d
+	-1394164484: Predicate_sticker_getFavedStickers,        // 0xace6bcfc
+	141962210:   Predicate_sticker_faveSticker,             // 0x8762be2
+	860894680:   Predicate_sticker_getStickersByEmoji,      // 0x335035d8
+	183577690:   Predicate_sticker_changeStickerPosition,   // 0xaf12c5a
+	-1126334974: Predicate_sticker_setStickerSetThumb,      // 0xbcdd7e02
+
+}
+
+func GetClazzID(clazzName string, layer int) int32 {
+	if m, ok := clazzNameRegisters2[clazzName]; ok {
+		m2, ok2 := m[layer]
+		if ok2 {
+			return m2
+		}
+		m2, ok2 = m[0]
+		if ok2 {
+			return m2
+		}
+	}
+	return 0
+}
@@ -0,0 +1,1541 @@
+/*
+ * WARNING! All changes made in this file will be lost!
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2022-present,  Teamgram Authors.
+ *  All rights reserved.
+ *
+ * Author: teagramio (teagram.io@gmail.com)
+ */
+
+// ConstructorList
+// RequestList
+
+package sticker
+
+import (
+	"fmt"
+
+	"github.com/teamgram/proto/mtproto"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/types"
+)
+
+//////////////////////////////////////////////////////////////////////////////////////////
+
+var _ *types.Int32Value
+var _ *mtproto.Bool
+var _ fmt.GoStringer
+
+var clazzIdRegisters2 = map[int32]func() mtproto.TLObject{
+	// Constructor
+	-334514215: func() mtproto.TLObject { // 0xec0fb7d9
+		o := MakeTLStickerSetDocument(nil)
+		o.Data2.Constructor = -334514215
+		return o
+	},
+	948201815: func() mtproto.TLObject { // 0x38846957
+		o := MakeTLStickerSetData(nil)
+		o.Data2.Constructor = 948201815
+		return o
+	},
+
+	// Method
+	-2003713060: func() mtproto.TLObject { // 0x8891c3dc
+		return &TLStickerCreateStickerSet{
+			Constructor: -2003713060,
+		}
+	},
+	45345711: func() mtproto.TLObject { // 0x2b3ebaf
+		return &TLStickerAddStickerToSet{
+			Constructor: 45345711,
+		}
+	},
+	2146540240: func() mtproto.TLObject { // 0x7ff19ad0
+		return &TLStickerRemoveStickerFromSet{
+			Constructor: 2146540240,
+		}
+	},
+	-657601968: func() mtproto.TLObject { // 0xd8cdca50
+		return &TLStickerCheckShortName{
+			Constructor: -657601968,
+		}
+	},
+	-406132953: func() mtproto.TLObject { // 0xe7cae727
+		return &TLStickerGetStickerSet{
+			Constructor: -406132953,
+		}
+	},
+	-1653573960: func() mtproto.TLObject { // 0x9d7076b8
+		return &TLStickerGetStickerSetThumb{
+			Constructor: -1653573960,
+		}
+	},
+	-2030490898: func() mtproto.TLObject { // 0x86f92aee
+		return &TLStickerInstallStickerSet{
+			Constructor: -2030490898,
+		}
+	},
+	1975590147: func() mtproto.TLObject { // 0x75c11d03
+		return &TLStickerUninstallStickerSet{
+			Constructor: 1975590147,
+		}
+	},
+	-118567221: func() mtproto.TLObject { // 0xf8eececb
+		return &TLStickerGetInstalledStickerSets{
+			Constructor: -118567221,
+		}
+	},
+	-42094018: func() mtproto.TLObject { // 0xfd7db23e
+		return &TLStickerGetRecentStickers{
+			Constructor: -42094018,
+		}
+	},
+	45145886: func() mtproto.TLObject { // 0x2b0df1e
+		return &TLStickerSaveRecentSticker{
+			Constructor: 45145886,
+		}
+	},
+	610423709: func() mtproto.TLObject { // 0x2462539d
+		return &TLStickerClearRecentStickers{
+			Constructor: 610423709,
+		}
+	},
+	-1394164484: func() mtproto.TLObject { // 0xace6bcfc
+		return &TLStickerGetFavedStickers{
+			Constructor: -1394164484,
+		}
+	},
+	141962210: func() mtproto.TLObject { // 0x8762be2
+		return &TLStickerFaveSticker{
+			Constructor: 141962210,
+		}
+	},
+	860894680: func() mtproto.TLObject { // 0x335035d8
+		return &TLStickerGetStickersByEmoji{
+			Constructor: 860894680,
+		}
+	},
+	183577690: func() mtproto.TLObject { // 0xaf12c5a
+		return &TLStickerChangeStickerPosition{
+			Constructor: 183577690,
+		}
+	},
+	-1126334974: func() mtproto.TLObject { // 0xbcdd7e02
+		return &TLStickerSetStickerSetThumb{
+			Constructor: -1126334974,
+		}
+	},
+}
+
+func NewTLObjectByClassID(classId int32) mtproto.TLObject {
+	f, ok := clazzIdRegisters2[classId]
+	if !ok {
+		return nil
+	}
+	return f()
+}
+
+func CheckClassID(classId int32) (ok bool) {
+	_, ok = clazzIdRegisters2[classId]
+	return
+}
+
+//----------------------------------------------------------------------------------------------------------------
+
+///////////////////////////////////////////////////////////////////////////////
+// StickerSetDocument <--
+//  + TL_StickerSetDocument
+//
+
+func (m *StickerSetDocument) Encode(layer int32) []byte {
+	predicateName := m.PredicateName
+	if predicateName == "" {
+		if n, ok := clazzIdNameRegisters2[int32(m.Constructor)]; ok {
+			predicateName = n
+		}
+	}
+
+	var (
+		xBuf []byte
+	)
+
+	switch predicateName {
+	case Predicate_stickerSetDocument:
+		t := m.To_StickerSetDocument()
+		xBuf = t.Encode(layer)
+
+	default:
+		// logx.Errorf("invalid predicate error: %s",  m.PredicateName)
+		return []byte{}
+	}
+
+	return xBuf
+}
+
+func (m *StickerSetDocument) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *StickerSetDocument) Decode(dBuf *mtproto.DecodeBuf) error {
+	m.Constructor = TLConstructor(dBuf.Int())
+	switch uint32(m.Constructor) {
+	case 0xec0fb7d9:
+		m2 := MakeTLStickerSetDocument(m)
+		m2.Decode(dBuf)
+
+	default:
+		return fmt.Errorf("invalid constructorId: 0x%x", uint32(m.Constructor))
+	}
+	return dBuf.GetError()
+}
+
+func (m *StickerSetDocument) DebugString() string {
+	switch m.PredicateName {
+	case Predicate_stickerSetDocument:
+		t := m.To_StickerSetDocument()
+		return t.DebugString()
+
+	default:
+		return "{}"
+	}
+}
+
+// To_StickerSetDocument
+// stickerSetDocument set_id:long set_access_hash:long document_id:long emoji:string date:int = StickerSetDocument;
+func (m *StickerSetDocument) To_StickerSetDocument() *TLStickerSetDocument {
+	m.PredicateName = Predicate_stickerSetDocument
+	return &TLStickerSetDocument{
+		Data2: m,
+	}
+}
+
+// MakeTLStickerSetDocument
+// stickerSetDocument set_id:long set_access_hash:long document_id:long emoji:string date:int = StickerSetDocument;
+func MakeTLStickerSetDocument(data2 *StickerSetDocument) *TLStickerSetDocument {
+	if data2 == nil {
+		return &TLStickerSetDocument{Data2: &StickerSetDocument{
+			PredicateName: Predicate_stickerSetDocument,
+		}}
+	} else {
+		data2.PredicateName = Predicate_stickerSetDocument
+		return &TLStickerSetDocument{Data2: data2}
+	}
+}
+
+func (m *TLStickerSetDocument) To_StickerSetDocument() *StickerSetDocument {
+	m.Data2.PredicateName = Predicate_stickerSetDocument
+	return m.Data2
+}
+
+func (m *TLStickerSetDocument) SetSetId(v int64) { m.Data2.SetId = v }
+func (m *TLStickerSetDocument) GetSetId() int64  { return m.Data2.SetId }
+
+func (m *TLStickerSetDocument) SetSetAccessHash(v int64) { m.Data2.SetAccessHash = v }
+func (m *TLStickerSetDocument) GetSetAccessHash() int64  { return m.Data2.SetAccessHash }
+
+func (m *TLStickerSetDocument) SetDocumentId(v int64) { m.Data2.DocumentId = v }
+func (m *TLStickerSetDocument) GetDocumentId() int64  { return m.Data2.DocumentId }
+
+func (m *TLStickerSetDocument) SetEmoji(v string) { m.Data2.Emoji = v }
+func (m *TLStickerSetDocument) GetEmoji() string  { return m.Data2.Emoji }
+
+func (m *TLStickerSetDocument) SetDate(v int32) { m.Data2.Date = v }
+func (m *TLStickerSetDocument) GetDate() int32  { return m.Data2.Date }
+
+func (m *TLStickerSetDocument) GetPredicateName() string {
+	return Predicate_stickerSetDocument
+}
+
+func (m *TLStickerSetDocument) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+
+	var encodeF = map[uint32]func() []byte{
+		0xec0fb7d9: func() []byte {
+			// stickerSetDocument set_id:long set_access_hash:long document_id:long emoji:string date:int = StickerSetDocument;
+			x.UInt(0xec0fb7d9)
+
+			x.Long(m.GetSetId())
+			x.Long(m.GetSetAccessHash())
+			x.Long(m.GetDocumentId())
+			x.String(m.GetEmoji())
+			x.Int(m.GetDate())
+			return x.GetBuf()
+		},
+	}
+
+	clazzId := GetClazzID(Predicate_stickerSetDocument, int(layer))
+	if f, ok := encodeF[uint32(clazzId)]; ok {
+		return f()
+	} else {
+		// TODO(@benqi): handle error
+		// log.Errorf("not found clazzId by (%s, %d)", Predicate_stickerSetDocument, layer)
+		return x.GetBuf()
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLStickerSetDocument) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLStickerSetDocument) Decode(dBuf *mtproto.DecodeBuf) error {
+	var decodeF = map[uint32]func() error{
+		0xec0fb7d9: func() error {
+			// stickerSetDocument set_id:long set_access_hash:long document_id:long emoji:string date:int = StickerSetDocument;
+			m.SetSetId(dBuf.Long())
+			m.SetSetAccessHash(dBuf.Long())
+			m.SetDocumentId(dBuf.Long())
+			m.SetEmoji(dBuf.String())
+			m.SetDate(dBuf.Int())
+			return dBuf.GetError()
+		},
+	}
+
+	if f, ok := decodeF[uint32(m.Data2.Constructor)]; ok {
+		return f()
+	} else {
+		return fmt.Errorf("invalid constructor: %x", uint32(m.Data2.Constructor))
+	}
+}
+
+func (m *TLStickerSetDocument) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+///////////////////////////////////////////////////////////////////////////////
+// StickerSetData <--
+//  + TL_StickerSetData
+//
+
+func (m *StickerSetData) Encode(layer int32) []byte {
+	predicateName := m.PredicateName
+	if predicateName == "" {
+		if n, ok := clazzIdNameRegisters2[int32(m.Constructor)]; ok {
+			predicateName = n
+		}
+	}
+
+	var (
+		xBuf []byte
+	)
+
+	switch predicateName {
+	case Predicate_stickerSetData:
+		t := m.To_StickerSetData()
+		xBuf = t.Encode(layer)
+
+	default:
+		// logx.Errorf("invalid predicate error: %s",  m.PredicateName)
+		return []byte{}
+	}
+
+	return xBuf
+}
+
+func (m *StickerSetData) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *StickerSetData) Decode(dBuf *mtproto.DecodeBuf) error {
+	m.Constructor = TLConstructor(dBuf.Int())
+	switch uint32(m.Constructor) {
+	case 0x38846957:
+		m2 := MakeTLStickerSetData(m)
+		m2.Decode(dBuf)
+
+	default:
+		return fmt.Errorf("invalid constructorId: 0x%x", uint32(m.Constructor))
+	}
+	return dBuf.GetError()
+}
+
+func (m *StickerSetData) DebugString() string {
+	switch m.PredicateName {
+	case Predicate_stickerSetData:
+		t := m.To_StickerSetData()
+		return t.DebugString()
+
+	default:
+		return "{}"
+	}
+}
+
+// To_StickerSetData
+// stickerSetData set:StickerSet documents:Vector<StickerSetDocument> = StickerSetData;
+func (m *StickerSetData) To_StickerSetData() *TLStickerSetData {
+	m.PredicateName = Predicate_stickerSetData
+	return &TLStickerSetData{
+		Data2: m,
+	}
+}
+
+// MakeTLStickerSetData
+// stickerSetData set:StickerSet documents:Vector<StickerSetDocument> = StickerSetData;
+func MakeTLStickerSetData(data2 *StickerSetData) *TLStickerSetData {
+	if data2 == nil {
+		return &TLStickerSetData{Data2: &StickerSetData{
+			PredicateName: Predicate_stickerSetData,
+		}}
+	} else {
+		data2.PredicateName = Predicate_stickerSetData
+		return &TLStickerSetData{Data2: data2}
+	}
+}
+
+func (m *TLStickerSetData) To_StickerSetData() *StickerSetData {
+	m.Data2.PredicateName = Predicate_stickerSetData
+	return m.Data2
+}
+
+func (m *TLStickerSetData) SetSet(v *mtproto.StickerSet) { m.Data2.Set = v }
+func (m *TLStickerSetData) GetSet() *mtproto.StickerSet  { return m.Data2.Set }
+
+func (m *TLStickerSetData) SetDocuments(v []*StickerSetDocument) { m.Data2.Documents = v }
+func (m *TLStickerSetData) GetDocuments() []*StickerSetDocument  { return m.Data2.Documents }
+
+func (m *TLStickerSetData) GetPredicateName() string {
+	return Predicate_stickerSetData
+}
+
+func (m *TLStickerSetData) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+
+	var encodeF = map[uint32]func() []byte{
+		0x38846957: func() []byte {
+			// stickerSetData set:StickerSet documents:Vector<StickerSetDocument> = StickerSetData;
+			x.UInt(0x38846957)
+
+			x.Bytes(m.GetSet().Encode(layer))
+
+			x.Int(int32(mtproto.CRC32_vector))
+			x.Int(int32(len(m.GetDocuments())))
+			for _, v := range m.GetDocuments() {
+				x.Bytes((*v).Encode(layer))
+			}
+
+			return x.GetBuf()
+		},
+	}
+
+	clazzId := GetClazzID(Predicate_stickerSetData, int(layer))
+	if f, ok := encodeF[uint32(clazzId)]; ok {
+		return f()
+	} else {
+		// TODO(@benqi): handle error
+		// log.Errorf("not found clazzId by (%s, %d)", Predicate_stickerSetData, layer)
+		return x.GetBuf()
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLStickerSetData) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLStickerSetData) Decode(dBuf *mtproto.DecodeBuf) error {
+	var decodeF = map[uint32]func() error{
+		0x38846957: func() error {
+			// stickerSetData set:StickerSet documents:Vector<StickerSetDocument> = StickerSetData;
+
+			m0 := &mtproto.StickerSet{}
+			m0.Decode(dBuf)
+			m.SetSet(m0)
+
+			c1 := dBuf.Int()
+			if c1 != int32(mtproto.CRC32_vector) {
+				// dBuf.err = fmt.Errorf("invalid mtproto.CRC32_vector, c%d: %d", 1, c1)
+				return fmt.Errorf("invalid mtproto.CRC32_vector, c%d: %d", 1, c1)
+			}
+			l1 := dBuf.Int()
+			v1 := make([]*StickerSetDocument, l1)
+			for i := int32(0); i < l1; i++ {
+				v1[i] = &StickerSetDocument{}
+				v1[i].Decode(dBuf)
+			}
+			m.SetDocuments(v1)
+
+			return dBuf.GetError()
+		},
+	}
+
+	if f, ok := decodeF[uint32(m.Data2.Constructor)]; ok {
+		return f()
+	} else {
+		return fmt.Errorf("invalid constructor: %x", uint32(m.Data2.Constructor))
+	}
+}
+
+func (m *TLStickerSetData) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+//----------------------------------------------------------------------------------------------------------------
+// TLStickerCreateStickerSet
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLStickerCreateStickerSet) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_sticker_createStickerSet))
+
+	switch uint32(m.Constructor) {
+	case 0x8891c3dc:
+		// sticker.createStickerSet flags:# user_id:long masks:flags.0?true animated:flags.1?true videos:flags.2?true title:string short_name:string thumb:flags.3?Document stickers:Vector<StickerSetDocument> = StickerSetData;
+		x.UInt(0x8891c3dc)
+
+		// set flags
+		var flags uint32 = 0
+
+		if m.GetMasks() == true {
+			flags |= 1 << 0
+		}
+		if m.GetAnimated() == true {
+			flags |= 1 << 1
+		}
+		if m.GetVideos() == true {
+			flags |= 1 << 2
+		}
+		if m.GetThumb() != nil {
+			flags |= 1 << 3
+		}
+
+		x.UInt(flags)
+
+		// flags Debug by @benqi
+		x.Long(m.GetUserId())
+		x.String(m.GetTitle())
+		x.String(m.GetShortName())
+		if m.GetThumb() != nil {
+			x.Bytes(m.GetThumb().Encode(layer))
+		}
+
+		x.Int(int32(mtproto.CRC32_vector))
+		x.Int(int32(len(m.GetStickers())))
+		for _, v := range m.GetStickers() {
+			x.Bytes((*v).Encode(layer))
+		}
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLStickerCreateStickerSet) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLStickerCreateStickerSet) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0x8891c3dc:
+		// sticker.createStickerSet flags:# user_id:long masks:flags.0?true animated:flags.1?true videos:flags.2?true title:string short_name:string thumb:flags.3?Document stickers:Vector<StickerSetDocument> = StickerSetData;
+
+		flags := dBuf.UInt()
+		_ = flags
+
+		// flags Debug by @benqi
+		m.UserId = dBuf.Long()
+		if (flags & (1 << 0)) != 0 {
+			m.Masks = true
+		}
+		if (flags & (1 << 1)) != 0 {
+			m.Animated = true
+		}
+		if (flags & (1 << 2)) != 0 {
+			m.Videos = true
+		}
+		m.Title = dBuf.String()
+		m.ShortName = dBuf.String()
+		if (flags & (1 << 3)) != 0 {
+			m8 := &mtproto.Document{}
+			m8.Decode(dBuf)
+			m.Thumb = m8
+		}
+
+		c9 := dBuf.Int()
+		if c9 != int32(mtproto.CRC32_vector) {
+			// dBuf.err = fmt.Errorf("invalid mtproto.CRC32_vector, c%d: %d", 9, c9)
+			return fmt.Errorf("invalid mtproto.CRC32_vector, c%d: %d", 9, c9)
+		}
+		l9 := dBuf.Int()
+		v9 := make([]*StickerSetDocument, l9)
+		for i := int32(0); i < l9; i++ {
+			v9[i] = &StickerSetDocument{}
+			v9[i].Decode(dBuf)
+		}
+		m.Stickers = v9
+
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLStickerCreateStickerSet) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLStickerAddStickerToSet
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLStickerAddStickerToSet) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_sticker_addStickerToSet))
+
+	switch uint32(m.Constructor) {
+	case 0x2b3ebaf:
+		// sticker.addStickerToSet user_id:long stickerset:InputStickerSet sticker:StickerSetDocument = StickerSetData;
+		x.UInt(0x2b3ebaf)
+
+		// no flags
+
+		x.Long(m.GetUserId())
+		x.Bytes(m.GetStickerset().Encode(layer))
+		x.Bytes(m.GetSticker().Encode(layer))
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLStickerAddStickerToSet) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLStickerAddStickerToSet) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0x2b3ebaf:
+		// sticker.addStickerToSet user_id:long stickerset:InputStickerSet sticker:StickerSetDocument = StickerSetData;
+
+		// not has flags
+
+		m.UserId = dBuf.Long()
+
+		m2 := &mtproto.InputStickerSet{}
+		m2.Decode(dBuf)
+		m.Stickerset = m2
+
+		m3 := &StickerSetDocument{}
+		m3.Decode(dBuf)
+		m.Sticker = m3
+
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLStickerAddStickerToSet) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLStickerRemoveStickerFromSet
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLStickerRemoveStickerFromSet) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_sticker_removeStickerFromSet))
+
+	switch uint32(m.Constructor) {
+	case 0x7ff19ad0:
+		// sticker.removeStickerFromSet user_id:long document_id:long = StickerSetData;
+		x.UInt(0x7ff19ad0)
+
+		// no flags
+
+		x.Long(m.GetUserId())
+		x.Long(m.GetDocumentId())
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLStickerRemoveStickerFromSet) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLStickerRemoveStickerFromSet) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0x7ff19ad0:
+		// sticker.removeStickerFromSet user_id:long document_id:long = StickerSetData;
+
+		// not has flags
+
+		m.UserId = dBuf.Long()
+		m.DocumentId = dBuf.Long()
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLStickerRemoveStickerFromSet) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLStickerCheckShortName
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLStickerCheckShortName) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_sticker_checkShortName))
+
+	switch uint32(m.Constructor) {
+	case 0xd8cdca50:
+		// sticker.checkShortName short_name:string = Bool;
+		x.UInt(0xd8cdca50)
+
+		// no flags
+
+		x.String(m.GetShortName())
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLStickerCheckShortName) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLStickerCheckShortName) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0xd8cdca50:
+		// sticker.checkShortName short_name:string = Bool;
+
+		// not has flags
+
+		m.ShortName = dBuf.String()
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLStickerCheckShortName) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLStickerGetStickerSet
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLStickerGetStickerSet) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_sticker_getStickerSet))
+
+	switch uint32(m.Constructor) {
+	case 0xe7cae727:
+		// sticker.getStickerSet user_id:long stickerset:InputStickerSet = StickerSetData;
+		x.UInt(0xe7cae727)
+
+		// no flags
+
+		x.Long(m.GetUserId())
+		x.Bytes(m.GetStickerset().Encode(layer))
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLStickerGetStickerSet) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLStickerGetStickerSet) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0xe7cae727:
+		// sticker.getStickerSet user_id:long stickerset:InputStickerSet = StickerSetData;
+
+		// not has flags
+
+		m.UserId = dBuf.Long()
+
+		m2 := &mtproto.InputStickerSet{}
+		m2.Decode(dBuf)
+		m.Stickerset = m2
+
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLStickerGetStickerSet) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLStickerGetStickerSetThumb
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLStickerGetStickerSetThumb) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_sticker_getStickerSetThumb))
+
+	switch uint32(m.Constructor) {
+	case 0x9d7076b8:
+		// sticker.getStickerSetThumb stickerset:InputStickerSet thumb_version:int = InputFileLocation;
+		x.UInt(0x9d7076b8)
+
+		// no flags
+
+		x.Bytes(m.GetStickerset().Encode(layer))
+		x.Int(m.GetThumbVersion())
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLStickerGetStickerSetThumb) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLStickerGetStickerSetThumb) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0x9d7076b8:
+		// sticker.getStickerSetThumb stickerset:InputStickerSet thumb_version:int = InputFileLocation;
+
+		// not has flags
+
+		m1 := &mtproto.InputStickerSet{}
+		m1.Decode(dBuf)
+		m.Stickerset = m1
+
+		m.ThumbVersion = dBuf.Int()
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLStickerGetStickerSetThumb) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLStickerInstallStickerSet
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLStickerInstallStickerSet) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_sticker_installStickerSet))
+
+	switch uint32(m.Constructor) {
+	case 0x86f92aee:
+		// sticker.installStickerSet user_id:long stickerset:InputStickerSet archived:Bool = StickerSet;
+		x.UInt(0x86f92aee)
+
+		// no flags
+
+		x.Long(m.GetUserId())
+		x.Bytes(m.GetStickerset().Encode(layer))
+		x.Bytes(m.GetArchived().Encode(layer))
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLStickerInstallStickerSet) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLStickerInstallStickerSet) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0x86f92aee:
+		// sticker.installStickerSet user_id:long stickerset:InputStickerSet archived:Bool = StickerSet;
+
+		// not has flags
+
+		m.UserId = dBuf.Long()
+
+		m2 := &mtproto.InputStickerSet{}
+		m2.Decode(dBuf)
+		m.Stickerset = m2
+
+		m3 := &mtproto.Bool{}
+		m3.Decode(dBuf)
+		m.Archived = m3
+
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLStickerInstallStickerSet) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLStickerUninstallStickerSet
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLStickerUninstallStickerSet) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_sticker_uninstallStickerSet))
+
+	switch uint32(m.Constructor) {
+	case 0x75c11d03:
+		// sticker.uninstallStickerSet user_id:long stickerset:InputStickerSet = Bool;
+		x.UInt(0x75c11d03)
+
+		// no flags
+
+		x.Long(m.GetUserId())
+		x.Bytes(m.GetStickerset().Encode(layer))
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLStickerUninstallStickerSet) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLStickerUninstallStickerSet) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0x75c11d03:
+		// sticker.uninstallStickerSet user_id:long stickerset:InputStickerSet = Bool;
+
+		// not has flags
+
+		m.UserId = dBuf.Long()
+
+		m2 := &mtproto.InputStickerSet{}
+		m2.Decode(dBuf)
+		m.Stickerset = m2
+
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLStickerUninstallStickerSet) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLStickerGetInstalledStickerSets
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLStickerGetInstalledStickerSets) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_sticker_getInstalledStickerSets))
+
+	switch uint32(m.Constructor) {
+	case 0xf8eececb:
+		// sticker.getInstalledStickerSets user_id:long masks:Bool = Vector<StickerSet>;
+		x.UInt(0xf8eececb)
+
+		// no flags
+
+		x.Long(m.GetUserId())
+		x.Bytes(m.GetMasks().Encode(layer))
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLStickerGetInstalledStickerSets) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLStickerGetInstalledStickerSets) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0xf8eececb:
+		// sticker.getInstalledStickerSets user_id:long masks:Bool = Vector<StickerSet>;
+
+		// not has flags
+
+		m.UserId = dBuf.Long()
+
+		m2 := &mtproto.Bool{}
+		m2.Decode(dBuf)
+		m.Masks = m2
+
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLStickerGetInstalledStickerSets) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLStickerGetRecentStickers
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLStickerGetRecentStickers) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_sticker_getRecentStickers))
+
+	switch uint32(m.Constructor) {
+	case 0xfd7db23e:
+		// sticker.getRecentStickers user_id:long attached:Bool = Vector<StickerSetDocument>;
+		x.UInt(0xfd7db23e)
+
+		// no flags
+
+		x.Long(m.GetUserId())
+		x.Bytes(m.GetAttached().Encode(layer))
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLStickerGetRecentStickers) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLStickerGetRecentStickers) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0xfd7db23e:
+		// sticker.getRecentStickers user_id:long attached:Bool = Vector<StickerSetDocument>;
+
+		// not has flags
+
+		m.UserId = dBuf.Long()
+
+		m2 := &mtproto.Bool{}
+		m2.Decode(dBuf)
+		m.Attached = m2
+
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLStickerGetRecentStickers) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLStickerSaveRecentSticker
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLStickerSaveRecentSticker) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_sticker_saveRecentSticker))
+
+	switch uint32(m.Constructor) {
+	case 0x2b0df1e:
+		// sticker.saveRecentSticker user_id:long attached:Bool document_id:long unsave:Bool = Bool;
+		x.UInt(0x2b0df1e)
+
+		// no flags
+
+		x.Long(m.GetUserId())
+		x.Bytes(m.GetAttached().Encode(layer))
+		x.Long(m.GetDocumentId())
+		x.Bytes(m.GetUnsave().Encode(layer))
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLStickerSaveRecentSticker) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLStickerSaveRecentSticker) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0x2b0df1e:
+		// sticker.saveRecentSticker user_id:long attached:Bool document_id:long unsave:Bool = Bool;
+
+		// not has flags
+
+		m.UserId = dBuf.Long()
+
+		m2 := &mtproto.Bool{}
+		m2.Decode(dBuf)
+		m.Attached = m2
+
+		m.DocumentId = dBuf.Long()
+
+		m4 := &mtproto.Bool{}
+		m4.Decode(dBuf)
+		m.Unsave = m4
+
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLStickerSaveRecentSticker) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLStickerClearRecentStickers
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLStickerClearRecentStickers) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_sticker_clearRecentStickers))
+
+	switch uint32(m.Constructor) {
+	case 0x2462539d:
+		// sticker.clearRecentStickers user_id:long attached:Bool = Bool;
+		x.UInt(0x2462539d)
+
+		// no flags
+
+		x.Long(m.GetUserId())
+		x.Bytes(m.GetAttached().Encode(layer))
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLStickerClearRecentStickers) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLStickerClearRecentStickers) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0x2462539d:
+		// sticker.clearRecentStickers user_id:long attached:Bool = Bool;
+
+		// not has flags
+
+		m.UserId = dBuf.Long()
+
+		m2 := &mtproto.Bool{}
+		m2.Decode(dBuf)
+		m.Attached = m2
+
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLStickerClearRecentStickers) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLStickerGetFavedStickers
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLStickerGetFavedStickers) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_sticker_getFavedStickers))
+
+	switch uint32(m.Constructor) {
+	case 0xace6bcfc:
+		// sticker.getFavedStickers user_id:long = Vector<StickerSetDocument>;
+		x.UInt(0xace6bcfc)
+
+		// no flags
+
+		x.Long(m.GetUserId())
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLStickerGetFavedStickers) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLStickerGetFavedStickers) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0xace6bcfc:
+		// sticker.getFavedStickers user_id:long = Vector<StickerSetDocument>;
+
+		// not has flags
+
+		m.UserId = dBuf.Long()
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLStickerGetFavedStickers) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLStickerFaveSticker
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLStickerFaveSticker) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_sticker_faveSticker))
+
+	switch uint32(m.Constructor) {
+	case 0x8762be2:
+		// sticker.faveSticker user_id:long document_id:long unfave:Bool = Bool;
+		x.UInt(0x8762be2)
+
+		// no flags
+
+		x.Long(m.GetUserId())
+		x.Long(m.GetDocumentId())
+		x.Bytes(m.GetUnfave().Encode(layer))
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLStickerFaveSticker) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLStickerFaveSticker) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0x8762be2:
+		// sticker.faveSticker user_id:long document_id:long unfave:Bool = Bool;
+
+		// not has flags
+
+		m.UserId = dBuf.Long()
+		m.DocumentId = dBuf.Long()
+
+		m3 := &mtproto.Bool{}
+		m3.Decode(dBuf)
+		m.Unfave = m3
+
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLStickerFaveSticker) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLStickerGetStickersByEmoji
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLStickerGetStickersByEmoji) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_sticker_getStickersByEmoji))
+
+	switch uint32(m.Constructor) {
+	case 0x335035d8:
+		// sticker.getStickersByEmoji user_id:long emoticon:string = Vector<StickerSetDocument>;
+		x.UInt(0x335035d8)
+
+		// no flags
+
+		x.Long(m.GetUserId())
+		x.String(m.GetEmoticon())
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLStickerGetStickersByEmoji) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLStickerGetStickersByEmoji) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0x335035d8:
+		// sticker.getStickersByEmoji user_id:long emoticon:string = Vector<StickerSetDocument>;
+
+		// not has flags
+
+		m.UserId = dBuf.Long()
+		m.Emoticon = dBuf.String()
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLStickerGetStickersByEmoji) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLStickerChangeStickerPosition
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLStickerChangeStickerPosition) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_sticker_changeStickerPosition))
+
+	switch uint32(m.Constructor) {
+	case 0xaf12c5a:
+		// sticker.changeStickerPosition user_id:long document_id:long position:int = StickerSetData;
+		x.UInt(0xaf12c5a)
+
+		// no flags
+
+		x.Long(m.GetUserId())
+		x.Long(m.GetDocumentId())
+		x.Int(m.GetPosition())
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLStickerChangeStickerPosition) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLStickerChangeStickerPosition) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0xaf12c5a:
+		// sticker.changeStickerPosition user_id:long document_id:long position:int = StickerSetData;
+
+		// not has flags
+
+		m.UserId = dBuf.Long()
+		m.DocumentId = dBuf.Long()
+		m.Position = dBuf.Int()
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLStickerChangeStickerPosition) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// TLStickerSetStickerSetThumb
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLStickerSetStickerSetThumb) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	// x.Int(int32(CRC32_sticker_setStickerSetThumb))
+
+	switch uint32(m.Constructor) {
+	case 0xbcdd7e02:
+		// sticker.setStickerSetThumb user_id:long stickerset:InputStickerSet thumb:Document = StickerSetData;
+		x.UInt(0xbcdd7e02)
+
+		// no flags
+
+		x.Long(m.GetUserId())
+		x.Bytes(m.GetStickerset().Encode(layer))
+		x.Bytes(m.GetThumb().Encode(layer))
+
+	default:
+		// log.Errorf("")
+	}
+
+	return x.GetBuf()
+}
+
+func (m *TLStickerSetStickerSetThumb) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLStickerSetStickerSetThumb) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0xbcdd7e02:
+		// sticker.setStickerSetThumb user_id:long stickerset:InputStickerSet thumb:Document = StickerSetData;
+
+		// not has flags
+
+		m.UserId = dBuf.Long()
+
+		m2 := &mtproto.InputStickerSet{}
+		m2.Decode(dBuf)
+		m.Stickerset = m2
+
+		m3 := &mtproto.Document{}
+		m3.Decode(dBuf)
+		m.Thumb = m3
+
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+func (m *TLStickerSetStickerSetThumb) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+//----------------------------------------------------------------------------------------------------------------
+// Vector_StickerSet
+///////////////////////////////////////////////////////////////////////////////
+func (m *Vector_StickerSet) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	x.Int(int32(mtproto.CRC32_vector))
+	x.Int(int32(len(m.Datas)))
+	for _, v := range m.Datas {
+		x.Bytes((*v).Encode(layer))
+	}
+
+	return x.GetBuf()
+}
+
+func (m *Vector_StickerSet) Decode(dBuf *mtproto.DecodeBuf) error {
+	dBuf.Int() // TODO(@benqi): Check crc32 invalid
+	l1 := dBuf.Int()
+	m.Datas = make([]*mtproto.StickerSet, l1)
+	for i := int32(0); i < l1; i++ {
+		m.Datas[i] = new(mtproto.StickerSet)
+		(*m.Datas[i]).Decode(dBuf)
+	}
+
+	return dBuf.GetError()
+}
+
+func (m *Vector_StickerSet) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *Vector_StickerSet) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
+
+// Vector_StickerSetDocument
+///////////////////////////////////////////////////////////////////////////////
+func (m *Vector_StickerSetDocument) Encode(layer int32) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	x.Int(int32(mtproto.CRC32_vector))
+	x.Int(int32(len(m.Datas)))
+	for _, v := range m.Datas {
+		x.Bytes((*v).Encode(layer))
+	}
+
+	return x.GetBuf()
+}
+
+func (m *Vector_StickerSetDocument) Decode(dBuf *mtproto.DecodeBuf) error {
+	dBuf.Int() // TODO(@benqi): Check crc32 invalid
+	l1 := dBuf.Int()
+	m.Datas = make([]*StickerSetDocument, l1)
+	for i := int32(0); i < l1; i++ {
+		m.Datas[i] = new(StickerSetDocument)
+		(*m.Datas[i]).Decode(dBuf)
+	}
+
+	return dBuf.GetError()
+}
+
+func (m *Vector_StickerSetDocument) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *Vector_StickerSetDocument) DebugString() string {
+	jsonm := &jsonpb.Marshaler{OrigName: true}
+	dbgString, _ := jsonm.MarshalToString(m)
+	return dbgString
+}
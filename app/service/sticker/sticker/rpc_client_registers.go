@@ -0,0 +1,64 @@
+/*
+ * WARNING! All changes made in this file will be lost!
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2022-present,  Teamgram Authors.
+ *  All rights reserved.
+ *
+ * Author: teagramio (teagram.io@gmail.com)
+ */
+
+package sticker
+
+import (
+	"reflect"
+
+	"github.com/teamgram/proto/mtproto"
+)
+
+var _ *mtproto.Bool
+
+type newRPCReplyFunc func() interface{}
+
+type RPCContextTuple struct {
+	Method       string
+	NewReplyFunc newRPCReplyFunc
+}
+
+var rpcContextRegisters = map[string]RPCContextTuple{
+	"TLStickerCreateStickerSet":        RPCContextTuple{"/mtproto.RPCSticker/sticker_createStickerSet", func() interface{} { return new(StickerSetData) }},
+	"TLStickerAddStickerToSet":         RPCContextTuple{"/mtproto.RPCSticker/sticker_addStickerToSet", func() interface{} { return new(StickerSetData) }},
+	"TLStickerRemoveStickerFromSet":    RPCContextTuple{"/mtproto.RPCSticker/sticker_removeStickerFromSet", func() interface{} { return new(StickerSetData) }},
+	"TLStickerCheckShortName":          RPCContextTuple{"/mtproto.RPCSticker/sticker_checkShortName", func() interface{} { return new(mtproto.Bool) }},
+	"TLStickerGetStickerSet":           RPCContextTuple{"/mtproto.RPCSticker/sticker_getStickerSet", func() interface{} { return new(StickerSetData) }},
+	"TLStickerGetStickerSetThumb":      RPCContextTuple{"/mtproto.RPCSticker/sticker_getStickerSetThumb", func() interface{} { return new(mtproto.InputFileLocation) }},
+	"TLStickerInstallStickerSet":       RPCContextTuple{"/mtproto.RPCSticker/sticker_installStickerSet", func() interface{} { return new(mtproto.StickerSet) }},
+	"TLStickerUninstallStickerSet":     RPCContextTuple{"/mtproto.RPCSticker/sticker_uninstallStickerSet", func() interface{} { return new(mtproto.Bool) }},
+	"TLStickerGetInstalledStickerSets": RPCContextTuple{"/mtproto.RPCSticker/sticker_getInstalledStickerSets", func() interface{} { return new(Vector_StickerSet) }},
+	"TLStickerGetRecentStickers":       RPCContextTuple{"/mtproto.RPCSticker/sticker_getRecentStickers", func() interface{} { return new(Vector_StickerSetDocument) }},
+	"TLStickerSaveRecentSticker":       RPCContextTuple{"/mtproto.RPCSticker/sticker_saveRecentSticker", func() interface{} { return new(mtproto.Bool) }},
+	"TLStickerClearRecentStickers":     RPCContextTuple{"/mtproto.RPCSticker/sticker_clearRecentStickers", func() interface{} { return new(mtproto.Bool) }},
+	"TLStickerGetFavedStickers":        RPCContextTuple{"/mtproto.RPCSticker/sticker_getFavedStickers", func() interface{} { return new(Vector_StickerSetDocument) }},
+	"TLStickerFaveSticker":             RPCContextTuple{"/mtproto.RPCSticker/sticker_faveSticker", func() interface{} { return new(mtproto.Bool) }},
+	"TLStickerGetStickersByEmoji":      RPCContextTuple{"/mtproto.RPCSticker/sticker_getStickersByEmoji", func() interface{} { return new(Vector_StickerSetDocument) }},
+	"TLStickerChangeStickerPosition":   RPCContextTuple{"/mtproto.RPCSticker/sticker_changeStickerPosition", func() interface{} { return new(StickerSetData) }},
+	"TLStickerSetStickerSetThumb":      RPCContextTuple{"/mtproto.RPCSticker/sticker_setStickerSetThumb", func() interface{} { return new(StickerSetData) }},
+}
+
+func FindRPCContextTuple(t interface{}) *RPCContextTuple {
+	rt := reflect.TypeOf(t)
+	if rt.Kind() == reflect.Ptr {
+		rt = rt.Elem()
+	}
+
+	m, ok := rpcContextRegisters[rt.Name()]
+	if !ok {
+		// log.Errorf("Can't find name: %s", rt.Name())
+		return nil
+	}
+	return &m
+}
+
+func GetRPCContextRegisters() map[string]RPCContextTuple {
+	return rpcContextRegisters
+}
@@ -0,0 +1,7870 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: sticker.tl.proto
+
+package sticker
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/types"
+	mtproto "github.com/teamgram/proto/mtproto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	reflect "reflect"
+	strings "strings"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type TLConstructor int32
+
+const (
+	CRC32_UNKNOWN                         TLConstructor = 0
+	CRC32_stickerSetDocument              TLConstructor = -334514215
+	CRC32_stickerSetData                  TLConstructor = 948201815
+	CRC32_sticker_createStickerSet        TLConstructor = -2003713060
+	CRC32_sticker_addStickerToSet         TLConstructor = 45345711
+	CRC32_sticker_removeStickerFromSet    TLConstructor = 2146540240
+	CRC32_sticker_checkShortName          TLConstructor = -657601968
+	CRC32_sticker_getStickerSet           TLConstructor = -406132953
+	CRC32_sticker_getStickerSetThumb      TLConstructor = -1653573960
+	CRC32_sticker_installStickerSet       TLConstructor = -2030490898
+	CRC32_sticker_uninstallStickerSet     TLConstructor = 1975590147
+	CRC32_sticker_getInstalledStickerSets TLConstructor = -118567221
+	CRC32_sticker_getRecentStickers       TLConstructor = -42094018
+	CRC32_sticker_saveRecentSticker       TLConstructor = 45145886
+	CRC32_sticker_clearRecentStickers     TLConstructor = 610423709
+	CRC32_sticker_getFavedStickers        TLConstructor = -1394164484
+	CRC32_sticker_faveSticker             TLConstructor = 141962210
+	CRC32_sticker_getStickersByEmoji      TLConstructor = 860894680
+	CRC32_sticker_changeStickerPosition   TLConstructor = 183577690
+	CRC32_sticker_setStickerSetThumb      TLConstructor = -1126334974
+)
+
+var TLConstructor_name = map[int32]string{
+	0:           "CRC32_UNKNOWN",
+	-334514215:  "CRC32_stickerSetDocument",
+	948201815:   "CRC32_stickerSetData",
+	-2003713060: "CRC32_sticker_createStickerSet",
+	45345711:    "CRC32_sticker_addStickerToSet",
+	2146540240:  "CRC32_sticker_removeStickerFromSet",
+	-657601968:  "CRC32_sticker_checkShortName",
+	-406132953:  "CRC32_sticker_getStickerSet",
+	-1653573960: "CRC32_sticker_getStickerSetThumb",
+	-2030490898: "CRC32_sticker_installStickerSet",
+	1975590147:  "CRC32_sticker_uninstallStickerSet",
+	-118567221:  "CRC32_sticker_getInstalledStickerSets",
+	-42094018:   "CRC32_sticker_getRecentStickers",
+	45145886:    "CRC32_sticker_saveRecentSticker",
+	610423709:   "CRC32_sticker_clearRecentStickers",
+	-1394164484: "CRC32_sticker_getFavedStickers",
+	141962210:   "CRC32_sticker_faveSticker",
+	860894680:   "CRC32_sticker_getStickersByEmoji",
+	183577690:   "CRC32_sticker_changeStickerPosition",
+	-1126334974: "CRC32_sticker_setStickerSetThumb",
+}
+
+var TLConstructor_value = map[string]int32{
+	"CRC32_UNKNOWN":                         0,
+	"CRC32_stickerSetDocument":              -334514215,
+	"CRC32_stickerSetData":                  948201815,
+	"CRC32_sticker_createStickerSet":        -2003713060,
+	"CRC32_sticker_addStickerToSet":         45345711,
+	"CRC32_sticker_removeStickerFromSet":    2146540240,
+	"CRC32_sticker_checkShortName":          -657601968,
+	"CRC32_sticker_getStickerSet":           -406132953,
+	"CRC32_sticker_getStickerSetThumb":      -1653573960,
+	"CRC32_sticker_installStickerSet":       -2030490898,
+	"CRC32_sticker_uninstallStickerSet":     1975590147,
+	"CRC32_sticker_getInstalledStickerSets": -118567221,
+	"CRC32_sticker_getRecentStickers":       -42094018,
+	"CRC32_sticker_saveRecentSticker":       45145886,
+	"CRC32_sticker_clearRecentStickers":     610423709,
+	"CRC32_sticker_getFavedStickers":        -1394164484,
+	"CRC32_sticker_faveSticker":             141962210,
+	"CRC32_sticker_getStickersByEmoji":      860894680,
+	"CRC32_sticker_changeStickerPosition":   183577690,
+	"CRC32_sticker_setStickerSetThumb":      -1126334974,
+}
+
+func (x TLConstructor) String() string {
+	return proto.EnumName(TLConstructor_name, int32(x))
+}
+
+func (TLConstructor) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_4cab48ebee30d6c7, []int{0}
+}
+
+//--------------------------------------------------------------------------------------------
+// stickerSetDocument set_id:long set_access_hash:long document_id:long emoji:string date:int = StickerSetDocument;
+//
+// StickerSetDocument <--
+//  + TL_stickerSetDocument
+//
+type StickerSetDocument struct {
+	PredicateName        string        `protobuf:"bytes,1,opt,name=predicate_name,json=predicateName,proto3" json:"predicate_name,omitempty"`
+	Constructor          TLConstructor `protobuf:"varint,2,opt,name=constructor,proto3,enum=sticker.TLConstructor" json:"constructor,omitempty"`
+	SetId                int64         `protobuf:"varint,3,opt,name=set_id,json=setId,proto3" json:"set_id,omitempty"`
+	SetAccessHash        int64         `protobuf:"varint,4,opt,name=set_access_hash,json=setAccessHash,proto3" json:"set_access_hash,omitempty"`
+	DocumentId           int64         `protobuf:"varint,5,opt,name=document_id,json=documentId,proto3" json:"document_id,omitempty"`
+	Emoji                string        `protobuf:"bytes,6,opt,name=emoji,proto3" json:"emoji,omitempty"`
+	Date                 int32         `protobuf:"varint,7,opt,name=date,proto3" json:"date,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *StickerSetDocument) Reset()         { *m = StickerSetDocument{} }
+func (m *StickerSetDocument) String() string { return proto.CompactTextString(m) }
+func (*StickerSetDocument) ProtoMessage()    {}
+func (*StickerSetDocument) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4cab48ebee30d6c7, []int{0}
+}
+func (m *StickerSetDocument) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StickerSetDocument) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StickerSetDocument.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StickerSetDocument) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StickerSetDocument.Merge(m, src)
+}
+func (m *StickerSetDocument) XXX_Size() int {
+	return m.Size()
+}
+func (m *StickerSetDocument) XXX_DiscardUnknown() {
+	xxx_messageInfo_StickerSetDocument.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StickerSetDocument proto.InternalMessageInfo
+
+func (m *StickerSetDocument) GetPredicateName() string {
+	if m != nil {
+		return m.PredicateName
+	}
+	return ""
+}
+
+func (m *StickerSetDocument) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
+	}
+	return CRC32_UNKNOWN
+}
+
+func (m *StickerSetDocument) GetSetId() int64 {
+	if m != nil {
+		return m.SetId
+	}
+	return 0
+}
+
+func (m *StickerSetDocument) GetSetAccessHash() int64 {
+	if m != nil {
+		return m.SetAccessHash
+	}
+	return 0
+}
+
+func (m *StickerSetDocument) GetDocumentId() int64 {
+	if m != nil {
+		return m.DocumentId
+	}
+	return 0
+}
+
+func (m *StickerSetDocument) GetEmoji() string {
+	if m != nil {
+		return m.Emoji
+	}
+	return ""
+}
+
+func (m *StickerSetDocument) GetDate() int32 {
+	if m != nil {
+		return m.Date
+	}
+	return 0
+}
+
+// stickerSetDocument set_id:long set_access_hash:long document_id:long emoji:string date:int = StickerSetDocument;
+type TLStickerSetDocument struct {
+	Data2                *StickerSetDocument `protobuf:"bytes,1,opt,name=data2,proto3" json:"data2,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *TLStickerSetDocument) Reset()         { *m = TLStickerSetDocument{} }
+func (m *TLStickerSetDocument) String() string { return proto.CompactTextString(m) }
+func (*TLStickerSetDocument) ProtoMessage()    {}
+func (*TLStickerSetDocument) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4cab48ebee30d6c7, []int{1}
+}
+func (m *TLStickerSetDocument) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLStickerSetDocument) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLStickerSetDocument.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TLStickerSetDocument) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLStickerSetDocument.Merge(m, src)
+}
+func (m *TLStickerSetDocument) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLStickerSetDocument) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLStickerSetDocument.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLStickerSetDocument proto.InternalMessageInfo
+
+func (m *TLStickerSetDocument) GetData2() *StickerSetDocument {
+	if m != nil {
+		return m.Data2
+	}
+	return nil
+}
+
+//--------------------------------------------------------------------------------------------
+// stickerSetData set:StickerSet documents:Vector<StickerSetDocument> = StickerSetData;
+//
+// StickerSetData <--
+//  + TL_stickerSetData
+//
+type StickerSetData struct {
+	PredicateName        string                `protobuf:"bytes,1,opt,name=predicate_name,json=predicateName,proto3" json:"predicate_name,omitempty"`
+	Constructor          TLConstructor         `protobuf:"varint,2,opt,name=constructor,proto3,enum=sticker.TLConstructor" json:"constructor,omitempty"`
+	Set                  *mtproto.StickerSet   `protobuf:"bytes,3,opt,name=set,proto3" json:"set,omitempty"`
+	Documents            []*StickerSetDocument `protobuf:"bytes,4,rep,name=documents,proto3" json:"documents,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *StickerSetData) Reset()         { *m = StickerSetData{} }
+func (m *StickerSetData) String() string { return proto.CompactTextString(m) }
+func (*StickerSetData) ProtoMessage()    {}
+func (*StickerSetData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4cab48ebee30d6c7, []int{2}
+}
+func (m *StickerSetData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StickerSetData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StickerSetData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StickerSetData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StickerSetData.Merge(m, src)
+}
+func (m *StickerSetData) XXX_Size() int {
+	return m.Size()
+}
+func (m *StickerSetData) XXX_DiscardUnknown() {
+	xxx_messageInfo_StickerSetData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StickerSetData proto.InternalMessageInfo
+
+func (m *StickerSetData) GetPredicateName() string {
+	if m != nil {
+		return m.PredicateName
+	}
+	return ""
+}
+
+func (m *StickerSetData) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
+	}
+	return CRC32_UNKNOWN
+}
+
+func (m *StickerSetData) GetSet() *mtproto.StickerSet {
+	if m != nil {
+		return m.Set
+	}
+	return nil
+}
+
+func (m *StickerSetData) GetDocuments() []*StickerSetDocument {
+	if m != nil {
+		return m.Documents
+	}
+	return nil
+}
+
+// stickerSetData set:StickerSet documents:Vector<StickerSetDocument> = StickerSetData;
+type TLStickerSetData struct {
+	Data2                *StickerSetData `protobuf:"bytes,1,opt,name=data2,proto3" json:"data2,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *TLStickerSetData) Reset()         { *m = TLStickerSetData{} }
+func (m *TLStickerSetData) String() string { return proto.CompactTextString(m) }
+func (*TLStickerSetData) ProtoMessage()    {}
+func (*TLStickerSetData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4cab48ebee30d6c7, []int{3}
+}
+func (m *TLStickerSetData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLStickerSetData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLStickerSetData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TLStickerSetData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLStickerSetData.Merge(m, src)
+}
+func (m *TLStickerSetData) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLStickerSetData) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLStickerSetData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLStickerSetData proto.InternalMessageInfo
+
+func (m *TLStickerSetData) GetData2() *StickerSetData {
+	if m != nil {
+		return m.Data2
+	}
+	return nil
+}
+
+//--------------------------------------------------------------------------------------------
+// sticker.createStickerSet flags:# user_id:long masks:flags.0?true animated:flags.1?true videos:flags.2?true title:string short_name:string thumb:flags.3?Document stickers:Vector<StickerSetDocument> = StickerSetData;
+type TLStickerCreateStickerSet struct {
+	Constructor          TLConstructor         `protobuf:"varint,1,opt,name=constructor,proto3,enum=sticker.TLConstructor" json:"constructor,omitempty"`
+	UserId               int64                 `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Masks                bool                  `protobuf:"varint,4,opt,name=masks,proto3" json:"masks,omitempty"`
+	Animated             bool                  `protobuf:"varint,5,opt,name=animated,proto3" json:"animated,omitempty"`
+	Videos               bool                  `protobuf:"varint,6,opt,name=videos,proto3" json:"videos,omitempty"`
+	Title                string                `protobuf:"bytes,7,opt,name=title,proto3" json:"title,omitempty"`
+	ShortName            string                `protobuf:"bytes,8,opt,name=short_name,json=shortName,proto3" json:"short_name,omitempty"`
+	Thumb                *mtproto.Document     `protobuf:"bytes,9,opt,name=thumb,proto3" json:"thumb,omitempty"`
+	Stickers             []*StickerSetDocument `protobuf:"bytes,10,rep,name=stickers,proto3" json:"stickers,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *TLStickerCreateStickerSet) Reset()         { *m = TLStickerCreateStickerSet{} }
+func (m *TLStickerCreateStickerSet) String() string { return proto.CompactTextString(m) }
+func (*TLStickerCreateStickerSet) ProtoMessage()    {}
+func (*TLStickerCreateStickerSet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4cab48ebee30d6c7, []int{4}
+}
+func (m *TLStickerCreateStickerSet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLStickerCreateStickerSet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLStickerCreateStickerSet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TLStickerCreateStickerSet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLStickerCreateStickerSet.Merge(m, src)
+}
+func (m *TLStickerCreateStickerSet) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLStickerCreateStickerSet) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLStickerCreateStickerSet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLStickerCreateStickerSet proto.InternalMessageInfo
+
+func (m *TLStickerCreateStickerSet) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
+	}
+	return CRC32_UNKNOWN
+}
+
+func (m *TLStickerCreateStickerSet) GetUserId() int64 {
+	if m != nil {
+		return m.UserId
+	}
+	return 0
+}
+
+func (m *TLStickerCreateStickerSet) GetMasks() bool {
+	if m != nil {
+		return m.Masks
+	}
+	return false
+}
+
+func (m *TLStickerCreateStickerSet) GetAnimated() bool {
+	if m != nil {
+		return m.Animated
+	}
+	return false
+}
+
+func (m *TLStickerCreateStickerSet) GetVideos() bool {
+	if m != nil {
+		return m.Videos
+	}
+	return false
+}
+
+func (m *TLStickerCreateStickerSet) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *TLStickerCreateStickerSet) GetShortName() string {
+	if m != nil {
+		return m.ShortName
+	}
+	return ""
+}
+
+func (m *TLStickerCreateStickerSet) GetThumb() *mtproto.Document {
+	if m != nil {
+		return m.Thumb
+	}
+	return nil
+}
+
+func (m *TLStickerCreateStickerSet) GetStickers() []*StickerSetDocument {
+	if m != nil {
+		return m.Stickers
+	}
+	return nil
+}
+
+//--------------------------------------------------------------------------------------------
+// sticker.addStickerToSet user_id:long stickerset:InputStickerSet sticker:StickerSetDocument = StickerSetData;
+type TLStickerAddStickerToSet struct {
+	Constructor          TLConstructor            `protobuf:"varint,1,opt,name=constructor,proto3,enum=sticker.TLConstructor" json:"constructor,omitempty"`
+	UserId               int64                    `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Stickerset           *mtproto.InputStickerSet `protobuf:"bytes,4,opt,name=stickerset,proto3" json:"stickerset,omitempty"`
+	Sticker              *StickerSetDocument      `protobuf:"bytes,5,opt,name=sticker,proto3" json:"sticker,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *TLStickerAddStickerToSet) Reset()         { *m = TLStickerAddStickerToSet{} }
+func (m *TLStickerAddStickerToSet) String() string { return proto.CompactTextString(m) }
+func (*TLStickerAddStickerToSet) ProtoMessage()    {}
+func (*TLStickerAddStickerToSet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4cab48ebee30d6c7, []int{5}
+}
+func (m *TLStickerAddStickerToSet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLStickerAddStickerToSet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLStickerAddStickerToSet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TLStickerAddStickerToSet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLStickerAddStickerToSet.Merge(m, src)
+}
+func (m *TLStickerAddStickerToSet) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLStickerAddStickerToSet) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLStickerAddStickerToSet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLStickerAddStickerToSet proto.InternalMessageInfo
+
+func (m *TLStickerAddStickerToSet) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
+	}
+	return CRC32_UNKNOWN
+}
+
+func (m *TLStickerAddStickerToSet) GetUserId() int64 {
+	if m != nil {
+		return m.UserId
+	}
+	return 0
+}
+
+func (m *TLStickerAddStickerToSet) GetStickerset() *mtproto.InputStickerSet {
+	if m != nil {
+		return m.Stickerset
+	}
+	return nil
+}
+
+func (m *TLStickerAddStickerToSet) GetSticker() *StickerSetDocument {
+	if m != nil {
+		return m.Sticker
+	}
+	return nil
+}
+
+//--------------------------------------------------------------------------------------------
+// sticker.removeStickerFromSet user_id:long document_id:long = StickerSetData;
+type TLStickerRemoveStickerFromSet struct {
+	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=sticker.TLConstructor" json:"constructor,omitempty"`
+	UserId               int64         `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	DocumentId           int64         `protobuf:"varint,4,opt,name=document_id,json=documentId,proto3" json:"document_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *TLStickerRemoveStickerFromSet) Reset()         { *m = TLStickerRemoveStickerFromSet{} }
+func (m *TLStickerRemoveStickerFromSet) String() string { return proto.CompactTextString(m) }
+func (*TLStickerRemoveStickerFromSet) ProtoMessage()    {}
+func (*TLStickerRemoveStickerFromSet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4cab48ebee30d6c7, []int{6}
+}
+func (m *TLStickerRemoveStickerFromSet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLStickerRemoveStickerFromSet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLStickerRemoveStickerFromSet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TLStickerRemoveStickerFromSet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLStickerRemoveStickerFromSet.Merge(m, src)
+}
+func (m *TLStickerRemoveStickerFromSet) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLStickerRemoveStickerFromSet) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLStickerRemoveStickerFromSet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLStickerRemoveStickerFromSet proto.InternalMessageInfo
+
+func (m *TLStickerRemoveStickerFromSet) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
+	}
+	return CRC32_UNKNOWN
+}
+
+func (m *TLStickerRemoveStickerFromSet) GetUserId() int64 {
+	if m != nil {
+		return m.UserId
+	}
+	return 0
+}
+
+func (m *TLStickerRemoveStickerFromSet) GetDocumentId() int64 {
+	if m != nil {
+		return m.DocumentId
+	}
+	return 0
+}
+
+//--------------------------------------------------------------------------------------------
+// sticker.checkShortName short_name:string = Bool;
+type TLStickerCheckShortName struct {
+	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=sticker.TLConstructor" json:"constructor,omitempty"`
+	ShortName            string        `protobuf:"bytes,3,opt,name=short_name,json=shortName,proto3" json:"short_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *TLStickerCheckShortName) Reset()         { *m = TLStickerCheckShortName{} }
+func (m *TLStickerCheckShortName) String() string { return proto.CompactTextString(m) }
+func (*TLStickerCheckShortName) ProtoMessage()    {}
+func (*TLStickerCheckShortName) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4cab48ebee30d6c7, []int{7}
+}
+func (m *TLStickerCheckShortName) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLStickerCheckShortName) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLStickerCheckShortName.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TLStickerCheckShortName) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLStickerCheckShortName.Merge(m, src)
+}
+func (m *TLStickerCheckShortName) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLStickerCheckShortName) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLStickerCheckShortName.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLStickerCheckShortName proto.InternalMessageInfo
+
+func (m *TLStickerCheckShortName) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
+	}
+	return CRC32_UNKNOWN
+}
+
+func (m *TLStickerCheckShortName) GetShortName() string {
+	if m != nil {
+		return m.ShortName
+	}
+	return ""
+}
+
+//--------------------------------------------------------------------------------------------
+// sticker.getStickerSet user_id:long stickerset:InputStickerSet = StickerSetData;
+type TLStickerGetStickerSet struct {
+	Constructor          TLConstructor            `protobuf:"varint,1,opt,name=constructor,proto3,enum=sticker.TLConstructor" json:"constructor,omitempty"`
+	UserId               int64                    `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Stickerset           *mtproto.InputStickerSet `protobuf:"bytes,4,opt,name=stickerset,proto3" json:"stickerset,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *TLStickerGetStickerSet) Reset()         { *m = TLStickerGetStickerSet{} }
+func (m *TLStickerGetStickerSet) String() string { return proto.CompactTextString(m) }
+func (*TLStickerGetStickerSet) ProtoMessage()    {}
+func (*TLStickerGetStickerSet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4cab48ebee30d6c7, []int{8}
+}
+func (m *TLStickerGetStickerSet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLStickerGetStickerSet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLStickerGetStickerSet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TLStickerGetStickerSet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLStickerGetStickerSet.Merge(m, src)
+}
+func (m *TLStickerGetStickerSet) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLStickerGetStickerSet) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLStickerGetStickerSet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLStickerGetStickerSet proto.InternalMessageInfo
+
+func (m *TLStickerGetStickerSet) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
+	}
+	return CRC32_UNKNOWN
+}
+
+func (m *TLStickerGetStickerSet) GetUserId() int64 {
+	if m != nil {
+		return m.UserId
+	}
+	return 0
+}
+
+func (m *TLStickerGetStickerSet) GetStickerset() *mtproto.InputStickerSet {
+	if m != nil {
+		return m.Stickerset
+	}
+	return nil
+}
+
+//--------------------------------------------------------------------------------------------
+// sticker.getStickerSetThumb stickerset:InputStickerSet thumb_version:int = InputFileLocation;
+type TLStickerGetStickerSetThumb struct {
+	Constructor          TLConstructor            `protobuf:"varint,1,opt,name=constructor,proto3,enum=sticker.TLConstructor" json:"constructor,omitempty"`
+	Stickerset           *mtproto.InputStickerSet `protobuf:"bytes,3,opt,name=stickerset,proto3" json:"stickerset,omitempty"`
+	ThumbVersion         int32                    `protobuf:"varint,4,opt,name=thumb_version,json=thumbVersion,proto3" json:"thumb_version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *TLStickerGetStickerSetThumb) Reset()         { *m = TLStickerGetStickerSetThumb{} }
+func (m *TLStickerGetStickerSetThumb) String() string { return proto.CompactTextString(m) }
+func (*TLStickerGetStickerSetThumb) ProtoMessage()    {}
+func (*TLStickerGetStickerSetThumb) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4cab48ebee30d6c7, []int{9}
+}
+func (m *TLStickerGetStickerSetThumb) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLStickerGetStickerSetThumb) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLStickerGetStickerSetThumb.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TLStickerGetStickerSetThumb) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLStickerGetStickerSetThumb.Merge(m, src)
+}
+func (m *TLStickerGetStickerSetThumb) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLStickerGetStickerSetThumb) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLStickerGetStickerSetThumb.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLStickerGetStickerSetThumb proto.InternalMessageInfo
+
+func (m *TLStickerGetStickerSetThumb) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
+	}
+	return CRC32_UNKNOWN
+}
+
+func (m *TLStickerGetStickerSetThumb) GetStickerset() *mtproto.InputStickerSet {
+	if m != nil {
+		return m.Stickerset
+	}
+	return nil
+}
+
+func (m *TLStickerGetStickerSetThumb) GetThumbVersion() int32 {
+	if m != nil {
+		return m.ThumbVersion
+	}
+	return 0
+}
+
+//--------------------------------------------------------------------------------------------
+// sticker.installStickerSet user_id:long stickerset:InputStickerSet archived:Bool = StickerSet;
+type TLStickerInstallStickerSet struct {
+	Constructor          TLConstructor            `protobuf:"varint,1,opt,name=constructor,proto3,enum=sticker.TLConstructor" json:"constructor,omitempty"`
+	UserId               int64                    `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Stickerset           *mtproto.InputStickerSet `protobuf:"bytes,4,opt,name=stickerset,proto3" json:"stickerset,omitempty"`
+	Archived             *mtproto.Bool            `protobuf:"bytes,5,opt,name=archived,proto3" json:"archived,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *TLStickerInstallStickerSet) Reset()         { *m = TLStickerInstallStickerSet{} }
+func (m *TLStickerInstallStickerSet) String() string { return proto.CompactTextString(m) }
+func (*TLStickerInstallStickerSet) ProtoMessage()    {}
+func (*TLStickerInstallStickerSet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4cab48ebee30d6c7, []int{10}
+}
+func (m *TLStickerInstallStickerSet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLStickerInstallStickerSet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLStickerInstallStickerSet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TLStickerInstallStickerSet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLStickerInstallStickerSet.Merge(m, src)
+}
+func (m *TLStickerInstallStickerSet) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLStickerInstallStickerSet) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLStickerInstallStickerSet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLStickerInstallStickerSet proto.InternalMessageInfo
+
+func (m *TLStickerInstallStickerSet) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
+	}
+	return CRC32_UNKNOWN
+}
+
+func (m *TLStickerInstallStickerSet) GetUserId() int64 {
+	if m != nil {
+		return m.UserId
+	}
+	return 0
+}
+
+func (m *TLStickerInstallStickerSet) GetStickerset() *mtproto.InputStickerSet {
+	if m != nil {
+		return m.Stickerset
+	}
+	return nil
+}
+
+func (m *TLStickerInstallStickerSet) GetArchived() *mtproto.Bool {
+	if m != nil {
+		return m.Archived
+	}
+	return nil
+}
+
+//--------------------------------------------------------------------------------------------
+// sticker.uninstallStickerSet user_id:long stickerset:InputStickerSet = Bool;
+type TLStickerUninstallStickerSet struct {
+	Constructor          TLConstructor            `protobuf:"varint,1,opt,name=constructor,proto3,enum=sticker.TLConstructor" json:"constructor,omitempty"`
+	UserId               int64                    `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Stickerset           *mtproto.InputStickerSet `protobuf:"bytes,4,opt,name=stickerset,proto3" json:"stickerset,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *TLStickerUninstallStickerSet) Reset()         { *m = TLStickerUninstallStickerSet{} }
+func (m *TLStickerUninstallStickerSet) String() string { return proto.CompactTextString(m) }
+func (*TLStickerUninstallStickerSet) ProtoMessage()    {}
+func (*TLStickerUninstallStickerSet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4cab48ebee30d6c7, []int{11}
+}
+func (m *TLStickerUninstallStickerSet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLStickerUninstallStickerSet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLStickerUninstallStickerSet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TLStickerUninstallStickerSet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLStickerUninstallStickerSet.Merge(m, src)
+}
+func (m *TLStickerUninstallStickerSet) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLStickerUninstallStickerSet) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLStickerUninstallStickerSet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLStickerUninstallStickerSet proto.InternalMessageInfo
+
+func (m *TLStickerUninstallStickerSet) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
+	}
+	return CRC32_UNKNOWN
+}
+
+func (m *TLStickerUninstallStickerSet) GetUserId() int64 {
+	if m != nil {
+		return m.UserId
+	}
+	return 0
+}
+
+func (m *TLStickerUninstallStickerSet) GetStickerset() *mtproto.InputStickerSet {
+	if m != nil {
+		return m.Stickerset
+	}
+	return nil
+}
+
+//--------------------------------------------------------------------------------------------
+// sticker.getInstalledStickerSets user_id:long masks:Bool = Vector<StickerSet>;
+type TLStickerGetInstalledStickerSets struct {
+	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=sticker.TLConstructor" json:"constructor,omitempty"`
+	UserId               int64         `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Masks                *mtproto.Bool `protobuf:"bytes,4,opt,name=masks,proto3" json:"masks,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *TLStickerGetInstalledStickerSets) Reset()         { *m = TLStickerGetInstalledStickerSets{} }
+func (m *TLStickerGetInstalledStickerSets) String() string { return proto.CompactTextString(m) }
+func (*TLStickerGetInstalledStickerSets) ProtoMessage()    {}
+func (*TLStickerGetInstalledStickerSets) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4cab48ebee30d6c7, []int{12}
+}
+func (m *TLStickerGetInstalledStickerSets) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLStickerGetInstalledStickerSets) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLStickerGetInstalledStickerSets.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TLStickerGetInstalledStickerSets) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLStickerGetInstalledStickerSets.Merge(m, src)
+}
+func (m *TLStickerGetInstalledStickerSets) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLStickerGetInstalledStickerSets) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLStickerGetInstalledStickerSets.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLStickerGetInstalledStickerSets proto.InternalMessageInfo
+
+func (m *TLStickerGetInstalledStickerSets) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
+	}
+	return CRC32_UNKNOWN
+}
+
+func (m *TLStickerGetInstalledStickerSets) GetUserId() int64 {
+	if m != nil {
+		return m.UserId
+	}
+	return 0
+}
+
+func (m *TLStickerGetInstalledStickerSets) GetMasks() *mtproto.Bool {
+	if m != nil {
+		return m.Masks
+	}
+	return nil
+}
+
+//--------------------------------------------------------------------------------------------
+// sticker.getRecentStickers user_id:long attached:Bool = Vector<StickerSetDocument>;
+type TLStickerGetRecentStickers struct {
+	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=sticker.TLConstructor" json:"constructor,omitempty"`
+	UserId               int64         `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Attached             *mtproto.Bool `protobuf:"bytes,4,opt,name=attached,proto3" json:"attached,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *TLStickerGetRecentStickers) Reset()         { *m = TLStickerGetRecentStickers{} }
+func (m *TLStickerGetRecentStickers) String() string { return proto.CompactTextString(m) }
+func (*TLStickerGetRecentStickers) ProtoMessage()    {}
+func (*TLStickerGetRecentStickers) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4cab48ebee30d6c7, []int{13}
+}
+func (m *TLStickerGetRecentStickers) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLStickerGetRecentStickers) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLStickerGetRecentStickers.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TLStickerGetRecentStickers) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLStickerGetRecentStickers.Merge(m, src)
+}
+func (m *TLStickerGetRecentStickers) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLStickerGetRecentStickers) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLStickerGetRecentStickers.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLStickerGetRecentStickers proto.InternalMessageInfo
+
+func (m *TLStickerGetRecentStickers) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
+	}
+	return CRC32_UNKNOWN
+}
+
+func (m *TLStickerGetRecentStickers) GetUserId() int64 {
+	if m != nil {
+		return m.UserId
+	}
+	return 0
+}
+
+func (m *TLStickerGetRecentStickers) GetAttached() *mtproto.Bool {
+	if m != nil {
+		return m.Attached
+	}
+	return nil
+}
+
+//--------------------------------------------------------------------------------------------
+// sticker.saveRecentSticker user_id:long attached:Bool document_id:long unsave:Bool = Bool;
+type TLStickerSaveRecentSticker struct {
+	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=sticker.TLConstructor" json:"constructor,omitempty"`
+	UserId               int64         `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Attached             *mtproto.Bool `protobuf:"bytes,4,opt,name=attached,proto3" json:"attached,omitempty"`
+	DocumentId           int64         `protobuf:"varint,5,opt,name=document_id,json=documentId,proto3" json:"document_id,omitempty"`
+	Unsave               *mtproto.Bool `protobuf:"bytes,6,opt,name=unsave,proto3" json:"unsave,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *TLStickerSaveRecentSticker) Reset()         { *m = TLStickerSaveRecentSticker{} }
+func (m *TLStickerSaveRecentSticker) String() string { return proto.CompactTextString(m) }
+func (*TLStickerSaveRecentSticker) ProtoMessage()    {}
+func (*TLStickerSaveRecentSticker) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4cab48ebee30d6c7, []int{14}
+}
+func (m *TLStickerSaveRecentSticker) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLStickerSaveRecentSticker) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLStickerSaveRecentSticker.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TLStickerSaveRecentSticker) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLStickerSaveRecentSticker.Merge(m, src)
+}
+func (m *TLStickerSaveRecentSticker) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLStickerSaveRecentSticker) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLStickerSaveRecentSticker.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLStickerSaveRecentSticker proto.InternalMessageInfo
+
+func (m *TLStickerSaveRecentSticker) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
+	}
+	return CRC32_UNKNOWN
+}
+
+func (m *TLStickerSaveRecentSticker) GetUserId() int64 {
+	if m != nil {
+		return m.UserId
+	}
+	return 0
+}
+
+func (m *TLStickerSaveRecentSticker) GetAttached() *mtproto.Bool {
+	if m != nil {
+		return m.Attached
+	}
+	return nil
+}
+
+func (m *TLStickerSaveRecentSticker) GetDocumentId() int64 {
+	if m != nil {
+		return m.DocumentId
+	}
+	return 0
+}
+
+func (m *TLStickerSaveRecentSticker) GetUnsave() *mtproto.Bool {
+	if m != nil {
+		return m.Unsave
+	}
+	return nil
+}
+
+//--------------------------------------------------------------------------------------------
+// sticker.clearRecentStickers user_id:long attached:Bool = Bool;
+type TLStickerClearRecentStickers struct {
+	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=sticker.TLConstructor" json:"constructor,omitempty"`
+	UserId               int64         `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Attached             *mtproto.Bool `protobuf:"bytes,4,opt,name=attached,proto3" json:"attached,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *TLStickerClearRecentStickers) Reset()         { *m = TLStickerClearRecentStickers{} }
+func (m *TLStickerClearRecentStickers) String() string { return proto.CompactTextString(m) }
+func (*TLStickerClearRecentStickers) ProtoMessage()    {}
+func (*TLStickerClearRecentStickers) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4cab48ebee30d6c7, []int{15}
+}
+func (m *TLStickerClearRecentStickers) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLStickerClearRecentStickers) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLStickerClearRecentStickers.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TLStickerClearRecentStickers) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLStickerClearRecentStickers.Merge(m, src)
+}
+func (m *TLStickerClearRecentStickers) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLStickerClearRecentStickers) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLStickerClearRecentStickers.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLStickerClearRecentStickers proto.InternalMessageInfo
+
+func (m *TLStickerClearRecentStickers) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
+	}
+	return CRC32_UNKNOWN
+}
+
+func (m *TLStickerClearRecentStickers) GetUserId() int64 {
+	if m != nil {
+		return m.UserId
+	}
+	return 0
+}
+
+func (m *TLStickerClearRecentStickers) GetAttached() *mtproto.Bool {
+	if m != nil {
+		return m.Attached
+	}
+	return nil
+}
+
+//--------------------------------------------------------------------------------------------
+// sticker.getFavedStickers user_id:long = Vector<StickerSetDocument>;
+type TLStickerGetFavedStickers struct {
+	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=sticker.TLConstructor" json:"constructor,omitempty"`
+	UserId               int64         `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *TLStickerGetFavedStickers) Reset()         { *m = TLStickerGetFavedStickers{} }
+func (m *TLStickerGetFavedStickers) String() string { return proto.CompactTextString(m) }
+func (*TLStickerGetFavedStickers) ProtoMessage()    {}
+func (*TLStickerGetFavedStickers) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4cab48ebee30d6c7, []int{16}
+}
+func (m *TLStickerGetFavedStickers) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLStickerGetFavedStickers) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLStickerGetFavedStickers.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TLStickerGetFavedStickers) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLStickerGetFavedStickers.Merge(m, src)
+}
+func (m *TLStickerGetFavedStickers) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLStickerGetFavedStickers) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLStickerGetFavedStickers.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLStickerGetFavedStickers proto.InternalMessageInfo
+
+func (m *TLStickerGetFavedStickers) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
+	}
+	return CRC32_UNKNOWN
+}
+
+func (m *TLStickerGetFavedStickers) GetUserId() int64 {
+	if m != nil {
+		return m.UserId
+	}
+	return 0
+}
+
+//--------------------------------------------------------------------------------------------
+// sticker.faveSticker user_id:long document_id:long unfave:Bool = Bool;
+type TLStickerFaveSticker struct {
+	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=sticker.TLConstructor" json:"constructor,omitempty"`
+	UserId               int64         `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	DocumentId           int64         `protobuf:"varint,4,opt,name=document_id,json=documentId,proto3" json:"document_id,omitempty"`
+	Unfave               *mtproto.Bool `protobuf:"bytes,5,opt,name=unfave,proto3" json:"unfave,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *TLStickerFaveSticker) Reset()         { *m = TLStickerFaveSticker{} }
+func (m *TLStickerFaveSticker) String() string { return proto.CompactTextString(m) }
+func (*TLStickerFaveSticker) ProtoMessage()    {}
+func (*TLStickerFaveSticker) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4cab48ebee30d6c7, []int{17}
+}
+func (m *TLStickerFaveSticker) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLStickerFaveSticker) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLStickerFaveSticker.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TLStickerFaveSticker) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLStickerFaveSticker.Merge(m, src)
+}
+func (m *TLStickerFaveSticker) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLStickerFaveSticker) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLStickerFaveSticker.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLStickerFaveSticker proto.InternalMessageInfo
+
+func (m *TLStickerFaveSticker) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
+	}
+	return CRC32_UNKNOWN
+}
+
+func (m *TLStickerFaveSticker) GetUserId() int64 {
+	if m != nil {
+		return m.UserId
+	}
+	return 0
+}
+
+func (m *TLStickerFaveSticker) GetDocumentId() int64 {
+	if m != nil {
+		return m.DocumentId
+	}
+	return 0
+}
+
+func (m *TLStickerFaveSticker) GetUnfave() *mtproto.Bool {
+	if m != nil {
+		return m.Unfave
+	}
+	return nil
+}
+
+//--------------------------------------------------------------------------------------------
+// sticker.getStickersByEmoji user_id:long emoticon:string = Vector<StickerSetDocument>;
+type TLStickerGetStickersByEmoji struct {
+	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=sticker.TLConstructor" json:"constructor,omitempty"`
+	UserId               int64         `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Emoticon             string        `protobuf:"bytes,4,opt,name=emoticon,proto3" json:"emoticon,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *TLStickerGetStickersByEmoji) Reset()         { *m = TLStickerGetStickersByEmoji{} }
+func (m *TLStickerGetStickersByEmoji) String() string { return proto.CompactTextString(m) }
+func (*TLStickerGetStickersByEmoji) ProtoMessage()    {}
+func (*TLStickerGetStickersByEmoji) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4cab48ebee30d6c7, []int{18}
+}
+func (m *TLStickerGetStickersByEmoji) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLStickerGetStickersByEmoji) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLStickerGetStickersByEmoji.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TLStickerGetStickersByEmoji) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLStickerGetStickersByEmoji.Merge(m, src)
+}
+func (m *TLStickerGetStickersByEmoji) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLStickerGetStickersByEmoji) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLStickerGetStickersByEmoji.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLStickerGetStickersByEmoji proto.InternalMessageInfo
+
+func (m *TLStickerGetStickersByEmoji) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
+	}
+	return CRC32_UNKNOWN
+}
+
+func (m *TLStickerGetStickersByEmoji) GetUserId() int64 {
+	if m != nil {
+		return m.UserId
+	}
+	return 0
+}
+
+func (m *TLStickerGetStickersByEmoji) GetEmoticon() string {
+	if m != nil {
+		return m.Emoticon
+	}
+	return ""
+}
+
+//--------------------------------------------------------------------------------------------
+// sticker.changeStickerPosition user_id:long document_id:long position:int = StickerSetData;
+type TLStickerChangeStickerPosition struct {
+	Constructor          TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=sticker.TLConstructor" json:"constructor,omitempty"`
+	UserId               int64         `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	DocumentId           int64         `protobuf:"varint,4,opt,name=document_id,json=documentId,proto3" json:"document_id,omitempty"`
+	Position             int32         `protobuf:"varint,5,opt,name=position,proto3" json:"position,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *TLStickerChangeStickerPosition) Reset()         { *m = TLStickerChangeStickerPosition{} }
+func (m *TLStickerChangeStickerPosition) String() string { return proto.CompactTextString(m) }
+func (*TLStickerChangeStickerPosition) ProtoMessage()    {}
+func (*TLStickerChangeStickerPosition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4cab48ebee30d6c7, []int{19}
+}
+func (m *TLStickerChangeStickerPosition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLStickerChangeStickerPosition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLStickerChangeStickerPosition.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TLStickerChangeStickerPosition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLStickerChangeStickerPosition.Merge(m, src)
+}
+func (m *TLStickerChangeStickerPosition) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLStickerChangeStickerPosition) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLStickerChangeStickerPosition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLStickerChangeStickerPosition proto.InternalMessageInfo
+
+func (m *TLStickerChangeStickerPosition) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
+	}
+	return CRC32_UNKNOWN
+}
+
+func (m *TLStickerChangeStickerPosition) GetUserId() int64 {
+	if m != nil {
+		return m.UserId
+	}
+	return 0
+}
+
+func (m *TLStickerChangeStickerPosition) GetDocumentId() int64 {
+	if m != nil {
+		return m.DocumentId
+	}
+	return 0
+}
+
+func (m *TLStickerChangeStickerPosition) GetPosition() int32 {
+	if m != nil {
+		return m.Position
+	}
+	return 0
+}
+
+//--------------------------------------------------------------------------------------------
+// sticker.setStickerSetThumb user_id:long stickerset:InputStickerSet thumb:Document = StickerSetData;
+type TLStickerSetStickerSetThumb struct {
+	Constructor          TLConstructor            `protobuf:"varint,1,opt,name=constructor,proto3,enum=sticker.TLConstructor" json:"constructor,omitempty"`
+	UserId               int64                    `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Stickerset           *mtproto.InputStickerSet `protobuf:"bytes,4,opt,name=stickerset,proto3" json:"stickerset,omitempty"`
+	Thumb                *mtproto.Document        `protobuf:"bytes,5,opt,name=thumb,proto3" json:"thumb,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *TLStickerSetStickerSetThumb) Reset()         { *m = TLStickerSetStickerSetThumb{} }
+func (m *TLStickerSetStickerSetThumb) String() string { return proto.CompactTextString(m) }
+func (*TLStickerSetStickerSetThumb) ProtoMessage()    {}
+func (*TLStickerSetStickerSetThumb) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4cab48ebee30d6c7, []int{20}
+}
+func (m *TLStickerSetStickerSetThumb) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLStickerSetStickerSetThumb) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLStickerSetStickerSetThumb.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TLStickerSetStickerSetThumb) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLStickerSetStickerSetThumb.Merge(m, src)
+}
+func (m *TLStickerSetStickerSetThumb) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLStickerSetStickerSetThumb) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLStickerSetStickerSetThumb.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLStickerSetStickerSetThumb proto.InternalMessageInfo
+
+func (m *TLStickerSetStickerSetThumb) GetConstructor() TLConstructor {
+	if m != nil {
+		return m.Constructor
+	}
+	return CRC32_UNKNOWN
+}
+
+func (m *TLStickerSetStickerSetThumb) GetUserId() int64 {
+	if m != nil {
+		return m.UserId
+	}
+	return 0
+}
+
+func (m *TLStickerSetStickerSetThumb) GetStickerset() *mtproto.InputStickerSet {
+	if m != nil {
+		return m.Stickerset
+	}
+	return nil
+}
+
+func (m *TLStickerSetStickerSetThumb) GetThumb() *mtproto.Document {
+	if m != nil {
+		return m.Thumb
+	}
+	return nil
+}
+
+//--------------------------------------------------------------------------------------------
+// Vector api result type
+type Vector_StickerSet struct {
+	Datas                []*mtproto.StickerSet `protobuf:"bytes,1,rep,name=datas,proto3" json:"datas,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *Vector_StickerSet) Reset()         { *m = Vector_StickerSet{} }
+func (m *Vector_StickerSet) String() string { return proto.CompactTextString(m) }
+func (*Vector_StickerSet) ProtoMessage()    {}
+func (*Vector_StickerSet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4cab48ebee30d6c7, []int{21}
+}
+func (m *Vector_StickerSet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Vector_StickerSet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Vector_StickerSet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Vector_StickerSet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Vector_StickerSet.Merge(m, src)
+}
+func (m *Vector_StickerSet) XXX_Size() int {
+	return m.Size()
+}
+func (m *Vector_StickerSet) XXX_DiscardUnknown() {
+	xxx_messageInfo_Vector_StickerSet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Vector_StickerSet proto.InternalMessageInfo
+
+func (m *Vector_StickerSet) GetDatas() []*mtproto.StickerSet {
+	if m != nil {
+		return m.Datas
+	}
+	return nil
+}
+
+type Vector_StickerSetDocument struct {
+	Datas                []*StickerSetDocument `protobuf:"bytes,1,rep,name=datas,proto3" json:"datas,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *Vector_StickerSetDocument) Reset()         { *m = Vector_StickerSetDocument{} }
+func (m *Vector_StickerSetDocument) String() string { return proto.CompactTextString(m) }
+func (*Vector_StickerSetDocument) ProtoMessage()    {}
+func (*Vector_StickerSetDocument) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4cab48ebee30d6c7, []int{22}
+}
+func (m *Vector_StickerSetDocument) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Vector_StickerSetDocument) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Vector_StickerSetDocument.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Vector_StickerSetDocument) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Vector_StickerSetDocument.Merge(m, src)
+}
+func (m *Vector_StickerSetDocument) XXX_Size() int {
+	return m.Size()
+}
+func (m *Vector_StickerSetDocument) XXX_DiscardUnknown() {
+	xxx_messageInfo_Vector_StickerSetDocument.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Vector_StickerSetDocument proto.InternalMessageInfo
+
+func (m *Vector_StickerSetDocument) GetDatas() []*StickerSetDocument {
+	if m != nil {
+		return m.Datas
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("sticker.TLConstructor", TLConstructor_name, TLConstructor_value)
+	proto.RegisterType((*StickerSetDocument)(nil), "sticker.StickerSetDocument")
+	proto.RegisterType((*TLStickerSetDocument)(nil), "sticker.TL_stickerSetDocument")
+	proto.RegisterType((*StickerSetData)(nil), "sticker.StickerSetData")
+	proto.RegisterType((*TLStickerSetData)(nil), "sticker.TL_stickerSetData")
+	proto.RegisterType((*TLStickerCreateStickerSet)(nil), "sticker.TL_sticker_createStickerSet")
+	proto.RegisterType((*TLStickerAddStickerToSet)(nil), "sticker.TL_sticker_addStickerToSet")
+	proto.RegisterType((*TLStickerRemoveStickerFromSet)(nil), "sticker.TL_sticker_removeStickerFromSet")
+	proto.RegisterType((*TLStickerCheckShortName)(nil), "sticker.TL_sticker_checkShortName")
+	proto.RegisterType((*TLStickerGetStickerSet)(nil), "sticker.TL_sticker_getStickerSet")
+	proto.RegisterType((*TLStickerGetStickerSetThumb)(nil), "sticker.TL_sticker_getStickerSetThumb")
+	proto.RegisterType((*TLStickerInstallStickerSet)(nil), "sticker.TL_sticker_installStickerSet")
+	proto.RegisterType((*TLStickerUninstallStickerSet)(nil), "sticker.TL_sticker_uninstallStickerSet")
+	proto.RegisterType((*TLStickerGetInstalledStickerSets)(nil), "sticker.TL_sticker_getInstalledStickerSets")
+	proto.RegisterType((*TLStickerGetRecentStickers)(nil), "sticker.TL_sticker_getRecentStickers")
+	proto.RegisterType((*TLStickerSaveRecentSticker)(nil), "sticker.TL_sticker_saveRecentSticker")
+	proto.RegisterType((*TLStickerClearRecentStickers)(nil), "sticker.TL_sticker_clearRecentStickers")
+	proto.RegisterType((*TLStickerGetFavedStickers)(nil), "sticker.TL_sticker_getFavedStickers")
+	proto.RegisterType((*TLStickerFaveSticker)(nil), "sticker.TL_sticker_faveSticker")
+	proto.RegisterType((*TLStickerGetStickersByEmoji)(nil), "sticker.TL_sticker_getStickersByEmoji")
+	proto.RegisterType((*TLStickerChangeStickerPosition)(nil), "sticker.TL_sticker_changeStickerPosition")
+	proto.RegisterType((*TLStickerSetStickerSetThumb)(nil), "sticker.TL_sticker_setStickerSetThumb")
+	proto.RegisterType((*Vector_StickerSet)(nil), "sticker.Vector_StickerSet")
+	proto.RegisterType((*Vector_StickerSetDocument)(nil), "sticker.Vector_StickerSetDocument")
+}
+
+func init() { proto.RegisterFile("sticker.tl.proto", fileDescriptor_4cab48ebee30d6c7) }
+
+var fileDescriptor_4cab48ebee30d6c7 = []byte{
+	// 1616 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0x5f, 0x6c, 0x14, 0x55,
+	0x17, 0xef, 0xb0, 0xdd, 0xed, 0xf6, 0x94, 0xf2, 0x4d, 0x07, 0x5a, 0xa6, 0xd3, 0x76, 0xbb, 0x4c,
+	0x29, 0xb4, 0xf0, 0xb5, 0x8d, 0x4b, 0x8c, 0xf8, 0xa2, 0xb1, 0x55, 0xb4, 0x8a, 0x15, 0xa7, 0x05,
+	0xa2, 0x3e, 0x6c, 0x6e, 0x67, 0x2f, 0xbb, 0x03, 0xbb, 0x3b, 0x9b, 0xb9, 0x77, 0x6b, 0x78, 0x55,
+	0x13, 0xf5, 0xc5, 0xc4, 0x44, 0x5f, 0x08, 0xf0, 0xc2, 0x03, 0x0f, 0x46, 0x4d, 0x34, 0x06, 0xa2,
+	0x49, 0xa3, 0x91, 0x80, 0x89, 0x89, 0x22, 0x06, 0x41, 0xe3, 0x03, 0x36, 0x44, 0x1f, 0x88, 0x7f,
+	0x5e, 0x34, 0xa2, 0x68, 0xcd, 0xde, 0x99, 0xd9, 0x9d, 0xd9, 0xb9, 0xb3, 0x8b, 0xc4, 0x0d, 0x3c,
+	0x75, 0xee, 0x3d, 0xbf, 0x39, 0xf7, 0x9c, 0xdf, 0x39, 0x73, 0xce, 0x3d, 0x5b, 0x10, 0x09, 0x35,
+	0xf4, 0x43, 0xd8, 0x9a, 0xa4, 0xf9, 0xc9, 0x92, 0x65, 0x52, 0x53, 0xea, 0x70, 0x76, 0x94, 0x89,
+	0xac, 0x41, 0x73, 0xe5, 0xc5, 0x49, 0xdd, 0x2c, 0x4c, 0x65, 0xcd, 0xac, 0x39, 0xc5, 0xe4, 0x8b,
+	0xe5, 0x03, 0x6c, 0xc5, 0x16, 0xec, 0xc9, 0x7e, 0x4f, 0x49, 0x64, 0x4d, 0x33, 0x9b, 0xc7, 0x35,
+	0xd4, 0xb3, 0x16, 0x2a, 0x95, 0xb0, 0x45, 0x1c, 0xb9, 0x42, 0xf4, 0x1c, 0x2e, 0xa0, 0xca, 0x41,
+	0xba, 0x69, 0xe1, 0x34, 0x3d, 0x5c, 0xc2, 0xae, 0xac, 0xbf, 0x26, 0xa3, 0x16, 0x2a, 0x92, 0x92,
+	0x69, 0x51, 0x47, 0xb4, 0xa1, 0x26, 0x22, 0x87, 0x8b, 0xba, 0xbd, 0xab, 0xae, 0x0a, 0x20, 0xcd,
+	0xdb, 0x76, 0xce, 0x63, 0xfa, 0xa0, 0xa9, 0x97, 0x0b, 0xb8, 0x48, 0xa5, 0x51, 0x58, 0x57, 0xb2,
+	0x70, 0xc6, 0xd0, 0x11, 0xc5, 0xe9, 0x22, 0x2a, 0x60, 0x59, 0x48, 0x0a, 0x63, 0x9d, 0x5a, 0x77,
+	0x75, 0x77, 0x0e, 0x15, 0xb0, 0xb4, 0x13, 0xba, 0x74, 0xb3, 0x48, 0xa8, 0x55, 0xd6, 0xa9, 0x69,
+	0xc9, 0x6b, 0x92, 0xc2, 0xd8, 0xba, 0x54, 0xdf, 0xa4, 0x4b, 0xc5, 0xc2, 0xee, 0x99, 0x9a, 0x54,
+	0xf3, 0x42, 0xa5, 0x5e, 0x88, 0x11, 0x4c, 0xd3, 0x46, 0x46, 0x8e, 0x24, 0x85, 0xb1, 0x88, 0x16,
+	0x25, 0x98, 0xce, 0x66, 0xa4, 0x2d, 0xf0, 0xbf, 0xca, 0x36, 0xd2, 0x75, 0x4c, 0x48, 0x3a, 0x87,
+	0x48, 0x4e, 0x6e, 0x67, 0xf2, 0x6e, 0x82, 0xe9, 0x03, 0x6c, 0xf7, 0x11, 0x44, 0x72, 0xd2, 0x30,
+	0x74, 0x65, 0x1c, 0x5b, 0x2b, 0x3a, 0xa2, 0x0c, 0x03, 0xee, 0xd6, 0x6c, 0x46, 0xda, 0x00, 0x51,
+	0x5c, 0x30, 0x0f, 0x1a, 0x72, 0x8c, 0xd9, 0x6d, 0x2f, 0x24, 0x09, 0xda, 0x33, 0x88, 0x62, 0xb9,
+	0x23, 0x29, 0x8c, 0x45, 0x35, 0xf6, 0xac, 0x3e, 0x0a, 0xbd, 0x0b, 0xbb, 0xd3, 0x24, 0xc8, 0xc1,
+	0x5d, 0x10, 0xcd, 0x20, 0x8a, 0x52, 0xcc, 0xf5, 0xae, 0xd4, 0x40, 0xd5, 0xad, 0x20, 0x5f, 0x9a,
+	0x8d, 0x54, 0xbf, 0x14, 0x60, 0x9d, 0x47, 0x8a, 0x28, 0x6a, 0x3d, 0x93, 0xa3, 0x10, 0x21, 0x98,
+	0x32, 0x1a, 0xbb, 0x52, 0xeb, 0x27, 0x0b, 0x94, 0x05, 0xd6, 0x63, 0xa4, 0x56, 0x91, 0x4b, 0xf7,
+	0x42, 0xa7, 0x4b, 0x0f, 0x91, 0xdb, 0x93, 0x91, 0x66, 0x1e, 0xd5, 0xd0, 0xea, 0x34, 0xf4, 0xf8,
+	0x19, 0xaa, 0xf8, 0x35, 0xe1, 0x67, 0x67, 0x23, 0x4f, 0x17, 0xa2, 0xc8, 0x65, 0xe6, 0x8b, 0x35,
+	0x30, 0x50, 0x53, 0x92, 0xd6, 0x2d, 0x8c, 0x28, 0xae, 0x41, 0xeb, 0xfd, 0x17, 0x6e, 0xde, 0xff,
+	0x8d, 0xd0, 0x51, 0x26, 0xd8, 0xaa, 0xa5, 0x52, 0xac, 0xb2, 0xb4, 0x53, 0xa0, 0x80, 0xc8, 0x21,
+	0xc2, 0x32, 0x28, 0xae, 0xd9, 0x0b, 0x49, 0x81, 0x38, 0x2a, 0x1a, 0x05, 0x44, 0xb1, 0x9d, 0x36,
+	0x71, 0xad, 0xba, 0x96, 0xfa, 0x20, 0xb6, 0x64, 0x64, 0xb0, 0x49, 0x58, 0xd6, 0xc4, 0x35, 0x67,
+	0x55, 0xd1, 0x44, 0x0d, 0x9a, 0xb7, 0xf3, 0xa6, 0x53, 0xb3, 0x17, 0xd2, 0x10, 0x00, 0xc9, 0x99,
+	0x16, 0xb5, 0xa3, 0x1a, 0x67, 0xa2, 0x4e, 0xb6, 0xc3, 0x22, 0xba, 0x15, 0xa2, 0x34, 0x57, 0x2e,
+	0x2c, 0xca, 0x9d, 0x8c, 0xa0, 0x9e, 0x6a, 0x64, 0x6a, 0x49, 0xc3, 0xe4, 0xd2, 0x3d, 0x10, 0x77,
+	0xdc, 0x24, 0x32, 0x34, 0x0f, 0x4c, 0x15, 0xac, 0x5e, 0x11, 0x40, 0xf1, 0x70, 0x8a, 0x32, 0x19,
+	0x07, 0xbe, 0x60, 0xb6, 0x88, 0xd2, 0x9d, 0x00, 0xee, 0xe9, 0x98, 0x32, 0x5e, 0xbb, 0x52, 0x72,
+	0xd5, 0xb1, 0xd9, 0x62, 0xa9, 0x4c, 0x3d, 0x79, 0xe7, 0xc1, 0x4a, 0x77, 0x83, 0x5b, 0x0e, 0x19,
+	0xeb, 0x4d, 0x7c, 0x74, 0xb1, 0xea, 0xeb, 0x02, 0x0c, 0x7b, 0x5c, 0xb4, 0x70, 0xc1, 0x5c, 0x72,
+	0xd3, 0x66, 0x97, 0x65, 0x16, 0x5a, 0xe4, 0x67, 0x5d, 0x79, 0x69, 0xaf, 0x2f, 0x2f, 0x2a, 0x85,
+	0x7e, 0x6f, 0x36, 0xe7, 0xb0, 0x7e, 0x68, 0xbe, 0x1a, 0xf9, 0x5b, 0x37, 0xc8, 0x9f, 0x52, 0x91,
+	0xba, 0x94, 0x52, 0x4f, 0x0a, 0x20, 0x7b, 0x8e, 0xcd, 0x62, 0xda, 0xda, 0x2f, 0xe8, 0x96, 0xc3,
+	0xad, 0x9e, 0x16, 0x60, 0x28, 0xcc, 0xd2, 0x05, 0x96, 0xf5, 0xb7, 0x6e, 0xae, 0xdf, 0xaa, 0xc8,
+	0xbf, 0x48, 0xc2, 0x11, 0xe8, 0x66, 0x9f, 0x5c, 0x7a, 0x09, 0x5b, 0xc4, 0x30, 0x8b, 0xcc, 0xa5,
+	0xa8, 0xb6, 0x96, 0x6d, 0xee, 0xb3, 0xf7, 0xd4, 0xaf, 0x04, 0x18, 0xf4, 0x98, 0x6e, 0x14, 0x09,
+	0x45, 0xf9, 0xfc, 0x1d, 0x4a, 0xb4, 0x34, 0x0e, 0x71, 0x64, 0xe9, 0x39, 0x63, 0xc9, 0x29, 0x67,
+	0x5d, 0xa9, 0xee, 0xea, 0x7b, 0xd3, 0xa6, 0x99, 0xd7, 0xaa, 0x62, 0xf5, 0x4d, 0x01, 0x12, 0x1e,
+	0xc7, 0xca, 0xc5, 0x3b, 0xdb, 0x35, 0xf5, 0xa8, 0x00, 0xaa, 0x3f, 0x87, 0x66, 0x6d, 0x83, 0x71,
+	0xa6, 0xf6, 0x06, 0x69, 0x85, 0xcd, 0x23, 0xde, 0xce, 0x11, 0x60, 0xd4, 0x96, 0xa9, 0x47, 0xfd,
+	0x79, 0x92, 0xc5, 0x54, 0xc3, 0x3a, 0x2e, 0xba, 0xde, 0xb4, 0xc4, 0xb0, 0x4a, 0xb4, 0x29, 0x45,
+	0x7a, 0x0e, 0x67, 0xf8, 0xb6, 0x55, 0xc5, 0xea, 0x55, 0xbf, 0x79, 0x04, 0x2d, 0x61, 0x9f, 0x7d,
+	0xb7, 0xd7, 0xbc, 0xe6, 0x17, 0xb8, 0x51, 0x88, 0x95, 0x8b, 0x15, 0xab, 0xe5, 0x18, 0x4f, 0x93,
+	0x23, 0x54, 0x8f, 0xfb, 0x93, 0x5a, 0xcf, 0x63, 0x64, 0xdd, 0x51, 0x71, 0x28, 0xf9, 0xee, 0x3d,
+	0x59, 0x4c, 0x77, 0xa1, 0x25, 0x9c, 0x69, 0xa1, 0x71, 0xea, 0xbb, 0x02, 0xf4, 0x79, 0x8e, 0x3c,
+	0x80, 0xaa, 0x1d, 0xf3, 0x76, 0xb4, 0x4a, 0x3b, 0x90, 0x15, 0x23, 0xf8, 0xf5, 0xc9, 0x11, 0xaa,
+	0xaf, 0x84, 0x75, 0x0c, 0x32, 0x7d, 0xf8, 0x21, 0x76, 0x79, 0x6f, 0x81, 0xf1, 0x0a, 0xc4, 0x71,
+	0xc1, 0xa4, 0x86, 0xee, 0xf4, 0x82, 0x4e, 0xad, 0xba, 0x56, 0xdf, 0x11, 0x20, 0xe9, 0xeb, 0xf1,
+	0xa8, 0x98, 0x75, 0x89, 0xdc, 0x63, 0x12, 0x83, 0x1a, 0x66, 0xf1, 0xb6, 0x10, 0xaa, 0x40, 0xbc,
+	0xe4, 0x9c, 0xcf, 0x28, 0x8d, 0x6a, 0xd5, 0xb5, 0x7a, 0xd1, 0xcf, 0x22, 0xf9, 0x2f, 0xfb, 0x6e,
+	0x0b, 0xba, 0x57, 0xf5, 0x8e, 0x1c, 0x6d, 0x7c, 0x47, 0x56, 0xef, 0x83, 0x9e, 0x7d, 0xb8, 0x62,
+	0x45, 0xda, 0xd3, 0xad, 0xc6, 0xed, 0x11, 0x84, 0xc8, 0x42, 0x32, 0x12, 0x36, 0xfb, 0xd8, 0x08,
+	0x75, 0x0e, 0xfa, 0x03, 0xef, 0xd7, 0x0f, 0x7a, 0xae, 0x9e, 0xe6, 0x83, 0x1e, 0xd9, 0x76, 0x21,
+	0x06, 0xdd, 0x3e, 0xaa, 0xa4, 0x1e, 0xe8, 0x9e, 0xd1, 0x66, 0x76, 0xa4, 0xd2, 0x7b, 0xe7, 0x1e,
+	0x9b, 0x7b, 0x62, 0xff, 0x9c, 0xd8, 0x26, 0x8d, 0x82, 0x6c, 0x6f, 0x05, 0x87, 0x4b, 0xf1, 0xeb,
+	0x9f, 0x97, 0xaf, 0xfc, 0xbd, 0xba, 0xba, 0xba, 0x2a, 0x48, 0x83, 0xb0, 0x21, 0x00, 0x43, 0x14,
+	0x89, 0x97, 0x2e, 0xbc, 0x7a, 0x26, 0x22, 0x6d, 0x87, 0x84, 0x4f, 0x1a, 0x18, 0x9d, 0xc4, 0x6f,
+	0x5f, 0x3c, 0x77, 0xe6, 0x77, 0x5b, 0xd5, 0x66, 0x18, 0xf2, 0x83, 0xeb, 0x66, 0x02, 0xf1, 0xed,
+	0x4b, 0x9f, 0xf7, 0x4a, 0xdb, 0x40, 0xf5, 0xa3, 0x78, 0xd7, 0x6a, 0xf1, 0xfc, 0x7b, 0x67, 0x57,
+	0x3b, 0xa4, 0x71, 0x18, 0xac, 0x3b, 0xde, 0x77, 0xd7, 0x15, 0xcf, 0xbf, 0x76, 0xea, 0xec, 0x5f,
+	0xf6, 0xe1, 0x63, 0x30, 0xe0, 0x87, 0xfa, 0x6e, 0x7d, 0xe2, 0xc9, 0xcf, 0xde, 0x58, 0x76, 0x3c,
+	0x9e, 0x80, 0x64, 0x03, 0x24, 0xcb, 0x53, 0xf1, 0xf4, 0x8f, 0x1f, 0x5d, 0xbb, 0x6e, 0xc3, 0xff,
+	0x0f, 0xc3, 0x7e, 0x78, 0xe0, 0xe2, 0x22, 0xfe, 0x74, 0xf1, 0xea, 0x29, 0x87, 0x83, 0x71, 0xd8,
+	0xe4, 0x47, 0x73, 0x2e, 0x3a, 0xe2, 0xf3, 0xef, 0xbf, 0xf0, 0x56, 0x87, 0x94, 0x82, 0xd1, 0x80,
+	0x1d, 0xbc, 0x3b, 0x86, 0xf8, 0xe9, 0xb1, 0x0f, 0xce, 0xad, 0x86, 0x18, 0x13, 0x68, 0xfc, 0xe2,
+	0xf2, 0xd5, 0xdf, 0xae, 0x39, 0xe8, 0x2d, 0xf5, 0xe8, 0x40, 0x1f, 0x16, 0x8f, 0x2f, 0x7f, 0xdc,
+	0x1b, 0x34, 0x9a, 0xd3, 0xc8, 0xc4, 0x63, 0x27, 0x5f, 0x3e, 0xb1, 0x26, 0x98, 0x10, 0xf5, 0x3d,
+	0x45, 0xbc, 0x71, 0xfd, 0xc8, 0x0f, 0x7f, 0xd8, 0xe7, 0x27, 0xa1, 0xdf, 0x0f, 0xf6, 0x74, 0x03,
+	0x71, 0xe5, 0xd2, 0xe5, 0x19, 0x69, 0x2c, 0x3c, 0x16, 0x6e, 0xe5, 0x15, 0x2f, 0x5f, 0xfb, 0xf0,
+	0x48, 0x44, 0xda, 0x06, 0x23, 0xf5, 0xa9, 0xc0, 0x29, 0x89, 0xe2, 0x37, 0x97, 0xcf, 0xec, 0x0f,
+	0x46, 0x38, 0x58, 0x89, 0xc4, 0xe7, 0x6e, 0xfc, 0xfa, 0xfd, 0x9f, 0xcc, 0x4c, 0xa5, 0xfd, 0xa5,
+	0x13, 0x89, 0xb6, 0xd4, 0x85, 0xb5, 0x00, 0xda, 0x9e, 0x19, 0xb7, 0x59, 0x3d, 0x03, 0x72, 0xe8,
+	0xcf, 0x05, 0x9b, 0x3d, 0x05, 0x2b, 0xf4, 0xcb, 0x50, 0xc2, 0x7e, 0x94, 0x50, 0xdb, 0xa4, 0xa7,
+	0x60, 0x63, 0xd8, 0xdc, 0x3c, 0xc2, 0xd3, 0x5d, 0x07, 0x6a, 0xa4, 0x5a, 0x87, 0xc1, 0x86, 0xf3,
+	0xea, 0x18, 0x4f, 0x3f, 0x0f, 0xd9, 0xe8, 0x90, 0xc7, 0xa1, 0x2f, 0x64, 0xfa, 0x54, 0xb9, 0xd4,
+	0xf8, 0x30, 0x8a, 0xbf, 0x07, 0xab, 0x6d, 0xd2, 0x5e, 0xe8, 0xe5, 0x4f, 0x95, 0x9b, 0x78, 0xda,
+	0x7c, 0x90, 0x46, 0x56, 0x2e, 0x82, 0xd2, 0x60, 0x04, 0xdc, 0xd2, 0x54, 0x37, 0xc3, 0x29, 0x8a,
+	0xbf, 0x97, 0xec, 0x32, 0xf2, 0x78, 0xb7, 0xa9, 0x23, 0xd6, 0xf0, 0x2a, 0x91, 0xec, 0x0f, 0x9f,
+	0xd5, 0x46, 0x79, 0x47, 0x04, 0x60, 0x0a, 0xaf, 0x75, 0xa8, 0x6d, 0xd2, 0x7e, 0x18, 0x68, 0x34,
+	0x2d, 0x6d, 0xe5, 0x29, 0xe7, 0x00, 0x83, 0x74, 0xe7, 0x61, 0xb8, 0xd9, 0x58, 0xb3, 0x3d, 0x84,
+	0x1c, 0x1e, 0x58, 0x51, 0xaa, 0xe0, 0x40, 0x97, 0x53, 0xdb, 0xa4, 0x1c, 0xf4, 0x87, 0x16, 0x2b,
+	0x3e, 0x43, 0x01, 0x98, 0xa2, 0x86, 0x9f, 0xe0, 0xf6, 0x34, 0xb5, 0x4d, 0x9a, 0x87, 0xfe, 0xd0,
+	0x42, 0xc7, 0x3f, 0x29, 0x00, 0x0b, 0x92, 0xe5, 0x89, 0x02, 0xef, 0x7a, 0xcf, 0x8d, 0x02, 0x07,
+	0x18, 0x54, 0x7c, 0x00, 0xe4, 0xb0, 0x1a, 0xca, 0x2f, 0x30, 0xf5, 0xa8, 0x9b, 0x64, 0xe5, 0x61,
+	0x58, 0xcf, 0xbb, 0x8c, 0x0f, 0xf3, 0x8e, 0xf0, 0x00, 0x82, 0x06, 0x1f, 0x04, 0x25, 0xbc, 0x4a,
+	0x37, 0xfb, 0x9c, 0x5c, 0xdc, 0x4d, 0x1a, 0x8d, 0x61, 0xa8, 0xf1, 0xd5, 0x77, 0x9c, 0x5f, 0x67,
+	0x38, 0xd0, 0x46, 0x15, 0x22, 0x0d, 0x4a, 0x78, 0x8b, 0xe0, 0xbb, 0x14, 0xc4, 0x35, 0x38, 0x60,
+	0xfa, 0xc9, 0x5f, 0xbe, 0x4b, 0x08, 0x9f, 0xac, 0x24, 0x84, 0xf3, 0x2b, 0x09, 0xe1, 0xca, 0x4a,
+	0x42, 0x78, 0xfa, 0x7e, 0xcf, 0xbf, 0x63, 0x28, 0x46, 0x85, 0xac, 0x85, 0x6a, 0x0f, 0x13, 0x04,
+	0x5b, 0x4b, 0xd8, 0x9a, 0x42, 0xa5, 0xd2, 0x54, 0xe5, 0xd1, 0xd0, 0xf1, 0x94, 0xa3, 0xdb, 0xfd,
+	0xbb, 0x18, 0x63, 0x41, 0xd9, 0xf1, 0xcf, 0x00, 0x89, 0x3a, 0x5e, 0xb2, 0xf3, 0x19, 0x00, 0x00,
+}
+
+func (this *StickerSetDocument) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 11)
+	s = append(s, "&sticker.StickerSetDocument{")
+	s = append(s, "PredicateName: "+fmt.Sprintf("%#v", this.PredicateName)+",\n")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "SetId: "+fmt.Sprintf("%#v", this.SetId)+",\n")
+	s = append(s, "SetAccessHash: "+fmt.Sprintf("%#v", this.SetAccessHash)+",\n")
+	s = append(s, "DocumentId: "+fmt.Sprintf("%#v", this.DocumentId)+",\n")
+	s = append(s, "Emoji: "+fmt.Sprintf("%#v", this.Emoji)+",\n")
+	s = append(s, "Date: "+fmt.Sprintf("%#v", this.Date)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLStickerSetDocument) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&sticker.TLStickerSetDocument{")
+	if this.Data2 != nil {
+		s = append(s, "Data2: "+fmt.Sprintf("%#v", this.Data2)+",\n")
+	}
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *StickerSetData) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&sticker.StickerSetData{")
+	s = append(s, "PredicateName: "+fmt.Sprintf("%#v", this.PredicateName)+",\n")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	if this.Set != nil {
+		s = append(s, "Set: "+fmt.Sprintf("%#v", this.Set)+",\n")
+	}
+	if this.Documents != nil {
+		s = append(s, "Documents: "+fmt.Sprintf("%#v", this.Documents)+",\n")
+	}
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLStickerSetData) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&sticker.TLStickerSetData{")
+	if this.Data2 != nil {
+		s = append(s, "Data2: "+fmt.Sprintf("%#v", this.Data2)+",\n")
+	}
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLStickerCreateStickerSet) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 13)
+	s = append(s, "&sticker.TLStickerCreateStickerSet{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
+	s = append(s, "Masks: "+fmt.Sprintf("%#v", this.Masks)+",\n")
+	s = append(s, "Animated: "+fmt.Sprintf("%#v", this.Animated)+",\n")
+	s = append(s, "Videos: "+fmt.Sprintf("%#v", this.Videos)+",\n")
+	s = append(s, "Title: "+fmt.Sprintf("%#v", this.Title)+",\n")
+	s = append(s, "ShortName: "+fmt.Sprintf("%#v", this.ShortName)+",\n")
+	if this.Thumb != nil {
+		s = append(s, "Thumb: "+fmt.Sprintf("%#v", this.Thumb)+",\n")
+	}
+	if this.Stickers != nil {
+		s = append(s, "Stickers: "+fmt.Sprintf("%#v", this.Stickers)+",\n")
+	}
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLStickerAddStickerToSet) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&sticker.TLStickerAddStickerToSet{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
+	if this.Stickerset != nil {
+		s = append(s, "Stickerset: "+fmt.Sprintf("%#v", this.Stickerset)+",\n")
+	}
+	if this.Sticker != nil {
+		s = append(s, "Sticker: "+fmt.Sprintf("%#v", this.Sticker)+",\n")
+	}
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLStickerRemoveStickerFromSet) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&sticker.TLStickerRemoveStickerFromSet{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
+	s = append(s, "DocumentId: "+fmt.Sprintf("%#v", this.DocumentId)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLStickerCheckShortName) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&sticker.TLStickerCheckShortName{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "ShortName: "+fmt.Sprintf("%#v", this.ShortName)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLStickerGetStickerSet) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&sticker.TLStickerGetStickerSet{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
+	if this.Stickerset != nil {
+		s = append(s, "Stickerset: "+fmt.Sprintf("%#v", this.Stickerset)+",\n")
+	}
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLStickerGetStickerSetThumb) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&sticker.TLStickerGetStickerSetThumb{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	if this.Stickerset != nil {
+		s = append(s, "Stickerset: "+fmt.Sprintf("%#v", this.Stickerset)+",\n")
+	}
+	s = append(s, "ThumbVersion: "+fmt.Sprintf("%#v", this.ThumbVersion)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLStickerInstallStickerSet) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&sticker.TLStickerInstallStickerSet{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
+	if this.Stickerset != nil {
+		s = append(s, "Stickerset: "+fmt.Sprintf("%#v", this.Stickerset)+",\n")
+	}
+	if this.Archived != nil {
+		s = append(s, "Archived: "+fmt.Sprintf("%#v", this.Archived)+",\n")
+	}
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLStickerUninstallStickerSet) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&sticker.TLStickerUninstallStickerSet{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
+	if this.Stickerset != nil {
+		s = append(s, "Stickerset: "+fmt.Sprintf("%#v", this.Stickerset)+",\n")
+	}
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLStickerGetInstalledStickerSets) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&sticker.TLStickerGetInstalledStickerSets{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
+	if this.Masks != nil {
+		s = append(s, "Masks: "+fmt.Sprintf("%#v", this.Masks)+",\n")
+	}
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLStickerGetRecentStickers) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&sticker.TLStickerGetRecentStickers{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
+	if this.Attached != nil {
+		s = append(s, "Attached: "+fmt.Sprintf("%#v", this.Attached)+",\n")
+	}
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLStickerSaveRecentSticker) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 9)
+	s = append(s, "&sticker.TLStickerSaveRecentSticker{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
+	if this.Attached != nil {
+		s = append(s, "Attached: "+fmt.Sprintf("%#v", this.Attached)+",\n")
+	}
+	s = append(s, "DocumentId: "+fmt.Sprintf("%#v", this.DocumentId)+",\n")
+	if this.Unsave != nil {
+		s = append(s, "Unsave: "+fmt.Sprintf("%#v", this.Unsave)+",\n")
+	}
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLStickerClearRecentStickers) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&sticker.TLStickerClearRecentStickers{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
+	if this.Attached != nil {
+		s = append(s, "Attached: "+fmt.Sprintf("%#v", this.Attached)+",\n")
+	}
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLStickerGetFavedStickers) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&sticker.TLStickerGetFavedStickers{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLStickerFaveSticker) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&sticker.TLStickerFaveSticker{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
+	s = append(s, "DocumentId: "+fmt.Sprintf("%#v", this.DocumentId)+",\n")
+	if this.Unfave != nil {
+		s = append(s, "Unfave: "+fmt.Sprintf("%#v", this.Unfave)+",\n")
+	}
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLStickerGetStickersByEmoji) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&sticker.TLStickerGetStickersByEmoji{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
+	s = append(s, "Emoticon: "+fmt.Sprintf("%#v", this.Emoticon)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLStickerChangeStickerPosition) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&sticker.TLStickerChangeStickerPosition{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
+	s = append(s, "DocumentId: "+fmt.Sprintf("%#v", this.DocumentId)+",\n")
+	s = append(s, "Position: "+fmt.Sprintf("%#v", this.Position)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLStickerSetStickerSetThumb) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&sticker.TLStickerSetStickerSetThumb{")
+	s = append(s, "Constructor: "+fmt.Sprintf("%#v", this.Constructor)+",\n")
+	s = append(s, "UserId: "+fmt.Sprintf("%#v", this.UserId)+",\n")
+	if this.Stickerset != nil {
+		s = append(s, "Stickerset: "+fmt.Sprintf("%#v", this.Stickerset)+",\n")
+	}
+	if this.Thumb != nil {
+		s = append(s, "Thumb: "+fmt.Sprintf("%#v", this.Thumb)+",\n")
+	}
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *Vector_StickerSet) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&sticker.Vector_StickerSet{")
+	if this.Datas != nil {
+		s = append(s, "Datas: "+fmt.Sprintf("%#v", this.Datas)+",\n")
+	}
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *Vector_StickerSetDocument) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&sticker.Vector_StickerSetDocument{")
+	if this.Datas != nil {
+		s = append(s, "Datas: "+fmt.Sprintf("%#v", this.Datas)+",\n")
+	}
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringStickerTl(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// RPCStickerClient is the client API for RPCSticker service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type RPCStickerClient interface {
+	// sticker.createStickerSet flags:# user_id:long masks:flags.0?true animated:flags.1?true videos:flags.2?true title:string short_name:string thumb:flags.3?Document stickers:Vector<StickerSetDocument> = StickerSetData;
+	StickerCreateStickerSet(ctx context.Context, in *TLStickerCreateStickerSet, opts ...grpc.CallOption) (*StickerSetData, error)
+	// sticker.addStickerToSet user_id:long stickerset:InputStickerSet sticker:StickerSetDocument = StickerSetData;
+	StickerAddStickerToSet(ctx context.Context, in *TLStickerAddStickerToSet, opts ...grpc.CallOption) (*StickerSetData, error)
+	// sticker.removeStickerFromSet user_id:long document_id:long = StickerSetData;
+	StickerRemoveStickerFromSet(ctx context.Context, in *TLStickerRemoveStickerFromSet, opts ...grpc.CallOption) (*StickerSetData, error)
+	// sticker.checkShortName short_name:string = Bool;
+	StickerCheckShortName(ctx context.Context, in *TLStickerCheckShortName, opts ...grpc.CallOption) (*mtproto.Bool, error)
+	// sticker.getStickerSet user_id:long stickerset:InputStickerSet = StickerSetData;
+	StickerGetStickerSet(ctx context.Context, in *TLStickerGetStickerSet, opts ...grpc.CallOption) (*StickerSetData, error)
+	// sticker.getStickerSetThumb stickerset:InputStickerSet thumb_version:int = InputFileLocation;
+	StickerGetStickerSetThumb(ctx context.Context, in *TLStickerGetStickerSetThumb, opts ...grpc.CallOption) (*mtproto.InputFileLocation, error)
+	// sticker.installStickerSet user_id:long stickerset:InputStickerSet archived:Bool = StickerSet;
+	StickerInstallStickerSet(ctx context.Context, in *TLStickerInstallStickerSet, opts ...grpc.CallOption) (*mtproto.StickerSet, error)
+	// sticker.uninstallStickerSet user_id:long stickerset:InputStickerSet = Bool;
+	StickerUninstallStickerSet(ctx context.Context, in *TLStickerUninstallStickerSet, opts ...grpc.CallOption) (*mtproto.Bool, error)
+	// sticker.getInstalledStickerSets user_id:long masks:Bool = Vector<StickerSet>;
+	StickerGetInstalledStickerSets(ctx context.Context, in *TLStickerGetInstalledStickerSets, opts ...grpc.CallOption) (*Vector_StickerSet, error)
+	// sticker.getRecentStickers user_id:long attached:Bool = Vector<StickerSetDocument>;
+	StickerGetRecentStickers(ctx context.Context, in *TLStickerGetRecentStickers, opts ...grpc.CallOption) (*Vector_StickerSetDocument, error)
+	// sticker.saveRecentSticker user_id:long attached:Bool document_id:long unsave:Bool = Bool;
+	StickerSaveRecentSticker(ctx context.Context, in *TLStickerSaveRecentSticker, opts ...grpc.CallOption) (*mtproto.Bool, error)
+	// sticker.clearRecentStickers user_id:long attached:Bool = Bool;
+	StickerClearRecentStickers(ctx context.Context, in *TLStickerClearRecentStickers, opts ...grpc.CallOption) (*mtproto.Bool, error)
+	// sticker.getFavedStickers user_id:long = Vector<StickerSetDocument>;
+	StickerGetFavedStickers(ctx context.Context, in *TLStickerGetFavedStickers, opts ...grpc.CallOption) (*Vector_StickerSetDocument, error)
+	// sticker.faveSticker user_id:long document_id:long unfave:Bool = Bool;
+	StickerFaveSticker(ctx context.Context, in *TLStickerFaveSticker, opts ...grpc.CallOption) (*mtproto.Bool, error)
+	// sticker.getStickersByEmoji user_id:long emoticon:string = Vector<StickerSetDocument>;
+	StickerGetStickersByEmoji(ctx context.Context, in *TLStickerGetStickersByEmoji, opts ...grpc.CallOption) (*Vector_StickerSetDocument, error)
+	// sticker.changeStickerPosition user_id:long document_id:long position:int = StickerSetData;
+	StickerChangeStickerPosition(ctx context.Context, in *TLStickerChangeStickerPosition, opts ...grpc.CallOption) (*StickerSetData, error)
+	// sticker.setStickerSetThumb user_id:long stickerset:InputStickerSet thumb:Document = StickerSetData;
+	StickerSetStickerSetThumb(ctx context.Context, in *TLStickerSetStickerSetThumb, opts ...grpc.CallOption) (*StickerSetData, error)
+}
+
+type rPCStickerClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewRPCStickerClient(cc *grpc.ClientConn) RPCStickerClient {
+	return &rPCStickerClient{cc}
+}
+
+func (c *rPCStickerClient) StickerCreateStickerSet(ctx context.Context, in *TLStickerCreateStickerSet, opts ...grpc.CallOption) (*StickerSetData, error) {
+	out := new(StickerSetData)
+	err := c.cc.Invoke(ctx, "/sticker.RPCSticker/sticker_createStickerSet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPCStickerClient) StickerAddStickerToSet(ctx context.Context, in *TLStickerAddStickerToSet, opts ...grpc.CallOption) (*StickerSetData, error) {
+	out := new(StickerSetData)
+	err := c.cc.Invoke(ctx, "/sticker.RPCSticker/sticker_addStickerToSet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPCStickerClient) StickerRemoveStickerFromSet(ctx context.Context, in *TLStickerRemoveStickerFromSet, opts ...grpc.CallOption) (*StickerSetData, error) {
+	out := new(StickerSetData)
+	err := c.cc.Invoke(ctx, "/sticker.RPCSticker/sticker_removeStickerFromSet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPCStickerClient) StickerCheckShortName(ctx context.Context, in *TLStickerCheckShortName, opts ...grpc.CallOption) (*mtproto.Bool, error) {
+	out := new(mtproto.Bool)
+	err := c.cc.Invoke(ctx, "/sticker.RPCSticker/sticker_checkShortName", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPCStickerClient) StickerGetStickerSet(ctx context.Context, in *TLStickerGetStickerSet, opts ...grpc.CallOption) (*StickerSetData, error) {
+	out := new(StickerSetData)
+	err := c.cc.Invoke(ctx, "/sticker.RPCSticker/sticker_getStickerSet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPCStickerClient) StickerGetStickerSetThumb(ctx context.Context, in *TLStickerGetStickerSetThumb, opts ...grpc.CallOption) (*mtproto.InputFileLocation, error) {
+	out := new(mtproto.InputFileLocation)
+	err := c.cc.Invoke(ctx, "/sticker.RPCSticker/sticker_getStickerSetThumb", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPCStickerClient) StickerInstallStickerSet(ctx context.Context, in *TLStickerInstallStickerSet, opts ...grpc.CallOption) (*mtproto.StickerSet, error) {
+	out := new(mtproto.StickerSet)
+	err := c.cc.Invoke(ctx, "/sticker.RPCSticker/sticker_installStickerSet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPCStickerClient) StickerUninstallStickerSet(ctx context.Context, in *TLStickerUninstallStickerSet, opts ...grpc.CallOption) (*mtproto.Bool, error) {
+	out := new(mtproto.Bool)
+	err := c.cc.Invoke(ctx, "/sticker.RPCSticker/sticker_uninstallStickerSet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPCStickerClient) StickerGetInstalledStickerSets(ctx context.Context, in *TLStickerGetInstalledStickerSets, opts ...grpc.CallOption) (*Vector_StickerSet, error) {
+	out := new(Vector_StickerSet)
+	err := c.cc.Invoke(ctx, "/sticker.RPCSticker/sticker_getInstalledStickerSets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPCStickerClient) StickerGetRecentStickers(ctx context.Context, in *TLStickerGetRecentStickers, opts ...grpc.CallOption) (*Vector_StickerSetDocument, error) {
+	out := new(Vector_StickerSetDocument)
+	err := c.cc.Invoke(ctx, "/sticker.RPCSticker/sticker_getRecentStickers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPCStickerClient) StickerSaveRecentSticker(ctx context.Context, in *TLStickerSaveRecentSticker, opts ...grpc.CallOption) (*mtproto.Bool, error) {
+	out := new(mtproto.Bool)
+	err := c.cc.Invoke(ctx, "/sticker.RPCSticker/sticker_saveRecentSticker", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPCStickerClient) StickerClearRecentStickers(ctx context.Context, in *TLStickerClearRecentStickers, opts ...grpc.CallOption) (*mtproto.Bool, error) {
+	out := new(mtproto.Bool)
+	err := c.cc.Invoke(ctx, "/sticker.RPCSticker/sticker_clearRecentStickers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPCStickerClient) StickerGetFavedStickers(ctx context.Context, in *TLStickerGetFavedStickers, opts ...grpc.CallOption) (*Vector_StickerSetDocument, error) {
+	out := new(Vector_StickerSetDocument)
+	err := c.cc.Invoke(ctx, "/sticker.RPCSticker/sticker_getFavedStickers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPCStickerClient) StickerFaveSticker(ctx context.Context, in *TLStickerFaveSticker, opts ...grpc.CallOption) (*mtproto.Bool, error) {
+	out := new(mtproto.Bool)
+	err := c.cc.Invoke(ctx, "/sticker.RPCSticker/sticker_faveSticker", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPCStickerClient) StickerGetStickersByEmoji(ctx context.Context, in *TLStickerGetStickersByEmoji, opts ...grpc.CallOption) (*Vector_StickerSetDocument, error) {
+	out := new(Vector_StickerSetDocument)
+	err := c.cc.Invoke(ctx, "/sticker.RPCSticker/sticker_getStickersByEmoji", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPCStickerClient) StickerChangeStickerPosition(ctx context.Context, in *TLStickerChangeStickerPosition, opts ...grpc.CallOption) (*StickerSetData, error) {
+	out := new(StickerSetData)
+	err := c.cc.Invoke(ctx, "/sticker.RPCSticker/sticker_changeStickerPosition", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPCStickerClient) StickerSetStickerSetThumb(ctx context.Context, in *TLStickerSetStickerSetThumb, opts ...grpc.CallOption) (*StickerSetData, error) {
+	out := new(StickerSetData)
+	err := c.cc.Invoke(ctx, "/sticker.RPCSticker/sticker_setStickerSetThumb", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RPCStickerServer is the server API for RPCSticker service.
+type RPCStickerServer interface {
+	// sticker.createStickerSet flags:# user_id:long masks:flags.0?true animated:flags.1?true videos:flags.2?true title:string short_name:string thumb:flags.3?Document stickers:Vector<StickerSetDocument> = StickerSetData;
+	StickerCreateStickerSet(context.Context, *TLStickerCreateStickerSet) (*StickerSetData, error)
+	// sticker.addStickerToSet user_id:long stickerset:InputStickerSet sticker:StickerSetDocument = StickerSetData;
+	StickerAddStickerToSet(context.Context, *TLStickerAddStickerToSet) (*StickerSetData, error)
+	// sticker.removeStickerFromSet user_id:long document_id:long = StickerSetData;
+	StickerRemoveStickerFromSet(context.Context, *TLStickerRemoveStickerFromSet) (*StickerSetData, error)
+	// sticker.checkShortName short_name:string = Bool;
+	StickerCheckShortName(context.Context, *TLStickerCheckShortName) (*mtproto.Bool, error)
+	// sticker.getStickerSet user_id:long stickerset:InputStickerSet = StickerSetData;
+	StickerGetStickerSet(context.Context, *TLStickerGetStickerSet) (*StickerSetData, error)
+	// sticker.getStickerSetThumb stickerset:InputStickerSet thumb_version:int = InputFileLocation;
+	StickerGetStickerSetThumb(context.Context, *TLStickerGetStickerSetThumb) (*mtproto.InputFileLocation, error)
+	// sticker.installStickerSet user_id:long stickerset:InputStickerSet archived:Bool = StickerSet;
+	StickerInstallStickerSet(context.Context, *TLStickerInstallStickerSet) (*mtproto.StickerSet, error)
+	// sticker.uninstallStickerSet user_id:long stickerset:InputStickerSet = Bool;
+	StickerUninstallStickerSet(context.Context, *TLStickerUninstallStickerSet) (*mtproto.Bool, error)
+	// sticker.getInstalledStickerSets user_id:long masks:Bool = Vector<StickerSet>;
+	StickerGetInstalledStickerSets(context.Context, *TLStickerGetInstalledStickerSets) (*Vector_StickerSet, error)
+	// sticker.getRecentStickers user_id:long attached:Bool = Vector<StickerSetDocument>;
+	StickerGetRecentStickers(context.Context, *TLStickerGetRecentStickers) (*Vector_StickerSetDocument, error)
+	// sticker.saveRecentSticker user_id:long attached:Bool document_id:long unsave:Bool = Bool;
+	StickerSaveRecentSticker(context.Context, *TLStickerSaveRecentSticker) (*mtproto.Bool, error)
+	// sticker.clearRecentStickers user_id:long attached:Bool = Bool;
+	StickerClearRecentStickers(context.Context, *TLStickerClearRecentStickers) (*mtproto.Bool, error)
+	// sticker.getFavedStickers user_id:long = Vector<StickerSetDocument>;
+	StickerGetFavedStickers(context.Context, *TLStickerGetFavedStickers) (*Vector_StickerSetDocument, error)
+	// sticker.faveSticker user_id:long document_id:long unfave:Bool = Bool;
+	StickerFaveSticker(context.Context, *TLStickerFaveSticker) (*mtproto.Bool, error)
+	// sticker.getStickersByEmoji user_id:long emoticon:string = Vector<StickerSetDocument>;
+	StickerGetStickersByEmoji(context.Context, *TLStickerGetStickersByEmoji) (*Vector_StickerSetDocument, error)
+	// sticker.changeStickerPosition user_id:long document_id:long position:int = StickerSetData;
+	StickerChangeStickerPosition(context.Context, *TLStickerChangeStickerPosition) (*StickerSetData, error)
+	// sticker.setStickerSetThumb user_id:long stickerset:InputStickerSet thumb:Document = StickerSetData;
+	StickerSetStickerSetThumb(context.Context, *TLStickerSetStickerSetThumb) (*StickerSetData, error)
+}
+
+// UnimplementedRPCStickerServer can be embedded to have forward compatible implementations.
+type UnimplementedRPCStickerServer struct {
+}
+
+func (*UnimplementedRPCStickerServer) StickerCreateStickerSet(ctx context.Context, req *TLStickerCreateStickerSet) (*StickerSetData, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StickerCreateStickerSet not implemented")
+}
+func (*UnimplementedRPCStickerServer) StickerAddStickerToSet(ctx context.Context, req *TLStickerAddStickerToSet) (*StickerSetData, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StickerAddStickerToSet not implemented")
+}
+func (*UnimplementedRPCStickerServer) StickerRemoveStickerFromSet(ctx context.Context, req *TLStickerRemoveStickerFromSet) (*StickerSetData, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StickerRemoveStickerFromSet not implemented")
+}
+func (*UnimplementedRPCStickerServer) StickerCheckShortName(ctx context.Context, req *TLStickerCheckShortName) (*mtproto.Bool, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StickerCheckShortName not implemented")
+}
+func (*UnimplementedRPCStickerServer) StickerGetStickerSet(ctx context.Context, req *TLStickerGetStickerSet) (*StickerSetData, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StickerGetStickerSet not implemented")
+}
+func (*UnimplementedRPCStickerServer) StickerGetStickerSetThumb(ctx context.Context, req *TLStickerGetStickerSetThumb) (*mtproto.InputFileLocation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StickerGetStickerSetThumb not implemented")
+}
+func (*UnimplementedRPCStickerServer) StickerInstallStickerSet(ctx context.Context, req *TLStickerInstallStickerSet) (*mtproto.StickerSet, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StickerInstallStickerSet not implemented")
+}
+func (*UnimplementedRPCStickerServer) StickerUninstallStickerSet(ctx context.Context, req *TLStickerUninstallStickerSet) (*mtproto.Bool, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StickerUninstallStickerSet not implemented")
+}
+func (*UnimplementedRPCStickerServer) StickerGetInstalledStickerSets(ctx context.Context, req *TLStickerGetInstalledStickerSets) (*Vector_StickerSet, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StickerGetInstalledStickerSets not implemented")
+}
+func (*UnimplementedRPCStickerServer) StickerGetRecentStickers(ctx context.Context, req *TLStickerGetRecentStickers) (*Vector_StickerSetDocument, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StickerGetRecentStickers not implemented")
+}
+func (*UnimplementedRPCStickerServer) StickerSaveRecentSticker(ctx context.Context, req *TLStickerSaveRecentSticker) (*mtproto.Bool, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StickerSaveRecentSticker not implemented")
+}
+func (*UnimplementedRPCStickerServer) StickerClearRecentStickers(ctx context.Context, req *TLStickerClearRecentStickers) (*mtproto.Bool, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StickerClearRecentStickers not implemented")
+}
+func (*UnimplementedRPCStickerServer) StickerGetFavedStickers(ctx context.Context, req *TLStickerGetFavedStickers) (*Vector_StickerSetDocument, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StickerGetFavedStickers not implemented")
+}
+func (*UnimplementedRPCStickerServer) StickerFaveSticker(ctx context.Context, req *TLStickerFaveSticker) (*mtproto.Bool, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StickerFaveSticker not implemented")
+}
+func (*UnimplementedRPCStickerServer) StickerGetStickersByEmoji(ctx context.Context, req *TLStickerGetStickersByEmoji) (*Vector_StickerSetDocument, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StickerGetStickersByEmoji not implemented")
+}
+func (*UnimplementedRPCStickerServer) StickerChangeStickerPosition(ctx context.Context, req *TLStickerChangeStickerPosition) (*StickerSetData, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StickerChangeStickerPosition not implemented")
+}
+func (*UnimplementedRPCStickerServer) StickerSetStickerSetThumb(ctx context.Context, req *TLStickerSetStickerSetThumb) (*StickerSetData, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StickerSetStickerSetThumb not implemented")
+}
+
+func RegisterRPCStickerServer(s *grpc.Server, srv RPCStickerServer) {
+	s.RegisterService(&_RPCSticker_serviceDesc, srv)
+}
+
+func _RPCSticker_StickerCreateStickerSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLStickerCreateStickerSet)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCStickerServer).StickerCreateStickerSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sticker.RPCSticker/StickerCreateStickerSet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCStickerServer).StickerCreateStickerSet(ctx, req.(*TLStickerCreateStickerSet))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCSticker_StickerAddStickerToSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLStickerAddStickerToSet)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCStickerServer).StickerAddStickerToSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sticker.RPCSticker/StickerAddStickerToSet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCStickerServer).StickerAddStickerToSet(ctx, req.(*TLStickerAddStickerToSet))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCSticker_StickerRemoveStickerFromSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLStickerRemoveStickerFromSet)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCStickerServer).StickerRemoveStickerFromSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sticker.RPCSticker/StickerRemoveStickerFromSet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCStickerServer).StickerRemoveStickerFromSet(ctx, req.(*TLStickerRemoveStickerFromSet))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCSticker_StickerCheckShortName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLStickerCheckShortName)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCStickerServer).StickerCheckShortName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sticker.RPCSticker/StickerCheckShortName",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCStickerServer).StickerCheckShortName(ctx, req.(*TLStickerCheckShortName))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCSticker_StickerGetStickerSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLStickerGetStickerSet)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCStickerServer).StickerGetStickerSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sticker.RPCSticker/StickerGetStickerSet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCStickerServer).StickerGetStickerSet(ctx, req.(*TLStickerGetStickerSet))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCSticker_StickerGetStickerSetThumb_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLStickerGetStickerSetThumb)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCStickerServer).StickerGetStickerSetThumb(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sticker.RPCSticker/StickerGetStickerSetThumb",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCStickerServer).StickerGetStickerSetThumb(ctx, req.(*TLStickerGetStickerSetThumb))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCSticker_StickerInstallStickerSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLStickerInstallStickerSet)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCStickerServer).StickerInstallStickerSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sticker.RPCSticker/StickerInstallStickerSet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCStickerServer).StickerInstallStickerSet(ctx, req.(*TLStickerInstallStickerSet))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCSticker_StickerUninstallStickerSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLStickerUninstallStickerSet)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCStickerServer).StickerUninstallStickerSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sticker.RPCSticker/StickerUninstallStickerSet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCStickerServer).StickerUninstallStickerSet(ctx, req.(*TLStickerUninstallStickerSet))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCSticker_StickerGetInstalledStickerSets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLStickerGetInstalledStickerSets)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCStickerServer).StickerGetInstalledStickerSets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sticker.RPCSticker/StickerGetInstalledStickerSets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCStickerServer).StickerGetInstalledStickerSets(ctx, req.(*TLStickerGetInstalledStickerSets))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCSticker_StickerGetRecentStickers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLStickerGetRecentStickers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCStickerServer).StickerGetRecentStickers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sticker.RPCSticker/StickerGetRecentStickers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCStickerServer).StickerGetRecentStickers(ctx, req.(*TLStickerGetRecentStickers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCSticker_StickerSaveRecentSticker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLStickerSaveRecentSticker)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCStickerServer).StickerSaveRecentSticker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sticker.RPCSticker/StickerSaveRecentSticker",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCStickerServer).StickerSaveRecentSticker(ctx, req.(*TLStickerSaveRecentSticker))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCSticker_StickerClearRecentStickers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLStickerClearRecentStickers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCStickerServer).StickerClearRecentStickers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sticker.RPCSticker/StickerClearRecentStickers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCStickerServer).StickerClearRecentStickers(ctx, req.(*TLStickerClearRecentStickers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCSticker_StickerGetFavedStickers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLStickerGetFavedStickers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCStickerServer).StickerGetFavedStickers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sticker.RPCSticker/StickerGetFavedStickers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCStickerServer).StickerGetFavedStickers(ctx, req.(*TLStickerGetFavedStickers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCSticker_StickerFaveSticker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLStickerFaveSticker)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCStickerServer).StickerFaveSticker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sticker.RPCSticker/StickerFaveSticker",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCStickerServer).StickerFaveSticker(ctx, req.(*TLStickerFaveSticker))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCSticker_StickerGetStickersByEmoji_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLStickerGetStickersByEmoji)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCStickerServer).StickerGetStickersByEmoji(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sticker.RPCSticker/StickerGetStickersByEmoji",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCStickerServer).StickerGetStickersByEmoji(ctx, req.(*TLStickerGetStickersByEmoji))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCSticker_StickerChangeStickerPosition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLStickerChangeStickerPosition)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCStickerServer).StickerChangeStickerPosition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sticker.RPCSticker/StickerChangeStickerPosition",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCStickerServer).StickerChangeStickerPosition(ctx, req.(*TLStickerChangeStickerPosition))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCSticker_StickerSetStickerSetThumb_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLStickerSetStickerSetThumb)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCStickerServer).StickerSetStickerSetThumb(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sticker.RPCSticker/StickerSetStickerSetThumb",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCStickerServer).StickerSetStickerSetThumb(ctx, req.(*TLStickerSetStickerSetThumb))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _RPCSticker_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "sticker.RPCSticker",
+	HandlerType: (*RPCStickerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "sticker_createStickerSet",
+			Handler:    _RPCSticker_StickerCreateStickerSet_Handler,
+		},
+		{
+			MethodName: "sticker_addStickerToSet",
+			Handler:    _RPCSticker_StickerAddStickerToSet_Handler,
+		},
+		{
+			MethodName: "sticker_removeStickerFromSet",
+			Handler:    _RPCSticker_StickerRemoveStickerFromSet_Handler,
+		},
+		{
+			MethodName: "sticker_checkShortName",
+			Handler:    _RPCSticker_StickerCheckShortName_Handler,
+		},
+		{
+			MethodName: "sticker_getStickerSet",
+			Handler:    _RPCSticker_StickerGetStickerSet_Handler,
+		},
+		{
+			MethodName: "sticker_getStickerSetThumb",
+			Handler:    _RPCSticker_StickerGetStickerSetThumb_Handler,
+		},
+		{
+			MethodName: "sticker_installStickerSet",
+			Handler:    _RPCSticker_StickerInstallStickerSet_Handler,
+		},
+		{
+			MethodName: "sticker_uninstallStickerSet",
+			Handler:    _RPCSticker_StickerUninstallStickerSet_Handler,
+		},
+		{
+			MethodName: "sticker_getInstalledStickerSets",
+			Handler:    _RPCSticker_StickerGetInstalledStickerSets_Handler,
+		},
+		{
+			MethodName: "sticker_getRecentStickers",
+			Handler:    _RPCSticker_StickerGetRecentStickers_Handler,
+		},
+		{
+			MethodName: "sticker_saveRecentSticker",
+			Handler:    _RPCSticker_StickerSaveRecentSticker_Handler,
+		},
+		{
+			MethodName: "sticker_clearRecentStickers",
+			Handler:    _RPCSticker_StickerClearRecentStickers_Handler,
+		},
+		{
+			MethodName: "sticker_getFavedStickers",
+			Handler:    _RPCSticker_StickerGetFavedStickers_Handler,
+		},
+		{
+			MethodName: "sticker_faveSticker",
+			Handler:    _RPCSticker_StickerFaveSticker_Handler,
+		},
+		{
+			MethodName: "sticker_getStickersByEmoji",
+			Handler:    _RPCSticker_StickerGetStickersByEmoji_Handler,
+		},
+		{
+			MethodName: "sticker_changeStickerPosition",
+			Handler:    _RPCSticker_StickerChangeStickerPosition_Handler,
+		},
+		{
+			MethodName: "sticker_setStickerSetThumb",
+			Handler:    _RPCSticker_StickerSetStickerSetThumb_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "sticker.tl.proto",
+}
+
+func (m *StickerSetDocument) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StickerSetDocument) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StickerSetDocument) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Date != 0 {
+		i = encodeVarintStickerTl(dAtA, i, uint64(m.Date))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Emoji) > 0 {
+		i -= len(m.Emoji)
+		copy(dAtA[i:], m.Emoji)
+		i = encodeVarintStickerTl(dAtA, i, uint64(len(m.Emoji)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.DocumentId != 0 {
+		i = encodeVarintStickerTl(dAtA, i, uint64(m.DocumentId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.SetAccessHash != 0 {
+		i = encodeVarintStickerTl(dAtA, i, uint64(m.SetAccessHash))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.SetId != 0 {
+		i = encodeVarintStickerTl(dAtA, i, uint64(m.SetId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintStickerTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.PredicateName) > 0 {
+		i -= len(m.PredicateName)
+		copy(dAtA[i:], m.PredicateName)
+		i = encodeVarintStickerTl(dAtA, i, uint64(len(m.PredicateName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLStickerSetDocument) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLStickerSetDocument) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLStickerSetDocument) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Data2 != nil {
+		{
+			size, err := m.Data2.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStickerTl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StickerSetData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StickerSetData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StickerSetData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Documents) > 0 {
+		for iNdEx := len(m.Documents) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Documents[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStickerTl(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Set != nil {
+		{
+			size, err := m.Set.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStickerTl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintStickerTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.PredicateName) > 0 {
+		i -= len(m.PredicateName)
+		copy(dAtA[i:], m.PredicateName)
+		i = encodeVarintStickerTl(dAtA, i, uint64(len(m.PredicateName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLStickerSetData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLStickerSetData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLStickerSetData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Data2 != nil {
+		{
+			size, err := m.Data2.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStickerTl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLStickerCreateStickerSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLStickerCreateStickerSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLStickerCreateStickerSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Stickers) > 0 {
+		for iNdEx := len(m.Stickers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Stickers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStickerTl(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if m.Thumb != nil {
+		{
+			size, err := m.Thumb.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStickerTl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.ShortName) > 0 {
+		i -= len(m.ShortName)
+		copy(dAtA[i:], m.ShortName)
+		i = encodeVarintStickerTl(dAtA, i, uint64(len(m.ShortName)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintStickerTl(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Videos {
+		i--
+		if m.Videos {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Animated {
+		i--
+		if m.Animated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Masks {
+		i--
+		if m.Masks {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.UserId != 0 {
+		i = encodeVarintStickerTl(dAtA, i, uint64(m.UserId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintStickerTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLStickerAddStickerToSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLStickerAddStickerToSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLStickerAddStickerToSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Sticker != nil {
+		{
+			size, err := m.Sticker.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStickerTl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Stickerset != nil {
+		{
+			size, err := m.Stickerset.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStickerTl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.UserId != 0 {
+		i = encodeVarintStickerTl(dAtA, i, uint64(m.UserId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintStickerTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLStickerRemoveStickerFromSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLStickerRemoveStickerFromSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLStickerRemoveStickerFromSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.DocumentId != 0 {
+		i = encodeVarintStickerTl(dAtA, i, uint64(m.DocumentId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.UserId != 0 {
+		i = encodeVarintStickerTl(dAtA, i, uint64(m.UserId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintStickerTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLStickerCheckShortName) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLStickerCheckShortName) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLStickerCheckShortName) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.ShortName) > 0 {
+		i -= len(m.ShortName)
+		copy(dAtA[i:], m.ShortName)
+		i = encodeVarintStickerTl(dAtA, i, uint64(len(m.ShortName)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintStickerTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLStickerGetStickerSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLStickerGetStickerSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLStickerGetStickerSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Stickerset != nil {
+		{
+			size, err := m.Stickerset.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStickerTl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.UserId != 0 {
+		i = encodeVarintStickerTl(dAtA, i, uint64(m.UserId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintStickerTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLStickerGetStickerSetThumb) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLStickerGetStickerSetThumb) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLStickerGetStickerSetThumb) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ThumbVersion != 0 {
+		i = encodeVarintStickerTl(dAtA, i, uint64(m.ThumbVersion))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Stickerset != nil {
+		{
+			size, err := m.Stickerset.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStickerTl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintStickerTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLStickerInstallStickerSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLStickerInstallStickerSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLStickerInstallStickerSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Archived != nil {
+		{
+			size, err := m.Archived.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStickerTl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Stickerset != nil {
+		{
+			size, err := m.Stickerset.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStickerTl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.UserId != 0 {
+		i = encodeVarintStickerTl(dAtA, i, uint64(m.UserId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintStickerTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLStickerUninstallStickerSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLStickerUninstallStickerSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLStickerUninstallStickerSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Stickerset != nil {
+		{
+			size, err := m.Stickerset.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStickerTl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.UserId != 0 {
+		i = encodeVarintStickerTl(dAtA, i, uint64(m.UserId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintStickerTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLStickerGetInstalledStickerSets) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLStickerGetInstalledStickerSets) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLStickerGetInstalledStickerSets) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Masks != nil {
+		{
+			size, err := m.Masks.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStickerTl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.UserId != 0 {
+		i = encodeVarintStickerTl(dAtA, i, uint64(m.UserId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintStickerTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLStickerGetRecentStickers) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLStickerGetRecentStickers) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLStickerGetRecentStickers) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Attached != nil {
+		{
+			size, err := m.Attached.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStickerTl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.UserId != 0 {
+		i = encodeVarintStickerTl(dAtA, i, uint64(m.UserId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintStickerTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLStickerSaveRecentSticker) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLStickerSaveRecentSticker) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLStickerSaveRecentSticker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Unsave != nil {
+		{
+			size, err := m.Unsave.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStickerTl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.DocumentId != 0 {
+		i = encodeVarintStickerTl(dAtA, i, uint64(m.DocumentId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Attached != nil {
+		{
+			size, err := m.Attached.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStickerTl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.UserId != 0 {
+		i = encodeVarintStickerTl(dAtA, i, uint64(m.UserId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintStickerTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLStickerClearRecentStickers) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLStickerClearRecentStickers) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLStickerClearRecentStickers) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Attached != nil {
+		{
+			size, err := m.Attached.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStickerTl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.UserId != 0 {
+		i = encodeVarintStickerTl(dAtA, i, uint64(m.UserId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintStickerTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLStickerGetFavedStickers) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLStickerGetFavedStickers) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLStickerGetFavedStickers) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.UserId != 0 {
+		i = encodeVarintStickerTl(dAtA, i, uint64(m.UserId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintStickerTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLStickerFaveSticker) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLStickerFaveSticker) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLStickerFaveSticker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Unfave != nil {
+		{
+			size, err := m.Unfave.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStickerTl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.DocumentId != 0 {
+		i = encodeVarintStickerTl(dAtA, i, uint64(m.DocumentId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.UserId != 0 {
+		i = encodeVarintStickerTl(dAtA, i, uint64(m.UserId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintStickerTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLStickerGetStickersByEmoji) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLStickerGetStickersByEmoji) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLStickerGetStickersByEmoji) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Emoticon) > 0 {
+		i -= len(m.Emoticon)
+		copy(dAtA[i:], m.Emoticon)
+		i = encodeVarintStickerTl(dAtA, i, uint64(len(m.Emoticon)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.UserId != 0 {
+		i = encodeVarintStickerTl(dAtA, i, uint64(m.UserId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintStickerTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLStickerChangeStickerPosition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLStickerChangeStickerPosition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLStickerChangeStickerPosition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Position != 0 {
+		i = encodeVarintStickerTl(dAtA, i, uint64(m.Position))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.DocumentId != 0 {
+		i = encodeVarintStickerTl(dAtA, i, uint64(m.DocumentId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.UserId != 0 {
+		i = encodeVarintStickerTl(dAtA, i, uint64(m.UserId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintStickerTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLStickerSetStickerSetThumb) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLStickerSetStickerSetThumb) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLStickerSetStickerSetThumb) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Thumb != nil {
+		{
+			size, err := m.Thumb.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStickerTl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Stickerset != nil {
+		{
+			size, err := m.Stickerset.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStickerTl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.UserId != 0 {
+		i = encodeVarintStickerTl(dAtA, i, uint64(m.UserId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constructor != 0 {
+		i = encodeVarintStickerTl(dAtA, i, uint64(m.Constructor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Vector_StickerSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Vector_StickerSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Vector_StickerSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Datas) > 0 {
+		for iNdEx := len(m.Datas) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Datas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStickerTl(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Vector_StickerSetDocument) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Vector_StickerSetDocument) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Vector_StickerSetDocument) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Datas) > 0 {
+		for iNdEx := len(m.Datas) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Datas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStickerTl(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintStickerTl(dAtA []byte, offset int, v uint64) int {
+	offset -= sovStickerTl(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *StickerSetDocument) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PredicateName)
+	if l > 0 {
+		n += 1 + l + sovStickerTl(uint64(l))
+	}
+	if m.Constructor != 0 {
+		n += 1 + sovStickerTl(uint64(m.Constructor))
+	}
+	if m.SetId != 0 {
+		n += 1 + sovStickerTl(uint64(m.SetId))
+	}
+	if m.SetAccessHash != 0 {
+		n += 1 + sovStickerTl(uint64(m.SetAccessHash))
+	}
+	if m.DocumentId != 0 {
+		n += 1 + sovStickerTl(uint64(m.DocumentId))
+	}
+	l = len(m.Emoji)
+	if l > 0 {
+		n += 1 + l + sovStickerTl(uint64(l))
+	}
+	if m.Date != 0 {
+		n += 1 + sovStickerTl(uint64(m.Date))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLStickerSetDocument) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Data2 != nil {
+		l = m.Data2.Size()
+		n += 1 + l + sovStickerTl(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StickerSetData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PredicateName)
+	if l > 0 {
+		n += 1 + l + sovStickerTl(uint64(l))
+	}
+	if m.Constructor != 0 {
+		n += 1 + sovStickerTl(uint64(m.Constructor))
+	}
+	if m.Set != nil {
+		l = m.Set.Size()
+		n += 1 + l + sovStickerTl(uint64(l))
+	}
+	if len(m.Documents) > 0 {
+		for _, e := range m.Documents {
+			l = e.Size()
+			n += 1 + l + sovStickerTl(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLStickerSetData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Data2 != nil {
+		l = m.Data2.Size()
+		n += 1 + l + sovStickerTl(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLStickerCreateStickerSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovStickerTl(uint64(m.Constructor))
+	}
+	if m.UserId != 0 {
+		n += 1 + sovStickerTl(uint64(m.UserId))
+	}
+	if m.Masks {
+		n += 2
+	}
+	if m.Animated {
+		n += 2
+	}
+	if m.Videos {
+		n += 2
+	}
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovStickerTl(uint64(l))
+	}
+	l = len(m.ShortName)
+	if l > 0 {
+		n += 1 + l + sovStickerTl(uint64(l))
+	}
+	if m.Thumb != nil {
+		l = m.Thumb.Size()
+		n += 1 + l + sovStickerTl(uint64(l))
+	}
+	if len(m.Stickers) > 0 {
+		for _, e := range m.Stickers {
+			l = e.Size()
+			n += 1 + l + sovStickerTl(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLStickerAddStickerToSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovStickerTl(uint64(m.Constructor))
+	}
+	if m.UserId != 0 {
+		n += 1 + sovStickerTl(uint64(m.UserId))
+	}
+	if m.Stickerset != nil {
+		l = m.Stickerset.Size()
+		n += 1 + l + sovStickerTl(uint64(l))
+	}
+	if m.Sticker != nil {
+		l = m.Sticker.Size()
+		n += 1 + l + sovStickerTl(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLStickerRemoveStickerFromSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovStickerTl(uint64(m.Constructor))
+	}
+	if m.UserId != 0 {
+		n += 1 + sovStickerTl(uint64(m.UserId))
+	}
+	if m.DocumentId != 0 {
+		n += 1 + sovStickerTl(uint64(m.DocumentId))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLStickerCheckShortName) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovStickerTl(uint64(m.Constructor))
+	}
+	l = len(m.ShortName)
+	if l > 0 {
+		n += 1 + l + sovStickerTl(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLStickerGetStickerSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovStickerTl(uint64(m.Constructor))
+	}
+	if m.UserId != 0 {
+		n += 1 + sovStickerTl(uint64(m.UserId))
+	}
+	if m.Stickerset != nil {
+		l = m.Stickerset.Size()
+		n += 1 + l + sovStickerTl(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLStickerGetStickerSetThumb) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovStickerTl(uint64(m.Constructor))
+	}
+	if m.Stickerset != nil {
+		l = m.Stickerset.Size()
+		n += 1 + l + sovStickerTl(uint64(l))
+	}
+	if m.ThumbVersion != 0 {
+		n += 1 + sovStickerTl(uint64(m.ThumbVersion))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLStickerInstallStickerSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovStickerTl(uint64(m.Constructor))
+	}
+	if m.UserId != 0 {
+		n += 1 + sovStickerTl(uint64(m.UserId))
+	}
+	if m.Stickerset != nil {
+		l = m.Stickerset.Size()
+		n += 1 + l + sovStickerTl(uint64(l))
+	}
+	if m.Archived != nil {
+		l = m.Archived.Size()
+		n += 1 + l + sovStickerTl(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLStickerUninstallStickerSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovStickerTl(uint64(m.Constructor))
+	}
+	if m.UserId != 0 {
+		n += 1 + sovStickerTl(uint64(m.UserId))
+	}
+	if m.Stickerset != nil {
+		l = m.Stickerset.Size()
+		n += 1 + l + sovStickerTl(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLStickerGetInstalledStickerSets) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovStickerTl(uint64(m.Constructor))
+	}
+	if m.UserId != 0 {
+		n += 1 + sovStickerTl(uint64(m.UserId))
+	}
+	if m.Masks != nil {
+		l = m.Masks.Size()
+		n += 1 + l + sovStickerTl(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLStickerGetRecentStickers) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovStickerTl(uint64(m.Constructor))
+	}
+	if m.UserId != 0 {
+		n += 1 + sovStickerTl(uint64(m.UserId))
+	}
+	if m.Attached != nil {
+		l = m.Attached.Size()
+		n += 1 + l + sovStickerTl(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLStickerSaveRecentSticker) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovStickerTl(uint64(m.Constructor))
+	}
+	if m.UserId != 0 {
+		n += 1 + sovStickerTl(uint64(m.UserId))
+	}
+	if m.Attached != nil {
+		l = m.Attached.Size()
+		n += 1 + l + sovStickerTl(uint64(l))
+	}
+	if m.DocumentId != 0 {
+		n += 1 + sovStickerTl(uint64(m.DocumentId))
+	}
+	if m.Unsave != nil {
+		l = m.Unsave.Size()
+		n += 1 + l + sovStickerTl(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLStickerClearRecentStickers) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovStickerTl(uint64(m.Constructor))
+	}
+	if m.UserId != 0 {
+		n += 1 + sovStickerTl(uint64(m.UserId))
+	}
+	if m.Attached != nil {
+		l = m.Attached.Size()
+		n += 1 + l + sovStickerTl(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLStickerGetFavedStickers) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovStickerTl(uint64(m.Constructor))
+	}
+	if m.UserId != 0 {
+		n += 1 + sovStickerTl(uint64(m.UserId))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLStickerFaveSticker) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovStickerTl(uint64(m.Constructor))
+	}
+	if m.UserId != 0 {
+		n += 1 + sovStickerTl(uint64(m.UserId))
+	}
+	if m.DocumentId != 0 {
+		n += 1 + sovStickerTl(uint64(m.DocumentId))
+	}
+	if m.Unfave != nil {
+		l = m.Unfave.Size()
+		n += 1 + l + sovStickerTl(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLStickerGetStickersByEmoji) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovStickerTl(uint64(m.Constructor))
+	}
+	if m.UserId != 0 {
+		n += 1 + sovStickerTl(uint64(m.UserId))
+	}
+	l = len(m.Emoticon)
+	if l > 0 {
+		n += 1 + l + sovStickerTl(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLStickerChangeStickerPosition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovStickerTl(uint64(m.Constructor))
+	}
+	if m.UserId != 0 {
+		n += 1 + sovStickerTl(uint64(m.UserId))
+	}
+	if m.DocumentId != 0 {
+		n += 1 + sovStickerTl(uint64(m.DocumentId))
+	}
+	if m.Position != 0 {
+		n += 1 + sovStickerTl(uint64(m.Position))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLStickerSetStickerSetThumb) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constructor != 0 {
+		n += 1 + sovStickerTl(uint64(m.Constructor))
+	}
+	if m.UserId != 0 {
+		n += 1 + sovStickerTl(uint64(m.UserId))
+	}
+	if m.Stickerset != nil {
+		l = m.Stickerset.Size()
+		n += 1 + l + sovStickerTl(uint64(l))
+	}
+	if m.Thumb != nil {
+		l = m.Thumb.Size()
+		n += 1 + l + sovStickerTl(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Vector_StickerSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Datas) > 0 {
+		for _, e := range m.Datas {
+			l = e.Size()
+			n += 1 + l + sovStickerTl(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Vector_StickerSetDocument) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Datas) > 0 {
+		for _, e := range m.Datas {
+			l = e.Size()
+			n += 1 + l + sovStickerTl(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovStickerTl(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozStickerTl(x uint64) (n int) {
+	return sovStickerTl(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *StickerSetDocument) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStickerTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StickerSetDocument: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StickerSetDocument: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PredicateName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStickerTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStickerTl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStickerTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PredicateName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStickerTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SetId", wireType)
+			}
+			m.SetId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStickerTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SetId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SetAccessHash", wireType)
+			}
+			m.SetAccessHash = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStickerTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SetAccessHash |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DocumentId", wireType)
+			}
+			m.DocumentId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStickerTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DocumentId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Emoji", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStickerTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStickerTl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStickerTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Emoji = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Date", wireType)
+			}
+			m.Date = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStickerTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Date |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStickerTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStickerTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLStickerSetDocument) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStickerTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_stickerSetDocument: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_stickerSetDocument: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data2", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStickerTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStickerTl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStickerTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Data2 == nil {
+				m.Data2 = &StickerSetDocument{}
+			}
+			if err := m.Data2.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStickerTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStickerTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StickerSetData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStickerTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StickerSetData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StickerSetData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PredicateName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStickerTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStickerTl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStickerTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PredicateName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStickerTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Set", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStickerTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStickerTl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStickerTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Set == nil {
+				m.Set = &mtproto.StickerSet{}
+			}
+			if err := m.Set.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Documents", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStickerTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStickerTl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStickerTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Documents = append(m.Documents, &StickerSetDocument{})
+			if err := m.Documents[len(m.Documents)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStickerTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStickerTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLStickerSetData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStickerTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_stickerSetData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_stickerSetData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data2", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStickerTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStickerTl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStickerTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Data2 == nil {
+				m.Data2 = &StickerSetData{}
+			}
+			if err := m.Data2.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStickerTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStickerTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLStickerCreateStickerSet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStickerTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_sticker_createStickerSet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_sticker_createStickerSet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStickerTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			m.UserId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStickerTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UserId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Masks", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStickerTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Masks = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Animated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStickerTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Animated = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Videos", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStickerTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Videos = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStickerTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStickerTl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStickerTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShortName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStickerTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStickerTl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStickerTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ShortName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Thumb", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStickerTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStickerTl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStickerTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Thumb == nil {
+				m.Thumb = &mtproto.Document{}
+			}
+			if err := m.Thumb.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stickers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStickerTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStickerTl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStickerTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stickers = append(m.Stickers, &StickerSetDocument{})
+			if err := m.Stickers[len(m.Stickers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStickerTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStickerTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLStickerAddStickerToSet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStickerTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_sticker_addStickerToSet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_sticker_addStickerToSet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStickerTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			m.UserId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStickerTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UserId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stickerset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStickerTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStickerTl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStickerTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Stickerset == nil {
+				m.Stickerset = &mtproto.InputStickerSet{}
+			}
+			if err := m.Stickerset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sticker", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStickerTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStickerTl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStickerTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Sticker == nil {
+				m.Sticker = &StickerSetDocument{}
+			}
+			if err := m.Sticker.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStickerTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStickerTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLStickerRemoveStickerFromSet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStickerTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_sticker_removeStickerFromSet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_sticker_removeStickerFromSet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStickerTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			m.UserId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStickerTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UserId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DocumentId", wireType)
+			}
+			m.DocumentId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStickerTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DocumentId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStickerTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStickerTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLStickerCheckShortName) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStickerTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_sticker_checkShortName: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_sticker_checkShortName: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStickerTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShortName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStickerTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStickerTl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStickerTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ShortName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStickerTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStickerTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLStickerGetStickerSet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStickerTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_sticker_getStickerSet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_sticker_getStickerSet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStickerTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			m.UserId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStickerTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UserId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stickerset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStickerTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStickerTl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStickerTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Stickerset == nil {
+				m.Stickerset = &mtproto.InputStickerSet{}
+			}
+			if err := m.Stickerset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStickerTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStickerTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLStickerGetStickerSetThumb) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStickerTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_sticker_getStickerSetThumb: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_sticker_getStickerSetThumb: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStickerTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stickerset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStickerTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStickerTl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStickerTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Stickerset == nil {
+				m.Stickerset = &mtproto.InputStickerSet{}
+			}
+			if err := m.Stickerset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ThumbVersion", wireType)
+			}
+			m.ThumbVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStickerTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ThumbVersion |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStickerTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStickerTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLStickerInstallStickerSet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStickerTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_sticker_installStickerSet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_sticker_installStickerSet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStickerTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			m.UserId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStickerTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UserId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stickerset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStickerTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStickerTl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStickerTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Stickerset == nil {
+				m.Stickerset = &mtproto.InputStickerSet{}
+			}
+			if err := m.Stickerset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Archived", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStickerTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStickerTl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStickerTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Archived == nil {
+				m.Archived = &mtproto.Bool{}
+			}
+			if err := m.Archived.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStickerTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStickerTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLStickerUninstallStickerSet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStickerTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_sticker_uninstallStickerSet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_sticker_uninstallStickerSet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStickerTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			m.UserId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStickerTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UserId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stickerset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStickerTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStickerTl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStickerTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Stickerset == nil {
+				m.Stickerset = &mtproto.InputStickerSet{}
+			}
+			if err := m.Stickerset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStickerTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStickerTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLStickerGetInstalledStickerSets) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStickerTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_sticker_getInstalledStickerSets: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_sticker_getInstalledStickerSets: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStickerTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			m.UserId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStickerTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UserId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Masks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStickerTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStickerTl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStickerTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Masks == nil {
+				m.Masks = &mtproto.Bool{}
+			}
+			if err := m.Masks.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStickerTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStickerTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLStickerGetRecentStickers) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStickerTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_sticker_getRecentStickers: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_sticker_getRecentStickers: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStickerTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			m.UserId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStickerTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UserId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attached", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStickerTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStickerTl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStickerTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Attached == nil {
+				m.Attached = &mtproto.Bool{}
+			}
+			if err := m.Attached.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStickerTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStickerTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLStickerSaveRecentSticker) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStickerTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_sticker_saveRecentSticker: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_sticker_saveRecentSticker: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStickerTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			m.UserId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStickerTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UserId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attached", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStickerTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStickerTl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStickerTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Attached == nil {
+				m.Attached = &mtproto.Bool{}
+			}
+			if err := m.Attached.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DocumentId", wireType)
+			}
+			m.DocumentId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStickerTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DocumentId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unsave", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStickerTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStickerTl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStickerTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Unsave == nil {
+				m.Unsave = &mtproto.Bool{}
+			}
+			if err := m.Unsave.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStickerTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStickerTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLStickerClearRecentStickers) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStickerTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_sticker_clearRecentStickers: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_sticker_clearRecentStickers: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStickerTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			m.UserId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStickerTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UserId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attached", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStickerTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStickerTl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStickerTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Attached == nil {
+				m.Attached = &mtproto.Bool{}
+			}
+			if err := m.Attached.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStickerTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStickerTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLStickerGetFavedStickers) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStickerTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_sticker_getFavedStickers: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_sticker_getFavedStickers: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStickerTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			m.UserId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStickerTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UserId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStickerTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStickerTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLStickerFaveSticker) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStickerTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_sticker_faveSticker: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_sticker_faveSticker: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStickerTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			m.UserId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStickerTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UserId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DocumentId", wireType)
+			}
+			m.DocumentId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStickerTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DocumentId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unfave", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStickerTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStickerTl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStickerTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Unfave == nil {
+				m.Unfave = &mtproto.Bool{}
+			}
+			if err := m.Unfave.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStickerTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStickerTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLStickerGetStickersByEmoji) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStickerTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_sticker_getStickersByEmoji: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_sticker_getStickersByEmoji: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStickerTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			m.UserId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStickerTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UserId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Emoticon", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStickerTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStickerTl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStickerTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Emoticon = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStickerTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStickerTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLStickerChangeStickerPosition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStickerTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_sticker_changeStickerPosition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_sticker_changeStickerPosition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStickerTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			m.UserId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStickerTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UserId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DocumentId", wireType)
+			}
+			m.DocumentId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStickerTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DocumentId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Position", wireType)
+			}
+			m.Position = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStickerTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Position |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStickerTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStickerTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLStickerSetStickerSetThumb) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStickerTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TL_sticker_setStickerSetThumb: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TL_sticker_setStickerSetThumb: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constructor", wireType)
+			}
+			m.Constructor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStickerTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constructor |= TLConstructor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			m.UserId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStickerTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UserId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stickerset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStickerTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStickerTl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStickerTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Stickerset == nil {
+				m.Stickerset = &mtproto.InputStickerSet{}
+			}
+			if err := m.Stickerset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Thumb", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStickerTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStickerTl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStickerTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Thumb == nil {
+				m.Thumb = &mtproto.Document{}
+			}
+			if err := m.Thumb.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStickerTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStickerTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Vector_StickerSet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStickerTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Vector_StickerSet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Vector_StickerSet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Datas", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStickerTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStickerTl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStickerTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Datas = append(m.Datas, &mtproto.StickerSet{})
+			if err := m.Datas[len(m.Datas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStickerTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStickerTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Vector_StickerSetDocument) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStickerTl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Vector_StickerSetDocument: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Vector_StickerSetDocument: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Datas", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStickerTl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStickerTl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStickerTl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Datas = append(m.Datas, &StickerSetDocument{})
+			if err := m.Datas[len(m.Datas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStickerTl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStickerTl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipStickerTl(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowStickerTl
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowStickerTl
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowStickerTl
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthStickerTl
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupStickerTl
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthStickerTl
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthStickerTl        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowStickerTl          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupStickerTl = fmt.Errorf("proto: unexpected end of group")
+)
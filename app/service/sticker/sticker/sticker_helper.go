@@ -0,0 +1,188 @@
+// Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+//  All rights reserved.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package sticker
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/pkg/hashx"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	// ErrStickersTooMuch the sticker set is full
+	ErrStickersTooMuch = status.Error(mtproto.ErrBadRequest, "STICKERS_TOO_MUCH")
+)
+
+const (
+	StickerSetTitleMaxLen     = 64
+	StickerSetShortNameMaxLen = 64
+	StickerSetStickersMax     = 120
+	StickerEmojiMax           = 16
+)
+
+var (
+	shortNameRe = regexp.MustCompile("^[a-zA-Z][a-zA-Z0-9_]*$")
+)
+
+// CheckShortName the short name is used in the t.me/addstickers links, it must begin with a letter,
+// can't contain consecutive underscores and must end in a letter or a digit.
+func CheckShortName(shortName string) bool {
+	if len(shortName) == 0 || len(shortName) > StickerSetShortNameMaxLen {
+		return false
+	}
+	if !shortNameRe.MatchString(shortName) {
+		return false
+	}
+	if strings.Contains(shortName, "__") || strings.HasSuffix(shortName, "_") {
+		return false
+	}
+	return true
+}
+
+// MakeShortName suggests a short name from the title of a set, the letters and digits of the title
+// are kept and joined by underscores, it is empty if the title has no latin letter.
+func MakeShortName(title string) string {
+	var (
+		b          strings.Builder
+		underscore bool
+	)
+
+	for _, r := range strings.ToLower(title) {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9' && b.Len() > 0:
+			if underscore {
+				b.WriteByte('_')
+				underscore = false
+			}
+			b.WriteRune(r)
+		case b.Len() > 0:
+			underscore = true
+		}
+		if b.Len() >= StickerSetShortNameMaxLen-8 {
+			// room is left for a suffix if the name is occupied
+			break
+		}
+	}
+
+	return b.String()
+}
+
+func isRegionalIndicator(r rune) bool {
+	return r >= 0x1F1E6 && r <= 0x1F1FF
+}
+
+// isEmojiModifier the runes never starting an emoji, they modify the one before them
+func isEmojiModifier(r rune) bool {
+	return r == 0xFE0E || r == 0xFE0F || // variation selectors
+		r == 0x20E3 || // combining keycap
+		(r >= 0x1F3FB && r <= 0x1F3FF) || // skin tones
+		(r >= 0xE0020 && r <= 0xE007F) // tags
+}
+
+// SplitEmoji splits the emoji string of a sticker into the emoji it is associated with,
+// the zwj sequences and the flags are kept as one emoji.
+func SplitEmoji(s string) []string {
+	var (
+		emojiList []string
+		runes     = []rune(s)
+	)
+
+	for i := 0; i < len(runes); {
+		j := i + 1
+		for j < len(runes) {
+			if isEmojiModifier(runes[j]) {
+				j++
+			} else if runes[j] == 0x200D {
+				j += 2
+			} else if j == i+1 && isRegionalIndicator(runes[i]) && isRegionalIndicator(runes[j]) {
+				j++
+			} else {
+				break
+			}
+		}
+		if j > len(runes) {
+			j = len(runes)
+		}
+		emojiList = append(emojiList, string(runes[i:j]))
+		i = j
+	}
+
+	return emojiList
+}
+
+// MakeStickerPacks groups the stickers by emoji, in the order the emoji first appear.
+func MakeStickerPacks(documents []*StickerSetDocument) []*mtproto.StickerPack {
+	var (
+		packs   = make([]*mtproto.StickerPack, 0)
+		packMap = make(map[string]*mtproto.StickerPack)
+	)
+
+	for _, doc := range documents {
+		for _, emoji := range SplitEmoji(doc.GetEmoji()) {
+			pack, ok := packMap[emoji]
+			if !ok {
+				pack = mtproto.MakeTLStickerPack(&mtproto.StickerPack{
+					Emoticon:  emoji,
+					Documents: []int64{},
+				}).To_StickerPack()
+				packMap[emoji] = pack
+				packs = append(packs, pack)
+			}
+			pack.Documents = append(pack.Documents, doc.GetDocumentId())
+		}
+	}
+
+	return packs
+}
+
+// HasEmoji the sticker is associated with emoticon
+func (m *StickerSetDocument) HasEmoji(emoticon string) bool {
+	for _, emoji := range SplitEmoji(m.GetEmoji()) {
+		if emoji == emoticon {
+			return true
+		}
+	}
+	return false
+}
+
+func GetDocumentIdList(documents []*StickerSetDocument) []int64 {
+	idList := make([]int64, 0, len(documents))
+	for _, doc := range documents {
+		idList = append(idList, doc.GetDocumentId())
+	}
+	return idList
+}
+
+// MakeStickersHash the hash of messages.getStickers, messages.getRecentStickers and messages.getFavedStickers
+func MakeStickersHash(idList []int64) int64 {
+	var (
+		acc int64
+	)
+	for _, id := range idList {
+		acc = hashx.CombineInt64Hash2(acc, id)
+	}
+	return acc
+}
+
+// MakeStickerSetHash the hash of a sticker set, it changes with the stickers in the set
+func MakeStickerSetHash(idList []int64) int32 {
+	return hashx.HashInt64(MakeStickersHash(idList))
+}
+
+// MakeAllStickersHash the hash of messages.getAllStickers, made of the hashes of the installed sets
+func MakeAllStickersHash(sets []*mtproto.StickerSet) int64 {
+	var (
+		acc int64
+	)
+	for _, set := range sets {
+		acc = hashx.CombineInt64Hash2(acc, int64(uint32(set.GetHash())))
+	}
+	return acc
+}
@@ -0,0 +1,98 @@
+// Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+//  All rights reserved.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package sticker
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestSplitEmoji(t *testing.T) {
+	cases := []struct {
+		s    string
+		want []string
+	}{
+		{"😀", []string{"😀"}},
+		{"😀😃", []string{"😀", "😃"}},
+		{"❤️🔥", []string{"❤️", "🔥"}},
+		{"👍🏽👎", []string{"👍🏽", "👎"}},
+		{"👨‍👩‍👧🇺🇦🇩🇪", []string{"👨‍👩‍👧", "🇺🇦", "🇩🇪"}},
+		{"1️⃣", []string{"1️⃣"}},
+		{"", nil},
+	}
+
+	for _, c := range cases {
+		if got := SplitEmoji(c.s); !reflect.DeepEqual(got, c.want) {
+			t.Errorf("SplitEmoji(%q) = %q, want %q", c.s, got, c.want)
+		}
+	}
+}
+
+func TestMakeStickerPacks(t *testing.T) {
+	documents := []*StickerSetDocument{
+		{DocumentId: 1, Emoji: "😀😃"},
+		{DocumentId: 2, Emoji: "🔥"},
+		{DocumentId: 3, Emoji: "😃"},
+	}
+
+	packs := MakeStickerPacks(documents)
+	if len(packs) != 3 {
+		t.Fatalf("bad packs: %v", packs)
+	}
+	if packs[0].Emoticon != "😀" || !reflect.DeepEqual(packs[0].Documents, []int64{1}) {
+		t.Errorf("bad packs[0]: %v", packs[0])
+	}
+	if packs[1].Emoticon != "😃" || !reflect.DeepEqual(packs[1].Documents, []int64{1, 3}) {
+		t.Errorf("bad packs[1]: %v", packs[1])
+	}
+	if !documents[0].HasEmoji("😃") || documents[1].HasEmoji("😃") {
+		t.Errorf("bad HasEmoji")
+	}
+
+	if MakeStickerSetHash([]int64{1, 2, 3}) == MakeStickerSetHash([]int64{1, 3}) {
+		t.Errorf("hash not changed")
+	}
+}
+
+func TestCheckShortName(t *testing.T) {
+	for _, s := range []string{"cats", "Cats_2022", "a1_b2"} {
+		if !CheckShortName(s) {
+			t.Errorf("CheckShortName(%q) = false", s)
+		}
+	}
+	for _, s := range []string{"", "1cats", "_cats", "cats_", "ca__ts", "ca-ts", "котики"} {
+		if CheckShortName(s) {
+			t.Errorf("CheckShortName(%q) = true", s)
+		}
+	}
+}
+
+func TestMakeShortName(t *testing.T) {
+	cases := []struct {
+		title string
+		want  string
+	}{
+		{"Cute Cats", "cute_cats"},
+		{"  2022 -- Cats & Dogs!! ", "cats_dogs"},
+		{"Котики", ""},
+		{"Котики cats 2", "cats_2"},
+	}
+
+	for _, c := range cases {
+		got := MakeShortName(c.title)
+		if got != c.want {
+			t.Errorf("MakeShortName(%q) = %q, want %q", c.title, got, c.want)
+		}
+		if got != "" && !CheckShortName(got) {
+			t.Errorf("MakeShortName(%q) = %q, invalid", c.title, got)
+		}
+	}
+	if got := MakeShortName(strings.Repeat("a", 100)); len(got) != StickerSetShortNameMaxLen-8 {
+		t.Errorf("bad length: %d", len(got))
+	}
+}
@@ -25,6 +25,60 @@ type SmsVerifyCodeConfig struct {
 	Key           string
 	Secret        string
 	RegionId      string
+	// Text of the message, %s is replaced by the code
+	Text string `json:",optional"`
+	// SentCodeType tells the client how the code is delivered, call for voice gateways
+	SentCodeType string `json:",default=sms,options=sms|call"`
+	// Timeout seconds the client waits before auth.resendCode
+	Timeout   int32           `json:",default=60"`
+	Retries   int             `json:",default=2"`
+	RateLimit RateLimitConfig `json:",optional"`
+	Http      *HttpConfig     `json:",optional"`
+	Smpp      *SmppConfig     `json:",optional"`
+	File      *FileConfig     `json:",optional"`
+}
+
+// RateLimitConfig limits the codes sent to one phone number, zero disables a limit.
+type RateLimitConfig struct {
+	Interval int `json:",default=60"`   // min seconds between two codes
+	Period   int `json:",default=3600"` // seconds
+	Quota    int `json:",default=5"`    // codes per Period
+}
+
+// HttpConfig is a generic HTTP/JSON gateway.
+//
+// Body is a text/template executed with .Phone, .Code, .Text, .Key, .Secret and .RegionId,
+// the response is accepted if the status is 2xx and, if set, SuccessKey of the json
+// response equals SuccessValue. MessageIdKey is the key of the message id in the response.
+type HttpConfig struct {
+	Url          string
+	Method       string            `json:",default=POST,options=GET|POST"`
+	Headers      map[string]string `json:",optional"`
+	Body         string            `json:",optional"`
+	ContentType  string            `json:",default=application/json"`
+	SuccessKey   string            `json:",optional"`
+	SuccessValue string            `json:",optional"`
+	MessageIdKey string            `json:",optional"`
+	Timeout      int               `json:",default=5"` // seconds
+}
+
+// SmppConfig is a SMPP 3.4 transmitter.
+type SmppConfig struct {
+	Addr       string
+	SystemId   string
+	Password   string
+	SystemType string `json:",optional"`
+	SourceAddr string `json:",optional"`
+	SourceTon  int    `json:",default=5"` // alphanumeric
+	SourceNpi  int    `json:",default=0"`
+	DestTon    int    `json:",default=1"` // international
+	DestNpi    int    `json:",default=1"` // isdn
+	Timeout    int    `json:",default=5"` // seconds
+}
+
+// FileConfig appends every code to Path, or logs it if Path is empty. For test environments only.
+type FileConfig struct {
+	Path string `json:",optional"`
 }
 
 type WebrtcConfig struct {
@@ -0,0 +1,87 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package file
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/teamgram/teamgram-server/pkg/code/conf"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// New creates a sender writing the codes to a local file, it's for test environments.
+func New(c *conf.SmsVerifyCodeConfig) *fileSender {
+	m := &fileSender{}
+	if c.File != nil {
+		m.path = c.File.Path
+	}
+	return m
+}
+
+type fileSender struct {
+	mu   sync.Mutex
+	path string
+	seq  int64
+}
+
+type record struct {
+	Date  int64  `json:"date"`
+	Phone string `json:"phone"`
+	Code  string `json:"code"`
+	Text  string `json:"text"`
+}
+
+func (m *fileSender) Send(ctx context.Context, phoneNumber, code, text string) (string, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.seq++
+	if m.path == "" {
+		logx.WithContext(ctx).Infof("verify code for phone(%s): %s", phoneNumber, code)
+		return strconv.FormatInt(m.seq, 10), nil
+	}
+
+	b, err := json.Marshal(&record{
+		Date:  time.Now().Unix(),
+		Phone: phoneNumber,
+		Code:  code,
+		Text:  text,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	f, err := os.OpenFile(m.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	if _, err = f.Write(append(b, '\n')); err != nil {
+		return "", err
+	}
+
+	return strconv.FormatInt(m.seq, 10), nil
+}
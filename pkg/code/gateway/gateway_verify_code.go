@@ -0,0 +1,162 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package gateway
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/teamgram/teamgram-server/pkg/code/conf"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+const (
+	maxResponseSize = 64 * 1024
+)
+
+type templateData struct {
+	Phone    string
+	Code     string
+	Text     string
+	Key      string
+	Secret   string
+	RegionId string
+}
+
+// New creates a sender for a generic HTTP/JSON sms gateway.
+func New(c *conf.SmsVerifyCodeConfig) *gatewaySender {
+	if c.Http == nil || c.Http.Url == "" {
+		logx.Must(errors.New("code: http provider requires Code.Http.Url"))
+	}
+
+	funcs := template.FuncMap{
+		"json": func(s string) (string, error) {
+			b, err := json.Marshal(s)
+			return string(b), err
+		},
+		"query": url.QueryEscape,
+	}
+
+	m := &gatewaySender{
+		c:    c,
+		http: c.Http,
+		cli: &http.Client{
+			Timeout: time.Duration(c.Http.Timeout) * time.Second,
+		},
+	}
+
+	var err error
+	m.url, err = template.New("url").Funcs(funcs).Parse(c.Http.Url)
+	logx.Must(err)
+	if c.Http.Body != "" {
+		m.body, err = template.New("body").Funcs(funcs).Parse(c.Http.Body)
+		logx.Must(err)
+	}
+
+	return m
+}
+
+type gatewaySender struct {
+	c    *conf.SmsVerifyCodeConfig
+	http *conf.HttpConfig
+	cli  *http.Client
+	url  *template.Template
+	body *template.Template
+}
+
+func (m *gatewaySender) Send(ctx context.Context, phoneNumber, code, text string) (string, error) {
+	var (
+		data = &templateData{
+			Phone:    phoneNumber,
+			Code:     code,
+			Text:     text,
+			Key:      m.c.Key,
+			Secret:   m.c.Secret,
+			RegionId: m.c.RegionId,
+		}
+		urlV strings.Builder
+		body bytes.Buffer
+	)
+
+	if err := m.url.Execute(&urlV, data); err != nil {
+		return "", err
+	}
+	if m.body != nil {
+		if err := m.body.Execute(&body, data); err != nil {
+			return "", err
+		}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, m.http.Method, urlV.String(), &body)
+	if err != nil {
+		return "", err
+	}
+	if m.body != nil {
+		req.Header.Set("Content-Type", m.http.ContentType)
+	}
+	for k, v := range m.http.Headers {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := m.cli.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	rBody, err := ioutil.ReadAll(io.LimitReader(resp.Body, maxResponseSize))
+	if err != nil {
+		return "", err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return "", fmt.Errorf("gateway status %d: %s", resp.StatusCode, rBody)
+	}
+
+	if m.http.SuccessKey == "" && m.http.MessageIdKey == "" {
+		return "", nil
+	}
+
+	var (
+		result map[string]interface{}
+		dec    = json.NewDecoder(bytes.NewReader(rBody))
+	)
+	dec.UseNumber()
+	if err = dec.Decode(&result); err != nil {
+		return "", fmt.Errorf("gateway invalid response: %s", rBody)
+	}
+	if m.http.SuccessKey != "" && fmt.Sprint(result[m.http.SuccessKey]) != m.http.SuccessValue {
+		return "", fmt.Errorf("gateway rejected: %s", rBody)
+	}
+	if v, ok := result[m.http.MessageIdKey]; ok && v != nil {
+		return fmt.Sprint(v), nil
+	}
+
+	return "", nil
+}
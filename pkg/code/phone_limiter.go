@@ -0,0 +1,111 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package code
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/teamgram/teamgram-server/pkg/code/conf"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+const (
+	phoneLimitIntervalPrefix = "phone_code_interval"
+	phoneLimitQuotaPrefix    = "phone_code_quota"
+)
+
+// LimitStore keeps the counters of the codes sent to a phone number, so the limits are
+// shared by all the processes. kv.Store and redis.Redis implement it.
+type LimitStore interface {
+	SetnxEx(key, value string, seconds int) (bool, error)
+	Incr(key string) (int64, error)
+	Expire(key string, seconds int) error
+	Ttl(key string) (int, error)
+}
+
+// phoneLimiter limits the codes sent to one phone number.
+type phoneLimiter struct {
+	c     conf.RateLimitConfig
+	store LimitStore
+}
+
+func newPhoneLimiter(c conf.RateLimitConfig, store LimitStore) *phoneLimiter {
+	return &phoneLimiter{
+		c:     c,
+		store: store,
+	}
+}
+
+// Allow records a code sent now and returns 0, or returns the seconds to wait.
+// Errors of the store are logged and let the code through.
+func (l *phoneLimiter) Allow(ctx context.Context, phoneNumber string) int32 {
+	if l.store == nil {
+		return 0
+	}
+
+	if l.c.Interval > 0 {
+		key := fmt.Sprintf("%s#%s", phoneLimitIntervalPrefix, phoneNumber)
+		ok, err := l.store.SetnxEx(key, "1", l.c.Interval)
+		if err != nil {
+			logx.WithContext(ctx).Errorf("phoneLimiter - SETNX(%s) error: %v", key, err)
+			return 0
+		}
+		if !ok {
+			return l.wait(ctx, key, l.c.Interval)
+		}
+	}
+
+	if l.c.Period > 0 && l.c.Quota > 0 {
+		key := fmt.Sprintf("%s#%s", phoneLimitQuotaPrefix, phoneNumber)
+		n, err := l.store.Incr(key)
+		if err != nil {
+			logx.WithContext(ctx).Errorf("phoneLimiter - INCR(%s) error: %v", key, err)
+			return 0
+		}
+		if n == 1 {
+			if err = l.store.Expire(key, l.c.Period); err != nil {
+				logx.WithContext(ctx).Errorf("phoneLimiter - EXPIRE(%s) error: %v", key, err)
+			}
+		}
+		if n > int64(l.c.Quota) {
+			return l.wait(ctx, key, l.c.Period)
+		}
+	}
+
+	return 0
+}
+
+func (l *phoneLimiter) wait(ctx context.Context, key string, seconds int) int32 {
+	ttl, err := l.store.Ttl(key)
+	if err != nil {
+		logx.WithContext(ctx).Errorf("phoneLimiter - TTL(%s) error: %v", key, err)
+		return int32(seconds)
+	}
+	if ttl < 0 {
+		// lost the expire of the first INCR, don't lock the phone out forever
+		l.store.Expire(key, seconds)
+		ttl = seconds
+	}
+	if ttl == 0 {
+		ttl = 1
+	}
+	return int32(ttl)
+}
@@ -22,7 +22,10 @@ import (
 	"context"
 
 	"github.com/teamgram/teamgram-server/pkg/code/conf"
+	"github.com/teamgram/teamgram-server/pkg/code/file"
+	"github.com/teamgram/teamgram-server/pkg/code/gateway"
 	"github.com/teamgram/teamgram-server/pkg/code/none"
+	"github.com/teamgram/teamgram-server/pkg/code/smpp"
 )
 
 type VerifyCodeInterface interface {
@@ -30,7 +33,20 @@ type VerifyCodeInterface interface {
 	VerifySmsCode(ctx context.Context, codeHash, code, extraData string) error
 }
 
-func NewVerifyCode(c *conf.SmsVerifyCodeConfig) VerifyCodeInterface {
+// SentCodeSender is implemented by the providers which report how a code was delivered.
+type SentCodeSender interface {
+	SendVerifyCode(ctx context.Context, phoneNumber, code, codeHash string) (*SentCode, error)
+}
+
+// Sender delivers a code to a phone number and returns the message id of the provider,
+// text is the code formatted by SmsVerifyCodeConfig.Text.
+type Sender interface {
+	Send(ctx context.Context, phoneNumber, code, text string) (string, error)
+}
+
+// NewVerifyCode creates the configured provider, the codes sent to one phone number
+// are counted in store, a nil store disables the rate limit.
+func NewVerifyCode(c *conf.SmsVerifyCodeConfig, store LimitStore) VerifyCodeInterface {
 	if c == nil {
 		c = new(conf.SmsVerifyCodeConfig)
 	}
@@ -40,6 +56,12 @@ func NewVerifyCode(c *conf.SmsVerifyCodeConfig) VerifyCodeInterface {
 	// 	return predefined.New(c)
 	case "none":
 		return none.New(c)
+	case "http":
+		return newSmsVerifyCode(c, gateway.New(c), store)
+	case "smpp":
+		return newSmsVerifyCode(c, smpp.New(c), store)
+	case "file":
+		return newSmsVerifyCode(c, file.New(c), store)
 	}
 	return none.New(c)
 }
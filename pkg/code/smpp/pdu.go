@@ -0,0 +1,139 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package smpp
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"unicode/utf16"
+)
+
+// SMPP 3.4 command ids, only what a transmitter needs.
+const (
+	genericNack         uint32 = 0x80000000
+	bindTransmitter     uint32 = 0x00000002
+	bindTransmitterResp uint32 = 0x80000002
+	submitSm            uint32 = 0x00000004
+	submitSmResp        uint32 = 0x80000004
+	unbind              uint32 = 0x00000006
+	unbindResp          uint32 = 0x80000006
+	enquireLink         uint32 = 0x00000015
+	enquireLinkResp     uint32 = 0x80000015
+)
+
+const (
+	headerLen        = 16
+	maxPduLen        = 64 * 1024
+	interfaceVersion = 0x34
+	maxShortMessage  = 254
+
+	dataCodingDefault = 0x00
+	dataCodingUCS2    = 0x08
+
+	tagMessagePayload = 0x0424
+)
+
+type pdu struct {
+	Id     uint32
+	Status uint32
+	Seq    uint32
+	Body   []byte
+}
+
+func writePdu(w io.Writer, p *pdu) error {
+	b := make([]byte, headerLen+len(p.Body))
+	binary.BigEndian.PutUint32(b[0:], uint32(len(b)))
+	binary.BigEndian.PutUint32(b[4:], p.Id)
+	binary.BigEndian.PutUint32(b[8:], p.Status)
+	binary.BigEndian.PutUint32(b[12:], p.Seq)
+	copy(b[headerLen:], p.Body)
+
+	_, err := w.Write(b)
+	return err
+}
+
+func readPdu(r io.Reader) (*pdu, error) {
+	var header [headerLen]byte
+	if _, err := io.ReadFull(r, header[:]); err != nil {
+		return nil, err
+	}
+
+	l := binary.BigEndian.Uint32(header[0:])
+	if l < headerLen || l > maxPduLen {
+		return nil, fmt.Errorf("smpp: invalid pdu length %d", l)
+	}
+
+	p := &pdu{
+		Id:     binary.BigEndian.Uint32(header[4:]),
+		Status: binary.BigEndian.Uint32(header[8:]),
+		Seq:    binary.BigEndian.Uint32(header[12:]),
+		Body:   make([]byte, l-headerLen),
+	}
+	if _, err := io.ReadFull(r, p.Body); err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
+
+type bodyWriter struct {
+	bytes.Buffer
+}
+
+func (w *bodyWriter) CString(s string) {
+	w.WriteString(s)
+	w.WriteByte(0)
+}
+
+func (w *bodyWriter) Uint8(v int) {
+	w.WriteByte(byte(v))
+}
+
+func (w *bodyWriter) TLV(tag uint16, v []byte) {
+	var b [4]byte
+	binary.BigEndian.PutUint16(b[0:], tag)
+	binary.BigEndian.PutUint16(b[2:], uint16(len(v)))
+	w.Write(b[:])
+	w.Write(v)
+}
+
+// readCString returns the first null terminated string of b.
+func readCString(b []byte) string {
+	if i := bytes.IndexByte(b, 0); i >= 0 {
+		return string(b[:i])
+	}
+	return string(b)
+}
+
+// encodeText uses the SMSC default alphabet for ASCII and UCS2 for everything else.
+func encodeText(text string) (int, []byte) {
+	for i := 0; i < len(text); i++ {
+		if text[i] >= 0x80 {
+			u := utf16.Encode([]rune(text))
+			b := make([]byte, len(u)*2)
+			for j, c := range u {
+				binary.BigEndian.PutUint16(b[j*2:], c)
+			}
+			return dataCodingUCS2, b
+		}
+	}
+	return dataCodingDefault, []byte(text)
+}
@@ -0,0 +1,173 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package smpp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/teamgram/teamgram-server/pkg/code/conf"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// New creates a SMPP transmitter.
+//
+// Codes are rare, so every Send binds a new session and unbinds it after submit_sm,
+// there's no session to keep alive between codes.
+func New(c *conf.SmsVerifyCodeConfig) *smppSender {
+	if c.Smpp == nil || c.Smpp.Addr == "" {
+		logx.Must(errors.New("code: smpp provider requires Code.Smpp.Addr"))
+	}
+
+	return &smppSender{
+		c: c.Smpp,
+	}
+}
+
+type smppSender struct {
+	c *conf.SmppConfig
+}
+
+type session struct {
+	conn net.Conn
+	seq  uint32
+}
+
+func (m *smppSender) Send(ctx context.Context, phoneNumber, code, text string) (string, error) {
+	var (
+		timeout = time.Duration(m.c.Timeout) * time.Second
+		dialer  = &net.Dialer{Timeout: timeout}
+	)
+
+	conn, err := dialer.DialContext(ctx, "tcp", m.c.Addr)
+	if err != nil {
+		return "", err
+	}
+	defer conn.Close()
+
+	deadline := time.Now().Add(timeout)
+	if d, ok := ctx.Deadline(); ok && d.Before(deadline) {
+		deadline = d
+	}
+	conn.SetDeadline(deadline)
+
+	s := &session{conn: conn}
+	if err = s.bind(m.c); err != nil {
+		return "", err
+	}
+
+	messageId, err := s.submit(m.c, phoneNumber, text)
+	s.unbind()
+
+	return messageId, err
+}
+
+func (s *session) call(id uint32, body []byte) (*pdu, error) {
+	s.seq++
+	if err := writePdu(s.conn, &pdu{Id: id, Seq: s.seq, Body: body}); err != nil {
+		return nil, err
+	}
+
+	for {
+		p, err := readPdu(s.conn)
+		if err != nil {
+			return nil, err
+		}
+
+		switch {
+		case p.Id == enquireLink:
+			if err = writePdu(s.conn, &pdu{Id: enquireLinkResp, Seq: p.Seq}); err != nil {
+				return nil, err
+			}
+		case p.Id == genericNack && p.Seq == s.seq:
+			return nil, fmt.Errorf("smpp: generic_nack, status 0x%08x", p.Status)
+		case p.Id == id|genericNack && p.Seq == s.seq:
+			if p.Status != 0 {
+				return nil, fmt.Errorf("smpp: command 0x%08x failed, status 0x%08x", id, p.Status)
+			}
+			return p, nil
+		default:
+			// unexpected pdu, e.g. deliver_sm to a transmitter
+			logx.Errorf("smpp: skip pdu {id: 0x%08x, seq: %d}", p.Id, p.Seq)
+		}
+	}
+}
+
+func (s *session) bind(c *conf.SmppConfig) error {
+	var w bodyWriter
+	w.CString(c.SystemId)
+	w.CString(c.Password)
+	w.CString(c.SystemType)
+	w.Uint8(interfaceVersion)
+	w.Uint8(0) // addr_ton
+	w.Uint8(0) // addr_npi
+	w.CString("")
+
+	_, err := s.call(bindTransmitter, w.Bytes())
+	return err
+}
+
+func (s *session) submit(c *conf.SmppConfig, phoneNumber, text string) (string, error) {
+	var (
+		w                = bodyWriter{}
+		dataCoding, data = encodeText(text)
+	)
+
+	w.CString("") // service_type
+	w.Uint8(c.SourceTon)
+	w.Uint8(c.SourceNpi)
+	w.CString(c.SourceAddr)
+	w.Uint8(c.DestTon)
+	w.Uint8(c.DestNpi)
+	w.CString(strings.TrimPrefix(phoneNumber, "+"))
+	w.Uint8(0)    // esm_class
+	w.Uint8(0)    // protocol_id
+	w.Uint8(0)    // priority_flag
+	w.CString("") // schedule_delivery_time
+	w.CString("") // validity_period
+	w.Uint8(0)    // registered_delivery
+	w.Uint8(0)    // replace_if_present_flag
+	w.Uint8(dataCoding)
+	w.Uint8(0) // sm_default_msg_id
+	if len(data) <= maxShortMessage {
+		w.Uint8(len(data))
+		w.Write(data)
+	} else {
+		w.Uint8(0)
+		w.TLV(tagMessagePayload, data)
+	}
+
+	p, err := s.call(submitSm, w.Bytes())
+	if err != nil {
+		return "", err
+	}
+
+	return readCString(p.Body), nil
+}
+
+func (s *session) unbind() {
+	if _, err := s.call(unbind, nil); err != nil {
+		logx.Errorf("smpp: unbind error: %v", err)
+	}
+}
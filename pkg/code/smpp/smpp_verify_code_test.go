@@ -0,0 +1,104 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package smpp
+
+import (
+	"bytes"
+	"context"
+	"net"
+	"testing"
+
+	"github.com/teamgram/teamgram-server/pkg/code/conf"
+)
+
+// fakeSmsc accepts one session and records the submitted destination and text.
+func fakeSmsc(t *testing.T, ln net.Listener, submitted chan<- []byte) {
+	conn, err := ln.Accept()
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+
+	for {
+		p, err := readPdu(conn)
+		if err != nil {
+			return
+		}
+
+		resp := &pdu{Id: p.Id | genericNack, Seq: p.Seq}
+		switch p.Id {
+		case bindTransmitter:
+			if !bytes.HasPrefix(p.Body, []byte("user\x00secret\x00")) {
+				resp.Status = 0x0e // ESME_RINVPASWD
+			}
+		case submitSm:
+			submitted <- p.Body
+			resp.Body = []byte("id-42\x00")
+		}
+		if err = writePdu(conn, resp); err != nil {
+			t.Error(err)
+			return
+		}
+		if p.Id == unbind {
+			return
+		}
+	}
+}
+
+func TestSmppSend(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ln.Close()
+
+	submitted := make(chan []byte, 1)
+	go fakeSmsc(t, ln, submitted)
+
+	m := New(&conf.SmsVerifyCodeConfig{
+		Smpp: &conf.SmppConfig{
+			Addr:     ln.Addr().String(),
+			SystemId: "user",
+			Password: "secret",
+			Timeout:  5,
+		},
+	})
+
+	messageId, err := m.Send(context.Background(), "+8613800000000", "12345", "code 12345")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if messageId != "id-42" {
+		t.Fatalf("messageId = %s, want id-42", messageId)
+	}
+
+	body := <-submitted
+	if !bytes.Contains(body, []byte("8613800000000\x00")) || !bytes.HasSuffix(body, []byte("code 12345")) {
+		t.Fatalf("unexpected submit_sm body: %q", body)
+	}
+}
+
+func TestEncodeText(t *testing.T) {
+	if dataCoding, b := encodeText("code 1"); dataCoding != dataCodingDefault || string(b) != "code 1" {
+		t.Fatalf("encodeText ascii = %d, %q", dataCoding, b)
+	}
+	if dataCoding, b := encodeText("код"); dataCoding != dataCodingUCS2 || !bytes.Equal(b, []byte{0x04, 0x3a, 0x04, 0x3e, 0x04, 0x34}) {
+		t.Fatalf("encodeText ucs2 = %d, %x", dataCoding, b)
+	}
+}
@@ -0,0 +1,167 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package code
+
+import (
+	"context"
+	"crypto/subtle"
+	"fmt"
+	"time"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/pkg/code/conf"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+const (
+	SentCodeTypeSms  = "sms"
+	SentCodeTypeCall = "call"
+)
+
+const (
+	DeliveryStatusSent   = "sent"
+	DeliveryStatusFailed = "failed"
+)
+
+const (
+	defaultSmsText    = "Your verification code: %s"
+	defaultTimeout    = 60
+	retryBackoffStart = 500 * time.Millisecond
+)
+
+// SentCode records the delivery of a code.
+type SentCode struct {
+	Provider  string `json:"provider"`
+	Type      string `json:"type"`
+	ExtraData string `json:"-"`
+	MessageId string `json:"message_id,omitempty"`
+	Status    string `json:"status"`
+	Attempts  int    `json:"attempts"`
+	Timeout   int32  `json:"timeout"`
+	Date      int64  `json:"date"`
+	Error     string `json:"error,omitempty"`
+}
+
+// smsVerifyCode adds rate limiting, retries and local verification of the code to a Sender.
+type smsVerifyCode struct {
+	c       *conf.SmsVerifyCodeConfig
+	sender  Sender
+	limiter *phoneLimiter
+	now     func() time.Time
+}
+
+func newSmsVerifyCode(c *conf.SmsVerifyCodeConfig, sender Sender, store LimitStore) *smsVerifyCode {
+	return &smsVerifyCode{
+		c:       c,
+		sender:  sender,
+		limiter: newPhoneLimiter(c.RateLimit, store),
+		now:     time.Now,
+	}
+}
+
+func (m *smsVerifyCode) SendVerifyCode(ctx context.Context, phoneNumber, code, codeHash string) (*SentCode, error) {
+	now := m.now()
+	if wait := m.limiter.Allow(ctx, phoneNumber); wait > 0 {
+		logx.WithContext(ctx).Errorf("sendVerifyCode - phone(%s) rate limited, wait %ds", phoneNumber, wait)
+		return nil, mtproto.NewErrFloodWaitX(wait)
+	}
+
+	var (
+		text     = defaultSmsText
+		sentCode = &SentCode{
+			Provider:  m.c.Name,
+			Type:      m.c.SentCodeType,
+			ExtraData: code,
+			Timeout:   m.c.Timeout,
+			Date:      now.Unix(),
+		}
+		backoff = retryBackoffStart
+		err     error
+	)
+
+	if m.c.Text != "" {
+		text = m.c.Text
+	}
+	if sentCode.Type == "" {
+		sentCode.Type = SentCodeTypeSms
+	}
+	if sentCode.Timeout <= 0 {
+		sentCode.Timeout = defaultTimeout
+	}
+	if interval := int32(m.c.RateLimit.Interval); interval > sentCode.Timeout {
+		sentCode.Timeout = interval
+	}
+
+	for sentCode.Attempts < m.c.Retries+1 {
+		if sentCode.Attempts > 0 {
+			select {
+			case <-ctx.Done():
+				err = ctx.Err()
+			case <-time.After(backoff):
+				backoff *= 2
+			}
+			if ctx.Err() != nil {
+				break
+			}
+		}
+
+		sentCode.Attempts++
+		sentCode.MessageId, err = m.sender.Send(ctx, phoneNumber, code, fmt.Sprintf(text, code))
+		if err == nil {
+			break
+		}
+		logx.WithContext(ctx).Errorf("sendVerifyCode - %s send to phone(%s), attempt %d, error: %v",
+			m.c.Name,
+			phoneNumber,
+			sentCode.Attempts,
+			err)
+	}
+
+	if err != nil {
+		sentCode.Status = DeliveryStatusFailed
+		sentCode.Error = err.Error()
+		return sentCode, mtproto.ErrSmsCodeCreateFailed
+	}
+
+	sentCode.Status = DeliveryStatusSent
+	logx.WithContext(ctx).Infof("sendVerifyCode - %s sent to phone(%s): {message_id: %s, attempts: %d}",
+		m.c.Name,
+		phoneNumber,
+		sentCode.MessageId,
+		sentCode.Attempts)
+
+	return sentCode, nil
+}
+
+func (m *smsVerifyCode) SendSmsVerifyCode(ctx context.Context, phoneNumber, code, codeHash string) (string, error) {
+	sentCode, err := m.SendVerifyCode(ctx, phoneNumber, code, codeHash)
+	if err != nil {
+		return "", err
+	}
+
+	return sentCode.ExtraData, nil
+}
+
+func (m *smsVerifyCode) VerifySmsCode(ctx context.Context, codeHash, code, extraData string) error {
+	if extraData == "" || subtle.ConstantTimeCompare([]byte(code), []byte(extraData)) != 1 {
+		return mtproto.ErrPhoneCodeInvalid
+	}
+	return nil
+}
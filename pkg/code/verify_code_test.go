@@ -0,0 +1,154 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package code
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/pkg/code/conf"
+
+	"github.com/zeromicro/go-zero/core/stores/redis/redistest"
+)
+
+type fakeSender struct {
+	fails int
+	calls int
+	text  string
+}
+
+func (m *fakeSender) Send(ctx context.Context, phoneNumber, code, text string) (string, error) {
+	m.calls++
+	m.text = text
+	if m.calls <= m.fails {
+		return "", errors.New("gateway down")
+	}
+	return "msg-1", nil
+}
+
+func TestPhoneLimiter(t *testing.T) {
+	r, clean, err := redistest.CreateRedis()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer clean()
+
+	var (
+		l   = newPhoneLimiter(conf.RateLimitConfig{Interval: 60, Period: 3600, Quota: 2}, r)
+		ctx = context.Background()
+	)
+
+	if wait := l.Allow(ctx, "1"); wait != 0 {
+		t.Fatalf("first code limited: %d", wait)
+	}
+	if wait := l.Allow(ctx, "1"); wait <= 0 || wait > 60 {
+		t.Fatalf("interval wait = %d, want (0, 60]", wait)
+	}
+	if wait := l.Allow(ctx, "2"); wait != 0 {
+		t.Fatalf("other phone limited: %d", wait)
+	}
+
+	// the interval is over
+	r.Del("phone_code_interval#1")
+	if wait := l.Allow(ctx, "1"); wait != 0 {
+		t.Fatalf("second code limited: %d", wait)
+	}
+	r.Del("phone_code_interval#1")
+	if wait := l.Allow(ctx, "1"); wait <= 60 || wait > 3600 {
+		t.Fatalf("quota wait = %d, want (60, 3600]", wait)
+	}
+
+	// the period is over
+	r.Del("phone_code_interval#1", "phone_code_quota#1")
+	if wait := l.Allow(ctx, "1"); wait != 0 {
+		t.Fatalf("code after period limited: %d", wait)
+	}
+}
+
+func TestPhoneLimiterStoreError(t *testing.T) {
+	r, clean, err := redistest.CreateRedis()
+	if err != nil {
+		t.Fatal(err)
+	}
+	clean()
+
+	l := newPhoneLimiter(conf.RateLimitConfig{Interval: 60, Period: 3600, Quota: 2}, r)
+	if wait := l.Allow(context.Background(), "1"); wait != 0 {
+		t.Fatalf("redis down, code limited: %d", wait)
+	}
+}
+
+func TestSendVerifyCode(t *testing.T) {
+	r, clean, err := redistest.CreateRedis()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer clean()
+
+	var (
+		sender = &fakeSender{fails: 1}
+		m      = newSmsVerifyCode(&conf.SmsVerifyCodeConfig{
+			Name:      "fake",
+			Text:      "code %s",
+			Timeout:   30,
+			Retries:   1,
+			RateLimit: conf.RateLimitConfig{Interval: 120},
+		}, sender, r)
+		ctx = context.Background()
+	)
+
+	sentCode, err := m.SendVerifyCode(ctx, "+100", "12345", "hash")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sentCode.Status != DeliveryStatusSent || sentCode.Attempts != 2 || sentCode.MessageId != "msg-1" {
+		t.Fatalf("unexpected sentCode: %+v", sentCode)
+	}
+	if sentCode.Type != SentCodeTypeSms || sentCode.Timeout != 120 || sender.text != "code 12345" {
+		t.Fatalf("unexpected sentCode: %+v, text: %s", sentCode, sender.text)
+	}
+
+	if _, err = m.SendVerifyCode(ctx, "+100", "12345", "hash"); err == nil {
+		t.Fatal("expected flood wait")
+	}
+
+	if err = m.VerifySmsCode(ctx, "hash", "12345", sentCode.ExtraData); err != nil {
+		t.Fatal(err)
+	}
+	if err = m.VerifySmsCode(ctx, "hash", "54321", sentCode.ExtraData); err != mtproto.ErrPhoneCodeInvalid {
+		t.Fatalf("VerifySmsCode = %v, want %v", err, mtproto.ErrPhoneCodeInvalid)
+	}
+}
+
+func TestSendVerifyCodeFailed(t *testing.T) {
+	var (
+		sender = &fakeSender{fails: 10}
+		m      = newSmsVerifyCode(&conf.SmsVerifyCodeConfig{Name: "fake"}, sender, nil)
+	)
+
+	sentCode, err := m.SendVerifyCode(context.Background(), "+100", "12345", "hash")
+	if err != mtproto.ErrSmsCodeCreateFailed {
+		t.Fatalf("SendVerifyCode = %v, want %v", err, mtproto.ErrSmsCodeCreateFailed)
+	}
+	if sentCode.Status != DeliveryStatusFailed || sentCode.Attempts != 1 || sender.calls != 1 {
+		t.Fatalf("unexpected sentCode: %+v", sentCode)
+	}
+}
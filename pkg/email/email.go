@@ -0,0 +1,111 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+// Package email sends the two-step verification email codes.
+package email
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/smtp"
+	"strings"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// Config is a SMTP server, the codes are only logged if Addr is empty. For test environments only.
+type Config struct {
+	Addr     string `json:",optional"` // host:port
+	Username string `json:",optional"`
+	Password string `json:",optional"`
+	From     string `json:",optional"`
+	Subject  string `json:",optional"` // "Teamgram code" by default
+	// Text of the message, %s is replaced by the code, "Your code is: %s" by default
+	Text string `json:",optional"`
+}
+
+type Sender struct {
+	c Config
+}
+
+func New(c *Config) *Sender {
+	m := &Sender{}
+	if c != nil {
+		m.c = *c
+	}
+	if m.c.Subject == "" {
+		m.c.Subject = "Teamgram code"
+	}
+	if m.c.Text == "" {
+		m.c.Text = "Your code is: %s"
+	}
+	return m
+}
+
+func (m *Sender) SendCode(ctx context.Context, to, code string) error {
+	if m.c.Addr == "" {
+		logx.WithContext(ctx).Infof("email code for %s: %s", to, code)
+		return nil
+	}
+
+	var (
+		auth smtp.Auth
+		msg  strings.Builder
+	)
+	if m.c.Username != "" {
+		host, _, _ := net.SplitHostPort(m.c.Addr)
+		auth = smtp.PlainAuth("", m.c.Username, m.c.Password, host)
+	}
+
+	fmt.Fprintf(&msg, "From: %s\r\n", m.c.From)
+	fmt.Fprintf(&msg, "To: %s\r\n", to)
+	fmt.Fprintf(&msg, "Subject: %s\r\n", m.c.Subject)
+	msg.WriteString("Content-Type: text/plain; charset=UTF-8\r\n\r\n")
+	fmt.Fprintf(&msg, m.c.Text, code)
+	msg.WriteString("\r\n")
+
+	return smtp.SendMail(m.c.Addr, auth, m.c.From, []string{to}, []byte(msg.String()))
+}
+
+// MaskAddress hides the local part and the domain name, as shown in email_pattern.
+func MaskAddress(addr string) string {
+	i := strings.LastIndex(addr, "@")
+	if i <= 0 {
+		return ""
+	}
+
+	var (
+		name   = addr[:i]
+		domain = addr[i+1:]
+		tld    = ""
+	)
+	if j := strings.LastIndex(domain, "."); j > 0 {
+		domain, tld = domain[:j], domain[j:]
+	}
+
+	return mask(name) + "@" + mask(domain) + tld
+}
+
+func mask(s string) string {
+	r := []rune(s)
+	if len(r) <= 1 {
+		return "*"
+	}
+	return string(r[0]) + strings.Repeat("*", len(r)-1)
+}
@@ -0,0 +1,192 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package filereference
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/pkg/filereference/conf"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// the contexts a file is served in, see https://core.telegram.org/api/file_reference
+const (
+	ContextMessage      = 1 // peer_type, peer_id and the id of the message
+	ContextProfilePhoto = 2 // peer_type and peer_id of the user, chat or channel
+	ContextStickerSet   = 3 // id is the set
+	ContextInlineResult = 4 // peer_id is the bot
+	ContextUpload       = 5 // messages.uploadMedia, the client uploads it again
+)
+
+const (
+	version = 1
+
+	// version(1) type(1) peer_type(1) peer_id(8) id(8) date(4)
+	headerSize = 23
+	macSize    = 16
+	refSize    = headerSize + macSize
+
+	// maxClockSkew a reference from the future is accepted up to it, the bff nodes share no clock
+	maxClockSkew = 60
+)
+
+// Context is where the client got the file from, it refetches the context for a new
+// reference when a download fails with FILE_REFERENCE_EXPIRED.
+type Context struct {
+	Type     int8
+	PeerType int32
+	PeerId   int64
+	Id       int64
+}
+
+func MessageContext(peerType int32, peerId int64, msgId int32) Context {
+	return Context{Type: ContextMessage, PeerType: peerType, PeerId: peerId, Id: int64(msgId)}
+}
+
+func ProfilePhotoContext(userId int64) Context {
+	return PeerPhotoContext(mtproto.PEER_USER, userId)
+}
+
+func PeerPhotoContext(peerType int32, peerId int64) Context {
+	return Context{Type: ContextProfilePhoto, PeerType: peerType, PeerId: peerId}
+}
+
+func StickerSetContext(setId int64) Context {
+	return Context{Type: ContextStickerSet, Id: setId}
+}
+
+func InlineResultContext(botId int64) Context {
+	return Context{Type: ContextInlineResult, PeerType: mtproto.PEER_USER, PeerId: botId}
+}
+
+func UploadContext() Context {
+	return Context{Type: ContextUpload}
+}
+
+// FileReference makes and checks the file_reference of the photos and the documents,
+// a reference is signed for the user it is served to and expires.
+type FileReference struct {
+	secret []byte
+	expire int64
+}
+
+const (
+	// minSecretLength the secret is at least as long as the hmac key of sha256 should be
+	minSecretLength = 32
+
+	// placeholderSecret the sample secret once shipped in the configs
+	placeholderSecret = "change-me-to-a-random-string"
+)
+
+func checkSecret(secret string) error {
+	switch {
+	case secret == "":
+		return errors.New("filereference: FileReference.Secret is required")
+	case secret == placeholderSecret:
+		return errors.New("filereference: FileReference.Secret is the sample value, set a random string")
+	case len(secret) < minSecretLength:
+		return fmt.Errorf("filereference: FileReference.Secret must be at least %d bytes", minSecretLength)
+	}
+
+	return nil
+}
+
+func New(c *conf.FileReferenceConfig) *FileReference {
+	if c == nil {
+		logx.Must(errors.New("filereference: FileReference is required"))
+	}
+	logx.Must(checkSecret(c.Secret))
+
+	expire := c.Expire
+	if expire <= 0 {
+		expire = 86400
+	}
+
+	return &FileReference{
+		secret: []byte(c.Secret),
+		expire: expire,
+	}
+}
+
+func (r *FileReference) sign(header []byte, userId, id, accessHash int64) []byte {
+	var b [24]byte
+	binary.BigEndian.PutUint64(b[0:], uint64(userId))
+	binary.BigEndian.PutUint64(b[8:], uint64(id))
+	binary.BigEndian.PutUint64(b[16:], uint64(accessHash))
+
+	h := hmac.New(sha256.New, r.secret)
+	h.Write(header)
+	h.Write(b[:])
+	return h.Sum(nil)[:macSize]
+}
+
+func (r *FileReference) makeAt(userId, id, accessHash int64, ctx Context, date int64) []byte {
+	ref := make([]byte, headerSize, refSize)
+	ref[0] = version
+	ref[1] = byte(ctx.Type)
+	ref[2] = byte(ctx.PeerType)
+	binary.BigEndian.PutUint64(ref[3:], uint64(ctx.PeerId))
+	binary.BigEndian.PutUint64(ref[11:], uint64(ctx.Id))
+	binary.BigEndian.PutUint32(ref[19:], uint32(date))
+
+	return append(ref, r.sign(ref, userId, id, accessHash)...)
+}
+
+// Make returns the file_reference of the file id served to userId in ctx.
+func (r *FileReference) Make(userId, id, accessHash int64, ctx Context) []byte {
+	return r.makeAt(userId, id, accessHash, ctx, time.Now().Unix())
+}
+
+func (r *FileReference) checkAt(userId, id, accessHash int64, ref []byte, now int64) (Context, error) {
+	if len(ref) == 0 {
+		return Context{}, mtproto.ErrFileReferenceEmpty
+	}
+
+	// a forged reference or one signed with a previous secret is refreshed as well
+	if len(ref) != refSize ||
+		ref[0] != version ||
+		!hmac.Equal(ref[headerSize:], r.sign(ref[:headerSize], userId, id, accessHash)) {
+		return Context{}, mtproto.ErrFileReferenceExpired
+	}
+
+	date := int64(binary.BigEndian.Uint32(ref[19:]))
+	if now-date >= r.expire || date-now > maxClockSkew {
+		return Context{}, mtproto.ErrFileReferenceExpired
+	}
+
+	return Context{
+		Type:     int8(ref[1]),
+		PeerType: int32(ref[2]),
+		PeerId:   int64(binary.BigEndian.Uint64(ref[3:])),
+		Id:       int64(binary.BigEndian.Uint64(ref[11:])),
+	}, nil
+}
+
+// Check returns the context of ref if it was made for the file id and userId and is not
+// expired, else FILE_REFERENCE_EMPTY or FILE_REFERENCE_EXPIRED.
+func (r *FileReference) Check(userId, id, accessHash int64, ref []byte) (Context, error) {
+	return r.checkAt(userId, id, accessHash, ref, time.Now().Unix())
+}
@@ -0,0 +1,214 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package filereference
+
+import (
+	"testing"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/pkg/filereference/conf"
+)
+
+const (
+	testSecret  = "0123456789abcdef0123456789abcdef"
+	testSecret2 = "fedcba9876543210fedcba9876543210"
+)
+
+func TestCheckSecret(t *testing.T) {
+	for _, c := range []struct {
+		secret string
+		ok     bool
+	}{
+		{"", false},
+		{placeholderSecret, false},
+		{"too-short", false},
+		{testSecret[:minSecretLength-1], false},
+		{testSecret, true},
+	} {
+		if err := checkSecret(c.secret); (err == nil) != c.ok {
+			t.Errorf("checkSecret(%q) = %v, want ok %v", c.secret, err, c.ok)
+		}
+	}
+}
+
+func TestFileReference(t *testing.T) {
+	var (
+		r   = New(&conf.FileReferenceConfig{Secret: testSecret, Expire: 3600})
+		ctx = MessageContext(mtproto.PEER_CHANNEL, 1001, 42)
+		now = int64(1660000000)
+	)
+
+	ref := r.makeAt(1, 100, 200, ctx, now)
+	if c, err := r.checkAt(1, 100, 200, ref, now+10); err != nil || c != ctx {
+		t.Fatalf("checkAt: %+v, %v", c, err)
+	}
+
+	for _, c := range []struct {
+		name                   string
+		userId, id, accessHash int64
+		ref                    []byte
+		now                    int64
+		want                   error
+	}{
+		{"empty", 1, 100, 200, nil, now, mtproto.ErrFileReferenceEmpty},
+		{"another user", 2, 100, 200, ref, now, mtproto.ErrFileReferenceExpired},
+		{"another file", 1, 101, 200, ref, now, mtproto.ErrFileReferenceExpired},
+		{"guessed access_hash", 1, 100, 201, ref, now, mtproto.ErrFileReferenceExpired},
+		{"truncated", 1, 100, 200, ref[:len(ref)-1], now, mtproto.ErrFileReferenceExpired},
+		{"expired", 1, 100, 200, ref, now + 3600, mtproto.ErrFileReferenceExpired},
+		{"from the future", 1, 100, 200, ref, now - maxClockSkew - 1, mtproto.ErrFileReferenceExpired},
+	} {
+		if _, err := r.checkAt(c.userId, c.id, c.accessHash, c.ref, c.now); err != c.want {
+			t.Errorf("%s: %v, want %v", c.name, err, c.want)
+		}
+	}
+
+	// the context can't be changed
+	forged := append([]byte{}, ref...)
+	forged[11]++
+	if _, err := r.checkAt(1, 100, 200, forged, now); err != mtproto.ErrFileReferenceExpired {
+		t.Errorf("forged context: %v", err)
+	}
+
+	// another secret
+	r2 := New(&conf.FileReferenceConfig{Secret: testSecret2})
+	if _, err := r2.checkAt(1, 100, 200, ref, now); err != mtproto.ErrFileReferenceExpired {
+		t.Errorf("another secret: %v", err)
+	}
+}
+
+func TestSetMessages(t *testing.T) {
+	var (
+		r         = New(&conf.FileReferenceConfig{Secret: testSecret})
+		photo     = mtproto.MakeTLPhoto(&mtproto.Photo{Id: 100, AccessHash: 200}).To_Photo()
+		doc       = mtproto.MakeTLDocument(&mtproto.Document{Id: 101, AccessHash: 201}).To_Document()
+		chatPhoto = mtproto.MakeTLPhoto(&mtproto.Photo{Id: 102, AccessHash: 202}).To_Photo()
+	)
+
+	messages := []*mtproto.Message{
+		mtproto.MakeTLMessage(&mtproto.Message{
+			Id:     7,
+			PeerId: mtproto.MakePeerUser(2),
+			Media:  mtproto.MakeTLMessageMediaPhoto(&mtproto.MessageMedia{Photo_FLAGPHOTO: photo}).To_MessageMedia(),
+		}).To_Message(),
+		mtproto.MakeTLMessage(&mtproto.Message{
+			Id:     8,
+			PeerId: mtproto.MakePeerChat(3),
+			Media:  mtproto.MakeTLMessageMediaDocument(&mtproto.MessageMedia{Document: doc}).To_MessageMedia(),
+		}).To_Message(),
+		mtproto.MakeTLMessage(&mtproto.Message{
+			Id:     9,
+			PeerId: mtproto.MakePeerChat(3),
+		}).To_Message(),
+		mtproto.MakeTLMessageService(&mtproto.Message{
+			Id:     10,
+			PeerId: mtproto.MakePeerChat(3),
+			Action: mtproto.MakeMessageActionChatEditPhoto(chatPhoto),
+		}).To_Message(),
+	}
+	r.SetMessages(1, messages...)
+
+	if c, err := r.Check(1, 100, 200, photo.FileReference); err != nil || c != MessageContext(mtproto.PEER_USER, 2, 7) {
+		t.Errorf("photo: %+v, %v", c, err)
+	}
+	if c, err := r.Check(1, 101, 201, doc.FileReference); err != nil || c != MessageContext(mtproto.PEER_CHAT, 3, 8) {
+		t.Errorf("document: %+v, %v", c, err)
+	}
+	if c, err := r.Check(1, 102, 202, chatPhoto.FileReference); err != nil || c != MessageContext(mtproto.PEER_CHAT, 3, 10) {
+		t.Errorf("messageActionChatEditPhoto: %+v, %v", c, err)
+	}
+
+	location := mtproto.MakeTLInputDocumentFileLocation(&mtproto.InputFileLocation{
+		Id:            101,
+		AccessHash:    201,
+		FileReference: doc.FileReference,
+	}).To_InputFileLocation()
+	if err := r.CheckFileLocation(1, location); err != nil {
+		t.Errorf("CheckFileLocation: %v", err)
+	}
+	location.Id = 100
+	if err := r.CheckFileLocation(1, location); err != mtproto.ErrFileReferenceExpired {
+		t.Errorf("CheckFileLocation of another document: %v", err)
+	}
+}
+
+func TestSetUpdates(t *testing.T) {
+	var (
+		r     = New(&conf.FileReferenceConfig{Secret: testSecret})
+		photo = mtproto.MakeTLPhoto(&mtproto.Photo{Id: 100, AccessHash: 200}).To_Photo()
+		doc   = mtproto.MakeTLDocument(&mtproto.Document{Id: 101, AccessHash: 201}).To_Document()
+	)
+
+	updates := mtproto.MakeUpdatesByUpdates(
+		mtproto.MakeTLUpdateNewMessage(&mtproto.Update{
+			Message_MESSAGE: mtproto.MakeTLMessage(&mtproto.Message{
+				Id:     7,
+				PeerId: mtproto.MakePeerUser(2),
+				Media:  mtproto.MakeTLMessageMediaPhoto(&mtproto.MessageMedia{Photo_FLAGPHOTO: photo}).To_MessageMedia(),
+			}).To_Message(),
+		}).To_Update(),
+		mtproto.MakeTLUpdateNewChannelMessage(&mtproto.Update{
+			Message_MESSAGE: mtproto.MakeTLMessage(&mtproto.Message{
+				Id:     8,
+				PeerId: mtproto.MakePeerChannel(3),
+				Media:  mtproto.MakeTLMessageMediaDocument(&mtproto.MessageMedia{Document: doc}).To_MessageMedia(),
+			}).To_Message(),
+		}).To_Update())
+	r.SetUpdates(1, updates)
+
+	if c, err := r.Check(1, 100, 200, photo.FileReference); err != nil || c != MessageContext(mtproto.PEER_USER, 2, 7) {
+		t.Errorf("updateNewMessage: %+v, %v", c, err)
+	}
+	if c, err := r.Check(1, 101, 201, doc.FileReference); err != nil || c != MessageContext(mtproto.PEER_CHANNEL, 3, 8) {
+		t.Errorf("updateNewChannelMessage: %+v, %v", c, err)
+	}
+
+	// the same updates pushed to another user are signed for that user
+	r.SetUpdates(2, updates)
+	if _, err := r.Check(2, 100, 200, photo.FileReference); err != nil {
+		t.Errorf("updateNewMessage of user 2: %v", err)
+	}
+	if _, err := r.Check(1, 100, 200, photo.FileReference); err != mtproto.ErrFileReferenceExpired {
+		t.Errorf("updateNewMessage of user 2 used by user 1: %v", err)
+	}
+}
+
+func TestSetBotResults(t *testing.T) {
+	var (
+		r     = New(&conf.FileReferenceConfig{Secret: testSecret})
+		photo = mtproto.MakeTLPhoto(&mtproto.Photo{Id: 100, AccessHash: 200}).To_Photo()
+		doc   = mtproto.MakeTLDocument(&mtproto.Document{Id: 101, AccessHash: 201}).To_Document()
+	)
+
+	results := mtproto.MakeTLMessagesBotResults(&mtproto.Messages_BotResults{
+		Results: []*mtproto.BotInlineResult{
+			mtproto.MakeTLBotInlineMediaResult(&mtproto.BotInlineResult{Id: "1", Photo: photo}).To_BotInlineResult(),
+			mtproto.MakeTLBotInlineMediaResult(&mtproto.BotInlineResult{Id: "2", Document: doc}).To_BotInlineResult(),
+			mtproto.MakeTLBotInlineResult(&mtproto.BotInlineResult{Id: "3"}).To_BotInlineResult(),
+		},
+	}).To_Messages_BotResults()
+	r.SetBotResults(1, 5, results)
+
+	if c, err := r.Check(1, 100, 200, photo.FileReference); err != nil || c != InlineResultContext(5) {
+		t.Errorf("photo: %+v, %v", c, err)
+	}
+	if c, err := r.Check(1, 101, 201, doc.FileReference); err != nil || c != InlineResultContext(5) {
+		t.Errorf("document: %+v, %v", c, err)
+	}
+}
@@ -0,0 +1,142 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package filereference
+
+import (
+	"github.com/teamgram/proto/mtproto"
+)
+
+// SetPhoto sets the file_reference of photo served to userId in ctx.
+func (r *FileReference) SetPhoto(userId int64, photo *mtproto.Photo, ctx Context) {
+	if photo.GetPredicateName() != mtproto.Predicate_photo {
+		return
+	}
+	photo.FileReference = r.Make(userId, photo.GetId(), photo.GetAccessHash(), ctx)
+}
+
+// SetDocument sets the file_reference of document served to userId in ctx.
+func (r *FileReference) SetDocument(userId int64, document *mtproto.Document, ctx Context) {
+	if document.GetPredicateName() != mtproto.Predicate_document {
+		return
+	}
+	document.FileReference = r.Make(userId, document.GetId(), document.GetAccessHash(), ctx)
+}
+
+// SetMessageMedia sets the file_reference of the photos and the documents of media.
+func (r *FileReference) SetMessageMedia(userId int64, media *mtproto.MessageMedia, ctx Context) {
+	switch media.GetPredicateName() {
+	case mtproto.Predicate_messageMediaPhoto:
+		r.SetPhoto(userId, media.GetPhoto_FLAGPHOTO(), ctx)
+	case mtproto.Predicate_messageMediaDocument:
+		r.SetDocument(userId, media.GetDocument(), ctx)
+	case mtproto.Predicate_messageMediaWebPage:
+		r.SetPhoto(userId, media.GetWebpage().GetPhoto(), ctx)
+		r.SetDocument(userId, media.GetWebpage().GetDocument(), ctx)
+	case mtproto.Predicate_messageMediaGame:
+		r.SetPhoto(userId, media.GetGame().GetPhoto(), ctx)
+		r.SetDocument(userId, media.GetGame().GetDocument(), ctx)
+	}
+}
+
+// SetMessages sets the file_reference of the media of messages served to userId and of
+// the photo of messageActionChatEditPhoto, the context is the message so that the client
+// refreshes it by messages.getMessages or channels.getMessages.
+func (r *FileReference) SetMessages(userId int64, messages ...*mtproto.Message) {
+	for _, m := range messages {
+		if m.GetMedia() == nil && m.GetAction().GetPredicateName() != mtproto.Predicate_messageActionChatEditPhoto {
+			continue
+		}
+
+		var ctx Context
+		switch peer := m.GetPeerId(); peer.GetPredicateName() {
+		case mtproto.Predicate_peerUser:
+			ctx = MessageContext(mtproto.PEER_USER, peer.GetUserId(), m.GetId())
+		case mtproto.Predicate_peerChat:
+			ctx = MessageContext(mtproto.PEER_CHAT, peer.GetChatId(), m.GetId())
+		case mtproto.Predicate_peerChannel:
+			ctx = MessageContext(mtproto.PEER_CHANNEL, peer.GetChannelId(), m.GetId())
+		default:
+			continue
+		}
+		r.SetMessageMedia(userId, m.GetMedia(), ctx)
+		if m.GetAction().GetPredicateName() == mtproto.Predicate_messageActionChatEditPhoto {
+			r.SetPhoto(userId, m.GetAction().GetPhoto(), ctx)
+		}
+	}
+}
+
+// SetBotResults sets the file_reference of the photos and the documents of the inline
+// results of botId served to userId.
+func (r *FileReference) SetBotResults(userId, botId int64, results *mtproto.Messages_BotResults) {
+	ctx := InlineResultContext(botId)
+	for _, result := range results.GetResults() {
+		r.SetPhoto(userId, result.GetPhoto(), ctx)
+		r.SetDocument(userId, result.GetDocument(), ctx)
+	}
+}
+
+// CheckFileLocation checks the file_reference of the photo and document locations, the
+// other locations carry none.
+func (r *FileReference) CheckFileLocation(userId int64, location *mtproto.InputFileLocation) error {
+	switch location.GetPredicateName() {
+	case mtproto.Predicate_inputDocumentFileLocation,
+		mtproto.Predicate_inputPhotoFileLocation:
+		_, err := r.Check(userId, location.GetId(), location.GetAccessHash(), location.GetFileReference())
+		return err
+	}
+
+	return nil
+}
+
+// SetUpdateList sets the file_reference of the messages carried by updates.
+func (r *FileReference) SetUpdateList(userId int64, updates ...*mtproto.Update) {
+	for _, u := range updates {
+		switch u.GetPredicateName() {
+		case mtproto.Predicate_updateNewMessage,
+			mtproto.Predicate_updateNewChannelMessage,
+			mtproto.Predicate_updateEditMessage,
+			mtproto.Predicate_updateEditChannelMessage,
+			mtproto.Predicate_updateNewScheduledMessage:
+			r.SetMessages(userId, u.GetMessage_MESSAGE())
+		}
+	}
+}
+
+// SetUpdates sets the file_reference of the messages in updates pushed or returned to userId.
+func (r *FileReference) SetUpdates(userId int64, updates *mtproto.Updates) {
+	switch updates.GetPredicateName() {
+	case mtproto.Predicate_updates, mtproto.Predicate_updatesCombined:
+		r.SetUpdateList(userId, updates.GetUpdates()...)
+	case mtproto.Predicate_updateShort:
+		r.SetUpdateList(userId, updates.GetUpdate())
+	}
+}
+
+// SetDifference sets the file_reference of the messages of updates.getDifference.
+func (r *FileReference) SetDifference(userId int64, difference *mtproto.Updates_Difference) {
+	r.SetMessages(userId, difference.GetNewMessages()...)
+	r.SetUpdateList(userId, difference.GetOtherUpdates()...)
+}
+
+// SetChannelDifference sets the file_reference of the messages of updates.getChannelDifference.
+func (r *FileReference) SetChannelDifference(userId int64, difference *mtproto.Updates_ChannelDifference) {
+	r.SetMessages(userId, difference.GetMessages()...)
+	r.SetMessages(userId, difference.GetNewMessages()...)
+	r.SetUpdateList(userId, difference.GetOtherUpdates()...)
+}
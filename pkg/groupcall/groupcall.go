@@ -0,0 +1,165 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package groupcall
+
+import (
+	"encoding/json"
+	"sort"
+
+	"github.com/teamgram/proto/mtproto"
+
+	"github.com/gogo/protobuf/types"
+)
+
+const (
+	// DefaultVolume is 100%, the volume of groupCallParticipant is in 1/100 of a percent
+	DefaultVolume = 10000
+)
+
+// GroupCall is a voice chat of a basic group, the media is relayed by the sfu.Adapter.
+type GroupCall struct {
+	Id         int64  `json:"id"`
+	AccessHash int64  `json:"access_hash"`
+	ChatId     int64  `json:"chat_id"`
+	CreatorId  int64  `json:"creator_id"`
+	Date       int32  `json:"date"`
+	Title      string `json:"title,omitempty"`
+	JoinMuted  bool   `json:"join_muted"`
+	Discarded  bool   `json:"discarded"`
+	Duration   int32  `json:"duration"`
+}
+
+// Participant is a member of the chat who joined the call, Source is the ssrc of its audio.
+type Participant struct {
+	UserId          int64 `json:"user_id"`
+	Date            int32 `json:"date"`
+	ActiveDate      int32 `json:"active_date"`
+	Source          int32 `json:"source"`
+	Muted           bool  `json:"muted"`
+	MutedByAdmin    bool  `json:"muted_by_admin"`
+	Volume          int32 `json:"volume"`
+	VolumeByAdmin   bool  `json:"volume_by_admin"`
+	RaiseHandRating int64 `json:"raise_hand_rating"`
+	VideoStopped    bool  `json:"video_stopped"`
+}
+
+func (m *GroupCall) ToInputGroupCall() *mtproto.InputGroupCall {
+	return mtproto.MakeTLInputGroupCall(&mtproto.InputGroupCall{
+		Id:         m.Id,
+		AccessHash: m.AccessHash,
+	}).To_InputGroupCall()
+}
+
+func (m *GroupCall) Check(call *mtproto.InputGroupCall) bool {
+	return call != nil && call.Id == m.Id && call.AccessHash == m.AccessHash
+}
+
+// ToGroupCall count is the number of the participants, version the version of the list.
+func (m *GroupCall) ToGroupCall(count, version int32) *mtproto.GroupCall {
+	if m.Discarded {
+		return mtproto.MakeTLGroupCallDiscarded(&mtproto.GroupCall{
+			Id:         m.Id,
+			AccessHash: m.AccessHash,
+			Duration:   m.Duration,
+		}).To_GroupCall()
+	}
+
+	call := mtproto.MakeTLGroupCall(&mtproto.GroupCall{
+		Id:                 m.Id,
+		AccessHash:         m.AccessHash,
+		JoinMuted:          m.JoinMuted,
+		CanChangeJoinMuted: true,
+		JoinDateAsc:        true,
+		ParticipantsCount:  count,
+		Version:            version,
+	}).To_GroupCall()
+	if m.Title != "" {
+		call.Title = &types.StringValue{Value: m.Title}
+	}
+
+	return call
+}
+
+// ToMessageAction the messageActionGroupCall of the service messages written when the call starts and ends.
+func (m *GroupCall) ToMessageAction() *mtproto.MessageAction {
+	action := mtproto.MakeTLMessageActionGroupCall(&mtproto.MessageAction{
+		Call: m.ToInputGroupCall(),
+	}).To_MessageAction()
+	if m.Discarded {
+		action.Duration = &types.Int32Value{Value: m.Duration}
+	}
+
+	return action
+}
+
+// CanSelfUnmute the participant may unmute itself unless an admin muted it.
+func (p *Participant) CanSelfUnmute() bool {
+	return !p.MutedByAdmin
+}
+
+// ToGroupCallParticipant the groupCallParticipant seen by selfId.
+func (p *Participant) ToGroupCallParticipant(selfId int64) *mtproto.GroupCallParticipant {
+	participant := mtproto.MakeTLGroupCallParticipant(&mtproto.GroupCallParticipant{
+		Muted:         p.Muted,
+		CanSelfUnmute: p.CanSelfUnmute(),
+		VolumeByAdmin: p.VolumeByAdmin,
+		Self:          p.UserId == selfId,
+		Peer:          mtproto.MakePeerUser(p.UserId),
+		Date:          p.Date,
+		Source:        p.Source,
+	}).To_GroupCallParticipant()
+	if p.ActiveDate != 0 {
+		participant.ActiveDate = &types.Int32Value{Value: p.ActiveDate}
+	}
+	if p.Volume != 0 && p.Volume != DefaultVolume {
+		participant.Volume = &types.Int32Value{Value: p.Volume}
+	}
+	if p.RaiseHandRating != 0 {
+		participant.RaiseHandRating = &types.Int64Value{Value: p.RaiseHandRating}
+	}
+
+	return participant
+}
+
+// SortParticipants the participants are listed in the order they joined.
+func SortParticipants(participants []*Participant) {
+	sort.Slice(participants, func(i, j int) bool {
+		if participants[i].Date == participants[j].Date {
+			return participants[i].UserId < participants[j].UserId
+		}
+		return participants[i].Date < participants[j].Date
+	})
+}
+
+// ParseSource returns the ssrc of the audio in the params of phone.joinGroupCall.
+func ParseSource(params string) (int32, error) {
+	var v struct {
+		Ssrc *int64 `json:"ssrc"`
+	}
+
+	if err := json.Unmarshal([]byte(params), &v); err != nil {
+		return 0, mtproto.ErrDataJsonInvalid
+	}
+	if v.Ssrc == nil {
+		return 0, mtproto.ErrDataJsonInvalid
+	}
+
+	// the ssrc is an uint32, the source of the participant its int32 bits
+	return int32(uint32(*v.Ssrc)), nil
+}
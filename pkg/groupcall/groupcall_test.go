@@ -0,0 +1,104 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package groupcall
+
+import (
+	"testing"
+
+	"github.com/teamgram/proto/mtproto"
+)
+
+func TestParseSource(t *testing.T) {
+	for i, c := range []struct {
+		params string
+		want   int32
+		err    error
+	}{
+		{`{"ufrag":"a","pwd":"b","ssrc":12345,"fingerprints":[]}`, 12345, nil},
+		{`{"ssrc":4294967295}`, -1, nil},
+		{`{"ufrag":"a"}`, 0, mtproto.ErrDataJsonInvalid},
+		{`not json`, 0, mtproto.ErrDataJsonInvalid},
+	} {
+		source, err := ParseSource(c.params)
+		if source != c.want || err != c.err {
+			t.Errorf("#%d: ParseSource(%s) = %d, %v, want %d, %v", i, c.params, source, err, c.want, c.err)
+		}
+	}
+}
+
+func TestToGroupCallParticipant(t *testing.T) {
+	p := &Participant{
+		UserId:       1,
+		Date:         100,
+		Source:       7,
+		Muted:        true,
+		MutedByAdmin: true,
+		Volume:       DefaultVolume,
+	}
+
+	self := p.ToGroupCallParticipant(1)
+	if !self.Self || self.CanSelfUnmute || !self.Muted || self.Volume != nil {
+		t.Errorf("ToGroupCallParticipant(self) = %v", self)
+	}
+	if other := p.ToGroupCallParticipant(2); other.Self {
+		t.Errorf("ToGroupCallParticipant(other) = %v", other)
+	}
+
+	p.MutedByAdmin = false
+	p.Volume = 5000
+	if v := p.ToGroupCallParticipant(2); !v.CanSelfUnmute || v.GetVolume().GetValue() != 5000 {
+		t.Errorf("ToGroupCallParticipant() = %v", v)
+	}
+}
+
+func TestSortParticipants(t *testing.T) {
+	participants := []*Participant{
+		{UserId: 3, Date: 200},
+		{UserId: 2, Date: 100},
+		{UserId: 1, Date: 200},
+	}
+	SortParticipants(participants)
+
+	for i, id := range []int64{2, 1, 3} {
+		if participants[i].UserId != id {
+			t.Errorf("#%d: UserId = %d, want %d", i, participants[i].UserId, id)
+		}
+	}
+}
+
+func TestGroupCallDiscarded(t *testing.T) {
+	call := &GroupCall{Id: 1, AccessHash: 2, Title: "voice chat"}
+
+	if c := call.ToGroupCall(3, 4); c.GetPredicateName() != mtproto.Predicate_groupCall ||
+		c.ParticipantsCount != 3 || c.Version != 4 || c.GetTitle().GetValue() != "voice chat" {
+		t.Errorf("ToGroupCall() = %v", c)
+	}
+	if a := call.ToMessageAction(); a.Duration != nil {
+		t.Errorf("ToMessageAction() = %v, want no duration", a)
+	}
+
+	call.Discarded = true
+	call.Duration = 60
+	if c := call.ToGroupCall(0, 0); c.GetPredicateName() != mtproto.Predicate_groupCallDiscarded || c.Duration != 60 {
+		t.Errorf("ToGroupCall() = %v, want groupCallDiscarded", c)
+	}
+	if a := call.ToMessageAction(); a.GetDuration().GetValue() != 60 {
+		t.Errorf("ToMessageAction() = %v, want duration 60", a)
+	}
+}
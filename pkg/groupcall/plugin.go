@@ -0,0 +1,100 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package groupcall
+
+import (
+	"context"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/pkg/sfu"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/stores/kv"
+)
+
+// ChatPlugin implements the chat plugin of biz, it fills the call of chatFull and the flags of chat.
+type ChatPlugin struct {
+	store *Store
+}
+
+func NewChatPlugin(c kv.KvConf) *ChatPlugin {
+	return &ChatPlugin{
+		store: NewStore(c),
+	}
+}
+
+func (m *ChatPlugin) GetChatCallActiveAndNotEmpty(ctx context.Context, userId int64, chatId int64) (bool, bool) {
+	call, err := m.store.GetChatGroupCall(ctx, chatId)
+	if err != nil || call == nil {
+		return false, false
+	}
+
+	count, _ := m.store.CountParticipants(ctx, call.Id)
+	return true, count > 0
+}
+
+func (m *ChatPlugin) GetChatGroupCall(ctx context.Context, userId int64, chatId int64) *mtproto.InputGroupCall {
+	call, err := m.store.GetChatGroupCall(ctx, chatId)
+	if err != nil || call == nil {
+		return nil
+	}
+
+	return call.ToInputGroupCall()
+}
+
+// StreamPlugin serves the inputGroupCallStream of upload.getFile from the sfu.Adapter.
+type StreamPlugin struct {
+	store *Store
+	sfu   sfu.Adapter
+}
+
+func NewStreamPlugin(c kv.KvConf, adapter sfu.Adapter) *StreamPlugin {
+	return &StreamPlugin{
+		store: NewStore(c),
+		sfu:   adapter,
+	}
+}
+
+// GetGroupCallStreamFile only the participants of the call get the broadcast.
+func (m *StreamPlugin) GetGroupCallStreamFile(ctx context.Context, userId int64, file *mtproto.InputFileLocation) (*mtproto.Upload_File, error) {
+	call, err := m.store.GetGroupCall(ctx, file.GetCall().GetId())
+	if err != nil {
+		return nil, err
+	} else if call == nil || call.Discarded || !call.Check(file.GetCall()) {
+		return nil, mtproto.ErrGroupCallInvalid
+	}
+
+	if p, err := m.store.GetParticipant(ctx, call.Id, userId); err != nil {
+		return nil, err
+	} else if p == nil {
+		return nil, mtproto.ErrGroupCallJoinMissing
+	}
+
+	data, err := m.sfu.GetStreamFile(ctx, call.Id, file.GetTimeMs(), file.GetScale())
+	if err != nil {
+		logx.WithContext(ctx).Errorf("sfu.GetStreamFile(%d, %d) error(%v)", call.Id, file.GetTimeMs(), err)
+		return nil, err
+	}
+
+	return mtproto.MakeTLUploadFile(&mtproto.Upload_File{
+		Type:  mtproto.MakeTLStorageFileUnknown(nil).To_Storage_FileType(),
+		Mtime: int32(file.GetTimeMs() / 1000),
+		Bytes: data,
+	}).To_Upload_File(), nil
+}
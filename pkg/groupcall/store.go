@@ -0,0 +1,276 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package groupcall
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/stores/kv"
+	"github.com/zeromicro/go-zero/core/stores/redis"
+)
+
+const (
+	chatGroupCallKeyPrefix         = "chat_group_call"
+	groupCallKeyPrefix             = "group_call"
+	groupCallParticipantsKeyPrefix = "group_call_participants"
+	groupCallVersionKeyPrefix      = "group_call_version"
+	groupCallDiscardedExpire       = 24 * 60 * 60 // the discarded calls are still resolved by phone.getGroupCall for a day
+)
+
+func genChatGroupCallKey(chatId int64) string {
+	return fmt.Sprintf("%s_%d", chatGroupCallKeyPrefix, chatId)
+}
+
+func genGroupCallKey(id int64) string {
+	return fmt.Sprintf("%s_%d", groupCallKeyPrefix, id)
+}
+
+func genGroupCallParticipantsKey(id int64) string {
+	return fmt.Sprintf("%s_%d", groupCallParticipantsKeyPrefix, id)
+}
+
+func genGroupCallVersionKey(id int64) string {
+	return fmt.Sprintf("%s_%d", groupCallVersionKeyPrefix, id)
+}
+
+// Store keeps the group calls, shared by bff.groupcalls and the chat plugin of biz.
+type Store struct {
+	kv kv.Store
+}
+
+func NewStore(c kv.KvConf) *Store {
+	return &Store{
+		kv: kv.NewStore(c),
+	}
+}
+
+// PutChatGroupCall only the first of the concurrent phone.createGroupCall of a chat succeeds.
+func (s *Store) PutChatGroupCall(ctx context.Context, chatId, id int64) (bool, error) {
+	key := genChatGroupCallKey(chatId)
+
+	ok, err := s.kv.Setnx(key, strconv.FormatInt(id, 10))
+	if err != nil {
+		logx.WithContext(ctx).Errorf("conn.Do(SETNX %s) error(%v)", key, err)
+		return false, err
+	}
+
+	return ok, nil
+}
+
+// GetChatGroupCall returns the running call of the chat, nil if there is none.
+func (s *Store) GetChatGroupCall(ctx context.Context, chatId int64) (*GroupCall, error) {
+	key := genChatGroupCallKey(chatId)
+
+	value, err := s.kv.Get(key)
+	if err != nil {
+		logx.WithContext(ctx).Errorf("conn.Do(GET %s) error(%v)", key, err)
+		return nil, err
+	} else if value == "" {
+		return nil, nil
+	}
+
+	id, _ := strconv.ParseInt(value, 10, 64)
+	call, err := s.GetGroupCall(ctx, id)
+	if err != nil {
+		return nil, err
+	} else if call == nil || call.Discarded {
+		return nil, nil
+	}
+
+	return call, nil
+}
+
+func (s *Store) DeleteChatGroupCall(ctx context.Context, chatId int64) error {
+	key := genChatGroupCallKey(chatId)
+
+	if _, err := s.kv.Del(key); err != nil {
+		logx.WithContext(ctx).Errorf("conn.Do(DEL %s) error(%v)", key, err)
+		return err
+	}
+
+	return nil
+}
+
+// PutGroupCall the running calls don't expire.
+func (s *Store) PutGroupCall(ctx context.Context, call *GroupCall) error {
+	var (
+		key      = genGroupCallKey(call.Id)
+		value, _ = json.Marshal(call)
+		err      error
+	)
+
+	if call.Discarded {
+		err = s.kv.Setex(key, string(value), groupCallDiscardedExpire)
+	} else {
+		err = s.kv.Set(key, string(value))
+	}
+	if err != nil {
+		logx.WithContext(ctx).Errorf("conn.Do(SET %s) error(%v)", key, err)
+		return err
+	}
+
+	return nil
+}
+
+func (s *Store) GetGroupCall(ctx context.Context, id int64) (*GroupCall, error) {
+	key := genGroupCallKey(id)
+
+	value, err := s.kv.Get(key)
+	if err != nil {
+		logx.WithContext(ctx).Errorf("conn.Do(GET %s) error(%v)", key, err)
+		return nil, err
+	} else if value == "" {
+		return nil, nil
+	}
+
+	call := new(GroupCall)
+	if err = json.Unmarshal([]byte(value), call); err != nil {
+		logx.WithContext(ctx).Errorf("json.Unmarshal(%s) error(%v)", value, err)
+		return nil, err
+	}
+
+	return call, nil
+}
+
+func (s *Store) PutParticipant(ctx context.Context, id int64, p *Participant) error {
+	var (
+		key      = genGroupCallParticipantsKey(id)
+		value, _ = json.Marshal(p)
+	)
+
+	if err := s.kv.Hset(key, strconv.FormatInt(p.UserId, 10), string(value)); err != nil {
+		logx.WithContext(ctx).Errorf("conn.Do(HSET %s) error(%v)", key, err)
+		return err
+	}
+
+	return nil
+}
+
+// GetParticipant returns nil if userId isn't in the call.
+func (s *Store) GetParticipant(ctx context.Context, id, userId int64) (*Participant, error) {
+	key := genGroupCallParticipantsKey(id)
+
+	value, err := s.kv.Hget(key, strconv.FormatInt(userId, 10))
+	if err == redis.Nil {
+		return nil, nil
+	} else if err != nil {
+		logx.WithContext(ctx).Errorf("conn.Do(HGET %s) error(%v)", key, err)
+		return nil, err
+	} else if value == "" {
+		return nil, nil
+	}
+
+	p := new(Participant)
+	if err = json.Unmarshal([]byte(value), p); err != nil {
+		logx.WithContext(ctx).Errorf("json.Unmarshal(%s) error(%v)", value, err)
+		return nil, err
+	}
+
+	return p, nil
+}
+
+// GetParticipants returns the participants in the order they joined.
+func (s *Store) GetParticipants(ctx context.Context, id int64) ([]*Participant, error) {
+	key := genGroupCallParticipantsKey(id)
+
+	values, err := s.kv.Hgetall(key)
+	if err != nil {
+		logx.WithContext(ctx).Errorf("conn.Do(HGETALL %s) error(%v)", key, err)
+		return nil, err
+	}
+
+	participants := make([]*Participant, 0, len(values))
+	for _, value := range values {
+		p := new(Participant)
+		if err = json.Unmarshal([]byte(value), p); err != nil {
+			logx.WithContext(ctx).Errorf("json.Unmarshal(%s) error(%v)", value, err)
+			continue
+		}
+		participants = append(participants, p)
+	}
+	SortParticipants(participants)
+
+	return participants, nil
+}
+
+func (s *Store) CountParticipants(ctx context.Context, id int64) (int32, error) {
+	key := genGroupCallParticipantsKey(id)
+
+	n, err := s.kv.Hlen(key)
+	if err != nil {
+		logx.WithContext(ctx).Errorf("conn.Do(HLEN %s) error(%v)", key, err)
+		return 0, err
+	}
+
+	return int32(n), nil
+}
+
+// DeleteParticipant returns false if userId wasn't in the call.
+func (s *Store) DeleteParticipant(ctx context.Context, id, userId int64) (bool, error) {
+	key := genGroupCallParticipantsKey(id)
+
+	ok, err := s.kv.Hdel(key, strconv.FormatInt(userId, 10))
+	if err != nil {
+		logx.WithContext(ctx).Errorf("conn.Do(HDEL %s) error(%v)", key, err)
+		return false, err
+	}
+
+	return ok, nil
+}
+
+func (s *Store) DeleteParticipants(ctx context.Context, id int64) error {
+	key := genGroupCallParticipantsKey(id)
+
+	if _, err := s.kv.Del(key); err != nil {
+		logx.WithContext(ctx).Errorf("conn.Do(DEL %s) error(%v)", key, err)
+		return err
+	}
+
+	return nil
+}
+
+// NextVersion the version of the participants list is bumped by every updateGroupCallParticipants.
+func (s *Store) NextVersion(ctx context.Context, id int64) (int32, error) {
+	key := genGroupCallVersionKey(id)
+
+	v, err := s.kv.Incr(key)
+	if err != nil {
+		logx.WithContext(ctx).Errorf("conn.Do(INCR %s) error(%v)", key, err)
+		return 0, err
+	}
+
+	return int32(v), nil
+}
+
+func (s *Store) GetVersion(ctx context.Context, id int64) (int32, error) {
+	key := genGroupCallVersionKey(id)
+
+	value, err := s.kv.Get(key)
+	if err != nil {
+		logx.WithContext(ctx).Errorf("conn.Do(GET %s) error(%v)", key, err)
+		return 0, err
+	}
+	v, _ := strconv.ParseInt(value, 10, 32)
+
+	return int32(v), nil
+}
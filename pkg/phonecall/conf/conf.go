@@ -0,0 +1,44 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package conf
+
+type PhoneCallConfig struct {
+	// RingTimeout seconds a call waits for phone.acceptCall before it is discarded as missed
+	RingTimeout int32 `json:",default=90"`
+	// P2PAllowed the clients may try a direct connection before the relays
+	P2PAllowed bool `json:",default=true"`
+	// Json is returned by phone.getCallConfig, the libtgvoip defaults are used if empty
+	Json string `json:",optional"`
+	// Connections are the relays published to the participants of an accepted call
+	Connections []PhoneConnectionConfig `json:",optional"`
+}
+
+// PhoneConnectionConfig a reflector is a phoneConnection, the peer_tag is generated per call,
+// stun and turn servers are phoneConnectionWebrtc.
+type PhoneConnectionConfig struct {
+	Id       int64
+	Type     string `json:",default=reflector,options=reflector|webrtc"`
+	Ip       string
+	Ipv6     string `json:",optional"`
+	Port     int32
+	Turn     bool   `json:",optional"`
+	Stun     bool   `json:",optional"`
+	Username string `json:",optional"`
+	Password string `json:",optional"`
+}
@@ -0,0 +1,738 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package search
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// The file backend keeps an inverted index per user in memory and persists it
+// as an append-only log of changes plus a periodically compacted snapshot, both
+// are JSON lines of fileRecord. It's meant for single node installs.
+//
+// The compaction moves the log aside to index.log.old and writes the snapshot
+// from a copy of the docs in the background, the writers go on with a new log.
+// Only the newest MaxUserDocs messages of a user are kept searchable, so the
+// postings in memory stay bounded.
+
+const (
+	fileIndexLog      = "index.log"
+	fileIndexSnapshot = "index.snap"
+	fileIndexOldLog   = "index.log.old"
+
+	defaultSearchLimit      = 20
+	defaultCompactThreshold = 100000
+	defaultMaxUserDocs      = 100000
+)
+
+const (
+	opIndex      = 1
+	opDelete     = 2
+	opCheckpoint = 3
+)
+
+func init() {
+	Register(BackendFile, newFileIndex)
+}
+
+type fileDoc struct {
+	DialogId1 int64    `json:"d1"`
+	DialogId2 int64    `json:"d2"`
+	FromId    int64    `json:"f"`
+	MediaType int32    `json:"m"`
+	Date      int64    `json:"t"`
+	Terms     []string `json:"w,omitempty"`
+}
+
+type fileRecord struct {
+	Op         int8     `json:"op"`
+	UserId     int64    `json:"u,omitempty"`
+	MessageId  int32    `json:"id,omitempty"`
+	Doc        *fileDoc `json:"doc,omitempty"`
+	IdList     []int32  `json:"ids,omitempty"`
+	Checkpoint int64    `json:"cp,omitempty"`
+}
+
+type userIndex struct {
+	docs     map[int32]*fileDoc
+	postings map[string][]int32 // ascending message ids
+}
+
+// fileSnapshot is the copy of the index written by a compaction, the docs are
+// shared with the index since putLocked replaces them instead of changing them.
+type fileSnapshot struct {
+	users      map[int64]map[int32]*fileDoc
+	checkpoint int64
+}
+
+type fileIndex struct {
+	mu               sync.RWMutex
+	path             string
+	compactThreshold int
+	maxUserDocs      int
+	users            map[int64]*userIndex
+	checkpoint       int64
+	log              *os.File
+	w                *bufio.Writer
+	records          int
+	compacting       bool
+	wg               sync.WaitGroup
+}
+
+func newFileIndex(c Config) (Index, error) {
+	if c.Path == "" {
+		return nil, errors.New("search: file backend requires Path")
+	}
+	if err := os.MkdirAll(c.Path, 0755); err != nil {
+		return nil, err
+	}
+
+	idx := &fileIndex{
+		path:             c.Path,
+		compactThreshold: c.CompactThreshold,
+		maxUserDocs:      c.MaxUserDocs,
+		users:            make(map[int64]*userIndex),
+	}
+	if idx.compactThreshold <= 0 {
+		idx.compactThreshold = defaultCompactThreshold
+	}
+	if idx.maxUserDocs <= 0 {
+		idx.maxUserDocs = defaultMaxUserDocs
+	}
+
+	if _, err := idx.replay(filepath.Join(c.Path, fileIndexSnapshot)); err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+
+	// a compaction cut short left the rotated log behind
+	_, err := idx.replay(filepath.Join(c.Path, fileIndexOldLog))
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	hasOldLog := err == nil
+
+	logName := filepath.Join(c.Path, fileIndexLog)
+	n, err := idx.replay(logName)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	idx.records = n
+
+	idx.log, err = os.OpenFile(logName, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return nil, err
+	}
+	idx.w = bufio.NewWriter(idx.log)
+
+	if hasOldLog {
+		// nothing else runs yet, so fold both logs into the snapshot right away
+		if err = idx.writeSnapshot(idx.snapshotLocked()); err != nil {
+			idx.log.Close()
+			return nil, err
+		}
+		if err = os.Remove(filepath.Join(c.Path, fileIndexOldLog)); err != nil {
+			idx.log.Close()
+			return nil, err
+		}
+		if err = idx.log.Truncate(0); err != nil {
+			idx.log.Close()
+			return nil, err
+		}
+		idx.records = 0
+	}
+
+	return idx, nil
+}
+
+// replay applies every complete record of the file, a torn tail left by a crash is cut off.
+func (idx *fileIndex) replay(name string) (int, error) {
+	f, err := os.OpenFile(name, os.O_RDWR, 0644)
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+
+	var (
+		r      = bufio.NewReader(f)
+		offset int64
+		n      int
+	)
+
+	for {
+		line, err := r.ReadBytes('\n')
+		if err == io.EOF {
+			if len(line) > 0 {
+				logx.Errorf("search: truncate torn record at %s:%d", name, offset)
+				return n, f.Truncate(offset)
+			}
+			return n, nil
+		} else if err != nil {
+			return n, err
+		}
+
+		rec := new(fileRecord)
+		if err = json.Unmarshal(line, rec); err != nil {
+			logx.Errorf("search: truncate bad record at %s:%d - %v", name, offset, err)
+			return n, f.Truncate(offset)
+		}
+		idx.apply(rec)
+		offset += int64(len(line))
+		n++
+	}
+}
+
+func (idx *fileIndex) apply(rec *fileRecord) {
+	switch rec.Op {
+	case opIndex:
+		idx.putLocked(rec.UserId, rec.MessageId, rec.Doc)
+	case opDelete:
+		if u, ok := idx.users[rec.UserId]; ok {
+			for _, id := range rec.IdList {
+				u.remove(id)
+			}
+		}
+	case opCheckpoint:
+		idx.checkpoint = rec.Checkpoint
+	}
+}
+
+func (idx *fileIndex) putLocked(userId int64, id int32, doc *fileDoc) {
+	u, ok := idx.users[userId]
+	if !ok {
+		u = &userIndex{
+			docs:     make(map[int32]*fileDoc),
+			postings: make(map[string][]int32),
+		}
+		idx.users[userId] = u
+	}
+	u.remove(id)
+	u.docs[id] = doc
+	for _, t := range doc.Terms {
+		u.postings[t] = insertId(u.postings[t], id)
+	}
+
+	if len(u.docs) > idx.maxUserDocs {
+		// a tenth goes at once, so the sort is paid once per many messages
+		u.evict(len(u.docs) - (idx.maxUserDocs - idx.maxUserDocs/10))
+	}
+}
+
+// evict drops the n oldest messages of the user.
+func (u *userIndex) evict(n int) {
+	idList := make([]int32, 0, len(u.docs))
+	for id := range u.docs {
+		idList = append(idList, id)
+	}
+	sort.Slice(idList, func(i, j int) bool { return idList[i] < idList[j] })
+
+	for _, id := range idList[:n] {
+		u.remove(id)
+	}
+}
+
+func (u *userIndex) remove(id int32) {
+	doc, ok := u.docs[id]
+	if !ok {
+		return
+	}
+	for _, t := range doc.Terms {
+		if l := removeId(u.postings[t], id); len(l) == 0 {
+			delete(u.postings, t)
+		} else {
+			u.postings[t] = l
+		}
+	}
+	delete(u.docs, id)
+}
+
+func (idx *fileIndex) appendLocked(recs ...*fileRecord) error {
+	for _, rec := range recs {
+		b, err := json.Marshal(rec)
+		if err != nil {
+			return err
+		}
+		idx.w.Write(b)
+		idx.w.WriteByte('\n')
+	}
+	if err := idx.w.Flush(); err != nil {
+		return err
+	}
+
+	idx.records += len(recs)
+	if idx.records >= idx.compactThreshold && !idx.compacting {
+		if err := idx.rotateLocked(); err != nil {
+			logx.Errorf("search: compact index error - %v", err)
+		}
+	}
+	return nil
+}
+
+func (idx *fileIndex) snapshotLocked() *fileSnapshot {
+	s := &fileSnapshot{
+		users:      make(map[int64]map[int32]*fileDoc, len(idx.users)),
+		checkpoint: idx.checkpoint,
+	}
+	for userId, u := range idx.users {
+		docs := make(map[int32]*fileDoc, len(u.docs))
+		for id, doc := range u.docs {
+			docs[id] = doc
+		}
+		s.users[userId] = docs
+	}
+	return s
+}
+
+// rotateLocked moves the log aside and starts the compaction, the writers only
+// wait for the copy of the docs.
+func (idx *fileIndex) rotateLocked() error {
+	var (
+		logName = filepath.Join(idx.path, fileIndexLog)
+		oldName = filepath.Join(idx.path, fileIndexOldLog)
+	)
+
+	if err := os.Rename(logName, oldName); err != nil {
+		return err
+	}
+	f, err := os.OpenFile(logName, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		os.Rename(oldName, logName)
+		return err
+	}
+	idx.log.Close()
+	idx.log = f
+	idx.w.Reset(f)
+	idx.records = 0
+	idx.compacting = true
+
+	s := idx.snapshotLocked()
+	idx.wg.Add(1)
+	go func() {
+		defer idx.wg.Done()
+		idx.compact(s)
+	}()
+
+	return nil
+}
+
+// compact writes the snapshot and drops the rotated log, it runs without the lock.
+func (idx *fileIndex) compact(s *fileSnapshot) {
+	err := idx.writeSnapshot(s)
+	if err == nil {
+		err = os.Remove(filepath.Join(idx.path, fileIndexOldLog))
+	}
+
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	idx.compacting = false
+	if err != nil {
+		logx.Errorf("search: compact index error - %v", err)
+		if err = idx.restoreLocked(); err != nil {
+			logx.Errorf("search: restore index log error - %v", err)
+		}
+	}
+}
+
+// restoreLocked puts the rotated log back in front of the current one after a
+// failed compaction, the next one starts over from the whole log.
+//
+// replaying the log twice after a crash in between is harmless, every record
+// sets the final state of its message.
+func (idx *fileIndex) restoreLocked() error {
+	var (
+		logName = filepath.Join(idx.path, fileIndexLog)
+		oldName = filepath.Join(idx.path, fileIndexOldLog)
+	)
+
+	old, err := os.OpenFile(oldName, os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return err
+	}
+	cur, err := os.Open(logName)
+	if err != nil {
+		old.Close()
+		return err
+	}
+	_, err = io.Copy(old, cur)
+	cur.Close()
+	if err == nil {
+		err = old.Sync()
+	}
+	if err == nil {
+		err = os.Rename(oldName, logName)
+	}
+	if err != nil {
+		old.Close()
+		return err
+	}
+
+	idx.log.Close()
+	idx.log = old
+	idx.w.Reset(old)
+
+	return nil
+}
+
+// writeSnapshot writes s to a new snapshot file.
+func (idx *fileIndex) writeSnapshot(s *fileSnapshot) error {
+	var (
+		snapName = filepath.Join(idx.path, fileIndexSnapshot)
+		tmpName  = snapName + ".tmp"
+	)
+
+	f, err := os.Create(tmpName)
+	if err != nil {
+		return err
+	}
+
+	var (
+		w   = bufio.NewWriter(f)
+		enc = json.NewEncoder(w)
+	)
+	for userId, docs := range s.users {
+		for id, doc := range docs {
+			if err = enc.Encode(&fileRecord{Op: opIndex, UserId: userId, MessageId: id, Doc: doc}); err != nil {
+				f.Close()
+				return err
+			}
+		}
+	}
+	if err = enc.Encode(&fileRecord{Op: opCheckpoint, Checkpoint: s.checkpoint}); err != nil {
+		f.Close()
+		return err
+	}
+	if err = w.Flush(); err != nil {
+		f.Close()
+		return err
+	}
+	if err = f.Sync(); err != nil {
+		f.Close()
+		return err
+	}
+	if err = f.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmpName, snapName)
+}
+
+func (idx *fileIndex) Index(ctx context.Context, docs ...*Document) error {
+	if len(docs) == 0 {
+		return nil
+	}
+
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	recs := make([]*fileRecord, 0, len(docs))
+	for _, d := range docs {
+		doc := &fileDoc{
+			DialogId1: d.DialogId1,
+			DialogId2: d.DialogId2,
+			FromId:    d.FromId,
+			MediaType: d.MediaType,
+			Date:      d.Date,
+			Terms:     Tokenize(d.Text),
+		}
+		idx.putLocked(d.UserId, d.MessageId, doc)
+		recs = append(recs, &fileRecord{Op: opIndex, UserId: d.UserId, MessageId: d.MessageId, Doc: doc})
+	}
+
+	return idx.appendLocked(recs...)
+}
+
+func (idx *fileIndex) Delete(ctx context.Context, userId int64, idList ...int32) error {
+	if len(idList) == 0 {
+		return nil
+	}
+
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	idx.apply(&fileRecord{Op: opDelete, UserId: userId, IdList: idList})
+	return idx.appendLocked(&fileRecord{Op: opDelete, UserId: userId, IdList: idList})
+}
+
+func (idx *fileIndex) Search(ctx context.Context, q *Query) ([]int32, error) {
+	limit := int(q.Limit)
+	if limit <= 0 {
+		limit = defaultSearchLimit
+	}
+
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+
+	if q.AddOffset < 0 {
+		// the page starts at newer ids than offset_id, so collect them all
+		// and cut the page out of the list.
+		var (
+			all   []int32
+			start = -1
+		)
+		idx.matchLocked(q, func(id int32) bool {
+			if start < 0 && (q.OffsetId <= 0 || id < q.OffsetId) {
+				start = len(all)
+			}
+			all = append(all, id)
+			return start < 0 || len(all) < start+limit
+		})
+		if start < 0 {
+			start = len(all)
+		}
+		start += int(q.AddOffset)
+		if start < 0 {
+			limit += start
+			start = 0
+		}
+		if limit <= 0 {
+			return []int32{}, nil
+		}
+		if start+limit > len(all) {
+			limit = len(all) - start
+		}
+		return all[start : start+limit], nil
+	}
+
+	var (
+		skip   = int(q.AddOffset)
+		idList = make([]int32, 0, limit)
+	)
+	idx.matchLocked(q, func(id int32) bool {
+		if q.OffsetId > 0 && id >= q.OffsetId {
+			return true
+		}
+		if skip > 0 {
+			skip--
+			return true
+		}
+		idList = append(idList, id)
+		return len(idList) < limit
+	})
+
+	return idList, nil
+}
+
+func (idx *fileIndex) Count(ctx context.Context, q *Query) (int32, error) {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+
+	var n int32
+	idx.matchLocked(q, func(id int32) bool {
+		n++
+		return true
+	})
+
+	return n, nil
+}
+
+// matchLocked visits the matching ids from the newest one until cb returns false,
+// offset_id and add_offset are left to the caller.
+func (idx *fileIndex) matchLocked(q *Query, cb func(id int32) bool) {
+	u, ok := idx.users[q.UserId]
+	if !ok {
+		return
+	}
+
+	var candidates []int32
+	if terms := TokenizeQuery(q.Q); len(terms) == 0 {
+		if q.Q != "" {
+			// nothing searchable in the query, e.g. only punctuation
+			return
+		}
+		candidates = make([]int32, 0, len(u.docs))
+		for id := range u.docs {
+			candidates = append(candidates, id)
+		}
+		sort.Slice(candidates, func(i, j int) bool { return candidates[i] < candidates[j] })
+	} else {
+		for i, t := range terms {
+			var l []int32
+			if i == len(terms)-1 {
+				l = u.prefixPostings(t)
+			} else {
+				l = u.postings[t]
+			}
+			if i == 0 {
+				candidates = l
+			} else {
+				candidates = intersectIds(candidates, l)
+			}
+			if len(candidates) == 0 {
+				return
+			}
+		}
+	}
+
+	for i := len(candidates) - 1; i >= 0; i-- {
+		id := candidates[i]
+		if q.MaxId > 0 && id >= q.MaxId {
+			continue
+		}
+		if id <= q.MinId {
+			break
+		}
+		if !q.filter(u.docs[id]) {
+			continue
+		}
+		if !cb(id) {
+			return
+		}
+	}
+}
+
+func (q *Query) filter(doc *fileDoc) bool {
+	if doc == nil {
+		return false
+	}
+	if (q.DialogId1 != 0 || q.DialogId2 != 0) && (doc.DialogId1 != q.DialogId1 || doc.DialogId2 != q.DialogId2) {
+		return false
+	}
+	if q.FromId != 0 && doc.FromId != q.FromId {
+		return false
+	}
+	if q.MinDate != 0 && doc.Date < q.MinDate {
+		return false
+	}
+	if q.MaxDate != 0 && doc.Date > q.MaxDate {
+		return false
+	}
+	if len(q.MediaTypes) > 0 {
+		for _, m := range q.MediaTypes {
+			if doc.MediaType == m {
+				return true
+			}
+		}
+		return false
+	}
+	return true
+}
+
+func (u *userIndex) prefixPostings(prefix string) []int32 {
+	var l []int32
+	for t, ids := range u.postings {
+		if !strings.HasPrefix(t, prefix) {
+			continue
+		}
+		if l == nil {
+			l = ids
+		} else {
+			l = unionIds(l, ids)
+		}
+	}
+	return l
+}
+
+func (idx *fileIndex) Checkpoint() int64 {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+
+	return idx.checkpoint
+}
+
+func (idx *fileIndex) SetCheckpoint(id int64) error {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	idx.checkpoint = id
+	return idx.appendLocked(&fileRecord{Op: opCheckpoint, Checkpoint: id})
+}
+
+func (idx *fileIndex) Close() error {
+	// let a running compaction finish
+	idx.wg.Wait()
+
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	if err := idx.w.Flush(); err != nil {
+		return err
+	}
+	if err := idx.log.Sync(); err != nil {
+		return err
+	}
+	return idx.log.Close()
+}
+
+func insertId(l []int32, id int32) []int32 {
+	i := sort.Search(len(l), func(i int) bool { return l[i] >= id })
+	if i < len(l) && l[i] == id {
+		return l
+	}
+	l = append(l, 0)
+	copy(l[i+1:], l[i:])
+	l[i] = id
+	return l
+}
+
+func removeId(l []int32, id int32) []int32 {
+	i := sort.Search(len(l), func(i int) bool { return l[i] >= id })
+	if i == len(l) || l[i] != id {
+		return l
+	}
+	return append(l[:i], l[i+1:]...)
+}
+
+func intersectIds(a, b []int32) []int32 {
+	r := make([]int32, 0, len(a))
+	for i, j := 0, 0; i < len(a) && j < len(b); {
+		switch {
+		case a[i] < b[j]:
+			i++
+		case a[i] > b[j]:
+			j++
+		default:
+			r = append(r, a[i])
+			i++
+			j++
+		}
+	}
+	return r
+}
+
+func unionIds(a, b []int32) []int32 {
+	r := make([]int32, 0, len(a)+len(b))
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] < b[j]:
+			r = append(r, a[i])
+			i++
+		case a[i] > b[j]:
+			r = append(r, b[j])
+			j++
+		default:
+			r = append(r, a[i])
+			i++
+			j++
+		}
+	}
+	r = append(r, a[i:]...)
+	return append(r, b[j:]...)
+}
@@ -0,0 +1,220 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package search
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func openFileIndex(t *testing.T, dir string, threshold int) Index {
+	return openFileIndexConfig(t, Config{Backend: BackendFile, Path: dir, CompactThreshold: threshold})
+}
+
+func openFileIndexConfig(t *testing.T, c Config) Index {
+	idx, err := New(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return idx
+}
+
+func testDocuments() []*Document {
+	return []*Document{
+		{UserId: 1, MessageId: 1, DialogId1: 1, DialogId2: 2, FromId: 1, Date: 100, Text: "hello world"},
+		{UserId: 1, MessageId: 2, DialogId1: 1, DialogId2: 2, FromId: 2, Date: 200, Text: "Привет мир"},
+		{UserId: 1, MessageId: 3, DialogId1: 1, DialogId2: 3, FromId: 3, Date: 300, Text: "hello teamgram"},
+		{UserId: 1, MessageId: 4, DialogId1: 1, DialogId2: 3, FromId: 3, MediaType: 1, Date: 400},
+		{UserId: 2, MessageId: 1, DialogId1: 1, DialogId2: 2, FromId: 1, Date: 100, Text: "hello world"},
+	}
+}
+
+func checkSearch(t *testing.T, idx Index, q *Query, want []int32) {
+	t.Helper()
+
+	idList, err := idx.Search(context.Background(), q)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(idList) == 0 && len(want) == 0 {
+		return
+	}
+	if !reflect.DeepEqual(idList, want) {
+		t.Errorf("Search(%+v) = %v, want %v", q, idList, want)
+	}
+}
+
+func TestFileIndexSearch(t *testing.T) {
+	idx := openFileIndex(t, t.TempDir(), 0)
+	defer idx.Close()
+
+	if err := idx.Index(context.Background(), testDocuments()...); err != nil {
+		t.Fatal(err)
+	}
+
+	checkSearch(t, idx, &Query{UserId: 1, Q: "hello"}, []int32{3, 1})
+	checkSearch(t, idx, &Query{UserId: 1, Q: "hel"}, []int32{3, 1})
+	checkSearch(t, idx, &Query{UserId: 1, Q: "hello wor"}, []int32{1})
+	checkSearch(t, idx, &Query{UserId: 1, Q: "ПРИВЕТ"}, []int32{2})
+	checkSearch(t, idx, &Query{UserId: 1, Q: "hello", OffsetId: 3}, []int32{1})
+	checkSearch(t, idx, &Query{UserId: 1, Q: "hello", Limit: 1}, []int32{3})
+	checkSearch(t, idx, &Query{UserId: 1, Q: "hello", DialogId1: 1, DialogId2: 2}, []int32{1})
+	checkSearch(t, idx, &Query{UserId: 1, Q: "hello", MinDate: 150}, []int32{3})
+	checkSearch(t, idx, &Query{UserId: 1, FromId: 3}, []int32{4, 3})
+	checkSearch(t, idx, &Query{UserId: 1, Q: "?!"}, nil)
+	checkSearch(t, idx, &Query{UserId: 3, Q: "hello"}, nil)
+
+	n, err := idx.Count(context.Background(), &Query{UserId: 1, MediaTypes: []int32{1}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 1 {
+		t.Errorf("Count = %d, want 1", n)
+	}
+}
+
+func TestFileIndexPaging(t *testing.T) {
+	idx := openFileIndex(t, t.TempDir(), 0)
+	defer idx.Close()
+
+	var docs []*Document
+	for id := int32(1); id <= 10; id++ {
+		docs = append(docs, &Document{UserId: 1, MessageId: id, DialogId1: 1, DialogId2: 2, FromId: 1, Date: int64(id), Text: "page"})
+	}
+	if err := idx.Index(context.Background(), docs...); err != nil {
+		t.Fatal(err)
+	}
+
+	checkSearch(t, idx, &Query{UserId: 1, Q: "page", Limit: 3}, []int32{10, 9, 8})
+	checkSearch(t, idx, &Query{UserId: 1, Q: "page", OffsetId: 8, Limit: 3}, []int32{7, 6, 5})
+	checkSearch(t, idx, &Query{UserId: 1, Q: "page", OffsetId: 8, AddOffset: 2, Limit: 3}, []int32{5, 4, 3})
+	checkSearch(t, idx, &Query{UserId: 1, Q: "page", OffsetId: 8, AddOffset: -2, Limit: 4}, []int32{9, 8, 7, 6})
+	checkSearch(t, idx, &Query{UserId: 1, Q: "page", OffsetId: 8, AddOffset: -5, Limit: 4}, []int32{10, 9})
+	checkSearch(t, idx, &Query{UserId: 1, Q: "page", OffsetId: 1, AddOffset: -2, Limit: 4}, []int32{2, 1})
+	checkSearch(t, idx, &Query{UserId: 1, Q: "page", MaxId: 6, MinId: 3, Limit: 10}, []int32{5, 4})
+	checkSearch(t, idx, &Query{UserId: 1, Q: "page", OffsetId: 8, MinId: 5, Limit: 10}, []int32{7, 6})
+}
+
+func TestFileIndexUpdate(t *testing.T) {
+	idx := openFileIndex(t, t.TempDir(), 0)
+	defer idx.Close()
+
+	ctx := context.Background()
+	if err := idx.Index(ctx, testDocuments()...); err != nil {
+		t.Fatal(err)
+	}
+
+	// edit
+	idx.Index(ctx, &Document{UserId: 1, MessageId: 1, DialogId1: 1, DialogId2: 2, FromId: 1, Date: 100, Text: "bye"})
+	checkSearch(t, idx, &Query{UserId: 1, Q: "hello"}, []int32{3})
+	checkSearch(t, idx, &Query{UserId: 1, Q: "bye"}, []int32{1})
+
+	// delete
+	idx.Delete(ctx, 1, 3)
+	checkSearch(t, idx, &Query{UserId: 1, Q: "hello"}, nil)
+	checkSearch(t, idx, &Query{UserId: 2, Q: "hello"}, []int32{1})
+}
+
+func TestFileIndexReopen(t *testing.T) {
+	for _, threshold := range []int{2, 100000} {
+		dir := t.TempDir()
+		ctx := context.Background()
+
+		idx := openFileIndex(t, dir, threshold)
+		idx.Index(ctx, testDocuments()...)
+		idx.Delete(ctx, 1, 1)
+		idx.SetCheckpoint(42)
+		if err := idx.Close(); err != nil {
+			t.Fatal(err)
+		}
+
+		idx = openFileIndex(t, dir, threshold)
+		checkSearch(t, idx, &Query{UserId: 1, Q: "hello"}, []int32{3})
+		checkSearch(t, idx, &Query{UserId: 2, Q: "hello"}, []int32{1})
+		if cp := idx.Checkpoint(); cp != 42 {
+			t.Errorf("threshold %d: Checkpoint = %d, want 42", threshold, cp)
+		}
+		idx.Close()
+	}
+}
+
+func TestFileIndexCompact(t *testing.T) {
+	dir := t.TempDir()
+	ctx := context.Background()
+
+	idx := openFileIndex(t, dir, 3)
+	for id := int32(1); id <= 50; id++ {
+		idx.Index(ctx, &Document{UserId: 1, MessageId: id, DialogId1: 1, DialogId2: 2, FromId: 1, Date: int64(id), Text: "compact"})
+		checkSearch(t, idx, &Query{UserId: 1, Q: "compact", Limit: 1}, []int32{id})
+	}
+	idx.Delete(ctx, 1, 50)
+	if err := idx.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	idx = openFileIndex(t, dir, 3)
+	defer idx.Close()
+	checkSearch(t, idx, &Query{UserId: 1, Q: "compact", Limit: 2}, []int32{49, 48})
+	if n, _ := idx.Count(ctx, &Query{UserId: 1, Q: "compact"}); n != 49 {
+		t.Errorf("Count = %d, want 49", n)
+	}
+}
+
+func TestFileIndexOldLog(t *testing.T) {
+	dir := t.TempDir()
+	ctx := context.Background()
+
+	idx := openFileIndex(t, dir, 100000)
+	idx.Index(ctx, testDocuments()...)
+	idx.Close()
+
+	// a crash in the middle of a compaction
+	if err := os.Rename(filepath.Join(dir, fileIndexLog), filepath.Join(dir, fileIndexOldLog)); err != nil {
+		t.Fatal(err)
+	}
+
+	idx = openFileIndex(t, dir, 100000)
+	defer idx.Close()
+	checkSearch(t, idx, &Query{UserId: 1, Q: "hello"}, []int32{3, 1})
+	if _, err := os.Stat(filepath.Join(dir, fileIndexOldLog)); !os.IsNotExist(err) {
+		t.Errorf("%s left behind - %v", fileIndexOldLog, err)
+	}
+}
+
+func TestFileIndexMaxUserDocs(t *testing.T) {
+	idx := openFileIndexConfig(t, Config{Backend: BackendFile, Path: t.TempDir(), MaxUserDocs: 10})
+	defer idx.Close()
+
+	var docs []*Document
+	for id := int32(1); id <= 11; id++ {
+		docs = append(docs, &Document{UserId: 1, MessageId: id, DialogId1: 1, DialogId2: 2, FromId: 1, Date: int64(id), Text: "bound"})
+	}
+	if err := idx.Index(context.Background(), docs...); err != nil {
+		t.Fatal(err)
+	}
+
+	// the two oldest are evicted
+	if n, _ := idx.Count(context.Background(), &Query{UserId: 1, Q: "bound"}); n != 9 {
+		t.Errorf("Count = %d, want 9", n)
+	}
+	checkSearch(t, idx, &Query{UserId: 1, Q: "bound", OffsetId: 4}, []int32{3})
+}
@@ -0,0 +1,147 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package search
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+const (
+	BackendNone = "none"
+	BackendFile = "file"
+)
+
+// Document is the indexed view of one row of the per-user messages box.
+type Document struct {
+	UserId    int64  `json:"user_id"`
+	MessageId int32  `json:"message_id"`
+	DialogId1 int64  `json:"dialog_id1"`
+	DialogId2 int64  `json:"dialog_id2"`
+	FromId    int64  `json:"from_id"`
+	MediaType int32  `json:"media_type"`
+	Date      int64  `json:"date"`
+	Text      string `json:"text,omitempty"`
+}
+
+// DeletedMessages lists message box ids of one user that must be dropped from the index.
+type DeletedMessages struct {
+	UserId int64   `json:"user_id"`
+	IdList []int32 `json:"id_list"`
+}
+
+// IndexUpdates is a batch of changes published by the messages write path.
+type IndexUpdates struct {
+	Documents []*Document        `json:"documents,omitempty"`
+	Deleted   []*DeletedMessages `json:"deleted,omitempty"`
+}
+
+// Query
+// All terms of Q must match, the last one is matched as a prefix.
+// Zero values of the other fields disable the corresponding filter.
+//
+// OffsetId, AddOffset, MaxId and MinId page the results the same way as
+// messages.getHistory: the page starts AddOffset results after the first id
+// lower than OffsetId, a negative AddOffset moves it back to newer ids.
+// MaxId and MinId are exclusive bounds.
+type Query struct {
+	UserId     int64
+	DialogId1  int64
+	DialogId2  int64
+	FromId     int64
+	MediaTypes []int32
+	Q          string
+	MinDate    int64
+	MaxDate    int64
+	OffsetId   int32
+	AddOffset  int32
+	MaxId      int32
+	MinId      int32
+	Limit      int32
+}
+
+// Index is implemented by every search backend.
+//
+// Search returns matching message ids newest first, Checkpoint/SetCheckpoint
+// persist the source row id the backfill has reached. Results are not ranked
+// by relevance: clients page by message id, so any other order would break
+// offset_id paging.
+type Index interface {
+	Index(ctx context.Context, docs ...*Document) error
+	Delete(ctx context.Context, userId int64, idList ...int32) error
+	Search(ctx context.Context, q *Query) ([]int32, error)
+	Count(ctx context.Context, q *Query) (int32, error)
+	Checkpoint() int64
+	SetCheckpoint(id int64) error
+	Close() error
+}
+
+type Config struct {
+	Backend          string `json:",default=file,options=none|file"`
+	Path             string `json:",optional"`
+	CompactThreshold int    `json:",default=100000"`
+	MaxUserDocs      int    `json:",default=100000"`
+}
+
+type NewIndexFunc func(c Config) (Index, error)
+
+var (
+	backendsMu sync.RWMutex
+	backends   = map[string]NewIndexFunc{}
+)
+
+// Register makes a backend available by name, it's called from init() of the backend.
+func Register(name string, f NewIndexFunc) {
+	backendsMu.Lock()
+	defer backendsMu.Unlock()
+
+	if f == nil {
+		panic("search: Register backend is nil")
+	}
+	if _, dup := backends[name]; dup {
+		panic("search: Register called twice for backend " + name)
+	}
+	backends[name] = f
+}
+
+// New opens the configured backend, it returns nil if search is disabled.
+func New(c Config) (Index, error) {
+	if c.Backend == "" || c.Backend == BackendNone {
+		return nil, nil
+	}
+
+	backendsMu.RLock()
+	f, ok := backends[c.Backend]
+	backendsMu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("search: unknown backend %q", c.Backend)
+	}
+
+	return f(c)
+}
+
+// MustNew is like New but exits on error.
+func MustNew(c Config) Index {
+	idx, err := New(c)
+	logx.Must(err)
+	return idx
+}
@@ -0,0 +1,128 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package search
+
+import (
+	"unicode"
+)
+
+const (
+	maxTokenLen = 64
+)
+
+// isIdeographic reports whether r belongs to a script written without spaces
+// between words, such runs are split into n-grams instead of words.
+func isIdeographic(r rune) bool {
+	return unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana)
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || unicode.IsMark(r)
+}
+
+func foldRune(r rune) rune {
+	r = unicode.ToLower(r)
+	switch r {
+	case 'ё':
+		return 'е'
+	}
+	return r
+}
+
+// Tokenize splits text into the terms stored in the index.
+//
+// Latin, Cyrillic and other space separated scripts yield lower-cased words,
+// ideographic runs yield unigrams plus overlapping bigrams. Duplicates are removed.
+func Tokenize(text string) []string {
+	var (
+		tokens = tokenize(text, true)
+		seen   = make(map[string]struct{}, len(tokens))
+		terms  = make([]string, 0, len(tokens))
+	)
+
+	for _, t := range tokens {
+		if _, ok := seen[t]; ok {
+			continue
+		}
+		seen[t] = struct{}{}
+		terms = append(terms, t)
+	}
+
+	return terms
+}
+
+// TokenizeQuery splits a search query keeping the order of the terms,
+// ideographic runs longer than one rune are matched by their bigrams only.
+func TokenizeQuery(q string) []string {
+	return tokenize(q, false)
+}
+
+func tokenize(text string, forIndex bool) []string {
+	var (
+		tokens []string
+		word   []rune
+		ideo   []rune
+	)
+
+	flushWord := func() {
+		if len(word) == 0 {
+			return
+		}
+		if len(word) > maxTokenLen {
+			word = word[:maxTokenLen]
+		}
+		tokens = append(tokens, string(word))
+		word = word[:0]
+	}
+
+	flushIdeo := func() {
+		switch {
+		case len(ideo) == 1:
+			tokens = append(tokens, string(ideo))
+		case len(ideo) > 1:
+			if forIndex {
+				for _, r := range ideo {
+					tokens = append(tokens, string(r))
+				}
+			}
+			for i := 0; i+1 < len(ideo); i++ {
+				tokens = append(tokens, string(ideo[i:i+2]))
+			}
+		}
+		ideo = ideo[:0]
+	}
+
+	for _, r := range text {
+		switch {
+		case isIdeographic(r):
+			flushWord()
+			ideo = append(ideo, r)
+		case isWordRune(r):
+			flushIdeo()
+			word = append(word, foldRune(r))
+		default:
+			flushWord()
+			flushIdeo()
+		}
+	}
+	flushWord()
+	flushIdeo()
+
+	return tokens
+}
@@ -0,0 +1,61 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package search
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTokenize(t *testing.T) {
+	cases := []struct {
+		text  string
+		terms []string
+	}{
+		{"Hello, World! hello", []string{"hello", "world"}},
+		{"Привет, Ёлка", []string{"привет", "елка"}},
+		{"我爱你", []string{"我", "爱", "你", "我爱", "爱你"}},
+		{"go1.17 テスト", []string{"go1", "17", "テ", "ス", "ト", "テス", "スト"}},
+		{"  ...  ", []string{}},
+	}
+
+	for _, c := range cases {
+		if terms := Tokenize(c.text); !reflect.DeepEqual(terms, c.terms) {
+			t.Errorf("Tokenize(%q) = %v, want %v", c.text, terms, c.terms)
+		}
+	}
+}
+
+func TestTokenizeQuery(t *testing.T) {
+	cases := []struct {
+		q     string
+		terms []string
+	}{
+		{"World hello", []string{"world", "hello"}},
+		{"爱你", []string{"爱你"}},
+		{"爱", []string{"爱"}},
+		{"", nil},
+	}
+
+	for _, c := range cases {
+		if terms := TokenizeQuery(c.q); !reflect.DeepEqual(terms, c.terms) {
+			t.Errorf("TokenizeQuery(%q) = %v, want %v", c.q, terms, c.terms)
+		}
+	}
+}
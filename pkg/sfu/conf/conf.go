@@ -0,0 +1,36 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package conf
+
+type SfuConfig struct {
+	// Name of the adapter of the media plane, none accepts every call without relaying any media
+	Name string      `json:",default=none,options=none|http"`
+	Http *HttpConfig `json:",optional"`
+}
+
+// HttpConfig is a SFU driven by a HTTP/JSON api.
+//
+// every operation is a POST of a json body to Url/<operation>, e.g. Url/join,
+// the response of join is the json transport params returned to the client,
+// the response of stream is the chunk of the broadcast.
+type HttpConfig struct {
+	Url     string
+	Headers map[string]string `json:",optional"`
+	Timeout int               `json:",default=5"` // seconds
+}
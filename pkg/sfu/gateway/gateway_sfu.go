@@ -0,0 +1,153 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package gateway
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/teamgram/teamgram-server/pkg/sfu/conf"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+const (
+	maxResponseSize = 4 * 1024 * 1024 // a chunk of the broadcast
+)
+
+type request struct {
+	CallId int64  `json:"call_id"`
+	UserId int64  `json:"user_id,omitempty"`
+	Source int32  `json:"source,omitempty"`
+	Params string `json:"params,omitempty"`
+	Muted  bool   `json:"muted,omitempty"`
+	TimeMs int64  `json:"time_ms,omitempty"`
+	Scale  int32  `json:"scale,omitempty"`
+}
+
+// New creates an adapter for a SFU driven by a HTTP/JSON api.
+func New(c *conf.SfuConfig) *gatewaySfu {
+	if c.Http == nil || c.Http.Url == "" {
+		logx.Must(errors.New("sfu: http adapter requires Sfu.Http.Url"))
+	}
+
+	return &gatewaySfu{
+		http: c.Http,
+		url:  strings.TrimSuffix(c.Http.Url, "/"),
+		cli: &http.Client{
+			Timeout: time.Duration(c.Http.Timeout) * time.Second,
+		},
+	}
+}
+
+type gatewaySfu struct {
+	http *conf.HttpConfig
+	url  string
+	cli  *http.Client
+}
+
+func (m *gatewaySfu) CreateGroupCall(ctx context.Context, callId int64) error {
+	_, err := m.do(ctx, "create", &request{CallId: callId})
+	return err
+}
+
+func (m *gatewaySfu) JoinGroupCall(ctx context.Context, callId, userId int64, source int32, params string) (string, error) {
+	rBody, err := m.do(ctx, "join", &request{
+		CallId: callId,
+		UserId: userId,
+		Source: source,
+		Params: params,
+	})
+	if err != nil {
+		return "", err
+	}
+	if !json.Valid(rBody) {
+		return "", fmt.Errorf("sfu invalid params: %s", rBody)
+	}
+
+	return string(rBody), nil
+}
+
+func (m *gatewaySfu) LeaveGroupCall(ctx context.Context, callId, userId int64, source int32) error {
+	_, err := m.do(ctx, "leave", &request{
+		CallId: callId,
+		UserId: userId,
+		Source: source,
+	})
+	return err
+}
+
+func (m *gatewaySfu) MuteGroupCallParticipant(ctx context.Context, callId, userId int64, muted bool) error {
+	_, err := m.do(ctx, "mute", &request{
+		CallId: callId,
+		UserId: userId,
+		Muted:  muted,
+	})
+	return err
+}
+
+func (m *gatewaySfu) DiscardGroupCall(ctx context.Context, callId int64) error {
+	_, err := m.do(ctx, "discard", &request{CallId: callId})
+	return err
+}
+
+func (m *gatewaySfu) GetStreamFile(ctx context.Context, callId, timeMs int64, scale int32) ([]byte, error) {
+	return m.do(ctx, "stream", &request{
+		CallId: callId,
+		TimeMs: timeMs,
+		Scale:  scale,
+	})
+}
+
+func (m *gatewaySfu) do(ctx context.Context, op string, r *request) ([]byte, error) {
+	body, _ := json.Marshal(r)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, m.url+"/"+op, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range m.http.Headers {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := m.cli.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	rBody, err := ioutil.ReadAll(io.LimitReader(resp.Body, maxResponseSize))
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, fmt.Errorf("sfu %s status %d: %s", op, resp.StatusCode, rBody)
+	}
+
+	return rBody, nil
+}
@@ -0,0 +1,75 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package gateway
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/teamgram/teamgram-server/pkg/sfu/conf"
+)
+
+func TestGatewaySfu(t *testing.T) {
+	var (
+		path string
+		req  request
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.Path
+		body, _ := ioutil.ReadAll(r.Body)
+		json.Unmarshal(body, &req)
+		switch path {
+		case "/sfu/join":
+			w.Write([]byte(`{"transport":{"ufrag":"x"}}`))
+		case "/sfu/stream":
+			w.Write([]byte("chunk"))
+		case "/sfu/discard":
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	m := New(&conf.SfuConfig{
+		Name: "http",
+		Http: &conf.HttpConfig{Url: srv.URL + "/sfu/", Timeout: 5},
+	})
+
+	params, err := m.JoinGroupCall(context.Background(), 1, 2, 3, `{"ssrc":3}`)
+	if err != nil || params != `{"transport":{"ufrag":"x"}}` {
+		t.Fatalf("JoinGroupCall() = %s, %v", params, err)
+	}
+	if path != "/sfu/join" || req.CallId != 1 || req.UserId != 2 || req.Source != 3 || req.Params != `{"ssrc":3}` {
+		t.Errorf("JoinGroupCall() sent %s %+v", path, req)
+	}
+
+	if data, err := m.GetStreamFile(context.Background(), 1, 1000, 1); err != nil || string(data) != "chunk" {
+		t.Errorf("GetStreamFile() = %s, %v", data, err)
+	}
+	if req.TimeMs != 1000 || req.Scale != 1 {
+		t.Errorf("GetStreamFile() sent %+v", req)
+	}
+
+	if err = m.DiscardGroupCall(context.Background(), 1); err == nil {
+		t.Errorf("DiscardGroupCall() = nil, want status error")
+	}
+}
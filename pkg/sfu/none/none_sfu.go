@@ -0,0 +1,66 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package none
+
+import (
+	"context"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/pkg/sfu/conf"
+)
+
+const (
+	// emptyParams is a transport without any candidate, for test environments only.
+	emptyParams = `{"transport":{"ufrag":"","pwd":"","fingerprints":[],"candidates":[]}}`
+)
+
+func New(c *conf.SfuConfig) *noneSfu {
+	return &noneSfu{
+		c: c,
+	}
+}
+
+// noneSfu accepts every operation and relays no media.
+type noneSfu struct {
+	c *conf.SfuConfig
+}
+
+func (m *noneSfu) CreateGroupCall(ctx context.Context, callId int64) error {
+	return nil
+}
+
+func (m *noneSfu) JoinGroupCall(ctx context.Context, callId, userId int64, source int32, params string) (string, error) {
+	return emptyParams, nil
+}
+
+func (m *noneSfu) LeaveGroupCall(ctx context.Context, callId, userId int64, source int32) error {
+	return nil
+}
+
+func (m *noneSfu) MuteGroupCallParticipant(ctx context.Context, callId, userId int64, muted bool) error {
+	return nil
+}
+
+func (m *noneSfu) DiscardGroupCall(ctx context.Context, callId int64) error {
+	return nil
+}
+
+func (m *noneSfu) GetStreamFile(ctx context.Context, callId, timeMs int64, scale int32) ([]byte, error) {
+	return nil, mtproto.ErrGroupCallInvalid
+}
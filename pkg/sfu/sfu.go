@@ -0,0 +1,55 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package sfu
+
+import (
+	"context"
+
+	"github.com/teamgram/teamgram-server/pkg/sfu/conf"
+	"github.com/teamgram/teamgram-server/pkg/sfu/gateway"
+	"github.com/teamgram/teamgram-server/pkg/sfu/none"
+)
+
+// Adapter is the media plane of the group calls, the signaling is done by bff.groupcalls.
+//
+// source is the ssrc of the audio of a participant, params are the webrtc transport
+// params of phone.joinGroupCall and of updateGroupCallConnection.
+type Adapter interface {
+	CreateGroupCall(ctx context.Context, callId int64) error
+	JoinGroupCall(ctx context.Context, callId, userId int64, source int32, params string) (string, error)
+	LeaveGroupCall(ctx context.Context, callId, userId int64, source int32) error
+	MuteGroupCallParticipant(ctx context.Context, callId, userId int64, muted bool) error
+	DiscardGroupCall(ctx context.Context, callId int64) error
+	// GetStreamFile returns the chunk of the broadcast starting at timeMs, it lasts 1000>>scale ms.
+	GetStreamFile(ctx context.Context, callId, timeMs int64, scale int32) ([]byte, error)
+}
+
+func New(c *conf.SfuConfig) Adapter {
+	if c == nil {
+		c = new(conf.SfuConfig)
+	}
+
+	switch c.Name {
+	case "none":
+		return none.New(c)
+	case "http":
+		return gateway.New(c)
+	}
+	return none.New(c)
+}
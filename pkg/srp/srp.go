@@ -0,0 +1,178 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+// Package srp is the server side of the SRP-2048 two-step verification,
+// see https://core.telegram.org/api/srp
+package srp
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"math/big"
+)
+
+const (
+	// G the generator of passwordKdfAlgoSHA256SHA256PBKDF2HMACSHA512iter100000SHA256ModPow
+	G = 3
+
+	// SizeBytes srp_B, A, M1 and the verifier are padded to 256 bytes
+	SizeBytes = 256
+)
+
+var (
+	ErrInvalidA        = errors.New("srp: invalid A")
+	ErrInvalidVerifier = errors.New("srp: invalid verifier")
+)
+
+var (
+	// P the 2048-bit safe prime also used by the official servers
+	P = mustHex("c71caeb9c6b1c9048e6c522f70f13f73980d40238e3e21c14934d037563d930f" +
+		"48198a0aa7c14058229493d22530f4dbfa336f6e0ac925139543aed44cce7c37" +
+		"20fd51f69458705ac68cd4fe6b6b13abdc9746512969328454f18faf8c595f64" +
+		"2477fe96bb2a941d5bcd1d4ac8cc49880708fa9b378e3c4f3a9060bee67cf9a4" +
+		"a4a695811051907e162753b56b0f6b410dba74d8a84b2a14b3144e0ef1284754" +
+		"fd17ed950d5965b4b9dd46582db1178d169c6bc465b0d6ff9ca3928fef5b9ae4" +
+		"e418fc15e83ebea0f87fa9ff5eed70050ded2849f47bf959d956850ce929851f" +
+		"0d8115f635b105ee2e4e15d04b2454bf6f4fadf034b10403119cd8e3b92fcc5b")
+
+	p = new(big.Int).SetBytes(P)
+	g = big.NewInt(G)
+)
+
+func mustHex(s string) []byte {
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+func h(data ...[]byte) []byte {
+	s := sha256.New()
+	for _, v := range data {
+		s.Write(v)
+	}
+	return s.Sum(nil)
+}
+
+// Pad left pads b with zeros to SizeBytes.
+func Pad(b []byte) []byte {
+	if len(b) >= SizeBytes {
+		return b
+	}
+	r := make([]byte, SizeBytes)
+	copy(r[SizeBytes-len(b):], b)
+	return r
+}
+
+func padInt(i *big.Int) []byte {
+	return Pad(i.Bytes())
+}
+
+// checkGA 1 < ga < p-1
+func checkGA(ga *big.Int) bool {
+	if ga.Cmp(big.NewInt(1)) <= 0 {
+		return false
+	}
+	return ga.Cmp(new(big.Int).Sub(p, big.NewInt(1))) < 0
+}
+
+// CheckVerifier checks the new_password_hash sent by account.updatePasswordSettings,
+// it's the verifier v = g^x mod p computed by the client.
+func CheckVerifier(v []byte) error {
+	if len(v) == 0 || len(v) > SizeBytes {
+		return ErrInvalidVerifier
+	}
+	if !checkGA(new(big.Int).SetBytes(v)) {
+		return ErrInvalidVerifier
+	}
+	return nil
+}
+
+// RandomBytes returns n bytes from crypto/rand.
+func RandomBytes(n int) []byte {
+	b := make([]byte, n)
+	rand.Read(b)
+	return b
+}
+
+// k = H(p | g)
+func k() *big.Int {
+	return new(big.Int).SetBytes(h(P, padInt(g)))
+}
+
+// MakeB returns the server secret b and srp_B = (k*v + g^b) mod p.
+func MakeB(v []byte) (b, B []byte) {
+	vInt := new(big.Int).SetBytes(v)
+	for {
+		b = RandomBytes(SizeBytes)
+		bInt := new(big.Int).SetBytes(b)
+		BInt := new(big.Int).Mul(k(), vInt)
+		BInt.Add(BInt, new(big.Int).Exp(g, bInt, p))
+		BInt.Mod(BInt, p)
+		if checkGA(BInt) {
+			return b, padInt(BInt)
+		}
+	}
+}
+
+// CheckM1 checks the InputCheckPasswordSRP sent by the client:
+//
+//	u = H(A | B)
+//	S = (A * v^u) ^ b mod p
+//	K = H(S)
+//	M1 = H(H(p) xor H(g) | H(salt1) | H(salt2) | A | B | K)
+func CheckM1(salt1, salt2, v, b, A, M1 []byte) (bool, error) {
+	AInt := new(big.Int).SetBytes(A)
+	if len(A) > SizeBytes || !checkGA(AInt) {
+		return false, ErrInvalidA
+	}
+
+	var (
+		vInt = new(big.Int).SetBytes(v)
+		bInt = new(big.Int).SetBytes(b)
+		BInt = new(big.Int).Mul(k(), vInt)
+	)
+	BInt.Add(BInt, new(big.Int).Exp(g, bInt, p))
+	BInt.Mod(BInt, p)
+
+	var (
+		aPad = padInt(AInt)
+		bPad = padInt(BInt)
+		u    = new(big.Int).SetBytes(h(aPad, bPad))
+	)
+	if u.Sign() == 0 {
+		return false, ErrInvalidA
+	}
+
+	S := new(big.Int).Exp(vInt, u, p)
+	S.Mul(S, AInt)
+	S.Exp(S, bInt, p)
+	K := h(padInt(S))
+
+	hp := h(P)
+	hg := h(padInt(g))
+	for i := range hp {
+		hp[i] ^= hg[i]
+	}
+
+	return subtle.ConstantTimeCompare(M1, h(hp, h(salt1), h(salt2), aPad, bPad, K)) == 1, nil
+}
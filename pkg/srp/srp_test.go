@@ -0,0 +1,113 @@
+// Copyright 2022 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package srp
+
+import (
+	"crypto/sha512"
+	"math/big"
+	"testing"
+
+	"golang.org/x/crypto/pbkdf2"
+)
+
+// the client side, as done by the official apps
+
+func sh(data, salt []byte) []byte {
+	return h(salt, data, salt)
+}
+
+func clientX(password string, salt1, salt2 []byte) *big.Int {
+	ph1 := sh(sh([]byte(password), salt1), salt2)
+	ph2 := sh(pbkdf2.Key(ph1, salt1, 100000, 64, sha512.New), salt2)
+	return new(big.Int).SetBytes(ph2)
+}
+
+func clientVerifier(password string, salt1, salt2 []byte) []byte {
+	return padInt(new(big.Int).Exp(g, clientX(password, salt1, salt2), p))
+}
+
+func clientCheck(password string, salt1, salt2, B []byte) (A, M1 []byte) {
+	var (
+		x    = clientX(password, salt1, salt2)
+		a    = new(big.Int).SetBytes(RandomBytes(SizeBytes))
+		AInt = new(big.Int).Exp(g, a, p)
+		BInt = new(big.Int).SetBytes(B)
+	)
+	A = padInt(AInt)
+	u := new(big.Int).SetBytes(h(A, Pad(B)))
+
+	// S = (B - k*g^x) ^ (a + u*x) mod p
+	kgx := new(big.Int).Mul(k(), new(big.Int).Exp(g, x, p))
+	base := new(big.Int).Sub(BInt, kgx)
+	base.Mod(base, p)
+	exp := new(big.Int).Mul(u, x)
+	exp.Add(exp, a)
+	S := new(big.Int).Exp(base, exp, p)
+	K := h(padInt(S))
+
+	hp := h(P)
+	hg := h(padInt(g))
+	for i := range hp {
+		hp[i] ^= hg[i]
+	}
+	M1 = h(hp, h(salt1), h(salt2), A, Pad(B), K)
+	return
+}
+
+func TestPrime(t *testing.T) {
+	if p.BitLen() != 2048 || !p.ProbablyPrime(20) {
+		t.Fatal("p is not a 2048-bit prime")
+	}
+	q := new(big.Int).Rsh(p, 1)
+	if !q.ProbablyPrime(20) {
+		t.Fatal("(p-1)/2 is not a prime")
+	}
+	// g = 3 generates a cyclic subgroup of prime order (p-1)/2 if p mod 3 = 2
+	if new(big.Int).Mod(p, big.NewInt(3)).Int64() != 2 {
+		t.Fatal("p mod 3 != 2")
+	}
+}
+
+func TestCheckM1(t *testing.T) {
+	var (
+		salt1 = append(RandomBytes(8), RandomBytes(32)...)
+		salt2 = RandomBytes(16)
+		v     = clientVerifier("teamgram", salt1, salt2)
+	)
+
+	if err := CheckVerifier(v); err != nil {
+		t.Fatal(err)
+	}
+
+	b, B := MakeB(v)
+	A, M1 := clientCheck("teamgram", salt1, salt2, B)
+	if ok, err := CheckM1(salt1, salt2, v, b, A, M1); err != nil || !ok {
+		t.Fatalf("right password: ok = %v, err = %v", ok, err)
+	}
+
+	b, B = MakeB(v)
+	A, M1 = clientCheck("teamgram2", salt1, salt2, B)
+	if ok, err := CheckM1(salt1, salt2, v, b, A, M1); err != nil || ok {
+		t.Fatalf("wrong password: ok = %v, err = %v", ok, err)
+	}
+
+	if _, err := CheckM1(salt1, salt2, v, b, []byte{1}, M1); err != ErrInvalidA {
+		t.Fatalf("A = 1: err = %v", err)
+	}
+}
@@ -20,6 +20,18 @@ echo "build media ..."
 cd ${TEAMGRAMAPP}/service/media/cmd/media
 go build -o ${INSTALL}/bin/media
 
+echo "build poll ..."
+cd ${TEAMGRAMAPP}/service/poll/cmd/poll
+go build -o ${INSTALL}/bin/poll
+
+echo "build secretchat ..."
+cd ${TEAMGRAMAPP}/service/secretchat/cmd/secretchat
+go build -o ${INSTALL}/bin/secretchat
+
+echo "build sticker ..."
+cd ${TEAMGRAMAPP}/service/sticker/cmd/sticker
+go build -o ${INSTALL}/bin/sticker
+
 echo "build authsession ..."
 cd ${TEAMGRAMAPP}/service/authsession/cmd/authsession
 go build -o ${INSTALL}/bin/authsession
@@ -36,10 +48,22 @@ echo "build sync ..."
 cd ${TEAMGRAMAPP}/messenger/sync/cmd/sync
 go build -o ${INSTALL}/bin/sync
 
+echo "build push ..."
+cd ${TEAMGRAMAPP}/messenger/push/cmd/push
+go build -o ${INSTALL}/bin/push
+
 echo "build bff ..."
 cd ${TEAMGRAMAPP}/bff/bff/cmd/bff
 go build -o ${INSTALL}/bin/bff
 
+echo "build cdn ..."
+cd ${TEAMGRAMAPP}/interface/cdn/cmd/cdn
+go build -o ${INSTALL}/bin/cdn
+
+echo "build botapi ..."
+cd ${TEAMGRAMAPP}/interface/botapi/cmd/botapi
+go build -o ${INSTALL}/bin/botapi
+
 echo "build session ..."
 cd ${TEAMGRAMAPP}/interface/session/cmd/session
 go build -o ${INSTALL}/bin/session
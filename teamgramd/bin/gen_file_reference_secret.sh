@@ -0,0 +1,43 @@
+#!/usr/bin/env bash
+
+# Sets FileReference.Secret in the given configs where it is empty. The secret already set in one
+# of them is reused, else a random one is generated, so that bff and sync sign the same references.
+#
+#   ./gen_file_reference_secret.sh ../etc/bff.yaml ../etc/sync.yaml
+
+if [ $# -eq 0 ]; then
+  echo "usage: $0 config.yaml ..."
+  exit 1
+fi
+
+get_secret() {
+  sed -n '/^FileReference:/,/^[^ #]/ s/^  Secret: *"\(.*\)"$/\1/p' "$1"
+}
+
+secret=""
+for f in "$@"; do
+  s=$(get_secret "$f")
+  if [ -n "$s" ]; then
+    secret=$s
+    break
+  fi
+done
+
+if [ -z "$secret" ]; then
+  if command -v openssl > /dev/null 2>&1; then
+    secret=$(openssl rand -hex 32)
+  else
+    secret=$(head -c 32 /dev/urandom | od -An -tx1 | tr -d ' \n')
+  fi
+  if [ ${#secret} -lt 32 ]; then
+    echo "generate FileReference.Secret failed"
+    exit 1
+  fi
+fi
+
+for f in "$@"; do
+  if grep -q '^FileReference:' "$f" && [ -z "$(get_secret "$f")" ]; then
+    echo "set FileReference.Secret in $f ..."
+    sed -i.bak '/^FileReference:/,/^[^ #]/ s/^  Secret: *""$/  Secret: "'"$secret"'"/' "$f" && rm -f "$f.bak"
+  fi
+done
@@ -1,4 +1,4 @@
 #!/usr/bin/env bash
 
-killall gateway session biz authsession status idgen media dfs msg sync bff
+killall gateway session biz authsession status idgen media poll secretchat sticker dfs msg sync push bff botapi
 
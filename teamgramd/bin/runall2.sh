@@ -1,5 +1,8 @@
 #!/usr/bin/env bash
 
+# bff and sync don't start without FileReference.Secret, a random one is set on the first run
+./gen_file_reference_secret.sh ../etc/bff.yaml ../etc/sync.yaml || exit 1
+
 echo "run idgen ..."
 nohup ./idgen -f=../etc/idgen.yaml >> ../logs/idgen.log  2>&1 &
 sleep 1
@@ -20,6 +23,18 @@ echo "run media ..."
 nohup ./media -f=../etc/media.yaml >> ../logs/media.log  2>&1 &
 sleep 1
 
+echo "run poll ..."
+nohup ./poll -f=../etc/poll.yaml >> ../logs/poll.log  2>&1 &
+sleep 1
+
+echo "run secretchat ..."
+nohup ./secretchat -f=../etc/secretchat.yaml >> ../logs/secretchat.log  2>&1 &
+sleep 1
+
+echo "run sticker ..."
+nohup ./sticker -f=../etc/sticker.yaml >> ../logs/sticker.log  2>&1 &
+sleep 1
+
 echo "run biz ..."
 nohup ./biz -f=../etc/biz.yaml >> ../logs/biz.log  2>&1 &
 sleep 1
@@ -32,10 +47,18 @@ echo "run sync ..."
 nohup ./sync -f=../etc/sync.yaml >> ../logs/sync.log  2>&1 &
 sleep 1
 
+echo "run push ..."
+nohup ./push -f=../etc/push.yaml >> ../logs/push.log  2>&1 &
+sleep 1
+
 echo "run bff ..."
 nohup ./bff -f=../etc/bff.yaml >> ../logs/bff.log  2>&1 &
 sleep 5
 
+echo "run botapi ..."
+nohup ./botapi -f=../etc/botapi.yaml >> ../logs/botapi.log  2>&1 &
+sleep 1
+
 echo "run session ..."
 nohup ./session -f=../etc/session.yaml >> ../logs/session.log  2>&1 &
 sleep 1
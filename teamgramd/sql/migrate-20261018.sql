@@ -0,0 +1,292 @@
+CREATE TABLE `channels` (
+  `id` bigint(20) NOT NULL AUTO_INCREMENT,
+  `creator_user_id` bigint(20) NOT NULL,
+  `access_hash` bigint(20) NOT NULL,
+  `random_id` bigint(20) NOT NULL DEFAULT '0',
+  `broadcast` tinyint(1) NOT NULL DEFAULT '0',
+  `megagroup` tinyint(1) NOT NULL DEFAULT '0',
+  `signatures` tinyint(1) NOT NULL DEFAULT '0',
+  `noforwards` tinyint(1) NOT NULL DEFAULT '0',
+  `title` varchar(255) COLLATE utf8mb4_unicode_ci NOT NULL DEFAULT '',
+  `about` varchar(255) COLLATE utf8mb4_unicode_ci NOT NULL DEFAULT '',
+  `photo_id` bigint(20) NOT NULL DEFAULT '0',
+  `username` varchar(64) COLLATE utf8mb4_unicode_ci NOT NULL DEFAULT '',
+  `participants_count` int(11) NOT NULL DEFAULT '0',
+  `default_banned_rights` bigint(20) NOT NULL DEFAULT '0',
+  `pts` int(11) NOT NULL DEFAULT '0',
+  `top_message` int(11) NOT NULL DEFAULT '0',
+  `deactivated` tinyint(1) NOT NULL DEFAULT '0',
+  `hidden_prehistory` tinyint(1) NOT NULL DEFAULT '0',
+  `slowmode_seconds` int(11) NOT NULL DEFAULT '0',
+  `version` int(11) NOT NULL DEFAULT '1',
+  `date2` bigint(20) NOT NULL DEFAULT '0',
+  `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  KEY `creator_user_id` (`creator_user_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+CREATE TABLE `channel_participants` (
+  `id` bigint(20) NOT NULL AUTO_INCREMENT,
+  `channel_id` bigint(20) NOT NULL,
+  `user_id` bigint(20) NOT NULL,
+  `participant_type` int(11) NOT NULL DEFAULT '0',
+  `state` int(11) NOT NULL DEFAULT '0',
+  `inviter_user_id` bigint(20) NOT NULL DEFAULT '0',
+  `admin_rights` int(11) NOT NULL DEFAULT '0',
+  `banned_rights` bigint(20) NOT NULL DEFAULT '0',
+  `rank` varchar(64) COLLATE utf8mb4_unicode_ci NOT NULL DEFAULT '',
+  `promoted_by` bigint(20) NOT NULL DEFAULT '0',
+  `kicked_by` bigint(20) NOT NULL DEFAULT '0',
+  `read_inbox_max_id` int(11) NOT NULL DEFAULT '0',
+  `available_min_id` int(11) NOT NULL DEFAULT '0',
+  `date2` bigint(20) NOT NULL DEFAULT '0',
+  `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `channel_id_2` (`channel_id`,`user_id`),
+  KEY `user_id` (`user_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+CREATE TABLE `channel_messages` (
+  `id` bigint(20) NOT NULL AUTO_INCREMENT,
+  `channel_id` bigint(20) NOT NULL,
+  `channel_message_id` int(11) NOT NULL,
+  `sender_user_id` bigint(20) NOT NULL,
+  `random_id` bigint(20) NOT NULL DEFAULT '0',
+  `pts` int(11) NOT NULL DEFAULT '0',
+  `message_filter_type` int(11) NOT NULL DEFAULT '0',
+  `message_data` json NOT NULL,
+  `message` text COLLATE utf8mb4_unicode_ci NOT NULL,
+  `views` int(11) NOT NULL DEFAULT '0',
+  `date2` bigint(20) NOT NULL DEFAULT '0',
+  `deleted` tinyint(1) NOT NULL DEFAULT '0',
+  `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `channel_id` (`channel_id`,`channel_message_id`),
+  UNIQUE KEY `sender_user_id` (`sender_user_id`,`random_id`,`channel_id`),
+  KEY `channel_id_pts` (`channel_id`,`pts`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+CREATE TABLE `scheduled_messages` (
+  `id` bigint(20) NOT NULL AUTO_INCREMENT,
+  `user_id` bigint(20) NOT NULL,
+  `peer_type` int(11) NOT NULL,
+  `peer_id` bigint(20) NOT NULL,
+  `scheduled_message_id` int(11) NOT NULL,
+  `random_id` bigint(20) NOT NULL DEFAULT '0',
+  `no_webpage` tinyint(1) NOT NULL DEFAULT '0',
+  `background` tinyint(1) NOT NULL DEFAULT '0',
+  `message_data` json NOT NULL,
+  `schedule_date` bigint(20) NOT NULL,
+  `state` int(11) NOT NULL DEFAULT '0',
+  `date2` bigint(20) NOT NULL DEFAULT '0',
+  `deleted` tinyint(1) NOT NULL DEFAULT '0',
+  `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `user_id` (`user_id`,`scheduled_message_id`),
+  KEY `user_id_2` (`user_id`,`peer_type`,`peer_id`),
+  KEY `user_id_3` (`user_id`,`random_id`),
+  KEY `state` (`state`,`schedule_date`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+CREATE TABLE `user_passwords` (
+  `id` bigint(20) NOT NULL AUTO_INCREMENT,
+  `user_id` bigint(20) NOT NULL,
+  `salt1` varchar(128) COLLATE utf8mb4_unicode_ci NOT NULL DEFAULT '' COMMENT 'base64',
+  `salt2` varchar(128) COLLATE utf8mb4_unicode_ci NOT NULL DEFAULT '' COMMENT 'base64',
+  `v` varchar(512) COLLATE utf8mb4_unicode_ci NOT NULL DEFAULT '' COMMENT 'srp verifier g^x mod p, base64',
+  `hint` varchar(128) COLLATE utf8mb4_unicode_ci NOT NULL DEFAULT '',
+  `email` varchar(128) COLLATE utf8mb4_unicode_ci NOT NULL DEFAULT '',
+  `email_unconfirmed` varchar(128) COLLATE utf8mb4_unicode_ci NOT NULL DEFAULT '',
+  `secure_salt` varchar(128) COLLATE utf8mb4_unicode_ci NOT NULL DEFAULT '' COMMENT 'base64',
+  `secure_secret` varchar(512) COLLATE utf8mb4_unicode_ci NOT NULL DEFAULT '' COMMENT 'base64',
+  `secure_secret_id` bigint(20) NOT NULL DEFAULT '0',
+  `pending_reset_date` int(11) NOT NULL DEFAULT '0',
+  `reset_retry_date` int(11) NOT NULL DEFAULT '0',
+  `date2` bigint(20) NOT NULL DEFAULT '0',
+  `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `user_id` (`user_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+CREATE TABLE `polls` (
+  `id` bigint(20) NOT NULL AUTO_INCREMENT,
+  `poll_id` bigint(20) NOT NULL,
+  `creator` bigint(20) NOT NULL,
+  `question` varchar(512) COLLATE utf8mb4_unicode_ci NOT NULL,
+  `answers` json NOT NULL,
+  `closed` tinyint(1) NOT NULL DEFAULT '0',
+  `public_voters` tinyint(1) NOT NULL DEFAULT '0',
+  `multiple_choice` tinyint(1) NOT NULL DEFAULT '0',
+  `quiz` tinyint(1) NOT NULL DEFAULT '0',
+  `close_period` int(11) NOT NULL DEFAULT '0',
+  `close_date` bigint(20) NOT NULL DEFAULT '0',
+  `correct_answers` json NOT NULL,
+  `solution` varchar(512) COLLATE utf8mb4_unicode_ci NOT NULL DEFAULT '',
+  `solution_entities` json NOT NULL,
+  `date2` bigint(20) NOT NULL DEFAULT '0',
+  `deleted` tinyint(1) NOT NULL DEFAULT '0',
+  `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `poll_id` (`poll_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+CREATE TABLE `poll_answer_voters` (
+  `id` bigint(20) NOT NULL AUTO_INCREMENT,
+  `poll_id` bigint(20) NOT NULL,
+  `vote_user_id` bigint(20) NOT NULL,
+  `answer_option` varbinary(128) NOT NULL,
+  `date2` bigint(20) NOT NULL DEFAULT '0',
+  `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `poll_id` (`poll_id`,`vote_user_id`,`answer_option`),
+  KEY `poll_id_2` (`poll_id`,`answer_option`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+CREATE TABLE `message_reactions` (
+  `id` bigint(20) NOT NULL AUTO_INCREMENT,
+  `dialog_message_id` bigint(20) NOT NULL,
+  `user_id` bigint(20) NOT NULL,
+  `reaction` varchar(16) COLLATE utf8mb4_unicode_ci NOT NULL,
+  `big` tinyint(1) NOT NULL DEFAULT '0',
+  `date2` bigint(20) NOT NULL DEFAULT '0',
+  `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `dialog_message_id` (`dialog_message_id`,`user_id`),
+  KEY `dialog_message_id_2` (`dialog_message_id`,`reaction`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+CREATE TABLE `encrypted_chats` (
+  `id` int(11) NOT NULL AUTO_INCREMENT,
+  `access_hash` bigint(20) NOT NULL,
+  `random_id` int(11) NOT NULL,
+  `admin_id` bigint(20) NOT NULL,
+  `admin_auth_key_id` bigint(20) NOT NULL,
+  `participant_id` bigint(20) NOT NULL,
+  `participant_auth_key_id` bigint(20) NOT NULL DEFAULT '0',
+  `g_a` varbinary(512) NOT NULL,
+  `g_b` varbinary(512) NOT NULL DEFAULT '',
+  `key_fingerprint` bigint(20) NOT NULL DEFAULT '0',
+  `state` int(11) NOT NULL DEFAULT '0',
+  `history_deleted` tinyint(1) NOT NULL DEFAULT '0',
+  `date2` bigint(20) NOT NULL DEFAULT '0',
+  `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `admin_id` (`admin_id`,`random_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+CREATE TABLE `auth_qts_updates` (
+  `id` bigint(20) NOT NULL AUTO_INCREMENT,
+  `auth_id` bigint(20) NOT NULL,
+  `user_id` bigint(20) NOT NULL,
+  `qts` int(11) NOT NULL,
+  `update_type` int(11) NOT NULL DEFAULT '0',
+  `update_data` json NOT NULL,
+  `date2` bigint(20) NOT NULL DEFAULT '0',
+  `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `auth_id` (`auth_id`,`qts`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+CREATE TABLE `sticker_sets` (
+  `id` bigint(20) NOT NULL AUTO_INCREMENT,
+  `set_id` bigint(20) NOT NULL,
+  `access_hash` bigint(20) NOT NULL,
+  `creator_id` bigint(20) NOT NULL,
+  `title` varchar(64) COLLATE utf8mb4_unicode_ci NOT NULL,
+  `short_name` varchar(64) COLLATE utf8mb4_unicode_ci NOT NULL,
+  `masks` tinyint(1) NOT NULL DEFAULT '0',
+  `animated` tinyint(1) NOT NULL DEFAULT '0',
+  `videos` tinyint(1) NOT NULL DEFAULT '0',
+  `thumb_document_id` bigint(20) NOT NULL DEFAULT '0',
+  `thumb_access_hash` bigint(20) NOT NULL DEFAULT '0',
+  `thumb_size` int(11) NOT NULL DEFAULT '0',
+  `thumb_version` int(11) NOT NULL DEFAULT '0',
+  `count` int(11) NOT NULL DEFAULT '0',
+  `hash` int(11) NOT NULL DEFAULT '0',
+  `deleted` tinyint(1) NOT NULL DEFAULT '0',
+  `date2` bigint(20) NOT NULL DEFAULT '0',
+  `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `set_id` (`set_id`),
+  UNIQUE KEY `short_name` (`short_name`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+CREATE TABLE `sticker_set_documents` (
+  `id` bigint(20) NOT NULL AUTO_INCREMENT,
+  `set_id` bigint(20) NOT NULL,
+  `document_id` bigint(20) NOT NULL,
+  `emoji` varchar(64) COLLATE utf8mb4_unicode_ci NOT NULL,
+  `position` int(11) NOT NULL DEFAULT '0',
+  `deleted` tinyint(1) NOT NULL DEFAULT '0',
+  `date2` bigint(20) NOT NULL DEFAULT '0',
+  `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `set_id` (`set_id`,`document_id`),
+  KEY `document_id` (`document_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+CREATE TABLE `user_installed_sticker_sets` (
+  `id` bigint(20) NOT NULL AUTO_INCREMENT,
+  `user_id` bigint(20) NOT NULL,
+  `set_id` bigint(20) NOT NULL,
+  `masks` tinyint(1) NOT NULL DEFAULT '0',
+  `archived` tinyint(1) NOT NULL DEFAULT '0',
+  `deleted` tinyint(1) NOT NULL DEFAULT '0',
+  `date2` bigint(20) NOT NULL DEFAULT '0',
+  `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `user_id` (`user_id`,`set_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+CREATE TABLE `user_recent_stickers` (
+  `id` bigint(20) NOT NULL AUTO_INCREMENT,
+  `user_id` bigint(20) NOT NULL,
+  `attached` tinyint(1) NOT NULL DEFAULT '0',
+  `document_id` bigint(20) NOT NULL,
+  `date2` bigint(20) NOT NULL DEFAULT '0',
+  `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `user_id` (`user_id`,`attached`,`document_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+CREATE TABLE `user_faved_stickers` (
+  `id` bigint(20) NOT NULL AUTO_INCREMENT,
+  `user_id` bigint(20) NOT NULL,
+  `document_id` bigint(20) NOT NULL,
+  `date2` bigint(20) NOT NULL DEFAULT '0',
+  `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `user_id` (`user_id`,`document_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+ALTER TABLE `bots` ADD KEY `creator_user_id` (`creator_user_id`);
+
+INSERT IGNORE INTO `users` (`id`, `user_type`, `access_hash`, `first_name`, `username`, `phone`, `country_code`, `verified`, `is_bot`) VALUES
+(6, 3, 2147405625, 'BotFather', 'BotFather', 'botfather', '', 1, 1);
+
+INSERT IGNORE INTO `bots` (`bot_id`, `bot_type`, `creator_user_id`, `token`, `description`, `bot_nochats`, `bot_info_version`) VALUES
+(6, 1, 0, '', 'BotFather is the one bot to rule them all. Use it to create new bot accounts and manage your existing bots.', 1, 1);
+
+INSERT IGNORE INTO `username` (`username`, `peer_type`, `peer_id`) VALUES
+('BotFather', 2, 6);
+
+INSERT IGNORE INTO `user_presences` (`user_id`, `last_seen_at`, `expires`) VALUES
+(6, 0, 0);
+
+INSERT INTO `bot_commands` (`bot_id`, `command`, `description`) VALUES
+(6, 'newbot', 'create a new bot'),
+(6, 'mybots', 'list your bots'),
+(6, 'token', 'get the token of a bot'),
+(6, 'revoke', 'revoke the token of a bot'),
+(6, 'setcommands', 'change the list of commands'),
+(6, 'cancel', 'cancel the current operation');